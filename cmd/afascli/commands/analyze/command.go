@@ -36,12 +36,14 @@ import (
 	xregisters "github.com/immune-gmbh/AttestationFailureAnalysisService/pkg/registers"
 
 	"github.com/immune-gmbh/AttestationFailureAnalysisService/cmd/afascli/commands/analyze/format"
-	"github.com/immune-gmbh/AttestationFailureAnalysisService/cmd/afascli/commands/display_eventlog"
 	"github.com/immune-gmbh/AttestationFailureAnalysisService/cmd/afascli/commands/dump"
 	"github.com/immune-gmbh/AttestationFailureAnalysisService/cmd/afascli/helpers"
 	"github.com/immune-gmbh/AttestationFailureAnalysisService/pkg/commands"
 	"github.com/immune-gmbh/AttestationFailureAnalysisService/pkg/dmidecode"
+	"github.com/immune-gmbh/AttestationFailureAnalysisService/pkg/evidence"
 	"github.com/immune-gmbh/AttestationFailureAnalysisService/pkg/firmwarewand"
+	"github.com/immune-gmbh/AttestationFailureAnalysisService/pkg/flashrom"
+	"github.com/immune-gmbh/AttestationFailureAnalysisService/pkg/hwaccess"
 	"github.com/immune-gmbh/AttestationFailureAnalysisService/pkg/tpm"
 
 	pcr0tool_commands "github.com/9elements/converged-security-suite/v2/cmd/pcr0tool/commands"
@@ -84,11 +86,13 @@ type Command struct {
 	tpmDevice         *string
 	flow              *string
 	localhostRequest  *bool
+	bundle            *string
 	showNotApplicable *bool
 	dumpRequest       *string
 	useRequest        *string
 	outputJSON        *bool
 	outputFormat      *string
+	deltaUpload       *bool
 }
 
 // Usage prints the syntax of arguments for this command
@@ -101,35 +105,56 @@ func (cmd Command) Description() string {
 	return "launches selected analyzers: " + knownAnalyzersArg()
 }
 
-// Registers returns status registers according to flag '-registers' and '-localhost'
-func (cmd Command) Registers() (registers.Registers, bool, error) {
+// EvidenceBundle returns the evidence bundle defined by path through flag '-bundle'.
+// Returns nil (without error) if the flag is empty.
+func (cmd Command) EvidenceBundle() (*evidence.Bundle, error) {
+	if len(*cmd.bundle) == 0 {
+		return nil, nil
+	}
+	data, err := os.ReadFile(*cmd.bundle)
+	if err != nil {
+		return nil, fmt.Errorf("unable to read file '%s': %w", *cmd.bundle, err)
+	}
+	return evidence.Read(data)
+}
+
+// Registers returns status registers according to flag '-registers', the evidence bundle and '-localhost'
+func (cmd Command) Registers(bundle *evidence.Bundle, hw hwaccess.Interface) (registers.Registers, bool, error) {
 	if len(*cmd.registers) > 0 {
 		regs, err := helpers.ParseRegisters(*cmd.registers)
 		return regs, true, err
+	} else if bundle != nil && bundle.StatusRegisters != nil {
+		return bundle.StatusRegisters, true, nil
 	} else if *cmd.localhostRequest {
-		regs, err := xregisters.LocalRegisters()
+		regs, err := xregisters.LocalRegisters(hw)
 		return regs, false, err
 	}
 	return nil, false, nil
 }
 
-// TPMDevice returns TPM device according to flag '-tpm-device' and '-localhost'
-func (cmd Command) TPMDevice() (tpmdetection.Type, bool, error) {
+// TPMDevice returns TPM device according to flag '-tpm-device', the evidence bundle and '-localhost'
+func (cmd Command) TPMDevice(bundle *evidence.Bundle, hw hwaccess.Interface) (tpmdetection.Type, bool, error) {
 	if len(*cmd.tpmDevice) > 0 {
 		tpmDevice, err := tpmdetection.FromString(*cmd.tpmDevice)
 		return tpmDevice, true, err
+	} else if bundle != nil && bundle.TPMDevice != nil {
+		return *bundle.TPMDevice, true, nil
 	} else if *cmd.localhostRequest {
-		tpmDevice, err := tpmdetection.Local()
+		tpmDevice, err := hw.TPMType()
 		return tpmDevice, false, err
 	}
 	return tpmdetection.TypeNoTPM, false, nil
 }
 
-// EventLog returns a parsed TPM Event Log defined by path through flag '-event-log'.
-func (cmd Command) EventLog() (*tpmeventlog.TPMEventLog, error) {
+// EventLog returns a parsed TPM Event Log defined by path through flag '-event-log'
+// or taken from the evidence bundle.
+func (cmd Command) EventLog(bundle *evidence.Bundle, hw hwaccess.Interface) (*tpmeventlog.TPMEventLog, error) {
 	eventlogPath := *cmd.eventLog
+	if len(eventlogPath) == 0 && bundle != nil && len(bundle.TPMEventLog) > 0 {
+		return bundle.ParseTPMEventLog()
+	}
 	if *cmd.localhostRequest && len(*cmd.eventLog) == 0 {
-		eventlogPath = display_eventlog.DefaultEventlogLocation
+		eventlogPath = hw.Path(hwaccess.PathTPMEventLog)
 	}
 	if len(eventlogPath) == 0 {
 		return nil, nil
@@ -137,18 +162,20 @@ func (cmd Command) EventLog() (*tpmeventlog.TPMEventLog, error) {
 	return helpers.ParseTPMEventlog(eventlogPath)
 }
 
-// ExpectPCR0 returns a PCR0 defined by path flag '-expect-pcr0' and '-localhost'
-func (cmd Command) ExpectPCR0() ([]byte, bool, error) {
+// ExpectPCR0 returns a PCR0 defined by path flag '-expect-pcr0', the evidence bundle and '-localhost'
+func (cmd Command) ExpectPCR0(bundle *evidence.Bundle, hw hwaccess.Interface) ([]byte, bool, error) {
 	if len(*cmd.expectPCR0) > 0 {
 		pcr0, err := helpers.ConvertUserInputPCR(*cmd.expectPCR0)
 		return pcr0, true, err
+	} else if bundle != nil && bundle.PCR0() != nil {
+		return bundle.PCR0(), true, nil
 	} else if *cmd.localhostRequest {
 		var (
 			localPCR0 []byte
 			err       error
 		)
 		for _, alg := range []tpm2.Algorithm{tpm2.AlgSHA256, tpm2.AlgSHA1} {
-			localPCR0, err = tpm.ReadPCRFromTPM(0, alg)
+			localPCR0, err = tpm.ReadPCRFromTPM(hw, 0, alg)
 			if err == nil {
 				return localPCR0, false, nil
 			}
@@ -191,7 +218,7 @@ func (cmd Command) FlagUseRequest(ctx context.Context) (*afas.AnalyzeRequest, er
 }
 
 // FirmwareVersion returns information about firmware version based on flags '-firmware-version' and '-localhost' as well as optional input args
-func (cmd Command) FirmwareVersion(ctx context.Context, actualFirmware []byte, actualImageMetaData *afas.FirmwareImageMetadata) ([]afas.FirmwareVersion, error) {
+func (cmd Command) FirmwareVersion(ctx context.Context, actualFirmware []byte, actualImageMetaData *afas.FirmwareImageMetadata, bundle *evidence.Bundle, hw hwaccess.Interface) ([]afas.FirmwareVersion, error) {
 	if len(*cmd.firmwareVersion) > 0 {
 		return []afas.FirmwareVersion{
 			{
@@ -200,6 +227,7 @@ func (cmd Command) FirmwareVersion(ctx context.Context, actualFirmware []byte, a
 		}, nil
 	}
 	// Try actualFirmware first (as we should take original firmware that matches it)
+	// Try SMBIOS table from the evidence bundle (if it is provided)
 	// Try local firmware DMI Table (if we do analysis for a local host)
 	// Try information found in BlobStorage (BlobStorage may store old/outdated information)
 	var getFirmwareVersion []func() (string, error)
@@ -214,9 +242,20 @@ func (cmd Command) FirmwareVersion(ctx context.Context, actualFirmware []byte, a
 			return biosInfo.Version, nil
 		})
 	}
+	if bundle != nil && len(bundle.SMBIOS) > 0 {
+		getFirmwareVersion = append(getFirmwareVersion, func() (string, error) {
+			bundleDMI, err := bundle.DMITable()
+			if err != nil {
+				logger.FromCtx(ctx).Errorf("Failed to get DMI Table from the evidence bundle: %v", err)
+				return "", err
+			}
+			biosInfo := bundleDMI.BIOSInfo()
+			return biosInfo.Version, nil
+		})
+	}
 	if *cmd.localhostRequest {
 		getFirmwareVersion = append(getFirmwareVersion, func() (string, error) {
-			localDMI, err := dmidecode.LocalDMITable()
+			localDMI, err := dmidecode.DMITableFromHardware(hw)
 			if err != nil {
 				logger.FromCtx(ctx).Errorf("Failed to get local DMI Table: %v", err)
 				return "", err
@@ -281,8 +320,10 @@ func (cmd *Command) SetupFlagSet(flag *flag.FlagSet) {
 	cmd.tpmDevice = flag.String("tpm-device", "", "optional tpm device type, values: "+pcr0tool_commands.TPMTypeCommandLineValues())
 	cmd.flow = flag.String("flow", pcr.FlowAuto.String(), "desired measurements flow, values: "+pcr0tool_commands.FlowCommandLineValues())
 	cmd.localhostRequest = flag.Bool("localhost", false, "specified whether request is made for localhost environment")
+	cmd.bundle = flag.String("bundle", "", "path to an evidence bundle produced by 'afascli collect'; explicitly passed flags and the image path take precedence over the bundle content, and the bundle content takes precedence over -localhost")
 	cmd.showNotApplicable = flag.Bool("show-not-applicable", false, "specifies whether to show not applicable analyzers result")
 	cmd.outputJSON = flag.Bool("json", false, "prints the result AnalyzeResult thrift structure in json format")
+	cmd.deltaUpload = flag.Bool("delta-upload", true, "upload only the parts of the firmware image which are unknown to the server")
 
 	// TODO: Consider splitting "afascli analyze" to "afascli scan" and "afascli analyze".
 	//       The "scan" should gather all the information, but do not send it anywhere,
@@ -295,8 +336,8 @@ func (cmd *Command) SetupFlagSet(flag *flag.FlagSet) {
 
 // FirmwarewandOptions returns firmwarewand.Option slice
 // which should be used according to passed flags.
-func (cmd Command) FirmwarewandOptions() []firmwarewand.Option {
-	return append(helpers.FirmwarewandOptions(*cmd.afasEndpoint), firmwarewand.OptionFlashromOptions(cmd.FlashromOptions()))
+func (cmd Command) FirmwarewandOptions(hw hwaccess.Interface) []firmwarewand.Option {
+	return append(helpers.FirmwarewandOptions(*cmd.afasEndpoint), firmwarewand.OptionFlashromOptions(cmd.FlashromOptions(hw)))
 }
 
 func ptr[T any](in T) *T {
@@ -313,6 +354,7 @@ func (cmd Command) buildAnalyzeRequest(
 	cfg commands.Config,
 	fwWand *firmwarewand.FirmwareWand,
 	args []string,
+	deltaUpload bool,
 ) (*afas.AnalyzeRequest, error) {
 	var actualFirmwareFile string
 	if len(args) > 0 {
@@ -336,10 +378,21 @@ func (cmd Command) buildAnalyzeRequest(
 		}
 	}
 
+	bundle, err := cmd.EvidenceBundle()
+	if err != nil {
+		return nil, commands.ErrArgs{Err: fmt.Errorf("unable to load the evidence bundle: %w", err)}
+	}
+
+	regions, err := cmd.Regions()
+	if err != nil {
+		return nil, commands.ErrArgs{Err: err}
+	}
+
 	var (
-		actualFirmware     []byte
-		actualFirmwareMeta *afas.FirmwareImageMetadata
-		err                error
+		actualFirmware       []byte
+		actualFirmwareMeta   *afas.FirmwareImageMetadata
+		actualFirmwareSparse *flashrom.SparseImage
+		actualFirmwareDump   *afas.FirmwareDumpInfo
 	)
 
 	if len(actualFirmwareFile) > 0 {
@@ -347,19 +400,36 @@ func (cmd Command) buildAnalyzeRequest(
 		if err != nil {
 			return nil, fmt.Errorf("failed to read file '%s': %w", actualFirmware, err)
 		}
+	} else if bundle != nil && len(bundle.FirmwareImage) > 0 {
+		actualFirmware = bundle.FirmwareImage
+	} else if *cmd.localhostRequest && !regions.IsEmpty() {
+		actualFirmwareSparse, err = fwWand.DumpRegions(ctx, regions)
+		if err != nil {
+			logger.FromCtx(ctx).Errorf("Failed to dump regions of local firmware: %v", err)
+		} else {
+			actualFirmware = actualFirmwareSparse.Image
+			actualFirmwareDump = firmwarewand.DumpInfoToThrift(actualFirmwareSparse.Info)
+			logger.FromCtx(ctx).Infof("Dumped ranges %s of local firmware of '%d' bytes", actualFirmwareSparse.Coverage, len(actualFirmware))
+		}
 	} else if *cmd.localhostRequest {
-		actualFirmware, err = fwWand.Dump(ctx)
+		var dumpInfo *flashrom.DumpInfo
+		actualFirmware, dumpInfo, err = fwWand.DumpWithInfo(ctx)
 		if err != nil {
 			logger.FromCtx(ctx).Errorf("Failed to dump local firmware: %v", err)
 		}
-		logger.FromCtx(ctx).Infof("Dumped local firmware of '%d' bytes", len(actualFirmware))
+		if dumpInfo != nil {
+			actualFirmwareDump = firmwarewand.DumpInfoToThrift(*dumpInfo)
+			logger.FromCtx(ctx).Infof("Dumped local firmware of '%d' bytes using %s (quality: %s)", len(actualFirmware), dumpInfo.Method, dumpInfo.Quality)
+		} else {
+			logger.FromCtx(ctx).Infof("Dumped local firmware of '%d' bytes", len(actualFirmware))
+		}
 	}
 
-	if len(actualFirmware) > 0 {
+	if len(actualFirmware) > 0 && (actualFirmwareSparse == nil || actualFirmwareSparse.IsComplete()) {
 		actualFirmwareMeta = fwWand.FindImage(ctx, actualFirmware)
 	}
 
-	firmwareVersions, err := cmd.FirmwareVersion(ctx, actualFirmware, actualFirmwareMeta)
+	firmwareVersions, err := cmd.FirmwareVersion(ctx, actualFirmware, actualFirmwareMeta, bundle, cfg.Hardware)
 	if err != nil {
 		logger.FromCtx(ctx).Errorf("Failed to obtain firmware version: %v", err)
 	}
@@ -381,7 +451,7 @@ func (cmd Command) buildAnalyzeRequest(
 		}
 	}
 
-	registers, userInput, err := cmd.Registers()
+	registers, userInput, err := cmd.Registers(bundle, cfg.Hardware)
 	if err != nil {
 		if registers == nil {
 			logger.FromCtx(ctx).Errorf("Failed to obtain registers: %v", err)
@@ -394,7 +464,7 @@ func (cmd Command) buildAnalyzeRequest(
 		}
 	}
 
-	tpmDevice, userInput, err := cmd.TPMDevice()
+	tpmDevice, userInput, err := cmd.TPMDevice(bundle, cfg.Hardware)
 	if err != nil {
 		logger.FromCtx(ctx).Errorf("Failed to obtain TPM device: %v", err)
 		if userInput {
@@ -402,13 +472,13 @@ func (cmd Command) buildAnalyzeRequest(
 		}
 	}
 
-	eventlog, err := cmd.EventLog()
+	eventlog, err := cmd.EventLog(bundle, cfg.Hardware)
 	if err != nil {
 		logger.FromCtx(ctx).Errorf("Failed to obtain TPM eventlog: %v", err)
 		return nil, err
 	}
 
-	expectPCR0, userInput, err := cmd.ExpectPCR0()
+	expectPCR0, userInput, err := cmd.ExpectPCR0(bundle, cfg.Hardware)
 	if err != nil {
 		logger.FromCtx(ctx).Errorf("Failed to obtain expected PCR0: %v", err)
 		if userInput {
@@ -423,16 +493,32 @@ func (cmd Command) buildAnalyzeRequest(
 	}
 
 	requestBuilder := firmwarewand.NewAnalyzeRequestBuilder()
-	if *cmd.localhostRequest {
-		if err := requestBuilder.AddLocalHostInfo(); err != nil {
+	if bundle != nil && bundle.HostInfo != nil {
+		requestBuilder.SetHostInfo(bundle.HostInfo)
+	} else if *cmd.localhostRequest {
+		if err := requestBuilder.AddHostInfo(cfg.Hardware); err != nil {
 			return nil, fmt.Errorf("failed to add local host info: %w", err)
 		}
 	}
 
 	var actualImage afas.FirmwareImage
-	if actualFirmwareMeta != nil && len(actualFirmwareMeta.ImageID) > 0 {
+	if actualFirmwareSparse != nil && !actualFirmwareSparse.IsComplete() {
+		image, err := firmwarewand.SparseImageUpload(actualFirmwareSparse)
+		if err != nil {
+			logger.FromCtx(ctx).Errorf("Failed to prepare the actual firmware image upload: %v", err)
+			return nil, err
+		}
+		actualImage = *image
+	} else if actualFirmwareMeta != nil && len(actualFirmwareMeta.ImageID) > 0 {
 		logger.FromCtx(ctx).Infof("Use blob storage image ID: %X", actualFirmwareMeta.ImageID)
 		actualImage.BlobStorageKey = ptr(string(actualFirmwareMeta.ImageID))
+	} else if len(actualFirmware) > 0 && deltaUpload {
+		image, err := fwWand.PrepareImageUpload(ctx, actualFirmware)
+		if err != nil {
+			logger.FromCtx(ctx).Errorf("Failed to prepare the actual firmware image upload: %v", err)
+			return nil, err
+		}
+		actualImage = *image
 	} else if len(actualFirmware) > 0 {
 		compressedImage, err := compressXZ(actualFirmware)
 		// this should not happen as all images should be compressed. Treat as a fatal error
@@ -457,6 +543,7 @@ func (cmd Command) buildAnalyzeRequest(
 				tpmDevice,
 				eventlog,
 				expectPCR0,
+				actualFirmwareDump,
 			)
 			if err != nil {
 				color.New(color.FgRed).Printf("Failed to add measured boot input request: %v\n", err)
@@ -556,7 +643,7 @@ func (cmd Command) Execute(ctx context.Context, cfg commands.Config, args []stri
 		return err
 	}
 
-	fwWand, err := firmwarewand.New(ctx, append(cfg.FirmwareWandOptions, cmd.FirmwarewandOptions()...)...)
+	fwWand, err := firmwarewand.New(ctx, append(cfg.FirmwareWandOptions, cmd.FirmwarewandOptions(cfg.Hardware)...)...)
 	if err != nil {
 		return fmt.Errorf("unable to initialize a firmwarewand: %w", err)
 	}
@@ -572,7 +659,10 @@ func (cmd Command) Execute(ctx context.Context, cfg commands.Config, args []stri
 	}
 
 	if request == nil {
-		request, err = cmd.buildAnalyzeRequest(ctx, cfg, fwWand, args)
+		// a dumped request could be sent later to another server, so it
+		// should not rely on the images stored by the server
+		deltaUpload := *cmd.deltaUpload && dumpRequestFormat == DumpFormatNone
+		request, err = cmd.buildAnalyzeRequest(ctx, cfg, fwWand, args, deltaUpload)
 		if err != nil {
 			return err
 		}
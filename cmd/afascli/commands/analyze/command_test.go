@@ -0,0 +1,155 @@
+// Copyright 2023 Meta Platforms, Inc. and affiliates.
+//
+// Redistribution and use in source and binary forms, with or without modification, are permitted provided that the following conditions are met:
+//
+// 1. Redistributions of source code must retain the above copyright notice, this list of conditions and the following disclaimer.
+//
+// 2. Redistributions in binary form must reproduce the above copyright notice, this list of conditions and the following disclaimer in the documentation and/or other materials provided with the distribution.
+//
+// 3. Neither the name of the copyright holder nor the names of its contributors may be used to endorse or promote products derived from this software without specific prior written permission.
+//
+// THIS SOFTWARE IS PROVIDED BY THE COPYRIGHT HOLDERS AND CONTRIBUTORS "AS IS" AND ANY EXPRESS OR IMPLIED WARRANTIES, INCLUDING, BUT NOT LIMITED TO, THE IMPLIED WARRANTIES OF MERCHANTABILITY AND FITNESS FOR A PARTICULAR PURPOSE ARE DISCLAIMED. IN NO EVENT SHALL THE COPYRIGHT HOLDER OR CONTRIBUTORS BE LIABLE FOR ANY DIRECT, INDIRECT, INCIDENTAL, SPECIAL, EXEMPLARY, OR CONSEQUENTIAL DAMAGES (INCLUDING, BUT NOT LIMITED TO, PROCUREMENT OF SUBSTITUTE GOODS OR SERVICES; LOSS OF USE, DATA, OR PROFITS; OR BUSINESS INTERRUPTION) HOWEVER CAUSED AND ON ANY THEORY OF LIABILITY, WHETHER IN CONTRACT, STRICT LIABILITY, OR TORT (INCLUDING NEGLIGENCE OR OTHERWISE) ARISING IN ANY WAY OUT OF THE USE OF THIS SOFTWARE, EVEN IF ADVISED OF THE POSSIBILITY OF SUCH DAMAGE.
+package analyze
+
+import (
+	"bytes"
+	"context"
+	"crypto/sha1"
+	"crypto/sha256"
+	"encoding/binary"
+	"encoding/hex"
+	"flag"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"path/filepath"
+	"sync"
+	"testing"
+
+	"github.com/9elements/converged-security-suite/v2/testdata/firmware"
+	"github.com/apache/thrift/lib/go/thrift"
+	"github.com/stretchr/testify/require"
+
+	"github.com/immune-gmbh/AttestationFailureAnalysisService/cmd/afascli/commands/collect"
+	"github.com/immune-gmbh/AttestationFailureAnalysisService/if/generated/afas"
+	"github.com/immune-gmbh/AttestationFailureAnalysisService/pkg/analyzers/diffmeasuredboot/report/generated/diffanalysis"
+	"github.com/immune-gmbh/AttestationFailureAnalysisService/pkg/commands"
+	"github.com/immune-gmbh/AttestationFailureAnalysisService/pkg/hwaccess"
+)
+
+type fakeAFAS struct {
+	afas.AttestationFailureAnalyzerService
+
+	locker   sync.Mutex
+	requests []*afas.AnalyzeRequest
+}
+
+func (srv *fakeAFAS) SearchFirmware(ctx context.Context, request *afas.SearchFirmwareRequest) (*afas.SearchFirmwareResult_, error) {
+	return &afas.SearchFirmwareResult_{}, nil
+}
+
+func (srv *fakeAFAS) CheckFirmwareVersion(ctx context.Context, request *afas.CheckFirmwareVersionRequest) (*afas.CheckFirmwareVersionResult_, error) {
+	result := &afas.CheckFirmwareVersionResult_{}
+	for range request.Firmwares {
+		result.ExistStatus = append(result.ExistStatus, true)
+	}
+	return result, nil
+}
+
+func (srv *fakeAFAS) Analyze(ctx context.Context, request *afas.AnalyzeRequest) (*afas.AnalyzeResult_, error) {
+	srv.locker.Lock()
+	defer srv.locker.Unlock()
+	srv.requests = append(srv.requests, request)
+	return &afas.AnalyzeResult_{}, nil
+}
+
+// smbiosBIOSInfo returns a raw SMBIOS table with only the BIOS information structure (type 0).
+func smbiosBIOSInfo(version string) []byte {
+	var buf bytes.Buffer
+	buf.Write([]byte{0x00, 0x18, 0x00, 0x00}) // type, length, handle
+	buf.Write([]byte{0x01, 0x02, 0x00, 0xf0, 0x03, 0xff})
+	buf.Write(make([]byte, 0x18-buf.Len()))
+	buf.WriteString("Vendor\x00" + version + "\x00" + "01/01/2023\x00\x00")
+	buf.Write([]byte{0x7f, 0x04, 0x01, 0x00, 0x00, 0x00}) // end-of-table
+	return buf.Bytes()
+}
+
+// tpm12EventLog returns a TPM event log in the TCG1.2 format with a single PCR0 event.
+func tpm12EventLog(data []byte) []byte {
+	var buf bytes.Buffer
+	digest := sha1.Sum(data)
+	_ = binary.Write(&buf, binary.LittleEndian, uint32(0))          // PCR index
+	_ = binary.Write(&buf, binary.LittleEndian, uint32(0x00000008)) // EV_S_CRTM_VERSION
+	buf.Write(digest[:])
+	_ = binary.Write(&buf, binary.LittleEndian, uint32(len(data)))
+	buf.Write(data)
+	return buf.Bytes()
+}
+
+func runCommand(t *testing.T, cmd commands.Command, hw hwaccess.Interface, args ...string) {
+	flagSet := flag.NewFlagSet("", flag.ContinueOnError)
+	cmd.SetupFlagSet(flagSet)
+	require.NoError(t, flagSet.Parse(args))
+	require.NoError(t, cmd.Execute(context.Background(), commands.Config{Hardware: hw}, flagSet.Args()))
+}
+
+func TestCollectAnalyzeFixture(t *testing.T) {
+	image := firmware.FakeIntelFirmware
+	pcr0 := sha256.Sum256([]byte("PCR0"))
+	hw, err := hwaccess.WriteFixture(t.TempDir(), map[string][]byte{
+		"sys/class/mtd/mtd0/name":               []byte("BIOS\n"),
+		"sys/class/mtd/mtd0/size":               []byte(fmt.Sprintf("%d\n", len(image))),
+		"sys/class/mtd/mtd0/offset":             []byte("0\n"),
+		"dev/mtd0":                              image,
+		hwaccess.PathTPMDevice:                  nil,
+		hwaccess.PathSysFSTPM + "/pcr-sha256/0": []byte(hex.EncodeToString(pcr0[:]) + "\n"),
+		hwaccess.PathTPMEventLog:                tpm12EventLog([]byte("1.2.3")),
+		hwaccess.PathSMBIOS:                     smbiosBIOSInfo("1.2.3"),
+		hwaccess.PathHostname:                   []byte("fixture-host\n"),
+	})
+	require.NoError(t, err)
+
+	srv := &fakeAFAS{}
+	protocolFactory := thrift.NewTBinaryProtocolFactoryConf(nil)
+	httpServer := httptest.NewServer(http.HandlerFunc(thrift.NewThriftHandlerFunc(
+		afas.NewAttestationFailureAnalyzerServiceProcessor(srv),
+		protocolFactory, protocolFactory,
+	)))
+	defer httpServer.Close()
+	analyzeArgs := []string{
+		"-afas-endpoint", httpServer.URL,
+		"-analyzer", diffanalysis.DiffMeasuredBootAnalyzerID,
+		"-delta-upload=false",
+		"-json",
+	}
+
+	bundlePath := filepath.Join(t.TempDir(), "bundle.tar.gz")
+	runCommand(t, &collect.Command{}, hw, bundlePath)
+	runCommand(t, &Command{}, hw, append(analyzeArgs, "-bundle", bundlePath)...)
+	runCommand(t, &Command{}, hw, append(analyzeArgs, "-localhost")...)
+
+	require.Len(t, srv.requests, 2)
+	for _, request := range srv.requests {
+		require.NotNil(t, request.HostInfo)
+		require.Equal(t, "fixture-host", request.HostInfo.GetHostname())
+		require.True(t, request.HostInfo.IsClientHostAnalyzed)
+
+		require.Len(t, request.Analyzers, 1)
+		input := request.Analyzers[0].DiffMeasuredBoot
+		require.NotNil(t, input)
+		require.NotNil(t, input.OriginalFirmwareImage)
+		require.Equal(t, "1.2.3", request.Artifacts[*input.OriginalFirmwareImage].FwImage.FirmwareVersion.Version)
+		require.NotNil(t, input.ActualPCR0)
+		require.Equal(t, pcr0[:], request.Artifacts[*input.ActualPCR0].Pcr.Value)
+		require.NotNil(t, input.TPMEventLog)
+	}
+
+	// the bundle collected from the fixture yields the same request as the analysis
+	// of the fixture itself, except the dump info (which is not stored in bundles, yet)
+	bundleRequest, localRequest := srv.requests[0], srv.requests[1]
+	require.Len(t, localRequest.Artifacts, len(bundleRequest.Artifacts)+1)
+	require.Equal(t, bundleRequest.Artifacts, localRequest.Artifacts[:len(bundleRequest.Artifacts)])
+	dumpInfo := localRequest.Artifacts[len(bundleRequest.Artifacts)].FirmwareDumpInfo
+	require.NotNil(t, dumpInfo)
+	require.Equal(t, afas.FirmwareDumpMethod_MTD, dumpInfo.Method)
+}
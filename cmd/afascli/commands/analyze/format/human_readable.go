@@ -65,9 +65,33 @@ func HumanReadable(w io.Writer, result afas.AnalyzeResult_, enableColors bool, s
 		printAnalyzerResult(w, *analyzerResult.AnalyzerOutcome, enableColors)
 		fmt.Fprintf(w, "=== End of '%s' ===\n", analyzerResult.AnalyzerName)
 	}
+	printVerdict(w, result.Verdict, enableColors)
 	printReferencesToImages(w, result)
 }
 
+func printVerdict(w io.Writer, verdict *afas.AnalyzeVerdict, enableColors bool) {
+	if verdict == nil {
+		return
+	}
+	var colorAttr color.Attribute
+	switch verdict.Verdict {
+	case afas.Verdict_Pass:
+		colorAttr = color.FgGreen
+	case afas.Verdict_Remediate:
+		colorAttr = color.FgYellow
+	default:
+		colorAttr = color.FgRed
+	}
+	fprintfWithColor(w, enableColors, colorAttr, "Verdict: %s (policy '%s')\n", verdict.Verdict, verdict.Policy)
+	for _, reason := range verdict.Reasons {
+		fmt.Fprintf(w, "\t%s: rule '%s' of analyzer '%s'", reason.Verdict, reason.Rule, reason.AnalyzerName)
+		if reason.Description != "" {
+			fmt.Fprintf(w, ": %s", reason.Description)
+		}
+		fmt.Fprintln(w)
+	}
+}
+
 func printReferencesToImages(w io.Writer, result afas.AnalyzeResult_) {
 	var actualImageIDs, originalImageIDs []types.ImageID
 	actualImageIDIsSet, originalImageIDIsSet := map[types.ImageID]struct{}{}, map[types.ImageID]struct{}{}
@@ -166,7 +190,16 @@ func printAnalyzerResult(w io.Writer, result afas.AnalyzerOutcome, enableColors
 			switch {
 			case report.Custom.IsSetDiffMeasuredBoot():
 				diffMeasuredBoot := report.Custom.GetDiffMeasuredBoot()
-				fmt.Fprintf(w, "Diff diagnosis: %s\n", diffMeasuredBoot.GetDiagnosis())
+				fmt.Fprintf(w, "Diff diagnosis: %s (confidence %.2f)\n", diffMeasuredBoot.GetDiagnosis(), diffMeasuredBoot.GetDiagnosisConfidence())
+				if alignment := diffMeasuredBoot.GetAlignment(); len(alignment) > 1 {
+					for _, segment := range alignment {
+						fmt.Fprintf(w, "aligned %s %s: original 0x%08X--0x%08X, actual 0x%08X--0x%08X\n",
+							segment.Kind, segment.Name,
+							segment.Original.Offset, segment.Original.Offset+segment.Original.Length,
+							segment.Actual.Offset, segment.Actual.Offset+segment.Actual.Length,
+						)
+					}
+				}
 				for _, diffEntry := range diffMeasuredBoot.GetDiffEntries() {
 					var offset, length int64
 					if diffEntry.Range == nil || (diffEntry.Range.Length == 0 && diffEntry.OBSOLETE_Length != 0) {
@@ -184,6 +217,9 @@ func printAnalyzerResult(w io.Writer, result afas.AnalyzerOutcome, enableColors
 					if diffEntry.HammingDistanceNon00orFF*100/diffEntry.HammingDistance < 50 {
 						fmt.Fprintf(w, "\tbitwise non 0x00/0xFF hamming distance is %7d\n", diffEntry.HammingDistanceNon00orFF)
 					}
+					if assessment := diffEntry.GetAssessment(); assessment != nil {
+						fmt.Fprintf(w, "\tregion: %s, pattern: %s, score: %.2f: %s\n", assessment.Region, assessment.Pattern, assessment.Score, assessment.Reason)
+					}
 				}
 			case report.Custom.IsSetIntelACM():
 				intelACM := report.Custom.GetIntelACM()
@@ -0,0 +1,198 @@
+// Copyright 2023 Meta Platforms, Inc. and affiliates.
+//
+// Redistribution and use in source and binary forms, with or without modification, are permitted provided that the following conditions are met:
+//
+// 1. Redistributions of source code must retain the above copyright notice, this list of conditions and the following disclaimer.
+//
+// 2. Redistributions in binary form must reproduce the above copyright notice, this list of conditions and the following disclaimer in the documentation and/or other materials provided with the distribution.
+//
+// 3. Neither the name of the copyright holder nor the names of its contributors may be used to endorse or promote products derived from this software without specific prior written permission.
+//
+// THIS SOFTWARE IS PROVIDED BY THE COPYRIGHT HOLDERS AND CONTRIBUTORS "AS IS" AND ANY EXPRESS OR IMPLIED WARRANTIES, INCLUDING, BUT NOT LIMITED TO, THE IMPLIED WARRANTIES OF MERCHANTABILITY AND FITNESS FOR A PARTICULAR PURPOSE ARE DISCLAIMED. IN NO EVENT SHALL THE COPYRIGHT HOLDER OR CONTRIBUTORS BE LIABLE FOR ANY DIRECT, INDIRECT, INCIDENTAL, SPECIAL, EXEMPLARY, OR CONSEQUENTIAL DAMAGES (INCLUDING, BUT NOT LIMITED TO, PROCUREMENT OF SUBSTITUTE GOODS OR SERVICES; LOSS OF USE, DATA, OR PROFITS; OR BUSINESS INTERRUPTION) HOWEVER CAUSED AND ON ANY THEORY OF LIABILITY, WHETHER IN CONTRACT, STRICT LIABILITY, OR TORT (INCLUDING NEGLIGENCE OR OTHERWISE) ARISING IN ANY WAY OUT OF THE USE OF THIS SOFTWARE, EVEN IF ADVISED OF THE POSSIBILITY OF SUCH DAMAGE.
+
+package collect
+
+import (
+	"context"
+	"flag"
+	"fmt"
+	"os"
+	"time"
+
+	"github.com/immune-gmbh/AttestationFailureAnalysisService/cmd/afascli/commands/display_eventlog"
+	"github.com/immune-gmbh/AttestationFailureAnalysisService/cmd/afascli/commands/dump"
+	"github.com/immune-gmbh/AttestationFailureAnalysisService/cmd/afascli/helpers"
+	"github.com/immune-gmbh/AttestationFailureAnalysisService/pkg/commands"
+	"github.com/immune-gmbh/AttestationFailureAnalysisService/pkg/evidence"
+	"github.com/immune-gmbh/AttestationFailureAnalysisService/pkg/firmwarewand"
+	"github.com/immune-gmbh/AttestationFailureAnalysisService/pkg/flashrom"
+	"github.com/immune-gmbh/AttestationFailureAnalysisService/pkg/hwaccess"
+	xregisters "github.com/immune-gmbh/AttestationFailureAnalysisService/pkg/registers"
+	"github.com/immune-gmbh/AttestationFailureAnalysisService/pkg/tpm"
+
+	pcrtypes "github.com/9elements/converged-security-suite/v2/pkg/pcr/types"
+	"github.com/9elements/converged-security-suite/v2/pkg/tpmdetection"
+	"github.com/facebookincubator/go-belt/tool/logger"
+	"github.com/google/go-tpm/tpm2"
+)
+
+// amountOfCollectedPCRs is the amount of PCRs (starting from PCR0) stored in the bundle.
+const amountOfCollectedPCRs = 8
+
+type dumpCommand = dump.Command
+
+// Command is the implementation of `commands.Command`.
+type Command struct {
+	dumpCommand
+	image     *string
+	registers *string
+	eventLog  *string
+}
+
+// Usage prints the syntax of arguments for this command
+func (cmd Command) Usage() string {
+	return "<output-file>"
+}
+
+// Description explains what this verb commands to do
+func (cmd Command) Description() string {
+	return "collect evidence from local machine into a bundle (a tar.gz or, if the output file has extension '.zip', a zip archive)"
+}
+
+// SetupFlagSet is called to allow the command implementation
+// to setup which option flags it has.
+func (cmd *Command) SetupFlagSet(flag *flag.FlagSet) {
+	cmd.dumpCommand.SetupFlagSet(flag)
+	cmd.image = flag.String("image", "", "use the firmware image from the file instead of dumping it")
+	cmd.registers = flag.String("registers", "", "use status registers from JSON file instead of reading them from the local machine")
+	cmd.eventLog = flag.String("event-log", display_eventlog.DefaultEventlogLocation, "path to the binary EventLog")
+}
+
+// Execute is the main function here. It is responsible to
+// start the execution of the command.
+//
+// `args` are the arguments left unused by verb itself and options.
+func (cmd Command) Execute(ctx context.Context, cfg commands.Config, args []string) error {
+	if len(args) < 1 {
+		return commands.ErrArgs{Err: fmt.Errorf("error: no path to the output file was specified")}
+	}
+	if len(args) > 1 {
+		return commands.ErrArgs{Err: fmt.Errorf("error: too many parameters")}
+	}
+	outputPath := args[0]
+	log := logger.FromCtx(ctx)
+
+	bundle := &evidence.Bundle{
+		Generator: "afascli collect",
+		CreatedAt: time.Now(),
+	}
+
+	regions, err := cmd.Regions()
+	if err != nil {
+		return commands.ErrArgs{Err: err}
+	}
+	if !regions.IsEmpty() {
+		// TODO: store the coverage in the bundle to support partial dumps.
+		return commands.ErrArgs{Err: fmt.Errorf("evidence bundles do not support partially dumped images")}
+	}
+
+	if len(*cmd.image) > 0 {
+		bundle.FirmwareImage, err = os.ReadFile(*cmd.image)
+		if err != nil {
+			return fmt.Errorf("unable to read the firmware image '%s': %w", *cmd.image, err)
+		}
+	} else {
+		bundle.FirmwareImage, err = flashrom.Dump(ctx, cmd.FlashromOptions(cfg.Hardware)...)
+		if err != nil {
+			return fmt.Errorf("unable to dump a firmware image: %w", err)
+		}
+	}
+
+	if len(*cmd.registers) > 0 {
+		bundle.StatusRegisters, err = helpers.ParseRegisters(*cmd.registers)
+		if err != nil {
+			return fmt.Errorf("unable to parse registers from '%s': %w", *cmd.registers, err)
+		}
+	} else {
+		bundle.StatusRegisters, err = xregisters.LocalRegisters(cfg.Hardware)
+		if err != nil {
+			// some registers are just not available on some platforms
+			log.Warnf("unable to read some of the status registers: %v", err)
+		}
+	}
+
+	if len(*cmd.eventLog) > 0 {
+		eventLogPath := display_eventlog.EventLogPath(*cmd.eventLog, cfg.Hardware)
+		bundle.TPMEventLog, err = os.ReadFile(eventLogPath)
+		if err != nil {
+			log.Warnf("unable to read the TPM event log '%s': %v", eventLogPath, err)
+		}
+	}
+
+	tpmDevice, err := cfg.Hardware.TPMType()
+	if err != nil {
+		log.Warnf("unable to detect the TPM device: %v", err)
+	} else {
+		bundle.TPMDevice = &tpmDevice
+		bundle.PCRs = readPCRs(ctx, cfg.Hardware, tpmDevice)
+	}
+
+	bundle.SMBIOS, err = os.ReadFile(cfg.Hardware.Path(hwaccess.PathSMBIOS))
+	if err != nil {
+		log.Warnf("unable to read the SMBIOS table: %v", err)
+	}
+
+	bundle.HostInfo, err = firmwarewand.HostInfo(cfg.Hardware)
+	if err != nil {
+		log.Warnf("unable to get the host info: %v", err)
+	}
+
+	f, err := os.OpenFile(outputPath, os.O_WRONLY|os.O_CREATE|os.O_TRUNC, 0440)
+	if err != nil {
+		return fmt.Errorf("unable to create file '%s': %w", outputPath, err)
+	}
+	if err := bundle.Write(f, evidence.ArchiveFormatFromPath(outputPath)); err != nil {
+		_ = f.Close()
+		return fmt.Errorf("unable to write the evidence bundle: %w", err)
+	}
+	if err := f.Close(); err != nil {
+		return fmt.Errorf("unable to close file '%s': %w", outputPath, err)
+	}
+	return nil
+}
+
+func readPCRs(ctx context.Context, hw hwaccess.Interface, tpmDevice tpmdetection.Type) []evidence.PCR {
+	var banks []tpm2.Algorithm
+	switch tpmDevice {
+	case tpmdetection.TypeTPM12:
+		banks = []tpm2.Algorithm{tpm2.AlgSHA1}
+	case tpmdetection.TypeTPM20:
+		banks = []tpm2.Algorithm{tpm2.AlgSHA1, tpm2.AlgSHA256}
+	}
+
+	var result []evidence.PCR
+	for _, bank := range banks {
+		hash, err := bank.Hash()
+		if err != nil {
+			logger.FromCtx(ctx).Errorf("unsupported PCR bank %s: %v", bank, err)
+			continue
+		}
+		for pcrIndex := pcrtypes.ID(0); pcrIndex < amountOfCollectedPCRs; pcrIndex++ {
+			value, err := tpm.ReadPCRFromTPM(hw, pcrIndex, bank)
+			if err != nil {
+				logger.FromCtx(ctx).Warnf("unable to read PCR%d of bank %s: %v", pcrIndex, bank, err)
+				continue
+			}
+			if len(value) != hash.Size() {
+				logger.FromCtx(ctx).Warnf("invalid PCR%d length of bank %s: %d", pcrIndex, bank, len(value))
+				continue
+			}
+			result = append(result, evidence.PCR{
+				Index: uint32(pcrIndex),
+				Bank:  bank.String(),
+				Value: value,
+			})
+		}
+	}
+	return result
+}
@@ -20,6 +20,7 @@ import (
 
 	"github.com/immune-gmbh/AttestationFailureAnalysisService/cmd/afascli/commands/display_eventlog/format"
 	"github.com/immune-gmbh/AttestationFailureAnalysisService/pkg/commands"
+	"github.com/immune-gmbh/AttestationFailureAnalysisService/pkg/hwaccess"
 	"github.com/immune-gmbh/AttestationFailureAnalysisService/pkg/xtpmeventlog"
 
 	"github.com/9elements/converged-security-suite/v2/pkg/pcr"
@@ -27,7 +28,16 @@ import (
 	manifest "github.com/linuxboot/fiano/pkg/intel/metadata/cbnt"
 )
 
-const DefaultEventlogLocation = "/sys/kernel/security/tpm0/binary_bios_measurements"
+const DefaultEventlogLocation = hwaccess.PathTPMEventLog
+
+// EventLogPath returns `path`, unless it is DefaultEventlogLocation, in which
+// case it returns the location of the event log of hardware `hw`.
+func EventLogPath(path string, hw hwaccess.Interface) string {
+	if path == DefaultEventlogLocation {
+		return hw.Path(hwaccess.PathTPMEventLog)
+	}
+	return path
+}
 
 // Command is the implementation of `commands.Command`.
 type Command struct {
@@ -67,14 +77,15 @@ func (cmd Command) Execute(ctx context.Context, cfg commands.Config, args []stri
 		return commands.ErrArgs{Err: fmt.Errorf("error: too many parameters")}
 	}
 
-	eventLogFile, err := os.Open(*cmd.eventLog)
+	eventLogPath := EventLogPath(*cmd.eventLog, cfg.Hardware)
+	eventLogFile, err := os.Open(eventLogPath)
 	if err != nil {
-		return fmt.Errorf("unable to open EventLog '%s': %w", *cmd.eventLog, err)
+		return fmt.Errorf("unable to open EventLog '%s': %w", eventLogPath, err)
 	}
 
 	eventLog, err := tpmeventlog.Parse(eventLogFile)
 	if err != nil {
-		return fmt.Errorf("unable to parse EventLog '%s': %w", *cmd.eventLog, err)
+		return fmt.Errorf("unable to parse EventLog '%s': %w", eventLogPath, err)
 	}
 
 	if *cmd.calcPCR && (*cmd.pcrIndex == -1 || *cmd.hashAlgo == 0) {
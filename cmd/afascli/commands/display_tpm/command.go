@@ -17,7 +17,6 @@ import (
 	"flag"
 	"fmt"
 
-	"github.com/9elements/converged-security-suite/v2/pkg/tpmdetection"
 	"github.com/immune-gmbh/AttestationFailureAnalysisService/pkg/commands"
 )
 
@@ -45,7 +44,7 @@ func (cmd *Command) SetupFlagSet(flag *flag.FlagSet) {
 //
 // `args` are the arguments left unused by verb itself and options.
 func (cmd Command) Execute(ctx context.Context, cfg commands.Config, args []string) error {
-	localTPM, err := tpmdetection.Local()
+	localTPM, err := cfg.Hardware.TPMType()
 	if err != nil {
 		fmt.Printf("Failed to detect local TPM, err: %v", err)
 		return err
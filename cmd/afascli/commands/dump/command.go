@@ -17,12 +17,16 @@ import (
 	"flag"
 	"fmt"
 	"os"
+	"strconv"
 	"strings"
 
 	"github.com/immune-gmbh/AttestationFailureAnalysisService/pkg/commands"
 	"github.com/immune-gmbh/AttestationFailureAnalysisService/pkg/flashrom"
+	"github.com/immune-gmbh/AttestationFailureAnalysisService/pkg/hwaccess"
 
 	"github.com/facebookincubator/go-belt/tool/experimental/tracer"
+	"github.com/facebookincubator/go-belt/tool/logger"
+	pkgbytes "github.com/linuxboot/fiano/pkg/bytes"
 )
 
 // Command is the implementation of `commands.Command`.
@@ -30,6 +34,10 @@ type Command struct {
 	DumpMethod   *string
 	PathFlashrom *string
 	PathAfulnx64 *string
+	IFDRegions   *string
+	Ranges       *string
+	MappedRanges *string
+	DoubleRead   *bool
 }
 
 // Usage prints the syntax of arguments for this command
@@ -48,11 +56,74 @@ func (cmd *Command) SetupFlagSet(flag *flag.FlagSet) {
 	cmd.DumpMethod = flag.String("dump-method", "auto", "possible values: flashrom, afulnx64, devmem, mtd, auto")
 	cmd.PathFlashrom = flag.String("path-flashrom", "", "path to flashrom")
 	cmd.PathAfulnx64 = flag.String("path-afulnx64", "", "path to afulnx64")
+	cmd.IFDRegions = flag.String("ifd-regions", "", "dump only the listed IFD regions (comma-separated), for example: bios,me")
+	cmd.Ranges = flag.String("ranges", "", "dump only the listed ranges of the flash image (comma-separated 'offset:length'), for example: 0x0:0x1000")
+	cmd.DoubleRead = flag.Bool("double-read", false, "read the firmware twice and report the ranges which differ between the reads")
+	cmd.MappedRanges = flag.String("mapped-ranges", "", "dump only the listed ranges of the BIOS region mapped to the physical memory (comma-separated 'address:length'), for example: 0xffffffc0:0x40")
 }
 
-// FlashromOptions returns options to be used in package "flashrom".
-func (cmd Command) FlashromOptions() []flashrom.Option {
-	var result []flashrom.Option
+// Regions returns the regions to be dumped according to flags
+// '-ifd-regions', '-ranges' and '-mapped-ranges'.
+//
+// Empty result means to dump the whole image.
+func (cmd Command) Regions() (flashrom.Regions, error) {
+	var (
+		result flashrom.Regions
+		err    error
+	)
+	for _, name := range splitList(*cmd.IFDRegions) {
+		regionType, err := flashrom.ParseIFDRegionType(name)
+		if err != nil {
+			return flashrom.Regions{}, err
+		}
+		result.IFD = append(result.IFD, regionType)
+	}
+	result.Ranges, err = parseRanges(*cmd.Ranges)
+	if err != nil {
+		return flashrom.Regions{}, fmt.Errorf("unable to parse '-ranges': %w", err)
+	}
+	result.MappedRanges, err = parseRanges(*cmd.MappedRanges)
+	if err != nil {
+		return flashrom.Regions{}, fmt.Errorf("unable to parse '-mapped-ranges': %w", err)
+	}
+	return result, nil
+}
+
+func splitList(s string) []string {
+	var result []string
+	for _, item := range strings.Split(s, ",") {
+		item = strings.TrimSpace(item)
+		if item != "" {
+			result = append(result, item)
+		}
+	}
+	return result
+}
+
+func parseRanges(s string) (pkgbytes.Ranges, error) {
+	var result pkgbytes.Ranges
+	for _, item := range splitList(s) {
+		parts := strings.Split(item, ":")
+		if len(parts) != 2 {
+			return nil, fmt.Errorf("invalid range '%s', expected 'offset:length'", item)
+		}
+		offset, err := strconv.ParseUint(parts[0], 0, 64)
+		if err != nil {
+			return nil, fmt.Errorf("invalid offset in range '%s': %w", item, err)
+		}
+		length, err := strconv.ParseUint(parts[1], 0, 64)
+		if err != nil {
+			return nil, fmt.Errorf("invalid length in range '%s': %w", item, err)
+		}
+		result = append(result, pkgbytes.Range{Offset: offset, Length: length})
+	}
+	return result, nil
+}
+
+// FlashromOptions returns options to be used in package "flashrom" to dump
+// the image from hardware `hw`.
+func (cmd Command) FlashromOptions(hw hwaccess.Interface) []flashrom.Option {
+	result := []flashrom.Option{flashrom.OptionHardware{Hardware: hw}}
 
 	var dumpMethod flashrom.DumpMethod
 	switch strings.ToLower(*cmd.DumpMethod) {
@@ -75,6 +146,9 @@ func (cmd Command) FlashromOptions() []flashrom.Option {
 	if *cmd.PathAfulnx64 != "" {
 		result = append(result, flashrom.OptionAfulnx64Path(*cmd.PathAfulnx64))
 	}
+	if *cmd.DoubleRead {
+		result = append(result, flashrom.OptionDoubleRead(true))
+	}
 
 	return result
 }
@@ -92,10 +166,26 @@ func (cmd Command) Execute(ctx context.Context, cfg commands.Config, args []stri
 	}
 	outputPath := args[0]
 
-	imageBytes, err := flashrom.Dump(ctx, cmd.FlashromOptions()...)
+	regions, err := cmd.Regions()
+	if err != nil {
+		return commands.ErrArgs{Err: err}
+	}
+
+	image, err := flashrom.DumpRegions(ctx, regions, cmd.FlashromOptions(cfg.Hardware)...)
 	if err != nil {
 		return fmt.Errorf("unable to dump a firmware image: %w", err)
 	}
+	imageBytes := image.Image
+	if missing := image.MissingRanges(); len(missing) != 0 {
+		logger.FromCtx(ctx).Infof("dumped ranges: %s; missing ranges (filled with 0xFF): %s", image.Coverage, missing)
+	}
+	logger.FromCtx(ctx).Infof("dump method: %s; quality: %s", image.Info.Method, image.Info.Quality)
+	if image.Info.Fallback {
+		logger.FromCtx(ctx).Warnf("the image was dumped using a fallback method: %s", image.Info.FallbackError)
+	}
+	if len(image.Info.UnstableRanges) != 0 {
+		logger.FromCtx(ctx).Warnf("unstable ranges: %s", image.Info.UnstableRanges)
+	}
 
 	span, _ := tracer.StartChildSpanFromCtx(ctx, "writeFile")
 	defer span.Finish()
@@ -54,7 +54,7 @@ func (cmd Command) Execute(ctx context.Context, cfg commands.Config, args []stri
 		return commands.ErrArgs{Err: fmt.Errorf("error: too many parameters")}
 	}
 
-	allRegisters, err := xregisters.LocalRegisters()
+	allRegisters, err := xregisters.LocalRegisters(cfg.Hardware)
 	if allRegisters == nil && err != nil {
 		return fmt.Errorf("unable to fetch local registers: %w", err)
 	}
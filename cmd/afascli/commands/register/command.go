@@ -0,0 +1,161 @@
+// Copyright 2023 Meta Platforms, Inc. and affiliates.
+//
+// Redistribution and use in source and binary forms, with or without modification, are permitted provided that the following conditions are met:
+//
+// 1. Redistributions of source code must retain the above copyright notice, this list of conditions and the following disclaimer.
+//
+// 2. Redistributions in binary form must reproduce the above copyright notice, this list of conditions and the following disclaimer in the documentation and/or other materials provided with the distribution.
+//
+// 3. Neither the name of the copyright holder nor the names of its contributors may be used to endorse or promote products derived from this software without specific prior written permission.
+//
+// THIS SOFTWARE IS PROVIDED BY THE COPYRIGHT HOLDERS AND CONTRIBUTORS "AS IS" AND ANY EXPRESS OR IMPLIED WARRANTIES, INCLUDING, BUT NOT LIMITED TO, THE IMPLIED WARRANTIES OF MERCHANTABILITY AND FITNESS FOR A PARTICULAR PURPOSE ARE DISCLAIMED. IN NO EVENT SHALL THE COPYRIGHT HOLDER OR CONTRIBUTORS BE LIABLE FOR ANY DIRECT, INDIRECT, INCIDENTAL, SPECIAL, EXEMPLARY, OR CONSEQUENTIAL DAMAGES (INCLUDING, BUT NOT LIMITED TO, PROCUREMENT OF SUBSTITUTE GOODS OR SERVICES; LOSS OF USE, DATA, OR PROFITS; OR BUSINESS INTERRUPTION) HOWEVER CAUSED AND ON ANY THEORY OF LIABILITY, WHETHER IN CONTRACT, STRICT LIABILITY, OR TORT (INCLUDING NEGLIGENCE OR OTHERWISE) ARISING IN ANY WAY OUT OF THE USE OF THIS SOFTWARE, EVEN IF ADVISED OF THE POSSIBILITY OF SUCH DAMAGE.
+
+package register
+
+import (
+	"context"
+	"encoding/hex"
+	"flag"
+	"fmt"
+	"strconv"
+	"strings"
+
+	verbhelpers "github.com/immune-gmbh/AttestationFailureAnalysisService/cmd/afascli/helpers"
+	"github.com/immune-gmbh/AttestationFailureAnalysisService/if/generated/afas"
+	"github.com/immune-gmbh/AttestationFailureAnalysisService/pkg/commands"
+	"github.com/immune-gmbh/AttestationFailureAnalysisService/pkg/firmwarewand"
+)
+
+// Command is the implementation of `commands.Command`.
+type Command struct {
+	afasEndpoint *string
+	fwType       *string
+	version      *string
+	imageURL     *string
+	modelIDs     *string
+	hostnames    *string
+	measurements measurementsFlag
+}
+
+type measurementsFlag []*afas.OriginalFirmwareMeasurement
+
+// String implements flag.Value.
+func (f measurementsFlag) String() string {
+	var s []string
+	for _, m := range f {
+		s = append(s, fmt.Sprintf("%s:%X", m.Type, m.Value))
+	}
+	return strings.Join(s, ",")
+}
+
+// Set implements flag.Value.
+func (f *measurementsFlag) Set(in string) error {
+	parts := strings.SplitN(in, ":", 2)
+	if len(parts) != 2 {
+		return fmt.Errorf("expected format TYPE:HEX_VALUE, but received '%s'", in)
+	}
+	value, err := hex.DecodeString(strings.TrimPrefix(parts[1], "0x"))
+	if err != nil {
+		return fmt.Errorf("unable to parse value '%s' as hex: %w", parts[1], err)
+	}
+	*f = append(*f, &afas.OriginalFirmwareMeasurement{
+		Type:  parts[0],
+		Value: value,
+	})
+	return nil
+}
+
+// Usage prints the syntax of arguments for this command
+func (cmd Command) Usage() string {
+	return "[-type=BIOS] -version=version [-image-url=URL] <-model-ids=id1,id2|-hostnames=host1,host2> [-measurement=TYPE:HEX_VALUE ...]"
+}
+
+// Description explains what this verb commands to do
+func (cmd Command) Description() string {
+	return "register a released firmware version in the original firmware database (requires admin permissions)"
+}
+
+// SetupFlagSet is called to allow the command implementation
+// to setup which option flags it has.
+func (cmd *Command) SetupFlagSet(flag *flag.FlagSet) {
+	cmd.afasEndpoint = flag.String("afas-endpoint", "http://localhost:17545", "")
+	cmd.fwType = flag.String("type", afas.OriginalFirmwareType_BIOS.String(), "firmware type")
+	cmd.version = flag.String("version", "", "firmware version")
+	cmd.imageURL = flag.String("image-url", "", "URL to download the firmware image from")
+	cmd.modelIDs = flag.String("model-ids", "", "comma-separated list of model IDs the firmware is designated for")
+	cmd.hostnames = flag.String("hostnames", "", "comma-separated list of hostnames the firmware is designated for")
+	flag.Var(&cmd.measurements, "measurement", "expected measurement in format TYPE:HEX_VALUE (e.g. PCR0_SHA1:0123...); could be specified multiple times")
+}
+
+func (cmd Command) firmwarewandOptions() []firmwarewand.Option {
+	return verbhelpers.FirmwarewandOptions(*cmd.afasEndpoint)
+}
+
+func (cmd Command) flagTargets() ([]*afas.OriginalFirmwareTarget, error) {
+	var targets []*afas.OriginalFirmwareTarget
+	for _, modelIDString := range strings.Split(*cmd.modelIDs, ",") {
+		if modelIDString == "" {
+			continue
+		}
+		modelID, err := strconv.ParseInt(modelIDString, 10, 64)
+		if err != nil {
+			return nil, fmt.Errorf("unable to parse model ID '%s': %w", modelIDString, err)
+		}
+		targets = append(targets, &afas.OriginalFirmwareTarget{ModelID: &modelID})
+	}
+	for _, hostname := range strings.Split(*cmd.hostnames, ",") {
+		if hostname == "" {
+			continue
+		}
+		hostname := hostname
+		targets = append(targets, &afas.OriginalFirmwareTarget{Hostname: &hostname})
+	}
+	return targets, nil
+}
+
+// Execute is the main function here. It is responsible to
+// start the execution of the command.
+//
+// `args` are the arguments left unused by verb itself and options.
+func (cmd Command) Execute(ctx context.Context, cfg commands.Config, args []string) error {
+	if len(args) != 0 {
+		return commands.ErrArgs{Err: fmt.Errorf("error: too many arguments")}
+	}
+	if *cmd.version == "" {
+		return commands.ErrArgs{Err: fmt.Errorf("version is not specified")}
+	}
+
+	fwType, err := afas.OriginalFirmwareTypeFromString(*cmd.fwType)
+	if err != nil {
+		return commands.ErrArgs{Err: fmt.Errorf("invalid firmware type: %w", err)}
+	}
+
+	targets, err := cmd.flagTargets()
+	if err != nil {
+		return commands.ErrArgs{Err: err}
+	}
+	if len(targets) == 0 {
+		return commands.ErrArgs{Err: fmt.Errorf("no targets specified, use -model-ids or/and -hostnames")}
+	}
+
+	fwWand, err := firmwarewand.New(ctx, append(cfg.FirmwareWandOptions, cmd.firmwarewandOptions()...)...)
+	if err != nil {
+		return fmt.Errorf("unable to initialize a firmwarewand: %w", err)
+	}
+
+	id, err := fwWand.InsertOriginalFirmware(ctx, afas.OriginalFirmware{
+		Type:         fwType,
+		Version:      *cmd.version,
+		ImageURL:     *cmd.imageURL,
+		Targets:      targets,
+		Measurements: cmd.measurements,
+	})
+	if err != nil {
+		return fmt.Errorf("unable to register the firmware: %w", err)
+	}
+
+	if !cfg.IsQuiet {
+		fmt.Printf("registered with ID %d\n", id)
+	}
+	return nil
+}
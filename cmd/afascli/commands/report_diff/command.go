@@ -0,0 +1,159 @@
+// Copyright 2023 Meta Platforms, Inc. and affiliates.
+//
+// Redistribution and use in source and binary forms, with or without modification, are permitted provided that the following conditions are met:
+//
+// 1. Redistributions of source code must retain the above copyright notice, this list of conditions and the following disclaimer.
+//
+// 2. Redistributions in binary form must reproduce the above copyright notice, this list of conditions and the following disclaimer in the documentation and/or other materials provided with the distribution.
+//
+// 3. Neither the name of the copyright holder nor the names of its contributors may be used to endorse or promote products derived from this software without specific prior written permission.
+//
+// THIS SOFTWARE IS PROVIDED BY THE COPYRIGHT HOLDERS AND CONTRIBUTORS "AS IS" AND ANY EXPRESS OR IMPLIED WARRANTIES, INCLUDING, BUT NOT LIMITED TO, THE IMPLIED WARRANTIES OF MERCHANTABILITY AND FITNESS FOR A PARTICULAR PURPOSE ARE DISCLAIMED. IN NO EVENT SHALL THE COPYRIGHT HOLDER OR CONTRIBUTORS BE LIABLE FOR ANY DIRECT, INDIRECT, INCIDENTAL, SPECIAL, EXEMPLARY, OR CONSEQUENTIAL DAMAGES (INCLUDING, BUT NOT LIMITED TO, PROCUREMENT OF SUBSTITUTE GOODS OR SERVICES; LOSS OF USE, DATA, OR PROFITS; OR BUSINESS INTERRUPTION) HOWEVER CAUSED AND ON ANY THEORY OF LIABILITY, WHETHER IN CONTRACT, STRICT LIABILITY, OR TORT (INCLUDING NEGLIGENCE OR OTHERWISE) ARISING IN ANY WAY OUT OF THE USE OF THIS SOFTWARE, EVEN IF ADVISED OF THE POSSIBILITY OF SUCH DAMAGE.
+
+package report_diff
+
+import (
+	"context"
+	"encoding/json"
+	"flag"
+	"fmt"
+	"io"
+	"os"
+	"time"
+
+	"github.com/fatih/color"
+
+	verbhelpers "github.com/immune-gmbh/AttestationFailureAnalysisService/cmd/afascli/helpers"
+	"github.com/immune-gmbh/AttestationFailureAnalysisService/if/generated/afas"
+	"github.com/immune-gmbh/AttestationFailureAnalysisService/pkg/commands"
+	"github.com/immune-gmbh/AttestationFailureAnalysisService/pkg/firmwarewand"
+	"github.com/immune-gmbh/AttestationFailureAnalysisService/pkg/types"
+)
+
+// Command is the implementation of `commands.Command`.
+type Command struct {
+	afasEndpoint  *string
+	outputJSON    *bool
+	showUnchanged *bool
+}
+
+// Usage prints the syntax of arguments for this command
+func (cmd Command) Usage() string {
+	return "<jobID A> <jobID B>"
+}
+
+// Description explains what this verb commands to do
+func (cmd Command) Description() string {
+	return "show what changed between two analyze reports (usually of the same host)"
+}
+
+// SetupFlagSet is called to allow the command implementation
+// to setup which option flags it has.
+func (cmd *Command) SetupFlagSet(flag *flag.FlagSet) {
+	cmd.afasEndpoint = flag.String("afas-endpoint", "http://localhost:17545", "")
+	cmd.outputJSON = flag.Bool("json", false, "prints the result CompareReportsResult thrift structure in json format")
+	cmd.showUnchanged = flag.Bool("show-unchanged", false, "show also unchanged issues")
+}
+
+func (cmd Command) firmwarewandOptions() []firmwarewand.Option {
+	return verbhelpers.FirmwarewandOptions(*cmd.afasEndpoint)
+}
+
+// Execute is the main function here. It is responsible to
+// start the execution of the command.
+//
+// `args` are the arguments left unused by verb itself and options.
+func (cmd Command) Execute(ctx context.Context, cfg commands.Config, args []string) error {
+	if len(args) != 2 {
+		return commands.ErrArgs{Err: fmt.Errorf("expected exactly two arguments (job IDs), but received %d", len(args))}
+	}
+
+	var jobIDs [2]types.JobID
+	for idx, arg := range args {
+		var err error
+		jobIDs[idx], err = types.ParseJobID(arg)
+		if err != nil {
+			return commands.ErrArgs{Err: fmt.Errorf("unable to parse job ID '%s': %w", arg, err)}
+		}
+	}
+
+	fwWand, err := firmwarewand.New(ctx, append(cfg.FirmwareWandOptions, cmd.firmwarewandOptions()...)...)
+	if err != nil {
+		return fmt.Errorf("unable to initialize a firmwarewand: %w", err)
+	}
+
+	result, err := fwWand.CompareReports(ctx, jobIDs[0], jobIDs[1])
+	if err != nil {
+		return fmt.Errorf("unable to perform CompareReports request: %w", err)
+	}
+
+	if *cmd.outputJSON {
+		b, err := json.Marshal(result)
+		if err != nil {
+			return fmt.Errorf("failed to marshal result: %w", err)
+		}
+		fmt.Printf("%s\n", b)
+		return nil
+	}
+
+	humanReadable(os.Stdout, result, *cmd.showUnchanged)
+	return nil
+}
+
+func humanReadable(w io.Writer, result *afas.CompareReportsResult_, showUnchanged bool) {
+	printSummary(w, "A", result.GetReportA())
+	printSummary(w, "B", result.GetReportB())
+
+	for _, analyzer := range result.GetAnalyzers() {
+		fmt.Fprintf(w, "=== '%s' ===\n", analyzer.AnalyzerName)
+		switch {
+		case !analyzer.PresentInA:
+			color.New(color.FgYellow).Fprintf(w, "Executed only in B\n")
+		case !analyzer.PresentInB:
+			color.New(color.FgYellow).Fprintf(w, "Executed only in A\n")
+		}
+		if analyzer.GetErrorA() != analyzer.GetErrorB() {
+			fmt.Fprintf(w, "Error: '%s' -> '%s'\n", analyzer.GetErrorA(), analyzer.GetErrorB())
+		}
+		if analyzer.GetDiagnosisA() != analyzer.GetDiagnosisB() {
+			color.New(color.FgYellow).Fprintf(w, "Diagnosis: %s -> %s\n", analyzer.GetDiagnosisA(), analyzer.GetDiagnosisB())
+		}
+		for _, issue := range analyzer.GetIssues() {
+			switch issue.Change {
+			case afas.IssueChange_New:
+				color.New(color.FgRed).Fprintf(w, "+ [%s] %s\n", issue.Issue.Severity, issue.Issue.GetDescription())
+			case afas.IssueChange_Resolved:
+				color.New(color.FgGreen).Fprintf(w, "- [%s] %s\n", issue.Issue.Severity, issue.Issue.GetDescription())
+			default:
+				if showUnchanged {
+					fmt.Fprintf(w, "  [%s] %s\n", issue.Issue.Severity, issue.Issue.GetDescription())
+				}
+			}
+		}
+		if analyzer.CustomReportChanged {
+			fmt.Fprintf(w, "The analyzer-specific report changed\n")
+		}
+		for _, input := range analyzer.GetChangedInputs() {
+			fmt.Fprintf(w, "Changed input %s:\n\tA: %s\n\tB: %s\n", input.Type, valueOrAbsent(input.ValueA), valueOrAbsent(input.ValueB))
+		}
+	}
+}
+
+func printSummary(w io.Writer, name string, report *afas.AnalyzeReportSummary) {
+	jobID, _ := types.NewJobIDFromBytes(report.GetJobID())
+	fmt.Fprintf(w, "Report %s: jobID: %s, time: %s", name, jobID, time.Unix(0, report.GetTimestamp()).Format(time.RFC3339))
+	if report.AssetID != nil {
+		fmt.Fprintf(w, ", assetID: %d", *report.AssetID)
+	}
+	if verdict := report.GetVerdict(); verdict != nil {
+		fmt.Fprintf(w, ", verdict: %s", verdict.Verdict)
+	}
+	fmt.Fprintln(w)
+}
+
+func valueOrAbsent(value *string) string {
+	if value == nil {
+		return "<absent>"
+	}
+	return *value
+}
@@ -0,0 +1,115 @@
+// Copyright 2023 Meta Platforms, Inc. and affiliates.
+//
+// Redistribution and use in source and binary forms, with or without modification, are permitted provided that the following conditions are met:
+//
+// 1. Redistributions of source code must retain the above copyright notice, this list of conditions and the following disclaimer.
+//
+// 2. Redistributions in binary form must reproduce the above copyright notice, this list of conditions and the following disclaimer in the documentation and/or other materials provided with the distribution.
+//
+// 3. Neither the name of the copyright holder nor the names of its contributors may be used to endorse or promote products derived from this software without specific prior written permission.
+//
+// THIS SOFTWARE IS PROVIDED BY THE COPYRIGHT HOLDERS AND CONTRIBUTORS "AS IS" AND ANY EXPRESS OR IMPLIED WARRANTIES, INCLUDING, BUT NOT LIMITED TO, THE IMPLIED WARRANTIES OF MERCHANTABILITY AND FITNESS FOR A PARTICULAR PURPOSE ARE DISCLAIMED. IN NO EVENT SHALL THE COPYRIGHT HOLDER OR CONTRIBUTORS BE LIABLE FOR ANY DIRECT, INDIRECT, INCIDENTAL, SPECIAL, EXEMPLARY, OR CONSEQUENTIAL DAMAGES (INCLUDING, BUT NOT LIMITED TO, PROCUREMENT OF SUBSTITUTE GOODS OR SERVICES; LOSS OF USE, DATA, OR PROFITS; OR BUSINESS INTERRUPTION) HOWEVER CAUSED AND ON ANY THEORY OF LIABILITY, WHETHER IN CONTRACT, STRICT LIABILITY, OR TORT (INCLUDING NEGLIGENCE OR OTHERWISE) ARISING IN ANY WAY OUT OF THE USE OF THIS SOFTWARE, EVEN IF ADVISED OF THE POSSIBILITY OF SUCH DAMAGE.
+
+package search_pcr
+
+import (
+	"context"
+	"encoding/hex"
+	"encoding/json"
+	"flag"
+	"fmt"
+	"strings"
+
+	verbhelpers "github.com/immune-gmbh/AttestationFailureAnalysisService/cmd/afascli/helpers"
+	"github.com/immune-gmbh/AttestationFailureAnalysisService/if/generated/tpm"
+	"github.com/immune-gmbh/AttestationFailureAnalysisService/pkg/commands"
+	"github.com/immune-gmbh/AttestationFailureAnalysisService/pkg/firmwarewand"
+)
+
+// Command is the implementation of `commands.Command`.
+type Command struct {
+	afasEndpoint *string
+	pcrIndex     *uint
+	bank         *string
+	modelID      *int64
+}
+
+// Usage prints the syntax of arguments for this command
+func (cmd Command) Usage() string {
+	return "<PCR value in hex>"
+}
+
+// Description explains what this verb commands to do
+func (cmd Command) Description() string {
+	return "search for firmware versions and configurations, which yield the given PCR value"
+}
+
+// SetupFlagSet is called to allow the command implementation
+// to setup which option flags it has.
+func (cmd *Command) SetupFlagSet(flag *flag.FlagSet) {
+	cmd.afasEndpoint = flag.String("afas-endpoint", "http://localhost:17545", "")
+	cmd.pcrIndex = flag.Uint("pcr-index", 0, "PCR index")
+	cmd.bank = flag.String("bank", "", "PCR bank: SHA1 or SHA256; if empty then it is detected by the length of the value")
+	cmd.modelID = flag.Int64("model-id", 0, "[optional] limit the results to original firmwares designated for this model ID")
+}
+
+func (cmd Command) firmwarewandOptions() []firmwarewand.Option {
+	return verbhelpers.FirmwarewandOptions(*cmd.afasEndpoint)
+}
+
+func (cmd Command) flagBank(digest []byte) (tpm.Algo, error) {
+	if *cmd.bank != "" {
+		return tpm.AlgoFromString(strings.ToUpper(*cmd.bank))
+	}
+
+	switch len(digest) {
+	case 20:
+		return tpm.Algo_SHA1, nil
+	case 32:
+		return tpm.Algo_SHA256, nil
+	}
+	return tpm.Algo_Error, fmt.Errorf("unable to detect the bank by value length %d, please specify -bank", len(digest))
+}
+
+// Execute is the main function here. It is responsible to
+// start the execution of the command.
+//
+// `args` are the arguments left unused by verb itself and options.
+func (cmd Command) Execute(ctx context.Context, cfg commands.Config, args []string) error {
+	if len(args) != 1 {
+		return commands.ErrArgs{Err: fmt.Errorf("expected exactly one argument (the PCR value), but received %d", len(args))}
+	}
+
+	digest, err := hex.DecodeString(strings.TrimPrefix(args[0], "0x"))
+	if err != nil {
+		return commands.ErrArgs{Err: fmt.Errorf("unable to parse PCR value '%s' as hex: %w", args[0], err)}
+	}
+
+	bank, err := cmd.flagBank(digest)
+	if err != nil {
+		return commands.ErrArgs{Err: fmt.Errorf("invalid bank: %w", err)}
+	}
+
+	var modelID *int64
+	if *cmd.modelID != 0 {
+		modelID = cmd.modelID
+	}
+
+	fwWand, err := firmwarewand.New(ctx, append(cfg.FirmwareWandOptions, cmd.firmwarewandOptions()...)...)
+	if err != nil {
+		return fmt.Errorf("unable to initialize a firmwarewand: %w", err)
+	}
+
+	matches, err := fwWand.SearchByPCR(ctx, int32(*cmd.pcrIndex), bank, digest, modelID)
+	if err != nil {
+		return fmt.Errorf("unable to perform a search: %w", err)
+	}
+
+	b, err := json.Marshal(matches)
+	if err != nil {
+		return fmt.Errorf("unable to serialize the matches: %w", err)
+	}
+	fmt.Printf("%s\n", b)
+
+	return nil
+}
@@ -0,0 +1,204 @@
+// Copyright 2023 Meta Platforms, Inc. and affiliates.
+//
+// Redistribution and use in source and binary forms, with or without modification, are permitted provided that the following conditions are met:
+//
+// 1. Redistributions of source code must retain the above copyright notice, this list of conditions and the following disclaimer.
+//
+// 2. Redistributions in binary form must reproduce the above copyright notice, this list of conditions and the following disclaimer in the documentation and/or other materials provided with the distribution.
+//
+// 3. Neither the name of the copyright holder nor the names of its contributors may be used to endorse or promote products derived from this software without specific prior written permission.
+//
+// THIS SOFTWARE IS PROVIDED BY THE COPYRIGHT HOLDERS AND CONTRIBUTORS "AS IS" AND ANY EXPRESS OR IMPLIED WARRANTIES, INCLUDING, BUT NOT LIMITED TO, THE IMPLIED WARRANTIES OF MERCHANTABILITY AND FITNESS FOR A PARTICULAR PURPOSE ARE DISCLAIMED. IN NO EVENT SHALL THE COPYRIGHT HOLDER OR CONTRIBUTORS BE LIABLE FOR ANY DIRECT, INDIRECT, INCIDENTAL, SPECIAL, EXEMPLARY, OR CONSEQUENTIAL DAMAGES (INCLUDING, BUT NOT LIMITED TO, PROCUREMENT OF SUBSTITUTE GOODS OR SERVICES; LOSS OF USE, DATA, OR PROFITS; OR BUSINESS INTERRUPTION) HOWEVER CAUSED AND ON ANY THEORY OF LIABILITY, WHETHER IN CONTRACT, STRICT LIABILITY, OR TORT (INCLUDING NEGLIGENCE OR OTHERWISE) ARISING IN ANY WAY OUT OF THE USE OF THIS SOFTWARE, EVEN IF ADVISED OF THE POSSIBILITY OF SUCH DAMAGE.
+package stats
+
+import (
+	"context"
+	"encoding/json"
+	"flag"
+	"fmt"
+	"io"
+	"os"
+	"strconv"
+	"strings"
+	"text/tabwriter"
+	"time"
+
+	verbhelpers "github.com/immune-gmbh/AttestationFailureAnalysisService/cmd/afascli/helpers"
+	"github.com/immune-gmbh/AttestationFailureAnalysisService/if/generated/afas"
+	"github.com/immune-gmbh/AttestationFailureAnalysisService/pkg/commands"
+	"github.com/immune-gmbh/AttestationFailureAnalysisService/pkg/firmwarewand"
+)
+
+var dimensions = []afas.AggregateDimension{
+	afas.AggregateDimension_Analyzer,
+	afas.AggregateDimension_Diagnosis,
+	afas.AggregateDimension_IssueSeverity,
+	afas.AggregateDimension_IssueDescription,
+	afas.AggregateDimension_ActualFirmwareVersion,
+	afas.AggregateDimension_OriginalFirmwareVersion,
+	afas.AggregateDimension_ModelID,
+}
+
+// Command is the implementation of `commands.Command`.
+type Command struct {
+	afasEndpoint *string
+	groupBy      *string
+	since        *time.Duration
+	bucket       *time.Duration
+	analyzers    *string
+	diagnoses    *string
+	modelIDs     *string
+	outputJSON   *bool
+}
+
+// Usage prints the syntax of arguments for this command
+func (cmd Command) Usage() string {
+	return ""
+}
+
+// Description explains what this verb commands to do
+func (cmd Command) Description() string {
+	return "show statistics of analyze reports"
+}
+
+// SetupFlagSet is called to allow the command implementation
+// to setup which option flags it has.
+func (cmd *Command) SetupFlagSet(flag *flag.FlagSet) {
+	var dimensionNames []string
+	for _, dim := range dimensions {
+		dimensionNames = append(dimensionNames, dim.String())
+	}
+
+	cmd.afasEndpoint = flag.String("afas-endpoint", "http://localhost:17545", "")
+	cmd.groupBy = flag.String("group-by", "Analyzer,Diagnosis", "comma-separated list of dimensions to group by, possible values: "+strings.Join(dimensionNames, ", "))
+	cmd.since = flag.Duration("since", 7*24*time.Hour, "consider only reports not older than the given duration; 0 means no limit")
+	cmd.bucket = flag.Duration("bucket", 0, "additionally group reports by time intervals of the given duration (a multiple of a second)")
+	cmd.analyzers = flag.String("analyzer", "", "comma-separated list of analyzers to consider; empty means all")
+	cmd.diagnoses = flag.String("diagnosis", "", "comma-separated list of diagnoses to consider; empty means all")
+	cmd.modelIDs = flag.String("model-id", "", "comma-separated list of host model IDs to consider; empty means all")
+	cmd.outputJSON = flag.Bool("json", false, "prints the result AggregateReportsResult thrift structure in json format")
+}
+
+func (cmd Command) firmwarewandOptions() []firmwarewand.Option {
+	return verbhelpers.FirmwarewandOptions(*cmd.afasEndpoint)
+}
+
+// Execute is the main function here. It is responsible to
+// start the execution of the command.
+//
+// `args` are the arguments left unused by verb itself and options.
+func (cmd Command) Execute(ctx context.Context, cfg commands.Config, args []string) error {
+	if len(args) != 0 {
+		return commands.ErrArgs{Err: fmt.Errorf("expected no arguments, but received %d", len(args))}
+	}
+
+	request, err := cmd.request()
+	if err != nil {
+		return commands.ErrArgs{Err: err}
+	}
+
+	fwWand, err := firmwarewand.New(ctx, append(cfg.FirmwareWandOptions, cmd.firmwarewandOptions()...)...)
+	if err != nil {
+		return fmt.Errorf("unable to initialize a firmwarewand: %w", err)
+	}
+
+	result, err := fwWand.AggregateReports(ctx, request)
+	if err != nil {
+		return fmt.Errorf("unable to perform AggregateReports request: %w", err)
+	}
+
+	if *cmd.outputJSON {
+		b, err := json.Marshal(result)
+		if err != nil {
+			return fmt.Errorf("failed to marshal result: %w", err)
+		}
+		fmt.Printf("%s\n", b)
+		return nil
+	}
+
+	return renderTable(os.Stdout, request, result)
+}
+
+func (cmd Command) request() (*afas.AggregateReportsRequest, error) {
+	request := &afas.AggregateReportsRequest{
+		AnalyzerNames: splitList(*cmd.analyzers),
+		Diagnoses:     splitList(*cmd.diagnoses),
+	}
+	for _, dimName := range splitList(*cmd.groupBy) {
+		dim, err := parseDimension(dimName)
+		if err != nil {
+			return nil, err
+		}
+		request.GroupBy = append(request.GroupBy, dim)
+	}
+	for _, modelIDString := range splitList(*cmd.modelIDs) {
+		modelID, err := strconv.ParseInt(modelIDString, 10, 64)
+		if err != nil {
+			return nil, fmt.Errorf("unable to parse model ID '%s': %w", modelIDString, err)
+		}
+		request.ModelIDs = append(request.ModelIDs, modelID)
+	}
+	if *cmd.since != 0 {
+		timeFrom := time.Now().Add(-*cmd.since).Unix()
+		request.TimeFrom = &timeFrom
+	}
+	if *cmd.bucket != 0 {
+		if *cmd.bucket < time.Second || *cmd.bucket%time.Second != 0 {
+			return nil, fmt.Errorf("bucket duration should be a positive multiple of a second, but is %v", *cmd.bucket)
+		}
+		bucketSeconds := int64(*cmd.bucket / time.Second)
+		request.BucketSeconds = &bucketSeconds
+	}
+	return request, nil
+}
+
+func parseDimension(s string) (afas.AggregateDimension, error) {
+	for _, dim := range dimensions {
+		if strings.EqualFold(dim.String(), s) {
+			return dim, nil
+		}
+	}
+	return 0, fmt.Errorf("unknown dimension '%s'", s)
+}
+
+func splitList(s string) []string {
+	var result []string
+	for _, item := range strings.Split(s, ",") {
+		item = strings.TrimSpace(item)
+		if item == "" {
+			continue
+		}
+		result = append(result, item)
+	}
+	return result
+}
+
+func renderTable(w io.Writer, request *afas.AggregateReportsRequest, result *afas.AggregateReportsResult_) error {
+	tw := tabwriter.NewWriter(w, 0, 0, 2, ' ', 0)
+
+	var header []string
+	if request.IsSetBucketSeconds() {
+		header = append(header, "BucketStart")
+	}
+	for _, dim := range request.GetGroupBy() {
+		header = append(header, dim.String())
+	}
+	header = append(header, "Reports", "Hosts")
+	fmt.Fprintln(tw, strings.Join(header, "\t"))
+
+	for _, group := range result.GetGroups() {
+		var row []string
+		if request.IsSetBucketSeconds() {
+			row = append(row, time.Unix(group.GetBucketStart(), 0).Format(time.RFC3339))
+		}
+		for _, value := range group.GetValues() {
+			if value == "" {
+				value = "-"
+			}
+			row = append(row, value)
+		}
+		row = append(row, strconv.FormatInt(group.GetReports(), 10), strconv.FormatInt(group.GetHosts(), 10))
+		fmt.Fprintln(tw, strings.Join(row, "\t"))
+	}
+	return tw.Flush()
+}
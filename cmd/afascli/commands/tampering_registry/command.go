@@ -0,0 +1,178 @@
+// Copyright 2023 Meta Platforms, Inc. and affiliates.
+//
+// Redistribution and use in source and binary forms, with or without modification, are permitted provided that the following conditions are met:
+//
+// 1. Redistributions of source code must retain the above copyright notice, this list of conditions and the following disclaimer.
+//
+// 2. Redistributions in binary form must reproduce the above copyright notice, this list of conditions and the following disclaimer in the documentation and/or other materials provided with the distribution.
+//
+// 3. Neither the name of the copyright holder nor the names of its contributors may be used to endorse or promote products derived from this software without specific prior written permission.
+//
+// THIS SOFTWARE IS PROVIDED BY THE COPYRIGHT HOLDERS AND CONTRIBUTORS "AS IS" AND ANY EXPRESS OR IMPLIED WARRANTIES, INCLUDING, BUT NOT LIMITED TO, THE IMPLIED WARRANTIES OF MERCHANTABILITY AND FITNESS FOR A PARTICULAR PURPOSE ARE DISCLAIMED. IN NO EVENT SHALL THE COPYRIGHT HOLDER OR CONTRIBUTORS BE LIABLE FOR ANY DIRECT, INDIRECT, INCIDENTAL, SPECIAL, EXEMPLARY, OR CONSEQUENTIAL DAMAGES (INCLUDING, BUT NOT LIMITED TO, PROCUREMENT OF SUBSTITUTE GOODS OR SERVICES; LOSS OF USE, DATA, OR PROFITS; OR BUSINESS INTERRUPTION) HOWEVER CAUSED AND ON ANY THEORY OF LIABILITY, WHETHER IN CONTRACT, STRICT LIABILITY, OR TORT (INCLUDING NEGLIGENCE OR OTHERWISE) ARISING IN ANY WAY OUT OF THE USE OF THIS SOFTWARE, EVEN IF ADVISED OF THE POSSIBILITY OF SUCH DAMAGE.
+package tampering_registry
+
+import (
+	"context"
+	"flag"
+	"fmt"
+	"os"
+	"strconv"
+	"text/tabwriter"
+	"time"
+
+	verbhelpers "github.com/immune-gmbh/AttestationFailureAnalysisService/cmd/afascli/helpers"
+	"github.com/immune-gmbh/AttestationFailureAnalysisService/if/generated/afas"
+	"github.com/immune-gmbh/AttestationFailureAnalysisService/pkg/commands"
+	"github.com/immune-gmbh/AttestationFailureAnalysisService/pkg/firmwarewand"
+)
+
+// Command is the implementation of `commands.Command`.
+type Command struct {
+	afasEndpoint   *string
+	kind           *string
+	value          *string
+	reason         *string
+	expiresIn      *time.Duration
+	includeExpired *bool
+}
+
+// Usage prints the syntax of arguments for this command
+func (cmd Command) Usage() string {
+	return "<list | insert -kind=kind -value=value -reason=reason | update <id> -kind=kind -value=value -reason=reason | delete <id>>"
+}
+
+// Description explains what this verb commands to do
+func (cmd Command) Description() string {
+	return "manage the registry of known tampered hosts and known malicious firmware images (modifications require admin permissions)"
+}
+
+// SetupFlagSet is called to allow the command implementation
+// to setup which option flags it has.
+func (cmd *Command) SetupFlagSet(flag *flag.FlagSet) {
+	cmd.afasEndpoint = flag.String("afas-endpoint", "http://localhost:17545", "")
+	cmd.kind = flag.String("kind", "", "kind of the entry: AssetID, Hostname, ImageHash or ImageStableHash (for 'list' it filters entries)")
+	cmd.value = flag.String("value", "", "asset ID, hostname or hex-encoded hash of the image")
+	cmd.reason = flag.String("reason", "", "why the host or the image is considered tampered")
+	cmd.expiresIn = flag.Duration("expires-in", 0, "how long the entry should be active; zero means forever")
+	cmd.includeExpired = flag.Bool("include-expired", false, "list also expired entries")
+}
+
+func (cmd Command) firmwarewandOptions() []firmwarewand.Option {
+	return verbhelpers.FirmwarewandOptions(*cmd.afasEndpoint)
+}
+
+func (cmd Command) flagEntry() (afas.TamperingRegistryEntry, error) {
+	kind, err := afas.TamperingRegistryKindFromString(*cmd.kind)
+	if err != nil {
+		return afas.TamperingRegistryEntry{}, fmt.Errorf("invalid kind '%s': %w", *cmd.kind, err)
+	}
+	if *cmd.value == "" {
+		return afas.TamperingRegistryEntry{}, fmt.Errorf("value is not specified")
+	}
+	if *cmd.reason == "" {
+		return afas.TamperingRegistryEntry{}, fmt.Errorf("reason is not specified")
+	}
+	entry := afas.TamperingRegistryEntry{
+		Kind:   kind,
+		Value:  *cmd.value,
+		Reason: *cmd.reason,
+	}
+	if *cmd.expiresIn != 0 {
+		expiresAt := time.Now().Add(*cmd.expiresIn).Unix()
+		entry.ExpiresAt = &expiresAt
+	}
+	return entry, nil
+}
+
+// Execute is the main function here. It is responsible to
+// start the execution of the command.
+//
+// `args` are the arguments left unused by verb itself and options.
+func (cmd Command) Execute(ctx context.Context, cfg commands.Config, args []string) error {
+	if len(args) < 1 {
+		return commands.ErrArgs{Err: fmt.Errorf("error: no action specified")}
+	}
+	action, args := args[0], args[1:]
+
+	var id int64
+	switch action {
+	case "list", "insert":
+		if len(args) != 0 {
+			return commands.ErrArgs{Err: fmt.Errorf("error: too many arguments")}
+		}
+	case "update", "delete":
+		if len(args) != 1 {
+			return commands.ErrArgs{Err: fmt.Errorf("error: expected exactly one argument (entry ID), but received %d", len(args))}
+		}
+		var err error
+		id, err = strconv.ParseInt(args[0], 10, 64)
+		if err != nil {
+			return commands.ErrArgs{Err: fmt.Errorf("unable to parse entry ID '%s': %w", args[0], err)}
+		}
+	default:
+		return commands.ErrArgs{Err: fmt.Errorf("unknown action '%s'", action)}
+	}
+
+	var entry afas.TamperingRegistryEntry
+	if action == "insert" || action == "update" {
+		var err error
+		entry, err = cmd.flagEntry()
+		if err != nil {
+			return commands.ErrArgs{Err: err}
+		}
+		entry.ID = id
+	}
+
+	var kindFilter *afas.TamperingRegistryKind
+	if action == "list" && *cmd.kind != "" {
+		kind, err := afas.TamperingRegistryKindFromString(*cmd.kind)
+		if err != nil {
+			return commands.ErrArgs{Err: fmt.Errorf("invalid kind '%s': %w", *cmd.kind, err)}
+		}
+		kindFilter = &kind
+	}
+
+	fwWand, err := firmwarewand.New(ctx, append(cfg.FirmwareWandOptions, cmd.firmwarewandOptions()...)...)
+	if err != nil {
+		return fmt.Errorf("unable to initialize a firmwarewand: %w", err)
+	}
+
+	switch action {
+	case "list":
+		entries, err := fwWand.ListTamperingRegistryEntries(ctx, kindFilter, *cmd.includeExpired)
+		if err != nil {
+			return fmt.Errorf("unable to list the tampering registry: %w", err)
+		}
+		printEntries(entries)
+	case "insert":
+		id, err := fwWand.InsertTamperingRegistryEntry(ctx, entry)
+		if err != nil {
+			return fmt.Errorf("unable to insert the entry: %w", err)
+		}
+		if !cfg.IsQuiet {
+			fmt.Printf("inserted with ID %d\n", id)
+		}
+	case "update":
+		if err := fwWand.UpdateTamperingRegistryEntry(ctx, entry); err != nil {
+			return fmt.Errorf("unable to update the entry %d: %w", id, err)
+		}
+	case "delete":
+		if err := fwWand.DeleteTamperingRegistryEntry(ctx, id); err != nil {
+			return fmt.Errorf("unable to delete the entry %d: %w", id, err)
+		}
+	}
+	return nil
+}
+
+func printEntries(entries []*afas.TamperingRegistryEntry) {
+	w := tabwriter.NewWriter(os.Stdout, 0, 0, 2, ' ', 0)
+	fmt.Fprintln(w, "ID\tKIND\tVALUE\tEXPIRES\tREASON")
+	for _, entry := range entries {
+		expires := "never"
+		if entry.IsSetExpiresAt() {
+			expires = time.Unix(entry.GetExpiresAt(), 0).UTC().Format(time.RFC3339)
+		}
+		fmt.Fprintf(w, "%d\t%s\t%s\t%s\t%s\n", entry.GetID(), entry.GetKind(), entry.GetValue(), expires, entry.GetReason())
+	}
+	w.Flush()
+}
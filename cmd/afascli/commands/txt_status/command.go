@@ -18,7 +18,6 @@ import (
 	"fmt"
 	"reflect"
 
-	"github.com/9elements/go-linux-lowlevel-hw/pkg/hwapi"
 	"github.com/immune-gmbh/AttestationFailureAnalysisService/if/generated/txt_errors"
 	"github.com/immune-gmbh/AttestationFailureAnalysisService/pkg/commands"
 
@@ -52,9 +51,7 @@ func (cmd Command) Execute(ctx context.Context, cfg commands.Config, args []stri
 		return commands.ErrArgs{Err: fmt.Errorf("error: too many parameters")}
 	}
 
-	txtAPI := hwapi.GetAPI()
-
-	txtConfig, err := registers.FetchTXTConfigSpaceSafe(txtAPI)
+	txtConfig, err := registers.FetchTXTConfigSpaceSafe(cfg.Hardware)
 	if err != nil {
 		return fmt.Errorf("unable to fetch TXT config space: %w", err)
 	}
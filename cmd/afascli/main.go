@@ -23,6 +23,7 @@ import (
 	"sort"
 
 	"github.com/immune-gmbh/AttestationFailureAnalysisService/cmd/afascli/commands/analyze"
+	"github.com/immune-gmbh/AttestationFailureAnalysisService/cmd/afascli/commands/collect"
 	"github.com/immune-gmbh/AttestationFailureAnalysisService/cmd/afascli/commands/display_eventlog"
 	"github.com/immune-gmbh/AttestationFailureAnalysisService/cmd/afascli/commands/display_info"
 	"github.com/immune-gmbh/AttestationFailureAnalysisService/cmd/afascli/commands/display_tpm"
@@ -30,11 +31,18 @@ import (
 	"github.com/immune-gmbh/AttestationFailureAnalysisService/cmd/afascli/commands/dump_registers"
 	"github.com/immune-gmbh/AttestationFailureAnalysisService/cmd/afascli/commands/fetch"
 	pcr0sum "github.com/immune-gmbh/AttestationFailureAnalysisService/cmd/afascli/commands/pcr0_sum"
+	"github.com/immune-gmbh/AttestationFailureAnalysisService/cmd/afascli/commands/register"
+	"github.com/immune-gmbh/AttestationFailureAnalysisService/cmd/afascli/commands/report_diff"
 	"github.com/immune-gmbh/AttestationFailureAnalysisService/cmd/afascli/commands/search"
+	"github.com/immune-gmbh/AttestationFailureAnalysisService/cmd/afascli/commands/search_pcr"
 	"github.com/immune-gmbh/AttestationFailureAnalysisService/cmd/afascli/commands/search_report"
+	"github.com/immune-gmbh/AttestationFailureAnalysisService/cmd/afascli/commands/server_info"
+	"github.com/immune-gmbh/AttestationFailureAnalysisService/cmd/afascli/commands/stats"
+	"github.com/immune-gmbh/AttestationFailureAnalysisService/cmd/afascli/commands/tampering_registry"
 	"github.com/immune-gmbh/AttestationFailureAnalysisService/cmd/afascli/commands/txt_status"
 	"github.com/immune-gmbh/AttestationFailureAnalysisService/pkg/commands"
 	"github.com/immune-gmbh/AttestationFailureAnalysisService/pkg/firmwarewand"
+	"github.com/immune-gmbh/AttestationFailureAnalysisService/pkg/hwaccess"
 	"github.com/immune-gmbh/AttestationFailureAnalysisService/pkg/observability"
 
 	"github.com/facebookincubator/go-belt/beltctx"
@@ -46,17 +54,24 @@ import (
 
 var (
 	knownCommands = map[string]commands.Command{
-		"analyze":          &analyze.Command{},
-		"display_eventlog": &display_eventlog.Command{},
-		"display_info":     &display_info.Command{},
-		"display_tpm":      &display_tpm.Command{},
-		"dump":             &dump.Command{},
-		"dump_registers":   &dump_registers.Command{},
-		"fetch":            &fetch.Command{},
-		"pcr0_sum":         &pcr0sum.Command{},
-		"search":           &search.Command{},
-		"search_report":    &search_report.Command{},
-		"txt_status":       &txt_status.Command{},
+		"analyze":            &analyze.Command{},
+		"collect":            &collect.Command{},
+		"display_eventlog":   &display_eventlog.Command{},
+		"display_info":       &display_info.Command{},
+		"display_tpm":        &display_tpm.Command{},
+		"dump":               &dump.Command{},
+		"dump_registers":     &dump_registers.Command{},
+		"fetch":              &fetch.Command{},
+		"pcr0_sum":           &pcr0sum.Command{},
+		"register":           &register.Command{},
+		"report_diff":        &report_diff.Command{},
+		"search":             &search.Command{},
+		"search_pcr":         &search_pcr.Command{},
+		"search_report":      &search_report.Command{},
+		"server_info":        &server_info.Command{},
+		"stats":              &stats.Command{},
+		"tampering_registry": &tampering_registry.Command{},
+		"txt_status":         &txt_status.Command{},
 	}
 	exitCode = 0
 )
@@ -72,6 +87,7 @@ type flags struct {
 	remoteLoggingLevel logger.Level
 	tracePrefix        *string
 	netPprofAddr       *string
+	hwFixture          *string
 }
 
 // fiano will log warning output when trying to decompress non-compressed data,
@@ -132,6 +148,7 @@ func setupFlag() (*flag.FlagSet, *flags) {
 	f.isQuiet = flagSet.Bool("quiet", false, "suppress stdout")
 	f.tracePrefix = flagSet.String("trace-prefix", "", "prepend traceID with this value; it is useful to understand which automation was responsible for this run")
 	f.netPprofAddr = flagSet.String("net-pprof-addr", "", "if non-empty then listens with net/http/pprof")
+	f.hwFixture = flagSet.String("hw-fixture", "", "if non-empty then the hardware data is collected from the fixture directory instead of the local host (see package hwaccess)")
 	return flagSet, &f
 }
 
@@ -187,7 +204,17 @@ func main() {
 	defer span.Finish()
 
 	cfg := commands.Config{
-		IsQuiet: *flags.isQuiet,
+		IsQuiet:  *flags.isQuiet,
+		Hardware: hwaccess.Local(),
+	}
+	if *flags.hwFixture != "" {
+		fixture, err := hwaccess.NewFixture(*flags.hwFixture)
+		if err != nil {
+			_, _ = fmt.Fprintf(flag.CommandLine.Output(), "error: %v\n\n", err)
+			usage(flagSet)
+			return
+		}
+		cfg.Hardware = fixture
 	}
 
 	cfg.FirmwareWandOptions = append(cfg.FirmwareWandOptions, firmwarewand.OptionRemoteLogLevel(flags.remoteLoggingLevel))
@@ -14,10 +14,14 @@ package main
 
 import (
 	"context"
+	"fmt"
 	"net/http"
 	_ "net/http/pprof"
 	"os"
+	"os/signal"
 	"runtime"
+	"strings"
+	"syscall"
 	"time"
 
 	"github.com/go-sql-driver/mysql"
@@ -26,8 +30,13 @@ import (
 	"github.com/immune-gmbh/AttestationFailureAnalysisService/pkg/devicegetter"
 	"github.com/immune-gmbh/AttestationFailureAnalysisService/pkg/firmwaredb/firmwaredbsql"
 	"github.com/immune-gmbh/AttestationFailureAnalysisService/pkg/firmwarerepo"
+	"github.com/immune-gmbh/AttestationFailureAnalysisService/pkg/healthcheck"
+	"github.com/immune-gmbh/AttestationFailureAnalysisService/pkg/identity"
+	"github.com/immune-gmbh/AttestationFailureAnalysisService/pkg/measurements"
 	"github.com/immune-gmbh/AttestationFailureAnalysisService/pkg/objcache"
 	"github.com/immune-gmbh/AttestationFailureAnalysisService/pkg/observability"
+	"github.com/immune-gmbh/AttestationFailureAnalysisService/pkg/policy"
+	"github.com/immune-gmbh/AttestationFailureAnalysisService/pkg/reportgroup"
 	"github.com/immune-gmbh/AttestationFailureAnalysisService/pkg/server/controller"
 	controllertypes "github.com/immune-gmbh/AttestationFailureAnalysisService/pkg/server/controller/types"
 	"github.com/immune-gmbh/AttestationFailureAnalysisService/pkg/server/thrift"
@@ -37,6 +46,7 @@ import (
 	"github.com/facebookincubator/go-belt/tool/logger"
 	fianoLog "github.com/linuxboot/fiano/pkg/log"
 	"github.com/spf13/pflag"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
 )
 
 const (
@@ -47,6 +57,8 @@ const (
 	rtpfwCacheEvictionTimeoutDefault = 24 * time.Hour
 	apiCachePurgeTimeoutDefault      = time.Hour
 	dataCacheSizeDefault             = 1000
+	gcImageGracePeriodDefault        = 24 * time.Hour
+	tracerShutdownTimeout            = 10 * time.Second
 )
 
 func assertNoError(ctx context.Context, err error) {
@@ -60,6 +72,14 @@ func usageExit() {
 	os.Exit(2) // The default Go's exitcode on flag.Parse() problems
 }
 
+func toAnalyzerIDs(ids []string) []analysis.AnalyzerID {
+	result := make([]analysis.AnalyzerID, 0, len(ids))
+	for _, id := range ids {
+		result = append(result, analysis.AnalyzerID(id))
+	}
+	return result
+}
+
 func main() {
 	logLevel := logger.LevelInfo // the default value
 	dbAddr := os.Getenv("DBHOST")
@@ -82,11 +102,14 @@ func main() {
 	rdbmsDSNOrigFW := pflag.String("rdbms-dsn-fw-orig", defaultDSN, "")
 	rdbmsDriverInternal := pflag.String("rdbms-driver-internal", "mysql", "")
 	rdbmsDSNInternal := pflag.String("rdbms-dsn-internal", defaultDSN, "")
-	origFirmwareImageRepoBaseURL := pflag.String("original-firmware-image-repo-baseurl", "http://orig-fw-repo:17546/", "")
+	origFirmwareImageRepoBaseURL := pflag.String("original-firmware-image-repo-baseurl", "http://orig-fw-repo:17546/", "the URL of the original firmware images repository: http(s)://, file://, oci://, oci+http:// or blobstorage+<blob storage URL>")
+	origFirmwareImageRepoMirrors := pflag.StringSlice("original-firmware-image-repo-mirrors", nil, "additional base URLs of the original firmware images repository, tried in order if the main one fails (HTTP only)")
+	origFirmwareImageRepoHeaders := pflag.StringSlice("original-firmware-image-repo-headers", nil, "HTTP headers in format 'Key: Value' added to requests to the original firmware images repository (e.g. for authentication)")
+	origFirmwareImageCacheDir := pflag.String("original-firmware-image-cache-dir", "", "if non-empty then fetched original firmware images are cached in this directory")
 	blobStorageURL := pflag.String("blob-storage-url", "fs:///srv/afasd", "")
-	amountOfWorkers := pflag.Uint("workers", uint(runtime.NumCPU()), "amount of concurrent workers")
-	workersQueue := pflag.Uint("workers-queue", uint(runtime.NumCPU())*10000, "maximal amount of requests permitted in the queue")
-	cpuLoadLimit := pflag.Float64("cpu-load-limit", 0.8, "suspend accepting requests while fraction of busy CPU cycles is more than the specified number")
+	amountOfWorkers := pflag.Uint("workers", uint(runtime.NumCPU()), "amount of concurrently executed Analyze requests (0 means no limit)")
+	workersQueue := pflag.Uint("workers-queue", uint(runtime.NumCPU())*10000, "maximal amount of Analyze requests waiting for a worker; the excess is rejected as overloaded")
+	cpuLoadLimit := pflag.Float64("cpu-load-limit", 0.8, "reject new Analyze requests as overloaded while fraction of busy CPU cycles is more than the specified number")
 	apiCachePurgeTimeout := pflag.Duration(
 		"api-cache-purge-timeout",
 		apiCachePurgeTimeoutDefault,
@@ -94,13 +117,50 @@ func main() {
 	)
 	storageCacheSize := pflag.Uint64("image-storage-cache-size", storageCacheSizeDefault, "defines the memory limit for the storage used to save images, analyzed by AFAS")
 	dataCacheSize := pflag.Int("data-cache-size", dataCacheSizeDefault, "defines the size of the cache for internally calculated data objects like parsed firmware, measurements flow")
+	gcInterval := pflag.Duration("gc-interval", 0, "interval between runs of the garbage collector of analyze reports and firmware images; zero disables it")
+	gcMaxReportAge := pflag.Duration("gc-max-report-age", 0, "delete analyze reports older than the specified duration; zero means no limit")
+	gcMaxReportsPerAsset := pflag.Uint("gc-max-reports-per-asset", 0, "keep only the specified amount of the newest analyze reports for each asset; zero means no limit")
+	gcKeepCritical := pflag.Bool("gc-keep-critical", true, "never delete analyze reports with critical issues")
+	gcImageGracePeriod := pflag.Duration("gc-image-grace-period", gcImageGracePeriodDefault, "delete firmware images not referenced by any report and older than the specified duration; zero disables deletion of images")
+	adminHostnames := pflag.StringSlice("admin-hostnames", nil, "hostnames (from client TLS certificates) permitted to use administrative methods, like modifying the original firmware database")
+	gcDryRun := pflag.Bool("gc-dry-run", false, "only log what the garbage collector would delete")
+	reanalysisInterval := pflag.Duration("reanalysis-interval", 0, "interval between re-analyses of stored analyzer reports produced by outdated versions of analyzers; zero disables it")
+	reanalysisAnalyzers := pflag.StringSlice("reanalysis-analyzers", nil, "IDs of analyzers, reports of which are re-analyzed when the analyzer version changes")
+	reanalysisBatchSize := pflag.Uint("reanalysis-batch-size", 0, "maximal amount of analyzer reports re-analyzed per analyzer in one run; zero means the default value")
+	expectedMeasurementsRegistersFile := pflag.String("expected-measurements-registers-file", "", "path to a JSON file with status register sets (an array of arrays of registers), each of them is used to simulate boot flows while populating expected measurements of original firmwares; if empty then only the empty register set is simulated")
+	policyFile := pflag.String("policy-file", "", "path to a JSON file with policies deciding verdicts of analyze reports; if empty then the default policy is used")
+	alertWebhookURL := pflag.String("alert-webhook-url", "", "group analyze reports by similar problems and send an alert about each new group as a JSON HTTP POST request to the specified URL")
+	alertFile := pflag.String("alert-file", "", "group analyze reports by similar problems and append an alert about each new group to the specified file (one JSON per line)")
+	tlsCertFile := pflag.String("tls-cert", "", "path to the PEM certificate of the server; if non-empty then HTTPS is served instead of HTTP")
+	tlsKeyFile := pflag.String("tls-key", "", "path to the PEM private key of the server certificate")
+	tlsClientCAFiles := pflag.StringSlice("tls-client-ca", nil, "paths to PEM bundles of CA certificates to verify client certificates against")
+	tlsClientAuth := pflag.String("tls-client-auth", "none", "how client certificates are verified: 'none', 'verify-if-given' or 'require' (mutual TLS)")
+	authzFile := pflag.String("authz-file", "", "path to a JSON file with authorization rules mapping client certificate hostnames to permitted RPCs")
+	metricsAddr := pflag.String("metrics-addr", "", "if non-empty then Prometheus metrics ('/metrics') and health checks ('/healthz' and '/readyz') are also served on this address (for example, to be accessed without a client TLS certificate); they are always served on the Thrift address")
+	otelCollectorURL := pflag.String("otel-collector-url", "", "if non-empty then tracing spans are exported to the specified OpenTelemetry collector using OTLP over HTTP (for example, 'http://otel-collector:4318')")
 	pflag.Parse()
 	if pflag.NArg() != 0 {
 		usageExit()
 	}
 
+	var tracerProvider *sdktrace.TracerProvider
+	if *otelCollectorURL != "" {
+		var err error
+		tracerProvider, err = observability.NewOTLPTracerProvider(context.Background(), *otelCollectorURL, "afasd")
+		if err != nil {
+			fmt.Fprintf(os.Stderr, "unable to initialize tracing: %v\n", err)
+			os.Exit(1)
+		}
+		observability.TracerProvider = tracerProvider
+	}
+
+	// the server is stopped gracefully on SIGINT/SIGTERM, so that
+	// the remaining tracing spans could be exported below
+	signalCtx, stopSignals := signal.NotifyContext(context.Background(), os.Interrupt, syscall.SIGTERM)
+	defer stopSignals()
+
 	ctx := observability.WithBelt(
-		context.Background(),
+		signalCtx,
 		logLevel,
 		"AFAS", true,
 	)
@@ -114,6 +174,17 @@ func main() {
 		}()
 	}
 
+	if len(*adminHostnames) != 0 {
+		identity.IsAdmin = identity.AdminHostnames(*adminHostnames...)
+	}
+	if *authzFile != "" {
+		authzPolicy, err := identity.LoadAuthzPolicy(*authzFile)
+		if err != nil {
+			log.Fatalf("unable to load authorization rules: %v", err)
+		}
+		identity.IsPermitted = authzPolicy.IsPermitted
+	}
+
 	fianoLog.DefaultLogger = newFianoLogger(log.WithField("module", "fiano"))
 
 	firmwareBlobStorage, err := blobstorage.New(*blobStorageURL)
@@ -136,7 +207,36 @@ func main() {
 		log.Panic(err)
 	}
 
-	origFirmwareRepo := firmwarerepo.New(*origFirmwareImageRepoBaseURL, "AttestationFailureAnalyzer")
+	origFirmwareRepoHeaders := http.Header{}
+	for _, header := range *origFirmwareImageRepoHeaders {
+		k, v, ok := strings.Cut(header, ":")
+		if !ok {
+			log.Panicf("invalid header '%s', expected format 'Key: Value'", header)
+		}
+		origFirmwareRepoHeaders.Add(strings.TrimSpace(k), strings.TrimSpace(v))
+	}
+	origFirmwareRepoBackend, err := firmwarerepo.NewBackend(
+		append([]string{*origFirmwareImageRepoBaseURL}, *origFirmwareImageRepoMirrors...),
+		origFirmwareRepoHeaders,
+	)
+	if err != nil {
+		log.Panic(err)
+	}
+	origFirmwareRepo, err := firmwarerepo.New(
+		origFirmwareRepoBackend,
+		"AttestationFailureAnalyzer",
+		firmwarerepo.OptionFirmwareDB{DB: origFirmwareDB},
+		firmwarerepo.OptionCacheDir(*origFirmwareImageCacheDir),
+	)
+	if err != nil {
+		log.Panic(err)
+	}
+
+	healthChecker := healthcheck.NewChecker()
+	healthChecker.Add("database-internal", storage.Ping)
+	healthChecker.Add("database-fw-orig", origFirmwareDB.Ping)
+	healthChecker.Add("blob-storage", firmwareBlobStorage.Ping)
+	healthChecker.Add("firmware-repo", origFirmwareRepo.Ping)
 
 	dataCalculator, err := analysis.NewDataCalculator(*dataCacheSize)
 	if err != nil {
@@ -151,10 +251,43 @@ func main() {
 		dataCalculator,
 		devicegetter.DummyDeviceGetter{},
 		*apiCachePurgeTimeout,
+		controller.RetentionPolicy{
+			GCInterval:         *gcInterval,
+			MaxReportAge:       *gcMaxReportAge,
+			MaxReportsPerAsset: *gcMaxReportsPerAsset,
+			KeepCritical:       *gcKeepCritical,
+			ImageGracePeriod:   *gcImageGracePeriod,
+			DryRun:             *gcDryRun,
+		},
+		controller.ReanalysisPolicy{
+			Interval:  *reanalysisInterval,
+			Analyzers: toAnalyzerIDs(*reanalysisAnalyzers),
+			BatchSize: *reanalysisBatchSize,
+		},
 	)
 	assertNoError(ctx, err)
 	log.Debugf("created a controller")
 
+	if *expectedMeasurementsRegistersFile != "" {
+		ctrl.ExpectedMeasurementsRegisterSets, err = measurements.LoadRegisterSets(*expectedMeasurementsRegistersFile)
+		assertNoError(ctx, err)
+	}
+	if *policyFile != "" {
+		ctrl.Policies, err = policy.Load(*policyFile)
+		assertNoError(ctx, err)
+	}
+
+	switch {
+	case *alertWebhookURL != "" && *alertFile != "":
+		log.Fatalf("flags --alert-webhook-url and --alert-file are mutually exclusive")
+	case *alertWebhookURL != "":
+		err = ctrl.SetReportGrouper(reportgroup.NewEngine(ctrl.FirmwareStorage, reportgroup.NewWebhookNotifier(*alertWebhookURL, nil)))
+		assertNoError(ctx, err)
+	case *alertFile != "":
+		err = ctrl.SetReportGrouper(reportgroup.NewEngine(ctrl.FirmwareStorage, reportgroup.NewFileNotifier(*alertFile)))
+		assertNoError(ctx, err)
+	}
+
 	srv, err := thrift.NewServer(
 		*amountOfWorkers,
 		*workersQueue,
@@ -166,6 +299,43 @@ func main() {
 	assertNoError(ctx, err)
 	log.Debugf("created a Thrift server")
 
+	if *tlsCertFile != "" {
+		var clientAuth thrift.ClientAuth
+		switch *tlsClientAuth {
+		case "none":
+			clientAuth = thrift.ClientAuthNone
+		case "verify-if-given":
+			clientAuth = thrift.ClientAuthVerifyIfGiven
+		case "require":
+			clientAuth = thrift.ClientAuthRequire
+		default:
+			log.Fatalf("unknown value of --tls-client-auth: '%s'", *tlsClientAuth)
+		}
+		srv.TLSConfig, err = thrift.NewTLSConfig(*tlsCertFile, *tlsKeyFile, *tlsClientCAFiles, clientAuth)
+		assertNoError(ctx, err)
+	}
+
+	http.Handle("/metrics", observability.MetricsHandler())
+	http.Handle("/healthz", healthChecker.LivenessHandler())
+	http.Handle("/readyz", healthChecker.ReadinessHandler())
+	if *metricsAddr != "" {
+		go func() {
+			mux := http.NewServeMux()
+			mux.Handle("/metrics", observability.MetricsHandler())
+			mux.Handle("/healthz", healthChecker.LivenessHandler())
+			mux.Handle("/readyz", healthChecker.ReadinessHandler())
+			err := http.ListenAndServe(*metricsAddr, mux)
+			log.Errorf("unable to start listening for metrics: %v", err)
+		}()
+	}
+
 	err = srv.Serve(ctx, *thriftBindAddr)
+	if tracerProvider != nil {
+		shutdownCtx, cancelFn := context.WithTimeout(context.Background(), tracerShutdownTimeout)
+		if err := tracerProvider.Shutdown(shutdownCtx); err != nil {
+			log.Errorf("unable to shut down the tracer provider: %v", err)
+		}
+		cancelFn()
+	}
 	assertNoError(ctx, err)
 }
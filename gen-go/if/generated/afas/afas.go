@@ -274,6 +274,288 @@ func (p *BuildMode) Value() (driver.Value, error) {
 	return int64(*p), nil
 }
 
+type IssueChange int64
+
+const (
+	IssueChange_Unchanged IssueChange = 1
+	IssueChange_New       IssueChange = 2
+	IssueChange_Resolved  IssueChange = 3
+)
+
+func (p IssueChange) String() string {
+	switch p {
+	case IssueChange_Unchanged:
+		return "Unchanged"
+	case IssueChange_New:
+		return "New"
+	case IssueChange_Resolved:
+		return "Resolved"
+	}
+	return "<UNSET>"
+}
+
+func IssueChangeFromString(s string) (IssueChange, error) {
+	switch s {
+	case "Unchanged":
+		return IssueChange_Unchanged, nil
+	case "New":
+		return IssueChange_New, nil
+	case "Resolved":
+		return IssueChange_Resolved, nil
+	}
+	return IssueChange(0), fmt.Errorf("not a valid IssueChange string")
+}
+
+func IssueChangePtr(v IssueChange) *IssueChange { return &v }
+
+func (p IssueChange) MarshalText() ([]byte, error) {
+	return []byte(p.String()), nil
+}
+
+func (p *IssueChange) UnmarshalText(text []byte) error {
+	q, err := IssueChangeFromString(string(text))
+	if err != nil {
+		return err
+	}
+	*p = q
+	return nil
+}
+
+func (p *IssueChange) Scan(value interface{}) error {
+	v, ok := value.(int64)
+	if !ok {
+		return errors.New("Scan value is not int64")
+	}
+	*p = IssueChange(v)
+	return nil
+}
+
+func (p *IssueChange) Value() (driver.Value, error) {
+	if p == nil {
+		return nil, nil
+	}
+	return int64(*p), nil
+}
+
+type AggregateDimension int64
+
+const (
+	AggregateDimension_Analyzer                AggregateDimension = 1
+	AggregateDimension_Diagnosis               AggregateDimension = 2
+	AggregateDimension_IssueSeverity           AggregateDimension = 3
+	AggregateDimension_IssueDescription        AggregateDimension = 4
+	AggregateDimension_ActualFirmwareVersion   AggregateDimension = 5
+	AggregateDimension_OriginalFirmwareVersion AggregateDimension = 6
+	AggregateDimension_ModelID                 AggregateDimension = 7
+)
+
+func (p AggregateDimension) String() string {
+	switch p {
+	case AggregateDimension_Analyzer:
+		return "Analyzer"
+	case AggregateDimension_Diagnosis:
+		return "Diagnosis"
+	case AggregateDimension_IssueSeverity:
+		return "IssueSeverity"
+	case AggregateDimension_IssueDescription:
+		return "IssueDescription"
+	case AggregateDimension_ActualFirmwareVersion:
+		return "ActualFirmwareVersion"
+	case AggregateDimension_OriginalFirmwareVersion:
+		return "OriginalFirmwareVersion"
+	case AggregateDimension_ModelID:
+		return "ModelID"
+	}
+	return "<UNSET>"
+}
+
+func AggregateDimensionFromString(s string) (AggregateDimension, error) {
+	switch s {
+	case "Analyzer":
+		return AggregateDimension_Analyzer, nil
+	case "Diagnosis":
+		return AggregateDimension_Diagnosis, nil
+	case "IssueSeverity":
+		return AggregateDimension_IssueSeverity, nil
+	case "IssueDescription":
+		return AggregateDimension_IssueDescription, nil
+	case "ActualFirmwareVersion":
+		return AggregateDimension_ActualFirmwareVersion, nil
+	case "OriginalFirmwareVersion":
+		return AggregateDimension_OriginalFirmwareVersion, nil
+	case "ModelID":
+		return AggregateDimension_ModelID, nil
+	}
+	return AggregateDimension(0), fmt.Errorf("not a valid AggregateDimension string")
+}
+
+func AggregateDimensionPtr(v AggregateDimension) *AggregateDimension { return &v }
+
+func (p AggregateDimension) MarshalText() ([]byte, error) {
+	return []byte(p.String()), nil
+}
+
+func (p *AggregateDimension) UnmarshalText(text []byte) error {
+	q, err := AggregateDimensionFromString(string(text))
+	if err != nil {
+		return err
+	}
+	*p = q
+	return nil
+}
+
+func (p *AggregateDimension) Scan(value interface{}) error {
+	v, ok := value.(int64)
+	if !ok {
+		return errors.New("Scan value is not int64")
+	}
+	*p = AggregateDimension(v)
+	return nil
+}
+
+func (p *AggregateDimension) Value() (driver.Value, error) {
+	if p == nil {
+		return nil, nil
+	}
+	return int64(*p), nil
+}
+
+type FirmwareDumpMethod int64
+
+const (
+	FirmwareDumpMethod_Unknown  FirmwareDumpMethod = 0
+	FirmwareDumpMethod_Flashrom FirmwareDumpMethod = 1
+	FirmwareDumpMethod_Afulnx64 FirmwareDumpMethod = 2
+	FirmwareDumpMethod_DevMem   FirmwareDumpMethod = 3
+	FirmwareDumpMethod_MTD      FirmwareDumpMethod = 4
+)
+
+func (p FirmwareDumpMethod) String() string {
+	switch p {
+	case FirmwareDumpMethod_Unknown:
+		return "Unknown"
+	case FirmwareDumpMethod_Flashrom:
+		return "Flashrom"
+	case FirmwareDumpMethod_Afulnx64:
+		return "Afulnx64"
+	case FirmwareDumpMethod_DevMem:
+		return "DevMem"
+	case FirmwareDumpMethod_MTD:
+		return "MTD"
+	}
+	return "<UNSET>"
+}
+
+func FirmwareDumpMethodFromString(s string) (FirmwareDumpMethod, error) {
+	switch s {
+	case "Unknown":
+		return FirmwareDumpMethod_Unknown, nil
+	case "Flashrom":
+		return FirmwareDumpMethod_Flashrom, nil
+	case "Afulnx64":
+		return FirmwareDumpMethod_Afulnx64, nil
+	case "DevMem":
+		return FirmwareDumpMethod_DevMem, nil
+	case "MTD":
+		return FirmwareDumpMethod_MTD, nil
+	}
+	return FirmwareDumpMethod(0), fmt.Errorf("not a valid FirmwareDumpMethod string")
+}
+
+func FirmwareDumpMethodPtr(v FirmwareDumpMethod) *FirmwareDumpMethod { return &v }
+
+func (p FirmwareDumpMethod) MarshalText() ([]byte, error) {
+	return []byte(p.String()), nil
+}
+
+func (p *FirmwareDumpMethod) UnmarshalText(text []byte) error {
+	q, err := FirmwareDumpMethodFromString(string(text))
+	if err != nil {
+		return err
+	}
+	*p = q
+	return nil
+}
+
+func (p *FirmwareDumpMethod) Scan(value interface{}) error {
+	v, ok := value.(int64)
+	if !ok {
+		return errors.New("Scan value is not int64")
+	}
+	*p = FirmwareDumpMethod(v)
+	return nil
+}
+
+func (p *FirmwareDumpMethod) Value() (driver.Value, error) {
+	if p == nil {
+		return nil, nil
+	}
+	return int64(*p), nil
+}
+
+type FirmwareDumpQuality int64
+
+const (
+	FirmwareDumpQuality_Unverified FirmwareDumpQuality = 0
+	FirmwareDumpQuality_Verified   FirmwareDumpQuality = 1
+	FirmwareDumpQuality_Unstable   FirmwareDumpQuality = 2
+)
+
+func (p FirmwareDumpQuality) String() string {
+	switch p {
+	case FirmwareDumpQuality_Unverified:
+		return "Unverified"
+	case FirmwareDumpQuality_Verified:
+		return "Verified"
+	case FirmwareDumpQuality_Unstable:
+		return "Unstable"
+	}
+	return "<UNSET>"
+}
+
+func FirmwareDumpQualityFromString(s string) (FirmwareDumpQuality, error) {
+	switch s {
+	case "Unverified":
+		return FirmwareDumpQuality_Unverified, nil
+	case "Verified":
+		return FirmwareDumpQuality_Verified, nil
+	case "Unstable":
+		return FirmwareDumpQuality_Unstable, nil
+	}
+	return FirmwareDumpQuality(0), fmt.Errorf("not a valid FirmwareDumpQuality string")
+}
+
+func FirmwareDumpQualityPtr(v FirmwareDumpQuality) *FirmwareDumpQuality { return &v }
+
+func (p FirmwareDumpQuality) MarshalText() ([]byte, error) {
+	return []byte(p.String()), nil
+}
+
+func (p *FirmwareDumpQuality) UnmarshalText(text []byte) error {
+	q, err := FirmwareDumpQualityFromString(string(text))
+	if err != nil {
+		return err
+	}
+	*p = q
+	return nil
+}
+
+func (p *FirmwareDumpQuality) Scan(value interface{}) error {
+	v, ok := value.(int64)
+	if !ok {
+		return errors.New("Scan value is not int64")
+	}
+	*p = FirmwareDumpQuality(v)
+	return nil
+}
+
+func (p *FirmwareDumpQuality) Value() (driver.Value, error) {
+	if p == nil {
+		return nil, nil
+	}
+	return int64(*p), nil
+}
+
 type ErrorClass int64
 
 const (
@@ -337,87 +619,329 @@ func (p *ErrorClass) Value() (driver.Value, error) {
 	return int64(*p), nil
 }
 
-type NodeInfo *diffanalysis.NodeInfo
-
-func NodeInfoPtr(v NodeInfo) *NodeInfo { return &v }
+type Verdict int64
 
-type DiffEntry *diffanalysis.DiffEntry
+const (
+	Verdict_Pass      Verdict = 1
+	Verdict_Remediate Verdict = 2
+	Verdict_Escalate  Verdict = 3
+)
 
-func DiffEntryPtr(v DiffEntry) *DiffEntry { return &v }
+func (p Verdict) String() string {
+	switch p {
+	case Verdict_Pass:
+		return "Pass"
+	case Verdict_Remediate:
+		return "Remediate"
+	case Verdict_Escalate:
+		return "Escalate"
+	}
+	return "<UNSET>"
+}
 
-type RelatedMeasurement *diffanalysis.RelatedMeasurement
+func VerdictFromString(s string) (Verdict, error) {
+	switch s {
+	case "Pass":
+		return Verdict_Pass, nil
+	case "Remediate":
+		return Verdict_Remediate, nil
+	case "Escalate":
+		return Verdict_Escalate, nil
+	}
+	return Verdict(0), fmt.Errorf("not a valid Verdict string")
+}
 
-func RelatedMeasurementPtr(v RelatedMeasurement) *RelatedMeasurement { return &v }
+func VerdictPtr(v Verdict) *Verdict { return &v }
 
-type Measurement *diffanalysis.Measurement
+func (p Verdict) MarshalText() ([]byte, error) {
+	return []byte(p.String()), nil
+}
 
-func MeasurementPtr(v Measurement) *Measurement { return &v }
+func (p *Verdict) UnmarshalText(text []byte) error {
+	q, err := VerdictFromString(string(text))
+	if err != nil {
+		return err
+	}
+	*p = q
+	return nil
+}
 
-type DataChunk *diffanalysis.DataChunk
+func (p *Verdict) Scan(value interface{}) error {
+	v, ok := value.(int64)
+	if !ok {
+		return errors.New("Scan value is not int64")
+	}
+	*p = Verdict(v)
+	return nil
+}
 
-func DataChunkPtr(v DataChunk) *DataChunk { return &v }
+func (p *Verdict) Value() (driver.Value, error) {
+	if p == nil {
+		return nil, nil
+	}
+	return int64(*p), nil
+}
 
-type Range_ *diffanalysis.Range_
+type OriginalFirmwareType int64
 
-func Range_Ptr(v Range_) *Range_ { return &v }
+const (
+	OriginalFirmwareType_BIOS OriginalFirmwareType = 1
+)
 
-// Attributes:
-//   - Original
-//   - Received
-//   - LastReported
-//   - EventLog
-//   - TPM
-//   - OrigTable
-type PCRValue struct {
-	Original     []byte `thrift:"Original,1" db:"Original" json:"Original,omitempty"`
-	Received     []byte `thrift:"Received,2" db:"Received" json:"Received,omitempty"`
-	LastReported []byte `thrift:"LastReported,3" db:"LastReported" json:"LastReported,omitempty"`
-	EventLog     []byte `thrift:"EventLog,4" db:"EventLog" json:"EventLog,omitempty"`
-	TPM          []byte `thrift:"TPM,5" db:"TPM" json:"TPM,omitempty"`
-	OrigTable    []byte `thrift:"OrigTable,6" db:"OrigTable" json:"OrigTable,omitempty"`
+func (p OriginalFirmwareType) String() string {
+	switch p {
+	case OriginalFirmwareType_BIOS:
+		return "BIOS"
+	}
+	return "<UNSET>"
 }
 
-func NewPCRValue() *PCRValue {
-	return &PCRValue{}
+func OriginalFirmwareTypeFromString(s string) (OriginalFirmwareType, error) {
+	switch s {
+	case "BIOS":
+		return OriginalFirmwareType_BIOS, nil
+	}
+	return OriginalFirmwareType(0), fmt.Errorf("not a valid OriginalFirmwareType string")
 }
 
-var PCRValue_Original_DEFAULT []byte
+func OriginalFirmwareTypePtr(v OriginalFirmwareType) *OriginalFirmwareType { return &v }
 
-func (p *PCRValue) GetOriginal() []byte {
-	return p.Original
+func (p OriginalFirmwareType) MarshalText() ([]byte, error) {
+	return []byte(p.String()), nil
 }
 
-var PCRValue_Received_DEFAULT []byte
-
-func (p *PCRValue) GetReceived() []byte {
-	return p.Received
+func (p *OriginalFirmwareType) UnmarshalText(text []byte) error {
+	q, err := OriginalFirmwareTypeFromString(string(text))
+	if err != nil {
+		return err
+	}
+	*p = q
+	return nil
 }
 
-var PCRValue_LastReported_DEFAULT []byte
+func (p *OriginalFirmwareType) Scan(value interface{}) error {
+	v, ok := value.(int64)
+	if !ok {
+		return errors.New("Scan value is not int64")
+	}
+	*p = OriginalFirmwareType(v)
+	return nil
+}
 
-func (p *PCRValue) GetLastReported() []byte {
-	return p.LastReported
+func (p *OriginalFirmwareType) Value() (driver.Value, error) {
+	if p == nil {
+		return nil, nil
+	}
+	return int64(*p), nil
 }
 
-var PCRValue_EventLog_DEFAULT []byte
+type TamperingRegistryKind int64
 
-func (p *PCRValue) GetEventLog() []byte {
-	return p.EventLog
-}
+const (
+	TamperingRegistryKind_AssetID         TamperingRegistryKind = 1
+	TamperingRegistryKind_Hostname        TamperingRegistryKind = 2
+	TamperingRegistryKind_ImageHash       TamperingRegistryKind = 3
+	TamperingRegistryKind_ImageStableHash TamperingRegistryKind = 4
+)
 
-var PCRValue_TPM_DEFAULT []byte
+func (p TamperingRegistryKind) String() string {
+	switch p {
+	case TamperingRegistryKind_AssetID:
+		return "AssetID"
+	case TamperingRegistryKind_Hostname:
+		return "Hostname"
+	case TamperingRegistryKind_ImageHash:
+		return "ImageHash"
+	case TamperingRegistryKind_ImageStableHash:
+		return "ImageStableHash"
+	}
+	return "<UNSET>"
+}
 
-func (p *PCRValue) GetTPM() []byte {
-	return p.TPM
+func TamperingRegistryKindFromString(s string) (TamperingRegistryKind, error) {
+	switch s {
+	case "AssetID":
+		return TamperingRegistryKind_AssetID, nil
+	case "Hostname":
+		return TamperingRegistryKind_Hostname, nil
+	case "ImageHash":
+		return TamperingRegistryKind_ImageHash, nil
+	case "ImageStableHash":
+		return TamperingRegistryKind_ImageStableHash, nil
+	}
+	return TamperingRegistryKind(0), fmt.Errorf("not a valid TamperingRegistryKind string")
 }
 
-var PCRValue_OrigTable_DEFAULT []byte
+func TamperingRegistryKindPtr(v TamperingRegistryKind) *TamperingRegistryKind { return &v }
 
-func (p *PCRValue) GetOrigTable() []byte {
-	return p.OrigTable
-}
-func (p *PCRValue) IsSetOriginal() bool {
-	return p.Original != nil
+func (p TamperingRegistryKind) MarshalText() ([]byte, error) {
+	return []byte(p.String()), nil
+}
+
+func (p *TamperingRegistryKind) UnmarshalText(text []byte) error {
+	q, err := TamperingRegistryKindFromString(string(text))
+	if err != nil {
+		return err
+	}
+	*p = q
+	return nil
+}
+
+func (p *TamperingRegistryKind) Scan(value interface{}) error {
+	v, ok := value.(int64)
+	if !ok {
+		return errors.New("Scan value is not int64")
+	}
+	*p = TamperingRegistryKind(v)
+	return nil
+}
+
+func (p *TamperingRegistryKind) Value() (driver.Value, error) {
+	if p == nil {
+		return nil, nil
+	}
+	return int64(*p), nil
+}
+
+type PCRMatchSource int64
+
+const (
+	PCRMatchSource_OriginalFirmwareDB PCRMatchSource = 1
+	PCRMatchSource_ReproducedPCRs     PCRMatchSource = 2
+)
+
+func (p PCRMatchSource) String() string {
+	switch p {
+	case PCRMatchSource_OriginalFirmwareDB:
+		return "OriginalFirmwareDB"
+	case PCRMatchSource_ReproducedPCRs:
+		return "ReproducedPCRs"
+	}
+	return "<UNSET>"
+}
+
+func PCRMatchSourceFromString(s string) (PCRMatchSource, error) {
+	switch s {
+	case "OriginalFirmwareDB":
+		return PCRMatchSource_OriginalFirmwareDB, nil
+	case "ReproducedPCRs":
+		return PCRMatchSource_ReproducedPCRs, nil
+	}
+	return PCRMatchSource(0), fmt.Errorf("not a valid PCRMatchSource string")
+}
+
+func PCRMatchSourcePtr(v PCRMatchSource) *PCRMatchSource { return &v }
+
+func (p PCRMatchSource) MarshalText() ([]byte, error) {
+	return []byte(p.String()), nil
+}
+
+func (p *PCRMatchSource) UnmarshalText(text []byte) error {
+	q, err := PCRMatchSourceFromString(string(text))
+	if err != nil {
+		return err
+	}
+	*p = q
+	return nil
+}
+
+func (p *PCRMatchSource) Scan(value interface{}) error {
+	v, ok := value.(int64)
+	if !ok {
+		return errors.New("Scan value is not int64")
+	}
+	*p = PCRMatchSource(v)
+	return nil
+}
+
+func (p *PCRMatchSource) Value() (driver.Value, error) {
+	if p == nil {
+		return nil, nil
+	}
+	return int64(*p), nil
+}
+
+type NodeInfo *diffanalysis.NodeInfo
+
+func NodeInfoPtr(v NodeInfo) *NodeInfo { return &v }
+
+type DiffEntry *diffanalysis.DiffEntry
+
+func DiffEntryPtr(v DiffEntry) *DiffEntry { return &v }
+
+type RelatedMeasurement *diffanalysis.RelatedMeasurement
+
+func RelatedMeasurementPtr(v RelatedMeasurement) *RelatedMeasurement { return &v }
+
+type Measurement *diffanalysis.Measurement
+
+func MeasurementPtr(v Measurement) *Measurement { return &v }
+
+type DataChunk *diffanalysis.DataChunk
+
+func DataChunkPtr(v DataChunk) *DataChunk { return &v }
+
+type Range_ *diffanalysis.Range_
+
+func Range_Ptr(v Range_) *Range_ { return &v }
+
+// Attributes:
+//   - Original
+//   - Received
+//   - LastReported
+//   - EventLog
+//   - TPM
+//   - OrigTable
+type PCRValue struct {
+	Original     []byte `thrift:"Original,1" db:"Original" json:"Original,omitempty"`
+	Received     []byte `thrift:"Received,2" db:"Received" json:"Received,omitempty"`
+	LastReported []byte `thrift:"LastReported,3" db:"LastReported" json:"LastReported,omitempty"`
+	EventLog     []byte `thrift:"EventLog,4" db:"EventLog" json:"EventLog,omitempty"`
+	TPM          []byte `thrift:"TPM,5" db:"TPM" json:"TPM,omitempty"`
+	OrigTable    []byte `thrift:"OrigTable,6" db:"OrigTable" json:"OrigTable,omitempty"`
+}
+
+func NewPCRValue() *PCRValue {
+	return &PCRValue{}
+}
+
+var PCRValue_Original_DEFAULT []byte
+
+func (p *PCRValue) GetOriginal() []byte {
+	return p.Original
+}
+
+var PCRValue_Received_DEFAULT []byte
+
+func (p *PCRValue) GetReceived() []byte {
+	return p.Received
+}
+
+var PCRValue_LastReported_DEFAULT []byte
+
+func (p *PCRValue) GetLastReported() []byte {
+	return p.LastReported
+}
+
+var PCRValue_EventLog_DEFAULT []byte
+
+func (p *PCRValue) GetEventLog() []byte {
+	return p.EventLog
+}
+
+var PCRValue_TPM_DEFAULT []byte
+
+func (p *PCRValue) GetTPM() []byte {
+	return p.TPM
+}
+
+var PCRValue_OrigTable_DEFAULT []byte
+
+func (p *PCRValue) GetOrigTable() []byte {
+	return p.OrigTable
+}
+func (p *PCRValue) IsSetOriginal() bool {
+	return p.Original != nil
 }
 
 func (p *PCRValue) IsSetReceived() bool {
@@ -1722,9 +2246,13 @@ func (p *HostInfo) String() string {
 // Attributes:
 //   - Revision
 //   - BuildMode
+//   - Analyzers
+//   - ArtifactTypes
 type ServerInfo struct {
-	Revision  string    `thrift:"Revision,1" db:"Revision" json:"Revision"`
-	BuildMode BuildMode `thrift:"BuildMode,2" db:"BuildMode" json:"BuildMode"`
+	Revision      string          `thrift:"Revision,1" db:"Revision" json:"Revision"`
+	BuildMode     BuildMode       `thrift:"BuildMode,2" db:"BuildMode" json:"BuildMode"`
+	Analyzers     []*AnalyzerInfo `thrift:"Analyzers,3" db:"Analyzers" json:"Analyzers"`
+	ArtifactTypes []string        `thrift:"ArtifactTypes,4" db:"ArtifactTypes" json:"ArtifactTypes"`
 }
 
 func NewServerInfo() *ServerInfo {
@@ -1738,6 +2266,14 @@ func (p *ServerInfo) GetRevision() string {
 func (p *ServerInfo) GetBuildMode() BuildMode {
 	return p.BuildMode
 }
+
+func (p *ServerInfo) GetAnalyzers() []*AnalyzerInfo {
+	return p.Analyzers
+}
+
+func (p *ServerInfo) GetArtifactTypes() []string {
+	return p.ArtifactTypes
+}
 func (p *ServerInfo) Read(ctx context.Context, iprot thrift.TProtocol) error {
 	if _, err := iprot.ReadStructBegin(ctx); err != nil {
 		return thrift.PrependError(fmt.Sprintf("%T read error: ", p), err)
@@ -1772,6 +2308,26 @@ func (p *ServerInfo) Read(ctx context.Context, iprot thrift.TProtocol) error {
 					return err
 				}
 			}
+		case 3:
+			if fieldTypeId == thrift.LIST {
+				if err := p.ReadField3(ctx, iprot); err != nil {
+					return err
+				}
+			} else {
+				if err := iprot.Skip(ctx, fieldTypeId); err != nil {
+					return err
+				}
+			}
+		case 4:
+			if fieldTypeId == thrift.LIST {
+				if err := p.ReadField4(ctx, iprot); err != nil {
+					return err
+				}
+			} else {
+				if err := iprot.Skip(ctx, fieldTypeId); err != nil {
+					return err
+				}
+			}
 		default:
 			if err := iprot.Skip(ctx, fieldTypeId); err != nil {
 				return err
@@ -1806,6 +2362,48 @@ func (p *ServerInfo) ReadField2(ctx context.Context, iprot thrift.TProtocol) err
 	return nil
 }
 
+func (p *ServerInfo) ReadField3(ctx context.Context, iprot thrift.TProtocol) error {
+	_, size, err := iprot.ReadListBegin(ctx)
+	if err != nil {
+		return thrift.PrependError("error reading list begin: ", err)
+	}
+	tSlice := make([]*AnalyzerInfo, 0, size)
+	p.Analyzers = tSlice
+	for i := 0; i < size; i++ {
+		_elem0 := &AnalyzerInfo{}
+		if err := _elem0.Read(ctx, iprot); err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T error reading struct: ", _elem0), err)
+		}
+		p.Analyzers = append(p.Analyzers, _elem0)
+	}
+	if err := iprot.ReadListEnd(ctx); err != nil {
+		return thrift.PrependError("error reading list end: ", err)
+	}
+	return nil
+}
+
+func (p *ServerInfo) ReadField4(ctx context.Context, iprot thrift.TProtocol) error {
+	_, size, err := iprot.ReadListBegin(ctx)
+	if err != nil {
+		return thrift.PrependError("error reading list begin: ", err)
+	}
+	tSlice := make([]string, 0, size)
+	p.ArtifactTypes = tSlice
+	for i := 0; i < size; i++ {
+		var _elem1 string
+		if v, err := iprot.ReadString(ctx); err != nil {
+			return thrift.PrependError("error reading field 0: ", err)
+		} else {
+			_elem1 = v
+		}
+		p.ArtifactTypes = append(p.ArtifactTypes, _elem1)
+	}
+	if err := iprot.ReadListEnd(ctx); err != nil {
+		return thrift.PrependError("error reading list end: ", err)
+	}
+	return nil
+}
+
 func (p *ServerInfo) Write(ctx context.Context, oprot thrift.TProtocol) error {
 	if err := oprot.WriteStructBegin(ctx, "ServerInfo"); err != nil {
 		return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
@@ -1817,6 +2415,12 @@ func (p *ServerInfo) Write(ctx context.Context, oprot thrift.TProtocol) error {
 		if err := p.writeField2(ctx, oprot); err != nil {
 			return err
 		}
+		if err := p.writeField3(ctx, oprot); err != nil {
+			return err
+		}
+		if err := p.writeField4(ctx, oprot); err != nil {
+			return err
+		}
 	}
 	if err := oprot.WriteFieldStop(ctx); err != nil {
 		return thrift.PrependError("write field stop error: ", err)
@@ -1853,6 +2457,48 @@ func (p *ServerInfo) writeField2(ctx context.Context, oprot thrift.TProtocol) (e
 	return err
 }
 
+func (p *ServerInfo) writeField3(ctx context.Context, oprot thrift.TProtocol) (err error) {
+	if err := oprot.WriteFieldBegin(ctx, "Analyzers", thrift.LIST, 3); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T write field begin error 3:Analyzers: ", p), err)
+	}
+	if err := oprot.WriteListBegin(ctx, thrift.STRUCT, len(p.Analyzers)); err != nil {
+		return thrift.PrependError("error writing list begin: ", err)
+	}
+	for _, v := range p.Analyzers {
+		if err := v.Write(ctx, oprot); err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T error writing struct: ", v), err)
+		}
+	}
+	if err := oprot.WriteListEnd(ctx); err != nil {
+		return thrift.PrependError("error writing list end: ", err)
+	}
+	if err := oprot.WriteFieldEnd(ctx); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T write field end error 3:Analyzers: ", p), err)
+	}
+	return err
+}
+
+func (p *ServerInfo) writeField4(ctx context.Context, oprot thrift.TProtocol) (err error) {
+	if err := oprot.WriteFieldBegin(ctx, "ArtifactTypes", thrift.LIST, 4); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T write field begin error 4:ArtifactTypes: ", p), err)
+	}
+	if err := oprot.WriteListBegin(ctx, thrift.STRING, len(p.ArtifactTypes)); err != nil {
+		return thrift.PrependError("error writing list begin: ", err)
+	}
+	for _, v := range p.ArtifactTypes {
+		if err := oprot.WriteString(ctx, string(v)); err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T. (0) field write error: ", p), err)
+		}
+	}
+	if err := oprot.WriteListEnd(ctx); err != nil {
+		return thrift.PrependError("error writing list end: ", err)
+	}
+	if err := oprot.WriteFieldEnd(ctx); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T write field end error 4:ArtifactTypes: ", p), err)
+	}
+	return err
+}
+
 func (p *ServerInfo) Equals(other *ServerInfo) bool {
 	if p == other {
 		return true
@@ -1865,6 +2511,24 @@ func (p *ServerInfo) Equals(other *ServerInfo) bool {
 	if p.BuildMode != other.BuildMode {
 		return false
 	}
+	if len(p.Analyzers) != len(other.Analyzers) {
+		return false
+	}
+	for i, _tgt := range p.Analyzers {
+		_src2 := other.Analyzers[i]
+		if !_tgt.Equals(_src2) {
+			return false
+		}
+	}
+	if len(p.ArtifactTypes) != len(other.ArtifactTypes) {
+		return false
+	}
+	for i, _tgt := range p.ArtifactTypes {
+		_src3 := other.ArtifactTypes[i]
+		if _tgt != _src3 {
+			return false
+		}
+	}
 	return true
 }
 
@@ -1876,15 +2540,234 @@ func (p *ServerInfo) String() string {
 }
 
 // Attributes:
+//   - ID
 //   - Version
-//   - Reason
-type UnableToGetOriginalFirmware struct {
-	Version string `thrift:"Version,1" db:"Version" json:"Version"`
-	Reason  string `thrift:"Reason,2" db:"Reason" json:"Reason"`
+type AnalyzerInfo struct {
+	ID      string `thrift:"ID,1" db:"ID" json:"ID"`
+	Version string `thrift:"Version,2" db:"Version" json:"Version"`
 }
 
-func NewUnableToGetOriginalFirmware() *UnableToGetOriginalFirmware {
-	return &UnableToGetOriginalFirmware{}
+func NewAnalyzerInfo() *AnalyzerInfo {
+	return &AnalyzerInfo{}
+}
+
+func (p *AnalyzerInfo) GetID() string {
+	return p.ID
+}
+
+func (p *AnalyzerInfo) GetVersion() string {
+	return p.Version
+}
+func (p *AnalyzerInfo) Read(ctx context.Context, iprot thrift.TProtocol) error {
+	if _, err := iprot.ReadStructBegin(ctx); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T read error: ", p), err)
+	}
+
+	for {
+		_, fieldTypeId, fieldId, err := iprot.ReadFieldBegin(ctx)
+		if err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T field %d read error: ", p, fieldId), err)
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+		switch fieldId {
+		case 1:
+			if fieldTypeId == thrift.STRING {
+				if err := p.ReadField1(ctx, iprot); err != nil {
+					return err
+				}
+			} else {
+				if err := iprot.Skip(ctx, fieldTypeId); err != nil {
+					return err
+				}
+			}
+		case 2:
+			if fieldTypeId == thrift.STRING {
+				if err := p.ReadField2(ctx, iprot); err != nil {
+					return err
+				}
+			} else {
+				if err := iprot.Skip(ctx, fieldTypeId); err != nil {
+					return err
+				}
+			}
+		default:
+			if err := iprot.Skip(ctx, fieldTypeId); err != nil {
+				return err
+			}
+		}
+		if err := iprot.ReadFieldEnd(ctx); err != nil {
+			return err
+		}
+	}
+	if err := iprot.ReadStructEnd(ctx); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
+	}
+	return nil
+}
+
+func (p *AnalyzerInfo) ReadField1(ctx context.Context, iprot thrift.TProtocol) error {
+	if v, err := iprot.ReadString(ctx); err != nil {
+		return thrift.PrependError("error reading field 1: ", err)
+	} else {
+		p.ID = v
+	}
+	return nil
+}
+
+func (p *AnalyzerInfo) ReadField2(ctx context.Context, iprot thrift.TProtocol) error {
+	if v, err := iprot.ReadString(ctx); err != nil {
+		return thrift.PrependError("error reading field 2: ", err)
+	} else {
+		p.Version = v
+	}
+	return nil
+}
+
+func (p *AnalyzerInfo) Write(ctx context.Context, oprot thrift.TProtocol) error {
+	if err := oprot.WriteStructBegin(ctx, "AnalyzerInfo"); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
+	}
+	if p != nil {
+		if err := p.writeField1(ctx, oprot); err != nil {
+			return err
+		}
+		if err := p.writeField2(ctx, oprot); err != nil {
+			return err
+		}
+	}
+	if err := oprot.WriteFieldStop(ctx); err != nil {
+		return thrift.PrependError("write field stop error: ", err)
+	}
+	if err := oprot.WriteStructEnd(ctx); err != nil {
+		return thrift.PrependError("write struct stop error: ", err)
+	}
+	return nil
+}
+
+func (p *AnalyzerInfo) writeField1(ctx context.Context, oprot thrift.TProtocol) (err error) {
+	if err := oprot.WriteFieldBegin(ctx, "ID", thrift.STRING, 1); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T write field begin error 1:ID: ", p), err)
+	}
+	if err := oprot.WriteString(ctx, string(p.ID)); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T.ID (1) field write error: ", p), err)
+	}
+	if err := oprot.WriteFieldEnd(ctx); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T write field end error 1:ID: ", p), err)
+	}
+	return err
+}
+
+func (p *AnalyzerInfo) writeField2(ctx context.Context, oprot thrift.TProtocol) (err error) {
+	if err := oprot.WriteFieldBegin(ctx, "Version", thrift.STRING, 2); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T write field begin error 2:Version: ", p), err)
+	}
+	if err := oprot.WriteString(ctx, string(p.Version)); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T.Version (2) field write error: ", p), err)
+	}
+	if err := oprot.WriteFieldEnd(ctx); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T write field end error 2:Version: ", p), err)
+	}
+	return err
+}
+
+func (p *AnalyzerInfo) Equals(other *AnalyzerInfo) bool {
+	if p == other {
+		return true
+	} else if p == nil || other == nil {
+		return false
+	}
+	if p.ID != other.ID {
+		return false
+	}
+	if p.Version != other.Version {
+		return false
+	}
+	return true
+}
+
+func (p *AnalyzerInfo) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("AnalyzerInfo(%+v)", *p)
+}
+
+type GetServerInfoRequest struct {
+}
+
+func NewGetServerInfoRequest() *GetServerInfoRequest {
+	return &GetServerInfoRequest{}
+}
+
+func (p *GetServerInfoRequest) Read(ctx context.Context, iprot thrift.TProtocol) error {
+	if _, err := iprot.ReadStructBegin(ctx); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T read error: ", p), err)
+	}
+
+	for {
+		_, fieldTypeId, fieldId, err := iprot.ReadFieldBegin(ctx)
+		if err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T field %d read error: ", p, fieldId), err)
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+		if err := iprot.Skip(ctx, fieldTypeId); err != nil {
+			return err
+		}
+		if err := iprot.ReadFieldEnd(ctx); err != nil {
+			return err
+		}
+	}
+	if err := iprot.ReadStructEnd(ctx); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
+	}
+	return nil
+}
+
+func (p *GetServerInfoRequest) Write(ctx context.Context, oprot thrift.TProtocol) error {
+	if err := oprot.WriteStructBegin(ctx, "GetServerInfoRequest"); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
+	}
+	if p != nil {
+	}
+	if err := oprot.WriteFieldStop(ctx); err != nil {
+		return thrift.PrependError("write field stop error: ", err)
+	}
+	if err := oprot.WriteStructEnd(ctx); err != nil {
+		return thrift.PrependError("write struct stop error: ", err)
+	}
+	return nil
+}
+
+func (p *GetServerInfoRequest) Equals(other *GetServerInfoRequest) bool {
+	if p == other {
+		return true
+	} else if p == nil || other == nil {
+		return false
+	}
+	return true
+}
+
+func (p *GetServerInfoRequest) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("GetServerInfoRequest(%+v)", *p)
+}
+
+// Attributes:
+//   - Version
+//   - Reason
+type UnableToGetOriginalFirmware struct {
+	Version string `thrift:"Version,1" db:"Version" json:"Version"`
+	Reason  string `thrift:"Reason,2" db:"Reason" json:"Reason"`
+}
+
+func NewUnableToGetOriginalFirmware() *UnableToGetOriginalFirmware {
+	return &UnableToGetOriginalFirmware{}
 }
 
 func (p *UnableToGetOriginalFirmware) GetVersion() string {
@@ -2162,25 +3045,19 @@ func (IncorrectHostConfiguration) TExceptionType() thrift.TExceptionType {
 var _ thrift.TException = (*IncorrectHostConfiguration)(nil)
 
 // Attributes:
-//   - OrFilters
-//   - FetchContent
-type SearchFirmwareRequest struct {
-	OrFilters    []*SearchFirmwareFilters `thrift:"OrFilters,1" db:"OrFilters" json:"OrFilters"`
-	FetchContent bool                     `thrift:"FetchContent,2" db:"FetchContent" json:"FetchContent"`
-}
-
-func NewSearchFirmwareRequest() *SearchFirmwareRequest {
-	return &SearchFirmwareRequest{}
+//   - Reason
+type PermissionDenied struct {
+	Reason string `thrift:"Reason,1" db:"Reason" json:"Reason"`
 }
 
-func (p *SearchFirmwareRequest) GetOrFilters() []*SearchFirmwareFilters {
-	return p.OrFilters
+func NewPermissionDenied() *PermissionDenied {
+	return &PermissionDenied{}
 }
 
-func (p *SearchFirmwareRequest) GetFetchContent() bool {
-	return p.FetchContent
+func (p *PermissionDenied) GetReason() string {
+	return p.Reason
 }
-func (p *SearchFirmwareRequest) Read(ctx context.Context, iprot thrift.TProtocol) error {
+func (p *PermissionDenied) Read(ctx context.Context, iprot thrift.TProtocol) error {
 	if _, err := iprot.ReadStructBegin(ctx); err != nil {
 		return thrift.PrependError(fmt.Sprintf("%T read error: ", p), err)
 	}
@@ -2195,7 +3072,7 @@ func (p *SearchFirmwareRequest) Read(ctx context.Context, iprot thrift.TProtocol
 		}
 		switch fieldId {
 		case 1:
-			if fieldTypeId == thrift.LIST {
+			if fieldTypeId == thrift.STRING {
 				if err := p.ReadField1(ctx, iprot); err != nil {
 					return err
 				}
@@ -2204,16 +3081,6 @@ func (p *SearchFirmwareRequest) Read(ctx context.Context, iprot thrift.TProtocol
 					return err
 				}
 			}
-		case 2:
-			if fieldTypeId == thrift.BOOL {
-				if err := p.ReadField2(ctx, iprot); err != nil {
-					return err
-				}
-			} else {
-				if err := iprot.Skip(ctx, fieldTypeId); err != nil {
-					return err
-				}
-			}
 		default:
 			if err := iprot.Skip(ctx, fieldTypeId); err != nil {
 				return err
@@ -2229,46 +3096,23 @@ func (p *SearchFirmwareRequest) Read(ctx context.Context, iprot thrift.TProtocol
 	return nil
 }
 
-func (p *SearchFirmwareRequest) ReadField1(ctx context.Context, iprot thrift.TProtocol) error {
-	_, size, err := iprot.ReadListBegin(ctx)
-	if err != nil {
-		return thrift.PrependError("error reading list begin: ", err)
-	}
-	tSlice := make([]*SearchFirmwareFilters, 0, size)
-	p.OrFilters = tSlice
-	for i := 0; i < size; i++ {
-		_elem0 := &SearchFirmwareFilters{}
-		if err := _elem0.Read(ctx, iprot); err != nil {
-			return thrift.PrependError(fmt.Sprintf("%T error reading struct: ", _elem0), err)
-		}
-		p.OrFilters = append(p.OrFilters, _elem0)
-	}
-	if err := iprot.ReadListEnd(ctx); err != nil {
-		return thrift.PrependError("error reading list end: ", err)
-	}
-	return nil
-}
-
-func (p *SearchFirmwareRequest) ReadField2(ctx context.Context, iprot thrift.TProtocol) error {
-	if v, err := iprot.ReadBool(ctx); err != nil {
-		return thrift.PrependError("error reading field 2: ", err)
+func (p *PermissionDenied) ReadField1(ctx context.Context, iprot thrift.TProtocol) error {
+	if v, err := iprot.ReadString(ctx); err != nil {
+		return thrift.PrependError("error reading field 1: ", err)
 	} else {
-		p.FetchContent = v
+		p.Reason = v
 	}
 	return nil
 }
 
-func (p *SearchFirmwareRequest) Write(ctx context.Context, oprot thrift.TProtocol) error {
-	if err := oprot.WriteStructBegin(ctx, "SearchFirmwareRequest"); err != nil {
+func (p *PermissionDenied) Write(ctx context.Context, oprot thrift.TProtocol) error {
+	if err := oprot.WriteStructBegin(ctx, "PermissionDenied"); err != nil {
 		return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
 	}
 	if p != nil {
 		if err := p.writeField1(ctx, oprot); err != nil {
 			return err
 		}
-		if err := p.writeField2(ctx, oprot); err != nil {
-			return err
-		}
 	}
 	if err := oprot.WriteFieldStop(ctx); err != nil {
 		return thrift.PrependError("write field stop error: ", err)
@@ -2279,154 +3123,189 @@ func (p *SearchFirmwareRequest) Write(ctx context.Context, oprot thrift.TProtoco
 	return nil
 }
 
-func (p *SearchFirmwareRequest) writeField1(ctx context.Context, oprot thrift.TProtocol) (err error) {
-	if err := oprot.WriteFieldBegin(ctx, "OrFilters", thrift.LIST, 1); err != nil {
-		return thrift.PrependError(fmt.Sprintf("%T write field begin error 1:OrFilters: ", p), err)
-	}
-	if err := oprot.WriteListBegin(ctx, thrift.STRUCT, len(p.OrFilters)); err != nil {
-		return thrift.PrependError("error writing list begin: ", err)
+func (p *PermissionDenied) writeField1(ctx context.Context, oprot thrift.TProtocol) (err error) {
+	if err := oprot.WriteFieldBegin(ctx, "Reason", thrift.STRING, 1); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T write field begin error 1:Reason: ", p), err)
 	}
-	for _, v := range p.OrFilters {
-		if err := v.Write(ctx, oprot); err != nil {
-			return thrift.PrependError(fmt.Sprintf("%T error writing struct: ", v), err)
-		}
-	}
-	if err := oprot.WriteListEnd(ctx); err != nil {
-		return thrift.PrependError("error writing list end: ", err)
-	}
-	if err := oprot.WriteFieldEnd(ctx); err != nil {
-		return thrift.PrependError(fmt.Sprintf("%T write field end error 1:OrFilters: ", p), err)
-	}
-	return err
-}
-
-func (p *SearchFirmwareRequest) writeField2(ctx context.Context, oprot thrift.TProtocol) (err error) {
-	if err := oprot.WriteFieldBegin(ctx, "FetchContent", thrift.BOOL, 2); err != nil {
-		return thrift.PrependError(fmt.Sprintf("%T write field begin error 2:FetchContent: ", p), err)
-	}
-	if err := oprot.WriteBool(ctx, bool(p.FetchContent)); err != nil {
-		return thrift.PrependError(fmt.Sprintf("%T.FetchContent (2) field write error: ", p), err)
+	if err := oprot.WriteString(ctx, string(p.Reason)); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T.Reason (1) field write error: ", p), err)
 	}
 	if err := oprot.WriteFieldEnd(ctx); err != nil {
-		return thrift.PrependError(fmt.Sprintf("%T write field end error 2:FetchContent: ", p), err)
+		return thrift.PrependError(fmt.Sprintf("%T write field end error 1:Reason: ", p), err)
 	}
 	return err
 }
 
-func (p *SearchFirmwareRequest) Equals(other *SearchFirmwareRequest) bool {
+func (p *PermissionDenied) Equals(other *PermissionDenied) bool {
 	if p == other {
 		return true
 	} else if p == nil || other == nil {
 		return false
 	}
-	if len(p.OrFilters) != len(other.OrFilters) {
-		return false
-	}
-	for i, _tgt := range p.OrFilters {
-		_src1 := other.OrFilters[i]
-		if !_tgt.Equals(_src1) {
-			return false
-		}
-	}
-	if p.FetchContent != other.FetchContent {
+	if p.Reason != other.Reason {
 		return false
 	}
 	return true
 }
 
-func (p *SearchFirmwareRequest) String() string {
+func (p *PermissionDenied) String() string {
 	if p == nil {
 		return "<nil>"
 	}
-	return fmt.Sprintf("SearchFirmwareRequest(%+v)", *p)
+	return fmt.Sprintf("PermissionDenied(%+v)", *p)
 }
 
-// Attributes:
-//   - ImageID
-//   - HashSHA2_512
-//   - HashBlake3_512
-//   - HashStable
-//   - Filename
-//   - Version
-type SearchFirmwareFilters struct {
-	ImageID        []byte  `thrift:"ImageID,1" db:"ImageID" json:"ImageID,omitempty"`
-	HashSHA2_512   []byte  `thrift:"HashSHA2_512,2" db:"HashSHA2_512" json:"HashSHA2_512,omitempty"`
-	HashBlake3_512 []byte  `thrift:"HashBlake3_512,3" db:"HashBlake3_512" json:"HashBlake3_512,omitempty"`
-	HashStable     []byte  `thrift:"HashStable,4" db:"HashStable" json:"HashStable,omitempty"`
-	Filename       *string `thrift:"Filename,5" db:"Filename" json:"Filename,omitempty"`
-	Version        *string `thrift:"Version,6" db:"Version" json:"Version,omitempty"`
+func (p *PermissionDenied) Error() string {
+	return p.String()
 }
 
-func NewSearchFirmwareFilters() *SearchFirmwareFilters {
-	return &SearchFirmwareFilters{}
+func (PermissionDenied) TExceptionType() thrift.TExceptionType {
+	return thrift.TExceptionTypeCompiled
 }
 
-var SearchFirmwareFilters_ImageID_DEFAULT []byte
+var _ thrift.TException = (*PermissionDenied)(nil)
 
-func (p *SearchFirmwareFilters) GetImageID() []byte {
-	return p.ImageID
+// Attributes:
+//   - Reason
+type InvalidRequest struct {
+	Reason string `thrift:"Reason,1" db:"Reason" json:"Reason"`
 }
 
-var SearchFirmwareFilters_HashSHA2_512_DEFAULT []byte
-
-func (p *SearchFirmwareFilters) GetHashSHA2_512() []byte {
-	return p.HashSHA2_512
+func NewInvalidRequest() *InvalidRequest {
+	return &InvalidRequest{}
 }
 
-var SearchFirmwareFilters_HashBlake3_512_DEFAULT []byte
-
-func (p *SearchFirmwareFilters) GetHashBlake3_512() []byte {
-	return p.HashBlake3_512
+func (p *InvalidRequest) GetReason() string {
+	return p.Reason
 }
+func (p *InvalidRequest) Read(ctx context.Context, iprot thrift.TProtocol) error {
+	if _, err := iprot.ReadStructBegin(ctx); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T read error: ", p), err)
+	}
 
-var SearchFirmwareFilters_HashStable_DEFAULT []byte
-
-func (p *SearchFirmwareFilters) GetHashStable() []byte {
-	return p.HashStable
+	for {
+		_, fieldTypeId, fieldId, err := iprot.ReadFieldBegin(ctx)
+		if err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T field %d read error: ", p, fieldId), err)
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+		switch fieldId {
+		case 1:
+			if fieldTypeId == thrift.STRING {
+				if err := p.ReadField1(ctx, iprot); err != nil {
+					return err
+				}
+			} else {
+				if err := iprot.Skip(ctx, fieldTypeId); err != nil {
+					return err
+				}
+			}
+		default:
+			if err := iprot.Skip(ctx, fieldTypeId); err != nil {
+				return err
+			}
+		}
+		if err := iprot.ReadFieldEnd(ctx); err != nil {
+			return err
+		}
+	}
+	if err := iprot.ReadStructEnd(ctx); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
+	}
+	return nil
 }
 
-var SearchFirmwareFilters_Filename_DEFAULT string
+func (p *InvalidRequest) ReadField1(ctx context.Context, iprot thrift.TProtocol) error {
+	if v, err := iprot.ReadString(ctx); err != nil {
+		return thrift.PrependError("error reading field 1: ", err)
+	} else {
+		p.Reason = v
+	}
+	return nil
+}
 
-func (p *SearchFirmwareFilters) GetFilename() string {
-	if !p.IsSetFilename() {
-		return SearchFirmwareFilters_Filename_DEFAULT
+func (p *InvalidRequest) Write(ctx context.Context, oprot thrift.TProtocol) error {
+	if err := oprot.WriteStructBegin(ctx, "InvalidRequest"); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
 	}
-	return *p.Filename
+	if p != nil {
+		if err := p.writeField1(ctx, oprot); err != nil {
+			return err
+		}
+	}
+	if err := oprot.WriteFieldStop(ctx); err != nil {
+		return thrift.PrependError("write field stop error: ", err)
+	}
+	if err := oprot.WriteStructEnd(ctx); err != nil {
+		return thrift.PrependError("write struct stop error: ", err)
+	}
+	return nil
 }
 
-var SearchFirmwareFilters_Version_DEFAULT string
+func (p *InvalidRequest) writeField1(ctx context.Context, oprot thrift.TProtocol) (err error) {
+	if err := oprot.WriteFieldBegin(ctx, "Reason", thrift.STRING, 1); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T write field begin error 1:Reason: ", p), err)
+	}
+	if err := oprot.WriteString(ctx, string(p.Reason)); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T.Reason (1) field write error: ", p), err)
+	}
+	if err := oprot.WriteFieldEnd(ctx); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T write field end error 1:Reason: ", p), err)
+	}
+	return err
+}
 
-func (p *SearchFirmwareFilters) GetVersion() string {
-	if !p.IsSetVersion() {
-		return SearchFirmwareFilters_Version_DEFAULT
+func (p *InvalidRequest) Equals(other *InvalidRequest) bool {
+	if p == other {
+		return true
+	} else if p == nil || other == nil {
+		return false
 	}
-	return *p.Version
+	if p.Reason != other.Reason {
+		return false
+	}
+	return true
 }
-func (p *SearchFirmwareFilters) IsSetImageID() bool {
-	return p.ImageID != nil
+
+func (p *InvalidRequest) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("InvalidRequest(%+v)", *p)
 }
 
-func (p *SearchFirmwareFilters) IsSetHashSHA2_512() bool {
-	return p.HashSHA2_512 != nil
+func (p *InvalidRequest) Error() string {
+	return p.String()
 }
 
-func (p *SearchFirmwareFilters) IsSetHashBlake3_512() bool {
-	return p.HashBlake3_512 != nil
+func (InvalidRequest) TExceptionType() thrift.TExceptionType {
+	return thrift.TExceptionTypeCompiled
 }
 
-func (p *SearchFirmwareFilters) IsSetHashStable() bool {
-	return p.HashStable != nil
+var _ thrift.TException = (*InvalidRequest)(nil)
+
+// Attributes:
+//   - Reason
+//   - RetryAfterMilliseconds
+type Overloaded struct {
+	Reason                 string `thrift:"Reason,1" db:"Reason" json:"Reason"`
+	RetryAfterMilliseconds int64  `thrift:"RetryAfterMilliseconds,2" db:"RetryAfterMilliseconds" json:"RetryAfterMilliseconds"`
 }
 
-func (p *SearchFirmwareFilters) IsSetFilename() bool {
-	return p.Filename != nil
+func NewOverloaded() *Overloaded {
+	return &Overloaded{}
 }
 
-func (p *SearchFirmwareFilters) IsSetVersion() bool {
-	return p.Version != nil
+func (p *Overloaded) GetReason() string {
+	return p.Reason
 }
 
-func (p *SearchFirmwareFilters) Read(ctx context.Context, iprot thrift.TProtocol) error {
+func (p *Overloaded) GetRetryAfterMilliseconds() int64 {
+	return p.RetryAfterMilliseconds
+}
+func (p *Overloaded) Read(ctx context.Context, iprot thrift.TProtocol) error {
 	if _, err := iprot.ReadStructBegin(ctx); err != nil {
 		return thrift.PrependError(fmt.Sprintf("%T read error: ", p), err)
 	}
@@ -2451,7 +3330,7 @@ func (p *SearchFirmwareFilters) Read(ctx context.Context, iprot thrift.TProtocol
 				}
 			}
 		case 2:
-			if fieldTypeId == thrift.STRING {
+			if fieldTypeId == thrift.I64 {
 				if err := p.ReadField2(ctx, iprot); err != nil {
 					return err
 				}
@@ -2460,46 +3339,6 @@ func (p *SearchFirmwareFilters) Read(ctx context.Context, iprot thrift.TProtocol
 					return err
 				}
 			}
-		case 3:
-			if fieldTypeId == thrift.STRING {
-				if err := p.ReadField3(ctx, iprot); err != nil {
-					return err
-				}
-			} else {
-				if err := iprot.Skip(ctx, fieldTypeId); err != nil {
-					return err
-				}
-			}
-		case 4:
-			if fieldTypeId == thrift.STRING {
-				if err := p.ReadField4(ctx, iprot); err != nil {
-					return err
-				}
-			} else {
-				if err := iprot.Skip(ctx, fieldTypeId); err != nil {
-					return err
-				}
-			}
-		case 5:
-			if fieldTypeId == thrift.STRING {
-				if err := p.ReadField5(ctx, iprot); err != nil {
-					return err
-				}
-			} else {
-				if err := iprot.Skip(ctx, fieldTypeId); err != nil {
-					return err
-				}
-			}
-		case 6:
-			if fieldTypeId == thrift.STRING {
-				if err := p.ReadField6(ctx, iprot); err != nil {
-					return err
-				}
-			} else {
-				if err := iprot.Skip(ctx, fieldTypeId); err != nil {
-					return err
-				}
-			}
 		default:
 			if err := iprot.Skip(ctx, fieldTypeId); err != nil {
 				return err
@@ -2515,241 +3354,123 @@ func (p *SearchFirmwareFilters) Read(ctx context.Context, iprot thrift.TProtocol
 	return nil
 }
 
-func (p *SearchFirmwareFilters) ReadField1(ctx context.Context, iprot thrift.TProtocol) error {
-	if v, err := iprot.ReadBinary(ctx); err != nil {
+func (p *Overloaded) ReadField1(ctx context.Context, iprot thrift.TProtocol) error {
+	if v, err := iprot.ReadString(ctx); err != nil {
 		return thrift.PrependError("error reading field 1: ", err)
 	} else {
-		p.ImageID = v
+		p.Reason = v
 	}
 	return nil
 }
 
-func (p *SearchFirmwareFilters) ReadField2(ctx context.Context, iprot thrift.TProtocol) error {
-	if v, err := iprot.ReadBinary(ctx); err != nil {
+func (p *Overloaded) ReadField2(ctx context.Context, iprot thrift.TProtocol) error {
+	if v, err := iprot.ReadI64(ctx); err != nil {
 		return thrift.PrependError("error reading field 2: ", err)
 	} else {
-		p.HashSHA2_512 = v
+		p.RetryAfterMilliseconds = v
 	}
 	return nil
 }
 
-func (p *SearchFirmwareFilters) ReadField3(ctx context.Context, iprot thrift.TProtocol) error {
-	if v, err := iprot.ReadBinary(ctx); err != nil {
-		return thrift.PrependError("error reading field 3: ", err)
-	} else {
-		p.HashBlake3_512 = v
+func (p *Overloaded) Write(ctx context.Context, oprot thrift.TProtocol) error {
+	if err := oprot.WriteStructBegin(ctx, "Overloaded"); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
+	}
+	if p != nil {
+		if err := p.writeField1(ctx, oprot); err != nil {
+			return err
+		}
+		if err := p.writeField2(ctx, oprot); err != nil {
+			return err
+		}
+	}
+	if err := oprot.WriteFieldStop(ctx); err != nil {
+		return thrift.PrependError("write field stop error: ", err)
+	}
+	if err := oprot.WriteStructEnd(ctx); err != nil {
+		return thrift.PrependError("write struct stop error: ", err)
 	}
 	return nil
 }
 
-func (p *SearchFirmwareFilters) ReadField4(ctx context.Context, iprot thrift.TProtocol) error {
-	if v, err := iprot.ReadBinary(ctx); err != nil {
-		return thrift.PrependError("error reading field 4: ", err)
-	} else {
-		p.HashStable = v
-	}
-	return nil
-}
-
-func (p *SearchFirmwareFilters) ReadField5(ctx context.Context, iprot thrift.TProtocol) error {
-	if v, err := iprot.ReadString(ctx); err != nil {
-		return thrift.PrependError("error reading field 5: ", err)
-	} else {
-		p.Filename = &v
-	}
-	return nil
-}
-
-func (p *SearchFirmwareFilters) ReadField6(ctx context.Context, iprot thrift.TProtocol) error {
-	if v, err := iprot.ReadString(ctx); err != nil {
-		return thrift.PrependError("error reading field 6: ", err)
-	} else {
-		p.Version = &v
-	}
-	return nil
-}
-
-func (p *SearchFirmwareFilters) Write(ctx context.Context, oprot thrift.TProtocol) error {
-	if err := oprot.WriteStructBegin(ctx, "SearchFirmwareFilters"); err != nil {
-		return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
-	}
-	if p != nil {
-		if err := p.writeField1(ctx, oprot); err != nil {
-			return err
-		}
-		if err := p.writeField2(ctx, oprot); err != nil {
-			return err
-		}
-		if err := p.writeField3(ctx, oprot); err != nil {
-			return err
-		}
-		if err := p.writeField4(ctx, oprot); err != nil {
-			return err
-		}
-		if err := p.writeField5(ctx, oprot); err != nil {
-			return err
-		}
-		if err := p.writeField6(ctx, oprot); err != nil {
-			return err
-		}
-	}
-	if err := oprot.WriteFieldStop(ctx); err != nil {
-		return thrift.PrependError("write field stop error: ", err)
-	}
-	if err := oprot.WriteStructEnd(ctx); err != nil {
-		return thrift.PrependError("write struct stop error: ", err)
-	}
-	return nil
-}
-
-func (p *SearchFirmwareFilters) writeField1(ctx context.Context, oprot thrift.TProtocol) (err error) {
-	if p.IsSetImageID() {
-		if err := oprot.WriteFieldBegin(ctx, "ImageID", thrift.STRING, 1); err != nil {
-			return thrift.PrependError(fmt.Sprintf("%T write field begin error 1:ImageID: ", p), err)
-		}
-		if err := oprot.WriteBinary(ctx, p.ImageID); err != nil {
-			return thrift.PrependError(fmt.Sprintf("%T.ImageID (1) field write error: ", p), err)
-		}
-		if err := oprot.WriteFieldEnd(ctx); err != nil {
-			return thrift.PrependError(fmt.Sprintf("%T write field end error 1:ImageID: ", p), err)
-		}
+func (p *Overloaded) writeField1(ctx context.Context, oprot thrift.TProtocol) (err error) {
+	if err := oprot.WriteFieldBegin(ctx, "Reason", thrift.STRING, 1); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T write field begin error 1:Reason: ", p), err)
 	}
-	return err
-}
-
-func (p *SearchFirmwareFilters) writeField2(ctx context.Context, oprot thrift.TProtocol) (err error) {
-	if p.IsSetHashSHA2_512() {
-		if err := oprot.WriteFieldBegin(ctx, "HashSHA2_512", thrift.STRING, 2); err != nil {
-			return thrift.PrependError(fmt.Sprintf("%T write field begin error 2:HashSHA2_512: ", p), err)
-		}
-		if err := oprot.WriteBinary(ctx, p.HashSHA2_512); err != nil {
-			return thrift.PrependError(fmt.Sprintf("%T.HashSHA2_512 (2) field write error: ", p), err)
-		}
-		if err := oprot.WriteFieldEnd(ctx); err != nil {
-			return thrift.PrependError(fmt.Sprintf("%T write field end error 2:HashSHA2_512: ", p), err)
-		}
+	if err := oprot.WriteString(ctx, string(p.Reason)); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T.Reason (1) field write error: ", p), err)
 	}
-	return err
-}
-
-func (p *SearchFirmwareFilters) writeField3(ctx context.Context, oprot thrift.TProtocol) (err error) {
-	if p.IsSetHashBlake3_512() {
-		if err := oprot.WriteFieldBegin(ctx, "HashBlake3_512", thrift.STRING, 3); err != nil {
-			return thrift.PrependError(fmt.Sprintf("%T write field begin error 3:HashBlake3_512: ", p), err)
-		}
-		if err := oprot.WriteBinary(ctx, p.HashBlake3_512); err != nil {
-			return thrift.PrependError(fmt.Sprintf("%T.HashBlake3_512 (3) field write error: ", p), err)
-		}
-		if err := oprot.WriteFieldEnd(ctx); err != nil {
-			return thrift.PrependError(fmt.Sprintf("%T write field end error 3:HashBlake3_512: ", p), err)
-		}
+	if err := oprot.WriteFieldEnd(ctx); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T write field end error 1:Reason: ", p), err)
 	}
 	return err
 }
 
-func (p *SearchFirmwareFilters) writeField4(ctx context.Context, oprot thrift.TProtocol) (err error) {
-	if p.IsSetHashStable() {
-		if err := oprot.WriteFieldBegin(ctx, "HashStable", thrift.STRING, 4); err != nil {
-			return thrift.PrependError(fmt.Sprintf("%T write field begin error 4:HashStable: ", p), err)
-		}
-		if err := oprot.WriteBinary(ctx, p.HashStable); err != nil {
-			return thrift.PrependError(fmt.Sprintf("%T.HashStable (4) field write error: ", p), err)
-		}
-		if err := oprot.WriteFieldEnd(ctx); err != nil {
-			return thrift.PrependError(fmt.Sprintf("%T write field end error 4:HashStable: ", p), err)
-		}
+func (p *Overloaded) writeField2(ctx context.Context, oprot thrift.TProtocol) (err error) {
+	if err := oprot.WriteFieldBegin(ctx, "RetryAfterMilliseconds", thrift.I64, 2); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T write field begin error 2:RetryAfterMilliseconds: ", p), err)
 	}
-	return err
-}
-
-func (p *SearchFirmwareFilters) writeField5(ctx context.Context, oprot thrift.TProtocol) (err error) {
-	if p.IsSetFilename() {
-		if err := oprot.WriteFieldBegin(ctx, "Filename", thrift.STRING, 5); err != nil {
-			return thrift.PrependError(fmt.Sprintf("%T write field begin error 5:Filename: ", p), err)
-		}
-		if err := oprot.WriteString(ctx, string(*p.Filename)); err != nil {
-			return thrift.PrependError(fmt.Sprintf("%T.Filename (5) field write error: ", p), err)
-		}
-		if err := oprot.WriteFieldEnd(ctx); err != nil {
-			return thrift.PrependError(fmt.Sprintf("%T write field end error 5:Filename: ", p), err)
-		}
+	if err := oprot.WriteI64(ctx, int64(p.RetryAfterMilliseconds)); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T.RetryAfterMilliseconds (2) field write error: ", p), err)
 	}
-	return err
-}
-
-func (p *SearchFirmwareFilters) writeField6(ctx context.Context, oprot thrift.TProtocol) (err error) {
-	if p.IsSetVersion() {
-		if err := oprot.WriteFieldBegin(ctx, "Version", thrift.STRING, 6); err != nil {
-			return thrift.PrependError(fmt.Sprintf("%T write field begin error 6:Version: ", p), err)
-		}
-		if err := oprot.WriteString(ctx, string(*p.Version)); err != nil {
-			return thrift.PrependError(fmt.Sprintf("%T.Version (6) field write error: ", p), err)
-		}
-		if err := oprot.WriteFieldEnd(ctx); err != nil {
-			return thrift.PrependError(fmt.Sprintf("%T write field end error 6:Version: ", p), err)
-		}
+	if err := oprot.WriteFieldEnd(ctx); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T write field end error 2:RetryAfterMilliseconds: ", p), err)
 	}
 	return err
 }
 
-func (p *SearchFirmwareFilters) Equals(other *SearchFirmwareFilters) bool {
+func (p *Overloaded) Equals(other *Overloaded) bool {
 	if p == other {
 		return true
 	} else if p == nil || other == nil {
 		return false
 	}
-	if bytes.Compare(p.ImageID, other.ImageID) != 0 {
-		return false
-	}
-	if bytes.Compare(p.HashSHA2_512, other.HashSHA2_512) != 0 {
-		return false
-	}
-	if bytes.Compare(p.HashBlake3_512, other.HashBlake3_512) != 0 {
+	if p.Reason != other.Reason {
 		return false
 	}
-	if bytes.Compare(p.HashStable, other.HashStable) != 0 {
+	if p.RetryAfterMilliseconds != other.RetryAfterMilliseconds {
 		return false
 	}
-	if p.Filename != other.Filename {
-		if p.Filename == nil || other.Filename == nil {
-			return false
-		}
-		if (*p.Filename) != (*other.Filename) {
-			return false
-		}
-	}
-	if p.Version != other.Version {
-		if p.Version == nil || other.Version == nil {
-			return false
-		}
-		if (*p.Version) != (*other.Version) {
-			return false
-		}
-	}
 	return true
 }
 
-func (p *SearchFirmwareFilters) String() string {
+func (p *Overloaded) String() string {
 	if p == nil {
 		return "<nil>"
 	}
-	return fmt.Sprintf("SearchFirmwareFilters(%+v)", *p)
+	return fmt.Sprintf("Overloaded(%+v)", *p)
+}
+
+func (p *Overloaded) Error() string {
+	return p.String()
 }
 
+func (Overloaded) TExceptionType() thrift.TExceptionType {
+	return thrift.TExceptionTypeCompiled
+}
+
+var _ thrift.TException = (*Overloaded)(nil)
+
 // Attributes:
-//   - Found
-type SearchFirmwareResult_ struct {
-	Found []*Firmware `thrift:"Found,1" db:"Found" json:"Found"`
+//   - OrFilters
+//   - FetchContent
+type SearchFirmwareRequest struct {
+	OrFilters    []*SearchFirmwareFilters `thrift:"OrFilters,1" db:"OrFilters" json:"OrFilters"`
+	FetchContent bool                     `thrift:"FetchContent,2" db:"FetchContent" json:"FetchContent"`
 }
 
-func NewSearchFirmwareResult_() *SearchFirmwareResult_ {
-	return &SearchFirmwareResult_{}
+func NewSearchFirmwareRequest() *SearchFirmwareRequest {
+	return &SearchFirmwareRequest{}
 }
 
-func (p *SearchFirmwareResult_) GetFound() []*Firmware {
-	return p.Found
+func (p *SearchFirmwareRequest) GetOrFilters() []*SearchFirmwareFilters {
+	return p.OrFilters
 }
-func (p *SearchFirmwareResult_) Read(ctx context.Context, iprot thrift.TProtocol) error {
+
+func (p *SearchFirmwareRequest) GetFetchContent() bool {
+	return p.FetchContent
+}
+func (p *SearchFirmwareRequest) Read(ctx context.Context, iprot thrift.TProtocol) error {
 	if _, err := iprot.ReadStructBegin(ctx); err != nil {
 		return thrift.PrependError(fmt.Sprintf("%T read error: ", p), err)
 	}
@@ -2773,6 +3494,16 @@ func (p *SearchFirmwareResult_) Read(ctx context.Context, iprot thrift.TProtocol
 					return err
 				}
 			}
+		case 2:
+			if fieldTypeId == thrift.BOOL {
+				if err := p.ReadField2(ctx, iprot); err != nil {
+					return err
+				}
+			} else {
+				if err := iprot.Skip(ctx, fieldTypeId); err != nil {
+					return err
+				}
+			}
 		default:
 			if err := iprot.Skip(ctx, fieldTypeId); err != nil {
 				return err
@@ -2788,19 +3519,19 @@ func (p *SearchFirmwareResult_) Read(ctx context.Context, iprot thrift.TProtocol
 	return nil
 }
 
-func (p *SearchFirmwareResult_) ReadField1(ctx context.Context, iprot thrift.TProtocol) error {
+func (p *SearchFirmwareRequest) ReadField1(ctx context.Context, iprot thrift.TProtocol) error {
 	_, size, err := iprot.ReadListBegin(ctx)
 	if err != nil {
 		return thrift.PrependError("error reading list begin: ", err)
 	}
-	tSlice := make([]*Firmware, 0, size)
-	p.Found = tSlice
+	tSlice := make([]*SearchFirmwareFilters, 0, size)
+	p.OrFilters = tSlice
 	for i := 0; i < size; i++ {
-		_elem2 := &Firmware{}
-		if err := _elem2.Read(ctx, iprot); err != nil {
-			return thrift.PrependError(fmt.Sprintf("%T error reading struct: ", _elem2), err)
+		_elem4 := &SearchFirmwareFilters{}
+		if err := _elem4.Read(ctx, iprot); err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T error reading struct: ", _elem4), err)
 		}
-		p.Found = append(p.Found, _elem2)
+		p.OrFilters = append(p.OrFilters, _elem4)
 	}
 	if err := iprot.ReadListEnd(ctx); err != nil {
 		return thrift.PrependError("error reading list end: ", err)
@@ -2808,14 +3539,26 @@ func (p *SearchFirmwareResult_) ReadField1(ctx context.Context, iprot thrift.TPr
 	return nil
 }
 
-func (p *SearchFirmwareResult_) Write(ctx context.Context, oprot thrift.TProtocol) error {
-	if err := oprot.WriteStructBegin(ctx, "SearchFirmwareResult"); err != nil {
+func (p *SearchFirmwareRequest) ReadField2(ctx context.Context, iprot thrift.TProtocol) error {
+	if v, err := iprot.ReadBool(ctx); err != nil {
+		return thrift.PrependError("error reading field 2: ", err)
+	} else {
+		p.FetchContent = v
+	}
+	return nil
+}
+
+func (p *SearchFirmwareRequest) Write(ctx context.Context, oprot thrift.TProtocol) error {
+	if err := oprot.WriteStructBegin(ctx, "SearchFirmwareRequest"); err != nil {
 		return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
 	}
 	if p != nil {
 		if err := p.writeField1(ctx, oprot); err != nil {
 			return err
 		}
+		if err := p.writeField2(ctx, oprot); err != nil {
+			return err
+		}
 	}
 	if err := oprot.WriteFieldStop(ctx); err != nil {
 		return thrift.PrependError("write field stop error: ", err)
@@ -2826,14 +3569,14 @@ func (p *SearchFirmwareResult_) Write(ctx context.Context, oprot thrift.TProtoco
 	return nil
 }
 
-func (p *SearchFirmwareResult_) writeField1(ctx context.Context, oprot thrift.TProtocol) (err error) {
-	if err := oprot.WriteFieldBegin(ctx, "Found", thrift.LIST, 1); err != nil {
-		return thrift.PrependError(fmt.Sprintf("%T write field begin error 1:Found: ", p), err)
+func (p *SearchFirmwareRequest) writeField1(ctx context.Context, oprot thrift.TProtocol) (err error) {
+	if err := oprot.WriteFieldBegin(ctx, "OrFilters", thrift.LIST, 1); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T write field begin error 1:OrFilters: ", p), err)
 	}
-	if err := oprot.WriteListBegin(ctx, thrift.STRUCT, len(p.Found)); err != nil {
+	if err := oprot.WriteListBegin(ctx, thrift.STRUCT, len(p.OrFilters)); err != nil {
 		return thrift.PrependError("error writing list begin: ", err)
 	}
-	for _, v := range p.Found {
+	for _, v := range p.OrFilters {
 		if err := v.Write(ctx, oprot); err != nil {
 			return thrift.PrependError(fmt.Sprintf("%T error writing struct: ", v), err)
 		}
@@ -2842,205 +3585,50 @@ func (p *SearchFirmwareResult_) writeField1(ctx context.Context, oprot thrift.TP
 		return thrift.PrependError("error writing list end: ", err)
 	}
 	if err := oprot.WriteFieldEnd(ctx); err != nil {
-		return thrift.PrependError(fmt.Sprintf("%T write field end error 1:Found: ", p), err)
+		return thrift.PrependError(fmt.Sprintf("%T write field end error 1:OrFilters: ", p), err)
 	}
 	return err
 }
 
-func (p *SearchFirmwareResult_) Equals(other *SearchFirmwareResult_) bool {
-	if p == other {
-		return true
-	} else if p == nil || other == nil {
-		return false
-	}
-	if len(p.Found) != len(other.Found) {
-		return false
+func (p *SearchFirmwareRequest) writeField2(ctx context.Context, oprot thrift.TProtocol) (err error) {
+	if err := oprot.WriteFieldBegin(ctx, "FetchContent", thrift.BOOL, 2); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T write field begin error 2:FetchContent: ", p), err)
 	}
-	for i, _tgt := range p.Found {
-		_src3 := other.Found[i]
-		if !_tgt.Equals(_src3) {
-			return false
-		}
-	}
-	return true
-}
-
-func (p *SearchFirmwareResult_) String() string {
-	if p == nil {
-		return "<nil>"
-	}
-	return fmt.Sprintf("SearchFirmwareResult_(%+v)", *p)
-}
-
-// Attributes:
-//   - Metadata
-//   - Data
-type Firmware struct {
-	Metadata *FirmwareImageMetadata `thrift:"Metadata,1" db:"Metadata" json:"Metadata"`
-	Data     []byte                 `thrift:"Data,2" db:"Data" json:"Data,omitempty"`
-}
-
-func NewFirmware() *Firmware {
-	return &Firmware{}
-}
-
-var Firmware_Metadata_DEFAULT *FirmwareImageMetadata
-
-func (p *Firmware) GetMetadata() *FirmwareImageMetadata {
-	if !p.IsSetMetadata() {
-		return Firmware_Metadata_DEFAULT
-	}
-	return p.Metadata
-}
-
-var Firmware_Data_DEFAULT []byte
-
-func (p *Firmware) GetData() []byte {
-	return p.Data
-}
-func (p *Firmware) IsSetMetadata() bool {
-	return p.Metadata != nil
-}
-
-func (p *Firmware) IsSetData() bool {
-	return p.Data != nil
-}
-
-func (p *Firmware) Read(ctx context.Context, iprot thrift.TProtocol) error {
-	if _, err := iprot.ReadStructBegin(ctx); err != nil {
-		return thrift.PrependError(fmt.Sprintf("%T read error: ", p), err)
-	}
-
-	for {
-		_, fieldTypeId, fieldId, err := iprot.ReadFieldBegin(ctx)
-		if err != nil {
-			return thrift.PrependError(fmt.Sprintf("%T field %d read error: ", p, fieldId), err)
-		}
-		if fieldTypeId == thrift.STOP {
-			break
-		}
-		switch fieldId {
-		case 1:
-			if fieldTypeId == thrift.STRUCT {
-				if err := p.ReadField1(ctx, iprot); err != nil {
-					return err
-				}
-			} else {
-				if err := iprot.Skip(ctx, fieldTypeId); err != nil {
-					return err
-				}
-			}
-		case 2:
-			if fieldTypeId == thrift.STRING {
-				if err := p.ReadField2(ctx, iprot); err != nil {
-					return err
-				}
-			} else {
-				if err := iprot.Skip(ctx, fieldTypeId); err != nil {
-					return err
-				}
-			}
-		default:
-			if err := iprot.Skip(ctx, fieldTypeId); err != nil {
-				return err
-			}
-		}
-		if err := iprot.ReadFieldEnd(ctx); err != nil {
-			return err
-		}
-	}
-	if err := iprot.ReadStructEnd(ctx); err != nil {
-		return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
-	}
-	return nil
-}
-
-func (p *Firmware) ReadField1(ctx context.Context, iprot thrift.TProtocol) error {
-	p.Metadata = &FirmwareImageMetadata{}
-	if err := p.Metadata.Read(ctx, iprot); err != nil {
-		return thrift.PrependError(fmt.Sprintf("%T error reading struct: ", p.Metadata), err)
-	}
-	return nil
-}
-
-func (p *Firmware) ReadField2(ctx context.Context, iprot thrift.TProtocol) error {
-	if v, err := iprot.ReadBinary(ctx); err != nil {
-		return thrift.PrependError("error reading field 2: ", err)
-	} else {
-		p.Data = v
-	}
-	return nil
-}
-
-func (p *Firmware) Write(ctx context.Context, oprot thrift.TProtocol) error {
-	if err := oprot.WriteStructBegin(ctx, "Firmware"); err != nil {
-		return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
-	}
-	if p != nil {
-		if err := p.writeField1(ctx, oprot); err != nil {
-			return err
-		}
-		if err := p.writeField2(ctx, oprot); err != nil {
-			return err
-		}
-	}
-	if err := oprot.WriteFieldStop(ctx); err != nil {
-		return thrift.PrependError("write field stop error: ", err)
-	}
-	if err := oprot.WriteStructEnd(ctx); err != nil {
-		return thrift.PrependError("write struct stop error: ", err)
-	}
-	return nil
-}
-
-func (p *Firmware) writeField1(ctx context.Context, oprot thrift.TProtocol) (err error) {
-	if err := oprot.WriteFieldBegin(ctx, "Metadata", thrift.STRUCT, 1); err != nil {
-		return thrift.PrependError(fmt.Sprintf("%T write field begin error 1:Metadata: ", p), err)
-	}
-	if err := p.Metadata.Write(ctx, oprot); err != nil {
-		return thrift.PrependError(fmt.Sprintf("%T error writing struct: ", p.Metadata), err)
+	if err := oprot.WriteBool(ctx, bool(p.FetchContent)); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T.FetchContent (2) field write error: ", p), err)
 	}
 	if err := oprot.WriteFieldEnd(ctx); err != nil {
-		return thrift.PrependError(fmt.Sprintf("%T write field end error 1:Metadata: ", p), err)
-	}
-	return err
-}
-
-func (p *Firmware) writeField2(ctx context.Context, oprot thrift.TProtocol) (err error) {
-	if p.IsSetData() {
-		if err := oprot.WriteFieldBegin(ctx, "Data", thrift.STRING, 2); err != nil {
-			return thrift.PrependError(fmt.Sprintf("%T write field begin error 2:Data: ", p), err)
-		}
-		if err := oprot.WriteBinary(ctx, p.Data); err != nil {
-			return thrift.PrependError(fmt.Sprintf("%T.Data (2) field write error: ", p), err)
-		}
-		if err := oprot.WriteFieldEnd(ctx); err != nil {
-			return thrift.PrependError(fmt.Sprintf("%T write field end error 2:Data: ", p), err)
-		}
+		return thrift.PrependError(fmt.Sprintf("%T write field end error 2:FetchContent: ", p), err)
 	}
 	return err
 }
 
-func (p *Firmware) Equals(other *Firmware) bool {
+func (p *SearchFirmwareRequest) Equals(other *SearchFirmwareRequest) bool {
 	if p == other {
 		return true
 	} else if p == nil || other == nil {
 		return false
 	}
-	if !p.Metadata.Equals(other.Metadata) {
+	if len(p.OrFilters) != len(other.OrFilters) {
 		return false
 	}
-	if bytes.Compare(p.Data, other.Data) != 0 {
+	for i, _tgt := range p.OrFilters {
+		_src5 := other.OrFilters[i]
+		if !_tgt.Equals(_src5) {
+			return false
+		}
+	}
+	if p.FetchContent != other.FetchContent {
 		return false
 	}
 	return true
 }
 
-func (p *Firmware) String() string {
+func (p *SearchFirmwareRequest) String() string {
 	if p == nil {
 		return "<nil>"
 	}
-	return fmt.Sprintf("Firmware(%+v)", *p)
+	return fmt.Sprintf("SearchFirmwareRequest(%+v)", *p)
 }
 
 // Attributes:
@@ -3050,88 +3638,85 @@ func (p *Firmware) String() string {
 //   - HashStable
 //   - Filename
 //   - Version
-//   - Size
-//   - TSAdd
-//   - TSUpload
-type FirmwareImageMetadata struct {
-	ImageID        []byte  `thrift:"ImageID,1" db:"ImageID" json:"ImageID"`
-	HashSHA2_512   []byte  `thrift:"HashSHA2_512,2" db:"HashSHA2_512" json:"HashSHA2_512"`
-	HashBlake3_512 []byte  `thrift:"HashBlake3_512,3" db:"HashBlake3_512" json:"HashBlake3_512"`
-	HashStable     []byte  `thrift:"HashStable,4" db:"HashStable" json:"HashStable"`
+type SearchFirmwareFilters struct {
+	ImageID        []byte  `thrift:"ImageID,1" db:"ImageID" json:"ImageID,omitempty"`
+	HashSHA2_512   []byte  `thrift:"HashSHA2_512,2" db:"HashSHA2_512" json:"HashSHA2_512,omitempty"`
+	HashBlake3_512 []byte  `thrift:"HashBlake3_512,3" db:"HashBlake3_512" json:"HashBlake3_512,omitempty"`
+	HashStable     []byte  `thrift:"HashStable,4" db:"HashStable" json:"HashStable,omitempty"`
 	Filename       *string `thrift:"Filename,5" db:"Filename" json:"Filename,omitempty"`
 	Version        *string `thrift:"Version,6" db:"Version" json:"Version,omitempty"`
-	Size           int64   `thrift:"Size,7" db:"Size" json:"Size"`
-	TSAdd          int64   `thrift:"TSAdd,8" db:"TSAdd" json:"TSAdd"`
-	TSUpload       *int64  `thrift:"TSUpload,9" db:"TSUpload" json:"TSUpload,omitempty"`
 }
 
-func NewFirmwareImageMetadata() *FirmwareImageMetadata {
-	return &FirmwareImageMetadata{}
+func NewSearchFirmwareFilters() *SearchFirmwareFilters {
+	return &SearchFirmwareFilters{}
 }
 
-func (p *FirmwareImageMetadata) GetImageID() []byte {
+var SearchFirmwareFilters_ImageID_DEFAULT []byte
+
+func (p *SearchFirmwareFilters) GetImageID() []byte {
 	return p.ImageID
 }
 
-func (p *FirmwareImageMetadata) GetHashSHA2_512() []byte {
+var SearchFirmwareFilters_HashSHA2_512_DEFAULT []byte
+
+func (p *SearchFirmwareFilters) GetHashSHA2_512() []byte {
 	return p.HashSHA2_512
 }
 
-func (p *FirmwareImageMetadata) GetHashBlake3_512() []byte {
+var SearchFirmwareFilters_HashBlake3_512_DEFAULT []byte
+
+func (p *SearchFirmwareFilters) GetHashBlake3_512() []byte {
 	return p.HashBlake3_512
 }
 
-func (p *FirmwareImageMetadata) GetHashStable() []byte {
+var SearchFirmwareFilters_HashStable_DEFAULT []byte
+
+func (p *SearchFirmwareFilters) GetHashStable() []byte {
 	return p.HashStable
 }
 
-var FirmwareImageMetadata_Filename_DEFAULT string
+var SearchFirmwareFilters_Filename_DEFAULT string
 
-func (p *FirmwareImageMetadata) GetFilename() string {
+func (p *SearchFirmwareFilters) GetFilename() string {
 	if !p.IsSetFilename() {
-		return FirmwareImageMetadata_Filename_DEFAULT
+		return SearchFirmwareFilters_Filename_DEFAULT
 	}
 	return *p.Filename
 }
 
-var FirmwareImageMetadata_Version_DEFAULT string
+var SearchFirmwareFilters_Version_DEFAULT string
 
-func (p *FirmwareImageMetadata) GetVersion() string {
+func (p *SearchFirmwareFilters) GetVersion() string {
 	if !p.IsSetVersion() {
-		return FirmwareImageMetadata_Version_DEFAULT
+		return SearchFirmwareFilters_Version_DEFAULT
 	}
 	return *p.Version
 }
-
-func (p *FirmwareImageMetadata) GetSize() int64 {
-	return p.Size
+func (p *SearchFirmwareFilters) IsSetImageID() bool {
+	return p.ImageID != nil
 }
 
-func (p *FirmwareImageMetadata) GetTSAdd() int64 {
-	return p.TSAdd
+func (p *SearchFirmwareFilters) IsSetHashSHA2_512() bool {
+	return p.HashSHA2_512 != nil
 }
 
-var FirmwareImageMetadata_TSUpload_DEFAULT int64
+func (p *SearchFirmwareFilters) IsSetHashBlake3_512() bool {
+	return p.HashBlake3_512 != nil
+}
 
-func (p *FirmwareImageMetadata) GetTSUpload() int64 {
-	if !p.IsSetTSUpload() {
-		return FirmwareImageMetadata_TSUpload_DEFAULT
-	}
-	return *p.TSUpload
+func (p *SearchFirmwareFilters) IsSetHashStable() bool {
+	return p.HashStable != nil
 }
-func (p *FirmwareImageMetadata) IsSetFilename() bool {
+
+func (p *SearchFirmwareFilters) IsSetFilename() bool {
 	return p.Filename != nil
 }
 
-func (p *FirmwareImageMetadata) IsSetVersion() bool {
+func (p *SearchFirmwareFilters) IsSetVersion() bool {
 	return p.Version != nil
 }
 
-func (p *FirmwareImageMetadata) IsSetTSUpload() bool {
-	return p.TSUpload != nil
-}
-
-func (p *FirmwareImageMetadata) Read(ctx context.Context, iprot thrift.TProtocol) error {
+func (p *SearchFirmwareFilters) Read(ctx context.Context, iprot thrift.TProtocol) error {
 	if _, err := iprot.ReadStructBegin(ctx); err != nil {
 		return thrift.PrependError(fmt.Sprintf("%T read error: ", p), err)
 	}
@@ -3205,36 +3790,6 @@ func (p *FirmwareImageMetadata) Read(ctx context.Context, iprot thrift.TProtocol
 					return err
 				}
 			}
-		case 7:
-			if fieldTypeId == thrift.I64 {
-				if err := p.ReadField7(ctx, iprot); err != nil {
-					return err
-				}
-			} else {
-				if err := iprot.Skip(ctx, fieldTypeId); err != nil {
-					return err
-				}
-			}
-		case 8:
-			if fieldTypeId == thrift.I64 {
-				if err := p.ReadField8(ctx, iprot); err != nil {
-					return err
-				}
-			} else {
-				if err := iprot.Skip(ctx, fieldTypeId); err != nil {
-					return err
-				}
-			}
-		case 9:
-			if fieldTypeId == thrift.I64 {
-				if err := p.ReadField9(ctx, iprot); err != nil {
-					return err
-				}
-			} else {
-				if err := iprot.Skip(ctx, fieldTypeId); err != nil {
-					return err
-				}
-			}
 		default:
 			if err := iprot.Skip(ctx, fieldTypeId); err != nil {
 				return err
@@ -3250,7 +3805,7 @@ func (p *FirmwareImageMetadata) Read(ctx context.Context, iprot thrift.TProtocol
 	return nil
 }
 
-func (p *FirmwareImageMetadata) ReadField1(ctx context.Context, iprot thrift.TProtocol) error {
+func (p *SearchFirmwareFilters) ReadField1(ctx context.Context, iprot thrift.TProtocol) error {
 	if v, err := iprot.ReadBinary(ctx); err != nil {
 		return thrift.PrependError("error reading field 1: ", err)
 	} else {
@@ -3259,7 +3814,7 @@ func (p *FirmwareImageMetadata) ReadField1(ctx context.Context, iprot thrift.TPr
 	return nil
 }
 
-func (p *FirmwareImageMetadata) ReadField2(ctx context.Context, iprot thrift.TProtocol) error {
+func (p *SearchFirmwareFilters) ReadField2(ctx context.Context, iprot thrift.TProtocol) error {
 	if v, err := iprot.ReadBinary(ctx); err != nil {
 		return thrift.PrependError("error reading field 2: ", err)
 	} else {
@@ -3268,7 +3823,7 @@ func (p *FirmwareImageMetadata) ReadField2(ctx context.Context, iprot thrift.TPr
 	return nil
 }
 
-func (p *FirmwareImageMetadata) ReadField3(ctx context.Context, iprot thrift.TProtocol) error {
+func (p *SearchFirmwareFilters) ReadField3(ctx context.Context, iprot thrift.TProtocol) error {
 	if v, err := iprot.ReadBinary(ctx); err != nil {
 		return thrift.PrependError("error reading field 3: ", err)
 	} else {
@@ -3277,7 +3832,7 @@ func (p *FirmwareImageMetadata) ReadField3(ctx context.Context, iprot thrift.TPr
 	return nil
 }
 
-func (p *FirmwareImageMetadata) ReadField4(ctx context.Context, iprot thrift.TProtocol) error {
+func (p *SearchFirmwareFilters) ReadField4(ctx context.Context, iprot thrift.TProtocol) error {
 	if v, err := iprot.ReadBinary(ctx); err != nil {
 		return thrift.PrependError("error reading field 4: ", err)
 	} else {
@@ -3286,7 +3841,7 @@ func (p *FirmwareImageMetadata) ReadField4(ctx context.Context, iprot thrift.TPr
 	return nil
 }
 
-func (p *FirmwareImageMetadata) ReadField5(ctx context.Context, iprot thrift.TProtocol) error {
+func (p *SearchFirmwareFilters) ReadField5(ctx context.Context, iprot thrift.TProtocol) error {
 	if v, err := iprot.ReadString(ctx); err != nil {
 		return thrift.PrependError("error reading field 5: ", err)
 	} else {
@@ -3295,7 +3850,7 @@ func (p *FirmwareImageMetadata) ReadField5(ctx context.Context, iprot thrift.TPr
 	return nil
 }
 
-func (p *FirmwareImageMetadata) ReadField6(ctx context.Context, iprot thrift.TProtocol) error {
+func (p *SearchFirmwareFilters) ReadField6(ctx context.Context, iprot thrift.TProtocol) error {
 	if v, err := iprot.ReadString(ctx); err != nil {
 		return thrift.PrependError("error reading field 6: ", err)
 	} else {
@@ -3304,35 +3859,8 @@ func (p *FirmwareImageMetadata) ReadField6(ctx context.Context, iprot thrift.TPr
 	return nil
 }
 
-func (p *FirmwareImageMetadata) ReadField7(ctx context.Context, iprot thrift.TProtocol) error {
-	if v, err := iprot.ReadI64(ctx); err != nil {
-		return thrift.PrependError("error reading field 7: ", err)
-	} else {
-		p.Size = v
-	}
-	return nil
-}
-
-func (p *FirmwareImageMetadata) ReadField8(ctx context.Context, iprot thrift.TProtocol) error {
-	if v, err := iprot.ReadI64(ctx); err != nil {
-		return thrift.PrependError("error reading field 8: ", err)
-	} else {
-		p.TSAdd = v
-	}
-	return nil
-}
-
-func (p *FirmwareImageMetadata) ReadField9(ctx context.Context, iprot thrift.TProtocol) error {
-	if v, err := iprot.ReadI64(ctx); err != nil {
-		return thrift.PrependError("error reading field 9: ", err)
-	} else {
-		p.TSUpload = &v
-	}
-	return nil
-}
-
-func (p *FirmwareImageMetadata) Write(ctx context.Context, oprot thrift.TProtocol) error {
-	if err := oprot.WriteStructBegin(ctx, "FirmwareImageMetadata"); err != nil {
+func (p *SearchFirmwareFilters) Write(ctx context.Context, oprot thrift.TProtocol) error {
+	if err := oprot.WriteStructBegin(ctx, "SearchFirmwareFilters"); err != nil {
 		return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
 	}
 	if p != nil {
@@ -3354,15 +3882,6 @@ func (p *FirmwareImageMetadata) Write(ctx context.Context, oprot thrift.TProtoco
 		if err := p.writeField6(ctx, oprot); err != nil {
 			return err
 		}
-		if err := p.writeField7(ctx, oprot); err != nil {
-			return err
-		}
-		if err := p.writeField8(ctx, oprot); err != nil {
-			return err
-		}
-		if err := p.writeField9(ctx, oprot); err != nil {
-			return err
-		}
 	}
 	if err := oprot.WriteFieldStop(ctx); err != nil {
 		return thrift.PrependError("write field stop error: ", err)
@@ -3373,59 +3892,67 @@ func (p *FirmwareImageMetadata) Write(ctx context.Context, oprot thrift.TProtoco
 	return nil
 }
 
-func (p *FirmwareImageMetadata) writeField1(ctx context.Context, oprot thrift.TProtocol) (err error) {
-	if err := oprot.WriteFieldBegin(ctx, "ImageID", thrift.STRING, 1); err != nil {
-		return thrift.PrependError(fmt.Sprintf("%T write field begin error 1:ImageID: ", p), err)
-	}
-	if err := oprot.WriteBinary(ctx, p.ImageID); err != nil {
-		return thrift.PrependError(fmt.Sprintf("%T.ImageID (1) field write error: ", p), err)
-	}
-	if err := oprot.WriteFieldEnd(ctx); err != nil {
-		return thrift.PrependError(fmt.Sprintf("%T write field end error 1:ImageID: ", p), err)
+func (p *SearchFirmwareFilters) writeField1(ctx context.Context, oprot thrift.TProtocol) (err error) {
+	if p.IsSetImageID() {
+		if err := oprot.WriteFieldBegin(ctx, "ImageID", thrift.STRING, 1); err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T write field begin error 1:ImageID: ", p), err)
+		}
+		if err := oprot.WriteBinary(ctx, p.ImageID); err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T.ImageID (1) field write error: ", p), err)
+		}
+		if err := oprot.WriteFieldEnd(ctx); err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T write field end error 1:ImageID: ", p), err)
+		}
 	}
 	return err
 }
 
-func (p *FirmwareImageMetadata) writeField2(ctx context.Context, oprot thrift.TProtocol) (err error) {
-	if err := oprot.WriteFieldBegin(ctx, "HashSHA2_512", thrift.STRING, 2); err != nil {
-		return thrift.PrependError(fmt.Sprintf("%T write field begin error 2:HashSHA2_512: ", p), err)
-	}
-	if err := oprot.WriteBinary(ctx, p.HashSHA2_512); err != nil {
-		return thrift.PrependError(fmt.Sprintf("%T.HashSHA2_512 (2) field write error: ", p), err)
-	}
-	if err := oprot.WriteFieldEnd(ctx); err != nil {
-		return thrift.PrependError(fmt.Sprintf("%T write field end error 2:HashSHA2_512: ", p), err)
+func (p *SearchFirmwareFilters) writeField2(ctx context.Context, oprot thrift.TProtocol) (err error) {
+	if p.IsSetHashSHA2_512() {
+		if err := oprot.WriteFieldBegin(ctx, "HashSHA2_512", thrift.STRING, 2); err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T write field begin error 2:HashSHA2_512: ", p), err)
+		}
+		if err := oprot.WriteBinary(ctx, p.HashSHA2_512); err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T.HashSHA2_512 (2) field write error: ", p), err)
+		}
+		if err := oprot.WriteFieldEnd(ctx); err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T write field end error 2:HashSHA2_512: ", p), err)
+		}
 	}
 	return err
 }
 
-func (p *FirmwareImageMetadata) writeField3(ctx context.Context, oprot thrift.TProtocol) (err error) {
-	if err := oprot.WriteFieldBegin(ctx, "HashBlake3_512", thrift.STRING, 3); err != nil {
-		return thrift.PrependError(fmt.Sprintf("%T write field begin error 3:HashBlake3_512: ", p), err)
-	}
-	if err := oprot.WriteBinary(ctx, p.HashBlake3_512); err != nil {
-		return thrift.PrependError(fmt.Sprintf("%T.HashBlake3_512 (3) field write error: ", p), err)
-	}
-	if err := oprot.WriteFieldEnd(ctx); err != nil {
-		return thrift.PrependError(fmt.Sprintf("%T write field end error 3:HashBlake3_512: ", p), err)
+func (p *SearchFirmwareFilters) writeField3(ctx context.Context, oprot thrift.TProtocol) (err error) {
+	if p.IsSetHashBlake3_512() {
+		if err := oprot.WriteFieldBegin(ctx, "HashBlake3_512", thrift.STRING, 3); err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T write field begin error 3:HashBlake3_512: ", p), err)
+		}
+		if err := oprot.WriteBinary(ctx, p.HashBlake3_512); err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T.HashBlake3_512 (3) field write error: ", p), err)
+		}
+		if err := oprot.WriteFieldEnd(ctx); err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T write field end error 3:HashBlake3_512: ", p), err)
+		}
 	}
 	return err
 }
 
-func (p *FirmwareImageMetadata) writeField4(ctx context.Context, oprot thrift.TProtocol) (err error) {
-	if err := oprot.WriteFieldBegin(ctx, "HashStable", thrift.STRING, 4); err != nil {
-		return thrift.PrependError(fmt.Sprintf("%T write field begin error 4:HashStable: ", p), err)
-	}
-	if err := oprot.WriteBinary(ctx, p.HashStable); err != nil {
-		return thrift.PrependError(fmt.Sprintf("%T.HashStable (4) field write error: ", p), err)
-	}
-	if err := oprot.WriteFieldEnd(ctx); err != nil {
-		return thrift.PrependError(fmt.Sprintf("%T write field end error 4:HashStable: ", p), err)
+func (p *SearchFirmwareFilters) writeField4(ctx context.Context, oprot thrift.TProtocol) (err error) {
+	if p.IsSetHashStable() {
+		if err := oprot.WriteFieldBegin(ctx, "HashStable", thrift.STRING, 4); err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T write field begin error 4:HashStable: ", p), err)
+		}
+		if err := oprot.WriteBinary(ctx, p.HashStable); err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T.HashStable (4) field write error: ", p), err)
+		}
+		if err := oprot.WriteFieldEnd(ctx); err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T write field end error 4:HashStable: ", p), err)
+		}
 	}
 	return err
 }
 
-func (p *FirmwareImageMetadata) writeField5(ctx context.Context, oprot thrift.TProtocol) (err error) {
+func (p *SearchFirmwareFilters) writeField5(ctx context.Context, oprot thrift.TProtocol) (err error) {
 	if p.IsSetFilename() {
 		if err := oprot.WriteFieldBegin(ctx, "Filename", thrift.STRING, 5); err != nil {
 			return thrift.PrependError(fmt.Sprintf("%T write field begin error 5:Filename: ", p), err)
@@ -3440,7 +3967,7 @@ func (p *FirmwareImageMetadata) writeField5(ctx context.Context, oprot thrift.TP
 	return err
 }
 
-func (p *FirmwareImageMetadata) writeField6(ctx context.Context, oprot thrift.TProtocol) (err error) {
+func (p *SearchFirmwareFilters) writeField6(ctx context.Context, oprot thrift.TProtocol) (err error) {
 	if p.IsSetVersion() {
 		if err := oprot.WriteFieldBegin(ctx, "Version", thrift.STRING, 6); err != nil {
 			return thrift.PrependError(fmt.Sprintf("%T write field begin error 6:Version: ", p), err)
@@ -3455,48 +3982,7 @@ func (p *FirmwareImageMetadata) writeField6(ctx context.Context, oprot thrift.TP
 	return err
 }
 
-func (p *FirmwareImageMetadata) writeField7(ctx context.Context, oprot thrift.TProtocol) (err error) {
-	if err := oprot.WriteFieldBegin(ctx, "Size", thrift.I64, 7); err != nil {
-		return thrift.PrependError(fmt.Sprintf("%T write field begin error 7:Size: ", p), err)
-	}
-	if err := oprot.WriteI64(ctx, int64(p.Size)); err != nil {
-		return thrift.PrependError(fmt.Sprintf("%T.Size (7) field write error: ", p), err)
-	}
-	if err := oprot.WriteFieldEnd(ctx); err != nil {
-		return thrift.PrependError(fmt.Sprintf("%T write field end error 7:Size: ", p), err)
-	}
-	return err
-}
-
-func (p *FirmwareImageMetadata) writeField8(ctx context.Context, oprot thrift.TProtocol) (err error) {
-	if err := oprot.WriteFieldBegin(ctx, "TSAdd", thrift.I64, 8); err != nil {
-		return thrift.PrependError(fmt.Sprintf("%T write field begin error 8:TSAdd: ", p), err)
-	}
-	if err := oprot.WriteI64(ctx, int64(p.TSAdd)); err != nil {
-		return thrift.PrependError(fmt.Sprintf("%T.TSAdd (8) field write error: ", p), err)
-	}
-	if err := oprot.WriteFieldEnd(ctx); err != nil {
-		return thrift.PrependError(fmt.Sprintf("%T write field end error 8:TSAdd: ", p), err)
-	}
-	return err
-}
-
-func (p *FirmwareImageMetadata) writeField9(ctx context.Context, oprot thrift.TProtocol) (err error) {
-	if p.IsSetTSUpload() {
-		if err := oprot.WriteFieldBegin(ctx, "TSUpload", thrift.I64, 9); err != nil {
-			return thrift.PrependError(fmt.Sprintf("%T write field begin error 9:TSUpload: ", p), err)
-		}
-		if err := oprot.WriteI64(ctx, int64(*p.TSUpload)); err != nil {
-			return thrift.PrependError(fmt.Sprintf("%T.TSUpload (9) field write error: ", p), err)
-		}
-		if err := oprot.WriteFieldEnd(ctx); err != nil {
-			return thrift.PrependError(fmt.Sprintf("%T write field end error 9:TSUpload: ", p), err)
-		}
-	}
-	return err
-}
-
-func (p *FirmwareImageMetadata) Equals(other *FirmwareImageMetadata) bool {
+func (p *SearchFirmwareFilters) Equals(other *SearchFirmwareFilters) bool {
 	if p == other {
 		return true
 	} else if p == nil || other == nil {
@@ -3530,50 +4016,30 @@ func (p *FirmwareImageMetadata) Equals(other *FirmwareImageMetadata) bool {
 			return false
 		}
 	}
-	if p.Size != other.Size {
-		return false
-	}
-	if p.TSAdd != other.TSAdd {
-		return false
-	}
-	if p.TSUpload != other.TSUpload {
-		if p.TSUpload == nil || other.TSUpload == nil {
-			return false
-		}
-		if (*p.TSUpload) != (*other.TSUpload) {
-			return false
-		}
-	}
 	return true
 }
 
-func (p *FirmwareImageMetadata) String() string {
+func (p *SearchFirmwareFilters) String() string {
 	if p == nil {
 		return "<nil>"
 	}
-	return fmt.Sprintf("FirmwareImageMetadata(%+v)", *p)
+	return fmt.Sprintf("SearchFirmwareFilters(%+v)", *p)
 }
 
 // Attributes:
-//   - OrFilters
-//   - Limit
-type SearchReportRequest struct {
-	OrFilters []*SearchReportFilters `thrift:"OrFilters,1" db:"OrFilters" json:"OrFilters"`
-	Limit     int64                  `thrift:"Limit,2" db:"Limit" json:"Limit"`
-}
-
-func NewSearchReportRequest() *SearchReportRequest {
-	return &SearchReportRequest{}
+//   - Found
+type SearchFirmwareResult_ struct {
+	Found []*Firmware `thrift:"Found,1" db:"Found" json:"Found"`
 }
 
-func (p *SearchReportRequest) GetOrFilters() []*SearchReportFilters {
-	return p.OrFilters
+func NewSearchFirmwareResult_() *SearchFirmwareResult_ {
+	return &SearchFirmwareResult_{}
 }
 
-func (p *SearchReportRequest) GetLimit() int64 {
-	return p.Limit
+func (p *SearchFirmwareResult_) GetFound() []*Firmware {
+	return p.Found
 }
-func (p *SearchReportRequest) Read(ctx context.Context, iprot thrift.TProtocol) error {
+func (p *SearchFirmwareResult_) Read(ctx context.Context, iprot thrift.TProtocol) error {
 	if _, err := iprot.ReadStructBegin(ctx); err != nil {
 		return thrift.PrependError(fmt.Sprintf("%T read error: ", p), err)
 	}
@@ -3597,16 +4063,6 @@ func (p *SearchReportRequest) Read(ctx context.Context, iprot thrift.TProtocol)
 					return err
 				}
 			}
-		case 2:
-			if fieldTypeId == thrift.I64 {
-				if err := p.ReadField2(ctx, iprot); err != nil {
-					return err
-				}
-			} else {
-				if err := iprot.Skip(ctx, fieldTypeId); err != nil {
-					return err
-				}
-			}
 		default:
 			if err := iprot.Skip(ctx, fieldTypeId); err != nil {
 				return err
@@ -3622,19 +4078,19 @@ func (p *SearchReportRequest) Read(ctx context.Context, iprot thrift.TProtocol)
 	return nil
 }
 
-func (p *SearchReportRequest) ReadField1(ctx context.Context, iprot thrift.TProtocol) error {
+func (p *SearchFirmwareResult_) ReadField1(ctx context.Context, iprot thrift.TProtocol) error {
 	_, size, err := iprot.ReadListBegin(ctx)
 	if err != nil {
 		return thrift.PrependError("error reading list begin: ", err)
 	}
-	tSlice := make([]*SearchReportFilters, 0, size)
-	p.OrFilters = tSlice
+	tSlice := make([]*Firmware, 0, size)
+	p.Found = tSlice
 	for i := 0; i < size; i++ {
-		_elem4 := &SearchReportFilters{}
-		if err := _elem4.Read(ctx, iprot); err != nil {
-			return thrift.PrependError(fmt.Sprintf("%T error reading struct: ", _elem4), err)
+		_elem6 := &Firmware{}
+		if err := _elem6.Read(ctx, iprot); err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T error reading struct: ", _elem6), err)
 		}
-		p.OrFilters = append(p.OrFilters, _elem4)
+		p.Found = append(p.Found, _elem6)
 	}
 	if err := iprot.ReadListEnd(ctx); err != nil {
 		return thrift.PrependError("error reading list end: ", err)
@@ -3642,26 +4098,14 @@ func (p *SearchReportRequest) ReadField1(ctx context.Context, iprot thrift.TProt
 	return nil
 }
 
-func (p *SearchReportRequest) ReadField2(ctx context.Context, iprot thrift.TProtocol) error {
-	if v, err := iprot.ReadI64(ctx); err != nil {
-		return thrift.PrependError("error reading field 2: ", err)
-	} else {
-		p.Limit = v
-	}
-	return nil
-}
-
-func (p *SearchReportRequest) Write(ctx context.Context, oprot thrift.TProtocol) error {
-	if err := oprot.WriteStructBegin(ctx, "SearchReportRequest"); err != nil {
+func (p *SearchFirmwareResult_) Write(ctx context.Context, oprot thrift.TProtocol) error {
+	if err := oprot.WriteStructBegin(ctx, "SearchFirmwareResult"); err != nil {
 		return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
 	}
 	if p != nil {
 		if err := p.writeField1(ctx, oprot); err != nil {
 			return err
 		}
-		if err := p.writeField2(ctx, oprot); err != nil {
-			return err
-		}
 	}
 	if err := oprot.WriteFieldStop(ctx); err != nil {
 		return thrift.PrependError("write field stop error: ", err)
@@ -3672,14 +4116,14 @@ func (p *SearchReportRequest) Write(ctx context.Context, oprot thrift.TProtocol)
 	return nil
 }
 
-func (p *SearchReportRequest) writeField1(ctx context.Context, oprot thrift.TProtocol) (err error) {
-	if err := oprot.WriteFieldBegin(ctx, "OrFilters", thrift.LIST, 1); err != nil {
-		return thrift.PrependError(fmt.Sprintf("%T write field begin error 1:OrFilters: ", p), err)
+func (p *SearchFirmwareResult_) writeField1(ctx context.Context, oprot thrift.TProtocol) (err error) {
+	if err := oprot.WriteFieldBegin(ctx, "Found", thrift.LIST, 1); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T write field begin error 1:Found: ", p), err)
 	}
-	if err := oprot.WriteListBegin(ctx, thrift.STRUCT, len(p.OrFilters)); err != nil {
+	if err := oprot.WriteListBegin(ctx, thrift.STRUCT, len(p.Found)); err != nil {
 		return thrift.PrependError("error writing list begin: ", err)
 	}
-	for _, v := range p.OrFilters {
+	for _, v := range p.Found {
 		if err := v.Write(ctx, oprot); err != nil {
 			return thrift.PrependError(fmt.Sprintf("%T error writing struct: ", v), err)
 		}
@@ -3688,102 +4132,71 @@ func (p *SearchReportRequest) writeField1(ctx context.Context, oprot thrift.TPro
 		return thrift.PrependError("error writing list end: ", err)
 	}
 	if err := oprot.WriteFieldEnd(ctx); err != nil {
-		return thrift.PrependError(fmt.Sprintf("%T write field end error 1:OrFilters: ", p), err)
-	}
-	return err
-}
-
-func (p *SearchReportRequest) writeField2(ctx context.Context, oprot thrift.TProtocol) (err error) {
-	if err := oprot.WriteFieldBegin(ctx, "Limit", thrift.I64, 2); err != nil {
-		return thrift.PrependError(fmt.Sprintf("%T write field begin error 2:Limit: ", p), err)
-	}
-	if err := oprot.WriteI64(ctx, int64(p.Limit)); err != nil {
-		return thrift.PrependError(fmt.Sprintf("%T.Limit (2) field write error: ", p), err)
-	}
-	if err := oprot.WriteFieldEnd(ctx); err != nil {
-		return thrift.PrependError(fmt.Sprintf("%T write field end error 2:Limit: ", p), err)
+		return thrift.PrependError(fmt.Sprintf("%T write field end error 1:Found: ", p), err)
 	}
 	return err
 }
 
-func (p *SearchReportRequest) Equals(other *SearchReportRequest) bool {
+func (p *SearchFirmwareResult_) Equals(other *SearchFirmwareResult_) bool {
 	if p == other {
 		return true
 	} else if p == nil || other == nil {
 		return false
 	}
-	if len(p.OrFilters) != len(other.OrFilters) {
+	if len(p.Found) != len(other.Found) {
 		return false
 	}
-	for i, _tgt := range p.OrFilters {
-		_src5 := other.OrFilters[i]
-		if !_tgt.Equals(_src5) {
+	for i, _tgt := range p.Found {
+		_src7 := other.Found[i]
+		if !_tgt.Equals(_src7) {
 			return false
 		}
 	}
-	if p.Limit != other.Limit {
-		return false
-	}
 	return true
 }
 
-func (p *SearchReportRequest) String() string {
+func (p *SearchFirmwareResult_) String() string {
 	if p == nil {
 		return "<nil>"
 	}
-	return fmt.Sprintf("SearchReportRequest(%+v)", *p)
+	return fmt.Sprintf("SearchFirmwareResult_(%+v)", *p)
 }
 
 // Attributes:
-//   - JobID
-//   - AssetID
-//   - ActualFirmware
-type SearchReportFilters struct {
-	JobID          []byte                 `thrift:"JobID,1" db:"JobID" json:"JobID,omitempty"`
-	AssetID        *int64                 `thrift:"AssetID,2" db:"AssetID" json:"AssetID,omitempty"`
-	ActualFirmware *SearchFirmwareFilters `thrift:"ActualFirmware,3" db:"ActualFirmware" json:"ActualFirmware"`
-}
-
-func NewSearchReportFilters() *SearchReportFilters {
-	return &SearchReportFilters{}
+//   - Metadata
+//   - Data
+type Firmware struct {
+	Metadata *FirmwareImageMetadata `thrift:"Metadata,1" db:"Metadata" json:"Metadata"`
+	Data     []byte                 `thrift:"Data,2" db:"Data" json:"Data,omitempty"`
 }
 
-var SearchReportFilters_JobID_DEFAULT []byte
-
-func (p *SearchReportFilters) GetJobID() []byte {
-	return p.JobID
+func NewFirmware() *Firmware {
+	return &Firmware{}
 }
 
-var SearchReportFilters_AssetID_DEFAULT int64
+var Firmware_Metadata_DEFAULT *FirmwareImageMetadata
 
-func (p *SearchReportFilters) GetAssetID() int64 {
-	if !p.IsSetAssetID() {
-		return SearchReportFilters_AssetID_DEFAULT
+func (p *Firmware) GetMetadata() *FirmwareImageMetadata {
+	if !p.IsSetMetadata() {
+		return Firmware_Metadata_DEFAULT
 	}
-	return *p.AssetID
+	return p.Metadata
 }
 
-var SearchReportFilters_ActualFirmware_DEFAULT *SearchFirmwareFilters
+var Firmware_Data_DEFAULT []byte
 
-func (p *SearchReportFilters) GetActualFirmware() *SearchFirmwareFilters {
-	if !p.IsSetActualFirmware() {
-		return SearchReportFilters_ActualFirmware_DEFAULT
-	}
-	return p.ActualFirmware
-}
-func (p *SearchReportFilters) IsSetJobID() bool {
-	return p.JobID != nil
+func (p *Firmware) GetData() []byte {
+	return p.Data
 }
-
-func (p *SearchReportFilters) IsSetAssetID() bool {
-	return p.AssetID != nil
+func (p *Firmware) IsSetMetadata() bool {
+	return p.Metadata != nil
 }
 
-func (p *SearchReportFilters) IsSetActualFirmware() bool {
-	return p.ActualFirmware != nil
+func (p *Firmware) IsSetData() bool {
+	return p.Data != nil
 }
 
-func (p *SearchReportFilters) Read(ctx context.Context, iprot thrift.TProtocol) error {
+func (p *Firmware) Read(ctx context.Context, iprot thrift.TProtocol) error {
 	if _, err := iprot.ReadStructBegin(ctx); err != nil {
 		return thrift.PrependError(fmt.Sprintf("%T read error: ", p), err)
 	}
@@ -3798,7 +4211,7 @@ func (p *SearchReportFilters) Read(ctx context.Context, iprot thrift.TProtocol)
 		}
 		switch fieldId {
 		case 1:
-			if fieldTypeId == thrift.STRING {
+			if fieldTypeId == thrift.STRUCT {
 				if err := p.ReadField1(ctx, iprot); err != nil {
 					return err
 				}
@@ -3808,7 +4221,7 @@ func (p *SearchReportFilters) Read(ctx context.Context, iprot thrift.TProtocol)
 				}
 			}
 		case 2:
-			if fieldTypeId == thrift.I64 {
+			if fieldTypeId == thrift.STRING {
 				if err := p.ReadField2(ctx, iprot); err != nil {
 					return err
 				}
@@ -3817,16 +4230,6 @@ func (p *SearchReportFilters) Read(ctx context.Context, iprot thrift.TProtocol)
 					return err
 				}
 			}
-		case 3:
-			if fieldTypeId == thrift.STRUCT {
-				if err := p.ReadField3(ctx, iprot); err != nil {
-					return err
-				}
-			} else {
-				if err := iprot.Skip(ctx, fieldTypeId); err != nil {
-					return err
-				}
-			}
 		default:
 			if err := iprot.Skip(ctx, fieldTypeId); err != nil {
 				return err
@@ -3842,34 +4245,25 @@ func (p *SearchReportFilters) Read(ctx context.Context, iprot thrift.TProtocol)
 	return nil
 }
 
-func (p *SearchReportFilters) ReadField1(ctx context.Context, iprot thrift.TProtocol) error {
-	if v, err := iprot.ReadBinary(ctx); err != nil {
-		return thrift.PrependError("error reading field 1: ", err)
-	} else {
-		p.JobID = v
+func (p *Firmware) ReadField1(ctx context.Context, iprot thrift.TProtocol) error {
+	p.Metadata = &FirmwareImageMetadata{}
+	if err := p.Metadata.Read(ctx, iprot); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T error reading struct: ", p.Metadata), err)
 	}
 	return nil
 }
 
-func (p *SearchReportFilters) ReadField2(ctx context.Context, iprot thrift.TProtocol) error {
-	if v, err := iprot.ReadI64(ctx); err != nil {
+func (p *Firmware) ReadField2(ctx context.Context, iprot thrift.TProtocol) error {
+	if v, err := iprot.ReadBinary(ctx); err != nil {
 		return thrift.PrependError("error reading field 2: ", err)
 	} else {
-		p.AssetID = &v
-	}
-	return nil
-}
-
-func (p *SearchReportFilters) ReadField3(ctx context.Context, iprot thrift.TProtocol) error {
-	p.ActualFirmware = &SearchFirmwareFilters{}
-	if err := p.ActualFirmware.Read(ctx, iprot); err != nil {
-		return thrift.PrependError(fmt.Sprintf("%T error reading struct: ", p.ActualFirmware), err)
+		p.Data = v
 	}
 	return nil
 }
 
-func (p *SearchReportFilters) Write(ctx context.Context, oprot thrift.TProtocol) error {
-	if err := oprot.WriteStructBegin(ctx, "SearchReportFilters"); err != nil {
+func (p *Firmware) Write(ctx context.Context, oprot thrift.TProtocol) error {
+	if err := oprot.WriteStructBegin(ctx, "Firmware"); err != nil {
 		return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
 	}
 	if p != nil {
@@ -3879,9 +4273,6 @@ func (p *SearchReportFilters) Write(ctx context.Context, oprot thrift.TProtocol)
 		if err := p.writeField2(ctx, oprot); err != nil {
 			return err
 		}
-		if err := p.writeField3(ctx, oprot); err != nil {
-			return err
-		}
 	}
 	if err := oprot.WriteFieldStop(ctx); err != nil {
 		return thrift.PrependError("write field stop error: ", err)
@@ -3892,229 +4283,145 @@ func (p *SearchReportFilters) Write(ctx context.Context, oprot thrift.TProtocol)
 	return nil
 }
 
-func (p *SearchReportFilters) writeField1(ctx context.Context, oprot thrift.TProtocol) (err error) {
-	if p.IsSetJobID() {
-		if err := oprot.WriteFieldBegin(ctx, "JobID", thrift.STRING, 1); err != nil {
-			return thrift.PrependError(fmt.Sprintf("%T write field begin error 1:JobID: ", p), err)
-		}
-		if err := oprot.WriteBinary(ctx, p.JobID); err != nil {
-			return thrift.PrependError(fmt.Sprintf("%T.JobID (1) field write error: ", p), err)
-		}
-		if err := oprot.WriteFieldEnd(ctx); err != nil {
-			return thrift.PrependError(fmt.Sprintf("%T write field end error 1:JobID: ", p), err)
-		}
+func (p *Firmware) writeField1(ctx context.Context, oprot thrift.TProtocol) (err error) {
+	if err := oprot.WriteFieldBegin(ctx, "Metadata", thrift.STRUCT, 1); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T write field begin error 1:Metadata: ", p), err)
+	}
+	if err := p.Metadata.Write(ctx, oprot); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T error writing struct: ", p.Metadata), err)
+	}
+	if err := oprot.WriteFieldEnd(ctx); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T write field end error 1:Metadata: ", p), err)
 	}
 	return err
 }
 
-func (p *SearchReportFilters) writeField2(ctx context.Context, oprot thrift.TProtocol) (err error) {
-	if p.IsSetAssetID() {
-		if err := oprot.WriteFieldBegin(ctx, "AssetID", thrift.I64, 2); err != nil {
-			return thrift.PrependError(fmt.Sprintf("%T write field begin error 2:AssetID: ", p), err)
+func (p *Firmware) writeField2(ctx context.Context, oprot thrift.TProtocol) (err error) {
+	if p.IsSetData() {
+		if err := oprot.WriteFieldBegin(ctx, "Data", thrift.STRING, 2); err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T write field begin error 2:Data: ", p), err)
 		}
-		if err := oprot.WriteI64(ctx, int64(*p.AssetID)); err != nil {
-			return thrift.PrependError(fmt.Sprintf("%T.AssetID (2) field write error: ", p), err)
+		if err := oprot.WriteBinary(ctx, p.Data); err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T.Data (2) field write error: ", p), err)
 		}
 		if err := oprot.WriteFieldEnd(ctx); err != nil {
-			return thrift.PrependError(fmt.Sprintf("%T write field end error 2:AssetID: ", p), err)
+			return thrift.PrependError(fmt.Sprintf("%T write field end error 2:Data: ", p), err)
 		}
 	}
 	return err
 }
 
-func (p *SearchReportFilters) writeField3(ctx context.Context, oprot thrift.TProtocol) (err error) {
-	if err := oprot.WriteFieldBegin(ctx, "ActualFirmware", thrift.STRUCT, 3); err != nil {
-		return thrift.PrependError(fmt.Sprintf("%T write field begin error 3:ActualFirmware: ", p), err)
-	}
-	if err := p.ActualFirmware.Write(ctx, oprot); err != nil {
-		return thrift.PrependError(fmt.Sprintf("%T error writing struct: ", p.ActualFirmware), err)
-	}
-	if err := oprot.WriteFieldEnd(ctx); err != nil {
-		return thrift.PrependError(fmt.Sprintf("%T write field end error 3:ActualFirmware: ", p), err)
-	}
-	return err
-}
-
-func (p *SearchReportFilters) Equals(other *SearchReportFilters) bool {
+func (p *Firmware) Equals(other *Firmware) bool {
 	if p == other {
 		return true
 	} else if p == nil || other == nil {
 		return false
 	}
-	if bytes.Compare(p.JobID, other.JobID) != 0 {
+	if !p.Metadata.Equals(other.Metadata) {
 		return false
 	}
-	if p.AssetID != other.AssetID {
-		if p.AssetID == nil || other.AssetID == nil {
-			return false
-		}
-		if (*p.AssetID) != (*other.AssetID) {
-			return false
-		}
-	}
-	if !p.ActualFirmware.Equals(other.ActualFirmware) {
+	if bytes.Compare(p.Data, other.Data) != 0 {
 		return false
 	}
 	return true
 }
 
-func (p *SearchReportFilters) String() string {
+func (p *Firmware) String() string {
 	if p == nil {
 		return "<nil>"
 	}
-	return fmt.Sprintf("SearchReportFilters(%+v)", *p)
+	return fmt.Sprintf("Firmware(%+v)", *p)
 }
 
 // Attributes:
-//   - Found
-type SearchReportResult_ struct {
-	Found []*AnalyzeResult_ `thrift:"Found,1" db:"Found" json:"Found"`
-}
-
-func NewSearchReportResult_() *SearchReportResult_ {
-	return &SearchReportResult_{}
-}
-
-func (p *SearchReportResult_) GetFound() []*AnalyzeResult_ {
-	return p.Found
-}
-func (p *SearchReportResult_) Read(ctx context.Context, iprot thrift.TProtocol) error {
-	if _, err := iprot.ReadStructBegin(ctx); err != nil {
-		return thrift.PrependError(fmt.Sprintf("%T read error: ", p), err)
-	}
-
-	for {
-		_, fieldTypeId, fieldId, err := iprot.ReadFieldBegin(ctx)
-		if err != nil {
-			return thrift.PrependError(fmt.Sprintf("%T field %d read error: ", p, fieldId), err)
-		}
-		if fieldTypeId == thrift.STOP {
-			break
-		}
-		switch fieldId {
-		case 1:
-			if fieldTypeId == thrift.LIST {
-				if err := p.ReadField1(ctx, iprot); err != nil {
-					return err
-				}
-			} else {
-				if err := iprot.Skip(ctx, fieldTypeId); err != nil {
-					return err
-				}
-			}
-		default:
-			if err := iprot.Skip(ctx, fieldTypeId); err != nil {
-				return err
-			}
-		}
-		if err := iprot.ReadFieldEnd(ctx); err != nil {
-			return err
-		}
-	}
-	if err := iprot.ReadStructEnd(ctx); err != nil {
-		return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
-	}
-	return nil
+//   - ImageID
+//   - HashSHA2_512
+//   - HashBlake3_512
+//   - HashStable
+//   - Filename
+//   - Version
+//   - Size
+//   - TSAdd
+//   - TSUpload
+type FirmwareImageMetadata struct {
+	ImageID        []byte  `thrift:"ImageID,1" db:"ImageID" json:"ImageID"`
+	HashSHA2_512   []byte  `thrift:"HashSHA2_512,2" db:"HashSHA2_512" json:"HashSHA2_512"`
+	HashBlake3_512 []byte  `thrift:"HashBlake3_512,3" db:"HashBlake3_512" json:"HashBlake3_512"`
+	HashStable     []byte  `thrift:"HashStable,4" db:"HashStable" json:"HashStable"`
+	Filename       *string `thrift:"Filename,5" db:"Filename" json:"Filename,omitempty"`
+	Version        *string `thrift:"Version,6" db:"Version" json:"Version,omitempty"`
+	Size           int64   `thrift:"Size,7" db:"Size" json:"Size"`
+	TSAdd          int64   `thrift:"TSAdd,8" db:"TSAdd" json:"TSAdd"`
+	TSUpload       *int64  `thrift:"TSUpload,9" db:"TSUpload" json:"TSUpload,omitempty"`
 }
 
-func (p *SearchReportResult_) ReadField1(ctx context.Context, iprot thrift.TProtocol) error {
-	_, size, err := iprot.ReadListBegin(ctx)
-	if err != nil {
-		return thrift.PrependError("error reading list begin: ", err)
-	}
-	tSlice := make([]*AnalyzeResult_, 0, size)
-	p.Found = tSlice
-	for i := 0; i < size; i++ {
-		_elem6 := &AnalyzeResult_{}
-		if err := _elem6.Read(ctx, iprot); err != nil {
-			return thrift.PrependError(fmt.Sprintf("%T error reading struct: ", _elem6), err)
-		}
-		p.Found = append(p.Found, _elem6)
-	}
-	if err := iprot.ReadListEnd(ctx); err != nil {
-		return thrift.PrependError("error reading list end: ", err)
-	}
-	return nil
+func NewFirmwareImageMetadata() *FirmwareImageMetadata {
+	return &FirmwareImageMetadata{}
 }
 
-func (p *SearchReportResult_) Write(ctx context.Context, oprot thrift.TProtocol) error {
-	if err := oprot.WriteStructBegin(ctx, "SearchReportResult"); err != nil {
-		return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
-	}
-	if p != nil {
-		if err := p.writeField1(ctx, oprot); err != nil {
-			return err
-		}
-	}
-	if err := oprot.WriteFieldStop(ctx); err != nil {
-		return thrift.PrependError("write field stop error: ", err)
-	}
-	if err := oprot.WriteStructEnd(ctx); err != nil {
-		return thrift.PrependError("write struct stop error: ", err)
-	}
-	return nil
+func (p *FirmwareImageMetadata) GetImageID() []byte {
+	return p.ImageID
 }
 
-func (p *SearchReportResult_) writeField1(ctx context.Context, oprot thrift.TProtocol) (err error) {
-	if err := oprot.WriteFieldBegin(ctx, "Found", thrift.LIST, 1); err != nil {
-		return thrift.PrependError(fmt.Sprintf("%T write field begin error 1:Found: ", p), err)
-	}
-	if err := oprot.WriteListBegin(ctx, thrift.STRUCT, len(p.Found)); err != nil {
-		return thrift.PrependError("error writing list begin: ", err)
-	}
-	for _, v := range p.Found {
-		if err := v.Write(ctx, oprot); err != nil {
-			return thrift.PrependError(fmt.Sprintf("%T error writing struct: ", v), err)
-		}
-	}
-	if err := oprot.WriteListEnd(ctx); err != nil {
-		return thrift.PrependError("error writing list end: ", err)
-	}
-	if err := oprot.WriteFieldEnd(ctx); err != nil {
-		return thrift.PrependError(fmt.Sprintf("%T write field end error 1:Found: ", p), err)
-	}
-	return err
+func (p *FirmwareImageMetadata) GetHashSHA2_512() []byte {
+	return p.HashSHA2_512
 }
 
-func (p *SearchReportResult_) Equals(other *SearchReportResult_) bool {
-	if p == other {
-		return true
-	} else if p == nil || other == nil {
-		return false
-	}
-	if len(p.Found) != len(other.Found) {
-		return false
-	}
-	for i, _tgt := range p.Found {
-		_src7 := other.Found[i]
-		if !_tgt.Equals(_src7) {
-			return false
-		}
+func (p *FirmwareImageMetadata) GetHashBlake3_512() []byte {
+	return p.HashBlake3_512
+}
+
+func (p *FirmwareImageMetadata) GetHashStable() []byte {
+	return p.HashStable
+}
+
+var FirmwareImageMetadata_Filename_DEFAULT string
+
+func (p *FirmwareImageMetadata) GetFilename() string {
+	if !p.IsSetFilename() {
+		return FirmwareImageMetadata_Filename_DEFAULT
 	}
-	return true
+	return *p.Filename
 }
 
-func (p *SearchReportResult_) String() string {
-	if p == nil {
-		return "<nil>"
+var FirmwareImageMetadata_Version_DEFAULT string
+
+func (p *FirmwareImageMetadata) GetVersion() string {
+	if !p.IsSetVersion() {
+		return FirmwareImageMetadata_Version_DEFAULT
 	}
-	return fmt.Sprintf("SearchReportResult_(%+v)", *p)
+	return *p.Version
 }
 
-// Attributes:
-//   - Version
-type FirmwareVersion struct {
-	Version string `thrift:"Version,1" db:"Version" json:"Version"`
+func (p *FirmwareImageMetadata) GetSize() int64 {
+	return p.Size
 }
 
-func NewFirmwareVersion() *FirmwareVersion {
-	return &FirmwareVersion{}
+func (p *FirmwareImageMetadata) GetTSAdd() int64 {
+	return p.TSAdd
 }
 
-func (p *FirmwareVersion) GetVersion() string {
-	return p.Version
+var FirmwareImageMetadata_TSUpload_DEFAULT int64
+
+func (p *FirmwareImageMetadata) GetTSUpload() int64 {
+	if !p.IsSetTSUpload() {
+		return FirmwareImageMetadata_TSUpload_DEFAULT
+	}
+	return *p.TSUpload
 }
-func (p *FirmwareVersion) Read(ctx context.Context, iprot thrift.TProtocol) error {
+func (p *FirmwareImageMetadata) IsSetFilename() bool {
+	return p.Filename != nil
+}
+
+func (p *FirmwareImageMetadata) IsSetVersion() bool {
+	return p.Version != nil
+}
+
+func (p *FirmwareImageMetadata) IsSetTSUpload() bool {
+	return p.TSUpload != nil
+}
+
+func (p *FirmwareImageMetadata) Read(ctx context.Context, iprot thrift.TProtocol) error {
 	if _, err := iprot.ReadStructBegin(ctx); err != nil {
 		return thrift.PrependError(fmt.Sprintf("%T read error: ", p), err)
 	}
@@ -4138,116 +4445,19 @@ func (p *FirmwareVersion) Read(ctx context.Context, iprot thrift.TProtocol) erro
 					return err
 				}
 			}
-		default:
-			if err := iprot.Skip(ctx, fieldTypeId); err != nil {
-				return err
+		case 2:
+			if fieldTypeId == thrift.STRING {
+				if err := p.ReadField2(ctx, iprot); err != nil {
+					return err
+				}
+			} else {
+				if err := iprot.Skip(ctx, fieldTypeId); err != nil {
+					return err
+				}
 			}
-		}
-		if err := iprot.ReadFieldEnd(ctx); err != nil {
-			return err
-		}
-	}
-	if err := iprot.ReadStructEnd(ctx); err != nil {
-		return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
-	}
-	return nil
-}
-
-func (p *FirmwareVersion) ReadField1(ctx context.Context, iprot thrift.TProtocol) error {
-	if v, err := iprot.ReadString(ctx); err != nil {
-		return thrift.PrependError("error reading field 1: ", err)
-	} else {
-		p.Version = v
-	}
-	return nil
-}
-
-func (p *FirmwareVersion) Write(ctx context.Context, oprot thrift.TProtocol) error {
-	if err := oprot.WriteStructBegin(ctx, "FirmwareVersion"); err != nil {
-		return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
-	}
-	if p != nil {
-		if err := p.writeField1(ctx, oprot); err != nil {
-			return err
-		}
-	}
-	if err := oprot.WriteFieldStop(ctx); err != nil {
-		return thrift.PrependError("write field stop error: ", err)
-	}
-	if err := oprot.WriteStructEnd(ctx); err != nil {
-		return thrift.PrependError("write struct stop error: ", err)
-	}
-	return nil
-}
-
-func (p *FirmwareVersion) writeField1(ctx context.Context, oprot thrift.TProtocol) (err error) {
-	if err := oprot.WriteFieldBegin(ctx, "Version", thrift.STRING, 1); err != nil {
-		return thrift.PrependError(fmt.Sprintf("%T write field begin error 1:Version: ", p), err)
-	}
-	if err := oprot.WriteString(ctx, string(p.Version)); err != nil {
-		return thrift.PrependError(fmt.Sprintf("%T.Version (1) field write error: ", p), err)
-	}
-	if err := oprot.WriteFieldEnd(ctx); err != nil {
-		return thrift.PrependError(fmt.Sprintf("%T write field end error 1:Version: ", p), err)
-	}
-	return err
-}
-
-func (p *FirmwareVersion) Equals(other *FirmwareVersion) bool {
-	if p == other {
-		return true
-	} else if p == nil || other == nil {
-		return false
-	}
-	if p.Version != other.Version {
-		return false
-	}
-	return true
-}
-
-func (p *FirmwareVersion) String() string {
-	if p == nil {
-		return "<nil>"
-	}
-	return fmt.Sprintf("FirmwareVersion(%+v)", *p)
-}
-
-// Attributes:
-//   - Blob
-//   - Compression
-type CompressedBlob struct {
-	Blob        []byte          `thrift:"Blob,1" db:"Blob" json:"Blob"`
-	Compression CompressionType `thrift:"Compression,2" db:"Compression" json:"Compression"`
-}
-
-func NewCompressedBlob() *CompressedBlob {
-	return &CompressedBlob{}
-}
-
-func (p *CompressedBlob) GetBlob() []byte {
-	return p.Blob
-}
-
-func (p *CompressedBlob) GetCompression() CompressionType {
-	return p.Compression
-}
-func (p *CompressedBlob) Read(ctx context.Context, iprot thrift.TProtocol) error {
-	if _, err := iprot.ReadStructBegin(ctx); err != nil {
-		return thrift.PrependError(fmt.Sprintf("%T read error: ", p), err)
-	}
-
-	for {
-		_, fieldTypeId, fieldId, err := iprot.ReadFieldBegin(ctx)
-		if err != nil {
-			return thrift.PrependError(fmt.Sprintf("%T field %d read error: ", p, fieldId), err)
-		}
-		if fieldTypeId == thrift.STOP {
-			break
-		}
-		switch fieldId {
-		case 1:
+		case 3:
 			if fieldTypeId == thrift.STRING {
-				if err := p.ReadField1(ctx, iprot); err != nil {
+				if err := p.ReadField3(ctx, iprot); err != nil {
 					return err
 				}
 			} else {
@@ -4255,9 +4465,59 @@ func (p *CompressedBlob) Read(ctx context.Context, iprot thrift.TProtocol) error
 					return err
 				}
 			}
-		case 2:
-			if fieldTypeId == thrift.I32 {
-				if err := p.ReadField2(ctx, iprot); err != nil {
+		case 4:
+			if fieldTypeId == thrift.STRING {
+				if err := p.ReadField4(ctx, iprot); err != nil {
+					return err
+				}
+			} else {
+				if err := iprot.Skip(ctx, fieldTypeId); err != nil {
+					return err
+				}
+			}
+		case 5:
+			if fieldTypeId == thrift.STRING {
+				if err := p.ReadField5(ctx, iprot); err != nil {
+					return err
+				}
+			} else {
+				if err := iprot.Skip(ctx, fieldTypeId); err != nil {
+					return err
+				}
+			}
+		case 6:
+			if fieldTypeId == thrift.STRING {
+				if err := p.ReadField6(ctx, iprot); err != nil {
+					return err
+				}
+			} else {
+				if err := iprot.Skip(ctx, fieldTypeId); err != nil {
+					return err
+				}
+			}
+		case 7:
+			if fieldTypeId == thrift.I64 {
+				if err := p.ReadField7(ctx, iprot); err != nil {
+					return err
+				}
+			} else {
+				if err := iprot.Skip(ctx, fieldTypeId); err != nil {
+					return err
+				}
+			}
+		case 8:
+			if fieldTypeId == thrift.I64 {
+				if err := p.ReadField8(ctx, iprot); err != nil {
+					return err
+				}
+			} else {
+				if err := iprot.Skip(ctx, fieldTypeId); err != nil {
+					return err
+				}
+			}
+		case 9:
+			if fieldTypeId == thrift.I64 {
+				if err := p.ReadField9(ctx, iprot); err != nil {
 					return err
 				}
 			} else {
@@ -4280,27 +4540,89 @@ func (p *CompressedBlob) Read(ctx context.Context, iprot thrift.TProtocol) error
 	return nil
 }
 
-func (p *CompressedBlob) ReadField1(ctx context.Context, iprot thrift.TProtocol) error {
+func (p *FirmwareImageMetadata) ReadField1(ctx context.Context, iprot thrift.TProtocol) error {
 	if v, err := iprot.ReadBinary(ctx); err != nil {
 		return thrift.PrependError("error reading field 1: ", err)
 	} else {
-		p.Blob = v
+		p.ImageID = v
 	}
 	return nil
 }
 
-func (p *CompressedBlob) ReadField2(ctx context.Context, iprot thrift.TProtocol) error {
-	if v, err := iprot.ReadI32(ctx); err != nil {
+func (p *FirmwareImageMetadata) ReadField2(ctx context.Context, iprot thrift.TProtocol) error {
+	if v, err := iprot.ReadBinary(ctx); err != nil {
 		return thrift.PrependError("error reading field 2: ", err)
 	} else {
-		temp := CompressionType(v)
-		p.Compression = temp
+		p.HashSHA2_512 = v
 	}
 	return nil
 }
 
-func (p *CompressedBlob) Write(ctx context.Context, oprot thrift.TProtocol) error {
-	if err := oprot.WriteStructBegin(ctx, "CompressedBlob"); err != nil {
+func (p *FirmwareImageMetadata) ReadField3(ctx context.Context, iprot thrift.TProtocol) error {
+	if v, err := iprot.ReadBinary(ctx); err != nil {
+		return thrift.PrependError("error reading field 3: ", err)
+	} else {
+		p.HashBlake3_512 = v
+	}
+	return nil
+}
+
+func (p *FirmwareImageMetadata) ReadField4(ctx context.Context, iprot thrift.TProtocol) error {
+	if v, err := iprot.ReadBinary(ctx); err != nil {
+		return thrift.PrependError("error reading field 4: ", err)
+	} else {
+		p.HashStable = v
+	}
+	return nil
+}
+
+func (p *FirmwareImageMetadata) ReadField5(ctx context.Context, iprot thrift.TProtocol) error {
+	if v, err := iprot.ReadString(ctx); err != nil {
+		return thrift.PrependError("error reading field 5: ", err)
+	} else {
+		p.Filename = &v
+	}
+	return nil
+}
+
+func (p *FirmwareImageMetadata) ReadField6(ctx context.Context, iprot thrift.TProtocol) error {
+	if v, err := iprot.ReadString(ctx); err != nil {
+		return thrift.PrependError("error reading field 6: ", err)
+	} else {
+		p.Version = &v
+	}
+	return nil
+}
+
+func (p *FirmwareImageMetadata) ReadField7(ctx context.Context, iprot thrift.TProtocol) error {
+	if v, err := iprot.ReadI64(ctx); err != nil {
+		return thrift.PrependError("error reading field 7: ", err)
+	} else {
+		p.Size = v
+	}
+	return nil
+}
+
+func (p *FirmwareImageMetadata) ReadField8(ctx context.Context, iprot thrift.TProtocol) error {
+	if v, err := iprot.ReadI64(ctx); err != nil {
+		return thrift.PrependError("error reading field 8: ", err)
+	} else {
+		p.TSAdd = v
+	}
+	return nil
+}
+
+func (p *FirmwareImageMetadata) ReadField9(ctx context.Context, iprot thrift.TProtocol) error {
+	if v, err := iprot.ReadI64(ctx); err != nil {
+		return thrift.PrependError("error reading field 9: ", err)
+	} else {
+		p.TSUpload = &v
+	}
+	return nil
+}
+
+func (p *FirmwareImageMetadata) Write(ctx context.Context, oprot thrift.TProtocol) error {
+	if err := oprot.WriteStructBegin(ctx, "FirmwareImageMetadata"); err != nil {
 		return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
 	}
 	if p != nil {
@@ -4310,6 +4632,27 @@ func (p *CompressedBlob) Write(ctx context.Context, oprot thrift.TProtocol) erro
 		if err := p.writeField2(ctx, oprot); err != nil {
 			return err
 		}
+		if err := p.writeField3(ctx, oprot); err != nil {
+			return err
+		}
+		if err := p.writeField4(ctx, oprot); err != nil {
+			return err
+		}
+		if err := p.writeField5(ctx, oprot); err != nil {
+			return err
+		}
+		if err := p.writeField6(ctx, oprot); err != nil {
+			return err
+		}
+		if err := p.writeField7(ctx, oprot); err != nil {
+			return err
+		}
+		if err := p.writeField8(ctx, oprot); err != nil {
+			return err
+		}
+		if err := p.writeField9(ctx, oprot); err != nil {
+			return err
+		}
 	}
 	if err := oprot.WriteFieldStop(ctx); err != nil {
 		return thrift.PrependError("write field stop error: ", err)
@@ -4320,122 +4663,207 @@ func (p *CompressedBlob) Write(ctx context.Context, oprot thrift.TProtocol) erro
 	return nil
 }
 
-func (p *CompressedBlob) writeField1(ctx context.Context, oprot thrift.TProtocol) (err error) {
-	if err := oprot.WriteFieldBegin(ctx, "Blob", thrift.STRING, 1); err != nil {
-		return thrift.PrependError(fmt.Sprintf("%T write field begin error 1:Blob: ", p), err)
+func (p *FirmwareImageMetadata) writeField1(ctx context.Context, oprot thrift.TProtocol) (err error) {
+	if err := oprot.WriteFieldBegin(ctx, "ImageID", thrift.STRING, 1); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T write field begin error 1:ImageID: ", p), err)
 	}
-	if err := oprot.WriteBinary(ctx, p.Blob); err != nil {
-		return thrift.PrependError(fmt.Sprintf("%T.Blob (1) field write error: ", p), err)
+	if err := oprot.WriteBinary(ctx, p.ImageID); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T.ImageID (1) field write error: ", p), err)
 	}
 	if err := oprot.WriteFieldEnd(ctx); err != nil {
-		return thrift.PrependError(fmt.Sprintf("%T write field end error 1:Blob: ", p), err)
+		return thrift.PrependError(fmt.Sprintf("%T write field end error 1:ImageID: ", p), err)
 	}
 	return err
 }
 
-func (p *CompressedBlob) writeField2(ctx context.Context, oprot thrift.TProtocol) (err error) {
-	if err := oprot.WriteFieldBegin(ctx, "Compression", thrift.I32, 2); err != nil {
-		return thrift.PrependError(fmt.Sprintf("%T write field begin error 2:Compression: ", p), err)
+func (p *FirmwareImageMetadata) writeField2(ctx context.Context, oprot thrift.TProtocol) (err error) {
+	if err := oprot.WriteFieldBegin(ctx, "HashSHA2_512", thrift.STRING, 2); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T write field begin error 2:HashSHA2_512: ", p), err)
 	}
-	if err := oprot.WriteI32(ctx, int32(p.Compression)); err != nil {
-		return thrift.PrependError(fmt.Sprintf("%T.Compression (2) field write error: ", p), err)
+	if err := oprot.WriteBinary(ctx, p.HashSHA2_512); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T.HashSHA2_512 (2) field write error: ", p), err)
 	}
 	if err := oprot.WriteFieldEnd(ctx); err != nil {
-		return thrift.PrependError(fmt.Sprintf("%T write field end error 2:Compression: ", p), err)
+		return thrift.PrependError(fmt.Sprintf("%T write field end error 2:HashSHA2_512: ", p), err)
 	}
 	return err
 }
 
-func (p *CompressedBlob) Equals(other *CompressedBlob) bool {
-	if p == other {
-		return true
-	} else if p == nil || other == nil {
-		return false
+func (p *FirmwareImageMetadata) writeField3(ctx context.Context, oprot thrift.TProtocol) (err error) {
+	if err := oprot.WriteFieldBegin(ctx, "HashBlake3_512", thrift.STRING, 3); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T write field begin error 3:HashBlake3_512: ", p), err)
 	}
-	if bytes.Compare(p.Blob, other.Blob) != 0 {
-		return false
+	if err := oprot.WriteBinary(ctx, p.HashBlake3_512); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T.HashBlake3_512 (3) field write error: ", p), err)
 	}
-	if p.Compression != other.Compression {
-		return false
+	if err := oprot.WriteFieldEnd(ctx); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T write field end error 3:HashBlake3_512: ", p), err)
 	}
-	return true
+	return err
 }
 
-func (p *CompressedBlob) String() string {
-	if p == nil {
-		return "<nil>"
+func (p *FirmwareImageMetadata) writeField4(ctx context.Context, oprot thrift.TProtocol) (err error) {
+	if err := oprot.WriteFieldBegin(ctx, "HashStable", thrift.STRING, 4); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T write field begin error 4:HashStable: ", p), err)
 	}
-	return fmt.Sprintf("CompressedBlob(%+v)", *p)
+	if err := oprot.WriteBinary(ctx, p.HashStable); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T.HashStable (4) field write error: ", p), err)
+	}
+	if err := oprot.WriteFieldEnd(ctx); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T write field end error 4:HashStable: ", p), err)
+	}
+	return err
 }
 
-// Attributes:
-//   - Blob
-//   - BlobStorageKey
-//   - FirmwareVersion
-type FirmwareImage struct {
-	Blob            *CompressedBlob  `thrift:"Blob,1" db:"Blob" json:"Blob,omitempty"`
-	BlobStorageKey  *string          `thrift:"BlobStorageKey,2" db:"BlobStorageKey" json:"BlobStorageKey,omitempty"`
-	FirmwareVersion *FirmwareVersion `thrift:"FirmwareVersion,3" db:"FirmwareVersion" json:"FirmwareVersion,omitempty"`
+func (p *FirmwareImageMetadata) writeField5(ctx context.Context, oprot thrift.TProtocol) (err error) {
+	if p.IsSetFilename() {
+		if err := oprot.WriteFieldBegin(ctx, "Filename", thrift.STRING, 5); err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T write field begin error 5:Filename: ", p), err)
+		}
+		if err := oprot.WriteString(ctx, string(*p.Filename)); err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T.Filename (5) field write error: ", p), err)
+		}
+		if err := oprot.WriteFieldEnd(ctx); err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T write field end error 5:Filename: ", p), err)
+		}
+	}
+	return err
 }
 
-func NewFirmwareImage() *FirmwareImage {
-	return &FirmwareImage{}
+func (p *FirmwareImageMetadata) writeField6(ctx context.Context, oprot thrift.TProtocol) (err error) {
+	if p.IsSetVersion() {
+		if err := oprot.WriteFieldBegin(ctx, "Version", thrift.STRING, 6); err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T write field begin error 6:Version: ", p), err)
+		}
+		if err := oprot.WriteString(ctx, string(*p.Version)); err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T.Version (6) field write error: ", p), err)
+		}
+		if err := oprot.WriteFieldEnd(ctx); err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T write field end error 6:Version: ", p), err)
+		}
+	}
+	return err
 }
 
-var FirmwareImage_Blob_DEFAULT *CompressedBlob
-
-func (p *FirmwareImage) GetBlob() *CompressedBlob {
-	if !p.IsSetBlob() {
-		return FirmwareImage_Blob_DEFAULT
+func (p *FirmwareImageMetadata) writeField7(ctx context.Context, oprot thrift.TProtocol) (err error) {
+	if err := oprot.WriteFieldBegin(ctx, "Size", thrift.I64, 7); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T write field begin error 7:Size: ", p), err)
 	}
-	return p.Blob
+	if err := oprot.WriteI64(ctx, int64(p.Size)); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T.Size (7) field write error: ", p), err)
+	}
+	if err := oprot.WriteFieldEnd(ctx); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T write field end error 7:Size: ", p), err)
+	}
+	return err
 }
 
-var FirmwareImage_BlobStorageKey_DEFAULT string
-
-func (p *FirmwareImage) GetBlobStorageKey() string {
-	if !p.IsSetBlobStorageKey() {
-		return FirmwareImage_BlobStorageKey_DEFAULT
+func (p *FirmwareImageMetadata) writeField8(ctx context.Context, oprot thrift.TProtocol) (err error) {
+	if err := oprot.WriteFieldBegin(ctx, "TSAdd", thrift.I64, 8); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T write field begin error 8:TSAdd: ", p), err)
 	}
-	return *p.BlobStorageKey
+	if err := oprot.WriteI64(ctx, int64(p.TSAdd)); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T.TSAdd (8) field write error: ", p), err)
+	}
+	if err := oprot.WriteFieldEnd(ctx); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T write field end error 8:TSAdd: ", p), err)
+	}
+	return err
 }
 
-var FirmwareImage_FirmwareVersion_DEFAULT *FirmwareVersion
-
-func (p *FirmwareImage) GetFirmwareVersion() *FirmwareVersion {
-	if !p.IsSetFirmwareVersion() {
-		return FirmwareImage_FirmwareVersion_DEFAULT
+func (p *FirmwareImageMetadata) writeField9(ctx context.Context, oprot thrift.TProtocol) (err error) {
+	if p.IsSetTSUpload() {
+		if err := oprot.WriteFieldBegin(ctx, "TSUpload", thrift.I64, 9); err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T write field begin error 9:TSUpload: ", p), err)
+		}
+		if err := oprot.WriteI64(ctx, int64(*p.TSUpload)); err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T.TSUpload (9) field write error: ", p), err)
+		}
+		if err := oprot.WriteFieldEnd(ctx); err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T write field end error 9:TSUpload: ", p), err)
+		}
 	}
-	return p.FirmwareVersion
+	return err
 }
-func (p *FirmwareImage) CountSetFieldsFirmwareImage() int {
-	count := 0
-	if p.IsSetBlob() {
-		count++
+
+func (p *FirmwareImageMetadata) Equals(other *FirmwareImageMetadata) bool {
+	if p == other {
+		return true
+	} else if p == nil || other == nil {
+		return false
 	}
-	if p.IsSetBlobStorageKey() {
-		count++
+	if bytes.Compare(p.ImageID, other.ImageID) != 0 {
+		return false
 	}
-	if p.IsSetFirmwareVersion() {
-		count++
+	if bytes.Compare(p.HashSHA2_512, other.HashSHA2_512) != 0 {
+		return false
 	}
-	return count
+	if bytes.Compare(p.HashBlake3_512, other.HashBlake3_512) != 0 {
+		return false
+	}
+	if bytes.Compare(p.HashStable, other.HashStable) != 0 {
+		return false
+	}
+	if p.Filename != other.Filename {
+		if p.Filename == nil || other.Filename == nil {
+			return false
+		}
+		if (*p.Filename) != (*other.Filename) {
+			return false
+		}
+	}
+	if p.Version != other.Version {
+		if p.Version == nil || other.Version == nil {
+			return false
+		}
+		if (*p.Version) != (*other.Version) {
+			return false
+		}
+	}
+	if p.Size != other.Size {
+		return false
+	}
+	if p.TSAdd != other.TSAdd {
+		return false
+	}
+	if p.TSUpload != other.TSUpload {
+		if p.TSUpload == nil || other.TSUpload == nil {
+			return false
+		}
+		if (*p.TSUpload) != (*other.TSUpload) {
+			return false
+		}
+	}
+	return true
+}
 
+func (p *FirmwareImageMetadata) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("FirmwareImageMetadata(%+v)", *p)
 }
 
-func (p *FirmwareImage) IsSetBlob() bool {
-	return p.Blob != nil
+// Attributes:
+//   - OrFilters
+//   - Limit
+type SearchReportRequest struct {
+	OrFilters []*SearchReportFilters `thrift:"OrFilters,1" db:"OrFilters" json:"OrFilters"`
+	Limit     int64                  `thrift:"Limit,2" db:"Limit" json:"Limit"`
 }
 
-func (p *FirmwareImage) IsSetBlobStorageKey() bool {
-	return p.BlobStorageKey != nil
+func NewSearchReportRequest() *SearchReportRequest {
+	return &SearchReportRequest{}
 }
 
-func (p *FirmwareImage) IsSetFirmwareVersion() bool {
-	return p.FirmwareVersion != nil
+func (p *SearchReportRequest) GetOrFilters() []*SearchReportFilters {
+	return p.OrFilters
 }
 
-func (p *FirmwareImage) Read(ctx context.Context, iprot thrift.TProtocol) error {
+func (p *SearchReportRequest) GetLimit() int64 {
+	return p.Limit
+}
+func (p *SearchReportRequest) Read(ctx context.Context, iprot thrift.TProtocol) error {
 	if _, err := iprot.ReadStructBegin(ctx); err != nil {
 		return thrift.PrependError(fmt.Sprintf("%T read error: ", p), err)
 	}
@@ -4450,7 +4878,7 @@ func (p *FirmwareImage) Read(ctx context.Context, iprot thrift.TProtocol) error
 		}
 		switch fieldId {
 		case 1:
-			if fieldTypeId == thrift.STRUCT {
+			if fieldTypeId == thrift.LIST {
 				if err := p.ReadField1(ctx, iprot); err != nil {
 					return err
 				}
@@ -4460,7 +4888,7 @@ func (p *FirmwareImage) Read(ctx context.Context, iprot thrift.TProtocol) error
 				}
 			}
 		case 2:
-			if fieldTypeId == thrift.STRING {
+			if fieldTypeId == thrift.I64 {
 				if err := p.ReadField2(ctx, iprot); err != nil {
 					return err
 				}
@@ -4469,16 +4897,6 @@ func (p *FirmwareImage) Read(ctx context.Context, iprot thrift.TProtocol) error
 					return err
 				}
 			}
-		case 3:
-			if fieldTypeId == thrift.STRUCT {
-				if err := p.ReadField3(ctx, iprot); err != nil {
-					return err
-				}
-			} else {
-				if err := iprot.Skip(ctx, fieldTypeId); err != nil {
-					return err
-				}
-			}
 		default:
 			if err := iprot.Skip(ctx, fieldTypeId); err != nil {
 				return err
@@ -4494,36 +4912,37 @@ func (p *FirmwareImage) Read(ctx context.Context, iprot thrift.TProtocol) error
 	return nil
 }
 
-func (p *FirmwareImage) ReadField1(ctx context.Context, iprot thrift.TProtocol) error {
-	p.Blob = &CompressedBlob{}
-	if err := p.Blob.Read(ctx, iprot); err != nil {
-		return thrift.PrependError(fmt.Sprintf("%T error reading struct: ", p.Blob), err)
+func (p *SearchReportRequest) ReadField1(ctx context.Context, iprot thrift.TProtocol) error {
+	_, size, err := iprot.ReadListBegin(ctx)
+	if err != nil {
+		return thrift.PrependError("error reading list begin: ", err)
+	}
+	tSlice := make([]*SearchReportFilters, 0, size)
+	p.OrFilters = tSlice
+	for i := 0; i < size; i++ {
+		_elem8 := &SearchReportFilters{}
+		if err := _elem8.Read(ctx, iprot); err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T error reading struct: ", _elem8), err)
+		}
+		p.OrFilters = append(p.OrFilters, _elem8)
+	}
+	if err := iprot.ReadListEnd(ctx); err != nil {
+		return thrift.PrependError("error reading list end: ", err)
 	}
 	return nil
 }
 
-func (p *FirmwareImage) ReadField2(ctx context.Context, iprot thrift.TProtocol) error {
-	if v, err := iprot.ReadString(ctx); err != nil {
+func (p *SearchReportRequest) ReadField2(ctx context.Context, iprot thrift.TProtocol) error {
+	if v, err := iprot.ReadI64(ctx); err != nil {
 		return thrift.PrependError("error reading field 2: ", err)
 	} else {
-		p.BlobStorageKey = &v
-	}
-	return nil
-}
-
-func (p *FirmwareImage) ReadField3(ctx context.Context, iprot thrift.TProtocol) error {
-	p.FirmwareVersion = &FirmwareVersion{}
-	if err := p.FirmwareVersion.Read(ctx, iprot); err != nil {
-		return thrift.PrependError(fmt.Sprintf("%T error reading struct: ", p.FirmwareVersion), err)
+		p.Limit = v
 	}
 	return nil
 }
 
-func (p *FirmwareImage) Write(ctx context.Context, oprot thrift.TProtocol) error {
-	if c := p.CountSetFieldsFirmwareImage(); c != 1 {
-		return fmt.Errorf("%T write union: exactly one field must be set (%d set).", p, c)
-	}
-	if err := oprot.WriteStructBegin(ctx, "FirmwareImage"); err != nil {
+func (p *SearchReportRequest) Write(ctx context.Context, oprot thrift.TProtocol) error {
+	if err := oprot.WriteStructBegin(ctx, "SearchReportRequest"); err != nil {
 		return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
 	}
 	if p != nil {
@@ -4533,9 +4952,6 @@ func (p *FirmwareImage) Write(ctx context.Context, oprot thrift.TProtocol) error
 		if err := p.writeField2(ctx, oprot); err != nil {
 			return err
 		}
-		if err := p.writeField3(ctx, oprot); err != nil {
-			return err
-		}
 	}
 	if err := oprot.WriteFieldStop(ctx); err != nil {
 		return thrift.PrependError("write field stop error: ", err)
@@ -4546,101 +4962,118 @@ func (p *FirmwareImage) Write(ctx context.Context, oprot thrift.TProtocol) error
 	return nil
 }
 
-func (p *FirmwareImage) writeField1(ctx context.Context, oprot thrift.TProtocol) (err error) {
-	if p.IsSetBlob() {
-		if err := oprot.WriteFieldBegin(ctx, "Blob", thrift.STRUCT, 1); err != nil {
-			return thrift.PrependError(fmt.Sprintf("%T write field begin error 1:Blob: ", p), err)
-		}
-		if err := p.Blob.Write(ctx, oprot); err != nil {
-			return thrift.PrependError(fmt.Sprintf("%T error writing struct: ", p.Blob), err)
-		}
-		if err := oprot.WriteFieldEnd(ctx); err != nil {
-			return thrift.PrependError(fmt.Sprintf("%T write field end error 1:Blob: ", p), err)
+func (p *SearchReportRequest) writeField1(ctx context.Context, oprot thrift.TProtocol) (err error) {
+	if err := oprot.WriteFieldBegin(ctx, "OrFilters", thrift.LIST, 1); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T write field begin error 1:OrFilters: ", p), err)
+	}
+	if err := oprot.WriteListBegin(ctx, thrift.STRUCT, len(p.OrFilters)); err != nil {
+		return thrift.PrependError("error writing list begin: ", err)
+	}
+	for _, v := range p.OrFilters {
+		if err := v.Write(ctx, oprot); err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T error writing struct: ", v), err)
 		}
 	}
+	if err := oprot.WriteListEnd(ctx); err != nil {
+		return thrift.PrependError("error writing list end: ", err)
+	}
+	if err := oprot.WriteFieldEnd(ctx); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T write field end error 1:OrFilters: ", p), err)
+	}
 	return err
 }
 
-func (p *FirmwareImage) writeField2(ctx context.Context, oprot thrift.TProtocol) (err error) {
-	if p.IsSetBlobStorageKey() {
-		if err := oprot.WriteFieldBegin(ctx, "BlobStorageKey", thrift.STRING, 2); err != nil {
-			return thrift.PrependError(fmt.Sprintf("%T write field begin error 2:BlobStorageKey: ", p), err)
-		}
-		if err := oprot.WriteString(ctx, string(*p.BlobStorageKey)); err != nil {
-			return thrift.PrependError(fmt.Sprintf("%T.BlobStorageKey (2) field write error: ", p), err)
-		}
-		if err := oprot.WriteFieldEnd(ctx); err != nil {
-			return thrift.PrependError(fmt.Sprintf("%T write field end error 2:BlobStorageKey: ", p), err)
-		}
+func (p *SearchReportRequest) writeField2(ctx context.Context, oprot thrift.TProtocol) (err error) {
+	if err := oprot.WriteFieldBegin(ctx, "Limit", thrift.I64, 2); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T write field begin error 2:Limit: ", p), err)
 	}
-	return err
-}
-
-func (p *FirmwareImage) writeField3(ctx context.Context, oprot thrift.TProtocol) (err error) {
-	if p.IsSetFirmwareVersion() {
-		if err := oprot.WriteFieldBegin(ctx, "FirmwareVersion", thrift.STRUCT, 3); err != nil {
-			return thrift.PrependError(fmt.Sprintf("%T write field begin error 3:FirmwareVersion: ", p), err)
-		}
-		if err := p.FirmwareVersion.Write(ctx, oprot); err != nil {
-			return thrift.PrependError(fmt.Sprintf("%T error writing struct: ", p.FirmwareVersion), err)
-		}
-		if err := oprot.WriteFieldEnd(ctx); err != nil {
-			return thrift.PrependError(fmt.Sprintf("%T write field end error 3:FirmwareVersion: ", p), err)
-		}
+	if err := oprot.WriteI64(ctx, int64(p.Limit)); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T.Limit (2) field write error: ", p), err)
+	}
+	if err := oprot.WriteFieldEnd(ctx); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T write field end error 2:Limit: ", p), err)
 	}
 	return err
 }
 
-func (p *FirmwareImage) Equals(other *FirmwareImage) bool {
+func (p *SearchReportRequest) Equals(other *SearchReportRequest) bool {
 	if p == other {
 		return true
 	} else if p == nil || other == nil {
 		return false
 	}
-	if !p.Blob.Equals(other.Blob) {
+	if len(p.OrFilters) != len(other.OrFilters) {
 		return false
 	}
-	if p.BlobStorageKey != other.BlobStorageKey {
-		if p.BlobStorageKey == nil || other.BlobStorageKey == nil {
-			return false
-		}
-		if (*p.BlobStorageKey) != (*other.BlobStorageKey) {
+	for i, _tgt := range p.OrFilters {
+		_src9 := other.OrFilters[i]
+		if !_tgt.Equals(_src9) {
 			return false
 		}
 	}
-	if !p.FirmwareVersion.Equals(other.FirmwareVersion) {
+	if p.Limit != other.Limit {
 		return false
 	}
 	return true
 }
 
-func (p *FirmwareImage) String() string {
+func (p *SearchReportRequest) String() string {
 	if p == nil {
 		return "<nil>"
 	}
-	return fmt.Sprintf("FirmwareImage(%+v)", *p)
+	return fmt.Sprintf("SearchReportRequest(%+v)", *p)
 }
 
 // Attributes:
-//   - Value
-//   - Index
-type PCR struct {
-	Value []byte `thrift:"Value,1" db:"Value" json:"Value"`
-	Index int32  `thrift:"Index,2" db:"Index" json:"Index"`
+//   - JobID
+//   - AssetID
+//   - ActualFirmware
+type SearchReportFilters struct {
+	JobID          []byte                 `thrift:"JobID,1" db:"JobID" json:"JobID,omitempty"`
+	AssetID        *int64                 `thrift:"AssetID,2" db:"AssetID" json:"AssetID,omitempty"`
+	ActualFirmware *SearchFirmwareFilters `thrift:"ActualFirmware,3" db:"ActualFirmware" json:"ActualFirmware"`
 }
 
-func NewPCR() *PCR {
-	return &PCR{}
+func NewSearchReportFilters() *SearchReportFilters {
+	return &SearchReportFilters{}
 }
 
-func (p *PCR) GetValue() []byte {
-	return p.Value
+var SearchReportFilters_JobID_DEFAULT []byte
+
+func (p *SearchReportFilters) GetJobID() []byte {
+	return p.JobID
 }
 
-func (p *PCR) GetIndex() int32 {
-	return p.Index
+var SearchReportFilters_AssetID_DEFAULT int64
+
+func (p *SearchReportFilters) GetAssetID() int64 {
+	if !p.IsSetAssetID() {
+		return SearchReportFilters_AssetID_DEFAULT
+	}
+	return *p.AssetID
 }
-func (p *PCR) Read(ctx context.Context, iprot thrift.TProtocol) error {
+
+var SearchReportFilters_ActualFirmware_DEFAULT *SearchFirmwareFilters
+
+func (p *SearchReportFilters) GetActualFirmware() *SearchFirmwareFilters {
+	if !p.IsSetActualFirmware() {
+		return SearchReportFilters_ActualFirmware_DEFAULT
+	}
+	return p.ActualFirmware
+}
+func (p *SearchReportFilters) IsSetJobID() bool {
+	return p.JobID != nil
+}
+
+func (p *SearchReportFilters) IsSetAssetID() bool {
+	return p.AssetID != nil
+}
+
+func (p *SearchReportFilters) IsSetActualFirmware() bool {
+	return p.ActualFirmware != nil
+}
+
+func (p *SearchReportFilters) Read(ctx context.Context, iprot thrift.TProtocol) error {
 	if _, err := iprot.ReadStructBegin(ctx); err != nil {
 		return thrift.PrependError(fmt.Sprintf("%T read error: ", p), err)
 	}
@@ -4665,7 +5098,7 @@ func (p *PCR) Read(ctx context.Context, iprot thrift.TProtocol) error {
 				}
 			}
 		case 2:
-			if fieldTypeId == thrift.I32 {
+			if fieldTypeId == thrift.I64 {
 				if err := p.ReadField2(ctx, iprot); err != nil {
 					return err
 				}
@@ -4674,6 +5107,16 @@ func (p *PCR) Read(ctx context.Context, iprot thrift.TProtocol) error {
 					return err
 				}
 			}
+		case 3:
+			if fieldTypeId == thrift.STRUCT {
+				if err := p.ReadField3(ctx, iprot); err != nil {
+					return err
+				}
+			} else {
+				if err := iprot.Skip(ctx, fieldTypeId); err != nil {
+					return err
+				}
+			}
 		default:
 			if err := iprot.Skip(ctx, fieldTypeId); err != nil {
 				return err
@@ -4689,26 +5132,34 @@ func (p *PCR) Read(ctx context.Context, iprot thrift.TProtocol) error {
 	return nil
 }
 
-func (p *PCR) ReadField1(ctx context.Context, iprot thrift.TProtocol) error {
+func (p *SearchReportFilters) ReadField1(ctx context.Context, iprot thrift.TProtocol) error {
 	if v, err := iprot.ReadBinary(ctx); err != nil {
 		return thrift.PrependError("error reading field 1: ", err)
 	} else {
-		p.Value = v
+		p.JobID = v
 	}
 	return nil
 }
 
-func (p *PCR) ReadField2(ctx context.Context, iprot thrift.TProtocol) error {
-	if v, err := iprot.ReadI32(ctx); err != nil {
+func (p *SearchReportFilters) ReadField2(ctx context.Context, iprot thrift.TProtocol) error {
+	if v, err := iprot.ReadI64(ctx); err != nil {
 		return thrift.PrependError("error reading field 2: ", err)
 	} else {
-		p.Index = v
+		p.AssetID = &v
 	}
 	return nil
 }
 
-func (p *PCR) Write(ctx context.Context, oprot thrift.TProtocol) error {
-	if err := oprot.WriteStructBegin(ctx, "PCR"); err != nil {
+func (p *SearchReportFilters) ReadField3(ctx context.Context, iprot thrift.TProtocol) error {
+	p.ActualFirmware = &SearchFirmwareFilters{}
+	if err := p.ActualFirmware.Read(ctx, iprot); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T error reading struct: ", p.ActualFirmware), err)
+	}
+	return nil
+}
+
+func (p *SearchReportFilters) Write(ctx context.Context, oprot thrift.TProtocol) error {
+	if err := oprot.WriteStructBegin(ctx, "SearchReportFilters"); err != nil {
 		return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
 	}
 	if p != nil {
@@ -4718,6 +5169,9 @@ func (p *PCR) Write(ctx context.Context, oprot thrift.TProtocol) error {
 		if err := p.writeField2(ctx, oprot); err != nil {
 			return err
 		}
+		if err := p.writeField3(ctx, oprot); err != nil {
+			return err
+		}
 	}
 	if err := oprot.WriteFieldStop(ctx); err != nil {
 		return thrift.PrependError("write field stop error: ", err)
@@ -4728,173 +5182,235 @@ func (p *PCR) Write(ctx context.Context, oprot thrift.TProtocol) error {
 	return nil
 }
 
-func (p *PCR) writeField1(ctx context.Context, oprot thrift.TProtocol) (err error) {
-	if err := oprot.WriteFieldBegin(ctx, "Value", thrift.STRING, 1); err != nil {
-		return thrift.PrependError(fmt.Sprintf("%T write field begin error 1:Value: ", p), err)
-	}
-	if err := oprot.WriteBinary(ctx, p.Value); err != nil {
-		return thrift.PrependError(fmt.Sprintf("%T.Value (1) field write error: ", p), err)
+func (p *SearchReportFilters) writeField1(ctx context.Context, oprot thrift.TProtocol) (err error) {
+	if p.IsSetJobID() {
+		if err := oprot.WriteFieldBegin(ctx, "JobID", thrift.STRING, 1); err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T write field begin error 1:JobID: ", p), err)
+		}
+		if err := oprot.WriteBinary(ctx, p.JobID); err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T.JobID (1) field write error: ", p), err)
+		}
+		if err := oprot.WriteFieldEnd(ctx); err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T write field end error 1:JobID: ", p), err)
+		}
 	}
-	if err := oprot.WriteFieldEnd(ctx); err != nil {
-		return thrift.PrependError(fmt.Sprintf("%T write field end error 1:Value: ", p), err)
+	return err
+}
+
+func (p *SearchReportFilters) writeField2(ctx context.Context, oprot thrift.TProtocol) (err error) {
+	if p.IsSetAssetID() {
+		if err := oprot.WriteFieldBegin(ctx, "AssetID", thrift.I64, 2); err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T write field begin error 2:AssetID: ", p), err)
+		}
+		if err := oprot.WriteI64(ctx, int64(*p.AssetID)); err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T.AssetID (2) field write error: ", p), err)
+		}
+		if err := oprot.WriteFieldEnd(ctx); err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T write field end error 2:AssetID: ", p), err)
+		}
 	}
 	return err
 }
 
-func (p *PCR) writeField2(ctx context.Context, oprot thrift.TProtocol) (err error) {
-	if err := oprot.WriteFieldBegin(ctx, "Index", thrift.I32, 2); err != nil {
-		return thrift.PrependError(fmt.Sprintf("%T write field begin error 2:Index: ", p), err)
+func (p *SearchReportFilters) writeField3(ctx context.Context, oprot thrift.TProtocol) (err error) {
+	if err := oprot.WriteFieldBegin(ctx, "ActualFirmware", thrift.STRUCT, 3); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T write field begin error 3:ActualFirmware: ", p), err)
 	}
-	if err := oprot.WriteI32(ctx, int32(p.Index)); err != nil {
-		return thrift.PrependError(fmt.Sprintf("%T.Index (2) field write error: ", p), err)
+	if err := p.ActualFirmware.Write(ctx, oprot); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T error writing struct: ", p.ActualFirmware), err)
 	}
 	if err := oprot.WriteFieldEnd(ctx); err != nil {
-		return thrift.PrependError(fmt.Sprintf("%T write field end error 2:Index: ", p), err)
+		return thrift.PrependError(fmt.Sprintf("%T write field end error 3:ActualFirmware: ", p), err)
 	}
 	return err
 }
 
-func (p *PCR) Equals(other *PCR) bool {
+func (p *SearchReportFilters) Equals(other *SearchReportFilters) bool {
 	if p == other {
 		return true
 	} else if p == nil || other == nil {
 		return false
 	}
-	if bytes.Compare(p.Value, other.Value) != 0 {
+	if bytes.Compare(p.JobID, other.JobID) != 0 {
 		return false
 	}
-	if p.Index != other.Index {
+	if p.AssetID != other.AssetID {
+		if p.AssetID == nil || other.AssetID == nil {
+			return false
+		}
+		if (*p.AssetID) != (*other.AssetID) {
+			return false
+		}
+	}
+	if !p.ActualFirmware.Equals(other.ActualFirmware) {
 		return false
 	}
 	return true
 }
 
-func (p *PCR) String() string {
+func (p *SearchReportFilters) String() string {
 	if p == nil {
 		return "<nil>"
 	}
-	return fmt.Sprintf("PCR(%+v)", *p)
+	return fmt.Sprintf("SearchReportFilters(%+v)", *p)
 }
 
 // Attributes:
-//   - FwImage
-//   - Pcr
-//   - TPMDevice
-//   - TPMEventLog
-//   - StatusRegisters
-//   - MeasurementsFlow
-type Artifact struct {
-	FwImage          *FirmwareImage     `thrift:"FwImage,1" db:"FwImage" json:"FwImage,omitempty"`
-	Pcr              *PCR               `thrift:"Pcr,2" db:"Pcr" json:"Pcr,omitempty"`
-	TPMDevice        *TPMType           `thrift:"TPMDevice,3" db:"TPMDevice" json:"TPMDevice,omitempty"`
-	TPMEventLog      *tpm.EventLog      `thrift:"TPMEventLog,4" db:"TPMEventLog" json:"TPMEventLog,omitempty"`
-	StatusRegisters  []*StatusRegister  `thrift:"StatusRegisters,5" db:"StatusRegisters" json:"StatusRegisters,omitempty"`
-	MeasurementsFlow *measurements.Flow `thrift:"MeasurementsFlow,6" db:"MeasurementsFlow" json:"MeasurementsFlow,omitempty"`
-}
-
-func NewArtifact() *Artifact {
-	return &Artifact{}
-}
-
-var Artifact_FwImage_DEFAULT *FirmwareImage
-
-func (p *Artifact) GetFwImage() *FirmwareImage {
-	if !p.IsSetFwImage() {
-		return Artifact_FwImage_DEFAULT
-	}
-	return p.FwImage
+//   - Found
+type SearchReportResult_ struct {
+	Found []*AnalyzeResult_ `thrift:"Found,1" db:"Found" json:"Found"`
 }
 
-var Artifact_Pcr_DEFAULT *PCR
-
-func (p *Artifact) GetPcr() *PCR {
-	if !p.IsSetPcr() {
-		return Artifact_Pcr_DEFAULT
-	}
-	return p.Pcr
+func NewSearchReportResult_() *SearchReportResult_ {
+	return &SearchReportResult_{}
 }
 
-var Artifact_TPMDevice_DEFAULT TPMType
-
-func (p *Artifact) GetTPMDevice() TPMType {
-	if !p.IsSetTPMDevice() {
-		return Artifact_TPMDevice_DEFAULT
-	}
-	return *p.TPMDevice
+func (p *SearchReportResult_) GetFound() []*AnalyzeResult_ {
+	return p.Found
 }
-
-var Artifact_TPMEventLog_DEFAULT *tpm.EventLog
-
-func (p *Artifact) GetTPMEventLog() *tpm.EventLog {
-	if !p.IsSetTPMEventLog() {
-		return Artifact_TPMEventLog_DEFAULT
+func (p *SearchReportResult_) Read(ctx context.Context, iprot thrift.TProtocol) error {
+	if _, err := iprot.ReadStructBegin(ctx); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T read error: ", p), err)
 	}
-	return p.TPMEventLog
-}
-
-var Artifact_StatusRegisters_DEFAULT []*StatusRegister
 
-func (p *Artifact) GetStatusRegisters() []*StatusRegister {
-	return p.StatusRegisters
+	for {
+		_, fieldTypeId, fieldId, err := iprot.ReadFieldBegin(ctx)
+		if err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T field %d read error: ", p, fieldId), err)
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+		switch fieldId {
+		case 1:
+			if fieldTypeId == thrift.LIST {
+				if err := p.ReadField1(ctx, iprot); err != nil {
+					return err
+				}
+			} else {
+				if err := iprot.Skip(ctx, fieldTypeId); err != nil {
+					return err
+				}
+			}
+		default:
+			if err := iprot.Skip(ctx, fieldTypeId); err != nil {
+				return err
+			}
+		}
+		if err := iprot.ReadFieldEnd(ctx); err != nil {
+			return err
+		}
+	}
+	if err := iprot.ReadStructEnd(ctx); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
+	}
+	return nil
 }
 
-var Artifact_MeasurementsFlow_DEFAULT measurements.Flow
-
-func (p *Artifact) GetMeasurementsFlow() measurements.Flow {
-	if !p.IsSetMeasurementsFlow() {
-		return Artifact_MeasurementsFlow_DEFAULT
+func (p *SearchReportResult_) ReadField1(ctx context.Context, iprot thrift.TProtocol) error {
+	_, size, err := iprot.ReadListBegin(ctx)
+	if err != nil {
+		return thrift.PrependError("error reading list begin: ", err)
 	}
-	return *p.MeasurementsFlow
-}
-func (p *Artifact) CountSetFieldsArtifact() int {
-	count := 0
-	if p.IsSetFwImage() {
-		count++
+	tSlice := make([]*AnalyzeResult_, 0, size)
+	p.Found = tSlice
+	for i := 0; i < size; i++ {
+		_elem10 := &AnalyzeResult_{}
+		if err := _elem10.Read(ctx, iprot); err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T error reading struct: ", _elem10), err)
+		}
+		p.Found = append(p.Found, _elem10)
 	}
-	if p.IsSetPcr() {
-		count++
+	if err := iprot.ReadListEnd(ctx); err != nil {
+		return thrift.PrependError("error reading list end: ", err)
 	}
-	if p.IsSetTPMDevice() {
-		count++
+	return nil
+}
+
+func (p *SearchReportResult_) Write(ctx context.Context, oprot thrift.TProtocol) error {
+	if err := oprot.WriteStructBegin(ctx, "SearchReportResult"); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
 	}
-	if p.IsSetTPMEventLog() {
-		count++
+	if p != nil {
+		if err := p.writeField1(ctx, oprot); err != nil {
+			return err
+		}
 	}
-	if p.IsSetStatusRegisters() {
-		count++
+	if err := oprot.WriteFieldStop(ctx); err != nil {
+		return thrift.PrependError("write field stop error: ", err)
 	}
-	if p.IsSetMeasurementsFlow() {
-		count++
+	if err := oprot.WriteStructEnd(ctx); err != nil {
+		return thrift.PrependError("write struct stop error: ", err)
 	}
-	return count
-
+	return nil
 }
 
-func (p *Artifact) IsSetFwImage() bool {
-	return p.FwImage != nil
+func (p *SearchReportResult_) writeField1(ctx context.Context, oprot thrift.TProtocol) (err error) {
+	if err := oprot.WriteFieldBegin(ctx, "Found", thrift.LIST, 1); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T write field begin error 1:Found: ", p), err)
+	}
+	if err := oprot.WriteListBegin(ctx, thrift.STRUCT, len(p.Found)); err != nil {
+		return thrift.PrependError("error writing list begin: ", err)
+	}
+	for _, v := range p.Found {
+		if err := v.Write(ctx, oprot); err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T error writing struct: ", v), err)
+		}
+	}
+	if err := oprot.WriteListEnd(ctx); err != nil {
+		return thrift.PrependError("error writing list end: ", err)
+	}
+	if err := oprot.WriteFieldEnd(ctx); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T write field end error 1:Found: ", p), err)
+	}
+	return err
 }
 
-func (p *Artifact) IsSetPcr() bool {
-	return p.Pcr != nil
+func (p *SearchReportResult_) Equals(other *SearchReportResult_) bool {
+	if p == other {
+		return true
+	} else if p == nil || other == nil {
+		return false
+	}
+	if len(p.Found) != len(other.Found) {
+		return false
+	}
+	for i, _tgt := range p.Found {
+		_src11 := other.Found[i]
+		if !_tgt.Equals(_src11) {
+			return false
+		}
+	}
+	return true
 }
 
-func (p *Artifact) IsSetTPMDevice() bool {
-	return p.TPMDevice != nil
+func (p *SearchReportResult_) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("SearchReportResult_(%+v)", *p)
 }
 
-func (p *Artifact) IsSetTPMEventLog() bool {
-	return p.TPMEventLog != nil
+// Attributes:
+//   - JobIDA
+//   - JobIDB
+type CompareReportsRequest struct {
+	JobIDA []byte `thrift:"JobIDA,1" db:"JobIDA" json:"JobIDA"`
+	JobIDB []byte `thrift:"JobIDB,2" db:"JobIDB" json:"JobIDB"`
 }
 
-func (p *Artifact) IsSetStatusRegisters() bool {
-	return p.StatusRegisters != nil
+func NewCompareReportsRequest() *CompareReportsRequest {
+	return &CompareReportsRequest{}
 }
 
-func (p *Artifact) IsSetMeasurementsFlow() bool {
-	return p.MeasurementsFlow != nil
+func (p *CompareReportsRequest) GetJobIDA() []byte {
+	return p.JobIDA
 }
 
-func (p *Artifact) Read(ctx context.Context, iprot thrift.TProtocol) error {
+func (p *CompareReportsRequest) GetJobIDB() []byte {
+	return p.JobIDB
+}
+func (p *CompareReportsRequest) Read(ctx context.Context, iprot thrift.TProtocol) error {
 	if _, err := iprot.ReadStructBegin(ctx); err != nil {
 		return thrift.PrependError(fmt.Sprintf("%T read error: ", p), err)
 	}
@@ -4909,7 +5425,7 @@ func (p *Artifact) Read(ctx context.Context, iprot thrift.TProtocol) error {
 		}
 		switch fieldId {
 		case 1:
-			if fieldTypeId == thrift.STRUCT {
+			if fieldTypeId == thrift.STRING {
 				if err := p.ReadField1(ctx, iprot); err != nil {
 					return err
 				}
@@ -4919,7 +5435,7 @@ func (p *Artifact) Read(ctx context.Context, iprot thrift.TProtocol) error {
 				}
 			}
 		case 2:
-			if fieldTypeId == thrift.STRUCT {
+			if fieldTypeId == thrift.STRING {
 				if err := p.ReadField2(ctx, iprot); err != nil {
 					return err
 				}
@@ -4928,46 +5444,6 @@ func (p *Artifact) Read(ctx context.Context, iprot thrift.TProtocol) error {
 					return err
 				}
 			}
-		case 3:
-			if fieldTypeId == thrift.I32 {
-				if err := p.ReadField3(ctx, iprot); err != nil {
-					return err
-				}
-			} else {
-				if err := iprot.Skip(ctx, fieldTypeId); err != nil {
-					return err
-				}
-			}
-		case 4:
-			if fieldTypeId == thrift.STRUCT {
-				if err := p.ReadField4(ctx, iprot); err != nil {
-					return err
-				}
-			} else {
-				if err := iprot.Skip(ctx, fieldTypeId); err != nil {
-					return err
-				}
-			}
-		case 5:
-			if fieldTypeId == thrift.LIST {
-				if err := p.ReadField5(ctx, iprot); err != nil {
-					return err
-				}
-			} else {
-				if err := iprot.Skip(ctx, fieldTypeId); err != nil {
-					return err
-				}
-			}
-		case 6:
-			if fieldTypeId == thrift.I32 {
-				if err := p.ReadField6(ctx, iprot); err != nil {
-					return err
-				}
-			} else {
-				if err := iprot.Skip(ctx, fieldTypeId); err != nil {
-					return err
-				}
-			}
 		default:
 			if err := iprot.Skip(ctx, fieldTypeId); err != nil {
 				return err
@@ -4983,75 +5459,26 @@ func (p *Artifact) Read(ctx context.Context, iprot thrift.TProtocol) error {
 	return nil
 }
 
-func (p *Artifact) ReadField1(ctx context.Context, iprot thrift.TProtocol) error {
-	p.FwImage = &FirmwareImage{}
-	if err := p.FwImage.Read(ctx, iprot); err != nil {
-		return thrift.PrependError(fmt.Sprintf("%T error reading struct: ", p.FwImage), err)
-	}
-	return nil
-}
-
-func (p *Artifact) ReadField2(ctx context.Context, iprot thrift.TProtocol) error {
-	p.Pcr = &PCR{}
-	if err := p.Pcr.Read(ctx, iprot); err != nil {
-		return thrift.PrependError(fmt.Sprintf("%T error reading struct: ", p.Pcr), err)
-	}
-	return nil
-}
-
-func (p *Artifact) ReadField3(ctx context.Context, iprot thrift.TProtocol) error {
-	if v, err := iprot.ReadI32(ctx); err != nil {
-		return thrift.PrependError("error reading field 3: ", err)
+func (p *CompareReportsRequest) ReadField1(ctx context.Context, iprot thrift.TProtocol) error {
+	if v, err := iprot.ReadBinary(ctx); err != nil {
+		return thrift.PrependError("error reading field 1: ", err)
 	} else {
-		temp := TPMType(v)
-		p.TPMDevice = &temp
-	}
-	return nil
-}
-
-func (p *Artifact) ReadField4(ctx context.Context, iprot thrift.TProtocol) error {
-	p.TPMEventLog = &tpm.EventLog{}
-	if err := p.TPMEventLog.Read(ctx, iprot); err != nil {
-		return thrift.PrependError(fmt.Sprintf("%T error reading struct: ", p.TPMEventLog), err)
-	}
-	return nil
-}
-
-func (p *Artifact) ReadField5(ctx context.Context, iprot thrift.TProtocol) error {
-	_, size, err := iprot.ReadListBegin(ctx)
-	if err != nil {
-		return thrift.PrependError("error reading list begin: ", err)
-	}
-	tSlice := make([]*StatusRegister, 0, size)
-	p.StatusRegisters = tSlice
-	for i := 0; i < size; i++ {
-		_elem8 := &StatusRegister{}
-		if err := _elem8.Read(ctx, iprot); err != nil {
-			return thrift.PrependError(fmt.Sprintf("%T error reading struct: ", _elem8), err)
-		}
-		p.StatusRegisters = append(p.StatusRegisters, _elem8)
-	}
-	if err := iprot.ReadListEnd(ctx); err != nil {
-		return thrift.PrependError("error reading list end: ", err)
+		p.JobIDA = v
 	}
 	return nil
 }
 
-func (p *Artifact) ReadField6(ctx context.Context, iprot thrift.TProtocol) error {
-	if v, err := iprot.ReadI32(ctx); err != nil {
-		return thrift.PrependError("error reading field 6: ", err)
+func (p *CompareReportsRequest) ReadField2(ctx context.Context, iprot thrift.TProtocol) error {
+	if v, err := iprot.ReadBinary(ctx); err != nil {
+		return thrift.PrependError("error reading field 2: ", err)
 	} else {
-		temp := measurements.Flow(v)
-		p.MeasurementsFlow = &temp
+		p.JobIDB = v
 	}
 	return nil
 }
 
-func (p *Artifact) Write(ctx context.Context, oprot thrift.TProtocol) error {
-	if c := p.CountSetFieldsArtifact(); c != 1 {
-		return fmt.Errorf("%T write union: exactly one field must be set (%d set).", p, c)
-	}
-	if err := oprot.WriteStructBegin(ctx, "Artifact"); err != nil {
+func (p *CompareReportsRequest) Write(ctx context.Context, oprot thrift.TProtocol) error {
+	if err := oprot.WriteStructBegin(ctx, "CompareReportsRequest"); err != nil {
 		return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
 	}
 	if p != nil {
@@ -5061,18 +5488,6 @@ func (p *Artifact) Write(ctx context.Context, oprot thrift.TProtocol) error {
 		if err := p.writeField2(ctx, oprot); err != nil {
 			return err
 		}
-		if err := p.writeField3(ctx, oprot); err != nil {
-			return err
-		}
-		if err := p.writeField4(ctx, oprot); err != nil {
-			return err
-		}
-		if err := p.writeField5(ctx, oprot); err != nil {
-			return err
-		}
-		if err := p.writeField6(ctx, oprot); err != nil {
-			return err
-		}
 	}
 	if err := oprot.WriteFieldStop(ctx); err != nil {
 		return thrift.PrependError("write field stop error: ", err)
@@ -5083,243 +5498,83 @@ func (p *Artifact) Write(ctx context.Context, oprot thrift.TProtocol) error {
 	return nil
 }
 
-func (p *Artifact) writeField1(ctx context.Context, oprot thrift.TProtocol) (err error) {
-	if p.IsSetFwImage() {
-		if err := oprot.WriteFieldBegin(ctx, "FwImage", thrift.STRUCT, 1); err != nil {
-			return thrift.PrependError(fmt.Sprintf("%T write field begin error 1:FwImage: ", p), err)
-		}
-		if err := p.FwImage.Write(ctx, oprot); err != nil {
-			return thrift.PrependError(fmt.Sprintf("%T error writing struct: ", p.FwImage), err)
-		}
-		if err := oprot.WriteFieldEnd(ctx); err != nil {
-			return thrift.PrependError(fmt.Sprintf("%T write field end error 1:FwImage: ", p), err)
-		}
+func (p *CompareReportsRequest) writeField1(ctx context.Context, oprot thrift.TProtocol) (err error) {
+	if err := oprot.WriteFieldBegin(ctx, "JobIDA", thrift.STRING, 1); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T write field begin error 1:JobIDA: ", p), err)
 	}
-	return err
-}
-
-func (p *Artifact) writeField2(ctx context.Context, oprot thrift.TProtocol) (err error) {
-	if p.IsSetPcr() {
-		if err := oprot.WriteFieldBegin(ctx, "Pcr", thrift.STRUCT, 2); err != nil {
-			return thrift.PrependError(fmt.Sprintf("%T write field begin error 2:Pcr: ", p), err)
-		}
-		if err := p.Pcr.Write(ctx, oprot); err != nil {
-			return thrift.PrependError(fmt.Sprintf("%T error writing struct: ", p.Pcr), err)
-		}
-		if err := oprot.WriteFieldEnd(ctx); err != nil {
-			return thrift.PrependError(fmt.Sprintf("%T write field end error 2:Pcr: ", p), err)
-		}
+	if err := oprot.WriteBinary(ctx, p.JobIDA); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T.JobIDA (1) field write error: ", p), err)
 	}
-	return err
-}
-
-func (p *Artifact) writeField3(ctx context.Context, oprot thrift.TProtocol) (err error) {
-	if p.IsSetTPMDevice() {
-		if err := oprot.WriteFieldBegin(ctx, "TPMDevice", thrift.I32, 3); err != nil {
-			return thrift.PrependError(fmt.Sprintf("%T write field begin error 3:TPMDevice: ", p), err)
-		}
-		if err := oprot.WriteI32(ctx, int32(*p.TPMDevice)); err != nil {
-			return thrift.PrependError(fmt.Sprintf("%T.TPMDevice (3) field write error: ", p), err)
-		}
-		if err := oprot.WriteFieldEnd(ctx); err != nil {
-			return thrift.PrependError(fmt.Sprintf("%T write field end error 3:TPMDevice: ", p), err)
-		}
+	if err := oprot.WriteFieldEnd(ctx); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T write field end error 1:JobIDA: ", p), err)
 	}
 	return err
 }
 
-func (p *Artifact) writeField4(ctx context.Context, oprot thrift.TProtocol) (err error) {
-	if p.IsSetTPMEventLog() {
-		if err := oprot.WriteFieldBegin(ctx, "TPMEventLog", thrift.STRUCT, 4); err != nil {
-			return thrift.PrependError(fmt.Sprintf("%T write field begin error 4:TPMEventLog: ", p), err)
-		}
-		if err := p.TPMEventLog.Write(ctx, oprot); err != nil {
-			return thrift.PrependError(fmt.Sprintf("%T error writing struct: ", p.TPMEventLog), err)
-		}
-		if err := oprot.WriteFieldEnd(ctx); err != nil {
-			return thrift.PrependError(fmt.Sprintf("%T write field end error 4:TPMEventLog: ", p), err)
-		}
+func (p *CompareReportsRequest) writeField2(ctx context.Context, oprot thrift.TProtocol) (err error) {
+	if err := oprot.WriteFieldBegin(ctx, "JobIDB", thrift.STRING, 2); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T write field begin error 2:JobIDB: ", p), err)
 	}
-	return err
-}
-
-func (p *Artifact) writeField5(ctx context.Context, oprot thrift.TProtocol) (err error) {
-	if p.IsSetStatusRegisters() {
-		if err := oprot.WriteFieldBegin(ctx, "StatusRegisters", thrift.LIST, 5); err != nil {
-			return thrift.PrependError(fmt.Sprintf("%T write field begin error 5:StatusRegisters: ", p), err)
-		}
-		if err := oprot.WriteListBegin(ctx, thrift.STRUCT, len(p.StatusRegisters)); err != nil {
-			return thrift.PrependError("error writing list begin: ", err)
-		}
-		for _, v := range p.StatusRegisters {
-			if err := v.Write(ctx, oprot); err != nil {
-				return thrift.PrependError(fmt.Sprintf("%T error writing struct: ", v), err)
-			}
-		}
-		if err := oprot.WriteListEnd(ctx); err != nil {
-			return thrift.PrependError("error writing list end: ", err)
-		}
-		if err := oprot.WriteFieldEnd(ctx); err != nil {
-			return thrift.PrependError(fmt.Sprintf("%T write field end error 5:StatusRegisters: ", p), err)
-		}
+	if err := oprot.WriteBinary(ctx, p.JobIDB); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T.JobIDB (2) field write error: ", p), err)
 	}
-	return err
-}
-
-func (p *Artifact) writeField6(ctx context.Context, oprot thrift.TProtocol) (err error) {
-	if p.IsSetMeasurementsFlow() {
-		if err := oprot.WriteFieldBegin(ctx, "MeasurementsFlow", thrift.I32, 6); err != nil {
-			return thrift.PrependError(fmt.Sprintf("%T write field begin error 6:MeasurementsFlow: ", p), err)
-		}
-		if err := oprot.WriteI32(ctx, int32(*p.MeasurementsFlow)); err != nil {
-			return thrift.PrependError(fmt.Sprintf("%T.MeasurementsFlow (6) field write error: ", p), err)
-		}
-		if err := oprot.WriteFieldEnd(ctx); err != nil {
-			return thrift.PrependError(fmt.Sprintf("%T write field end error 6:MeasurementsFlow: ", p), err)
-		}
+	if err := oprot.WriteFieldEnd(ctx); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T write field end error 2:JobIDB: ", p), err)
 	}
 	return err
 }
 
-func (p *Artifact) Equals(other *Artifact) bool {
+func (p *CompareReportsRequest) Equals(other *CompareReportsRequest) bool {
 	if p == other {
 		return true
 	} else if p == nil || other == nil {
 		return false
 	}
-	if !p.FwImage.Equals(other.FwImage) {
-		return false
-	}
-	if !p.Pcr.Equals(other.Pcr) {
-		return false
-	}
-	if p.TPMDevice != other.TPMDevice {
-		if p.TPMDevice == nil || other.TPMDevice == nil {
-			return false
-		}
-		if (*p.TPMDevice) != (*other.TPMDevice) {
-			return false
-		}
-	}
-	if !p.TPMEventLog.Equals(other.TPMEventLog) {
+	if bytes.Compare(p.JobIDA, other.JobIDA) != 0 {
 		return false
 	}
-	if len(p.StatusRegisters) != len(other.StatusRegisters) {
+	if bytes.Compare(p.JobIDB, other.JobIDB) != 0 {
 		return false
 	}
-	for i, _tgt := range p.StatusRegisters {
-		_src9 := other.StatusRegisters[i]
-		if !_tgt.Equals(_src9) {
-			return false
-		}
-	}
-	if p.MeasurementsFlow != other.MeasurementsFlow {
-		if p.MeasurementsFlow == nil || other.MeasurementsFlow == nil {
-			return false
-		}
-		if (*p.MeasurementsFlow) != (*other.MeasurementsFlow) {
-			return false
-		}
-	}
 	return true
 }
 
-func (p *Artifact) String() string {
+func (p *CompareReportsRequest) String() string {
 	if p == nil {
 		return "<nil>"
 	}
-	return fmt.Sprintf("Artifact(%+v)", *p)
+	return fmt.Sprintf("CompareReportsRequest(%+v)", *p)
 }
 
 // Attributes:
-//   - ActualFirmwareImage
-//   - OriginalFirmwareImage
-//   - StatusRegisters
-//   - TPMDevice
-//   - TPMEventLog
-//   - ActualPCR0
-type DiffMeasuredBootInput struct {
-	ActualFirmwareImage   int32  `thrift:"ActualFirmwareImage,1" db:"ActualFirmwareImage" json:"ActualFirmwareImage"`
-	OriginalFirmwareImage *int32 `thrift:"OriginalFirmwareImage,2" db:"OriginalFirmwareImage" json:"OriginalFirmwareImage,omitempty"`
-	StatusRegisters       *int32 `thrift:"StatusRegisters,3" db:"StatusRegisters" json:"StatusRegisters,omitempty"`
-	TPMDevice             *int32 `thrift:"TPMDevice,4" db:"TPMDevice" json:"TPMDevice,omitempty"`
-	TPMEventLog           *int32 `thrift:"TPMEventLog,5" db:"TPMEventLog" json:"TPMEventLog,omitempty"`
-	ActualPCR0            *int32 `thrift:"ActualPCR0,6" db:"ActualPCR0" json:"ActualPCR0,omitempty"`
-}
-
-func NewDiffMeasuredBootInput() *DiffMeasuredBootInput {
-	return &DiffMeasuredBootInput{}
-}
-
-func (p *DiffMeasuredBootInput) GetActualFirmwareImage() int32 {
-	return p.ActualFirmwareImage
-}
-
-var DiffMeasuredBootInput_OriginalFirmwareImage_DEFAULT int32
-
-func (p *DiffMeasuredBootInput) GetOriginalFirmwareImage() int32 {
-	if !p.IsSetOriginalFirmwareImage() {
-		return DiffMeasuredBootInput_OriginalFirmwareImage_DEFAULT
-	}
-	return *p.OriginalFirmwareImage
-}
-
-var DiffMeasuredBootInput_StatusRegisters_DEFAULT int32
-
-func (p *DiffMeasuredBootInput) GetStatusRegisters() int32 {
-	if !p.IsSetStatusRegisters() {
-		return DiffMeasuredBootInput_StatusRegisters_DEFAULT
-	}
-	return *p.StatusRegisters
+//   - Change
+//   - Issue
+type IssueDiff struct {
+	Change IssueChange           `thrift:"Change,1" db:"Change" json:"Change"`
+	Issue  *analyzerreport.Issue `thrift:"Issue,2" db:"Issue" json:"Issue"`
 }
 
-var DiffMeasuredBootInput_TPMDevice_DEFAULT int32
-
-func (p *DiffMeasuredBootInput) GetTPMDevice() int32 {
-	if !p.IsSetTPMDevice() {
-		return DiffMeasuredBootInput_TPMDevice_DEFAULT
-	}
-	return *p.TPMDevice
+func NewIssueDiff() *IssueDiff {
+	return &IssueDiff{}
 }
 
-var DiffMeasuredBootInput_TPMEventLog_DEFAULT int32
-
-func (p *DiffMeasuredBootInput) GetTPMEventLog() int32 {
-	if !p.IsSetTPMEventLog() {
-		return DiffMeasuredBootInput_TPMEventLog_DEFAULT
-	}
-	return *p.TPMEventLog
+func (p *IssueDiff) GetChange() IssueChange {
+	return p.Change
 }
 
-var DiffMeasuredBootInput_ActualPCR0_DEFAULT int32
+var IssueDiff_Issue_DEFAULT *analyzerreport.Issue
 
-func (p *DiffMeasuredBootInput) GetActualPCR0() int32 {
-	if !p.IsSetActualPCR0() {
-		return DiffMeasuredBootInput_ActualPCR0_DEFAULT
+func (p *IssueDiff) GetIssue() *analyzerreport.Issue {
+	if !p.IsSetIssue() {
+		return IssueDiff_Issue_DEFAULT
 	}
-	return *p.ActualPCR0
-}
-func (p *DiffMeasuredBootInput) IsSetOriginalFirmwareImage() bool {
-	return p.OriginalFirmwareImage != nil
-}
-
-func (p *DiffMeasuredBootInput) IsSetStatusRegisters() bool {
-	return p.StatusRegisters != nil
-}
-
-func (p *DiffMeasuredBootInput) IsSetTPMDevice() bool {
-	return p.TPMDevice != nil
-}
-
-func (p *DiffMeasuredBootInput) IsSetTPMEventLog() bool {
-	return p.TPMEventLog != nil
+	return p.Issue
 }
-
-func (p *DiffMeasuredBootInput) IsSetActualPCR0() bool {
-	return p.ActualPCR0 != nil
+func (p *IssueDiff) IsSetIssue() bool {
+	return p.Issue != nil
 }
 
-func (p *DiffMeasuredBootInput) Read(ctx context.Context, iprot thrift.TProtocol) error {
+func (p *IssueDiff) Read(ctx context.Context, iprot thrift.TProtocol) error {
 	if _, err := iprot.ReadStructBegin(ctx); err != nil {
 		return thrift.PrependError(fmt.Sprintf("%T read error: ", p), err)
 	}
@@ -5344,7 +5599,7 @@ func (p *DiffMeasuredBootInput) Read(ctx context.Context, iprot thrift.TProtocol
 				}
 			}
 		case 2:
-			if fieldTypeId == thrift.I32 {
+			if fieldTypeId == thrift.STRUCT {
 				if err := p.ReadField2(ctx, iprot); err != nil {
 					return err
 				}
@@ -5353,46 +5608,6 @@ func (p *DiffMeasuredBootInput) Read(ctx context.Context, iprot thrift.TProtocol
 					return err
 				}
 			}
-		case 3:
-			if fieldTypeId == thrift.I32 {
-				if err := p.ReadField3(ctx, iprot); err != nil {
-					return err
-				}
-			} else {
-				if err := iprot.Skip(ctx, fieldTypeId); err != nil {
-					return err
-				}
-			}
-		case 4:
-			if fieldTypeId == thrift.I32 {
-				if err := p.ReadField4(ctx, iprot); err != nil {
-					return err
-				}
-			} else {
-				if err := iprot.Skip(ctx, fieldTypeId); err != nil {
-					return err
-				}
-			}
-		case 5:
-			if fieldTypeId == thrift.I32 {
-				if err := p.ReadField5(ctx, iprot); err != nil {
-					return err
-				}
-			} else {
-				if err := iprot.Skip(ctx, fieldTypeId); err != nil {
-					return err
-				}
-			}
-		case 6:
-			if fieldTypeId == thrift.I32 {
-				if err := p.ReadField6(ctx, iprot); err != nil {
-					return err
-				}
-			} else {
-				if err := iprot.Skip(ctx, fieldTypeId); err != nil {
-					return err
-				}
-			}
 		default:
 			if err := iprot.Skip(ctx, fieldTypeId); err != nil {
 				return err
@@ -5408,63 +5623,27 @@ func (p *DiffMeasuredBootInput) Read(ctx context.Context, iprot thrift.TProtocol
 	return nil
 }
 
-func (p *DiffMeasuredBootInput) ReadField1(ctx context.Context, iprot thrift.TProtocol) error {
+func (p *IssueDiff) ReadField1(ctx context.Context, iprot thrift.TProtocol) error {
 	if v, err := iprot.ReadI32(ctx); err != nil {
 		return thrift.PrependError("error reading field 1: ", err)
 	} else {
-		p.ActualFirmwareImage = v
+		temp := IssueChange(v)
+		p.Change = temp
 	}
 	return nil
 }
 
-func (p *DiffMeasuredBootInput) ReadField2(ctx context.Context, iprot thrift.TProtocol) error {
-	if v, err := iprot.ReadI32(ctx); err != nil {
-		return thrift.PrependError("error reading field 2: ", err)
-	} else {
-		p.OriginalFirmwareImage = &v
+func (p *IssueDiff) ReadField2(ctx context.Context, iprot thrift.TProtocol) error {
+	p.Issue = &analyzerreport.Issue{}
+	if err := p.Issue.Read(ctx, iprot); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T error reading struct: ", p.Issue), err)
 	}
 	return nil
 }
 
-func (p *DiffMeasuredBootInput) ReadField3(ctx context.Context, iprot thrift.TProtocol) error {
-	if v, err := iprot.ReadI32(ctx); err != nil {
-		return thrift.PrependError("error reading field 3: ", err)
-	} else {
-		p.StatusRegisters = &v
-	}
-	return nil
-}
-
-func (p *DiffMeasuredBootInput) ReadField4(ctx context.Context, iprot thrift.TProtocol) error {
-	if v, err := iprot.ReadI32(ctx); err != nil {
-		return thrift.PrependError("error reading field 4: ", err)
-	} else {
-		p.TPMDevice = &v
-	}
-	return nil
-}
-
-func (p *DiffMeasuredBootInput) ReadField5(ctx context.Context, iprot thrift.TProtocol) error {
-	if v, err := iprot.ReadI32(ctx); err != nil {
-		return thrift.PrependError("error reading field 5: ", err)
-	} else {
-		p.TPMEventLog = &v
-	}
-	return nil
-}
-
-func (p *DiffMeasuredBootInput) ReadField6(ctx context.Context, iprot thrift.TProtocol) error {
-	if v, err := iprot.ReadI32(ctx); err != nil {
-		return thrift.PrependError("error reading field 6: ", err)
-	} else {
-		p.ActualPCR0 = &v
-	}
-	return nil
-}
-
-func (p *DiffMeasuredBootInput) Write(ctx context.Context, oprot thrift.TProtocol) error {
-	if err := oprot.WriteStructBegin(ctx, "DiffMeasuredBootInput"); err != nil {
-		return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
+func (p *IssueDiff) Write(ctx context.Context, oprot thrift.TProtocol) error {
+	if err := oprot.WriteStructBegin(ctx, "IssueDiff"); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
 	}
 	if p != nil {
 		if err := p.writeField1(ctx, oprot); err != nil {
@@ -5473,18 +5652,6 @@ func (p *DiffMeasuredBootInput) Write(ctx context.Context, oprot thrift.TProtoco
 		if err := p.writeField2(ctx, oprot); err != nil {
 			return err
 		}
-		if err := p.writeField3(ctx, oprot); err != nil {
-			return err
-		}
-		if err := p.writeField4(ctx, oprot); err != nil {
-			return err
-		}
-		if err := p.writeField5(ctx, oprot); err != nil {
-			return err
-		}
-		if err := p.writeField6(ctx, oprot); err != nil {
-			return err
-		}
 	}
 	if err := oprot.WriteFieldStop(ctx); err != nil {
 		return thrift.PrependError("write field stop error: ", err)
@@ -5495,182 +5662,98 @@ func (p *DiffMeasuredBootInput) Write(ctx context.Context, oprot thrift.TProtoco
 	return nil
 }
 
-func (p *DiffMeasuredBootInput) writeField1(ctx context.Context, oprot thrift.TProtocol) (err error) {
-	if err := oprot.WriteFieldBegin(ctx, "ActualFirmwareImage", thrift.I32, 1); err != nil {
-		return thrift.PrependError(fmt.Sprintf("%T write field begin error 1:ActualFirmwareImage: ", p), err)
+func (p *IssueDiff) writeField1(ctx context.Context, oprot thrift.TProtocol) (err error) {
+	if err := oprot.WriteFieldBegin(ctx, "Change", thrift.I32, 1); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T write field begin error 1:Change: ", p), err)
 	}
-	if err := oprot.WriteI32(ctx, int32(p.ActualFirmwareImage)); err != nil {
-		return thrift.PrependError(fmt.Sprintf("%T.ActualFirmwareImage (1) field write error: ", p), err)
+	if err := oprot.WriteI32(ctx, int32(p.Change)); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T.Change (1) field write error: ", p), err)
 	}
 	if err := oprot.WriteFieldEnd(ctx); err != nil {
-		return thrift.PrependError(fmt.Sprintf("%T write field end error 1:ActualFirmwareImage: ", p), err)
-	}
-	return err
-}
-
-func (p *DiffMeasuredBootInput) writeField2(ctx context.Context, oprot thrift.TProtocol) (err error) {
-	if p.IsSetOriginalFirmwareImage() {
-		if err := oprot.WriteFieldBegin(ctx, "OriginalFirmwareImage", thrift.I32, 2); err != nil {
-			return thrift.PrependError(fmt.Sprintf("%T write field begin error 2:OriginalFirmwareImage: ", p), err)
-		}
-		if err := oprot.WriteI32(ctx, int32(*p.OriginalFirmwareImage)); err != nil {
-			return thrift.PrependError(fmt.Sprintf("%T.OriginalFirmwareImage (2) field write error: ", p), err)
-		}
-		if err := oprot.WriteFieldEnd(ctx); err != nil {
-			return thrift.PrependError(fmt.Sprintf("%T write field end error 2:OriginalFirmwareImage: ", p), err)
-		}
-	}
-	return err
-}
-
-func (p *DiffMeasuredBootInput) writeField3(ctx context.Context, oprot thrift.TProtocol) (err error) {
-	if p.IsSetStatusRegisters() {
-		if err := oprot.WriteFieldBegin(ctx, "StatusRegisters", thrift.I32, 3); err != nil {
-			return thrift.PrependError(fmt.Sprintf("%T write field begin error 3:StatusRegisters: ", p), err)
-		}
-		if err := oprot.WriteI32(ctx, int32(*p.StatusRegisters)); err != nil {
-			return thrift.PrependError(fmt.Sprintf("%T.StatusRegisters (3) field write error: ", p), err)
-		}
-		if err := oprot.WriteFieldEnd(ctx); err != nil {
-			return thrift.PrependError(fmt.Sprintf("%T write field end error 3:StatusRegisters: ", p), err)
-		}
+		return thrift.PrependError(fmt.Sprintf("%T write field end error 1:Change: ", p), err)
 	}
 	return err
 }
 
-func (p *DiffMeasuredBootInput) writeField4(ctx context.Context, oprot thrift.TProtocol) (err error) {
-	if p.IsSetTPMDevice() {
-		if err := oprot.WriteFieldBegin(ctx, "TPMDevice", thrift.I32, 4); err != nil {
-			return thrift.PrependError(fmt.Sprintf("%T write field begin error 4:TPMDevice: ", p), err)
-		}
-		if err := oprot.WriteI32(ctx, int32(*p.TPMDevice)); err != nil {
-			return thrift.PrependError(fmt.Sprintf("%T.TPMDevice (4) field write error: ", p), err)
-		}
-		if err := oprot.WriteFieldEnd(ctx); err != nil {
-			return thrift.PrependError(fmt.Sprintf("%T write field end error 4:TPMDevice: ", p), err)
-		}
+func (p *IssueDiff) writeField2(ctx context.Context, oprot thrift.TProtocol) (err error) {
+	if err := oprot.WriteFieldBegin(ctx, "Issue", thrift.STRUCT, 2); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T write field begin error 2:Issue: ", p), err)
 	}
-	return err
-}
-
-func (p *DiffMeasuredBootInput) writeField5(ctx context.Context, oprot thrift.TProtocol) (err error) {
-	if p.IsSetTPMEventLog() {
-		if err := oprot.WriteFieldBegin(ctx, "TPMEventLog", thrift.I32, 5); err != nil {
-			return thrift.PrependError(fmt.Sprintf("%T write field begin error 5:TPMEventLog: ", p), err)
-		}
-		if err := oprot.WriteI32(ctx, int32(*p.TPMEventLog)); err != nil {
-			return thrift.PrependError(fmt.Sprintf("%T.TPMEventLog (5) field write error: ", p), err)
-		}
-		if err := oprot.WriteFieldEnd(ctx); err != nil {
-			return thrift.PrependError(fmt.Sprintf("%T write field end error 5:TPMEventLog: ", p), err)
-		}
+	if err := p.Issue.Write(ctx, oprot); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T error writing struct: ", p.Issue), err)
 	}
-	return err
-}
-
-func (p *DiffMeasuredBootInput) writeField6(ctx context.Context, oprot thrift.TProtocol) (err error) {
-	if p.IsSetActualPCR0() {
-		if err := oprot.WriteFieldBegin(ctx, "ActualPCR0", thrift.I32, 6); err != nil {
-			return thrift.PrependError(fmt.Sprintf("%T write field begin error 6:ActualPCR0: ", p), err)
-		}
-		if err := oprot.WriteI32(ctx, int32(*p.ActualPCR0)); err != nil {
-			return thrift.PrependError(fmt.Sprintf("%T.ActualPCR0 (6) field write error: ", p), err)
-		}
-		if err := oprot.WriteFieldEnd(ctx); err != nil {
-			return thrift.PrependError(fmt.Sprintf("%T write field end error 6:ActualPCR0: ", p), err)
-		}
+	if err := oprot.WriteFieldEnd(ctx); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T write field end error 2:Issue: ", p), err)
 	}
 	return err
 }
 
-func (p *DiffMeasuredBootInput) Equals(other *DiffMeasuredBootInput) bool {
+func (p *IssueDiff) Equals(other *IssueDiff) bool {
 	if p == other {
 		return true
 	} else if p == nil || other == nil {
 		return false
 	}
-	if p.ActualFirmwareImage != other.ActualFirmwareImage {
+	if p.Change != other.Change {
 		return false
 	}
-	if p.OriginalFirmwareImage != other.OriginalFirmwareImage {
-		if p.OriginalFirmwareImage == nil || other.OriginalFirmwareImage == nil {
-			return false
-		}
-		if (*p.OriginalFirmwareImage) != (*other.OriginalFirmwareImage) {
-			return false
-		}
-	}
-	if p.StatusRegisters != other.StatusRegisters {
-		if p.StatusRegisters == nil || other.StatusRegisters == nil {
-			return false
-		}
-		if (*p.StatusRegisters) != (*other.StatusRegisters) {
-			return false
-		}
-	}
-	if p.TPMDevice != other.TPMDevice {
-		if p.TPMDevice == nil || other.TPMDevice == nil {
-			return false
-		}
-		if (*p.TPMDevice) != (*other.TPMDevice) {
-			return false
-		}
-	}
-	if p.TPMEventLog != other.TPMEventLog {
-		if p.TPMEventLog == nil || other.TPMEventLog == nil {
-			return false
-		}
-		if (*p.TPMEventLog) != (*other.TPMEventLog) {
-			return false
-		}
-	}
-	if p.ActualPCR0 != other.ActualPCR0 {
-		if p.ActualPCR0 == nil || other.ActualPCR0 == nil {
-			return false
-		}
-		if (*p.ActualPCR0) != (*other.ActualPCR0) {
-			return false
-		}
+	if !p.Issue.Equals(other.Issue) {
+		return false
 	}
 	return true
 }
 
-func (p *DiffMeasuredBootInput) String() string {
+func (p *IssueDiff) String() string {
 	if p == nil {
 		return "<nil>"
 	}
-	return fmt.Sprintf("DiffMeasuredBootInput(%+v)", *p)
+	return fmt.Sprintf("IssueDiff(%+v)", *p)
 }
 
 // Attributes:
-//   - ActualFirmwareImage
-//   - OriginalFirmwareImage
-type IntelACMInput struct {
-	ActualFirmwareImage   int32  `thrift:"ActualFirmwareImage,1" db:"ActualFirmwareImage" json:"ActualFirmwareImage"`
-	OriginalFirmwareImage *int32 `thrift:"OriginalFirmwareImage,2" db:"OriginalFirmwareImage" json:"OriginalFirmwareImage,omitempty"`
+//   - Type
+//   - ValueA
+//   - ValueB
+type InputDiff struct {
+	Type   string  `thrift:"Type,1" db:"Type" json:"Type"`
+	ValueA *string `thrift:"ValueA,2" db:"ValueA" json:"ValueA,omitempty"`
+	ValueB *string `thrift:"ValueB,3" db:"ValueB" json:"ValueB,omitempty"`
 }
 
-func NewIntelACMInput() *IntelACMInput {
-	return &IntelACMInput{}
+func NewInputDiff() *InputDiff {
+	return &InputDiff{}
 }
 
-func (p *IntelACMInput) GetActualFirmwareImage() int32 {
-	return p.ActualFirmwareImage
+func (p *InputDiff) GetType() string {
+	return p.Type
 }
 
-var IntelACMInput_OriginalFirmwareImage_DEFAULT int32
+var InputDiff_ValueA_DEFAULT string
 
-func (p *IntelACMInput) GetOriginalFirmwareImage() int32 {
-	if !p.IsSetOriginalFirmwareImage() {
-		return IntelACMInput_OriginalFirmwareImage_DEFAULT
+func (p *InputDiff) GetValueA() string {
+	if !p.IsSetValueA() {
+		return InputDiff_ValueA_DEFAULT
 	}
-	return *p.OriginalFirmwareImage
+	return *p.ValueA
 }
-func (p *IntelACMInput) IsSetOriginalFirmwareImage() bool {
-	return p.OriginalFirmwareImage != nil
+
+var InputDiff_ValueB_DEFAULT string
+
+func (p *InputDiff) GetValueB() string {
+	if !p.IsSetValueB() {
+		return InputDiff_ValueB_DEFAULT
+	}
+	return *p.ValueB
+}
+func (p *InputDiff) IsSetValueA() bool {
+	return p.ValueA != nil
 }
 
-func (p *IntelACMInput) Read(ctx context.Context, iprot thrift.TProtocol) error {
+func (p *InputDiff) IsSetValueB() bool {
+	return p.ValueB != nil
+}
+
+func (p *InputDiff) Read(ctx context.Context, iprot thrift.TProtocol) error {
 	if _, err := iprot.ReadStructBegin(ctx); err != nil {
 		return thrift.PrependError(fmt.Sprintf("%T read error: ", p), err)
 	}
@@ -5685,7 +5768,7 @@ func (p *IntelACMInput) Read(ctx context.Context, iprot thrift.TProtocol) error
 		}
 		switch fieldId {
 		case 1:
-			if fieldTypeId == thrift.I32 {
+			if fieldTypeId == thrift.STRING {
 				if err := p.ReadField1(ctx, iprot); err != nil {
 					return err
 				}
@@ -5695,7 +5778,7 @@ func (p *IntelACMInput) Read(ctx context.Context, iprot thrift.TProtocol) error
 				}
 			}
 		case 2:
-			if fieldTypeId == thrift.I32 {
+			if fieldTypeId == thrift.STRING {
 				if err := p.ReadField2(ctx, iprot); err != nil {
 					return err
 				}
@@ -5704,6 +5787,16 @@ func (p *IntelACMInput) Read(ctx context.Context, iprot thrift.TProtocol) error
 					return err
 				}
 			}
+		case 3:
+			if fieldTypeId == thrift.STRING {
+				if err := p.ReadField3(ctx, iprot); err != nil {
+					return err
+				}
+			} else {
+				if err := iprot.Skip(ctx, fieldTypeId); err != nil {
+					return err
+				}
+			}
 		default:
 			if err := iprot.Skip(ctx, fieldTypeId); err != nil {
 				return err
@@ -5719,26 +5812,35 @@ func (p *IntelACMInput) Read(ctx context.Context, iprot thrift.TProtocol) error
 	return nil
 }
 
-func (p *IntelACMInput) ReadField1(ctx context.Context, iprot thrift.TProtocol) error {
-	if v, err := iprot.ReadI32(ctx); err != nil {
+func (p *InputDiff) ReadField1(ctx context.Context, iprot thrift.TProtocol) error {
+	if v, err := iprot.ReadString(ctx); err != nil {
 		return thrift.PrependError("error reading field 1: ", err)
 	} else {
-		p.ActualFirmwareImage = v
+		p.Type = v
 	}
 	return nil
 }
 
-func (p *IntelACMInput) ReadField2(ctx context.Context, iprot thrift.TProtocol) error {
-	if v, err := iprot.ReadI32(ctx); err != nil {
+func (p *InputDiff) ReadField2(ctx context.Context, iprot thrift.TProtocol) error {
+	if v, err := iprot.ReadString(ctx); err != nil {
 		return thrift.PrependError("error reading field 2: ", err)
 	} else {
-		p.OriginalFirmwareImage = &v
+		p.ValueA = &v
 	}
 	return nil
 }
 
-func (p *IntelACMInput) Write(ctx context.Context, oprot thrift.TProtocol) error {
-	if err := oprot.WriteStructBegin(ctx, "IntelACMInput"); err != nil {
+func (p *InputDiff) ReadField3(ctx context.Context, iprot thrift.TProtocol) error {
+	if v, err := iprot.ReadString(ctx); err != nil {
+		return thrift.PrependError("error reading field 3: ", err)
+	} else {
+		p.ValueB = &v
+	}
+	return nil
+}
+
+func (p *InputDiff) Write(ctx context.Context, oprot thrift.TProtocol) error {
+	if err := oprot.WriteStructBegin(ctx, "InputDiff"); err != nil {
 		return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
 	}
 	if p != nil {
@@ -5748,6 +5850,9 @@ func (p *IntelACMInput) Write(ctx context.Context, oprot thrift.TProtocol) error
 		if err := p.writeField2(ctx, oprot); err != nil {
 			return err
 		}
+		if err := p.writeField3(ctx, oprot); err != nil {
+			return err
+		}
 	}
 	if err := oprot.WriteFieldStop(ctx); err != nil {
 		return thrift.PrependError("write field stop error: ", err)
@@ -5758,156 +5863,188 @@ func (p *IntelACMInput) Write(ctx context.Context, oprot thrift.TProtocol) error
 	return nil
 }
 
-func (p *IntelACMInput) writeField1(ctx context.Context, oprot thrift.TProtocol) (err error) {
-	if err := oprot.WriteFieldBegin(ctx, "ActualFirmwareImage", thrift.I32, 1); err != nil {
-		return thrift.PrependError(fmt.Sprintf("%T write field begin error 1:ActualFirmwareImage: ", p), err)
+func (p *InputDiff) writeField1(ctx context.Context, oprot thrift.TProtocol) (err error) {
+	if err := oprot.WriteFieldBegin(ctx, "Type", thrift.STRING, 1); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T write field begin error 1:Type: ", p), err)
 	}
-	if err := oprot.WriteI32(ctx, int32(p.ActualFirmwareImage)); err != nil {
-		return thrift.PrependError(fmt.Sprintf("%T.ActualFirmwareImage (1) field write error: ", p), err)
+	if err := oprot.WriteString(ctx, string(p.Type)); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T.Type (1) field write error: ", p), err)
 	}
 	if err := oprot.WriteFieldEnd(ctx); err != nil {
-		return thrift.PrependError(fmt.Sprintf("%T write field end error 1:ActualFirmwareImage: ", p), err)
+		return thrift.PrependError(fmt.Sprintf("%T write field end error 1:Type: ", p), err)
 	}
 	return err
 }
 
-func (p *IntelACMInput) writeField2(ctx context.Context, oprot thrift.TProtocol) (err error) {
-	if p.IsSetOriginalFirmwareImage() {
-		if err := oprot.WriteFieldBegin(ctx, "OriginalFirmwareImage", thrift.I32, 2); err != nil {
-			return thrift.PrependError(fmt.Sprintf("%T write field begin error 2:OriginalFirmwareImage: ", p), err)
+func (p *InputDiff) writeField2(ctx context.Context, oprot thrift.TProtocol) (err error) {
+	if p.IsSetValueA() {
+		if err := oprot.WriteFieldBegin(ctx, "ValueA", thrift.STRING, 2); err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T write field begin error 2:ValueA: ", p), err)
 		}
-		if err := oprot.WriteI32(ctx, int32(*p.OriginalFirmwareImage)); err != nil {
-			return thrift.PrependError(fmt.Sprintf("%T.OriginalFirmwareImage (2) field write error: ", p), err)
+		if err := oprot.WriteString(ctx, string(*p.ValueA)); err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T.ValueA (2) field write error: ", p), err)
 		}
 		if err := oprot.WriteFieldEnd(ctx); err != nil {
-			return thrift.PrependError(fmt.Sprintf("%T write field end error 2:OriginalFirmwareImage: ", p), err)
+			return thrift.PrependError(fmt.Sprintf("%T write field end error 2:ValueA: ", p), err)
 		}
 	}
 	return err
 }
 
-func (p *IntelACMInput) Equals(other *IntelACMInput) bool {
+func (p *InputDiff) writeField3(ctx context.Context, oprot thrift.TProtocol) (err error) {
+	if p.IsSetValueB() {
+		if err := oprot.WriteFieldBegin(ctx, "ValueB", thrift.STRING, 3); err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T write field begin error 3:ValueB: ", p), err)
+		}
+		if err := oprot.WriteString(ctx, string(*p.ValueB)); err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T.ValueB (3) field write error: ", p), err)
+		}
+		if err := oprot.WriteFieldEnd(ctx); err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T write field end error 3:ValueB: ", p), err)
+		}
+	}
+	return err
+}
+
+func (p *InputDiff) Equals(other *InputDiff) bool {
 	if p == other {
 		return true
 	} else if p == nil || other == nil {
 		return false
 	}
-	if p.ActualFirmwareImage != other.ActualFirmwareImage {
+	if p.Type != other.Type {
 		return false
 	}
-	if p.OriginalFirmwareImage != other.OriginalFirmwareImage {
-		if p.OriginalFirmwareImage == nil || other.OriginalFirmwareImage == nil {
+	if p.ValueA != other.ValueA {
+		if p.ValueA == nil || other.ValueA == nil {
 			return false
 		}
-		if (*p.OriginalFirmwareImage) != (*other.OriginalFirmwareImage) {
+		if (*p.ValueA) != (*other.ValueA) {
+			return false
+		}
+	}
+	if p.ValueB != other.ValueB {
+		if p.ValueB == nil || other.ValueB == nil {
+			return false
+		}
+		if (*p.ValueB) != (*other.ValueB) {
 			return false
 		}
 	}
 	return true
 }
 
-func (p *IntelACMInput) String() string {
+func (p *InputDiff) String() string {
 	if p == nil {
 		return "<nil>"
 	}
-	return fmt.Sprintf("IntelACMInput(%+v)", *p)
+	return fmt.Sprintf("InputDiff(%+v)", *p)
 }
 
 // Attributes:
-//   - ActualFirmwareImage
-//   - OriginalFirmwareImage
-//   - StatusRegisters
-//   - TPMDevice
-//   - TPMEventLog
-//   - ExpectedPCR
-//   - MeasurementsFlow
-type ReproducePCRInput struct {
-	ActualFirmwareImage   int32  `thrift:"ActualFirmwareImage,1" db:"ActualFirmwareImage" json:"ActualFirmwareImage"`
-	OriginalFirmwareImage *int32 `thrift:"OriginalFirmwareImage,2" db:"OriginalFirmwareImage" json:"OriginalFirmwareImage,omitempty"`
-	StatusRegisters       *int32 `thrift:"StatusRegisters,3" db:"StatusRegisters" json:"StatusRegisters,omitempty"`
-	TPMDevice             *int32 `thrift:"TPMDevice,4" db:"TPMDevice" json:"TPMDevice,omitempty"`
-	TPMEventLog           *int32 `thrift:"TPMEventLog,5" db:"TPMEventLog" json:"TPMEventLog,omitempty"`
-	ExpectedPCR           int32  `thrift:"ExpectedPCR,6" db:"ExpectedPCR" json:"ExpectedPCR"`
-	MeasurementsFlow      *int32 `thrift:"MeasurementsFlow,7" db:"MeasurementsFlow" json:"MeasurementsFlow,omitempty"`
+//   - AnalyzerName
+//   - PresentInA
+//   - PresentInB
+//   - ErrorA
+//   - ErrorB
+//   - Issues
+//   - DiagnosisA
+//   - DiagnosisB
+//   - CustomReportChanged
+//   - ChangedInputs
+type AnalyzerReportDiff struct {
+	AnalyzerName        string       `thrift:"AnalyzerName,1" db:"AnalyzerName" json:"AnalyzerName"`
+	PresentInA          bool         `thrift:"PresentInA,2" db:"PresentInA" json:"PresentInA"`
+	PresentInB          bool         `thrift:"PresentInB,3" db:"PresentInB" json:"PresentInB"`
+	ErrorA              *string      `thrift:"ErrorA,4" db:"ErrorA" json:"ErrorA,omitempty"`
+	ErrorB              *string      `thrift:"ErrorB,5" db:"ErrorB" json:"ErrorB,omitempty"`
+	Issues              []*IssueDiff `thrift:"Issues,6" db:"Issues" json:"Issues"`
+	DiagnosisA          *string      `thrift:"DiagnosisA,7" db:"DiagnosisA" json:"DiagnosisA,omitempty"`
+	DiagnosisB          *string      `thrift:"DiagnosisB,8" db:"DiagnosisB" json:"DiagnosisB,omitempty"`
+	CustomReportChanged bool         `thrift:"CustomReportChanged,9" db:"CustomReportChanged" json:"CustomReportChanged"`
+	ChangedInputs       []*InputDiff `thrift:"ChangedInputs,10" db:"ChangedInputs" json:"ChangedInputs"`
+}
+
+func NewAnalyzerReportDiff() *AnalyzerReportDiff {
+	return &AnalyzerReportDiff{}
+}
+
+func (p *AnalyzerReportDiff) GetAnalyzerName() string {
+	return p.AnalyzerName
 }
 
-func NewReproducePCRInput() *ReproducePCRInput {
-	return &ReproducePCRInput{}
+func (p *AnalyzerReportDiff) GetPresentInA() bool {
+	return p.PresentInA
 }
 
-func (p *ReproducePCRInput) GetActualFirmwareImage() int32 {
-	return p.ActualFirmwareImage
+func (p *AnalyzerReportDiff) GetPresentInB() bool {
+	return p.PresentInB
 }
 
-var ReproducePCRInput_OriginalFirmwareImage_DEFAULT int32
+var AnalyzerReportDiff_ErrorA_DEFAULT string
 
-func (p *ReproducePCRInput) GetOriginalFirmwareImage() int32 {
-	if !p.IsSetOriginalFirmwareImage() {
-		return ReproducePCRInput_OriginalFirmwareImage_DEFAULT
+func (p *AnalyzerReportDiff) GetErrorA() string {
+	if !p.IsSetErrorA() {
+		return AnalyzerReportDiff_ErrorA_DEFAULT
 	}
-	return *p.OriginalFirmwareImage
+	return *p.ErrorA
 }
 
-var ReproducePCRInput_StatusRegisters_DEFAULT int32
+var AnalyzerReportDiff_ErrorB_DEFAULT string
 
-func (p *ReproducePCRInput) GetStatusRegisters() int32 {
-	if !p.IsSetStatusRegisters() {
-		return ReproducePCRInput_StatusRegisters_DEFAULT
+func (p *AnalyzerReportDiff) GetErrorB() string {
+	if !p.IsSetErrorB() {
+		return AnalyzerReportDiff_ErrorB_DEFAULT
 	}
-	return *p.StatusRegisters
+	return *p.ErrorB
 }
 
-var ReproducePCRInput_TPMDevice_DEFAULT int32
-
-func (p *ReproducePCRInput) GetTPMDevice() int32 {
-	if !p.IsSetTPMDevice() {
-		return ReproducePCRInput_TPMDevice_DEFAULT
-	}
-	return *p.TPMDevice
+func (p *AnalyzerReportDiff) GetIssues() []*IssueDiff {
+	return p.Issues
 }
 
-var ReproducePCRInput_TPMEventLog_DEFAULT int32
+var AnalyzerReportDiff_DiagnosisA_DEFAULT string
 
-func (p *ReproducePCRInput) GetTPMEventLog() int32 {
-	if !p.IsSetTPMEventLog() {
-		return ReproducePCRInput_TPMEventLog_DEFAULT
+func (p *AnalyzerReportDiff) GetDiagnosisA() string {
+	if !p.IsSetDiagnosisA() {
+		return AnalyzerReportDiff_DiagnosisA_DEFAULT
 	}
-	return *p.TPMEventLog
-}
-
-func (p *ReproducePCRInput) GetExpectedPCR() int32 {
-	return p.ExpectedPCR
+	return *p.DiagnosisA
 }
 
-var ReproducePCRInput_MeasurementsFlow_DEFAULT int32
+var AnalyzerReportDiff_DiagnosisB_DEFAULT string
 
-func (p *ReproducePCRInput) GetMeasurementsFlow() int32 {
-	if !p.IsSetMeasurementsFlow() {
-		return ReproducePCRInput_MeasurementsFlow_DEFAULT
+func (p *AnalyzerReportDiff) GetDiagnosisB() string {
+	if !p.IsSetDiagnosisB() {
+		return AnalyzerReportDiff_DiagnosisB_DEFAULT
 	}
-	return *p.MeasurementsFlow
+	return *p.DiagnosisB
 }
-func (p *ReproducePCRInput) IsSetOriginalFirmwareImage() bool {
-	return p.OriginalFirmwareImage != nil
+
+func (p *AnalyzerReportDiff) GetCustomReportChanged() bool {
+	return p.CustomReportChanged
 }
 
-func (p *ReproducePCRInput) IsSetStatusRegisters() bool {
-	return p.StatusRegisters != nil
+func (p *AnalyzerReportDiff) GetChangedInputs() []*InputDiff {
+	return p.ChangedInputs
+}
+func (p *AnalyzerReportDiff) IsSetErrorA() bool {
+	return p.ErrorA != nil
 }
 
-func (p *ReproducePCRInput) IsSetTPMDevice() bool {
-	return p.TPMDevice != nil
+func (p *AnalyzerReportDiff) IsSetErrorB() bool {
+	return p.ErrorB != nil
 }
 
-func (p *ReproducePCRInput) IsSetTPMEventLog() bool {
-	return p.TPMEventLog != nil
+func (p *AnalyzerReportDiff) IsSetDiagnosisA() bool {
+	return p.DiagnosisA != nil
 }
 
-func (p *ReproducePCRInput) IsSetMeasurementsFlow() bool {
-	return p.MeasurementsFlow != nil
+func (p *AnalyzerReportDiff) IsSetDiagnosisB() bool {
+	return p.DiagnosisB != nil
 }
 
-func (p *ReproducePCRInput) Read(ctx context.Context, iprot thrift.TProtocol) error {
+func (p *AnalyzerReportDiff) Read(ctx context.Context, iprot thrift.TProtocol) error {
 	if _, err := iprot.ReadStructBegin(ctx); err != nil {
 		return thrift.PrependError(fmt.Sprintf("%T read error: ", p), err)
 	}
@@ -5922,7 +6059,7 @@ func (p *ReproducePCRInput) Read(ctx context.Context, iprot thrift.TProtocol) er
 		}
 		switch fieldId {
 		case 1:
-			if fieldTypeId == thrift.I32 {
+			if fieldTypeId == thrift.STRING {
 				if err := p.ReadField1(ctx, iprot); err != nil {
 					return err
 				}
@@ -5932,7 +6069,7 @@ func (p *ReproducePCRInput) Read(ctx context.Context, iprot thrift.TProtocol) er
 				}
 			}
 		case 2:
-			if fieldTypeId == thrift.I32 {
+			if fieldTypeId == thrift.BOOL {
 				if err := p.ReadField2(ctx, iprot); err != nil {
 					return err
 				}
@@ -5942,7 +6079,7 @@ func (p *ReproducePCRInput) Read(ctx context.Context, iprot thrift.TProtocol) er
 				}
 			}
 		case 3:
-			if fieldTypeId == thrift.I32 {
+			if fieldTypeId == thrift.BOOL {
 				if err := p.ReadField3(ctx, iprot); err != nil {
 					return err
 				}
@@ -5952,7 +6089,7 @@ func (p *ReproducePCRInput) Read(ctx context.Context, iprot thrift.TProtocol) er
 				}
 			}
 		case 4:
-			if fieldTypeId == thrift.I32 {
+			if fieldTypeId == thrift.STRING {
 				if err := p.ReadField4(ctx, iprot); err != nil {
 					return err
 				}
@@ -5962,7 +6099,7 @@ func (p *ReproducePCRInput) Read(ctx context.Context, iprot thrift.TProtocol) er
 				}
 			}
 		case 5:
-			if fieldTypeId == thrift.I32 {
+			if fieldTypeId == thrift.STRING {
 				if err := p.ReadField5(ctx, iprot); err != nil {
 					return err
 				}
@@ -5972,7 +6109,7 @@ func (p *ReproducePCRInput) Read(ctx context.Context, iprot thrift.TProtocol) er
 				}
 			}
 		case 6:
-			if fieldTypeId == thrift.I32 {
+			if fieldTypeId == thrift.LIST {
 				if err := p.ReadField6(ctx, iprot); err != nil {
 					return err
 				}
@@ -5982,7 +6119,7 @@ func (p *ReproducePCRInput) Read(ctx context.Context, iprot thrift.TProtocol) er
 				}
 			}
 		case 7:
-			if fieldTypeId == thrift.I32 {
+			if fieldTypeId == thrift.STRING {
 				if err := p.ReadField7(ctx, iprot); err != nil {
 					return err
 				}
@@ -5991,6 +6128,36 @@ func (p *ReproducePCRInput) Read(ctx context.Context, iprot thrift.TProtocol) er
 					return err
 				}
 			}
+		case 8:
+			if fieldTypeId == thrift.STRING {
+				if err := p.ReadField8(ctx, iprot); err != nil {
+					return err
+				}
+			} else {
+				if err := iprot.Skip(ctx, fieldTypeId); err != nil {
+					return err
+				}
+			}
+		case 9:
+			if fieldTypeId == thrift.BOOL {
+				if err := p.ReadField9(ctx, iprot); err != nil {
+					return err
+				}
+			} else {
+				if err := iprot.Skip(ctx, fieldTypeId); err != nil {
+					return err
+				}
+			}
+		case 10:
+			if fieldTypeId == thrift.LIST {
+				if err := p.ReadField10(ctx, iprot); err != nil {
+					return err
+				}
+			} else {
+				if err := iprot.Skip(ctx, fieldTypeId); err != nil {
+					return err
+				}
+			}
 		default:
 			if err := iprot.Skip(ctx, fieldTypeId); err != nil {
 				return err
@@ -6006,71 +6173,120 @@ func (p *ReproducePCRInput) Read(ctx context.Context, iprot thrift.TProtocol) er
 	return nil
 }
 
-func (p *ReproducePCRInput) ReadField1(ctx context.Context, iprot thrift.TProtocol) error {
-	if v, err := iprot.ReadI32(ctx); err != nil {
+func (p *AnalyzerReportDiff) ReadField1(ctx context.Context, iprot thrift.TProtocol) error {
+	if v, err := iprot.ReadString(ctx); err != nil {
 		return thrift.PrependError("error reading field 1: ", err)
 	} else {
-		p.ActualFirmwareImage = v
+		p.AnalyzerName = v
 	}
 	return nil
 }
 
-func (p *ReproducePCRInput) ReadField2(ctx context.Context, iprot thrift.TProtocol) error {
-	if v, err := iprot.ReadI32(ctx); err != nil {
+func (p *AnalyzerReportDiff) ReadField2(ctx context.Context, iprot thrift.TProtocol) error {
+	if v, err := iprot.ReadBool(ctx); err != nil {
 		return thrift.PrependError("error reading field 2: ", err)
 	} else {
-		p.OriginalFirmwareImage = &v
+		p.PresentInA = v
 	}
 	return nil
 }
 
-func (p *ReproducePCRInput) ReadField3(ctx context.Context, iprot thrift.TProtocol) error {
-	if v, err := iprot.ReadI32(ctx); err != nil {
+func (p *AnalyzerReportDiff) ReadField3(ctx context.Context, iprot thrift.TProtocol) error {
+	if v, err := iprot.ReadBool(ctx); err != nil {
 		return thrift.PrependError("error reading field 3: ", err)
 	} else {
-		p.StatusRegisters = &v
+		p.PresentInB = v
 	}
 	return nil
 }
 
-func (p *ReproducePCRInput) ReadField4(ctx context.Context, iprot thrift.TProtocol) error {
-	if v, err := iprot.ReadI32(ctx); err != nil {
+func (p *AnalyzerReportDiff) ReadField4(ctx context.Context, iprot thrift.TProtocol) error {
+	if v, err := iprot.ReadString(ctx); err != nil {
 		return thrift.PrependError("error reading field 4: ", err)
 	} else {
-		p.TPMDevice = &v
+		p.ErrorA = &v
 	}
 	return nil
 }
 
-func (p *ReproducePCRInput) ReadField5(ctx context.Context, iprot thrift.TProtocol) error {
-	if v, err := iprot.ReadI32(ctx); err != nil {
+func (p *AnalyzerReportDiff) ReadField5(ctx context.Context, iprot thrift.TProtocol) error {
+	if v, err := iprot.ReadString(ctx); err != nil {
 		return thrift.PrependError("error reading field 5: ", err)
 	} else {
-		p.TPMEventLog = &v
+		p.ErrorB = &v
 	}
 	return nil
 }
 
-func (p *ReproducePCRInput) ReadField6(ctx context.Context, iprot thrift.TProtocol) error {
-	if v, err := iprot.ReadI32(ctx); err != nil {
-		return thrift.PrependError("error reading field 6: ", err)
-	} else {
-		p.ExpectedPCR = v
+func (p *AnalyzerReportDiff) ReadField6(ctx context.Context, iprot thrift.TProtocol) error {
+	_, size, err := iprot.ReadListBegin(ctx)
+	if err != nil {
+		return thrift.PrependError("error reading list begin: ", err)
+	}
+	tSlice := make([]*IssueDiff, 0, size)
+	p.Issues = tSlice
+	for i := 0; i < size; i++ {
+		_elem12 := &IssueDiff{}
+		if err := _elem12.Read(ctx, iprot); err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T error reading struct: ", _elem12), err)
+		}
+		p.Issues = append(p.Issues, _elem12)
+	}
+	if err := iprot.ReadListEnd(ctx); err != nil {
+		return thrift.PrependError("error reading list end: ", err)
 	}
 	return nil
 }
 
-func (p *ReproducePCRInput) ReadField7(ctx context.Context, iprot thrift.TProtocol) error {
-	if v, err := iprot.ReadI32(ctx); err != nil {
+func (p *AnalyzerReportDiff) ReadField7(ctx context.Context, iprot thrift.TProtocol) error {
+	if v, err := iprot.ReadString(ctx); err != nil {
 		return thrift.PrependError("error reading field 7: ", err)
 	} else {
-		p.MeasurementsFlow = &v
+		p.DiagnosisA = &v
 	}
 	return nil
 }
 
-func (p *ReproducePCRInput) Write(ctx context.Context, oprot thrift.TProtocol) error {
-	if err := oprot.WriteStructBegin(ctx, "ReproducePCRInput"); err != nil {
+func (p *AnalyzerReportDiff) ReadField8(ctx context.Context, iprot thrift.TProtocol) error {
+	if v, err := iprot.ReadString(ctx); err != nil {
+		return thrift.PrependError("error reading field 8: ", err)
+	} else {
+		p.DiagnosisB = &v
+	}
+	return nil
+}
+
+func (p *AnalyzerReportDiff) ReadField9(ctx context.Context, iprot thrift.TProtocol) error {
+	if v, err := iprot.ReadBool(ctx); err != nil {
+		return thrift.PrependError("error reading field 9: ", err)
+	} else {
+		p.CustomReportChanged = v
+	}
+	return nil
+}
+
+func (p *AnalyzerReportDiff) ReadField10(ctx context.Context, iprot thrift.TProtocol) error {
+	_, size, err := iprot.ReadListBegin(ctx)
+	if err != nil {
+		return thrift.PrependError("error reading list begin: ", err)
+	}
+	tSlice := make([]*InputDiff, 0, size)
+	p.ChangedInputs = tSlice
+	for i := 0; i < size; i++ {
+		_elem13 := &InputDiff{}
+		if err := _elem13.Read(ctx, iprot); err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T error reading struct: ", _elem13), err)
+		}
+		p.ChangedInputs = append(p.ChangedInputs, _elem13)
+	}
+	if err := iprot.ReadListEnd(ctx); err != nil {
+		return thrift.PrependError("error reading list end: ", err)
+	}
+	return nil
+}
+
+func (p *AnalyzerReportDiff) Write(ctx context.Context, oprot thrift.TProtocol) error {
+	if err := oprot.WriteStructBegin(ctx, "AnalyzerReportDiff"); err != nil {
 		return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
 	}
 	if p != nil {
@@ -6095,6 +6311,15 @@ func (p *ReproducePCRInput) Write(ctx context.Context, oprot thrift.TProtocol) e
 		if err := p.writeField7(ctx, oprot); err != nil {
 			return err
 		}
+		if err := p.writeField8(ctx, oprot); err != nil {
+			return err
+		}
+		if err := p.writeField9(ctx, oprot); err != nil {
+			return err
+		}
+		if err := p.writeField10(ctx, oprot); err != nil {
+			return err
+		}
 	}
 	if err := oprot.WriteFieldStop(ctx); err != nil {
 		return thrift.PrependError("write field stop error: ", err)
@@ -6105,183 +6330,282 @@ func (p *ReproducePCRInput) Write(ctx context.Context, oprot thrift.TProtocol) e
 	return nil
 }
 
-func (p *ReproducePCRInput) writeField1(ctx context.Context, oprot thrift.TProtocol) (err error) {
-	if err := oprot.WriteFieldBegin(ctx, "ActualFirmwareImage", thrift.I32, 1); err != nil {
-		return thrift.PrependError(fmt.Sprintf("%T write field begin error 1:ActualFirmwareImage: ", p), err)
+func (p *AnalyzerReportDiff) writeField1(ctx context.Context, oprot thrift.TProtocol) (err error) {
+	if err := oprot.WriteFieldBegin(ctx, "AnalyzerName", thrift.STRING, 1); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T write field begin error 1:AnalyzerName: ", p), err)
 	}
-	if err := oprot.WriteI32(ctx, int32(p.ActualFirmwareImage)); err != nil {
-		return thrift.PrependError(fmt.Sprintf("%T.ActualFirmwareImage (1) field write error: ", p), err)
+	if err := oprot.WriteString(ctx, string(p.AnalyzerName)); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T.AnalyzerName (1) field write error: ", p), err)
 	}
 	if err := oprot.WriteFieldEnd(ctx); err != nil {
-		return thrift.PrependError(fmt.Sprintf("%T write field end error 1:ActualFirmwareImage: ", p), err)
+		return thrift.PrependError(fmt.Sprintf("%T write field end error 1:AnalyzerName: ", p), err)
 	}
 	return err
 }
 
-func (p *ReproducePCRInput) writeField2(ctx context.Context, oprot thrift.TProtocol) (err error) {
-	if p.IsSetOriginalFirmwareImage() {
-		if err := oprot.WriteFieldBegin(ctx, "OriginalFirmwareImage", thrift.I32, 2); err != nil {
-			return thrift.PrependError(fmt.Sprintf("%T write field begin error 2:OriginalFirmwareImage: ", p), err)
-		}
-		if err := oprot.WriteI32(ctx, int32(*p.OriginalFirmwareImage)); err != nil {
-			return thrift.PrependError(fmt.Sprintf("%T.OriginalFirmwareImage (2) field write error: ", p), err)
-		}
-		if err := oprot.WriteFieldEnd(ctx); err != nil {
-			return thrift.PrependError(fmt.Sprintf("%T write field end error 2:OriginalFirmwareImage: ", p), err)
-		}
+func (p *AnalyzerReportDiff) writeField2(ctx context.Context, oprot thrift.TProtocol) (err error) {
+	if err := oprot.WriteFieldBegin(ctx, "PresentInA", thrift.BOOL, 2); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T write field begin error 2:PresentInA: ", p), err)
+	}
+	if err := oprot.WriteBool(ctx, bool(p.PresentInA)); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T.PresentInA (2) field write error: ", p), err)
+	}
+	if err := oprot.WriteFieldEnd(ctx); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T write field end error 2:PresentInA: ", p), err)
 	}
 	return err
 }
 
-func (p *ReproducePCRInput) writeField3(ctx context.Context, oprot thrift.TProtocol) (err error) {
-	if p.IsSetStatusRegisters() {
-		if err := oprot.WriteFieldBegin(ctx, "StatusRegisters", thrift.I32, 3); err != nil {
-			return thrift.PrependError(fmt.Sprintf("%T write field begin error 3:StatusRegisters: ", p), err)
-		}
-		if err := oprot.WriteI32(ctx, int32(*p.StatusRegisters)); err != nil {
-			return thrift.PrependError(fmt.Sprintf("%T.StatusRegisters (3) field write error: ", p), err)
-		}
-		if err := oprot.WriteFieldEnd(ctx); err != nil {
-			return thrift.PrependError(fmt.Sprintf("%T write field end error 3:StatusRegisters: ", p), err)
-		}
+func (p *AnalyzerReportDiff) writeField3(ctx context.Context, oprot thrift.TProtocol) (err error) {
+	if err := oprot.WriteFieldBegin(ctx, "PresentInB", thrift.BOOL, 3); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T write field begin error 3:PresentInB: ", p), err)
+	}
+	if err := oprot.WriteBool(ctx, bool(p.PresentInB)); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T.PresentInB (3) field write error: ", p), err)
+	}
+	if err := oprot.WriteFieldEnd(ctx); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T write field end error 3:PresentInB: ", p), err)
 	}
 	return err
 }
 
-func (p *ReproducePCRInput) writeField4(ctx context.Context, oprot thrift.TProtocol) (err error) {
-	if p.IsSetTPMDevice() {
-		if err := oprot.WriteFieldBegin(ctx, "TPMDevice", thrift.I32, 4); err != nil {
-			return thrift.PrependError(fmt.Sprintf("%T write field begin error 4:TPMDevice: ", p), err)
+func (p *AnalyzerReportDiff) writeField4(ctx context.Context, oprot thrift.TProtocol) (err error) {
+	if p.IsSetErrorA() {
+		if err := oprot.WriteFieldBegin(ctx, "ErrorA", thrift.STRING, 4); err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T write field begin error 4:ErrorA: ", p), err)
 		}
-		if err := oprot.WriteI32(ctx, int32(*p.TPMDevice)); err != nil {
-			return thrift.PrependError(fmt.Sprintf("%T.TPMDevice (4) field write error: ", p), err)
+		if err := oprot.WriteString(ctx, string(*p.ErrorA)); err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T.ErrorA (4) field write error: ", p), err)
 		}
 		if err := oprot.WriteFieldEnd(ctx); err != nil {
-			return thrift.PrependError(fmt.Sprintf("%T write field end error 4:TPMDevice: ", p), err)
+			return thrift.PrependError(fmt.Sprintf("%T write field end error 4:ErrorA: ", p), err)
 		}
 	}
 	return err
 }
 
-func (p *ReproducePCRInput) writeField5(ctx context.Context, oprot thrift.TProtocol) (err error) {
-	if p.IsSetTPMEventLog() {
-		if err := oprot.WriteFieldBegin(ctx, "TPMEventLog", thrift.I32, 5); err != nil {
-			return thrift.PrependError(fmt.Sprintf("%T write field begin error 5:TPMEventLog: ", p), err)
+func (p *AnalyzerReportDiff) writeField5(ctx context.Context, oprot thrift.TProtocol) (err error) {
+	if p.IsSetErrorB() {
+		if err := oprot.WriteFieldBegin(ctx, "ErrorB", thrift.STRING, 5); err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T write field begin error 5:ErrorB: ", p), err)
 		}
-		if err := oprot.WriteI32(ctx, int32(*p.TPMEventLog)); err != nil {
-			return thrift.PrependError(fmt.Sprintf("%T.TPMEventLog (5) field write error: ", p), err)
+		if err := oprot.WriteString(ctx, string(*p.ErrorB)); err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T.ErrorB (5) field write error: ", p), err)
 		}
 		if err := oprot.WriteFieldEnd(ctx); err != nil {
-			return thrift.PrependError(fmt.Sprintf("%T write field end error 5:TPMEventLog: ", p), err)
+			return thrift.PrependError(fmt.Sprintf("%T write field end error 5:ErrorB: ", p), err)
 		}
 	}
 	return err
 }
 
-func (p *ReproducePCRInput) writeField6(ctx context.Context, oprot thrift.TProtocol) (err error) {
-	if err := oprot.WriteFieldBegin(ctx, "ExpectedPCR", thrift.I32, 6); err != nil {
-		return thrift.PrependError(fmt.Sprintf("%T write field begin error 6:ExpectedPCR: ", p), err)
+func (p *AnalyzerReportDiff) writeField6(ctx context.Context, oprot thrift.TProtocol) (err error) {
+	if err := oprot.WriteFieldBegin(ctx, "Issues", thrift.LIST, 6); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T write field begin error 6:Issues: ", p), err)
 	}
-	if err := oprot.WriteI32(ctx, int32(p.ExpectedPCR)); err != nil {
-		return thrift.PrependError(fmt.Sprintf("%T.ExpectedPCR (6) field write error: ", p), err)
+	if err := oprot.WriteListBegin(ctx, thrift.STRUCT, len(p.Issues)); err != nil {
+		return thrift.PrependError("error writing list begin: ", err)
+	}
+	for _, v := range p.Issues {
+		if err := v.Write(ctx, oprot); err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T error writing struct: ", v), err)
+		}
+	}
+	if err := oprot.WriteListEnd(ctx); err != nil {
+		return thrift.PrependError("error writing list end: ", err)
 	}
 	if err := oprot.WriteFieldEnd(ctx); err != nil {
-		return thrift.PrependError(fmt.Sprintf("%T write field end error 6:ExpectedPCR: ", p), err)
+		return thrift.PrependError(fmt.Sprintf("%T write field end error 6:Issues: ", p), err)
 	}
 	return err
 }
 
-func (p *ReproducePCRInput) writeField7(ctx context.Context, oprot thrift.TProtocol) (err error) {
-	if p.IsSetMeasurementsFlow() {
-		if err := oprot.WriteFieldBegin(ctx, "MeasurementsFlow", thrift.I32, 7); err != nil {
-			return thrift.PrependError(fmt.Sprintf("%T write field begin error 7:MeasurementsFlow: ", p), err)
+func (p *AnalyzerReportDiff) writeField7(ctx context.Context, oprot thrift.TProtocol) (err error) {
+	if p.IsSetDiagnosisA() {
+		if err := oprot.WriteFieldBegin(ctx, "DiagnosisA", thrift.STRING, 7); err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T write field begin error 7:DiagnosisA: ", p), err)
 		}
-		if err := oprot.WriteI32(ctx, int32(*p.MeasurementsFlow)); err != nil {
-			return thrift.PrependError(fmt.Sprintf("%T.MeasurementsFlow (7) field write error: ", p), err)
+		if err := oprot.WriteString(ctx, string(*p.DiagnosisA)); err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T.DiagnosisA (7) field write error: ", p), err)
 		}
 		if err := oprot.WriteFieldEnd(ctx); err != nil {
-			return thrift.PrependError(fmt.Sprintf("%T write field end error 7:MeasurementsFlow: ", p), err)
+			return thrift.PrependError(fmt.Sprintf("%T write field end error 7:DiagnosisA: ", p), err)
 		}
 	}
 	return err
 }
 
-func (p *ReproducePCRInput) Equals(other *ReproducePCRInput) bool {
-	if p == other {
-		return true
-	} else if p == nil || other == nil {
-		return false
-	}
-	if p.ActualFirmwareImage != other.ActualFirmwareImage {
+func (p *AnalyzerReportDiff) writeField8(ctx context.Context, oprot thrift.TProtocol) (err error) {
+	if p.IsSetDiagnosisB() {
+		if err := oprot.WriteFieldBegin(ctx, "DiagnosisB", thrift.STRING, 8); err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T write field begin error 8:DiagnosisB: ", p), err)
+		}
+		if err := oprot.WriteString(ctx, string(*p.DiagnosisB)); err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T.DiagnosisB (8) field write error: ", p), err)
+		}
+		if err := oprot.WriteFieldEnd(ctx); err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T write field end error 8:DiagnosisB: ", p), err)
+		}
+	}
+	return err
+}
+
+func (p *AnalyzerReportDiff) writeField9(ctx context.Context, oprot thrift.TProtocol) (err error) {
+	if err := oprot.WriteFieldBegin(ctx, "CustomReportChanged", thrift.BOOL, 9); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T write field begin error 9:CustomReportChanged: ", p), err)
+	}
+	if err := oprot.WriteBool(ctx, bool(p.CustomReportChanged)); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T.CustomReportChanged (9) field write error: ", p), err)
+	}
+	if err := oprot.WriteFieldEnd(ctx); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T write field end error 9:CustomReportChanged: ", p), err)
+	}
+	return err
+}
+
+func (p *AnalyzerReportDiff) writeField10(ctx context.Context, oprot thrift.TProtocol) (err error) {
+	if err := oprot.WriteFieldBegin(ctx, "ChangedInputs", thrift.LIST, 10); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T write field begin error 10:ChangedInputs: ", p), err)
+	}
+	if err := oprot.WriteListBegin(ctx, thrift.STRUCT, len(p.ChangedInputs)); err != nil {
+		return thrift.PrependError("error writing list begin: ", err)
+	}
+	for _, v := range p.ChangedInputs {
+		if err := v.Write(ctx, oprot); err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T error writing struct: ", v), err)
+		}
+	}
+	if err := oprot.WriteListEnd(ctx); err != nil {
+		return thrift.PrependError("error writing list end: ", err)
+	}
+	if err := oprot.WriteFieldEnd(ctx); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T write field end error 10:ChangedInputs: ", p), err)
+	}
+	return err
+}
+
+func (p *AnalyzerReportDiff) Equals(other *AnalyzerReportDiff) bool {
+	if p == other {
+		return true
+	} else if p == nil || other == nil {
 		return false
 	}
-	if p.OriginalFirmwareImage != other.OriginalFirmwareImage {
-		if p.OriginalFirmwareImage == nil || other.OriginalFirmwareImage == nil {
+	if p.AnalyzerName != other.AnalyzerName {
+		return false
+	}
+	if p.PresentInA != other.PresentInA {
+		return false
+	}
+	if p.PresentInB != other.PresentInB {
+		return false
+	}
+	if p.ErrorA != other.ErrorA {
+		if p.ErrorA == nil || other.ErrorA == nil {
 			return false
 		}
-		if (*p.OriginalFirmwareImage) != (*other.OriginalFirmwareImage) {
+		if (*p.ErrorA) != (*other.ErrorA) {
 			return false
 		}
 	}
-	if p.StatusRegisters != other.StatusRegisters {
-		if p.StatusRegisters == nil || other.StatusRegisters == nil {
+	if p.ErrorB != other.ErrorB {
+		if p.ErrorB == nil || other.ErrorB == nil {
 			return false
 		}
-		if (*p.StatusRegisters) != (*other.StatusRegisters) {
+		if (*p.ErrorB) != (*other.ErrorB) {
 			return false
 		}
 	}
-	if p.TPMDevice != other.TPMDevice {
-		if p.TPMDevice == nil || other.TPMDevice == nil {
+	if len(p.Issues) != len(other.Issues) {
+		return false
+	}
+	for i, _tgt := range p.Issues {
+		_src14 := other.Issues[i]
+		if !_tgt.Equals(_src14) {
 			return false
 		}
-		if (*p.TPMDevice) != (*other.TPMDevice) {
+	}
+	if p.DiagnosisA != other.DiagnosisA {
+		if p.DiagnosisA == nil || other.DiagnosisA == nil {
+			return false
+		}
+		if (*p.DiagnosisA) != (*other.DiagnosisA) {
 			return false
 		}
 	}
-	if p.TPMEventLog != other.TPMEventLog {
-		if p.TPMEventLog == nil || other.TPMEventLog == nil {
+	if p.DiagnosisB != other.DiagnosisB {
+		if p.DiagnosisB == nil || other.DiagnosisB == nil {
 			return false
 		}
-		if (*p.TPMEventLog) != (*other.TPMEventLog) {
+		if (*p.DiagnosisB) != (*other.DiagnosisB) {
 			return false
 		}
 	}
-	if p.ExpectedPCR != other.ExpectedPCR {
+	if p.CustomReportChanged != other.CustomReportChanged {
 		return false
 	}
-	if p.MeasurementsFlow != other.MeasurementsFlow {
-		if p.MeasurementsFlow == nil || other.MeasurementsFlow == nil {
-			return false
-		}
-		if (*p.MeasurementsFlow) != (*other.MeasurementsFlow) {
+	if len(p.ChangedInputs) != len(other.ChangedInputs) {
+		return false
+	}
+	for i, _tgt := range p.ChangedInputs {
+		_src15 := other.ChangedInputs[i]
+		if !_tgt.Equals(_src15) {
 			return false
 		}
 	}
 	return true
 }
 
-func (p *ReproducePCRInput) String() string {
+func (p *AnalyzerReportDiff) String() string {
 	if p == nil {
 		return "<nil>"
 	}
-	return fmt.Sprintf("ReproducePCRInput(%+v)", *p)
+	return fmt.Sprintf("AnalyzerReportDiff(%+v)", *p)
 }
 
 // Attributes:
-//   - ActualFirmwareImage
-type PSPSignatureInput struct {
-	ActualFirmwareImage int32 `thrift:"ActualFirmwareImage,1" db:"ActualFirmwareImage" json:"ActualFirmwareImage"`
+//   - ReportA
+//   - ReportB
+//   - Analyzers
+type CompareReportsResult_ struct {
+	ReportA   *AnalyzeReportSummary `thrift:"ReportA,1" db:"ReportA" json:"ReportA"`
+	ReportB   *AnalyzeReportSummary `thrift:"ReportB,2" db:"ReportB" json:"ReportB"`
+	Analyzers []*AnalyzerReportDiff `thrift:"Analyzers,3" db:"Analyzers" json:"Analyzers"`
 }
 
-func NewPSPSignatureInput() *PSPSignatureInput {
-	return &PSPSignatureInput{}
+func NewCompareReportsResult_() *CompareReportsResult_ {
+	return &CompareReportsResult_{}
 }
 
-func (p *PSPSignatureInput) GetActualFirmwareImage() int32 {
-	return p.ActualFirmwareImage
+var CompareReportsResult__ReportA_DEFAULT *AnalyzeReportSummary
+
+func (p *CompareReportsResult_) GetReportA() *AnalyzeReportSummary {
+	if !p.IsSetReportA() {
+		return CompareReportsResult__ReportA_DEFAULT
+	}
+	return p.ReportA
 }
-func (p *PSPSignatureInput) Read(ctx context.Context, iprot thrift.TProtocol) error {
+
+var CompareReportsResult__ReportB_DEFAULT *AnalyzeReportSummary
+
+func (p *CompareReportsResult_) GetReportB() *AnalyzeReportSummary {
+	if !p.IsSetReportB() {
+		return CompareReportsResult__ReportB_DEFAULT
+	}
+	return p.ReportB
+}
+
+func (p *CompareReportsResult_) GetAnalyzers() []*AnalyzerReportDiff {
+	return p.Analyzers
+}
+func (p *CompareReportsResult_) IsSetReportA() bool {
+	return p.ReportA != nil
+}
+
+func (p *CompareReportsResult_) IsSetReportB() bool {
+	return p.ReportB != nil
+}
+
+func (p *CompareReportsResult_) Read(ctx context.Context, iprot thrift.TProtocol) error {
 	if _, err := iprot.ReadStructBegin(ctx); err != nil {
 		return thrift.PrependError(fmt.Sprintf("%T read error: ", p), err)
 	}
@@ -6296,7 +6620,7 @@ func (p *PSPSignatureInput) Read(ctx context.Context, iprot thrift.TProtocol) er
 		}
 		switch fieldId {
 		case 1:
-			if fieldTypeId == thrift.I32 {
+			if fieldTypeId == thrift.STRUCT {
 				if err := p.ReadField1(ctx, iprot); err != nil {
 					return err
 				}
@@ -6305,6 +6629,26 @@ func (p *PSPSignatureInput) Read(ctx context.Context, iprot thrift.TProtocol) er
 					return err
 				}
 			}
+		case 2:
+			if fieldTypeId == thrift.STRUCT {
+				if err := p.ReadField2(ctx, iprot); err != nil {
+					return err
+				}
+			} else {
+				if err := iprot.Skip(ctx, fieldTypeId); err != nil {
+					return err
+				}
+			}
+		case 3:
+			if fieldTypeId == thrift.LIST {
+				if err := p.ReadField3(ctx, iprot); err != nil {
+					return err
+				}
+			} else {
+				if err := iprot.Skip(ctx, fieldTypeId); err != nil {
+					return err
+				}
+			}
 		default:
 			if err := iprot.Skip(ctx, fieldTypeId); err != nil {
 				return err
@@ -6320,23 +6664,56 @@ func (p *PSPSignatureInput) Read(ctx context.Context, iprot thrift.TProtocol) er
 	return nil
 }
 
-func (p *PSPSignatureInput) ReadField1(ctx context.Context, iprot thrift.TProtocol) error {
-	if v, err := iprot.ReadI32(ctx); err != nil {
-		return thrift.PrependError("error reading field 1: ", err)
-	} else {
-		p.ActualFirmwareImage = v
+func (p *CompareReportsResult_) ReadField1(ctx context.Context, iprot thrift.TProtocol) error {
+	p.ReportA = &AnalyzeReportSummary{}
+	if err := p.ReportA.Read(ctx, iprot); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T error reading struct: ", p.ReportA), err)
 	}
 	return nil
 }
 
-func (p *PSPSignatureInput) Write(ctx context.Context, oprot thrift.TProtocol) error {
-	if err := oprot.WriteStructBegin(ctx, "PSPSignatureInput"); err != nil {
+func (p *CompareReportsResult_) ReadField2(ctx context.Context, iprot thrift.TProtocol) error {
+	p.ReportB = &AnalyzeReportSummary{}
+	if err := p.ReportB.Read(ctx, iprot); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T error reading struct: ", p.ReportB), err)
+	}
+	return nil
+}
+
+func (p *CompareReportsResult_) ReadField3(ctx context.Context, iprot thrift.TProtocol) error {
+	_, size, err := iprot.ReadListBegin(ctx)
+	if err != nil {
+		return thrift.PrependError("error reading list begin: ", err)
+	}
+	tSlice := make([]*AnalyzerReportDiff, 0, size)
+	p.Analyzers = tSlice
+	for i := 0; i < size; i++ {
+		_elem16 := &AnalyzerReportDiff{}
+		if err := _elem16.Read(ctx, iprot); err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T error reading struct: ", _elem16), err)
+		}
+		p.Analyzers = append(p.Analyzers, _elem16)
+	}
+	if err := iprot.ReadListEnd(ctx); err != nil {
+		return thrift.PrependError("error reading list end: ", err)
+	}
+	return nil
+}
+
+func (p *CompareReportsResult_) Write(ctx context.Context, oprot thrift.TProtocol) error {
+	if err := oprot.WriteStructBegin(ctx, "CompareReportsResult"); err != nil {
 		return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
 	}
 	if p != nil {
 		if err := p.writeField1(ctx, oprot); err != nil {
 			return err
 		}
+		if err := p.writeField2(ctx, oprot); err != nil {
+			return err
+		}
+		if err := p.writeField3(ctx, oprot); err != nil {
+			return err
+		}
 	}
 	if err := oprot.WriteFieldStop(ctx); err != nil {
 		return thrift.PrependError("write field stop error: ", err)
@@ -6347,52 +6724,134 @@ func (p *PSPSignatureInput) Write(ctx context.Context, oprot thrift.TProtocol) e
 	return nil
 }
 
-func (p *PSPSignatureInput) writeField1(ctx context.Context, oprot thrift.TProtocol) (err error) {
-	if err := oprot.WriteFieldBegin(ctx, "ActualFirmwareImage", thrift.I32, 1); err != nil {
-		return thrift.PrependError(fmt.Sprintf("%T write field begin error 1:ActualFirmwareImage: ", p), err)
+func (p *CompareReportsResult_) writeField1(ctx context.Context, oprot thrift.TProtocol) (err error) {
+	if err := oprot.WriteFieldBegin(ctx, "ReportA", thrift.STRUCT, 1); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T write field begin error 1:ReportA: ", p), err)
 	}
-	if err := oprot.WriteI32(ctx, int32(p.ActualFirmwareImage)); err != nil {
-		return thrift.PrependError(fmt.Sprintf("%T.ActualFirmwareImage (1) field write error: ", p), err)
+	if err := p.ReportA.Write(ctx, oprot); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T error writing struct: ", p.ReportA), err)
 	}
 	if err := oprot.WriteFieldEnd(ctx); err != nil {
-		return thrift.PrependError(fmt.Sprintf("%T write field end error 1:ActualFirmwareImage: ", p), err)
+		return thrift.PrependError(fmt.Sprintf("%T write field end error 1:ReportA: ", p), err)
 	}
 	return err
 }
 
-func (p *PSPSignatureInput) Equals(other *PSPSignatureInput) bool {
+func (p *CompareReportsResult_) writeField2(ctx context.Context, oprot thrift.TProtocol) (err error) {
+	if err := oprot.WriteFieldBegin(ctx, "ReportB", thrift.STRUCT, 2); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T write field begin error 2:ReportB: ", p), err)
+	}
+	if err := p.ReportB.Write(ctx, oprot); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T error writing struct: ", p.ReportB), err)
+	}
+	if err := oprot.WriteFieldEnd(ctx); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T write field end error 2:ReportB: ", p), err)
+	}
+	return err
+}
+
+func (p *CompareReportsResult_) writeField3(ctx context.Context, oprot thrift.TProtocol) (err error) {
+	if err := oprot.WriteFieldBegin(ctx, "Analyzers", thrift.LIST, 3); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T write field begin error 3:Analyzers: ", p), err)
+	}
+	if err := oprot.WriteListBegin(ctx, thrift.STRUCT, len(p.Analyzers)); err != nil {
+		return thrift.PrependError("error writing list begin: ", err)
+	}
+	for _, v := range p.Analyzers {
+		if err := v.Write(ctx, oprot); err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T error writing struct: ", v), err)
+		}
+	}
+	if err := oprot.WriteListEnd(ctx); err != nil {
+		return thrift.PrependError("error writing list end: ", err)
+	}
+	if err := oprot.WriteFieldEnd(ctx); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T write field end error 3:Analyzers: ", p), err)
+	}
+	return err
+}
+
+func (p *CompareReportsResult_) Equals(other *CompareReportsResult_) bool {
 	if p == other {
 		return true
 	} else if p == nil || other == nil {
 		return false
 	}
-	if p.ActualFirmwareImage != other.ActualFirmwareImage {
+	if !p.ReportA.Equals(other.ReportA) {
+		return false
+	}
+	if !p.ReportB.Equals(other.ReportB) {
+		return false
+	}
+	if len(p.Analyzers) != len(other.Analyzers) {
 		return false
 	}
+	for i, _tgt := range p.Analyzers {
+		_src17 := other.Analyzers[i]
+		if !_tgt.Equals(_src17) {
+			return false
+		}
+	}
 	return true
 }
 
-func (p *PSPSignatureInput) String() string {
+func (p *CompareReportsResult_) String() string {
 	if p == nil {
 		return "<nil>"
 	}
-	return fmt.Sprintf("PSPSignatureInput(%+v)", *p)
+	return fmt.Sprintf("CompareReportsResult_(%+v)", *p)
 }
 
 // Attributes:
-//   - ActualFirmwareImage
-type BIOSRTMVolumeInput struct {
-	ActualFirmwareImage int32 `thrift:"ActualFirmwareImage,1" db:"ActualFirmwareImage" json:"ActualFirmwareImage"`
+//   - JobID
+//   - AssetID
+//   - Timestamp
+//   - Verdict
+type AnalyzeReportSummary struct {
+	JobID     []byte          `thrift:"JobID,1" db:"JobID" json:"JobID"`
+	AssetID   *int64          `thrift:"AssetID,2" db:"AssetID" json:"AssetID,omitempty"`
+	Timestamp int64           `thrift:"Timestamp,3" db:"Timestamp" json:"Timestamp"`
+	Verdict   *AnalyzeVerdict `thrift:"Verdict,4" db:"Verdict" json:"Verdict,omitempty"`
 }
 
-func NewBIOSRTMVolumeInput() *BIOSRTMVolumeInput {
-	return &BIOSRTMVolumeInput{}
+func NewAnalyzeReportSummary() *AnalyzeReportSummary {
+	return &AnalyzeReportSummary{}
 }
 
-func (p *BIOSRTMVolumeInput) GetActualFirmwareImage() int32 {
-	return p.ActualFirmwareImage
+func (p *AnalyzeReportSummary) GetJobID() []byte {
+	return p.JobID
 }
-func (p *BIOSRTMVolumeInput) Read(ctx context.Context, iprot thrift.TProtocol) error {
+
+var AnalyzeReportSummary_AssetID_DEFAULT int64
+
+func (p *AnalyzeReportSummary) GetAssetID() int64 {
+	if !p.IsSetAssetID() {
+		return AnalyzeReportSummary_AssetID_DEFAULT
+	}
+	return *p.AssetID
+}
+
+func (p *AnalyzeReportSummary) GetTimestamp() int64 {
+	return p.Timestamp
+}
+
+var AnalyzeReportSummary_Verdict_DEFAULT *AnalyzeVerdict
+
+func (p *AnalyzeReportSummary) GetVerdict() *AnalyzeVerdict {
+	if !p.IsSetVerdict() {
+		return AnalyzeReportSummary_Verdict_DEFAULT
+	}
+	return p.Verdict
+}
+func (p *AnalyzeReportSummary) IsSetAssetID() bool {
+	return p.AssetID != nil
+}
+
+func (p *AnalyzeReportSummary) IsSetVerdict() bool {
+	return p.Verdict != nil
+}
+
+func (p *AnalyzeReportSummary) Read(ctx context.Context, iprot thrift.TProtocol) error {
 	if _, err := iprot.ReadStructBegin(ctx); err != nil {
 		return thrift.PrependError(fmt.Sprintf("%T read error: ", p), err)
 	}
@@ -6407,7 +6866,7 @@ func (p *BIOSRTMVolumeInput) Read(ctx context.Context, iprot thrift.TProtocol) e
 		}
 		switch fieldId {
 		case 1:
-			if fieldTypeId == thrift.I32 {
+			if fieldTypeId == thrift.STRING {
 				if err := p.ReadField1(ctx, iprot); err != nil {
 					return err
 				}
@@ -6416,6 +6875,36 @@ func (p *BIOSRTMVolumeInput) Read(ctx context.Context, iprot thrift.TProtocol) e
 					return err
 				}
 			}
+		case 2:
+			if fieldTypeId == thrift.I64 {
+				if err := p.ReadField2(ctx, iprot); err != nil {
+					return err
+				}
+			} else {
+				if err := iprot.Skip(ctx, fieldTypeId); err != nil {
+					return err
+				}
+			}
+		case 3:
+			if fieldTypeId == thrift.I64 {
+				if err := p.ReadField3(ctx, iprot); err != nil {
+					return err
+				}
+			} else {
+				if err := iprot.Skip(ctx, fieldTypeId); err != nil {
+					return err
+				}
+			}
+		case 4:
+			if fieldTypeId == thrift.STRUCT {
+				if err := p.ReadField4(ctx, iprot); err != nil {
+					return err
+				}
+			} else {
+				if err := iprot.Skip(ctx, fieldTypeId); err != nil {
+					return err
+				}
+			}
 		default:
 			if err := iprot.Skip(ctx, fieldTypeId); err != nil {
 				return err
@@ -6431,23 +6920,58 @@ func (p *BIOSRTMVolumeInput) Read(ctx context.Context, iprot thrift.TProtocol) e
 	return nil
 }
 
-func (p *BIOSRTMVolumeInput) ReadField1(ctx context.Context, iprot thrift.TProtocol) error {
-	if v, err := iprot.ReadI32(ctx); err != nil {
+func (p *AnalyzeReportSummary) ReadField1(ctx context.Context, iprot thrift.TProtocol) error {
+	if v, err := iprot.ReadBinary(ctx); err != nil {
 		return thrift.PrependError("error reading field 1: ", err)
 	} else {
-		p.ActualFirmwareImage = v
+		p.JobID = v
 	}
 	return nil
 }
 
-func (p *BIOSRTMVolumeInput) Write(ctx context.Context, oprot thrift.TProtocol) error {
-	if err := oprot.WriteStructBegin(ctx, "BIOSRTMVolumeInput"); err != nil {
+func (p *AnalyzeReportSummary) ReadField2(ctx context.Context, iprot thrift.TProtocol) error {
+	if v, err := iprot.ReadI64(ctx); err != nil {
+		return thrift.PrependError("error reading field 2: ", err)
+	} else {
+		p.AssetID = &v
+	}
+	return nil
+}
+
+func (p *AnalyzeReportSummary) ReadField3(ctx context.Context, iprot thrift.TProtocol) error {
+	if v, err := iprot.ReadI64(ctx); err != nil {
+		return thrift.PrependError("error reading field 3: ", err)
+	} else {
+		p.Timestamp = v
+	}
+	return nil
+}
+
+func (p *AnalyzeReportSummary) ReadField4(ctx context.Context, iprot thrift.TProtocol) error {
+	p.Verdict = &AnalyzeVerdict{}
+	if err := p.Verdict.Read(ctx, iprot); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T error reading struct: ", p.Verdict), err)
+	}
+	return nil
+}
+
+func (p *AnalyzeReportSummary) Write(ctx context.Context, oprot thrift.TProtocol) error {
+	if err := oprot.WriteStructBegin(ctx, "AnalyzeReportSummary"); err != nil {
 		return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
 	}
 	if p != nil {
 		if err := p.writeField1(ctx, oprot); err != nil {
 			return err
 		}
+		if err := p.writeField2(ctx, oprot); err != nil {
+			return err
+		}
+		if err := p.writeField3(ctx, oprot); err != nil {
+			return err
+		}
+		if err := p.writeField4(ctx, oprot); err != nil {
+			return err
+		}
 	}
 	if err := oprot.WriteFieldStop(ctx); err != nil {
 		return thrift.PrependError("write field stop error: ", err)
@@ -6458,52 +6982,172 @@ func (p *BIOSRTMVolumeInput) Write(ctx context.Context, oprot thrift.TProtocol)
 	return nil
 }
 
-func (p *BIOSRTMVolumeInput) writeField1(ctx context.Context, oprot thrift.TProtocol) (err error) {
-	if err := oprot.WriteFieldBegin(ctx, "ActualFirmwareImage", thrift.I32, 1); err != nil {
-		return thrift.PrependError(fmt.Sprintf("%T write field begin error 1:ActualFirmwareImage: ", p), err)
+func (p *AnalyzeReportSummary) writeField1(ctx context.Context, oprot thrift.TProtocol) (err error) {
+	if err := oprot.WriteFieldBegin(ctx, "JobID", thrift.STRING, 1); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T write field begin error 1:JobID: ", p), err)
 	}
-	if err := oprot.WriteI32(ctx, int32(p.ActualFirmwareImage)); err != nil {
-		return thrift.PrependError(fmt.Sprintf("%T.ActualFirmwareImage (1) field write error: ", p), err)
+	if err := oprot.WriteBinary(ctx, p.JobID); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T.JobID (1) field write error: ", p), err)
 	}
 	if err := oprot.WriteFieldEnd(ctx); err != nil {
-		return thrift.PrependError(fmt.Sprintf("%T write field end error 1:ActualFirmwareImage: ", p), err)
+		return thrift.PrependError(fmt.Sprintf("%T write field end error 1:JobID: ", p), err)
 	}
 	return err
 }
 
-func (p *BIOSRTMVolumeInput) Equals(other *BIOSRTMVolumeInput) bool {
+func (p *AnalyzeReportSummary) writeField2(ctx context.Context, oprot thrift.TProtocol) (err error) {
+	if p.IsSetAssetID() {
+		if err := oprot.WriteFieldBegin(ctx, "AssetID", thrift.I64, 2); err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T write field begin error 2:AssetID: ", p), err)
+		}
+		if err := oprot.WriteI64(ctx, int64(*p.AssetID)); err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T.AssetID (2) field write error: ", p), err)
+		}
+		if err := oprot.WriteFieldEnd(ctx); err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T write field end error 2:AssetID: ", p), err)
+		}
+	}
+	return err
+}
+
+func (p *AnalyzeReportSummary) writeField3(ctx context.Context, oprot thrift.TProtocol) (err error) {
+	if err := oprot.WriteFieldBegin(ctx, "Timestamp", thrift.I64, 3); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T write field begin error 3:Timestamp: ", p), err)
+	}
+	if err := oprot.WriteI64(ctx, int64(p.Timestamp)); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T.Timestamp (3) field write error: ", p), err)
+	}
+	if err := oprot.WriteFieldEnd(ctx); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T write field end error 3:Timestamp: ", p), err)
+	}
+	return err
+}
+
+func (p *AnalyzeReportSummary) writeField4(ctx context.Context, oprot thrift.TProtocol) (err error) {
+	if p.IsSetVerdict() {
+		if err := oprot.WriteFieldBegin(ctx, "Verdict", thrift.STRUCT, 4); err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T write field begin error 4:Verdict: ", p), err)
+		}
+		if err := p.Verdict.Write(ctx, oprot); err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T error writing struct: ", p.Verdict), err)
+		}
+		if err := oprot.WriteFieldEnd(ctx); err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T write field end error 4:Verdict: ", p), err)
+		}
+	}
+	return err
+}
+
+func (p *AnalyzeReportSummary) Equals(other *AnalyzeReportSummary) bool {
 	if p == other {
 		return true
 	} else if p == nil || other == nil {
 		return false
 	}
-	if p.ActualFirmwareImage != other.ActualFirmwareImage {
+	if bytes.Compare(p.JobID, other.JobID) != 0 {
+		return false
+	}
+	if p.AssetID != other.AssetID {
+		if p.AssetID == nil || other.AssetID == nil {
+			return false
+		}
+		if (*p.AssetID) != (*other.AssetID) {
+			return false
+		}
+	}
+	if p.Timestamp != other.Timestamp {
+		return false
+	}
+	if !p.Verdict.Equals(other.Verdict) {
 		return false
 	}
 	return true
 }
 
-func (p *BIOSRTMVolumeInput) String() string {
+func (p *AnalyzeReportSummary) String() string {
 	if p == nil {
 		return "<nil>"
 	}
-	return fmt.Sprintf("BIOSRTMVolumeInput(%+v)", *p)
+	return fmt.Sprintf("AnalyzeReportSummary(%+v)", *p)
 }
 
 // Attributes:
-//   - ActualFirmwareImage
-type APCBSecurityTokensInput struct {
-	ActualFirmwareImage int32 `thrift:"ActualFirmwareImage,1" db:"ActualFirmwareImage" json:"ActualFirmwareImage"`
+//   - GroupBy
+//   - TimeFrom
+//   - TimeTo
+//   - BucketSeconds
+//   - AnalyzerNames
+//   - Diagnoses
+//   - ModelIDs
+type AggregateReportsRequest struct {
+	GroupBy       []AggregateDimension `thrift:"GroupBy,1" db:"GroupBy" json:"GroupBy"`
+	TimeFrom      *int64               `thrift:"TimeFrom,2" db:"TimeFrom" json:"TimeFrom,omitempty"`
+	TimeTo        *int64               `thrift:"TimeTo,3" db:"TimeTo" json:"TimeTo,omitempty"`
+	BucketSeconds *int64               `thrift:"BucketSeconds,4" db:"BucketSeconds" json:"BucketSeconds,omitempty"`
+	AnalyzerNames []string             `thrift:"AnalyzerNames,5" db:"AnalyzerNames" json:"AnalyzerNames"`
+	Diagnoses     []string             `thrift:"Diagnoses,6" db:"Diagnoses" json:"Diagnoses"`
+	ModelIDs      []int64              `thrift:"ModelIDs,7" db:"ModelIDs" json:"ModelIDs"`
 }
 
-func NewAPCBSecurityTokensInput() *APCBSecurityTokensInput {
-	return &APCBSecurityTokensInput{}
+func NewAggregateReportsRequest() *AggregateReportsRequest {
+	return &AggregateReportsRequest{}
 }
 
-func (p *APCBSecurityTokensInput) GetActualFirmwareImage() int32 {
-	return p.ActualFirmwareImage
+func (p *AggregateReportsRequest) GetGroupBy() []AggregateDimension {
+	return p.GroupBy
 }
-func (p *APCBSecurityTokensInput) Read(ctx context.Context, iprot thrift.TProtocol) error {
+
+var AggregateReportsRequest_TimeFrom_DEFAULT int64
+
+func (p *AggregateReportsRequest) GetTimeFrom() int64 {
+	if !p.IsSetTimeFrom() {
+		return AggregateReportsRequest_TimeFrom_DEFAULT
+	}
+	return *p.TimeFrom
+}
+
+var AggregateReportsRequest_TimeTo_DEFAULT int64
+
+func (p *AggregateReportsRequest) GetTimeTo() int64 {
+	if !p.IsSetTimeTo() {
+		return AggregateReportsRequest_TimeTo_DEFAULT
+	}
+	return *p.TimeTo
+}
+
+var AggregateReportsRequest_BucketSeconds_DEFAULT int64
+
+func (p *AggregateReportsRequest) GetBucketSeconds() int64 {
+	if !p.IsSetBucketSeconds() {
+		return AggregateReportsRequest_BucketSeconds_DEFAULT
+	}
+	return *p.BucketSeconds
+}
+
+func (p *AggregateReportsRequest) GetAnalyzerNames() []string {
+	return p.AnalyzerNames
+}
+
+func (p *AggregateReportsRequest) GetDiagnoses() []string {
+	return p.Diagnoses
+}
+
+func (p *AggregateReportsRequest) GetModelIDs() []int64 {
+	return p.ModelIDs
+}
+func (p *AggregateReportsRequest) IsSetTimeFrom() bool {
+	return p.TimeFrom != nil
+}
+
+func (p *AggregateReportsRequest) IsSetTimeTo() bool {
+	return p.TimeTo != nil
+}
+
+func (p *AggregateReportsRequest) IsSetBucketSeconds() bool {
+	return p.BucketSeconds != nil
+}
+
+func (p *AggregateReportsRequest) Read(ctx context.Context, iprot thrift.TProtocol) error {
 	if _, err := iprot.ReadStructBegin(ctx); err != nil {
 		return thrift.PrependError(fmt.Sprintf("%T read error: ", p), err)
 	}
@@ -6518,7 +7162,7 @@ func (p *APCBSecurityTokensInput) Read(ctx context.Context, iprot thrift.TProtoc
 		}
 		switch fieldId {
 		case 1:
-			if fieldTypeId == thrift.I32 {
+			if fieldTypeId == thrift.LIST {
 				if err := p.ReadField1(ctx, iprot); err != nil {
 					return err
 				}
@@ -6527,6 +7171,66 @@ func (p *APCBSecurityTokensInput) Read(ctx context.Context, iprot thrift.TProtoc
 					return err
 				}
 			}
+		case 2:
+			if fieldTypeId == thrift.I64 {
+				if err := p.ReadField2(ctx, iprot); err != nil {
+					return err
+				}
+			} else {
+				if err := iprot.Skip(ctx, fieldTypeId); err != nil {
+					return err
+				}
+			}
+		case 3:
+			if fieldTypeId == thrift.I64 {
+				if err := p.ReadField3(ctx, iprot); err != nil {
+					return err
+				}
+			} else {
+				if err := iprot.Skip(ctx, fieldTypeId); err != nil {
+					return err
+				}
+			}
+		case 4:
+			if fieldTypeId == thrift.I64 {
+				if err := p.ReadField4(ctx, iprot); err != nil {
+					return err
+				}
+			} else {
+				if err := iprot.Skip(ctx, fieldTypeId); err != nil {
+					return err
+				}
+			}
+		case 5:
+			if fieldTypeId == thrift.LIST {
+				if err := p.ReadField5(ctx, iprot); err != nil {
+					return err
+				}
+			} else {
+				if err := iprot.Skip(ctx, fieldTypeId); err != nil {
+					return err
+				}
+			}
+		case 6:
+			if fieldTypeId == thrift.LIST {
+				if err := p.ReadField6(ctx, iprot); err != nil {
+					return err
+				}
+			} else {
+				if err := iprot.Skip(ctx, fieldTypeId); err != nil {
+					return err
+				}
+			}
+		case 7:
+			if fieldTypeId == thrift.LIST {
+				if err := p.ReadField7(ctx, iprot); err != nil {
+					return err
+				}
+			} else {
+				if err := iprot.Skip(ctx, fieldTypeId); err != nil {
+					return err
+				}
+			}
 		default:
 			if err := iprot.Skip(ctx, fieldTypeId); err != nil {
 				return err
@@ -6542,187 +7246,404 @@ func (p *APCBSecurityTokensInput) Read(ctx context.Context, iprot thrift.TProtoc
 	return nil
 }
 
-func (p *APCBSecurityTokensInput) ReadField1(ctx context.Context, iprot thrift.TProtocol) error {
-	if v, err := iprot.ReadI32(ctx); err != nil {
-		return thrift.PrependError("error reading field 1: ", err)
+func (p *AggregateReportsRequest) ReadField1(ctx context.Context, iprot thrift.TProtocol) error {
+	_, size, err := iprot.ReadListBegin(ctx)
+	if err != nil {
+		return thrift.PrependError("error reading list begin: ", err)
+	}
+	tSlice := make([]AggregateDimension, 0, size)
+	p.GroupBy = tSlice
+	for i := 0; i < size; i++ {
+		var _elem18 AggregateDimension
+		if v, err := iprot.ReadI32(ctx); err != nil {
+			return thrift.PrependError("error reading field 0: ", err)
+		} else {
+			temp := AggregateDimension(v)
+			_elem18 = temp
+		}
+		p.GroupBy = append(p.GroupBy, _elem18)
+	}
+	if err := iprot.ReadListEnd(ctx); err != nil {
+		return thrift.PrependError("error reading list end: ", err)
+	}
+	return nil
+}
+
+func (p *AggregateReportsRequest) ReadField2(ctx context.Context, iprot thrift.TProtocol) error {
+	if v, err := iprot.ReadI64(ctx); err != nil {
+		return thrift.PrependError("error reading field 2: ", err)
 	} else {
-		p.ActualFirmwareImage = v
+		p.TimeFrom = &v
 	}
 	return nil
 }
 
-func (p *APCBSecurityTokensInput) Write(ctx context.Context, oprot thrift.TProtocol) error {
-	if err := oprot.WriteStructBegin(ctx, "APCBSecurityTokensInput"); err != nil {
-		return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
+func (p *AggregateReportsRequest) ReadField3(ctx context.Context, iprot thrift.TProtocol) error {
+	if v, err := iprot.ReadI64(ctx); err != nil {
+		return thrift.PrependError("error reading field 3: ", err)
+	} else {
+		p.TimeTo = &v
 	}
-	if p != nil {
-		if err := p.writeField1(ctx, oprot); err != nil {
-			return err
-		}
+	return nil
+}
+
+func (p *AggregateReportsRequest) ReadField4(ctx context.Context, iprot thrift.TProtocol) error {
+	if v, err := iprot.ReadI64(ctx); err != nil {
+		return thrift.PrependError("error reading field 4: ", err)
+	} else {
+		p.BucketSeconds = &v
 	}
-	if err := oprot.WriteFieldStop(ctx); err != nil {
-		return thrift.PrependError("write field stop error: ", err)
+	return nil
+}
+
+func (p *AggregateReportsRequest) ReadField5(ctx context.Context, iprot thrift.TProtocol) error {
+	_, size, err := iprot.ReadListBegin(ctx)
+	if err != nil {
+		return thrift.PrependError("error reading list begin: ", err)
 	}
-	if err := oprot.WriteStructEnd(ctx); err != nil {
-		return thrift.PrependError("write struct stop error: ", err)
+	tSlice := make([]string, 0, size)
+	p.AnalyzerNames = tSlice
+	for i := 0; i < size; i++ {
+		var _elem19 string
+		if v, err := iprot.ReadString(ctx); err != nil {
+			return thrift.PrependError("error reading field 0: ", err)
+		} else {
+			_elem19 = v
+		}
+		p.AnalyzerNames = append(p.AnalyzerNames, _elem19)
+	}
+	if err := iprot.ReadListEnd(ctx); err != nil {
+		return thrift.PrependError("error reading list end: ", err)
 	}
 	return nil
 }
 
-func (p *APCBSecurityTokensInput) writeField1(ctx context.Context, oprot thrift.TProtocol) (err error) {
-	if err := oprot.WriteFieldBegin(ctx, "ActualFirmwareImage", thrift.I32, 1); err != nil {
-		return thrift.PrependError(fmt.Sprintf("%T write field begin error 1:ActualFirmwareImage: ", p), err)
+func (p *AggregateReportsRequest) ReadField6(ctx context.Context, iprot thrift.TProtocol) error {
+	_, size, err := iprot.ReadListBegin(ctx)
+	if err != nil {
+		return thrift.PrependError("error reading list begin: ", err)
 	}
-	if err := oprot.WriteI32(ctx, int32(p.ActualFirmwareImage)); err != nil {
-		return thrift.PrependError(fmt.Sprintf("%T.ActualFirmwareImage (1) field write error: ", p), err)
+	tSlice := make([]string, 0, size)
+	p.Diagnoses = tSlice
+	for i := 0; i < size; i++ {
+		var _elem20 string
+		if v, err := iprot.ReadString(ctx); err != nil {
+			return thrift.PrependError("error reading field 0: ", err)
+		} else {
+			_elem20 = v
+		}
+		p.Diagnoses = append(p.Diagnoses, _elem20)
 	}
-	if err := oprot.WriteFieldEnd(ctx); err != nil {
-		return thrift.PrependError(fmt.Sprintf("%T write field end error 1:ActualFirmwareImage: ", p), err)
+	if err := iprot.ReadListEnd(ctx); err != nil {
+		return thrift.PrependError("error reading list end: ", err)
 	}
-	return err
+	return nil
 }
 
-func (p *APCBSecurityTokensInput) Equals(other *APCBSecurityTokensInput) bool {
-	if p == other {
-		return true
-	} else if p == nil || other == nil {
-		return false
+func (p *AggregateReportsRequest) ReadField7(ctx context.Context, iprot thrift.TProtocol) error {
+	_, size, err := iprot.ReadListBegin(ctx)
+	if err != nil {
+		return thrift.PrependError("error reading list begin: ", err)
 	}
-	if p.ActualFirmwareImage != other.ActualFirmwareImage {
-		return false
+	tSlice := make([]int64, 0, size)
+	p.ModelIDs = tSlice
+	for i := 0; i < size; i++ {
+		var _elem21 int64
+		if v, err := iprot.ReadI64(ctx); err != nil {
+			return thrift.PrependError("error reading field 0: ", err)
+		} else {
+			_elem21 = v
+		}
+		p.ModelIDs = append(p.ModelIDs, _elem21)
 	}
-	return true
+	if err := iprot.ReadListEnd(ctx); err != nil {
+		return thrift.PrependError("error reading list end: ", err)
+	}
+	return nil
 }
 
-func (p *APCBSecurityTokensInput) String() string {
-	if p == nil {
-		return "<nil>"
+func (p *AggregateReportsRequest) Write(ctx context.Context, oprot thrift.TProtocol) error {
+	if err := oprot.WriteStructBegin(ctx, "AggregateReportsRequest"); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
 	}
-	return fmt.Sprintf("APCBSecurityTokensInput(%+v)", *p)
+	if p != nil {
+		if err := p.writeField1(ctx, oprot); err != nil {
+			return err
+		}
+		if err := p.writeField2(ctx, oprot); err != nil {
+			return err
+		}
+		if err := p.writeField3(ctx, oprot); err != nil {
+			return err
+		}
+		if err := p.writeField4(ctx, oprot); err != nil {
+			return err
+		}
+		if err := p.writeField5(ctx, oprot); err != nil {
+			return err
+		}
+		if err := p.writeField6(ctx, oprot); err != nil {
+			return err
+		}
+		if err := p.writeField7(ctx, oprot); err != nil {
+			return err
+		}
+	}
+	if err := oprot.WriteFieldStop(ctx); err != nil {
+		return thrift.PrependError("write field stop error: ", err)
+	}
+	if err := oprot.WriteStructEnd(ctx); err != nil {
+		return thrift.PrependError("write struct stop error: ", err)
+	}
+	return nil
 }
 
-// Attributes:
-//   - DiffMeasuredBoot
-//   - IntelACM
-//   - ReproducePCR
-//   - PSPSignature
-//   - BIOSRTMVolume
-//   - APCBSecurityTokens
-type AnalyzerInput struct {
-	DiffMeasuredBoot   *DiffMeasuredBootInput   `thrift:"DiffMeasuredBoot,1" db:"DiffMeasuredBoot" json:"DiffMeasuredBoot,omitempty"`
-	IntelACM           *IntelACMInput           `thrift:"IntelACM,2" db:"IntelACM" json:"IntelACM,omitempty"`
-	ReproducePCR       *ReproducePCRInput       `thrift:"ReproducePCR,3" db:"ReproducePCR" json:"ReproducePCR,omitempty"`
-	PSPSignature       *PSPSignatureInput       `thrift:"PSPSignature,4" db:"PSPSignature" json:"PSPSignature,omitempty"`
-	BIOSRTMVolume      *BIOSRTMVolumeInput      `thrift:"BIOSRTMVolume,5" db:"BIOSRTMVolume" json:"BIOSRTMVolume,omitempty"`
-	APCBSecurityTokens *APCBSecurityTokensInput `thrift:"APCBSecurityTokens,6" db:"APCBSecurityTokens" json:"APCBSecurityTokens,omitempty"`
+func (p *AggregateReportsRequest) writeField1(ctx context.Context, oprot thrift.TProtocol) (err error) {
+	if err := oprot.WriteFieldBegin(ctx, "GroupBy", thrift.LIST, 1); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T write field begin error 1:GroupBy: ", p), err)
+	}
+	if err := oprot.WriteListBegin(ctx, thrift.I32, len(p.GroupBy)); err != nil {
+		return thrift.PrependError("error writing list begin: ", err)
+	}
+	for _, v := range p.GroupBy {
+		if err := oprot.WriteI32(ctx, int32(v)); err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T. (0) field write error: ", p), err)
+		}
+	}
+	if err := oprot.WriteListEnd(ctx); err != nil {
+		return thrift.PrependError("error writing list end: ", err)
+	}
+	if err := oprot.WriteFieldEnd(ctx); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T write field end error 1:GroupBy: ", p), err)
+	}
+	return err
 }
 
-func NewAnalyzerInput() *AnalyzerInput {
-	return &AnalyzerInput{}
+func (p *AggregateReportsRequest) writeField2(ctx context.Context, oprot thrift.TProtocol) (err error) {
+	if p.IsSetTimeFrom() {
+		if err := oprot.WriteFieldBegin(ctx, "TimeFrom", thrift.I64, 2); err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T write field begin error 2:TimeFrom: ", p), err)
+		}
+		if err := oprot.WriteI64(ctx, int64(*p.TimeFrom)); err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T.TimeFrom (2) field write error: ", p), err)
+		}
+		if err := oprot.WriteFieldEnd(ctx); err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T write field end error 2:TimeFrom: ", p), err)
+		}
+	}
+	return err
 }
 
-var AnalyzerInput_DiffMeasuredBoot_DEFAULT *DiffMeasuredBootInput
-
-func (p *AnalyzerInput) GetDiffMeasuredBoot() *DiffMeasuredBootInput {
-	if !p.IsSetDiffMeasuredBoot() {
-		return AnalyzerInput_DiffMeasuredBoot_DEFAULT
+func (p *AggregateReportsRequest) writeField3(ctx context.Context, oprot thrift.TProtocol) (err error) {
+	if p.IsSetTimeTo() {
+		if err := oprot.WriteFieldBegin(ctx, "TimeTo", thrift.I64, 3); err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T write field begin error 3:TimeTo: ", p), err)
+		}
+		if err := oprot.WriteI64(ctx, int64(*p.TimeTo)); err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T.TimeTo (3) field write error: ", p), err)
+		}
+		if err := oprot.WriteFieldEnd(ctx); err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T write field end error 3:TimeTo: ", p), err)
+		}
 	}
-	return p.DiffMeasuredBoot
+	return err
 }
 
-var AnalyzerInput_IntelACM_DEFAULT *IntelACMInput
-
-func (p *AnalyzerInput) GetIntelACM() *IntelACMInput {
-	if !p.IsSetIntelACM() {
-		return AnalyzerInput_IntelACM_DEFAULT
+func (p *AggregateReportsRequest) writeField4(ctx context.Context, oprot thrift.TProtocol) (err error) {
+	if p.IsSetBucketSeconds() {
+		if err := oprot.WriteFieldBegin(ctx, "BucketSeconds", thrift.I64, 4); err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T write field begin error 4:BucketSeconds: ", p), err)
+		}
+		if err := oprot.WriteI64(ctx, int64(*p.BucketSeconds)); err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T.BucketSeconds (4) field write error: ", p), err)
+		}
+		if err := oprot.WriteFieldEnd(ctx); err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T write field end error 4:BucketSeconds: ", p), err)
+		}
 	}
-	return p.IntelACM
+	return err
 }
 
-var AnalyzerInput_ReproducePCR_DEFAULT *ReproducePCRInput
-
-func (p *AnalyzerInput) GetReproducePCR() *ReproducePCRInput {
-	if !p.IsSetReproducePCR() {
-		return AnalyzerInput_ReproducePCR_DEFAULT
+func (p *AggregateReportsRequest) writeField5(ctx context.Context, oprot thrift.TProtocol) (err error) {
+	if err := oprot.WriteFieldBegin(ctx, "AnalyzerNames", thrift.LIST, 5); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T write field begin error 5:AnalyzerNames: ", p), err)
 	}
-	return p.ReproducePCR
+	if err := oprot.WriteListBegin(ctx, thrift.STRING, len(p.AnalyzerNames)); err != nil {
+		return thrift.PrependError("error writing list begin: ", err)
+	}
+	for _, v := range p.AnalyzerNames {
+		if err := oprot.WriteString(ctx, string(v)); err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T. (0) field write error: ", p), err)
+		}
+	}
+	if err := oprot.WriteListEnd(ctx); err != nil {
+		return thrift.PrependError("error writing list end: ", err)
+	}
+	if err := oprot.WriteFieldEnd(ctx); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T write field end error 5:AnalyzerNames: ", p), err)
+	}
+	return err
 }
 
-var AnalyzerInput_PSPSignature_DEFAULT *PSPSignatureInput
-
-func (p *AnalyzerInput) GetPSPSignature() *PSPSignatureInput {
-	if !p.IsSetPSPSignature() {
-		return AnalyzerInput_PSPSignature_DEFAULT
+func (p *AggregateReportsRequest) writeField6(ctx context.Context, oprot thrift.TProtocol) (err error) {
+	if err := oprot.WriteFieldBegin(ctx, "Diagnoses", thrift.LIST, 6); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T write field begin error 6:Diagnoses: ", p), err)
 	}
-	return p.PSPSignature
+	if err := oprot.WriteListBegin(ctx, thrift.STRING, len(p.Diagnoses)); err != nil {
+		return thrift.PrependError("error writing list begin: ", err)
+	}
+	for _, v := range p.Diagnoses {
+		if err := oprot.WriteString(ctx, string(v)); err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T. (0) field write error: ", p), err)
+		}
+	}
+	if err := oprot.WriteListEnd(ctx); err != nil {
+		return thrift.PrependError("error writing list end: ", err)
+	}
+	if err := oprot.WriteFieldEnd(ctx); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T write field end error 6:Diagnoses: ", p), err)
+	}
+	return err
 }
 
-var AnalyzerInput_BIOSRTMVolume_DEFAULT *BIOSRTMVolumeInput
-
-func (p *AnalyzerInput) GetBIOSRTMVolume() *BIOSRTMVolumeInput {
-	if !p.IsSetBIOSRTMVolume() {
-		return AnalyzerInput_BIOSRTMVolume_DEFAULT
+func (p *AggregateReportsRequest) writeField7(ctx context.Context, oprot thrift.TProtocol) (err error) {
+	if err := oprot.WriteFieldBegin(ctx, "ModelIDs", thrift.LIST, 7); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T write field begin error 7:ModelIDs: ", p), err)
 	}
-	return p.BIOSRTMVolume
+	if err := oprot.WriteListBegin(ctx, thrift.I64, len(p.ModelIDs)); err != nil {
+		return thrift.PrependError("error writing list begin: ", err)
+	}
+	for _, v := range p.ModelIDs {
+		if err := oprot.WriteI64(ctx, int64(v)); err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T. (0) field write error: ", p), err)
+		}
+	}
+	if err := oprot.WriteListEnd(ctx); err != nil {
+		return thrift.PrependError("error writing list end: ", err)
+	}
+	if err := oprot.WriteFieldEnd(ctx); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T write field end error 7:ModelIDs: ", p), err)
+	}
+	return err
 }
 
-var AnalyzerInput_APCBSecurityTokens_DEFAULT *APCBSecurityTokensInput
-
-func (p *AnalyzerInput) GetAPCBSecurityTokens() *APCBSecurityTokensInput {
-	if !p.IsSetAPCBSecurityTokens() {
-		return AnalyzerInput_APCBSecurityTokens_DEFAULT
+func (p *AggregateReportsRequest) Equals(other *AggregateReportsRequest) bool {
+	if p == other {
+		return true
+	} else if p == nil || other == nil {
+		return false
 	}
-	return p.APCBSecurityTokens
-}
-func (p *AnalyzerInput) CountSetFieldsAnalyzerInput() int {
-	count := 0
-	if p.IsSetDiffMeasuredBoot() {
-		count++
+	if len(p.GroupBy) != len(other.GroupBy) {
+		return false
 	}
-	if p.IsSetIntelACM() {
-		count++
+	for i, _tgt := range p.GroupBy {
+		_src22 := other.GroupBy[i]
+		if _tgt != _src22 {
+			return false
+		}
 	}
-	if p.IsSetReproducePCR() {
-		count++
+	if p.TimeFrom != other.TimeFrom {
+		if p.TimeFrom == nil || other.TimeFrom == nil {
+			return false
+		}
+		if (*p.TimeFrom) != (*other.TimeFrom) {
+			return false
+		}
 	}
-	if p.IsSetPSPSignature() {
-		count++
+	if p.TimeTo != other.TimeTo {
+		if p.TimeTo == nil || other.TimeTo == nil {
+			return false
+		}
+		if (*p.TimeTo) != (*other.TimeTo) {
+			return false
+		}
 	}
-	if p.IsSetBIOSRTMVolume() {
-		count++
+	if p.BucketSeconds != other.BucketSeconds {
+		if p.BucketSeconds == nil || other.BucketSeconds == nil {
+			return false
+		}
+		if (*p.BucketSeconds) != (*other.BucketSeconds) {
+			return false
+		}
 	}
-	if p.IsSetAPCBSecurityTokens() {
-		count++
+	if len(p.AnalyzerNames) != len(other.AnalyzerNames) {
+		return false
 	}
-	return count
+	for i, _tgt := range p.AnalyzerNames {
+		_src23 := other.AnalyzerNames[i]
+		if _tgt != _src23 {
+			return false
+		}
+	}
+	if len(p.Diagnoses) != len(other.Diagnoses) {
+		return false
+	}
+	for i, _tgt := range p.Diagnoses {
+		_src24 := other.Diagnoses[i]
+		if _tgt != _src24 {
+			return false
+		}
+	}
+	if len(p.ModelIDs) != len(other.ModelIDs) {
+		return false
+	}
+	for i, _tgt := range p.ModelIDs {
+		_src25 := other.ModelIDs[i]
+		if _tgt != _src25 {
+			return false
+		}
+	}
+	return true
+}
 
+func (p *AggregateReportsRequest) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("AggregateReportsRequest(%+v)", *p)
 }
 
-func (p *AnalyzerInput) IsSetDiffMeasuredBoot() bool {
-	return p.DiffMeasuredBoot != nil
+// Attributes:
+//   - Values
+//   - BucketStart
+//   - Reports
+//   - Hosts
+type AggregateReportsGroup struct {
+	Values      []string `thrift:"Values,1" db:"Values" json:"Values"`
+	BucketStart *int64   `thrift:"BucketStart,2" db:"BucketStart" json:"BucketStart,omitempty"`
+	Reports     int64    `thrift:"Reports,3" db:"Reports" json:"Reports"`
+	Hosts       int64    `thrift:"Hosts,4" db:"Hosts" json:"Hosts"`
 }
 
-func (p *AnalyzerInput) IsSetIntelACM() bool {
-	return p.IntelACM != nil
+func NewAggregateReportsGroup() *AggregateReportsGroup {
+	return &AggregateReportsGroup{}
 }
 
-func (p *AnalyzerInput) IsSetReproducePCR() bool {
-	return p.ReproducePCR != nil
+func (p *AggregateReportsGroup) GetValues() []string {
+	return p.Values
 }
 
-func (p *AnalyzerInput) IsSetPSPSignature() bool {
-	return p.PSPSignature != nil
+var AggregateReportsGroup_BucketStart_DEFAULT int64
+
+func (p *AggregateReportsGroup) GetBucketStart() int64 {
+	if !p.IsSetBucketStart() {
+		return AggregateReportsGroup_BucketStart_DEFAULT
+	}
+	return *p.BucketStart
 }
 
-func (p *AnalyzerInput) IsSetBIOSRTMVolume() bool {
-	return p.BIOSRTMVolume != nil
+func (p *AggregateReportsGroup) GetReports() int64 {
+	return p.Reports
 }
 
-func (p *AnalyzerInput) IsSetAPCBSecurityTokens() bool {
-	return p.APCBSecurityTokens != nil
+func (p *AggregateReportsGroup) GetHosts() int64 {
+	return p.Hosts
+}
+func (p *AggregateReportsGroup) IsSetBucketStart() bool {
+	return p.BucketStart != nil
 }
 
-func (p *AnalyzerInput) Read(ctx context.Context, iprot thrift.TProtocol) error {
+func (p *AggregateReportsGroup) Read(ctx context.Context, iprot thrift.TProtocol) error {
 	if _, err := iprot.ReadStructBegin(ctx); err != nil {
 		return thrift.PrependError(fmt.Sprintf("%T read error: ", p), err)
 	}
@@ -6737,7 +7658,7 @@ func (p *AnalyzerInput) Read(ctx context.Context, iprot thrift.TProtocol) error
 		}
 		switch fieldId {
 		case 1:
-			if fieldTypeId == thrift.STRUCT {
+			if fieldTypeId == thrift.LIST {
 				if err := p.ReadField1(ctx, iprot); err != nil {
 					return err
 				}
@@ -6747,7 +7668,7 @@ func (p *AnalyzerInput) Read(ctx context.Context, iprot thrift.TProtocol) error
 				}
 			}
 		case 2:
-			if fieldTypeId == thrift.STRUCT {
+			if fieldTypeId == thrift.I64 {
 				if err := p.ReadField2(ctx, iprot); err != nil {
 					return err
 				}
@@ -6757,7 +7678,7 @@ func (p *AnalyzerInput) Read(ctx context.Context, iprot thrift.TProtocol) error
 				}
 			}
 		case 3:
-			if fieldTypeId == thrift.STRUCT {
+			if fieldTypeId == thrift.I64 {
 				if err := p.ReadField3(ctx, iprot); err != nil {
 					return err
 				}
@@ -6767,7 +7688,7 @@ func (p *AnalyzerInput) Read(ctx context.Context, iprot thrift.TProtocol) error
 				}
 			}
 		case 4:
-			if fieldTypeId == thrift.STRUCT {
+			if fieldTypeId == thrift.I64 {
 				if err := p.ReadField4(ctx, iprot); err != nil {
 					return err
 				}
@@ -6776,29 +7697,9 @@ func (p *AnalyzerInput) Read(ctx context.Context, iprot thrift.TProtocol) error
 					return err
 				}
 			}
-		case 5:
-			if fieldTypeId == thrift.STRUCT {
-				if err := p.ReadField5(ctx, iprot); err != nil {
-					return err
-				}
-			} else {
-				if err := iprot.Skip(ctx, fieldTypeId); err != nil {
-					return err
-				}
-			}
-		case 6:
-			if fieldTypeId == thrift.STRUCT {
-				if err := p.ReadField6(ctx, iprot); err != nil {
-					return err
-				}
-			} else {
-				if err := iprot.Skip(ctx, fieldTypeId); err != nil {
-					return err
-				}
-			}
-		default:
-			if err := iprot.Skip(ctx, fieldTypeId); err != nil {
-				return err
+		default:
+			if err := iprot.Skip(ctx, fieldTypeId); err != nil {
+				return err
 			}
 		}
 		if err := iprot.ReadFieldEnd(ctx); err != nil {
@@ -6811,59 +7712,57 @@ func (p *AnalyzerInput) Read(ctx context.Context, iprot thrift.TProtocol) error
 	return nil
 }
 
-func (p *AnalyzerInput) ReadField1(ctx context.Context, iprot thrift.TProtocol) error {
-	p.DiffMeasuredBoot = &DiffMeasuredBootInput{}
-	if err := p.DiffMeasuredBoot.Read(ctx, iprot); err != nil {
-		return thrift.PrependError(fmt.Sprintf("%T error reading struct: ", p.DiffMeasuredBoot), err)
+func (p *AggregateReportsGroup) ReadField1(ctx context.Context, iprot thrift.TProtocol) error {
+	_, size, err := iprot.ReadListBegin(ctx)
+	if err != nil {
+		return thrift.PrependError("error reading list begin: ", err)
 	}
-	return nil
-}
-
-func (p *AnalyzerInput) ReadField2(ctx context.Context, iprot thrift.TProtocol) error {
-	p.IntelACM = &IntelACMInput{}
-	if err := p.IntelACM.Read(ctx, iprot); err != nil {
-		return thrift.PrependError(fmt.Sprintf("%T error reading struct: ", p.IntelACM), err)
+	tSlice := make([]string, 0, size)
+	p.Values = tSlice
+	for i := 0; i < size; i++ {
+		var _elem26 string
+		if v, err := iprot.ReadString(ctx); err != nil {
+			return thrift.PrependError("error reading field 0: ", err)
+		} else {
+			_elem26 = v
+		}
+		p.Values = append(p.Values, _elem26)
 	}
-	return nil
-}
-
-func (p *AnalyzerInput) ReadField3(ctx context.Context, iprot thrift.TProtocol) error {
-	p.ReproducePCR = &ReproducePCRInput{}
-	if err := p.ReproducePCR.Read(ctx, iprot); err != nil {
-		return thrift.PrependError(fmt.Sprintf("%T error reading struct: ", p.ReproducePCR), err)
+	if err := iprot.ReadListEnd(ctx); err != nil {
+		return thrift.PrependError("error reading list end: ", err)
 	}
 	return nil
 }
 
-func (p *AnalyzerInput) ReadField4(ctx context.Context, iprot thrift.TProtocol) error {
-	p.PSPSignature = &PSPSignatureInput{}
-	if err := p.PSPSignature.Read(ctx, iprot); err != nil {
-		return thrift.PrependError(fmt.Sprintf("%T error reading struct: ", p.PSPSignature), err)
+func (p *AggregateReportsGroup) ReadField2(ctx context.Context, iprot thrift.TProtocol) error {
+	if v, err := iprot.ReadI64(ctx); err != nil {
+		return thrift.PrependError("error reading field 2: ", err)
+	} else {
+		p.BucketStart = &v
 	}
 	return nil
 }
 
-func (p *AnalyzerInput) ReadField5(ctx context.Context, iprot thrift.TProtocol) error {
-	p.BIOSRTMVolume = &BIOSRTMVolumeInput{}
-	if err := p.BIOSRTMVolume.Read(ctx, iprot); err != nil {
-		return thrift.PrependError(fmt.Sprintf("%T error reading struct: ", p.BIOSRTMVolume), err)
+func (p *AggregateReportsGroup) ReadField3(ctx context.Context, iprot thrift.TProtocol) error {
+	if v, err := iprot.ReadI64(ctx); err != nil {
+		return thrift.PrependError("error reading field 3: ", err)
+	} else {
+		p.Reports = v
 	}
 	return nil
 }
 
-func (p *AnalyzerInput) ReadField6(ctx context.Context, iprot thrift.TProtocol) error {
-	p.APCBSecurityTokens = &APCBSecurityTokensInput{}
-	if err := p.APCBSecurityTokens.Read(ctx, iprot); err != nil {
-		return thrift.PrependError(fmt.Sprintf("%T error reading struct: ", p.APCBSecurityTokens), err)
+func (p *AggregateReportsGroup) ReadField4(ctx context.Context, iprot thrift.TProtocol) error {
+	if v, err := iprot.ReadI64(ctx); err != nil {
+		return thrift.PrependError("error reading field 4: ", err)
+	} else {
+		p.Hosts = v
 	}
 	return nil
 }
 
-func (p *AnalyzerInput) Write(ctx context.Context, oprot thrift.TProtocol) error {
-	if c := p.CountSetFieldsAnalyzerInput(); c != 1 {
-		return fmt.Errorf("%T write union: exactly one field must be set (%d set).", p, c)
-	}
-	if err := oprot.WriteStructBegin(ctx, "AnalyzerInput"); err != nil {
+func (p *AggregateReportsGroup) Write(ctx context.Context, oprot thrift.TProtocol) error {
+	if err := oprot.WriteStructBegin(ctx, "AggregateReportsGroup"); err != nil {
 		return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
 	}
 	if p != nil {
@@ -6879,12 +7778,6 @@ func (p *AnalyzerInput) Write(ctx context.Context, oprot thrift.TProtocol) error
 		if err := p.writeField4(ctx, oprot); err != nil {
 			return err
 		}
-		if err := p.writeField5(ctx, oprot); err != nil {
-			return err
-		}
-		if err := p.writeField6(ctx, oprot); err != nil {
-			return err
-		}
 	}
 	if err := oprot.WriteFieldStop(ctx); err != nil {
 		return thrift.PrependError("write field stop error: ", err)
@@ -6895,165 +7788,121 @@ func (p *AnalyzerInput) Write(ctx context.Context, oprot thrift.TProtocol) error
 	return nil
 }
 
-func (p *AnalyzerInput) writeField1(ctx context.Context, oprot thrift.TProtocol) (err error) {
-	if p.IsSetDiffMeasuredBoot() {
-		if err := oprot.WriteFieldBegin(ctx, "DiffMeasuredBoot", thrift.STRUCT, 1); err != nil {
-			return thrift.PrependError(fmt.Sprintf("%T write field begin error 1:DiffMeasuredBoot: ", p), err)
-		}
-		if err := p.DiffMeasuredBoot.Write(ctx, oprot); err != nil {
-			return thrift.PrependError(fmt.Sprintf("%T error writing struct: ", p.DiffMeasuredBoot), err)
-		}
-		if err := oprot.WriteFieldEnd(ctx); err != nil {
-			return thrift.PrependError(fmt.Sprintf("%T write field end error 1:DiffMeasuredBoot: ", p), err)
-		}
+func (p *AggregateReportsGroup) writeField1(ctx context.Context, oprot thrift.TProtocol) (err error) {
+	if err := oprot.WriteFieldBegin(ctx, "Values", thrift.LIST, 1); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T write field begin error 1:Values: ", p), err)
 	}
-	return err
-}
-
-func (p *AnalyzerInput) writeField2(ctx context.Context, oprot thrift.TProtocol) (err error) {
-	if p.IsSetIntelACM() {
-		if err := oprot.WriteFieldBegin(ctx, "IntelACM", thrift.STRUCT, 2); err != nil {
-			return thrift.PrependError(fmt.Sprintf("%T write field begin error 2:IntelACM: ", p), err)
-		}
-		if err := p.IntelACM.Write(ctx, oprot); err != nil {
-			return thrift.PrependError(fmt.Sprintf("%T error writing struct: ", p.IntelACM), err)
-		}
-		if err := oprot.WriteFieldEnd(ctx); err != nil {
-			return thrift.PrependError(fmt.Sprintf("%T write field end error 2:IntelACM: ", p), err)
-		}
+	if err := oprot.WriteListBegin(ctx, thrift.STRING, len(p.Values)); err != nil {
+		return thrift.PrependError("error writing list begin: ", err)
 	}
-	return err
-}
-
-func (p *AnalyzerInput) writeField3(ctx context.Context, oprot thrift.TProtocol) (err error) {
-	if p.IsSetReproducePCR() {
-		if err := oprot.WriteFieldBegin(ctx, "ReproducePCR", thrift.STRUCT, 3); err != nil {
-			return thrift.PrependError(fmt.Sprintf("%T write field begin error 3:ReproducePCR: ", p), err)
-		}
-		if err := p.ReproducePCR.Write(ctx, oprot); err != nil {
-			return thrift.PrependError(fmt.Sprintf("%T error writing struct: ", p.ReproducePCR), err)
-		}
-		if err := oprot.WriteFieldEnd(ctx); err != nil {
-			return thrift.PrependError(fmt.Sprintf("%T write field end error 3:ReproducePCR: ", p), err)
+	for _, v := range p.Values {
+		if err := oprot.WriteString(ctx, string(v)); err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T. (0) field write error: ", p), err)
 		}
 	}
+	if err := oprot.WriteListEnd(ctx); err != nil {
+		return thrift.PrependError("error writing list end: ", err)
+	}
+	if err := oprot.WriteFieldEnd(ctx); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T write field end error 1:Values: ", p), err)
+	}
 	return err
 }
 
-func (p *AnalyzerInput) writeField4(ctx context.Context, oprot thrift.TProtocol) (err error) {
-	if p.IsSetPSPSignature() {
-		if err := oprot.WriteFieldBegin(ctx, "PSPSignature", thrift.STRUCT, 4); err != nil {
-			return thrift.PrependError(fmt.Sprintf("%T write field begin error 4:PSPSignature: ", p), err)
+func (p *AggregateReportsGroup) writeField2(ctx context.Context, oprot thrift.TProtocol) (err error) {
+	if p.IsSetBucketStart() {
+		if err := oprot.WriteFieldBegin(ctx, "BucketStart", thrift.I64, 2); err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T write field begin error 2:BucketStart: ", p), err)
 		}
-		if err := p.PSPSignature.Write(ctx, oprot); err != nil {
-			return thrift.PrependError(fmt.Sprintf("%T error writing struct: ", p.PSPSignature), err)
+		if err := oprot.WriteI64(ctx, int64(*p.BucketStart)); err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T.BucketStart (2) field write error: ", p), err)
 		}
 		if err := oprot.WriteFieldEnd(ctx); err != nil {
-			return thrift.PrependError(fmt.Sprintf("%T write field end error 4:PSPSignature: ", p), err)
+			return thrift.PrependError(fmt.Sprintf("%T write field end error 2:BucketStart: ", p), err)
 		}
 	}
 	return err
 }
 
-func (p *AnalyzerInput) writeField5(ctx context.Context, oprot thrift.TProtocol) (err error) {
-	if p.IsSetBIOSRTMVolume() {
-		if err := oprot.WriteFieldBegin(ctx, "BIOSRTMVolume", thrift.STRUCT, 5); err != nil {
-			return thrift.PrependError(fmt.Sprintf("%T write field begin error 5:BIOSRTMVolume: ", p), err)
-		}
-		if err := p.BIOSRTMVolume.Write(ctx, oprot); err != nil {
-			return thrift.PrependError(fmt.Sprintf("%T error writing struct: ", p.BIOSRTMVolume), err)
-		}
-		if err := oprot.WriteFieldEnd(ctx); err != nil {
-			return thrift.PrependError(fmt.Sprintf("%T write field end error 5:BIOSRTMVolume: ", p), err)
-		}
+func (p *AggregateReportsGroup) writeField3(ctx context.Context, oprot thrift.TProtocol) (err error) {
+	if err := oprot.WriteFieldBegin(ctx, "Reports", thrift.I64, 3); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T write field begin error 3:Reports: ", p), err)
+	}
+	if err := oprot.WriteI64(ctx, int64(p.Reports)); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T.Reports (3) field write error: ", p), err)
+	}
+	if err := oprot.WriteFieldEnd(ctx); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T write field end error 3:Reports: ", p), err)
 	}
 	return err
 }
 
-func (p *AnalyzerInput) writeField6(ctx context.Context, oprot thrift.TProtocol) (err error) {
-	if p.IsSetAPCBSecurityTokens() {
-		if err := oprot.WriteFieldBegin(ctx, "APCBSecurityTokens", thrift.STRUCT, 6); err != nil {
-			return thrift.PrependError(fmt.Sprintf("%T write field begin error 6:APCBSecurityTokens: ", p), err)
-		}
-		if err := p.APCBSecurityTokens.Write(ctx, oprot); err != nil {
-			return thrift.PrependError(fmt.Sprintf("%T error writing struct: ", p.APCBSecurityTokens), err)
-		}
-		if err := oprot.WriteFieldEnd(ctx); err != nil {
-			return thrift.PrependError(fmt.Sprintf("%T write field end error 6:APCBSecurityTokens: ", p), err)
-		}
+func (p *AggregateReportsGroup) writeField4(ctx context.Context, oprot thrift.TProtocol) (err error) {
+	if err := oprot.WriteFieldBegin(ctx, "Hosts", thrift.I64, 4); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T write field begin error 4:Hosts: ", p), err)
+	}
+	if err := oprot.WriteI64(ctx, int64(p.Hosts)); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T.Hosts (4) field write error: ", p), err)
+	}
+	if err := oprot.WriteFieldEnd(ctx); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T write field end error 4:Hosts: ", p), err)
 	}
 	return err
 }
 
-func (p *AnalyzerInput) Equals(other *AnalyzerInput) bool {
+func (p *AggregateReportsGroup) Equals(other *AggregateReportsGroup) bool {
 	if p == other {
 		return true
 	} else if p == nil || other == nil {
 		return false
 	}
-	if !p.DiffMeasuredBoot.Equals(other.DiffMeasuredBoot) {
-		return false
-	}
-	if !p.IntelACM.Equals(other.IntelACM) {
+	if len(p.Values) != len(other.Values) {
 		return false
 	}
-	if !p.ReproducePCR.Equals(other.ReproducePCR) {
-		return false
+	for i, _tgt := range p.Values {
+		_src27 := other.Values[i]
+		if _tgt != _src27 {
+			return false
+		}
 	}
-	if !p.PSPSignature.Equals(other.PSPSignature) {
-		return false
+	if p.BucketStart != other.BucketStart {
+		if p.BucketStart == nil || other.BucketStart == nil {
+			return false
+		}
+		if (*p.BucketStart) != (*other.BucketStart) {
+			return false
+		}
 	}
-	if !p.BIOSRTMVolume.Equals(other.BIOSRTMVolume) {
+	if p.Reports != other.Reports {
 		return false
 	}
-	if !p.APCBSecurityTokens.Equals(other.APCBSecurityTokens) {
+	if p.Hosts != other.Hosts {
 		return false
 	}
 	return true
 }
 
-func (p *AnalyzerInput) String() string {
+func (p *AggregateReportsGroup) String() string {
 	if p == nil {
 		return "<nil>"
 	}
-	return fmt.Sprintf("AnalyzerInput(%+v)", *p)
+	return fmt.Sprintf("AggregateReportsGroup(%+v)", *p)
 }
 
 // Attributes:
-//   - HostInfo
-//   - Artifacts
-//   - Analyzers
-type AnalyzeRequest struct {
-	HostInfo  *HostInfo        `thrift:"HostInfo,1" db:"HostInfo" json:"HostInfo,omitempty"`
-	Artifacts []*Artifact      `thrift:"Artifacts,2" db:"Artifacts" json:"Artifacts"`
-	Analyzers []*AnalyzerInput `thrift:"Analyzers,3" db:"Analyzers" json:"Analyzers"`
-}
-
-func NewAnalyzeRequest() *AnalyzeRequest {
-	return &AnalyzeRequest{}
-}
-
-var AnalyzeRequest_HostInfo_DEFAULT *HostInfo
-
-func (p *AnalyzeRequest) GetHostInfo() *HostInfo {
-	if !p.IsSetHostInfo() {
-		return AnalyzeRequest_HostInfo_DEFAULT
-	}
-	return p.HostInfo
+//   - Groups
+type AggregateReportsResult_ struct {
+	Groups []*AggregateReportsGroup `thrift:"Groups,1" db:"Groups" json:"Groups"`
 }
 
-func (p *AnalyzeRequest) GetArtifacts() []*Artifact {
-	return p.Artifacts
+func NewAggregateReportsResult_() *AggregateReportsResult_ {
+	return &AggregateReportsResult_{}
 }
 
-func (p *AnalyzeRequest) GetAnalyzers() []*AnalyzerInput {
-	return p.Analyzers
+func (p *AggregateReportsResult_) GetGroups() []*AggregateReportsGroup {
+	return p.Groups
 }
-func (p *AnalyzeRequest) IsSetHostInfo() bool {
-	return p.HostInfo != nil
-}
-
-func (p *AnalyzeRequest) Read(ctx context.Context, iprot thrift.TProtocol) error {
+func (p *AggregateReportsResult_) Read(ctx context.Context, iprot thrift.TProtocol) error {
 	if _, err := iprot.ReadStructBegin(ctx); err != nil {
 		return thrift.PrependError(fmt.Sprintf("%T read error: ", p), err)
 	}
@@ -7068,28 +7917,8 @@ func (p *AnalyzeRequest) Read(ctx context.Context, iprot thrift.TProtocol) error
 		}
 		switch fieldId {
 		case 1:
-			if fieldTypeId == thrift.STRUCT {
-				if err := p.ReadField1(ctx, iprot); err != nil {
-					return err
-				}
-			} else {
-				if err := iprot.Skip(ctx, fieldTypeId); err != nil {
-					return err
-				}
-			}
-		case 2:
-			if fieldTypeId == thrift.LIST {
-				if err := p.ReadField2(ctx, iprot); err != nil {
-					return err
-				}
-			} else {
-				if err := iprot.Skip(ctx, fieldTypeId); err != nil {
-					return err
-				}
-			}
-		case 3:
 			if fieldTypeId == thrift.LIST {
-				if err := p.ReadField3(ctx, iprot); err != nil {
+				if err := p.ReadField1(ctx, iprot); err != nil {
 					return err
 				}
 			} else {
@@ -7112,27 +7941,19 @@ func (p *AnalyzeRequest) Read(ctx context.Context, iprot thrift.TProtocol) error
 	return nil
 }
 
-func (p *AnalyzeRequest) ReadField1(ctx context.Context, iprot thrift.TProtocol) error {
-	p.HostInfo = &HostInfo{}
-	if err := p.HostInfo.Read(ctx, iprot); err != nil {
-		return thrift.PrependError(fmt.Sprintf("%T error reading struct: ", p.HostInfo), err)
-	}
-	return nil
-}
-
-func (p *AnalyzeRequest) ReadField2(ctx context.Context, iprot thrift.TProtocol) error {
+func (p *AggregateReportsResult_) ReadField1(ctx context.Context, iprot thrift.TProtocol) error {
 	_, size, err := iprot.ReadListBegin(ctx)
 	if err != nil {
 		return thrift.PrependError("error reading list begin: ", err)
 	}
-	tSlice := make([]*Artifact, 0, size)
-	p.Artifacts = tSlice
+	tSlice := make([]*AggregateReportsGroup, 0, size)
+	p.Groups = tSlice
 	for i := 0; i < size; i++ {
-		_elem10 := &Artifact{}
-		if err := _elem10.Read(ctx, iprot); err != nil {
-			return thrift.PrependError(fmt.Sprintf("%T error reading struct: ", _elem10), err)
+		_elem28 := &AggregateReportsGroup{}
+		if err := _elem28.Read(ctx, iprot); err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T error reading struct: ", _elem28), err)
 		}
-		p.Artifacts = append(p.Artifacts, _elem10)
+		p.Groups = append(p.Groups, _elem28)
 	}
 	if err := iprot.ReadListEnd(ctx); err != nil {
 		return thrift.PrependError("error reading list end: ", err)
@@ -7140,94 +7961,32 @@ func (p *AnalyzeRequest) ReadField2(ctx context.Context, iprot thrift.TProtocol)
 	return nil
 }
 
-func (p *AnalyzeRequest) ReadField3(ctx context.Context, iprot thrift.TProtocol) error {
-	_, size, err := iprot.ReadListBegin(ctx)
-	if err != nil {
-		return thrift.PrependError("error reading list begin: ", err)
+func (p *AggregateReportsResult_) Write(ctx context.Context, oprot thrift.TProtocol) error {
+	if err := oprot.WriteStructBegin(ctx, "AggregateReportsResult"); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
 	}
-	tSlice := make([]*AnalyzerInput, 0, size)
-	p.Analyzers = tSlice
-	for i := 0; i < size; i++ {
-		_elem11 := &AnalyzerInput{}
-		if err := _elem11.Read(ctx, iprot); err != nil {
-			return thrift.PrependError(fmt.Sprintf("%T error reading struct: ", _elem11), err)
+	if p != nil {
+		if err := p.writeField1(ctx, oprot); err != nil {
+			return err
 		}
-		p.Analyzers = append(p.Analyzers, _elem11)
 	}
-	if err := iprot.ReadListEnd(ctx); err != nil {
-		return thrift.PrependError("error reading list end: ", err)
+	if err := oprot.WriteFieldStop(ctx); err != nil {
+		return thrift.PrependError("write field stop error: ", err)
+	}
+	if err := oprot.WriteStructEnd(ctx); err != nil {
+		return thrift.PrependError("write struct stop error: ", err)
 	}
 	return nil
 }
 
-func (p *AnalyzeRequest) Write(ctx context.Context, oprot thrift.TProtocol) error {
-	if err := oprot.WriteStructBegin(ctx, "AnalyzeRequest"); err != nil {
-		return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
-	}
-	if p != nil {
-		if err := p.writeField1(ctx, oprot); err != nil {
-			return err
-		}
-		if err := p.writeField2(ctx, oprot); err != nil {
-			return err
-		}
-		if err := p.writeField3(ctx, oprot); err != nil {
-			return err
-		}
-	}
-	if err := oprot.WriteFieldStop(ctx); err != nil {
-		return thrift.PrependError("write field stop error: ", err)
-	}
-	if err := oprot.WriteStructEnd(ctx); err != nil {
-		return thrift.PrependError("write struct stop error: ", err)
-	}
-	return nil
-}
-
-func (p *AnalyzeRequest) writeField1(ctx context.Context, oprot thrift.TProtocol) (err error) {
-	if p.IsSetHostInfo() {
-		if err := oprot.WriteFieldBegin(ctx, "HostInfo", thrift.STRUCT, 1); err != nil {
-			return thrift.PrependError(fmt.Sprintf("%T write field begin error 1:HostInfo: ", p), err)
-		}
-		if err := p.HostInfo.Write(ctx, oprot); err != nil {
-			return thrift.PrependError(fmt.Sprintf("%T error writing struct: ", p.HostInfo), err)
-		}
-		if err := oprot.WriteFieldEnd(ctx); err != nil {
-			return thrift.PrependError(fmt.Sprintf("%T write field end error 1:HostInfo: ", p), err)
-		}
-	}
-	return err
-}
-
-func (p *AnalyzeRequest) writeField2(ctx context.Context, oprot thrift.TProtocol) (err error) {
-	if err := oprot.WriteFieldBegin(ctx, "Artifacts", thrift.LIST, 2); err != nil {
-		return thrift.PrependError(fmt.Sprintf("%T write field begin error 2:Artifacts: ", p), err)
-	}
-	if err := oprot.WriteListBegin(ctx, thrift.STRUCT, len(p.Artifacts)); err != nil {
-		return thrift.PrependError("error writing list begin: ", err)
-	}
-	for _, v := range p.Artifacts {
-		if err := v.Write(ctx, oprot); err != nil {
-			return thrift.PrependError(fmt.Sprintf("%T error writing struct: ", v), err)
-		}
+func (p *AggregateReportsResult_) writeField1(ctx context.Context, oprot thrift.TProtocol) (err error) {
+	if err := oprot.WriteFieldBegin(ctx, "Groups", thrift.LIST, 1); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T write field begin error 1:Groups: ", p), err)
 	}
-	if err := oprot.WriteListEnd(ctx); err != nil {
-		return thrift.PrependError("error writing list end: ", err)
-	}
-	if err := oprot.WriteFieldEnd(ctx); err != nil {
-		return thrift.PrependError(fmt.Sprintf("%T write field end error 2:Artifacts: ", p), err)
-	}
-	return err
-}
-
-func (p *AnalyzeRequest) writeField3(ctx context.Context, oprot thrift.TProtocol) (err error) {
-	if err := oprot.WriteFieldBegin(ctx, "Analyzers", thrift.LIST, 3); err != nil {
-		return thrift.PrependError(fmt.Sprintf("%T write field begin error 3:Analyzers: ", p), err)
-	}
-	if err := oprot.WriteListBegin(ctx, thrift.STRUCT, len(p.Analyzers)); err != nil {
+	if err := oprot.WriteListBegin(ctx, thrift.STRUCT, len(p.Groups)); err != nil {
 		return thrift.PrependError("error writing list begin: ", err)
 	}
-	for _, v := range p.Analyzers {
+	for _, v := range p.Groups {
 		if err := v.Write(ctx, oprot); err != nil {
 			return thrift.PrependError(fmt.Sprintf("%T error writing struct: ", v), err)
 		}
@@ -7236,68 +7995,50 @@ func (p *AnalyzeRequest) writeField3(ctx context.Context, oprot thrift.TProtocol
 		return thrift.PrependError("error writing list end: ", err)
 	}
 	if err := oprot.WriteFieldEnd(ctx); err != nil {
-		return thrift.PrependError(fmt.Sprintf("%T write field end error 3:Analyzers: ", p), err)
+		return thrift.PrependError(fmt.Sprintf("%T write field end error 1:Groups: ", p), err)
 	}
 	return err
 }
 
-func (p *AnalyzeRequest) Equals(other *AnalyzeRequest) bool {
+func (p *AggregateReportsResult_) Equals(other *AggregateReportsResult_) bool {
 	if p == other {
 		return true
 	} else if p == nil || other == nil {
 		return false
 	}
-	if !p.HostInfo.Equals(other.HostInfo) {
-		return false
-	}
-	if len(p.Artifacts) != len(other.Artifacts) {
-		return false
-	}
-	for i, _tgt := range p.Artifacts {
-		_src12 := other.Artifacts[i]
-		if !_tgt.Equals(_src12) {
-			return false
-		}
-	}
-	if len(p.Analyzers) != len(other.Analyzers) {
+	if len(p.Groups) != len(other.Groups) {
 		return false
 	}
-	for i, _tgt := range p.Analyzers {
-		_src13 := other.Analyzers[i]
-		if !_tgt.Equals(_src13) {
+	for i, _tgt := range p.Groups {
+		_src29 := other.Groups[i]
+		if !_tgt.Equals(_src29) {
 			return false
 		}
 	}
 	return true
 }
 
-func (p *AnalyzeRequest) String() string {
+func (p *AggregateReportsResult_) String() string {
 	if p == nil {
 		return "<nil>"
 	}
-	return fmt.Sprintf("AnalyzeRequest(%+v)", *p)
+	return fmt.Sprintf("AggregateReportsResult_(%+v)", *p)
 }
 
 // Attributes:
-//   - ErrorClass
-//   - Description
-type Error struct {
-	ErrorClass  ErrorClass `thrift:"ErrorClass,1" db:"ErrorClass" json:"ErrorClass"`
-	Description string     `thrift:"Description,2" db:"Description" json:"Description"`
-}
-
-func NewError() *Error {
-	return &Error{}
+//   - Version
+type FirmwareVersion struct {
+	Version string `thrift:"Version,1" db:"Version" json:"Version"`
 }
 
-func (p *Error) GetErrorClass() ErrorClass {
-	return p.ErrorClass
+func NewFirmwareVersion() *FirmwareVersion {
+	return &FirmwareVersion{}
 }
 
-func (p *Error) GetDescription() string {
-	return p.Description
+func (p *FirmwareVersion) GetVersion() string {
+	return p.Version
 }
-func (p *Error) Read(ctx context.Context, iprot thrift.TProtocol) error {
+func (p *FirmwareVersion) Read(ctx context.Context, iprot thrift.TProtocol) error {
 	if _, err := iprot.ReadStructBegin(ctx); err != nil {
 		return thrift.PrependError(fmt.Sprintf("%T read error: ", p), err)
 	}
@@ -7312,18 +8053,8 @@ func (p *Error) Read(ctx context.Context, iprot thrift.TProtocol) error {
 		}
 		switch fieldId {
 		case 1:
-			if fieldTypeId == thrift.I32 {
-				if err := p.ReadField1(ctx, iprot); err != nil {
-					return err
-				}
-			} else {
-				if err := iprot.Skip(ctx, fieldTypeId); err != nil {
-					return err
-				}
-			}
-		case 2:
 			if fieldTypeId == thrift.STRING {
-				if err := p.ReadField2(ctx, iprot); err != nil {
+				if err := p.ReadField1(ctx, iprot); err != nil {
 					return err
 				}
 			} else {
@@ -7346,36 +8077,23 @@ func (p *Error) Read(ctx context.Context, iprot thrift.TProtocol) error {
 	return nil
 }
 
-func (p *Error) ReadField1(ctx context.Context, iprot thrift.TProtocol) error {
-	if v, err := iprot.ReadI32(ctx); err != nil {
-		return thrift.PrependError("error reading field 1: ", err)
-	} else {
-		temp := ErrorClass(v)
-		p.ErrorClass = temp
-	}
-	return nil
-}
-
-func (p *Error) ReadField2(ctx context.Context, iprot thrift.TProtocol) error {
+func (p *FirmwareVersion) ReadField1(ctx context.Context, iprot thrift.TProtocol) error {
 	if v, err := iprot.ReadString(ctx); err != nil {
-		return thrift.PrependError("error reading field 2: ", err)
+		return thrift.PrependError("error reading field 1: ", err)
 	} else {
-		p.Description = v
+		p.Version = v
 	}
 	return nil
 }
 
-func (p *Error) Write(ctx context.Context, oprot thrift.TProtocol) error {
-	if err := oprot.WriteStructBegin(ctx, "Error"); err != nil {
+func (p *FirmwareVersion) Write(ctx context.Context, oprot thrift.TProtocol) error {
+	if err := oprot.WriteStructBegin(ctx, "FirmwareVersion"); err != nil {
 		return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
 	}
 	if p != nil {
 		if err := p.writeField1(ctx, oprot); err != nil {
 			return err
 		}
-		if err := p.writeField2(ctx, oprot); err != nil {
-			return err
-		}
 	}
 	if err := oprot.WriteFieldStop(ctx); err != nil {
 		return thrift.PrependError("write field stop error: ", err)
@@ -7386,98 +8104,58 @@ func (p *Error) Write(ctx context.Context, oprot thrift.TProtocol) error {
 	return nil
 }
 
-func (p *Error) writeField1(ctx context.Context, oprot thrift.TProtocol) (err error) {
-	if err := oprot.WriteFieldBegin(ctx, "ErrorClass", thrift.I32, 1); err != nil {
-		return thrift.PrependError(fmt.Sprintf("%T write field begin error 1:ErrorClass: ", p), err)
-	}
-	if err := oprot.WriteI32(ctx, int32(p.ErrorClass)); err != nil {
-		return thrift.PrependError(fmt.Sprintf("%T.ErrorClass (1) field write error: ", p), err)
-	}
-	if err := oprot.WriteFieldEnd(ctx); err != nil {
-		return thrift.PrependError(fmt.Sprintf("%T write field end error 1:ErrorClass: ", p), err)
-	}
-	return err
-}
-
-func (p *Error) writeField2(ctx context.Context, oprot thrift.TProtocol) (err error) {
-	if err := oprot.WriteFieldBegin(ctx, "Description", thrift.STRING, 2); err != nil {
-		return thrift.PrependError(fmt.Sprintf("%T write field begin error 2:Description: ", p), err)
+func (p *FirmwareVersion) writeField1(ctx context.Context, oprot thrift.TProtocol) (err error) {
+	if err := oprot.WriteFieldBegin(ctx, "Version", thrift.STRING, 1); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T write field begin error 1:Version: ", p), err)
 	}
-	if err := oprot.WriteString(ctx, string(p.Description)); err != nil {
-		return thrift.PrependError(fmt.Sprintf("%T.Description (2) field write error: ", p), err)
+	if err := oprot.WriteString(ctx, string(p.Version)); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T.Version (1) field write error: ", p), err)
 	}
 	if err := oprot.WriteFieldEnd(ctx); err != nil {
-		return thrift.PrependError(fmt.Sprintf("%T write field end error 2:Description: ", p), err)
+		return thrift.PrependError(fmt.Sprintf("%T write field end error 1:Version: ", p), err)
 	}
 	return err
 }
 
-func (p *Error) Equals(other *Error) bool {
+func (p *FirmwareVersion) Equals(other *FirmwareVersion) bool {
 	if p == other {
 		return true
 	} else if p == nil || other == nil {
 		return false
 	}
-	if p.ErrorClass != other.ErrorClass {
-		return false
-	}
-	if p.Description != other.Description {
+	if p.Version != other.Version {
 		return false
 	}
 	return true
 }
 
-func (p *Error) String() string {
+func (p *FirmwareVersion) String() string {
 	if p == nil {
 		return "<nil>"
 	}
-	return fmt.Sprintf("Error(%+v)", *p)
+	return fmt.Sprintf("FirmwareVersion(%+v)", *p)
 }
 
 // Attributes:
-//   - AnalyzerName
-//   - AnalyzerOutcome
-//   - ProcessedInputJSON
-type AnalyzerResult_ struct {
-	AnalyzerName       string           `thrift:"AnalyzerName,1" db:"AnalyzerName" json:"AnalyzerName"`
-	AnalyzerOutcome    *AnalyzerOutcome `thrift:"AnalyzerOutcome,2" db:"AnalyzerOutcome" json:"AnalyzerOutcome"`
-	ProcessedInputJSON *string          `thrift:"ProcessedInputJSON,3" db:"ProcessedInputJSON" json:"ProcessedInputJSON,omitempty"`
-}
-
-func NewAnalyzerResult_() *AnalyzerResult_ {
-	return &AnalyzerResult_{}
-}
-
-func (p *AnalyzerResult_) GetAnalyzerName() string {
-	return p.AnalyzerName
+//   - Blob
+//   - Compression
+type CompressedBlob struct {
+	Blob        []byte          `thrift:"Blob,1" db:"Blob" json:"Blob"`
+	Compression CompressionType `thrift:"Compression,2" db:"Compression" json:"Compression"`
 }
 
-var AnalyzerResult__AnalyzerOutcome_DEFAULT *AnalyzerOutcome
-
-func (p *AnalyzerResult_) GetAnalyzerOutcome() *AnalyzerOutcome {
-	if !p.IsSetAnalyzerOutcome() {
-		return AnalyzerResult__AnalyzerOutcome_DEFAULT
-	}
-	return p.AnalyzerOutcome
+func NewCompressedBlob() *CompressedBlob {
+	return &CompressedBlob{}
 }
 
-var AnalyzerResult__ProcessedInputJSON_DEFAULT string
-
-func (p *AnalyzerResult_) GetProcessedInputJSON() string {
-	if !p.IsSetProcessedInputJSON() {
-		return AnalyzerResult__ProcessedInputJSON_DEFAULT
-	}
-	return *p.ProcessedInputJSON
-}
-func (p *AnalyzerResult_) IsSetAnalyzerOutcome() bool {
-	return p.AnalyzerOutcome != nil
+func (p *CompressedBlob) GetBlob() []byte {
+	return p.Blob
 }
 
-func (p *AnalyzerResult_) IsSetProcessedInputJSON() bool {
-	return p.ProcessedInputJSON != nil
+func (p *CompressedBlob) GetCompression() CompressionType {
+	return p.Compression
 }
-
-func (p *AnalyzerResult_) Read(ctx context.Context, iprot thrift.TProtocol) error {
+func (p *CompressedBlob) Read(ctx context.Context, iprot thrift.TProtocol) error {
 	if _, err := iprot.ReadStructBegin(ctx); err != nil {
 		return thrift.PrependError(fmt.Sprintf("%T read error: ", p), err)
 	}
@@ -7502,7 +8180,7 @@ func (p *AnalyzerResult_) Read(ctx context.Context, iprot thrift.TProtocol) erro
 				}
 			}
 		case 2:
-			if fieldTypeId == thrift.STRUCT {
+			if fieldTypeId == thrift.I32 {
 				if err := p.ReadField2(ctx, iprot); err != nil {
 					return err
 				}
@@ -7511,16 +8189,6 @@ func (p *AnalyzerResult_) Read(ctx context.Context, iprot thrift.TProtocol) erro
 					return err
 				}
 			}
-		case 3:
-			if fieldTypeId == thrift.STRING {
-				if err := p.ReadField3(ctx, iprot); err != nil {
-					return err
-				}
-			} else {
-				if err := iprot.Skip(ctx, fieldTypeId); err != nil {
-					return err
-				}
-			}
 		default:
 			if err := iprot.Skip(ctx, fieldTypeId); err != nil {
 				return err
@@ -7536,34 +8204,27 @@ func (p *AnalyzerResult_) Read(ctx context.Context, iprot thrift.TProtocol) erro
 	return nil
 }
 
-func (p *AnalyzerResult_) ReadField1(ctx context.Context, iprot thrift.TProtocol) error {
-	if v, err := iprot.ReadString(ctx); err != nil {
+func (p *CompressedBlob) ReadField1(ctx context.Context, iprot thrift.TProtocol) error {
+	if v, err := iprot.ReadBinary(ctx); err != nil {
 		return thrift.PrependError("error reading field 1: ", err)
 	} else {
-		p.AnalyzerName = v
-	}
-	return nil
-}
-
-func (p *AnalyzerResult_) ReadField2(ctx context.Context, iprot thrift.TProtocol) error {
-	p.AnalyzerOutcome = &AnalyzerOutcome{}
-	if err := p.AnalyzerOutcome.Read(ctx, iprot); err != nil {
-		return thrift.PrependError(fmt.Sprintf("%T error reading struct: ", p.AnalyzerOutcome), err)
+		p.Blob = v
 	}
 	return nil
 }
 
-func (p *AnalyzerResult_) ReadField3(ctx context.Context, iprot thrift.TProtocol) error {
-	if v, err := iprot.ReadString(ctx); err != nil {
-		return thrift.PrependError("error reading field 3: ", err)
+func (p *CompressedBlob) ReadField2(ctx context.Context, iprot thrift.TProtocol) error {
+	if v, err := iprot.ReadI32(ctx); err != nil {
+		return thrift.PrependError("error reading field 2: ", err)
 	} else {
-		p.ProcessedInputJSON = &v
+		temp := CompressionType(v)
+		p.Compression = temp
 	}
 	return nil
 }
 
-func (p *AnalyzerResult_) Write(ctx context.Context, oprot thrift.TProtocol) error {
-	if err := oprot.WriteStructBegin(ctx, "AnalyzerResult"); err != nil {
+func (p *CompressedBlob) Write(ctx context.Context, oprot thrift.TProtocol) error {
+	if err := oprot.WriteStructBegin(ctx, "CompressedBlob"); err != nil {
 		return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
 	}
 	if p != nil {
@@ -7573,9 +8234,6 @@ func (p *AnalyzerResult_) Write(ctx context.Context, oprot thrift.TProtocol) err
 		if err := p.writeField2(ctx, oprot); err != nil {
 			return err
 		}
-		if err := p.writeField3(ctx, oprot); err != nil {
-			return err
-		}
 	}
 	if err := oprot.WriteFieldStop(ctx); err != nil {
 		return thrift.PrependError("write field stop error: ", err)
@@ -7586,127 +8244,86 @@ func (p *AnalyzerResult_) Write(ctx context.Context, oprot thrift.TProtocol) err
 	return nil
 }
 
-func (p *AnalyzerResult_) writeField1(ctx context.Context, oprot thrift.TProtocol) (err error) {
-	if err := oprot.WriteFieldBegin(ctx, "AnalyzerName", thrift.STRING, 1); err != nil {
-		return thrift.PrependError(fmt.Sprintf("%T write field begin error 1:AnalyzerName: ", p), err)
+func (p *CompressedBlob) writeField1(ctx context.Context, oprot thrift.TProtocol) (err error) {
+	if err := oprot.WriteFieldBegin(ctx, "Blob", thrift.STRING, 1); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T write field begin error 1:Blob: ", p), err)
 	}
-	if err := oprot.WriteString(ctx, string(p.AnalyzerName)); err != nil {
-		return thrift.PrependError(fmt.Sprintf("%T.AnalyzerName (1) field write error: ", p), err)
+	if err := oprot.WriteBinary(ctx, p.Blob); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T.Blob (1) field write error: ", p), err)
 	}
 	if err := oprot.WriteFieldEnd(ctx); err != nil {
-		return thrift.PrependError(fmt.Sprintf("%T write field end error 1:AnalyzerName: ", p), err)
+		return thrift.PrependError(fmt.Sprintf("%T write field end error 1:Blob: ", p), err)
 	}
 	return err
 }
 
-func (p *AnalyzerResult_) writeField2(ctx context.Context, oprot thrift.TProtocol) (err error) {
-	if err := oprot.WriteFieldBegin(ctx, "AnalyzerOutcome", thrift.STRUCT, 2); err != nil {
-		return thrift.PrependError(fmt.Sprintf("%T write field begin error 2:AnalyzerOutcome: ", p), err)
+func (p *CompressedBlob) writeField2(ctx context.Context, oprot thrift.TProtocol) (err error) {
+	if err := oprot.WriteFieldBegin(ctx, "Compression", thrift.I32, 2); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T write field begin error 2:Compression: ", p), err)
 	}
-	if err := p.AnalyzerOutcome.Write(ctx, oprot); err != nil {
-		return thrift.PrependError(fmt.Sprintf("%T error writing struct: ", p.AnalyzerOutcome), err)
+	if err := oprot.WriteI32(ctx, int32(p.Compression)); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T.Compression (2) field write error: ", p), err)
 	}
 	if err := oprot.WriteFieldEnd(ctx); err != nil {
-		return thrift.PrependError(fmt.Sprintf("%T write field end error 2:AnalyzerOutcome: ", p), err)
-	}
-	return err
-}
-
-func (p *AnalyzerResult_) writeField3(ctx context.Context, oprot thrift.TProtocol) (err error) {
-	if p.IsSetProcessedInputJSON() {
-		if err := oprot.WriteFieldBegin(ctx, "ProcessedInputJSON", thrift.STRING, 3); err != nil {
-			return thrift.PrependError(fmt.Sprintf("%T write field begin error 3:ProcessedInputJSON: ", p), err)
-		}
-		if err := oprot.WriteString(ctx, string(*p.ProcessedInputJSON)); err != nil {
-			return thrift.PrependError(fmt.Sprintf("%T.ProcessedInputJSON (3) field write error: ", p), err)
-		}
-		if err := oprot.WriteFieldEnd(ctx); err != nil {
-			return thrift.PrependError(fmt.Sprintf("%T write field end error 3:ProcessedInputJSON: ", p), err)
-		}
+		return thrift.PrependError(fmt.Sprintf("%T write field end error 2:Compression: ", p), err)
 	}
 	return err
 }
 
-func (p *AnalyzerResult_) Equals(other *AnalyzerResult_) bool {
+func (p *CompressedBlob) Equals(other *CompressedBlob) bool {
 	if p == other {
 		return true
 	} else if p == nil || other == nil {
 		return false
 	}
-	if p.AnalyzerName != other.AnalyzerName {
+	if bytes.Compare(p.Blob, other.Blob) != 0 {
 		return false
 	}
-	if !p.AnalyzerOutcome.Equals(other.AnalyzerOutcome) {
+	if p.Compression != other.Compression {
 		return false
 	}
-	if p.ProcessedInputJSON != other.ProcessedInputJSON {
-		if p.ProcessedInputJSON == nil || other.ProcessedInputJSON == nil {
-			return false
-		}
-		if (*p.ProcessedInputJSON) != (*other.ProcessedInputJSON) {
-			return false
-		}
-	}
 	return true
 }
 
-func (p *AnalyzerResult_) String() string {
+func (p *CompressedBlob) String() string {
 	if p == nil {
 		return "<nil>"
 	}
-	return fmt.Sprintf("AnalyzerResult_(%+v)", *p)
+	return fmt.Sprintf("CompressedBlob(%+v)", *p)
 }
 
 // Attributes:
-//   - Report
-//   - Err
-type AnalyzerOutcome struct {
-	Report *analyzerreport.AnalyzerReport `thrift:"Report,1" db:"Report" json:"Report,omitempty"`
-	Err    *Error                         `thrift:"Err,2" db:"Err" json:"Err,omitempty"`
-}
-
-func NewAnalyzerOutcome() *AnalyzerOutcome {
-	return &AnalyzerOutcome{}
+//   - ImageSHA2_512
+//   - ImageSize
+//   - ChunkSize
+//   - ChunkHashes
+type ImageChunksManifest struct {
+	ImageSHA2_512 []byte   `thrift:"ImageSHA2_512,1" db:"ImageSHA2_512" json:"ImageSHA2_512"`
+	ImageSize     int64    `thrift:"ImageSize,2" db:"ImageSize" json:"ImageSize"`
+	ChunkSize     int32    `thrift:"ChunkSize,3" db:"ChunkSize" json:"ChunkSize"`
+	ChunkHashes   [][]byte `thrift:"ChunkHashes,4" db:"ChunkHashes" json:"ChunkHashes"`
 }
 
-var AnalyzerOutcome_Report_DEFAULT *analyzerreport.AnalyzerReport
-
-func (p *AnalyzerOutcome) GetReport() *analyzerreport.AnalyzerReport {
-	if !p.IsSetReport() {
-		return AnalyzerOutcome_Report_DEFAULT
-	}
-	return p.Report
+func NewImageChunksManifest() *ImageChunksManifest {
+	return &ImageChunksManifest{}
 }
 
-var AnalyzerOutcome_Err_DEFAULT *Error
-
-func (p *AnalyzerOutcome) GetErr() *Error {
-	if !p.IsSetErr() {
-		return AnalyzerOutcome_Err_DEFAULT
-	}
-	return p.Err
+func (p *ImageChunksManifest) GetImageSHA2_512() []byte {
+	return p.ImageSHA2_512
 }
-func (p *AnalyzerOutcome) CountSetFieldsAnalyzerOutcome() int {
-	count := 0
-	if p.IsSetReport() {
-		count++
-	}
-	if p.IsSetErr() {
-		count++
-	}
-	return count
 
+func (p *ImageChunksManifest) GetImageSize() int64 {
+	return p.ImageSize
 }
 
-func (p *AnalyzerOutcome) IsSetReport() bool {
-	return p.Report != nil
+func (p *ImageChunksManifest) GetChunkSize() int32 {
+	return p.ChunkSize
 }
 
-func (p *AnalyzerOutcome) IsSetErr() bool {
-	return p.Err != nil
+func (p *ImageChunksManifest) GetChunkHashes() [][]byte {
+	return p.ChunkHashes
 }
-
-func (p *AnalyzerOutcome) Read(ctx context.Context, iprot thrift.TProtocol) error {
+func (p *ImageChunksManifest) Read(ctx context.Context, iprot thrift.TProtocol) error {
 	if _, err := iprot.ReadStructBegin(ctx); err != nil {
 		return thrift.PrependError(fmt.Sprintf("%T read error: ", p), err)
 	}
@@ -7721,7 +8338,7 @@ func (p *AnalyzerOutcome) Read(ctx context.Context, iprot thrift.TProtocol) erro
 		}
 		switch fieldId {
 		case 1:
-			if fieldTypeId == thrift.STRUCT {
+			if fieldTypeId == thrift.STRING {
 				if err := p.ReadField1(ctx, iprot); err != nil {
 					return err
 				}
@@ -7731,7 +8348,7 @@ func (p *AnalyzerOutcome) Read(ctx context.Context, iprot thrift.TProtocol) erro
 				}
 			}
 		case 2:
-			if fieldTypeId == thrift.STRUCT {
+			if fieldTypeId == thrift.I64 {
 				if err := p.ReadField2(ctx, iprot); err != nil {
 					return err
 				}
@@ -7740,6 +8357,26 @@ func (p *AnalyzerOutcome) Read(ctx context.Context, iprot thrift.TProtocol) erro
 					return err
 				}
 			}
+		case 3:
+			if fieldTypeId == thrift.I32 {
+				if err := p.ReadField3(ctx, iprot); err != nil {
+					return err
+				}
+			} else {
+				if err := iprot.Skip(ctx, fieldTypeId); err != nil {
+					return err
+				}
+			}
+		case 4:
+			if fieldTypeId == thrift.LIST {
+				if err := p.ReadField4(ctx, iprot); err != nil {
+					return err
+				}
+			} else {
+				if err := iprot.Skip(ctx, fieldTypeId); err != nil {
+					return err
+				}
+			}
 		default:
 			if err := iprot.Skip(ctx, fieldTypeId); err != nil {
 				return err
@@ -7755,27 +8392,57 @@ func (p *AnalyzerOutcome) Read(ctx context.Context, iprot thrift.TProtocol) erro
 	return nil
 }
 
-func (p *AnalyzerOutcome) ReadField1(ctx context.Context, iprot thrift.TProtocol) error {
-	p.Report = &analyzerreport.AnalyzerReport{}
-	if err := p.Report.Read(ctx, iprot); err != nil {
-		return thrift.PrependError(fmt.Sprintf("%T error reading struct: ", p.Report), err)
+func (p *ImageChunksManifest) ReadField1(ctx context.Context, iprot thrift.TProtocol) error {
+	if v, err := iprot.ReadBinary(ctx); err != nil {
+		return thrift.PrependError("error reading field 1: ", err)
+	} else {
+		p.ImageSHA2_512 = v
 	}
 	return nil
 }
 
-func (p *AnalyzerOutcome) ReadField2(ctx context.Context, iprot thrift.TProtocol) error {
-	p.Err = &Error{}
-	if err := p.Err.Read(ctx, iprot); err != nil {
-		return thrift.PrependError(fmt.Sprintf("%T error reading struct: ", p.Err), err)
+func (p *ImageChunksManifest) ReadField2(ctx context.Context, iprot thrift.TProtocol) error {
+	if v, err := iprot.ReadI64(ctx); err != nil {
+		return thrift.PrependError("error reading field 2: ", err)
+	} else {
+		p.ImageSize = v
 	}
 	return nil
 }
 
-func (p *AnalyzerOutcome) Write(ctx context.Context, oprot thrift.TProtocol) error {
-	if c := p.CountSetFieldsAnalyzerOutcome(); c != 1 {
-		return fmt.Errorf("%T write union: exactly one field must be set (%d set).", p, c)
+func (p *ImageChunksManifest) ReadField3(ctx context.Context, iprot thrift.TProtocol) error {
+	if v, err := iprot.ReadI32(ctx); err != nil {
+		return thrift.PrependError("error reading field 3: ", err)
+	} else {
+		p.ChunkSize = v
 	}
-	if err := oprot.WriteStructBegin(ctx, "AnalyzerOutcome"); err != nil {
+	return nil
+}
+
+func (p *ImageChunksManifest) ReadField4(ctx context.Context, iprot thrift.TProtocol) error {
+	_, size, err := iprot.ReadListBegin(ctx)
+	if err != nil {
+		return thrift.PrependError("error reading list begin: ", err)
+	}
+	tSlice := make([][]byte, 0, size)
+	p.ChunkHashes = tSlice
+	for i := 0; i < size; i++ {
+		var _elem30 []byte
+		if v, err := iprot.ReadBinary(ctx); err != nil {
+			return thrift.PrependError("error reading field 0: ", err)
+		} else {
+			_elem30 = v
+		}
+		p.ChunkHashes = append(p.ChunkHashes, _elem30)
+	}
+	if err := iprot.ReadListEnd(ctx); err != nil {
+		return thrift.PrependError("error reading list end: ", err)
+	}
+	return nil
+}
+
+func (p *ImageChunksManifest) Write(ctx context.Context, oprot thrift.TProtocol) error {
+	if err := oprot.WriteStructBegin(ctx, "ImageChunksManifest"); err != nil {
 		return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
 	}
 	if p != nil {
@@ -7785,6 +8452,12 @@ func (p *AnalyzerOutcome) Write(ctx context.Context, oprot thrift.TProtocol) err
 		if err := p.writeField2(ctx, oprot); err != nil {
 			return err
 		}
+		if err := p.writeField3(ctx, oprot); err != nil {
+			return err
+		}
+		if err := p.writeField4(ctx, oprot); err != nil {
+			return err
+		}
 	}
 	if err := oprot.WriteFieldStop(ctx); err != nil {
 		return thrift.PrependError("write field stop error: ", err)
@@ -7795,78 +8468,129 @@ func (p *AnalyzerOutcome) Write(ctx context.Context, oprot thrift.TProtocol) err
 	return nil
 }
 
-func (p *AnalyzerOutcome) writeField1(ctx context.Context, oprot thrift.TProtocol) (err error) {
-	if p.IsSetReport() {
-		if err := oprot.WriteFieldBegin(ctx, "Report", thrift.STRUCT, 1); err != nil {
-			return thrift.PrependError(fmt.Sprintf("%T write field begin error 1:Report: ", p), err)
-		}
-		if err := p.Report.Write(ctx, oprot); err != nil {
-			return thrift.PrependError(fmt.Sprintf("%T error writing struct: ", p.Report), err)
-		}
-		if err := oprot.WriteFieldEnd(ctx); err != nil {
-			return thrift.PrependError(fmt.Sprintf("%T write field end error 1:Report: ", p), err)
-		}
+func (p *ImageChunksManifest) writeField1(ctx context.Context, oprot thrift.TProtocol) (err error) {
+	if err := oprot.WriteFieldBegin(ctx, "ImageSHA2_512", thrift.STRING, 1); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T write field begin error 1:ImageSHA2_512: ", p), err)
+	}
+	if err := oprot.WriteBinary(ctx, p.ImageSHA2_512); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T.ImageSHA2_512 (1) field write error: ", p), err)
+	}
+	if err := oprot.WriteFieldEnd(ctx); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T write field end error 1:ImageSHA2_512: ", p), err)
 	}
 	return err
 }
 
-func (p *AnalyzerOutcome) writeField2(ctx context.Context, oprot thrift.TProtocol) (err error) {
-	if p.IsSetErr() {
-		if err := oprot.WriteFieldBegin(ctx, "Err", thrift.STRUCT, 2); err != nil {
-			return thrift.PrependError(fmt.Sprintf("%T write field begin error 2:Err: ", p), err)
-		}
-		if err := p.Err.Write(ctx, oprot); err != nil {
-			return thrift.PrependError(fmt.Sprintf("%T error writing struct: ", p.Err), err)
-		}
-		if err := oprot.WriteFieldEnd(ctx); err != nil {
-			return thrift.PrependError(fmt.Sprintf("%T write field end error 2:Err: ", p), err)
+func (p *ImageChunksManifest) writeField2(ctx context.Context, oprot thrift.TProtocol) (err error) {
+	if err := oprot.WriteFieldBegin(ctx, "ImageSize", thrift.I64, 2); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T write field begin error 2:ImageSize: ", p), err)
+	}
+	if err := oprot.WriteI64(ctx, int64(p.ImageSize)); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T.ImageSize (2) field write error: ", p), err)
+	}
+	if err := oprot.WriteFieldEnd(ctx); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T write field end error 2:ImageSize: ", p), err)
+	}
+	return err
+}
+
+func (p *ImageChunksManifest) writeField3(ctx context.Context, oprot thrift.TProtocol) (err error) {
+	if err := oprot.WriteFieldBegin(ctx, "ChunkSize", thrift.I32, 3); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T write field begin error 3:ChunkSize: ", p), err)
+	}
+	if err := oprot.WriteI32(ctx, int32(p.ChunkSize)); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T.ChunkSize (3) field write error: ", p), err)
+	}
+	if err := oprot.WriteFieldEnd(ctx); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T write field end error 3:ChunkSize: ", p), err)
+	}
+	return err
+}
+
+func (p *ImageChunksManifest) writeField4(ctx context.Context, oprot thrift.TProtocol) (err error) {
+	if err := oprot.WriteFieldBegin(ctx, "ChunkHashes", thrift.LIST, 4); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T write field begin error 4:ChunkHashes: ", p), err)
+	}
+	if err := oprot.WriteListBegin(ctx, thrift.STRING, len(p.ChunkHashes)); err != nil {
+		return thrift.PrependError("error writing list begin: ", err)
+	}
+	for _, v := range p.ChunkHashes {
+		if err := oprot.WriteBinary(ctx, v); err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T. (0) field write error: ", p), err)
 		}
 	}
+	if err := oprot.WriteListEnd(ctx); err != nil {
+		return thrift.PrependError("error writing list end: ", err)
+	}
+	if err := oprot.WriteFieldEnd(ctx); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T write field end error 4:ChunkHashes: ", p), err)
+	}
 	return err
 }
 
-func (p *AnalyzerOutcome) Equals(other *AnalyzerOutcome) bool {
+func (p *ImageChunksManifest) Equals(other *ImageChunksManifest) bool {
 	if p == other {
 		return true
 	} else if p == nil || other == nil {
 		return false
 	}
-	if !p.Report.Equals(other.Report) {
+	if bytes.Compare(p.ImageSHA2_512, other.ImageSHA2_512) != 0 {
 		return false
 	}
-	if !p.Err.Equals(other.Err) {
+	if p.ImageSize != other.ImageSize {
+		return false
+	}
+	if p.ChunkSize != other.ChunkSize {
+		return false
+	}
+	if len(p.ChunkHashes) != len(other.ChunkHashes) {
 		return false
 	}
+	for i, _tgt := range p.ChunkHashes {
+		_src31 := other.ChunkHashes[i]
+		if bytes.Compare(_tgt, _src31) != 0 {
+			return false
+		}
+	}
 	return true
 }
 
-func (p *AnalyzerOutcome) String() string {
+func (p *ImageChunksManifest) String() string {
 	if p == nil {
 		return "<nil>"
 	}
-	return fmt.Sprintf("AnalyzerOutcome(%+v)", *p)
+	return fmt.Sprintf("ImageChunksManifest(%+v)", *p)
 }
 
 // Attributes:
-//   - JobID
-//   - Results
-type AnalyzeResult_ struct {
-	JobID   []byte             `thrift:"JobID,1" db:"JobID" json:"JobID"`
-	Results []*AnalyzerResult_ `thrift:"Results,2" db:"Results" json:"Results"`
+//   - Manifest
+//   - Chunks
+type DeltaBlob struct {
+	Manifest *ImageChunksManifest      `thrift:"Manifest,1" db:"Manifest" json:"Manifest"`
+	Chunks   map[int32]*CompressedBlob `thrift:"Chunks,2" db:"Chunks" json:"Chunks"`
 }
 
-func NewAnalyzeResult_() *AnalyzeResult_ {
-	return &AnalyzeResult_{}
+func NewDeltaBlob() *DeltaBlob {
+	return &DeltaBlob{}
 }
 
-func (p *AnalyzeResult_) GetJobID() []byte {
-	return p.JobID
+var DeltaBlob_Manifest_DEFAULT *ImageChunksManifest
+
+func (p *DeltaBlob) GetManifest() *ImageChunksManifest {
+	if !p.IsSetManifest() {
+		return DeltaBlob_Manifest_DEFAULT
+	}
+	return p.Manifest
 }
 
-func (p *AnalyzeResult_) GetResults() []*AnalyzerResult_ {
-	return p.Results
+func (p *DeltaBlob) GetChunks() map[int32]*CompressedBlob {
+	return p.Chunks
 }
-func (p *AnalyzeResult_) Read(ctx context.Context, iprot thrift.TProtocol) error {
+func (p *DeltaBlob) IsSetManifest() bool {
+	return p.Manifest != nil
+}
+
+func (p *DeltaBlob) Read(ctx context.Context, iprot thrift.TProtocol) error {
 	if _, err := iprot.ReadStructBegin(ctx); err != nil {
 		return thrift.PrependError(fmt.Sprintf("%T read error: ", p), err)
 	}
@@ -7881,7 +8605,7 @@ func (p *AnalyzeResult_) Read(ctx context.Context, iprot thrift.TProtocol) error
 		}
 		switch fieldId {
 		case 1:
-			if fieldTypeId == thrift.STRING {
+			if fieldTypeId == thrift.STRUCT {
 				if err := p.ReadField1(ctx, iprot); err != nil {
 					return err
 				}
@@ -7891,7 +8615,7 @@ func (p *AnalyzeResult_) Read(ctx context.Context, iprot thrift.TProtocol) error
 				}
 			}
 		case 2:
-			if fieldTypeId == thrift.LIST {
+			if fieldTypeId == thrift.MAP {
 				if err := p.ReadField2(ctx, iprot); err != nil {
 					return err
 				}
@@ -7915,37 +8639,42 @@ func (p *AnalyzeResult_) Read(ctx context.Context, iprot thrift.TProtocol) error
 	return nil
 }
 
-func (p *AnalyzeResult_) ReadField1(ctx context.Context, iprot thrift.TProtocol) error {
-	if v, err := iprot.ReadBinary(ctx); err != nil {
-		return thrift.PrependError("error reading field 1: ", err)
-	} else {
-		p.JobID = v
+func (p *DeltaBlob) ReadField1(ctx context.Context, iprot thrift.TProtocol) error {
+	p.Manifest = &ImageChunksManifest{}
+	if err := p.Manifest.Read(ctx, iprot); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T error reading struct: ", p.Manifest), err)
 	}
 	return nil
 }
 
-func (p *AnalyzeResult_) ReadField2(ctx context.Context, iprot thrift.TProtocol) error {
-	_, size, err := iprot.ReadListBegin(ctx)
+func (p *DeltaBlob) ReadField2(ctx context.Context, iprot thrift.TProtocol) error {
+	_, _, size, err := iprot.ReadMapBegin(ctx)
 	if err != nil {
-		return thrift.PrependError("error reading list begin: ", err)
+		return thrift.PrependError("error reading map begin: ", err)
 	}
-	tSlice := make([]*AnalyzerResult_, 0, size)
-	p.Results = tSlice
+	tMap := make(map[int32]*CompressedBlob, size)
+	p.Chunks = tMap
 	for i := 0; i < size; i++ {
-		_elem14 := &AnalyzerResult_{}
-		if err := _elem14.Read(ctx, iprot); err != nil {
-			return thrift.PrependError(fmt.Sprintf("%T error reading struct: ", _elem14), err)
+		var _key32 int32
+		if v, err := iprot.ReadI32(ctx); err != nil {
+			return thrift.PrependError("error reading field 0: ", err)
+		} else {
+			_key32 = v
 		}
-		p.Results = append(p.Results, _elem14)
+		_val33 := &CompressedBlob{}
+		if err := _val33.Read(ctx, iprot); err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T error reading struct: ", _val33), err)
+		}
+		p.Chunks[_key32] = _val33
 	}
-	if err := iprot.ReadListEnd(ctx); err != nil {
-		return thrift.PrependError("error reading list end: ", err)
+	if err := iprot.ReadMapEnd(ctx); err != nil {
+		return thrift.PrependError("error reading map end: ", err)
 	}
 	return nil
 }
 
-func (p *AnalyzeResult_) Write(ctx context.Context, oprot thrift.TProtocol) error {
-	if err := oprot.WriteStructBegin(ctx, "AnalyzeResult"); err != nil {
+func (p *DeltaBlob) Write(ctx context.Context, oprot thrift.TProtocol) error {
+	if err := oprot.WriteStructBegin(ctx, "DeltaBlob"); err != nil {
 		return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
 	}
 	if p != nil {
@@ -7965,82 +8694,91 @@ func (p *AnalyzeResult_) Write(ctx context.Context, oprot thrift.TProtocol) erro
 	return nil
 }
 
-func (p *AnalyzeResult_) writeField1(ctx context.Context, oprot thrift.TProtocol) (err error) {
-	if err := oprot.WriteFieldBegin(ctx, "JobID", thrift.STRING, 1); err != nil {
-		return thrift.PrependError(fmt.Sprintf("%T write field begin error 1:JobID: ", p), err)
+func (p *DeltaBlob) writeField1(ctx context.Context, oprot thrift.TProtocol) (err error) {
+	if err := oprot.WriteFieldBegin(ctx, "Manifest", thrift.STRUCT, 1); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T write field begin error 1:Manifest: ", p), err)
 	}
-	if err := oprot.WriteBinary(ctx, p.JobID); err != nil {
-		return thrift.PrependError(fmt.Sprintf("%T.JobID (1) field write error: ", p), err)
+	if err := p.Manifest.Write(ctx, oprot); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T error writing struct: ", p.Manifest), err)
 	}
 	if err := oprot.WriteFieldEnd(ctx); err != nil {
-		return thrift.PrependError(fmt.Sprintf("%T write field end error 1:JobID: ", p), err)
+		return thrift.PrependError(fmt.Sprintf("%T write field end error 1:Manifest: ", p), err)
 	}
 	return err
 }
 
-func (p *AnalyzeResult_) writeField2(ctx context.Context, oprot thrift.TProtocol) (err error) {
-	if err := oprot.WriteFieldBegin(ctx, "Results", thrift.LIST, 2); err != nil {
-		return thrift.PrependError(fmt.Sprintf("%T write field begin error 2:Results: ", p), err)
+func (p *DeltaBlob) writeField2(ctx context.Context, oprot thrift.TProtocol) (err error) {
+	if err := oprot.WriteFieldBegin(ctx, "Chunks", thrift.MAP, 2); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T write field begin error 2:Chunks: ", p), err)
 	}
-	if err := oprot.WriteListBegin(ctx, thrift.STRUCT, len(p.Results)); err != nil {
-		return thrift.PrependError("error writing list begin: ", err)
+	if err := oprot.WriteMapBegin(ctx, thrift.I32, thrift.STRUCT, len(p.Chunks)); err != nil {
+		return thrift.PrependError("error writing map begin: ", err)
 	}
-	for _, v := range p.Results {
+	for k, v := range p.Chunks {
+		if err := oprot.WriteI32(ctx, int32(k)); err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T. (0) field write error: ", p), err)
+		}
 		if err := v.Write(ctx, oprot); err != nil {
 			return thrift.PrependError(fmt.Sprintf("%T error writing struct: ", v), err)
 		}
 	}
-	if err := oprot.WriteListEnd(ctx); err != nil {
-		return thrift.PrependError("error writing list end: ", err)
+	if err := oprot.WriteMapEnd(ctx); err != nil {
+		return thrift.PrependError("error writing map end: ", err)
 	}
 	if err := oprot.WriteFieldEnd(ctx); err != nil {
-		return thrift.PrependError(fmt.Sprintf("%T write field end error 2:Results: ", p), err)
+		return thrift.PrependError(fmt.Sprintf("%T write field end error 2:Chunks: ", p), err)
 	}
 	return err
 }
 
-func (p *AnalyzeResult_) Equals(other *AnalyzeResult_) bool {
+func (p *DeltaBlob) Equals(other *DeltaBlob) bool {
 	if p == other {
 		return true
 	} else if p == nil || other == nil {
 		return false
 	}
-	if bytes.Compare(p.JobID, other.JobID) != 0 {
+	if !p.Manifest.Equals(other.Manifest) {
 		return false
 	}
-	if len(p.Results) != len(other.Results) {
+	if len(p.Chunks) != len(other.Chunks) {
 		return false
 	}
-	for i, _tgt := range p.Results {
-		_src15 := other.Results[i]
-		if !_tgt.Equals(_src15) {
+	for k, _tgt := range p.Chunks {
+		_src34 := other.Chunks[k]
+		if !_tgt.Equals(_src34) {
 			return false
 		}
 	}
 	return true
 }
 
-func (p *AnalyzeResult_) String() string {
+func (p *DeltaBlob) String() string {
 	if p == nil {
 		return "<nil>"
 	}
-	return fmt.Sprintf("AnalyzeResult_(%+v)", *p)
+	return fmt.Sprintf("DeltaBlob(%+v)", *p)
 }
 
 // Attributes:
-//   - Firmwares
-type CheckFirmwareVersionRequest struct {
-	Firmwares []*FirmwareVersion `thrift:"firmwares,1" db:"firmwares" json:"firmwares"`
+//   - Offset
+//   - Length
+type ByteRange struct {
+	Offset int64 `thrift:"Offset,1" db:"Offset" json:"Offset"`
+	Length int64 `thrift:"Length,2" db:"Length" json:"Length"`
 }
 
-func NewCheckFirmwareVersionRequest() *CheckFirmwareVersionRequest {
-	return &CheckFirmwareVersionRequest{}
+func NewByteRange() *ByteRange {
+	return &ByteRange{}
 }
 
-func (p *CheckFirmwareVersionRequest) GetFirmwares() []*FirmwareVersion {
-	return p.Firmwares
+func (p *ByteRange) GetOffset() int64 {
+	return p.Offset
 }
-func (p *CheckFirmwareVersionRequest) Read(ctx context.Context, iprot thrift.TProtocol) error {
+
+func (p *ByteRange) GetLength() int64 {
+	return p.Length
+}
+func (p *ByteRange) Read(ctx context.Context, iprot thrift.TProtocol) error {
 	if _, err := iprot.ReadStructBegin(ctx); err != nil {
 		return thrift.PrependError(fmt.Sprintf("%T read error: ", p), err)
 	}
@@ -8055,7 +8793,7 @@ func (p *CheckFirmwareVersionRequest) Read(ctx context.Context, iprot thrift.TPr
 		}
 		switch fieldId {
 		case 1:
-			if fieldTypeId == thrift.LIST {
+			if fieldTypeId == thrift.I64 {
 				if err := p.ReadField1(ctx, iprot); err != nil {
 					return err
 				}
@@ -8064,6 +8802,16 @@ func (p *CheckFirmwareVersionRequest) Read(ctx context.Context, iprot thrift.TPr
 					return err
 				}
 			}
+		case 2:
+			if fieldTypeId == thrift.I64 {
+				if err := p.ReadField2(ctx, iprot); err != nil {
+					return err
+				}
+			} else {
+				if err := iprot.Skip(ctx, fieldTypeId); err != nil {
+					return err
+				}
+			}
 		default:
 			if err := iprot.Skip(ctx, fieldTypeId); err != nil {
 				return err
@@ -8079,34 +8827,35 @@ func (p *CheckFirmwareVersionRequest) Read(ctx context.Context, iprot thrift.TPr
 	return nil
 }
 
-func (p *CheckFirmwareVersionRequest) ReadField1(ctx context.Context, iprot thrift.TProtocol) error {
-	_, size, err := iprot.ReadListBegin(ctx)
-	if err != nil {
-		return thrift.PrependError("error reading list begin: ", err)
-	}
-	tSlice := make([]*FirmwareVersion, 0, size)
-	p.Firmwares = tSlice
-	for i := 0; i < size; i++ {
-		_elem16 := &FirmwareVersion{}
-		if err := _elem16.Read(ctx, iprot); err != nil {
-			return thrift.PrependError(fmt.Sprintf("%T error reading struct: ", _elem16), err)
-		}
-		p.Firmwares = append(p.Firmwares, _elem16)
+func (p *ByteRange) ReadField1(ctx context.Context, iprot thrift.TProtocol) error {
+	if v, err := iprot.ReadI64(ctx); err != nil {
+		return thrift.PrependError("error reading field 1: ", err)
+	} else {
+		p.Offset = v
 	}
-	if err := iprot.ReadListEnd(ctx); err != nil {
-		return thrift.PrependError("error reading list end: ", err)
+	return nil
+}
+
+func (p *ByteRange) ReadField2(ctx context.Context, iprot thrift.TProtocol) error {
+	if v, err := iprot.ReadI64(ctx); err != nil {
+		return thrift.PrependError("error reading field 2: ", err)
+	} else {
+		p.Length = v
 	}
 	return nil
 }
 
-func (p *CheckFirmwareVersionRequest) Write(ctx context.Context, oprot thrift.TProtocol) error {
-	if err := oprot.WriteStructBegin(ctx, "CheckFirmwareVersionRequest"); err != nil {
+func (p *ByteRange) Write(ctx context.Context, oprot thrift.TProtocol) error {
+	if err := oprot.WriteStructBegin(ctx, "ByteRange"); err != nil {
 		return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
 	}
 	if p != nil {
 		if err := p.writeField1(ctx, oprot); err != nil {
 			return err
 		}
+		if err := p.writeField2(ctx, oprot); err != nil {
+			return err
+		}
 	}
 	if err := oprot.WriteFieldStop(ctx); err != nil {
 		return thrift.PrependError("write field stop error: ", err)
@@ -8117,66 +8866,83 @@ func (p *CheckFirmwareVersionRequest) Write(ctx context.Context, oprot thrift.TP
 	return nil
 }
 
-func (p *CheckFirmwareVersionRequest) writeField1(ctx context.Context, oprot thrift.TProtocol) (err error) {
-	if err := oprot.WriteFieldBegin(ctx, "firmwares", thrift.LIST, 1); err != nil {
-		return thrift.PrependError(fmt.Sprintf("%T write field begin error 1:firmwares: ", p), err)
+func (p *ByteRange) writeField1(ctx context.Context, oprot thrift.TProtocol) (err error) {
+	if err := oprot.WriteFieldBegin(ctx, "Offset", thrift.I64, 1); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T write field begin error 1:Offset: ", p), err)
 	}
-	if err := oprot.WriteListBegin(ctx, thrift.STRUCT, len(p.Firmwares)); err != nil {
-		return thrift.PrependError("error writing list begin: ", err)
+	if err := oprot.WriteI64(ctx, int64(p.Offset)); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T.Offset (1) field write error: ", p), err)
 	}
-	for _, v := range p.Firmwares {
-		if err := v.Write(ctx, oprot); err != nil {
-			return thrift.PrependError(fmt.Sprintf("%T error writing struct: ", v), err)
-		}
+	if err := oprot.WriteFieldEnd(ctx); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T write field end error 1:Offset: ", p), err)
 	}
-	if err := oprot.WriteListEnd(ctx); err != nil {
-		return thrift.PrependError("error writing list end: ", err)
+	return err
+}
+
+func (p *ByteRange) writeField2(ctx context.Context, oprot thrift.TProtocol) (err error) {
+	if err := oprot.WriteFieldBegin(ctx, "Length", thrift.I64, 2); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T write field begin error 2:Length: ", p), err)
+	}
+	if err := oprot.WriteI64(ctx, int64(p.Length)); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T.Length (2) field write error: ", p), err)
 	}
 	if err := oprot.WriteFieldEnd(ctx); err != nil {
-		return thrift.PrependError(fmt.Sprintf("%T write field end error 1:firmwares: ", p), err)
+		return thrift.PrependError(fmt.Sprintf("%T write field end error 2:Length: ", p), err)
 	}
 	return err
 }
 
-func (p *CheckFirmwareVersionRequest) Equals(other *CheckFirmwareVersionRequest) bool {
+func (p *ByteRange) Equals(other *ByteRange) bool {
 	if p == other {
 		return true
 	} else if p == nil || other == nil {
 		return false
 	}
-	if len(p.Firmwares) != len(other.Firmwares) {
+	if p.Offset != other.Offset {
 		return false
 	}
-	for i, _tgt := range p.Firmwares {
-		_src17 := other.Firmwares[i]
-		if !_tgt.Equals(_src17) {
-			return false
-		}
+	if p.Length != other.Length {
+		return false
 	}
 	return true
 }
 
-func (p *CheckFirmwareVersionRequest) String() string {
+func (p *ByteRange) String() string {
 	if p == nil {
 		return "<nil>"
 	}
-	return fmt.Sprintf("CheckFirmwareVersionRequest(%+v)", *p)
+	return fmt.Sprintf("ByteRange(%+v)", *p)
 }
 
 // Attributes:
-//   - ExistStatus
-type CheckFirmwareVersionResult_ struct {
-	ExistStatus []bool `thrift:"existStatus,1" db:"existStatus" json:"existStatus"`
+//   - Blob
+//   - Coverage
+type SparseBlob struct {
+	Blob     *CompressedBlob `thrift:"Blob,1" db:"Blob" json:"Blob"`
+	Coverage []*ByteRange    `thrift:"Coverage,2" db:"Coverage" json:"Coverage"`
 }
 
-func NewCheckFirmwareVersionResult_() *CheckFirmwareVersionResult_ {
-	return &CheckFirmwareVersionResult_{}
+func NewSparseBlob() *SparseBlob {
+	return &SparseBlob{}
 }
 
-func (p *CheckFirmwareVersionResult_) GetExistStatus() []bool {
-	return p.ExistStatus
+var SparseBlob_Blob_DEFAULT *CompressedBlob
+
+func (p *SparseBlob) GetBlob() *CompressedBlob {
+	if !p.IsSetBlob() {
+		return SparseBlob_Blob_DEFAULT
+	}
+	return p.Blob
 }
-func (p *CheckFirmwareVersionResult_) Read(ctx context.Context, iprot thrift.TProtocol) error {
+
+func (p *SparseBlob) GetCoverage() []*ByteRange {
+	return p.Coverage
+}
+func (p *SparseBlob) IsSetBlob() bool {
+	return p.Blob != nil
+}
+
+func (p *SparseBlob) Read(ctx context.Context, iprot thrift.TProtocol) error {
 	if _, err := iprot.ReadStructBegin(ctx); err != nil {
 		return thrift.PrependError(fmt.Sprintf("%T read error: ", p), err)
 	}
@@ -8191,7 +8957,7 @@ func (p *CheckFirmwareVersionResult_) Read(ctx context.Context, iprot thrift.TPr
 		}
 		switch fieldId {
 		case 1:
-			if fieldTypeId == thrift.LIST {
+			if fieldTypeId == thrift.STRUCT {
 				if err := p.ReadField1(ctx, iprot); err != nil {
 					return err
 				}
@@ -8200,6 +8966,16 @@ func (p *CheckFirmwareVersionResult_) Read(ctx context.Context, iprot thrift.TPr
 					return err
 				}
 			}
+		case 2:
+			if fieldTypeId == thrift.LIST {
+				if err := p.ReadField2(ctx, iprot); err != nil {
+					return err
+				}
+			} else {
+				if err := iprot.Skip(ctx, fieldTypeId); err != nil {
+					return err
+				}
+			}
 		default:
 			if err := iprot.Skip(ctx, fieldTypeId); err != nil {
 				return err
@@ -8215,21 +8991,27 @@ func (p *CheckFirmwareVersionResult_) Read(ctx context.Context, iprot thrift.TPr
 	return nil
 }
 
-func (p *CheckFirmwareVersionResult_) ReadField1(ctx context.Context, iprot thrift.TProtocol) error {
+func (p *SparseBlob) ReadField1(ctx context.Context, iprot thrift.TProtocol) error {
+	p.Blob = &CompressedBlob{}
+	if err := p.Blob.Read(ctx, iprot); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T error reading struct: ", p.Blob), err)
+	}
+	return nil
+}
+
+func (p *SparseBlob) ReadField2(ctx context.Context, iprot thrift.TProtocol) error {
 	_, size, err := iprot.ReadListBegin(ctx)
 	if err != nil {
 		return thrift.PrependError("error reading list begin: ", err)
 	}
-	tSlice := make([]bool, 0, size)
-	p.ExistStatus = tSlice
+	tSlice := make([]*ByteRange, 0, size)
+	p.Coverage = tSlice
 	for i := 0; i < size; i++ {
-		var _elem18 bool
-		if v, err := iprot.ReadBool(ctx); err != nil {
-			return thrift.PrependError("error reading field 0: ", err)
-		} else {
-			_elem18 = v
+		_elem35 := &ByteRange{}
+		if err := _elem35.Read(ctx, iprot); err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T error reading struct: ", _elem35), err)
 		}
-		p.ExistStatus = append(p.ExistStatus, _elem18)
+		p.Coverage = append(p.Coverage, _elem35)
 	}
 	if err := iprot.ReadListEnd(ctx); err != nil {
 		return thrift.PrependError("error reading list end: ", err)
@@ -8237,14 +9019,17 @@ func (p *CheckFirmwareVersionResult_) ReadField1(ctx context.Context, iprot thri
 	return nil
 }
 
-func (p *CheckFirmwareVersionResult_) Write(ctx context.Context, oprot thrift.TProtocol) error {
-	if err := oprot.WriteStructBegin(ctx, "CheckFirmwareVersionResult"); err != nil {
+func (p *SparseBlob) Write(ctx context.Context, oprot thrift.TProtocol) error {
+	if err := oprot.WriteStructBegin(ctx, "SparseBlob"); err != nil {
 		return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
 	}
 	if p != nil {
 		if err := p.writeField1(ctx, oprot); err != nil {
 			return err
 		}
+		if err := p.writeField2(ctx, oprot); err != nil {
+			return err
+		}
 	}
 	if err := oprot.WriteFieldStop(ctx); err != nil {
 		return thrift.PrependError("write field stop error: ", err)
@@ -8255,550 +9040,14110 @@ func (p *CheckFirmwareVersionResult_) Write(ctx context.Context, oprot thrift.TP
 	return nil
 }
 
-func (p *CheckFirmwareVersionResult_) writeField1(ctx context.Context, oprot thrift.TProtocol) (err error) {
-	if err := oprot.WriteFieldBegin(ctx, "existStatus", thrift.LIST, 1); err != nil {
-		return thrift.PrependError(fmt.Sprintf("%T write field begin error 1:existStatus: ", p), err)
+func (p *SparseBlob) writeField1(ctx context.Context, oprot thrift.TProtocol) (err error) {
+	if err := oprot.WriteFieldBegin(ctx, "Blob", thrift.STRUCT, 1); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T write field begin error 1:Blob: ", p), err)
 	}
-	if err := oprot.WriteListBegin(ctx, thrift.BOOL, len(p.ExistStatus)); err != nil {
+	if err := p.Blob.Write(ctx, oprot); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T error writing struct: ", p.Blob), err)
+	}
+	if err := oprot.WriteFieldEnd(ctx); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T write field end error 1:Blob: ", p), err)
+	}
+	return err
+}
+
+func (p *SparseBlob) writeField2(ctx context.Context, oprot thrift.TProtocol) (err error) {
+	if err := oprot.WriteFieldBegin(ctx, "Coverage", thrift.LIST, 2); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T write field begin error 2:Coverage: ", p), err)
+	}
+	if err := oprot.WriteListBegin(ctx, thrift.STRUCT, len(p.Coverage)); err != nil {
 		return thrift.PrependError("error writing list begin: ", err)
 	}
-	for _, v := range p.ExistStatus {
-		if err := oprot.WriteBool(ctx, bool(v)); err != nil {
-			return thrift.PrependError(fmt.Sprintf("%T. (0) field write error: ", p), err)
+	for _, v := range p.Coverage {
+		if err := v.Write(ctx, oprot); err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T error writing struct: ", v), err)
 		}
 	}
 	if err := oprot.WriteListEnd(ctx); err != nil {
 		return thrift.PrependError("error writing list end: ", err)
 	}
 	if err := oprot.WriteFieldEnd(ctx); err != nil {
-		return thrift.PrependError(fmt.Sprintf("%T write field end error 1:existStatus: ", p), err)
+		return thrift.PrependError(fmt.Sprintf("%T write field end error 2:Coverage: ", p), err)
 	}
 	return err
 }
 
-func (p *CheckFirmwareVersionResult_) Equals(other *CheckFirmwareVersionResult_) bool {
+func (p *SparseBlob) Equals(other *SparseBlob) bool {
 	if p == other {
 		return true
 	} else if p == nil || other == nil {
 		return false
 	}
-	if len(p.ExistStatus) != len(other.ExistStatus) {
+	if !p.Blob.Equals(other.Blob) {
 		return false
 	}
-	for i, _tgt := range p.ExistStatus {
-		_src19 := other.ExistStatus[i]
-		if _tgt != _src19 {
+	if len(p.Coverage) != len(other.Coverage) {
+		return false
+	}
+	for i, _tgt := range p.Coverage {
+		_src36 := other.Coverage[i]
+		if !_tgt.Equals(_src36) {
 			return false
 		}
 	}
 	return true
 }
 
-func (p *CheckFirmwareVersionResult_) String() string {
+func (p *SparseBlob) String() string {
 	if p == nil {
 		return "<nil>"
 	}
-	return fmt.Sprintf("CheckFirmwareVersionResult_(%+v)", *p)
-}
-
-type AttestationFailureAnalyzerService interface {
-	// Parameters:
-	//  - Request
-	SearchFirmware(ctx context.Context, request *SearchFirmwareRequest) (r *SearchFirmwareResult_, err error)
-	// Parameters:
-	//  - Request
-	SearchReport(ctx context.Context, request *SearchReportRequest) (r *SearchReportResult_, err error)
-	// Parameters:
-	//  - Request
-	Analyze(ctx context.Context, request *AnalyzeRequest) (r *AnalyzeResult_, err error)
-	// Parameters:
-	//  - Request
-	CheckFirmwareVersion(ctx context.Context, request *CheckFirmwareVersionRequest) (r *CheckFirmwareVersionResult_, err error)
+	return fmt.Sprintf("SparseBlob(%+v)", *p)
 }
 
-type AttestationFailureAnalyzerServiceClient struct {
-	c    thrift.TClient
-	meta thrift.ResponseMeta
+// Attributes:
+//   - Method
+//   - Quality
+//   - UnstableRanges
+//   - Fallback
+//   - FallbackError
+type FirmwareDumpInfo struct {
+	Method         FirmwareDumpMethod  `thrift:"Method,1" db:"Method" json:"Method"`
+	Quality        FirmwareDumpQuality `thrift:"Quality,2" db:"Quality" json:"Quality"`
+	UnstableRanges []*ByteRange        `thrift:"UnstableRanges,3" db:"UnstableRanges" json:"UnstableRanges"`
+	Fallback       bool                `thrift:"Fallback,4" db:"Fallback" json:"Fallback"`
+	FallbackError  *string             `thrift:"FallbackError,5" db:"FallbackError" json:"FallbackError,omitempty"`
 }
 
-func NewAttestationFailureAnalyzerServiceClientFactory(t thrift.TTransport, f thrift.TProtocolFactory) *AttestationFailureAnalyzerServiceClient {
-	return &AttestationFailureAnalyzerServiceClient{
-		c: thrift.NewTStandardClient(f.GetProtocol(t), f.GetProtocol(t)),
-	}
+func NewFirmwareDumpInfo() *FirmwareDumpInfo {
+	return &FirmwareDumpInfo{}
 }
 
-func NewAttestationFailureAnalyzerServiceClientProtocol(t thrift.TTransport, iprot thrift.TProtocol, oprot thrift.TProtocol) *AttestationFailureAnalyzerServiceClient {
-	return &AttestationFailureAnalyzerServiceClient{
-		c: thrift.NewTStandardClient(iprot, oprot),
-	}
+func (p *FirmwareDumpInfo) GetMethod() FirmwareDumpMethod {
+	return p.Method
 }
 
-func NewAttestationFailureAnalyzerServiceClient(c thrift.TClient) *AttestationFailureAnalyzerServiceClient {
-	return &AttestationFailureAnalyzerServiceClient{
-		c: c,
-	}
+func (p *FirmwareDumpInfo) GetQuality() FirmwareDumpQuality {
+	return p.Quality
 }
 
-func (p *AttestationFailureAnalyzerServiceClient) Client_() thrift.TClient {
-	return p.c
+func (p *FirmwareDumpInfo) GetUnstableRanges() []*ByteRange {
+	return p.UnstableRanges
 }
 
-func (p *AttestationFailureAnalyzerServiceClient) LastResponseMeta_() thrift.ResponseMeta {
-	return p.meta
+func (p *FirmwareDumpInfo) GetFallback() bool {
+	return p.Fallback
 }
 
-func (p *AttestationFailureAnalyzerServiceClient) SetLastResponseMeta_(meta thrift.ResponseMeta) {
-	p.meta = meta
-}
+var FirmwareDumpInfo_FallbackError_DEFAULT string
 
-// Parameters:
-//   - Request
-func (p *AttestationFailureAnalyzerServiceClient) SearchFirmware(ctx context.Context, request *SearchFirmwareRequest) (r *SearchFirmwareResult_, err error) {
-	var _args20 AttestationFailureAnalyzerServiceSearchFirmwareArgs
-	_args20.Request = request
-	var _result21 AttestationFailureAnalyzerServiceSearchFirmwareResult
-	var meta thrift.ResponseMeta
-	meta, err = p.Client_().Call(ctx, "SearchFirmware", &_args20, &_result21)
-	p.SetLastResponseMeta_(meta)
-	if err != nil {
-		return
+func (p *FirmwareDumpInfo) GetFallbackError() string {
+	if !p.IsSetFallbackError() {
+		return FirmwareDumpInfo_FallbackError_DEFAULT
 	}
-	return _result21.GetSuccess(), nil
+	return *p.FallbackError
 }
-
-// Parameters:
-//   - Request
-func (p *AttestationFailureAnalyzerServiceClient) SearchReport(ctx context.Context, request *SearchReportRequest) (r *SearchReportResult_, err error) {
-	var _args22 AttestationFailureAnalyzerServiceSearchReportArgs
-	_args22.Request = request
-	var _result23 AttestationFailureAnalyzerServiceSearchReportResult
-	var meta thrift.ResponseMeta
-	meta, err = p.Client_().Call(ctx, "SearchReport", &_args22, &_result23)
-	p.SetLastResponseMeta_(meta)
-	if err != nil {
-		return
-	}
-	return _result23.GetSuccess(), nil
+func (p *FirmwareDumpInfo) IsSetFallbackError() bool {
+	return p.FallbackError != nil
 }
 
-// Parameters:
-//   - Request
-func (p *AttestationFailureAnalyzerServiceClient) Analyze(ctx context.Context, request *AnalyzeRequest) (r *AnalyzeResult_, err error) {
-	var _args24 AttestationFailureAnalyzerServiceAnalyzeArgs
-	_args24.Request = request
-	var _result25 AttestationFailureAnalyzerServiceAnalyzeResult
-	var meta thrift.ResponseMeta
-	meta, err = p.Client_().Call(ctx, "Analyze", &_args24, &_result25)
-	p.SetLastResponseMeta_(meta)
-	if err != nil {
-		return
+func (p *FirmwareDumpInfo) Read(ctx context.Context, iprot thrift.TProtocol) error {
+	if _, err := iprot.ReadStructBegin(ctx); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T read error: ", p), err)
 	}
-	return _result25.GetSuccess(), nil
-}
 
-// Parameters:
-//   - Request
-func (p *AttestationFailureAnalyzerServiceClient) CheckFirmwareVersion(ctx context.Context, request *CheckFirmwareVersionRequest) (r *CheckFirmwareVersionResult_, err error) {
-	var _args26 AttestationFailureAnalyzerServiceCheckFirmwareVersionArgs
-	_args26.Request = request
-	var _result27 AttestationFailureAnalyzerServiceCheckFirmwareVersionResult
-	var meta thrift.ResponseMeta
-	meta, err = p.Client_().Call(ctx, "CheckFirmwareVersion", &_args26, &_result27)
-	p.SetLastResponseMeta_(meta)
-	if err != nil {
-		return
-	}
-	return _result27.GetSuccess(), nil
-}
+	for {
+		_, fieldTypeId, fieldId, err := iprot.ReadFieldBegin(ctx)
+		if err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T field %d read error: ", p, fieldId), err)
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+		switch fieldId {
+		case 1:
+			if fieldTypeId == thrift.I32 {
+				if err := p.ReadField1(ctx, iprot); err != nil {
+					return err
+				}
+			} else {
+				if err := iprot.Skip(ctx, fieldTypeId); err != nil {
+					return err
+				}
+			}
+		case 2:
+			if fieldTypeId == thrift.I32 {
+				if err := p.ReadField2(ctx, iprot); err != nil {
+					return err
+				}
+			} else {
+				if err := iprot.Skip(ctx, fieldTypeId); err != nil {
+					return err
+				}
+			}
+		case 3:
+			if fieldTypeId == thrift.LIST {
+				if err := p.ReadField3(ctx, iprot); err != nil {
+					return err
+				}
+			} else {
+				if err := iprot.Skip(ctx, fieldTypeId); err != nil {
+					return err
+				}
+			}
+		case 4:
+			if fieldTypeId == thrift.BOOL {
+				if err := p.ReadField4(ctx, iprot); err != nil {
+					return err
+				}
+			} else {
+				if err := iprot.Skip(ctx, fieldTypeId); err != nil {
+					return err
+				}
+			}
+		case 5:
+			if fieldTypeId == thrift.STRING {
+				if err := p.ReadField5(ctx, iprot); err != nil {
+					return err
+				}
+			} else {
+				if err := iprot.Skip(ctx, fieldTypeId); err != nil {
+					return err
+				}
+			}
+		default:
+			if err := iprot.Skip(ctx, fieldTypeId); err != nil {
+				return err
+			}
+		}
+		if err := iprot.ReadFieldEnd(ctx); err != nil {
+			return err
+		}
+	}
+	if err := iprot.ReadStructEnd(ctx); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
+	}
+	return nil
+}
+
+func (p *FirmwareDumpInfo) ReadField1(ctx context.Context, iprot thrift.TProtocol) error {
+	if v, err := iprot.ReadI32(ctx); err != nil {
+		return thrift.PrependError("error reading field 1: ", err)
+	} else {
+		temp := FirmwareDumpMethod(v)
+		p.Method = temp
+	}
+	return nil
+}
+
+func (p *FirmwareDumpInfo) ReadField2(ctx context.Context, iprot thrift.TProtocol) error {
+	if v, err := iprot.ReadI32(ctx); err != nil {
+		return thrift.PrependError("error reading field 2: ", err)
+	} else {
+		temp := FirmwareDumpQuality(v)
+		p.Quality = temp
+	}
+	return nil
+}
+
+func (p *FirmwareDumpInfo) ReadField3(ctx context.Context, iprot thrift.TProtocol) error {
+	_, size, err := iprot.ReadListBegin(ctx)
+	if err != nil {
+		return thrift.PrependError("error reading list begin: ", err)
+	}
+	tSlice := make([]*ByteRange, 0, size)
+	p.UnstableRanges = tSlice
+	for i := 0; i < size; i++ {
+		_elem37 := &ByteRange{}
+		if err := _elem37.Read(ctx, iprot); err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T error reading struct: ", _elem37), err)
+		}
+		p.UnstableRanges = append(p.UnstableRanges, _elem37)
+	}
+	if err := iprot.ReadListEnd(ctx); err != nil {
+		return thrift.PrependError("error reading list end: ", err)
+	}
+	return nil
+}
+
+func (p *FirmwareDumpInfo) ReadField4(ctx context.Context, iprot thrift.TProtocol) error {
+	if v, err := iprot.ReadBool(ctx); err != nil {
+		return thrift.PrependError("error reading field 4: ", err)
+	} else {
+		p.Fallback = v
+	}
+	return nil
+}
+
+func (p *FirmwareDumpInfo) ReadField5(ctx context.Context, iprot thrift.TProtocol) error {
+	if v, err := iprot.ReadString(ctx); err != nil {
+		return thrift.PrependError("error reading field 5: ", err)
+	} else {
+		p.FallbackError = &v
+	}
+	return nil
+}
+
+func (p *FirmwareDumpInfo) Write(ctx context.Context, oprot thrift.TProtocol) error {
+	if err := oprot.WriteStructBegin(ctx, "FirmwareDumpInfo"); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
+	}
+	if p != nil {
+		if err := p.writeField1(ctx, oprot); err != nil {
+			return err
+		}
+		if err := p.writeField2(ctx, oprot); err != nil {
+			return err
+		}
+		if err := p.writeField3(ctx, oprot); err != nil {
+			return err
+		}
+		if err := p.writeField4(ctx, oprot); err != nil {
+			return err
+		}
+		if err := p.writeField5(ctx, oprot); err != nil {
+			return err
+		}
+	}
+	if err := oprot.WriteFieldStop(ctx); err != nil {
+		return thrift.PrependError("write field stop error: ", err)
+	}
+	if err := oprot.WriteStructEnd(ctx); err != nil {
+		return thrift.PrependError("write struct stop error: ", err)
+	}
+	return nil
+}
+
+func (p *FirmwareDumpInfo) writeField1(ctx context.Context, oprot thrift.TProtocol) (err error) {
+	if err := oprot.WriteFieldBegin(ctx, "Method", thrift.I32, 1); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T write field begin error 1:Method: ", p), err)
+	}
+	if err := oprot.WriteI32(ctx, int32(p.Method)); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T.Method (1) field write error: ", p), err)
+	}
+	if err := oprot.WriteFieldEnd(ctx); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T write field end error 1:Method: ", p), err)
+	}
+	return err
+}
+
+func (p *FirmwareDumpInfo) writeField2(ctx context.Context, oprot thrift.TProtocol) (err error) {
+	if err := oprot.WriteFieldBegin(ctx, "Quality", thrift.I32, 2); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T write field begin error 2:Quality: ", p), err)
+	}
+	if err := oprot.WriteI32(ctx, int32(p.Quality)); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T.Quality (2) field write error: ", p), err)
+	}
+	if err := oprot.WriteFieldEnd(ctx); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T write field end error 2:Quality: ", p), err)
+	}
+	return err
+}
+
+func (p *FirmwareDumpInfo) writeField3(ctx context.Context, oprot thrift.TProtocol) (err error) {
+	if err := oprot.WriteFieldBegin(ctx, "UnstableRanges", thrift.LIST, 3); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T write field begin error 3:UnstableRanges: ", p), err)
+	}
+	if err := oprot.WriteListBegin(ctx, thrift.STRUCT, len(p.UnstableRanges)); err != nil {
+		return thrift.PrependError("error writing list begin: ", err)
+	}
+	for _, v := range p.UnstableRanges {
+		if err := v.Write(ctx, oprot); err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T error writing struct: ", v), err)
+		}
+	}
+	if err := oprot.WriteListEnd(ctx); err != nil {
+		return thrift.PrependError("error writing list end: ", err)
+	}
+	if err := oprot.WriteFieldEnd(ctx); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T write field end error 3:UnstableRanges: ", p), err)
+	}
+	return err
+}
+
+func (p *FirmwareDumpInfo) writeField4(ctx context.Context, oprot thrift.TProtocol) (err error) {
+	if err := oprot.WriteFieldBegin(ctx, "Fallback", thrift.BOOL, 4); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T write field begin error 4:Fallback: ", p), err)
+	}
+	if err := oprot.WriteBool(ctx, bool(p.Fallback)); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T.Fallback (4) field write error: ", p), err)
+	}
+	if err := oprot.WriteFieldEnd(ctx); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T write field end error 4:Fallback: ", p), err)
+	}
+	return err
+}
+
+func (p *FirmwareDumpInfo) writeField5(ctx context.Context, oprot thrift.TProtocol) (err error) {
+	if p.IsSetFallbackError() {
+		if err := oprot.WriteFieldBegin(ctx, "FallbackError", thrift.STRING, 5); err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T write field begin error 5:FallbackError: ", p), err)
+		}
+		if err := oprot.WriteString(ctx, string(*p.FallbackError)); err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T.FallbackError (5) field write error: ", p), err)
+		}
+		if err := oprot.WriteFieldEnd(ctx); err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T write field end error 5:FallbackError: ", p), err)
+		}
+	}
+	return err
+}
+
+func (p *FirmwareDumpInfo) Equals(other *FirmwareDumpInfo) bool {
+	if p == other {
+		return true
+	} else if p == nil || other == nil {
+		return false
+	}
+	if p.Method != other.Method {
+		return false
+	}
+	if p.Quality != other.Quality {
+		return false
+	}
+	if len(p.UnstableRanges) != len(other.UnstableRanges) {
+		return false
+	}
+	for i, _tgt := range p.UnstableRanges {
+		_src38 := other.UnstableRanges[i]
+		if !_tgt.Equals(_src38) {
+			return false
+		}
+	}
+	if p.Fallback != other.Fallback {
+		return false
+	}
+	if p.FallbackError != other.FallbackError {
+		if p.FallbackError == nil || other.FallbackError == nil {
+			return false
+		}
+		if (*p.FallbackError) != (*other.FallbackError) {
+			return false
+		}
+	}
+	return true
+}
+
+func (p *FirmwareDumpInfo) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("FirmwareDumpInfo(%+v)", *p)
+}
+
+// Attributes:
+//   - Blob
+//   - BlobStorageKey
+//   - FirmwareVersion
+//   - DeltaBlob
+//   - SparseBlob
+type FirmwareImage struct {
+	Blob            *CompressedBlob  `thrift:"Blob,1" db:"Blob" json:"Blob,omitempty"`
+	BlobStorageKey  *string          `thrift:"BlobStorageKey,2" db:"BlobStorageKey" json:"BlobStorageKey,omitempty"`
+	FirmwareVersion *FirmwareVersion `thrift:"FirmwareVersion,3" db:"FirmwareVersion" json:"FirmwareVersion,omitempty"`
+	DeltaBlob       *DeltaBlob       `thrift:"DeltaBlob,4" db:"DeltaBlob" json:"DeltaBlob,omitempty"`
+	SparseBlob      *SparseBlob      `thrift:"SparseBlob,5" db:"SparseBlob" json:"SparseBlob,omitempty"`
+}
+
+func NewFirmwareImage() *FirmwareImage {
+	return &FirmwareImage{}
+}
+
+var FirmwareImage_Blob_DEFAULT *CompressedBlob
+
+func (p *FirmwareImage) GetBlob() *CompressedBlob {
+	if !p.IsSetBlob() {
+		return FirmwareImage_Blob_DEFAULT
+	}
+	return p.Blob
+}
+
+var FirmwareImage_BlobStorageKey_DEFAULT string
+
+func (p *FirmwareImage) GetBlobStorageKey() string {
+	if !p.IsSetBlobStorageKey() {
+		return FirmwareImage_BlobStorageKey_DEFAULT
+	}
+	return *p.BlobStorageKey
+}
+
+var FirmwareImage_FirmwareVersion_DEFAULT *FirmwareVersion
+
+func (p *FirmwareImage) GetFirmwareVersion() *FirmwareVersion {
+	if !p.IsSetFirmwareVersion() {
+		return FirmwareImage_FirmwareVersion_DEFAULT
+	}
+	return p.FirmwareVersion
+}
+
+var FirmwareImage_DeltaBlob_DEFAULT *DeltaBlob
+
+func (p *FirmwareImage) GetDeltaBlob() *DeltaBlob {
+	if !p.IsSetDeltaBlob() {
+		return FirmwareImage_DeltaBlob_DEFAULT
+	}
+	return p.DeltaBlob
+}
+
+var FirmwareImage_SparseBlob_DEFAULT *SparseBlob
+
+func (p *FirmwareImage) GetSparseBlob() *SparseBlob {
+	if !p.IsSetSparseBlob() {
+		return FirmwareImage_SparseBlob_DEFAULT
+	}
+	return p.SparseBlob
+}
+func (p *FirmwareImage) CountSetFieldsFirmwareImage() int {
+	count := 0
+	if p.IsSetBlob() {
+		count++
+	}
+	if p.IsSetBlobStorageKey() {
+		count++
+	}
+	if p.IsSetFirmwareVersion() {
+		count++
+	}
+	if p.IsSetDeltaBlob() {
+		count++
+	}
+	if p.IsSetSparseBlob() {
+		count++
+	}
+	return count
+
+}
+
+func (p *FirmwareImage) IsSetBlob() bool {
+	return p.Blob != nil
+}
+
+func (p *FirmwareImage) IsSetBlobStorageKey() bool {
+	return p.BlobStorageKey != nil
+}
+
+func (p *FirmwareImage) IsSetFirmwareVersion() bool {
+	return p.FirmwareVersion != nil
+}
+
+func (p *FirmwareImage) IsSetDeltaBlob() bool {
+	return p.DeltaBlob != nil
+}
+
+func (p *FirmwareImage) IsSetSparseBlob() bool {
+	return p.SparseBlob != nil
+}
+
+func (p *FirmwareImage) Read(ctx context.Context, iprot thrift.TProtocol) error {
+	if _, err := iprot.ReadStructBegin(ctx); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T read error: ", p), err)
+	}
+
+	for {
+		_, fieldTypeId, fieldId, err := iprot.ReadFieldBegin(ctx)
+		if err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T field %d read error: ", p, fieldId), err)
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+		switch fieldId {
+		case 1:
+			if fieldTypeId == thrift.STRUCT {
+				if err := p.ReadField1(ctx, iprot); err != nil {
+					return err
+				}
+			} else {
+				if err := iprot.Skip(ctx, fieldTypeId); err != nil {
+					return err
+				}
+			}
+		case 2:
+			if fieldTypeId == thrift.STRING {
+				if err := p.ReadField2(ctx, iprot); err != nil {
+					return err
+				}
+			} else {
+				if err := iprot.Skip(ctx, fieldTypeId); err != nil {
+					return err
+				}
+			}
+		case 3:
+			if fieldTypeId == thrift.STRUCT {
+				if err := p.ReadField3(ctx, iprot); err != nil {
+					return err
+				}
+			} else {
+				if err := iprot.Skip(ctx, fieldTypeId); err != nil {
+					return err
+				}
+			}
+		case 4:
+			if fieldTypeId == thrift.STRUCT {
+				if err := p.ReadField4(ctx, iprot); err != nil {
+					return err
+				}
+			} else {
+				if err := iprot.Skip(ctx, fieldTypeId); err != nil {
+					return err
+				}
+			}
+		case 5:
+			if fieldTypeId == thrift.STRUCT {
+				if err := p.ReadField5(ctx, iprot); err != nil {
+					return err
+				}
+			} else {
+				if err := iprot.Skip(ctx, fieldTypeId); err != nil {
+					return err
+				}
+			}
+		default:
+			if err := iprot.Skip(ctx, fieldTypeId); err != nil {
+				return err
+			}
+		}
+		if err := iprot.ReadFieldEnd(ctx); err != nil {
+			return err
+		}
+	}
+	if err := iprot.ReadStructEnd(ctx); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
+	}
+	return nil
+}
+
+func (p *FirmwareImage) ReadField1(ctx context.Context, iprot thrift.TProtocol) error {
+	p.Blob = &CompressedBlob{}
+	if err := p.Blob.Read(ctx, iprot); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T error reading struct: ", p.Blob), err)
+	}
+	return nil
+}
+
+func (p *FirmwareImage) ReadField2(ctx context.Context, iprot thrift.TProtocol) error {
+	if v, err := iprot.ReadString(ctx); err != nil {
+		return thrift.PrependError("error reading field 2: ", err)
+	} else {
+		p.BlobStorageKey = &v
+	}
+	return nil
+}
+
+func (p *FirmwareImage) ReadField3(ctx context.Context, iprot thrift.TProtocol) error {
+	p.FirmwareVersion = &FirmwareVersion{}
+	if err := p.FirmwareVersion.Read(ctx, iprot); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T error reading struct: ", p.FirmwareVersion), err)
+	}
+	return nil
+}
+
+func (p *FirmwareImage) ReadField4(ctx context.Context, iprot thrift.TProtocol) error {
+	p.DeltaBlob = &DeltaBlob{}
+	if err := p.DeltaBlob.Read(ctx, iprot); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T error reading struct: ", p.DeltaBlob), err)
+	}
+	return nil
+}
+
+func (p *FirmwareImage) ReadField5(ctx context.Context, iprot thrift.TProtocol) error {
+	p.SparseBlob = &SparseBlob{}
+	if err := p.SparseBlob.Read(ctx, iprot); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T error reading struct: ", p.SparseBlob), err)
+	}
+	return nil
+}
+
+func (p *FirmwareImage) Write(ctx context.Context, oprot thrift.TProtocol) error {
+	if c := p.CountSetFieldsFirmwareImage(); c != 1 {
+		return fmt.Errorf("%T write union: exactly one field must be set (%d set).", p, c)
+	}
+	if err := oprot.WriteStructBegin(ctx, "FirmwareImage"); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
+	}
+	if p != nil {
+		if err := p.writeField1(ctx, oprot); err != nil {
+			return err
+		}
+		if err := p.writeField2(ctx, oprot); err != nil {
+			return err
+		}
+		if err := p.writeField3(ctx, oprot); err != nil {
+			return err
+		}
+		if err := p.writeField4(ctx, oprot); err != nil {
+			return err
+		}
+		if err := p.writeField5(ctx, oprot); err != nil {
+			return err
+		}
+	}
+	if err := oprot.WriteFieldStop(ctx); err != nil {
+		return thrift.PrependError("write field stop error: ", err)
+	}
+	if err := oprot.WriteStructEnd(ctx); err != nil {
+		return thrift.PrependError("write struct stop error: ", err)
+	}
+	return nil
+}
+
+func (p *FirmwareImage) writeField1(ctx context.Context, oprot thrift.TProtocol) (err error) {
+	if p.IsSetBlob() {
+		if err := oprot.WriteFieldBegin(ctx, "Blob", thrift.STRUCT, 1); err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T write field begin error 1:Blob: ", p), err)
+		}
+		if err := p.Blob.Write(ctx, oprot); err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T error writing struct: ", p.Blob), err)
+		}
+		if err := oprot.WriteFieldEnd(ctx); err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T write field end error 1:Blob: ", p), err)
+		}
+	}
+	return err
+}
+
+func (p *FirmwareImage) writeField2(ctx context.Context, oprot thrift.TProtocol) (err error) {
+	if p.IsSetBlobStorageKey() {
+		if err := oprot.WriteFieldBegin(ctx, "BlobStorageKey", thrift.STRING, 2); err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T write field begin error 2:BlobStorageKey: ", p), err)
+		}
+		if err := oprot.WriteString(ctx, string(*p.BlobStorageKey)); err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T.BlobStorageKey (2) field write error: ", p), err)
+		}
+		if err := oprot.WriteFieldEnd(ctx); err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T write field end error 2:BlobStorageKey: ", p), err)
+		}
+	}
+	return err
+}
+
+func (p *FirmwareImage) writeField3(ctx context.Context, oprot thrift.TProtocol) (err error) {
+	if p.IsSetFirmwareVersion() {
+		if err := oprot.WriteFieldBegin(ctx, "FirmwareVersion", thrift.STRUCT, 3); err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T write field begin error 3:FirmwareVersion: ", p), err)
+		}
+		if err := p.FirmwareVersion.Write(ctx, oprot); err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T error writing struct: ", p.FirmwareVersion), err)
+		}
+		if err := oprot.WriteFieldEnd(ctx); err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T write field end error 3:FirmwareVersion: ", p), err)
+		}
+	}
+	return err
+}
+
+func (p *FirmwareImage) writeField4(ctx context.Context, oprot thrift.TProtocol) (err error) {
+	if p.IsSetDeltaBlob() {
+		if err := oprot.WriteFieldBegin(ctx, "DeltaBlob", thrift.STRUCT, 4); err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T write field begin error 4:DeltaBlob: ", p), err)
+		}
+		if err := p.DeltaBlob.Write(ctx, oprot); err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T error writing struct: ", p.DeltaBlob), err)
+		}
+		if err := oprot.WriteFieldEnd(ctx); err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T write field end error 4:DeltaBlob: ", p), err)
+		}
+	}
+	return err
+}
+
+func (p *FirmwareImage) writeField5(ctx context.Context, oprot thrift.TProtocol) (err error) {
+	if p.IsSetSparseBlob() {
+		if err := oprot.WriteFieldBegin(ctx, "SparseBlob", thrift.STRUCT, 5); err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T write field begin error 5:SparseBlob: ", p), err)
+		}
+		if err := p.SparseBlob.Write(ctx, oprot); err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T error writing struct: ", p.SparseBlob), err)
+		}
+		if err := oprot.WriteFieldEnd(ctx); err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T write field end error 5:SparseBlob: ", p), err)
+		}
+	}
+	return err
+}
+
+func (p *FirmwareImage) Equals(other *FirmwareImage) bool {
+	if p == other {
+		return true
+	} else if p == nil || other == nil {
+		return false
+	}
+	if !p.Blob.Equals(other.Blob) {
+		return false
+	}
+	if p.BlobStorageKey != other.BlobStorageKey {
+		if p.BlobStorageKey == nil || other.BlobStorageKey == nil {
+			return false
+		}
+		if (*p.BlobStorageKey) != (*other.BlobStorageKey) {
+			return false
+		}
+	}
+	if !p.FirmwareVersion.Equals(other.FirmwareVersion) {
+		return false
+	}
+	if !p.DeltaBlob.Equals(other.DeltaBlob) {
+		return false
+	}
+	if !p.SparseBlob.Equals(other.SparseBlob) {
+		return false
+	}
+	return true
+}
+
+func (p *FirmwareImage) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("FirmwareImage(%+v)", *p)
+}
+
+// Attributes:
+//   - Value
+//   - Index
+type PCR struct {
+	Value []byte `thrift:"Value,1" db:"Value" json:"Value"`
+	Index int32  `thrift:"Index,2" db:"Index" json:"Index"`
+}
+
+func NewPCR() *PCR {
+	return &PCR{}
+}
+
+func (p *PCR) GetValue() []byte {
+	return p.Value
+}
+
+func (p *PCR) GetIndex() int32 {
+	return p.Index
+}
+func (p *PCR) Read(ctx context.Context, iprot thrift.TProtocol) error {
+	if _, err := iprot.ReadStructBegin(ctx); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T read error: ", p), err)
+	}
+
+	for {
+		_, fieldTypeId, fieldId, err := iprot.ReadFieldBegin(ctx)
+		if err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T field %d read error: ", p, fieldId), err)
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+		switch fieldId {
+		case 1:
+			if fieldTypeId == thrift.STRING {
+				if err := p.ReadField1(ctx, iprot); err != nil {
+					return err
+				}
+			} else {
+				if err := iprot.Skip(ctx, fieldTypeId); err != nil {
+					return err
+				}
+			}
+		case 2:
+			if fieldTypeId == thrift.I32 {
+				if err := p.ReadField2(ctx, iprot); err != nil {
+					return err
+				}
+			} else {
+				if err := iprot.Skip(ctx, fieldTypeId); err != nil {
+					return err
+				}
+			}
+		default:
+			if err := iprot.Skip(ctx, fieldTypeId); err != nil {
+				return err
+			}
+		}
+		if err := iprot.ReadFieldEnd(ctx); err != nil {
+			return err
+		}
+	}
+	if err := iprot.ReadStructEnd(ctx); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
+	}
+	return nil
+}
+
+func (p *PCR) ReadField1(ctx context.Context, iprot thrift.TProtocol) error {
+	if v, err := iprot.ReadBinary(ctx); err != nil {
+		return thrift.PrependError("error reading field 1: ", err)
+	} else {
+		p.Value = v
+	}
+	return nil
+}
+
+func (p *PCR) ReadField2(ctx context.Context, iprot thrift.TProtocol) error {
+	if v, err := iprot.ReadI32(ctx); err != nil {
+		return thrift.PrependError("error reading field 2: ", err)
+	} else {
+		p.Index = v
+	}
+	return nil
+}
+
+func (p *PCR) Write(ctx context.Context, oprot thrift.TProtocol) error {
+	if err := oprot.WriteStructBegin(ctx, "PCR"); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
+	}
+	if p != nil {
+		if err := p.writeField1(ctx, oprot); err != nil {
+			return err
+		}
+		if err := p.writeField2(ctx, oprot); err != nil {
+			return err
+		}
+	}
+	if err := oprot.WriteFieldStop(ctx); err != nil {
+		return thrift.PrependError("write field stop error: ", err)
+	}
+	if err := oprot.WriteStructEnd(ctx); err != nil {
+		return thrift.PrependError("write struct stop error: ", err)
+	}
+	return nil
+}
+
+func (p *PCR) writeField1(ctx context.Context, oprot thrift.TProtocol) (err error) {
+	if err := oprot.WriteFieldBegin(ctx, "Value", thrift.STRING, 1); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T write field begin error 1:Value: ", p), err)
+	}
+	if err := oprot.WriteBinary(ctx, p.Value); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T.Value (1) field write error: ", p), err)
+	}
+	if err := oprot.WriteFieldEnd(ctx); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T write field end error 1:Value: ", p), err)
+	}
+	return err
+}
+
+func (p *PCR) writeField2(ctx context.Context, oprot thrift.TProtocol) (err error) {
+	if err := oprot.WriteFieldBegin(ctx, "Index", thrift.I32, 2); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T write field begin error 2:Index: ", p), err)
+	}
+	if err := oprot.WriteI32(ctx, int32(p.Index)); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T.Index (2) field write error: ", p), err)
+	}
+	if err := oprot.WriteFieldEnd(ctx); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T write field end error 2:Index: ", p), err)
+	}
+	return err
+}
+
+func (p *PCR) Equals(other *PCR) bool {
+	if p == other {
+		return true
+	} else if p == nil || other == nil {
+		return false
+	}
+	if bytes.Compare(p.Value, other.Value) != 0 {
+		return false
+	}
+	if p.Index != other.Index {
+		return false
+	}
+	return true
+}
+
+func (p *PCR) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("PCR(%+v)", *p)
+}
+
+// Attributes:
+//   - FwImage
+//   - Pcr
+//   - TPMDevice
+//   - TPMEventLog
+//   - StatusRegisters
+//   - MeasurementsFlow
+//   - EvidenceBundle
+//   - FirmwareDumpInfo
+type Artifact struct {
+	FwImage          *FirmwareImage     `thrift:"FwImage,1" db:"FwImage" json:"FwImage,omitempty"`
+	Pcr              *PCR               `thrift:"Pcr,2" db:"Pcr" json:"Pcr,omitempty"`
+	TPMDevice        *TPMType           `thrift:"TPMDevice,3" db:"TPMDevice" json:"TPMDevice,omitempty"`
+	TPMEventLog      *tpm.EventLog      `thrift:"TPMEventLog,4" db:"TPMEventLog" json:"TPMEventLog,omitempty"`
+	StatusRegisters  []*StatusRegister  `thrift:"StatusRegisters,5" db:"StatusRegisters" json:"StatusRegisters,omitempty"`
+	MeasurementsFlow *measurements.Flow `thrift:"MeasurementsFlow,6" db:"MeasurementsFlow" json:"MeasurementsFlow,omitempty"`
+	EvidenceBundle   []byte             `thrift:"EvidenceBundle,7" db:"EvidenceBundle" json:"EvidenceBundle,omitempty"`
+	FirmwareDumpInfo *FirmwareDumpInfo  `thrift:"FirmwareDumpInfo,8" db:"FirmwareDumpInfo" json:"FirmwareDumpInfo,omitempty"`
+}
+
+func NewArtifact() *Artifact {
+	return &Artifact{}
+}
+
+var Artifact_FwImage_DEFAULT *FirmwareImage
+
+func (p *Artifact) GetFwImage() *FirmwareImage {
+	if !p.IsSetFwImage() {
+		return Artifact_FwImage_DEFAULT
+	}
+	return p.FwImage
+}
+
+var Artifact_Pcr_DEFAULT *PCR
+
+func (p *Artifact) GetPcr() *PCR {
+	if !p.IsSetPcr() {
+		return Artifact_Pcr_DEFAULT
+	}
+	return p.Pcr
+}
+
+var Artifact_TPMDevice_DEFAULT TPMType
+
+func (p *Artifact) GetTPMDevice() TPMType {
+	if !p.IsSetTPMDevice() {
+		return Artifact_TPMDevice_DEFAULT
+	}
+	return *p.TPMDevice
+}
+
+var Artifact_TPMEventLog_DEFAULT *tpm.EventLog
+
+func (p *Artifact) GetTPMEventLog() *tpm.EventLog {
+	if !p.IsSetTPMEventLog() {
+		return Artifact_TPMEventLog_DEFAULT
+	}
+	return p.TPMEventLog
+}
+
+var Artifact_StatusRegisters_DEFAULT []*StatusRegister
+
+func (p *Artifact) GetStatusRegisters() []*StatusRegister {
+	return p.StatusRegisters
+}
+
+var Artifact_MeasurementsFlow_DEFAULT measurements.Flow
+
+func (p *Artifact) GetMeasurementsFlow() measurements.Flow {
+	if !p.IsSetMeasurementsFlow() {
+		return Artifact_MeasurementsFlow_DEFAULT
+	}
+	return *p.MeasurementsFlow
+}
+
+var Artifact_EvidenceBundle_DEFAULT []byte
+
+func (p *Artifact) GetEvidenceBundle() []byte {
+	return p.EvidenceBundle
+}
+
+var Artifact_FirmwareDumpInfo_DEFAULT *FirmwareDumpInfo
+
+func (p *Artifact) GetFirmwareDumpInfo() *FirmwareDumpInfo {
+	if !p.IsSetFirmwareDumpInfo() {
+		return Artifact_FirmwareDumpInfo_DEFAULT
+	}
+	return p.FirmwareDumpInfo
+}
+func (p *Artifact) CountSetFieldsArtifact() int {
+	count := 0
+	if p.IsSetFwImage() {
+		count++
+	}
+	if p.IsSetPcr() {
+		count++
+	}
+	if p.IsSetTPMDevice() {
+		count++
+	}
+	if p.IsSetTPMEventLog() {
+		count++
+	}
+	if p.IsSetStatusRegisters() {
+		count++
+	}
+	if p.IsSetMeasurementsFlow() {
+		count++
+	}
+	if p.IsSetEvidenceBundle() {
+		count++
+	}
+	if p.IsSetFirmwareDumpInfo() {
+		count++
+	}
+	return count
+
+}
+
+func (p *Artifact) IsSetFwImage() bool {
+	return p.FwImage != nil
+}
+
+func (p *Artifact) IsSetPcr() bool {
+	return p.Pcr != nil
+}
+
+func (p *Artifact) IsSetTPMDevice() bool {
+	return p.TPMDevice != nil
+}
+
+func (p *Artifact) IsSetTPMEventLog() bool {
+	return p.TPMEventLog != nil
+}
+
+func (p *Artifact) IsSetStatusRegisters() bool {
+	return p.StatusRegisters != nil
+}
+
+func (p *Artifact) IsSetMeasurementsFlow() bool {
+	return p.MeasurementsFlow != nil
+}
+
+func (p *Artifact) IsSetEvidenceBundle() bool {
+	return p.EvidenceBundle != nil
+}
+
+func (p *Artifact) IsSetFirmwareDumpInfo() bool {
+	return p.FirmwareDumpInfo != nil
+}
+
+func (p *Artifact) Read(ctx context.Context, iprot thrift.TProtocol) error {
+	if _, err := iprot.ReadStructBegin(ctx); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T read error: ", p), err)
+	}
+
+	for {
+		_, fieldTypeId, fieldId, err := iprot.ReadFieldBegin(ctx)
+		if err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T field %d read error: ", p, fieldId), err)
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+		switch fieldId {
+		case 1:
+			if fieldTypeId == thrift.STRUCT {
+				if err := p.ReadField1(ctx, iprot); err != nil {
+					return err
+				}
+			} else {
+				if err := iprot.Skip(ctx, fieldTypeId); err != nil {
+					return err
+				}
+			}
+		case 2:
+			if fieldTypeId == thrift.STRUCT {
+				if err := p.ReadField2(ctx, iprot); err != nil {
+					return err
+				}
+			} else {
+				if err := iprot.Skip(ctx, fieldTypeId); err != nil {
+					return err
+				}
+			}
+		case 3:
+			if fieldTypeId == thrift.I32 {
+				if err := p.ReadField3(ctx, iprot); err != nil {
+					return err
+				}
+			} else {
+				if err := iprot.Skip(ctx, fieldTypeId); err != nil {
+					return err
+				}
+			}
+		case 4:
+			if fieldTypeId == thrift.STRUCT {
+				if err := p.ReadField4(ctx, iprot); err != nil {
+					return err
+				}
+			} else {
+				if err := iprot.Skip(ctx, fieldTypeId); err != nil {
+					return err
+				}
+			}
+		case 5:
+			if fieldTypeId == thrift.LIST {
+				if err := p.ReadField5(ctx, iprot); err != nil {
+					return err
+				}
+			} else {
+				if err := iprot.Skip(ctx, fieldTypeId); err != nil {
+					return err
+				}
+			}
+		case 6:
+			if fieldTypeId == thrift.I32 {
+				if err := p.ReadField6(ctx, iprot); err != nil {
+					return err
+				}
+			} else {
+				if err := iprot.Skip(ctx, fieldTypeId); err != nil {
+					return err
+				}
+			}
+		case 7:
+			if fieldTypeId == thrift.STRING {
+				if err := p.ReadField7(ctx, iprot); err != nil {
+					return err
+				}
+			} else {
+				if err := iprot.Skip(ctx, fieldTypeId); err != nil {
+					return err
+				}
+			}
+		case 8:
+			if fieldTypeId == thrift.STRUCT {
+				if err := p.ReadField8(ctx, iprot); err != nil {
+					return err
+				}
+			} else {
+				if err := iprot.Skip(ctx, fieldTypeId); err != nil {
+					return err
+				}
+			}
+		default:
+			if err := iprot.Skip(ctx, fieldTypeId); err != nil {
+				return err
+			}
+		}
+		if err := iprot.ReadFieldEnd(ctx); err != nil {
+			return err
+		}
+	}
+	if err := iprot.ReadStructEnd(ctx); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
+	}
+	return nil
+}
+
+func (p *Artifact) ReadField1(ctx context.Context, iprot thrift.TProtocol) error {
+	p.FwImage = &FirmwareImage{}
+	if err := p.FwImage.Read(ctx, iprot); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T error reading struct: ", p.FwImage), err)
+	}
+	return nil
+}
+
+func (p *Artifact) ReadField2(ctx context.Context, iprot thrift.TProtocol) error {
+	p.Pcr = &PCR{}
+	if err := p.Pcr.Read(ctx, iprot); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T error reading struct: ", p.Pcr), err)
+	}
+	return nil
+}
+
+func (p *Artifact) ReadField3(ctx context.Context, iprot thrift.TProtocol) error {
+	if v, err := iprot.ReadI32(ctx); err != nil {
+		return thrift.PrependError("error reading field 3: ", err)
+	} else {
+		temp := TPMType(v)
+		p.TPMDevice = &temp
+	}
+	return nil
+}
+
+func (p *Artifact) ReadField4(ctx context.Context, iprot thrift.TProtocol) error {
+	p.TPMEventLog = &tpm.EventLog{}
+	if err := p.TPMEventLog.Read(ctx, iprot); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T error reading struct: ", p.TPMEventLog), err)
+	}
+	return nil
+}
+
+func (p *Artifact) ReadField5(ctx context.Context, iprot thrift.TProtocol) error {
+	_, size, err := iprot.ReadListBegin(ctx)
+	if err != nil {
+		return thrift.PrependError("error reading list begin: ", err)
+	}
+	tSlice := make([]*StatusRegister, 0, size)
+	p.StatusRegisters = tSlice
+	for i := 0; i < size; i++ {
+		_elem39 := &StatusRegister{}
+		if err := _elem39.Read(ctx, iprot); err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T error reading struct: ", _elem39), err)
+		}
+		p.StatusRegisters = append(p.StatusRegisters, _elem39)
+	}
+	if err := iprot.ReadListEnd(ctx); err != nil {
+		return thrift.PrependError("error reading list end: ", err)
+	}
+	return nil
+}
+
+func (p *Artifact) ReadField6(ctx context.Context, iprot thrift.TProtocol) error {
+	if v, err := iprot.ReadI32(ctx); err != nil {
+		return thrift.PrependError("error reading field 6: ", err)
+	} else {
+		temp := measurements.Flow(v)
+		p.MeasurementsFlow = &temp
+	}
+	return nil
+}
+
+func (p *Artifact) ReadField7(ctx context.Context, iprot thrift.TProtocol) error {
+	if v, err := iprot.ReadBinary(ctx); err != nil {
+		return thrift.PrependError("error reading field 7: ", err)
+	} else {
+		p.EvidenceBundle = v
+	}
+	return nil
+}
+
+func (p *Artifact) ReadField8(ctx context.Context, iprot thrift.TProtocol) error {
+	p.FirmwareDumpInfo = &FirmwareDumpInfo{}
+	if err := p.FirmwareDumpInfo.Read(ctx, iprot); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T error reading struct: ", p.FirmwareDumpInfo), err)
+	}
+	return nil
+}
+
+func (p *Artifact) Write(ctx context.Context, oprot thrift.TProtocol) error {
+	if c := p.CountSetFieldsArtifact(); c != 1 {
+		return fmt.Errorf("%T write union: exactly one field must be set (%d set).", p, c)
+	}
+	if err := oprot.WriteStructBegin(ctx, "Artifact"); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
+	}
+	if p != nil {
+		if err := p.writeField1(ctx, oprot); err != nil {
+			return err
+		}
+		if err := p.writeField2(ctx, oprot); err != nil {
+			return err
+		}
+		if err := p.writeField3(ctx, oprot); err != nil {
+			return err
+		}
+		if err := p.writeField4(ctx, oprot); err != nil {
+			return err
+		}
+		if err := p.writeField5(ctx, oprot); err != nil {
+			return err
+		}
+		if err := p.writeField6(ctx, oprot); err != nil {
+			return err
+		}
+		if err := p.writeField7(ctx, oprot); err != nil {
+			return err
+		}
+		if err := p.writeField8(ctx, oprot); err != nil {
+			return err
+		}
+	}
+	if err := oprot.WriteFieldStop(ctx); err != nil {
+		return thrift.PrependError("write field stop error: ", err)
+	}
+	if err := oprot.WriteStructEnd(ctx); err != nil {
+		return thrift.PrependError("write struct stop error: ", err)
+	}
+	return nil
+}
+
+func (p *Artifact) writeField1(ctx context.Context, oprot thrift.TProtocol) (err error) {
+	if p.IsSetFwImage() {
+		if err := oprot.WriteFieldBegin(ctx, "FwImage", thrift.STRUCT, 1); err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T write field begin error 1:FwImage: ", p), err)
+		}
+		if err := p.FwImage.Write(ctx, oprot); err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T error writing struct: ", p.FwImage), err)
+		}
+		if err := oprot.WriteFieldEnd(ctx); err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T write field end error 1:FwImage: ", p), err)
+		}
+	}
+	return err
+}
+
+func (p *Artifact) writeField2(ctx context.Context, oprot thrift.TProtocol) (err error) {
+	if p.IsSetPcr() {
+		if err := oprot.WriteFieldBegin(ctx, "Pcr", thrift.STRUCT, 2); err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T write field begin error 2:Pcr: ", p), err)
+		}
+		if err := p.Pcr.Write(ctx, oprot); err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T error writing struct: ", p.Pcr), err)
+		}
+		if err := oprot.WriteFieldEnd(ctx); err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T write field end error 2:Pcr: ", p), err)
+		}
+	}
+	return err
+}
+
+func (p *Artifact) writeField3(ctx context.Context, oprot thrift.TProtocol) (err error) {
+	if p.IsSetTPMDevice() {
+		if err := oprot.WriteFieldBegin(ctx, "TPMDevice", thrift.I32, 3); err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T write field begin error 3:TPMDevice: ", p), err)
+		}
+		if err := oprot.WriteI32(ctx, int32(*p.TPMDevice)); err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T.TPMDevice (3) field write error: ", p), err)
+		}
+		if err := oprot.WriteFieldEnd(ctx); err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T write field end error 3:TPMDevice: ", p), err)
+		}
+	}
+	return err
+}
+
+func (p *Artifact) writeField4(ctx context.Context, oprot thrift.TProtocol) (err error) {
+	if p.IsSetTPMEventLog() {
+		if err := oprot.WriteFieldBegin(ctx, "TPMEventLog", thrift.STRUCT, 4); err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T write field begin error 4:TPMEventLog: ", p), err)
+		}
+		if err := p.TPMEventLog.Write(ctx, oprot); err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T error writing struct: ", p.TPMEventLog), err)
+		}
+		if err := oprot.WriteFieldEnd(ctx); err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T write field end error 4:TPMEventLog: ", p), err)
+		}
+	}
+	return err
+}
+
+func (p *Artifact) writeField5(ctx context.Context, oprot thrift.TProtocol) (err error) {
+	if p.IsSetStatusRegisters() {
+		if err := oprot.WriteFieldBegin(ctx, "StatusRegisters", thrift.LIST, 5); err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T write field begin error 5:StatusRegisters: ", p), err)
+		}
+		if err := oprot.WriteListBegin(ctx, thrift.STRUCT, len(p.StatusRegisters)); err != nil {
+			return thrift.PrependError("error writing list begin: ", err)
+		}
+		for _, v := range p.StatusRegisters {
+			if err := v.Write(ctx, oprot); err != nil {
+				return thrift.PrependError(fmt.Sprintf("%T error writing struct: ", v), err)
+			}
+		}
+		if err := oprot.WriteListEnd(ctx); err != nil {
+			return thrift.PrependError("error writing list end: ", err)
+		}
+		if err := oprot.WriteFieldEnd(ctx); err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T write field end error 5:StatusRegisters: ", p), err)
+		}
+	}
+	return err
+}
+
+func (p *Artifact) writeField6(ctx context.Context, oprot thrift.TProtocol) (err error) {
+	if p.IsSetMeasurementsFlow() {
+		if err := oprot.WriteFieldBegin(ctx, "MeasurementsFlow", thrift.I32, 6); err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T write field begin error 6:MeasurementsFlow: ", p), err)
+		}
+		if err := oprot.WriteI32(ctx, int32(*p.MeasurementsFlow)); err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T.MeasurementsFlow (6) field write error: ", p), err)
+		}
+		if err := oprot.WriteFieldEnd(ctx); err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T write field end error 6:MeasurementsFlow: ", p), err)
+		}
+	}
+	return err
+}
+
+func (p *Artifact) writeField7(ctx context.Context, oprot thrift.TProtocol) (err error) {
+	if p.IsSetEvidenceBundle() {
+		if err := oprot.WriteFieldBegin(ctx, "EvidenceBundle", thrift.STRING, 7); err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T write field begin error 7:EvidenceBundle: ", p), err)
+		}
+		if err := oprot.WriteBinary(ctx, p.EvidenceBundle); err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T.EvidenceBundle (7) field write error: ", p), err)
+		}
+		if err := oprot.WriteFieldEnd(ctx); err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T write field end error 7:EvidenceBundle: ", p), err)
+		}
+	}
+	return err
+}
+
+func (p *Artifact) writeField8(ctx context.Context, oprot thrift.TProtocol) (err error) {
+	if p.IsSetFirmwareDumpInfo() {
+		if err := oprot.WriteFieldBegin(ctx, "FirmwareDumpInfo", thrift.STRUCT, 8); err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T write field begin error 8:FirmwareDumpInfo: ", p), err)
+		}
+		if err := p.FirmwareDumpInfo.Write(ctx, oprot); err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T error writing struct: ", p.FirmwareDumpInfo), err)
+		}
+		if err := oprot.WriteFieldEnd(ctx); err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T write field end error 8:FirmwareDumpInfo: ", p), err)
+		}
+	}
+	return err
+}
+
+func (p *Artifact) Equals(other *Artifact) bool {
+	if p == other {
+		return true
+	} else if p == nil || other == nil {
+		return false
+	}
+	if !p.FwImage.Equals(other.FwImage) {
+		return false
+	}
+	if !p.Pcr.Equals(other.Pcr) {
+		return false
+	}
+	if p.TPMDevice != other.TPMDevice {
+		if p.TPMDevice == nil || other.TPMDevice == nil {
+			return false
+		}
+		if (*p.TPMDevice) != (*other.TPMDevice) {
+			return false
+		}
+	}
+	if !p.TPMEventLog.Equals(other.TPMEventLog) {
+		return false
+	}
+	if len(p.StatusRegisters) != len(other.StatusRegisters) {
+		return false
+	}
+	for i, _tgt := range p.StatusRegisters {
+		_src40 := other.StatusRegisters[i]
+		if !_tgt.Equals(_src40) {
+			return false
+		}
+	}
+	if p.MeasurementsFlow != other.MeasurementsFlow {
+		if p.MeasurementsFlow == nil || other.MeasurementsFlow == nil {
+			return false
+		}
+		if (*p.MeasurementsFlow) != (*other.MeasurementsFlow) {
+			return false
+		}
+	}
+	if bytes.Compare(p.EvidenceBundle, other.EvidenceBundle) != 0 {
+		return false
+	}
+	if !p.FirmwareDumpInfo.Equals(other.FirmwareDumpInfo) {
+		return false
+	}
+	return true
+}
+
+func (p *Artifact) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("Artifact(%+v)", *p)
+}
+
+// Attributes:
+//   - ActualFirmwareImage
+//   - OriginalFirmwareImage
+//   - StatusRegisters
+//   - TPMDevice
+//   - TPMEventLog
+//   - ActualPCR0
+//   - ActualFirmwareDumpInfo
+type DiffMeasuredBootInput struct {
+	ActualFirmwareImage    int32  `thrift:"ActualFirmwareImage,1" db:"ActualFirmwareImage" json:"ActualFirmwareImage"`
+	OriginalFirmwareImage  *int32 `thrift:"OriginalFirmwareImage,2" db:"OriginalFirmwareImage" json:"OriginalFirmwareImage,omitempty"`
+	StatusRegisters        *int32 `thrift:"StatusRegisters,3" db:"StatusRegisters" json:"StatusRegisters,omitempty"`
+	TPMDevice              *int32 `thrift:"TPMDevice,4" db:"TPMDevice" json:"TPMDevice,omitempty"`
+	TPMEventLog            *int32 `thrift:"TPMEventLog,5" db:"TPMEventLog" json:"TPMEventLog,omitempty"`
+	ActualPCR0             *int32 `thrift:"ActualPCR0,6" db:"ActualPCR0" json:"ActualPCR0,omitempty"`
+	ActualFirmwareDumpInfo *int32 `thrift:"ActualFirmwareDumpInfo,7" db:"ActualFirmwareDumpInfo" json:"ActualFirmwareDumpInfo,omitempty"`
+}
+
+func NewDiffMeasuredBootInput() *DiffMeasuredBootInput {
+	return &DiffMeasuredBootInput{}
+}
+
+func (p *DiffMeasuredBootInput) GetActualFirmwareImage() int32 {
+	return p.ActualFirmwareImage
+}
+
+var DiffMeasuredBootInput_OriginalFirmwareImage_DEFAULT int32
+
+func (p *DiffMeasuredBootInput) GetOriginalFirmwareImage() int32 {
+	if !p.IsSetOriginalFirmwareImage() {
+		return DiffMeasuredBootInput_OriginalFirmwareImage_DEFAULT
+	}
+	return *p.OriginalFirmwareImage
+}
+
+var DiffMeasuredBootInput_StatusRegisters_DEFAULT int32
+
+func (p *DiffMeasuredBootInput) GetStatusRegisters() int32 {
+	if !p.IsSetStatusRegisters() {
+		return DiffMeasuredBootInput_StatusRegisters_DEFAULT
+	}
+	return *p.StatusRegisters
+}
+
+var DiffMeasuredBootInput_TPMDevice_DEFAULT int32
+
+func (p *DiffMeasuredBootInput) GetTPMDevice() int32 {
+	if !p.IsSetTPMDevice() {
+		return DiffMeasuredBootInput_TPMDevice_DEFAULT
+	}
+	return *p.TPMDevice
+}
+
+var DiffMeasuredBootInput_TPMEventLog_DEFAULT int32
+
+func (p *DiffMeasuredBootInput) GetTPMEventLog() int32 {
+	if !p.IsSetTPMEventLog() {
+		return DiffMeasuredBootInput_TPMEventLog_DEFAULT
+	}
+	return *p.TPMEventLog
+}
+
+var DiffMeasuredBootInput_ActualPCR0_DEFAULT int32
+
+func (p *DiffMeasuredBootInput) GetActualPCR0() int32 {
+	if !p.IsSetActualPCR0() {
+		return DiffMeasuredBootInput_ActualPCR0_DEFAULT
+	}
+	return *p.ActualPCR0
+}
+
+var DiffMeasuredBootInput_ActualFirmwareDumpInfo_DEFAULT int32
+
+func (p *DiffMeasuredBootInput) GetActualFirmwareDumpInfo() int32 {
+	if !p.IsSetActualFirmwareDumpInfo() {
+		return DiffMeasuredBootInput_ActualFirmwareDumpInfo_DEFAULT
+	}
+	return *p.ActualFirmwareDumpInfo
+}
+func (p *DiffMeasuredBootInput) IsSetOriginalFirmwareImage() bool {
+	return p.OriginalFirmwareImage != nil
+}
+
+func (p *DiffMeasuredBootInput) IsSetStatusRegisters() bool {
+	return p.StatusRegisters != nil
+}
+
+func (p *DiffMeasuredBootInput) IsSetTPMDevice() bool {
+	return p.TPMDevice != nil
+}
+
+func (p *DiffMeasuredBootInput) IsSetTPMEventLog() bool {
+	return p.TPMEventLog != nil
+}
+
+func (p *DiffMeasuredBootInput) IsSetActualPCR0() bool {
+	return p.ActualPCR0 != nil
+}
+
+func (p *DiffMeasuredBootInput) IsSetActualFirmwareDumpInfo() bool {
+	return p.ActualFirmwareDumpInfo != nil
+}
+
+func (p *DiffMeasuredBootInput) Read(ctx context.Context, iprot thrift.TProtocol) error {
+	if _, err := iprot.ReadStructBegin(ctx); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T read error: ", p), err)
+	}
+
+	for {
+		_, fieldTypeId, fieldId, err := iprot.ReadFieldBegin(ctx)
+		if err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T field %d read error: ", p, fieldId), err)
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+		switch fieldId {
+		case 1:
+			if fieldTypeId == thrift.I32 {
+				if err := p.ReadField1(ctx, iprot); err != nil {
+					return err
+				}
+			} else {
+				if err := iprot.Skip(ctx, fieldTypeId); err != nil {
+					return err
+				}
+			}
+		case 2:
+			if fieldTypeId == thrift.I32 {
+				if err := p.ReadField2(ctx, iprot); err != nil {
+					return err
+				}
+			} else {
+				if err := iprot.Skip(ctx, fieldTypeId); err != nil {
+					return err
+				}
+			}
+		case 3:
+			if fieldTypeId == thrift.I32 {
+				if err := p.ReadField3(ctx, iprot); err != nil {
+					return err
+				}
+			} else {
+				if err := iprot.Skip(ctx, fieldTypeId); err != nil {
+					return err
+				}
+			}
+		case 4:
+			if fieldTypeId == thrift.I32 {
+				if err := p.ReadField4(ctx, iprot); err != nil {
+					return err
+				}
+			} else {
+				if err := iprot.Skip(ctx, fieldTypeId); err != nil {
+					return err
+				}
+			}
+		case 5:
+			if fieldTypeId == thrift.I32 {
+				if err := p.ReadField5(ctx, iprot); err != nil {
+					return err
+				}
+			} else {
+				if err := iprot.Skip(ctx, fieldTypeId); err != nil {
+					return err
+				}
+			}
+		case 6:
+			if fieldTypeId == thrift.I32 {
+				if err := p.ReadField6(ctx, iprot); err != nil {
+					return err
+				}
+			} else {
+				if err := iprot.Skip(ctx, fieldTypeId); err != nil {
+					return err
+				}
+			}
+		case 7:
+			if fieldTypeId == thrift.I32 {
+				if err := p.ReadField7(ctx, iprot); err != nil {
+					return err
+				}
+			} else {
+				if err := iprot.Skip(ctx, fieldTypeId); err != nil {
+					return err
+				}
+			}
+		default:
+			if err := iprot.Skip(ctx, fieldTypeId); err != nil {
+				return err
+			}
+		}
+		if err := iprot.ReadFieldEnd(ctx); err != nil {
+			return err
+		}
+	}
+	if err := iprot.ReadStructEnd(ctx); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
+	}
+	return nil
+}
+
+func (p *DiffMeasuredBootInput) ReadField1(ctx context.Context, iprot thrift.TProtocol) error {
+	if v, err := iprot.ReadI32(ctx); err != nil {
+		return thrift.PrependError("error reading field 1: ", err)
+	} else {
+		p.ActualFirmwareImage = v
+	}
+	return nil
+}
+
+func (p *DiffMeasuredBootInput) ReadField2(ctx context.Context, iprot thrift.TProtocol) error {
+	if v, err := iprot.ReadI32(ctx); err != nil {
+		return thrift.PrependError("error reading field 2: ", err)
+	} else {
+		p.OriginalFirmwareImage = &v
+	}
+	return nil
+}
+
+func (p *DiffMeasuredBootInput) ReadField3(ctx context.Context, iprot thrift.TProtocol) error {
+	if v, err := iprot.ReadI32(ctx); err != nil {
+		return thrift.PrependError("error reading field 3: ", err)
+	} else {
+		p.StatusRegisters = &v
+	}
+	return nil
+}
+
+func (p *DiffMeasuredBootInput) ReadField4(ctx context.Context, iprot thrift.TProtocol) error {
+	if v, err := iprot.ReadI32(ctx); err != nil {
+		return thrift.PrependError("error reading field 4: ", err)
+	} else {
+		p.TPMDevice = &v
+	}
+	return nil
+}
+
+func (p *DiffMeasuredBootInput) ReadField5(ctx context.Context, iprot thrift.TProtocol) error {
+	if v, err := iprot.ReadI32(ctx); err != nil {
+		return thrift.PrependError("error reading field 5: ", err)
+	} else {
+		p.TPMEventLog = &v
+	}
+	return nil
+}
+
+func (p *DiffMeasuredBootInput) ReadField6(ctx context.Context, iprot thrift.TProtocol) error {
+	if v, err := iprot.ReadI32(ctx); err != nil {
+		return thrift.PrependError("error reading field 6: ", err)
+	} else {
+		p.ActualPCR0 = &v
+	}
+	return nil
+}
+
+func (p *DiffMeasuredBootInput) ReadField7(ctx context.Context, iprot thrift.TProtocol) error {
+	if v, err := iprot.ReadI32(ctx); err != nil {
+		return thrift.PrependError("error reading field 7: ", err)
+	} else {
+		p.ActualFirmwareDumpInfo = &v
+	}
+	return nil
+}
+
+func (p *DiffMeasuredBootInput) Write(ctx context.Context, oprot thrift.TProtocol) error {
+	if err := oprot.WriteStructBegin(ctx, "DiffMeasuredBootInput"); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
+	}
+	if p != nil {
+		if err := p.writeField1(ctx, oprot); err != nil {
+			return err
+		}
+		if err := p.writeField2(ctx, oprot); err != nil {
+			return err
+		}
+		if err := p.writeField3(ctx, oprot); err != nil {
+			return err
+		}
+		if err := p.writeField4(ctx, oprot); err != nil {
+			return err
+		}
+		if err := p.writeField5(ctx, oprot); err != nil {
+			return err
+		}
+		if err := p.writeField6(ctx, oprot); err != nil {
+			return err
+		}
+		if err := p.writeField7(ctx, oprot); err != nil {
+			return err
+		}
+	}
+	if err := oprot.WriteFieldStop(ctx); err != nil {
+		return thrift.PrependError("write field stop error: ", err)
+	}
+	if err := oprot.WriteStructEnd(ctx); err != nil {
+		return thrift.PrependError("write struct stop error: ", err)
+	}
+	return nil
+}
+
+func (p *DiffMeasuredBootInput) writeField1(ctx context.Context, oprot thrift.TProtocol) (err error) {
+	if err := oprot.WriteFieldBegin(ctx, "ActualFirmwareImage", thrift.I32, 1); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T write field begin error 1:ActualFirmwareImage: ", p), err)
+	}
+	if err := oprot.WriteI32(ctx, int32(p.ActualFirmwareImage)); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T.ActualFirmwareImage (1) field write error: ", p), err)
+	}
+	if err := oprot.WriteFieldEnd(ctx); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T write field end error 1:ActualFirmwareImage: ", p), err)
+	}
+	return err
+}
+
+func (p *DiffMeasuredBootInput) writeField2(ctx context.Context, oprot thrift.TProtocol) (err error) {
+	if p.IsSetOriginalFirmwareImage() {
+		if err := oprot.WriteFieldBegin(ctx, "OriginalFirmwareImage", thrift.I32, 2); err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T write field begin error 2:OriginalFirmwareImage: ", p), err)
+		}
+		if err := oprot.WriteI32(ctx, int32(*p.OriginalFirmwareImage)); err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T.OriginalFirmwareImage (2) field write error: ", p), err)
+		}
+		if err := oprot.WriteFieldEnd(ctx); err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T write field end error 2:OriginalFirmwareImage: ", p), err)
+		}
+	}
+	return err
+}
+
+func (p *DiffMeasuredBootInput) writeField3(ctx context.Context, oprot thrift.TProtocol) (err error) {
+	if p.IsSetStatusRegisters() {
+		if err := oprot.WriteFieldBegin(ctx, "StatusRegisters", thrift.I32, 3); err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T write field begin error 3:StatusRegisters: ", p), err)
+		}
+		if err := oprot.WriteI32(ctx, int32(*p.StatusRegisters)); err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T.StatusRegisters (3) field write error: ", p), err)
+		}
+		if err := oprot.WriteFieldEnd(ctx); err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T write field end error 3:StatusRegisters: ", p), err)
+		}
+	}
+	return err
+}
+
+func (p *DiffMeasuredBootInput) writeField4(ctx context.Context, oprot thrift.TProtocol) (err error) {
+	if p.IsSetTPMDevice() {
+		if err := oprot.WriteFieldBegin(ctx, "TPMDevice", thrift.I32, 4); err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T write field begin error 4:TPMDevice: ", p), err)
+		}
+		if err := oprot.WriteI32(ctx, int32(*p.TPMDevice)); err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T.TPMDevice (4) field write error: ", p), err)
+		}
+		if err := oprot.WriteFieldEnd(ctx); err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T write field end error 4:TPMDevice: ", p), err)
+		}
+	}
+	return err
+}
+
+func (p *DiffMeasuredBootInput) writeField5(ctx context.Context, oprot thrift.TProtocol) (err error) {
+	if p.IsSetTPMEventLog() {
+		if err := oprot.WriteFieldBegin(ctx, "TPMEventLog", thrift.I32, 5); err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T write field begin error 5:TPMEventLog: ", p), err)
+		}
+		if err := oprot.WriteI32(ctx, int32(*p.TPMEventLog)); err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T.TPMEventLog (5) field write error: ", p), err)
+		}
+		if err := oprot.WriteFieldEnd(ctx); err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T write field end error 5:TPMEventLog: ", p), err)
+		}
+	}
+	return err
+}
+
+func (p *DiffMeasuredBootInput) writeField6(ctx context.Context, oprot thrift.TProtocol) (err error) {
+	if p.IsSetActualPCR0() {
+		if err := oprot.WriteFieldBegin(ctx, "ActualPCR0", thrift.I32, 6); err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T write field begin error 6:ActualPCR0: ", p), err)
+		}
+		if err := oprot.WriteI32(ctx, int32(*p.ActualPCR0)); err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T.ActualPCR0 (6) field write error: ", p), err)
+		}
+		if err := oprot.WriteFieldEnd(ctx); err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T write field end error 6:ActualPCR0: ", p), err)
+		}
+	}
+	return err
+}
+
+func (p *DiffMeasuredBootInput) writeField7(ctx context.Context, oprot thrift.TProtocol) (err error) {
+	if p.IsSetActualFirmwareDumpInfo() {
+		if err := oprot.WriteFieldBegin(ctx, "ActualFirmwareDumpInfo", thrift.I32, 7); err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T write field begin error 7:ActualFirmwareDumpInfo: ", p), err)
+		}
+		if err := oprot.WriteI32(ctx, int32(*p.ActualFirmwareDumpInfo)); err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T.ActualFirmwareDumpInfo (7) field write error: ", p), err)
+		}
+		if err := oprot.WriteFieldEnd(ctx); err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T write field end error 7:ActualFirmwareDumpInfo: ", p), err)
+		}
+	}
+	return err
+}
+
+func (p *DiffMeasuredBootInput) Equals(other *DiffMeasuredBootInput) bool {
+	if p == other {
+		return true
+	} else if p == nil || other == nil {
+		return false
+	}
+	if p.ActualFirmwareImage != other.ActualFirmwareImage {
+		return false
+	}
+	if p.OriginalFirmwareImage != other.OriginalFirmwareImage {
+		if p.OriginalFirmwareImage == nil || other.OriginalFirmwareImage == nil {
+			return false
+		}
+		if (*p.OriginalFirmwareImage) != (*other.OriginalFirmwareImage) {
+			return false
+		}
+	}
+	if p.StatusRegisters != other.StatusRegisters {
+		if p.StatusRegisters == nil || other.StatusRegisters == nil {
+			return false
+		}
+		if (*p.StatusRegisters) != (*other.StatusRegisters) {
+			return false
+		}
+	}
+	if p.TPMDevice != other.TPMDevice {
+		if p.TPMDevice == nil || other.TPMDevice == nil {
+			return false
+		}
+		if (*p.TPMDevice) != (*other.TPMDevice) {
+			return false
+		}
+	}
+	if p.TPMEventLog != other.TPMEventLog {
+		if p.TPMEventLog == nil || other.TPMEventLog == nil {
+			return false
+		}
+		if (*p.TPMEventLog) != (*other.TPMEventLog) {
+			return false
+		}
+	}
+	if p.ActualPCR0 != other.ActualPCR0 {
+		if p.ActualPCR0 == nil || other.ActualPCR0 == nil {
+			return false
+		}
+		if (*p.ActualPCR0) != (*other.ActualPCR0) {
+			return false
+		}
+	}
+	if p.ActualFirmwareDumpInfo != other.ActualFirmwareDumpInfo {
+		if p.ActualFirmwareDumpInfo == nil || other.ActualFirmwareDumpInfo == nil {
+			return false
+		}
+		if (*p.ActualFirmwareDumpInfo) != (*other.ActualFirmwareDumpInfo) {
+			return false
+		}
+	}
+	return true
+}
+
+func (p *DiffMeasuredBootInput) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("DiffMeasuredBootInput(%+v)", *p)
+}
+
+// Attributes:
+//   - ActualFirmwareImage
+//   - OriginalFirmwareImage
+type IntelACMInput struct {
+	ActualFirmwareImage   int32  `thrift:"ActualFirmwareImage,1" db:"ActualFirmwareImage" json:"ActualFirmwareImage"`
+	OriginalFirmwareImage *int32 `thrift:"OriginalFirmwareImage,2" db:"OriginalFirmwareImage" json:"OriginalFirmwareImage,omitempty"`
+}
+
+func NewIntelACMInput() *IntelACMInput {
+	return &IntelACMInput{}
+}
+
+func (p *IntelACMInput) GetActualFirmwareImage() int32 {
+	return p.ActualFirmwareImage
+}
+
+var IntelACMInput_OriginalFirmwareImage_DEFAULT int32
+
+func (p *IntelACMInput) GetOriginalFirmwareImage() int32 {
+	if !p.IsSetOriginalFirmwareImage() {
+		return IntelACMInput_OriginalFirmwareImage_DEFAULT
+	}
+	return *p.OriginalFirmwareImage
+}
+func (p *IntelACMInput) IsSetOriginalFirmwareImage() bool {
+	return p.OriginalFirmwareImage != nil
+}
+
+func (p *IntelACMInput) Read(ctx context.Context, iprot thrift.TProtocol) error {
+	if _, err := iprot.ReadStructBegin(ctx); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T read error: ", p), err)
+	}
+
+	for {
+		_, fieldTypeId, fieldId, err := iprot.ReadFieldBegin(ctx)
+		if err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T field %d read error: ", p, fieldId), err)
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+		switch fieldId {
+		case 1:
+			if fieldTypeId == thrift.I32 {
+				if err := p.ReadField1(ctx, iprot); err != nil {
+					return err
+				}
+			} else {
+				if err := iprot.Skip(ctx, fieldTypeId); err != nil {
+					return err
+				}
+			}
+		case 2:
+			if fieldTypeId == thrift.I32 {
+				if err := p.ReadField2(ctx, iprot); err != nil {
+					return err
+				}
+			} else {
+				if err := iprot.Skip(ctx, fieldTypeId); err != nil {
+					return err
+				}
+			}
+		default:
+			if err := iprot.Skip(ctx, fieldTypeId); err != nil {
+				return err
+			}
+		}
+		if err := iprot.ReadFieldEnd(ctx); err != nil {
+			return err
+		}
+	}
+	if err := iprot.ReadStructEnd(ctx); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
+	}
+	return nil
+}
+
+func (p *IntelACMInput) ReadField1(ctx context.Context, iprot thrift.TProtocol) error {
+	if v, err := iprot.ReadI32(ctx); err != nil {
+		return thrift.PrependError("error reading field 1: ", err)
+	} else {
+		p.ActualFirmwareImage = v
+	}
+	return nil
+}
+
+func (p *IntelACMInput) ReadField2(ctx context.Context, iprot thrift.TProtocol) error {
+	if v, err := iprot.ReadI32(ctx); err != nil {
+		return thrift.PrependError("error reading field 2: ", err)
+	} else {
+		p.OriginalFirmwareImage = &v
+	}
+	return nil
+}
+
+func (p *IntelACMInput) Write(ctx context.Context, oprot thrift.TProtocol) error {
+	if err := oprot.WriteStructBegin(ctx, "IntelACMInput"); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
+	}
+	if p != nil {
+		if err := p.writeField1(ctx, oprot); err != nil {
+			return err
+		}
+		if err := p.writeField2(ctx, oprot); err != nil {
+			return err
+		}
+	}
+	if err := oprot.WriteFieldStop(ctx); err != nil {
+		return thrift.PrependError("write field stop error: ", err)
+	}
+	if err := oprot.WriteStructEnd(ctx); err != nil {
+		return thrift.PrependError("write struct stop error: ", err)
+	}
+	return nil
+}
+
+func (p *IntelACMInput) writeField1(ctx context.Context, oprot thrift.TProtocol) (err error) {
+	if err := oprot.WriteFieldBegin(ctx, "ActualFirmwareImage", thrift.I32, 1); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T write field begin error 1:ActualFirmwareImage: ", p), err)
+	}
+	if err := oprot.WriteI32(ctx, int32(p.ActualFirmwareImage)); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T.ActualFirmwareImage (1) field write error: ", p), err)
+	}
+	if err := oprot.WriteFieldEnd(ctx); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T write field end error 1:ActualFirmwareImage: ", p), err)
+	}
+	return err
+}
+
+func (p *IntelACMInput) writeField2(ctx context.Context, oprot thrift.TProtocol) (err error) {
+	if p.IsSetOriginalFirmwareImage() {
+		if err := oprot.WriteFieldBegin(ctx, "OriginalFirmwareImage", thrift.I32, 2); err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T write field begin error 2:OriginalFirmwareImage: ", p), err)
+		}
+		if err := oprot.WriteI32(ctx, int32(*p.OriginalFirmwareImage)); err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T.OriginalFirmwareImage (2) field write error: ", p), err)
+		}
+		if err := oprot.WriteFieldEnd(ctx); err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T write field end error 2:OriginalFirmwareImage: ", p), err)
+		}
+	}
+	return err
+}
+
+func (p *IntelACMInput) Equals(other *IntelACMInput) bool {
+	if p == other {
+		return true
+	} else if p == nil || other == nil {
+		return false
+	}
+	if p.ActualFirmwareImage != other.ActualFirmwareImage {
+		return false
+	}
+	if p.OriginalFirmwareImage != other.OriginalFirmwareImage {
+		if p.OriginalFirmwareImage == nil || other.OriginalFirmwareImage == nil {
+			return false
+		}
+		if (*p.OriginalFirmwareImage) != (*other.OriginalFirmwareImage) {
+			return false
+		}
+	}
+	return true
+}
+
+func (p *IntelACMInput) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("IntelACMInput(%+v)", *p)
+}
+
+// Attributes:
+//   - ActualFirmwareImage
+//   - OriginalFirmwareImage
+//   - StatusRegisters
+//   - TPMDevice
+//   - TPMEventLog
+//   - ExpectedPCR
+//   - MeasurementsFlow
+type ReproducePCRInput struct {
+	ActualFirmwareImage   int32  `thrift:"ActualFirmwareImage,1" db:"ActualFirmwareImage" json:"ActualFirmwareImage"`
+	OriginalFirmwareImage *int32 `thrift:"OriginalFirmwareImage,2" db:"OriginalFirmwareImage" json:"OriginalFirmwareImage,omitempty"`
+	StatusRegisters       *int32 `thrift:"StatusRegisters,3" db:"StatusRegisters" json:"StatusRegisters,omitempty"`
+	TPMDevice             *int32 `thrift:"TPMDevice,4" db:"TPMDevice" json:"TPMDevice,omitempty"`
+	TPMEventLog           *int32 `thrift:"TPMEventLog,5" db:"TPMEventLog" json:"TPMEventLog,omitempty"`
+	ExpectedPCR           int32  `thrift:"ExpectedPCR,6" db:"ExpectedPCR" json:"ExpectedPCR"`
+	MeasurementsFlow      *int32 `thrift:"MeasurementsFlow,7" db:"MeasurementsFlow" json:"MeasurementsFlow,omitempty"`
+}
+
+func NewReproducePCRInput() *ReproducePCRInput {
+	return &ReproducePCRInput{}
+}
+
+func (p *ReproducePCRInput) GetActualFirmwareImage() int32 {
+	return p.ActualFirmwareImage
+}
+
+var ReproducePCRInput_OriginalFirmwareImage_DEFAULT int32
+
+func (p *ReproducePCRInput) GetOriginalFirmwareImage() int32 {
+	if !p.IsSetOriginalFirmwareImage() {
+		return ReproducePCRInput_OriginalFirmwareImage_DEFAULT
+	}
+	return *p.OriginalFirmwareImage
+}
+
+var ReproducePCRInput_StatusRegisters_DEFAULT int32
+
+func (p *ReproducePCRInput) GetStatusRegisters() int32 {
+	if !p.IsSetStatusRegisters() {
+		return ReproducePCRInput_StatusRegisters_DEFAULT
+	}
+	return *p.StatusRegisters
+}
+
+var ReproducePCRInput_TPMDevice_DEFAULT int32
+
+func (p *ReproducePCRInput) GetTPMDevice() int32 {
+	if !p.IsSetTPMDevice() {
+		return ReproducePCRInput_TPMDevice_DEFAULT
+	}
+	return *p.TPMDevice
+}
+
+var ReproducePCRInput_TPMEventLog_DEFAULT int32
+
+func (p *ReproducePCRInput) GetTPMEventLog() int32 {
+	if !p.IsSetTPMEventLog() {
+		return ReproducePCRInput_TPMEventLog_DEFAULT
+	}
+	return *p.TPMEventLog
+}
+
+func (p *ReproducePCRInput) GetExpectedPCR() int32 {
+	return p.ExpectedPCR
+}
+
+var ReproducePCRInput_MeasurementsFlow_DEFAULT int32
+
+func (p *ReproducePCRInput) GetMeasurementsFlow() int32 {
+	if !p.IsSetMeasurementsFlow() {
+		return ReproducePCRInput_MeasurementsFlow_DEFAULT
+	}
+	return *p.MeasurementsFlow
+}
+func (p *ReproducePCRInput) IsSetOriginalFirmwareImage() bool {
+	return p.OriginalFirmwareImage != nil
+}
+
+func (p *ReproducePCRInput) IsSetStatusRegisters() bool {
+	return p.StatusRegisters != nil
+}
+
+func (p *ReproducePCRInput) IsSetTPMDevice() bool {
+	return p.TPMDevice != nil
+}
+
+func (p *ReproducePCRInput) IsSetTPMEventLog() bool {
+	return p.TPMEventLog != nil
+}
+
+func (p *ReproducePCRInput) IsSetMeasurementsFlow() bool {
+	return p.MeasurementsFlow != nil
+}
+
+func (p *ReproducePCRInput) Read(ctx context.Context, iprot thrift.TProtocol) error {
+	if _, err := iprot.ReadStructBegin(ctx); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T read error: ", p), err)
+	}
+
+	for {
+		_, fieldTypeId, fieldId, err := iprot.ReadFieldBegin(ctx)
+		if err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T field %d read error: ", p, fieldId), err)
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+		switch fieldId {
+		case 1:
+			if fieldTypeId == thrift.I32 {
+				if err := p.ReadField1(ctx, iprot); err != nil {
+					return err
+				}
+			} else {
+				if err := iprot.Skip(ctx, fieldTypeId); err != nil {
+					return err
+				}
+			}
+		case 2:
+			if fieldTypeId == thrift.I32 {
+				if err := p.ReadField2(ctx, iprot); err != nil {
+					return err
+				}
+			} else {
+				if err := iprot.Skip(ctx, fieldTypeId); err != nil {
+					return err
+				}
+			}
+		case 3:
+			if fieldTypeId == thrift.I32 {
+				if err := p.ReadField3(ctx, iprot); err != nil {
+					return err
+				}
+			} else {
+				if err := iprot.Skip(ctx, fieldTypeId); err != nil {
+					return err
+				}
+			}
+		case 4:
+			if fieldTypeId == thrift.I32 {
+				if err := p.ReadField4(ctx, iprot); err != nil {
+					return err
+				}
+			} else {
+				if err := iprot.Skip(ctx, fieldTypeId); err != nil {
+					return err
+				}
+			}
+		case 5:
+			if fieldTypeId == thrift.I32 {
+				if err := p.ReadField5(ctx, iprot); err != nil {
+					return err
+				}
+			} else {
+				if err := iprot.Skip(ctx, fieldTypeId); err != nil {
+					return err
+				}
+			}
+		case 6:
+			if fieldTypeId == thrift.I32 {
+				if err := p.ReadField6(ctx, iprot); err != nil {
+					return err
+				}
+			} else {
+				if err := iprot.Skip(ctx, fieldTypeId); err != nil {
+					return err
+				}
+			}
+		case 7:
+			if fieldTypeId == thrift.I32 {
+				if err := p.ReadField7(ctx, iprot); err != nil {
+					return err
+				}
+			} else {
+				if err := iprot.Skip(ctx, fieldTypeId); err != nil {
+					return err
+				}
+			}
+		default:
+			if err := iprot.Skip(ctx, fieldTypeId); err != nil {
+				return err
+			}
+		}
+		if err := iprot.ReadFieldEnd(ctx); err != nil {
+			return err
+		}
+	}
+	if err := iprot.ReadStructEnd(ctx); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
+	}
+	return nil
+}
+
+func (p *ReproducePCRInput) ReadField1(ctx context.Context, iprot thrift.TProtocol) error {
+	if v, err := iprot.ReadI32(ctx); err != nil {
+		return thrift.PrependError("error reading field 1: ", err)
+	} else {
+		p.ActualFirmwareImage = v
+	}
+	return nil
+}
+
+func (p *ReproducePCRInput) ReadField2(ctx context.Context, iprot thrift.TProtocol) error {
+	if v, err := iprot.ReadI32(ctx); err != nil {
+		return thrift.PrependError("error reading field 2: ", err)
+	} else {
+		p.OriginalFirmwareImage = &v
+	}
+	return nil
+}
+
+func (p *ReproducePCRInput) ReadField3(ctx context.Context, iprot thrift.TProtocol) error {
+	if v, err := iprot.ReadI32(ctx); err != nil {
+		return thrift.PrependError("error reading field 3: ", err)
+	} else {
+		p.StatusRegisters = &v
+	}
+	return nil
+}
+
+func (p *ReproducePCRInput) ReadField4(ctx context.Context, iprot thrift.TProtocol) error {
+	if v, err := iprot.ReadI32(ctx); err != nil {
+		return thrift.PrependError("error reading field 4: ", err)
+	} else {
+		p.TPMDevice = &v
+	}
+	return nil
+}
+
+func (p *ReproducePCRInput) ReadField5(ctx context.Context, iprot thrift.TProtocol) error {
+	if v, err := iprot.ReadI32(ctx); err != nil {
+		return thrift.PrependError("error reading field 5: ", err)
+	} else {
+		p.TPMEventLog = &v
+	}
+	return nil
+}
+
+func (p *ReproducePCRInput) ReadField6(ctx context.Context, iprot thrift.TProtocol) error {
+	if v, err := iprot.ReadI32(ctx); err != nil {
+		return thrift.PrependError("error reading field 6: ", err)
+	} else {
+		p.ExpectedPCR = v
+	}
+	return nil
+}
+
+func (p *ReproducePCRInput) ReadField7(ctx context.Context, iprot thrift.TProtocol) error {
+	if v, err := iprot.ReadI32(ctx); err != nil {
+		return thrift.PrependError("error reading field 7: ", err)
+	} else {
+		p.MeasurementsFlow = &v
+	}
+	return nil
+}
+
+func (p *ReproducePCRInput) Write(ctx context.Context, oprot thrift.TProtocol) error {
+	if err := oprot.WriteStructBegin(ctx, "ReproducePCRInput"); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
+	}
+	if p != nil {
+		if err := p.writeField1(ctx, oprot); err != nil {
+			return err
+		}
+		if err := p.writeField2(ctx, oprot); err != nil {
+			return err
+		}
+		if err := p.writeField3(ctx, oprot); err != nil {
+			return err
+		}
+		if err := p.writeField4(ctx, oprot); err != nil {
+			return err
+		}
+		if err := p.writeField5(ctx, oprot); err != nil {
+			return err
+		}
+		if err := p.writeField6(ctx, oprot); err != nil {
+			return err
+		}
+		if err := p.writeField7(ctx, oprot); err != nil {
+			return err
+		}
+	}
+	if err := oprot.WriteFieldStop(ctx); err != nil {
+		return thrift.PrependError("write field stop error: ", err)
+	}
+	if err := oprot.WriteStructEnd(ctx); err != nil {
+		return thrift.PrependError("write struct stop error: ", err)
+	}
+	return nil
+}
+
+func (p *ReproducePCRInput) writeField1(ctx context.Context, oprot thrift.TProtocol) (err error) {
+	if err := oprot.WriteFieldBegin(ctx, "ActualFirmwareImage", thrift.I32, 1); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T write field begin error 1:ActualFirmwareImage: ", p), err)
+	}
+	if err := oprot.WriteI32(ctx, int32(p.ActualFirmwareImage)); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T.ActualFirmwareImage (1) field write error: ", p), err)
+	}
+	if err := oprot.WriteFieldEnd(ctx); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T write field end error 1:ActualFirmwareImage: ", p), err)
+	}
+	return err
+}
+
+func (p *ReproducePCRInput) writeField2(ctx context.Context, oprot thrift.TProtocol) (err error) {
+	if p.IsSetOriginalFirmwareImage() {
+		if err := oprot.WriteFieldBegin(ctx, "OriginalFirmwareImage", thrift.I32, 2); err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T write field begin error 2:OriginalFirmwareImage: ", p), err)
+		}
+		if err := oprot.WriteI32(ctx, int32(*p.OriginalFirmwareImage)); err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T.OriginalFirmwareImage (2) field write error: ", p), err)
+		}
+		if err := oprot.WriteFieldEnd(ctx); err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T write field end error 2:OriginalFirmwareImage: ", p), err)
+		}
+	}
+	return err
+}
+
+func (p *ReproducePCRInput) writeField3(ctx context.Context, oprot thrift.TProtocol) (err error) {
+	if p.IsSetStatusRegisters() {
+		if err := oprot.WriteFieldBegin(ctx, "StatusRegisters", thrift.I32, 3); err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T write field begin error 3:StatusRegisters: ", p), err)
+		}
+		if err := oprot.WriteI32(ctx, int32(*p.StatusRegisters)); err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T.StatusRegisters (3) field write error: ", p), err)
+		}
+		if err := oprot.WriteFieldEnd(ctx); err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T write field end error 3:StatusRegisters: ", p), err)
+		}
+	}
+	return err
+}
+
+func (p *ReproducePCRInput) writeField4(ctx context.Context, oprot thrift.TProtocol) (err error) {
+	if p.IsSetTPMDevice() {
+		if err := oprot.WriteFieldBegin(ctx, "TPMDevice", thrift.I32, 4); err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T write field begin error 4:TPMDevice: ", p), err)
+		}
+		if err := oprot.WriteI32(ctx, int32(*p.TPMDevice)); err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T.TPMDevice (4) field write error: ", p), err)
+		}
+		if err := oprot.WriteFieldEnd(ctx); err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T write field end error 4:TPMDevice: ", p), err)
+		}
+	}
+	return err
+}
+
+func (p *ReproducePCRInput) writeField5(ctx context.Context, oprot thrift.TProtocol) (err error) {
+	if p.IsSetTPMEventLog() {
+		if err := oprot.WriteFieldBegin(ctx, "TPMEventLog", thrift.I32, 5); err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T write field begin error 5:TPMEventLog: ", p), err)
+		}
+		if err := oprot.WriteI32(ctx, int32(*p.TPMEventLog)); err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T.TPMEventLog (5) field write error: ", p), err)
+		}
+		if err := oprot.WriteFieldEnd(ctx); err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T write field end error 5:TPMEventLog: ", p), err)
+		}
+	}
+	return err
+}
+
+func (p *ReproducePCRInput) writeField6(ctx context.Context, oprot thrift.TProtocol) (err error) {
+	if err := oprot.WriteFieldBegin(ctx, "ExpectedPCR", thrift.I32, 6); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T write field begin error 6:ExpectedPCR: ", p), err)
+	}
+	if err := oprot.WriteI32(ctx, int32(p.ExpectedPCR)); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T.ExpectedPCR (6) field write error: ", p), err)
+	}
+	if err := oprot.WriteFieldEnd(ctx); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T write field end error 6:ExpectedPCR: ", p), err)
+	}
+	return err
+}
+
+func (p *ReproducePCRInput) writeField7(ctx context.Context, oprot thrift.TProtocol) (err error) {
+	if p.IsSetMeasurementsFlow() {
+		if err := oprot.WriteFieldBegin(ctx, "MeasurementsFlow", thrift.I32, 7); err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T write field begin error 7:MeasurementsFlow: ", p), err)
+		}
+		if err := oprot.WriteI32(ctx, int32(*p.MeasurementsFlow)); err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T.MeasurementsFlow (7) field write error: ", p), err)
+		}
+		if err := oprot.WriteFieldEnd(ctx); err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T write field end error 7:MeasurementsFlow: ", p), err)
+		}
+	}
+	return err
+}
+
+func (p *ReproducePCRInput) Equals(other *ReproducePCRInput) bool {
+	if p == other {
+		return true
+	} else if p == nil || other == nil {
+		return false
+	}
+	if p.ActualFirmwareImage != other.ActualFirmwareImage {
+		return false
+	}
+	if p.OriginalFirmwareImage != other.OriginalFirmwareImage {
+		if p.OriginalFirmwareImage == nil || other.OriginalFirmwareImage == nil {
+			return false
+		}
+		if (*p.OriginalFirmwareImage) != (*other.OriginalFirmwareImage) {
+			return false
+		}
+	}
+	if p.StatusRegisters != other.StatusRegisters {
+		if p.StatusRegisters == nil || other.StatusRegisters == nil {
+			return false
+		}
+		if (*p.StatusRegisters) != (*other.StatusRegisters) {
+			return false
+		}
+	}
+	if p.TPMDevice != other.TPMDevice {
+		if p.TPMDevice == nil || other.TPMDevice == nil {
+			return false
+		}
+		if (*p.TPMDevice) != (*other.TPMDevice) {
+			return false
+		}
+	}
+	if p.TPMEventLog != other.TPMEventLog {
+		if p.TPMEventLog == nil || other.TPMEventLog == nil {
+			return false
+		}
+		if (*p.TPMEventLog) != (*other.TPMEventLog) {
+			return false
+		}
+	}
+	if p.ExpectedPCR != other.ExpectedPCR {
+		return false
+	}
+	if p.MeasurementsFlow != other.MeasurementsFlow {
+		if p.MeasurementsFlow == nil || other.MeasurementsFlow == nil {
+			return false
+		}
+		if (*p.MeasurementsFlow) != (*other.MeasurementsFlow) {
+			return false
+		}
+	}
+	return true
+}
+
+func (p *ReproducePCRInput) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("ReproducePCRInput(%+v)", *p)
+}
+
+// Attributes:
+//   - ActualFirmwareImage
+type PSPSignatureInput struct {
+	ActualFirmwareImage int32 `thrift:"ActualFirmwareImage,1" db:"ActualFirmwareImage" json:"ActualFirmwareImage"`
+}
+
+func NewPSPSignatureInput() *PSPSignatureInput {
+	return &PSPSignatureInput{}
+}
+
+func (p *PSPSignatureInput) GetActualFirmwareImage() int32 {
+	return p.ActualFirmwareImage
+}
+func (p *PSPSignatureInput) Read(ctx context.Context, iprot thrift.TProtocol) error {
+	if _, err := iprot.ReadStructBegin(ctx); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T read error: ", p), err)
+	}
+
+	for {
+		_, fieldTypeId, fieldId, err := iprot.ReadFieldBegin(ctx)
+		if err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T field %d read error: ", p, fieldId), err)
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+		switch fieldId {
+		case 1:
+			if fieldTypeId == thrift.I32 {
+				if err := p.ReadField1(ctx, iprot); err != nil {
+					return err
+				}
+			} else {
+				if err := iprot.Skip(ctx, fieldTypeId); err != nil {
+					return err
+				}
+			}
+		default:
+			if err := iprot.Skip(ctx, fieldTypeId); err != nil {
+				return err
+			}
+		}
+		if err := iprot.ReadFieldEnd(ctx); err != nil {
+			return err
+		}
+	}
+	if err := iprot.ReadStructEnd(ctx); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
+	}
+	return nil
+}
+
+func (p *PSPSignatureInput) ReadField1(ctx context.Context, iprot thrift.TProtocol) error {
+	if v, err := iprot.ReadI32(ctx); err != nil {
+		return thrift.PrependError("error reading field 1: ", err)
+	} else {
+		p.ActualFirmwareImage = v
+	}
+	return nil
+}
+
+func (p *PSPSignatureInput) Write(ctx context.Context, oprot thrift.TProtocol) error {
+	if err := oprot.WriteStructBegin(ctx, "PSPSignatureInput"); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
+	}
+	if p != nil {
+		if err := p.writeField1(ctx, oprot); err != nil {
+			return err
+		}
+	}
+	if err := oprot.WriteFieldStop(ctx); err != nil {
+		return thrift.PrependError("write field stop error: ", err)
+	}
+	if err := oprot.WriteStructEnd(ctx); err != nil {
+		return thrift.PrependError("write struct stop error: ", err)
+	}
+	return nil
+}
+
+func (p *PSPSignatureInput) writeField1(ctx context.Context, oprot thrift.TProtocol) (err error) {
+	if err := oprot.WriteFieldBegin(ctx, "ActualFirmwareImage", thrift.I32, 1); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T write field begin error 1:ActualFirmwareImage: ", p), err)
+	}
+	if err := oprot.WriteI32(ctx, int32(p.ActualFirmwareImage)); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T.ActualFirmwareImage (1) field write error: ", p), err)
+	}
+	if err := oprot.WriteFieldEnd(ctx); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T write field end error 1:ActualFirmwareImage: ", p), err)
+	}
+	return err
+}
+
+func (p *PSPSignatureInput) Equals(other *PSPSignatureInput) bool {
+	if p == other {
+		return true
+	} else if p == nil || other == nil {
+		return false
+	}
+	if p.ActualFirmwareImage != other.ActualFirmwareImage {
+		return false
+	}
+	return true
+}
+
+func (p *PSPSignatureInput) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("PSPSignatureInput(%+v)", *p)
+}
+
+// Attributes:
+//   - ActualFirmwareImage
+type BIOSRTMVolumeInput struct {
+	ActualFirmwareImage int32 `thrift:"ActualFirmwareImage,1" db:"ActualFirmwareImage" json:"ActualFirmwareImage"`
+}
+
+func NewBIOSRTMVolumeInput() *BIOSRTMVolumeInput {
+	return &BIOSRTMVolumeInput{}
+}
+
+func (p *BIOSRTMVolumeInput) GetActualFirmwareImage() int32 {
+	return p.ActualFirmwareImage
+}
+func (p *BIOSRTMVolumeInput) Read(ctx context.Context, iprot thrift.TProtocol) error {
+	if _, err := iprot.ReadStructBegin(ctx); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T read error: ", p), err)
+	}
+
+	for {
+		_, fieldTypeId, fieldId, err := iprot.ReadFieldBegin(ctx)
+		if err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T field %d read error: ", p, fieldId), err)
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+		switch fieldId {
+		case 1:
+			if fieldTypeId == thrift.I32 {
+				if err := p.ReadField1(ctx, iprot); err != nil {
+					return err
+				}
+			} else {
+				if err := iprot.Skip(ctx, fieldTypeId); err != nil {
+					return err
+				}
+			}
+		default:
+			if err := iprot.Skip(ctx, fieldTypeId); err != nil {
+				return err
+			}
+		}
+		if err := iprot.ReadFieldEnd(ctx); err != nil {
+			return err
+		}
+	}
+	if err := iprot.ReadStructEnd(ctx); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
+	}
+	return nil
+}
+
+func (p *BIOSRTMVolumeInput) ReadField1(ctx context.Context, iprot thrift.TProtocol) error {
+	if v, err := iprot.ReadI32(ctx); err != nil {
+		return thrift.PrependError("error reading field 1: ", err)
+	} else {
+		p.ActualFirmwareImage = v
+	}
+	return nil
+}
+
+func (p *BIOSRTMVolumeInput) Write(ctx context.Context, oprot thrift.TProtocol) error {
+	if err := oprot.WriteStructBegin(ctx, "BIOSRTMVolumeInput"); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
+	}
+	if p != nil {
+		if err := p.writeField1(ctx, oprot); err != nil {
+			return err
+		}
+	}
+	if err := oprot.WriteFieldStop(ctx); err != nil {
+		return thrift.PrependError("write field stop error: ", err)
+	}
+	if err := oprot.WriteStructEnd(ctx); err != nil {
+		return thrift.PrependError("write struct stop error: ", err)
+	}
+	return nil
+}
+
+func (p *BIOSRTMVolumeInput) writeField1(ctx context.Context, oprot thrift.TProtocol) (err error) {
+	if err := oprot.WriteFieldBegin(ctx, "ActualFirmwareImage", thrift.I32, 1); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T write field begin error 1:ActualFirmwareImage: ", p), err)
+	}
+	if err := oprot.WriteI32(ctx, int32(p.ActualFirmwareImage)); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T.ActualFirmwareImage (1) field write error: ", p), err)
+	}
+	if err := oprot.WriteFieldEnd(ctx); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T write field end error 1:ActualFirmwareImage: ", p), err)
+	}
+	return err
+}
+
+func (p *BIOSRTMVolumeInput) Equals(other *BIOSRTMVolumeInput) bool {
+	if p == other {
+		return true
+	} else if p == nil || other == nil {
+		return false
+	}
+	if p.ActualFirmwareImage != other.ActualFirmwareImage {
+		return false
+	}
+	return true
+}
+
+func (p *BIOSRTMVolumeInput) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("BIOSRTMVolumeInput(%+v)", *p)
+}
+
+// Attributes:
+//   - ActualFirmwareImage
+type APCBSecurityTokensInput struct {
+	ActualFirmwareImage int32 `thrift:"ActualFirmwareImage,1" db:"ActualFirmwareImage" json:"ActualFirmwareImage"`
+}
+
+func NewAPCBSecurityTokensInput() *APCBSecurityTokensInput {
+	return &APCBSecurityTokensInput{}
+}
+
+func (p *APCBSecurityTokensInput) GetActualFirmwareImage() int32 {
+	return p.ActualFirmwareImage
+}
+func (p *APCBSecurityTokensInput) Read(ctx context.Context, iprot thrift.TProtocol) error {
+	if _, err := iprot.ReadStructBegin(ctx); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T read error: ", p), err)
+	}
+
+	for {
+		_, fieldTypeId, fieldId, err := iprot.ReadFieldBegin(ctx)
+		if err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T field %d read error: ", p, fieldId), err)
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+		switch fieldId {
+		case 1:
+			if fieldTypeId == thrift.I32 {
+				if err := p.ReadField1(ctx, iprot); err != nil {
+					return err
+				}
+			} else {
+				if err := iprot.Skip(ctx, fieldTypeId); err != nil {
+					return err
+				}
+			}
+		default:
+			if err := iprot.Skip(ctx, fieldTypeId); err != nil {
+				return err
+			}
+		}
+		if err := iprot.ReadFieldEnd(ctx); err != nil {
+			return err
+		}
+	}
+	if err := iprot.ReadStructEnd(ctx); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
+	}
+	return nil
+}
+
+func (p *APCBSecurityTokensInput) ReadField1(ctx context.Context, iprot thrift.TProtocol) error {
+	if v, err := iprot.ReadI32(ctx); err != nil {
+		return thrift.PrependError("error reading field 1: ", err)
+	} else {
+		p.ActualFirmwareImage = v
+	}
+	return nil
+}
+
+func (p *APCBSecurityTokensInput) Write(ctx context.Context, oprot thrift.TProtocol) error {
+	if err := oprot.WriteStructBegin(ctx, "APCBSecurityTokensInput"); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
+	}
+	if p != nil {
+		if err := p.writeField1(ctx, oprot); err != nil {
+			return err
+		}
+	}
+	if err := oprot.WriteFieldStop(ctx); err != nil {
+		return thrift.PrependError("write field stop error: ", err)
+	}
+	if err := oprot.WriteStructEnd(ctx); err != nil {
+		return thrift.PrependError("write struct stop error: ", err)
+	}
+	return nil
+}
+
+func (p *APCBSecurityTokensInput) writeField1(ctx context.Context, oprot thrift.TProtocol) (err error) {
+	if err := oprot.WriteFieldBegin(ctx, "ActualFirmwareImage", thrift.I32, 1); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T write field begin error 1:ActualFirmwareImage: ", p), err)
+	}
+	if err := oprot.WriteI32(ctx, int32(p.ActualFirmwareImage)); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T.ActualFirmwareImage (1) field write error: ", p), err)
+	}
+	if err := oprot.WriteFieldEnd(ctx); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T write field end error 1:ActualFirmwareImage: ", p), err)
+	}
+	return err
+}
+
+func (p *APCBSecurityTokensInput) Equals(other *APCBSecurityTokensInput) bool {
+	if p == other {
+		return true
+	} else if p == nil || other == nil {
+		return false
+	}
+	if p.ActualFirmwareImage != other.ActualFirmwareImage {
+		return false
+	}
+	return true
+}
+
+func (p *APCBSecurityTokensInput) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("APCBSecurityTokensInput(%+v)", *p)
+}
+
+// Attributes:
+//   - DiffMeasuredBoot
+//   - IntelACM
+//   - ReproducePCR
+//   - PSPSignature
+//   - BIOSRTMVolume
+//   - APCBSecurityTokens
+type AnalyzerInput struct {
+	DiffMeasuredBoot   *DiffMeasuredBootInput   `thrift:"DiffMeasuredBoot,1" db:"DiffMeasuredBoot" json:"DiffMeasuredBoot,omitempty"`
+	IntelACM           *IntelACMInput           `thrift:"IntelACM,2" db:"IntelACM" json:"IntelACM,omitempty"`
+	ReproducePCR       *ReproducePCRInput       `thrift:"ReproducePCR,3" db:"ReproducePCR" json:"ReproducePCR,omitempty"`
+	PSPSignature       *PSPSignatureInput       `thrift:"PSPSignature,4" db:"PSPSignature" json:"PSPSignature,omitempty"`
+	BIOSRTMVolume      *BIOSRTMVolumeInput      `thrift:"BIOSRTMVolume,5" db:"BIOSRTMVolume" json:"BIOSRTMVolume,omitempty"`
+	APCBSecurityTokens *APCBSecurityTokensInput `thrift:"APCBSecurityTokens,6" db:"APCBSecurityTokens" json:"APCBSecurityTokens,omitempty"`
+}
+
+func NewAnalyzerInput() *AnalyzerInput {
+	return &AnalyzerInput{}
+}
+
+var AnalyzerInput_DiffMeasuredBoot_DEFAULT *DiffMeasuredBootInput
+
+func (p *AnalyzerInput) GetDiffMeasuredBoot() *DiffMeasuredBootInput {
+	if !p.IsSetDiffMeasuredBoot() {
+		return AnalyzerInput_DiffMeasuredBoot_DEFAULT
+	}
+	return p.DiffMeasuredBoot
+}
+
+var AnalyzerInput_IntelACM_DEFAULT *IntelACMInput
+
+func (p *AnalyzerInput) GetIntelACM() *IntelACMInput {
+	if !p.IsSetIntelACM() {
+		return AnalyzerInput_IntelACM_DEFAULT
+	}
+	return p.IntelACM
+}
+
+var AnalyzerInput_ReproducePCR_DEFAULT *ReproducePCRInput
+
+func (p *AnalyzerInput) GetReproducePCR() *ReproducePCRInput {
+	if !p.IsSetReproducePCR() {
+		return AnalyzerInput_ReproducePCR_DEFAULT
+	}
+	return p.ReproducePCR
+}
+
+var AnalyzerInput_PSPSignature_DEFAULT *PSPSignatureInput
+
+func (p *AnalyzerInput) GetPSPSignature() *PSPSignatureInput {
+	if !p.IsSetPSPSignature() {
+		return AnalyzerInput_PSPSignature_DEFAULT
+	}
+	return p.PSPSignature
+}
+
+var AnalyzerInput_BIOSRTMVolume_DEFAULT *BIOSRTMVolumeInput
+
+func (p *AnalyzerInput) GetBIOSRTMVolume() *BIOSRTMVolumeInput {
+	if !p.IsSetBIOSRTMVolume() {
+		return AnalyzerInput_BIOSRTMVolume_DEFAULT
+	}
+	return p.BIOSRTMVolume
+}
+
+var AnalyzerInput_APCBSecurityTokens_DEFAULT *APCBSecurityTokensInput
+
+func (p *AnalyzerInput) GetAPCBSecurityTokens() *APCBSecurityTokensInput {
+	if !p.IsSetAPCBSecurityTokens() {
+		return AnalyzerInput_APCBSecurityTokens_DEFAULT
+	}
+	return p.APCBSecurityTokens
+}
+func (p *AnalyzerInput) CountSetFieldsAnalyzerInput() int {
+	count := 0
+	if p.IsSetDiffMeasuredBoot() {
+		count++
+	}
+	if p.IsSetIntelACM() {
+		count++
+	}
+	if p.IsSetReproducePCR() {
+		count++
+	}
+	if p.IsSetPSPSignature() {
+		count++
+	}
+	if p.IsSetBIOSRTMVolume() {
+		count++
+	}
+	if p.IsSetAPCBSecurityTokens() {
+		count++
+	}
+	return count
+
+}
+
+func (p *AnalyzerInput) IsSetDiffMeasuredBoot() bool {
+	return p.DiffMeasuredBoot != nil
+}
+
+func (p *AnalyzerInput) IsSetIntelACM() bool {
+	return p.IntelACM != nil
+}
+
+func (p *AnalyzerInput) IsSetReproducePCR() bool {
+	return p.ReproducePCR != nil
+}
+
+func (p *AnalyzerInput) IsSetPSPSignature() bool {
+	return p.PSPSignature != nil
+}
+
+func (p *AnalyzerInput) IsSetBIOSRTMVolume() bool {
+	return p.BIOSRTMVolume != nil
+}
+
+func (p *AnalyzerInput) IsSetAPCBSecurityTokens() bool {
+	return p.APCBSecurityTokens != nil
+}
+
+func (p *AnalyzerInput) Read(ctx context.Context, iprot thrift.TProtocol) error {
+	if _, err := iprot.ReadStructBegin(ctx); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T read error: ", p), err)
+	}
+
+	for {
+		_, fieldTypeId, fieldId, err := iprot.ReadFieldBegin(ctx)
+		if err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T field %d read error: ", p, fieldId), err)
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+		switch fieldId {
+		case 1:
+			if fieldTypeId == thrift.STRUCT {
+				if err := p.ReadField1(ctx, iprot); err != nil {
+					return err
+				}
+			} else {
+				if err := iprot.Skip(ctx, fieldTypeId); err != nil {
+					return err
+				}
+			}
+		case 2:
+			if fieldTypeId == thrift.STRUCT {
+				if err := p.ReadField2(ctx, iprot); err != nil {
+					return err
+				}
+			} else {
+				if err := iprot.Skip(ctx, fieldTypeId); err != nil {
+					return err
+				}
+			}
+		case 3:
+			if fieldTypeId == thrift.STRUCT {
+				if err := p.ReadField3(ctx, iprot); err != nil {
+					return err
+				}
+			} else {
+				if err := iprot.Skip(ctx, fieldTypeId); err != nil {
+					return err
+				}
+			}
+		case 4:
+			if fieldTypeId == thrift.STRUCT {
+				if err := p.ReadField4(ctx, iprot); err != nil {
+					return err
+				}
+			} else {
+				if err := iprot.Skip(ctx, fieldTypeId); err != nil {
+					return err
+				}
+			}
+		case 5:
+			if fieldTypeId == thrift.STRUCT {
+				if err := p.ReadField5(ctx, iprot); err != nil {
+					return err
+				}
+			} else {
+				if err := iprot.Skip(ctx, fieldTypeId); err != nil {
+					return err
+				}
+			}
+		case 6:
+			if fieldTypeId == thrift.STRUCT {
+				if err := p.ReadField6(ctx, iprot); err != nil {
+					return err
+				}
+			} else {
+				if err := iprot.Skip(ctx, fieldTypeId); err != nil {
+					return err
+				}
+			}
+		default:
+			if err := iprot.Skip(ctx, fieldTypeId); err != nil {
+				return err
+			}
+		}
+		if err := iprot.ReadFieldEnd(ctx); err != nil {
+			return err
+		}
+	}
+	if err := iprot.ReadStructEnd(ctx); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
+	}
+	return nil
+}
+
+func (p *AnalyzerInput) ReadField1(ctx context.Context, iprot thrift.TProtocol) error {
+	p.DiffMeasuredBoot = &DiffMeasuredBootInput{}
+	if err := p.DiffMeasuredBoot.Read(ctx, iprot); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T error reading struct: ", p.DiffMeasuredBoot), err)
+	}
+	return nil
+}
+
+func (p *AnalyzerInput) ReadField2(ctx context.Context, iprot thrift.TProtocol) error {
+	p.IntelACM = &IntelACMInput{}
+	if err := p.IntelACM.Read(ctx, iprot); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T error reading struct: ", p.IntelACM), err)
+	}
+	return nil
+}
+
+func (p *AnalyzerInput) ReadField3(ctx context.Context, iprot thrift.TProtocol) error {
+	p.ReproducePCR = &ReproducePCRInput{}
+	if err := p.ReproducePCR.Read(ctx, iprot); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T error reading struct: ", p.ReproducePCR), err)
+	}
+	return nil
+}
+
+func (p *AnalyzerInput) ReadField4(ctx context.Context, iprot thrift.TProtocol) error {
+	p.PSPSignature = &PSPSignatureInput{}
+	if err := p.PSPSignature.Read(ctx, iprot); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T error reading struct: ", p.PSPSignature), err)
+	}
+	return nil
+}
+
+func (p *AnalyzerInput) ReadField5(ctx context.Context, iprot thrift.TProtocol) error {
+	p.BIOSRTMVolume = &BIOSRTMVolumeInput{}
+	if err := p.BIOSRTMVolume.Read(ctx, iprot); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T error reading struct: ", p.BIOSRTMVolume), err)
+	}
+	return nil
+}
+
+func (p *AnalyzerInput) ReadField6(ctx context.Context, iprot thrift.TProtocol) error {
+	p.APCBSecurityTokens = &APCBSecurityTokensInput{}
+	if err := p.APCBSecurityTokens.Read(ctx, iprot); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T error reading struct: ", p.APCBSecurityTokens), err)
+	}
+	return nil
+}
+
+func (p *AnalyzerInput) Write(ctx context.Context, oprot thrift.TProtocol) error {
+	if c := p.CountSetFieldsAnalyzerInput(); c != 1 {
+		return fmt.Errorf("%T write union: exactly one field must be set (%d set).", p, c)
+	}
+	if err := oprot.WriteStructBegin(ctx, "AnalyzerInput"); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
+	}
+	if p != nil {
+		if err := p.writeField1(ctx, oprot); err != nil {
+			return err
+		}
+		if err := p.writeField2(ctx, oprot); err != nil {
+			return err
+		}
+		if err := p.writeField3(ctx, oprot); err != nil {
+			return err
+		}
+		if err := p.writeField4(ctx, oprot); err != nil {
+			return err
+		}
+		if err := p.writeField5(ctx, oprot); err != nil {
+			return err
+		}
+		if err := p.writeField6(ctx, oprot); err != nil {
+			return err
+		}
+	}
+	if err := oprot.WriteFieldStop(ctx); err != nil {
+		return thrift.PrependError("write field stop error: ", err)
+	}
+	if err := oprot.WriteStructEnd(ctx); err != nil {
+		return thrift.PrependError("write struct stop error: ", err)
+	}
+	return nil
+}
+
+func (p *AnalyzerInput) writeField1(ctx context.Context, oprot thrift.TProtocol) (err error) {
+	if p.IsSetDiffMeasuredBoot() {
+		if err := oprot.WriteFieldBegin(ctx, "DiffMeasuredBoot", thrift.STRUCT, 1); err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T write field begin error 1:DiffMeasuredBoot: ", p), err)
+		}
+		if err := p.DiffMeasuredBoot.Write(ctx, oprot); err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T error writing struct: ", p.DiffMeasuredBoot), err)
+		}
+		if err := oprot.WriteFieldEnd(ctx); err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T write field end error 1:DiffMeasuredBoot: ", p), err)
+		}
+	}
+	return err
+}
+
+func (p *AnalyzerInput) writeField2(ctx context.Context, oprot thrift.TProtocol) (err error) {
+	if p.IsSetIntelACM() {
+		if err := oprot.WriteFieldBegin(ctx, "IntelACM", thrift.STRUCT, 2); err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T write field begin error 2:IntelACM: ", p), err)
+		}
+		if err := p.IntelACM.Write(ctx, oprot); err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T error writing struct: ", p.IntelACM), err)
+		}
+		if err := oprot.WriteFieldEnd(ctx); err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T write field end error 2:IntelACM: ", p), err)
+		}
+	}
+	return err
+}
+
+func (p *AnalyzerInput) writeField3(ctx context.Context, oprot thrift.TProtocol) (err error) {
+	if p.IsSetReproducePCR() {
+		if err := oprot.WriteFieldBegin(ctx, "ReproducePCR", thrift.STRUCT, 3); err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T write field begin error 3:ReproducePCR: ", p), err)
+		}
+		if err := p.ReproducePCR.Write(ctx, oprot); err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T error writing struct: ", p.ReproducePCR), err)
+		}
+		if err := oprot.WriteFieldEnd(ctx); err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T write field end error 3:ReproducePCR: ", p), err)
+		}
+	}
+	return err
+}
+
+func (p *AnalyzerInput) writeField4(ctx context.Context, oprot thrift.TProtocol) (err error) {
+	if p.IsSetPSPSignature() {
+		if err := oprot.WriteFieldBegin(ctx, "PSPSignature", thrift.STRUCT, 4); err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T write field begin error 4:PSPSignature: ", p), err)
+		}
+		if err := p.PSPSignature.Write(ctx, oprot); err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T error writing struct: ", p.PSPSignature), err)
+		}
+		if err := oprot.WriteFieldEnd(ctx); err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T write field end error 4:PSPSignature: ", p), err)
+		}
+	}
+	return err
+}
+
+func (p *AnalyzerInput) writeField5(ctx context.Context, oprot thrift.TProtocol) (err error) {
+	if p.IsSetBIOSRTMVolume() {
+		if err := oprot.WriteFieldBegin(ctx, "BIOSRTMVolume", thrift.STRUCT, 5); err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T write field begin error 5:BIOSRTMVolume: ", p), err)
+		}
+		if err := p.BIOSRTMVolume.Write(ctx, oprot); err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T error writing struct: ", p.BIOSRTMVolume), err)
+		}
+		if err := oprot.WriteFieldEnd(ctx); err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T write field end error 5:BIOSRTMVolume: ", p), err)
+		}
+	}
+	return err
+}
+
+func (p *AnalyzerInput) writeField6(ctx context.Context, oprot thrift.TProtocol) (err error) {
+	if p.IsSetAPCBSecurityTokens() {
+		if err := oprot.WriteFieldBegin(ctx, "APCBSecurityTokens", thrift.STRUCT, 6); err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T write field begin error 6:APCBSecurityTokens: ", p), err)
+		}
+		if err := p.APCBSecurityTokens.Write(ctx, oprot); err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T error writing struct: ", p.APCBSecurityTokens), err)
+		}
+		if err := oprot.WriteFieldEnd(ctx); err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T write field end error 6:APCBSecurityTokens: ", p), err)
+		}
+	}
+	return err
+}
+
+func (p *AnalyzerInput) Equals(other *AnalyzerInput) bool {
+	if p == other {
+		return true
+	} else if p == nil || other == nil {
+		return false
+	}
+	if !p.DiffMeasuredBoot.Equals(other.DiffMeasuredBoot) {
+		return false
+	}
+	if !p.IntelACM.Equals(other.IntelACM) {
+		return false
+	}
+	if !p.ReproducePCR.Equals(other.ReproducePCR) {
+		return false
+	}
+	if !p.PSPSignature.Equals(other.PSPSignature) {
+		return false
+	}
+	if !p.BIOSRTMVolume.Equals(other.BIOSRTMVolume) {
+		return false
+	}
+	if !p.APCBSecurityTokens.Equals(other.APCBSecurityTokens) {
+		return false
+	}
+	return true
+}
+
+func (p *AnalyzerInput) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("AnalyzerInput(%+v)", *p)
+}
+
+// Attributes:
+//   - HostInfo
+//   - Artifacts
+//   - Analyzers
+type AnalyzeRequest struct {
+	HostInfo  *HostInfo        `thrift:"HostInfo,1" db:"HostInfo" json:"HostInfo,omitempty"`
+	Artifacts []*Artifact      `thrift:"Artifacts,2" db:"Artifacts" json:"Artifacts"`
+	Analyzers []*AnalyzerInput `thrift:"Analyzers,3" db:"Analyzers" json:"Analyzers"`
+}
+
+func NewAnalyzeRequest() *AnalyzeRequest {
+	return &AnalyzeRequest{}
+}
+
+var AnalyzeRequest_HostInfo_DEFAULT *HostInfo
+
+func (p *AnalyzeRequest) GetHostInfo() *HostInfo {
+	if !p.IsSetHostInfo() {
+		return AnalyzeRequest_HostInfo_DEFAULT
+	}
+	return p.HostInfo
+}
+
+func (p *AnalyzeRequest) GetArtifacts() []*Artifact {
+	return p.Artifacts
+}
+
+func (p *AnalyzeRequest) GetAnalyzers() []*AnalyzerInput {
+	return p.Analyzers
+}
+func (p *AnalyzeRequest) IsSetHostInfo() bool {
+	return p.HostInfo != nil
+}
+
+func (p *AnalyzeRequest) Read(ctx context.Context, iprot thrift.TProtocol) error {
+	if _, err := iprot.ReadStructBegin(ctx); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T read error: ", p), err)
+	}
+
+	for {
+		_, fieldTypeId, fieldId, err := iprot.ReadFieldBegin(ctx)
+		if err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T field %d read error: ", p, fieldId), err)
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+		switch fieldId {
+		case 1:
+			if fieldTypeId == thrift.STRUCT {
+				if err := p.ReadField1(ctx, iprot); err != nil {
+					return err
+				}
+			} else {
+				if err := iprot.Skip(ctx, fieldTypeId); err != nil {
+					return err
+				}
+			}
+		case 2:
+			if fieldTypeId == thrift.LIST {
+				if err := p.ReadField2(ctx, iprot); err != nil {
+					return err
+				}
+			} else {
+				if err := iprot.Skip(ctx, fieldTypeId); err != nil {
+					return err
+				}
+			}
+		case 3:
+			if fieldTypeId == thrift.LIST {
+				if err := p.ReadField3(ctx, iprot); err != nil {
+					return err
+				}
+			} else {
+				if err := iprot.Skip(ctx, fieldTypeId); err != nil {
+					return err
+				}
+			}
+		default:
+			if err := iprot.Skip(ctx, fieldTypeId); err != nil {
+				return err
+			}
+		}
+		if err := iprot.ReadFieldEnd(ctx); err != nil {
+			return err
+		}
+	}
+	if err := iprot.ReadStructEnd(ctx); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
+	}
+	return nil
+}
+
+func (p *AnalyzeRequest) ReadField1(ctx context.Context, iprot thrift.TProtocol) error {
+	p.HostInfo = &HostInfo{}
+	if err := p.HostInfo.Read(ctx, iprot); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T error reading struct: ", p.HostInfo), err)
+	}
+	return nil
+}
+
+func (p *AnalyzeRequest) ReadField2(ctx context.Context, iprot thrift.TProtocol) error {
+	_, size, err := iprot.ReadListBegin(ctx)
+	if err != nil {
+		return thrift.PrependError("error reading list begin: ", err)
+	}
+	tSlice := make([]*Artifact, 0, size)
+	p.Artifacts = tSlice
+	for i := 0; i < size; i++ {
+		_elem41 := &Artifact{}
+		if err := _elem41.Read(ctx, iprot); err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T error reading struct: ", _elem41), err)
+		}
+		p.Artifacts = append(p.Artifacts, _elem41)
+	}
+	if err := iprot.ReadListEnd(ctx); err != nil {
+		return thrift.PrependError("error reading list end: ", err)
+	}
+	return nil
+}
+
+func (p *AnalyzeRequest) ReadField3(ctx context.Context, iprot thrift.TProtocol) error {
+	_, size, err := iprot.ReadListBegin(ctx)
+	if err != nil {
+		return thrift.PrependError("error reading list begin: ", err)
+	}
+	tSlice := make([]*AnalyzerInput, 0, size)
+	p.Analyzers = tSlice
+	for i := 0; i < size; i++ {
+		_elem42 := &AnalyzerInput{}
+		if err := _elem42.Read(ctx, iprot); err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T error reading struct: ", _elem42), err)
+		}
+		p.Analyzers = append(p.Analyzers, _elem42)
+	}
+	if err := iprot.ReadListEnd(ctx); err != nil {
+		return thrift.PrependError("error reading list end: ", err)
+	}
+	return nil
+}
+
+func (p *AnalyzeRequest) Write(ctx context.Context, oprot thrift.TProtocol) error {
+	if err := oprot.WriteStructBegin(ctx, "AnalyzeRequest"); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
+	}
+	if p != nil {
+		if err := p.writeField1(ctx, oprot); err != nil {
+			return err
+		}
+		if err := p.writeField2(ctx, oprot); err != nil {
+			return err
+		}
+		if err := p.writeField3(ctx, oprot); err != nil {
+			return err
+		}
+	}
+	if err := oprot.WriteFieldStop(ctx); err != nil {
+		return thrift.PrependError("write field stop error: ", err)
+	}
+	if err := oprot.WriteStructEnd(ctx); err != nil {
+		return thrift.PrependError("write struct stop error: ", err)
+	}
+	return nil
+}
+
+func (p *AnalyzeRequest) writeField1(ctx context.Context, oprot thrift.TProtocol) (err error) {
+	if p.IsSetHostInfo() {
+		if err := oprot.WriteFieldBegin(ctx, "HostInfo", thrift.STRUCT, 1); err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T write field begin error 1:HostInfo: ", p), err)
+		}
+		if err := p.HostInfo.Write(ctx, oprot); err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T error writing struct: ", p.HostInfo), err)
+		}
+		if err := oprot.WriteFieldEnd(ctx); err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T write field end error 1:HostInfo: ", p), err)
+		}
+	}
+	return err
+}
+
+func (p *AnalyzeRequest) writeField2(ctx context.Context, oprot thrift.TProtocol) (err error) {
+	if err := oprot.WriteFieldBegin(ctx, "Artifacts", thrift.LIST, 2); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T write field begin error 2:Artifacts: ", p), err)
+	}
+	if err := oprot.WriteListBegin(ctx, thrift.STRUCT, len(p.Artifacts)); err != nil {
+		return thrift.PrependError("error writing list begin: ", err)
+	}
+	for _, v := range p.Artifacts {
+		if err := v.Write(ctx, oprot); err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T error writing struct: ", v), err)
+		}
+	}
+	if err := oprot.WriteListEnd(ctx); err != nil {
+		return thrift.PrependError("error writing list end: ", err)
+	}
+	if err := oprot.WriteFieldEnd(ctx); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T write field end error 2:Artifacts: ", p), err)
+	}
+	return err
+}
+
+func (p *AnalyzeRequest) writeField3(ctx context.Context, oprot thrift.TProtocol) (err error) {
+	if err := oprot.WriteFieldBegin(ctx, "Analyzers", thrift.LIST, 3); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T write field begin error 3:Analyzers: ", p), err)
+	}
+	if err := oprot.WriteListBegin(ctx, thrift.STRUCT, len(p.Analyzers)); err != nil {
+		return thrift.PrependError("error writing list begin: ", err)
+	}
+	for _, v := range p.Analyzers {
+		if err := v.Write(ctx, oprot); err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T error writing struct: ", v), err)
+		}
+	}
+	if err := oprot.WriteListEnd(ctx); err != nil {
+		return thrift.PrependError("error writing list end: ", err)
+	}
+	if err := oprot.WriteFieldEnd(ctx); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T write field end error 3:Analyzers: ", p), err)
+	}
+	return err
+}
+
+func (p *AnalyzeRequest) Equals(other *AnalyzeRequest) bool {
+	if p == other {
+		return true
+	} else if p == nil || other == nil {
+		return false
+	}
+	if !p.HostInfo.Equals(other.HostInfo) {
+		return false
+	}
+	if len(p.Artifacts) != len(other.Artifacts) {
+		return false
+	}
+	for i, _tgt := range p.Artifacts {
+		_src43 := other.Artifacts[i]
+		if !_tgt.Equals(_src43) {
+			return false
+		}
+	}
+	if len(p.Analyzers) != len(other.Analyzers) {
+		return false
+	}
+	for i, _tgt := range p.Analyzers {
+		_src44 := other.Analyzers[i]
+		if !_tgt.Equals(_src44) {
+			return false
+		}
+	}
+	return true
+}
+
+func (p *AnalyzeRequest) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("AnalyzeRequest(%+v)", *p)
+}
+
+// Attributes:
+//   - ErrorClass
+//   - Description
+type Error struct {
+	ErrorClass  ErrorClass `thrift:"ErrorClass,1" db:"ErrorClass" json:"ErrorClass"`
+	Description string     `thrift:"Description,2" db:"Description" json:"Description"`
+}
+
+func NewError() *Error {
+	return &Error{}
+}
+
+func (p *Error) GetErrorClass() ErrorClass {
+	return p.ErrorClass
+}
+
+func (p *Error) GetDescription() string {
+	return p.Description
+}
+func (p *Error) Read(ctx context.Context, iprot thrift.TProtocol) error {
+	if _, err := iprot.ReadStructBegin(ctx); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T read error: ", p), err)
+	}
+
+	for {
+		_, fieldTypeId, fieldId, err := iprot.ReadFieldBegin(ctx)
+		if err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T field %d read error: ", p, fieldId), err)
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+		switch fieldId {
+		case 1:
+			if fieldTypeId == thrift.I32 {
+				if err := p.ReadField1(ctx, iprot); err != nil {
+					return err
+				}
+			} else {
+				if err := iprot.Skip(ctx, fieldTypeId); err != nil {
+					return err
+				}
+			}
+		case 2:
+			if fieldTypeId == thrift.STRING {
+				if err := p.ReadField2(ctx, iprot); err != nil {
+					return err
+				}
+			} else {
+				if err := iprot.Skip(ctx, fieldTypeId); err != nil {
+					return err
+				}
+			}
+		default:
+			if err := iprot.Skip(ctx, fieldTypeId); err != nil {
+				return err
+			}
+		}
+		if err := iprot.ReadFieldEnd(ctx); err != nil {
+			return err
+		}
+	}
+	if err := iprot.ReadStructEnd(ctx); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
+	}
+	return nil
+}
+
+func (p *Error) ReadField1(ctx context.Context, iprot thrift.TProtocol) error {
+	if v, err := iprot.ReadI32(ctx); err != nil {
+		return thrift.PrependError("error reading field 1: ", err)
+	} else {
+		temp := ErrorClass(v)
+		p.ErrorClass = temp
+	}
+	return nil
+}
+
+func (p *Error) ReadField2(ctx context.Context, iprot thrift.TProtocol) error {
+	if v, err := iprot.ReadString(ctx); err != nil {
+		return thrift.PrependError("error reading field 2: ", err)
+	} else {
+		p.Description = v
+	}
+	return nil
+}
+
+func (p *Error) Write(ctx context.Context, oprot thrift.TProtocol) error {
+	if err := oprot.WriteStructBegin(ctx, "Error"); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
+	}
+	if p != nil {
+		if err := p.writeField1(ctx, oprot); err != nil {
+			return err
+		}
+		if err := p.writeField2(ctx, oprot); err != nil {
+			return err
+		}
+	}
+	if err := oprot.WriteFieldStop(ctx); err != nil {
+		return thrift.PrependError("write field stop error: ", err)
+	}
+	if err := oprot.WriteStructEnd(ctx); err != nil {
+		return thrift.PrependError("write struct stop error: ", err)
+	}
+	return nil
+}
+
+func (p *Error) writeField1(ctx context.Context, oprot thrift.TProtocol) (err error) {
+	if err := oprot.WriteFieldBegin(ctx, "ErrorClass", thrift.I32, 1); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T write field begin error 1:ErrorClass: ", p), err)
+	}
+	if err := oprot.WriteI32(ctx, int32(p.ErrorClass)); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T.ErrorClass (1) field write error: ", p), err)
+	}
+	if err := oprot.WriteFieldEnd(ctx); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T write field end error 1:ErrorClass: ", p), err)
+	}
+	return err
+}
+
+func (p *Error) writeField2(ctx context.Context, oprot thrift.TProtocol) (err error) {
+	if err := oprot.WriteFieldBegin(ctx, "Description", thrift.STRING, 2); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T write field begin error 2:Description: ", p), err)
+	}
+	if err := oprot.WriteString(ctx, string(p.Description)); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T.Description (2) field write error: ", p), err)
+	}
+	if err := oprot.WriteFieldEnd(ctx); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T write field end error 2:Description: ", p), err)
+	}
+	return err
+}
+
+func (p *Error) Equals(other *Error) bool {
+	if p == other {
+		return true
+	} else if p == nil || other == nil {
+		return false
+	}
+	if p.ErrorClass != other.ErrorClass {
+		return false
+	}
+	if p.Description != other.Description {
+		return false
+	}
+	return true
+}
+
+func (p *Error) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("Error(%+v)", *p)
+}
+
+// Attributes:
+//   - AnalyzerName
+//   - AnalyzerOutcome
+//   - ProcessedInputJSON
+//   - AnalyzerVersion
+type AnalyzerResult_ struct {
+	AnalyzerName       string           `thrift:"AnalyzerName,1" db:"AnalyzerName" json:"AnalyzerName"`
+	AnalyzerOutcome    *AnalyzerOutcome `thrift:"AnalyzerOutcome,2" db:"AnalyzerOutcome" json:"AnalyzerOutcome"`
+	ProcessedInputJSON *string          `thrift:"ProcessedInputJSON,3" db:"ProcessedInputJSON" json:"ProcessedInputJSON,omitempty"`
+	AnalyzerVersion    *string          `thrift:"AnalyzerVersion,4" db:"AnalyzerVersion" json:"AnalyzerVersion,omitempty"`
+}
+
+func NewAnalyzerResult_() *AnalyzerResult_ {
+	return &AnalyzerResult_{}
+}
+
+func (p *AnalyzerResult_) GetAnalyzerName() string {
+	return p.AnalyzerName
+}
+
+var AnalyzerResult__AnalyzerOutcome_DEFAULT *AnalyzerOutcome
+
+func (p *AnalyzerResult_) GetAnalyzerOutcome() *AnalyzerOutcome {
+	if !p.IsSetAnalyzerOutcome() {
+		return AnalyzerResult__AnalyzerOutcome_DEFAULT
+	}
+	return p.AnalyzerOutcome
+}
+
+var AnalyzerResult__ProcessedInputJSON_DEFAULT string
+
+func (p *AnalyzerResult_) GetProcessedInputJSON() string {
+	if !p.IsSetProcessedInputJSON() {
+		return AnalyzerResult__ProcessedInputJSON_DEFAULT
+	}
+	return *p.ProcessedInputJSON
+}
+
+var AnalyzerResult__AnalyzerVersion_DEFAULT string
+
+func (p *AnalyzerResult_) GetAnalyzerVersion() string {
+	if !p.IsSetAnalyzerVersion() {
+		return AnalyzerResult__AnalyzerVersion_DEFAULT
+	}
+	return *p.AnalyzerVersion
+}
+func (p *AnalyzerResult_) IsSetAnalyzerOutcome() bool {
+	return p.AnalyzerOutcome != nil
+}
+
+func (p *AnalyzerResult_) IsSetProcessedInputJSON() bool {
+	return p.ProcessedInputJSON != nil
+}
+
+func (p *AnalyzerResult_) IsSetAnalyzerVersion() bool {
+	return p.AnalyzerVersion != nil
+}
+
+func (p *AnalyzerResult_) Read(ctx context.Context, iprot thrift.TProtocol) error {
+	if _, err := iprot.ReadStructBegin(ctx); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T read error: ", p), err)
+	}
+
+	for {
+		_, fieldTypeId, fieldId, err := iprot.ReadFieldBegin(ctx)
+		if err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T field %d read error: ", p, fieldId), err)
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+		switch fieldId {
+		case 1:
+			if fieldTypeId == thrift.STRING {
+				if err := p.ReadField1(ctx, iprot); err != nil {
+					return err
+				}
+			} else {
+				if err := iprot.Skip(ctx, fieldTypeId); err != nil {
+					return err
+				}
+			}
+		case 2:
+			if fieldTypeId == thrift.STRUCT {
+				if err := p.ReadField2(ctx, iprot); err != nil {
+					return err
+				}
+			} else {
+				if err := iprot.Skip(ctx, fieldTypeId); err != nil {
+					return err
+				}
+			}
+		case 3:
+			if fieldTypeId == thrift.STRING {
+				if err := p.ReadField3(ctx, iprot); err != nil {
+					return err
+				}
+			} else {
+				if err := iprot.Skip(ctx, fieldTypeId); err != nil {
+					return err
+				}
+			}
+		case 4:
+			if fieldTypeId == thrift.STRING {
+				if err := p.ReadField4(ctx, iprot); err != nil {
+					return err
+				}
+			} else {
+				if err := iprot.Skip(ctx, fieldTypeId); err != nil {
+					return err
+				}
+			}
+		default:
+			if err := iprot.Skip(ctx, fieldTypeId); err != nil {
+				return err
+			}
+		}
+		if err := iprot.ReadFieldEnd(ctx); err != nil {
+			return err
+		}
+	}
+	if err := iprot.ReadStructEnd(ctx); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
+	}
+	return nil
+}
+
+func (p *AnalyzerResult_) ReadField1(ctx context.Context, iprot thrift.TProtocol) error {
+	if v, err := iprot.ReadString(ctx); err != nil {
+		return thrift.PrependError("error reading field 1: ", err)
+	} else {
+		p.AnalyzerName = v
+	}
+	return nil
+}
+
+func (p *AnalyzerResult_) ReadField2(ctx context.Context, iprot thrift.TProtocol) error {
+	p.AnalyzerOutcome = &AnalyzerOutcome{}
+	if err := p.AnalyzerOutcome.Read(ctx, iprot); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T error reading struct: ", p.AnalyzerOutcome), err)
+	}
+	return nil
+}
+
+func (p *AnalyzerResult_) ReadField3(ctx context.Context, iprot thrift.TProtocol) error {
+	if v, err := iprot.ReadString(ctx); err != nil {
+		return thrift.PrependError("error reading field 3: ", err)
+	} else {
+		p.ProcessedInputJSON = &v
+	}
+	return nil
+}
+
+func (p *AnalyzerResult_) ReadField4(ctx context.Context, iprot thrift.TProtocol) error {
+	if v, err := iprot.ReadString(ctx); err != nil {
+		return thrift.PrependError("error reading field 4: ", err)
+	} else {
+		p.AnalyzerVersion = &v
+	}
+	return nil
+}
+
+func (p *AnalyzerResult_) Write(ctx context.Context, oprot thrift.TProtocol) error {
+	if err := oprot.WriteStructBegin(ctx, "AnalyzerResult"); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
+	}
+	if p != nil {
+		if err := p.writeField1(ctx, oprot); err != nil {
+			return err
+		}
+		if err := p.writeField2(ctx, oprot); err != nil {
+			return err
+		}
+		if err := p.writeField3(ctx, oprot); err != nil {
+			return err
+		}
+		if err := p.writeField4(ctx, oprot); err != nil {
+			return err
+		}
+	}
+	if err := oprot.WriteFieldStop(ctx); err != nil {
+		return thrift.PrependError("write field stop error: ", err)
+	}
+	if err := oprot.WriteStructEnd(ctx); err != nil {
+		return thrift.PrependError("write struct stop error: ", err)
+	}
+	return nil
+}
+
+func (p *AnalyzerResult_) writeField1(ctx context.Context, oprot thrift.TProtocol) (err error) {
+	if err := oprot.WriteFieldBegin(ctx, "AnalyzerName", thrift.STRING, 1); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T write field begin error 1:AnalyzerName: ", p), err)
+	}
+	if err := oprot.WriteString(ctx, string(p.AnalyzerName)); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T.AnalyzerName (1) field write error: ", p), err)
+	}
+	if err := oprot.WriteFieldEnd(ctx); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T write field end error 1:AnalyzerName: ", p), err)
+	}
+	return err
+}
+
+func (p *AnalyzerResult_) writeField2(ctx context.Context, oprot thrift.TProtocol) (err error) {
+	if err := oprot.WriteFieldBegin(ctx, "AnalyzerOutcome", thrift.STRUCT, 2); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T write field begin error 2:AnalyzerOutcome: ", p), err)
+	}
+	if err := p.AnalyzerOutcome.Write(ctx, oprot); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T error writing struct: ", p.AnalyzerOutcome), err)
+	}
+	if err := oprot.WriteFieldEnd(ctx); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T write field end error 2:AnalyzerOutcome: ", p), err)
+	}
+	return err
+}
+
+func (p *AnalyzerResult_) writeField3(ctx context.Context, oprot thrift.TProtocol) (err error) {
+	if p.IsSetProcessedInputJSON() {
+		if err := oprot.WriteFieldBegin(ctx, "ProcessedInputJSON", thrift.STRING, 3); err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T write field begin error 3:ProcessedInputJSON: ", p), err)
+		}
+		if err := oprot.WriteString(ctx, string(*p.ProcessedInputJSON)); err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T.ProcessedInputJSON (3) field write error: ", p), err)
+		}
+		if err := oprot.WriteFieldEnd(ctx); err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T write field end error 3:ProcessedInputJSON: ", p), err)
+		}
+	}
+	return err
+}
+
+func (p *AnalyzerResult_) writeField4(ctx context.Context, oprot thrift.TProtocol) (err error) {
+	if p.IsSetAnalyzerVersion() {
+		if err := oprot.WriteFieldBegin(ctx, "AnalyzerVersion", thrift.STRING, 4); err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T write field begin error 4:AnalyzerVersion: ", p), err)
+		}
+		if err := oprot.WriteString(ctx, string(*p.AnalyzerVersion)); err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T.AnalyzerVersion (4) field write error: ", p), err)
+		}
+		if err := oprot.WriteFieldEnd(ctx); err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T write field end error 4:AnalyzerVersion: ", p), err)
+		}
+	}
+	return err
+}
+
+func (p *AnalyzerResult_) Equals(other *AnalyzerResult_) bool {
+	if p == other {
+		return true
+	} else if p == nil || other == nil {
+		return false
+	}
+	if p.AnalyzerName != other.AnalyzerName {
+		return false
+	}
+	if !p.AnalyzerOutcome.Equals(other.AnalyzerOutcome) {
+		return false
+	}
+	if p.ProcessedInputJSON != other.ProcessedInputJSON {
+		if p.ProcessedInputJSON == nil || other.ProcessedInputJSON == nil {
+			return false
+		}
+		if (*p.ProcessedInputJSON) != (*other.ProcessedInputJSON) {
+			return false
+		}
+	}
+	if p.AnalyzerVersion != other.AnalyzerVersion {
+		if p.AnalyzerVersion == nil || other.AnalyzerVersion == nil {
+			return false
+		}
+		if (*p.AnalyzerVersion) != (*other.AnalyzerVersion) {
+			return false
+		}
+	}
+	return true
+}
+
+func (p *AnalyzerResult_) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("AnalyzerResult_(%+v)", *p)
+}
+
+// Attributes:
+//   - Report
+//   - Err
+type AnalyzerOutcome struct {
+	Report *analyzerreport.AnalyzerReport `thrift:"Report,1" db:"Report" json:"Report,omitempty"`
+	Err    *Error                         `thrift:"Err,2" db:"Err" json:"Err,omitempty"`
+}
+
+func NewAnalyzerOutcome() *AnalyzerOutcome {
+	return &AnalyzerOutcome{}
+}
+
+var AnalyzerOutcome_Report_DEFAULT *analyzerreport.AnalyzerReport
+
+func (p *AnalyzerOutcome) GetReport() *analyzerreport.AnalyzerReport {
+	if !p.IsSetReport() {
+		return AnalyzerOutcome_Report_DEFAULT
+	}
+	return p.Report
+}
+
+var AnalyzerOutcome_Err_DEFAULT *Error
+
+func (p *AnalyzerOutcome) GetErr() *Error {
+	if !p.IsSetErr() {
+		return AnalyzerOutcome_Err_DEFAULT
+	}
+	return p.Err
+}
+func (p *AnalyzerOutcome) CountSetFieldsAnalyzerOutcome() int {
+	count := 0
+	if p.IsSetReport() {
+		count++
+	}
+	if p.IsSetErr() {
+		count++
+	}
+	return count
+
+}
+
+func (p *AnalyzerOutcome) IsSetReport() bool {
+	return p.Report != nil
+}
+
+func (p *AnalyzerOutcome) IsSetErr() bool {
+	return p.Err != nil
+}
+
+func (p *AnalyzerOutcome) Read(ctx context.Context, iprot thrift.TProtocol) error {
+	if _, err := iprot.ReadStructBegin(ctx); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T read error: ", p), err)
+	}
+
+	for {
+		_, fieldTypeId, fieldId, err := iprot.ReadFieldBegin(ctx)
+		if err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T field %d read error: ", p, fieldId), err)
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+		switch fieldId {
+		case 1:
+			if fieldTypeId == thrift.STRUCT {
+				if err := p.ReadField1(ctx, iprot); err != nil {
+					return err
+				}
+			} else {
+				if err := iprot.Skip(ctx, fieldTypeId); err != nil {
+					return err
+				}
+			}
+		case 2:
+			if fieldTypeId == thrift.STRUCT {
+				if err := p.ReadField2(ctx, iprot); err != nil {
+					return err
+				}
+			} else {
+				if err := iprot.Skip(ctx, fieldTypeId); err != nil {
+					return err
+				}
+			}
+		default:
+			if err := iprot.Skip(ctx, fieldTypeId); err != nil {
+				return err
+			}
+		}
+		if err := iprot.ReadFieldEnd(ctx); err != nil {
+			return err
+		}
+	}
+	if err := iprot.ReadStructEnd(ctx); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
+	}
+	return nil
+}
+
+func (p *AnalyzerOutcome) ReadField1(ctx context.Context, iprot thrift.TProtocol) error {
+	p.Report = &analyzerreport.AnalyzerReport{}
+	if err := p.Report.Read(ctx, iprot); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T error reading struct: ", p.Report), err)
+	}
+	return nil
+}
+
+func (p *AnalyzerOutcome) ReadField2(ctx context.Context, iprot thrift.TProtocol) error {
+	p.Err = &Error{}
+	if err := p.Err.Read(ctx, iprot); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T error reading struct: ", p.Err), err)
+	}
+	return nil
+}
+
+func (p *AnalyzerOutcome) Write(ctx context.Context, oprot thrift.TProtocol) error {
+	if c := p.CountSetFieldsAnalyzerOutcome(); c != 1 {
+		return fmt.Errorf("%T write union: exactly one field must be set (%d set).", p, c)
+	}
+	if err := oprot.WriteStructBegin(ctx, "AnalyzerOutcome"); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
+	}
+	if p != nil {
+		if err := p.writeField1(ctx, oprot); err != nil {
+			return err
+		}
+		if err := p.writeField2(ctx, oprot); err != nil {
+			return err
+		}
+	}
+	if err := oprot.WriteFieldStop(ctx); err != nil {
+		return thrift.PrependError("write field stop error: ", err)
+	}
+	if err := oprot.WriteStructEnd(ctx); err != nil {
+		return thrift.PrependError("write struct stop error: ", err)
+	}
+	return nil
+}
+
+func (p *AnalyzerOutcome) writeField1(ctx context.Context, oprot thrift.TProtocol) (err error) {
+	if p.IsSetReport() {
+		if err := oprot.WriteFieldBegin(ctx, "Report", thrift.STRUCT, 1); err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T write field begin error 1:Report: ", p), err)
+		}
+		if err := p.Report.Write(ctx, oprot); err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T error writing struct: ", p.Report), err)
+		}
+		if err := oprot.WriteFieldEnd(ctx); err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T write field end error 1:Report: ", p), err)
+		}
+	}
+	return err
+}
+
+func (p *AnalyzerOutcome) writeField2(ctx context.Context, oprot thrift.TProtocol) (err error) {
+	if p.IsSetErr() {
+		if err := oprot.WriteFieldBegin(ctx, "Err", thrift.STRUCT, 2); err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T write field begin error 2:Err: ", p), err)
+		}
+		if err := p.Err.Write(ctx, oprot); err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T error writing struct: ", p.Err), err)
+		}
+		if err := oprot.WriteFieldEnd(ctx); err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T write field end error 2:Err: ", p), err)
+		}
+	}
+	return err
+}
+
+func (p *AnalyzerOutcome) Equals(other *AnalyzerOutcome) bool {
+	if p == other {
+		return true
+	} else if p == nil || other == nil {
+		return false
+	}
+	if !p.Report.Equals(other.Report) {
+		return false
+	}
+	if !p.Err.Equals(other.Err) {
+		return false
+	}
+	return true
+}
+
+func (p *AnalyzerOutcome) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("AnalyzerOutcome(%+v)", *p)
+}
+
+// Attributes:
+//   - AnalyzerName
+//   - Rule
+//   - Verdict
+//   - Description
+type VerdictReason struct {
+	AnalyzerName string  `thrift:"AnalyzerName,1" db:"AnalyzerName" json:"AnalyzerName"`
+	Rule         string  `thrift:"Rule,2" db:"Rule" json:"Rule"`
+	Verdict      Verdict `thrift:"Verdict,3" db:"Verdict" json:"Verdict"`
+	Description  string  `thrift:"Description,4" db:"Description" json:"Description"`
+}
+
+func NewVerdictReason() *VerdictReason {
+	return &VerdictReason{}
+}
+
+func (p *VerdictReason) GetAnalyzerName() string {
+	return p.AnalyzerName
+}
+
+func (p *VerdictReason) GetRule() string {
+	return p.Rule
+}
+
+func (p *VerdictReason) GetVerdict() Verdict {
+	return p.Verdict
+}
+
+func (p *VerdictReason) GetDescription() string {
+	return p.Description
+}
+func (p *VerdictReason) Read(ctx context.Context, iprot thrift.TProtocol) error {
+	if _, err := iprot.ReadStructBegin(ctx); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T read error: ", p), err)
+	}
+
+	for {
+		_, fieldTypeId, fieldId, err := iprot.ReadFieldBegin(ctx)
+		if err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T field %d read error: ", p, fieldId), err)
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+		switch fieldId {
+		case 1:
+			if fieldTypeId == thrift.STRING {
+				if err := p.ReadField1(ctx, iprot); err != nil {
+					return err
+				}
+			} else {
+				if err := iprot.Skip(ctx, fieldTypeId); err != nil {
+					return err
+				}
+			}
+		case 2:
+			if fieldTypeId == thrift.STRING {
+				if err := p.ReadField2(ctx, iprot); err != nil {
+					return err
+				}
+			} else {
+				if err := iprot.Skip(ctx, fieldTypeId); err != nil {
+					return err
+				}
+			}
+		case 3:
+			if fieldTypeId == thrift.I32 {
+				if err := p.ReadField3(ctx, iprot); err != nil {
+					return err
+				}
+			} else {
+				if err := iprot.Skip(ctx, fieldTypeId); err != nil {
+					return err
+				}
+			}
+		case 4:
+			if fieldTypeId == thrift.STRING {
+				if err := p.ReadField4(ctx, iprot); err != nil {
+					return err
+				}
+			} else {
+				if err := iprot.Skip(ctx, fieldTypeId); err != nil {
+					return err
+				}
+			}
+		default:
+			if err := iprot.Skip(ctx, fieldTypeId); err != nil {
+				return err
+			}
+		}
+		if err := iprot.ReadFieldEnd(ctx); err != nil {
+			return err
+		}
+	}
+	if err := iprot.ReadStructEnd(ctx); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
+	}
+	return nil
+}
+
+func (p *VerdictReason) ReadField1(ctx context.Context, iprot thrift.TProtocol) error {
+	if v, err := iprot.ReadString(ctx); err != nil {
+		return thrift.PrependError("error reading field 1: ", err)
+	} else {
+		p.AnalyzerName = v
+	}
+	return nil
+}
+
+func (p *VerdictReason) ReadField2(ctx context.Context, iprot thrift.TProtocol) error {
+	if v, err := iprot.ReadString(ctx); err != nil {
+		return thrift.PrependError("error reading field 2: ", err)
+	} else {
+		p.Rule = v
+	}
+	return nil
+}
+
+func (p *VerdictReason) ReadField3(ctx context.Context, iprot thrift.TProtocol) error {
+	if v, err := iprot.ReadI32(ctx); err != nil {
+		return thrift.PrependError("error reading field 3: ", err)
+	} else {
+		temp := Verdict(v)
+		p.Verdict = temp
+	}
+	return nil
+}
+
+func (p *VerdictReason) ReadField4(ctx context.Context, iprot thrift.TProtocol) error {
+	if v, err := iprot.ReadString(ctx); err != nil {
+		return thrift.PrependError("error reading field 4: ", err)
+	} else {
+		p.Description = v
+	}
+	return nil
+}
+
+func (p *VerdictReason) Write(ctx context.Context, oprot thrift.TProtocol) error {
+	if err := oprot.WriteStructBegin(ctx, "VerdictReason"); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
+	}
+	if p != nil {
+		if err := p.writeField1(ctx, oprot); err != nil {
+			return err
+		}
+		if err := p.writeField2(ctx, oprot); err != nil {
+			return err
+		}
+		if err := p.writeField3(ctx, oprot); err != nil {
+			return err
+		}
+		if err := p.writeField4(ctx, oprot); err != nil {
+			return err
+		}
+	}
+	if err := oprot.WriteFieldStop(ctx); err != nil {
+		return thrift.PrependError("write field stop error: ", err)
+	}
+	if err := oprot.WriteStructEnd(ctx); err != nil {
+		return thrift.PrependError("write struct stop error: ", err)
+	}
+	return nil
+}
+
+func (p *VerdictReason) writeField1(ctx context.Context, oprot thrift.TProtocol) (err error) {
+	if err := oprot.WriteFieldBegin(ctx, "AnalyzerName", thrift.STRING, 1); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T write field begin error 1:AnalyzerName: ", p), err)
+	}
+	if err := oprot.WriteString(ctx, string(p.AnalyzerName)); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T.AnalyzerName (1) field write error: ", p), err)
+	}
+	if err := oprot.WriteFieldEnd(ctx); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T write field end error 1:AnalyzerName: ", p), err)
+	}
+	return err
+}
+
+func (p *VerdictReason) writeField2(ctx context.Context, oprot thrift.TProtocol) (err error) {
+	if err := oprot.WriteFieldBegin(ctx, "Rule", thrift.STRING, 2); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T write field begin error 2:Rule: ", p), err)
+	}
+	if err := oprot.WriteString(ctx, string(p.Rule)); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T.Rule (2) field write error: ", p), err)
+	}
+	if err := oprot.WriteFieldEnd(ctx); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T write field end error 2:Rule: ", p), err)
+	}
+	return err
+}
+
+func (p *VerdictReason) writeField3(ctx context.Context, oprot thrift.TProtocol) (err error) {
+	if err := oprot.WriteFieldBegin(ctx, "Verdict", thrift.I32, 3); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T write field begin error 3:Verdict: ", p), err)
+	}
+	if err := oprot.WriteI32(ctx, int32(p.Verdict)); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T.Verdict (3) field write error: ", p), err)
+	}
+	if err := oprot.WriteFieldEnd(ctx); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T write field end error 3:Verdict: ", p), err)
+	}
+	return err
+}
+
+func (p *VerdictReason) writeField4(ctx context.Context, oprot thrift.TProtocol) (err error) {
+	if err := oprot.WriteFieldBegin(ctx, "Description", thrift.STRING, 4); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T write field begin error 4:Description: ", p), err)
+	}
+	if err := oprot.WriteString(ctx, string(p.Description)); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T.Description (4) field write error: ", p), err)
+	}
+	if err := oprot.WriteFieldEnd(ctx); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T write field end error 4:Description: ", p), err)
+	}
+	return err
+}
+
+func (p *VerdictReason) Equals(other *VerdictReason) bool {
+	if p == other {
+		return true
+	} else if p == nil || other == nil {
+		return false
+	}
+	if p.AnalyzerName != other.AnalyzerName {
+		return false
+	}
+	if p.Rule != other.Rule {
+		return false
+	}
+	if p.Verdict != other.Verdict {
+		return false
+	}
+	if p.Description != other.Description {
+		return false
+	}
+	return true
+}
+
+func (p *VerdictReason) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("VerdictReason(%+v)", *p)
+}
+
+// Attributes:
+//   - Verdict
+//   - Policy
+//   - Reasons
+type AnalyzeVerdict struct {
+	Verdict Verdict          `thrift:"Verdict,1" db:"Verdict" json:"Verdict"`
+	Policy  string           `thrift:"Policy,2" db:"Policy" json:"Policy"`
+	Reasons []*VerdictReason `thrift:"Reasons,3" db:"Reasons" json:"Reasons"`
+}
+
+func NewAnalyzeVerdict() *AnalyzeVerdict {
+	return &AnalyzeVerdict{}
+}
+
+func (p *AnalyzeVerdict) GetVerdict() Verdict {
+	return p.Verdict
+}
+
+func (p *AnalyzeVerdict) GetPolicy() string {
+	return p.Policy
+}
+
+func (p *AnalyzeVerdict) GetReasons() []*VerdictReason {
+	return p.Reasons
+}
+func (p *AnalyzeVerdict) Read(ctx context.Context, iprot thrift.TProtocol) error {
+	if _, err := iprot.ReadStructBegin(ctx); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T read error: ", p), err)
+	}
+
+	for {
+		_, fieldTypeId, fieldId, err := iprot.ReadFieldBegin(ctx)
+		if err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T field %d read error: ", p, fieldId), err)
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+		switch fieldId {
+		case 1:
+			if fieldTypeId == thrift.I32 {
+				if err := p.ReadField1(ctx, iprot); err != nil {
+					return err
+				}
+			} else {
+				if err := iprot.Skip(ctx, fieldTypeId); err != nil {
+					return err
+				}
+			}
+		case 2:
+			if fieldTypeId == thrift.STRING {
+				if err := p.ReadField2(ctx, iprot); err != nil {
+					return err
+				}
+			} else {
+				if err := iprot.Skip(ctx, fieldTypeId); err != nil {
+					return err
+				}
+			}
+		case 3:
+			if fieldTypeId == thrift.LIST {
+				if err := p.ReadField3(ctx, iprot); err != nil {
+					return err
+				}
+			} else {
+				if err := iprot.Skip(ctx, fieldTypeId); err != nil {
+					return err
+				}
+			}
+		default:
+			if err := iprot.Skip(ctx, fieldTypeId); err != nil {
+				return err
+			}
+		}
+		if err := iprot.ReadFieldEnd(ctx); err != nil {
+			return err
+		}
+	}
+	if err := iprot.ReadStructEnd(ctx); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
+	}
+	return nil
+}
+
+func (p *AnalyzeVerdict) ReadField1(ctx context.Context, iprot thrift.TProtocol) error {
+	if v, err := iprot.ReadI32(ctx); err != nil {
+		return thrift.PrependError("error reading field 1: ", err)
+	} else {
+		temp := Verdict(v)
+		p.Verdict = temp
+	}
+	return nil
+}
+
+func (p *AnalyzeVerdict) ReadField2(ctx context.Context, iprot thrift.TProtocol) error {
+	if v, err := iprot.ReadString(ctx); err != nil {
+		return thrift.PrependError("error reading field 2: ", err)
+	} else {
+		p.Policy = v
+	}
+	return nil
+}
+
+func (p *AnalyzeVerdict) ReadField3(ctx context.Context, iprot thrift.TProtocol) error {
+	_, size, err := iprot.ReadListBegin(ctx)
+	if err != nil {
+		return thrift.PrependError("error reading list begin: ", err)
+	}
+	tSlice := make([]*VerdictReason, 0, size)
+	p.Reasons = tSlice
+	for i := 0; i < size; i++ {
+		_elem45 := &VerdictReason{}
+		if err := _elem45.Read(ctx, iprot); err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T error reading struct: ", _elem45), err)
+		}
+		p.Reasons = append(p.Reasons, _elem45)
+	}
+	if err := iprot.ReadListEnd(ctx); err != nil {
+		return thrift.PrependError("error reading list end: ", err)
+	}
+	return nil
+}
+
+func (p *AnalyzeVerdict) Write(ctx context.Context, oprot thrift.TProtocol) error {
+	if err := oprot.WriteStructBegin(ctx, "AnalyzeVerdict"); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
+	}
+	if p != nil {
+		if err := p.writeField1(ctx, oprot); err != nil {
+			return err
+		}
+		if err := p.writeField2(ctx, oprot); err != nil {
+			return err
+		}
+		if err := p.writeField3(ctx, oprot); err != nil {
+			return err
+		}
+	}
+	if err := oprot.WriteFieldStop(ctx); err != nil {
+		return thrift.PrependError("write field stop error: ", err)
+	}
+	if err := oprot.WriteStructEnd(ctx); err != nil {
+		return thrift.PrependError("write struct stop error: ", err)
+	}
+	return nil
+}
+
+func (p *AnalyzeVerdict) writeField1(ctx context.Context, oprot thrift.TProtocol) (err error) {
+	if err := oprot.WriteFieldBegin(ctx, "Verdict", thrift.I32, 1); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T write field begin error 1:Verdict: ", p), err)
+	}
+	if err := oprot.WriteI32(ctx, int32(p.Verdict)); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T.Verdict (1) field write error: ", p), err)
+	}
+	if err := oprot.WriteFieldEnd(ctx); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T write field end error 1:Verdict: ", p), err)
+	}
+	return err
+}
+
+func (p *AnalyzeVerdict) writeField2(ctx context.Context, oprot thrift.TProtocol) (err error) {
+	if err := oprot.WriteFieldBegin(ctx, "Policy", thrift.STRING, 2); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T write field begin error 2:Policy: ", p), err)
+	}
+	if err := oprot.WriteString(ctx, string(p.Policy)); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T.Policy (2) field write error: ", p), err)
+	}
+	if err := oprot.WriteFieldEnd(ctx); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T write field end error 2:Policy: ", p), err)
+	}
+	return err
+}
+
+func (p *AnalyzeVerdict) writeField3(ctx context.Context, oprot thrift.TProtocol) (err error) {
+	if err := oprot.WriteFieldBegin(ctx, "Reasons", thrift.LIST, 3); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T write field begin error 3:Reasons: ", p), err)
+	}
+	if err := oprot.WriteListBegin(ctx, thrift.STRUCT, len(p.Reasons)); err != nil {
+		return thrift.PrependError("error writing list begin: ", err)
+	}
+	for _, v := range p.Reasons {
+		if err := v.Write(ctx, oprot); err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T error writing struct: ", v), err)
+		}
+	}
+	if err := oprot.WriteListEnd(ctx); err != nil {
+		return thrift.PrependError("error writing list end: ", err)
+	}
+	if err := oprot.WriteFieldEnd(ctx); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T write field end error 3:Reasons: ", p), err)
+	}
+	return err
+}
+
+func (p *AnalyzeVerdict) Equals(other *AnalyzeVerdict) bool {
+	if p == other {
+		return true
+	} else if p == nil || other == nil {
+		return false
+	}
+	if p.Verdict != other.Verdict {
+		return false
+	}
+	if p.Policy != other.Policy {
+		return false
+	}
+	if len(p.Reasons) != len(other.Reasons) {
+		return false
+	}
+	for i, _tgt := range p.Reasons {
+		_src46 := other.Reasons[i]
+		if !_tgt.Equals(_src46) {
+			return false
+		}
+	}
+	return true
+}
+
+func (p *AnalyzeVerdict) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("AnalyzeVerdict(%+v)", *p)
+}
+
+// Attributes:
+//   - JobID
+//   - Results
+//   - Verdict
+type AnalyzeResult_ struct {
+	JobID   []byte             `thrift:"JobID,1" db:"JobID" json:"JobID"`
+	Results []*AnalyzerResult_ `thrift:"Results,2" db:"Results" json:"Results"`
+	Verdict *AnalyzeVerdict    `thrift:"Verdict,3" db:"Verdict" json:"Verdict,omitempty"`
+}
+
+func NewAnalyzeResult_() *AnalyzeResult_ {
+	return &AnalyzeResult_{}
+}
+
+func (p *AnalyzeResult_) GetJobID() []byte {
+	return p.JobID
+}
+
+func (p *AnalyzeResult_) GetResults() []*AnalyzerResult_ {
+	return p.Results
+}
+
+var AnalyzeResult__Verdict_DEFAULT *AnalyzeVerdict
+
+func (p *AnalyzeResult_) GetVerdict() *AnalyzeVerdict {
+	if !p.IsSetVerdict() {
+		return AnalyzeResult__Verdict_DEFAULT
+	}
+	return p.Verdict
+}
+func (p *AnalyzeResult_) IsSetVerdict() bool {
+	return p.Verdict != nil
+}
+
+func (p *AnalyzeResult_) Read(ctx context.Context, iprot thrift.TProtocol) error {
+	if _, err := iprot.ReadStructBegin(ctx); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T read error: ", p), err)
+	}
+
+	for {
+		_, fieldTypeId, fieldId, err := iprot.ReadFieldBegin(ctx)
+		if err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T field %d read error: ", p, fieldId), err)
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+		switch fieldId {
+		case 1:
+			if fieldTypeId == thrift.STRING {
+				if err := p.ReadField1(ctx, iprot); err != nil {
+					return err
+				}
+			} else {
+				if err := iprot.Skip(ctx, fieldTypeId); err != nil {
+					return err
+				}
+			}
+		case 2:
+			if fieldTypeId == thrift.LIST {
+				if err := p.ReadField2(ctx, iprot); err != nil {
+					return err
+				}
+			} else {
+				if err := iprot.Skip(ctx, fieldTypeId); err != nil {
+					return err
+				}
+			}
+		case 3:
+			if fieldTypeId == thrift.STRUCT {
+				if err := p.ReadField3(ctx, iprot); err != nil {
+					return err
+				}
+			} else {
+				if err := iprot.Skip(ctx, fieldTypeId); err != nil {
+					return err
+				}
+			}
+		default:
+			if err := iprot.Skip(ctx, fieldTypeId); err != nil {
+				return err
+			}
+		}
+		if err := iprot.ReadFieldEnd(ctx); err != nil {
+			return err
+		}
+	}
+	if err := iprot.ReadStructEnd(ctx); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
+	}
+	return nil
+}
+
+func (p *AnalyzeResult_) ReadField1(ctx context.Context, iprot thrift.TProtocol) error {
+	if v, err := iprot.ReadBinary(ctx); err != nil {
+		return thrift.PrependError("error reading field 1: ", err)
+	} else {
+		p.JobID = v
+	}
+	return nil
+}
+
+func (p *AnalyzeResult_) ReadField2(ctx context.Context, iprot thrift.TProtocol) error {
+	_, size, err := iprot.ReadListBegin(ctx)
+	if err != nil {
+		return thrift.PrependError("error reading list begin: ", err)
+	}
+	tSlice := make([]*AnalyzerResult_, 0, size)
+	p.Results = tSlice
+	for i := 0; i < size; i++ {
+		_elem47 := &AnalyzerResult_{}
+		if err := _elem47.Read(ctx, iprot); err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T error reading struct: ", _elem47), err)
+		}
+		p.Results = append(p.Results, _elem47)
+	}
+	if err := iprot.ReadListEnd(ctx); err != nil {
+		return thrift.PrependError("error reading list end: ", err)
+	}
+	return nil
+}
+
+func (p *AnalyzeResult_) ReadField3(ctx context.Context, iprot thrift.TProtocol) error {
+	p.Verdict = &AnalyzeVerdict{}
+	if err := p.Verdict.Read(ctx, iprot); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T error reading struct: ", p.Verdict), err)
+	}
+	return nil
+}
+
+func (p *AnalyzeResult_) Write(ctx context.Context, oprot thrift.TProtocol) error {
+	if err := oprot.WriteStructBegin(ctx, "AnalyzeResult"); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
+	}
+	if p != nil {
+		if err := p.writeField1(ctx, oprot); err != nil {
+			return err
+		}
+		if err := p.writeField2(ctx, oprot); err != nil {
+			return err
+		}
+		if err := p.writeField3(ctx, oprot); err != nil {
+			return err
+		}
+	}
+	if err := oprot.WriteFieldStop(ctx); err != nil {
+		return thrift.PrependError("write field stop error: ", err)
+	}
+	if err := oprot.WriteStructEnd(ctx); err != nil {
+		return thrift.PrependError("write struct stop error: ", err)
+	}
+	return nil
+}
+
+func (p *AnalyzeResult_) writeField1(ctx context.Context, oprot thrift.TProtocol) (err error) {
+	if err := oprot.WriteFieldBegin(ctx, "JobID", thrift.STRING, 1); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T write field begin error 1:JobID: ", p), err)
+	}
+	if err := oprot.WriteBinary(ctx, p.JobID); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T.JobID (1) field write error: ", p), err)
+	}
+	if err := oprot.WriteFieldEnd(ctx); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T write field end error 1:JobID: ", p), err)
+	}
+	return err
+}
+
+func (p *AnalyzeResult_) writeField2(ctx context.Context, oprot thrift.TProtocol) (err error) {
+	if err := oprot.WriteFieldBegin(ctx, "Results", thrift.LIST, 2); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T write field begin error 2:Results: ", p), err)
+	}
+	if err := oprot.WriteListBegin(ctx, thrift.STRUCT, len(p.Results)); err != nil {
+		return thrift.PrependError("error writing list begin: ", err)
+	}
+	for _, v := range p.Results {
+		if err := v.Write(ctx, oprot); err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T error writing struct: ", v), err)
+		}
+	}
+	if err := oprot.WriteListEnd(ctx); err != nil {
+		return thrift.PrependError("error writing list end: ", err)
+	}
+	if err := oprot.WriteFieldEnd(ctx); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T write field end error 2:Results: ", p), err)
+	}
+	return err
+}
+
+func (p *AnalyzeResult_) writeField3(ctx context.Context, oprot thrift.TProtocol) (err error) {
+	if p.IsSetVerdict() {
+		if err := oprot.WriteFieldBegin(ctx, "Verdict", thrift.STRUCT, 3); err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T write field begin error 3:Verdict: ", p), err)
+		}
+		if err := p.Verdict.Write(ctx, oprot); err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T error writing struct: ", p.Verdict), err)
+		}
+		if err := oprot.WriteFieldEnd(ctx); err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T write field end error 3:Verdict: ", p), err)
+		}
+	}
+	return err
+}
+
+func (p *AnalyzeResult_) Equals(other *AnalyzeResult_) bool {
+	if p == other {
+		return true
+	} else if p == nil || other == nil {
+		return false
+	}
+	if bytes.Compare(p.JobID, other.JobID) != 0 {
+		return false
+	}
+	if len(p.Results) != len(other.Results) {
+		return false
+	}
+	for i, _tgt := range p.Results {
+		_src48 := other.Results[i]
+		if !_tgt.Equals(_src48) {
+			return false
+		}
+	}
+	if !p.Verdict.Equals(other.Verdict) {
+		return false
+	}
+	return true
+}
+
+func (p *AnalyzeResult_) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("AnalyzeResult_(%+v)", *p)
+}
+
+// Attributes:
+//   - Firmwares
+type CheckFirmwareVersionRequest struct {
+	Firmwares []*FirmwareVersion `thrift:"firmwares,1" db:"firmwares" json:"firmwares"`
+}
+
+func NewCheckFirmwareVersionRequest() *CheckFirmwareVersionRequest {
+	return &CheckFirmwareVersionRequest{}
+}
+
+func (p *CheckFirmwareVersionRequest) GetFirmwares() []*FirmwareVersion {
+	return p.Firmwares
+}
+func (p *CheckFirmwareVersionRequest) Read(ctx context.Context, iprot thrift.TProtocol) error {
+	if _, err := iprot.ReadStructBegin(ctx); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T read error: ", p), err)
+	}
+
+	for {
+		_, fieldTypeId, fieldId, err := iprot.ReadFieldBegin(ctx)
+		if err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T field %d read error: ", p, fieldId), err)
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+		switch fieldId {
+		case 1:
+			if fieldTypeId == thrift.LIST {
+				if err := p.ReadField1(ctx, iprot); err != nil {
+					return err
+				}
+			} else {
+				if err := iprot.Skip(ctx, fieldTypeId); err != nil {
+					return err
+				}
+			}
+		default:
+			if err := iprot.Skip(ctx, fieldTypeId); err != nil {
+				return err
+			}
+		}
+		if err := iprot.ReadFieldEnd(ctx); err != nil {
+			return err
+		}
+	}
+	if err := iprot.ReadStructEnd(ctx); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
+	}
+	return nil
+}
+
+func (p *CheckFirmwareVersionRequest) ReadField1(ctx context.Context, iprot thrift.TProtocol) error {
+	_, size, err := iprot.ReadListBegin(ctx)
+	if err != nil {
+		return thrift.PrependError("error reading list begin: ", err)
+	}
+	tSlice := make([]*FirmwareVersion, 0, size)
+	p.Firmwares = tSlice
+	for i := 0; i < size; i++ {
+		_elem49 := &FirmwareVersion{}
+		if err := _elem49.Read(ctx, iprot); err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T error reading struct: ", _elem49), err)
+		}
+		p.Firmwares = append(p.Firmwares, _elem49)
+	}
+	if err := iprot.ReadListEnd(ctx); err != nil {
+		return thrift.PrependError("error reading list end: ", err)
+	}
+	return nil
+}
+
+func (p *CheckFirmwareVersionRequest) Write(ctx context.Context, oprot thrift.TProtocol) error {
+	if err := oprot.WriteStructBegin(ctx, "CheckFirmwareVersionRequest"); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
+	}
+	if p != nil {
+		if err := p.writeField1(ctx, oprot); err != nil {
+			return err
+		}
+	}
+	if err := oprot.WriteFieldStop(ctx); err != nil {
+		return thrift.PrependError("write field stop error: ", err)
+	}
+	if err := oprot.WriteStructEnd(ctx); err != nil {
+		return thrift.PrependError("write struct stop error: ", err)
+	}
+	return nil
+}
+
+func (p *CheckFirmwareVersionRequest) writeField1(ctx context.Context, oprot thrift.TProtocol) (err error) {
+	if err := oprot.WriteFieldBegin(ctx, "firmwares", thrift.LIST, 1); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T write field begin error 1:firmwares: ", p), err)
+	}
+	if err := oprot.WriteListBegin(ctx, thrift.STRUCT, len(p.Firmwares)); err != nil {
+		return thrift.PrependError("error writing list begin: ", err)
+	}
+	for _, v := range p.Firmwares {
+		if err := v.Write(ctx, oprot); err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T error writing struct: ", v), err)
+		}
+	}
+	if err := oprot.WriteListEnd(ctx); err != nil {
+		return thrift.PrependError("error writing list end: ", err)
+	}
+	if err := oprot.WriteFieldEnd(ctx); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T write field end error 1:firmwares: ", p), err)
+	}
+	return err
+}
+
+func (p *CheckFirmwareVersionRequest) Equals(other *CheckFirmwareVersionRequest) bool {
+	if p == other {
+		return true
+	} else if p == nil || other == nil {
+		return false
+	}
+	if len(p.Firmwares) != len(other.Firmwares) {
+		return false
+	}
+	for i, _tgt := range p.Firmwares {
+		_src50 := other.Firmwares[i]
+		if !_tgt.Equals(_src50) {
+			return false
+		}
+	}
+	return true
+}
+
+func (p *CheckFirmwareVersionRequest) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("CheckFirmwareVersionRequest(%+v)", *p)
+}
+
+// Attributes:
+//   - ExistStatus
+type CheckFirmwareVersionResult_ struct {
+	ExistStatus []bool `thrift:"existStatus,1" db:"existStatus" json:"existStatus"`
+}
+
+func NewCheckFirmwareVersionResult_() *CheckFirmwareVersionResult_ {
+	return &CheckFirmwareVersionResult_{}
+}
+
+func (p *CheckFirmwareVersionResult_) GetExistStatus() []bool {
+	return p.ExistStatus
+}
+func (p *CheckFirmwareVersionResult_) Read(ctx context.Context, iprot thrift.TProtocol) error {
+	if _, err := iprot.ReadStructBegin(ctx); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T read error: ", p), err)
+	}
+
+	for {
+		_, fieldTypeId, fieldId, err := iprot.ReadFieldBegin(ctx)
+		if err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T field %d read error: ", p, fieldId), err)
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+		switch fieldId {
+		case 1:
+			if fieldTypeId == thrift.LIST {
+				if err := p.ReadField1(ctx, iprot); err != nil {
+					return err
+				}
+			} else {
+				if err := iprot.Skip(ctx, fieldTypeId); err != nil {
+					return err
+				}
+			}
+		default:
+			if err := iprot.Skip(ctx, fieldTypeId); err != nil {
+				return err
+			}
+		}
+		if err := iprot.ReadFieldEnd(ctx); err != nil {
+			return err
+		}
+	}
+	if err := iprot.ReadStructEnd(ctx); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
+	}
+	return nil
+}
+
+func (p *CheckFirmwareVersionResult_) ReadField1(ctx context.Context, iprot thrift.TProtocol) error {
+	_, size, err := iprot.ReadListBegin(ctx)
+	if err != nil {
+		return thrift.PrependError("error reading list begin: ", err)
+	}
+	tSlice := make([]bool, 0, size)
+	p.ExistStatus = tSlice
+	for i := 0; i < size; i++ {
+		var _elem51 bool
+		if v, err := iprot.ReadBool(ctx); err != nil {
+			return thrift.PrependError("error reading field 0: ", err)
+		} else {
+			_elem51 = v
+		}
+		p.ExistStatus = append(p.ExistStatus, _elem51)
+	}
+	if err := iprot.ReadListEnd(ctx); err != nil {
+		return thrift.PrependError("error reading list end: ", err)
+	}
+	return nil
+}
+
+func (p *CheckFirmwareVersionResult_) Write(ctx context.Context, oprot thrift.TProtocol) error {
+	if err := oprot.WriteStructBegin(ctx, "CheckFirmwareVersionResult"); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
+	}
+	if p != nil {
+		if err := p.writeField1(ctx, oprot); err != nil {
+			return err
+		}
+	}
+	if err := oprot.WriteFieldStop(ctx); err != nil {
+		return thrift.PrependError("write field stop error: ", err)
+	}
+	if err := oprot.WriteStructEnd(ctx); err != nil {
+		return thrift.PrependError("write struct stop error: ", err)
+	}
+	return nil
+}
+
+func (p *CheckFirmwareVersionResult_) writeField1(ctx context.Context, oprot thrift.TProtocol) (err error) {
+	if err := oprot.WriteFieldBegin(ctx, "existStatus", thrift.LIST, 1); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T write field begin error 1:existStatus: ", p), err)
+	}
+	if err := oprot.WriteListBegin(ctx, thrift.BOOL, len(p.ExistStatus)); err != nil {
+		return thrift.PrependError("error writing list begin: ", err)
+	}
+	for _, v := range p.ExistStatus {
+		if err := oprot.WriteBool(ctx, bool(v)); err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T. (0) field write error: ", p), err)
+		}
+	}
+	if err := oprot.WriteListEnd(ctx); err != nil {
+		return thrift.PrependError("error writing list end: ", err)
+	}
+	if err := oprot.WriteFieldEnd(ctx); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T write field end error 1:existStatus: ", p), err)
+	}
+	return err
+}
+
+func (p *CheckFirmwareVersionResult_) Equals(other *CheckFirmwareVersionResult_) bool {
+	if p == other {
+		return true
+	} else if p == nil || other == nil {
+		return false
+	}
+	if len(p.ExistStatus) != len(other.ExistStatus) {
+		return false
+	}
+	for i, _tgt := range p.ExistStatus {
+		_src52 := other.ExistStatus[i]
+		if _tgt != _src52 {
+			return false
+		}
+	}
+	return true
+}
+
+func (p *CheckFirmwareVersionResult_) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("CheckFirmwareVersionResult_(%+v)", *p)
+}
+
+// Attributes:
+//   - ModelID
+//   - Hostname
+type OriginalFirmwareTarget struct {
+	ModelID  *int64  `thrift:"ModelID,1" db:"ModelID" json:"ModelID,omitempty"`
+	Hostname *string `thrift:"Hostname,2" db:"Hostname" json:"Hostname,omitempty"`
+}
+
+func NewOriginalFirmwareTarget() *OriginalFirmwareTarget {
+	return &OriginalFirmwareTarget{}
+}
+
+var OriginalFirmwareTarget_ModelID_DEFAULT int64
+
+func (p *OriginalFirmwareTarget) GetModelID() int64 {
+	if !p.IsSetModelID() {
+		return OriginalFirmwareTarget_ModelID_DEFAULT
+	}
+	return *p.ModelID
+}
+
+var OriginalFirmwareTarget_Hostname_DEFAULT string
+
+func (p *OriginalFirmwareTarget) GetHostname() string {
+	if !p.IsSetHostname() {
+		return OriginalFirmwareTarget_Hostname_DEFAULT
+	}
+	return *p.Hostname
+}
+func (p *OriginalFirmwareTarget) IsSetModelID() bool {
+	return p.ModelID != nil
+}
+
+func (p *OriginalFirmwareTarget) IsSetHostname() bool {
+	return p.Hostname != nil
+}
+
+func (p *OriginalFirmwareTarget) Read(ctx context.Context, iprot thrift.TProtocol) error {
+	if _, err := iprot.ReadStructBegin(ctx); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T read error: ", p), err)
+	}
+
+	for {
+		_, fieldTypeId, fieldId, err := iprot.ReadFieldBegin(ctx)
+		if err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T field %d read error: ", p, fieldId), err)
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+		switch fieldId {
+		case 1:
+			if fieldTypeId == thrift.I64 {
+				if err := p.ReadField1(ctx, iprot); err != nil {
+					return err
+				}
+			} else {
+				if err := iprot.Skip(ctx, fieldTypeId); err != nil {
+					return err
+				}
+			}
+		case 2:
+			if fieldTypeId == thrift.STRING {
+				if err := p.ReadField2(ctx, iprot); err != nil {
+					return err
+				}
+			} else {
+				if err := iprot.Skip(ctx, fieldTypeId); err != nil {
+					return err
+				}
+			}
+		default:
+			if err := iprot.Skip(ctx, fieldTypeId); err != nil {
+				return err
+			}
+		}
+		if err := iprot.ReadFieldEnd(ctx); err != nil {
+			return err
+		}
+	}
+	if err := iprot.ReadStructEnd(ctx); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
+	}
+	return nil
+}
+
+func (p *OriginalFirmwareTarget) ReadField1(ctx context.Context, iprot thrift.TProtocol) error {
+	if v, err := iprot.ReadI64(ctx); err != nil {
+		return thrift.PrependError("error reading field 1: ", err)
+	} else {
+		p.ModelID = &v
+	}
+	return nil
+}
+
+func (p *OriginalFirmwareTarget) ReadField2(ctx context.Context, iprot thrift.TProtocol) error {
+	if v, err := iprot.ReadString(ctx); err != nil {
+		return thrift.PrependError("error reading field 2: ", err)
+	} else {
+		p.Hostname = &v
+	}
+	return nil
+}
+
+func (p *OriginalFirmwareTarget) Write(ctx context.Context, oprot thrift.TProtocol) error {
+	if err := oprot.WriteStructBegin(ctx, "OriginalFirmwareTarget"); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
+	}
+	if p != nil {
+		if err := p.writeField1(ctx, oprot); err != nil {
+			return err
+		}
+		if err := p.writeField2(ctx, oprot); err != nil {
+			return err
+		}
+	}
+	if err := oprot.WriteFieldStop(ctx); err != nil {
+		return thrift.PrependError("write field stop error: ", err)
+	}
+	if err := oprot.WriteStructEnd(ctx); err != nil {
+		return thrift.PrependError("write struct stop error: ", err)
+	}
+	return nil
+}
+
+func (p *OriginalFirmwareTarget) writeField1(ctx context.Context, oprot thrift.TProtocol) (err error) {
+	if p.IsSetModelID() {
+		if err := oprot.WriteFieldBegin(ctx, "ModelID", thrift.I64, 1); err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T write field begin error 1:ModelID: ", p), err)
+		}
+		if err := oprot.WriteI64(ctx, int64(*p.ModelID)); err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T.ModelID (1) field write error: ", p), err)
+		}
+		if err := oprot.WriteFieldEnd(ctx); err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T write field end error 1:ModelID: ", p), err)
+		}
+	}
+	return err
+}
+
+func (p *OriginalFirmwareTarget) writeField2(ctx context.Context, oprot thrift.TProtocol) (err error) {
+	if p.IsSetHostname() {
+		if err := oprot.WriteFieldBegin(ctx, "Hostname", thrift.STRING, 2); err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T write field begin error 2:Hostname: ", p), err)
+		}
+		if err := oprot.WriteString(ctx, string(*p.Hostname)); err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T.Hostname (2) field write error: ", p), err)
+		}
+		if err := oprot.WriteFieldEnd(ctx); err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T write field end error 2:Hostname: ", p), err)
+		}
+	}
+	return err
+}
+
+func (p *OriginalFirmwareTarget) Equals(other *OriginalFirmwareTarget) bool {
+	if p == other {
+		return true
+	} else if p == nil || other == nil {
+		return false
+	}
+	if p.ModelID != other.ModelID {
+		if p.ModelID == nil || other.ModelID == nil {
+			return false
+		}
+		if (*p.ModelID) != (*other.ModelID) {
+			return false
+		}
+	}
+	if p.Hostname != other.Hostname {
+		if p.Hostname == nil || other.Hostname == nil {
+			return false
+		}
+		if (*p.Hostname) != (*other.Hostname) {
+			return false
+		}
+	}
+	return true
+}
+
+func (p *OriginalFirmwareTarget) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("OriginalFirmwareTarget(%+v)", *p)
+}
+
+// Attributes:
+//   - Type
+//   - Value
+type OriginalFirmwareMeasurement struct {
+	Type  string `thrift:"Type,1" db:"Type" json:"Type"`
+	Value []byte `thrift:"Value,2" db:"Value" json:"Value"`
+}
+
+func NewOriginalFirmwareMeasurement() *OriginalFirmwareMeasurement {
+	return &OriginalFirmwareMeasurement{}
+}
+
+func (p *OriginalFirmwareMeasurement) GetType() string {
+	return p.Type
+}
+
+func (p *OriginalFirmwareMeasurement) GetValue() []byte {
+	return p.Value
+}
+func (p *OriginalFirmwareMeasurement) Read(ctx context.Context, iprot thrift.TProtocol) error {
+	if _, err := iprot.ReadStructBegin(ctx); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T read error: ", p), err)
+	}
+
+	for {
+		_, fieldTypeId, fieldId, err := iprot.ReadFieldBegin(ctx)
+		if err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T field %d read error: ", p, fieldId), err)
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+		switch fieldId {
+		case 1:
+			if fieldTypeId == thrift.STRING {
+				if err := p.ReadField1(ctx, iprot); err != nil {
+					return err
+				}
+			} else {
+				if err := iprot.Skip(ctx, fieldTypeId); err != nil {
+					return err
+				}
+			}
+		case 2:
+			if fieldTypeId == thrift.STRING {
+				if err := p.ReadField2(ctx, iprot); err != nil {
+					return err
+				}
+			} else {
+				if err := iprot.Skip(ctx, fieldTypeId); err != nil {
+					return err
+				}
+			}
+		default:
+			if err := iprot.Skip(ctx, fieldTypeId); err != nil {
+				return err
+			}
+		}
+		if err := iprot.ReadFieldEnd(ctx); err != nil {
+			return err
+		}
+	}
+	if err := iprot.ReadStructEnd(ctx); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
+	}
+	return nil
+}
+
+func (p *OriginalFirmwareMeasurement) ReadField1(ctx context.Context, iprot thrift.TProtocol) error {
+	if v, err := iprot.ReadString(ctx); err != nil {
+		return thrift.PrependError("error reading field 1: ", err)
+	} else {
+		p.Type = v
+	}
+	return nil
+}
+
+func (p *OriginalFirmwareMeasurement) ReadField2(ctx context.Context, iprot thrift.TProtocol) error {
+	if v, err := iprot.ReadBinary(ctx); err != nil {
+		return thrift.PrependError("error reading field 2: ", err)
+	} else {
+		p.Value = v
+	}
+	return nil
+}
+
+func (p *OriginalFirmwareMeasurement) Write(ctx context.Context, oprot thrift.TProtocol) error {
+	if err := oprot.WriteStructBegin(ctx, "OriginalFirmwareMeasurement"); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
+	}
+	if p != nil {
+		if err := p.writeField1(ctx, oprot); err != nil {
+			return err
+		}
+		if err := p.writeField2(ctx, oprot); err != nil {
+			return err
+		}
+	}
+	if err := oprot.WriteFieldStop(ctx); err != nil {
+		return thrift.PrependError("write field stop error: ", err)
+	}
+	if err := oprot.WriteStructEnd(ctx); err != nil {
+		return thrift.PrependError("write struct stop error: ", err)
+	}
+	return nil
+}
+
+func (p *OriginalFirmwareMeasurement) writeField1(ctx context.Context, oprot thrift.TProtocol) (err error) {
+	if err := oprot.WriteFieldBegin(ctx, "Type", thrift.STRING, 1); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T write field begin error 1:Type: ", p), err)
+	}
+	if err := oprot.WriteString(ctx, string(p.Type)); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T.Type (1) field write error: ", p), err)
+	}
+	if err := oprot.WriteFieldEnd(ctx); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T write field end error 1:Type: ", p), err)
+	}
+	return err
+}
+
+func (p *OriginalFirmwareMeasurement) writeField2(ctx context.Context, oprot thrift.TProtocol) (err error) {
+	if err := oprot.WriteFieldBegin(ctx, "Value", thrift.STRING, 2); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T write field begin error 2:Value: ", p), err)
+	}
+	if err := oprot.WriteBinary(ctx, p.Value); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T.Value (2) field write error: ", p), err)
+	}
+	if err := oprot.WriteFieldEnd(ctx); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T write field end error 2:Value: ", p), err)
+	}
+	return err
+}
+
+func (p *OriginalFirmwareMeasurement) Equals(other *OriginalFirmwareMeasurement) bool {
+	if p == other {
+		return true
+	} else if p == nil || other == nil {
+		return false
+	}
+	if p.Type != other.Type {
+		return false
+	}
+	if bytes.Compare(p.Value, other.Value) != 0 {
+		return false
+	}
+	return true
+}
+
+func (p *OriginalFirmwareMeasurement) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("OriginalFirmwareMeasurement(%+v)", *p)
+}
+
+// Attributes:
+//   - ID
+//   - Type
+//   - Version
+//   - ImageURL
+//   - Targets
+//   - Measurements
+type OriginalFirmware struct {
+	ID           int64                          `thrift:"ID,1" db:"ID" json:"ID"`
+	Type         OriginalFirmwareType           `thrift:"Type,2" db:"Type" json:"Type"`
+	Version      string                         `thrift:"Version,3" db:"Version" json:"Version"`
+	ImageURL     string                         `thrift:"ImageURL,4" db:"ImageURL" json:"ImageURL"`
+	Targets      []*OriginalFirmwareTarget      `thrift:"Targets,5" db:"Targets" json:"Targets"`
+	Measurements []*OriginalFirmwareMeasurement `thrift:"Measurements,6" db:"Measurements" json:"Measurements"`
+}
+
+func NewOriginalFirmware() *OriginalFirmware {
+	return &OriginalFirmware{}
+}
+
+func (p *OriginalFirmware) GetID() int64 {
+	return p.ID
+}
+
+func (p *OriginalFirmware) GetType() OriginalFirmwareType {
+	return p.Type
+}
+
+func (p *OriginalFirmware) GetVersion() string {
+	return p.Version
+}
+
+func (p *OriginalFirmware) GetImageURL() string {
+	return p.ImageURL
+}
+
+func (p *OriginalFirmware) GetTargets() []*OriginalFirmwareTarget {
+	return p.Targets
+}
+
+func (p *OriginalFirmware) GetMeasurements() []*OriginalFirmwareMeasurement {
+	return p.Measurements
+}
+func (p *OriginalFirmware) Read(ctx context.Context, iprot thrift.TProtocol) error {
+	if _, err := iprot.ReadStructBegin(ctx); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T read error: ", p), err)
+	}
+
+	for {
+		_, fieldTypeId, fieldId, err := iprot.ReadFieldBegin(ctx)
+		if err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T field %d read error: ", p, fieldId), err)
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+		switch fieldId {
+		case 1:
+			if fieldTypeId == thrift.I64 {
+				if err := p.ReadField1(ctx, iprot); err != nil {
+					return err
+				}
+			} else {
+				if err := iprot.Skip(ctx, fieldTypeId); err != nil {
+					return err
+				}
+			}
+		case 2:
+			if fieldTypeId == thrift.I32 {
+				if err := p.ReadField2(ctx, iprot); err != nil {
+					return err
+				}
+			} else {
+				if err := iprot.Skip(ctx, fieldTypeId); err != nil {
+					return err
+				}
+			}
+		case 3:
+			if fieldTypeId == thrift.STRING {
+				if err := p.ReadField3(ctx, iprot); err != nil {
+					return err
+				}
+			} else {
+				if err := iprot.Skip(ctx, fieldTypeId); err != nil {
+					return err
+				}
+			}
+		case 4:
+			if fieldTypeId == thrift.STRING {
+				if err := p.ReadField4(ctx, iprot); err != nil {
+					return err
+				}
+			} else {
+				if err := iprot.Skip(ctx, fieldTypeId); err != nil {
+					return err
+				}
+			}
+		case 5:
+			if fieldTypeId == thrift.LIST {
+				if err := p.ReadField5(ctx, iprot); err != nil {
+					return err
+				}
+			} else {
+				if err := iprot.Skip(ctx, fieldTypeId); err != nil {
+					return err
+				}
+			}
+		case 6:
+			if fieldTypeId == thrift.LIST {
+				if err := p.ReadField6(ctx, iprot); err != nil {
+					return err
+				}
+			} else {
+				if err := iprot.Skip(ctx, fieldTypeId); err != nil {
+					return err
+				}
+			}
+		default:
+			if err := iprot.Skip(ctx, fieldTypeId); err != nil {
+				return err
+			}
+		}
+		if err := iprot.ReadFieldEnd(ctx); err != nil {
+			return err
+		}
+	}
+	if err := iprot.ReadStructEnd(ctx); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
+	}
+	return nil
+}
+
+func (p *OriginalFirmware) ReadField1(ctx context.Context, iprot thrift.TProtocol) error {
+	if v, err := iprot.ReadI64(ctx); err != nil {
+		return thrift.PrependError("error reading field 1: ", err)
+	} else {
+		p.ID = v
+	}
+	return nil
+}
+
+func (p *OriginalFirmware) ReadField2(ctx context.Context, iprot thrift.TProtocol) error {
+	if v, err := iprot.ReadI32(ctx); err != nil {
+		return thrift.PrependError("error reading field 2: ", err)
+	} else {
+		temp := OriginalFirmwareType(v)
+		p.Type = temp
+	}
+	return nil
+}
+
+func (p *OriginalFirmware) ReadField3(ctx context.Context, iprot thrift.TProtocol) error {
+	if v, err := iprot.ReadString(ctx); err != nil {
+		return thrift.PrependError("error reading field 3: ", err)
+	} else {
+		p.Version = v
+	}
+	return nil
+}
+
+func (p *OriginalFirmware) ReadField4(ctx context.Context, iprot thrift.TProtocol) error {
+	if v, err := iprot.ReadString(ctx); err != nil {
+		return thrift.PrependError("error reading field 4: ", err)
+	} else {
+		p.ImageURL = v
+	}
+	return nil
+}
+
+func (p *OriginalFirmware) ReadField5(ctx context.Context, iprot thrift.TProtocol) error {
+	_, size, err := iprot.ReadListBegin(ctx)
+	if err != nil {
+		return thrift.PrependError("error reading list begin: ", err)
+	}
+	tSlice := make([]*OriginalFirmwareTarget, 0, size)
+	p.Targets = tSlice
+	for i := 0; i < size; i++ {
+		_elem53 := &OriginalFirmwareTarget{}
+		if err := _elem53.Read(ctx, iprot); err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T error reading struct: ", _elem53), err)
+		}
+		p.Targets = append(p.Targets, _elem53)
+	}
+	if err := iprot.ReadListEnd(ctx); err != nil {
+		return thrift.PrependError("error reading list end: ", err)
+	}
+	return nil
+}
+
+func (p *OriginalFirmware) ReadField6(ctx context.Context, iprot thrift.TProtocol) error {
+	_, size, err := iprot.ReadListBegin(ctx)
+	if err != nil {
+		return thrift.PrependError("error reading list begin: ", err)
+	}
+	tSlice := make([]*OriginalFirmwareMeasurement, 0, size)
+	p.Measurements = tSlice
+	for i := 0; i < size; i++ {
+		_elem54 := &OriginalFirmwareMeasurement{}
+		if err := _elem54.Read(ctx, iprot); err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T error reading struct: ", _elem54), err)
+		}
+		p.Measurements = append(p.Measurements, _elem54)
+	}
+	if err := iprot.ReadListEnd(ctx); err != nil {
+		return thrift.PrependError("error reading list end: ", err)
+	}
+	return nil
+}
+
+func (p *OriginalFirmware) Write(ctx context.Context, oprot thrift.TProtocol) error {
+	if err := oprot.WriteStructBegin(ctx, "OriginalFirmware"); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
+	}
+	if p != nil {
+		if err := p.writeField1(ctx, oprot); err != nil {
+			return err
+		}
+		if err := p.writeField2(ctx, oprot); err != nil {
+			return err
+		}
+		if err := p.writeField3(ctx, oprot); err != nil {
+			return err
+		}
+		if err := p.writeField4(ctx, oprot); err != nil {
+			return err
+		}
+		if err := p.writeField5(ctx, oprot); err != nil {
+			return err
+		}
+		if err := p.writeField6(ctx, oprot); err != nil {
+			return err
+		}
+	}
+	if err := oprot.WriteFieldStop(ctx); err != nil {
+		return thrift.PrependError("write field stop error: ", err)
+	}
+	if err := oprot.WriteStructEnd(ctx); err != nil {
+		return thrift.PrependError("write struct stop error: ", err)
+	}
+	return nil
+}
+
+func (p *OriginalFirmware) writeField1(ctx context.Context, oprot thrift.TProtocol) (err error) {
+	if err := oprot.WriteFieldBegin(ctx, "ID", thrift.I64, 1); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T write field begin error 1:ID: ", p), err)
+	}
+	if err := oprot.WriteI64(ctx, int64(p.ID)); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T.ID (1) field write error: ", p), err)
+	}
+	if err := oprot.WriteFieldEnd(ctx); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T write field end error 1:ID: ", p), err)
+	}
+	return err
+}
+
+func (p *OriginalFirmware) writeField2(ctx context.Context, oprot thrift.TProtocol) (err error) {
+	if err := oprot.WriteFieldBegin(ctx, "Type", thrift.I32, 2); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T write field begin error 2:Type: ", p), err)
+	}
+	if err := oprot.WriteI32(ctx, int32(p.Type)); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T.Type (2) field write error: ", p), err)
+	}
+	if err := oprot.WriteFieldEnd(ctx); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T write field end error 2:Type: ", p), err)
+	}
+	return err
+}
+
+func (p *OriginalFirmware) writeField3(ctx context.Context, oprot thrift.TProtocol) (err error) {
+	if err := oprot.WriteFieldBegin(ctx, "Version", thrift.STRING, 3); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T write field begin error 3:Version: ", p), err)
+	}
+	if err := oprot.WriteString(ctx, string(p.Version)); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T.Version (3) field write error: ", p), err)
+	}
+	if err := oprot.WriteFieldEnd(ctx); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T write field end error 3:Version: ", p), err)
+	}
+	return err
+}
+
+func (p *OriginalFirmware) writeField4(ctx context.Context, oprot thrift.TProtocol) (err error) {
+	if err := oprot.WriteFieldBegin(ctx, "ImageURL", thrift.STRING, 4); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T write field begin error 4:ImageURL: ", p), err)
+	}
+	if err := oprot.WriteString(ctx, string(p.ImageURL)); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T.ImageURL (4) field write error: ", p), err)
+	}
+	if err := oprot.WriteFieldEnd(ctx); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T write field end error 4:ImageURL: ", p), err)
+	}
+	return err
+}
+
+func (p *OriginalFirmware) writeField5(ctx context.Context, oprot thrift.TProtocol) (err error) {
+	if err := oprot.WriteFieldBegin(ctx, "Targets", thrift.LIST, 5); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T write field begin error 5:Targets: ", p), err)
+	}
+	if err := oprot.WriteListBegin(ctx, thrift.STRUCT, len(p.Targets)); err != nil {
+		return thrift.PrependError("error writing list begin: ", err)
+	}
+	for _, v := range p.Targets {
+		if err := v.Write(ctx, oprot); err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T error writing struct: ", v), err)
+		}
+	}
+	if err := oprot.WriteListEnd(ctx); err != nil {
+		return thrift.PrependError("error writing list end: ", err)
+	}
+	if err := oprot.WriteFieldEnd(ctx); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T write field end error 5:Targets: ", p), err)
+	}
+	return err
+}
+
+func (p *OriginalFirmware) writeField6(ctx context.Context, oprot thrift.TProtocol) (err error) {
+	if err := oprot.WriteFieldBegin(ctx, "Measurements", thrift.LIST, 6); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T write field begin error 6:Measurements: ", p), err)
+	}
+	if err := oprot.WriteListBegin(ctx, thrift.STRUCT, len(p.Measurements)); err != nil {
+		return thrift.PrependError("error writing list begin: ", err)
+	}
+	for _, v := range p.Measurements {
+		if err := v.Write(ctx, oprot); err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T error writing struct: ", v), err)
+		}
+	}
+	if err := oprot.WriteListEnd(ctx); err != nil {
+		return thrift.PrependError("error writing list end: ", err)
+	}
+	if err := oprot.WriteFieldEnd(ctx); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T write field end error 6:Measurements: ", p), err)
+	}
+	return err
+}
+
+func (p *OriginalFirmware) Equals(other *OriginalFirmware) bool {
+	if p == other {
+		return true
+	} else if p == nil || other == nil {
+		return false
+	}
+	if p.ID != other.ID {
+		return false
+	}
+	if p.Type != other.Type {
+		return false
+	}
+	if p.Version != other.Version {
+		return false
+	}
+	if p.ImageURL != other.ImageURL {
+		return false
+	}
+	if len(p.Targets) != len(other.Targets) {
+		return false
+	}
+	for i, _tgt := range p.Targets {
+		_src55 := other.Targets[i]
+		if !_tgt.Equals(_src55) {
+			return false
+		}
+	}
+	if len(p.Measurements) != len(other.Measurements) {
+		return false
+	}
+	for i, _tgt := range p.Measurements {
+		_src56 := other.Measurements[i]
+		if !_tgt.Equals(_src56) {
+			return false
+		}
+	}
+	return true
+}
+
+func (p *OriginalFirmware) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("OriginalFirmware(%+v)", *p)
+}
+
+// Attributes:
+//   - Firmware
+type InsertOriginalFirmwareRequest struct {
+	Firmware *OriginalFirmware `thrift:"Firmware,1" db:"Firmware" json:"Firmware"`
+}
+
+func NewInsertOriginalFirmwareRequest() *InsertOriginalFirmwareRequest {
+	return &InsertOriginalFirmwareRequest{}
+}
+
+var InsertOriginalFirmwareRequest_Firmware_DEFAULT *OriginalFirmware
+
+func (p *InsertOriginalFirmwareRequest) GetFirmware() *OriginalFirmware {
+	if !p.IsSetFirmware() {
+		return InsertOriginalFirmwareRequest_Firmware_DEFAULT
+	}
+	return p.Firmware
+}
+func (p *InsertOriginalFirmwareRequest) IsSetFirmware() bool {
+	return p.Firmware != nil
+}
+
+func (p *InsertOriginalFirmwareRequest) Read(ctx context.Context, iprot thrift.TProtocol) error {
+	if _, err := iprot.ReadStructBegin(ctx); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T read error: ", p), err)
+	}
+
+	for {
+		_, fieldTypeId, fieldId, err := iprot.ReadFieldBegin(ctx)
+		if err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T field %d read error: ", p, fieldId), err)
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+		switch fieldId {
+		case 1:
+			if fieldTypeId == thrift.STRUCT {
+				if err := p.ReadField1(ctx, iprot); err != nil {
+					return err
+				}
+			} else {
+				if err := iprot.Skip(ctx, fieldTypeId); err != nil {
+					return err
+				}
+			}
+		default:
+			if err := iprot.Skip(ctx, fieldTypeId); err != nil {
+				return err
+			}
+		}
+		if err := iprot.ReadFieldEnd(ctx); err != nil {
+			return err
+		}
+	}
+	if err := iprot.ReadStructEnd(ctx); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
+	}
+	return nil
+}
+
+func (p *InsertOriginalFirmwareRequest) ReadField1(ctx context.Context, iprot thrift.TProtocol) error {
+	p.Firmware = &OriginalFirmware{}
+	if err := p.Firmware.Read(ctx, iprot); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T error reading struct: ", p.Firmware), err)
+	}
+	return nil
+}
+
+func (p *InsertOriginalFirmwareRequest) Write(ctx context.Context, oprot thrift.TProtocol) error {
+	if err := oprot.WriteStructBegin(ctx, "InsertOriginalFirmwareRequest"); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
+	}
+	if p != nil {
+		if err := p.writeField1(ctx, oprot); err != nil {
+			return err
+		}
+	}
+	if err := oprot.WriteFieldStop(ctx); err != nil {
+		return thrift.PrependError("write field stop error: ", err)
+	}
+	if err := oprot.WriteStructEnd(ctx); err != nil {
+		return thrift.PrependError("write struct stop error: ", err)
+	}
+	return nil
+}
+
+func (p *InsertOriginalFirmwareRequest) writeField1(ctx context.Context, oprot thrift.TProtocol) (err error) {
+	if err := oprot.WriteFieldBegin(ctx, "Firmware", thrift.STRUCT, 1); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T write field begin error 1:Firmware: ", p), err)
+	}
+	if err := p.Firmware.Write(ctx, oprot); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T error writing struct: ", p.Firmware), err)
+	}
+	if err := oprot.WriteFieldEnd(ctx); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T write field end error 1:Firmware: ", p), err)
+	}
+	return err
+}
+
+func (p *InsertOriginalFirmwareRequest) Equals(other *InsertOriginalFirmwareRequest) bool {
+	if p == other {
+		return true
+	} else if p == nil || other == nil {
+		return false
+	}
+	if !p.Firmware.Equals(other.Firmware) {
+		return false
+	}
+	return true
+}
+
+func (p *InsertOriginalFirmwareRequest) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("InsertOriginalFirmwareRequest(%+v)", *p)
+}
+
+// Attributes:
+//   - ID
+type InsertOriginalFirmwareResult_ struct {
+	ID int64 `thrift:"ID,1" db:"ID" json:"ID"`
+}
+
+func NewInsertOriginalFirmwareResult_() *InsertOriginalFirmwareResult_ {
+	return &InsertOriginalFirmwareResult_{}
+}
+
+func (p *InsertOriginalFirmwareResult_) GetID() int64 {
+	return p.ID
+}
+func (p *InsertOriginalFirmwareResult_) Read(ctx context.Context, iprot thrift.TProtocol) error {
+	if _, err := iprot.ReadStructBegin(ctx); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T read error: ", p), err)
+	}
+
+	for {
+		_, fieldTypeId, fieldId, err := iprot.ReadFieldBegin(ctx)
+		if err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T field %d read error: ", p, fieldId), err)
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+		switch fieldId {
+		case 1:
+			if fieldTypeId == thrift.I64 {
+				if err := p.ReadField1(ctx, iprot); err != nil {
+					return err
+				}
+			} else {
+				if err := iprot.Skip(ctx, fieldTypeId); err != nil {
+					return err
+				}
+			}
+		default:
+			if err := iprot.Skip(ctx, fieldTypeId); err != nil {
+				return err
+			}
+		}
+		if err := iprot.ReadFieldEnd(ctx); err != nil {
+			return err
+		}
+	}
+	if err := iprot.ReadStructEnd(ctx); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
+	}
+	return nil
+}
+
+func (p *InsertOriginalFirmwareResult_) ReadField1(ctx context.Context, iprot thrift.TProtocol) error {
+	if v, err := iprot.ReadI64(ctx); err != nil {
+		return thrift.PrependError("error reading field 1: ", err)
+	} else {
+		p.ID = v
+	}
+	return nil
+}
+
+func (p *InsertOriginalFirmwareResult_) Write(ctx context.Context, oprot thrift.TProtocol) error {
+	if err := oprot.WriteStructBegin(ctx, "InsertOriginalFirmwareResult"); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
+	}
+	if p != nil {
+		if err := p.writeField1(ctx, oprot); err != nil {
+			return err
+		}
+	}
+	if err := oprot.WriteFieldStop(ctx); err != nil {
+		return thrift.PrependError("write field stop error: ", err)
+	}
+	if err := oprot.WriteStructEnd(ctx); err != nil {
+		return thrift.PrependError("write struct stop error: ", err)
+	}
+	return nil
+}
+
+func (p *InsertOriginalFirmwareResult_) writeField1(ctx context.Context, oprot thrift.TProtocol) (err error) {
+	if err := oprot.WriteFieldBegin(ctx, "ID", thrift.I64, 1); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T write field begin error 1:ID: ", p), err)
+	}
+	if err := oprot.WriteI64(ctx, int64(p.ID)); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T.ID (1) field write error: ", p), err)
+	}
+	if err := oprot.WriteFieldEnd(ctx); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T write field end error 1:ID: ", p), err)
+	}
+	return err
+}
+
+func (p *InsertOriginalFirmwareResult_) Equals(other *InsertOriginalFirmwareResult_) bool {
+	if p == other {
+		return true
+	} else if p == nil || other == nil {
+		return false
+	}
+	if p.ID != other.ID {
+		return false
+	}
+	return true
+}
+
+func (p *InsertOriginalFirmwareResult_) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("InsertOriginalFirmwareResult_(%+v)", *p)
+}
+
+// Attributes:
+//   - Firmware
+type UpdateOriginalFirmwareRequest struct {
+	Firmware *OriginalFirmware `thrift:"Firmware,1" db:"Firmware" json:"Firmware"`
+}
+
+func NewUpdateOriginalFirmwareRequest() *UpdateOriginalFirmwareRequest {
+	return &UpdateOriginalFirmwareRequest{}
+}
+
+var UpdateOriginalFirmwareRequest_Firmware_DEFAULT *OriginalFirmware
+
+func (p *UpdateOriginalFirmwareRequest) GetFirmware() *OriginalFirmware {
+	if !p.IsSetFirmware() {
+		return UpdateOriginalFirmwareRequest_Firmware_DEFAULT
+	}
+	return p.Firmware
+}
+func (p *UpdateOriginalFirmwareRequest) IsSetFirmware() bool {
+	return p.Firmware != nil
+}
+
+func (p *UpdateOriginalFirmwareRequest) Read(ctx context.Context, iprot thrift.TProtocol) error {
+	if _, err := iprot.ReadStructBegin(ctx); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T read error: ", p), err)
+	}
+
+	for {
+		_, fieldTypeId, fieldId, err := iprot.ReadFieldBegin(ctx)
+		if err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T field %d read error: ", p, fieldId), err)
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+		switch fieldId {
+		case 1:
+			if fieldTypeId == thrift.STRUCT {
+				if err := p.ReadField1(ctx, iprot); err != nil {
+					return err
+				}
+			} else {
+				if err := iprot.Skip(ctx, fieldTypeId); err != nil {
+					return err
+				}
+			}
+		default:
+			if err := iprot.Skip(ctx, fieldTypeId); err != nil {
+				return err
+			}
+		}
+		if err := iprot.ReadFieldEnd(ctx); err != nil {
+			return err
+		}
+	}
+	if err := iprot.ReadStructEnd(ctx); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
+	}
+	return nil
+}
+
+func (p *UpdateOriginalFirmwareRequest) ReadField1(ctx context.Context, iprot thrift.TProtocol) error {
+	p.Firmware = &OriginalFirmware{}
+	if err := p.Firmware.Read(ctx, iprot); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T error reading struct: ", p.Firmware), err)
+	}
+	return nil
+}
+
+func (p *UpdateOriginalFirmwareRequest) Write(ctx context.Context, oprot thrift.TProtocol) error {
+	if err := oprot.WriteStructBegin(ctx, "UpdateOriginalFirmwareRequest"); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
+	}
+	if p != nil {
+		if err := p.writeField1(ctx, oprot); err != nil {
+			return err
+		}
+	}
+	if err := oprot.WriteFieldStop(ctx); err != nil {
+		return thrift.PrependError("write field stop error: ", err)
+	}
+	if err := oprot.WriteStructEnd(ctx); err != nil {
+		return thrift.PrependError("write struct stop error: ", err)
+	}
+	return nil
+}
+
+func (p *UpdateOriginalFirmwareRequest) writeField1(ctx context.Context, oprot thrift.TProtocol) (err error) {
+	if err := oprot.WriteFieldBegin(ctx, "Firmware", thrift.STRUCT, 1); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T write field begin error 1:Firmware: ", p), err)
+	}
+	if err := p.Firmware.Write(ctx, oprot); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T error writing struct: ", p.Firmware), err)
+	}
+	if err := oprot.WriteFieldEnd(ctx); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T write field end error 1:Firmware: ", p), err)
+	}
+	return err
+}
+
+func (p *UpdateOriginalFirmwareRequest) Equals(other *UpdateOriginalFirmwareRequest) bool {
+	if p == other {
+		return true
+	} else if p == nil || other == nil {
+		return false
+	}
+	if !p.Firmware.Equals(other.Firmware) {
+		return false
+	}
+	return true
+}
+
+func (p *UpdateOriginalFirmwareRequest) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("UpdateOriginalFirmwareRequest(%+v)", *p)
+}
+
+// Attributes:
+//   - ID
+type DeleteOriginalFirmwareRequest struct {
+	ID int64 `thrift:"ID,1" db:"ID" json:"ID"`
+}
+
+func NewDeleteOriginalFirmwareRequest() *DeleteOriginalFirmwareRequest {
+	return &DeleteOriginalFirmwareRequest{}
+}
+
+func (p *DeleteOriginalFirmwareRequest) GetID() int64 {
+	return p.ID
+}
+func (p *DeleteOriginalFirmwareRequest) Read(ctx context.Context, iprot thrift.TProtocol) error {
+	if _, err := iprot.ReadStructBegin(ctx); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T read error: ", p), err)
+	}
+
+	for {
+		_, fieldTypeId, fieldId, err := iprot.ReadFieldBegin(ctx)
+		if err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T field %d read error: ", p, fieldId), err)
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+		switch fieldId {
+		case 1:
+			if fieldTypeId == thrift.I64 {
+				if err := p.ReadField1(ctx, iprot); err != nil {
+					return err
+				}
+			} else {
+				if err := iprot.Skip(ctx, fieldTypeId); err != nil {
+					return err
+				}
+			}
+		default:
+			if err := iprot.Skip(ctx, fieldTypeId); err != nil {
+				return err
+			}
+		}
+		if err := iprot.ReadFieldEnd(ctx); err != nil {
+			return err
+		}
+	}
+	if err := iprot.ReadStructEnd(ctx); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
+	}
+	return nil
+}
+
+func (p *DeleteOriginalFirmwareRequest) ReadField1(ctx context.Context, iprot thrift.TProtocol) error {
+	if v, err := iprot.ReadI64(ctx); err != nil {
+		return thrift.PrependError("error reading field 1: ", err)
+	} else {
+		p.ID = v
+	}
+	return nil
+}
+
+func (p *DeleteOriginalFirmwareRequest) Write(ctx context.Context, oprot thrift.TProtocol) error {
+	if err := oprot.WriteStructBegin(ctx, "DeleteOriginalFirmwareRequest"); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
+	}
+	if p != nil {
+		if err := p.writeField1(ctx, oprot); err != nil {
+			return err
+		}
+	}
+	if err := oprot.WriteFieldStop(ctx); err != nil {
+		return thrift.PrependError("write field stop error: ", err)
+	}
+	if err := oprot.WriteStructEnd(ctx); err != nil {
+		return thrift.PrependError("write struct stop error: ", err)
+	}
+	return nil
+}
+
+func (p *DeleteOriginalFirmwareRequest) writeField1(ctx context.Context, oprot thrift.TProtocol) (err error) {
+	if err := oprot.WriteFieldBegin(ctx, "ID", thrift.I64, 1); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T write field begin error 1:ID: ", p), err)
+	}
+	if err := oprot.WriteI64(ctx, int64(p.ID)); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T.ID (1) field write error: ", p), err)
+	}
+	if err := oprot.WriteFieldEnd(ctx); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T write field end error 1:ID: ", p), err)
+	}
+	return err
+}
+
+func (p *DeleteOriginalFirmwareRequest) Equals(other *DeleteOriginalFirmwareRequest) bool {
+	if p == other {
+		return true
+	} else if p == nil || other == nil {
+		return false
+	}
+	if p.ID != other.ID {
+		return false
+	}
+	return true
+}
+
+func (p *DeleteOriginalFirmwareRequest) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("DeleteOriginalFirmwareRequest(%+v)", *p)
+}
+
+// Attributes:
+//   - ID
+//   - Kind
+//   - Value
+//   - Reason
+//   - ExpiresAt
+//   - CreatedAt
+type TamperingRegistryEntry struct {
+	ID        int64                 `thrift:"ID,1" db:"ID" json:"ID"`
+	Kind      TamperingRegistryKind `thrift:"Kind,2" db:"Kind" json:"Kind"`
+	Value     string                `thrift:"Value,3" db:"Value" json:"Value"`
+	Reason    string                `thrift:"Reason,4" db:"Reason" json:"Reason"`
+	ExpiresAt *int64                `thrift:"ExpiresAt,5" db:"ExpiresAt" json:"ExpiresAt,omitempty"`
+	CreatedAt int64                 `thrift:"CreatedAt,6" db:"CreatedAt" json:"CreatedAt"`
+}
+
+func NewTamperingRegistryEntry() *TamperingRegistryEntry {
+	return &TamperingRegistryEntry{}
+}
+
+func (p *TamperingRegistryEntry) GetID() int64 {
+	return p.ID
+}
+
+func (p *TamperingRegistryEntry) GetKind() TamperingRegistryKind {
+	return p.Kind
+}
+
+func (p *TamperingRegistryEntry) GetValue() string {
+	return p.Value
+}
+
+func (p *TamperingRegistryEntry) GetReason() string {
+	return p.Reason
+}
+
+var TamperingRegistryEntry_ExpiresAt_DEFAULT int64
+
+func (p *TamperingRegistryEntry) GetExpiresAt() int64 {
+	if !p.IsSetExpiresAt() {
+		return TamperingRegistryEntry_ExpiresAt_DEFAULT
+	}
+	return *p.ExpiresAt
+}
+
+func (p *TamperingRegistryEntry) GetCreatedAt() int64 {
+	return p.CreatedAt
+}
+func (p *TamperingRegistryEntry) IsSetExpiresAt() bool {
+	return p.ExpiresAt != nil
+}
+
+func (p *TamperingRegistryEntry) Read(ctx context.Context, iprot thrift.TProtocol) error {
+	if _, err := iprot.ReadStructBegin(ctx); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T read error: ", p), err)
+	}
+
+	for {
+		_, fieldTypeId, fieldId, err := iprot.ReadFieldBegin(ctx)
+		if err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T field %d read error: ", p, fieldId), err)
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+		switch fieldId {
+		case 1:
+			if fieldTypeId == thrift.I64 {
+				if err := p.ReadField1(ctx, iprot); err != nil {
+					return err
+				}
+			} else {
+				if err := iprot.Skip(ctx, fieldTypeId); err != nil {
+					return err
+				}
+			}
+		case 2:
+			if fieldTypeId == thrift.I32 {
+				if err := p.ReadField2(ctx, iprot); err != nil {
+					return err
+				}
+			} else {
+				if err := iprot.Skip(ctx, fieldTypeId); err != nil {
+					return err
+				}
+			}
+		case 3:
+			if fieldTypeId == thrift.STRING {
+				if err := p.ReadField3(ctx, iprot); err != nil {
+					return err
+				}
+			} else {
+				if err := iprot.Skip(ctx, fieldTypeId); err != nil {
+					return err
+				}
+			}
+		case 4:
+			if fieldTypeId == thrift.STRING {
+				if err := p.ReadField4(ctx, iprot); err != nil {
+					return err
+				}
+			} else {
+				if err := iprot.Skip(ctx, fieldTypeId); err != nil {
+					return err
+				}
+			}
+		case 5:
+			if fieldTypeId == thrift.I64 {
+				if err := p.ReadField5(ctx, iprot); err != nil {
+					return err
+				}
+			} else {
+				if err := iprot.Skip(ctx, fieldTypeId); err != nil {
+					return err
+				}
+			}
+		case 6:
+			if fieldTypeId == thrift.I64 {
+				if err := p.ReadField6(ctx, iprot); err != nil {
+					return err
+				}
+			} else {
+				if err := iprot.Skip(ctx, fieldTypeId); err != nil {
+					return err
+				}
+			}
+		default:
+			if err := iprot.Skip(ctx, fieldTypeId); err != nil {
+				return err
+			}
+		}
+		if err := iprot.ReadFieldEnd(ctx); err != nil {
+			return err
+		}
+	}
+	if err := iprot.ReadStructEnd(ctx); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
+	}
+	return nil
+}
+
+func (p *TamperingRegistryEntry) ReadField1(ctx context.Context, iprot thrift.TProtocol) error {
+	if v, err := iprot.ReadI64(ctx); err != nil {
+		return thrift.PrependError("error reading field 1: ", err)
+	} else {
+		p.ID = v
+	}
+	return nil
+}
+
+func (p *TamperingRegistryEntry) ReadField2(ctx context.Context, iprot thrift.TProtocol) error {
+	if v, err := iprot.ReadI32(ctx); err != nil {
+		return thrift.PrependError("error reading field 2: ", err)
+	} else {
+		temp := TamperingRegistryKind(v)
+		p.Kind = temp
+	}
+	return nil
+}
+
+func (p *TamperingRegistryEntry) ReadField3(ctx context.Context, iprot thrift.TProtocol) error {
+	if v, err := iprot.ReadString(ctx); err != nil {
+		return thrift.PrependError("error reading field 3: ", err)
+	} else {
+		p.Value = v
+	}
+	return nil
+}
+
+func (p *TamperingRegistryEntry) ReadField4(ctx context.Context, iprot thrift.TProtocol) error {
+	if v, err := iprot.ReadString(ctx); err != nil {
+		return thrift.PrependError("error reading field 4: ", err)
+	} else {
+		p.Reason = v
+	}
+	return nil
+}
+
+func (p *TamperingRegistryEntry) ReadField5(ctx context.Context, iprot thrift.TProtocol) error {
+	if v, err := iprot.ReadI64(ctx); err != nil {
+		return thrift.PrependError("error reading field 5: ", err)
+	} else {
+		p.ExpiresAt = &v
+	}
+	return nil
+}
+
+func (p *TamperingRegistryEntry) ReadField6(ctx context.Context, iprot thrift.TProtocol) error {
+	if v, err := iprot.ReadI64(ctx); err != nil {
+		return thrift.PrependError("error reading field 6: ", err)
+	} else {
+		p.CreatedAt = v
+	}
+	return nil
+}
+
+func (p *TamperingRegistryEntry) Write(ctx context.Context, oprot thrift.TProtocol) error {
+	if err := oprot.WriteStructBegin(ctx, "TamperingRegistryEntry"); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
+	}
+	if p != nil {
+		if err := p.writeField1(ctx, oprot); err != nil {
+			return err
+		}
+		if err := p.writeField2(ctx, oprot); err != nil {
+			return err
+		}
+		if err := p.writeField3(ctx, oprot); err != nil {
+			return err
+		}
+		if err := p.writeField4(ctx, oprot); err != nil {
+			return err
+		}
+		if err := p.writeField5(ctx, oprot); err != nil {
+			return err
+		}
+		if err := p.writeField6(ctx, oprot); err != nil {
+			return err
+		}
+	}
+	if err := oprot.WriteFieldStop(ctx); err != nil {
+		return thrift.PrependError("write field stop error: ", err)
+	}
+	if err := oprot.WriteStructEnd(ctx); err != nil {
+		return thrift.PrependError("write struct stop error: ", err)
+	}
+	return nil
+}
+
+func (p *TamperingRegistryEntry) writeField1(ctx context.Context, oprot thrift.TProtocol) (err error) {
+	if err := oprot.WriteFieldBegin(ctx, "ID", thrift.I64, 1); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T write field begin error 1:ID: ", p), err)
+	}
+	if err := oprot.WriteI64(ctx, int64(p.ID)); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T.ID (1) field write error: ", p), err)
+	}
+	if err := oprot.WriteFieldEnd(ctx); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T write field end error 1:ID: ", p), err)
+	}
+	return err
+}
+
+func (p *TamperingRegistryEntry) writeField2(ctx context.Context, oprot thrift.TProtocol) (err error) {
+	if err := oprot.WriteFieldBegin(ctx, "Kind", thrift.I32, 2); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T write field begin error 2:Kind: ", p), err)
+	}
+	if err := oprot.WriteI32(ctx, int32(p.Kind)); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T.Kind (2) field write error: ", p), err)
+	}
+	if err := oprot.WriteFieldEnd(ctx); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T write field end error 2:Kind: ", p), err)
+	}
+	return err
+}
+
+func (p *TamperingRegistryEntry) writeField3(ctx context.Context, oprot thrift.TProtocol) (err error) {
+	if err := oprot.WriteFieldBegin(ctx, "Value", thrift.STRING, 3); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T write field begin error 3:Value: ", p), err)
+	}
+	if err := oprot.WriteString(ctx, string(p.Value)); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T.Value (3) field write error: ", p), err)
+	}
+	if err := oprot.WriteFieldEnd(ctx); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T write field end error 3:Value: ", p), err)
+	}
+	return err
+}
+
+func (p *TamperingRegistryEntry) writeField4(ctx context.Context, oprot thrift.TProtocol) (err error) {
+	if err := oprot.WriteFieldBegin(ctx, "Reason", thrift.STRING, 4); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T write field begin error 4:Reason: ", p), err)
+	}
+	if err := oprot.WriteString(ctx, string(p.Reason)); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T.Reason (4) field write error: ", p), err)
+	}
+	if err := oprot.WriteFieldEnd(ctx); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T write field end error 4:Reason: ", p), err)
+	}
+	return err
+}
+
+func (p *TamperingRegistryEntry) writeField5(ctx context.Context, oprot thrift.TProtocol) (err error) {
+	if p.IsSetExpiresAt() {
+		if err := oprot.WriteFieldBegin(ctx, "ExpiresAt", thrift.I64, 5); err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T write field begin error 5:ExpiresAt: ", p), err)
+		}
+		if err := oprot.WriteI64(ctx, int64(*p.ExpiresAt)); err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T.ExpiresAt (5) field write error: ", p), err)
+		}
+		if err := oprot.WriteFieldEnd(ctx); err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T write field end error 5:ExpiresAt: ", p), err)
+		}
+	}
+	return err
+}
+
+func (p *TamperingRegistryEntry) writeField6(ctx context.Context, oprot thrift.TProtocol) (err error) {
+	if err := oprot.WriteFieldBegin(ctx, "CreatedAt", thrift.I64, 6); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T write field begin error 6:CreatedAt: ", p), err)
+	}
+	if err := oprot.WriteI64(ctx, int64(p.CreatedAt)); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T.CreatedAt (6) field write error: ", p), err)
+	}
+	if err := oprot.WriteFieldEnd(ctx); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T write field end error 6:CreatedAt: ", p), err)
+	}
+	return err
+}
+
+func (p *TamperingRegistryEntry) Equals(other *TamperingRegistryEntry) bool {
+	if p == other {
+		return true
+	} else if p == nil || other == nil {
+		return false
+	}
+	if p.ID != other.ID {
+		return false
+	}
+	if p.Kind != other.Kind {
+		return false
+	}
+	if p.Value != other.Value {
+		return false
+	}
+	if p.Reason != other.Reason {
+		return false
+	}
+	if p.ExpiresAt != other.ExpiresAt {
+		if p.ExpiresAt == nil || other.ExpiresAt == nil {
+			return false
+		}
+		if (*p.ExpiresAt) != (*other.ExpiresAt) {
+			return false
+		}
+	}
+	if p.CreatedAt != other.CreatedAt {
+		return false
+	}
+	return true
+}
+
+func (p *TamperingRegistryEntry) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("TamperingRegistryEntry(%+v)", *p)
+}
+
+// Attributes:
+//   - Entry
+type InsertTamperingRegistryEntryRequest struct {
+	Entry *TamperingRegistryEntry `thrift:"Entry,1" db:"Entry" json:"Entry"`
+}
+
+func NewInsertTamperingRegistryEntryRequest() *InsertTamperingRegistryEntryRequest {
+	return &InsertTamperingRegistryEntryRequest{}
+}
+
+var InsertTamperingRegistryEntryRequest_Entry_DEFAULT *TamperingRegistryEntry
+
+func (p *InsertTamperingRegistryEntryRequest) GetEntry() *TamperingRegistryEntry {
+	if !p.IsSetEntry() {
+		return InsertTamperingRegistryEntryRequest_Entry_DEFAULT
+	}
+	return p.Entry
+}
+func (p *InsertTamperingRegistryEntryRequest) IsSetEntry() bool {
+	return p.Entry != nil
+}
+
+func (p *InsertTamperingRegistryEntryRequest) Read(ctx context.Context, iprot thrift.TProtocol) error {
+	if _, err := iprot.ReadStructBegin(ctx); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T read error: ", p), err)
+	}
+
+	for {
+		_, fieldTypeId, fieldId, err := iprot.ReadFieldBegin(ctx)
+		if err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T field %d read error: ", p, fieldId), err)
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+		switch fieldId {
+		case 1:
+			if fieldTypeId == thrift.STRUCT {
+				if err := p.ReadField1(ctx, iprot); err != nil {
+					return err
+				}
+			} else {
+				if err := iprot.Skip(ctx, fieldTypeId); err != nil {
+					return err
+				}
+			}
+		default:
+			if err := iprot.Skip(ctx, fieldTypeId); err != nil {
+				return err
+			}
+		}
+		if err := iprot.ReadFieldEnd(ctx); err != nil {
+			return err
+		}
+	}
+	if err := iprot.ReadStructEnd(ctx); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
+	}
+	return nil
+}
+
+func (p *InsertTamperingRegistryEntryRequest) ReadField1(ctx context.Context, iprot thrift.TProtocol) error {
+	p.Entry = &TamperingRegistryEntry{}
+	if err := p.Entry.Read(ctx, iprot); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T error reading struct: ", p.Entry), err)
+	}
+	return nil
+}
+
+func (p *InsertTamperingRegistryEntryRequest) Write(ctx context.Context, oprot thrift.TProtocol) error {
+	if err := oprot.WriteStructBegin(ctx, "InsertTamperingRegistryEntryRequest"); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
+	}
+	if p != nil {
+		if err := p.writeField1(ctx, oprot); err != nil {
+			return err
+		}
+	}
+	if err := oprot.WriteFieldStop(ctx); err != nil {
+		return thrift.PrependError("write field stop error: ", err)
+	}
+	if err := oprot.WriteStructEnd(ctx); err != nil {
+		return thrift.PrependError("write struct stop error: ", err)
+	}
+	return nil
+}
+
+func (p *InsertTamperingRegistryEntryRequest) writeField1(ctx context.Context, oprot thrift.TProtocol) (err error) {
+	if err := oprot.WriteFieldBegin(ctx, "Entry", thrift.STRUCT, 1); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T write field begin error 1:Entry: ", p), err)
+	}
+	if err := p.Entry.Write(ctx, oprot); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T error writing struct: ", p.Entry), err)
+	}
+	if err := oprot.WriteFieldEnd(ctx); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T write field end error 1:Entry: ", p), err)
+	}
+	return err
+}
+
+func (p *InsertTamperingRegistryEntryRequest) Equals(other *InsertTamperingRegistryEntryRequest) bool {
+	if p == other {
+		return true
+	} else if p == nil || other == nil {
+		return false
+	}
+	if !p.Entry.Equals(other.Entry) {
+		return false
+	}
+	return true
+}
+
+func (p *InsertTamperingRegistryEntryRequest) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("InsertTamperingRegistryEntryRequest(%+v)", *p)
+}
+
+// Attributes:
+//   - ID
+type InsertTamperingRegistryEntryResult_ struct {
+	ID int64 `thrift:"ID,1" db:"ID" json:"ID"`
+}
+
+func NewInsertTamperingRegistryEntryResult_() *InsertTamperingRegistryEntryResult_ {
+	return &InsertTamperingRegistryEntryResult_{}
+}
+
+func (p *InsertTamperingRegistryEntryResult_) GetID() int64 {
+	return p.ID
+}
+func (p *InsertTamperingRegistryEntryResult_) Read(ctx context.Context, iprot thrift.TProtocol) error {
+	if _, err := iprot.ReadStructBegin(ctx); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T read error: ", p), err)
+	}
+
+	for {
+		_, fieldTypeId, fieldId, err := iprot.ReadFieldBegin(ctx)
+		if err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T field %d read error: ", p, fieldId), err)
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+		switch fieldId {
+		case 1:
+			if fieldTypeId == thrift.I64 {
+				if err := p.ReadField1(ctx, iprot); err != nil {
+					return err
+				}
+			} else {
+				if err := iprot.Skip(ctx, fieldTypeId); err != nil {
+					return err
+				}
+			}
+		default:
+			if err := iprot.Skip(ctx, fieldTypeId); err != nil {
+				return err
+			}
+		}
+		if err := iprot.ReadFieldEnd(ctx); err != nil {
+			return err
+		}
+	}
+	if err := iprot.ReadStructEnd(ctx); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
+	}
+	return nil
+}
+
+func (p *InsertTamperingRegistryEntryResult_) ReadField1(ctx context.Context, iprot thrift.TProtocol) error {
+	if v, err := iprot.ReadI64(ctx); err != nil {
+		return thrift.PrependError("error reading field 1: ", err)
+	} else {
+		p.ID = v
+	}
+	return nil
+}
+
+func (p *InsertTamperingRegistryEntryResult_) Write(ctx context.Context, oprot thrift.TProtocol) error {
+	if err := oprot.WriteStructBegin(ctx, "InsertTamperingRegistryEntryResult"); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
+	}
+	if p != nil {
+		if err := p.writeField1(ctx, oprot); err != nil {
+			return err
+		}
+	}
+	if err := oprot.WriteFieldStop(ctx); err != nil {
+		return thrift.PrependError("write field stop error: ", err)
+	}
+	if err := oprot.WriteStructEnd(ctx); err != nil {
+		return thrift.PrependError("write struct stop error: ", err)
+	}
+	return nil
+}
+
+func (p *InsertTamperingRegistryEntryResult_) writeField1(ctx context.Context, oprot thrift.TProtocol) (err error) {
+	if err := oprot.WriteFieldBegin(ctx, "ID", thrift.I64, 1); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T write field begin error 1:ID: ", p), err)
+	}
+	if err := oprot.WriteI64(ctx, int64(p.ID)); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T.ID (1) field write error: ", p), err)
+	}
+	if err := oprot.WriteFieldEnd(ctx); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T write field end error 1:ID: ", p), err)
+	}
+	return err
+}
+
+func (p *InsertTamperingRegistryEntryResult_) Equals(other *InsertTamperingRegistryEntryResult_) bool {
+	if p == other {
+		return true
+	} else if p == nil || other == nil {
+		return false
+	}
+	if p.ID != other.ID {
+		return false
+	}
+	return true
+}
+
+func (p *InsertTamperingRegistryEntryResult_) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("InsertTamperingRegistryEntryResult_(%+v)", *p)
+}
+
+// Attributes:
+//   - Entry
+type UpdateTamperingRegistryEntryRequest struct {
+	Entry *TamperingRegistryEntry `thrift:"Entry,1" db:"Entry" json:"Entry"`
+}
+
+func NewUpdateTamperingRegistryEntryRequest() *UpdateTamperingRegistryEntryRequest {
+	return &UpdateTamperingRegistryEntryRequest{}
+}
+
+var UpdateTamperingRegistryEntryRequest_Entry_DEFAULT *TamperingRegistryEntry
+
+func (p *UpdateTamperingRegistryEntryRequest) GetEntry() *TamperingRegistryEntry {
+	if !p.IsSetEntry() {
+		return UpdateTamperingRegistryEntryRequest_Entry_DEFAULT
+	}
+	return p.Entry
+}
+func (p *UpdateTamperingRegistryEntryRequest) IsSetEntry() bool {
+	return p.Entry != nil
+}
+
+func (p *UpdateTamperingRegistryEntryRequest) Read(ctx context.Context, iprot thrift.TProtocol) error {
+	if _, err := iprot.ReadStructBegin(ctx); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T read error: ", p), err)
+	}
+
+	for {
+		_, fieldTypeId, fieldId, err := iprot.ReadFieldBegin(ctx)
+		if err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T field %d read error: ", p, fieldId), err)
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+		switch fieldId {
+		case 1:
+			if fieldTypeId == thrift.STRUCT {
+				if err := p.ReadField1(ctx, iprot); err != nil {
+					return err
+				}
+			} else {
+				if err := iprot.Skip(ctx, fieldTypeId); err != nil {
+					return err
+				}
+			}
+		default:
+			if err := iprot.Skip(ctx, fieldTypeId); err != nil {
+				return err
+			}
+		}
+		if err := iprot.ReadFieldEnd(ctx); err != nil {
+			return err
+		}
+	}
+	if err := iprot.ReadStructEnd(ctx); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
+	}
+	return nil
+}
+
+func (p *UpdateTamperingRegistryEntryRequest) ReadField1(ctx context.Context, iprot thrift.TProtocol) error {
+	p.Entry = &TamperingRegistryEntry{}
+	if err := p.Entry.Read(ctx, iprot); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T error reading struct: ", p.Entry), err)
+	}
+	return nil
+}
+
+func (p *UpdateTamperingRegistryEntryRequest) Write(ctx context.Context, oprot thrift.TProtocol) error {
+	if err := oprot.WriteStructBegin(ctx, "UpdateTamperingRegistryEntryRequest"); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
+	}
+	if p != nil {
+		if err := p.writeField1(ctx, oprot); err != nil {
+			return err
+		}
+	}
+	if err := oprot.WriteFieldStop(ctx); err != nil {
+		return thrift.PrependError("write field stop error: ", err)
+	}
+	if err := oprot.WriteStructEnd(ctx); err != nil {
+		return thrift.PrependError("write struct stop error: ", err)
+	}
+	return nil
+}
+
+func (p *UpdateTamperingRegistryEntryRequest) writeField1(ctx context.Context, oprot thrift.TProtocol) (err error) {
+	if err := oprot.WriteFieldBegin(ctx, "Entry", thrift.STRUCT, 1); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T write field begin error 1:Entry: ", p), err)
+	}
+	if err := p.Entry.Write(ctx, oprot); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T error writing struct: ", p.Entry), err)
+	}
+	if err := oprot.WriteFieldEnd(ctx); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T write field end error 1:Entry: ", p), err)
+	}
+	return err
+}
+
+func (p *UpdateTamperingRegistryEntryRequest) Equals(other *UpdateTamperingRegistryEntryRequest) bool {
+	if p == other {
+		return true
+	} else if p == nil || other == nil {
+		return false
+	}
+	if !p.Entry.Equals(other.Entry) {
+		return false
+	}
+	return true
+}
+
+func (p *UpdateTamperingRegistryEntryRequest) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("UpdateTamperingRegistryEntryRequest(%+v)", *p)
+}
+
+// Attributes:
+//   - ID
+type DeleteTamperingRegistryEntryRequest struct {
+	ID int64 `thrift:"ID,1" db:"ID" json:"ID"`
+}
+
+func NewDeleteTamperingRegistryEntryRequest() *DeleteTamperingRegistryEntryRequest {
+	return &DeleteTamperingRegistryEntryRequest{}
+}
+
+func (p *DeleteTamperingRegistryEntryRequest) GetID() int64 {
+	return p.ID
+}
+func (p *DeleteTamperingRegistryEntryRequest) Read(ctx context.Context, iprot thrift.TProtocol) error {
+	if _, err := iprot.ReadStructBegin(ctx); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T read error: ", p), err)
+	}
+
+	for {
+		_, fieldTypeId, fieldId, err := iprot.ReadFieldBegin(ctx)
+		if err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T field %d read error: ", p, fieldId), err)
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+		switch fieldId {
+		case 1:
+			if fieldTypeId == thrift.I64 {
+				if err := p.ReadField1(ctx, iprot); err != nil {
+					return err
+				}
+			} else {
+				if err := iprot.Skip(ctx, fieldTypeId); err != nil {
+					return err
+				}
+			}
+		default:
+			if err := iprot.Skip(ctx, fieldTypeId); err != nil {
+				return err
+			}
+		}
+		if err := iprot.ReadFieldEnd(ctx); err != nil {
+			return err
+		}
+	}
+	if err := iprot.ReadStructEnd(ctx); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
+	}
+	return nil
+}
+
+func (p *DeleteTamperingRegistryEntryRequest) ReadField1(ctx context.Context, iprot thrift.TProtocol) error {
+	if v, err := iprot.ReadI64(ctx); err != nil {
+		return thrift.PrependError("error reading field 1: ", err)
+	} else {
+		p.ID = v
+	}
+	return nil
+}
+
+func (p *DeleteTamperingRegistryEntryRequest) Write(ctx context.Context, oprot thrift.TProtocol) error {
+	if err := oprot.WriteStructBegin(ctx, "DeleteTamperingRegistryEntryRequest"); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
+	}
+	if p != nil {
+		if err := p.writeField1(ctx, oprot); err != nil {
+			return err
+		}
+	}
+	if err := oprot.WriteFieldStop(ctx); err != nil {
+		return thrift.PrependError("write field stop error: ", err)
+	}
+	if err := oprot.WriteStructEnd(ctx); err != nil {
+		return thrift.PrependError("write struct stop error: ", err)
+	}
+	return nil
+}
+
+func (p *DeleteTamperingRegistryEntryRequest) writeField1(ctx context.Context, oprot thrift.TProtocol) (err error) {
+	if err := oprot.WriteFieldBegin(ctx, "ID", thrift.I64, 1); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T write field begin error 1:ID: ", p), err)
+	}
+	if err := oprot.WriteI64(ctx, int64(p.ID)); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T.ID (1) field write error: ", p), err)
+	}
+	if err := oprot.WriteFieldEnd(ctx); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T write field end error 1:ID: ", p), err)
+	}
+	return err
+}
+
+func (p *DeleteTamperingRegistryEntryRequest) Equals(other *DeleteTamperingRegistryEntryRequest) bool {
+	if p == other {
+		return true
+	} else if p == nil || other == nil {
+		return false
+	}
+	if p.ID != other.ID {
+		return false
+	}
+	return true
+}
+
+func (p *DeleteTamperingRegistryEntryRequest) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("DeleteTamperingRegistryEntryRequest(%+v)", *p)
+}
+
+// Attributes:
+//   - Kind
+//   - IncludeExpired
+type ListTamperingRegistryEntriesRequest struct {
+	Kind           *TamperingRegistryKind `thrift:"Kind,1" db:"Kind" json:"Kind,omitempty"`
+	IncludeExpired bool                   `thrift:"IncludeExpired,2" db:"IncludeExpired" json:"IncludeExpired"`
+}
+
+func NewListTamperingRegistryEntriesRequest() *ListTamperingRegistryEntriesRequest {
+	return &ListTamperingRegistryEntriesRequest{}
+}
+
+var ListTamperingRegistryEntriesRequest_Kind_DEFAULT TamperingRegistryKind
+
+func (p *ListTamperingRegistryEntriesRequest) GetKind() TamperingRegistryKind {
+	if !p.IsSetKind() {
+		return ListTamperingRegistryEntriesRequest_Kind_DEFAULT
+	}
+	return *p.Kind
+}
+
+func (p *ListTamperingRegistryEntriesRequest) GetIncludeExpired() bool {
+	return p.IncludeExpired
+}
+func (p *ListTamperingRegistryEntriesRequest) IsSetKind() bool {
+	return p.Kind != nil
+}
+
+func (p *ListTamperingRegistryEntriesRequest) Read(ctx context.Context, iprot thrift.TProtocol) error {
+	if _, err := iprot.ReadStructBegin(ctx); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T read error: ", p), err)
+	}
+
+	for {
+		_, fieldTypeId, fieldId, err := iprot.ReadFieldBegin(ctx)
+		if err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T field %d read error: ", p, fieldId), err)
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+		switch fieldId {
+		case 1:
+			if fieldTypeId == thrift.I32 {
+				if err := p.ReadField1(ctx, iprot); err != nil {
+					return err
+				}
+			} else {
+				if err := iprot.Skip(ctx, fieldTypeId); err != nil {
+					return err
+				}
+			}
+		case 2:
+			if fieldTypeId == thrift.BOOL {
+				if err := p.ReadField2(ctx, iprot); err != nil {
+					return err
+				}
+			} else {
+				if err := iprot.Skip(ctx, fieldTypeId); err != nil {
+					return err
+				}
+			}
+		default:
+			if err := iprot.Skip(ctx, fieldTypeId); err != nil {
+				return err
+			}
+		}
+		if err := iprot.ReadFieldEnd(ctx); err != nil {
+			return err
+		}
+	}
+	if err := iprot.ReadStructEnd(ctx); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
+	}
+	return nil
+}
+
+func (p *ListTamperingRegistryEntriesRequest) ReadField1(ctx context.Context, iprot thrift.TProtocol) error {
+	if v, err := iprot.ReadI32(ctx); err != nil {
+		return thrift.PrependError("error reading field 1: ", err)
+	} else {
+		temp := TamperingRegistryKind(v)
+		p.Kind = &temp
+	}
+	return nil
+}
+
+func (p *ListTamperingRegistryEntriesRequest) ReadField2(ctx context.Context, iprot thrift.TProtocol) error {
+	if v, err := iprot.ReadBool(ctx); err != nil {
+		return thrift.PrependError("error reading field 2: ", err)
+	} else {
+		p.IncludeExpired = v
+	}
+	return nil
+}
+
+func (p *ListTamperingRegistryEntriesRequest) Write(ctx context.Context, oprot thrift.TProtocol) error {
+	if err := oprot.WriteStructBegin(ctx, "ListTamperingRegistryEntriesRequest"); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
+	}
+	if p != nil {
+		if err := p.writeField1(ctx, oprot); err != nil {
+			return err
+		}
+		if err := p.writeField2(ctx, oprot); err != nil {
+			return err
+		}
+	}
+	if err := oprot.WriteFieldStop(ctx); err != nil {
+		return thrift.PrependError("write field stop error: ", err)
+	}
+	if err := oprot.WriteStructEnd(ctx); err != nil {
+		return thrift.PrependError("write struct stop error: ", err)
+	}
+	return nil
+}
+
+func (p *ListTamperingRegistryEntriesRequest) writeField1(ctx context.Context, oprot thrift.TProtocol) (err error) {
+	if p.IsSetKind() {
+		if err := oprot.WriteFieldBegin(ctx, "Kind", thrift.I32, 1); err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T write field begin error 1:Kind: ", p), err)
+		}
+		if err := oprot.WriteI32(ctx, int32(*p.Kind)); err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T.Kind (1) field write error: ", p), err)
+		}
+		if err := oprot.WriteFieldEnd(ctx); err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T write field end error 1:Kind: ", p), err)
+		}
+	}
+	return err
+}
+
+func (p *ListTamperingRegistryEntriesRequest) writeField2(ctx context.Context, oprot thrift.TProtocol) (err error) {
+	if err := oprot.WriteFieldBegin(ctx, "IncludeExpired", thrift.BOOL, 2); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T write field begin error 2:IncludeExpired: ", p), err)
+	}
+	if err := oprot.WriteBool(ctx, bool(p.IncludeExpired)); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T.IncludeExpired (2) field write error: ", p), err)
+	}
+	if err := oprot.WriteFieldEnd(ctx); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T write field end error 2:IncludeExpired: ", p), err)
+	}
+	return err
+}
+
+func (p *ListTamperingRegistryEntriesRequest) Equals(other *ListTamperingRegistryEntriesRequest) bool {
+	if p == other {
+		return true
+	} else if p == nil || other == nil {
+		return false
+	}
+	if p.Kind != other.Kind {
+		if p.Kind == nil || other.Kind == nil {
+			return false
+		}
+		if (*p.Kind) != (*other.Kind) {
+			return false
+		}
+	}
+	if p.IncludeExpired != other.IncludeExpired {
+		return false
+	}
+	return true
+}
+
+func (p *ListTamperingRegistryEntriesRequest) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("ListTamperingRegistryEntriesRequest(%+v)", *p)
+}
+
+// Attributes:
+//   - Entries
+type ListTamperingRegistryEntriesResult_ struct {
+	Entries []*TamperingRegistryEntry `thrift:"Entries,1" db:"Entries" json:"Entries"`
+}
+
+func NewListTamperingRegistryEntriesResult_() *ListTamperingRegistryEntriesResult_ {
+	return &ListTamperingRegistryEntriesResult_{}
+}
+
+func (p *ListTamperingRegistryEntriesResult_) GetEntries() []*TamperingRegistryEntry {
+	return p.Entries
+}
+func (p *ListTamperingRegistryEntriesResult_) Read(ctx context.Context, iprot thrift.TProtocol) error {
+	if _, err := iprot.ReadStructBegin(ctx); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T read error: ", p), err)
+	}
+
+	for {
+		_, fieldTypeId, fieldId, err := iprot.ReadFieldBegin(ctx)
+		if err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T field %d read error: ", p, fieldId), err)
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+		switch fieldId {
+		case 1:
+			if fieldTypeId == thrift.LIST {
+				if err := p.ReadField1(ctx, iprot); err != nil {
+					return err
+				}
+			} else {
+				if err := iprot.Skip(ctx, fieldTypeId); err != nil {
+					return err
+				}
+			}
+		default:
+			if err := iprot.Skip(ctx, fieldTypeId); err != nil {
+				return err
+			}
+		}
+		if err := iprot.ReadFieldEnd(ctx); err != nil {
+			return err
+		}
+	}
+	if err := iprot.ReadStructEnd(ctx); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
+	}
+	return nil
+}
+
+func (p *ListTamperingRegistryEntriesResult_) ReadField1(ctx context.Context, iprot thrift.TProtocol) error {
+	_, size, err := iprot.ReadListBegin(ctx)
+	if err != nil {
+		return thrift.PrependError("error reading list begin: ", err)
+	}
+	tSlice := make([]*TamperingRegistryEntry, 0, size)
+	p.Entries = tSlice
+	for i := 0; i < size; i++ {
+		_elem57 := &TamperingRegistryEntry{}
+		if err := _elem57.Read(ctx, iprot); err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T error reading struct: ", _elem57), err)
+		}
+		p.Entries = append(p.Entries, _elem57)
+	}
+	if err := iprot.ReadListEnd(ctx); err != nil {
+		return thrift.PrependError("error reading list end: ", err)
+	}
+	return nil
+}
+
+func (p *ListTamperingRegistryEntriesResult_) Write(ctx context.Context, oprot thrift.TProtocol) error {
+	if err := oprot.WriteStructBegin(ctx, "ListTamperingRegistryEntriesResult"); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
+	}
+	if p != nil {
+		if err := p.writeField1(ctx, oprot); err != nil {
+			return err
+		}
+	}
+	if err := oprot.WriteFieldStop(ctx); err != nil {
+		return thrift.PrependError("write field stop error: ", err)
+	}
+	if err := oprot.WriteStructEnd(ctx); err != nil {
+		return thrift.PrependError("write struct stop error: ", err)
+	}
+	return nil
+}
+
+func (p *ListTamperingRegistryEntriesResult_) writeField1(ctx context.Context, oprot thrift.TProtocol) (err error) {
+	if err := oprot.WriteFieldBegin(ctx, "Entries", thrift.LIST, 1); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T write field begin error 1:Entries: ", p), err)
+	}
+	if err := oprot.WriteListBegin(ctx, thrift.STRUCT, len(p.Entries)); err != nil {
+		return thrift.PrependError("error writing list begin: ", err)
+	}
+	for _, v := range p.Entries {
+		if err := v.Write(ctx, oprot); err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T error writing struct: ", v), err)
+		}
+	}
+	if err := oprot.WriteListEnd(ctx); err != nil {
+		return thrift.PrependError("error writing list end: ", err)
+	}
+	if err := oprot.WriteFieldEnd(ctx); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T write field end error 1:Entries: ", p), err)
+	}
+	return err
+}
+
+func (p *ListTamperingRegistryEntriesResult_) Equals(other *ListTamperingRegistryEntriesResult_) bool {
+	if p == other {
+		return true
+	} else if p == nil || other == nil {
+		return false
+	}
+	if len(p.Entries) != len(other.Entries) {
+		return false
+	}
+	for i, _tgt := range p.Entries {
+		_src58 := other.Entries[i]
+		if !_tgt.Equals(_src58) {
+			return false
+		}
+	}
+	return true
+}
+
+func (p *ListTamperingRegistryEntriesResult_) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("ListTamperingRegistryEntriesResult_(%+v)", *p)
+}
+
+// Attributes:
+//   - PCRIndex
+//   - Bank
+//   - Digest
+//   - ModelID
+type SearchByPCRRequest struct {
+	PCRIndex int32    `thrift:"PCRIndex,1" db:"PCRIndex" json:"PCRIndex"`
+	Bank     tpm.Algo `thrift:"Bank,2" db:"Bank" json:"Bank"`
+	Digest   []byte   `thrift:"Digest,3" db:"Digest" json:"Digest"`
+	ModelID  *int64   `thrift:"ModelID,4" db:"ModelID" json:"ModelID,omitempty"`
+}
+
+func NewSearchByPCRRequest() *SearchByPCRRequest {
+	return &SearchByPCRRequest{}
+}
+
+func (p *SearchByPCRRequest) GetPCRIndex() int32 {
+	return p.PCRIndex
+}
+
+func (p *SearchByPCRRequest) GetBank() tpm.Algo {
+	return p.Bank
+}
+
+func (p *SearchByPCRRequest) GetDigest() []byte {
+	return p.Digest
+}
+
+var SearchByPCRRequest_ModelID_DEFAULT int64
+
+func (p *SearchByPCRRequest) GetModelID() int64 {
+	if !p.IsSetModelID() {
+		return SearchByPCRRequest_ModelID_DEFAULT
+	}
+	return *p.ModelID
+}
+func (p *SearchByPCRRequest) IsSetModelID() bool {
+	return p.ModelID != nil
+}
+
+func (p *SearchByPCRRequest) Read(ctx context.Context, iprot thrift.TProtocol) error {
+	if _, err := iprot.ReadStructBegin(ctx); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T read error: ", p), err)
+	}
+
+	for {
+		_, fieldTypeId, fieldId, err := iprot.ReadFieldBegin(ctx)
+		if err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T field %d read error: ", p, fieldId), err)
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+		switch fieldId {
+		case 1:
+			if fieldTypeId == thrift.I32 {
+				if err := p.ReadField1(ctx, iprot); err != nil {
+					return err
+				}
+			} else {
+				if err := iprot.Skip(ctx, fieldTypeId); err != nil {
+					return err
+				}
+			}
+		case 2:
+			if fieldTypeId == thrift.I32 {
+				if err := p.ReadField2(ctx, iprot); err != nil {
+					return err
+				}
+			} else {
+				if err := iprot.Skip(ctx, fieldTypeId); err != nil {
+					return err
+				}
+			}
+		case 3:
+			if fieldTypeId == thrift.STRING {
+				if err := p.ReadField3(ctx, iprot); err != nil {
+					return err
+				}
+			} else {
+				if err := iprot.Skip(ctx, fieldTypeId); err != nil {
+					return err
+				}
+			}
+		case 4:
+			if fieldTypeId == thrift.I64 {
+				if err := p.ReadField4(ctx, iprot); err != nil {
+					return err
+				}
+			} else {
+				if err := iprot.Skip(ctx, fieldTypeId); err != nil {
+					return err
+				}
+			}
+		default:
+			if err := iprot.Skip(ctx, fieldTypeId); err != nil {
+				return err
+			}
+		}
+		if err := iprot.ReadFieldEnd(ctx); err != nil {
+			return err
+		}
+	}
+	if err := iprot.ReadStructEnd(ctx); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
+	}
+	return nil
+}
+
+func (p *SearchByPCRRequest) ReadField1(ctx context.Context, iprot thrift.TProtocol) error {
+	if v, err := iprot.ReadI32(ctx); err != nil {
+		return thrift.PrependError("error reading field 1: ", err)
+	} else {
+		p.PCRIndex = v
+	}
+	return nil
+}
+
+func (p *SearchByPCRRequest) ReadField2(ctx context.Context, iprot thrift.TProtocol) error {
+	if v, err := iprot.ReadI32(ctx); err != nil {
+		return thrift.PrependError("error reading field 2: ", err)
+	} else {
+		temp := tpm.Algo(v)
+		p.Bank = temp
+	}
+	return nil
+}
+
+func (p *SearchByPCRRequest) ReadField3(ctx context.Context, iprot thrift.TProtocol) error {
+	if v, err := iprot.ReadBinary(ctx); err != nil {
+		return thrift.PrependError("error reading field 3: ", err)
+	} else {
+		p.Digest = v
+	}
+	return nil
+}
+
+func (p *SearchByPCRRequest) ReadField4(ctx context.Context, iprot thrift.TProtocol) error {
+	if v, err := iprot.ReadI64(ctx); err != nil {
+		return thrift.PrependError("error reading field 4: ", err)
+	} else {
+		p.ModelID = &v
+	}
+	return nil
+}
+
+func (p *SearchByPCRRequest) Write(ctx context.Context, oprot thrift.TProtocol) error {
+	if err := oprot.WriteStructBegin(ctx, "SearchByPCRRequest"); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
+	}
+	if p != nil {
+		if err := p.writeField1(ctx, oprot); err != nil {
+			return err
+		}
+		if err := p.writeField2(ctx, oprot); err != nil {
+			return err
+		}
+		if err := p.writeField3(ctx, oprot); err != nil {
+			return err
+		}
+		if err := p.writeField4(ctx, oprot); err != nil {
+			return err
+		}
+	}
+	if err := oprot.WriteFieldStop(ctx); err != nil {
+		return thrift.PrependError("write field stop error: ", err)
+	}
+	if err := oprot.WriteStructEnd(ctx); err != nil {
+		return thrift.PrependError("write struct stop error: ", err)
+	}
+	return nil
+}
+
+func (p *SearchByPCRRequest) writeField1(ctx context.Context, oprot thrift.TProtocol) (err error) {
+	if err := oprot.WriteFieldBegin(ctx, "PCRIndex", thrift.I32, 1); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T write field begin error 1:PCRIndex: ", p), err)
+	}
+	if err := oprot.WriteI32(ctx, int32(p.PCRIndex)); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T.PCRIndex (1) field write error: ", p), err)
+	}
+	if err := oprot.WriteFieldEnd(ctx); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T write field end error 1:PCRIndex: ", p), err)
+	}
+	return err
+}
+
+func (p *SearchByPCRRequest) writeField2(ctx context.Context, oprot thrift.TProtocol) (err error) {
+	if err := oprot.WriteFieldBegin(ctx, "Bank", thrift.I32, 2); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T write field begin error 2:Bank: ", p), err)
+	}
+	if err := oprot.WriteI32(ctx, int32(p.Bank)); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T.Bank (2) field write error: ", p), err)
+	}
+	if err := oprot.WriteFieldEnd(ctx); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T write field end error 2:Bank: ", p), err)
+	}
+	return err
+}
+
+func (p *SearchByPCRRequest) writeField3(ctx context.Context, oprot thrift.TProtocol) (err error) {
+	if err := oprot.WriteFieldBegin(ctx, "Digest", thrift.STRING, 3); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T write field begin error 3:Digest: ", p), err)
+	}
+	if err := oprot.WriteBinary(ctx, p.Digest); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T.Digest (3) field write error: ", p), err)
+	}
+	if err := oprot.WriteFieldEnd(ctx); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T write field end error 3:Digest: ", p), err)
+	}
+	return err
+}
+
+func (p *SearchByPCRRequest) writeField4(ctx context.Context, oprot thrift.TProtocol) (err error) {
+	if p.IsSetModelID() {
+		if err := oprot.WriteFieldBegin(ctx, "ModelID", thrift.I64, 4); err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T write field begin error 4:ModelID: ", p), err)
+		}
+		if err := oprot.WriteI64(ctx, int64(*p.ModelID)); err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T.ModelID (4) field write error: ", p), err)
+		}
+		if err := oprot.WriteFieldEnd(ctx); err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T write field end error 4:ModelID: ", p), err)
+		}
+	}
+	return err
+}
+
+func (p *SearchByPCRRequest) Equals(other *SearchByPCRRequest) bool {
+	if p == other {
+		return true
+	} else if p == nil || other == nil {
+		return false
+	}
+	if p.PCRIndex != other.PCRIndex {
+		return false
+	}
+	if p.Bank != other.Bank {
+		return false
+	}
+	if bytes.Compare(p.Digest, other.Digest) != 0 {
+		return false
+	}
+	if p.ModelID != other.ModelID {
+		if p.ModelID == nil || other.ModelID == nil {
+			return false
+		}
+		if (*p.ModelID) != (*other.ModelID) {
+			return false
+		}
+	}
+	return true
+}
+
+func (p *SearchByPCRRequest) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("SearchByPCRRequest(%+v)", *p)
+}
+
+// Attributes:
+//   - Source
+//   - FirmwareVersion
+//   - ImageID
+//   - BootFlow
+//   - TPMDevice
+//   - StatusRegisters
+type PCRMatch struct {
+	Source          PCRMatchSource     `thrift:"Source,1" db:"Source" json:"Source"`
+	FirmwareVersion *string            `thrift:"FirmwareVersion,2" db:"FirmwareVersion" json:"FirmwareVersion,omitempty"`
+	ImageID         []byte             `thrift:"ImageID,3" db:"ImageID" json:"ImageID,omitempty"`
+	BootFlow        *measurements.Flow `thrift:"BootFlow,4" db:"BootFlow" json:"BootFlow,omitempty"`
+	TPMDevice       *TPMType           `thrift:"TPMDevice,5" db:"TPMDevice" json:"TPMDevice,omitempty"`
+	StatusRegisters []*StatusRegister  `thrift:"StatusRegisters,6" db:"StatusRegisters" json:"StatusRegisters"`
+}
+
+func NewPCRMatch() *PCRMatch {
+	return &PCRMatch{}
+}
+
+func (p *PCRMatch) GetSource() PCRMatchSource {
+	return p.Source
+}
+
+var PCRMatch_FirmwareVersion_DEFAULT string
+
+func (p *PCRMatch) GetFirmwareVersion() string {
+	if !p.IsSetFirmwareVersion() {
+		return PCRMatch_FirmwareVersion_DEFAULT
+	}
+	return *p.FirmwareVersion
+}
+
+var PCRMatch_ImageID_DEFAULT []byte
+
+func (p *PCRMatch) GetImageID() []byte {
+	return p.ImageID
+}
+
+var PCRMatch_BootFlow_DEFAULT measurements.Flow
+
+func (p *PCRMatch) GetBootFlow() measurements.Flow {
+	if !p.IsSetBootFlow() {
+		return PCRMatch_BootFlow_DEFAULT
+	}
+	return *p.BootFlow
+}
+
+var PCRMatch_TPMDevice_DEFAULT TPMType
+
+func (p *PCRMatch) GetTPMDevice() TPMType {
+	if !p.IsSetTPMDevice() {
+		return PCRMatch_TPMDevice_DEFAULT
+	}
+	return *p.TPMDevice
+}
+
+func (p *PCRMatch) GetStatusRegisters() []*StatusRegister {
+	return p.StatusRegisters
+}
+func (p *PCRMatch) IsSetFirmwareVersion() bool {
+	return p.FirmwareVersion != nil
+}
+
+func (p *PCRMatch) IsSetImageID() bool {
+	return p.ImageID != nil
+}
+
+func (p *PCRMatch) IsSetBootFlow() bool {
+	return p.BootFlow != nil
+}
+
+func (p *PCRMatch) IsSetTPMDevice() bool {
+	return p.TPMDevice != nil
+}
+
+func (p *PCRMatch) Read(ctx context.Context, iprot thrift.TProtocol) error {
+	if _, err := iprot.ReadStructBegin(ctx); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T read error: ", p), err)
+	}
+
+	for {
+		_, fieldTypeId, fieldId, err := iprot.ReadFieldBegin(ctx)
+		if err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T field %d read error: ", p, fieldId), err)
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+		switch fieldId {
+		case 1:
+			if fieldTypeId == thrift.I32 {
+				if err := p.ReadField1(ctx, iprot); err != nil {
+					return err
+				}
+			} else {
+				if err := iprot.Skip(ctx, fieldTypeId); err != nil {
+					return err
+				}
+			}
+		case 2:
+			if fieldTypeId == thrift.STRING {
+				if err := p.ReadField2(ctx, iprot); err != nil {
+					return err
+				}
+			} else {
+				if err := iprot.Skip(ctx, fieldTypeId); err != nil {
+					return err
+				}
+			}
+		case 3:
+			if fieldTypeId == thrift.STRING {
+				if err := p.ReadField3(ctx, iprot); err != nil {
+					return err
+				}
+			} else {
+				if err := iprot.Skip(ctx, fieldTypeId); err != nil {
+					return err
+				}
+			}
+		case 4:
+			if fieldTypeId == thrift.I32 {
+				if err := p.ReadField4(ctx, iprot); err != nil {
+					return err
+				}
+			} else {
+				if err := iprot.Skip(ctx, fieldTypeId); err != nil {
+					return err
+				}
+			}
+		case 5:
+			if fieldTypeId == thrift.I32 {
+				if err := p.ReadField5(ctx, iprot); err != nil {
+					return err
+				}
+			} else {
+				if err := iprot.Skip(ctx, fieldTypeId); err != nil {
+					return err
+				}
+			}
+		case 6:
+			if fieldTypeId == thrift.LIST {
+				if err := p.ReadField6(ctx, iprot); err != nil {
+					return err
+				}
+			} else {
+				if err := iprot.Skip(ctx, fieldTypeId); err != nil {
+					return err
+				}
+			}
+		default:
+			if err := iprot.Skip(ctx, fieldTypeId); err != nil {
+				return err
+			}
+		}
+		if err := iprot.ReadFieldEnd(ctx); err != nil {
+			return err
+		}
+	}
+	if err := iprot.ReadStructEnd(ctx); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
+	}
+	return nil
+}
+
+func (p *PCRMatch) ReadField1(ctx context.Context, iprot thrift.TProtocol) error {
+	if v, err := iprot.ReadI32(ctx); err != nil {
+		return thrift.PrependError("error reading field 1: ", err)
+	} else {
+		temp := PCRMatchSource(v)
+		p.Source = temp
+	}
+	return nil
+}
+
+func (p *PCRMatch) ReadField2(ctx context.Context, iprot thrift.TProtocol) error {
+	if v, err := iprot.ReadString(ctx); err != nil {
+		return thrift.PrependError("error reading field 2: ", err)
+	} else {
+		p.FirmwareVersion = &v
+	}
+	return nil
+}
+
+func (p *PCRMatch) ReadField3(ctx context.Context, iprot thrift.TProtocol) error {
+	if v, err := iprot.ReadBinary(ctx); err != nil {
+		return thrift.PrependError("error reading field 3: ", err)
+	} else {
+		p.ImageID = v
+	}
+	return nil
+}
+
+func (p *PCRMatch) ReadField4(ctx context.Context, iprot thrift.TProtocol) error {
+	if v, err := iprot.ReadI32(ctx); err != nil {
+		return thrift.PrependError("error reading field 4: ", err)
+	} else {
+		temp := measurements.Flow(v)
+		p.BootFlow = &temp
+	}
+	return nil
+}
+
+func (p *PCRMatch) ReadField5(ctx context.Context, iprot thrift.TProtocol) error {
+	if v, err := iprot.ReadI32(ctx); err != nil {
+		return thrift.PrependError("error reading field 5: ", err)
+	} else {
+		temp := TPMType(v)
+		p.TPMDevice = &temp
+	}
+	return nil
+}
+
+func (p *PCRMatch) ReadField6(ctx context.Context, iprot thrift.TProtocol) error {
+	_, size, err := iprot.ReadListBegin(ctx)
+	if err != nil {
+		return thrift.PrependError("error reading list begin: ", err)
+	}
+	tSlice := make([]*StatusRegister, 0, size)
+	p.StatusRegisters = tSlice
+	for i := 0; i < size; i++ {
+		_elem59 := &StatusRegister{}
+		if err := _elem59.Read(ctx, iprot); err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T error reading struct: ", _elem59), err)
+		}
+		p.StatusRegisters = append(p.StatusRegisters, _elem59)
+	}
+	if err := iprot.ReadListEnd(ctx); err != nil {
+		return thrift.PrependError("error reading list end: ", err)
+	}
+	return nil
+}
+
+func (p *PCRMatch) Write(ctx context.Context, oprot thrift.TProtocol) error {
+	if err := oprot.WriteStructBegin(ctx, "PCRMatch"); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
+	}
+	if p != nil {
+		if err := p.writeField1(ctx, oprot); err != nil {
+			return err
+		}
+		if err := p.writeField2(ctx, oprot); err != nil {
+			return err
+		}
+		if err := p.writeField3(ctx, oprot); err != nil {
+			return err
+		}
+		if err := p.writeField4(ctx, oprot); err != nil {
+			return err
+		}
+		if err := p.writeField5(ctx, oprot); err != nil {
+			return err
+		}
+		if err := p.writeField6(ctx, oprot); err != nil {
+			return err
+		}
+	}
+	if err := oprot.WriteFieldStop(ctx); err != nil {
+		return thrift.PrependError("write field stop error: ", err)
+	}
+	if err := oprot.WriteStructEnd(ctx); err != nil {
+		return thrift.PrependError("write struct stop error: ", err)
+	}
+	return nil
+}
+
+func (p *PCRMatch) writeField1(ctx context.Context, oprot thrift.TProtocol) (err error) {
+	if err := oprot.WriteFieldBegin(ctx, "Source", thrift.I32, 1); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T write field begin error 1:Source: ", p), err)
+	}
+	if err := oprot.WriteI32(ctx, int32(p.Source)); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T.Source (1) field write error: ", p), err)
+	}
+	if err := oprot.WriteFieldEnd(ctx); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T write field end error 1:Source: ", p), err)
+	}
+	return err
+}
+
+func (p *PCRMatch) writeField2(ctx context.Context, oprot thrift.TProtocol) (err error) {
+	if p.IsSetFirmwareVersion() {
+		if err := oprot.WriteFieldBegin(ctx, "FirmwareVersion", thrift.STRING, 2); err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T write field begin error 2:FirmwareVersion: ", p), err)
+		}
+		if err := oprot.WriteString(ctx, string(*p.FirmwareVersion)); err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T.FirmwareVersion (2) field write error: ", p), err)
+		}
+		if err := oprot.WriteFieldEnd(ctx); err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T write field end error 2:FirmwareVersion: ", p), err)
+		}
+	}
+	return err
+}
+
+func (p *PCRMatch) writeField3(ctx context.Context, oprot thrift.TProtocol) (err error) {
+	if p.IsSetImageID() {
+		if err := oprot.WriteFieldBegin(ctx, "ImageID", thrift.STRING, 3); err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T write field begin error 3:ImageID: ", p), err)
+		}
+		if err := oprot.WriteBinary(ctx, p.ImageID); err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T.ImageID (3) field write error: ", p), err)
+		}
+		if err := oprot.WriteFieldEnd(ctx); err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T write field end error 3:ImageID: ", p), err)
+		}
+	}
+	return err
+}
+
+func (p *PCRMatch) writeField4(ctx context.Context, oprot thrift.TProtocol) (err error) {
+	if p.IsSetBootFlow() {
+		if err := oprot.WriteFieldBegin(ctx, "BootFlow", thrift.I32, 4); err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T write field begin error 4:BootFlow: ", p), err)
+		}
+		if err := oprot.WriteI32(ctx, int32(*p.BootFlow)); err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T.BootFlow (4) field write error: ", p), err)
+		}
+		if err := oprot.WriteFieldEnd(ctx); err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T write field end error 4:BootFlow: ", p), err)
+		}
+	}
+	return err
+}
+
+func (p *PCRMatch) writeField5(ctx context.Context, oprot thrift.TProtocol) (err error) {
+	if p.IsSetTPMDevice() {
+		if err := oprot.WriteFieldBegin(ctx, "TPMDevice", thrift.I32, 5); err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T write field begin error 5:TPMDevice: ", p), err)
+		}
+		if err := oprot.WriteI32(ctx, int32(*p.TPMDevice)); err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T.TPMDevice (5) field write error: ", p), err)
+		}
+		if err := oprot.WriteFieldEnd(ctx); err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T write field end error 5:TPMDevice: ", p), err)
+		}
+	}
+	return err
+}
+
+func (p *PCRMatch) writeField6(ctx context.Context, oprot thrift.TProtocol) (err error) {
+	if err := oprot.WriteFieldBegin(ctx, "StatusRegisters", thrift.LIST, 6); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T write field begin error 6:StatusRegisters: ", p), err)
+	}
+	if err := oprot.WriteListBegin(ctx, thrift.STRUCT, len(p.StatusRegisters)); err != nil {
+		return thrift.PrependError("error writing list begin: ", err)
+	}
+	for _, v := range p.StatusRegisters {
+		if err := v.Write(ctx, oprot); err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T error writing struct: ", v), err)
+		}
+	}
+	if err := oprot.WriteListEnd(ctx); err != nil {
+		return thrift.PrependError("error writing list end: ", err)
+	}
+	if err := oprot.WriteFieldEnd(ctx); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T write field end error 6:StatusRegisters: ", p), err)
+	}
+	return err
+}
+
+func (p *PCRMatch) Equals(other *PCRMatch) bool {
+	if p == other {
+		return true
+	} else if p == nil || other == nil {
+		return false
+	}
+	if p.Source != other.Source {
+		return false
+	}
+	if p.FirmwareVersion != other.FirmwareVersion {
+		if p.FirmwareVersion == nil || other.FirmwareVersion == nil {
+			return false
+		}
+		if (*p.FirmwareVersion) != (*other.FirmwareVersion) {
+			return false
+		}
+	}
+	if bytes.Compare(p.ImageID, other.ImageID) != 0 {
+		return false
+	}
+	if p.BootFlow != other.BootFlow {
+		if p.BootFlow == nil || other.BootFlow == nil {
+			return false
+		}
+		if (*p.BootFlow) != (*other.BootFlow) {
+			return false
+		}
+	}
+	if p.TPMDevice != other.TPMDevice {
+		if p.TPMDevice == nil || other.TPMDevice == nil {
+			return false
+		}
+		if (*p.TPMDevice) != (*other.TPMDevice) {
+			return false
+		}
+	}
+	if len(p.StatusRegisters) != len(other.StatusRegisters) {
+		return false
+	}
+	for i, _tgt := range p.StatusRegisters {
+		_src60 := other.StatusRegisters[i]
+		if !_tgt.Equals(_src60) {
+			return false
+		}
+	}
+	return true
+}
+
+func (p *PCRMatch) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("PCRMatch(%+v)", *p)
+}
+
+// Attributes:
+//   - Found
+type SearchByPCRResult_ struct {
+	Found []*PCRMatch `thrift:"Found,1" db:"Found" json:"Found"`
+}
+
+func NewSearchByPCRResult_() *SearchByPCRResult_ {
+	return &SearchByPCRResult_{}
+}
+
+func (p *SearchByPCRResult_) GetFound() []*PCRMatch {
+	return p.Found
+}
+func (p *SearchByPCRResult_) Read(ctx context.Context, iprot thrift.TProtocol) error {
+	if _, err := iprot.ReadStructBegin(ctx); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T read error: ", p), err)
+	}
+
+	for {
+		_, fieldTypeId, fieldId, err := iprot.ReadFieldBegin(ctx)
+		if err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T field %d read error: ", p, fieldId), err)
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+		switch fieldId {
+		case 1:
+			if fieldTypeId == thrift.LIST {
+				if err := p.ReadField1(ctx, iprot); err != nil {
+					return err
+				}
+			} else {
+				if err := iprot.Skip(ctx, fieldTypeId); err != nil {
+					return err
+				}
+			}
+		default:
+			if err := iprot.Skip(ctx, fieldTypeId); err != nil {
+				return err
+			}
+		}
+		if err := iprot.ReadFieldEnd(ctx); err != nil {
+			return err
+		}
+	}
+	if err := iprot.ReadStructEnd(ctx); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
+	}
+	return nil
+}
+
+func (p *SearchByPCRResult_) ReadField1(ctx context.Context, iprot thrift.TProtocol) error {
+	_, size, err := iprot.ReadListBegin(ctx)
+	if err != nil {
+		return thrift.PrependError("error reading list begin: ", err)
+	}
+	tSlice := make([]*PCRMatch, 0, size)
+	p.Found = tSlice
+	for i := 0; i < size; i++ {
+		_elem61 := &PCRMatch{}
+		if err := _elem61.Read(ctx, iprot); err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T error reading struct: ", _elem61), err)
+		}
+		p.Found = append(p.Found, _elem61)
+	}
+	if err := iprot.ReadListEnd(ctx); err != nil {
+		return thrift.PrependError("error reading list end: ", err)
+	}
+	return nil
+}
+
+func (p *SearchByPCRResult_) Write(ctx context.Context, oprot thrift.TProtocol) error {
+	if err := oprot.WriteStructBegin(ctx, "SearchByPCRResult"); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
+	}
+	if p != nil {
+		if err := p.writeField1(ctx, oprot); err != nil {
+			return err
+		}
+	}
+	if err := oprot.WriteFieldStop(ctx); err != nil {
+		return thrift.PrependError("write field stop error: ", err)
+	}
+	if err := oprot.WriteStructEnd(ctx); err != nil {
+		return thrift.PrependError("write struct stop error: ", err)
+	}
+	return nil
+}
+
+func (p *SearchByPCRResult_) writeField1(ctx context.Context, oprot thrift.TProtocol) (err error) {
+	if err := oprot.WriteFieldBegin(ctx, "Found", thrift.LIST, 1); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T write field begin error 1:Found: ", p), err)
+	}
+	if err := oprot.WriteListBegin(ctx, thrift.STRUCT, len(p.Found)); err != nil {
+		return thrift.PrependError("error writing list begin: ", err)
+	}
+	for _, v := range p.Found {
+		if err := v.Write(ctx, oprot); err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T error writing struct: ", v), err)
+		}
+	}
+	if err := oprot.WriteListEnd(ctx); err != nil {
+		return thrift.PrependError("error writing list end: ", err)
+	}
+	if err := oprot.WriteFieldEnd(ctx); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T write field end error 1:Found: ", p), err)
+	}
+	return err
+}
+
+func (p *SearchByPCRResult_) Equals(other *SearchByPCRResult_) bool {
+	if p == other {
+		return true
+	} else if p == nil || other == nil {
+		return false
+	}
+	if len(p.Found) != len(other.Found) {
+		return false
+	}
+	for i, _tgt := range p.Found {
+		_src62 := other.Found[i]
+		if !_tgt.Equals(_src62) {
+			return false
+		}
+	}
+	return true
+}
+
+func (p *SearchByPCRResult_) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("SearchByPCRResult_(%+v)", *p)
+}
+
+// Attributes:
+//   - Manifest
+type NegotiateImageUploadRequest struct {
+	Manifest *ImageChunksManifest `thrift:"Manifest,1" db:"Manifest" json:"Manifest"`
+}
+
+func NewNegotiateImageUploadRequest() *NegotiateImageUploadRequest {
+	return &NegotiateImageUploadRequest{}
+}
+
+var NegotiateImageUploadRequest_Manifest_DEFAULT *ImageChunksManifest
+
+func (p *NegotiateImageUploadRequest) GetManifest() *ImageChunksManifest {
+	if !p.IsSetManifest() {
+		return NegotiateImageUploadRequest_Manifest_DEFAULT
+	}
+	return p.Manifest
+}
+func (p *NegotiateImageUploadRequest) IsSetManifest() bool {
+	return p.Manifest != nil
+}
+
+func (p *NegotiateImageUploadRequest) Read(ctx context.Context, iprot thrift.TProtocol) error {
+	if _, err := iprot.ReadStructBegin(ctx); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T read error: ", p), err)
+	}
+
+	for {
+		_, fieldTypeId, fieldId, err := iprot.ReadFieldBegin(ctx)
+		if err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T field %d read error: ", p, fieldId), err)
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+		switch fieldId {
+		case 1:
+			if fieldTypeId == thrift.STRUCT {
+				if err := p.ReadField1(ctx, iprot); err != nil {
+					return err
+				}
+			} else {
+				if err := iprot.Skip(ctx, fieldTypeId); err != nil {
+					return err
+				}
+			}
+		default:
+			if err := iprot.Skip(ctx, fieldTypeId); err != nil {
+				return err
+			}
+		}
+		if err := iprot.ReadFieldEnd(ctx); err != nil {
+			return err
+		}
+	}
+	if err := iprot.ReadStructEnd(ctx); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
+	}
+	return nil
+}
+
+func (p *NegotiateImageUploadRequest) ReadField1(ctx context.Context, iprot thrift.TProtocol) error {
+	p.Manifest = &ImageChunksManifest{}
+	if err := p.Manifest.Read(ctx, iprot); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T error reading struct: ", p.Manifest), err)
+	}
+	return nil
+}
+
+func (p *NegotiateImageUploadRequest) Write(ctx context.Context, oprot thrift.TProtocol) error {
+	if err := oprot.WriteStructBegin(ctx, "NegotiateImageUploadRequest"); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
+	}
+	if p != nil {
+		if err := p.writeField1(ctx, oprot); err != nil {
+			return err
+		}
+	}
+	if err := oprot.WriteFieldStop(ctx); err != nil {
+		return thrift.PrependError("write field stop error: ", err)
+	}
+	if err := oprot.WriteStructEnd(ctx); err != nil {
+		return thrift.PrependError("write struct stop error: ", err)
+	}
+	return nil
+}
+
+func (p *NegotiateImageUploadRequest) writeField1(ctx context.Context, oprot thrift.TProtocol) (err error) {
+	if err := oprot.WriteFieldBegin(ctx, "Manifest", thrift.STRUCT, 1); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T write field begin error 1:Manifest: ", p), err)
+	}
+	if err := p.Manifest.Write(ctx, oprot); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T error writing struct: ", p.Manifest), err)
+	}
+	if err := oprot.WriteFieldEnd(ctx); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T write field end error 1:Manifest: ", p), err)
+	}
+	return err
+}
+
+func (p *NegotiateImageUploadRequest) Equals(other *NegotiateImageUploadRequest) bool {
+	if p == other {
+		return true
+	} else if p == nil || other == nil {
+		return false
+	}
+	if !p.Manifest.Equals(other.Manifest) {
+		return false
+	}
+	return true
+}
+
+func (p *NegotiateImageUploadRequest) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("NegotiateImageUploadRequest(%+v)", *p)
+}
+
+// Attributes:
+//   - ImageID
+//   - MissingChunks
+type NegotiateImageUploadResult_ struct {
+	ImageID       []byte  `thrift:"ImageID,1" db:"ImageID" json:"ImageID,omitempty"`
+	MissingChunks []int32 `thrift:"MissingChunks,2" db:"MissingChunks" json:"MissingChunks"`
+}
+
+func NewNegotiateImageUploadResult_() *NegotiateImageUploadResult_ {
+	return &NegotiateImageUploadResult_{}
+}
+
+var NegotiateImageUploadResult__ImageID_DEFAULT []byte
+
+func (p *NegotiateImageUploadResult_) GetImageID() []byte {
+	return p.ImageID
+}
+
+func (p *NegotiateImageUploadResult_) GetMissingChunks() []int32 {
+	return p.MissingChunks
+}
+func (p *NegotiateImageUploadResult_) IsSetImageID() bool {
+	return p.ImageID != nil
+}
+
+func (p *NegotiateImageUploadResult_) Read(ctx context.Context, iprot thrift.TProtocol) error {
+	if _, err := iprot.ReadStructBegin(ctx); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T read error: ", p), err)
+	}
+
+	for {
+		_, fieldTypeId, fieldId, err := iprot.ReadFieldBegin(ctx)
+		if err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T field %d read error: ", p, fieldId), err)
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+		switch fieldId {
+		case 1:
+			if fieldTypeId == thrift.STRING {
+				if err := p.ReadField1(ctx, iprot); err != nil {
+					return err
+				}
+			} else {
+				if err := iprot.Skip(ctx, fieldTypeId); err != nil {
+					return err
+				}
+			}
+		case 2:
+			if fieldTypeId == thrift.LIST {
+				if err := p.ReadField2(ctx, iprot); err != nil {
+					return err
+				}
+			} else {
+				if err := iprot.Skip(ctx, fieldTypeId); err != nil {
+					return err
+				}
+			}
+		default:
+			if err := iprot.Skip(ctx, fieldTypeId); err != nil {
+				return err
+			}
+		}
+		if err := iprot.ReadFieldEnd(ctx); err != nil {
+			return err
+		}
+	}
+	if err := iprot.ReadStructEnd(ctx); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
+	}
+	return nil
+}
+
+func (p *NegotiateImageUploadResult_) ReadField1(ctx context.Context, iprot thrift.TProtocol) error {
+	if v, err := iprot.ReadBinary(ctx); err != nil {
+		return thrift.PrependError("error reading field 1: ", err)
+	} else {
+		p.ImageID = v
+	}
+	return nil
+}
+
+func (p *NegotiateImageUploadResult_) ReadField2(ctx context.Context, iprot thrift.TProtocol) error {
+	_, size, err := iprot.ReadListBegin(ctx)
+	if err != nil {
+		return thrift.PrependError("error reading list begin: ", err)
+	}
+	tSlice := make([]int32, 0, size)
+	p.MissingChunks = tSlice
+	for i := 0; i < size; i++ {
+		var _elem63 int32
+		if v, err := iprot.ReadI32(ctx); err != nil {
+			return thrift.PrependError("error reading field 0: ", err)
+		} else {
+			_elem63 = v
+		}
+		p.MissingChunks = append(p.MissingChunks, _elem63)
+	}
+	if err := iprot.ReadListEnd(ctx); err != nil {
+		return thrift.PrependError("error reading list end: ", err)
+	}
+	return nil
+}
+
+func (p *NegotiateImageUploadResult_) Write(ctx context.Context, oprot thrift.TProtocol) error {
+	if err := oprot.WriteStructBegin(ctx, "NegotiateImageUploadResult"); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
+	}
+	if p != nil {
+		if err := p.writeField1(ctx, oprot); err != nil {
+			return err
+		}
+		if err := p.writeField2(ctx, oprot); err != nil {
+			return err
+		}
+	}
+	if err := oprot.WriteFieldStop(ctx); err != nil {
+		return thrift.PrependError("write field stop error: ", err)
+	}
+	if err := oprot.WriteStructEnd(ctx); err != nil {
+		return thrift.PrependError("write struct stop error: ", err)
+	}
+	return nil
+}
+
+func (p *NegotiateImageUploadResult_) writeField1(ctx context.Context, oprot thrift.TProtocol) (err error) {
+	if p.IsSetImageID() {
+		if err := oprot.WriteFieldBegin(ctx, "ImageID", thrift.STRING, 1); err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T write field begin error 1:ImageID: ", p), err)
+		}
+		if err := oprot.WriteBinary(ctx, p.ImageID); err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T.ImageID (1) field write error: ", p), err)
+		}
+		if err := oprot.WriteFieldEnd(ctx); err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T write field end error 1:ImageID: ", p), err)
+		}
+	}
+	return err
+}
+
+func (p *NegotiateImageUploadResult_) writeField2(ctx context.Context, oprot thrift.TProtocol) (err error) {
+	if err := oprot.WriteFieldBegin(ctx, "MissingChunks", thrift.LIST, 2); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T write field begin error 2:MissingChunks: ", p), err)
+	}
+	if err := oprot.WriteListBegin(ctx, thrift.I32, len(p.MissingChunks)); err != nil {
+		return thrift.PrependError("error writing list begin: ", err)
+	}
+	for _, v := range p.MissingChunks {
+		if err := oprot.WriteI32(ctx, int32(v)); err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T. (0) field write error: ", p), err)
+		}
+	}
+	if err := oprot.WriteListEnd(ctx); err != nil {
+		return thrift.PrependError("error writing list end: ", err)
+	}
+	if err := oprot.WriteFieldEnd(ctx); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T write field end error 2:MissingChunks: ", p), err)
+	}
+	return err
+}
+
+func (p *NegotiateImageUploadResult_) Equals(other *NegotiateImageUploadResult_) bool {
+	if p == other {
+		return true
+	} else if p == nil || other == nil {
+		return false
+	}
+	if bytes.Compare(p.ImageID, other.ImageID) != 0 {
+		return false
+	}
+	if len(p.MissingChunks) != len(other.MissingChunks) {
+		return false
+	}
+	for i, _tgt := range p.MissingChunks {
+		_src64 := other.MissingChunks[i]
+		if _tgt != _src64 {
+			return false
+		}
+	}
+	return true
+}
+
+func (p *NegotiateImageUploadResult_) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("NegotiateImageUploadResult_(%+v)", *p)
+}
+
+type AttestationFailureAnalyzerService interface {
+	// Parameters:
+	//  - Request
+	GetServerInfo(ctx context.Context, request *GetServerInfoRequest) (r *ServerInfo, err error)
+	// Parameters:
+	//  - Request
+	SearchFirmware(ctx context.Context, request *SearchFirmwareRequest) (r *SearchFirmwareResult_, err error)
+	// Parameters:
+	//  - Request
+	SearchReport(ctx context.Context, request *SearchReportRequest) (r *SearchReportResult_, err error)
+	// Parameters:
+	//  - Request
+	Analyze(ctx context.Context, request *AnalyzeRequest) (r *AnalyzeResult_, err error)
+	// Parameters:
+	//  - Request
+	CheckFirmwareVersion(ctx context.Context, request *CheckFirmwareVersionRequest) (r *CheckFirmwareVersionResult_, err error)
+	// Parameters:
+	//  - Request
+	SearchByPCR(ctx context.Context, request *SearchByPCRRequest) (r *SearchByPCRResult_, err error)
+	// Parameters:
+	//  - Request
+	CompareReports(ctx context.Context, request *CompareReportsRequest) (r *CompareReportsResult_, err error)
+	// Parameters:
+	//  - Request
+	AggregateReports(ctx context.Context, request *AggregateReportsRequest) (r *AggregateReportsResult_, err error)
+	// Parameters:
+	//  - Request
+	NegotiateImageUpload(ctx context.Context, request *NegotiateImageUploadRequest) (r *NegotiateImageUploadResult_, err error)
+	// Parameters:
+	//  - Request
+	InsertOriginalFirmware(ctx context.Context, request *InsertOriginalFirmwareRequest) (r *InsertOriginalFirmwareResult_, err error)
+	// Parameters:
+	//  - Request
+	UpdateOriginalFirmware(ctx context.Context, request *UpdateOriginalFirmwareRequest) (err error)
+	// Parameters:
+	//  - Request
+	DeleteOriginalFirmware(ctx context.Context, request *DeleteOriginalFirmwareRequest) (err error)
+	// Parameters:
+	//  - Request
+	ListTamperingRegistryEntries(ctx context.Context, request *ListTamperingRegistryEntriesRequest) (r *ListTamperingRegistryEntriesResult_, err error)
+	// Parameters:
+	//  - Request
+	InsertTamperingRegistryEntry(ctx context.Context, request *InsertTamperingRegistryEntryRequest) (r *InsertTamperingRegistryEntryResult_, err error)
+	// Parameters:
+	//  - Request
+	UpdateTamperingRegistryEntry(ctx context.Context, request *UpdateTamperingRegistryEntryRequest) (err error)
+	// Parameters:
+	//  - Request
+	DeleteTamperingRegistryEntry(ctx context.Context, request *DeleteTamperingRegistryEntryRequest) (err error)
+}
+
+type AttestationFailureAnalyzerServiceClient struct {
+	c    thrift.TClient
+	meta thrift.ResponseMeta
+}
+
+func NewAttestationFailureAnalyzerServiceClientFactory(t thrift.TTransport, f thrift.TProtocolFactory) *AttestationFailureAnalyzerServiceClient {
+	return &AttestationFailureAnalyzerServiceClient{
+		c: thrift.NewTStandardClient(f.GetProtocol(t), f.GetProtocol(t)),
+	}
+}
+
+func NewAttestationFailureAnalyzerServiceClientProtocol(t thrift.TTransport, iprot thrift.TProtocol, oprot thrift.TProtocol) *AttestationFailureAnalyzerServiceClient {
+	return &AttestationFailureAnalyzerServiceClient{
+		c: thrift.NewTStandardClient(iprot, oprot),
+	}
+}
+
+func NewAttestationFailureAnalyzerServiceClient(c thrift.TClient) *AttestationFailureAnalyzerServiceClient {
+	return &AttestationFailureAnalyzerServiceClient{
+		c: c,
+	}
+}
+
+func (p *AttestationFailureAnalyzerServiceClient) Client_() thrift.TClient {
+	return p.c
+}
+
+func (p *AttestationFailureAnalyzerServiceClient) LastResponseMeta_() thrift.ResponseMeta {
+	return p.meta
+}
+
+func (p *AttestationFailureAnalyzerServiceClient) SetLastResponseMeta_(meta thrift.ResponseMeta) {
+	p.meta = meta
+}
+
+// Parameters:
+//   - Request
+func (p *AttestationFailureAnalyzerServiceClient) GetServerInfo(ctx context.Context, request *GetServerInfoRequest) (r *ServerInfo, err error) {
+	var _args65 AttestationFailureAnalyzerServiceGetServerInfoArgs
+	_args65.Request = request
+	var _result66 AttestationFailureAnalyzerServiceGetServerInfoResult
+	var meta thrift.ResponseMeta
+	meta, err = p.Client_().Call(ctx, "GetServerInfo", &_args65, &_result66)
+	p.SetLastResponseMeta_(meta)
+	if err != nil {
+		return
+	}
+	switch {
+	case _result66.PermissionDenied != nil:
+		return r, _result66.PermissionDenied
+	}
+
+	return _result66.GetSuccess(), nil
+}
+
+// Parameters:
+//   - Request
+func (p *AttestationFailureAnalyzerServiceClient) SearchFirmware(ctx context.Context, request *SearchFirmwareRequest) (r *SearchFirmwareResult_, err error) {
+	var _args67 AttestationFailureAnalyzerServiceSearchFirmwareArgs
+	_args67.Request = request
+	var _result68 AttestationFailureAnalyzerServiceSearchFirmwareResult
+	var meta thrift.ResponseMeta
+	meta, err = p.Client_().Call(ctx, "SearchFirmware", &_args67, &_result68)
+	p.SetLastResponseMeta_(meta)
+	if err != nil {
+		return
+	}
+	switch {
+	case _result68.PermissionDenied != nil:
+		return r, _result68.PermissionDenied
+	}
+
+	return _result68.GetSuccess(), nil
+}
+
+// Parameters:
+//   - Request
+func (p *AttestationFailureAnalyzerServiceClient) SearchReport(ctx context.Context, request *SearchReportRequest) (r *SearchReportResult_, err error) {
+	var _args69 AttestationFailureAnalyzerServiceSearchReportArgs
+	_args69.Request = request
+	var _result70 AttestationFailureAnalyzerServiceSearchReportResult
+	var meta thrift.ResponseMeta
+	meta, err = p.Client_().Call(ctx, "SearchReport", &_args69, &_result70)
+	p.SetLastResponseMeta_(meta)
+	if err != nil {
+		return
+	}
+	switch {
+	case _result70.PermissionDenied != nil:
+		return r, _result70.PermissionDenied
+	}
+
+	return _result70.GetSuccess(), nil
+}
+
+// Parameters:
+//   - Request
+func (p *AttestationFailureAnalyzerServiceClient) Analyze(ctx context.Context, request *AnalyzeRequest) (r *AnalyzeResult_, err error) {
+	var _args71 AttestationFailureAnalyzerServiceAnalyzeArgs
+	_args71.Request = request
+	var _result72 AttestationFailureAnalyzerServiceAnalyzeResult
+	var meta thrift.ResponseMeta
+	meta, err = p.Client_().Call(ctx, "Analyze", &_args71, &_result72)
+	p.SetLastResponseMeta_(meta)
+	if err != nil {
+		return
+	}
+	switch {
+	case _result72.UnableToGetOriginalFirmware != nil:
+		return r, _result72.UnableToGetOriginalFirmware
+	case _result72.IncorrectHostConfiguration != nil:
+		return r, _result72.IncorrectHostConfiguration
+	case _result72.PermissionDenied != nil:
+		return r, _result72.PermissionDenied
+	case _result72.Overloaded != nil:
+		return r, _result72.Overloaded
+	}
+
+	return _result72.GetSuccess(), nil
+}
+
+// Parameters:
+//   - Request
+func (p *AttestationFailureAnalyzerServiceClient) CheckFirmwareVersion(ctx context.Context, request *CheckFirmwareVersionRequest) (r *CheckFirmwareVersionResult_, err error) {
+	var _args73 AttestationFailureAnalyzerServiceCheckFirmwareVersionArgs
+	_args73.Request = request
+	var _result74 AttestationFailureAnalyzerServiceCheckFirmwareVersionResult
+	var meta thrift.ResponseMeta
+	meta, err = p.Client_().Call(ctx, "CheckFirmwareVersion", &_args73, &_result74)
+	p.SetLastResponseMeta_(meta)
+	if err != nil {
+		return
+	}
+	switch {
+	case _result74.PermissionDenied != nil:
+		return r, _result74.PermissionDenied
+	}
+
+	return _result74.GetSuccess(), nil
+}
+
+// Parameters:
+//   - Request
+func (p *AttestationFailureAnalyzerServiceClient) SearchByPCR(ctx context.Context, request *SearchByPCRRequest) (r *SearchByPCRResult_, err error) {
+	var _args75 AttestationFailureAnalyzerServiceSearchByPCRArgs
+	_args75.Request = request
+	var _result76 AttestationFailureAnalyzerServiceSearchByPCRResult
+	var meta thrift.ResponseMeta
+	meta, err = p.Client_().Call(ctx, "SearchByPCR", &_args75, &_result76)
+	p.SetLastResponseMeta_(meta)
+	if err != nil {
+		return
+	}
+	switch {
+	case _result76.InvalidRequest != nil:
+		return r, _result76.InvalidRequest
+	case _result76.PermissionDenied != nil:
+		return r, _result76.PermissionDenied
+	}
+
+	return _result76.GetSuccess(), nil
+}
+
+// Parameters:
+//   - Request
+func (p *AttestationFailureAnalyzerServiceClient) CompareReports(ctx context.Context, request *CompareReportsRequest) (r *CompareReportsResult_, err error) {
+	var _args77 AttestationFailureAnalyzerServiceCompareReportsArgs
+	_args77.Request = request
+	var _result78 AttestationFailureAnalyzerServiceCompareReportsResult
+	var meta thrift.ResponseMeta
+	meta, err = p.Client_().Call(ctx, "CompareReports", &_args77, &_result78)
+	p.SetLastResponseMeta_(meta)
+	if err != nil {
+		return
+	}
+	switch {
+	case _result78.InvalidRequest != nil:
+		return r, _result78.InvalidRequest
+	case _result78.PermissionDenied != nil:
+		return r, _result78.PermissionDenied
+	}
+
+	return _result78.GetSuccess(), nil
+}
+
+// Parameters:
+//   - Request
+func (p *AttestationFailureAnalyzerServiceClient) AggregateReports(ctx context.Context, request *AggregateReportsRequest) (r *AggregateReportsResult_, err error) {
+	var _args79 AttestationFailureAnalyzerServiceAggregateReportsArgs
+	_args79.Request = request
+	var _result80 AttestationFailureAnalyzerServiceAggregateReportsResult
+	var meta thrift.ResponseMeta
+	meta, err = p.Client_().Call(ctx, "AggregateReports", &_args79, &_result80)
+	p.SetLastResponseMeta_(meta)
+	if err != nil {
+		return
+	}
+	switch {
+	case _result80.InvalidRequest != nil:
+		return r, _result80.InvalidRequest
+	case _result80.PermissionDenied != nil:
+		return r, _result80.PermissionDenied
+	}
+
+	return _result80.GetSuccess(), nil
+}
+
+// Parameters:
+//   - Request
+func (p *AttestationFailureAnalyzerServiceClient) NegotiateImageUpload(ctx context.Context, request *NegotiateImageUploadRequest) (r *NegotiateImageUploadResult_, err error) {
+	var _args81 AttestationFailureAnalyzerServiceNegotiateImageUploadArgs
+	_args81.Request = request
+	var _result82 AttestationFailureAnalyzerServiceNegotiateImageUploadResult
+	var meta thrift.ResponseMeta
+	meta, err = p.Client_().Call(ctx, "NegotiateImageUpload", &_args81, &_result82)
+	p.SetLastResponseMeta_(meta)
+	if err != nil {
+		return
+	}
+	switch {
+	case _result82.InvalidRequest != nil:
+		return r, _result82.InvalidRequest
+	case _result82.PermissionDenied != nil:
+		return r, _result82.PermissionDenied
+	}
+
+	return _result82.GetSuccess(), nil
+}
+
+// Parameters:
+//   - Request
+func (p *AttestationFailureAnalyzerServiceClient) InsertOriginalFirmware(ctx context.Context, request *InsertOriginalFirmwareRequest) (r *InsertOriginalFirmwareResult_, err error) {
+	var _args83 AttestationFailureAnalyzerServiceInsertOriginalFirmwareArgs
+	_args83.Request = request
+	var _result84 AttestationFailureAnalyzerServiceInsertOriginalFirmwareResult
+	var meta thrift.ResponseMeta
+	meta, err = p.Client_().Call(ctx, "InsertOriginalFirmware", &_args83, &_result84)
+	p.SetLastResponseMeta_(meta)
+	if err != nil {
+		return
+	}
+	switch {
+	case _result84.PermissionDenied != nil:
+		return r, _result84.PermissionDenied
+	case _result84.InvalidRequest != nil:
+		return r, _result84.InvalidRequest
+	}
+
+	return _result84.GetSuccess(), nil
+}
+
+// Parameters:
+//   - Request
+func (p *AttestationFailureAnalyzerServiceClient) UpdateOriginalFirmware(ctx context.Context, request *UpdateOriginalFirmwareRequest) (err error) {
+	var _args85 AttestationFailureAnalyzerServiceUpdateOriginalFirmwareArgs
+	_args85.Request = request
+	var _result86 AttestationFailureAnalyzerServiceUpdateOriginalFirmwareResult
+	var meta thrift.ResponseMeta
+	meta, err = p.Client_().Call(ctx, "UpdateOriginalFirmware", &_args85, &_result86)
+	p.SetLastResponseMeta_(meta)
+	if err != nil {
+		return
+	}
+	switch {
+	case _result86.PermissionDenied != nil:
+		return _result86.PermissionDenied
+	case _result86.InvalidRequest != nil:
+		return _result86.InvalidRequest
+	}
+
+	return nil
+}
+
+// Parameters:
+//   - Request
+func (p *AttestationFailureAnalyzerServiceClient) DeleteOriginalFirmware(ctx context.Context, request *DeleteOriginalFirmwareRequest) (err error) {
+	var _args87 AttestationFailureAnalyzerServiceDeleteOriginalFirmwareArgs
+	_args87.Request = request
+	var _result88 AttestationFailureAnalyzerServiceDeleteOriginalFirmwareResult
+	var meta thrift.ResponseMeta
+	meta, err = p.Client_().Call(ctx, "DeleteOriginalFirmware", &_args87, &_result88)
+	p.SetLastResponseMeta_(meta)
+	if err != nil {
+		return
+	}
+	switch {
+	case _result88.PermissionDenied != nil:
+		return _result88.PermissionDenied
+	case _result88.InvalidRequest != nil:
+		return _result88.InvalidRequest
+	}
+
+	return nil
+}
+
+// Parameters:
+//   - Request
+func (p *AttestationFailureAnalyzerServiceClient) ListTamperingRegistryEntries(ctx context.Context, request *ListTamperingRegistryEntriesRequest) (r *ListTamperingRegistryEntriesResult_, err error) {
+	var _args89 AttestationFailureAnalyzerServiceListTamperingRegistryEntriesArgs
+	_args89.Request = request
+	var _result90 AttestationFailureAnalyzerServiceListTamperingRegistryEntriesResult
+	var meta thrift.ResponseMeta
+	meta, err = p.Client_().Call(ctx, "ListTamperingRegistryEntries", &_args89, &_result90)
+	p.SetLastResponseMeta_(meta)
+	if err != nil {
+		return
+	}
+	switch {
+	case _result90.InvalidRequest != nil:
+		return r, _result90.InvalidRequest
+	case _result90.PermissionDenied != nil:
+		return r, _result90.PermissionDenied
+	}
+
+	return _result90.GetSuccess(), nil
+}
+
+// Parameters:
+//   - Request
+func (p *AttestationFailureAnalyzerServiceClient) InsertTamperingRegistryEntry(ctx context.Context, request *InsertTamperingRegistryEntryRequest) (r *InsertTamperingRegistryEntryResult_, err error) {
+	var _args91 AttestationFailureAnalyzerServiceInsertTamperingRegistryEntryArgs
+	_args91.Request = request
+	var _result92 AttestationFailureAnalyzerServiceInsertTamperingRegistryEntryResult
+	var meta thrift.ResponseMeta
+	meta, err = p.Client_().Call(ctx, "InsertTamperingRegistryEntry", &_args91, &_result92)
+	p.SetLastResponseMeta_(meta)
+	if err != nil {
+		return
+	}
+	switch {
+	case _result92.PermissionDenied != nil:
+		return r, _result92.PermissionDenied
+	case _result92.InvalidRequest != nil:
+		return r, _result92.InvalidRequest
+	}
+
+	return _result92.GetSuccess(), nil
+}
+
+// Parameters:
+//   - Request
+func (p *AttestationFailureAnalyzerServiceClient) UpdateTamperingRegistryEntry(ctx context.Context, request *UpdateTamperingRegistryEntryRequest) (err error) {
+	var _args93 AttestationFailureAnalyzerServiceUpdateTamperingRegistryEntryArgs
+	_args93.Request = request
+	var _result94 AttestationFailureAnalyzerServiceUpdateTamperingRegistryEntryResult
+	var meta thrift.ResponseMeta
+	meta, err = p.Client_().Call(ctx, "UpdateTamperingRegistryEntry", &_args93, &_result94)
+	p.SetLastResponseMeta_(meta)
+	if err != nil {
+		return
+	}
+	switch {
+	case _result94.PermissionDenied != nil:
+		return _result94.PermissionDenied
+	case _result94.InvalidRequest != nil:
+		return _result94.InvalidRequest
+	}
+
+	return nil
+}
+
+// Parameters:
+//   - Request
+func (p *AttestationFailureAnalyzerServiceClient) DeleteTamperingRegistryEntry(ctx context.Context, request *DeleteTamperingRegistryEntryRequest) (err error) {
+	var _args95 AttestationFailureAnalyzerServiceDeleteTamperingRegistryEntryArgs
+	_args95.Request = request
+	var _result96 AttestationFailureAnalyzerServiceDeleteTamperingRegistryEntryResult
+	var meta thrift.ResponseMeta
+	meta, err = p.Client_().Call(ctx, "DeleteTamperingRegistryEntry", &_args95, &_result96)
+	p.SetLastResponseMeta_(meta)
+	if err != nil {
+		return
+	}
+	switch {
+	case _result96.PermissionDenied != nil:
+		return _result96.PermissionDenied
+	case _result96.InvalidRequest != nil:
+		return _result96.InvalidRequest
+	}
+
+	return nil
+}
+
+type AttestationFailureAnalyzerServiceProcessor struct {
+	processorMap map[string]thrift.TProcessorFunction
+	handler      AttestationFailureAnalyzerService
+}
+
+func (p *AttestationFailureAnalyzerServiceProcessor) AddToProcessorMap(key string, processor thrift.TProcessorFunction) {
+	p.processorMap[key] = processor
+}
+
+func (p *AttestationFailureAnalyzerServiceProcessor) GetProcessorFunction(key string) (processor thrift.TProcessorFunction, ok bool) {
+	processor, ok = p.processorMap[key]
+	return processor, ok
+}
+
+func (p *AttestationFailureAnalyzerServiceProcessor) ProcessorMap() map[string]thrift.TProcessorFunction {
+	return p.processorMap
+}
+
+func NewAttestationFailureAnalyzerServiceProcessor(handler AttestationFailureAnalyzerService) *AttestationFailureAnalyzerServiceProcessor {
+
+	self97 := &AttestationFailureAnalyzerServiceProcessor{handler: handler, processorMap: make(map[string]thrift.TProcessorFunction)}
+	self97.processorMap["GetServerInfo"] = &attestationFailureAnalyzerServiceProcessorGetServerInfo{handler: handler}
+	self97.processorMap["SearchFirmware"] = &attestationFailureAnalyzerServiceProcessorSearchFirmware{handler: handler}
+	self97.processorMap["SearchReport"] = &attestationFailureAnalyzerServiceProcessorSearchReport{handler: handler}
+	self97.processorMap["Analyze"] = &attestationFailureAnalyzerServiceProcessorAnalyze{handler: handler}
+	self97.processorMap["CheckFirmwareVersion"] = &attestationFailureAnalyzerServiceProcessorCheckFirmwareVersion{handler: handler}
+	self97.processorMap["SearchByPCR"] = &attestationFailureAnalyzerServiceProcessorSearchByPCR{handler: handler}
+	self97.processorMap["CompareReports"] = &attestationFailureAnalyzerServiceProcessorCompareReports{handler: handler}
+	self97.processorMap["AggregateReports"] = &attestationFailureAnalyzerServiceProcessorAggregateReports{handler: handler}
+	self97.processorMap["NegotiateImageUpload"] = &attestationFailureAnalyzerServiceProcessorNegotiateImageUpload{handler: handler}
+	self97.processorMap["InsertOriginalFirmware"] = &attestationFailureAnalyzerServiceProcessorInsertOriginalFirmware{handler: handler}
+	self97.processorMap["UpdateOriginalFirmware"] = &attestationFailureAnalyzerServiceProcessorUpdateOriginalFirmware{handler: handler}
+	self97.processorMap["DeleteOriginalFirmware"] = &attestationFailureAnalyzerServiceProcessorDeleteOriginalFirmware{handler: handler}
+	self97.processorMap["ListTamperingRegistryEntries"] = &attestationFailureAnalyzerServiceProcessorListTamperingRegistryEntries{handler: handler}
+	self97.processorMap["InsertTamperingRegistryEntry"] = &attestationFailureAnalyzerServiceProcessorInsertTamperingRegistryEntry{handler: handler}
+	self97.processorMap["UpdateTamperingRegistryEntry"] = &attestationFailureAnalyzerServiceProcessorUpdateTamperingRegistryEntry{handler: handler}
+	self97.processorMap["DeleteTamperingRegistryEntry"] = &attestationFailureAnalyzerServiceProcessorDeleteTamperingRegistryEntry{handler: handler}
+	return self97
+}
+
+func (p *AttestationFailureAnalyzerServiceProcessor) Process(ctx context.Context, iprot, oprot thrift.TProtocol) (success bool, err thrift.TException) {
+	name, _, seqId, err2 := iprot.ReadMessageBegin(ctx)
+	if err2 != nil {
+		return false, thrift.WrapTException(err2)
+	}
+	if processor, ok := p.GetProcessorFunction(name); ok {
+		return processor.Process(ctx, seqId, iprot, oprot)
+	}
+	iprot.Skip(ctx, thrift.STRUCT)
+	iprot.ReadMessageEnd(ctx)
+	x98 := thrift.NewTApplicationException(thrift.UNKNOWN_METHOD, "Unknown function "+name)
+	oprot.WriteMessageBegin(ctx, name, thrift.EXCEPTION, seqId)
+	x98.Write(ctx, oprot)
+	oprot.WriteMessageEnd(ctx)
+	oprot.Flush(ctx)
+	return false, x98
+
+}
+
+type attestationFailureAnalyzerServiceProcessorGetServerInfo struct {
+	handler AttestationFailureAnalyzerService
+}
+
+func (p *attestationFailureAnalyzerServiceProcessorGetServerInfo) Process(ctx context.Context, seqId int32, iprot, oprot thrift.TProtocol) (success bool, err thrift.TException) {
+	args := AttestationFailureAnalyzerServiceGetServerInfoArgs{}
+	var err2 error
+	if err2 = args.Read(ctx, iprot); err2 != nil {
+		iprot.ReadMessageEnd(ctx)
+		x := thrift.NewTApplicationException(thrift.PROTOCOL_ERROR, err2.Error())
+		oprot.WriteMessageBegin(ctx, "GetServerInfo", thrift.EXCEPTION, seqId)
+		x.Write(ctx, oprot)
+		oprot.WriteMessageEnd(ctx)
+		oprot.Flush(ctx)
+		return false, thrift.WrapTException(err2)
+	}
+	iprot.ReadMessageEnd(ctx)
+
+	tickerCancel := func() {}
+	// Start a goroutine to do server side connectivity check.
+	if thrift.ServerConnectivityCheckInterval > 0 {
+		var cancel context.CancelFunc
+		ctx, cancel = context.WithCancel(ctx)
+		defer cancel()
+		var tickerCtx context.Context
+		tickerCtx, tickerCancel = context.WithCancel(context.Background())
+		defer tickerCancel()
+		go func(ctx context.Context, cancel context.CancelFunc) {
+			ticker := time.NewTicker(thrift.ServerConnectivityCheckInterval)
+			defer ticker.Stop()
+			for {
+				select {
+				case <-ctx.Done():
+					return
+				case <-ticker.C:
+					if !iprot.Transport().IsOpen() {
+						cancel()
+						return
+					}
+				}
+			}
+		}(tickerCtx, cancel)
+	}
+
+	result := AttestationFailureAnalyzerServiceGetServerInfoResult{}
+	var retval *ServerInfo
+	if retval, err2 = p.handler.GetServerInfo(ctx, args.Request); err2 != nil {
+		tickerCancel()
+		switch v := err2.(type) {
+		case *PermissionDenied:
+			result.PermissionDenied = v
+		default:
+			if err2 == thrift.ErrAbandonRequest {
+				return false, thrift.WrapTException(err2)
+			}
+			x := thrift.NewTApplicationException(thrift.INTERNAL_ERROR, "Internal error processing GetServerInfo: "+err2.Error())
+			oprot.WriteMessageBegin(ctx, "GetServerInfo", thrift.EXCEPTION, seqId)
+			x.Write(ctx, oprot)
+			oprot.WriteMessageEnd(ctx)
+			oprot.Flush(ctx)
+			return true, thrift.WrapTException(err2)
+		}
+	} else {
+		result.Success = retval
+	}
+	tickerCancel()
+	if err2 = oprot.WriteMessageBegin(ctx, "GetServerInfo", thrift.REPLY, seqId); err2 != nil {
+		err = thrift.WrapTException(err2)
+	}
+	if err2 = result.Write(ctx, oprot); err == nil && err2 != nil {
+		err = thrift.WrapTException(err2)
+	}
+	if err2 = oprot.WriteMessageEnd(ctx); err == nil && err2 != nil {
+		err = thrift.WrapTException(err2)
+	}
+	if err2 = oprot.Flush(ctx); err == nil && err2 != nil {
+		err = thrift.WrapTException(err2)
+	}
+	if err != nil {
+		return
+	}
+	return true, err
+}
+
+type attestationFailureAnalyzerServiceProcessorSearchFirmware struct {
+	handler AttestationFailureAnalyzerService
+}
+
+func (p *attestationFailureAnalyzerServiceProcessorSearchFirmware) Process(ctx context.Context, seqId int32, iprot, oprot thrift.TProtocol) (success bool, err thrift.TException) {
+	args := AttestationFailureAnalyzerServiceSearchFirmwareArgs{}
+	var err2 error
+	if err2 = args.Read(ctx, iprot); err2 != nil {
+		iprot.ReadMessageEnd(ctx)
+		x := thrift.NewTApplicationException(thrift.PROTOCOL_ERROR, err2.Error())
+		oprot.WriteMessageBegin(ctx, "SearchFirmware", thrift.EXCEPTION, seqId)
+		x.Write(ctx, oprot)
+		oprot.WriteMessageEnd(ctx)
+		oprot.Flush(ctx)
+		return false, thrift.WrapTException(err2)
+	}
+	iprot.ReadMessageEnd(ctx)
+
+	tickerCancel := func() {}
+	// Start a goroutine to do server side connectivity check.
+	if thrift.ServerConnectivityCheckInterval > 0 {
+		var cancel context.CancelFunc
+		ctx, cancel = context.WithCancel(ctx)
+		defer cancel()
+		var tickerCtx context.Context
+		tickerCtx, tickerCancel = context.WithCancel(context.Background())
+		defer tickerCancel()
+		go func(ctx context.Context, cancel context.CancelFunc) {
+			ticker := time.NewTicker(thrift.ServerConnectivityCheckInterval)
+			defer ticker.Stop()
+			for {
+				select {
+				case <-ctx.Done():
+					return
+				case <-ticker.C:
+					if !iprot.Transport().IsOpen() {
+						cancel()
+						return
+					}
+				}
+			}
+		}(tickerCtx, cancel)
+	}
+
+	result := AttestationFailureAnalyzerServiceSearchFirmwareResult{}
+	var retval *SearchFirmwareResult_
+	if retval, err2 = p.handler.SearchFirmware(ctx, args.Request); err2 != nil {
+		tickerCancel()
+		switch v := err2.(type) {
+		case *PermissionDenied:
+			result.PermissionDenied = v
+		default:
+			if err2 == thrift.ErrAbandonRequest {
+				return false, thrift.WrapTException(err2)
+			}
+			x := thrift.NewTApplicationException(thrift.INTERNAL_ERROR, "Internal error processing SearchFirmware: "+err2.Error())
+			oprot.WriteMessageBegin(ctx, "SearchFirmware", thrift.EXCEPTION, seqId)
+			x.Write(ctx, oprot)
+			oprot.WriteMessageEnd(ctx)
+			oprot.Flush(ctx)
+			return true, thrift.WrapTException(err2)
+		}
+	} else {
+		result.Success = retval
+	}
+	tickerCancel()
+	if err2 = oprot.WriteMessageBegin(ctx, "SearchFirmware", thrift.REPLY, seqId); err2 != nil {
+		err = thrift.WrapTException(err2)
+	}
+	if err2 = result.Write(ctx, oprot); err == nil && err2 != nil {
+		err = thrift.WrapTException(err2)
+	}
+	if err2 = oprot.WriteMessageEnd(ctx); err == nil && err2 != nil {
+		err = thrift.WrapTException(err2)
+	}
+	if err2 = oprot.Flush(ctx); err == nil && err2 != nil {
+		err = thrift.WrapTException(err2)
+	}
+	if err != nil {
+		return
+	}
+	return true, err
+}
+
+type attestationFailureAnalyzerServiceProcessorSearchReport struct {
+	handler AttestationFailureAnalyzerService
+}
+
+func (p *attestationFailureAnalyzerServiceProcessorSearchReport) Process(ctx context.Context, seqId int32, iprot, oprot thrift.TProtocol) (success bool, err thrift.TException) {
+	args := AttestationFailureAnalyzerServiceSearchReportArgs{}
+	var err2 error
+	if err2 = args.Read(ctx, iprot); err2 != nil {
+		iprot.ReadMessageEnd(ctx)
+		x := thrift.NewTApplicationException(thrift.PROTOCOL_ERROR, err2.Error())
+		oprot.WriteMessageBegin(ctx, "SearchReport", thrift.EXCEPTION, seqId)
+		x.Write(ctx, oprot)
+		oprot.WriteMessageEnd(ctx)
+		oprot.Flush(ctx)
+		return false, thrift.WrapTException(err2)
+	}
+	iprot.ReadMessageEnd(ctx)
+
+	tickerCancel := func() {}
+	// Start a goroutine to do server side connectivity check.
+	if thrift.ServerConnectivityCheckInterval > 0 {
+		var cancel context.CancelFunc
+		ctx, cancel = context.WithCancel(ctx)
+		defer cancel()
+		var tickerCtx context.Context
+		tickerCtx, tickerCancel = context.WithCancel(context.Background())
+		defer tickerCancel()
+		go func(ctx context.Context, cancel context.CancelFunc) {
+			ticker := time.NewTicker(thrift.ServerConnectivityCheckInterval)
+			defer ticker.Stop()
+			for {
+				select {
+				case <-ctx.Done():
+					return
+				case <-ticker.C:
+					if !iprot.Transport().IsOpen() {
+						cancel()
+						return
+					}
+				}
+			}
+		}(tickerCtx, cancel)
+	}
+
+	result := AttestationFailureAnalyzerServiceSearchReportResult{}
+	var retval *SearchReportResult_
+	if retval, err2 = p.handler.SearchReport(ctx, args.Request); err2 != nil {
+		tickerCancel()
+		switch v := err2.(type) {
+		case *PermissionDenied:
+			result.PermissionDenied = v
+		default:
+			if err2 == thrift.ErrAbandonRequest {
+				return false, thrift.WrapTException(err2)
+			}
+			x := thrift.NewTApplicationException(thrift.INTERNAL_ERROR, "Internal error processing SearchReport: "+err2.Error())
+			oprot.WriteMessageBegin(ctx, "SearchReport", thrift.EXCEPTION, seqId)
+			x.Write(ctx, oprot)
+			oprot.WriteMessageEnd(ctx)
+			oprot.Flush(ctx)
+			return true, thrift.WrapTException(err2)
+		}
+	} else {
+		result.Success = retval
+	}
+	tickerCancel()
+	if err2 = oprot.WriteMessageBegin(ctx, "SearchReport", thrift.REPLY, seqId); err2 != nil {
+		err = thrift.WrapTException(err2)
+	}
+	if err2 = result.Write(ctx, oprot); err == nil && err2 != nil {
+		err = thrift.WrapTException(err2)
+	}
+	if err2 = oprot.WriteMessageEnd(ctx); err == nil && err2 != nil {
+		err = thrift.WrapTException(err2)
+	}
+	if err2 = oprot.Flush(ctx); err == nil && err2 != nil {
+		err = thrift.WrapTException(err2)
+	}
+	if err != nil {
+		return
+	}
+	return true, err
+}
+
+type attestationFailureAnalyzerServiceProcessorAnalyze struct {
+	handler AttestationFailureAnalyzerService
+}
+
+func (p *attestationFailureAnalyzerServiceProcessorAnalyze) Process(ctx context.Context, seqId int32, iprot, oprot thrift.TProtocol) (success bool, err thrift.TException) {
+	args := AttestationFailureAnalyzerServiceAnalyzeArgs{}
+	var err2 error
+	if err2 = args.Read(ctx, iprot); err2 != nil {
+		iprot.ReadMessageEnd(ctx)
+		x := thrift.NewTApplicationException(thrift.PROTOCOL_ERROR, err2.Error())
+		oprot.WriteMessageBegin(ctx, "Analyze", thrift.EXCEPTION, seqId)
+		x.Write(ctx, oprot)
+		oprot.WriteMessageEnd(ctx)
+		oprot.Flush(ctx)
+		return false, thrift.WrapTException(err2)
+	}
+	iprot.ReadMessageEnd(ctx)
+
+	tickerCancel := func() {}
+	// Start a goroutine to do server side connectivity check.
+	if thrift.ServerConnectivityCheckInterval > 0 {
+		var cancel context.CancelFunc
+		ctx, cancel = context.WithCancel(ctx)
+		defer cancel()
+		var tickerCtx context.Context
+		tickerCtx, tickerCancel = context.WithCancel(context.Background())
+		defer tickerCancel()
+		go func(ctx context.Context, cancel context.CancelFunc) {
+			ticker := time.NewTicker(thrift.ServerConnectivityCheckInterval)
+			defer ticker.Stop()
+			for {
+				select {
+				case <-ctx.Done():
+					return
+				case <-ticker.C:
+					if !iprot.Transport().IsOpen() {
+						cancel()
+						return
+					}
+				}
+			}
+		}(tickerCtx, cancel)
+	}
+
+	result := AttestationFailureAnalyzerServiceAnalyzeResult{}
+	var retval *AnalyzeResult_
+	if retval, err2 = p.handler.Analyze(ctx, args.Request); err2 != nil {
+		tickerCancel()
+		switch v := err2.(type) {
+		case *UnableToGetOriginalFirmware:
+			result.UnableToGetOriginalFirmware = v
+		case *IncorrectHostConfiguration:
+			result.IncorrectHostConfiguration = v
+		case *PermissionDenied:
+			result.PermissionDenied = v
+		case *Overloaded:
+			result.Overloaded = v
+		default:
+			if err2 == thrift.ErrAbandonRequest {
+				return false, thrift.WrapTException(err2)
+			}
+			x := thrift.NewTApplicationException(thrift.INTERNAL_ERROR, "Internal error processing Analyze: "+err2.Error())
+			oprot.WriteMessageBegin(ctx, "Analyze", thrift.EXCEPTION, seqId)
+			x.Write(ctx, oprot)
+			oprot.WriteMessageEnd(ctx)
+			oprot.Flush(ctx)
+			return true, thrift.WrapTException(err2)
+		}
+	} else {
+		result.Success = retval
+	}
+	tickerCancel()
+	if err2 = oprot.WriteMessageBegin(ctx, "Analyze", thrift.REPLY, seqId); err2 != nil {
+		err = thrift.WrapTException(err2)
+	}
+	if err2 = result.Write(ctx, oprot); err == nil && err2 != nil {
+		err = thrift.WrapTException(err2)
+	}
+	if err2 = oprot.WriteMessageEnd(ctx); err == nil && err2 != nil {
+		err = thrift.WrapTException(err2)
+	}
+	if err2 = oprot.Flush(ctx); err == nil && err2 != nil {
+		err = thrift.WrapTException(err2)
+	}
+	if err != nil {
+		return
+	}
+	return true, err
+}
+
+type attestationFailureAnalyzerServiceProcessorCheckFirmwareVersion struct {
+	handler AttestationFailureAnalyzerService
+}
+
+func (p *attestationFailureAnalyzerServiceProcessorCheckFirmwareVersion) Process(ctx context.Context, seqId int32, iprot, oprot thrift.TProtocol) (success bool, err thrift.TException) {
+	args := AttestationFailureAnalyzerServiceCheckFirmwareVersionArgs{}
+	var err2 error
+	if err2 = args.Read(ctx, iprot); err2 != nil {
+		iprot.ReadMessageEnd(ctx)
+		x := thrift.NewTApplicationException(thrift.PROTOCOL_ERROR, err2.Error())
+		oprot.WriteMessageBegin(ctx, "CheckFirmwareVersion", thrift.EXCEPTION, seqId)
+		x.Write(ctx, oprot)
+		oprot.WriteMessageEnd(ctx)
+		oprot.Flush(ctx)
+		return false, thrift.WrapTException(err2)
+	}
+	iprot.ReadMessageEnd(ctx)
+
+	tickerCancel := func() {}
+	// Start a goroutine to do server side connectivity check.
+	if thrift.ServerConnectivityCheckInterval > 0 {
+		var cancel context.CancelFunc
+		ctx, cancel = context.WithCancel(ctx)
+		defer cancel()
+		var tickerCtx context.Context
+		tickerCtx, tickerCancel = context.WithCancel(context.Background())
+		defer tickerCancel()
+		go func(ctx context.Context, cancel context.CancelFunc) {
+			ticker := time.NewTicker(thrift.ServerConnectivityCheckInterval)
+			defer ticker.Stop()
+			for {
+				select {
+				case <-ctx.Done():
+					return
+				case <-ticker.C:
+					if !iprot.Transport().IsOpen() {
+						cancel()
+						return
+					}
+				}
+			}
+		}(tickerCtx, cancel)
+	}
+
+	result := AttestationFailureAnalyzerServiceCheckFirmwareVersionResult{}
+	var retval *CheckFirmwareVersionResult_
+	if retval, err2 = p.handler.CheckFirmwareVersion(ctx, args.Request); err2 != nil {
+		tickerCancel()
+		switch v := err2.(type) {
+		case *PermissionDenied:
+			result.PermissionDenied = v
+		default:
+			if err2 == thrift.ErrAbandonRequest {
+				return false, thrift.WrapTException(err2)
+			}
+			x := thrift.NewTApplicationException(thrift.INTERNAL_ERROR, "Internal error processing CheckFirmwareVersion: "+err2.Error())
+			oprot.WriteMessageBegin(ctx, "CheckFirmwareVersion", thrift.EXCEPTION, seqId)
+			x.Write(ctx, oprot)
+			oprot.WriteMessageEnd(ctx)
+			oprot.Flush(ctx)
+			return true, thrift.WrapTException(err2)
+		}
+	} else {
+		result.Success = retval
+	}
+	tickerCancel()
+	if err2 = oprot.WriteMessageBegin(ctx, "CheckFirmwareVersion", thrift.REPLY, seqId); err2 != nil {
+		err = thrift.WrapTException(err2)
+	}
+	if err2 = result.Write(ctx, oprot); err == nil && err2 != nil {
+		err = thrift.WrapTException(err2)
+	}
+	if err2 = oprot.WriteMessageEnd(ctx); err == nil && err2 != nil {
+		err = thrift.WrapTException(err2)
+	}
+	if err2 = oprot.Flush(ctx); err == nil && err2 != nil {
+		err = thrift.WrapTException(err2)
+	}
+	if err != nil {
+		return
+	}
+	return true, err
+}
+
+type attestationFailureAnalyzerServiceProcessorSearchByPCR struct {
+	handler AttestationFailureAnalyzerService
+}
+
+func (p *attestationFailureAnalyzerServiceProcessorSearchByPCR) Process(ctx context.Context, seqId int32, iprot, oprot thrift.TProtocol) (success bool, err thrift.TException) {
+	args := AttestationFailureAnalyzerServiceSearchByPCRArgs{}
+	var err2 error
+	if err2 = args.Read(ctx, iprot); err2 != nil {
+		iprot.ReadMessageEnd(ctx)
+		x := thrift.NewTApplicationException(thrift.PROTOCOL_ERROR, err2.Error())
+		oprot.WriteMessageBegin(ctx, "SearchByPCR", thrift.EXCEPTION, seqId)
+		x.Write(ctx, oprot)
+		oprot.WriteMessageEnd(ctx)
+		oprot.Flush(ctx)
+		return false, thrift.WrapTException(err2)
+	}
+	iprot.ReadMessageEnd(ctx)
+
+	tickerCancel := func() {}
+	// Start a goroutine to do server side connectivity check.
+	if thrift.ServerConnectivityCheckInterval > 0 {
+		var cancel context.CancelFunc
+		ctx, cancel = context.WithCancel(ctx)
+		defer cancel()
+		var tickerCtx context.Context
+		tickerCtx, tickerCancel = context.WithCancel(context.Background())
+		defer tickerCancel()
+		go func(ctx context.Context, cancel context.CancelFunc) {
+			ticker := time.NewTicker(thrift.ServerConnectivityCheckInterval)
+			defer ticker.Stop()
+			for {
+				select {
+				case <-ctx.Done():
+					return
+				case <-ticker.C:
+					if !iprot.Transport().IsOpen() {
+						cancel()
+						return
+					}
+				}
+			}
+		}(tickerCtx, cancel)
+	}
+
+	result := AttestationFailureAnalyzerServiceSearchByPCRResult{}
+	var retval *SearchByPCRResult_
+	if retval, err2 = p.handler.SearchByPCR(ctx, args.Request); err2 != nil {
+		tickerCancel()
+		switch v := err2.(type) {
+		case *InvalidRequest:
+			result.InvalidRequest = v
+		case *PermissionDenied:
+			result.PermissionDenied = v
+		default:
+			if err2 == thrift.ErrAbandonRequest {
+				return false, thrift.WrapTException(err2)
+			}
+			x := thrift.NewTApplicationException(thrift.INTERNAL_ERROR, "Internal error processing SearchByPCR: "+err2.Error())
+			oprot.WriteMessageBegin(ctx, "SearchByPCR", thrift.EXCEPTION, seqId)
+			x.Write(ctx, oprot)
+			oprot.WriteMessageEnd(ctx)
+			oprot.Flush(ctx)
+			return true, thrift.WrapTException(err2)
+		}
+	} else {
+		result.Success = retval
+	}
+	tickerCancel()
+	if err2 = oprot.WriteMessageBegin(ctx, "SearchByPCR", thrift.REPLY, seqId); err2 != nil {
+		err = thrift.WrapTException(err2)
+	}
+	if err2 = result.Write(ctx, oprot); err == nil && err2 != nil {
+		err = thrift.WrapTException(err2)
+	}
+	if err2 = oprot.WriteMessageEnd(ctx); err == nil && err2 != nil {
+		err = thrift.WrapTException(err2)
+	}
+	if err2 = oprot.Flush(ctx); err == nil && err2 != nil {
+		err = thrift.WrapTException(err2)
+	}
+	if err != nil {
+		return
+	}
+	return true, err
+}
+
+type attestationFailureAnalyzerServiceProcessorCompareReports struct {
+	handler AttestationFailureAnalyzerService
+}
+
+func (p *attestationFailureAnalyzerServiceProcessorCompareReports) Process(ctx context.Context, seqId int32, iprot, oprot thrift.TProtocol) (success bool, err thrift.TException) {
+	args := AttestationFailureAnalyzerServiceCompareReportsArgs{}
+	var err2 error
+	if err2 = args.Read(ctx, iprot); err2 != nil {
+		iprot.ReadMessageEnd(ctx)
+		x := thrift.NewTApplicationException(thrift.PROTOCOL_ERROR, err2.Error())
+		oprot.WriteMessageBegin(ctx, "CompareReports", thrift.EXCEPTION, seqId)
+		x.Write(ctx, oprot)
+		oprot.WriteMessageEnd(ctx)
+		oprot.Flush(ctx)
+		return false, thrift.WrapTException(err2)
+	}
+	iprot.ReadMessageEnd(ctx)
+
+	tickerCancel := func() {}
+	// Start a goroutine to do server side connectivity check.
+	if thrift.ServerConnectivityCheckInterval > 0 {
+		var cancel context.CancelFunc
+		ctx, cancel = context.WithCancel(ctx)
+		defer cancel()
+		var tickerCtx context.Context
+		tickerCtx, tickerCancel = context.WithCancel(context.Background())
+		defer tickerCancel()
+		go func(ctx context.Context, cancel context.CancelFunc) {
+			ticker := time.NewTicker(thrift.ServerConnectivityCheckInterval)
+			defer ticker.Stop()
+			for {
+				select {
+				case <-ctx.Done():
+					return
+				case <-ticker.C:
+					if !iprot.Transport().IsOpen() {
+						cancel()
+						return
+					}
+				}
+			}
+		}(tickerCtx, cancel)
+	}
+
+	result := AttestationFailureAnalyzerServiceCompareReportsResult{}
+	var retval *CompareReportsResult_
+	if retval, err2 = p.handler.CompareReports(ctx, args.Request); err2 != nil {
+		tickerCancel()
+		switch v := err2.(type) {
+		case *InvalidRequest:
+			result.InvalidRequest = v
+		case *PermissionDenied:
+			result.PermissionDenied = v
+		default:
+			if err2 == thrift.ErrAbandonRequest {
+				return false, thrift.WrapTException(err2)
+			}
+			x := thrift.NewTApplicationException(thrift.INTERNAL_ERROR, "Internal error processing CompareReports: "+err2.Error())
+			oprot.WriteMessageBegin(ctx, "CompareReports", thrift.EXCEPTION, seqId)
+			x.Write(ctx, oprot)
+			oprot.WriteMessageEnd(ctx)
+			oprot.Flush(ctx)
+			return true, thrift.WrapTException(err2)
+		}
+	} else {
+		result.Success = retval
+	}
+	tickerCancel()
+	if err2 = oprot.WriteMessageBegin(ctx, "CompareReports", thrift.REPLY, seqId); err2 != nil {
+		err = thrift.WrapTException(err2)
+	}
+	if err2 = result.Write(ctx, oprot); err == nil && err2 != nil {
+		err = thrift.WrapTException(err2)
+	}
+	if err2 = oprot.WriteMessageEnd(ctx); err == nil && err2 != nil {
+		err = thrift.WrapTException(err2)
+	}
+	if err2 = oprot.Flush(ctx); err == nil && err2 != nil {
+		err = thrift.WrapTException(err2)
+	}
+	if err != nil {
+		return
+	}
+	return true, err
+}
+
+type attestationFailureAnalyzerServiceProcessorAggregateReports struct {
+	handler AttestationFailureAnalyzerService
+}
+
+func (p *attestationFailureAnalyzerServiceProcessorAggregateReports) Process(ctx context.Context, seqId int32, iprot, oprot thrift.TProtocol) (success bool, err thrift.TException) {
+	args := AttestationFailureAnalyzerServiceAggregateReportsArgs{}
+	var err2 error
+	if err2 = args.Read(ctx, iprot); err2 != nil {
+		iprot.ReadMessageEnd(ctx)
+		x := thrift.NewTApplicationException(thrift.PROTOCOL_ERROR, err2.Error())
+		oprot.WriteMessageBegin(ctx, "AggregateReports", thrift.EXCEPTION, seqId)
+		x.Write(ctx, oprot)
+		oprot.WriteMessageEnd(ctx)
+		oprot.Flush(ctx)
+		return false, thrift.WrapTException(err2)
+	}
+	iprot.ReadMessageEnd(ctx)
+
+	tickerCancel := func() {}
+	// Start a goroutine to do server side connectivity check.
+	if thrift.ServerConnectivityCheckInterval > 0 {
+		var cancel context.CancelFunc
+		ctx, cancel = context.WithCancel(ctx)
+		defer cancel()
+		var tickerCtx context.Context
+		tickerCtx, tickerCancel = context.WithCancel(context.Background())
+		defer tickerCancel()
+		go func(ctx context.Context, cancel context.CancelFunc) {
+			ticker := time.NewTicker(thrift.ServerConnectivityCheckInterval)
+			defer ticker.Stop()
+			for {
+				select {
+				case <-ctx.Done():
+					return
+				case <-ticker.C:
+					if !iprot.Transport().IsOpen() {
+						cancel()
+						return
+					}
+				}
+			}
+		}(tickerCtx, cancel)
+	}
+
+	result := AttestationFailureAnalyzerServiceAggregateReportsResult{}
+	var retval *AggregateReportsResult_
+	if retval, err2 = p.handler.AggregateReports(ctx, args.Request); err2 != nil {
+		tickerCancel()
+		switch v := err2.(type) {
+		case *InvalidRequest:
+			result.InvalidRequest = v
+		case *PermissionDenied:
+			result.PermissionDenied = v
+		default:
+			if err2 == thrift.ErrAbandonRequest {
+				return false, thrift.WrapTException(err2)
+			}
+			x := thrift.NewTApplicationException(thrift.INTERNAL_ERROR, "Internal error processing AggregateReports: "+err2.Error())
+			oprot.WriteMessageBegin(ctx, "AggregateReports", thrift.EXCEPTION, seqId)
+			x.Write(ctx, oprot)
+			oprot.WriteMessageEnd(ctx)
+			oprot.Flush(ctx)
+			return true, thrift.WrapTException(err2)
+		}
+	} else {
+		result.Success = retval
+	}
+	tickerCancel()
+	if err2 = oprot.WriteMessageBegin(ctx, "AggregateReports", thrift.REPLY, seqId); err2 != nil {
+		err = thrift.WrapTException(err2)
+	}
+	if err2 = result.Write(ctx, oprot); err == nil && err2 != nil {
+		err = thrift.WrapTException(err2)
+	}
+	if err2 = oprot.WriteMessageEnd(ctx); err == nil && err2 != nil {
+		err = thrift.WrapTException(err2)
+	}
+	if err2 = oprot.Flush(ctx); err == nil && err2 != nil {
+		err = thrift.WrapTException(err2)
+	}
+	if err != nil {
+		return
+	}
+	return true, err
+}
+
+type attestationFailureAnalyzerServiceProcessorNegotiateImageUpload struct {
+	handler AttestationFailureAnalyzerService
+}
+
+func (p *attestationFailureAnalyzerServiceProcessorNegotiateImageUpload) Process(ctx context.Context, seqId int32, iprot, oprot thrift.TProtocol) (success bool, err thrift.TException) {
+	args := AttestationFailureAnalyzerServiceNegotiateImageUploadArgs{}
+	var err2 error
+	if err2 = args.Read(ctx, iprot); err2 != nil {
+		iprot.ReadMessageEnd(ctx)
+		x := thrift.NewTApplicationException(thrift.PROTOCOL_ERROR, err2.Error())
+		oprot.WriteMessageBegin(ctx, "NegotiateImageUpload", thrift.EXCEPTION, seqId)
+		x.Write(ctx, oprot)
+		oprot.WriteMessageEnd(ctx)
+		oprot.Flush(ctx)
+		return false, thrift.WrapTException(err2)
+	}
+	iprot.ReadMessageEnd(ctx)
+
+	tickerCancel := func() {}
+	// Start a goroutine to do server side connectivity check.
+	if thrift.ServerConnectivityCheckInterval > 0 {
+		var cancel context.CancelFunc
+		ctx, cancel = context.WithCancel(ctx)
+		defer cancel()
+		var tickerCtx context.Context
+		tickerCtx, tickerCancel = context.WithCancel(context.Background())
+		defer tickerCancel()
+		go func(ctx context.Context, cancel context.CancelFunc) {
+			ticker := time.NewTicker(thrift.ServerConnectivityCheckInterval)
+			defer ticker.Stop()
+			for {
+				select {
+				case <-ctx.Done():
+					return
+				case <-ticker.C:
+					if !iprot.Transport().IsOpen() {
+						cancel()
+						return
+					}
+				}
+			}
+		}(tickerCtx, cancel)
+	}
+
+	result := AttestationFailureAnalyzerServiceNegotiateImageUploadResult{}
+	var retval *NegotiateImageUploadResult_
+	if retval, err2 = p.handler.NegotiateImageUpload(ctx, args.Request); err2 != nil {
+		tickerCancel()
+		switch v := err2.(type) {
+		case *InvalidRequest:
+			result.InvalidRequest = v
+		case *PermissionDenied:
+			result.PermissionDenied = v
+		default:
+			if err2 == thrift.ErrAbandonRequest {
+				return false, thrift.WrapTException(err2)
+			}
+			x := thrift.NewTApplicationException(thrift.INTERNAL_ERROR, "Internal error processing NegotiateImageUpload: "+err2.Error())
+			oprot.WriteMessageBegin(ctx, "NegotiateImageUpload", thrift.EXCEPTION, seqId)
+			x.Write(ctx, oprot)
+			oprot.WriteMessageEnd(ctx)
+			oprot.Flush(ctx)
+			return true, thrift.WrapTException(err2)
+		}
+	} else {
+		result.Success = retval
+	}
+	tickerCancel()
+	if err2 = oprot.WriteMessageBegin(ctx, "NegotiateImageUpload", thrift.REPLY, seqId); err2 != nil {
+		err = thrift.WrapTException(err2)
+	}
+	if err2 = result.Write(ctx, oprot); err == nil && err2 != nil {
+		err = thrift.WrapTException(err2)
+	}
+	if err2 = oprot.WriteMessageEnd(ctx); err == nil && err2 != nil {
+		err = thrift.WrapTException(err2)
+	}
+	if err2 = oprot.Flush(ctx); err == nil && err2 != nil {
+		err = thrift.WrapTException(err2)
+	}
+	if err != nil {
+		return
+	}
+	return true, err
+}
+
+type attestationFailureAnalyzerServiceProcessorInsertOriginalFirmware struct {
+	handler AttestationFailureAnalyzerService
+}
+
+func (p *attestationFailureAnalyzerServiceProcessorInsertOriginalFirmware) Process(ctx context.Context, seqId int32, iprot, oprot thrift.TProtocol) (success bool, err thrift.TException) {
+	args := AttestationFailureAnalyzerServiceInsertOriginalFirmwareArgs{}
+	var err2 error
+	if err2 = args.Read(ctx, iprot); err2 != nil {
+		iprot.ReadMessageEnd(ctx)
+		x := thrift.NewTApplicationException(thrift.PROTOCOL_ERROR, err2.Error())
+		oprot.WriteMessageBegin(ctx, "InsertOriginalFirmware", thrift.EXCEPTION, seqId)
+		x.Write(ctx, oprot)
+		oprot.WriteMessageEnd(ctx)
+		oprot.Flush(ctx)
+		return false, thrift.WrapTException(err2)
+	}
+	iprot.ReadMessageEnd(ctx)
+
+	tickerCancel := func() {}
+	// Start a goroutine to do server side connectivity check.
+	if thrift.ServerConnectivityCheckInterval > 0 {
+		var cancel context.CancelFunc
+		ctx, cancel = context.WithCancel(ctx)
+		defer cancel()
+		var tickerCtx context.Context
+		tickerCtx, tickerCancel = context.WithCancel(context.Background())
+		defer tickerCancel()
+		go func(ctx context.Context, cancel context.CancelFunc) {
+			ticker := time.NewTicker(thrift.ServerConnectivityCheckInterval)
+			defer ticker.Stop()
+			for {
+				select {
+				case <-ctx.Done():
+					return
+				case <-ticker.C:
+					if !iprot.Transport().IsOpen() {
+						cancel()
+						return
+					}
+				}
+			}
+		}(tickerCtx, cancel)
+	}
+
+	result := AttestationFailureAnalyzerServiceInsertOriginalFirmwareResult{}
+	var retval *InsertOriginalFirmwareResult_
+	if retval, err2 = p.handler.InsertOriginalFirmware(ctx, args.Request); err2 != nil {
+		tickerCancel()
+		switch v := err2.(type) {
+		case *PermissionDenied:
+			result.PermissionDenied = v
+		case *InvalidRequest:
+			result.InvalidRequest = v
+		default:
+			if err2 == thrift.ErrAbandonRequest {
+				return false, thrift.WrapTException(err2)
+			}
+			x := thrift.NewTApplicationException(thrift.INTERNAL_ERROR, "Internal error processing InsertOriginalFirmware: "+err2.Error())
+			oprot.WriteMessageBegin(ctx, "InsertOriginalFirmware", thrift.EXCEPTION, seqId)
+			x.Write(ctx, oprot)
+			oprot.WriteMessageEnd(ctx)
+			oprot.Flush(ctx)
+			return true, thrift.WrapTException(err2)
+		}
+	} else {
+		result.Success = retval
+	}
+	tickerCancel()
+	if err2 = oprot.WriteMessageBegin(ctx, "InsertOriginalFirmware", thrift.REPLY, seqId); err2 != nil {
+		err = thrift.WrapTException(err2)
+	}
+	if err2 = result.Write(ctx, oprot); err == nil && err2 != nil {
+		err = thrift.WrapTException(err2)
+	}
+	if err2 = oprot.WriteMessageEnd(ctx); err == nil && err2 != nil {
+		err = thrift.WrapTException(err2)
+	}
+	if err2 = oprot.Flush(ctx); err == nil && err2 != nil {
+		err = thrift.WrapTException(err2)
+	}
+	if err != nil {
+		return
+	}
+	return true, err
+}
+
+type attestationFailureAnalyzerServiceProcessorUpdateOriginalFirmware struct {
+	handler AttestationFailureAnalyzerService
+}
+
+func (p *attestationFailureAnalyzerServiceProcessorUpdateOriginalFirmware) Process(ctx context.Context, seqId int32, iprot, oprot thrift.TProtocol) (success bool, err thrift.TException) {
+	args := AttestationFailureAnalyzerServiceUpdateOriginalFirmwareArgs{}
+	var err2 error
+	if err2 = args.Read(ctx, iprot); err2 != nil {
+		iprot.ReadMessageEnd(ctx)
+		x := thrift.NewTApplicationException(thrift.PROTOCOL_ERROR, err2.Error())
+		oprot.WriteMessageBegin(ctx, "UpdateOriginalFirmware", thrift.EXCEPTION, seqId)
+		x.Write(ctx, oprot)
+		oprot.WriteMessageEnd(ctx)
+		oprot.Flush(ctx)
+		return false, thrift.WrapTException(err2)
+	}
+	iprot.ReadMessageEnd(ctx)
+
+	tickerCancel := func() {}
+	// Start a goroutine to do server side connectivity check.
+	if thrift.ServerConnectivityCheckInterval > 0 {
+		var cancel context.CancelFunc
+		ctx, cancel = context.WithCancel(ctx)
+		defer cancel()
+		var tickerCtx context.Context
+		tickerCtx, tickerCancel = context.WithCancel(context.Background())
+		defer tickerCancel()
+		go func(ctx context.Context, cancel context.CancelFunc) {
+			ticker := time.NewTicker(thrift.ServerConnectivityCheckInterval)
+			defer ticker.Stop()
+			for {
+				select {
+				case <-ctx.Done():
+					return
+				case <-ticker.C:
+					if !iprot.Transport().IsOpen() {
+						cancel()
+						return
+					}
+				}
+			}
+		}(tickerCtx, cancel)
+	}
+
+	result := AttestationFailureAnalyzerServiceUpdateOriginalFirmwareResult{}
+	if err2 = p.handler.UpdateOriginalFirmware(ctx, args.Request); err2 != nil {
+		tickerCancel()
+		switch v := err2.(type) {
+		case *PermissionDenied:
+			result.PermissionDenied = v
+		case *InvalidRequest:
+			result.InvalidRequest = v
+		default:
+			if err2 == thrift.ErrAbandonRequest {
+				return false, thrift.WrapTException(err2)
+			}
+			x := thrift.NewTApplicationException(thrift.INTERNAL_ERROR, "Internal error processing UpdateOriginalFirmware: "+err2.Error())
+			oprot.WriteMessageBegin(ctx, "UpdateOriginalFirmware", thrift.EXCEPTION, seqId)
+			x.Write(ctx, oprot)
+			oprot.WriteMessageEnd(ctx)
+			oprot.Flush(ctx)
+			return true, thrift.WrapTException(err2)
+		}
+	}
+	tickerCancel()
+	if err2 = oprot.WriteMessageBegin(ctx, "UpdateOriginalFirmware", thrift.REPLY, seqId); err2 != nil {
+		err = thrift.WrapTException(err2)
+	}
+	if err2 = result.Write(ctx, oprot); err == nil && err2 != nil {
+		err = thrift.WrapTException(err2)
+	}
+	if err2 = oprot.WriteMessageEnd(ctx); err == nil && err2 != nil {
+		err = thrift.WrapTException(err2)
+	}
+	if err2 = oprot.Flush(ctx); err == nil && err2 != nil {
+		err = thrift.WrapTException(err2)
+	}
+	if err != nil {
+		return
+	}
+	return true, err
+}
+
+type attestationFailureAnalyzerServiceProcessorDeleteOriginalFirmware struct {
+	handler AttestationFailureAnalyzerService
+}
+
+func (p *attestationFailureAnalyzerServiceProcessorDeleteOriginalFirmware) Process(ctx context.Context, seqId int32, iprot, oprot thrift.TProtocol) (success bool, err thrift.TException) {
+	args := AttestationFailureAnalyzerServiceDeleteOriginalFirmwareArgs{}
+	var err2 error
+	if err2 = args.Read(ctx, iprot); err2 != nil {
+		iprot.ReadMessageEnd(ctx)
+		x := thrift.NewTApplicationException(thrift.PROTOCOL_ERROR, err2.Error())
+		oprot.WriteMessageBegin(ctx, "DeleteOriginalFirmware", thrift.EXCEPTION, seqId)
+		x.Write(ctx, oprot)
+		oprot.WriteMessageEnd(ctx)
+		oprot.Flush(ctx)
+		return false, thrift.WrapTException(err2)
+	}
+	iprot.ReadMessageEnd(ctx)
+
+	tickerCancel := func() {}
+	// Start a goroutine to do server side connectivity check.
+	if thrift.ServerConnectivityCheckInterval > 0 {
+		var cancel context.CancelFunc
+		ctx, cancel = context.WithCancel(ctx)
+		defer cancel()
+		var tickerCtx context.Context
+		tickerCtx, tickerCancel = context.WithCancel(context.Background())
+		defer tickerCancel()
+		go func(ctx context.Context, cancel context.CancelFunc) {
+			ticker := time.NewTicker(thrift.ServerConnectivityCheckInterval)
+			defer ticker.Stop()
+			for {
+				select {
+				case <-ctx.Done():
+					return
+				case <-ticker.C:
+					if !iprot.Transport().IsOpen() {
+						cancel()
+						return
+					}
+				}
+			}
+		}(tickerCtx, cancel)
+	}
+
+	result := AttestationFailureAnalyzerServiceDeleteOriginalFirmwareResult{}
+	if err2 = p.handler.DeleteOriginalFirmware(ctx, args.Request); err2 != nil {
+		tickerCancel()
+		switch v := err2.(type) {
+		case *PermissionDenied:
+			result.PermissionDenied = v
+		case *InvalidRequest:
+			result.InvalidRequest = v
+		default:
+			if err2 == thrift.ErrAbandonRequest {
+				return false, thrift.WrapTException(err2)
+			}
+			x := thrift.NewTApplicationException(thrift.INTERNAL_ERROR, "Internal error processing DeleteOriginalFirmware: "+err2.Error())
+			oprot.WriteMessageBegin(ctx, "DeleteOriginalFirmware", thrift.EXCEPTION, seqId)
+			x.Write(ctx, oprot)
+			oprot.WriteMessageEnd(ctx)
+			oprot.Flush(ctx)
+			return true, thrift.WrapTException(err2)
+		}
+	}
+	tickerCancel()
+	if err2 = oprot.WriteMessageBegin(ctx, "DeleteOriginalFirmware", thrift.REPLY, seqId); err2 != nil {
+		err = thrift.WrapTException(err2)
+	}
+	if err2 = result.Write(ctx, oprot); err == nil && err2 != nil {
+		err = thrift.WrapTException(err2)
+	}
+	if err2 = oprot.WriteMessageEnd(ctx); err == nil && err2 != nil {
+		err = thrift.WrapTException(err2)
+	}
+	if err2 = oprot.Flush(ctx); err == nil && err2 != nil {
+		err = thrift.WrapTException(err2)
+	}
+	if err != nil {
+		return
+	}
+	return true, err
+}
+
+type attestationFailureAnalyzerServiceProcessorListTamperingRegistryEntries struct {
+	handler AttestationFailureAnalyzerService
+}
+
+func (p *attestationFailureAnalyzerServiceProcessorListTamperingRegistryEntries) Process(ctx context.Context, seqId int32, iprot, oprot thrift.TProtocol) (success bool, err thrift.TException) {
+	args := AttestationFailureAnalyzerServiceListTamperingRegistryEntriesArgs{}
+	var err2 error
+	if err2 = args.Read(ctx, iprot); err2 != nil {
+		iprot.ReadMessageEnd(ctx)
+		x := thrift.NewTApplicationException(thrift.PROTOCOL_ERROR, err2.Error())
+		oprot.WriteMessageBegin(ctx, "ListTamperingRegistryEntries", thrift.EXCEPTION, seqId)
+		x.Write(ctx, oprot)
+		oprot.WriteMessageEnd(ctx)
+		oprot.Flush(ctx)
+		return false, thrift.WrapTException(err2)
+	}
+	iprot.ReadMessageEnd(ctx)
+
+	tickerCancel := func() {}
+	// Start a goroutine to do server side connectivity check.
+	if thrift.ServerConnectivityCheckInterval > 0 {
+		var cancel context.CancelFunc
+		ctx, cancel = context.WithCancel(ctx)
+		defer cancel()
+		var tickerCtx context.Context
+		tickerCtx, tickerCancel = context.WithCancel(context.Background())
+		defer tickerCancel()
+		go func(ctx context.Context, cancel context.CancelFunc) {
+			ticker := time.NewTicker(thrift.ServerConnectivityCheckInterval)
+			defer ticker.Stop()
+			for {
+				select {
+				case <-ctx.Done():
+					return
+				case <-ticker.C:
+					if !iprot.Transport().IsOpen() {
+						cancel()
+						return
+					}
+				}
+			}
+		}(tickerCtx, cancel)
+	}
+
+	result := AttestationFailureAnalyzerServiceListTamperingRegistryEntriesResult{}
+	var retval *ListTamperingRegistryEntriesResult_
+	if retval, err2 = p.handler.ListTamperingRegistryEntries(ctx, args.Request); err2 != nil {
+		tickerCancel()
+		switch v := err2.(type) {
+		case *InvalidRequest:
+			result.InvalidRequest = v
+		case *PermissionDenied:
+			result.PermissionDenied = v
+		default:
+			if err2 == thrift.ErrAbandonRequest {
+				return false, thrift.WrapTException(err2)
+			}
+			x := thrift.NewTApplicationException(thrift.INTERNAL_ERROR, "Internal error processing ListTamperingRegistryEntries: "+err2.Error())
+			oprot.WriteMessageBegin(ctx, "ListTamperingRegistryEntries", thrift.EXCEPTION, seqId)
+			x.Write(ctx, oprot)
+			oprot.WriteMessageEnd(ctx)
+			oprot.Flush(ctx)
+			return true, thrift.WrapTException(err2)
+		}
+	} else {
+		result.Success = retval
+	}
+	tickerCancel()
+	if err2 = oprot.WriteMessageBegin(ctx, "ListTamperingRegistryEntries", thrift.REPLY, seqId); err2 != nil {
+		err = thrift.WrapTException(err2)
+	}
+	if err2 = result.Write(ctx, oprot); err == nil && err2 != nil {
+		err = thrift.WrapTException(err2)
+	}
+	if err2 = oprot.WriteMessageEnd(ctx); err == nil && err2 != nil {
+		err = thrift.WrapTException(err2)
+	}
+	if err2 = oprot.Flush(ctx); err == nil && err2 != nil {
+		err = thrift.WrapTException(err2)
+	}
+	if err != nil {
+		return
+	}
+	return true, err
+}
+
+type attestationFailureAnalyzerServiceProcessorInsertTamperingRegistryEntry struct {
+	handler AttestationFailureAnalyzerService
+}
+
+func (p *attestationFailureAnalyzerServiceProcessorInsertTamperingRegistryEntry) Process(ctx context.Context, seqId int32, iprot, oprot thrift.TProtocol) (success bool, err thrift.TException) {
+	args := AttestationFailureAnalyzerServiceInsertTamperingRegistryEntryArgs{}
+	var err2 error
+	if err2 = args.Read(ctx, iprot); err2 != nil {
+		iprot.ReadMessageEnd(ctx)
+		x := thrift.NewTApplicationException(thrift.PROTOCOL_ERROR, err2.Error())
+		oprot.WriteMessageBegin(ctx, "InsertTamperingRegistryEntry", thrift.EXCEPTION, seqId)
+		x.Write(ctx, oprot)
+		oprot.WriteMessageEnd(ctx)
+		oprot.Flush(ctx)
+		return false, thrift.WrapTException(err2)
+	}
+	iprot.ReadMessageEnd(ctx)
+
+	tickerCancel := func() {}
+	// Start a goroutine to do server side connectivity check.
+	if thrift.ServerConnectivityCheckInterval > 0 {
+		var cancel context.CancelFunc
+		ctx, cancel = context.WithCancel(ctx)
+		defer cancel()
+		var tickerCtx context.Context
+		tickerCtx, tickerCancel = context.WithCancel(context.Background())
+		defer tickerCancel()
+		go func(ctx context.Context, cancel context.CancelFunc) {
+			ticker := time.NewTicker(thrift.ServerConnectivityCheckInterval)
+			defer ticker.Stop()
+			for {
+				select {
+				case <-ctx.Done():
+					return
+				case <-ticker.C:
+					if !iprot.Transport().IsOpen() {
+						cancel()
+						return
+					}
+				}
+			}
+		}(tickerCtx, cancel)
+	}
+
+	result := AttestationFailureAnalyzerServiceInsertTamperingRegistryEntryResult{}
+	var retval *InsertTamperingRegistryEntryResult_
+	if retval, err2 = p.handler.InsertTamperingRegistryEntry(ctx, args.Request); err2 != nil {
+		tickerCancel()
+		switch v := err2.(type) {
+		case *PermissionDenied:
+			result.PermissionDenied = v
+		case *InvalidRequest:
+			result.InvalidRequest = v
+		default:
+			if err2 == thrift.ErrAbandonRequest {
+				return false, thrift.WrapTException(err2)
+			}
+			x := thrift.NewTApplicationException(thrift.INTERNAL_ERROR, "Internal error processing InsertTamperingRegistryEntry: "+err2.Error())
+			oprot.WriteMessageBegin(ctx, "InsertTamperingRegistryEntry", thrift.EXCEPTION, seqId)
+			x.Write(ctx, oprot)
+			oprot.WriteMessageEnd(ctx)
+			oprot.Flush(ctx)
+			return true, thrift.WrapTException(err2)
+		}
+	} else {
+		result.Success = retval
+	}
+	tickerCancel()
+	if err2 = oprot.WriteMessageBegin(ctx, "InsertTamperingRegistryEntry", thrift.REPLY, seqId); err2 != nil {
+		err = thrift.WrapTException(err2)
+	}
+	if err2 = result.Write(ctx, oprot); err == nil && err2 != nil {
+		err = thrift.WrapTException(err2)
+	}
+	if err2 = oprot.WriteMessageEnd(ctx); err == nil && err2 != nil {
+		err = thrift.WrapTException(err2)
+	}
+	if err2 = oprot.Flush(ctx); err == nil && err2 != nil {
+		err = thrift.WrapTException(err2)
+	}
+	if err != nil {
+		return
+	}
+	return true, err
+}
+
+type attestationFailureAnalyzerServiceProcessorUpdateTamperingRegistryEntry struct {
+	handler AttestationFailureAnalyzerService
+}
+
+func (p *attestationFailureAnalyzerServiceProcessorUpdateTamperingRegistryEntry) Process(ctx context.Context, seqId int32, iprot, oprot thrift.TProtocol) (success bool, err thrift.TException) {
+	args := AttestationFailureAnalyzerServiceUpdateTamperingRegistryEntryArgs{}
+	var err2 error
+	if err2 = args.Read(ctx, iprot); err2 != nil {
+		iprot.ReadMessageEnd(ctx)
+		x := thrift.NewTApplicationException(thrift.PROTOCOL_ERROR, err2.Error())
+		oprot.WriteMessageBegin(ctx, "UpdateTamperingRegistryEntry", thrift.EXCEPTION, seqId)
+		x.Write(ctx, oprot)
+		oprot.WriteMessageEnd(ctx)
+		oprot.Flush(ctx)
+		return false, thrift.WrapTException(err2)
+	}
+	iprot.ReadMessageEnd(ctx)
+
+	tickerCancel := func() {}
+	// Start a goroutine to do server side connectivity check.
+	if thrift.ServerConnectivityCheckInterval > 0 {
+		var cancel context.CancelFunc
+		ctx, cancel = context.WithCancel(ctx)
+		defer cancel()
+		var tickerCtx context.Context
+		tickerCtx, tickerCancel = context.WithCancel(context.Background())
+		defer tickerCancel()
+		go func(ctx context.Context, cancel context.CancelFunc) {
+			ticker := time.NewTicker(thrift.ServerConnectivityCheckInterval)
+			defer ticker.Stop()
+			for {
+				select {
+				case <-ctx.Done():
+					return
+				case <-ticker.C:
+					if !iprot.Transport().IsOpen() {
+						cancel()
+						return
+					}
+				}
+			}
+		}(tickerCtx, cancel)
+	}
+
+	result := AttestationFailureAnalyzerServiceUpdateTamperingRegistryEntryResult{}
+	if err2 = p.handler.UpdateTamperingRegistryEntry(ctx, args.Request); err2 != nil {
+		tickerCancel()
+		switch v := err2.(type) {
+		case *PermissionDenied:
+			result.PermissionDenied = v
+		case *InvalidRequest:
+			result.InvalidRequest = v
+		default:
+			if err2 == thrift.ErrAbandonRequest {
+				return false, thrift.WrapTException(err2)
+			}
+			x := thrift.NewTApplicationException(thrift.INTERNAL_ERROR, "Internal error processing UpdateTamperingRegistryEntry: "+err2.Error())
+			oprot.WriteMessageBegin(ctx, "UpdateTamperingRegistryEntry", thrift.EXCEPTION, seqId)
+			x.Write(ctx, oprot)
+			oprot.WriteMessageEnd(ctx)
+			oprot.Flush(ctx)
+			return true, thrift.WrapTException(err2)
+		}
+	}
+	tickerCancel()
+	if err2 = oprot.WriteMessageBegin(ctx, "UpdateTamperingRegistryEntry", thrift.REPLY, seqId); err2 != nil {
+		err = thrift.WrapTException(err2)
+	}
+	if err2 = result.Write(ctx, oprot); err == nil && err2 != nil {
+		err = thrift.WrapTException(err2)
+	}
+	if err2 = oprot.WriteMessageEnd(ctx); err == nil && err2 != nil {
+		err = thrift.WrapTException(err2)
+	}
+	if err2 = oprot.Flush(ctx); err == nil && err2 != nil {
+		err = thrift.WrapTException(err2)
+	}
+	if err != nil {
+		return
+	}
+	return true, err
+}
+
+type attestationFailureAnalyzerServiceProcessorDeleteTamperingRegistryEntry struct {
+	handler AttestationFailureAnalyzerService
+}
+
+func (p *attestationFailureAnalyzerServiceProcessorDeleteTamperingRegistryEntry) Process(ctx context.Context, seqId int32, iprot, oprot thrift.TProtocol) (success bool, err thrift.TException) {
+	args := AttestationFailureAnalyzerServiceDeleteTamperingRegistryEntryArgs{}
+	var err2 error
+	if err2 = args.Read(ctx, iprot); err2 != nil {
+		iprot.ReadMessageEnd(ctx)
+		x := thrift.NewTApplicationException(thrift.PROTOCOL_ERROR, err2.Error())
+		oprot.WriteMessageBegin(ctx, "DeleteTamperingRegistryEntry", thrift.EXCEPTION, seqId)
+		x.Write(ctx, oprot)
+		oprot.WriteMessageEnd(ctx)
+		oprot.Flush(ctx)
+		return false, thrift.WrapTException(err2)
+	}
+	iprot.ReadMessageEnd(ctx)
+
+	tickerCancel := func() {}
+	// Start a goroutine to do server side connectivity check.
+	if thrift.ServerConnectivityCheckInterval > 0 {
+		var cancel context.CancelFunc
+		ctx, cancel = context.WithCancel(ctx)
+		defer cancel()
+		var tickerCtx context.Context
+		tickerCtx, tickerCancel = context.WithCancel(context.Background())
+		defer tickerCancel()
+		go func(ctx context.Context, cancel context.CancelFunc) {
+			ticker := time.NewTicker(thrift.ServerConnectivityCheckInterval)
+			defer ticker.Stop()
+			for {
+				select {
+				case <-ctx.Done():
+					return
+				case <-ticker.C:
+					if !iprot.Transport().IsOpen() {
+						cancel()
+						return
+					}
+				}
+			}
+		}(tickerCtx, cancel)
+	}
+
+	result := AttestationFailureAnalyzerServiceDeleteTamperingRegistryEntryResult{}
+	if err2 = p.handler.DeleteTamperingRegistryEntry(ctx, args.Request); err2 != nil {
+		tickerCancel()
+		switch v := err2.(type) {
+		case *PermissionDenied:
+			result.PermissionDenied = v
+		case *InvalidRequest:
+			result.InvalidRequest = v
+		default:
+			if err2 == thrift.ErrAbandonRequest {
+				return false, thrift.WrapTException(err2)
+			}
+			x := thrift.NewTApplicationException(thrift.INTERNAL_ERROR, "Internal error processing DeleteTamperingRegistryEntry: "+err2.Error())
+			oprot.WriteMessageBegin(ctx, "DeleteTamperingRegistryEntry", thrift.EXCEPTION, seqId)
+			x.Write(ctx, oprot)
+			oprot.WriteMessageEnd(ctx)
+			oprot.Flush(ctx)
+			return true, thrift.WrapTException(err2)
+		}
+	}
+	tickerCancel()
+	if err2 = oprot.WriteMessageBegin(ctx, "DeleteTamperingRegistryEntry", thrift.REPLY, seqId); err2 != nil {
+		err = thrift.WrapTException(err2)
+	}
+	if err2 = result.Write(ctx, oprot); err == nil && err2 != nil {
+		err = thrift.WrapTException(err2)
+	}
+	if err2 = oprot.WriteMessageEnd(ctx); err == nil && err2 != nil {
+		err = thrift.WrapTException(err2)
+	}
+	if err2 = oprot.Flush(ctx); err == nil && err2 != nil {
+		err = thrift.WrapTException(err2)
+	}
+	if err != nil {
+		return
+	}
+	return true, err
+}
+
+// HELPER FUNCTIONS AND STRUCTURES
+
+// Attributes:
+//   - Request
+type AttestationFailureAnalyzerServiceGetServerInfoArgs struct {
+	Request *GetServerInfoRequest `thrift:"request,1" db:"request" json:"request"`
+}
+
+func NewAttestationFailureAnalyzerServiceGetServerInfoArgs() *AttestationFailureAnalyzerServiceGetServerInfoArgs {
+	return &AttestationFailureAnalyzerServiceGetServerInfoArgs{}
+}
+
+var AttestationFailureAnalyzerServiceGetServerInfoArgs_Request_DEFAULT *GetServerInfoRequest
+
+func (p *AttestationFailureAnalyzerServiceGetServerInfoArgs) GetRequest() *GetServerInfoRequest {
+	if !p.IsSetRequest() {
+		return AttestationFailureAnalyzerServiceGetServerInfoArgs_Request_DEFAULT
+	}
+	return p.Request
+}
+func (p *AttestationFailureAnalyzerServiceGetServerInfoArgs) IsSetRequest() bool {
+	return p.Request != nil
+}
+
+func (p *AttestationFailureAnalyzerServiceGetServerInfoArgs) Read(ctx context.Context, iprot thrift.TProtocol) error {
+	if _, err := iprot.ReadStructBegin(ctx); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T read error: ", p), err)
+	}
+
+	for {
+		_, fieldTypeId, fieldId, err := iprot.ReadFieldBegin(ctx)
+		if err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T field %d read error: ", p, fieldId), err)
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+		switch fieldId {
+		case 1:
+			if fieldTypeId == thrift.STRUCT {
+				if err := p.ReadField1(ctx, iprot); err != nil {
+					return err
+				}
+			} else {
+				if err := iprot.Skip(ctx, fieldTypeId); err != nil {
+					return err
+				}
+			}
+		default:
+			if err := iprot.Skip(ctx, fieldTypeId); err != nil {
+				return err
+			}
+		}
+		if err := iprot.ReadFieldEnd(ctx); err != nil {
+			return err
+		}
+	}
+	if err := iprot.ReadStructEnd(ctx); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
+	}
+	return nil
+}
+
+func (p *AttestationFailureAnalyzerServiceGetServerInfoArgs) ReadField1(ctx context.Context, iprot thrift.TProtocol) error {
+	p.Request = &GetServerInfoRequest{}
+	if err := p.Request.Read(ctx, iprot); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T error reading struct: ", p.Request), err)
+	}
+	return nil
+}
+
+func (p *AttestationFailureAnalyzerServiceGetServerInfoArgs) Write(ctx context.Context, oprot thrift.TProtocol) error {
+	if err := oprot.WriteStructBegin(ctx, "GetServerInfo_args"); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
+	}
+	if p != nil {
+		if err := p.writeField1(ctx, oprot); err != nil {
+			return err
+		}
+	}
+	if err := oprot.WriteFieldStop(ctx); err != nil {
+		return thrift.PrependError("write field stop error: ", err)
+	}
+	if err := oprot.WriteStructEnd(ctx); err != nil {
+		return thrift.PrependError("write struct stop error: ", err)
+	}
+	return nil
+}
+
+func (p *AttestationFailureAnalyzerServiceGetServerInfoArgs) writeField1(ctx context.Context, oprot thrift.TProtocol) (err error) {
+	if err := oprot.WriteFieldBegin(ctx, "request", thrift.STRUCT, 1); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T write field begin error 1:request: ", p), err)
+	}
+	if err := p.Request.Write(ctx, oprot); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T error writing struct: ", p.Request), err)
+	}
+	if err := oprot.WriteFieldEnd(ctx); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T write field end error 1:request: ", p), err)
+	}
+	return err
+}
+
+func (p *AttestationFailureAnalyzerServiceGetServerInfoArgs) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("AttestationFailureAnalyzerServiceGetServerInfoArgs(%+v)", *p)
+}
+
+// Attributes:
+//   - Success
+//   - PermissionDenied
+type AttestationFailureAnalyzerServiceGetServerInfoResult struct {
+	Success          *ServerInfo       `thrift:"success,0" db:"success" json:"success,omitempty"`
+	PermissionDenied *PermissionDenied `thrift:"permissionDenied,1" db:"permissionDenied" json:"permissionDenied,omitempty"`
+}
+
+func NewAttestationFailureAnalyzerServiceGetServerInfoResult() *AttestationFailureAnalyzerServiceGetServerInfoResult {
+	return &AttestationFailureAnalyzerServiceGetServerInfoResult{}
+}
+
+var AttestationFailureAnalyzerServiceGetServerInfoResult_Success_DEFAULT *ServerInfo
+
+func (p *AttestationFailureAnalyzerServiceGetServerInfoResult) GetSuccess() *ServerInfo {
+	if !p.IsSetSuccess() {
+		return AttestationFailureAnalyzerServiceGetServerInfoResult_Success_DEFAULT
+	}
+	return p.Success
+}
+
+var AttestationFailureAnalyzerServiceGetServerInfoResult_PermissionDenied_DEFAULT *PermissionDenied
+
+func (p *AttestationFailureAnalyzerServiceGetServerInfoResult) GetPermissionDenied() *PermissionDenied {
+	if !p.IsSetPermissionDenied() {
+		return AttestationFailureAnalyzerServiceGetServerInfoResult_PermissionDenied_DEFAULT
+	}
+	return p.PermissionDenied
+}
+func (p *AttestationFailureAnalyzerServiceGetServerInfoResult) IsSetSuccess() bool {
+	return p.Success != nil
+}
+
+func (p *AttestationFailureAnalyzerServiceGetServerInfoResult) IsSetPermissionDenied() bool {
+	return p.PermissionDenied != nil
+}
+
+func (p *AttestationFailureAnalyzerServiceGetServerInfoResult) Read(ctx context.Context, iprot thrift.TProtocol) error {
+	if _, err := iprot.ReadStructBegin(ctx); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T read error: ", p), err)
+	}
+
+	for {
+		_, fieldTypeId, fieldId, err := iprot.ReadFieldBegin(ctx)
+		if err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T field %d read error: ", p, fieldId), err)
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+		switch fieldId {
+		case 0:
+			if fieldTypeId == thrift.STRUCT {
+				if err := p.ReadField0(ctx, iprot); err != nil {
+					return err
+				}
+			} else {
+				if err := iprot.Skip(ctx, fieldTypeId); err != nil {
+					return err
+				}
+			}
+		case 1:
+			if fieldTypeId == thrift.STRUCT {
+				if err := p.ReadField1(ctx, iprot); err != nil {
+					return err
+				}
+			} else {
+				if err := iprot.Skip(ctx, fieldTypeId); err != nil {
+					return err
+				}
+			}
+		default:
+			if err := iprot.Skip(ctx, fieldTypeId); err != nil {
+				return err
+			}
+		}
+		if err := iprot.ReadFieldEnd(ctx); err != nil {
+			return err
+		}
+	}
+	if err := iprot.ReadStructEnd(ctx); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
+	}
+	return nil
+}
+
+func (p *AttestationFailureAnalyzerServiceGetServerInfoResult) ReadField0(ctx context.Context, iprot thrift.TProtocol) error {
+	p.Success = &ServerInfo{}
+	if err := p.Success.Read(ctx, iprot); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T error reading struct: ", p.Success), err)
+	}
+	return nil
+}
+
+func (p *AttestationFailureAnalyzerServiceGetServerInfoResult) ReadField1(ctx context.Context, iprot thrift.TProtocol) error {
+	p.PermissionDenied = &PermissionDenied{}
+	if err := p.PermissionDenied.Read(ctx, iprot); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T error reading struct: ", p.PermissionDenied), err)
+	}
+	return nil
+}
+
+func (p *AttestationFailureAnalyzerServiceGetServerInfoResult) Write(ctx context.Context, oprot thrift.TProtocol) error {
+	if err := oprot.WriteStructBegin(ctx, "GetServerInfo_result"); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
+	}
+	if p != nil {
+		if err := p.writeField0(ctx, oprot); err != nil {
+			return err
+		}
+		if err := p.writeField1(ctx, oprot); err != nil {
+			return err
+		}
+	}
+	if err := oprot.WriteFieldStop(ctx); err != nil {
+		return thrift.PrependError("write field stop error: ", err)
+	}
+	if err := oprot.WriteStructEnd(ctx); err != nil {
+		return thrift.PrependError("write struct stop error: ", err)
+	}
+	return nil
+}
+
+func (p *AttestationFailureAnalyzerServiceGetServerInfoResult) writeField0(ctx context.Context, oprot thrift.TProtocol) (err error) {
+	if p.IsSetSuccess() {
+		if err := oprot.WriteFieldBegin(ctx, "success", thrift.STRUCT, 0); err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T write field begin error 0:success: ", p), err)
+		}
+		if err := p.Success.Write(ctx, oprot); err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T error writing struct: ", p.Success), err)
+		}
+		if err := oprot.WriteFieldEnd(ctx); err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T write field end error 0:success: ", p), err)
+		}
+	}
+	return err
+}
+
+func (p *AttestationFailureAnalyzerServiceGetServerInfoResult) writeField1(ctx context.Context, oprot thrift.TProtocol) (err error) {
+	if p.IsSetPermissionDenied() {
+		if err := oprot.WriteFieldBegin(ctx, "permissionDenied", thrift.STRUCT, 1); err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T write field begin error 1:permissionDenied: ", p), err)
+		}
+		if err := p.PermissionDenied.Write(ctx, oprot); err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T error writing struct: ", p.PermissionDenied), err)
+		}
+		if err := oprot.WriteFieldEnd(ctx); err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T write field end error 1:permissionDenied: ", p), err)
+		}
+	}
+	return err
+}
+
+func (p *AttestationFailureAnalyzerServiceGetServerInfoResult) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("AttestationFailureAnalyzerServiceGetServerInfoResult(%+v)", *p)
+}
+
+// Attributes:
+//   - Request
+type AttestationFailureAnalyzerServiceSearchFirmwareArgs struct {
+	Request *SearchFirmwareRequest `thrift:"request,1" db:"request" json:"request"`
+}
+
+func NewAttestationFailureAnalyzerServiceSearchFirmwareArgs() *AttestationFailureAnalyzerServiceSearchFirmwareArgs {
+	return &AttestationFailureAnalyzerServiceSearchFirmwareArgs{}
+}
+
+var AttestationFailureAnalyzerServiceSearchFirmwareArgs_Request_DEFAULT *SearchFirmwareRequest
+
+func (p *AttestationFailureAnalyzerServiceSearchFirmwareArgs) GetRequest() *SearchFirmwareRequest {
+	if !p.IsSetRequest() {
+		return AttestationFailureAnalyzerServiceSearchFirmwareArgs_Request_DEFAULT
+	}
+	return p.Request
+}
+func (p *AttestationFailureAnalyzerServiceSearchFirmwareArgs) IsSetRequest() bool {
+	return p.Request != nil
+}
+
+func (p *AttestationFailureAnalyzerServiceSearchFirmwareArgs) Read(ctx context.Context, iprot thrift.TProtocol) error {
+	if _, err := iprot.ReadStructBegin(ctx); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T read error: ", p), err)
+	}
+
+	for {
+		_, fieldTypeId, fieldId, err := iprot.ReadFieldBegin(ctx)
+		if err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T field %d read error: ", p, fieldId), err)
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+		switch fieldId {
+		case 1:
+			if fieldTypeId == thrift.STRUCT {
+				if err := p.ReadField1(ctx, iprot); err != nil {
+					return err
+				}
+			} else {
+				if err := iprot.Skip(ctx, fieldTypeId); err != nil {
+					return err
+				}
+			}
+		default:
+			if err := iprot.Skip(ctx, fieldTypeId); err != nil {
+				return err
+			}
+		}
+		if err := iprot.ReadFieldEnd(ctx); err != nil {
+			return err
+		}
+	}
+	if err := iprot.ReadStructEnd(ctx); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
+	}
+	return nil
+}
+
+func (p *AttestationFailureAnalyzerServiceSearchFirmwareArgs) ReadField1(ctx context.Context, iprot thrift.TProtocol) error {
+	p.Request = &SearchFirmwareRequest{}
+	if err := p.Request.Read(ctx, iprot); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T error reading struct: ", p.Request), err)
+	}
+	return nil
+}
+
+func (p *AttestationFailureAnalyzerServiceSearchFirmwareArgs) Write(ctx context.Context, oprot thrift.TProtocol) error {
+	if err := oprot.WriteStructBegin(ctx, "SearchFirmware_args"); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
+	}
+	if p != nil {
+		if err := p.writeField1(ctx, oprot); err != nil {
+			return err
+		}
+	}
+	if err := oprot.WriteFieldStop(ctx); err != nil {
+		return thrift.PrependError("write field stop error: ", err)
+	}
+	if err := oprot.WriteStructEnd(ctx); err != nil {
+		return thrift.PrependError("write struct stop error: ", err)
+	}
+	return nil
+}
+
+func (p *AttestationFailureAnalyzerServiceSearchFirmwareArgs) writeField1(ctx context.Context, oprot thrift.TProtocol) (err error) {
+	if err := oprot.WriteFieldBegin(ctx, "request", thrift.STRUCT, 1); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T write field begin error 1:request: ", p), err)
+	}
+	if err := p.Request.Write(ctx, oprot); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T error writing struct: ", p.Request), err)
+	}
+	if err := oprot.WriteFieldEnd(ctx); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T write field end error 1:request: ", p), err)
+	}
+	return err
+}
+
+func (p *AttestationFailureAnalyzerServiceSearchFirmwareArgs) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("AttestationFailureAnalyzerServiceSearchFirmwareArgs(%+v)", *p)
+}
+
+// Attributes:
+//   - Success
+//   - PermissionDenied
+type AttestationFailureAnalyzerServiceSearchFirmwareResult struct {
+	Success          *SearchFirmwareResult_ `thrift:"success,0" db:"success" json:"success,omitempty"`
+	PermissionDenied *PermissionDenied      `thrift:"permissionDenied,1" db:"permissionDenied" json:"permissionDenied,omitempty"`
+}
+
+func NewAttestationFailureAnalyzerServiceSearchFirmwareResult() *AttestationFailureAnalyzerServiceSearchFirmwareResult {
+	return &AttestationFailureAnalyzerServiceSearchFirmwareResult{}
+}
+
+var AttestationFailureAnalyzerServiceSearchFirmwareResult_Success_DEFAULT *SearchFirmwareResult_
+
+func (p *AttestationFailureAnalyzerServiceSearchFirmwareResult) GetSuccess() *SearchFirmwareResult_ {
+	if !p.IsSetSuccess() {
+		return AttestationFailureAnalyzerServiceSearchFirmwareResult_Success_DEFAULT
+	}
+	return p.Success
+}
+
+var AttestationFailureAnalyzerServiceSearchFirmwareResult_PermissionDenied_DEFAULT *PermissionDenied
+
+func (p *AttestationFailureAnalyzerServiceSearchFirmwareResult) GetPermissionDenied() *PermissionDenied {
+	if !p.IsSetPermissionDenied() {
+		return AttestationFailureAnalyzerServiceSearchFirmwareResult_PermissionDenied_DEFAULT
+	}
+	return p.PermissionDenied
+}
+func (p *AttestationFailureAnalyzerServiceSearchFirmwareResult) IsSetSuccess() bool {
+	return p.Success != nil
+}
+
+func (p *AttestationFailureAnalyzerServiceSearchFirmwareResult) IsSetPermissionDenied() bool {
+	return p.PermissionDenied != nil
+}
+
+func (p *AttestationFailureAnalyzerServiceSearchFirmwareResult) Read(ctx context.Context, iprot thrift.TProtocol) error {
+	if _, err := iprot.ReadStructBegin(ctx); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T read error: ", p), err)
+	}
+
+	for {
+		_, fieldTypeId, fieldId, err := iprot.ReadFieldBegin(ctx)
+		if err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T field %d read error: ", p, fieldId), err)
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+		switch fieldId {
+		case 0:
+			if fieldTypeId == thrift.STRUCT {
+				if err := p.ReadField0(ctx, iprot); err != nil {
+					return err
+				}
+			} else {
+				if err := iprot.Skip(ctx, fieldTypeId); err != nil {
+					return err
+				}
+			}
+		case 1:
+			if fieldTypeId == thrift.STRUCT {
+				if err := p.ReadField1(ctx, iprot); err != nil {
+					return err
+				}
+			} else {
+				if err := iprot.Skip(ctx, fieldTypeId); err != nil {
+					return err
+				}
+			}
+		default:
+			if err := iprot.Skip(ctx, fieldTypeId); err != nil {
+				return err
+			}
+		}
+		if err := iprot.ReadFieldEnd(ctx); err != nil {
+			return err
+		}
+	}
+	if err := iprot.ReadStructEnd(ctx); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
+	}
+	return nil
+}
+
+func (p *AttestationFailureAnalyzerServiceSearchFirmwareResult) ReadField0(ctx context.Context, iprot thrift.TProtocol) error {
+	p.Success = &SearchFirmwareResult_{}
+	if err := p.Success.Read(ctx, iprot); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T error reading struct: ", p.Success), err)
+	}
+	return nil
+}
+
+func (p *AttestationFailureAnalyzerServiceSearchFirmwareResult) ReadField1(ctx context.Context, iprot thrift.TProtocol) error {
+	p.PermissionDenied = &PermissionDenied{}
+	if err := p.PermissionDenied.Read(ctx, iprot); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T error reading struct: ", p.PermissionDenied), err)
+	}
+	return nil
+}
+
+func (p *AttestationFailureAnalyzerServiceSearchFirmwareResult) Write(ctx context.Context, oprot thrift.TProtocol) error {
+	if err := oprot.WriteStructBegin(ctx, "SearchFirmware_result"); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
+	}
+	if p != nil {
+		if err := p.writeField0(ctx, oprot); err != nil {
+			return err
+		}
+		if err := p.writeField1(ctx, oprot); err != nil {
+			return err
+		}
+	}
+	if err := oprot.WriteFieldStop(ctx); err != nil {
+		return thrift.PrependError("write field stop error: ", err)
+	}
+	if err := oprot.WriteStructEnd(ctx); err != nil {
+		return thrift.PrependError("write struct stop error: ", err)
+	}
+	return nil
+}
+
+func (p *AttestationFailureAnalyzerServiceSearchFirmwareResult) writeField0(ctx context.Context, oprot thrift.TProtocol) (err error) {
+	if p.IsSetSuccess() {
+		if err := oprot.WriteFieldBegin(ctx, "success", thrift.STRUCT, 0); err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T write field begin error 0:success: ", p), err)
+		}
+		if err := p.Success.Write(ctx, oprot); err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T error writing struct: ", p.Success), err)
+		}
+		if err := oprot.WriteFieldEnd(ctx); err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T write field end error 0:success: ", p), err)
+		}
+	}
+	return err
+}
+
+func (p *AttestationFailureAnalyzerServiceSearchFirmwareResult) writeField1(ctx context.Context, oprot thrift.TProtocol) (err error) {
+	if p.IsSetPermissionDenied() {
+		if err := oprot.WriteFieldBegin(ctx, "permissionDenied", thrift.STRUCT, 1); err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T write field begin error 1:permissionDenied: ", p), err)
+		}
+		if err := p.PermissionDenied.Write(ctx, oprot); err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T error writing struct: ", p.PermissionDenied), err)
+		}
+		if err := oprot.WriteFieldEnd(ctx); err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T write field end error 1:permissionDenied: ", p), err)
+		}
+	}
+	return err
+}
+
+func (p *AttestationFailureAnalyzerServiceSearchFirmwareResult) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("AttestationFailureAnalyzerServiceSearchFirmwareResult(%+v)", *p)
+}
+
+// Attributes:
+//   - Request
+type AttestationFailureAnalyzerServiceSearchReportArgs struct {
+	Request *SearchReportRequest `thrift:"request,1" db:"request" json:"request"`
+}
+
+func NewAttestationFailureAnalyzerServiceSearchReportArgs() *AttestationFailureAnalyzerServiceSearchReportArgs {
+	return &AttestationFailureAnalyzerServiceSearchReportArgs{}
+}
+
+var AttestationFailureAnalyzerServiceSearchReportArgs_Request_DEFAULT *SearchReportRequest
+
+func (p *AttestationFailureAnalyzerServiceSearchReportArgs) GetRequest() *SearchReportRequest {
+	if !p.IsSetRequest() {
+		return AttestationFailureAnalyzerServiceSearchReportArgs_Request_DEFAULT
+	}
+	return p.Request
+}
+func (p *AttestationFailureAnalyzerServiceSearchReportArgs) IsSetRequest() bool {
+	return p.Request != nil
+}
+
+func (p *AttestationFailureAnalyzerServiceSearchReportArgs) Read(ctx context.Context, iprot thrift.TProtocol) error {
+	if _, err := iprot.ReadStructBegin(ctx); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T read error: ", p), err)
+	}
+
+	for {
+		_, fieldTypeId, fieldId, err := iprot.ReadFieldBegin(ctx)
+		if err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T field %d read error: ", p, fieldId), err)
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+		switch fieldId {
+		case 1:
+			if fieldTypeId == thrift.STRUCT {
+				if err := p.ReadField1(ctx, iprot); err != nil {
+					return err
+				}
+			} else {
+				if err := iprot.Skip(ctx, fieldTypeId); err != nil {
+					return err
+				}
+			}
+		default:
+			if err := iprot.Skip(ctx, fieldTypeId); err != nil {
+				return err
+			}
+		}
+		if err := iprot.ReadFieldEnd(ctx); err != nil {
+			return err
+		}
+	}
+	if err := iprot.ReadStructEnd(ctx); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
+	}
+	return nil
+}
+
+func (p *AttestationFailureAnalyzerServiceSearchReportArgs) ReadField1(ctx context.Context, iprot thrift.TProtocol) error {
+	p.Request = &SearchReportRequest{}
+	if err := p.Request.Read(ctx, iprot); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T error reading struct: ", p.Request), err)
+	}
+	return nil
+}
+
+func (p *AttestationFailureAnalyzerServiceSearchReportArgs) Write(ctx context.Context, oprot thrift.TProtocol) error {
+	if err := oprot.WriteStructBegin(ctx, "SearchReport_args"); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
+	}
+	if p != nil {
+		if err := p.writeField1(ctx, oprot); err != nil {
+			return err
+		}
+	}
+	if err := oprot.WriteFieldStop(ctx); err != nil {
+		return thrift.PrependError("write field stop error: ", err)
+	}
+	if err := oprot.WriteStructEnd(ctx); err != nil {
+		return thrift.PrependError("write struct stop error: ", err)
+	}
+	return nil
+}
+
+func (p *AttestationFailureAnalyzerServiceSearchReportArgs) writeField1(ctx context.Context, oprot thrift.TProtocol) (err error) {
+	if err := oprot.WriteFieldBegin(ctx, "request", thrift.STRUCT, 1); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T write field begin error 1:request: ", p), err)
+	}
+	if err := p.Request.Write(ctx, oprot); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T error writing struct: ", p.Request), err)
+	}
+	if err := oprot.WriteFieldEnd(ctx); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T write field end error 1:request: ", p), err)
+	}
+	return err
+}
+
+func (p *AttestationFailureAnalyzerServiceSearchReportArgs) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("AttestationFailureAnalyzerServiceSearchReportArgs(%+v)", *p)
+}
+
+// Attributes:
+//   - Success
+//   - PermissionDenied
+type AttestationFailureAnalyzerServiceSearchReportResult struct {
+	Success          *SearchReportResult_ `thrift:"success,0" db:"success" json:"success,omitempty"`
+	PermissionDenied *PermissionDenied    `thrift:"permissionDenied,1" db:"permissionDenied" json:"permissionDenied,omitempty"`
+}
+
+func NewAttestationFailureAnalyzerServiceSearchReportResult() *AttestationFailureAnalyzerServiceSearchReportResult {
+	return &AttestationFailureAnalyzerServiceSearchReportResult{}
+}
+
+var AttestationFailureAnalyzerServiceSearchReportResult_Success_DEFAULT *SearchReportResult_
+
+func (p *AttestationFailureAnalyzerServiceSearchReportResult) GetSuccess() *SearchReportResult_ {
+	if !p.IsSetSuccess() {
+		return AttestationFailureAnalyzerServiceSearchReportResult_Success_DEFAULT
+	}
+	return p.Success
+}
+
+var AttestationFailureAnalyzerServiceSearchReportResult_PermissionDenied_DEFAULT *PermissionDenied
+
+func (p *AttestationFailureAnalyzerServiceSearchReportResult) GetPermissionDenied() *PermissionDenied {
+	if !p.IsSetPermissionDenied() {
+		return AttestationFailureAnalyzerServiceSearchReportResult_PermissionDenied_DEFAULT
+	}
+	return p.PermissionDenied
+}
+func (p *AttestationFailureAnalyzerServiceSearchReportResult) IsSetSuccess() bool {
+	return p.Success != nil
+}
+
+func (p *AttestationFailureAnalyzerServiceSearchReportResult) IsSetPermissionDenied() bool {
+	return p.PermissionDenied != nil
+}
+
+func (p *AttestationFailureAnalyzerServiceSearchReportResult) Read(ctx context.Context, iprot thrift.TProtocol) error {
+	if _, err := iprot.ReadStructBegin(ctx); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T read error: ", p), err)
+	}
+
+	for {
+		_, fieldTypeId, fieldId, err := iprot.ReadFieldBegin(ctx)
+		if err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T field %d read error: ", p, fieldId), err)
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+		switch fieldId {
+		case 0:
+			if fieldTypeId == thrift.STRUCT {
+				if err := p.ReadField0(ctx, iprot); err != nil {
+					return err
+				}
+			} else {
+				if err := iprot.Skip(ctx, fieldTypeId); err != nil {
+					return err
+				}
+			}
+		case 1:
+			if fieldTypeId == thrift.STRUCT {
+				if err := p.ReadField1(ctx, iprot); err != nil {
+					return err
+				}
+			} else {
+				if err := iprot.Skip(ctx, fieldTypeId); err != nil {
+					return err
+				}
+			}
+		default:
+			if err := iprot.Skip(ctx, fieldTypeId); err != nil {
+				return err
+			}
+		}
+		if err := iprot.ReadFieldEnd(ctx); err != nil {
+			return err
+		}
+	}
+	if err := iprot.ReadStructEnd(ctx); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
+	}
+	return nil
+}
+
+func (p *AttestationFailureAnalyzerServiceSearchReportResult) ReadField0(ctx context.Context, iprot thrift.TProtocol) error {
+	p.Success = &SearchReportResult_{}
+	if err := p.Success.Read(ctx, iprot); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T error reading struct: ", p.Success), err)
+	}
+	return nil
+}
+
+func (p *AttestationFailureAnalyzerServiceSearchReportResult) ReadField1(ctx context.Context, iprot thrift.TProtocol) error {
+	p.PermissionDenied = &PermissionDenied{}
+	if err := p.PermissionDenied.Read(ctx, iprot); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T error reading struct: ", p.PermissionDenied), err)
+	}
+	return nil
+}
+
+func (p *AttestationFailureAnalyzerServiceSearchReportResult) Write(ctx context.Context, oprot thrift.TProtocol) error {
+	if err := oprot.WriteStructBegin(ctx, "SearchReport_result"); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
+	}
+	if p != nil {
+		if err := p.writeField0(ctx, oprot); err != nil {
+			return err
+		}
+		if err := p.writeField1(ctx, oprot); err != nil {
+			return err
+		}
+	}
+	if err := oprot.WriteFieldStop(ctx); err != nil {
+		return thrift.PrependError("write field stop error: ", err)
+	}
+	if err := oprot.WriteStructEnd(ctx); err != nil {
+		return thrift.PrependError("write struct stop error: ", err)
+	}
+	return nil
+}
+
+func (p *AttestationFailureAnalyzerServiceSearchReportResult) writeField0(ctx context.Context, oprot thrift.TProtocol) (err error) {
+	if p.IsSetSuccess() {
+		if err := oprot.WriteFieldBegin(ctx, "success", thrift.STRUCT, 0); err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T write field begin error 0:success: ", p), err)
+		}
+		if err := p.Success.Write(ctx, oprot); err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T error writing struct: ", p.Success), err)
+		}
+		if err := oprot.WriteFieldEnd(ctx); err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T write field end error 0:success: ", p), err)
+		}
+	}
+	return err
+}
+
+func (p *AttestationFailureAnalyzerServiceSearchReportResult) writeField1(ctx context.Context, oprot thrift.TProtocol) (err error) {
+	if p.IsSetPermissionDenied() {
+		if err := oprot.WriteFieldBegin(ctx, "permissionDenied", thrift.STRUCT, 1); err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T write field begin error 1:permissionDenied: ", p), err)
+		}
+		if err := p.PermissionDenied.Write(ctx, oprot); err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T error writing struct: ", p.PermissionDenied), err)
+		}
+		if err := oprot.WriteFieldEnd(ctx); err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T write field end error 1:permissionDenied: ", p), err)
+		}
+	}
+	return err
+}
+
+func (p *AttestationFailureAnalyzerServiceSearchReportResult) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("AttestationFailureAnalyzerServiceSearchReportResult(%+v)", *p)
+}
+
+// Attributes:
+//   - Request
+type AttestationFailureAnalyzerServiceAnalyzeArgs struct {
+	Request *AnalyzeRequest `thrift:"request,1" db:"request" json:"request"`
+}
+
+func NewAttestationFailureAnalyzerServiceAnalyzeArgs() *AttestationFailureAnalyzerServiceAnalyzeArgs {
+	return &AttestationFailureAnalyzerServiceAnalyzeArgs{}
+}
+
+var AttestationFailureAnalyzerServiceAnalyzeArgs_Request_DEFAULT *AnalyzeRequest
+
+func (p *AttestationFailureAnalyzerServiceAnalyzeArgs) GetRequest() *AnalyzeRequest {
+	if !p.IsSetRequest() {
+		return AttestationFailureAnalyzerServiceAnalyzeArgs_Request_DEFAULT
+	}
+	return p.Request
+}
+func (p *AttestationFailureAnalyzerServiceAnalyzeArgs) IsSetRequest() bool {
+	return p.Request != nil
+}
+
+func (p *AttestationFailureAnalyzerServiceAnalyzeArgs) Read(ctx context.Context, iprot thrift.TProtocol) error {
+	if _, err := iprot.ReadStructBegin(ctx); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T read error: ", p), err)
+	}
+
+	for {
+		_, fieldTypeId, fieldId, err := iprot.ReadFieldBegin(ctx)
+		if err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T field %d read error: ", p, fieldId), err)
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+		switch fieldId {
+		case 1:
+			if fieldTypeId == thrift.STRUCT {
+				if err := p.ReadField1(ctx, iprot); err != nil {
+					return err
+				}
+			} else {
+				if err := iprot.Skip(ctx, fieldTypeId); err != nil {
+					return err
+				}
+			}
+		default:
+			if err := iprot.Skip(ctx, fieldTypeId); err != nil {
+				return err
+			}
+		}
+		if err := iprot.ReadFieldEnd(ctx); err != nil {
+			return err
+		}
+	}
+	if err := iprot.ReadStructEnd(ctx); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
+	}
+	return nil
+}
+
+func (p *AttestationFailureAnalyzerServiceAnalyzeArgs) ReadField1(ctx context.Context, iprot thrift.TProtocol) error {
+	p.Request = &AnalyzeRequest{}
+	if err := p.Request.Read(ctx, iprot); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T error reading struct: ", p.Request), err)
+	}
+	return nil
+}
+
+func (p *AttestationFailureAnalyzerServiceAnalyzeArgs) Write(ctx context.Context, oprot thrift.TProtocol) error {
+	if err := oprot.WriteStructBegin(ctx, "Analyze_args"); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
+	}
+	if p != nil {
+		if err := p.writeField1(ctx, oprot); err != nil {
+			return err
+		}
+	}
+	if err := oprot.WriteFieldStop(ctx); err != nil {
+		return thrift.PrependError("write field stop error: ", err)
+	}
+	if err := oprot.WriteStructEnd(ctx); err != nil {
+		return thrift.PrependError("write struct stop error: ", err)
+	}
+	return nil
+}
+
+func (p *AttestationFailureAnalyzerServiceAnalyzeArgs) writeField1(ctx context.Context, oprot thrift.TProtocol) (err error) {
+	if err := oprot.WriteFieldBegin(ctx, "request", thrift.STRUCT, 1); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T write field begin error 1:request: ", p), err)
+	}
+	if err := p.Request.Write(ctx, oprot); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T error writing struct: ", p.Request), err)
+	}
+	if err := oprot.WriteFieldEnd(ctx); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T write field end error 1:request: ", p), err)
+	}
+	return err
+}
+
+func (p *AttestationFailureAnalyzerServiceAnalyzeArgs) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("AttestationFailureAnalyzerServiceAnalyzeArgs(%+v)", *p)
+}
+
+// Attributes:
+//   - Success
+//   - UnableToGetOriginalFirmware
+//   - IncorrectHostConfiguration
+//   - PermissionDenied
+//   - Overloaded
+type AttestationFailureAnalyzerServiceAnalyzeResult struct {
+	Success                     *AnalyzeResult_              `thrift:"success,0" db:"success" json:"success,omitempty"`
+	UnableToGetOriginalFirmware *UnableToGetOriginalFirmware `thrift:"unableToGetOriginalFirmware,1" db:"unableToGetOriginalFirmware" json:"unableToGetOriginalFirmware,omitempty"`
+	IncorrectHostConfiguration  *IncorrectHostConfiguration  `thrift:"incorrectHostConfiguration,2" db:"incorrectHostConfiguration" json:"incorrectHostConfiguration,omitempty"`
+	PermissionDenied            *PermissionDenied            `thrift:"permissionDenied,3" db:"permissionDenied" json:"permissionDenied,omitempty"`
+	Overloaded                  *Overloaded                  `thrift:"overloaded,4" db:"overloaded" json:"overloaded,omitempty"`
+}
+
+func NewAttestationFailureAnalyzerServiceAnalyzeResult() *AttestationFailureAnalyzerServiceAnalyzeResult {
+	return &AttestationFailureAnalyzerServiceAnalyzeResult{}
+}
+
+var AttestationFailureAnalyzerServiceAnalyzeResult_Success_DEFAULT *AnalyzeResult_
+
+func (p *AttestationFailureAnalyzerServiceAnalyzeResult) GetSuccess() *AnalyzeResult_ {
+	if !p.IsSetSuccess() {
+		return AttestationFailureAnalyzerServiceAnalyzeResult_Success_DEFAULT
+	}
+	return p.Success
+}
+
+var AttestationFailureAnalyzerServiceAnalyzeResult_UnableToGetOriginalFirmware_DEFAULT *UnableToGetOriginalFirmware
+
+func (p *AttestationFailureAnalyzerServiceAnalyzeResult) GetUnableToGetOriginalFirmware() *UnableToGetOriginalFirmware {
+	if !p.IsSetUnableToGetOriginalFirmware() {
+		return AttestationFailureAnalyzerServiceAnalyzeResult_UnableToGetOriginalFirmware_DEFAULT
+	}
+	return p.UnableToGetOriginalFirmware
+}
+
+var AttestationFailureAnalyzerServiceAnalyzeResult_IncorrectHostConfiguration_DEFAULT *IncorrectHostConfiguration
+
+func (p *AttestationFailureAnalyzerServiceAnalyzeResult) GetIncorrectHostConfiguration() *IncorrectHostConfiguration {
+	if !p.IsSetIncorrectHostConfiguration() {
+		return AttestationFailureAnalyzerServiceAnalyzeResult_IncorrectHostConfiguration_DEFAULT
+	}
+	return p.IncorrectHostConfiguration
+}
+
+var AttestationFailureAnalyzerServiceAnalyzeResult_PermissionDenied_DEFAULT *PermissionDenied
+
+func (p *AttestationFailureAnalyzerServiceAnalyzeResult) GetPermissionDenied() *PermissionDenied {
+	if !p.IsSetPermissionDenied() {
+		return AttestationFailureAnalyzerServiceAnalyzeResult_PermissionDenied_DEFAULT
+	}
+	return p.PermissionDenied
+}
+
+var AttestationFailureAnalyzerServiceAnalyzeResult_Overloaded_DEFAULT *Overloaded
+
+func (p *AttestationFailureAnalyzerServiceAnalyzeResult) GetOverloaded() *Overloaded {
+	if !p.IsSetOverloaded() {
+		return AttestationFailureAnalyzerServiceAnalyzeResult_Overloaded_DEFAULT
+	}
+	return p.Overloaded
+}
+func (p *AttestationFailureAnalyzerServiceAnalyzeResult) IsSetSuccess() bool {
+	return p.Success != nil
+}
+
+func (p *AttestationFailureAnalyzerServiceAnalyzeResult) IsSetUnableToGetOriginalFirmware() bool {
+	return p.UnableToGetOriginalFirmware != nil
+}
+
+func (p *AttestationFailureAnalyzerServiceAnalyzeResult) IsSetIncorrectHostConfiguration() bool {
+	return p.IncorrectHostConfiguration != nil
+}
+
+func (p *AttestationFailureAnalyzerServiceAnalyzeResult) IsSetPermissionDenied() bool {
+	return p.PermissionDenied != nil
+}
+
+func (p *AttestationFailureAnalyzerServiceAnalyzeResult) IsSetOverloaded() bool {
+	return p.Overloaded != nil
+}
+
+func (p *AttestationFailureAnalyzerServiceAnalyzeResult) Read(ctx context.Context, iprot thrift.TProtocol) error {
+	if _, err := iprot.ReadStructBegin(ctx); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T read error: ", p), err)
+	}
+
+	for {
+		_, fieldTypeId, fieldId, err := iprot.ReadFieldBegin(ctx)
+		if err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T field %d read error: ", p, fieldId), err)
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+		switch fieldId {
+		case 0:
+			if fieldTypeId == thrift.STRUCT {
+				if err := p.ReadField0(ctx, iprot); err != nil {
+					return err
+				}
+			} else {
+				if err := iprot.Skip(ctx, fieldTypeId); err != nil {
+					return err
+				}
+			}
+		case 1:
+			if fieldTypeId == thrift.STRUCT {
+				if err := p.ReadField1(ctx, iprot); err != nil {
+					return err
+				}
+			} else {
+				if err := iprot.Skip(ctx, fieldTypeId); err != nil {
+					return err
+				}
+			}
+		case 2:
+			if fieldTypeId == thrift.STRUCT {
+				if err := p.ReadField2(ctx, iprot); err != nil {
+					return err
+				}
+			} else {
+				if err := iprot.Skip(ctx, fieldTypeId); err != nil {
+					return err
+				}
+			}
+		case 3:
+			if fieldTypeId == thrift.STRUCT {
+				if err := p.ReadField3(ctx, iprot); err != nil {
+					return err
+				}
+			} else {
+				if err := iprot.Skip(ctx, fieldTypeId); err != nil {
+					return err
+				}
+			}
+		case 4:
+			if fieldTypeId == thrift.STRUCT {
+				if err := p.ReadField4(ctx, iprot); err != nil {
+					return err
+				}
+			} else {
+				if err := iprot.Skip(ctx, fieldTypeId); err != nil {
+					return err
+				}
+			}
+		default:
+			if err := iprot.Skip(ctx, fieldTypeId); err != nil {
+				return err
+			}
+		}
+		if err := iprot.ReadFieldEnd(ctx); err != nil {
+			return err
+		}
+	}
+	if err := iprot.ReadStructEnd(ctx); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
+	}
+	return nil
+}
+
+func (p *AttestationFailureAnalyzerServiceAnalyzeResult) ReadField0(ctx context.Context, iprot thrift.TProtocol) error {
+	p.Success = &AnalyzeResult_{}
+	if err := p.Success.Read(ctx, iprot); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T error reading struct: ", p.Success), err)
+	}
+	return nil
+}
+
+func (p *AttestationFailureAnalyzerServiceAnalyzeResult) ReadField1(ctx context.Context, iprot thrift.TProtocol) error {
+	p.UnableToGetOriginalFirmware = &UnableToGetOriginalFirmware{}
+	if err := p.UnableToGetOriginalFirmware.Read(ctx, iprot); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T error reading struct: ", p.UnableToGetOriginalFirmware), err)
+	}
+	return nil
+}
+
+func (p *AttestationFailureAnalyzerServiceAnalyzeResult) ReadField2(ctx context.Context, iprot thrift.TProtocol) error {
+	p.IncorrectHostConfiguration = &IncorrectHostConfiguration{}
+	if err := p.IncorrectHostConfiguration.Read(ctx, iprot); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T error reading struct: ", p.IncorrectHostConfiguration), err)
+	}
+	return nil
+}
+
+func (p *AttestationFailureAnalyzerServiceAnalyzeResult) ReadField3(ctx context.Context, iprot thrift.TProtocol) error {
+	p.PermissionDenied = &PermissionDenied{}
+	if err := p.PermissionDenied.Read(ctx, iprot); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T error reading struct: ", p.PermissionDenied), err)
+	}
+	return nil
+}
+
+func (p *AttestationFailureAnalyzerServiceAnalyzeResult) ReadField4(ctx context.Context, iprot thrift.TProtocol) error {
+	p.Overloaded = &Overloaded{}
+	if err := p.Overloaded.Read(ctx, iprot); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T error reading struct: ", p.Overloaded), err)
+	}
+	return nil
+}
+
+func (p *AttestationFailureAnalyzerServiceAnalyzeResult) Write(ctx context.Context, oprot thrift.TProtocol) error {
+	if err := oprot.WriteStructBegin(ctx, "Analyze_result"); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
+	}
+	if p != nil {
+		if err := p.writeField0(ctx, oprot); err != nil {
+			return err
+		}
+		if err := p.writeField1(ctx, oprot); err != nil {
+			return err
+		}
+		if err := p.writeField2(ctx, oprot); err != nil {
+			return err
+		}
+		if err := p.writeField3(ctx, oprot); err != nil {
+			return err
+		}
+		if err := p.writeField4(ctx, oprot); err != nil {
+			return err
+		}
+	}
+	if err := oprot.WriteFieldStop(ctx); err != nil {
+		return thrift.PrependError("write field stop error: ", err)
+	}
+	if err := oprot.WriteStructEnd(ctx); err != nil {
+		return thrift.PrependError("write struct stop error: ", err)
+	}
+	return nil
+}
+
+func (p *AttestationFailureAnalyzerServiceAnalyzeResult) writeField0(ctx context.Context, oprot thrift.TProtocol) (err error) {
+	if p.IsSetSuccess() {
+		if err := oprot.WriteFieldBegin(ctx, "success", thrift.STRUCT, 0); err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T write field begin error 0:success: ", p), err)
+		}
+		if err := p.Success.Write(ctx, oprot); err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T error writing struct: ", p.Success), err)
+		}
+		if err := oprot.WriteFieldEnd(ctx); err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T write field end error 0:success: ", p), err)
+		}
+	}
+	return err
+}
+
+func (p *AttestationFailureAnalyzerServiceAnalyzeResult) writeField1(ctx context.Context, oprot thrift.TProtocol) (err error) {
+	if p.IsSetUnableToGetOriginalFirmware() {
+		if err := oprot.WriteFieldBegin(ctx, "unableToGetOriginalFirmware", thrift.STRUCT, 1); err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T write field begin error 1:unableToGetOriginalFirmware: ", p), err)
+		}
+		if err := p.UnableToGetOriginalFirmware.Write(ctx, oprot); err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T error writing struct: ", p.UnableToGetOriginalFirmware), err)
+		}
+		if err := oprot.WriteFieldEnd(ctx); err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T write field end error 1:unableToGetOriginalFirmware: ", p), err)
+		}
+	}
+	return err
+}
+
+func (p *AttestationFailureAnalyzerServiceAnalyzeResult) writeField2(ctx context.Context, oprot thrift.TProtocol) (err error) {
+	if p.IsSetIncorrectHostConfiguration() {
+		if err := oprot.WriteFieldBegin(ctx, "incorrectHostConfiguration", thrift.STRUCT, 2); err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T write field begin error 2:incorrectHostConfiguration: ", p), err)
+		}
+		if err := p.IncorrectHostConfiguration.Write(ctx, oprot); err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T error writing struct: ", p.IncorrectHostConfiguration), err)
+		}
+		if err := oprot.WriteFieldEnd(ctx); err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T write field end error 2:incorrectHostConfiguration: ", p), err)
+		}
+	}
+	return err
+}
+
+func (p *AttestationFailureAnalyzerServiceAnalyzeResult) writeField3(ctx context.Context, oprot thrift.TProtocol) (err error) {
+	if p.IsSetPermissionDenied() {
+		if err := oprot.WriteFieldBegin(ctx, "permissionDenied", thrift.STRUCT, 3); err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T write field begin error 3:permissionDenied: ", p), err)
+		}
+		if err := p.PermissionDenied.Write(ctx, oprot); err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T error writing struct: ", p.PermissionDenied), err)
+		}
+		if err := oprot.WriteFieldEnd(ctx); err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T write field end error 3:permissionDenied: ", p), err)
+		}
+	}
+	return err
+}
+
+func (p *AttestationFailureAnalyzerServiceAnalyzeResult) writeField4(ctx context.Context, oprot thrift.TProtocol) (err error) {
+	if p.IsSetOverloaded() {
+		if err := oprot.WriteFieldBegin(ctx, "overloaded", thrift.STRUCT, 4); err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T write field begin error 4:overloaded: ", p), err)
+		}
+		if err := p.Overloaded.Write(ctx, oprot); err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T error writing struct: ", p.Overloaded), err)
+		}
+		if err := oprot.WriteFieldEnd(ctx); err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T write field end error 4:overloaded: ", p), err)
+		}
+	}
+	return err
+}
+
+func (p *AttestationFailureAnalyzerServiceAnalyzeResult) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("AttestationFailureAnalyzerServiceAnalyzeResult(%+v)", *p)
+}
+
+// Attributes:
+//   - Request
+type AttestationFailureAnalyzerServiceCheckFirmwareVersionArgs struct {
+	Request *CheckFirmwareVersionRequest `thrift:"request,1" db:"request" json:"request"`
+}
+
+func NewAttestationFailureAnalyzerServiceCheckFirmwareVersionArgs() *AttestationFailureAnalyzerServiceCheckFirmwareVersionArgs {
+	return &AttestationFailureAnalyzerServiceCheckFirmwareVersionArgs{}
+}
+
+var AttestationFailureAnalyzerServiceCheckFirmwareVersionArgs_Request_DEFAULT *CheckFirmwareVersionRequest
+
+func (p *AttestationFailureAnalyzerServiceCheckFirmwareVersionArgs) GetRequest() *CheckFirmwareVersionRequest {
+	if !p.IsSetRequest() {
+		return AttestationFailureAnalyzerServiceCheckFirmwareVersionArgs_Request_DEFAULT
+	}
+	return p.Request
+}
+func (p *AttestationFailureAnalyzerServiceCheckFirmwareVersionArgs) IsSetRequest() bool {
+	return p.Request != nil
+}
+
+func (p *AttestationFailureAnalyzerServiceCheckFirmwareVersionArgs) Read(ctx context.Context, iprot thrift.TProtocol) error {
+	if _, err := iprot.ReadStructBegin(ctx); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T read error: ", p), err)
+	}
+
+	for {
+		_, fieldTypeId, fieldId, err := iprot.ReadFieldBegin(ctx)
+		if err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T field %d read error: ", p, fieldId), err)
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+		switch fieldId {
+		case 1:
+			if fieldTypeId == thrift.STRUCT {
+				if err := p.ReadField1(ctx, iprot); err != nil {
+					return err
+				}
+			} else {
+				if err := iprot.Skip(ctx, fieldTypeId); err != nil {
+					return err
+				}
+			}
+		default:
+			if err := iprot.Skip(ctx, fieldTypeId); err != nil {
+				return err
+			}
+		}
+		if err := iprot.ReadFieldEnd(ctx); err != nil {
+			return err
+		}
+	}
+	if err := iprot.ReadStructEnd(ctx); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
+	}
+	return nil
+}
+
+func (p *AttestationFailureAnalyzerServiceCheckFirmwareVersionArgs) ReadField1(ctx context.Context, iprot thrift.TProtocol) error {
+	p.Request = &CheckFirmwareVersionRequest{}
+	if err := p.Request.Read(ctx, iprot); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T error reading struct: ", p.Request), err)
+	}
+	return nil
+}
+
+func (p *AttestationFailureAnalyzerServiceCheckFirmwareVersionArgs) Write(ctx context.Context, oprot thrift.TProtocol) error {
+	if err := oprot.WriteStructBegin(ctx, "CheckFirmwareVersion_args"); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
+	}
+	if p != nil {
+		if err := p.writeField1(ctx, oprot); err != nil {
+			return err
+		}
+	}
+	if err := oprot.WriteFieldStop(ctx); err != nil {
+		return thrift.PrependError("write field stop error: ", err)
+	}
+	if err := oprot.WriteStructEnd(ctx); err != nil {
+		return thrift.PrependError("write struct stop error: ", err)
+	}
+	return nil
+}
+
+func (p *AttestationFailureAnalyzerServiceCheckFirmwareVersionArgs) writeField1(ctx context.Context, oprot thrift.TProtocol) (err error) {
+	if err := oprot.WriteFieldBegin(ctx, "request", thrift.STRUCT, 1); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T write field begin error 1:request: ", p), err)
+	}
+	if err := p.Request.Write(ctx, oprot); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T error writing struct: ", p.Request), err)
+	}
+	if err := oprot.WriteFieldEnd(ctx); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T write field end error 1:request: ", p), err)
+	}
+	return err
+}
+
+func (p *AttestationFailureAnalyzerServiceCheckFirmwareVersionArgs) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("AttestationFailureAnalyzerServiceCheckFirmwareVersionArgs(%+v)", *p)
+}
+
+// Attributes:
+//   - Success
+//   - PermissionDenied
+type AttestationFailureAnalyzerServiceCheckFirmwareVersionResult struct {
+	Success          *CheckFirmwareVersionResult_ `thrift:"success,0" db:"success" json:"success,omitempty"`
+	PermissionDenied *PermissionDenied            `thrift:"permissionDenied,1" db:"permissionDenied" json:"permissionDenied,omitempty"`
+}
+
+func NewAttestationFailureAnalyzerServiceCheckFirmwareVersionResult() *AttestationFailureAnalyzerServiceCheckFirmwareVersionResult {
+	return &AttestationFailureAnalyzerServiceCheckFirmwareVersionResult{}
+}
+
+var AttestationFailureAnalyzerServiceCheckFirmwareVersionResult_Success_DEFAULT *CheckFirmwareVersionResult_
+
+func (p *AttestationFailureAnalyzerServiceCheckFirmwareVersionResult) GetSuccess() *CheckFirmwareVersionResult_ {
+	if !p.IsSetSuccess() {
+		return AttestationFailureAnalyzerServiceCheckFirmwareVersionResult_Success_DEFAULT
+	}
+	return p.Success
+}
+
+var AttestationFailureAnalyzerServiceCheckFirmwareVersionResult_PermissionDenied_DEFAULT *PermissionDenied
+
+func (p *AttestationFailureAnalyzerServiceCheckFirmwareVersionResult) GetPermissionDenied() *PermissionDenied {
+	if !p.IsSetPermissionDenied() {
+		return AttestationFailureAnalyzerServiceCheckFirmwareVersionResult_PermissionDenied_DEFAULT
+	}
+	return p.PermissionDenied
+}
+func (p *AttestationFailureAnalyzerServiceCheckFirmwareVersionResult) IsSetSuccess() bool {
+	return p.Success != nil
+}
+
+func (p *AttestationFailureAnalyzerServiceCheckFirmwareVersionResult) IsSetPermissionDenied() bool {
+	return p.PermissionDenied != nil
+}
+
+func (p *AttestationFailureAnalyzerServiceCheckFirmwareVersionResult) Read(ctx context.Context, iprot thrift.TProtocol) error {
+	if _, err := iprot.ReadStructBegin(ctx); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T read error: ", p), err)
+	}
+
+	for {
+		_, fieldTypeId, fieldId, err := iprot.ReadFieldBegin(ctx)
+		if err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T field %d read error: ", p, fieldId), err)
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+		switch fieldId {
+		case 0:
+			if fieldTypeId == thrift.STRUCT {
+				if err := p.ReadField0(ctx, iprot); err != nil {
+					return err
+				}
+			} else {
+				if err := iprot.Skip(ctx, fieldTypeId); err != nil {
+					return err
+				}
+			}
+		case 1:
+			if fieldTypeId == thrift.STRUCT {
+				if err := p.ReadField1(ctx, iprot); err != nil {
+					return err
+				}
+			} else {
+				if err := iprot.Skip(ctx, fieldTypeId); err != nil {
+					return err
+				}
+			}
+		default:
+			if err := iprot.Skip(ctx, fieldTypeId); err != nil {
+				return err
+			}
+		}
+		if err := iprot.ReadFieldEnd(ctx); err != nil {
+			return err
+		}
+	}
+	if err := iprot.ReadStructEnd(ctx); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
+	}
+	return nil
+}
+
+func (p *AttestationFailureAnalyzerServiceCheckFirmwareVersionResult) ReadField0(ctx context.Context, iprot thrift.TProtocol) error {
+	p.Success = &CheckFirmwareVersionResult_{}
+	if err := p.Success.Read(ctx, iprot); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T error reading struct: ", p.Success), err)
+	}
+	return nil
+}
+
+func (p *AttestationFailureAnalyzerServiceCheckFirmwareVersionResult) ReadField1(ctx context.Context, iprot thrift.TProtocol) error {
+	p.PermissionDenied = &PermissionDenied{}
+	if err := p.PermissionDenied.Read(ctx, iprot); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T error reading struct: ", p.PermissionDenied), err)
+	}
+	return nil
+}
+
+func (p *AttestationFailureAnalyzerServiceCheckFirmwareVersionResult) Write(ctx context.Context, oprot thrift.TProtocol) error {
+	if err := oprot.WriteStructBegin(ctx, "CheckFirmwareVersion_result"); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
+	}
+	if p != nil {
+		if err := p.writeField0(ctx, oprot); err != nil {
+			return err
+		}
+		if err := p.writeField1(ctx, oprot); err != nil {
+			return err
+		}
+	}
+	if err := oprot.WriteFieldStop(ctx); err != nil {
+		return thrift.PrependError("write field stop error: ", err)
+	}
+	if err := oprot.WriteStructEnd(ctx); err != nil {
+		return thrift.PrependError("write struct stop error: ", err)
+	}
+	return nil
+}
+
+func (p *AttestationFailureAnalyzerServiceCheckFirmwareVersionResult) writeField0(ctx context.Context, oprot thrift.TProtocol) (err error) {
+	if p.IsSetSuccess() {
+		if err := oprot.WriteFieldBegin(ctx, "success", thrift.STRUCT, 0); err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T write field begin error 0:success: ", p), err)
+		}
+		if err := p.Success.Write(ctx, oprot); err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T error writing struct: ", p.Success), err)
+		}
+		if err := oprot.WriteFieldEnd(ctx); err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T write field end error 0:success: ", p), err)
+		}
+	}
+	return err
+}
+
+func (p *AttestationFailureAnalyzerServiceCheckFirmwareVersionResult) writeField1(ctx context.Context, oprot thrift.TProtocol) (err error) {
+	if p.IsSetPermissionDenied() {
+		if err := oprot.WriteFieldBegin(ctx, "permissionDenied", thrift.STRUCT, 1); err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T write field begin error 1:permissionDenied: ", p), err)
+		}
+		if err := p.PermissionDenied.Write(ctx, oprot); err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T error writing struct: ", p.PermissionDenied), err)
+		}
+		if err := oprot.WriteFieldEnd(ctx); err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T write field end error 1:permissionDenied: ", p), err)
+		}
+	}
+	return err
+}
+
+func (p *AttestationFailureAnalyzerServiceCheckFirmwareVersionResult) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("AttestationFailureAnalyzerServiceCheckFirmwareVersionResult(%+v)", *p)
+}
+
+// Attributes:
+//   - Request
+type AttestationFailureAnalyzerServiceSearchByPCRArgs struct {
+	Request *SearchByPCRRequest `thrift:"request,1" db:"request" json:"request"`
+}
+
+func NewAttestationFailureAnalyzerServiceSearchByPCRArgs() *AttestationFailureAnalyzerServiceSearchByPCRArgs {
+	return &AttestationFailureAnalyzerServiceSearchByPCRArgs{}
+}
+
+var AttestationFailureAnalyzerServiceSearchByPCRArgs_Request_DEFAULT *SearchByPCRRequest
+
+func (p *AttestationFailureAnalyzerServiceSearchByPCRArgs) GetRequest() *SearchByPCRRequest {
+	if !p.IsSetRequest() {
+		return AttestationFailureAnalyzerServiceSearchByPCRArgs_Request_DEFAULT
+	}
+	return p.Request
+}
+func (p *AttestationFailureAnalyzerServiceSearchByPCRArgs) IsSetRequest() bool {
+	return p.Request != nil
+}
+
+func (p *AttestationFailureAnalyzerServiceSearchByPCRArgs) Read(ctx context.Context, iprot thrift.TProtocol) error {
+	if _, err := iprot.ReadStructBegin(ctx); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T read error: ", p), err)
+	}
+
+	for {
+		_, fieldTypeId, fieldId, err := iprot.ReadFieldBegin(ctx)
+		if err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T field %d read error: ", p, fieldId), err)
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+		switch fieldId {
+		case 1:
+			if fieldTypeId == thrift.STRUCT {
+				if err := p.ReadField1(ctx, iprot); err != nil {
+					return err
+				}
+			} else {
+				if err := iprot.Skip(ctx, fieldTypeId); err != nil {
+					return err
+				}
+			}
+		default:
+			if err := iprot.Skip(ctx, fieldTypeId); err != nil {
+				return err
+			}
+		}
+		if err := iprot.ReadFieldEnd(ctx); err != nil {
+			return err
+		}
+	}
+	if err := iprot.ReadStructEnd(ctx); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
+	}
+	return nil
+}
+
+func (p *AttestationFailureAnalyzerServiceSearchByPCRArgs) ReadField1(ctx context.Context, iprot thrift.TProtocol) error {
+	p.Request = &SearchByPCRRequest{}
+	if err := p.Request.Read(ctx, iprot); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T error reading struct: ", p.Request), err)
+	}
+	return nil
+}
+
+func (p *AttestationFailureAnalyzerServiceSearchByPCRArgs) Write(ctx context.Context, oprot thrift.TProtocol) error {
+	if err := oprot.WriteStructBegin(ctx, "SearchByPCR_args"); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
+	}
+	if p != nil {
+		if err := p.writeField1(ctx, oprot); err != nil {
+			return err
+		}
+	}
+	if err := oprot.WriteFieldStop(ctx); err != nil {
+		return thrift.PrependError("write field stop error: ", err)
+	}
+	if err := oprot.WriteStructEnd(ctx); err != nil {
+		return thrift.PrependError("write struct stop error: ", err)
+	}
+	return nil
+}
+
+func (p *AttestationFailureAnalyzerServiceSearchByPCRArgs) writeField1(ctx context.Context, oprot thrift.TProtocol) (err error) {
+	if err := oprot.WriteFieldBegin(ctx, "request", thrift.STRUCT, 1); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T write field begin error 1:request: ", p), err)
+	}
+	if err := p.Request.Write(ctx, oprot); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T error writing struct: ", p.Request), err)
+	}
+	if err := oprot.WriteFieldEnd(ctx); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T write field end error 1:request: ", p), err)
+	}
+	return err
+}
+
+func (p *AttestationFailureAnalyzerServiceSearchByPCRArgs) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("AttestationFailureAnalyzerServiceSearchByPCRArgs(%+v)", *p)
+}
+
+// Attributes:
+//   - Success
+//   - InvalidRequest
+//   - PermissionDenied
+type AttestationFailureAnalyzerServiceSearchByPCRResult struct {
+	Success          *SearchByPCRResult_ `thrift:"success,0" db:"success" json:"success,omitempty"`
+	InvalidRequest   *InvalidRequest     `thrift:"invalidRequest,1" db:"invalidRequest" json:"invalidRequest,omitempty"`
+	PermissionDenied *PermissionDenied   `thrift:"permissionDenied,2" db:"permissionDenied" json:"permissionDenied,omitempty"`
+}
+
+func NewAttestationFailureAnalyzerServiceSearchByPCRResult() *AttestationFailureAnalyzerServiceSearchByPCRResult {
+	return &AttestationFailureAnalyzerServiceSearchByPCRResult{}
+}
+
+var AttestationFailureAnalyzerServiceSearchByPCRResult_Success_DEFAULT *SearchByPCRResult_
+
+func (p *AttestationFailureAnalyzerServiceSearchByPCRResult) GetSuccess() *SearchByPCRResult_ {
+	if !p.IsSetSuccess() {
+		return AttestationFailureAnalyzerServiceSearchByPCRResult_Success_DEFAULT
+	}
+	return p.Success
+}
+
+var AttestationFailureAnalyzerServiceSearchByPCRResult_InvalidRequest_DEFAULT *InvalidRequest
+
+func (p *AttestationFailureAnalyzerServiceSearchByPCRResult) GetInvalidRequest() *InvalidRequest {
+	if !p.IsSetInvalidRequest() {
+		return AttestationFailureAnalyzerServiceSearchByPCRResult_InvalidRequest_DEFAULT
+	}
+	return p.InvalidRequest
+}
+
+var AttestationFailureAnalyzerServiceSearchByPCRResult_PermissionDenied_DEFAULT *PermissionDenied
+
+func (p *AttestationFailureAnalyzerServiceSearchByPCRResult) GetPermissionDenied() *PermissionDenied {
+	if !p.IsSetPermissionDenied() {
+		return AttestationFailureAnalyzerServiceSearchByPCRResult_PermissionDenied_DEFAULT
+	}
+	return p.PermissionDenied
+}
+func (p *AttestationFailureAnalyzerServiceSearchByPCRResult) IsSetSuccess() bool {
+	return p.Success != nil
+}
+
+func (p *AttestationFailureAnalyzerServiceSearchByPCRResult) IsSetInvalidRequest() bool {
+	return p.InvalidRequest != nil
+}
+
+func (p *AttestationFailureAnalyzerServiceSearchByPCRResult) IsSetPermissionDenied() bool {
+	return p.PermissionDenied != nil
+}
+
+func (p *AttestationFailureAnalyzerServiceSearchByPCRResult) Read(ctx context.Context, iprot thrift.TProtocol) error {
+	if _, err := iprot.ReadStructBegin(ctx); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T read error: ", p), err)
+	}
+
+	for {
+		_, fieldTypeId, fieldId, err := iprot.ReadFieldBegin(ctx)
+		if err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T field %d read error: ", p, fieldId), err)
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+		switch fieldId {
+		case 0:
+			if fieldTypeId == thrift.STRUCT {
+				if err := p.ReadField0(ctx, iprot); err != nil {
+					return err
+				}
+			} else {
+				if err := iprot.Skip(ctx, fieldTypeId); err != nil {
+					return err
+				}
+			}
+		case 1:
+			if fieldTypeId == thrift.STRUCT {
+				if err := p.ReadField1(ctx, iprot); err != nil {
+					return err
+				}
+			} else {
+				if err := iprot.Skip(ctx, fieldTypeId); err != nil {
+					return err
+				}
+			}
+		case 2:
+			if fieldTypeId == thrift.STRUCT {
+				if err := p.ReadField2(ctx, iprot); err != nil {
+					return err
+				}
+			} else {
+				if err := iprot.Skip(ctx, fieldTypeId); err != nil {
+					return err
+				}
+			}
+		default:
+			if err := iprot.Skip(ctx, fieldTypeId); err != nil {
+				return err
+			}
+		}
+		if err := iprot.ReadFieldEnd(ctx); err != nil {
+			return err
+		}
+	}
+	if err := iprot.ReadStructEnd(ctx); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
+	}
+	return nil
+}
+
+func (p *AttestationFailureAnalyzerServiceSearchByPCRResult) ReadField0(ctx context.Context, iprot thrift.TProtocol) error {
+	p.Success = &SearchByPCRResult_{}
+	if err := p.Success.Read(ctx, iprot); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T error reading struct: ", p.Success), err)
+	}
+	return nil
+}
+
+func (p *AttestationFailureAnalyzerServiceSearchByPCRResult) ReadField1(ctx context.Context, iprot thrift.TProtocol) error {
+	p.InvalidRequest = &InvalidRequest{}
+	if err := p.InvalidRequest.Read(ctx, iprot); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T error reading struct: ", p.InvalidRequest), err)
+	}
+	return nil
+}
+
+func (p *AttestationFailureAnalyzerServiceSearchByPCRResult) ReadField2(ctx context.Context, iprot thrift.TProtocol) error {
+	p.PermissionDenied = &PermissionDenied{}
+	if err := p.PermissionDenied.Read(ctx, iprot); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T error reading struct: ", p.PermissionDenied), err)
+	}
+	return nil
+}
+
+func (p *AttestationFailureAnalyzerServiceSearchByPCRResult) Write(ctx context.Context, oprot thrift.TProtocol) error {
+	if err := oprot.WriteStructBegin(ctx, "SearchByPCR_result"); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
+	}
+	if p != nil {
+		if err := p.writeField0(ctx, oprot); err != nil {
+			return err
+		}
+		if err := p.writeField1(ctx, oprot); err != nil {
+			return err
+		}
+		if err := p.writeField2(ctx, oprot); err != nil {
+			return err
+		}
+	}
+	if err := oprot.WriteFieldStop(ctx); err != nil {
+		return thrift.PrependError("write field stop error: ", err)
+	}
+	if err := oprot.WriteStructEnd(ctx); err != nil {
+		return thrift.PrependError("write struct stop error: ", err)
+	}
+	return nil
+}
+
+func (p *AttestationFailureAnalyzerServiceSearchByPCRResult) writeField0(ctx context.Context, oprot thrift.TProtocol) (err error) {
+	if p.IsSetSuccess() {
+		if err := oprot.WriteFieldBegin(ctx, "success", thrift.STRUCT, 0); err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T write field begin error 0:success: ", p), err)
+		}
+		if err := p.Success.Write(ctx, oprot); err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T error writing struct: ", p.Success), err)
+		}
+		if err := oprot.WriteFieldEnd(ctx); err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T write field end error 0:success: ", p), err)
+		}
+	}
+	return err
+}
+
+func (p *AttestationFailureAnalyzerServiceSearchByPCRResult) writeField1(ctx context.Context, oprot thrift.TProtocol) (err error) {
+	if p.IsSetInvalidRequest() {
+		if err := oprot.WriteFieldBegin(ctx, "invalidRequest", thrift.STRUCT, 1); err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T write field begin error 1:invalidRequest: ", p), err)
+		}
+		if err := p.InvalidRequest.Write(ctx, oprot); err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T error writing struct: ", p.InvalidRequest), err)
+		}
+		if err := oprot.WriteFieldEnd(ctx); err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T write field end error 1:invalidRequest: ", p), err)
+		}
+	}
+	return err
+}
+
+func (p *AttestationFailureAnalyzerServiceSearchByPCRResult) writeField2(ctx context.Context, oprot thrift.TProtocol) (err error) {
+	if p.IsSetPermissionDenied() {
+		if err := oprot.WriteFieldBegin(ctx, "permissionDenied", thrift.STRUCT, 2); err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T write field begin error 2:permissionDenied: ", p), err)
+		}
+		if err := p.PermissionDenied.Write(ctx, oprot); err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T error writing struct: ", p.PermissionDenied), err)
+		}
+		if err := oprot.WriteFieldEnd(ctx); err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T write field end error 2:permissionDenied: ", p), err)
+		}
+	}
+	return err
+}
+
+func (p *AttestationFailureAnalyzerServiceSearchByPCRResult) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("AttestationFailureAnalyzerServiceSearchByPCRResult(%+v)", *p)
+}
+
+// Attributes:
+//   - Request
+type AttestationFailureAnalyzerServiceCompareReportsArgs struct {
+	Request *CompareReportsRequest `thrift:"request,1" db:"request" json:"request"`
+}
+
+func NewAttestationFailureAnalyzerServiceCompareReportsArgs() *AttestationFailureAnalyzerServiceCompareReportsArgs {
+	return &AttestationFailureAnalyzerServiceCompareReportsArgs{}
+}
+
+var AttestationFailureAnalyzerServiceCompareReportsArgs_Request_DEFAULT *CompareReportsRequest
+
+func (p *AttestationFailureAnalyzerServiceCompareReportsArgs) GetRequest() *CompareReportsRequest {
+	if !p.IsSetRequest() {
+		return AttestationFailureAnalyzerServiceCompareReportsArgs_Request_DEFAULT
+	}
+	return p.Request
+}
+func (p *AttestationFailureAnalyzerServiceCompareReportsArgs) IsSetRequest() bool {
+	return p.Request != nil
+}
+
+func (p *AttestationFailureAnalyzerServiceCompareReportsArgs) Read(ctx context.Context, iprot thrift.TProtocol) error {
+	if _, err := iprot.ReadStructBegin(ctx); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T read error: ", p), err)
+	}
+
+	for {
+		_, fieldTypeId, fieldId, err := iprot.ReadFieldBegin(ctx)
+		if err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T field %d read error: ", p, fieldId), err)
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+		switch fieldId {
+		case 1:
+			if fieldTypeId == thrift.STRUCT {
+				if err := p.ReadField1(ctx, iprot); err != nil {
+					return err
+				}
+			} else {
+				if err := iprot.Skip(ctx, fieldTypeId); err != nil {
+					return err
+				}
+			}
+		default:
+			if err := iprot.Skip(ctx, fieldTypeId); err != nil {
+				return err
+			}
+		}
+		if err := iprot.ReadFieldEnd(ctx); err != nil {
+			return err
+		}
+	}
+	if err := iprot.ReadStructEnd(ctx); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
+	}
+	return nil
+}
+
+func (p *AttestationFailureAnalyzerServiceCompareReportsArgs) ReadField1(ctx context.Context, iprot thrift.TProtocol) error {
+	p.Request = &CompareReportsRequest{}
+	if err := p.Request.Read(ctx, iprot); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T error reading struct: ", p.Request), err)
+	}
+	return nil
+}
+
+func (p *AttestationFailureAnalyzerServiceCompareReportsArgs) Write(ctx context.Context, oprot thrift.TProtocol) error {
+	if err := oprot.WriteStructBegin(ctx, "CompareReports_args"); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
+	}
+	if p != nil {
+		if err := p.writeField1(ctx, oprot); err != nil {
+			return err
+		}
+	}
+	if err := oprot.WriteFieldStop(ctx); err != nil {
+		return thrift.PrependError("write field stop error: ", err)
+	}
+	if err := oprot.WriteStructEnd(ctx); err != nil {
+		return thrift.PrependError("write struct stop error: ", err)
+	}
+	return nil
+}
+
+func (p *AttestationFailureAnalyzerServiceCompareReportsArgs) writeField1(ctx context.Context, oprot thrift.TProtocol) (err error) {
+	if err := oprot.WriteFieldBegin(ctx, "request", thrift.STRUCT, 1); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T write field begin error 1:request: ", p), err)
+	}
+	if err := p.Request.Write(ctx, oprot); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T error writing struct: ", p.Request), err)
+	}
+	if err := oprot.WriteFieldEnd(ctx); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T write field end error 1:request: ", p), err)
+	}
+	return err
+}
+
+func (p *AttestationFailureAnalyzerServiceCompareReportsArgs) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("AttestationFailureAnalyzerServiceCompareReportsArgs(%+v)", *p)
+}
+
+// Attributes:
+//   - Success
+//   - InvalidRequest
+//   - PermissionDenied
+type AttestationFailureAnalyzerServiceCompareReportsResult struct {
+	Success          *CompareReportsResult_ `thrift:"success,0" db:"success" json:"success,omitempty"`
+	InvalidRequest   *InvalidRequest        `thrift:"invalidRequest,1" db:"invalidRequest" json:"invalidRequest,omitempty"`
+	PermissionDenied *PermissionDenied      `thrift:"permissionDenied,2" db:"permissionDenied" json:"permissionDenied,omitempty"`
+}
+
+func NewAttestationFailureAnalyzerServiceCompareReportsResult() *AttestationFailureAnalyzerServiceCompareReportsResult {
+	return &AttestationFailureAnalyzerServiceCompareReportsResult{}
+}
+
+var AttestationFailureAnalyzerServiceCompareReportsResult_Success_DEFAULT *CompareReportsResult_
+
+func (p *AttestationFailureAnalyzerServiceCompareReportsResult) GetSuccess() *CompareReportsResult_ {
+	if !p.IsSetSuccess() {
+		return AttestationFailureAnalyzerServiceCompareReportsResult_Success_DEFAULT
+	}
+	return p.Success
+}
+
+var AttestationFailureAnalyzerServiceCompareReportsResult_InvalidRequest_DEFAULT *InvalidRequest
+
+func (p *AttestationFailureAnalyzerServiceCompareReportsResult) GetInvalidRequest() *InvalidRequest {
+	if !p.IsSetInvalidRequest() {
+		return AttestationFailureAnalyzerServiceCompareReportsResult_InvalidRequest_DEFAULT
+	}
+	return p.InvalidRequest
+}
+
+var AttestationFailureAnalyzerServiceCompareReportsResult_PermissionDenied_DEFAULT *PermissionDenied
+
+func (p *AttestationFailureAnalyzerServiceCompareReportsResult) GetPermissionDenied() *PermissionDenied {
+	if !p.IsSetPermissionDenied() {
+		return AttestationFailureAnalyzerServiceCompareReportsResult_PermissionDenied_DEFAULT
+	}
+	return p.PermissionDenied
+}
+func (p *AttestationFailureAnalyzerServiceCompareReportsResult) IsSetSuccess() bool {
+	return p.Success != nil
+}
+
+func (p *AttestationFailureAnalyzerServiceCompareReportsResult) IsSetInvalidRequest() bool {
+	return p.InvalidRequest != nil
+}
+
+func (p *AttestationFailureAnalyzerServiceCompareReportsResult) IsSetPermissionDenied() bool {
+	return p.PermissionDenied != nil
+}
+
+func (p *AttestationFailureAnalyzerServiceCompareReportsResult) Read(ctx context.Context, iprot thrift.TProtocol) error {
+	if _, err := iprot.ReadStructBegin(ctx); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T read error: ", p), err)
+	}
+
+	for {
+		_, fieldTypeId, fieldId, err := iprot.ReadFieldBegin(ctx)
+		if err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T field %d read error: ", p, fieldId), err)
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+		switch fieldId {
+		case 0:
+			if fieldTypeId == thrift.STRUCT {
+				if err := p.ReadField0(ctx, iprot); err != nil {
+					return err
+				}
+			} else {
+				if err := iprot.Skip(ctx, fieldTypeId); err != nil {
+					return err
+				}
+			}
+		case 1:
+			if fieldTypeId == thrift.STRUCT {
+				if err := p.ReadField1(ctx, iprot); err != nil {
+					return err
+				}
+			} else {
+				if err := iprot.Skip(ctx, fieldTypeId); err != nil {
+					return err
+				}
+			}
+		case 2:
+			if fieldTypeId == thrift.STRUCT {
+				if err := p.ReadField2(ctx, iprot); err != nil {
+					return err
+				}
+			} else {
+				if err := iprot.Skip(ctx, fieldTypeId); err != nil {
+					return err
+				}
+			}
+		default:
+			if err := iprot.Skip(ctx, fieldTypeId); err != nil {
+				return err
+			}
+		}
+		if err := iprot.ReadFieldEnd(ctx); err != nil {
+			return err
+		}
+	}
+	if err := iprot.ReadStructEnd(ctx); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
+	}
+	return nil
+}
+
+func (p *AttestationFailureAnalyzerServiceCompareReportsResult) ReadField0(ctx context.Context, iprot thrift.TProtocol) error {
+	p.Success = &CompareReportsResult_{}
+	if err := p.Success.Read(ctx, iprot); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T error reading struct: ", p.Success), err)
+	}
+	return nil
+}
+
+func (p *AttestationFailureAnalyzerServiceCompareReportsResult) ReadField1(ctx context.Context, iprot thrift.TProtocol) error {
+	p.InvalidRequest = &InvalidRequest{}
+	if err := p.InvalidRequest.Read(ctx, iprot); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T error reading struct: ", p.InvalidRequest), err)
+	}
+	return nil
+}
+
+func (p *AttestationFailureAnalyzerServiceCompareReportsResult) ReadField2(ctx context.Context, iprot thrift.TProtocol) error {
+	p.PermissionDenied = &PermissionDenied{}
+	if err := p.PermissionDenied.Read(ctx, iprot); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T error reading struct: ", p.PermissionDenied), err)
+	}
+	return nil
+}
+
+func (p *AttestationFailureAnalyzerServiceCompareReportsResult) Write(ctx context.Context, oprot thrift.TProtocol) error {
+	if err := oprot.WriteStructBegin(ctx, "CompareReports_result"); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
+	}
+	if p != nil {
+		if err := p.writeField0(ctx, oprot); err != nil {
+			return err
+		}
+		if err := p.writeField1(ctx, oprot); err != nil {
+			return err
+		}
+		if err := p.writeField2(ctx, oprot); err != nil {
+			return err
+		}
+	}
+	if err := oprot.WriteFieldStop(ctx); err != nil {
+		return thrift.PrependError("write field stop error: ", err)
+	}
+	if err := oprot.WriteStructEnd(ctx); err != nil {
+		return thrift.PrependError("write struct stop error: ", err)
+	}
+	return nil
+}
+
+func (p *AttestationFailureAnalyzerServiceCompareReportsResult) writeField0(ctx context.Context, oprot thrift.TProtocol) (err error) {
+	if p.IsSetSuccess() {
+		if err := oprot.WriteFieldBegin(ctx, "success", thrift.STRUCT, 0); err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T write field begin error 0:success: ", p), err)
+		}
+		if err := p.Success.Write(ctx, oprot); err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T error writing struct: ", p.Success), err)
+		}
+		if err := oprot.WriteFieldEnd(ctx); err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T write field end error 0:success: ", p), err)
+		}
+	}
+	return err
+}
+
+func (p *AttestationFailureAnalyzerServiceCompareReportsResult) writeField1(ctx context.Context, oprot thrift.TProtocol) (err error) {
+	if p.IsSetInvalidRequest() {
+		if err := oprot.WriteFieldBegin(ctx, "invalidRequest", thrift.STRUCT, 1); err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T write field begin error 1:invalidRequest: ", p), err)
+		}
+		if err := p.InvalidRequest.Write(ctx, oprot); err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T error writing struct: ", p.InvalidRequest), err)
+		}
+		if err := oprot.WriteFieldEnd(ctx); err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T write field end error 1:invalidRequest: ", p), err)
+		}
+	}
+	return err
+}
+
+func (p *AttestationFailureAnalyzerServiceCompareReportsResult) writeField2(ctx context.Context, oprot thrift.TProtocol) (err error) {
+	if p.IsSetPermissionDenied() {
+		if err := oprot.WriteFieldBegin(ctx, "permissionDenied", thrift.STRUCT, 2); err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T write field begin error 2:permissionDenied: ", p), err)
+		}
+		if err := p.PermissionDenied.Write(ctx, oprot); err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T error writing struct: ", p.PermissionDenied), err)
+		}
+		if err := oprot.WriteFieldEnd(ctx); err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T write field end error 2:permissionDenied: ", p), err)
+		}
+	}
+	return err
+}
+
+func (p *AttestationFailureAnalyzerServiceCompareReportsResult) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("AttestationFailureAnalyzerServiceCompareReportsResult(%+v)", *p)
+}
+
+// Attributes:
+//   - Request
+type AttestationFailureAnalyzerServiceAggregateReportsArgs struct {
+	Request *AggregateReportsRequest `thrift:"request,1" db:"request" json:"request"`
+}
+
+func NewAttestationFailureAnalyzerServiceAggregateReportsArgs() *AttestationFailureAnalyzerServiceAggregateReportsArgs {
+	return &AttestationFailureAnalyzerServiceAggregateReportsArgs{}
+}
+
+var AttestationFailureAnalyzerServiceAggregateReportsArgs_Request_DEFAULT *AggregateReportsRequest
+
+func (p *AttestationFailureAnalyzerServiceAggregateReportsArgs) GetRequest() *AggregateReportsRequest {
+	if !p.IsSetRequest() {
+		return AttestationFailureAnalyzerServiceAggregateReportsArgs_Request_DEFAULT
+	}
+	return p.Request
+}
+func (p *AttestationFailureAnalyzerServiceAggregateReportsArgs) IsSetRequest() bool {
+	return p.Request != nil
+}
+
+func (p *AttestationFailureAnalyzerServiceAggregateReportsArgs) Read(ctx context.Context, iprot thrift.TProtocol) error {
+	if _, err := iprot.ReadStructBegin(ctx); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T read error: ", p), err)
+	}
+
+	for {
+		_, fieldTypeId, fieldId, err := iprot.ReadFieldBegin(ctx)
+		if err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T field %d read error: ", p, fieldId), err)
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+		switch fieldId {
+		case 1:
+			if fieldTypeId == thrift.STRUCT {
+				if err := p.ReadField1(ctx, iprot); err != nil {
+					return err
+				}
+			} else {
+				if err := iprot.Skip(ctx, fieldTypeId); err != nil {
+					return err
+				}
+			}
+		default:
+			if err := iprot.Skip(ctx, fieldTypeId); err != nil {
+				return err
+			}
+		}
+		if err := iprot.ReadFieldEnd(ctx); err != nil {
+			return err
+		}
+	}
+	if err := iprot.ReadStructEnd(ctx); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
+	}
+	return nil
+}
+
+func (p *AttestationFailureAnalyzerServiceAggregateReportsArgs) ReadField1(ctx context.Context, iprot thrift.TProtocol) error {
+	p.Request = &AggregateReportsRequest{}
+	if err := p.Request.Read(ctx, iprot); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T error reading struct: ", p.Request), err)
+	}
+	return nil
+}
+
+func (p *AttestationFailureAnalyzerServiceAggregateReportsArgs) Write(ctx context.Context, oprot thrift.TProtocol) error {
+	if err := oprot.WriteStructBegin(ctx, "AggregateReports_args"); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
+	}
+	if p != nil {
+		if err := p.writeField1(ctx, oprot); err != nil {
+			return err
+		}
+	}
+	if err := oprot.WriteFieldStop(ctx); err != nil {
+		return thrift.PrependError("write field stop error: ", err)
+	}
+	if err := oprot.WriteStructEnd(ctx); err != nil {
+		return thrift.PrependError("write struct stop error: ", err)
+	}
+	return nil
+}
+
+func (p *AttestationFailureAnalyzerServiceAggregateReportsArgs) writeField1(ctx context.Context, oprot thrift.TProtocol) (err error) {
+	if err := oprot.WriteFieldBegin(ctx, "request", thrift.STRUCT, 1); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T write field begin error 1:request: ", p), err)
+	}
+	if err := p.Request.Write(ctx, oprot); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T error writing struct: ", p.Request), err)
+	}
+	if err := oprot.WriteFieldEnd(ctx); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T write field end error 1:request: ", p), err)
+	}
+	return err
+}
+
+func (p *AttestationFailureAnalyzerServiceAggregateReportsArgs) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("AttestationFailureAnalyzerServiceAggregateReportsArgs(%+v)", *p)
+}
+
+// Attributes:
+//   - Success
+//   - InvalidRequest
+//   - PermissionDenied
+type AttestationFailureAnalyzerServiceAggregateReportsResult struct {
+	Success          *AggregateReportsResult_ `thrift:"success,0" db:"success" json:"success,omitempty"`
+	InvalidRequest   *InvalidRequest          `thrift:"invalidRequest,1" db:"invalidRequest" json:"invalidRequest,omitempty"`
+	PermissionDenied *PermissionDenied        `thrift:"permissionDenied,2" db:"permissionDenied" json:"permissionDenied,omitempty"`
+}
+
+func NewAttestationFailureAnalyzerServiceAggregateReportsResult() *AttestationFailureAnalyzerServiceAggregateReportsResult {
+	return &AttestationFailureAnalyzerServiceAggregateReportsResult{}
+}
+
+var AttestationFailureAnalyzerServiceAggregateReportsResult_Success_DEFAULT *AggregateReportsResult_
+
+func (p *AttestationFailureAnalyzerServiceAggregateReportsResult) GetSuccess() *AggregateReportsResult_ {
+	if !p.IsSetSuccess() {
+		return AttestationFailureAnalyzerServiceAggregateReportsResult_Success_DEFAULT
+	}
+	return p.Success
+}
+
+var AttestationFailureAnalyzerServiceAggregateReportsResult_InvalidRequest_DEFAULT *InvalidRequest
+
+func (p *AttestationFailureAnalyzerServiceAggregateReportsResult) GetInvalidRequest() *InvalidRequest {
+	if !p.IsSetInvalidRequest() {
+		return AttestationFailureAnalyzerServiceAggregateReportsResult_InvalidRequest_DEFAULT
+	}
+	return p.InvalidRequest
+}
+
+var AttestationFailureAnalyzerServiceAggregateReportsResult_PermissionDenied_DEFAULT *PermissionDenied
+
+func (p *AttestationFailureAnalyzerServiceAggregateReportsResult) GetPermissionDenied() *PermissionDenied {
+	if !p.IsSetPermissionDenied() {
+		return AttestationFailureAnalyzerServiceAggregateReportsResult_PermissionDenied_DEFAULT
+	}
+	return p.PermissionDenied
+}
+func (p *AttestationFailureAnalyzerServiceAggregateReportsResult) IsSetSuccess() bool {
+	return p.Success != nil
+}
+
+func (p *AttestationFailureAnalyzerServiceAggregateReportsResult) IsSetInvalidRequest() bool {
+	return p.InvalidRequest != nil
+}
+
+func (p *AttestationFailureAnalyzerServiceAggregateReportsResult) IsSetPermissionDenied() bool {
+	return p.PermissionDenied != nil
+}
+
+func (p *AttestationFailureAnalyzerServiceAggregateReportsResult) Read(ctx context.Context, iprot thrift.TProtocol) error {
+	if _, err := iprot.ReadStructBegin(ctx); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T read error: ", p), err)
+	}
+
+	for {
+		_, fieldTypeId, fieldId, err := iprot.ReadFieldBegin(ctx)
+		if err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T field %d read error: ", p, fieldId), err)
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+		switch fieldId {
+		case 0:
+			if fieldTypeId == thrift.STRUCT {
+				if err := p.ReadField0(ctx, iprot); err != nil {
+					return err
+				}
+			} else {
+				if err := iprot.Skip(ctx, fieldTypeId); err != nil {
+					return err
+				}
+			}
+		case 1:
+			if fieldTypeId == thrift.STRUCT {
+				if err := p.ReadField1(ctx, iprot); err != nil {
+					return err
+				}
+			} else {
+				if err := iprot.Skip(ctx, fieldTypeId); err != nil {
+					return err
+				}
+			}
+		case 2:
+			if fieldTypeId == thrift.STRUCT {
+				if err := p.ReadField2(ctx, iprot); err != nil {
+					return err
+				}
+			} else {
+				if err := iprot.Skip(ctx, fieldTypeId); err != nil {
+					return err
+				}
+			}
+		default:
+			if err := iprot.Skip(ctx, fieldTypeId); err != nil {
+				return err
+			}
+		}
+		if err := iprot.ReadFieldEnd(ctx); err != nil {
+			return err
+		}
+	}
+	if err := iprot.ReadStructEnd(ctx); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
+	}
+	return nil
+}
+
+func (p *AttestationFailureAnalyzerServiceAggregateReportsResult) ReadField0(ctx context.Context, iprot thrift.TProtocol) error {
+	p.Success = &AggregateReportsResult_{}
+	if err := p.Success.Read(ctx, iprot); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T error reading struct: ", p.Success), err)
+	}
+	return nil
+}
+
+func (p *AttestationFailureAnalyzerServiceAggregateReportsResult) ReadField1(ctx context.Context, iprot thrift.TProtocol) error {
+	p.InvalidRequest = &InvalidRequest{}
+	if err := p.InvalidRequest.Read(ctx, iprot); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T error reading struct: ", p.InvalidRequest), err)
+	}
+	return nil
+}
+
+func (p *AttestationFailureAnalyzerServiceAggregateReportsResult) ReadField2(ctx context.Context, iprot thrift.TProtocol) error {
+	p.PermissionDenied = &PermissionDenied{}
+	if err := p.PermissionDenied.Read(ctx, iprot); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T error reading struct: ", p.PermissionDenied), err)
+	}
+	return nil
+}
+
+func (p *AttestationFailureAnalyzerServiceAggregateReportsResult) Write(ctx context.Context, oprot thrift.TProtocol) error {
+	if err := oprot.WriteStructBegin(ctx, "AggregateReports_result"); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
+	}
+	if p != nil {
+		if err := p.writeField0(ctx, oprot); err != nil {
+			return err
+		}
+		if err := p.writeField1(ctx, oprot); err != nil {
+			return err
+		}
+		if err := p.writeField2(ctx, oprot); err != nil {
+			return err
+		}
+	}
+	if err := oprot.WriteFieldStop(ctx); err != nil {
+		return thrift.PrependError("write field stop error: ", err)
+	}
+	if err := oprot.WriteStructEnd(ctx); err != nil {
+		return thrift.PrependError("write struct stop error: ", err)
+	}
+	return nil
+}
+
+func (p *AttestationFailureAnalyzerServiceAggregateReportsResult) writeField0(ctx context.Context, oprot thrift.TProtocol) (err error) {
+	if p.IsSetSuccess() {
+		if err := oprot.WriteFieldBegin(ctx, "success", thrift.STRUCT, 0); err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T write field begin error 0:success: ", p), err)
+		}
+		if err := p.Success.Write(ctx, oprot); err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T error writing struct: ", p.Success), err)
+		}
+		if err := oprot.WriteFieldEnd(ctx); err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T write field end error 0:success: ", p), err)
+		}
+	}
+	return err
+}
+
+func (p *AttestationFailureAnalyzerServiceAggregateReportsResult) writeField1(ctx context.Context, oprot thrift.TProtocol) (err error) {
+	if p.IsSetInvalidRequest() {
+		if err := oprot.WriteFieldBegin(ctx, "invalidRequest", thrift.STRUCT, 1); err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T write field begin error 1:invalidRequest: ", p), err)
+		}
+		if err := p.InvalidRequest.Write(ctx, oprot); err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T error writing struct: ", p.InvalidRequest), err)
+		}
+		if err := oprot.WriteFieldEnd(ctx); err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T write field end error 1:invalidRequest: ", p), err)
+		}
+	}
+	return err
+}
+
+func (p *AttestationFailureAnalyzerServiceAggregateReportsResult) writeField2(ctx context.Context, oprot thrift.TProtocol) (err error) {
+	if p.IsSetPermissionDenied() {
+		if err := oprot.WriteFieldBegin(ctx, "permissionDenied", thrift.STRUCT, 2); err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T write field begin error 2:permissionDenied: ", p), err)
+		}
+		if err := p.PermissionDenied.Write(ctx, oprot); err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T error writing struct: ", p.PermissionDenied), err)
+		}
+		if err := oprot.WriteFieldEnd(ctx); err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T write field end error 2:permissionDenied: ", p), err)
+		}
+	}
+	return err
+}
+
+func (p *AttestationFailureAnalyzerServiceAggregateReportsResult) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("AttestationFailureAnalyzerServiceAggregateReportsResult(%+v)", *p)
+}
+
+// Attributes:
+//   - Request
+type AttestationFailureAnalyzerServiceNegotiateImageUploadArgs struct {
+	Request *NegotiateImageUploadRequest `thrift:"request,1" db:"request" json:"request"`
+}
+
+func NewAttestationFailureAnalyzerServiceNegotiateImageUploadArgs() *AttestationFailureAnalyzerServiceNegotiateImageUploadArgs {
+	return &AttestationFailureAnalyzerServiceNegotiateImageUploadArgs{}
+}
+
+var AttestationFailureAnalyzerServiceNegotiateImageUploadArgs_Request_DEFAULT *NegotiateImageUploadRequest
+
+func (p *AttestationFailureAnalyzerServiceNegotiateImageUploadArgs) GetRequest() *NegotiateImageUploadRequest {
+	if !p.IsSetRequest() {
+		return AttestationFailureAnalyzerServiceNegotiateImageUploadArgs_Request_DEFAULT
+	}
+	return p.Request
+}
+func (p *AttestationFailureAnalyzerServiceNegotiateImageUploadArgs) IsSetRequest() bool {
+	return p.Request != nil
+}
+
+func (p *AttestationFailureAnalyzerServiceNegotiateImageUploadArgs) Read(ctx context.Context, iprot thrift.TProtocol) error {
+	if _, err := iprot.ReadStructBegin(ctx); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T read error: ", p), err)
+	}
+
+	for {
+		_, fieldTypeId, fieldId, err := iprot.ReadFieldBegin(ctx)
+		if err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T field %d read error: ", p, fieldId), err)
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+		switch fieldId {
+		case 1:
+			if fieldTypeId == thrift.STRUCT {
+				if err := p.ReadField1(ctx, iprot); err != nil {
+					return err
+				}
+			} else {
+				if err := iprot.Skip(ctx, fieldTypeId); err != nil {
+					return err
+				}
+			}
+		default:
+			if err := iprot.Skip(ctx, fieldTypeId); err != nil {
+				return err
+			}
+		}
+		if err := iprot.ReadFieldEnd(ctx); err != nil {
+			return err
+		}
+	}
+	if err := iprot.ReadStructEnd(ctx); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
+	}
+	return nil
+}
+
+func (p *AttestationFailureAnalyzerServiceNegotiateImageUploadArgs) ReadField1(ctx context.Context, iprot thrift.TProtocol) error {
+	p.Request = &NegotiateImageUploadRequest{}
+	if err := p.Request.Read(ctx, iprot); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T error reading struct: ", p.Request), err)
+	}
+	return nil
+}
+
+func (p *AttestationFailureAnalyzerServiceNegotiateImageUploadArgs) Write(ctx context.Context, oprot thrift.TProtocol) error {
+	if err := oprot.WriteStructBegin(ctx, "NegotiateImageUpload_args"); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
+	}
+	if p != nil {
+		if err := p.writeField1(ctx, oprot); err != nil {
+			return err
+		}
+	}
+	if err := oprot.WriteFieldStop(ctx); err != nil {
+		return thrift.PrependError("write field stop error: ", err)
+	}
+	if err := oprot.WriteStructEnd(ctx); err != nil {
+		return thrift.PrependError("write struct stop error: ", err)
+	}
+	return nil
+}
+
+func (p *AttestationFailureAnalyzerServiceNegotiateImageUploadArgs) writeField1(ctx context.Context, oprot thrift.TProtocol) (err error) {
+	if err := oprot.WriteFieldBegin(ctx, "request", thrift.STRUCT, 1); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T write field begin error 1:request: ", p), err)
+	}
+	if err := p.Request.Write(ctx, oprot); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T error writing struct: ", p.Request), err)
+	}
+	if err := oprot.WriteFieldEnd(ctx); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T write field end error 1:request: ", p), err)
+	}
+	return err
+}
+
+func (p *AttestationFailureAnalyzerServiceNegotiateImageUploadArgs) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("AttestationFailureAnalyzerServiceNegotiateImageUploadArgs(%+v)", *p)
+}
+
+// Attributes:
+//   - Success
+//   - InvalidRequest
+//   - PermissionDenied
+type AttestationFailureAnalyzerServiceNegotiateImageUploadResult struct {
+	Success          *NegotiateImageUploadResult_ `thrift:"success,0" db:"success" json:"success,omitempty"`
+	InvalidRequest   *InvalidRequest              `thrift:"invalidRequest,1" db:"invalidRequest" json:"invalidRequest,omitempty"`
+	PermissionDenied *PermissionDenied            `thrift:"permissionDenied,2" db:"permissionDenied" json:"permissionDenied,omitempty"`
+}
+
+func NewAttestationFailureAnalyzerServiceNegotiateImageUploadResult() *AttestationFailureAnalyzerServiceNegotiateImageUploadResult {
+	return &AttestationFailureAnalyzerServiceNegotiateImageUploadResult{}
+}
+
+var AttestationFailureAnalyzerServiceNegotiateImageUploadResult_Success_DEFAULT *NegotiateImageUploadResult_
+
+func (p *AttestationFailureAnalyzerServiceNegotiateImageUploadResult) GetSuccess() *NegotiateImageUploadResult_ {
+	if !p.IsSetSuccess() {
+		return AttestationFailureAnalyzerServiceNegotiateImageUploadResult_Success_DEFAULT
+	}
+	return p.Success
+}
+
+var AttestationFailureAnalyzerServiceNegotiateImageUploadResult_InvalidRequest_DEFAULT *InvalidRequest
+
+func (p *AttestationFailureAnalyzerServiceNegotiateImageUploadResult) GetInvalidRequest() *InvalidRequest {
+	if !p.IsSetInvalidRequest() {
+		return AttestationFailureAnalyzerServiceNegotiateImageUploadResult_InvalidRequest_DEFAULT
+	}
+	return p.InvalidRequest
+}
+
+var AttestationFailureAnalyzerServiceNegotiateImageUploadResult_PermissionDenied_DEFAULT *PermissionDenied
+
+func (p *AttestationFailureAnalyzerServiceNegotiateImageUploadResult) GetPermissionDenied() *PermissionDenied {
+	if !p.IsSetPermissionDenied() {
+		return AttestationFailureAnalyzerServiceNegotiateImageUploadResult_PermissionDenied_DEFAULT
+	}
+	return p.PermissionDenied
+}
+func (p *AttestationFailureAnalyzerServiceNegotiateImageUploadResult) IsSetSuccess() bool {
+	return p.Success != nil
+}
+
+func (p *AttestationFailureAnalyzerServiceNegotiateImageUploadResult) IsSetInvalidRequest() bool {
+	return p.InvalidRequest != nil
+}
+
+func (p *AttestationFailureAnalyzerServiceNegotiateImageUploadResult) IsSetPermissionDenied() bool {
+	return p.PermissionDenied != nil
+}
+
+func (p *AttestationFailureAnalyzerServiceNegotiateImageUploadResult) Read(ctx context.Context, iprot thrift.TProtocol) error {
+	if _, err := iprot.ReadStructBegin(ctx); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T read error: ", p), err)
+	}
+
+	for {
+		_, fieldTypeId, fieldId, err := iprot.ReadFieldBegin(ctx)
+		if err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T field %d read error: ", p, fieldId), err)
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+		switch fieldId {
+		case 0:
+			if fieldTypeId == thrift.STRUCT {
+				if err := p.ReadField0(ctx, iprot); err != nil {
+					return err
+				}
+			} else {
+				if err := iprot.Skip(ctx, fieldTypeId); err != nil {
+					return err
+				}
+			}
+		case 1:
+			if fieldTypeId == thrift.STRUCT {
+				if err := p.ReadField1(ctx, iprot); err != nil {
+					return err
+				}
+			} else {
+				if err := iprot.Skip(ctx, fieldTypeId); err != nil {
+					return err
+				}
+			}
+		case 2:
+			if fieldTypeId == thrift.STRUCT {
+				if err := p.ReadField2(ctx, iprot); err != nil {
+					return err
+				}
+			} else {
+				if err := iprot.Skip(ctx, fieldTypeId); err != nil {
+					return err
+				}
+			}
+		default:
+			if err := iprot.Skip(ctx, fieldTypeId); err != nil {
+				return err
+			}
+		}
+		if err := iprot.ReadFieldEnd(ctx); err != nil {
+			return err
+		}
+	}
+	if err := iprot.ReadStructEnd(ctx); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
+	}
+	return nil
+}
+
+func (p *AttestationFailureAnalyzerServiceNegotiateImageUploadResult) ReadField0(ctx context.Context, iprot thrift.TProtocol) error {
+	p.Success = &NegotiateImageUploadResult_{}
+	if err := p.Success.Read(ctx, iprot); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T error reading struct: ", p.Success), err)
+	}
+	return nil
+}
+
+func (p *AttestationFailureAnalyzerServiceNegotiateImageUploadResult) ReadField1(ctx context.Context, iprot thrift.TProtocol) error {
+	p.InvalidRequest = &InvalidRequest{}
+	if err := p.InvalidRequest.Read(ctx, iprot); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T error reading struct: ", p.InvalidRequest), err)
+	}
+	return nil
+}
+
+func (p *AttestationFailureAnalyzerServiceNegotiateImageUploadResult) ReadField2(ctx context.Context, iprot thrift.TProtocol) error {
+	p.PermissionDenied = &PermissionDenied{}
+	if err := p.PermissionDenied.Read(ctx, iprot); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T error reading struct: ", p.PermissionDenied), err)
+	}
+	return nil
+}
+
+func (p *AttestationFailureAnalyzerServiceNegotiateImageUploadResult) Write(ctx context.Context, oprot thrift.TProtocol) error {
+	if err := oprot.WriteStructBegin(ctx, "NegotiateImageUpload_result"); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
+	}
+	if p != nil {
+		if err := p.writeField0(ctx, oprot); err != nil {
+			return err
+		}
+		if err := p.writeField1(ctx, oprot); err != nil {
+			return err
+		}
+		if err := p.writeField2(ctx, oprot); err != nil {
+			return err
+		}
+	}
+	if err := oprot.WriteFieldStop(ctx); err != nil {
+		return thrift.PrependError("write field stop error: ", err)
+	}
+	if err := oprot.WriteStructEnd(ctx); err != nil {
+		return thrift.PrependError("write struct stop error: ", err)
+	}
+	return nil
+}
+
+func (p *AttestationFailureAnalyzerServiceNegotiateImageUploadResult) writeField0(ctx context.Context, oprot thrift.TProtocol) (err error) {
+	if p.IsSetSuccess() {
+		if err := oprot.WriteFieldBegin(ctx, "success", thrift.STRUCT, 0); err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T write field begin error 0:success: ", p), err)
+		}
+		if err := p.Success.Write(ctx, oprot); err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T error writing struct: ", p.Success), err)
+		}
+		if err := oprot.WriteFieldEnd(ctx); err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T write field end error 0:success: ", p), err)
+		}
+	}
+	return err
+}
+
+func (p *AttestationFailureAnalyzerServiceNegotiateImageUploadResult) writeField1(ctx context.Context, oprot thrift.TProtocol) (err error) {
+	if p.IsSetInvalidRequest() {
+		if err := oprot.WriteFieldBegin(ctx, "invalidRequest", thrift.STRUCT, 1); err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T write field begin error 1:invalidRequest: ", p), err)
+		}
+		if err := p.InvalidRequest.Write(ctx, oprot); err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T error writing struct: ", p.InvalidRequest), err)
+		}
+		if err := oprot.WriteFieldEnd(ctx); err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T write field end error 1:invalidRequest: ", p), err)
+		}
+	}
+	return err
+}
+
+func (p *AttestationFailureAnalyzerServiceNegotiateImageUploadResult) writeField2(ctx context.Context, oprot thrift.TProtocol) (err error) {
+	if p.IsSetPermissionDenied() {
+		if err := oprot.WriteFieldBegin(ctx, "permissionDenied", thrift.STRUCT, 2); err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T write field begin error 2:permissionDenied: ", p), err)
+		}
+		if err := p.PermissionDenied.Write(ctx, oprot); err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T error writing struct: ", p.PermissionDenied), err)
+		}
+		if err := oprot.WriteFieldEnd(ctx); err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T write field end error 2:permissionDenied: ", p), err)
+		}
+	}
+	return err
+}
+
+func (p *AttestationFailureAnalyzerServiceNegotiateImageUploadResult) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("AttestationFailureAnalyzerServiceNegotiateImageUploadResult(%+v)", *p)
+}
+
+// Attributes:
+//   - Request
+type AttestationFailureAnalyzerServiceInsertOriginalFirmwareArgs struct {
+	Request *InsertOriginalFirmwareRequest `thrift:"request,1" db:"request" json:"request"`
+}
+
+func NewAttestationFailureAnalyzerServiceInsertOriginalFirmwareArgs() *AttestationFailureAnalyzerServiceInsertOriginalFirmwareArgs {
+	return &AttestationFailureAnalyzerServiceInsertOriginalFirmwareArgs{}
+}
+
+var AttestationFailureAnalyzerServiceInsertOriginalFirmwareArgs_Request_DEFAULT *InsertOriginalFirmwareRequest
+
+func (p *AttestationFailureAnalyzerServiceInsertOriginalFirmwareArgs) GetRequest() *InsertOriginalFirmwareRequest {
+	if !p.IsSetRequest() {
+		return AttestationFailureAnalyzerServiceInsertOriginalFirmwareArgs_Request_DEFAULT
+	}
+	return p.Request
+}
+func (p *AttestationFailureAnalyzerServiceInsertOriginalFirmwareArgs) IsSetRequest() bool {
+	return p.Request != nil
+}
+
+func (p *AttestationFailureAnalyzerServiceInsertOriginalFirmwareArgs) Read(ctx context.Context, iprot thrift.TProtocol) error {
+	if _, err := iprot.ReadStructBegin(ctx); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T read error: ", p), err)
+	}
+
+	for {
+		_, fieldTypeId, fieldId, err := iprot.ReadFieldBegin(ctx)
+		if err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T field %d read error: ", p, fieldId), err)
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+		switch fieldId {
+		case 1:
+			if fieldTypeId == thrift.STRUCT {
+				if err := p.ReadField1(ctx, iprot); err != nil {
+					return err
+				}
+			} else {
+				if err := iprot.Skip(ctx, fieldTypeId); err != nil {
+					return err
+				}
+			}
+		default:
+			if err := iprot.Skip(ctx, fieldTypeId); err != nil {
+				return err
+			}
+		}
+		if err := iprot.ReadFieldEnd(ctx); err != nil {
+			return err
+		}
+	}
+	if err := iprot.ReadStructEnd(ctx); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
+	}
+	return nil
+}
+
+func (p *AttestationFailureAnalyzerServiceInsertOriginalFirmwareArgs) ReadField1(ctx context.Context, iprot thrift.TProtocol) error {
+	p.Request = &InsertOriginalFirmwareRequest{}
+	if err := p.Request.Read(ctx, iprot); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T error reading struct: ", p.Request), err)
+	}
+	return nil
+}
+
+func (p *AttestationFailureAnalyzerServiceInsertOriginalFirmwareArgs) Write(ctx context.Context, oprot thrift.TProtocol) error {
+	if err := oprot.WriteStructBegin(ctx, "InsertOriginalFirmware_args"); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
+	}
+	if p != nil {
+		if err := p.writeField1(ctx, oprot); err != nil {
+			return err
+		}
+	}
+	if err := oprot.WriteFieldStop(ctx); err != nil {
+		return thrift.PrependError("write field stop error: ", err)
+	}
+	if err := oprot.WriteStructEnd(ctx); err != nil {
+		return thrift.PrependError("write struct stop error: ", err)
+	}
+	return nil
+}
+
+func (p *AttestationFailureAnalyzerServiceInsertOriginalFirmwareArgs) writeField1(ctx context.Context, oprot thrift.TProtocol) (err error) {
+	if err := oprot.WriteFieldBegin(ctx, "request", thrift.STRUCT, 1); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T write field begin error 1:request: ", p), err)
+	}
+	if err := p.Request.Write(ctx, oprot); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T error writing struct: ", p.Request), err)
+	}
+	if err := oprot.WriteFieldEnd(ctx); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T write field end error 1:request: ", p), err)
+	}
+	return err
+}
+
+func (p *AttestationFailureAnalyzerServiceInsertOriginalFirmwareArgs) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("AttestationFailureAnalyzerServiceInsertOriginalFirmwareArgs(%+v)", *p)
+}
+
+// Attributes:
+//   - Success
+//   - PermissionDenied
+//   - InvalidRequest
+type AttestationFailureAnalyzerServiceInsertOriginalFirmwareResult struct {
+	Success          *InsertOriginalFirmwareResult_ `thrift:"success,0" db:"success" json:"success,omitempty"`
+	PermissionDenied *PermissionDenied              `thrift:"permissionDenied,1" db:"permissionDenied" json:"permissionDenied,omitempty"`
+	InvalidRequest   *InvalidRequest                `thrift:"invalidRequest,2" db:"invalidRequest" json:"invalidRequest,omitempty"`
+}
+
+func NewAttestationFailureAnalyzerServiceInsertOriginalFirmwareResult() *AttestationFailureAnalyzerServiceInsertOriginalFirmwareResult {
+	return &AttestationFailureAnalyzerServiceInsertOriginalFirmwareResult{}
+}
+
+var AttestationFailureAnalyzerServiceInsertOriginalFirmwareResult_Success_DEFAULT *InsertOriginalFirmwareResult_
+
+func (p *AttestationFailureAnalyzerServiceInsertOriginalFirmwareResult) GetSuccess() *InsertOriginalFirmwareResult_ {
+	if !p.IsSetSuccess() {
+		return AttestationFailureAnalyzerServiceInsertOriginalFirmwareResult_Success_DEFAULT
+	}
+	return p.Success
+}
+
+var AttestationFailureAnalyzerServiceInsertOriginalFirmwareResult_PermissionDenied_DEFAULT *PermissionDenied
+
+func (p *AttestationFailureAnalyzerServiceInsertOriginalFirmwareResult) GetPermissionDenied() *PermissionDenied {
+	if !p.IsSetPermissionDenied() {
+		return AttestationFailureAnalyzerServiceInsertOriginalFirmwareResult_PermissionDenied_DEFAULT
+	}
+	return p.PermissionDenied
+}
+
+var AttestationFailureAnalyzerServiceInsertOriginalFirmwareResult_InvalidRequest_DEFAULT *InvalidRequest
+
+func (p *AttestationFailureAnalyzerServiceInsertOriginalFirmwareResult) GetInvalidRequest() *InvalidRequest {
+	if !p.IsSetInvalidRequest() {
+		return AttestationFailureAnalyzerServiceInsertOriginalFirmwareResult_InvalidRequest_DEFAULT
+	}
+	return p.InvalidRequest
+}
+func (p *AttestationFailureAnalyzerServiceInsertOriginalFirmwareResult) IsSetSuccess() bool {
+	return p.Success != nil
+}
+
+func (p *AttestationFailureAnalyzerServiceInsertOriginalFirmwareResult) IsSetPermissionDenied() bool {
+	return p.PermissionDenied != nil
+}
+
+func (p *AttestationFailureAnalyzerServiceInsertOriginalFirmwareResult) IsSetInvalidRequest() bool {
+	return p.InvalidRequest != nil
+}
+
+func (p *AttestationFailureAnalyzerServiceInsertOriginalFirmwareResult) Read(ctx context.Context, iprot thrift.TProtocol) error {
+	if _, err := iprot.ReadStructBegin(ctx); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T read error: ", p), err)
+	}
+
+	for {
+		_, fieldTypeId, fieldId, err := iprot.ReadFieldBegin(ctx)
+		if err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T field %d read error: ", p, fieldId), err)
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+		switch fieldId {
+		case 0:
+			if fieldTypeId == thrift.STRUCT {
+				if err := p.ReadField0(ctx, iprot); err != nil {
+					return err
+				}
+			} else {
+				if err := iprot.Skip(ctx, fieldTypeId); err != nil {
+					return err
+				}
+			}
+		case 1:
+			if fieldTypeId == thrift.STRUCT {
+				if err := p.ReadField1(ctx, iprot); err != nil {
+					return err
+				}
+			} else {
+				if err := iprot.Skip(ctx, fieldTypeId); err != nil {
+					return err
+				}
+			}
+		case 2:
+			if fieldTypeId == thrift.STRUCT {
+				if err := p.ReadField2(ctx, iprot); err != nil {
+					return err
+				}
+			} else {
+				if err := iprot.Skip(ctx, fieldTypeId); err != nil {
+					return err
+				}
+			}
+		default:
+			if err := iprot.Skip(ctx, fieldTypeId); err != nil {
+				return err
+			}
+		}
+		if err := iprot.ReadFieldEnd(ctx); err != nil {
+			return err
+		}
+	}
+	if err := iprot.ReadStructEnd(ctx); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
+	}
+	return nil
+}
 
-type AttestationFailureAnalyzerServiceProcessor struct {
-	processorMap map[string]thrift.TProcessorFunction
-	handler      AttestationFailureAnalyzerService
+func (p *AttestationFailureAnalyzerServiceInsertOriginalFirmwareResult) ReadField0(ctx context.Context, iprot thrift.TProtocol) error {
+	p.Success = &InsertOriginalFirmwareResult_{}
+	if err := p.Success.Read(ctx, iprot); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T error reading struct: ", p.Success), err)
+	}
+	return nil
 }
 
-func (p *AttestationFailureAnalyzerServiceProcessor) AddToProcessorMap(key string, processor thrift.TProcessorFunction) {
-	p.processorMap[key] = processor
+func (p *AttestationFailureAnalyzerServiceInsertOriginalFirmwareResult) ReadField1(ctx context.Context, iprot thrift.TProtocol) error {
+	p.PermissionDenied = &PermissionDenied{}
+	if err := p.PermissionDenied.Read(ctx, iprot); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T error reading struct: ", p.PermissionDenied), err)
+	}
+	return nil
 }
 
-func (p *AttestationFailureAnalyzerServiceProcessor) GetProcessorFunction(key string) (processor thrift.TProcessorFunction, ok bool) {
-	processor, ok = p.processorMap[key]
-	return processor, ok
+func (p *AttestationFailureAnalyzerServiceInsertOriginalFirmwareResult) ReadField2(ctx context.Context, iprot thrift.TProtocol) error {
+	p.InvalidRequest = &InvalidRequest{}
+	if err := p.InvalidRequest.Read(ctx, iprot); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T error reading struct: ", p.InvalidRequest), err)
+	}
+	return nil
 }
 
-func (p *AttestationFailureAnalyzerServiceProcessor) ProcessorMap() map[string]thrift.TProcessorFunction {
-	return p.processorMap
+func (p *AttestationFailureAnalyzerServiceInsertOriginalFirmwareResult) Write(ctx context.Context, oprot thrift.TProtocol) error {
+	if err := oprot.WriteStructBegin(ctx, "InsertOriginalFirmware_result"); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
+	}
+	if p != nil {
+		if err := p.writeField0(ctx, oprot); err != nil {
+			return err
+		}
+		if err := p.writeField1(ctx, oprot); err != nil {
+			return err
+		}
+		if err := p.writeField2(ctx, oprot); err != nil {
+			return err
+		}
+	}
+	if err := oprot.WriteFieldStop(ctx); err != nil {
+		return thrift.PrependError("write field stop error: ", err)
+	}
+	if err := oprot.WriteStructEnd(ctx); err != nil {
+		return thrift.PrependError("write struct stop error: ", err)
+	}
+	return nil
 }
 
-func NewAttestationFailureAnalyzerServiceProcessor(handler AttestationFailureAnalyzerService) *AttestationFailureAnalyzerServiceProcessor {
+func (p *AttestationFailureAnalyzerServiceInsertOriginalFirmwareResult) writeField0(ctx context.Context, oprot thrift.TProtocol) (err error) {
+	if p.IsSetSuccess() {
+		if err := oprot.WriteFieldBegin(ctx, "success", thrift.STRUCT, 0); err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T write field begin error 0:success: ", p), err)
+		}
+		if err := p.Success.Write(ctx, oprot); err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T error writing struct: ", p.Success), err)
+		}
+		if err := oprot.WriteFieldEnd(ctx); err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T write field end error 0:success: ", p), err)
+		}
+	}
+	return err
+}
 
-	self28 := &AttestationFailureAnalyzerServiceProcessor{handler: handler, processorMap: make(map[string]thrift.TProcessorFunction)}
-	self28.processorMap["SearchFirmware"] = &attestationFailureAnalyzerServiceProcessorSearchFirmware{handler: handler}
-	self28.processorMap["SearchReport"] = &attestationFailureAnalyzerServiceProcessorSearchReport{handler: handler}
-	self28.processorMap["Analyze"] = &attestationFailureAnalyzerServiceProcessorAnalyze{handler: handler}
-	self28.processorMap["CheckFirmwareVersion"] = &attestationFailureAnalyzerServiceProcessorCheckFirmwareVersion{handler: handler}
-	return self28
+func (p *AttestationFailureAnalyzerServiceInsertOriginalFirmwareResult) writeField1(ctx context.Context, oprot thrift.TProtocol) (err error) {
+	if p.IsSetPermissionDenied() {
+		if err := oprot.WriteFieldBegin(ctx, "permissionDenied", thrift.STRUCT, 1); err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T write field begin error 1:permissionDenied: ", p), err)
+		}
+		if err := p.PermissionDenied.Write(ctx, oprot); err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T error writing struct: ", p.PermissionDenied), err)
+		}
+		if err := oprot.WriteFieldEnd(ctx); err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T write field end error 1:permissionDenied: ", p), err)
+		}
+	}
+	return err
 }
 
-func (p *AttestationFailureAnalyzerServiceProcessor) Process(ctx context.Context, iprot, oprot thrift.TProtocol) (success bool, err thrift.TException) {
-	name, _, seqId, err2 := iprot.ReadMessageBegin(ctx)
-	if err2 != nil {
-		return false, thrift.WrapTException(err2)
+func (p *AttestationFailureAnalyzerServiceInsertOriginalFirmwareResult) writeField2(ctx context.Context, oprot thrift.TProtocol) (err error) {
+	if p.IsSetInvalidRequest() {
+		if err := oprot.WriteFieldBegin(ctx, "invalidRequest", thrift.STRUCT, 2); err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T write field begin error 2:invalidRequest: ", p), err)
+		}
+		if err := p.InvalidRequest.Write(ctx, oprot); err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T error writing struct: ", p.InvalidRequest), err)
+		}
+		if err := oprot.WriteFieldEnd(ctx); err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T write field end error 2:invalidRequest: ", p), err)
+		}
 	}
-	if processor, ok := p.GetProcessorFunction(name); ok {
-		return processor.Process(ctx, seqId, iprot, oprot)
+	return err
+}
+
+func (p *AttestationFailureAnalyzerServiceInsertOriginalFirmwareResult) String() string {
+	if p == nil {
+		return "<nil>"
 	}
-	iprot.Skip(ctx, thrift.STRUCT)
-	iprot.ReadMessageEnd(ctx)
-	x29 := thrift.NewTApplicationException(thrift.UNKNOWN_METHOD, "Unknown function "+name)
-	oprot.WriteMessageBegin(ctx, name, thrift.EXCEPTION, seqId)
-	x29.Write(ctx, oprot)
-	oprot.WriteMessageEnd(ctx)
-	oprot.Flush(ctx)
-	return false, x29
+	return fmt.Sprintf("AttestationFailureAnalyzerServiceInsertOriginalFirmwareResult(%+v)", *p)
+}
 
+// Attributes:
+//   - Request
+type AttestationFailureAnalyzerServiceUpdateOriginalFirmwareArgs struct {
+	Request *UpdateOriginalFirmwareRequest `thrift:"request,1" db:"request" json:"request"`
 }
 
-type attestationFailureAnalyzerServiceProcessorSearchFirmware struct {
-	handler AttestationFailureAnalyzerService
+func NewAttestationFailureAnalyzerServiceUpdateOriginalFirmwareArgs() *AttestationFailureAnalyzerServiceUpdateOriginalFirmwareArgs {
+	return &AttestationFailureAnalyzerServiceUpdateOriginalFirmwareArgs{}
 }
 
-func (p *attestationFailureAnalyzerServiceProcessorSearchFirmware) Process(ctx context.Context, seqId int32, iprot, oprot thrift.TProtocol) (success bool, err thrift.TException) {
-	args := AttestationFailureAnalyzerServiceSearchFirmwareArgs{}
-	var err2 error
-	if err2 = args.Read(ctx, iprot); err2 != nil {
-		iprot.ReadMessageEnd(ctx)
-		x := thrift.NewTApplicationException(thrift.PROTOCOL_ERROR, err2.Error())
-		oprot.WriteMessageBegin(ctx, "SearchFirmware", thrift.EXCEPTION, seqId)
-		x.Write(ctx, oprot)
-		oprot.WriteMessageEnd(ctx)
-		oprot.Flush(ctx)
-		return false, thrift.WrapTException(err2)
+var AttestationFailureAnalyzerServiceUpdateOriginalFirmwareArgs_Request_DEFAULT *UpdateOriginalFirmwareRequest
+
+func (p *AttestationFailureAnalyzerServiceUpdateOriginalFirmwareArgs) GetRequest() *UpdateOriginalFirmwareRequest {
+	if !p.IsSetRequest() {
+		return AttestationFailureAnalyzerServiceUpdateOriginalFirmwareArgs_Request_DEFAULT
 	}
-	iprot.ReadMessageEnd(ctx)
+	return p.Request
+}
+func (p *AttestationFailureAnalyzerServiceUpdateOriginalFirmwareArgs) IsSetRequest() bool {
+	return p.Request != nil
+}
 
-	tickerCancel := func() {}
-	// Start a goroutine to do server side connectivity check.
-	if thrift.ServerConnectivityCheckInterval > 0 {
-		var cancel context.CancelFunc
-		ctx, cancel = context.WithCancel(ctx)
-		defer cancel()
-		var tickerCtx context.Context
-		tickerCtx, tickerCancel = context.WithCancel(context.Background())
-		defer tickerCancel()
-		go func(ctx context.Context, cancel context.CancelFunc) {
-			ticker := time.NewTicker(thrift.ServerConnectivityCheckInterval)
-			defer ticker.Stop()
-			for {
-				select {
-				case <-ctx.Done():
-					return
-				case <-ticker.C:
-					if !iprot.Transport().IsOpen() {
-						cancel()
-						return
-					}
+func (p *AttestationFailureAnalyzerServiceUpdateOriginalFirmwareArgs) Read(ctx context.Context, iprot thrift.TProtocol) error {
+	if _, err := iprot.ReadStructBegin(ctx); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T read error: ", p), err)
+	}
+
+	for {
+		_, fieldTypeId, fieldId, err := iprot.ReadFieldBegin(ctx)
+		if err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T field %d read error: ", p, fieldId), err)
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+		switch fieldId {
+		case 1:
+			if fieldTypeId == thrift.STRUCT {
+				if err := p.ReadField1(ctx, iprot); err != nil {
+					return err
+				}
+			} else {
+				if err := iprot.Skip(ctx, fieldTypeId); err != nil {
+					return err
+				}
+			}
+		default:
+			if err := iprot.Skip(ctx, fieldTypeId); err != nil {
+				return err
+			}
+		}
+		if err := iprot.ReadFieldEnd(ctx); err != nil {
+			return err
+		}
+	}
+	if err := iprot.ReadStructEnd(ctx); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
+	}
+	return nil
+}
+
+func (p *AttestationFailureAnalyzerServiceUpdateOriginalFirmwareArgs) ReadField1(ctx context.Context, iprot thrift.TProtocol) error {
+	p.Request = &UpdateOriginalFirmwareRequest{}
+	if err := p.Request.Read(ctx, iprot); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T error reading struct: ", p.Request), err)
+	}
+	return nil
+}
+
+func (p *AttestationFailureAnalyzerServiceUpdateOriginalFirmwareArgs) Write(ctx context.Context, oprot thrift.TProtocol) error {
+	if err := oprot.WriteStructBegin(ctx, "UpdateOriginalFirmware_args"); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
+	}
+	if p != nil {
+		if err := p.writeField1(ctx, oprot); err != nil {
+			return err
+		}
+	}
+	if err := oprot.WriteFieldStop(ctx); err != nil {
+		return thrift.PrependError("write field stop error: ", err)
+	}
+	if err := oprot.WriteStructEnd(ctx); err != nil {
+		return thrift.PrependError("write struct stop error: ", err)
+	}
+	return nil
+}
+
+func (p *AttestationFailureAnalyzerServiceUpdateOriginalFirmwareArgs) writeField1(ctx context.Context, oprot thrift.TProtocol) (err error) {
+	if err := oprot.WriteFieldBegin(ctx, "request", thrift.STRUCT, 1); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T write field begin error 1:request: ", p), err)
+	}
+	if err := p.Request.Write(ctx, oprot); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T error writing struct: ", p.Request), err)
+	}
+	if err := oprot.WriteFieldEnd(ctx); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T write field end error 1:request: ", p), err)
+	}
+	return err
+}
+
+func (p *AttestationFailureAnalyzerServiceUpdateOriginalFirmwareArgs) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("AttestationFailureAnalyzerServiceUpdateOriginalFirmwareArgs(%+v)", *p)
+}
+
+// Attributes:
+//   - PermissionDenied
+//   - InvalidRequest
+type AttestationFailureAnalyzerServiceUpdateOriginalFirmwareResult struct {
+	PermissionDenied *PermissionDenied `thrift:"permissionDenied,1" db:"permissionDenied" json:"permissionDenied,omitempty"`
+	InvalidRequest   *InvalidRequest   `thrift:"invalidRequest,2" db:"invalidRequest" json:"invalidRequest,omitempty"`
+}
+
+func NewAttestationFailureAnalyzerServiceUpdateOriginalFirmwareResult() *AttestationFailureAnalyzerServiceUpdateOriginalFirmwareResult {
+	return &AttestationFailureAnalyzerServiceUpdateOriginalFirmwareResult{}
+}
+
+var AttestationFailureAnalyzerServiceUpdateOriginalFirmwareResult_PermissionDenied_DEFAULT *PermissionDenied
+
+func (p *AttestationFailureAnalyzerServiceUpdateOriginalFirmwareResult) GetPermissionDenied() *PermissionDenied {
+	if !p.IsSetPermissionDenied() {
+		return AttestationFailureAnalyzerServiceUpdateOriginalFirmwareResult_PermissionDenied_DEFAULT
+	}
+	return p.PermissionDenied
+}
+
+var AttestationFailureAnalyzerServiceUpdateOriginalFirmwareResult_InvalidRequest_DEFAULT *InvalidRequest
+
+func (p *AttestationFailureAnalyzerServiceUpdateOriginalFirmwareResult) GetInvalidRequest() *InvalidRequest {
+	if !p.IsSetInvalidRequest() {
+		return AttestationFailureAnalyzerServiceUpdateOriginalFirmwareResult_InvalidRequest_DEFAULT
+	}
+	return p.InvalidRequest
+}
+func (p *AttestationFailureAnalyzerServiceUpdateOriginalFirmwareResult) IsSetPermissionDenied() bool {
+	return p.PermissionDenied != nil
+}
+
+func (p *AttestationFailureAnalyzerServiceUpdateOriginalFirmwareResult) IsSetInvalidRequest() bool {
+	return p.InvalidRequest != nil
+}
+
+func (p *AttestationFailureAnalyzerServiceUpdateOriginalFirmwareResult) Read(ctx context.Context, iprot thrift.TProtocol) error {
+	if _, err := iprot.ReadStructBegin(ctx); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T read error: ", p), err)
+	}
+
+	for {
+		_, fieldTypeId, fieldId, err := iprot.ReadFieldBegin(ctx)
+		if err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T field %d read error: ", p, fieldId), err)
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+		switch fieldId {
+		case 1:
+			if fieldTypeId == thrift.STRUCT {
+				if err := p.ReadField1(ctx, iprot); err != nil {
+					return err
+				}
+			} else {
+				if err := iprot.Skip(ctx, fieldTypeId); err != nil {
+					return err
+				}
+			}
+		case 2:
+			if fieldTypeId == thrift.STRUCT {
+				if err := p.ReadField2(ctx, iprot); err != nil {
+					return err
+				}
+			} else {
+				if err := iprot.Skip(ctx, fieldTypeId); err != nil {
+					return err
 				}
 			}
-		}(tickerCtx, cancel)
-	}
-
-	result := AttestationFailureAnalyzerServiceSearchFirmwareResult{}
-	var retval *SearchFirmwareResult_
-	if retval, err2 = p.handler.SearchFirmware(ctx, args.Request); err2 != nil {
-		tickerCancel()
-		if err2 == thrift.ErrAbandonRequest {
-			return false, thrift.WrapTException(err2)
+		default:
+			if err := iprot.Skip(ctx, fieldTypeId); err != nil {
+				return err
+			}
+		}
+		if err := iprot.ReadFieldEnd(ctx); err != nil {
+			return err
 		}
-		x := thrift.NewTApplicationException(thrift.INTERNAL_ERROR, "Internal error processing SearchFirmware: "+err2.Error())
-		oprot.WriteMessageBegin(ctx, "SearchFirmware", thrift.EXCEPTION, seqId)
-		x.Write(ctx, oprot)
-		oprot.WriteMessageEnd(ctx)
-		oprot.Flush(ctx)
-		return true, thrift.WrapTException(err2)
-	} else {
-		result.Success = retval
-	}
-	tickerCancel()
-	if err2 = oprot.WriteMessageBegin(ctx, "SearchFirmware", thrift.REPLY, seqId); err2 != nil {
-		err = thrift.WrapTException(err2)
-	}
-	if err2 = result.Write(ctx, oprot); err == nil && err2 != nil {
-		err = thrift.WrapTException(err2)
-	}
-	if err2 = oprot.WriteMessageEnd(ctx); err == nil && err2 != nil {
-		err = thrift.WrapTException(err2)
-	}
-	if err2 = oprot.Flush(ctx); err == nil && err2 != nil {
-		err = thrift.WrapTException(err2)
 	}
-	if err != nil {
-		return
+	if err := iprot.ReadStructEnd(ctx); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
 	}
-	return true, err
+	return nil
 }
 
-type attestationFailureAnalyzerServiceProcessorSearchReport struct {
-	handler AttestationFailureAnalyzerService
+func (p *AttestationFailureAnalyzerServiceUpdateOriginalFirmwareResult) ReadField1(ctx context.Context, iprot thrift.TProtocol) error {
+	p.PermissionDenied = &PermissionDenied{}
+	if err := p.PermissionDenied.Read(ctx, iprot); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T error reading struct: ", p.PermissionDenied), err)
+	}
+	return nil
 }
 
-func (p *attestationFailureAnalyzerServiceProcessorSearchReport) Process(ctx context.Context, seqId int32, iprot, oprot thrift.TProtocol) (success bool, err thrift.TException) {
-	args := AttestationFailureAnalyzerServiceSearchReportArgs{}
-	var err2 error
-	if err2 = args.Read(ctx, iprot); err2 != nil {
-		iprot.ReadMessageEnd(ctx)
-		x := thrift.NewTApplicationException(thrift.PROTOCOL_ERROR, err2.Error())
-		oprot.WriteMessageBegin(ctx, "SearchReport", thrift.EXCEPTION, seqId)
-		x.Write(ctx, oprot)
-		oprot.WriteMessageEnd(ctx)
-		oprot.Flush(ctx)
-		return false, thrift.WrapTException(err2)
+func (p *AttestationFailureAnalyzerServiceUpdateOriginalFirmwareResult) ReadField2(ctx context.Context, iprot thrift.TProtocol) error {
+	p.InvalidRequest = &InvalidRequest{}
+	if err := p.InvalidRequest.Read(ctx, iprot); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T error reading struct: ", p.InvalidRequest), err)
 	}
-	iprot.ReadMessageEnd(ctx)
+	return nil
+}
 
-	tickerCancel := func() {}
-	// Start a goroutine to do server side connectivity check.
-	if thrift.ServerConnectivityCheckInterval > 0 {
-		var cancel context.CancelFunc
-		ctx, cancel = context.WithCancel(ctx)
-		defer cancel()
-		var tickerCtx context.Context
-		tickerCtx, tickerCancel = context.WithCancel(context.Background())
-		defer tickerCancel()
-		go func(ctx context.Context, cancel context.CancelFunc) {
-			ticker := time.NewTicker(thrift.ServerConnectivityCheckInterval)
-			defer ticker.Stop()
-			for {
-				select {
-				case <-ctx.Done():
-					return
-				case <-ticker.C:
-					if !iprot.Transport().IsOpen() {
-						cancel()
-						return
-					}
-				}
-			}
-		}(tickerCtx, cancel)
+func (p *AttestationFailureAnalyzerServiceUpdateOriginalFirmwareResult) Write(ctx context.Context, oprot thrift.TProtocol) error {
+	if err := oprot.WriteStructBegin(ctx, "UpdateOriginalFirmware_result"); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
 	}
-
-	result := AttestationFailureAnalyzerServiceSearchReportResult{}
-	var retval *SearchReportResult_
-	if retval, err2 = p.handler.SearchReport(ctx, args.Request); err2 != nil {
-		tickerCancel()
-		if err2 == thrift.ErrAbandonRequest {
-			return false, thrift.WrapTException(err2)
+	if p != nil {
+		if err := p.writeField1(ctx, oprot); err != nil {
+			return err
+		}
+		if err := p.writeField2(ctx, oprot); err != nil {
+			return err
 		}
-		x := thrift.NewTApplicationException(thrift.INTERNAL_ERROR, "Internal error processing SearchReport: "+err2.Error())
-		oprot.WriteMessageBegin(ctx, "SearchReport", thrift.EXCEPTION, seqId)
-		x.Write(ctx, oprot)
-		oprot.WriteMessageEnd(ctx)
-		oprot.Flush(ctx)
-		return true, thrift.WrapTException(err2)
-	} else {
-		result.Success = retval
 	}
-	tickerCancel()
-	if err2 = oprot.WriteMessageBegin(ctx, "SearchReport", thrift.REPLY, seqId); err2 != nil {
-		err = thrift.WrapTException(err2)
+	if err := oprot.WriteFieldStop(ctx); err != nil {
+		return thrift.PrependError("write field stop error: ", err)
 	}
-	if err2 = result.Write(ctx, oprot); err == nil && err2 != nil {
-		err = thrift.WrapTException(err2)
+	if err := oprot.WriteStructEnd(ctx); err != nil {
+		return thrift.PrependError("write struct stop error: ", err)
 	}
-	if err2 = oprot.WriteMessageEnd(ctx); err == nil && err2 != nil {
-		err = thrift.WrapTException(err2)
+	return nil
+}
+
+func (p *AttestationFailureAnalyzerServiceUpdateOriginalFirmwareResult) writeField1(ctx context.Context, oprot thrift.TProtocol) (err error) {
+	if p.IsSetPermissionDenied() {
+		if err := oprot.WriteFieldBegin(ctx, "permissionDenied", thrift.STRUCT, 1); err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T write field begin error 1:permissionDenied: ", p), err)
+		}
+		if err := p.PermissionDenied.Write(ctx, oprot); err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T error writing struct: ", p.PermissionDenied), err)
+		}
+		if err := oprot.WriteFieldEnd(ctx); err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T write field end error 1:permissionDenied: ", p), err)
+		}
 	}
-	if err2 = oprot.Flush(ctx); err == nil && err2 != nil {
-		err = thrift.WrapTException(err2)
+	return err
+}
+
+func (p *AttestationFailureAnalyzerServiceUpdateOriginalFirmwareResult) writeField2(ctx context.Context, oprot thrift.TProtocol) (err error) {
+	if p.IsSetInvalidRequest() {
+		if err := oprot.WriteFieldBegin(ctx, "invalidRequest", thrift.STRUCT, 2); err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T write field begin error 2:invalidRequest: ", p), err)
+		}
+		if err := p.InvalidRequest.Write(ctx, oprot); err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T error writing struct: ", p.InvalidRequest), err)
+		}
+		if err := oprot.WriteFieldEnd(ctx); err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T write field end error 2:invalidRequest: ", p), err)
+		}
 	}
-	if err != nil {
-		return
+	return err
+}
+
+func (p *AttestationFailureAnalyzerServiceUpdateOriginalFirmwareResult) String() string {
+	if p == nil {
+		return "<nil>"
 	}
-	return true, err
+	return fmt.Sprintf("AttestationFailureAnalyzerServiceUpdateOriginalFirmwareResult(%+v)", *p)
 }
 
-type attestationFailureAnalyzerServiceProcessorAnalyze struct {
-	handler AttestationFailureAnalyzerService
+// Attributes:
+//   - Request
+type AttestationFailureAnalyzerServiceDeleteOriginalFirmwareArgs struct {
+	Request *DeleteOriginalFirmwareRequest `thrift:"request,1" db:"request" json:"request"`
 }
 
-func (p *attestationFailureAnalyzerServiceProcessorAnalyze) Process(ctx context.Context, seqId int32, iprot, oprot thrift.TProtocol) (success bool, err thrift.TException) {
-	args := AttestationFailureAnalyzerServiceAnalyzeArgs{}
-	var err2 error
-	if err2 = args.Read(ctx, iprot); err2 != nil {
-		iprot.ReadMessageEnd(ctx)
-		x := thrift.NewTApplicationException(thrift.PROTOCOL_ERROR, err2.Error())
-		oprot.WriteMessageBegin(ctx, "Analyze", thrift.EXCEPTION, seqId)
-		x.Write(ctx, oprot)
-		oprot.WriteMessageEnd(ctx)
-		oprot.Flush(ctx)
-		return false, thrift.WrapTException(err2)
+func NewAttestationFailureAnalyzerServiceDeleteOriginalFirmwareArgs() *AttestationFailureAnalyzerServiceDeleteOriginalFirmwareArgs {
+	return &AttestationFailureAnalyzerServiceDeleteOriginalFirmwareArgs{}
+}
+
+var AttestationFailureAnalyzerServiceDeleteOriginalFirmwareArgs_Request_DEFAULT *DeleteOriginalFirmwareRequest
+
+func (p *AttestationFailureAnalyzerServiceDeleteOriginalFirmwareArgs) GetRequest() *DeleteOriginalFirmwareRequest {
+	if !p.IsSetRequest() {
+		return AttestationFailureAnalyzerServiceDeleteOriginalFirmwareArgs_Request_DEFAULT
 	}
-	iprot.ReadMessageEnd(ctx)
+	return p.Request
+}
+func (p *AttestationFailureAnalyzerServiceDeleteOriginalFirmwareArgs) IsSetRequest() bool {
+	return p.Request != nil
+}
 
-	tickerCancel := func() {}
-	// Start a goroutine to do server side connectivity check.
-	if thrift.ServerConnectivityCheckInterval > 0 {
-		var cancel context.CancelFunc
-		ctx, cancel = context.WithCancel(ctx)
-		defer cancel()
-		var tickerCtx context.Context
-		tickerCtx, tickerCancel = context.WithCancel(context.Background())
-		defer tickerCancel()
-		go func(ctx context.Context, cancel context.CancelFunc) {
-			ticker := time.NewTicker(thrift.ServerConnectivityCheckInterval)
-			defer ticker.Stop()
-			for {
-				select {
-				case <-ctx.Done():
-					return
-				case <-ticker.C:
-					if !iprot.Transport().IsOpen() {
-						cancel()
-						return
-					}
+func (p *AttestationFailureAnalyzerServiceDeleteOriginalFirmwareArgs) Read(ctx context.Context, iprot thrift.TProtocol) error {
+	if _, err := iprot.ReadStructBegin(ctx); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T read error: ", p), err)
+	}
+
+	for {
+		_, fieldTypeId, fieldId, err := iprot.ReadFieldBegin(ctx)
+		if err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T field %d read error: ", p, fieldId), err)
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+		switch fieldId {
+		case 1:
+			if fieldTypeId == thrift.STRUCT {
+				if err := p.ReadField1(ctx, iprot); err != nil {
+					return err
+				}
+			} else {
+				if err := iprot.Skip(ctx, fieldTypeId); err != nil {
+					return err
 				}
 			}
-		}(tickerCtx, cancel)
+		default:
+			if err := iprot.Skip(ctx, fieldTypeId); err != nil {
+				return err
+			}
+		}
+		if err := iprot.ReadFieldEnd(ctx); err != nil {
+			return err
+		}
 	}
+	if err := iprot.ReadStructEnd(ctx); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
+	}
+	return nil
+}
 
-	result := AttestationFailureAnalyzerServiceAnalyzeResult{}
-	var retval *AnalyzeResult_
-	if retval, err2 = p.handler.Analyze(ctx, args.Request); err2 != nil {
-		tickerCancel()
-		if err2 == thrift.ErrAbandonRequest {
-			return false, thrift.WrapTException(err2)
+func (p *AttestationFailureAnalyzerServiceDeleteOriginalFirmwareArgs) ReadField1(ctx context.Context, iprot thrift.TProtocol) error {
+	p.Request = &DeleteOriginalFirmwareRequest{}
+	if err := p.Request.Read(ctx, iprot); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T error reading struct: ", p.Request), err)
+	}
+	return nil
+}
+
+func (p *AttestationFailureAnalyzerServiceDeleteOriginalFirmwareArgs) Write(ctx context.Context, oprot thrift.TProtocol) error {
+	if err := oprot.WriteStructBegin(ctx, "DeleteOriginalFirmware_args"); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
+	}
+	if p != nil {
+		if err := p.writeField1(ctx, oprot); err != nil {
+			return err
 		}
-		x := thrift.NewTApplicationException(thrift.INTERNAL_ERROR, "Internal error processing Analyze: "+err2.Error())
-		oprot.WriteMessageBegin(ctx, "Analyze", thrift.EXCEPTION, seqId)
-		x.Write(ctx, oprot)
-		oprot.WriteMessageEnd(ctx)
-		oprot.Flush(ctx)
-		return true, thrift.WrapTException(err2)
-	} else {
-		result.Success = retval
 	}
-	tickerCancel()
-	if err2 = oprot.WriteMessageBegin(ctx, "Analyze", thrift.REPLY, seqId); err2 != nil {
-		err = thrift.WrapTException(err2)
+	if err := oprot.WriteFieldStop(ctx); err != nil {
+		return thrift.PrependError("write field stop error: ", err)
 	}
-	if err2 = result.Write(ctx, oprot); err == nil && err2 != nil {
-		err = thrift.WrapTException(err2)
+	if err := oprot.WriteStructEnd(ctx); err != nil {
+		return thrift.PrependError("write struct stop error: ", err)
 	}
-	if err2 = oprot.WriteMessageEnd(ctx); err == nil && err2 != nil {
-		err = thrift.WrapTException(err2)
+	return nil
+}
+
+func (p *AttestationFailureAnalyzerServiceDeleteOriginalFirmwareArgs) writeField1(ctx context.Context, oprot thrift.TProtocol) (err error) {
+	if err := oprot.WriteFieldBegin(ctx, "request", thrift.STRUCT, 1); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T write field begin error 1:request: ", p), err)
+	}
+	if err := p.Request.Write(ctx, oprot); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T error writing struct: ", p.Request), err)
+	}
+	if err := oprot.WriteFieldEnd(ctx); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T write field end error 1:request: ", p), err)
 	}
-	if err2 = oprot.Flush(ctx); err == nil && err2 != nil {
-		err = thrift.WrapTException(err2)
+	return err
+}
+
+func (p *AttestationFailureAnalyzerServiceDeleteOriginalFirmwareArgs) String() string {
+	if p == nil {
+		return "<nil>"
 	}
-	if err != nil {
-		return
+	return fmt.Sprintf("AttestationFailureAnalyzerServiceDeleteOriginalFirmwareArgs(%+v)", *p)
+}
+
+// Attributes:
+//   - PermissionDenied
+//   - InvalidRequest
+type AttestationFailureAnalyzerServiceDeleteOriginalFirmwareResult struct {
+	PermissionDenied *PermissionDenied `thrift:"permissionDenied,1" db:"permissionDenied" json:"permissionDenied,omitempty"`
+	InvalidRequest   *InvalidRequest   `thrift:"invalidRequest,2" db:"invalidRequest" json:"invalidRequest,omitempty"`
+}
+
+func NewAttestationFailureAnalyzerServiceDeleteOriginalFirmwareResult() *AttestationFailureAnalyzerServiceDeleteOriginalFirmwareResult {
+	return &AttestationFailureAnalyzerServiceDeleteOriginalFirmwareResult{}
+}
+
+var AttestationFailureAnalyzerServiceDeleteOriginalFirmwareResult_PermissionDenied_DEFAULT *PermissionDenied
+
+func (p *AttestationFailureAnalyzerServiceDeleteOriginalFirmwareResult) GetPermissionDenied() *PermissionDenied {
+	if !p.IsSetPermissionDenied() {
+		return AttestationFailureAnalyzerServiceDeleteOriginalFirmwareResult_PermissionDenied_DEFAULT
 	}
-	return true, err
+	return p.PermissionDenied
 }
 
-type attestationFailureAnalyzerServiceProcessorCheckFirmwareVersion struct {
-	handler AttestationFailureAnalyzerService
+var AttestationFailureAnalyzerServiceDeleteOriginalFirmwareResult_InvalidRequest_DEFAULT *InvalidRequest
+
+func (p *AttestationFailureAnalyzerServiceDeleteOriginalFirmwareResult) GetInvalidRequest() *InvalidRequest {
+	if !p.IsSetInvalidRequest() {
+		return AttestationFailureAnalyzerServiceDeleteOriginalFirmwareResult_InvalidRequest_DEFAULT
+	}
+	return p.InvalidRequest
+}
+func (p *AttestationFailureAnalyzerServiceDeleteOriginalFirmwareResult) IsSetPermissionDenied() bool {
+	return p.PermissionDenied != nil
 }
 
-func (p *attestationFailureAnalyzerServiceProcessorCheckFirmwareVersion) Process(ctx context.Context, seqId int32, iprot, oprot thrift.TProtocol) (success bool, err thrift.TException) {
-	args := AttestationFailureAnalyzerServiceCheckFirmwareVersionArgs{}
-	var err2 error
-	if err2 = args.Read(ctx, iprot); err2 != nil {
-		iprot.ReadMessageEnd(ctx)
-		x := thrift.NewTApplicationException(thrift.PROTOCOL_ERROR, err2.Error())
-		oprot.WriteMessageBegin(ctx, "CheckFirmwareVersion", thrift.EXCEPTION, seqId)
-		x.Write(ctx, oprot)
-		oprot.WriteMessageEnd(ctx)
-		oprot.Flush(ctx)
-		return false, thrift.WrapTException(err2)
+func (p *AttestationFailureAnalyzerServiceDeleteOriginalFirmwareResult) IsSetInvalidRequest() bool {
+	return p.InvalidRequest != nil
+}
+
+func (p *AttestationFailureAnalyzerServiceDeleteOriginalFirmwareResult) Read(ctx context.Context, iprot thrift.TProtocol) error {
+	if _, err := iprot.ReadStructBegin(ctx); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T read error: ", p), err)
 	}
-	iprot.ReadMessageEnd(ctx)
 
-	tickerCancel := func() {}
-	// Start a goroutine to do server side connectivity check.
-	if thrift.ServerConnectivityCheckInterval > 0 {
-		var cancel context.CancelFunc
-		ctx, cancel = context.WithCancel(ctx)
-		defer cancel()
-		var tickerCtx context.Context
-		tickerCtx, tickerCancel = context.WithCancel(context.Background())
-		defer tickerCancel()
-		go func(ctx context.Context, cancel context.CancelFunc) {
-			ticker := time.NewTicker(thrift.ServerConnectivityCheckInterval)
-			defer ticker.Stop()
-			for {
-				select {
-				case <-ctx.Done():
-					return
-				case <-ticker.C:
-					if !iprot.Transport().IsOpen() {
-						cancel()
-						return
-					}
+	for {
+		_, fieldTypeId, fieldId, err := iprot.ReadFieldBegin(ctx)
+		if err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T field %d read error: ", p, fieldId), err)
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+		switch fieldId {
+		case 1:
+			if fieldTypeId == thrift.STRUCT {
+				if err := p.ReadField1(ctx, iprot); err != nil {
+					return err
+				}
+			} else {
+				if err := iprot.Skip(ctx, fieldTypeId); err != nil {
+					return err
 				}
 			}
-		}(tickerCtx, cancel)
+		case 2:
+			if fieldTypeId == thrift.STRUCT {
+				if err := p.ReadField2(ctx, iprot); err != nil {
+					return err
+				}
+			} else {
+				if err := iprot.Skip(ctx, fieldTypeId); err != nil {
+					return err
+				}
+			}
+		default:
+			if err := iprot.Skip(ctx, fieldTypeId); err != nil {
+				return err
+			}
+		}
+		if err := iprot.ReadFieldEnd(ctx); err != nil {
+			return err
+		}
 	}
+	if err := iprot.ReadStructEnd(ctx); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
+	}
+	return nil
+}
 
-	result := AttestationFailureAnalyzerServiceCheckFirmwareVersionResult{}
-	var retval *CheckFirmwareVersionResult_
-	if retval, err2 = p.handler.CheckFirmwareVersion(ctx, args.Request); err2 != nil {
-		tickerCancel()
-		if err2 == thrift.ErrAbandonRequest {
-			return false, thrift.WrapTException(err2)
-		}
-		x := thrift.NewTApplicationException(thrift.INTERNAL_ERROR, "Internal error processing CheckFirmwareVersion: "+err2.Error())
-		oprot.WriteMessageBegin(ctx, "CheckFirmwareVersion", thrift.EXCEPTION, seqId)
-		x.Write(ctx, oprot)
-		oprot.WriteMessageEnd(ctx)
-		oprot.Flush(ctx)
-		return true, thrift.WrapTException(err2)
-	} else {
-		result.Success = retval
+func (p *AttestationFailureAnalyzerServiceDeleteOriginalFirmwareResult) ReadField1(ctx context.Context, iprot thrift.TProtocol) error {
+	p.PermissionDenied = &PermissionDenied{}
+	if err := p.PermissionDenied.Read(ctx, iprot); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T error reading struct: ", p.PermissionDenied), err)
 	}
-	tickerCancel()
-	if err2 = oprot.WriteMessageBegin(ctx, "CheckFirmwareVersion", thrift.REPLY, seqId); err2 != nil {
-		err = thrift.WrapTException(err2)
+	return nil
+}
+
+func (p *AttestationFailureAnalyzerServiceDeleteOriginalFirmwareResult) ReadField2(ctx context.Context, iprot thrift.TProtocol) error {
+	p.InvalidRequest = &InvalidRequest{}
+	if err := p.InvalidRequest.Read(ctx, iprot); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T error reading struct: ", p.InvalidRequest), err)
 	}
-	if err2 = result.Write(ctx, oprot); err == nil && err2 != nil {
-		err = thrift.WrapTException(err2)
+	return nil
+}
+
+func (p *AttestationFailureAnalyzerServiceDeleteOriginalFirmwareResult) Write(ctx context.Context, oprot thrift.TProtocol) error {
+	if err := oprot.WriteStructBegin(ctx, "DeleteOriginalFirmware_result"); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
 	}
-	if err2 = oprot.WriteMessageEnd(ctx); err == nil && err2 != nil {
-		err = thrift.WrapTException(err2)
+	if p != nil {
+		if err := p.writeField1(ctx, oprot); err != nil {
+			return err
+		}
+		if err := p.writeField2(ctx, oprot); err != nil {
+			return err
+		}
 	}
-	if err2 = oprot.Flush(ctx); err == nil && err2 != nil {
-		err = thrift.WrapTException(err2)
+	if err := oprot.WriteFieldStop(ctx); err != nil {
+		return thrift.PrependError("write field stop error: ", err)
 	}
-	if err != nil {
-		return
+	if err := oprot.WriteStructEnd(ctx); err != nil {
+		return thrift.PrependError("write struct stop error: ", err)
 	}
-	return true, err
+	return nil
 }
 
-// HELPER FUNCTIONS AND STRUCTURES
+func (p *AttestationFailureAnalyzerServiceDeleteOriginalFirmwareResult) writeField1(ctx context.Context, oprot thrift.TProtocol) (err error) {
+	if p.IsSetPermissionDenied() {
+		if err := oprot.WriteFieldBegin(ctx, "permissionDenied", thrift.STRUCT, 1); err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T write field begin error 1:permissionDenied: ", p), err)
+		}
+		if err := p.PermissionDenied.Write(ctx, oprot); err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T error writing struct: ", p.PermissionDenied), err)
+		}
+		if err := oprot.WriteFieldEnd(ctx); err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T write field end error 1:permissionDenied: ", p), err)
+		}
+	}
+	return err
+}
+
+func (p *AttestationFailureAnalyzerServiceDeleteOriginalFirmwareResult) writeField2(ctx context.Context, oprot thrift.TProtocol) (err error) {
+	if p.IsSetInvalidRequest() {
+		if err := oprot.WriteFieldBegin(ctx, "invalidRequest", thrift.STRUCT, 2); err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T write field begin error 2:invalidRequest: ", p), err)
+		}
+		if err := p.InvalidRequest.Write(ctx, oprot); err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T error writing struct: ", p.InvalidRequest), err)
+		}
+		if err := oprot.WriteFieldEnd(ctx); err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T write field end error 2:invalidRequest: ", p), err)
+		}
+	}
+	return err
+}
+
+func (p *AttestationFailureAnalyzerServiceDeleteOriginalFirmwareResult) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("AttestationFailureAnalyzerServiceDeleteOriginalFirmwareResult(%+v)", *p)
+}
 
 // Attributes:
 //   - Request
-type AttestationFailureAnalyzerServiceSearchFirmwareArgs struct {
-	Request *SearchFirmwareRequest `thrift:"request,1" db:"request" json:"request"`
+type AttestationFailureAnalyzerServiceListTamperingRegistryEntriesArgs struct {
+	Request *ListTamperingRegistryEntriesRequest `thrift:"request,1" db:"request" json:"request"`
 }
 
-func NewAttestationFailureAnalyzerServiceSearchFirmwareArgs() *AttestationFailureAnalyzerServiceSearchFirmwareArgs {
-	return &AttestationFailureAnalyzerServiceSearchFirmwareArgs{}
+func NewAttestationFailureAnalyzerServiceListTamperingRegistryEntriesArgs() *AttestationFailureAnalyzerServiceListTamperingRegistryEntriesArgs {
+	return &AttestationFailureAnalyzerServiceListTamperingRegistryEntriesArgs{}
 }
 
-var AttestationFailureAnalyzerServiceSearchFirmwareArgs_Request_DEFAULT *SearchFirmwareRequest
+var AttestationFailureAnalyzerServiceListTamperingRegistryEntriesArgs_Request_DEFAULT *ListTamperingRegistryEntriesRequest
 
-func (p *AttestationFailureAnalyzerServiceSearchFirmwareArgs) GetRequest() *SearchFirmwareRequest {
+func (p *AttestationFailureAnalyzerServiceListTamperingRegistryEntriesArgs) GetRequest() *ListTamperingRegistryEntriesRequest {
 	if !p.IsSetRequest() {
-		return AttestationFailureAnalyzerServiceSearchFirmwareArgs_Request_DEFAULT
+		return AttestationFailureAnalyzerServiceListTamperingRegistryEntriesArgs_Request_DEFAULT
 	}
 	return p.Request
 }
-func (p *AttestationFailureAnalyzerServiceSearchFirmwareArgs) IsSetRequest() bool {
+func (p *AttestationFailureAnalyzerServiceListTamperingRegistryEntriesArgs) IsSetRequest() bool {
 	return p.Request != nil
 }
 
-func (p *AttestationFailureAnalyzerServiceSearchFirmwareArgs) Read(ctx context.Context, iprot thrift.TProtocol) error {
+func (p *AttestationFailureAnalyzerServiceListTamperingRegistryEntriesArgs) Read(ctx context.Context, iprot thrift.TProtocol) error {
 	if _, err := iprot.ReadStructBegin(ctx); err != nil {
 		return thrift.PrependError(fmt.Sprintf("%T read error: ", p), err)
 	}
@@ -8837,16 +23182,16 @@ func (p *AttestationFailureAnalyzerServiceSearchFirmwareArgs) Read(ctx context.C
 	return nil
 }
 
-func (p *AttestationFailureAnalyzerServiceSearchFirmwareArgs) ReadField1(ctx context.Context, iprot thrift.TProtocol) error {
-	p.Request = &SearchFirmwareRequest{}
+func (p *AttestationFailureAnalyzerServiceListTamperingRegistryEntriesArgs) ReadField1(ctx context.Context, iprot thrift.TProtocol) error {
+	p.Request = &ListTamperingRegistryEntriesRequest{}
 	if err := p.Request.Read(ctx, iprot); err != nil {
 		return thrift.PrependError(fmt.Sprintf("%T error reading struct: ", p.Request), err)
 	}
 	return nil
 }
 
-func (p *AttestationFailureAnalyzerServiceSearchFirmwareArgs) Write(ctx context.Context, oprot thrift.TProtocol) error {
-	if err := oprot.WriteStructBegin(ctx, "SearchFirmware_args"); err != nil {
+func (p *AttestationFailureAnalyzerServiceListTamperingRegistryEntriesArgs) Write(ctx context.Context, oprot thrift.TProtocol) error {
+	if err := oprot.WriteStructBegin(ctx, "ListTamperingRegistryEntries_args"); err != nil {
 		return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
 	}
 	if p != nil {
@@ -8863,7 +23208,7 @@ func (p *AttestationFailureAnalyzerServiceSearchFirmwareArgs) Write(ctx context.
 	return nil
 }
 
-func (p *AttestationFailureAnalyzerServiceSearchFirmwareArgs) writeField1(ctx context.Context, oprot thrift.TProtocol) (err error) {
+func (p *AttestationFailureAnalyzerServiceListTamperingRegistryEntriesArgs) writeField1(ctx context.Context, oprot thrift.TProtocol) (err error) {
 	if err := oprot.WriteFieldBegin(ctx, "request", thrift.STRUCT, 1); err != nil {
 		return thrift.PrependError(fmt.Sprintf("%T write field begin error 1:request: ", p), err)
 	}
@@ -8876,36 +23221,66 @@ func (p *AttestationFailureAnalyzerServiceSearchFirmwareArgs) writeField1(ctx co
 	return err
 }
 
-func (p *AttestationFailureAnalyzerServiceSearchFirmwareArgs) String() string {
+func (p *AttestationFailureAnalyzerServiceListTamperingRegistryEntriesArgs) String() string {
 	if p == nil {
 		return "<nil>"
 	}
-	return fmt.Sprintf("AttestationFailureAnalyzerServiceSearchFirmwareArgs(%+v)", *p)
+	return fmt.Sprintf("AttestationFailureAnalyzerServiceListTamperingRegistryEntriesArgs(%+v)", *p)
 }
 
 // Attributes:
 //   - Success
-type AttestationFailureAnalyzerServiceSearchFirmwareResult struct {
-	Success *SearchFirmwareResult_ `thrift:"success,0" db:"success" json:"success,omitempty"`
+//   - InvalidRequest
+//   - PermissionDenied
+type AttestationFailureAnalyzerServiceListTamperingRegistryEntriesResult struct {
+	Success          *ListTamperingRegistryEntriesResult_ `thrift:"success,0" db:"success" json:"success,omitempty"`
+	InvalidRequest   *InvalidRequest                      `thrift:"invalidRequest,1" db:"invalidRequest" json:"invalidRequest,omitempty"`
+	PermissionDenied *PermissionDenied                    `thrift:"permissionDenied,2" db:"permissionDenied" json:"permissionDenied,omitempty"`
 }
 
-func NewAttestationFailureAnalyzerServiceSearchFirmwareResult() *AttestationFailureAnalyzerServiceSearchFirmwareResult {
-	return &AttestationFailureAnalyzerServiceSearchFirmwareResult{}
+func NewAttestationFailureAnalyzerServiceListTamperingRegistryEntriesResult() *AttestationFailureAnalyzerServiceListTamperingRegistryEntriesResult {
+	return &AttestationFailureAnalyzerServiceListTamperingRegistryEntriesResult{}
 }
 
-var AttestationFailureAnalyzerServiceSearchFirmwareResult_Success_DEFAULT *SearchFirmwareResult_
+var AttestationFailureAnalyzerServiceListTamperingRegistryEntriesResult_Success_DEFAULT *ListTamperingRegistryEntriesResult_
 
-func (p *AttestationFailureAnalyzerServiceSearchFirmwareResult) GetSuccess() *SearchFirmwareResult_ {
+func (p *AttestationFailureAnalyzerServiceListTamperingRegistryEntriesResult) GetSuccess() *ListTamperingRegistryEntriesResult_ {
 	if !p.IsSetSuccess() {
-		return AttestationFailureAnalyzerServiceSearchFirmwareResult_Success_DEFAULT
+		return AttestationFailureAnalyzerServiceListTamperingRegistryEntriesResult_Success_DEFAULT
 	}
 	return p.Success
 }
-func (p *AttestationFailureAnalyzerServiceSearchFirmwareResult) IsSetSuccess() bool {
+
+var AttestationFailureAnalyzerServiceListTamperingRegistryEntriesResult_InvalidRequest_DEFAULT *InvalidRequest
+
+func (p *AttestationFailureAnalyzerServiceListTamperingRegistryEntriesResult) GetInvalidRequest() *InvalidRequest {
+	if !p.IsSetInvalidRequest() {
+		return AttestationFailureAnalyzerServiceListTamperingRegistryEntriesResult_InvalidRequest_DEFAULT
+	}
+	return p.InvalidRequest
+}
+
+var AttestationFailureAnalyzerServiceListTamperingRegistryEntriesResult_PermissionDenied_DEFAULT *PermissionDenied
+
+func (p *AttestationFailureAnalyzerServiceListTamperingRegistryEntriesResult) GetPermissionDenied() *PermissionDenied {
+	if !p.IsSetPermissionDenied() {
+		return AttestationFailureAnalyzerServiceListTamperingRegistryEntriesResult_PermissionDenied_DEFAULT
+	}
+	return p.PermissionDenied
+}
+func (p *AttestationFailureAnalyzerServiceListTamperingRegistryEntriesResult) IsSetSuccess() bool {
 	return p.Success != nil
 }
 
-func (p *AttestationFailureAnalyzerServiceSearchFirmwareResult) Read(ctx context.Context, iprot thrift.TProtocol) error {
+func (p *AttestationFailureAnalyzerServiceListTamperingRegistryEntriesResult) IsSetInvalidRequest() bool {
+	return p.InvalidRequest != nil
+}
+
+func (p *AttestationFailureAnalyzerServiceListTamperingRegistryEntriesResult) IsSetPermissionDenied() bool {
+	return p.PermissionDenied != nil
+}
+
+func (p *AttestationFailureAnalyzerServiceListTamperingRegistryEntriesResult) Read(ctx context.Context, iprot thrift.TProtocol) error {
 	if _, err := iprot.ReadStructBegin(ctx); err != nil {
 		return thrift.PrependError(fmt.Sprintf("%T read error: ", p), err)
 	}
@@ -8929,6 +23304,26 @@ func (p *AttestationFailureAnalyzerServiceSearchFirmwareResult) Read(ctx context
 					return err
 				}
 			}
+		case 1:
+			if fieldTypeId == thrift.STRUCT {
+				if err := p.ReadField1(ctx, iprot); err != nil {
+					return err
+				}
+			} else {
+				if err := iprot.Skip(ctx, fieldTypeId); err != nil {
+					return err
+				}
+			}
+		case 2:
+			if fieldTypeId == thrift.STRUCT {
+				if err := p.ReadField2(ctx, iprot); err != nil {
+					return err
+				}
+			} else {
+				if err := iprot.Skip(ctx, fieldTypeId); err != nil {
+					return err
+				}
+			}
 		default:
 			if err := iprot.Skip(ctx, fieldTypeId); err != nil {
 				return err
@@ -8944,22 +23339,44 @@ func (p *AttestationFailureAnalyzerServiceSearchFirmwareResult) Read(ctx context
 	return nil
 }
 
-func (p *AttestationFailureAnalyzerServiceSearchFirmwareResult) ReadField0(ctx context.Context, iprot thrift.TProtocol) error {
-	p.Success = &SearchFirmwareResult_{}
+func (p *AttestationFailureAnalyzerServiceListTamperingRegistryEntriesResult) ReadField0(ctx context.Context, iprot thrift.TProtocol) error {
+	p.Success = &ListTamperingRegistryEntriesResult_{}
 	if err := p.Success.Read(ctx, iprot); err != nil {
 		return thrift.PrependError(fmt.Sprintf("%T error reading struct: ", p.Success), err)
 	}
 	return nil
 }
 
-func (p *AttestationFailureAnalyzerServiceSearchFirmwareResult) Write(ctx context.Context, oprot thrift.TProtocol) error {
-	if err := oprot.WriteStructBegin(ctx, "SearchFirmware_result"); err != nil {
+func (p *AttestationFailureAnalyzerServiceListTamperingRegistryEntriesResult) ReadField1(ctx context.Context, iprot thrift.TProtocol) error {
+	p.InvalidRequest = &InvalidRequest{}
+	if err := p.InvalidRequest.Read(ctx, iprot); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T error reading struct: ", p.InvalidRequest), err)
+	}
+	return nil
+}
+
+func (p *AttestationFailureAnalyzerServiceListTamperingRegistryEntriesResult) ReadField2(ctx context.Context, iprot thrift.TProtocol) error {
+	p.PermissionDenied = &PermissionDenied{}
+	if err := p.PermissionDenied.Read(ctx, iprot); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T error reading struct: ", p.PermissionDenied), err)
+	}
+	return nil
+}
+
+func (p *AttestationFailureAnalyzerServiceListTamperingRegistryEntriesResult) Write(ctx context.Context, oprot thrift.TProtocol) error {
+	if err := oprot.WriteStructBegin(ctx, "ListTamperingRegistryEntries_result"); err != nil {
 		return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
 	}
 	if p != nil {
 		if err := p.writeField0(ctx, oprot); err != nil {
 			return err
 		}
+		if err := p.writeField1(ctx, oprot); err != nil {
+			return err
+		}
+		if err := p.writeField2(ctx, oprot); err != nil {
+			return err
+		}
 	}
 	if err := oprot.WriteFieldStop(ctx); err != nil {
 		return thrift.PrependError("write field stop error: ", err)
@@ -8970,7 +23387,7 @@ func (p *AttestationFailureAnalyzerServiceSearchFirmwareResult) Write(ctx contex
 	return nil
 }
 
-func (p *AttestationFailureAnalyzerServiceSearchFirmwareResult) writeField0(ctx context.Context, oprot thrift.TProtocol) (err error) {
+func (p *AttestationFailureAnalyzerServiceListTamperingRegistryEntriesResult) writeField0(ctx context.Context, oprot thrift.TProtocol) (err error) {
 	if p.IsSetSuccess() {
 		if err := oprot.WriteFieldBegin(ctx, "success", thrift.STRUCT, 0); err != nil {
 			return thrift.PrependError(fmt.Sprintf("%T write field begin error 0:success: ", p), err)
@@ -8979,42 +23396,72 @@ func (p *AttestationFailureAnalyzerServiceSearchFirmwareResult) writeField0(ctx
 			return thrift.PrependError(fmt.Sprintf("%T error writing struct: ", p.Success), err)
 		}
 		if err := oprot.WriteFieldEnd(ctx); err != nil {
-			return thrift.PrependError(fmt.Sprintf("%T write field end error 0:success: ", p), err)
+			return thrift.PrependError(fmt.Sprintf("%T write field end error 0:success: ", p), err)
+		}
+	}
+	return err
+}
+
+func (p *AttestationFailureAnalyzerServiceListTamperingRegistryEntriesResult) writeField1(ctx context.Context, oprot thrift.TProtocol) (err error) {
+	if p.IsSetInvalidRequest() {
+		if err := oprot.WriteFieldBegin(ctx, "invalidRequest", thrift.STRUCT, 1); err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T write field begin error 1:invalidRequest: ", p), err)
+		}
+		if err := p.InvalidRequest.Write(ctx, oprot); err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T error writing struct: ", p.InvalidRequest), err)
+		}
+		if err := oprot.WriteFieldEnd(ctx); err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T write field end error 1:invalidRequest: ", p), err)
+		}
+	}
+	return err
+}
+
+func (p *AttestationFailureAnalyzerServiceListTamperingRegistryEntriesResult) writeField2(ctx context.Context, oprot thrift.TProtocol) (err error) {
+	if p.IsSetPermissionDenied() {
+		if err := oprot.WriteFieldBegin(ctx, "permissionDenied", thrift.STRUCT, 2); err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T write field begin error 2:permissionDenied: ", p), err)
+		}
+		if err := p.PermissionDenied.Write(ctx, oprot); err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T error writing struct: ", p.PermissionDenied), err)
+		}
+		if err := oprot.WriteFieldEnd(ctx); err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T write field end error 2:permissionDenied: ", p), err)
 		}
 	}
 	return err
 }
 
-func (p *AttestationFailureAnalyzerServiceSearchFirmwareResult) String() string {
+func (p *AttestationFailureAnalyzerServiceListTamperingRegistryEntriesResult) String() string {
 	if p == nil {
 		return "<nil>"
 	}
-	return fmt.Sprintf("AttestationFailureAnalyzerServiceSearchFirmwareResult(%+v)", *p)
+	return fmt.Sprintf("AttestationFailureAnalyzerServiceListTamperingRegistryEntriesResult(%+v)", *p)
 }
 
 // Attributes:
 //   - Request
-type AttestationFailureAnalyzerServiceSearchReportArgs struct {
-	Request *SearchReportRequest `thrift:"request,1" db:"request" json:"request"`
+type AttestationFailureAnalyzerServiceInsertTamperingRegistryEntryArgs struct {
+	Request *InsertTamperingRegistryEntryRequest `thrift:"request,1" db:"request" json:"request"`
 }
 
-func NewAttestationFailureAnalyzerServiceSearchReportArgs() *AttestationFailureAnalyzerServiceSearchReportArgs {
-	return &AttestationFailureAnalyzerServiceSearchReportArgs{}
+func NewAttestationFailureAnalyzerServiceInsertTamperingRegistryEntryArgs() *AttestationFailureAnalyzerServiceInsertTamperingRegistryEntryArgs {
+	return &AttestationFailureAnalyzerServiceInsertTamperingRegistryEntryArgs{}
 }
 
-var AttestationFailureAnalyzerServiceSearchReportArgs_Request_DEFAULT *SearchReportRequest
+var AttestationFailureAnalyzerServiceInsertTamperingRegistryEntryArgs_Request_DEFAULT *InsertTamperingRegistryEntryRequest
 
-func (p *AttestationFailureAnalyzerServiceSearchReportArgs) GetRequest() *SearchReportRequest {
+func (p *AttestationFailureAnalyzerServiceInsertTamperingRegistryEntryArgs) GetRequest() *InsertTamperingRegistryEntryRequest {
 	if !p.IsSetRequest() {
-		return AttestationFailureAnalyzerServiceSearchReportArgs_Request_DEFAULT
+		return AttestationFailureAnalyzerServiceInsertTamperingRegistryEntryArgs_Request_DEFAULT
 	}
 	return p.Request
 }
-func (p *AttestationFailureAnalyzerServiceSearchReportArgs) IsSetRequest() bool {
+func (p *AttestationFailureAnalyzerServiceInsertTamperingRegistryEntryArgs) IsSetRequest() bool {
 	return p.Request != nil
 }
 
-func (p *AttestationFailureAnalyzerServiceSearchReportArgs) Read(ctx context.Context, iprot thrift.TProtocol) error {
+func (p *AttestationFailureAnalyzerServiceInsertTamperingRegistryEntryArgs) Read(ctx context.Context, iprot thrift.TProtocol) error {
 	if _, err := iprot.ReadStructBegin(ctx); err != nil {
 		return thrift.PrependError(fmt.Sprintf("%T read error: ", p), err)
 	}
@@ -9053,16 +23500,16 @@ func (p *AttestationFailureAnalyzerServiceSearchReportArgs) Read(ctx context.Con
 	return nil
 }
 
-func (p *AttestationFailureAnalyzerServiceSearchReportArgs) ReadField1(ctx context.Context, iprot thrift.TProtocol) error {
-	p.Request = &SearchReportRequest{}
+func (p *AttestationFailureAnalyzerServiceInsertTamperingRegistryEntryArgs) ReadField1(ctx context.Context, iprot thrift.TProtocol) error {
+	p.Request = &InsertTamperingRegistryEntryRequest{}
 	if err := p.Request.Read(ctx, iprot); err != nil {
 		return thrift.PrependError(fmt.Sprintf("%T error reading struct: ", p.Request), err)
 	}
 	return nil
 }
 
-func (p *AttestationFailureAnalyzerServiceSearchReportArgs) Write(ctx context.Context, oprot thrift.TProtocol) error {
-	if err := oprot.WriteStructBegin(ctx, "SearchReport_args"); err != nil {
+func (p *AttestationFailureAnalyzerServiceInsertTamperingRegistryEntryArgs) Write(ctx context.Context, oprot thrift.TProtocol) error {
+	if err := oprot.WriteStructBegin(ctx, "InsertTamperingRegistryEntry_args"); err != nil {
 		return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
 	}
 	if p != nil {
@@ -9079,7 +23526,7 @@ func (p *AttestationFailureAnalyzerServiceSearchReportArgs) Write(ctx context.Co
 	return nil
 }
 
-func (p *AttestationFailureAnalyzerServiceSearchReportArgs) writeField1(ctx context.Context, oprot thrift.TProtocol) (err error) {
+func (p *AttestationFailureAnalyzerServiceInsertTamperingRegistryEntryArgs) writeField1(ctx context.Context, oprot thrift.TProtocol) (err error) {
 	if err := oprot.WriteFieldBegin(ctx, "request", thrift.STRUCT, 1); err != nil {
 		return thrift.PrependError(fmt.Sprintf("%T write field begin error 1:request: ", p), err)
 	}
@@ -9092,36 +23539,66 @@ func (p *AttestationFailureAnalyzerServiceSearchReportArgs) writeField1(ctx cont
 	return err
 }
 
-func (p *AttestationFailureAnalyzerServiceSearchReportArgs) String() string {
+func (p *AttestationFailureAnalyzerServiceInsertTamperingRegistryEntryArgs) String() string {
 	if p == nil {
 		return "<nil>"
 	}
-	return fmt.Sprintf("AttestationFailureAnalyzerServiceSearchReportArgs(%+v)", *p)
+	return fmt.Sprintf("AttestationFailureAnalyzerServiceInsertTamperingRegistryEntryArgs(%+v)", *p)
 }
 
 // Attributes:
 //   - Success
-type AttestationFailureAnalyzerServiceSearchReportResult struct {
-	Success *SearchReportResult_ `thrift:"success,0" db:"success" json:"success,omitempty"`
+//   - PermissionDenied
+//   - InvalidRequest
+type AttestationFailureAnalyzerServiceInsertTamperingRegistryEntryResult struct {
+	Success          *InsertTamperingRegistryEntryResult_ `thrift:"success,0" db:"success" json:"success,omitempty"`
+	PermissionDenied *PermissionDenied                    `thrift:"permissionDenied,1" db:"permissionDenied" json:"permissionDenied,omitempty"`
+	InvalidRequest   *InvalidRequest                      `thrift:"invalidRequest,2" db:"invalidRequest" json:"invalidRequest,omitempty"`
 }
 
-func NewAttestationFailureAnalyzerServiceSearchReportResult() *AttestationFailureAnalyzerServiceSearchReportResult {
-	return &AttestationFailureAnalyzerServiceSearchReportResult{}
+func NewAttestationFailureAnalyzerServiceInsertTamperingRegistryEntryResult() *AttestationFailureAnalyzerServiceInsertTamperingRegistryEntryResult {
+	return &AttestationFailureAnalyzerServiceInsertTamperingRegistryEntryResult{}
 }
 
-var AttestationFailureAnalyzerServiceSearchReportResult_Success_DEFAULT *SearchReportResult_
+var AttestationFailureAnalyzerServiceInsertTamperingRegistryEntryResult_Success_DEFAULT *InsertTamperingRegistryEntryResult_
 
-func (p *AttestationFailureAnalyzerServiceSearchReportResult) GetSuccess() *SearchReportResult_ {
+func (p *AttestationFailureAnalyzerServiceInsertTamperingRegistryEntryResult) GetSuccess() *InsertTamperingRegistryEntryResult_ {
 	if !p.IsSetSuccess() {
-		return AttestationFailureAnalyzerServiceSearchReportResult_Success_DEFAULT
+		return AttestationFailureAnalyzerServiceInsertTamperingRegistryEntryResult_Success_DEFAULT
 	}
 	return p.Success
 }
-func (p *AttestationFailureAnalyzerServiceSearchReportResult) IsSetSuccess() bool {
+
+var AttestationFailureAnalyzerServiceInsertTamperingRegistryEntryResult_PermissionDenied_DEFAULT *PermissionDenied
+
+func (p *AttestationFailureAnalyzerServiceInsertTamperingRegistryEntryResult) GetPermissionDenied() *PermissionDenied {
+	if !p.IsSetPermissionDenied() {
+		return AttestationFailureAnalyzerServiceInsertTamperingRegistryEntryResult_PermissionDenied_DEFAULT
+	}
+	return p.PermissionDenied
+}
+
+var AttestationFailureAnalyzerServiceInsertTamperingRegistryEntryResult_InvalidRequest_DEFAULT *InvalidRequest
+
+func (p *AttestationFailureAnalyzerServiceInsertTamperingRegistryEntryResult) GetInvalidRequest() *InvalidRequest {
+	if !p.IsSetInvalidRequest() {
+		return AttestationFailureAnalyzerServiceInsertTamperingRegistryEntryResult_InvalidRequest_DEFAULT
+	}
+	return p.InvalidRequest
+}
+func (p *AttestationFailureAnalyzerServiceInsertTamperingRegistryEntryResult) IsSetSuccess() bool {
 	return p.Success != nil
 }
 
-func (p *AttestationFailureAnalyzerServiceSearchReportResult) Read(ctx context.Context, iprot thrift.TProtocol) error {
+func (p *AttestationFailureAnalyzerServiceInsertTamperingRegistryEntryResult) IsSetPermissionDenied() bool {
+	return p.PermissionDenied != nil
+}
+
+func (p *AttestationFailureAnalyzerServiceInsertTamperingRegistryEntryResult) IsSetInvalidRequest() bool {
+	return p.InvalidRequest != nil
+}
+
+func (p *AttestationFailureAnalyzerServiceInsertTamperingRegistryEntryResult) Read(ctx context.Context, iprot thrift.TProtocol) error {
 	if _, err := iprot.ReadStructBegin(ctx); err != nil {
 		return thrift.PrependError(fmt.Sprintf("%T read error: ", p), err)
 	}
@@ -9145,6 +23622,26 @@ func (p *AttestationFailureAnalyzerServiceSearchReportResult) Read(ctx context.C
 					return err
 				}
 			}
+		case 1:
+			if fieldTypeId == thrift.STRUCT {
+				if err := p.ReadField1(ctx, iprot); err != nil {
+					return err
+				}
+			} else {
+				if err := iprot.Skip(ctx, fieldTypeId); err != nil {
+					return err
+				}
+			}
+		case 2:
+			if fieldTypeId == thrift.STRUCT {
+				if err := p.ReadField2(ctx, iprot); err != nil {
+					return err
+				}
+			} else {
+				if err := iprot.Skip(ctx, fieldTypeId); err != nil {
+					return err
+				}
+			}
 		default:
 			if err := iprot.Skip(ctx, fieldTypeId); err != nil {
 				return err
@@ -9160,22 +23657,44 @@ func (p *AttestationFailureAnalyzerServiceSearchReportResult) Read(ctx context.C
 	return nil
 }
 
-func (p *AttestationFailureAnalyzerServiceSearchReportResult) ReadField0(ctx context.Context, iprot thrift.TProtocol) error {
-	p.Success = &SearchReportResult_{}
+func (p *AttestationFailureAnalyzerServiceInsertTamperingRegistryEntryResult) ReadField0(ctx context.Context, iprot thrift.TProtocol) error {
+	p.Success = &InsertTamperingRegistryEntryResult_{}
 	if err := p.Success.Read(ctx, iprot); err != nil {
 		return thrift.PrependError(fmt.Sprintf("%T error reading struct: ", p.Success), err)
 	}
 	return nil
 }
 
-func (p *AttestationFailureAnalyzerServiceSearchReportResult) Write(ctx context.Context, oprot thrift.TProtocol) error {
-	if err := oprot.WriteStructBegin(ctx, "SearchReport_result"); err != nil {
+func (p *AttestationFailureAnalyzerServiceInsertTamperingRegistryEntryResult) ReadField1(ctx context.Context, iprot thrift.TProtocol) error {
+	p.PermissionDenied = &PermissionDenied{}
+	if err := p.PermissionDenied.Read(ctx, iprot); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T error reading struct: ", p.PermissionDenied), err)
+	}
+	return nil
+}
+
+func (p *AttestationFailureAnalyzerServiceInsertTamperingRegistryEntryResult) ReadField2(ctx context.Context, iprot thrift.TProtocol) error {
+	p.InvalidRequest = &InvalidRequest{}
+	if err := p.InvalidRequest.Read(ctx, iprot); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T error reading struct: ", p.InvalidRequest), err)
+	}
+	return nil
+}
+
+func (p *AttestationFailureAnalyzerServiceInsertTamperingRegistryEntryResult) Write(ctx context.Context, oprot thrift.TProtocol) error {
+	if err := oprot.WriteStructBegin(ctx, "InsertTamperingRegistryEntry_result"); err != nil {
 		return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
 	}
 	if p != nil {
 		if err := p.writeField0(ctx, oprot); err != nil {
 			return err
 		}
+		if err := p.writeField1(ctx, oprot); err != nil {
+			return err
+		}
+		if err := p.writeField2(ctx, oprot); err != nil {
+			return err
+		}
 	}
 	if err := oprot.WriteFieldStop(ctx); err != nil {
 		return thrift.PrependError("write field stop error: ", err)
@@ -9186,7 +23705,7 @@ func (p *AttestationFailureAnalyzerServiceSearchReportResult) Write(ctx context.
 	return nil
 }
 
-func (p *AttestationFailureAnalyzerServiceSearchReportResult) writeField0(ctx context.Context, oprot thrift.TProtocol) (err error) {
+func (p *AttestationFailureAnalyzerServiceInsertTamperingRegistryEntryResult) writeField0(ctx context.Context, oprot thrift.TProtocol) (err error) {
 	if p.IsSetSuccess() {
 		if err := oprot.WriteFieldBegin(ctx, "success", thrift.STRUCT, 0); err != nil {
 			return thrift.PrependError(fmt.Sprintf("%T write field begin error 0:success: ", p), err)
@@ -9201,36 +23720,66 @@ func (p *AttestationFailureAnalyzerServiceSearchReportResult) writeField0(ctx co
 	return err
 }
 
-func (p *AttestationFailureAnalyzerServiceSearchReportResult) String() string {
+func (p *AttestationFailureAnalyzerServiceInsertTamperingRegistryEntryResult) writeField1(ctx context.Context, oprot thrift.TProtocol) (err error) {
+	if p.IsSetPermissionDenied() {
+		if err := oprot.WriteFieldBegin(ctx, "permissionDenied", thrift.STRUCT, 1); err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T write field begin error 1:permissionDenied: ", p), err)
+		}
+		if err := p.PermissionDenied.Write(ctx, oprot); err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T error writing struct: ", p.PermissionDenied), err)
+		}
+		if err := oprot.WriteFieldEnd(ctx); err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T write field end error 1:permissionDenied: ", p), err)
+		}
+	}
+	return err
+}
+
+func (p *AttestationFailureAnalyzerServiceInsertTamperingRegistryEntryResult) writeField2(ctx context.Context, oprot thrift.TProtocol) (err error) {
+	if p.IsSetInvalidRequest() {
+		if err := oprot.WriteFieldBegin(ctx, "invalidRequest", thrift.STRUCT, 2); err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T write field begin error 2:invalidRequest: ", p), err)
+		}
+		if err := p.InvalidRequest.Write(ctx, oprot); err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T error writing struct: ", p.InvalidRequest), err)
+		}
+		if err := oprot.WriteFieldEnd(ctx); err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T write field end error 2:invalidRequest: ", p), err)
+		}
+	}
+	return err
+}
+
+func (p *AttestationFailureAnalyzerServiceInsertTamperingRegistryEntryResult) String() string {
 	if p == nil {
 		return "<nil>"
 	}
-	return fmt.Sprintf("AttestationFailureAnalyzerServiceSearchReportResult(%+v)", *p)
+	return fmt.Sprintf("AttestationFailureAnalyzerServiceInsertTamperingRegistryEntryResult(%+v)", *p)
 }
 
 // Attributes:
 //   - Request
-type AttestationFailureAnalyzerServiceAnalyzeArgs struct {
-	Request *AnalyzeRequest `thrift:"request,1" db:"request" json:"request"`
+type AttestationFailureAnalyzerServiceUpdateTamperingRegistryEntryArgs struct {
+	Request *UpdateTamperingRegistryEntryRequest `thrift:"request,1" db:"request" json:"request"`
 }
 
-func NewAttestationFailureAnalyzerServiceAnalyzeArgs() *AttestationFailureAnalyzerServiceAnalyzeArgs {
-	return &AttestationFailureAnalyzerServiceAnalyzeArgs{}
+func NewAttestationFailureAnalyzerServiceUpdateTamperingRegistryEntryArgs() *AttestationFailureAnalyzerServiceUpdateTamperingRegistryEntryArgs {
+	return &AttestationFailureAnalyzerServiceUpdateTamperingRegistryEntryArgs{}
 }
 
-var AttestationFailureAnalyzerServiceAnalyzeArgs_Request_DEFAULT *AnalyzeRequest
+var AttestationFailureAnalyzerServiceUpdateTamperingRegistryEntryArgs_Request_DEFAULT *UpdateTamperingRegistryEntryRequest
 
-func (p *AttestationFailureAnalyzerServiceAnalyzeArgs) GetRequest() *AnalyzeRequest {
+func (p *AttestationFailureAnalyzerServiceUpdateTamperingRegistryEntryArgs) GetRequest() *UpdateTamperingRegistryEntryRequest {
 	if !p.IsSetRequest() {
-		return AttestationFailureAnalyzerServiceAnalyzeArgs_Request_DEFAULT
+		return AttestationFailureAnalyzerServiceUpdateTamperingRegistryEntryArgs_Request_DEFAULT
 	}
 	return p.Request
 }
-func (p *AttestationFailureAnalyzerServiceAnalyzeArgs) IsSetRequest() bool {
+func (p *AttestationFailureAnalyzerServiceUpdateTamperingRegistryEntryArgs) IsSetRequest() bool {
 	return p.Request != nil
 }
 
-func (p *AttestationFailureAnalyzerServiceAnalyzeArgs) Read(ctx context.Context, iprot thrift.TProtocol) error {
+func (p *AttestationFailureAnalyzerServiceUpdateTamperingRegistryEntryArgs) Read(ctx context.Context, iprot thrift.TProtocol) error {
 	if _, err := iprot.ReadStructBegin(ctx); err != nil {
 		return thrift.PrependError(fmt.Sprintf("%T read error: ", p), err)
 	}
@@ -9269,16 +23818,16 @@ func (p *AttestationFailureAnalyzerServiceAnalyzeArgs) Read(ctx context.Context,
 	return nil
 }
 
-func (p *AttestationFailureAnalyzerServiceAnalyzeArgs) ReadField1(ctx context.Context, iprot thrift.TProtocol) error {
-	p.Request = &AnalyzeRequest{}
+func (p *AttestationFailureAnalyzerServiceUpdateTamperingRegistryEntryArgs) ReadField1(ctx context.Context, iprot thrift.TProtocol) error {
+	p.Request = &UpdateTamperingRegistryEntryRequest{}
 	if err := p.Request.Read(ctx, iprot); err != nil {
 		return thrift.PrependError(fmt.Sprintf("%T error reading struct: ", p.Request), err)
 	}
 	return nil
 }
 
-func (p *AttestationFailureAnalyzerServiceAnalyzeArgs) Write(ctx context.Context, oprot thrift.TProtocol) error {
-	if err := oprot.WriteStructBegin(ctx, "Analyze_args"); err != nil {
+func (p *AttestationFailureAnalyzerServiceUpdateTamperingRegistryEntryArgs) Write(ctx context.Context, oprot thrift.TProtocol) error {
+	if err := oprot.WriteStructBegin(ctx, "UpdateTamperingRegistryEntry_args"); err != nil {
 		return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
 	}
 	if p != nil {
@@ -9295,7 +23844,7 @@ func (p *AttestationFailureAnalyzerServiceAnalyzeArgs) Write(ctx context.Context
 	return nil
 }
 
-func (p *AttestationFailureAnalyzerServiceAnalyzeArgs) writeField1(ctx context.Context, oprot thrift.TProtocol) (err error) {
+func (p *AttestationFailureAnalyzerServiceUpdateTamperingRegistryEntryArgs) writeField1(ctx context.Context, oprot thrift.TProtocol) (err error) {
 	if err := oprot.WriteFieldBegin(ctx, "request", thrift.STRUCT, 1); err != nil {
 		return thrift.PrependError(fmt.Sprintf("%T write field begin error 1:request: ", p), err)
 	}
@@ -9308,36 +23857,51 @@ func (p *AttestationFailureAnalyzerServiceAnalyzeArgs) writeField1(ctx context.C
 	return err
 }
 
-func (p *AttestationFailureAnalyzerServiceAnalyzeArgs) String() string {
+func (p *AttestationFailureAnalyzerServiceUpdateTamperingRegistryEntryArgs) String() string {
 	if p == nil {
 		return "<nil>"
 	}
-	return fmt.Sprintf("AttestationFailureAnalyzerServiceAnalyzeArgs(%+v)", *p)
+	return fmt.Sprintf("AttestationFailureAnalyzerServiceUpdateTamperingRegistryEntryArgs(%+v)", *p)
 }
 
 // Attributes:
-//   - Success
-type AttestationFailureAnalyzerServiceAnalyzeResult struct {
-	Success *AnalyzeResult_ `thrift:"success,0" db:"success" json:"success,omitempty"`
+//   - PermissionDenied
+//   - InvalidRequest
+type AttestationFailureAnalyzerServiceUpdateTamperingRegistryEntryResult struct {
+	PermissionDenied *PermissionDenied `thrift:"permissionDenied,1" db:"permissionDenied" json:"permissionDenied,omitempty"`
+	InvalidRequest   *InvalidRequest   `thrift:"invalidRequest,2" db:"invalidRequest" json:"invalidRequest,omitempty"`
 }
 
-func NewAttestationFailureAnalyzerServiceAnalyzeResult() *AttestationFailureAnalyzerServiceAnalyzeResult {
-	return &AttestationFailureAnalyzerServiceAnalyzeResult{}
+func NewAttestationFailureAnalyzerServiceUpdateTamperingRegistryEntryResult() *AttestationFailureAnalyzerServiceUpdateTamperingRegistryEntryResult {
+	return &AttestationFailureAnalyzerServiceUpdateTamperingRegistryEntryResult{}
 }
 
-var AttestationFailureAnalyzerServiceAnalyzeResult_Success_DEFAULT *AnalyzeResult_
+var AttestationFailureAnalyzerServiceUpdateTamperingRegistryEntryResult_PermissionDenied_DEFAULT *PermissionDenied
 
-func (p *AttestationFailureAnalyzerServiceAnalyzeResult) GetSuccess() *AnalyzeResult_ {
-	if !p.IsSetSuccess() {
-		return AttestationFailureAnalyzerServiceAnalyzeResult_Success_DEFAULT
+func (p *AttestationFailureAnalyzerServiceUpdateTamperingRegistryEntryResult) GetPermissionDenied() *PermissionDenied {
+	if !p.IsSetPermissionDenied() {
+		return AttestationFailureAnalyzerServiceUpdateTamperingRegistryEntryResult_PermissionDenied_DEFAULT
 	}
-	return p.Success
+	return p.PermissionDenied
 }
-func (p *AttestationFailureAnalyzerServiceAnalyzeResult) IsSetSuccess() bool {
-	return p.Success != nil
+
+var AttestationFailureAnalyzerServiceUpdateTamperingRegistryEntryResult_InvalidRequest_DEFAULT *InvalidRequest
+
+func (p *AttestationFailureAnalyzerServiceUpdateTamperingRegistryEntryResult) GetInvalidRequest() *InvalidRequest {
+	if !p.IsSetInvalidRequest() {
+		return AttestationFailureAnalyzerServiceUpdateTamperingRegistryEntryResult_InvalidRequest_DEFAULT
+	}
+	return p.InvalidRequest
+}
+func (p *AttestationFailureAnalyzerServiceUpdateTamperingRegistryEntryResult) IsSetPermissionDenied() bool {
+	return p.PermissionDenied != nil
 }
 
-func (p *AttestationFailureAnalyzerServiceAnalyzeResult) Read(ctx context.Context, iprot thrift.TProtocol) error {
+func (p *AttestationFailureAnalyzerServiceUpdateTamperingRegistryEntryResult) IsSetInvalidRequest() bool {
+	return p.InvalidRequest != nil
+}
+
+func (p *AttestationFailureAnalyzerServiceUpdateTamperingRegistryEntryResult) Read(ctx context.Context, iprot thrift.TProtocol) error {
 	if _, err := iprot.ReadStructBegin(ctx); err != nil {
 		return thrift.PrependError(fmt.Sprintf("%T read error: ", p), err)
 	}
@@ -9351,9 +23915,19 @@ func (p *AttestationFailureAnalyzerServiceAnalyzeResult) Read(ctx context.Contex
 			break
 		}
 		switch fieldId {
-		case 0:
+		case 1:
 			if fieldTypeId == thrift.STRUCT {
-				if err := p.ReadField0(ctx, iprot); err != nil {
+				if err := p.ReadField1(ctx, iprot); err != nil {
+					return err
+				}
+			} else {
+				if err := iprot.Skip(ctx, fieldTypeId); err != nil {
+					return err
+				}
+			}
+		case 2:
+			if fieldTypeId == thrift.STRUCT {
+				if err := p.ReadField2(ctx, iprot); err != nil {
 					return err
 				}
 			} else {
@@ -9376,20 +23950,31 @@ func (p *AttestationFailureAnalyzerServiceAnalyzeResult) Read(ctx context.Contex
 	return nil
 }
 
-func (p *AttestationFailureAnalyzerServiceAnalyzeResult) ReadField0(ctx context.Context, iprot thrift.TProtocol) error {
-	p.Success = &AnalyzeResult_{}
-	if err := p.Success.Read(ctx, iprot); err != nil {
-		return thrift.PrependError(fmt.Sprintf("%T error reading struct: ", p.Success), err)
+func (p *AttestationFailureAnalyzerServiceUpdateTamperingRegistryEntryResult) ReadField1(ctx context.Context, iprot thrift.TProtocol) error {
+	p.PermissionDenied = &PermissionDenied{}
+	if err := p.PermissionDenied.Read(ctx, iprot); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T error reading struct: ", p.PermissionDenied), err)
 	}
 	return nil
 }
 
-func (p *AttestationFailureAnalyzerServiceAnalyzeResult) Write(ctx context.Context, oprot thrift.TProtocol) error {
-	if err := oprot.WriteStructBegin(ctx, "Analyze_result"); err != nil {
+func (p *AttestationFailureAnalyzerServiceUpdateTamperingRegistryEntryResult) ReadField2(ctx context.Context, iprot thrift.TProtocol) error {
+	p.InvalidRequest = &InvalidRequest{}
+	if err := p.InvalidRequest.Read(ctx, iprot); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T error reading struct: ", p.InvalidRequest), err)
+	}
+	return nil
+}
+
+func (p *AttestationFailureAnalyzerServiceUpdateTamperingRegistryEntryResult) Write(ctx context.Context, oprot thrift.TProtocol) error {
+	if err := oprot.WriteStructBegin(ctx, "UpdateTamperingRegistryEntry_result"); err != nil {
 		return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
 	}
 	if p != nil {
-		if err := p.writeField0(ctx, oprot); err != nil {
+		if err := p.writeField1(ctx, oprot); err != nil {
+			return err
+		}
+		if err := p.writeField2(ctx, oprot); err != nil {
 			return err
 		}
 	}
@@ -9402,51 +23987,66 @@ func (p *AttestationFailureAnalyzerServiceAnalyzeResult) Write(ctx context.Conte
 	return nil
 }
 
-func (p *AttestationFailureAnalyzerServiceAnalyzeResult) writeField0(ctx context.Context, oprot thrift.TProtocol) (err error) {
-	if p.IsSetSuccess() {
-		if err := oprot.WriteFieldBegin(ctx, "success", thrift.STRUCT, 0); err != nil {
-			return thrift.PrependError(fmt.Sprintf("%T write field begin error 0:success: ", p), err)
+func (p *AttestationFailureAnalyzerServiceUpdateTamperingRegistryEntryResult) writeField1(ctx context.Context, oprot thrift.TProtocol) (err error) {
+	if p.IsSetPermissionDenied() {
+		if err := oprot.WriteFieldBegin(ctx, "permissionDenied", thrift.STRUCT, 1); err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T write field begin error 1:permissionDenied: ", p), err)
 		}
-		if err := p.Success.Write(ctx, oprot); err != nil {
-			return thrift.PrependError(fmt.Sprintf("%T error writing struct: ", p.Success), err)
+		if err := p.PermissionDenied.Write(ctx, oprot); err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T error writing struct: ", p.PermissionDenied), err)
 		}
 		if err := oprot.WriteFieldEnd(ctx); err != nil {
-			return thrift.PrependError(fmt.Sprintf("%T write field end error 0:success: ", p), err)
+			return thrift.PrependError(fmt.Sprintf("%T write field end error 1:permissionDenied: ", p), err)
 		}
 	}
 	return err
 }
 
-func (p *AttestationFailureAnalyzerServiceAnalyzeResult) String() string {
+func (p *AttestationFailureAnalyzerServiceUpdateTamperingRegistryEntryResult) writeField2(ctx context.Context, oprot thrift.TProtocol) (err error) {
+	if p.IsSetInvalidRequest() {
+		if err := oprot.WriteFieldBegin(ctx, "invalidRequest", thrift.STRUCT, 2); err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T write field begin error 2:invalidRequest: ", p), err)
+		}
+		if err := p.InvalidRequest.Write(ctx, oprot); err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T error writing struct: ", p.InvalidRequest), err)
+		}
+		if err := oprot.WriteFieldEnd(ctx); err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T write field end error 2:invalidRequest: ", p), err)
+		}
+	}
+	return err
+}
+
+func (p *AttestationFailureAnalyzerServiceUpdateTamperingRegistryEntryResult) String() string {
 	if p == nil {
 		return "<nil>"
 	}
-	return fmt.Sprintf("AttestationFailureAnalyzerServiceAnalyzeResult(%+v)", *p)
+	return fmt.Sprintf("AttestationFailureAnalyzerServiceUpdateTamperingRegistryEntryResult(%+v)", *p)
 }
 
 // Attributes:
 //   - Request
-type AttestationFailureAnalyzerServiceCheckFirmwareVersionArgs struct {
-	Request *CheckFirmwareVersionRequest `thrift:"request,1" db:"request" json:"request"`
+type AttestationFailureAnalyzerServiceDeleteTamperingRegistryEntryArgs struct {
+	Request *DeleteTamperingRegistryEntryRequest `thrift:"request,1" db:"request" json:"request"`
 }
 
-func NewAttestationFailureAnalyzerServiceCheckFirmwareVersionArgs() *AttestationFailureAnalyzerServiceCheckFirmwareVersionArgs {
-	return &AttestationFailureAnalyzerServiceCheckFirmwareVersionArgs{}
+func NewAttestationFailureAnalyzerServiceDeleteTamperingRegistryEntryArgs() *AttestationFailureAnalyzerServiceDeleteTamperingRegistryEntryArgs {
+	return &AttestationFailureAnalyzerServiceDeleteTamperingRegistryEntryArgs{}
 }
 
-var AttestationFailureAnalyzerServiceCheckFirmwareVersionArgs_Request_DEFAULT *CheckFirmwareVersionRequest
+var AttestationFailureAnalyzerServiceDeleteTamperingRegistryEntryArgs_Request_DEFAULT *DeleteTamperingRegistryEntryRequest
 
-func (p *AttestationFailureAnalyzerServiceCheckFirmwareVersionArgs) GetRequest() *CheckFirmwareVersionRequest {
+func (p *AttestationFailureAnalyzerServiceDeleteTamperingRegistryEntryArgs) GetRequest() *DeleteTamperingRegistryEntryRequest {
 	if !p.IsSetRequest() {
-		return AttestationFailureAnalyzerServiceCheckFirmwareVersionArgs_Request_DEFAULT
+		return AttestationFailureAnalyzerServiceDeleteTamperingRegistryEntryArgs_Request_DEFAULT
 	}
 	return p.Request
 }
-func (p *AttestationFailureAnalyzerServiceCheckFirmwareVersionArgs) IsSetRequest() bool {
+func (p *AttestationFailureAnalyzerServiceDeleteTamperingRegistryEntryArgs) IsSetRequest() bool {
 	return p.Request != nil
 }
 
-func (p *AttestationFailureAnalyzerServiceCheckFirmwareVersionArgs) Read(ctx context.Context, iprot thrift.TProtocol) error {
+func (p *AttestationFailureAnalyzerServiceDeleteTamperingRegistryEntryArgs) Read(ctx context.Context, iprot thrift.TProtocol) error {
 	if _, err := iprot.ReadStructBegin(ctx); err != nil {
 		return thrift.PrependError(fmt.Sprintf("%T read error: ", p), err)
 	}
@@ -9485,16 +24085,16 @@ func (p *AttestationFailureAnalyzerServiceCheckFirmwareVersionArgs) Read(ctx con
 	return nil
 }
 
-func (p *AttestationFailureAnalyzerServiceCheckFirmwareVersionArgs) ReadField1(ctx context.Context, iprot thrift.TProtocol) error {
-	p.Request = &CheckFirmwareVersionRequest{}
+func (p *AttestationFailureAnalyzerServiceDeleteTamperingRegistryEntryArgs) ReadField1(ctx context.Context, iprot thrift.TProtocol) error {
+	p.Request = &DeleteTamperingRegistryEntryRequest{}
 	if err := p.Request.Read(ctx, iprot); err != nil {
 		return thrift.PrependError(fmt.Sprintf("%T error reading struct: ", p.Request), err)
 	}
 	return nil
 }
 
-func (p *AttestationFailureAnalyzerServiceCheckFirmwareVersionArgs) Write(ctx context.Context, oprot thrift.TProtocol) error {
-	if err := oprot.WriteStructBegin(ctx, "CheckFirmwareVersion_args"); err != nil {
+func (p *AttestationFailureAnalyzerServiceDeleteTamperingRegistryEntryArgs) Write(ctx context.Context, oprot thrift.TProtocol) error {
+	if err := oprot.WriteStructBegin(ctx, "DeleteTamperingRegistryEntry_args"); err != nil {
 		return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
 	}
 	if p != nil {
@@ -9511,7 +24111,7 @@ func (p *AttestationFailureAnalyzerServiceCheckFirmwareVersionArgs) Write(ctx co
 	return nil
 }
 
-func (p *AttestationFailureAnalyzerServiceCheckFirmwareVersionArgs) writeField1(ctx context.Context, oprot thrift.TProtocol) (err error) {
+func (p *AttestationFailureAnalyzerServiceDeleteTamperingRegistryEntryArgs) writeField1(ctx context.Context, oprot thrift.TProtocol) (err error) {
 	if err := oprot.WriteFieldBegin(ctx, "request", thrift.STRUCT, 1); err != nil {
 		return thrift.PrependError(fmt.Sprintf("%T write field begin error 1:request: ", p), err)
 	}
@@ -9524,36 +24124,51 @@ func (p *AttestationFailureAnalyzerServiceCheckFirmwareVersionArgs) writeField1(
 	return err
 }
 
-func (p *AttestationFailureAnalyzerServiceCheckFirmwareVersionArgs) String() string {
+func (p *AttestationFailureAnalyzerServiceDeleteTamperingRegistryEntryArgs) String() string {
 	if p == nil {
 		return "<nil>"
 	}
-	return fmt.Sprintf("AttestationFailureAnalyzerServiceCheckFirmwareVersionArgs(%+v)", *p)
+	return fmt.Sprintf("AttestationFailureAnalyzerServiceDeleteTamperingRegistryEntryArgs(%+v)", *p)
 }
 
 // Attributes:
-//   - Success
-type AttestationFailureAnalyzerServiceCheckFirmwareVersionResult struct {
-	Success *CheckFirmwareVersionResult_ `thrift:"success,0" db:"success" json:"success,omitempty"`
+//   - PermissionDenied
+//   - InvalidRequest
+type AttestationFailureAnalyzerServiceDeleteTamperingRegistryEntryResult struct {
+	PermissionDenied *PermissionDenied `thrift:"permissionDenied,1" db:"permissionDenied" json:"permissionDenied,omitempty"`
+	InvalidRequest   *InvalidRequest   `thrift:"invalidRequest,2" db:"invalidRequest" json:"invalidRequest,omitempty"`
 }
 
-func NewAttestationFailureAnalyzerServiceCheckFirmwareVersionResult() *AttestationFailureAnalyzerServiceCheckFirmwareVersionResult {
-	return &AttestationFailureAnalyzerServiceCheckFirmwareVersionResult{}
+func NewAttestationFailureAnalyzerServiceDeleteTamperingRegistryEntryResult() *AttestationFailureAnalyzerServiceDeleteTamperingRegistryEntryResult {
+	return &AttestationFailureAnalyzerServiceDeleteTamperingRegistryEntryResult{}
 }
 
-var AttestationFailureAnalyzerServiceCheckFirmwareVersionResult_Success_DEFAULT *CheckFirmwareVersionResult_
+var AttestationFailureAnalyzerServiceDeleteTamperingRegistryEntryResult_PermissionDenied_DEFAULT *PermissionDenied
 
-func (p *AttestationFailureAnalyzerServiceCheckFirmwareVersionResult) GetSuccess() *CheckFirmwareVersionResult_ {
-	if !p.IsSetSuccess() {
-		return AttestationFailureAnalyzerServiceCheckFirmwareVersionResult_Success_DEFAULT
+func (p *AttestationFailureAnalyzerServiceDeleteTamperingRegistryEntryResult) GetPermissionDenied() *PermissionDenied {
+	if !p.IsSetPermissionDenied() {
+		return AttestationFailureAnalyzerServiceDeleteTamperingRegistryEntryResult_PermissionDenied_DEFAULT
 	}
-	return p.Success
+	return p.PermissionDenied
 }
-func (p *AttestationFailureAnalyzerServiceCheckFirmwareVersionResult) IsSetSuccess() bool {
-	return p.Success != nil
+
+var AttestationFailureAnalyzerServiceDeleteTamperingRegistryEntryResult_InvalidRequest_DEFAULT *InvalidRequest
+
+func (p *AttestationFailureAnalyzerServiceDeleteTamperingRegistryEntryResult) GetInvalidRequest() *InvalidRequest {
+	if !p.IsSetInvalidRequest() {
+		return AttestationFailureAnalyzerServiceDeleteTamperingRegistryEntryResult_InvalidRequest_DEFAULT
+	}
+	return p.InvalidRequest
+}
+func (p *AttestationFailureAnalyzerServiceDeleteTamperingRegistryEntryResult) IsSetPermissionDenied() bool {
+	return p.PermissionDenied != nil
 }
 
-func (p *AttestationFailureAnalyzerServiceCheckFirmwareVersionResult) Read(ctx context.Context, iprot thrift.TProtocol) error {
+func (p *AttestationFailureAnalyzerServiceDeleteTamperingRegistryEntryResult) IsSetInvalidRequest() bool {
+	return p.InvalidRequest != nil
+}
+
+func (p *AttestationFailureAnalyzerServiceDeleteTamperingRegistryEntryResult) Read(ctx context.Context, iprot thrift.TProtocol) error {
 	if _, err := iprot.ReadStructBegin(ctx); err != nil {
 		return thrift.PrependError(fmt.Sprintf("%T read error: ", p), err)
 	}
@@ -9567,9 +24182,19 @@ func (p *AttestationFailureAnalyzerServiceCheckFirmwareVersionResult) Read(ctx c
 			break
 		}
 		switch fieldId {
-		case 0:
+		case 1:
 			if fieldTypeId == thrift.STRUCT {
-				if err := p.ReadField0(ctx, iprot); err != nil {
+				if err := p.ReadField1(ctx, iprot); err != nil {
+					return err
+				}
+			} else {
+				if err := iprot.Skip(ctx, fieldTypeId); err != nil {
+					return err
+				}
+			}
+		case 2:
+			if fieldTypeId == thrift.STRUCT {
+				if err := p.ReadField2(ctx, iprot); err != nil {
 					return err
 				}
 			} else {
@@ -9592,20 +24217,31 @@ func (p *AttestationFailureAnalyzerServiceCheckFirmwareVersionResult) Read(ctx c
 	return nil
 }
 
-func (p *AttestationFailureAnalyzerServiceCheckFirmwareVersionResult) ReadField0(ctx context.Context, iprot thrift.TProtocol) error {
-	p.Success = &CheckFirmwareVersionResult_{}
-	if err := p.Success.Read(ctx, iprot); err != nil {
-		return thrift.PrependError(fmt.Sprintf("%T error reading struct: ", p.Success), err)
+func (p *AttestationFailureAnalyzerServiceDeleteTamperingRegistryEntryResult) ReadField1(ctx context.Context, iprot thrift.TProtocol) error {
+	p.PermissionDenied = &PermissionDenied{}
+	if err := p.PermissionDenied.Read(ctx, iprot); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T error reading struct: ", p.PermissionDenied), err)
 	}
 	return nil
 }
 
-func (p *AttestationFailureAnalyzerServiceCheckFirmwareVersionResult) Write(ctx context.Context, oprot thrift.TProtocol) error {
-	if err := oprot.WriteStructBegin(ctx, "CheckFirmwareVersion_result"); err != nil {
+func (p *AttestationFailureAnalyzerServiceDeleteTamperingRegistryEntryResult) ReadField2(ctx context.Context, iprot thrift.TProtocol) error {
+	p.InvalidRequest = &InvalidRequest{}
+	if err := p.InvalidRequest.Read(ctx, iprot); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T error reading struct: ", p.InvalidRequest), err)
+	}
+	return nil
+}
+
+func (p *AttestationFailureAnalyzerServiceDeleteTamperingRegistryEntryResult) Write(ctx context.Context, oprot thrift.TProtocol) error {
+	if err := oprot.WriteStructBegin(ctx, "DeleteTamperingRegistryEntry_result"); err != nil {
 		return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
 	}
 	if p != nil {
-		if err := p.writeField0(ctx, oprot); err != nil {
+		if err := p.writeField1(ctx, oprot); err != nil {
+			return err
+		}
+		if err := p.writeField2(ctx, oprot); err != nil {
 			return err
 		}
 	}
@@ -9618,24 +24254,39 @@ func (p *AttestationFailureAnalyzerServiceCheckFirmwareVersionResult) Write(ctx
 	return nil
 }
 
-func (p *AttestationFailureAnalyzerServiceCheckFirmwareVersionResult) writeField0(ctx context.Context, oprot thrift.TProtocol) (err error) {
-	if p.IsSetSuccess() {
-		if err := oprot.WriteFieldBegin(ctx, "success", thrift.STRUCT, 0); err != nil {
-			return thrift.PrependError(fmt.Sprintf("%T write field begin error 0:success: ", p), err)
+func (p *AttestationFailureAnalyzerServiceDeleteTamperingRegistryEntryResult) writeField1(ctx context.Context, oprot thrift.TProtocol) (err error) {
+	if p.IsSetPermissionDenied() {
+		if err := oprot.WriteFieldBegin(ctx, "permissionDenied", thrift.STRUCT, 1); err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T write field begin error 1:permissionDenied: ", p), err)
 		}
-		if err := p.Success.Write(ctx, oprot); err != nil {
-			return thrift.PrependError(fmt.Sprintf("%T error writing struct: ", p.Success), err)
+		if err := p.PermissionDenied.Write(ctx, oprot); err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T error writing struct: ", p.PermissionDenied), err)
 		}
 		if err := oprot.WriteFieldEnd(ctx); err != nil {
-			return thrift.PrependError(fmt.Sprintf("%T write field end error 0:success: ", p), err)
+			return thrift.PrependError(fmt.Sprintf("%T write field end error 1:permissionDenied: ", p), err)
 		}
 	}
 	return err
 }
 
-func (p *AttestationFailureAnalyzerServiceCheckFirmwareVersionResult) String() string {
+func (p *AttestationFailureAnalyzerServiceDeleteTamperingRegistryEntryResult) writeField2(ctx context.Context, oprot thrift.TProtocol) (err error) {
+	if p.IsSetInvalidRequest() {
+		if err := oprot.WriteFieldBegin(ctx, "invalidRequest", thrift.STRUCT, 2); err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T write field begin error 2:invalidRequest: ", p), err)
+		}
+		if err := p.InvalidRequest.Write(ctx, oprot); err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T error writing struct: ", p.InvalidRequest), err)
+		}
+		if err := oprot.WriteFieldEnd(ctx); err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T write field end error 2:invalidRequest: ", p), err)
+		}
+	}
+	return err
+}
+
+func (p *AttestationFailureAnalyzerServiceDeleteTamperingRegistryEntryResult) String() string {
 	if p == nil {
 		return "<nil>"
 	}
-	return fmt.Sprintf("AttestationFailureAnalyzerServiceCheckFirmwareVersionResult(%+v)", *p)
+	return fmt.Sprintf("AttestationFailureAnalyzerServiceDeleteTamperingRegistryEntryResult(%+v)", *p)
 }
@@ -34,10 +34,22 @@ func Usage() {
 	fmt.Fprintln(os.Stderr, "Usage of ", os.Args[0], " [-h host:port] [-u url] [-f[ramed]] function [arg1 [arg2...]]:")
 	flag.PrintDefaults()
 	fmt.Fprintln(os.Stderr, "\nFunctions:")
+	fmt.Fprintln(os.Stderr, "  ServerInfo GetServerInfo(GetServerInfoRequest request)")
 	fmt.Fprintln(os.Stderr, "  SearchFirmwareResult SearchFirmware(SearchFirmwareRequest request)")
 	fmt.Fprintln(os.Stderr, "  SearchReportResult SearchReport(SearchReportRequest request)")
 	fmt.Fprintln(os.Stderr, "  AnalyzeResult Analyze(AnalyzeRequest request)")
 	fmt.Fprintln(os.Stderr, "  CheckFirmwareVersionResult CheckFirmwareVersion(CheckFirmwareVersionRequest request)")
+	fmt.Fprintln(os.Stderr, "  SearchByPCRResult SearchByPCR(SearchByPCRRequest request)")
+	fmt.Fprintln(os.Stderr, "  CompareReportsResult CompareReports(CompareReportsRequest request)")
+	fmt.Fprintln(os.Stderr, "  AggregateReportsResult AggregateReports(AggregateReportsRequest request)")
+	fmt.Fprintln(os.Stderr, "  NegotiateImageUploadResult NegotiateImageUpload(NegotiateImageUploadRequest request)")
+	fmt.Fprintln(os.Stderr, "  InsertOriginalFirmwareResult InsertOriginalFirmware(InsertOriginalFirmwareRequest request)")
+	fmt.Fprintln(os.Stderr, "  void UpdateOriginalFirmware(UpdateOriginalFirmwareRequest request)")
+	fmt.Fprintln(os.Stderr, "  void DeleteOriginalFirmware(DeleteOriginalFirmwareRequest request)")
+	fmt.Fprintln(os.Stderr, "  ListTamperingRegistryEntriesResult ListTamperingRegistryEntries(ListTamperingRegistryEntriesRequest request)")
+	fmt.Fprintln(os.Stderr, "  InsertTamperingRegistryEntryResult InsertTamperingRegistryEntry(InsertTamperingRegistryEntryRequest request)")
+	fmt.Fprintln(os.Stderr, "  void UpdateTamperingRegistryEntry(UpdateTamperingRegistryEntryRequest request)")
+	fmt.Fprintln(os.Stderr, "  void DeleteTamperingRegistryEntry(DeleteTamperingRegistryEntryRequest request)")
 	fmt.Fprintln(os.Stderr)
 	os.Exit(0)
 }
@@ -159,24 +171,49 @@ func main() {
 	}
 
 	switch cmd {
+	case "GetServerInfo":
+		if flag.NArg()-1 != 1 {
+			fmt.Fprintln(os.Stderr, "GetServerInfo requires 1 args")
+			flag.Usage()
+		}
+		arg99 := flag.Arg(1)
+		mbTrans100 := thrift.NewTMemoryBufferLen(len(arg99))
+		defer mbTrans100.Close()
+		_, err101 := mbTrans100.WriteString(arg99)
+		if err101 != nil {
+			Usage()
+			return
+		}
+		factory102 := thrift.NewTJSONProtocolFactory()
+		jsProt103 := factory102.GetProtocol(mbTrans100)
+		argvalue0 := afas.NewGetServerInfoRequest()
+		err104 := argvalue0.Read(context.Background(), jsProt103)
+		if err104 != nil {
+			Usage()
+			return
+		}
+		value0 := argvalue0
+		fmt.Print(client.GetServerInfo(context.Background(), value0))
+		fmt.Print("\n")
+		break
 	case "SearchFirmware":
 		if flag.NArg()-1 != 1 {
 			fmt.Fprintln(os.Stderr, "SearchFirmware requires 1 args")
 			flag.Usage()
 		}
-		arg30 := flag.Arg(1)
-		mbTrans31 := thrift.NewTMemoryBufferLen(len(arg30))
-		defer mbTrans31.Close()
-		_, err32 := mbTrans31.WriteString(arg30)
-		if err32 != nil {
+		arg105 := flag.Arg(1)
+		mbTrans106 := thrift.NewTMemoryBufferLen(len(arg105))
+		defer mbTrans106.Close()
+		_, err107 := mbTrans106.WriteString(arg105)
+		if err107 != nil {
 			Usage()
 			return
 		}
-		factory33 := thrift.NewTJSONProtocolFactory()
-		jsProt34 := factory33.GetProtocol(mbTrans31)
+		factory108 := thrift.NewTJSONProtocolFactory()
+		jsProt109 := factory108.GetProtocol(mbTrans106)
 		argvalue0 := afas.NewSearchFirmwareRequest()
-		err35 := argvalue0.Read(context.Background(), jsProt34)
-		if err35 != nil {
+		err110 := argvalue0.Read(context.Background(), jsProt109)
+		if err110 != nil {
 			Usage()
 			return
 		}
@@ -189,19 +226,19 @@ func main() {
 			fmt.Fprintln(os.Stderr, "SearchReport requires 1 args")
 			flag.Usage()
 		}
-		arg36 := flag.Arg(1)
-		mbTrans37 := thrift.NewTMemoryBufferLen(len(arg36))
-		defer mbTrans37.Close()
-		_, err38 := mbTrans37.WriteString(arg36)
-		if err38 != nil {
+		arg111 := flag.Arg(1)
+		mbTrans112 := thrift.NewTMemoryBufferLen(len(arg111))
+		defer mbTrans112.Close()
+		_, err113 := mbTrans112.WriteString(arg111)
+		if err113 != nil {
 			Usage()
 			return
 		}
-		factory39 := thrift.NewTJSONProtocolFactory()
-		jsProt40 := factory39.GetProtocol(mbTrans37)
+		factory114 := thrift.NewTJSONProtocolFactory()
+		jsProt115 := factory114.GetProtocol(mbTrans112)
 		argvalue0 := afas.NewSearchReportRequest()
-		err41 := argvalue0.Read(context.Background(), jsProt40)
-		if err41 != nil {
+		err116 := argvalue0.Read(context.Background(), jsProt115)
+		if err116 != nil {
 			Usage()
 			return
 		}
@@ -214,19 +251,19 @@ func main() {
 			fmt.Fprintln(os.Stderr, "Analyze requires 1 args")
 			flag.Usage()
 		}
-		arg42 := flag.Arg(1)
-		mbTrans43 := thrift.NewTMemoryBufferLen(len(arg42))
-		defer mbTrans43.Close()
-		_, err44 := mbTrans43.WriteString(arg42)
-		if err44 != nil {
+		arg117 := flag.Arg(1)
+		mbTrans118 := thrift.NewTMemoryBufferLen(len(arg117))
+		defer mbTrans118.Close()
+		_, err119 := mbTrans118.WriteString(arg117)
+		if err119 != nil {
 			Usage()
 			return
 		}
-		factory45 := thrift.NewTJSONProtocolFactory()
-		jsProt46 := factory45.GetProtocol(mbTrans43)
+		factory120 := thrift.NewTJSONProtocolFactory()
+		jsProt121 := factory120.GetProtocol(mbTrans118)
 		argvalue0 := afas.NewAnalyzeRequest()
-		err47 := argvalue0.Read(context.Background(), jsProt46)
-		if err47 != nil {
+		err122 := argvalue0.Read(context.Background(), jsProt121)
+		if err122 != nil {
 			Usage()
 			return
 		}
@@ -239,19 +276,19 @@ func main() {
 			fmt.Fprintln(os.Stderr, "CheckFirmwareVersion requires 1 args")
 			flag.Usage()
 		}
-		arg48 := flag.Arg(1)
-		mbTrans49 := thrift.NewTMemoryBufferLen(len(arg48))
-		defer mbTrans49.Close()
-		_, err50 := mbTrans49.WriteString(arg48)
-		if err50 != nil {
+		arg123 := flag.Arg(1)
+		mbTrans124 := thrift.NewTMemoryBufferLen(len(arg123))
+		defer mbTrans124.Close()
+		_, err125 := mbTrans124.WriteString(arg123)
+		if err125 != nil {
 			Usage()
 			return
 		}
-		factory51 := thrift.NewTJSONProtocolFactory()
-		jsProt52 := factory51.GetProtocol(mbTrans49)
+		factory126 := thrift.NewTJSONProtocolFactory()
+		jsProt127 := factory126.GetProtocol(mbTrans124)
 		argvalue0 := afas.NewCheckFirmwareVersionRequest()
-		err53 := argvalue0.Read(context.Background(), jsProt52)
-		if err53 != nil {
+		err128 := argvalue0.Read(context.Background(), jsProt127)
+		if err128 != nil {
 			Usage()
 			return
 		}
@@ -259,6 +296,281 @@ func main() {
 		fmt.Print(client.CheckFirmwareVersion(context.Background(), value0))
 		fmt.Print("\n")
 		break
+	case "SearchByPCR":
+		if flag.NArg()-1 != 1 {
+			fmt.Fprintln(os.Stderr, "SearchByPCR requires 1 args")
+			flag.Usage()
+		}
+		arg129 := flag.Arg(1)
+		mbTrans130 := thrift.NewTMemoryBufferLen(len(arg129))
+		defer mbTrans130.Close()
+		_, err131 := mbTrans130.WriteString(arg129)
+		if err131 != nil {
+			Usage()
+			return
+		}
+		factory132 := thrift.NewTJSONProtocolFactory()
+		jsProt133 := factory132.GetProtocol(mbTrans130)
+		argvalue0 := afas.NewSearchByPCRRequest()
+		err134 := argvalue0.Read(context.Background(), jsProt133)
+		if err134 != nil {
+			Usage()
+			return
+		}
+		value0 := argvalue0
+		fmt.Print(client.SearchByPCR(context.Background(), value0))
+		fmt.Print("\n")
+		break
+	case "CompareReports":
+		if flag.NArg()-1 != 1 {
+			fmt.Fprintln(os.Stderr, "CompareReports requires 1 args")
+			flag.Usage()
+		}
+		arg135 := flag.Arg(1)
+		mbTrans136 := thrift.NewTMemoryBufferLen(len(arg135))
+		defer mbTrans136.Close()
+		_, err137 := mbTrans136.WriteString(arg135)
+		if err137 != nil {
+			Usage()
+			return
+		}
+		factory138 := thrift.NewTJSONProtocolFactory()
+		jsProt139 := factory138.GetProtocol(mbTrans136)
+		argvalue0 := afas.NewCompareReportsRequest()
+		err140 := argvalue0.Read(context.Background(), jsProt139)
+		if err140 != nil {
+			Usage()
+			return
+		}
+		value0 := argvalue0
+		fmt.Print(client.CompareReports(context.Background(), value0))
+		fmt.Print("\n")
+		break
+	case "AggregateReports":
+		if flag.NArg()-1 != 1 {
+			fmt.Fprintln(os.Stderr, "AggregateReports requires 1 args")
+			flag.Usage()
+		}
+		arg141 := flag.Arg(1)
+		mbTrans142 := thrift.NewTMemoryBufferLen(len(arg141))
+		defer mbTrans142.Close()
+		_, err143 := mbTrans142.WriteString(arg141)
+		if err143 != nil {
+			Usage()
+			return
+		}
+		factory144 := thrift.NewTJSONProtocolFactory()
+		jsProt145 := factory144.GetProtocol(mbTrans142)
+		argvalue0 := afas.NewAggregateReportsRequest()
+		err146 := argvalue0.Read(context.Background(), jsProt145)
+		if err146 != nil {
+			Usage()
+			return
+		}
+		value0 := argvalue0
+		fmt.Print(client.AggregateReports(context.Background(), value0))
+		fmt.Print("\n")
+		break
+	case "NegotiateImageUpload":
+		if flag.NArg()-1 != 1 {
+			fmt.Fprintln(os.Stderr, "NegotiateImageUpload requires 1 args")
+			flag.Usage()
+		}
+		arg147 := flag.Arg(1)
+		mbTrans148 := thrift.NewTMemoryBufferLen(len(arg147))
+		defer mbTrans148.Close()
+		_, err149 := mbTrans148.WriteString(arg147)
+		if err149 != nil {
+			Usage()
+			return
+		}
+		factory150 := thrift.NewTJSONProtocolFactory()
+		jsProt151 := factory150.GetProtocol(mbTrans148)
+		argvalue0 := afas.NewNegotiateImageUploadRequest()
+		err152 := argvalue0.Read(context.Background(), jsProt151)
+		if err152 != nil {
+			Usage()
+			return
+		}
+		value0 := argvalue0
+		fmt.Print(client.NegotiateImageUpload(context.Background(), value0))
+		fmt.Print("\n")
+		break
+	case "InsertOriginalFirmware":
+		if flag.NArg()-1 != 1 {
+			fmt.Fprintln(os.Stderr, "InsertOriginalFirmware requires 1 args")
+			flag.Usage()
+		}
+		arg153 := flag.Arg(1)
+		mbTrans154 := thrift.NewTMemoryBufferLen(len(arg153))
+		defer mbTrans154.Close()
+		_, err155 := mbTrans154.WriteString(arg153)
+		if err155 != nil {
+			Usage()
+			return
+		}
+		factory156 := thrift.NewTJSONProtocolFactory()
+		jsProt157 := factory156.GetProtocol(mbTrans154)
+		argvalue0 := afas.NewInsertOriginalFirmwareRequest()
+		err158 := argvalue0.Read(context.Background(), jsProt157)
+		if err158 != nil {
+			Usage()
+			return
+		}
+		value0 := argvalue0
+		fmt.Print(client.InsertOriginalFirmware(context.Background(), value0))
+		fmt.Print("\n")
+		break
+	case "UpdateOriginalFirmware":
+		if flag.NArg()-1 != 1 {
+			fmt.Fprintln(os.Stderr, "UpdateOriginalFirmware requires 1 args")
+			flag.Usage()
+		}
+		arg159 := flag.Arg(1)
+		mbTrans160 := thrift.NewTMemoryBufferLen(len(arg159))
+		defer mbTrans160.Close()
+		_, err161 := mbTrans160.WriteString(arg159)
+		if err161 != nil {
+			Usage()
+			return
+		}
+		factory162 := thrift.NewTJSONProtocolFactory()
+		jsProt163 := factory162.GetProtocol(mbTrans160)
+		argvalue0 := afas.NewUpdateOriginalFirmwareRequest()
+		err164 := argvalue0.Read(context.Background(), jsProt163)
+		if err164 != nil {
+			Usage()
+			return
+		}
+		value0 := argvalue0
+		fmt.Print(client.UpdateOriginalFirmware(context.Background(), value0))
+		fmt.Print("\n")
+		break
+	case "DeleteOriginalFirmware":
+		if flag.NArg()-1 != 1 {
+			fmt.Fprintln(os.Stderr, "DeleteOriginalFirmware requires 1 args")
+			flag.Usage()
+		}
+		arg165 := flag.Arg(1)
+		mbTrans166 := thrift.NewTMemoryBufferLen(len(arg165))
+		defer mbTrans166.Close()
+		_, err167 := mbTrans166.WriteString(arg165)
+		if err167 != nil {
+			Usage()
+			return
+		}
+		factory168 := thrift.NewTJSONProtocolFactory()
+		jsProt169 := factory168.GetProtocol(mbTrans166)
+		argvalue0 := afas.NewDeleteOriginalFirmwareRequest()
+		err170 := argvalue0.Read(context.Background(), jsProt169)
+		if err170 != nil {
+			Usage()
+			return
+		}
+		value0 := argvalue0
+		fmt.Print(client.DeleteOriginalFirmware(context.Background(), value0))
+		fmt.Print("\n")
+		break
+	case "ListTamperingRegistryEntries":
+		if flag.NArg()-1 != 1 {
+			fmt.Fprintln(os.Stderr, "ListTamperingRegistryEntries requires 1 args")
+			flag.Usage()
+		}
+		arg171 := flag.Arg(1)
+		mbTrans172 := thrift.NewTMemoryBufferLen(len(arg171))
+		defer mbTrans172.Close()
+		_, err173 := mbTrans172.WriteString(arg171)
+		if err173 != nil {
+			Usage()
+			return
+		}
+		factory174 := thrift.NewTJSONProtocolFactory()
+		jsProt175 := factory174.GetProtocol(mbTrans172)
+		argvalue0 := afas.NewListTamperingRegistryEntriesRequest()
+		err176 := argvalue0.Read(context.Background(), jsProt175)
+		if err176 != nil {
+			Usage()
+			return
+		}
+		value0 := argvalue0
+		fmt.Print(client.ListTamperingRegistryEntries(context.Background(), value0))
+		fmt.Print("\n")
+		break
+	case "InsertTamperingRegistryEntry":
+		if flag.NArg()-1 != 1 {
+			fmt.Fprintln(os.Stderr, "InsertTamperingRegistryEntry requires 1 args")
+			flag.Usage()
+		}
+		arg177 := flag.Arg(1)
+		mbTrans178 := thrift.NewTMemoryBufferLen(len(arg177))
+		defer mbTrans178.Close()
+		_, err179 := mbTrans178.WriteString(arg177)
+		if err179 != nil {
+			Usage()
+			return
+		}
+		factory180 := thrift.NewTJSONProtocolFactory()
+		jsProt181 := factory180.GetProtocol(mbTrans178)
+		argvalue0 := afas.NewInsertTamperingRegistryEntryRequest()
+		err182 := argvalue0.Read(context.Background(), jsProt181)
+		if err182 != nil {
+			Usage()
+			return
+		}
+		value0 := argvalue0
+		fmt.Print(client.InsertTamperingRegistryEntry(context.Background(), value0))
+		fmt.Print("\n")
+		break
+	case "UpdateTamperingRegistryEntry":
+		if flag.NArg()-1 != 1 {
+			fmt.Fprintln(os.Stderr, "UpdateTamperingRegistryEntry requires 1 args")
+			flag.Usage()
+		}
+		arg183 := flag.Arg(1)
+		mbTrans184 := thrift.NewTMemoryBufferLen(len(arg183))
+		defer mbTrans184.Close()
+		_, err185 := mbTrans184.WriteString(arg183)
+		if err185 != nil {
+			Usage()
+			return
+		}
+		factory186 := thrift.NewTJSONProtocolFactory()
+		jsProt187 := factory186.GetProtocol(mbTrans184)
+		argvalue0 := afas.NewUpdateTamperingRegistryEntryRequest()
+		err188 := argvalue0.Read(context.Background(), jsProt187)
+		if err188 != nil {
+			Usage()
+			return
+		}
+		value0 := argvalue0
+		fmt.Print(client.UpdateTamperingRegistryEntry(context.Background(), value0))
+		fmt.Print("\n")
+		break
+	case "DeleteTamperingRegistryEntry":
+		if flag.NArg()-1 != 1 {
+			fmt.Fprintln(os.Stderr, "DeleteTamperingRegistryEntry requires 1 args")
+			flag.Usage()
+		}
+		arg189 := flag.Arg(1)
+		mbTrans190 := thrift.NewTMemoryBufferLen(len(arg189))
+		defer mbTrans190.Close()
+		_, err191 := mbTrans190.WriteString(arg189)
+		if err191 != nil {
+			Usage()
+			return
+		}
+		factory192 := thrift.NewTJSONProtocolFactory()
+		jsProt193 := factory192.GetProtocol(mbTrans190)
+		argvalue0 := afas.NewDeleteTamperingRegistryEntryRequest()
+		err194 := argvalue0.Read(context.Background(), jsProt193)
+		if err194 != nil {
+			Usage()
+			return
+		}
+		value0 := argvalue0
+		fmt.Print(client.DeleteTamperingRegistryEntry(context.Background(), value0))
+		fmt.Print("\n")
+		break
 	case "":
 		Usage()
 		break
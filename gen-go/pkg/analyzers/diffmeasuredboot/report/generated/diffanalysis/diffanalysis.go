@@ -29,6 +29,7 @@ const (
 	DiffDiagnosis_FirmwareVersionMismatch DiffDiagnosis = 4
 	DiffDiagnosis_InvalidOriginalFirmware DiffDiagnosis = 5
 	DiffDiagnosis_KnownTamperedHost       DiffDiagnosis = 6
+	DiffDiagnosis_UnstableDump            DiffDiagnosis = 7
 )
 
 func (p DiffDiagnosis) String() string {
@@ -47,6 +48,8 @@ func (p DiffDiagnosis) String() string {
 		return "InvalidOriginalFirmware"
 	case DiffDiagnosis_KnownTamperedHost:
 		return "KnownTamperedHost"
+	case DiffDiagnosis_UnstableDump:
+		return "UnstableDump"
 	}
 	return "<UNSET>"
 }
@@ -67,6 +70,8 @@ func DiffDiagnosisFromString(s string) (DiffDiagnosis, error) {
 		return DiffDiagnosis_InvalidOriginalFirmware, nil
 	case "KnownTamperedHost":
 		return DiffDiagnosis_KnownTamperedHost, nil
+	case "UnstableDump":
+		return DiffDiagnosis_UnstableDump, nil
 	}
 	return DiffDiagnosis(0), fmt.Errorf("not a valid DiffDiagnosis string")
 }
@@ -102,6 +107,583 @@ func (p *DiffDiagnosis) Value() (driver.Value, error) {
 	return int64(*p), nil
 }
 
+type DiffRegion int64
+
+const (
+	DiffRegion_Undefined DiffRegion = 0
+	DiffRegion_Other     DiffRegion = 1
+	DiffRegion_NVRAM     DiffRegion = 2
+	DiffRegion_Microcode DiffRegion = 3
+	DiffRegion_Padding   DiffRegion = 4
+	DiffRegion_PEICode   DiffRegion = 5
+	DiffRegion_DXECode   DiffRegion = 6
+	DiffRegion_FIT       DiffRegion = 7
+	DiffRegion_Signature DiffRegion = 8
+	DiffRegion_ACM       DiffRegion = 9
+)
+
+func (p DiffRegion) String() string {
+	switch p {
+	case DiffRegion_Undefined:
+		return "Undefined"
+	case DiffRegion_Other:
+		return "Other"
+	case DiffRegion_NVRAM:
+		return "NVRAM"
+	case DiffRegion_Microcode:
+		return "Microcode"
+	case DiffRegion_Padding:
+		return "Padding"
+	case DiffRegion_PEICode:
+		return "PEICode"
+	case DiffRegion_DXECode:
+		return "DXECode"
+	case DiffRegion_FIT:
+		return "FIT"
+	case DiffRegion_Signature:
+		return "Signature"
+	case DiffRegion_ACM:
+		return "ACM"
+	}
+	return "<UNSET>"
+}
+
+func DiffRegionFromString(s string) (DiffRegion, error) {
+	switch s {
+	case "Undefined":
+		return DiffRegion_Undefined, nil
+	case "Other":
+		return DiffRegion_Other, nil
+	case "NVRAM":
+		return DiffRegion_NVRAM, nil
+	case "Microcode":
+		return DiffRegion_Microcode, nil
+	case "Padding":
+		return DiffRegion_Padding, nil
+	case "PEICode":
+		return DiffRegion_PEICode, nil
+	case "DXECode":
+		return DiffRegion_DXECode, nil
+	case "FIT":
+		return DiffRegion_FIT, nil
+	case "Signature":
+		return DiffRegion_Signature, nil
+	case "ACM":
+		return DiffRegion_ACM, nil
+	}
+	return DiffRegion(0), fmt.Errorf("not a valid DiffRegion string")
+}
+
+func DiffRegionPtr(v DiffRegion) *DiffRegion { return &v }
+
+func (p DiffRegion) MarshalText() ([]byte, error) {
+	return []byte(p.String()), nil
+}
+
+func (p *DiffRegion) UnmarshalText(text []byte) error {
+	q, err := DiffRegionFromString(string(text))
+	if err != nil {
+		return err
+	}
+	*p = q
+	return nil
+}
+
+func (p *DiffRegion) Scan(value interface{}) error {
+	v, ok := value.(int64)
+	if !ok {
+		return errors.New("Scan value is not int64")
+	}
+	*p = DiffRegion(v)
+	return nil
+}
+
+func (p *DiffRegion) Value() (driver.Value, error) {
+	if p == nil {
+		return nil, nil
+	}
+	return int64(*p), nil
+}
+
+type DiffPattern int64
+
+const (
+	DiffPattern_Undefined   DiffPattern = 0
+	DiffPattern_Arbitrary   DiffPattern = 1
+	DiffPattern_BitFlip     DiffPattern = 2
+	DiffPattern_Erased      DiffPattern = 3
+	DiffPattern_Zeroed      DiffPattern = 4
+	DiffPattern_HighEntropy DiffPattern = 5
+)
+
+func (p DiffPattern) String() string {
+	switch p {
+	case DiffPattern_Undefined:
+		return "Undefined"
+	case DiffPattern_Arbitrary:
+		return "Arbitrary"
+	case DiffPattern_BitFlip:
+		return "BitFlip"
+	case DiffPattern_Erased:
+		return "Erased"
+	case DiffPattern_Zeroed:
+		return "Zeroed"
+	case DiffPattern_HighEntropy:
+		return "HighEntropy"
+	}
+	return "<UNSET>"
+}
+
+func DiffPatternFromString(s string) (DiffPattern, error) {
+	switch s {
+	case "Undefined":
+		return DiffPattern_Undefined, nil
+	case "Arbitrary":
+		return DiffPattern_Arbitrary, nil
+	case "BitFlip":
+		return DiffPattern_BitFlip, nil
+	case "Erased":
+		return DiffPattern_Erased, nil
+	case "Zeroed":
+		return DiffPattern_Zeroed, nil
+	case "HighEntropy":
+		return DiffPattern_HighEntropy, nil
+	}
+	return DiffPattern(0), fmt.Errorf("not a valid DiffPattern string")
+}
+
+func DiffPatternPtr(v DiffPattern) *DiffPattern { return &v }
+
+func (p DiffPattern) MarshalText() ([]byte, error) {
+	return []byte(p.String()), nil
+}
+
+func (p *DiffPattern) UnmarshalText(text []byte) error {
+	q, err := DiffPatternFromString(string(text))
+	if err != nil {
+		return err
+	}
+	*p = q
+	return nil
+}
+
+func (p *DiffPattern) Scan(value interface{}) error {
+	v, ok := value.(int64)
+	if !ok {
+		return errors.New("Scan value is not int64")
+	}
+	*p = DiffPattern(v)
+	return nil
+}
+
+func (p *DiffPattern) Value() (driver.Value, error) {
+	if p == nil {
+		return nil, nil
+	}
+	return int64(*p), nil
+}
+
+type AlignmentSegmentKind int64
+
+const (
+	AlignmentSegmentKind_Undefined AlignmentSegmentKind = 0
+	AlignmentSegmentKind_Image     AlignmentSegmentKind = 1
+	AlignmentSegmentKind_Region    AlignmentSegmentKind = 2
+	AlignmentSegmentKind_Volume    AlignmentSegmentKind = 3
+)
+
+func (p AlignmentSegmentKind) String() string {
+	switch p {
+	case AlignmentSegmentKind_Undefined:
+		return "Undefined"
+	case AlignmentSegmentKind_Image:
+		return "Image"
+	case AlignmentSegmentKind_Region:
+		return "Region"
+	case AlignmentSegmentKind_Volume:
+		return "Volume"
+	}
+	return "<UNSET>"
+}
+
+func AlignmentSegmentKindFromString(s string) (AlignmentSegmentKind, error) {
+	switch s {
+	case "Undefined":
+		return AlignmentSegmentKind_Undefined, nil
+	case "Image":
+		return AlignmentSegmentKind_Image, nil
+	case "Region":
+		return AlignmentSegmentKind_Region, nil
+	case "Volume":
+		return AlignmentSegmentKind_Volume, nil
+	}
+	return AlignmentSegmentKind(0), fmt.Errorf("not a valid AlignmentSegmentKind string")
+}
+
+func AlignmentSegmentKindPtr(v AlignmentSegmentKind) *AlignmentSegmentKind { return &v }
+
+func (p AlignmentSegmentKind) MarshalText() ([]byte, error) {
+	return []byte(p.String()), nil
+}
+
+func (p *AlignmentSegmentKind) UnmarshalText(text []byte) error {
+	q, err := AlignmentSegmentKindFromString(string(text))
+	if err != nil {
+		return err
+	}
+	*p = q
+	return nil
+}
+
+func (p *AlignmentSegmentKind) Scan(value interface{}) error {
+	v, ok := value.(int64)
+	if !ok {
+		return errors.New("Scan value is not int64")
+	}
+	*p = AlignmentSegmentKind(v)
+	return nil
+}
+
+func (p *AlignmentSegmentKind) Value() (driver.Value, error) {
+	if p == nil {
+		return nil, nil
+	}
+	return int64(*p), nil
+}
+
+// Attributes:
+//   - Region
+//   - Pattern
+//   - EraseBlockAligned
+//   - Entropy
+//   - Score
+//   - Reason
+type DiffEntryAssessment struct {
+	Region            DiffRegion  `thrift:"Region,1" db:"Region" json:"Region"`
+	Pattern           DiffPattern `thrift:"Pattern,2" db:"Pattern" json:"Pattern"`
+	EraseBlockAligned bool        `thrift:"EraseBlockAligned,3" db:"EraseBlockAligned" json:"EraseBlockAligned"`
+	Entropy           float64     `thrift:"Entropy,4" db:"Entropy" json:"Entropy"`
+	Score             float64     `thrift:"Score,5" db:"Score" json:"Score"`
+	Reason            string      `thrift:"Reason,6" db:"Reason" json:"Reason"`
+}
+
+func NewDiffEntryAssessment() *DiffEntryAssessment {
+	return &DiffEntryAssessment{}
+}
+
+func (p *DiffEntryAssessment) GetRegion() DiffRegion {
+	return p.Region
+}
+
+func (p *DiffEntryAssessment) GetPattern() DiffPattern {
+	return p.Pattern
+}
+
+func (p *DiffEntryAssessment) GetEraseBlockAligned() bool {
+	return p.EraseBlockAligned
+}
+
+func (p *DiffEntryAssessment) GetEntropy() float64 {
+	return p.Entropy
+}
+
+func (p *DiffEntryAssessment) GetScore() float64 {
+	return p.Score
+}
+
+func (p *DiffEntryAssessment) GetReason() string {
+	return p.Reason
+}
+func (p *DiffEntryAssessment) Read(ctx context.Context, iprot thrift.TProtocol) error {
+	if _, err := iprot.ReadStructBegin(ctx); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T read error: ", p), err)
+	}
+
+	for {
+		_, fieldTypeId, fieldId, err := iprot.ReadFieldBegin(ctx)
+		if err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T field %d read error: ", p, fieldId), err)
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+		switch fieldId {
+		case 1:
+			if fieldTypeId == thrift.I32 {
+				if err := p.ReadField1(ctx, iprot); err != nil {
+					return err
+				}
+			} else {
+				if err := iprot.Skip(ctx, fieldTypeId); err != nil {
+					return err
+				}
+			}
+		case 2:
+			if fieldTypeId == thrift.I32 {
+				if err := p.ReadField2(ctx, iprot); err != nil {
+					return err
+				}
+			} else {
+				if err := iprot.Skip(ctx, fieldTypeId); err != nil {
+					return err
+				}
+			}
+		case 3:
+			if fieldTypeId == thrift.BOOL {
+				if err := p.ReadField3(ctx, iprot); err != nil {
+					return err
+				}
+			} else {
+				if err := iprot.Skip(ctx, fieldTypeId); err != nil {
+					return err
+				}
+			}
+		case 4:
+			if fieldTypeId == thrift.DOUBLE {
+				if err := p.ReadField4(ctx, iprot); err != nil {
+					return err
+				}
+			} else {
+				if err := iprot.Skip(ctx, fieldTypeId); err != nil {
+					return err
+				}
+			}
+		case 5:
+			if fieldTypeId == thrift.DOUBLE {
+				if err := p.ReadField5(ctx, iprot); err != nil {
+					return err
+				}
+			} else {
+				if err := iprot.Skip(ctx, fieldTypeId); err != nil {
+					return err
+				}
+			}
+		case 6:
+			if fieldTypeId == thrift.STRING {
+				if err := p.ReadField6(ctx, iprot); err != nil {
+					return err
+				}
+			} else {
+				if err := iprot.Skip(ctx, fieldTypeId); err != nil {
+					return err
+				}
+			}
+		default:
+			if err := iprot.Skip(ctx, fieldTypeId); err != nil {
+				return err
+			}
+		}
+		if err := iprot.ReadFieldEnd(ctx); err != nil {
+			return err
+		}
+	}
+	if err := iprot.ReadStructEnd(ctx); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
+	}
+	return nil
+}
+
+func (p *DiffEntryAssessment) ReadField1(ctx context.Context, iprot thrift.TProtocol) error {
+	if v, err := iprot.ReadI32(ctx); err != nil {
+		return thrift.PrependError("error reading field 1: ", err)
+	} else {
+		temp := DiffRegion(v)
+		p.Region = temp
+	}
+	return nil
+}
+
+func (p *DiffEntryAssessment) ReadField2(ctx context.Context, iprot thrift.TProtocol) error {
+	if v, err := iprot.ReadI32(ctx); err != nil {
+		return thrift.PrependError("error reading field 2: ", err)
+	} else {
+		temp := DiffPattern(v)
+		p.Pattern = temp
+	}
+	return nil
+}
+
+func (p *DiffEntryAssessment) ReadField3(ctx context.Context, iprot thrift.TProtocol) error {
+	if v, err := iprot.ReadBool(ctx); err != nil {
+		return thrift.PrependError("error reading field 3: ", err)
+	} else {
+		p.EraseBlockAligned = v
+	}
+	return nil
+}
+
+func (p *DiffEntryAssessment) ReadField4(ctx context.Context, iprot thrift.TProtocol) error {
+	if v, err := iprot.ReadDouble(ctx); err != nil {
+		return thrift.PrependError("error reading field 4: ", err)
+	} else {
+		p.Entropy = v
+	}
+	return nil
+}
+
+func (p *DiffEntryAssessment) ReadField5(ctx context.Context, iprot thrift.TProtocol) error {
+	if v, err := iprot.ReadDouble(ctx); err != nil {
+		return thrift.PrependError("error reading field 5: ", err)
+	} else {
+		p.Score = v
+	}
+	return nil
+}
+
+func (p *DiffEntryAssessment) ReadField6(ctx context.Context, iprot thrift.TProtocol) error {
+	if v, err := iprot.ReadString(ctx); err != nil {
+		return thrift.PrependError("error reading field 6: ", err)
+	} else {
+		p.Reason = v
+	}
+	return nil
+}
+
+func (p *DiffEntryAssessment) Write(ctx context.Context, oprot thrift.TProtocol) error {
+	if err := oprot.WriteStructBegin(ctx, "DiffEntryAssessment"); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
+	}
+	if p != nil {
+		if err := p.writeField1(ctx, oprot); err != nil {
+			return err
+		}
+		if err := p.writeField2(ctx, oprot); err != nil {
+			return err
+		}
+		if err := p.writeField3(ctx, oprot); err != nil {
+			return err
+		}
+		if err := p.writeField4(ctx, oprot); err != nil {
+			return err
+		}
+		if err := p.writeField5(ctx, oprot); err != nil {
+			return err
+		}
+		if err := p.writeField6(ctx, oprot); err != nil {
+			return err
+		}
+	}
+	if err := oprot.WriteFieldStop(ctx); err != nil {
+		return thrift.PrependError("write field stop error: ", err)
+	}
+	if err := oprot.WriteStructEnd(ctx); err != nil {
+		return thrift.PrependError("write struct stop error: ", err)
+	}
+	return nil
+}
+
+func (p *DiffEntryAssessment) writeField1(ctx context.Context, oprot thrift.TProtocol) (err error) {
+	if err := oprot.WriteFieldBegin(ctx, "Region", thrift.I32, 1); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T write field begin error 1:Region: ", p), err)
+	}
+	if err := oprot.WriteI32(ctx, int32(p.Region)); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T.Region (1) field write error: ", p), err)
+	}
+	if err := oprot.WriteFieldEnd(ctx); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T write field end error 1:Region: ", p), err)
+	}
+	return err
+}
+
+func (p *DiffEntryAssessment) writeField2(ctx context.Context, oprot thrift.TProtocol) (err error) {
+	if err := oprot.WriteFieldBegin(ctx, "Pattern", thrift.I32, 2); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T write field begin error 2:Pattern: ", p), err)
+	}
+	if err := oprot.WriteI32(ctx, int32(p.Pattern)); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T.Pattern (2) field write error: ", p), err)
+	}
+	if err := oprot.WriteFieldEnd(ctx); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T write field end error 2:Pattern: ", p), err)
+	}
+	return err
+}
+
+func (p *DiffEntryAssessment) writeField3(ctx context.Context, oprot thrift.TProtocol) (err error) {
+	if err := oprot.WriteFieldBegin(ctx, "EraseBlockAligned", thrift.BOOL, 3); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T write field begin error 3:EraseBlockAligned: ", p), err)
+	}
+	if err := oprot.WriteBool(ctx, bool(p.EraseBlockAligned)); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T.EraseBlockAligned (3) field write error: ", p), err)
+	}
+	if err := oprot.WriteFieldEnd(ctx); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T write field end error 3:EraseBlockAligned: ", p), err)
+	}
+	return err
+}
+
+func (p *DiffEntryAssessment) writeField4(ctx context.Context, oprot thrift.TProtocol) (err error) {
+	if err := oprot.WriteFieldBegin(ctx, "Entropy", thrift.DOUBLE, 4); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T write field begin error 4:Entropy: ", p), err)
+	}
+	if err := oprot.WriteDouble(ctx, float64(p.Entropy)); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T.Entropy (4) field write error: ", p), err)
+	}
+	if err := oprot.WriteFieldEnd(ctx); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T write field end error 4:Entropy: ", p), err)
+	}
+	return err
+}
+
+func (p *DiffEntryAssessment) writeField5(ctx context.Context, oprot thrift.TProtocol) (err error) {
+	if err := oprot.WriteFieldBegin(ctx, "Score", thrift.DOUBLE, 5); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T write field begin error 5:Score: ", p), err)
+	}
+	if err := oprot.WriteDouble(ctx, float64(p.Score)); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T.Score (5) field write error: ", p), err)
+	}
+	if err := oprot.WriteFieldEnd(ctx); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T write field end error 5:Score: ", p), err)
+	}
+	return err
+}
+
+func (p *DiffEntryAssessment) writeField6(ctx context.Context, oprot thrift.TProtocol) (err error) {
+	if err := oprot.WriteFieldBegin(ctx, "Reason", thrift.STRING, 6); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T write field begin error 6:Reason: ", p), err)
+	}
+	if err := oprot.WriteString(ctx, string(p.Reason)); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T.Reason (6) field write error: ", p), err)
+	}
+	if err := oprot.WriteFieldEnd(ctx); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T write field end error 6:Reason: ", p), err)
+	}
+	return err
+}
+
+func (p *DiffEntryAssessment) Equals(other *DiffEntryAssessment) bool {
+	if p == other {
+		return true
+	} else if p == nil || other == nil {
+		return false
+	}
+	if p.Region != other.Region {
+		return false
+	}
+	if p.Pattern != other.Pattern {
+		return false
+	}
+	if p.EraseBlockAligned != other.EraseBlockAligned {
+		return false
+	}
+	if p.Entropy != other.Entropy {
+		return false
+	}
+	if p.Score != other.Score {
+		return false
+	}
+	if p.Reason != other.Reason {
+		return false
+	}
+	return true
+}
+
+func (p *DiffEntryAssessment) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("DiffEntryAssessment(%+v)", *p)
+}
+
 // Attributes:
 //   - UUID
 //   - Description
@@ -1155,6 +1737,8 @@ func (p *RelatedMeasurement) String() string {
 //   - Range
 //   - RelatedMeasurements
 //   - Nodes
+//   - InUnstableRange
+//   - Assessment
 type DiffEntry struct {
 	OBSOLETE_Start           int64                 `thrift:"OBSOLETE_Start,1" db:"OBSOLETE_Start" json:"OBSOLETE_Start"`
 	OBSOLETE_Length          int64                 `thrift:"OBSOLETE_Length,2" db:"OBSOLETE_Length" json:"OBSOLETE_Length"`
@@ -1163,6 +1747,8 @@ type DiffEntry struct {
 	Range                    *Range_               `thrift:"Range,5" db:"Range" json:"Range"`
 	RelatedMeasurements      []*RelatedMeasurement `thrift:"RelatedMeasurements,6" db:"RelatedMeasurements" json:"RelatedMeasurements"`
 	Nodes                    []*NodeInfo           `thrift:"Nodes,7" db:"Nodes" json:"Nodes"`
+	InUnstableRange          bool                  `thrift:"InUnstableRange,8" db:"InUnstableRange" json:"InUnstableRange"`
+	Assessment               *DiffEntryAssessment  `thrift:"Assessment,9" db:"Assessment" json:"Assessment,omitempty"`
 }
 
 func NewDiffEntry() *DiffEntry {
@@ -1201,10 +1787,27 @@ func (p *DiffEntry) GetRelatedMeasurements() []*RelatedMeasurement {
 func (p *DiffEntry) GetNodes() []*NodeInfo {
 	return p.Nodes
 }
+
+func (p *DiffEntry) GetInUnstableRange() bool {
+	return p.InUnstableRange
+}
+
+var DiffEntry_Assessment_DEFAULT *DiffEntryAssessment
+
+func (p *DiffEntry) GetAssessment() *DiffEntryAssessment {
+	if !p.IsSetAssessment() {
+		return DiffEntry_Assessment_DEFAULT
+	}
+	return p.Assessment
+}
 func (p *DiffEntry) IsSetRange() bool {
 	return p.Range != nil
 }
 
+func (p *DiffEntry) IsSetAssessment() bool {
+	return p.Assessment != nil
+}
+
 func (p *DiffEntry) Read(ctx context.Context, iprot thrift.TProtocol) error {
 	if _, err := iprot.ReadStructBegin(ctx); err != nil {
 		return thrift.PrependError(fmt.Sprintf("%T read error: ", p), err)
@@ -1289,6 +1892,26 @@ func (p *DiffEntry) Read(ctx context.Context, iprot thrift.TProtocol) error {
 					return err
 				}
 			}
+		case 8:
+			if fieldTypeId == thrift.BOOL {
+				if err := p.ReadField8(ctx, iprot); err != nil {
+					return err
+				}
+			} else {
+				if err := iprot.Skip(ctx, fieldTypeId); err != nil {
+					return err
+				}
+			}
+		case 9:
+			if fieldTypeId == thrift.STRUCT {
+				if err := p.ReadField9(ctx, iprot); err != nil {
+					return err
+				}
+			} else {
+				if err := iprot.Skip(ctx, fieldTypeId); err != nil {
+					return err
+				}
+			}
 		default:
 			if err := iprot.Skip(ctx, fieldTypeId); err != nil {
 				return err
@@ -1388,6 +2011,23 @@ func (p *DiffEntry) ReadField7(ctx context.Context, iprot thrift.TProtocol) erro
 	return nil
 }
 
+func (p *DiffEntry) ReadField8(ctx context.Context, iprot thrift.TProtocol) error {
+	if v, err := iprot.ReadBool(ctx); err != nil {
+		return thrift.PrependError("error reading field 8: ", err)
+	} else {
+		p.InUnstableRange = v
+	}
+	return nil
+}
+
+func (p *DiffEntry) ReadField9(ctx context.Context, iprot thrift.TProtocol) error {
+	p.Assessment = &DiffEntryAssessment{}
+	if err := p.Assessment.Read(ctx, iprot); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T error reading struct: ", p.Assessment), err)
+	}
+	return nil
+}
+
 func (p *DiffEntry) Write(ctx context.Context, oprot thrift.TProtocol) error {
 	if err := oprot.WriteStructBegin(ctx, "DiffEntry"); err != nil {
 		return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
@@ -1414,6 +2054,12 @@ func (p *DiffEntry) Write(ctx context.Context, oprot thrift.TProtocol) error {
 		if err := p.writeField7(ctx, oprot); err != nil {
 			return err
 		}
+		if err := p.writeField8(ctx, oprot); err != nil {
+			return err
+		}
+		if err := p.writeField9(ctx, oprot); err != nil {
+			return err
+		}
 	}
 	if err := oprot.WriteFieldStop(ctx); err != nil {
 		return thrift.PrependError("write field stop error: ", err)
@@ -1531,6 +2177,34 @@ func (p *DiffEntry) writeField7(ctx context.Context, oprot thrift.TProtocol) (er
 	return err
 }
 
+func (p *DiffEntry) writeField8(ctx context.Context, oprot thrift.TProtocol) (err error) {
+	if err := oprot.WriteFieldBegin(ctx, "InUnstableRange", thrift.BOOL, 8); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T write field begin error 8:InUnstableRange: ", p), err)
+	}
+	if err := oprot.WriteBool(ctx, bool(p.InUnstableRange)); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T.InUnstableRange (8) field write error: ", p), err)
+	}
+	if err := oprot.WriteFieldEnd(ctx); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T write field end error 8:InUnstableRange: ", p), err)
+	}
+	return err
+}
+
+func (p *DiffEntry) writeField9(ctx context.Context, oprot thrift.TProtocol) (err error) {
+	if p.IsSetAssessment() {
+		if err := oprot.WriteFieldBegin(ctx, "Assessment", thrift.STRUCT, 9); err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T write field begin error 9:Assessment: ", p), err)
+		}
+		if err := p.Assessment.Write(ctx, oprot); err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T error writing struct: ", p.Assessment), err)
+		}
+		if err := oprot.WriteFieldEnd(ctx); err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T write field end error 9:Assessment: ", p), err)
+		}
+	}
+	return err
+}
+
 func (p *DiffEntry) Equals(other *DiffEntry) bool {
 	if p == other {
 		return true
@@ -1561,33 +2235,311 @@ func (p *DiffEntry) Equals(other *DiffEntry) bool {
 			return false
 		}
 	}
-	if len(p.Nodes) != len(other.Nodes) {
+	if len(p.Nodes) != len(other.Nodes) {
+		return false
+	}
+	for i, _tgt := range p.Nodes {
+		_src7 := other.Nodes[i]
+		if !_tgt.Equals(_src7) {
+			return false
+		}
+	}
+	if p.InUnstableRange != other.InUnstableRange {
+		return false
+	}
+	if !p.Assessment.Equals(other.Assessment) {
+		return false
+	}
+	return true
+}
+
+func (p *DiffEntry) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("DiffEntry(%+v)", *p)
+}
+
+// Attributes:
+//   - Kind
+//   - Name
+//   - Original
+//   - Actual
+type AlignmentSegment struct {
+	Kind     AlignmentSegmentKind `thrift:"Kind,1" db:"Kind" json:"Kind"`
+	Name     string               `thrift:"Name,2" db:"Name" json:"Name"`
+	Original *Range_              `thrift:"Original,3" db:"Original" json:"Original"`
+	Actual   *Range_              `thrift:"Actual,4" db:"Actual" json:"Actual"`
+}
+
+func NewAlignmentSegment() *AlignmentSegment {
+	return &AlignmentSegment{}
+}
+
+func (p *AlignmentSegment) GetKind() AlignmentSegmentKind {
+	return p.Kind
+}
+
+func (p *AlignmentSegment) GetName() string {
+	return p.Name
+}
+
+var AlignmentSegment_Original_DEFAULT *Range_
+
+func (p *AlignmentSegment) GetOriginal() *Range_ {
+	if !p.IsSetOriginal() {
+		return AlignmentSegment_Original_DEFAULT
+	}
+	return p.Original
+}
+
+var AlignmentSegment_Actual_DEFAULT *Range_
+
+func (p *AlignmentSegment) GetActual() *Range_ {
+	if !p.IsSetActual() {
+		return AlignmentSegment_Actual_DEFAULT
+	}
+	return p.Actual
+}
+func (p *AlignmentSegment) IsSetOriginal() bool {
+	return p.Original != nil
+}
+
+func (p *AlignmentSegment) IsSetActual() bool {
+	return p.Actual != nil
+}
+
+func (p *AlignmentSegment) Read(ctx context.Context, iprot thrift.TProtocol) error {
+	if _, err := iprot.ReadStructBegin(ctx); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T read error: ", p), err)
+	}
+
+	for {
+		_, fieldTypeId, fieldId, err := iprot.ReadFieldBegin(ctx)
+		if err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T field %d read error: ", p, fieldId), err)
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+		switch fieldId {
+		case 1:
+			if fieldTypeId == thrift.I32 {
+				if err := p.ReadField1(ctx, iprot); err != nil {
+					return err
+				}
+			} else {
+				if err := iprot.Skip(ctx, fieldTypeId); err != nil {
+					return err
+				}
+			}
+		case 2:
+			if fieldTypeId == thrift.STRING {
+				if err := p.ReadField2(ctx, iprot); err != nil {
+					return err
+				}
+			} else {
+				if err := iprot.Skip(ctx, fieldTypeId); err != nil {
+					return err
+				}
+			}
+		case 3:
+			if fieldTypeId == thrift.STRUCT {
+				if err := p.ReadField3(ctx, iprot); err != nil {
+					return err
+				}
+			} else {
+				if err := iprot.Skip(ctx, fieldTypeId); err != nil {
+					return err
+				}
+			}
+		case 4:
+			if fieldTypeId == thrift.STRUCT {
+				if err := p.ReadField4(ctx, iprot); err != nil {
+					return err
+				}
+			} else {
+				if err := iprot.Skip(ctx, fieldTypeId); err != nil {
+					return err
+				}
+			}
+		default:
+			if err := iprot.Skip(ctx, fieldTypeId); err != nil {
+				return err
+			}
+		}
+		if err := iprot.ReadFieldEnd(ctx); err != nil {
+			return err
+		}
+	}
+	if err := iprot.ReadStructEnd(ctx); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
+	}
+	return nil
+}
+
+func (p *AlignmentSegment) ReadField1(ctx context.Context, iprot thrift.TProtocol) error {
+	if v, err := iprot.ReadI32(ctx); err != nil {
+		return thrift.PrependError("error reading field 1: ", err)
+	} else {
+		temp := AlignmentSegmentKind(v)
+		p.Kind = temp
+	}
+	return nil
+}
+
+func (p *AlignmentSegment) ReadField2(ctx context.Context, iprot thrift.TProtocol) error {
+	if v, err := iprot.ReadString(ctx); err != nil {
+		return thrift.PrependError("error reading field 2: ", err)
+	} else {
+		p.Name = v
+	}
+	return nil
+}
+
+func (p *AlignmentSegment) ReadField3(ctx context.Context, iprot thrift.TProtocol) error {
+	p.Original = &Range_{}
+	if err := p.Original.Read(ctx, iprot); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T error reading struct: ", p.Original), err)
+	}
+	return nil
+}
+
+func (p *AlignmentSegment) ReadField4(ctx context.Context, iprot thrift.TProtocol) error {
+	p.Actual = &Range_{}
+	if err := p.Actual.Read(ctx, iprot); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T error reading struct: ", p.Actual), err)
+	}
+	return nil
+}
+
+func (p *AlignmentSegment) Write(ctx context.Context, oprot thrift.TProtocol) error {
+	if err := oprot.WriteStructBegin(ctx, "AlignmentSegment"); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
+	}
+	if p != nil {
+		if err := p.writeField1(ctx, oprot); err != nil {
+			return err
+		}
+		if err := p.writeField2(ctx, oprot); err != nil {
+			return err
+		}
+		if err := p.writeField3(ctx, oprot); err != nil {
+			return err
+		}
+		if err := p.writeField4(ctx, oprot); err != nil {
+			return err
+		}
+	}
+	if err := oprot.WriteFieldStop(ctx); err != nil {
+		return thrift.PrependError("write field stop error: ", err)
+	}
+	if err := oprot.WriteStructEnd(ctx); err != nil {
+		return thrift.PrependError("write struct stop error: ", err)
+	}
+	return nil
+}
+
+func (p *AlignmentSegment) writeField1(ctx context.Context, oprot thrift.TProtocol) (err error) {
+	if err := oprot.WriteFieldBegin(ctx, "Kind", thrift.I32, 1); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T write field begin error 1:Kind: ", p), err)
+	}
+	if err := oprot.WriteI32(ctx, int32(p.Kind)); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T.Kind (1) field write error: ", p), err)
+	}
+	if err := oprot.WriteFieldEnd(ctx); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T write field end error 1:Kind: ", p), err)
+	}
+	return err
+}
+
+func (p *AlignmentSegment) writeField2(ctx context.Context, oprot thrift.TProtocol) (err error) {
+	if err := oprot.WriteFieldBegin(ctx, "Name", thrift.STRING, 2); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T write field begin error 2:Name: ", p), err)
+	}
+	if err := oprot.WriteString(ctx, string(p.Name)); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T.Name (2) field write error: ", p), err)
+	}
+	if err := oprot.WriteFieldEnd(ctx); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T write field end error 2:Name: ", p), err)
+	}
+	return err
+}
+
+func (p *AlignmentSegment) writeField3(ctx context.Context, oprot thrift.TProtocol) (err error) {
+	if err := oprot.WriteFieldBegin(ctx, "Original", thrift.STRUCT, 3); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T write field begin error 3:Original: ", p), err)
+	}
+	if err := p.Original.Write(ctx, oprot); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T error writing struct: ", p.Original), err)
+	}
+	if err := oprot.WriteFieldEnd(ctx); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T write field end error 3:Original: ", p), err)
+	}
+	return err
+}
+
+func (p *AlignmentSegment) writeField4(ctx context.Context, oprot thrift.TProtocol) (err error) {
+	if err := oprot.WriteFieldBegin(ctx, "Actual", thrift.STRUCT, 4); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T write field begin error 4:Actual: ", p), err)
+	}
+	if err := p.Actual.Write(ctx, oprot); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T error writing struct: ", p.Actual), err)
+	}
+	if err := oprot.WriteFieldEnd(ctx); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T write field end error 4:Actual: ", p), err)
+	}
+	return err
+}
+
+func (p *AlignmentSegment) Equals(other *AlignmentSegment) bool {
+	if p == other {
+		return true
+	} else if p == nil || other == nil {
+		return false
+	}
+	if p.Kind != other.Kind {
 		return false
 	}
-	for i, _tgt := range p.Nodes {
-		_src7 := other.Nodes[i]
-		if !_tgt.Equals(_src7) {
-			return false
-		}
+	if p.Name != other.Name {
+		return false
+	}
+	if !p.Original.Equals(other.Original) {
+		return false
+	}
+	if !p.Actual.Equals(other.Actual) {
+		return false
 	}
 	return true
 }
 
-func (p *DiffEntry) String() string {
+func (p *AlignmentSegment) String() string {
 	if p == nil {
 		return "<nil>"
 	}
-	return fmt.Sprintf("DiffEntry(%+v)", *p)
+	return fmt.Sprintf("AlignmentSegment(%+v)", *p)
 }
 
 // Attributes:
 //   - Diagnosis
 //   - DiffEntries
 //   - ImageOffset
+//   - MissingMeasuredRanges
+//   - ActualFirmwareDumpMethod
+//   - ActualFirmwareDumpQuality
+//   - DiagnosisConfidence
+//   - Alignment
+//   - UnmappedMeasuredRanges
 type CustomReport struct {
-	Diagnosis   DiffDiagnosis `thrift:"Diagnosis,1" db:"Diagnosis" json:"Diagnosis"`
-	DiffEntries []*DiffEntry  `thrift:"DiffEntries,2" db:"DiffEntries" json:"DiffEntries"`
-	ImageOffset int64         `thrift:"ImageOffset,3" db:"ImageOffset" json:"ImageOffset"`
+	Diagnosis                 DiffDiagnosis       `thrift:"Diagnosis,1" db:"Diagnosis" json:"Diagnosis"`
+	DiffEntries               []*DiffEntry        `thrift:"DiffEntries,2" db:"DiffEntries" json:"DiffEntries"`
+	ImageOffset               int64               `thrift:"ImageOffset,3" db:"ImageOffset" json:"ImageOffset"`
+	MissingMeasuredRanges     []*Range_           `thrift:"MissingMeasuredRanges,4" db:"MissingMeasuredRanges" json:"MissingMeasuredRanges"`
+	ActualFirmwareDumpMethod  *string             `thrift:"ActualFirmwareDumpMethod,5" db:"ActualFirmwareDumpMethod" json:"ActualFirmwareDumpMethod,omitempty"`
+	ActualFirmwareDumpQuality *string             `thrift:"ActualFirmwareDumpQuality,6" db:"ActualFirmwareDumpQuality" json:"ActualFirmwareDumpQuality,omitempty"`
+	DiagnosisConfidence       float64             `thrift:"DiagnosisConfidence,7" db:"DiagnosisConfidence" json:"DiagnosisConfidence"`
+	Alignment                 []*AlignmentSegment `thrift:"Alignment,8" db:"Alignment" json:"Alignment"`
+	UnmappedMeasuredRanges    []*Range_           `thrift:"UnmappedMeasuredRanges,9" db:"UnmappedMeasuredRanges" json:"UnmappedMeasuredRanges"`
 }
 
 func NewCustomReport() *CustomReport {
@@ -1605,6 +2557,48 @@ func (p *CustomReport) GetDiffEntries() []*DiffEntry {
 func (p *CustomReport) GetImageOffset() int64 {
 	return p.ImageOffset
 }
+
+func (p *CustomReport) GetMissingMeasuredRanges() []*Range_ {
+	return p.MissingMeasuredRanges
+}
+
+var CustomReport_ActualFirmwareDumpMethod_DEFAULT string
+
+func (p *CustomReport) GetActualFirmwareDumpMethod() string {
+	if !p.IsSetActualFirmwareDumpMethod() {
+		return CustomReport_ActualFirmwareDumpMethod_DEFAULT
+	}
+	return *p.ActualFirmwareDumpMethod
+}
+
+var CustomReport_ActualFirmwareDumpQuality_DEFAULT string
+
+func (p *CustomReport) GetActualFirmwareDumpQuality() string {
+	if !p.IsSetActualFirmwareDumpQuality() {
+		return CustomReport_ActualFirmwareDumpQuality_DEFAULT
+	}
+	return *p.ActualFirmwareDumpQuality
+}
+
+func (p *CustomReport) GetDiagnosisConfidence() float64 {
+	return p.DiagnosisConfidence
+}
+
+func (p *CustomReport) GetAlignment() []*AlignmentSegment {
+	return p.Alignment
+}
+
+func (p *CustomReport) GetUnmappedMeasuredRanges() []*Range_ {
+	return p.UnmappedMeasuredRanges
+}
+func (p *CustomReport) IsSetActualFirmwareDumpMethod() bool {
+	return p.ActualFirmwareDumpMethod != nil
+}
+
+func (p *CustomReport) IsSetActualFirmwareDumpQuality() bool {
+	return p.ActualFirmwareDumpQuality != nil
+}
+
 func (p *CustomReport) Read(ctx context.Context, iprot thrift.TProtocol) error {
 	if _, err := iprot.ReadStructBegin(ctx); err != nil {
 		return thrift.PrependError(fmt.Sprintf("%T read error: ", p), err)
@@ -1649,6 +2643,66 @@ func (p *CustomReport) Read(ctx context.Context, iprot thrift.TProtocol) error {
 					return err
 				}
 			}
+		case 4:
+			if fieldTypeId == thrift.LIST {
+				if err := p.ReadField4(ctx, iprot); err != nil {
+					return err
+				}
+			} else {
+				if err := iprot.Skip(ctx, fieldTypeId); err != nil {
+					return err
+				}
+			}
+		case 5:
+			if fieldTypeId == thrift.STRING {
+				if err := p.ReadField5(ctx, iprot); err != nil {
+					return err
+				}
+			} else {
+				if err := iprot.Skip(ctx, fieldTypeId); err != nil {
+					return err
+				}
+			}
+		case 6:
+			if fieldTypeId == thrift.STRING {
+				if err := p.ReadField6(ctx, iprot); err != nil {
+					return err
+				}
+			} else {
+				if err := iprot.Skip(ctx, fieldTypeId); err != nil {
+					return err
+				}
+			}
+		case 7:
+			if fieldTypeId == thrift.DOUBLE {
+				if err := p.ReadField7(ctx, iprot); err != nil {
+					return err
+				}
+			} else {
+				if err := iprot.Skip(ctx, fieldTypeId); err != nil {
+					return err
+				}
+			}
+		case 8:
+			if fieldTypeId == thrift.LIST {
+				if err := p.ReadField8(ctx, iprot); err != nil {
+					return err
+				}
+			} else {
+				if err := iprot.Skip(ctx, fieldTypeId); err != nil {
+					return err
+				}
+			}
+		case 9:
+			if fieldTypeId == thrift.LIST {
+				if err := p.ReadField9(ctx, iprot); err != nil {
+					return err
+				}
+			} else {
+				if err := iprot.Skip(ctx, fieldTypeId); err != nil {
+					return err
+				}
+			}
 		default:
 			if err := iprot.Skip(ctx, fieldTypeId); err != nil {
 				return err
@@ -1703,6 +2757,93 @@ func (p *CustomReport) ReadField3(ctx context.Context, iprot thrift.TProtocol) e
 	return nil
 }
 
+func (p *CustomReport) ReadField4(ctx context.Context, iprot thrift.TProtocol) error {
+	_, size, err := iprot.ReadListBegin(ctx)
+	if err != nil {
+		return thrift.PrependError("error reading list begin: ", err)
+	}
+	tSlice := make([]*Range_, 0, size)
+	p.MissingMeasuredRanges = tSlice
+	for i := 0; i < size; i++ {
+		_elem9 := &Range_{}
+		if err := _elem9.Read(ctx, iprot); err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T error reading struct: ", _elem9), err)
+		}
+		p.MissingMeasuredRanges = append(p.MissingMeasuredRanges, _elem9)
+	}
+	if err := iprot.ReadListEnd(ctx); err != nil {
+		return thrift.PrependError("error reading list end: ", err)
+	}
+	return nil
+}
+
+func (p *CustomReport) ReadField5(ctx context.Context, iprot thrift.TProtocol) error {
+	if v, err := iprot.ReadString(ctx); err != nil {
+		return thrift.PrependError("error reading field 5: ", err)
+	} else {
+		p.ActualFirmwareDumpMethod = &v
+	}
+	return nil
+}
+
+func (p *CustomReport) ReadField6(ctx context.Context, iprot thrift.TProtocol) error {
+	if v, err := iprot.ReadString(ctx); err != nil {
+		return thrift.PrependError("error reading field 6: ", err)
+	} else {
+		p.ActualFirmwareDumpQuality = &v
+	}
+	return nil
+}
+
+func (p *CustomReport) ReadField7(ctx context.Context, iprot thrift.TProtocol) error {
+	if v, err := iprot.ReadDouble(ctx); err != nil {
+		return thrift.PrependError("error reading field 7: ", err)
+	} else {
+		p.DiagnosisConfidence = v
+	}
+	return nil
+}
+
+func (p *CustomReport) ReadField8(ctx context.Context, iprot thrift.TProtocol) error {
+	_, size, err := iprot.ReadListBegin(ctx)
+	if err != nil {
+		return thrift.PrependError("error reading list begin: ", err)
+	}
+	tSlice := make([]*AlignmentSegment, 0, size)
+	p.Alignment = tSlice
+	for i := 0; i < size; i++ {
+		_elem10 := &AlignmentSegment{}
+		if err := _elem10.Read(ctx, iprot); err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T error reading struct: ", _elem10), err)
+		}
+		p.Alignment = append(p.Alignment, _elem10)
+	}
+	if err := iprot.ReadListEnd(ctx); err != nil {
+		return thrift.PrependError("error reading list end: ", err)
+	}
+	return nil
+}
+
+func (p *CustomReport) ReadField9(ctx context.Context, iprot thrift.TProtocol) error {
+	_, size, err := iprot.ReadListBegin(ctx)
+	if err != nil {
+		return thrift.PrependError("error reading list begin: ", err)
+	}
+	tSlice := make([]*Range_, 0, size)
+	p.UnmappedMeasuredRanges = tSlice
+	for i := 0; i < size; i++ {
+		_elem11 := &Range_{}
+		if err := _elem11.Read(ctx, iprot); err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T error reading struct: ", _elem11), err)
+		}
+		p.UnmappedMeasuredRanges = append(p.UnmappedMeasuredRanges, _elem11)
+	}
+	if err := iprot.ReadListEnd(ctx); err != nil {
+		return thrift.PrependError("error reading list end: ", err)
+	}
+	return nil
+}
+
 func (p *CustomReport) Write(ctx context.Context, oprot thrift.TProtocol) error {
 	if err := oprot.WriteStructBegin(ctx, "CustomReport"); err != nil {
 		return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
@@ -1717,6 +2858,24 @@ func (p *CustomReport) Write(ctx context.Context, oprot thrift.TProtocol) error
 		if err := p.writeField3(ctx, oprot); err != nil {
 			return err
 		}
+		if err := p.writeField4(ctx, oprot); err != nil {
+			return err
+		}
+		if err := p.writeField5(ctx, oprot); err != nil {
+			return err
+		}
+		if err := p.writeField6(ctx, oprot); err != nil {
+			return err
+		}
+		if err := p.writeField7(ctx, oprot); err != nil {
+			return err
+		}
+		if err := p.writeField8(ctx, oprot); err != nil {
+			return err
+		}
+		if err := p.writeField9(ctx, oprot); err != nil {
+			return err
+		}
 	}
 	if err := oprot.WriteFieldStop(ctx); err != nil {
 		return thrift.PrependError("write field stop error: ", err)
@@ -1774,6 +2933,112 @@ func (p *CustomReport) writeField3(ctx context.Context, oprot thrift.TProtocol)
 	return err
 }
 
+func (p *CustomReport) writeField4(ctx context.Context, oprot thrift.TProtocol) (err error) {
+	if err := oprot.WriteFieldBegin(ctx, "MissingMeasuredRanges", thrift.LIST, 4); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T write field begin error 4:MissingMeasuredRanges: ", p), err)
+	}
+	if err := oprot.WriteListBegin(ctx, thrift.STRUCT, len(p.MissingMeasuredRanges)); err != nil {
+		return thrift.PrependError("error writing list begin: ", err)
+	}
+	for _, v := range p.MissingMeasuredRanges {
+		if err := v.Write(ctx, oprot); err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T error writing struct: ", v), err)
+		}
+	}
+	if err := oprot.WriteListEnd(ctx); err != nil {
+		return thrift.PrependError("error writing list end: ", err)
+	}
+	if err := oprot.WriteFieldEnd(ctx); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T write field end error 4:MissingMeasuredRanges: ", p), err)
+	}
+	return err
+}
+
+func (p *CustomReport) writeField5(ctx context.Context, oprot thrift.TProtocol) (err error) {
+	if p.IsSetActualFirmwareDumpMethod() {
+		if err := oprot.WriteFieldBegin(ctx, "ActualFirmwareDumpMethod", thrift.STRING, 5); err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T write field begin error 5:ActualFirmwareDumpMethod: ", p), err)
+		}
+		if err := oprot.WriteString(ctx, string(*p.ActualFirmwareDumpMethod)); err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T.ActualFirmwareDumpMethod (5) field write error: ", p), err)
+		}
+		if err := oprot.WriteFieldEnd(ctx); err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T write field end error 5:ActualFirmwareDumpMethod: ", p), err)
+		}
+	}
+	return err
+}
+
+func (p *CustomReport) writeField6(ctx context.Context, oprot thrift.TProtocol) (err error) {
+	if p.IsSetActualFirmwareDumpQuality() {
+		if err := oprot.WriteFieldBegin(ctx, "ActualFirmwareDumpQuality", thrift.STRING, 6); err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T write field begin error 6:ActualFirmwareDumpQuality: ", p), err)
+		}
+		if err := oprot.WriteString(ctx, string(*p.ActualFirmwareDumpQuality)); err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T.ActualFirmwareDumpQuality (6) field write error: ", p), err)
+		}
+		if err := oprot.WriteFieldEnd(ctx); err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T write field end error 6:ActualFirmwareDumpQuality: ", p), err)
+		}
+	}
+	return err
+}
+
+func (p *CustomReport) writeField7(ctx context.Context, oprot thrift.TProtocol) (err error) {
+	if err := oprot.WriteFieldBegin(ctx, "DiagnosisConfidence", thrift.DOUBLE, 7); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T write field begin error 7:DiagnosisConfidence: ", p), err)
+	}
+	if err := oprot.WriteDouble(ctx, float64(p.DiagnosisConfidence)); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T.DiagnosisConfidence (7) field write error: ", p), err)
+	}
+	if err := oprot.WriteFieldEnd(ctx); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T write field end error 7:DiagnosisConfidence: ", p), err)
+	}
+	return err
+}
+
+func (p *CustomReport) writeField8(ctx context.Context, oprot thrift.TProtocol) (err error) {
+	if err := oprot.WriteFieldBegin(ctx, "Alignment", thrift.LIST, 8); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T write field begin error 8:Alignment: ", p), err)
+	}
+	if err := oprot.WriteListBegin(ctx, thrift.STRUCT, len(p.Alignment)); err != nil {
+		return thrift.PrependError("error writing list begin: ", err)
+	}
+	for _, v := range p.Alignment {
+		if err := v.Write(ctx, oprot); err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T error writing struct: ", v), err)
+		}
+	}
+	if err := oprot.WriteListEnd(ctx); err != nil {
+		return thrift.PrependError("error writing list end: ", err)
+	}
+	if err := oprot.WriteFieldEnd(ctx); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T write field end error 8:Alignment: ", p), err)
+	}
+	return err
+}
+
+func (p *CustomReport) writeField9(ctx context.Context, oprot thrift.TProtocol) (err error) {
+	if err := oprot.WriteFieldBegin(ctx, "UnmappedMeasuredRanges", thrift.LIST, 9); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T write field begin error 9:UnmappedMeasuredRanges: ", p), err)
+	}
+	if err := oprot.WriteListBegin(ctx, thrift.STRUCT, len(p.UnmappedMeasuredRanges)); err != nil {
+		return thrift.PrependError("error writing list begin: ", err)
+	}
+	for _, v := range p.UnmappedMeasuredRanges {
+		if err := v.Write(ctx, oprot); err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T error writing struct: ", v), err)
+		}
+	}
+	if err := oprot.WriteListEnd(ctx); err != nil {
+		return thrift.PrependError("error writing list end: ", err)
+	}
+	if err := oprot.WriteFieldEnd(ctx); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T write field end error 9:UnmappedMeasuredRanges: ", p), err)
+	}
+	return err
+}
+
 func (p *CustomReport) Equals(other *CustomReport) bool {
 	if p == other {
 		return true
@@ -1787,14 +3052,60 @@ func (p *CustomReport) Equals(other *CustomReport) bool {
 		return false
 	}
 	for i, _tgt := range p.DiffEntries {
-		_src9 := other.DiffEntries[i]
-		if !_tgt.Equals(_src9) {
+		_src12 := other.DiffEntries[i]
+		if !_tgt.Equals(_src12) {
 			return false
 		}
 	}
 	if p.ImageOffset != other.ImageOffset {
 		return false
 	}
+	if len(p.MissingMeasuredRanges) != len(other.MissingMeasuredRanges) {
+		return false
+	}
+	for i, _tgt := range p.MissingMeasuredRanges {
+		_src13 := other.MissingMeasuredRanges[i]
+		if !_tgt.Equals(_src13) {
+			return false
+		}
+	}
+	if p.ActualFirmwareDumpMethod != other.ActualFirmwareDumpMethod {
+		if p.ActualFirmwareDumpMethod == nil || other.ActualFirmwareDumpMethod == nil {
+			return false
+		}
+		if (*p.ActualFirmwareDumpMethod) != (*other.ActualFirmwareDumpMethod) {
+			return false
+		}
+	}
+	if p.ActualFirmwareDumpQuality != other.ActualFirmwareDumpQuality {
+		if p.ActualFirmwareDumpQuality == nil || other.ActualFirmwareDumpQuality == nil {
+			return false
+		}
+		if (*p.ActualFirmwareDumpQuality) != (*other.ActualFirmwareDumpQuality) {
+			return false
+		}
+	}
+	if p.DiagnosisConfidence != other.DiagnosisConfidence {
+		return false
+	}
+	if len(p.Alignment) != len(other.Alignment) {
+		return false
+	}
+	for i, _tgt := range p.Alignment {
+		_src14 := other.Alignment[i]
+		if !_tgt.Equals(_src14) {
+			return false
+		}
+	}
+	if len(p.UnmappedMeasuredRanges) != len(other.UnmappedMeasuredRanges) {
+		return false
+	}
+	for i, _tgt := range p.UnmappedMeasuredRanges {
+		_src15 := other.UnmappedMeasuredRanges[i]
+		if !_tgt.Equals(_src15) {
+			return false
+		}
+	}
 	return true
 }
 
@@ -82,6 +82,19 @@ struct HostInfo {
 struct ServerInfo {
   1: string Revision;
   2: BuildMode BuildMode;
+  // Analyzers are the analyzers supported by the server (see AnalyzerInput).
+  3: list<AnalyzerInfo> Analyzers;
+  // ArtifactTypes are the names of supported kinds of Artifact (e.g. "FwImage").
+  4: list<string> ArtifactTypes;
+}
+
+struct AnalyzerInfo {
+  1: string ID;
+  // Version is the semantic version of the analyzer logic (e.g. "1.0.0").
+  2: string Version;
+}
+
+struct GetServerInfoRequest {
 }
 
 enum BuildMode {
@@ -99,6 +112,21 @@ exception IncorrectHostConfiguration {
   1: string Reason;
 }
 
+exception PermissionDenied {
+  1: string Reason;
+}
+
+exception InvalidRequest {
+  1: string Reason;
+}
+
+// Overloaded means the request was rejected without processing because
+// the server is overloaded; it is safe to retry it after RetryAfterMilliseconds.
+exception Overloaded {
+  1: string Reason;
+  2: i64 RetryAfterMilliseconds;
+}
+
 struct SearchFirmwareRequest {
   // OrFilters are collected together through OR-s.
   1: list<SearchFirmwareFilters> OrFilters;
@@ -160,6 +188,105 @@ struct SearchReportResult {
   1: list<AnalyzeResult> Found;
 }
 
+struct CompareReportsRequest {
+  // JobIDA is the job ID of the (usually earlier) analysis to compare with.
+  1: binary JobIDA;
+  // JobIDB is the job ID of the (usually later) analysis to compare.
+  2: binary JobIDB;
+}
+
+enum IssueChange {
+  Unchanged = 1,
+  // New means the issue is reported only in report B.
+  New = 2,
+  // Resolved means the issue is reported only in report A.
+  Resolved = 3,
+}
+
+struct IssueDiff {
+  1: IssueChange Change;
+  2: analyzerreport.Issue Issue;
+}
+
+// InputDiff is an analyzer input value, which differs between the reports.
+struct InputDiff {
+  // Type is the type ID of the input value (for example the one of
+  // the status registers or of the actual firmware image).
+  1: string Type;
+  // ValueA is the JSON representation of the value in report A, unset if absent.
+  2: optional string ValueA;
+  // ValueB is the JSON representation of the value in report B, unset if absent.
+  3: optional string ValueB;
+}
+
+// AnalyzerReportDiff is the difference between the reports of the same analyzer.
+struct AnalyzerReportDiff {
+  1: string AnalyzerName;
+  2: bool PresentInA;
+  3: bool PresentInB;
+  4: optional string ErrorA;
+  5: optional string ErrorB;
+  6: list<IssueDiff> Issues;
+  7: optional string DiagnosisA;
+  8: optional string DiagnosisB;
+  // CustomReportChanged is true if the analyzer-specific reports differ.
+  9: bool CustomReportChanged;
+  10: list<InputDiff> ChangedInputs;
+}
+
+struct CompareReportsResult {
+  1: AnalyzeReportSummary ReportA;
+  2: AnalyzeReportSummary ReportB;
+  // Analyzers are the analyzers reports aligned by the analyzer name.
+  3: list<AnalyzerReportDiff> Analyzers;
+}
+
+struct AnalyzeReportSummary {
+  1: binary JobID;
+  2: optional i64 AssetID;
+  // Timestamp is the time of the analysis request in unix nanoseconds.
+  3: i64 Timestamp;
+  4: optional AnalyzeVerdict Verdict;
+}
+
+enum AggregateDimension {
+  Analyzer = 1,
+  Diagnosis = 2,
+  IssueSeverity = 3,
+  IssueDescription = 4,
+  ActualFirmwareVersion = 5,
+  OriginalFirmwareVersion = 6,
+  ModelID = 7,
+}
+
+struct AggregateReportsRequest {
+  // GroupBy is the list of dimensions to group the reports by.
+  1: list<AggregateDimension> GroupBy;
+  // TimeFrom selects reports not older than the given unix time (in seconds).
+  2: optional i64 TimeFrom;
+  // TimeTo selects reports older than the given unix time (in seconds).
+  3: optional i64 TimeTo;
+  // BucketSeconds additionally groups reports by time intervals of the given size.
+  4: optional i64 BucketSeconds;
+  5: list<string> AnalyzerNames;
+  6: list<string> Diagnoses;
+  7: list<i64> ModelIDs;
+}
+
+struct AggregateReportsGroup {
+  // Values contains a value for each dimension of GroupBy (in the same order).
+  // An unset value is represented by an empty string.
+  1: list<string> Values;
+  // BucketStart is the beginning of the time interval in unix time (in seconds).
+  2: optional i64 BucketStart;
+  3: i64 Reports;
+  4: i64 Hosts;
+}
+
+struct AggregateReportsResult {
+  1: list<AggregateReportsGroup> Groups;
+}
+
 struct FirmwareVersion {
   1: string Version;
 }
@@ -169,10 +296,74 @@ struct CompressedBlob {
   2: CompressionType Compression;
 }
 
+// ImageChunksManifest describes a firmware image split into fixed-size
+// chunks (see package pkg/imagechunks).
+struct ImageChunksManifest {
+  1: binary ImageSHA2_512;
+  2: i64 ImageSize;
+  // ChunkSize is the size of each chunk, except the last one (which could be smaller).
+  3: i32 ChunkSize;
+  // ChunkHashes are SHA2-256 hashes of the chunks.
+  4: list<binary> ChunkHashes;
+}
+
+// DeltaBlob is a partially transferred firmware image. The chunks which
+// are not included are taken from the images already stored by the server
+// (see NegotiateImageUpload).
+struct DeltaBlob {
+  1: ImageChunksManifest Manifest;
+  // Chunks maps chunk indexes to their content.
+  2: map<i32, CompressedBlob> Chunks;
+}
+
+// ByteRange is a range of bytes [Offset, Offset+Length).
+struct ByteRange {
+  1: i64 Offset;
+  2: i64 Length;
+}
+
+// SparseBlob is a partially dumped firmware image (see flashrom.DumpRegions).
+// The bytes outside of Coverage are filled with 0xFF and are not analyzed.
+struct SparseBlob {
+  1: CompressedBlob Blob;
+  2: list<ByteRange> Coverage;
+}
+
+enum FirmwareDumpMethod {
+  Unknown = 0,
+  Flashrom = 1,
+  Afulnx64 = 2,
+  DevMem = 3,
+  MTD = 4,
+}
+
+enum FirmwareDumpQuality {
+  // Unverified means the image was read once.
+  Unverified = 0,
+  // Verified means the image was read twice and the reads are identical.
+  Verified = 1,
+  // Unstable means the image was read twice and the reads differ at UnstableRanges.
+  Unstable = 2,
+}
+
+// FirmwareDumpInfo describes how the actual firmware image was acquired
+// on the host (see flashrom.DumpInfo).
+struct FirmwareDumpInfo {
+  1: FirmwareDumpMethod Method;
+  2: FirmwareDumpQuality Quality;
+  3: list<ByteRange> UnstableRanges;
+  // Fallback is true if all the dump methods failed and the image is the
+  // best-effort result of a failed method.
+  4: bool Fallback;
+  5: optional string FallbackError;
+}
+
 union FirmwareImage {
   1: CompressedBlob Blob;
   2: string BlobStorageKey;
   3: FirmwareVersion FirmwareVersion;
+  4: DeltaBlob DeltaBlob;
+  5: SparseBlob SparseBlob;
 }
 
 struct PCR {
@@ -189,6 +380,11 @@ union Artifact {
   4: tpm.EventLog TPMEventLog;
   5: list<StatusRegister> StatusRegisters;
   6: measurements.Flow MeasurementsFlow;
+  // EvidenceBundle is a tar.gz or zip archive produced by "afascli collect",
+  // see package pkg/evidence. It may provide the firmware image, the status
+  // registers, the TPM device, the TPM event log and PCR0 at once.
+  7: binary EvidenceBundle;
+  8: FirmwareDumpInfo FirmwareDumpInfo;
 }
 
 // DiffMeasuredBootInput is an input structure for DiffMeasuredBoot analyzer
@@ -199,6 +395,7 @@ struct DiffMeasuredBootInput {
   4: optional i32 TPMDevice;
   5: optional i32 TPMEventLog;
   6: optional i32 ActualPCR0;
+  7: optional i32 ActualFirmwareDumpInfo;
 }
 
 struct IntelACMInput {
@@ -270,6 +467,10 @@ struct AnalyzerResult {
   // No essential functionality should depend on this.
   // May not be provided if the server decides so for any reason.
   3: optional string ProcessedInputJSON;
+
+  // AnalyzerVersion is the version of the analyzer, which produced
+  // the result (unset for results produced before analyzers were versioned).
+  4: optional string AnalyzerVersion;
 }
 
 union AnalyzerOutcome {
@@ -277,12 +478,42 @@ union AnalyzerOutcome {
   2: Error Err;
 }
 
+// Verdict is the overall decision about an analyzed host.
+enum Verdict {
+  // Pass means the host is OK to return to production.
+  Pass = 1,
+  // Remediate means the host should be fixed (for example, reflashed) automatically.
+  Remediate = 2,
+  // Escalate means the host requires a human investigation.
+  Escalate = 3,
+}
+
+struct VerdictReason {
+  // AnalyzerName is the analyzer which report triggered the rule.
+  1: string AnalyzerName;
+  // Rule is the name of the policy rule.
+  2: string Rule;
+  3: Verdict Verdict;
+  4: string Description;
+}
+
+// AnalyzeVerdict is the outcome of the policy evaluated over the analyzers reports.
+struct AnalyzeVerdict {
+  1: Verdict Verdict;
+  // Policy is the name of the applied policy.
+  2: string Policy;
+  3: list<VerdictReason> Reasons;
+}
+
 struct AnalyzeResult {
   // JobID is a unique identifier for completed analysis. Could be used to find logs
   1: binary JobID;
 
   // Results are analyzers reports or errors in the same order as in AnalyzeFirmwareRequest
   2: list<AnalyzerResult> Results;
+
+  // Verdict is the overall decision derived from Results by the server policy.
+  3: optional AnalyzeVerdict Verdict;
 }
 
 struct CheckFirmwareVersionRequest {
@@ -293,11 +524,257 @@ struct CheckFirmwareVersionResult {
   1: list<bool> existStatus;
 }
 
+enum OriginalFirmwareType {
+  BIOS = 1,
+}
+
+// OriginalFirmwareTarget defines where an original firmware should be applied.
+// At least one of the fields should be set.
+struct OriginalFirmwareTarget {
+  1: optional i64 ModelID;
+  2: optional string Hostname;
+}
+
+// OriginalFirmwareMeasurement is an expected measurement of an original firmware.
+struct OriginalFirmwareMeasurement {
+  // Type is the name of the measurement type, for example "PCR0_SHA1".
+  1: string Type;
+  2: binary Value;
+}
+
+// OriginalFirmware is an entry of the original firmware database.
+struct OriginalFirmware {
+  // ID is ignored on insertion, and is required on update.
+  1: i64 ID;
+  2: OriginalFirmwareType Type;
+  3: string Version;
+  4: string ImageURL;
+  5: list<OriginalFirmwareTarget> Targets;
+  6: list<OriginalFirmwareMeasurement> Measurements;
+}
+
+struct InsertOriginalFirmwareRequest {
+  1: OriginalFirmware Firmware;
+}
+
+struct InsertOriginalFirmwareResult {
+  1: i64 ID;
+}
+
+struct UpdateOriginalFirmwareRequest {
+  1: OriginalFirmware Firmware;
+}
+
+struct DeleteOriginalFirmwareRequest {
+  1: i64 ID;
+}
+
+enum TamperingRegistryKind {
+  // AssetID matches the host by its asset ID (Value is a decimal number).
+  AssetID = 1,
+  // Hostname matches the host by its hostname.
+  Hostname = 2,
+  // ImageHash matches the actual firmware image by its SHA2-512 hash (Value is hex).
+  ImageHash = 3,
+  // ImageStableHash matches the actual firmware image by its stable hash (Value is hex).
+  ImageStableHash = 4,
+}
+
+// TamperingRegistryEntry marks a host as intentionally tampered (for example
+// a red-team machine) or a firmware image as known to be malicious.
+struct TamperingRegistryEntry {
+  // ID is ignored on insertion, and is required on update.
+  1: i64 ID;
+  2: TamperingRegistryKind Kind;
+  3: string Value;
+  4: string Reason;
+  // ExpiresAt is the unix time (in seconds) when the entry stops matching;
+  // unset means never.
+  5: optional i64 ExpiresAt;
+  // CreatedAt is the unix time (in seconds) of the insertion, it is ignored on insertion and update.
+  6: i64 CreatedAt;
+}
+
+struct InsertTamperingRegistryEntryRequest {
+  1: TamperingRegistryEntry Entry;
+}
+
+struct InsertTamperingRegistryEntryResult {
+  1: i64 ID;
+}
+
+struct UpdateTamperingRegistryEntryRequest {
+  1: TamperingRegistryEntry Entry;
+}
+
+struct DeleteTamperingRegistryEntryRequest {
+  1: i64 ID;
+}
+
+struct ListTamperingRegistryEntriesRequest {
+  // Kind limits the result to entries of the kind.
+  1: optional TamperingRegistryKind Kind;
+  2: bool IncludeExpired;
+}
+
+struct ListTamperingRegistryEntriesResult {
+  1: list<TamperingRegistryEntry> Entries;
+}
+
+struct SearchByPCRRequest {
+  // PCRIndex is the PCR number: 0, 1, 2, ...
+  1: i32 PCRIndex;
+  // Bank is the hash algorithm of the PCR bank, for example SHA1 or SHA256.
+  2: tpm.Algo Bank;
+  3: binary Digest;
+  // ModelID limits the results to original firmwares targeted to the model.
+  4: optional i64 ModelID;
+}
+
+enum PCRMatchSource {
+  // OriginalFirmwareDB means the match was found among the expected
+  // measurements of the original firmware database.
+  OriginalFirmwareDB = 1,
+  // ReproducedPCRs means the match was found among the PCR values
+  // reproduced by previous analyses.
+  ReproducedPCRs = 2,
+}
+
+// PCRMatch is a firmware configuration, which yields the searched PCR value.
+struct PCRMatch {
+  1: PCRMatchSource Source;
+  2: optional string FirmwareVersion;
+  3: optional binary ImageID;
+  4: optional measurements.Flow BootFlow;
+  5: optional TPMType TPMDevice;
+  6: list<StatusRegister> StatusRegisters;
+}
+
+struct SearchByPCRResult {
+  1: list<PCRMatch> Found;
+}
+
+struct NegotiateImageUploadRequest {
+  1: ImageChunksManifest Manifest;
+}
+
+struct NegotiateImageUploadResult {
+  // ImageID is deprecated and is not set by the server anymore (it
+  // disclosed whether an image is stored to anybody knowing its hash).
+  1: optional binary ImageID;
+  // MissingChunks are indexes of the chunks which should be sent
+  // in DeltaBlob.Chunks.
+  2: list<i32> MissingChunks;
+}
+
+// All the methods throw PermissionDenied if the client is not authorized
+// to use them.
 service AttestationFailureAnalyzerService {
-  SearchFirmwareResult SearchFirmware(1: SearchFirmwareRequest request);
-  SearchReportResult SearchReport(1: SearchReportRequest request);
-  AnalyzeResult Analyze(1: AnalyzeRequest request);
+  // GetServerInfo returns the build information and the capabilities of
+  // the server, so that clients could detect supported features.
+  ServerInfo GetServerInfo(
+    1: GetServerInfoRequest request,
+  ) throws (
+    1: PermissionDenied permissionDenied,
+  );
+  SearchFirmwareResult SearchFirmware(
+    1: SearchFirmwareRequest request,
+  ) throws (
+    1: PermissionDenied permissionDenied,
+  );
+  SearchReportResult SearchReport(
+    1: SearchReportRequest request,
+  ) throws (
+    1: PermissionDenied permissionDenied,
+  );
+  AnalyzeResult Analyze(
+    1: AnalyzeRequest request,
+  ) throws (
+    1: UnableToGetOriginalFirmware unableToGetOriginalFirmware,
+    2: IncorrectHostConfiguration incorrectHostConfiguration,
+    3: PermissionDenied permissionDenied,
+    4: Overloaded overloaded,
+  );
   CheckFirmwareVersionResult CheckFirmwareVersion(
     1: CheckFirmwareVersionRequest request,
+  ) throws (
+    1: PermissionDenied permissionDenied,
+  );
+  SearchByPCRResult SearchByPCR(
+    1: SearchByPCRRequest request,
+  ) throws (
+    1: InvalidRequest invalidRequest,
+    2: PermissionDenied permissionDenied,
+  );
+  // CompareReports shows the differences between two analyze reports
+  // (usually of the same host).
+  CompareReportsResult CompareReports(
+    1: CompareReportsRequest request,
+  ) throws (
+    1: InvalidRequest invalidRequest,
+    2: PermissionDenied permissionDenied,
+  );
+  // AggregateReports returns statistics of analyze reports grouped by
+  // the requested dimensions.
+  AggregateReportsResult AggregateReports(
+    1: AggregateReportsRequest request,
+  ) throws (
+    1: InvalidRequest invalidRequest,
+    2: PermissionDenied permissionDenied,
+  );
+  // NegotiateImageUpload tells which parts of a firmware image should be
+  // uploaded (see DeltaBlob).
+  NegotiateImageUploadResult NegotiateImageUpload(
+    1: NegotiateImageUploadRequest request,
+  ) throws (
+    1: InvalidRequest invalidRequest,
+    2: PermissionDenied permissionDenied,
+  );
+
+  // Administrative methods to manage the original firmware database.
+  InsertOriginalFirmwareResult InsertOriginalFirmware(
+    1: InsertOriginalFirmwareRequest request,
+  ) throws (
+    1: PermissionDenied permissionDenied,
+    2: InvalidRequest invalidRequest,
+  );
+  void UpdateOriginalFirmware(
+    1: UpdateOriginalFirmwareRequest request,
+  ) throws (
+    1: PermissionDenied permissionDenied,
+    2: InvalidRequest invalidRequest,
+  );
+  void DeleteOriginalFirmware(
+    1: DeleteOriginalFirmwareRequest request,
+  ) throws (
+    1: PermissionDenied permissionDenied,
+    2: InvalidRequest invalidRequest,
+  );
+
+  // Methods to manage the registry of known tampered hosts and known malicious
+  // firmware images (modifying methods are administrative).
+  ListTamperingRegistryEntriesResult ListTamperingRegistryEntries(
+    1: ListTamperingRegistryEntriesRequest request,
+  ) throws (
+    1: InvalidRequest invalidRequest,
+    2: PermissionDenied permissionDenied,
+  );
+  InsertTamperingRegistryEntryResult InsertTamperingRegistryEntry(
+    1: InsertTamperingRegistryEntryRequest request,
+  ) throws (
+    1: PermissionDenied permissionDenied,
+    2: InvalidRequest invalidRequest,
+  );
+  void UpdateTamperingRegistryEntry(
+    1: UpdateTamperingRegistryEntryRequest request,
+  ) throws (
+    1: PermissionDenied permissionDenied,
+    2: InvalidRequest invalidRequest,
+  );
+  void DeleteTamperingRegistryEntry(
+    1: DeleteTamperingRegistryEntryRequest request,
+  ) throws (
+    1: PermissionDenied permissionDenied,
+    2: InvalidRequest invalidRequest,
   );
 }
@@ -41,6 +41,9 @@ func ToThriftAnalyzeReport(report *models.AnalyzeReport) *afas.AnalyzeResult_ {
 	for _, report := range report.AnalyzerReports {
 		result.Results = append(result.Results, ToThriftAnalyzerReport(report))
 	}
+	if report.Verdict != nil {
+		result.Verdict = ToThriftAnalyzeVerdict(*report.Verdict)
+	}
 	return result
 }
 
@@ -76,10 +79,13 @@ func ToThriftAnalyzerReport(report models.AnalyzerReport) *afas.AnalyzerResult_
 		AnalyzerOutcome:    &afas.AnalyzerOutcome{},
 		ProcessedInputJSON: &[]string{string(inputJSON)}[0],
 	}
+	if report.AnalyzerVersion != "" {
+		result.AnalyzerVersion = &[]string{string(report.AnalyzerVersion)}[0]
+	}
 	outcome := result.AnalyzerOutcome
 	if err := report.ExecError.Err; err != nil {
 		outcome.Err = &afas.Error{
-			ErrorClass:  analyzeExecErrorToClass(err),
+			ErrorClass:  AnalyzeExecErrorToClass(err),
 			Description: err.Error(),
 		}
 		return result
@@ -148,7 +154,8 @@ func ToThriftAnalysisSeverity(severity analysis.Severity) (analyzerreport.Severi
 	return analyzerreport.Severity_SeverityCritical, fmt.Errorf("unknown severity %d", severity)
 }
 
-func analyzeExecErrorToClass(err error) afas.ErrorClass {
+// AnalyzeExecErrorToClass returns the class of an error of an analyzer execution.
+func AnalyzeExecErrorToClass(err error) afas.ErrorClass {
 	switch {
 	case errors.As(err, &controllererrors.ErrUnknownAnalyzer{}) ||
 		errors.As(err, &controllererrors.ErrInvalidInput{}) ||
@@ -0,0 +1,46 @@
+// Copyright 2023 Meta Platforms, Inc. and affiliates.
+//
+// Redistribution and use in source and binary forms, with or without modification, are permitted provided that the following conditions are met:
+//
+// 1. Redistributions of source code must retain the above copyright notice, this list of conditions and the following disclaimer.
+//
+// 2. Redistributions in binary form must reproduce the above copyright notice, this list of conditions and the following disclaimer in the documentation and/or other materials provided with the distribution.
+//
+// 3. Neither the name of the copyright holder nor the names of its contributors may be used to endorse or promote products derived from this software without specific prior written permission.
+//
+// THIS SOFTWARE IS PROVIDED BY THE COPYRIGHT HOLDERS AND CONTRIBUTORS "AS IS" AND ANY EXPRESS OR IMPLIED WARRANTIES, INCLUDING, BUT NOT LIMITED TO, THE IMPLIED WARRANTIES OF MERCHANTABILITY AND FITNESS FOR A PARTICULAR PURPOSE ARE DISCLAIMED. IN NO EVENT SHALL THE COPYRIGHT HOLDER OR CONTRIBUTORS BE LIABLE FOR ANY DIRECT, INDIRECT, INCIDENTAL, SPECIAL, EXEMPLARY, OR CONSEQUENTIAL DAMAGES (INCLUDING, BUT NOT LIMITED TO, PROCUREMENT OF SUBSTITUTE GOODS OR SERVICES; LOSS OF USE, DATA, OR PROFITS; OR BUSINESS INTERRUPTION) HOWEVER CAUSED AND ON ANY THEORY OF LIABILITY, WHETHER IN CONTRACT, STRICT LIABILITY, OR TORT (INCLUDING NEGLIGENCE OR OTHERWISE) ARISING IN ANY WAY OUT OF THE USE OF THIS SOFTWARE, EVEN IF ADVISED OF THE POSSIBILITY OF SUCH DAMAGE.
+
+package typeconv
+
+import (
+	"fmt"
+
+	"github.com/immune-gmbh/AttestationFailureAnalysisService/if/generated/afas"
+	"github.com/immune-gmbh/AttestationFailureAnalysisService/pkg/analysis"
+
+	pkgbytes "github.com/linuxboot/fiano/pkg/bytes"
+)
+
+// FromThriftFirmwareDumpInfo converts firmware analysis service FirmwareDumpInfo to the analysis format.
+func FromThriftFirmwareDumpInfo(in *afas.FirmwareDumpInfo) (*analysis.ActualFirmwareDumpInfo, error) {
+	if in == nil {
+		return nil, nil
+	}
+
+	out := &analysis.ActualFirmwareDumpInfo{
+		Method:        in.GetMethod().String(),
+		Quality:       in.GetQuality().String(),
+		Fallback:      in.GetFallback(),
+		FallbackError: in.GetFallbackError(),
+	}
+	for _, r := range in.GetUnstableRanges() {
+		if r == nil || r.Offset < 0 || r.Length < 0 {
+			return nil, fmt.Errorf("invalid unstable range: %v", r)
+		}
+		out.UnstableRanges = append(out.UnstableRanges, pkgbytes.Range{
+			Offset: uint64(r.Offset),
+			Length: uint64(r.Length),
+		})
+	}
+	return out, nil
+}
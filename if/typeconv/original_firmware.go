@@ -0,0 +1,69 @@
+// Copyright 2023 Meta Platforms, Inc. and affiliates.
+//
+// Redistribution and use in source and binary forms, with or without modification, are permitted provided that the following conditions are met:
+//
+// 1. Redistributions of source code must retain the above copyright notice, this list of conditions and the following disclaimer.
+//
+// 2. Redistributions in binary form must reproduce the above copyright notice, this list of conditions and the following disclaimer in the documentation and/or other materials provided with the distribution.
+//
+// 3. Neither the name of the copyright holder nor the names of its contributors may be used to endorse or promote products derived from this software without specific prior written permission.
+//
+// THIS SOFTWARE IS PROVIDED BY THE COPYRIGHT HOLDERS AND CONTRIBUTORS "AS IS" AND ANY EXPRESS OR IMPLIED WARRANTIES, INCLUDING, BUT NOT LIMITED TO, THE IMPLIED WARRANTIES OF MERCHANTABILITY AND FITNESS FOR A PARTICULAR PURPOSE ARE DISCLAIMED. IN NO EVENT SHALL THE COPYRIGHT HOLDER OR CONTRIBUTORS BE LIABLE FOR ANY DIRECT, INDIRECT, INCIDENTAL, SPECIAL, EXEMPLARY, OR CONSEQUENTIAL DAMAGES (INCLUDING, BUT NOT LIMITED TO, PROCUREMENT OF SUBSTITUTE GOODS OR SERVICES; LOSS OF USE, DATA, OR PROFITS; OR BUSINESS INTERRUPTION) HOWEVER CAUSED AND ON ANY THEORY OF LIABILITY, WHETHER IN CONTRACT, STRICT LIABILITY, OR TORT (INCLUDING NEGLIGENCE OR OTHERWISE) ARISING IN ANY WAY OUT OF THE USE OF THIS SOFTWARE, EVEN IF ADVISED OF THE POSSIBILITY OF SUCH DAMAGE.
+package typeconv
+
+import (
+	"fmt"
+
+	"github.com/immune-gmbh/AttestationFailureAnalysisService/if/generated/afas"
+	"github.com/immune-gmbh/AttestationFailureAnalysisService/pkg/firmwaredb"
+	"github.com/immune-gmbh/AttestationFailureAnalysisService/pkg/firmwaredb/models"
+)
+
+// FromThriftOriginalFirmwareType converts Thrift OriginalFirmwareType to the internal firmwaredb.FirmwareType.
+func FromThriftOriginalFirmwareType(t afas.OriginalFirmwareType) (firmwaredb.FirmwareType, error) {
+	switch t {
+	case afas.OriginalFirmwareType_BIOS:
+		return models.FirmwareTypeBIOS, nil
+	}
+	return models.FirmwareTypeUndefined, fmt.Errorf("unknown firmware type: %s", t)
+}
+
+// FromThriftOriginalFirmware converts Thrift OriginalFirmware to the internal firmwaredb.Firmware.
+func FromThriftOriginalFirmware(fw *afas.OriginalFirmware) (*firmwaredb.Firmware, error) {
+	if fw == nil {
+		return nil, fmt.Errorf("firmware is nil")
+	}
+
+	fwType, err := FromThriftOriginalFirmwareType(fw.GetType())
+	if err != nil {
+		return nil, err
+	}
+
+	result := &firmwaredb.Firmware{
+		ID:       fw.GetID(),
+		Type:     fwType,
+		Version:  fw.GetVersion(),
+		ImageURL: fw.GetImageURL(),
+	}
+	for idx, target := range fw.GetTargets() {
+		if target == nil {
+			return nil, fmt.Errorf("target #%d is nil", idx)
+		}
+		result.Targets = append(result.Targets, &firmwaredb.FirmwareTarget{
+			ModelID:  target.ModelID,
+			Hostname: target.Hostname,
+		})
+	}
+	for idx, measurement := range fw.GetMeasurements() {
+		if measurement == nil {
+			return nil, fmt.Errorf("measurement #%d is nil", idx)
+		}
+		result.Measurements = append(result.Measurements, &firmwaredb.FirmwareMeasurement{
+			FirmwareMeasurementType: &firmwaredb.FirmwareMeasurementType{
+				Name: measurement.GetType(),
+			},
+			Value: measurement.GetValue(),
+		})
+	}
+	return result, nil
+}
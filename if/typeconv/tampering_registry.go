@@ -0,0 +1,146 @@
+// Copyright 2023 Meta Platforms, Inc. and affiliates.
+//
+// Redistribution and use in source and binary forms, with or without modification, are permitted provided that the following conditions are met:
+//
+// 1. Redistributions of source code must retain the above copyright notice, this list of conditions and the following disclaimer.
+//
+// 2. Redistributions in binary form must reproduce the above copyright notice, this list of conditions and the following disclaimer in the documentation and/or other materials provided with the distribution.
+//
+// 3. Neither the name of the copyright holder nor the names of its contributors may be used to endorse or promote products derived from this software without specific prior written permission.
+//
+// THIS SOFTWARE IS PROVIDED BY THE COPYRIGHT HOLDERS AND CONTRIBUTORS "AS IS" AND ANY EXPRESS OR IMPLIED WARRANTIES, INCLUDING, BUT NOT LIMITED TO, THE IMPLIED WARRANTIES OF MERCHANTABILITY AND FITNESS FOR A PARTICULAR PURPOSE ARE DISCLAIMED. IN NO EVENT SHALL THE COPYRIGHT HOLDER OR CONTRIBUTORS BE LIABLE FOR ANY DIRECT, INDIRECT, INCIDENTAL, SPECIAL, EXEMPLARY, OR CONSEQUENTIAL DAMAGES (INCLUDING, BUT NOT LIMITED TO, PROCUREMENT OF SUBSTITUTE GOODS OR SERVICES; LOSS OF USE, DATA, OR PROFITS; OR BUSINESS INTERRUPTION) HOWEVER CAUSED AND ON ANY THEORY OF LIABILITY, WHETHER IN CONTRACT, STRICT LIABILITY, OR TORT (INCLUDING NEGLIGENCE OR OTHERWISE) ARISING IN ANY WAY OUT OF THE USE OF THIS SOFTWARE, EVEN IF ADVISED OF THE POSSIBILITY OF SUCH DAMAGE.
+package typeconv
+
+import (
+	"crypto/sha512"
+	"database/sql"
+	"encoding/hex"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/immune-gmbh/AttestationFailureAnalysisService/if/generated/afas"
+	"github.com/immune-gmbh/AttestationFailureAnalysisService/pkg/storage/models"
+)
+
+// stableHashSize is the size of types.NewImageStableHash values.
+const stableHashSize = 128
+
+// FromThriftTamperingRegistryKind converts Thrift TamperingRegistryKind to the internal models.TamperingRegistryKind.
+func FromThriftTamperingRegistryKind(kind afas.TamperingRegistryKind) (models.TamperingRegistryKind, error) {
+	switch kind {
+	case afas.TamperingRegistryKind_AssetID:
+		return models.TamperingRegistryKindAssetID, nil
+	case afas.TamperingRegistryKind_Hostname:
+		return models.TamperingRegistryKindHostname, nil
+	case afas.TamperingRegistryKind_ImageHash:
+		return models.TamperingRegistryKindImageHash, nil
+	case afas.TamperingRegistryKind_ImageStableHash:
+		return models.TamperingRegistryKindImageStableHash, nil
+	}
+	return "", fmt.Errorf("unknown tampering registry kind: %s", kind)
+}
+
+// ToThriftTamperingRegistryKind converts models.TamperingRegistryKind to Thrift TamperingRegistryKind.
+func ToThriftTamperingRegistryKind(kind models.TamperingRegistryKind) (afas.TamperingRegistryKind, error) {
+	switch kind {
+	case models.TamperingRegistryKindAssetID:
+		return afas.TamperingRegistryKind_AssetID, nil
+	case models.TamperingRegistryKindHostname:
+		return afas.TamperingRegistryKind_Hostname, nil
+	case models.TamperingRegistryKindImageHash:
+		return afas.TamperingRegistryKind_ImageHash, nil
+	case models.TamperingRegistryKindImageStableHash:
+		return afas.TamperingRegistryKind_ImageStableHash, nil
+	}
+	return 0, fmt.Errorf("unknown tampering registry kind: %s", kind)
+}
+
+// FromThriftTamperingRegistryEntry converts Thrift TamperingRegistryEntry to
+// the internal models.TamperingRegistryEntry. The value is validated and normalized
+// according to the kind.
+func FromThriftTamperingRegistryEntry(entry *afas.TamperingRegistryEntry) (*models.TamperingRegistryEntry, error) {
+	if entry == nil {
+		return nil, fmt.Errorf("entry is nil")
+	}
+	if entry.GetID() < 0 {
+		return nil, fmt.Errorf("invalid ID: %d", entry.GetID())
+	}
+
+	kind, err := FromThriftTamperingRegistryKind(entry.GetKind())
+	if err != nil {
+		return nil, err
+	}
+	value, err := normalizeTamperingRegistryValue(kind, entry.GetValue())
+	if err != nil {
+		return nil, fmt.Errorf("invalid value '%s' of kind %s: %w", entry.GetValue(), kind, err)
+	}
+	if entry.GetReason() == "" {
+		return nil, fmt.Errorf("the reason is empty")
+	}
+
+	result := &models.TamperingRegistryEntry{
+		ID:     uint64(entry.GetID()),
+		Kind:   kind,
+		Value:  value,
+		Reason: entry.GetReason(),
+	}
+	if entry.IsSetExpiresAt() {
+		result.ExpiresAt = sql.NullTime{Time: time.Unix(entry.GetExpiresAt(), 0), Valid: true}
+	}
+	return result, nil
+}
+
+func normalizeTamperingRegistryValue(kind models.TamperingRegistryKind, value string) (string, error) {
+	value = strings.TrimSpace(value)
+	switch kind {
+	case models.TamperingRegistryKindAssetID:
+		assetID, err := strconv.ParseInt(value, 10, 64)
+		if err != nil {
+			return "", err
+		}
+		return strconv.FormatInt(assetID, 10), nil
+	case models.TamperingRegistryKindHostname:
+		if value == "" {
+			return "", fmt.Errorf("empty hostname")
+		}
+		return strings.ToLower(value), nil
+	case models.TamperingRegistryKindImageHash:
+		return normalizeHex(value, sha512.Size)
+	case models.TamperingRegistryKindImageStableHash:
+		return normalizeHex(value, stableHashSize)
+	}
+	return "", fmt.Errorf("unknown kind")
+}
+
+func normalizeHex(value string, size int) (string, error) {
+	b, err := hex.DecodeString(value)
+	if err != nil {
+		return "", err
+	}
+	if len(b) != size {
+		return "", fmt.Errorf("expected %d bytes, but received %d", size, len(b))
+	}
+	return hex.EncodeToString(b), nil
+}
+
+// ToThriftTamperingRegistryEntry converts models.TamperingRegistryEntry to Thrift TamperingRegistryEntry.
+func ToThriftTamperingRegistryEntry(entry models.TamperingRegistryEntry) (*afas.TamperingRegistryEntry, error) {
+	kind, err := ToThriftTamperingRegistryKind(entry.Kind)
+	if err != nil {
+		return nil, err
+	}
+	result := &afas.TamperingRegistryEntry{
+		ID:        int64(entry.ID),
+		Kind:      kind,
+		Value:     entry.Value,
+		Reason:    entry.Reason,
+		CreatedAt: entry.CreatedAt.Unix(),
+	}
+	if entry.ExpiresAt.Valid {
+		expiresAt := entry.ExpiresAt.Time.Unix()
+		result.ExpiresAt = &expiresAt
+	}
+	return result, nil
+}
@@ -0,0 +1,48 @@
+// Copyright 2023 Meta Platforms, Inc. and affiliates.
+//
+// Redistribution and use in source and binary forms, with or without modification, are permitted provided that the following conditions are met:
+//
+// 1. Redistributions of source code must retain the above copyright notice, this list of conditions and the following disclaimer.
+//
+// 2. Redistributions in binary form must reproduce the above copyright notice, this list of conditions and the following disclaimer in the documentation and/or other materials provided with the distribution.
+//
+// 3. Neither the name of the copyright holder nor the names of its contributors may be used to endorse or promote products derived from this software without specific prior written permission.
+//
+// THIS SOFTWARE IS PROVIDED BY THE COPYRIGHT HOLDERS AND CONTRIBUTORS "AS IS" AND ANY EXPRESS OR IMPLIED WARRANTIES, INCLUDING, BUT NOT LIMITED TO, THE IMPLIED WARRANTIES OF MERCHANTABILITY AND FITNESS FOR A PARTICULAR PURPOSE ARE DISCLAIMED. IN NO EVENT SHALL THE COPYRIGHT HOLDER OR CONTRIBUTORS BE LIABLE FOR ANY DIRECT, INDIRECT, INCIDENTAL, SPECIAL, EXEMPLARY, OR CONSEQUENTIAL DAMAGES (INCLUDING, BUT NOT LIMITED TO, PROCUREMENT OF SUBSTITUTE GOODS OR SERVICES; LOSS OF USE, DATA, OR PROFITS; OR BUSINESS INTERRUPTION) HOWEVER CAUSED AND ON ANY THEORY OF LIABILITY, WHETHER IN CONTRACT, STRICT LIABILITY, OR TORT (INCLUDING NEGLIGENCE OR OTHERWISE) ARISING IN ANY WAY OUT OF THE USE OF THIS SOFTWARE, EVEN IF ADVISED OF THE POSSIBILITY OF SUCH DAMAGE.
+
+package typeconv
+
+import (
+	"github.com/immune-gmbh/AttestationFailureAnalysisService/if/generated/afas"
+	"github.com/immune-gmbh/AttestationFailureAnalysisService/pkg/policy"
+)
+
+// ToThriftVerdict converts policy.Decision to the Thrift representation of it.
+func ToThriftVerdict(decision policy.Decision) afas.Verdict {
+	switch decision {
+	case policy.DecisionPass:
+		return afas.Verdict_Pass
+	case policy.DecisionRemediate:
+		return afas.Verdict_Remediate
+	}
+	// Unknown decisions are treated as the strictest one.
+	return afas.Verdict_Escalate
+}
+
+// ToThriftAnalyzeVerdict converts policy.Verdict to the Thrift representation of it.
+func ToThriftAnalyzeVerdict(verdict policy.Verdict) *afas.AnalyzeVerdict {
+	result := &afas.AnalyzeVerdict{
+		Verdict: ToThriftVerdict(verdict.Decision),
+		Policy:  verdict.Policy,
+		Reasons: make([]*afas.VerdictReason, 0, len(verdict.Reasons)),
+	}
+	for _, reason := range verdict.Reasons {
+		result.Reasons = append(result.Reasons, &afas.VerdictReason{
+			AnalyzerName: string(reason.AnalyzerID),
+			Rule:         reason.Rule,
+			Verdict:      ToThriftVerdict(reason.Decision),
+			Description:  reason.Description,
+		})
+	}
+	return result
+}
@@ -0,0 +1,98 @@
+// Copyright 2023 Meta Platforms, Inc. and affiliates.
+//
+// Redistribution and use in source and binary forms, with or without modification, are permitted provided that the following conditions are met:
+//
+// 1. Redistributions of source code must retain the above copyright notice, this list of conditions and the following disclaimer.
+//
+// 2. Redistributions in binary form must reproduce the above copyright notice, this list of conditions and the following disclaimer in the documentation and/or other materials provided with the distribution.
+//
+// 3. Neither the name of the copyright holder nor the names of its contributors may be used to endorse or promote products derived from this software without specific prior written permission.
+//
+// THIS SOFTWARE IS PROVIDED BY THE COPYRIGHT HOLDERS AND CONTRIBUTORS "AS IS" AND ANY EXPRESS OR IMPLIED WARRANTIES, INCLUDING, BUT NOT LIMITED TO, THE IMPLIED WARRANTIES OF MERCHANTABILITY AND FITNESS FOR A PARTICULAR PURPOSE ARE DISCLAIMED. IN NO EVENT SHALL THE COPYRIGHT HOLDER OR CONTRIBUTORS BE LIABLE FOR ANY DIRECT, INDIRECT, INCIDENTAL, SPECIAL, EXEMPLARY, OR CONSEQUENTIAL DAMAGES (INCLUDING, BUT NOT LIMITED TO, PROCUREMENT OF SUBSTITUTE GOODS OR SERVICES; LOSS OF USE, DATA, OR PROFITS; OR BUSINESS INTERRUPTION) HOWEVER CAUSED AND ON ANY THEORY OF LIABILITY, WHETHER IN CONTRACT, STRICT LIABILITY, OR TORT (INCLUDING NEGLIGENCE OR OTHERWISE) ARISING IN ANY WAY OUT OF THE USE OF THIS SOFTWARE, EVEN IF ADVISED OF THE POSSIBILITY OF SUCH DAMAGE.
+package admission
+
+import (
+	"bufio"
+	"fmt"
+	"os"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+)
+
+// ProcStatCPULoad calculates the CPU load using "/proc/stat" (Linux only).
+//
+// The load is the fraction of busy CPU cycles since the previous sample;
+// samples are taken not more often than once per MinInterval.
+type ProcStatCPULoad struct {
+	MinInterval time.Duration
+	Path        string
+
+	locker     sync.Mutex
+	lastSample time.Time
+	lastBusy   uint64
+	lastTotal  uint64
+	lastLoad   float64
+}
+
+// NewProcStatCPULoad returns a new instance of ProcStatCPULoad.
+func NewProcStatCPULoad() *ProcStatCPULoad {
+	return &ProcStatCPULoad{
+		MinInterval: time.Second,
+		Path:        "/proc/stat",
+	}
+}
+
+// Load implements CPULoadFunc.
+func (l *ProcStatCPULoad) Load() (float64, error) {
+	l.locker.Lock()
+	defer l.locker.Unlock()
+
+	now := time.Now()
+	if !l.lastSample.IsZero() && now.Sub(l.lastSample) < l.MinInterval {
+		return l.lastLoad, nil
+	}
+
+	busy, total, err := readProcStat(l.Path)
+	if err != nil {
+		return 0, err
+	}
+	if !l.lastSample.IsZero() && total > l.lastTotal {
+		l.lastLoad = float64(busy-l.lastBusy) / float64(total-l.lastTotal)
+	}
+	l.lastSample, l.lastBusy, l.lastTotal = now, busy, total
+	return l.lastLoad, nil
+}
+
+func readProcStat(path string) (busy, total uint64, err error) {
+	f, err := os.Open(path)
+	if err != nil {
+		return 0, 0, fmt.Errorf("unable to open '%s': %w", path, err)
+	}
+	defer f.Close()
+
+	scanner := bufio.NewScanner(f)
+	for scanner.Scan() {
+		fields := strings.Fields(scanner.Text())
+		if len(fields) < 5 || fields[0] != "cpu" {
+			continue
+		}
+		// user nice system idle iowait irq softirq steal ...
+		for idx, s := range fields[1:] {
+			v, err := strconv.ParseUint(s, 10, 64)
+			if err != nil {
+				return 0, 0, fmt.Errorf("unable to parse value '%s' in '%s': %w", s, path, err)
+			}
+			total += v
+			if idx != 3 && idx != 4 {
+				busy += v
+			}
+		}
+		return busy, total, nil
+	}
+	if err := scanner.Err(); err != nil {
+		return 0, 0, fmt.Errorf("unable to read '%s': %w", path, err)
+	}
+	return 0, 0, fmt.Errorf("no aggregated 'cpu' line in '%s'", path)
+}
@@ -0,0 +1,238 @@
+// Copyright 2023 Meta Platforms, Inc. and affiliates.
+//
+// Redistribution and use in source and binary forms, with or without modification, are permitted provided that the following conditions are met:
+//
+// 1. Redistributions of source code must retain the above copyright notice, this list of conditions and the following disclaimer.
+//
+// 2. Redistributions in binary form must reproduce the above copyright notice, this list of conditions and the following disclaimer in the documentation and/or other materials provided with the distribution.
+//
+// 3. Neither the name of the copyright holder nor the names of its contributors may be used to endorse or promote products derived from this software without specific prior written permission.
+//
+// THIS SOFTWARE IS PROVIDED BY THE COPYRIGHT HOLDERS AND CONTRIBUTORS "AS IS" AND ANY EXPRESS OR IMPLIED WARRANTIES, INCLUDING, BUT NOT LIMITED TO, THE IMPLIED WARRANTIES OF MERCHANTABILITY AND FITNESS FOR A PARTICULAR PURPOSE ARE DISCLAIMED. IN NO EVENT SHALL THE COPYRIGHT HOLDER OR CONTRIBUTORS BE LIABLE FOR ANY DIRECT, INDIRECT, INCIDENTAL, SPECIAL, EXEMPLARY, OR CONSEQUENTIAL DAMAGES (INCLUDING, BUT NOT LIMITED TO, PROCUREMENT OF SUBSTITUTE GOODS OR SERVICES; LOSS OF USE, DATA, OR PROFITS; OR BUSINESS INTERRUPTION) HOWEVER CAUSED AND ON ANY THEORY OF LIABILITY, WHETHER IN CONTRACT, STRICT LIABILITY, OR TORT (INCLUDING NEGLIGENCE OR OTHERWISE) ARISING IN ANY WAY OUT OF THE USE OF THIS SOFTWARE, EVEN IF ADVISED OF THE POSSIBILITY OF SUCH DAMAGE.
+package admission
+
+import (
+	"container/list"
+	"context"
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/facebookincubator/go-belt/pkg/field"
+	"github.com/facebookincubator/go-belt/tool/experimental/metrics"
+	"github.com/facebookincubator/go-belt/tool/logger"
+)
+
+// DefaultRetryAfter is the back-off hint returned to rejected clients
+// if Config.RetryAfter is not set.
+const DefaultRetryAfter = time.Second
+
+// CPULoadFunc returns the fraction of busy CPU cycles (within [0, 1]).
+type CPULoadFunc func() (float64, error)
+
+// Config is the configuration of a Limiter.
+type Config struct {
+	// Name is used to distinguish limiters in metrics.
+	Name string
+
+	// Workers is the maximal amount of concurrently executed requests.
+	// Zero means no limit.
+	Workers uint
+
+	// QueueSize is the maximal amount of requests waiting for a worker.
+	// Requests exceeding the limit are rejected with ErrOverloaded.
+	QueueSize uint
+
+	// MaxCPULoad is the fraction of busy CPU cycles above which new requests
+	// are rejected with ErrOverloaded. Values <= 0 or >= 1 disable the check.
+	MaxCPULoad float64
+
+	// CPULoad is the source of the CPU load; nil disables the check.
+	CPULoad CPULoadFunc
+
+	// RetryAfter is the back-off hint returned with ErrOverloaded.
+	RetryAfter time.Duration
+}
+
+// Limiter is an admission controller: it limits the amount of concurrently
+// executed requests, queues the excess and rejects requests if the queue
+// is full or if the CPU is overloaded.
+//
+// Queued requests are dispatched fairly: a worker released is given
+// to the next client in a round-robin order, so a single client
+// flooding the queue cannot starve others.
+type Limiter struct {
+	config Config
+
+	locker  sync.Mutex
+	running uint
+	queued  uint
+	queues  map[string]*list.List
+	keys    []string
+	nextKey int
+}
+
+type waiter struct {
+	ctx     context.Context
+	ready   chan struct{}
+	granted bool
+}
+
+// NewLimiter returns a new instance of Limiter.
+func NewLimiter(cfg Config) *Limiter {
+	if cfg.RetryAfter <= 0 {
+		cfg.RetryAfter = DefaultRetryAfter
+	}
+	return &Limiter{
+		config: cfg,
+		queues: map[string]*list.List{},
+	}
+}
+
+// Acquire waits for a free worker for a request of the client identified by key
+// and returns the function to release the worker. The function returns
+// ErrOverloaded if the request is rejected, or the context error if
+// the context is done before a worker is acquired.
+func (l *Limiter) Acquire(ctx context.Context, key string) (func(), error) {
+	if err := l.checkCPULoad(ctx); err != nil {
+		return nil, err
+	}
+
+	l.locker.Lock()
+	if l.config.Workers == 0 || (l.running < l.config.Workers && l.queued == 0) {
+		l.running++
+		l.locker.Unlock()
+		return l.releaseFunc(), nil
+	}
+	if l.queued >= l.config.QueueSize {
+		l.locker.Unlock()
+		return nil, l.reject(ctx, "queue_full", "the queue of requests is full")
+	}
+	w := &waiter{ctx: ctx, ready: make(chan struct{})}
+	elem := l.enqueue(key, w)
+	l.locker.Unlock()
+
+	select {
+	case <-w.ready:
+		return l.releaseFunc(), nil
+	case <-ctx.Done():
+	}
+
+	l.locker.Lock()
+	granted := w.granted
+	if !granted {
+		l.dequeue(key, elem)
+	}
+	l.locker.Unlock()
+	if granted {
+		l.release()
+	}
+	return nil, ctx.Err()
+}
+
+// Running returns the amount of requests currently holding a worker.
+func (l *Limiter) Running() uint {
+	l.locker.Lock()
+	defer l.locker.Unlock()
+	return l.running
+}
+
+// Queued returns the amount of requests waiting for a worker.
+func (l *Limiter) Queued() uint {
+	l.locker.Lock()
+	defer l.locker.Unlock()
+	return l.queued
+}
+
+func (l *Limiter) checkCPULoad(ctx context.Context) error {
+	if l.config.CPULoad == nil || l.config.MaxCPULoad <= 0 || l.config.MaxCPULoad >= 1 {
+		return nil
+	}
+	load, err := l.config.CPULoad()
+	if err != nil {
+		logger.FromCtx(ctx).Warnf("unable to get the CPU load: %v", err)
+		return nil
+	}
+	if load <= l.config.MaxCPULoad {
+		return nil
+	}
+	return l.reject(ctx, "cpu_load", "the CPU load %.2f exceeds the limit %.2f", load, l.config.MaxCPULoad)
+}
+
+func (l *Limiter) reject(ctx context.Context, reason string, format string, args ...any) error {
+	metrics.FromCtx(ctx).CountFields("admissionRejected", field.Fields{
+		{Key: "limiter", Value: l.config.Name},
+		{Key: "reason", Value: reason},
+	}).Add(1)
+	return ErrOverloaded{
+		Reason:     fmt.Sprintf(format, args...),
+		RetryAfter: l.config.RetryAfter,
+	}
+}
+
+func (l *Limiter) queueDepthGauge(ctx context.Context) metrics.Gauge {
+	return metrics.FromCtx(ctx).GaugeFields("admissionQueueDepth", field.Fields{
+		{Key: "limiter", Value: l.config.Name},
+	})
+}
+
+// enqueue should be called only with l.locker locked.
+func (l *Limiter) enqueue(key string, w *waiter) *list.Element {
+	queue := l.queues[key]
+	if queue == nil {
+		queue = list.New()
+		l.queues[key] = queue
+		l.keys = append(l.keys, key)
+	}
+	l.queued++
+	l.queueDepthGauge(w.ctx).Add(1)
+	return queue.PushBack(w)
+}
+
+// dequeue should be called only with l.locker locked.
+func (l *Limiter) dequeue(key string, elem *list.Element) {
+	queue := l.queues[key]
+	w := queue.Remove(elem).(*waiter)
+	l.queued--
+	l.queueDepthGauge(w.ctx).Add(-1)
+	if queue.Len() > 0 {
+		return
+	}
+	delete(l.queues, key)
+	for idx, k := range l.keys {
+		if k != key {
+			continue
+		}
+		l.keys = append(l.keys[:idx], l.keys[idx+1:]...)
+		if l.nextKey > idx {
+			l.nextKey--
+		}
+		break
+	}
+}
+
+func (l *Limiter) releaseFunc() func() {
+	var once sync.Once
+	return func() {
+		once.Do(l.release)
+	}
+}
+
+func (l *Limiter) release() {
+	l.locker.Lock()
+	defer l.locker.Unlock()
+	l.running--
+	for l.running < l.config.Workers && len(l.keys) > 0 {
+		if l.nextKey >= len(l.keys) {
+			l.nextKey = 0
+		}
+		key := l.keys[l.nextKey]
+		l.nextKey++
+		elem := l.queues[key].Front()
+		w := elem.Value.(*waiter)
+		l.dequeue(key, elem)
+		w.granted = true
+		l.running++
+		close(w.ready)
+	}
+}
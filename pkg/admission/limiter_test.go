@@ -0,0 +1,137 @@
+// Copyright 2023 Meta Platforms, Inc. and affiliates.
+//
+// Redistribution and use in source and binary forms, with or without modification, are permitted provided that the following conditions are met:
+//
+// 1. Redistributions of source code must retain the above copyright notice, this list of conditions and the following disclaimer.
+//
+// 2. Redistributions in binary form must reproduce the above copyright notice, this list of conditions and the following disclaimer in the documentation and/or other materials provided with the distribution.
+//
+// 3. Neither the name of the copyright holder nor the names of its contributors may be used to endorse or promote products derived from this software without specific prior written permission.
+//
+// THIS SOFTWARE IS PROVIDED BY THE COPYRIGHT HOLDERS AND CONTRIBUTORS "AS IS" AND ANY EXPRESS OR IMPLIED WARRANTIES, INCLUDING, BUT NOT LIMITED TO, THE IMPLIED WARRANTIES OF MERCHANTABILITY AND FITNESS FOR A PARTICULAR PURPOSE ARE DISCLAIMED. IN NO EVENT SHALL THE COPYRIGHT HOLDER OR CONTRIBUTORS BE LIABLE FOR ANY DIRECT, INDIRECT, INCIDENTAL, SPECIAL, EXEMPLARY, OR CONSEQUENTIAL DAMAGES (INCLUDING, BUT NOT LIMITED TO, PROCUREMENT OF SUBSTITUTE GOODS OR SERVICES; LOSS OF USE, DATA, OR PROFITS; OR BUSINESS INTERRUPTION) HOWEVER CAUSED AND ON ANY THEORY OF LIABILITY, WHETHER IN CONTRACT, STRICT LIABILITY, OR TORT (INCLUDING NEGLIGENCE OR OTHERWISE) ARISING IN ANY WAY OUT OF THE USE OF THIS SOFTWARE, EVEN IF ADVISED OF THE POSSIBILITY OF SUCH DAMAGE.
+package admission
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+func acquireAsync(l *Limiter, key string) <-chan func() {
+	ch := make(chan func(), 1)
+	go func() {
+		release, err := l.Acquire(context.Background(), key)
+		if err != nil {
+			panic(err)
+		}
+		ch <- release
+	}()
+	return ch
+}
+
+func waitQueued(t *testing.T, l *Limiter, queued uint) {
+	require.Eventually(t, func() bool { return l.Queued() == queued }, time.Second, time.Millisecond)
+}
+
+func TestLimiterQueueFull(t *testing.T) {
+	l := NewLimiter(Config{Workers: 1, QueueSize: 1})
+
+	release, err := l.Acquire(context.Background(), "a")
+	require.NoError(t, err)
+	queued := acquireAsync(l, "a")
+	waitQueued(t, l, 1)
+
+	_, err = l.Acquire(context.Background(), "a")
+	var errOverloaded ErrOverloaded
+	require.True(t, errors.As(err, &errOverloaded), err)
+	require.Equal(t, DefaultRetryAfter, errOverloaded.RetryAfter)
+
+	release()
+	(<-queued)()
+	require.Zero(t, l.Running())
+}
+
+func TestLimiterFairQueuing(t *testing.T) {
+	l := NewLimiter(Config{Workers: 1, QueueSize: 10})
+
+	release, err := l.Acquire(context.Background(), "busy")
+	require.NoError(t, err)
+
+	var order []string
+	var waiters []<-chan func()
+	for _, key := range []string{"busy", "busy", "busy", "quiet"} {
+		waiters = append(waiters, acquireAsync(l, key))
+		waitQueued(t, l, uint(len(waiters)))
+	}
+
+	keys := []string{"busy", "busy", "busy", "quiet"}
+	release()
+	for len(order) < len(waiters) {
+		for idx, ch := range waiters {
+			select {
+			case release := <-ch:
+				order = append(order, fmt.Sprintf("%s%d", keys[idx], idx))
+				release()
+			default:
+			}
+		}
+	}
+	// "quiet" does not wait for all the requests of "busy"
+	require.Equal(t, []string{"busy0", "quiet3", "busy1", "busy2"}, order)
+}
+
+func TestLimiterCanceled(t *testing.T) {
+	l := NewLimiter(Config{Workers: 1, QueueSize: 1})
+
+	release, err := l.Acquire(context.Background(), "a")
+	require.NoError(t, err)
+
+	ctx, cancelFn := context.WithTimeout(context.Background(), 10*time.Millisecond)
+	defer cancelFn()
+	_, err = l.Acquire(ctx, "a")
+	require.ErrorIs(t, err, context.DeadlineExceeded)
+	require.Zero(t, l.Queued())
+
+	release()
+	require.Zero(t, l.Running())
+}
+
+func TestLimiterCPULoad(t *testing.T) {
+	load := 0.9
+	l := NewLimiter(Config{
+		MaxCPULoad: 0.8,
+		CPULoad:    func() (float64, error) { return load, nil },
+		RetryAfter: time.Minute,
+	})
+
+	_, err := l.Acquire(context.Background(), "a")
+	require.Equal(t, time.Minute, err.(ErrOverloaded).RetryAfter)
+
+	load = 0.5
+	release, err := l.Acquire(context.Background(), "a")
+	require.NoError(t, err)
+	release()
+}
+
+func TestProcStatCPULoad(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "stat")
+	write := func(s string) {
+		require.NoError(t, os.WriteFile(path, []byte(s), 0644))
+	}
+	l := &ProcStatCPULoad{Path: path}
+
+	write("cpu  100 0 100 700 100 0 0 0 0 0\ncpu0 100 0 100 700 100 0 0 0 0 0\n")
+	_, err := l.Load()
+	require.NoError(t, err)
+
+	write("cpu  250 0 250 800 100 0 0 0 0 0\ncpu0 250 0 250 800 100 0 0 0 0 0\n")
+	load, err := l.Load()
+	require.NoError(t, err)
+	require.InDelta(t, 0.75, load, 0.001)
+}
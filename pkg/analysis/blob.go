@@ -12,6 +12,13 @@
 
 package analysis
 
+import (
+	"fmt"
+	"reflect"
+
+	pkgbytes "github.com/linuxboot/fiano/pkg/bytes"
+)
+
 // Blob is an interface of a huge blob. Semantically in this package
 // it is just `[]byte`. But:
 //  1. Sometimes the consumers of this package
@@ -37,3 +44,114 @@ type BytesBlob []byte
 func (s BytesBlob) Bytes() []byte {
 	return s
 }
+
+// PartialBlob is a Blob which knows which of its ranges were not dumped
+// (see flashrom.DumpRegions). A partial image has the same layout as a complete
+// dump made by the same method, the missing ranges are just padded.
+type PartialBlob interface {
+	Blob
+	MissingRanges() pkgbytes.Ranges
+}
+
+// SparseBlob is a Blob of a partially dumped image (see flashrom.DumpRegions).
+// Only the bytes within Coverage were actually dumped, the rest are placeholders
+// and must not be interpreted as the image content.
+type SparseBlob struct {
+	Blob
+
+	// Coverage is the list of dumped ranges. It contributes into the cache key.
+	Coverage pkgbytes.Ranges
+}
+
+// NewSparseBlob creates a new SparseBlob object.
+func NewSparseBlob(blob Blob, coverage pkgbytes.Ranges) SparseBlob {
+	coverage = append(pkgbytes.Ranges{}, coverage...)
+	coverage.SortAndMerge()
+	return SparseBlob{
+		Blob:     blob,
+		Coverage: coverage,
+	}
+}
+
+// MissingRanges returns the ranges of the blob which were not dumped.
+func (blob SparseBlob) MissingRanges() pkgbytes.Ranges {
+	return pkgbytes.Range{Offset: 0, Length: uint64(len(blob.Bytes()))}.Exclude(blob.Coverage...)
+}
+
+// MissingRanges returns the ranges of the image which were not dumped,
+// if the image is a PartialBlob (or a wrapper of it, see UnwrapBlob).
+// For other blobs it returns nil.
+func MissingRanges(blob Blob) pkgbytes.Ranges {
+	for blob != nil {
+		if partial, ok := blob.(PartialBlob); ok {
+			return partial.MissingRanges()
+		}
+		blob = unwrapBlob(blob)
+	}
+	return nil
+}
+
+var blobType = reflect.TypeOf((*Blob)(nil)).Elem()
+
+// unwrapBlob returns the Blob embedded into `blob` or nil if `blob` is not a wrapper.
+func unwrapBlob(blob Blob) Blob {
+	v := reflect.ValueOf(blob)
+	if v.Kind() == reflect.Pointer {
+		if v.IsNil() {
+			return nil
+		}
+		v = v.Elem()
+	}
+	if v.Kind() != reflect.Struct {
+		return nil
+	}
+	field, ok := v.Type().FieldByName("Blob")
+	if !ok || !field.Anonymous || field.Type != blobType {
+		return nil
+	}
+	inner, _ := v.FieldByIndex(field.Index).Interface().(Blob)
+	return inner
+}
+
+// UnwrapBlob returns the innermost Blob of `blob`. A wrapper is any struct
+// (or a pointer to a struct) embedding a Blob, for example ActualFirmwareBlob,
+// OriginalFirmware or SparseBlob; wrappers might be nested.
+func UnwrapBlob(blob Blob) Blob {
+	for {
+		inner := unwrapBlob(blob)
+		if inner == nil {
+			return blob
+		}
+		blob = inner
+	}
+}
+
+// MissingRangesIssue returns an issue if any of the `required` ranges
+// of the image were not dumped. Otherwise it returns nil.
+func MissingRangesIssue(blob Blob, required pkgbytes.Ranges) *Issue {
+	var missing pkgbytes.Ranges
+	for _, missingRange := range MissingRanges(blob) {
+		for _, requiredRange := range required {
+			if !missingRange.Intersect(requiredRange) {
+				continue
+			}
+			start, end := missingRange.Offset, missingRange.End()
+			if requiredRange.Offset > start {
+				start = requiredRange.Offset
+			}
+			if requiredRange.End() < end {
+				end = requiredRange.End()
+			}
+			missing = append(missing, pkgbytes.Range{Offset: start, Length: end - start})
+		}
+	}
+	if len(missing) == 0 {
+		return nil
+	}
+	missing.SortAndMerge()
+	return &Issue{
+		Custom:      missing,
+		Severity:    SeverityWarning,
+		Description: fmt.Sprintf("the image was dumped partially, the analysis does not cover ranges %s", missing),
+	}
+}
@@ -0,0 +1,58 @@
+// Copyright 2023 Meta Platforms, Inc. and affiliates.
+//
+// Redistribution and use in source and binary forms, with or without modification, are permitted provided that the following conditions are met:
+//
+// 1. Redistributions of source code must retain the above copyright notice, this list of conditions and the following disclaimer.
+//
+// 2. Redistributions in binary form must reproduce the above copyright notice, this list of conditions and the following disclaimer in the documentation and/or other materials provided with the distribution.
+//
+// 3. Neither the name of the copyright holder nor the names of its contributors may be used to endorse or promote products derived from this software without specific prior written permission.
+//
+// THIS SOFTWARE IS PROVIDED BY THE COPYRIGHT HOLDERS AND CONTRIBUTORS "AS IS" AND ANY EXPRESS OR IMPLIED WARRANTIES, INCLUDING, BUT NOT LIMITED TO, THE IMPLIED WARRANTIES OF MERCHANTABILITY AND FITNESS FOR A PARTICULAR PURPOSE ARE DISCLAIMED. IN NO EVENT SHALL THE COPYRIGHT HOLDER OR CONTRIBUTORS BE LIABLE FOR ANY DIRECT, INDIRECT, INCIDENTAL, SPECIAL, EXEMPLARY, OR CONSEQUENTIAL DAMAGES (INCLUDING, BUT NOT LIMITED TO, PROCUREMENT OF SUBSTITUTE GOODS OR SERVICES; LOSS OF USE, DATA, OR PROFITS; OR BUSINESS INTERRUPTION) HOWEVER CAUSED AND ON ANY THEORY OF LIABILITY, WHETHER IN CONTRACT, STRICT LIABILITY, OR TORT (INCLUDING NEGLIGENCE OR OTHERWISE) ARISING IN ANY WAY OUT OF THE USE OF THIS SOFTWARE, EVEN IF ADVISED OF THE POSSIBILITY OF SUCH DAMAGE.
+
+package analysis
+
+import (
+	"testing"
+
+	pkgbytes "github.com/linuxboot/fiano/pkg/bytes"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSparseBlobMissingRanges(t *testing.T) {
+	blob := NewSparseBlob(BytesBlob(make([]byte, 0x100)), pkgbytes.Ranges{
+		{Offset: 0x80, Length: 0x80},
+		{Offset: 0, Length: 0x10},
+	})
+	actual := NewActualFirmwareBlob(blob)
+
+	require.Equal(t, pkgbytes.Ranges{{Offset: 0x10, Length: 0x70}}, MissingRanges(actual))
+	require.Nil(t, MissingRanges(NewActualFirmwareBlob(BytesBlob(make([]byte, 0x100)))))
+
+	require.Nil(t, MissingRangesIssue(actual, pkgbytes.Ranges{{Offset: 0x90, Length: 0x10}}))
+	issue := MissingRangesIssue(actual, pkgbytes.Ranges{
+		{Offset: 0x70, Length: 0x20},
+		{Offset: 0x8, Length: 0x10},
+	})
+	require.NotNil(t, issue)
+	require.Equal(t, SeverityWarning, issue.Severity)
+	require.Equal(t, pkgbytes.Ranges{
+		{Offset: 0x10, Length: 0x8},
+		{Offset: 0x70, Length: 0x10},
+	}, issue.Custom)
+}
+
+func TestUnwrapBlob(t *testing.T) {
+	inner := BytesBlob{1, 2, 3}
+	require.Equal(t, inner, UnwrapBlob(inner))
+	require.Equal(t, inner, UnwrapBlob(NewActualFirmwareBlob(inner)))
+	require.Equal(t, inner, UnwrapBlob(&ActualFirmwareBlob{Blob: inner}))
+
+	sparse := NewSparseBlob(inner, pkgbytes.Ranges{{Offset: 0, Length: 1}})
+	require.Equal(t, inner, UnwrapBlob(NewOriginalFirmwareBlob(sparse)))
+	require.Equal(t, inner, UnwrapBlob(&OriginalFirmwareBlob{Blob: &sparse}))
+	require.Equal(t, inner, UnwrapBlob(ActualFirmware{Blob: NewActualFirmwareBlob(sparse)}))
+	require.Equal(t, pkgbytes.Ranges{{Offset: 1, Length: 2}}, MissingRanges(ActualFirmware{Blob: &sparse}))
+
+	require.Equal(t, ActualFirmwareBlob{}, UnwrapBlob(ActualFirmwareBlob{}))
+}
@@ -58,7 +58,18 @@ func getActualFirmware(ctx context.Context, in actualFirmwareInput) (ActualFirmw
 		logger.FromCtx(ctx).Errorf("%v", err)
 		return ActualFirmware{}, nil, err
 	}
-	return NewActualFirmware(fw, in.FirmwareImage), nil, nil
+
+	// The analyzers relying on the parsed image could see placeholders instead
+	// of the actual content, so we report the missing ranges explicitly.
+	var issues []Issue
+	if missing := MissingRanges(in.FirmwareImage); len(missing) != 0 {
+		issues = append(issues, Issue{
+			Custom:      missing,
+			Severity:    SeverityWarning,
+			Description: fmt.Sprintf("the actual image was dumped partially, ranges %s are missing", missing),
+		})
+	}
+	return NewActualFirmware(fw, in.FirmwareImage), issues, nil
 }
 
 type actualPSPFirmwareInput struct {
@@ -202,14 +213,18 @@ type getAlignedOriginalImageInput struct {
 
 func getAlignedOriginalImage(ctx context.Context, in getAlignedOriginalImageInput) (AlignedOriginalFirmware, []Issue, error) {
 	log := logger.FromCtx(ctx)
-	alignedImage, offset, err := imgalign.GetAlignedImage(ctx, in.OriginalFirmware.UEFI(), in.ActualFirmware.Bytes())
+	alignedImage, alignment, err := imgalign.GetAlignedImage(ctx, in.OriginalFirmware.UEFI(), in.ActualFirmware.Bytes())
 	if err != nil {
 		err = fmt.Errorf("failed to align original and dumped firmware images: '%v'", err)
 		log.Errorf("%v", err)
 		return AlignedOriginalFirmware{}, nil, err
 	}
-	log.Infof("Aligned images offset: %d", offset)
-	return NewAlignedOriginalFirmware(alignedImage, offset, in.OriginalFirmware.Blob), nil, nil
+	if offset, ok := alignment.ImageOffset(); ok {
+		log.Infof("Aligned images offset: %d", offset)
+	} else {
+		log.Infof("Aligned images by %d segments, %d ranges are unmapped", len(alignment.Mapping), len(alignment.UnmappedRanges))
+	}
+	return NewAlignedOriginalFirmware(alignedImage, alignment, in.OriginalFirmware.Blob), nil, nil
 }
 
 type getReferenceFirmwareInput struct {
@@ -18,10 +18,14 @@ import (
 	"reflect"
 	"sort"
 	"sync"
+	"time"
 
 	"github.com/immune-gmbh/AttestationFailureAnalysisService/pkg/lockmap"
 	"github.com/immune-gmbh/AttestationFailureAnalysisService/pkg/objhash"
+	"github.com/immune-gmbh/AttestationFailureAnalysisService/pkg/observability"
 
+	"github.com/facebookincubator/go-belt/pkg/field"
+	"github.com/facebookincubator/go-belt/tool/experimental/metrics"
 	"github.com/facebookincubator/go-belt/tool/logger"
 	lru "github.com/hashicorp/golang-lru"
 )
@@ -204,6 +208,7 @@ func (dc *DataCalculator) resolveType(
 	unlocker := dc.singleOp.Lock(opHash)
 	defer unlocker.Unlock()
 	if cachedValue, ok := unlocker.UserData.(*CachedValue); ok {
+		countCacheHit(ctx, "runtime")
 		return cachedValue.Val, cachedValue.Issues, cachedValue.Err
 	}
 	defer func() {
@@ -216,6 +221,7 @@ func (dc *DataCalculator) resolveType(
 
 	if cache != nil {
 		if res := cache.Get(t, &opHash); res != nil {
+			countCacheHit(ctx, "scope")
 			return res.Val, res.Issues, res.Err
 		}
 	}
@@ -225,6 +231,7 @@ func (dc *DataCalculator) resolveType(
 		item := cached.(*globalCacheItem)
 		if v, found := item.values[t]; found {
 			log.Debugf("Found result type '%s' and key 0x'%X' in global cache", t, opHash)
+			countCacheHit(ctx, "global")
 			return v, item.issues, nil
 		}
 	}
@@ -273,6 +280,7 @@ func (dc *DataCalculator) resolveType(
 	}()
 	if found {
 		log.Debugf("Found result type '%s' and key '0x%X' in runtime cache", t, opHash)
+		countCacheHit(ctx, "runtime")
 		calcResult, err := calcFuture.Get()
 		if err != nil {
 			return reflect.Value{}, nil, err
@@ -281,8 +289,11 @@ func (dc *DataCalculator) resolveType(
 	}
 
 	// We are the first (and probably the only) who requested a value under this circumstances
+	metrics.FromCtx(ctx).Count("dataCalculatorCacheMisses").Add(1)
 	inputArgs := prepareInputArgs(ctx, inputValue, calculatorType.In(1).Kind() == reflect.Ptr)
+	startTime := time.Now()
 	calcResult, err := newCalculatorResult(calculatorVal.Call(inputArgs))
+	observability.ObserveDuration(ctx, "dataCalculatorDurationSeconds", field.Fields{{Key: "type", Value: t.String()}}, time.Since(startTime))
 	if err != nil {
 		log.Errorf("failed to process calculator '%s' results: '%v'", calculatorType.Name(), err)
 		calcFuture.SetError(err)
@@ -458,3 +469,7 @@ func isOptional(tags []string) bool {
 	}
 	return false
 }
+
+func countCacheHit(ctx context.Context, cacheName string) {
+	metrics.FromCtx(ctx).CountFields("dataCalculatorCacheHits", field.Fields{{Key: "cache", Value: cacheName}}).Add(1)
+}
@@ -163,6 +163,16 @@ func (in Input) AddOriginalBIOSInfo(biosInfo OriginalBIOSInfo) Input {
 	return in.AddCustomValue(biosInfo)
 }
 
+// AddActualFirmwareDumpInfo adds information about how the actual firmware image was dumped.
+func (in Input) AddActualFirmwareDumpInfo(info ActualFirmwareDumpInfo) Input {
+	return in.AddCustomValue(info)
+}
+
+// AddKnownTampering adds the entries of the tampering registry matching the host or its actual firmware.
+func (in Input) AddKnownTampering(t KnownTampering) Input {
+	return in.AddCustomValue(t)
+}
+
 // AddCustomValue adds a custom value as some plugins take unique values
 //
 // Note: register custom values through RegisterInputType, to make them
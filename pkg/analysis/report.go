@@ -33,6 +33,19 @@ const (
 	SeverityCritical
 )
 
+// String implements fmt.Stringer.
+func (s Severity) String() string {
+	switch s {
+	case SeverityInfo:
+		return "info"
+	case SeverityWarning:
+		return "warning"
+	case SeverityCritical:
+		return "critical"
+	}
+	return fmt.Sprintf("unknown_%d", uint32(s))
+}
+
 // Issue describes a single found problem in firmware
 type Issue struct {
 	// Custom is a custom information provided for issue description. Should be serialisable
@@ -23,8 +23,10 @@ import (
 	"github.com/9elements/converged-security-suite/v2/pkg/tpmeventlog"
 	"github.com/9elements/converged-security-suite/v2/pkg/uefi"
 	amd_manifest "github.com/linuxboot/fiano/pkg/amd/manifest"
+	pkgbytes "github.com/linuxboot/fiano/pkg/bytes"
 
 	"github.com/immune-gmbh/AttestationFailureAnalysisService/pkg/dmidecode"
+	"github.com/immune-gmbh/AttestationFailureAnalysisService/pkg/imgalign"
 	"github.com/immune-gmbh/AttestationFailureAnalysisService/pkg/objhash"
 	"github.com/immune-gmbh/AttestationFailureAnalysisService/pkg/types"
 )
@@ -32,6 +34,7 @@ import (
 func init() {
 	RegisterType((*types.BootFlow)(nil))
 	RegisterType((BytesBlob)(nil))
+	RegisterType((*SparseBlob)(nil))
 	RegisterType((*OriginalFirmwareBlob)(nil))
 	RegisterType((*ActualFirmwareBlob)(nil))
 	RegisterType((*ActualRegisters)(nil))
@@ -42,6 +45,8 @@ func init() {
 	RegisterType((*OriginalBIOSInfo)(nil))
 	RegisterType((*ActualBIOSInfo)(nil))
 	RegisterType((*ReferenceFirmware)(nil))
+	RegisterType((*ActualFirmwareDumpInfo)(nil))
+	RegisterType((*KnownTampering)(nil))
 }
 
 // AnalyzerID is a unique ID of every analyzer
@@ -49,9 +54,14 @@ func init() {
 // TODO: consider replacing unique indexes with reflect.TypeOf(input) to have a single source of truth
 type AnalyzerID string
 
+// AnalyzerVersion is a semantic version (like "1.2.0") of an analyzer. It should
+// be increased each time a change in the analyzer could change its reports.
+type AnalyzerVersion string
+
 // Analyzer is an abstract interface that each analyzer should implement
 type Analyzer[inputType any] interface {
 	ID() AnalyzerID
+	Version() AnalyzerVersion
 	Analyze(context.Context, inputType) (*Report, error)
 }
 
@@ -215,9 +225,13 @@ type ActualPCR0 []byte
 // Often the only region we can dump from the target is BIOS region, while the original image usually consists
 // of multiple regions (and the BIOS region is the last one). So the aligned image is a such image that has
 // an offset (to start with the same thing as the dumped firmware) and the same length as the dumped firmware.
+//
+// If the images have different flash sizes or layouts, then the aligned image is composed region-by-region
+// and volume-by-volume (see Alignment), and ImageOffset is zero.
 type AlignedOriginalFirmware struct {
 	Blob        Blob
 	ImageOffset uint64
+	Alignment   *imgalign.Alignment
 	fw          *uefi.UEFI
 }
 
@@ -229,13 +243,15 @@ func (ao AlignedOriginalFirmware) UEFI() *uefi.UEFI {
 // NewAlignedOriginalFirmware creates new AlignedOriginalImage object
 //
 // `blob` is optional, if not provided, then fw.Buf() is used instead.
-func NewAlignedOriginalFirmware(fw *uefi.UEFI, offset uint64, blob Blob) AlignedOriginalFirmware {
+func NewAlignedOriginalFirmware(fw *uefi.UEFI, alignment *imgalign.Alignment, blob Blob) AlignedOriginalFirmware {
 	if blob == nil {
 		blob = BytesBlob(fw.ImageBytes())
 	}
+	offset, _ := alignment.ImageOffset()
 	return AlignedOriginalFirmware{
 		Blob:        blob,
 		ImageOffset: offset,
+		Alignment:   alignment,
 		fw:          fw,
 	}
 }
@@ -325,3 +341,111 @@ type OriginalBIOSInfo struct {
 func NewOriginalBIOSInfo(biosInfo dmidecode.BIOSInfo) *OriginalBIOSInfo {
 	return &OriginalBIOSInfo{BIOSInfo: biosInfo}
 }
+
+// ActualFirmwareDumpInfo describes how the actual firmware image was acquired
+// from the host (see flashrom.DumpInfo).
+type ActualFirmwareDumpInfo struct {
+	// Method is the name of the dump method which produced the image.
+	Method string
+
+	// Quality is the name of the dump quality (unverified, verified, unstable).
+	Quality string
+
+	// UnstableRanges are ranges which differed between two consecutive reads.
+	UnstableRanges pkgbytes.Ranges
+
+	// Fallback is true if the image was produced by a fallback method
+	// after the preferred methods failed.
+	Fallback bool
+
+	// FallbackError is the error of the preferred method, if Fallback is true.
+	FallbackError string
+}
+
+// IsUnstable returns true if the given range lies fully within the unstable
+// ranges of the dump.
+func (info *ActualFirmwareDumpInfo) IsUnstable(r pkgbytes.Range) bool {
+	if info == nil || r.Length == 0 {
+		return false
+	}
+	return len(r.Exclude(info.UnstableRanges...)) == 0
+}
+
+// KnownTamperingEntry is an entry of the tampering registry, which matches
+// the analyzed host or its actual firmware image.
+type KnownTamperingEntry struct {
+	// Kind is what the entry matched: "asset_id", "hostname", "image_hash" or "image_stable_hash".
+	Kind string
+
+	// Value is the matched value.
+	Value string
+
+	// Reason is the human-readable explanation why the entry was registered.
+	Reason string
+}
+
+// String implements fmt.Stringer.
+func (entry KnownTamperingEntry) String() string {
+	return fmt.Sprintf("%s '%s' (%s)", entry.Kind, entry.Value, entry.Reason)
+}
+
+// KnownTampering describes whether the analyzed host is known to be
+// intentionally tampered (for example a red-team machine), and whether
+// its actual firmware image is known to be malicious.
+type KnownTampering struct {
+	// TamperedHost is set if the host is known to be intentionally tampered.
+	TamperedHost *KnownTamperingEntry
+
+	// BadImage is set if the actual firmware image is known to be malicious.
+	BadImage *KnownTamperingEntry
+}
+
+// KnownTamperingOf returns the KnownTampering added to the input
+// (see Input.AddKnownTampering), or nil if there is none.
+func KnownTamperingOf(in Input) *KnownTampering {
+	for _, v := range in {
+		switch v := v.(type) {
+		case *KnownTampering:
+			return v
+		case KnownTampering:
+			return &v
+		}
+	}
+	return nil
+}
+
+// IsTamperedHost returns true if the host is known to be intentionally tampered.
+func (t *KnownTampering) IsTamperedHost() bool {
+	return t != nil && t.TamperedHost != nil
+}
+
+// IsBadImage returns true if the actual firmware image is known to be malicious.
+func (t *KnownTampering) IsBadImage() bool {
+	return t != nil && t.BadImage != nil
+}
+
+// ApplyTo amends a report of an analyzer with a known malicious image: it
+// is reported as a critical issue (a warning on an intentionally tampered host),
+// unless the report already has a critical issue.
+//
+// Critical issues are never downgraded here: the only issues expected on an
+// intentionally tampered host are the firmware differences, and the analyzer
+// finding them (DiffMeasuredBoot) takes KnownTampering into account itself.
+func (t *KnownTampering) ApplyTo(report *Report) {
+	if report == nil || !t.IsBadImage() {
+		return
+	}
+	for _, issue := range report.Issues {
+		if issue.Severity == SeverityCritical {
+			return
+		}
+	}
+	severity := SeverityCritical
+	if t.IsTamperedHost() {
+		severity = SeverityWarning
+	}
+	report.Issues = append(report.Issues, Issue{
+		Severity:    severity,
+		Description: fmt.Sprintf("the actual firmware image is known to be malicious: %s", t.BadImage),
+	})
+}
@@ -17,6 +17,7 @@ import (
 	"reflect"
 	"testing"
 
+	pkgbytes "github.com/linuxboot/fiano/pkg/bytes"
 	"github.com/stretchr/testify/require"
 )
 
@@ -32,6 +33,8 @@ func TestTypesUnique(t *testing.T) {
 		reflect.TypeOf(ActualPCR0(nil)),
 		reflect.TypeOf(AlignedOriginalFirmware{}),
 		reflect.TypeOf(AssetID(0)),
+		reflect.TypeOf(ActualFirmwareDumpInfo{}),
+		reflect.TypeOf(KnownTampering{}),
 	}
 
 	seen := make(map[reflect.Type]struct{})
@@ -42,3 +45,57 @@ func TestTypesUnique(t *testing.T) {
 		seen[checkedType] = struct{}{}
 	}
 }
+
+func TestActualFirmwareDumpInfoIsUnstable(t *testing.T) {
+	info := &ActualFirmwareDumpInfo{
+		UnstableRanges: pkgbytes.Ranges{
+			{Offset: 0x10, Length: 0x10},
+			{Offset: 0x20, Length: 0x10},
+		},
+	}
+	require.True(t, info.IsUnstable(pkgbytes.Range{Offset: 0x10, Length: 1}))
+	require.True(t, info.IsUnstable(pkgbytes.Range{Offset: 0x18, Length: 0x10}))
+	require.False(t, info.IsUnstable(pkgbytes.Range{Offset: 0x28, Length: 0x10}))
+	require.False(t, info.IsUnstable(pkgbytes.Range{Offset: 0x0, Length: 1}))
+	require.False(t, info.IsUnstable(pkgbytes.Range{}))
+	require.False(t, (*ActualFirmwareDumpInfo)(nil).IsUnstable(pkgbytes.Range{Offset: 0x10, Length: 1}))
+}
+
+func TestKnownTamperingApplyTo(t *testing.T) {
+	newReport := func() *Report {
+		return &Report{Issues: []Issue{{Severity: SeverityCritical}, {Severity: SeverityInfo}}}
+	}
+	entry := &KnownTamperingEntry{Kind: "hostname", Value: "lab1", Reason: "red team"}
+
+	report := newReport()
+	(*KnownTampering)(nil).ApplyTo(report)
+	require.Equal(t, newReport(), report)
+
+	// critical issues are not downgraded for analyzers other than DiffMeasuredBoot
+	report = newReport()
+	(&KnownTampering{TamperedHost: entry}).ApplyTo(report)
+	require.Equal(t, newReport(), report)
+
+	// the image is already reported as critical
+	report = newReport()
+	(&KnownTampering{BadImage: entry}).ApplyTo(report)
+	require.Equal(t, newReport(), report)
+
+	report = &Report{Issues: []Issue{{Severity: SeverityInfo}}}
+	(&KnownTampering{BadImage: entry}).ApplyTo(report)
+	require.Len(t, report.Issues, 2)
+	require.Equal(t, SeverityCritical, report.Issues[1].Severity)
+
+	report = &Report{}
+	(&KnownTampering{TamperedHost: entry, BadImage: entry}).ApplyTo(report)
+	require.Len(t, report.Issues, 1)
+	require.Equal(t, SeverityWarning, report.Issues[0].Severity)
+}
+
+func TestKnownTamperingOf(t *testing.T) {
+	require.Nil(t, KnownTamperingOf(NewInput().AddActualPCR0([]byte{1})))
+
+	entry := &KnownTamperingEntry{Kind: "hostname", Value: "lab1", Reason: "red team"}
+	in := NewInput().AddKnownTampering(KnownTampering{TamperedHost: entry})
+	require.True(t, KnownTamperingOf(in).IsTamperedHost())
+}
@@ -33,6 +33,9 @@ func init() {
 // ID represents the unique id of APCBSecurityTokens analyzer that checks BIOS
 const ID analysis.AnalyzerID = apcbsecanalysis.APCBSecurityTokensAnalyzerID
 
+// Version is the semantic version of the APCBSecurityTokens analyzer
+const Version analysis.AnalyzerVersion = "1.0.0"
+
 // Input is an input structure required for analyzer
 type Input struct {
 	Firmware analysis.ActualPSPFirmware
@@ -66,6 +69,11 @@ func (analyzer *Analyzer) ID() analysis.AnalyzerID {
 	return ID
 }
 
+// Version implements the Version method required for analysis.Analyzer
+func (analyzer *Analyzer) Version() analysis.AnalyzerVersion {
+	return Version
+}
+
 // Analyze makes the APCB tokens gathering and analysis
 func (analyzer *Analyzer) Analyze(ctx context.Context, in Input) (*analysis.Report, error) {
 	log := logger.FromCtx(ctx)
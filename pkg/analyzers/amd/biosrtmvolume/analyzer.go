@@ -36,6 +36,9 @@ const (
 // ID represents the unique id of BIOSRTMVolumeSignature analyzer that checks BIOS
 const ID analysis.AnalyzerID = biosrtmanalysis.BIOSRTMVolumeAnalyzerID
 
+// Version is the semantic version of the BIOSRTMVolume analyzer
+const Version analysis.AnalyzerVersion = "1.0.0"
+
 // Input is an input structure required for analyzer
 type Input struct {
 	Firmware analysis.ActualPSPFirmware
@@ -69,6 +72,11 @@ func (analyzer *Analyzer) ID() analysis.AnalyzerID {
 	return ID
 }
 
+// Version implements the Version method required for analysis.Analyzer
+func (analyzer *Analyzer) Version() analysis.AnalyzerVersion {
+	return Version
+}
+
 // Analyze makes the ACM gathering
 func (analyzer *Analyzer) Analyze(ctx context.Context, in Input) (*analysis.Report, error) {
 	log := logger.FromCtx(ctx)
@@ -34,6 +34,9 @@ func init() {
 // ID represents the unique id of PSPSignature analyzer that checks all PSP signatures for validity
 const ID analysis.AnalyzerID = pspsignanalysis.PSPSignatureAnalyzerID
 
+// Version is the semantic version of the PSPSignature analyzer
+const Version analysis.AnalyzerVersion = "1.0.0"
+
 // Input is an input structure required for analyzer
 type Input struct {
 	Firmware analysis.ActualPSPFirmware
@@ -67,6 +70,11 @@ func (analyzer *PSPSignature) ID() analysis.AnalyzerID {
 	return ID
 }
 
+// Version implements the Version method required for analysis.Analyzer
+func (analyzer *PSPSignature) Version() analysis.AnalyzerVersion {
+	return Version
+}
+
 // Analyze makes the ACM gathering
 func (analyzer *PSPSignature) Analyze(ctx context.Context, in Input) (*analysis.Report, error) {
 	log := logger.FromCtx(ctx)
@@ -19,6 +19,7 @@ import (
 	"github.com/immune-gmbh/AttestationFailureAnalysisService/pkg/analysis"
 	"github.com/immune-gmbh/AttestationFailureAnalysisService/pkg/analyzers/diffmeasuredboot/report/generated/diffanalysis"
 	"github.com/immune-gmbh/AttestationFailureAnalysisService/pkg/flowscompat"
+	"github.com/immune-gmbh/AttestationFailureAnalysisService/pkg/imgalign"
 	"github.com/immune-gmbh/AttestationFailureAnalysisService/pkg/measurements"
 	"github.com/immune-gmbh/AttestationFailureAnalysisService/pkg/types"
 
@@ -32,6 +33,7 @@ import (
 	"github.com/9elements/converged-security-suite/v2/pkg/tpmdetection"
 	"github.com/9elements/converged-security-suite/v2/pkg/tpmeventlog"
 	"github.com/facebookincubator/go-belt/tool/logger"
+	pkgbytes "github.com/linuxboot/fiano/pkg/bytes"
 )
 
 func init() {
@@ -41,9 +43,12 @@ func init() {
 // ID represents the unique id of DiffMeasuredBoot analyzer
 const ID analysis.AnalyzerID = diffanalysis.DiffMeasuredBootAnalyzerID
 
+// Version is the semantic version of the DiffMeasuredBoot analyzer
+const Version analysis.AnalyzerVersion = "1.0.0"
+
 // NewExecutorInput builds an analysis.Executor's input required for DiffMeasuredBoot analyzer
 //
-// Optional arguments: tpm, eventlog, actualPCR and enforcedMeasurementsFlow
+// Optional arguments: tpm, eventlog, actualPCR, enforcedMeasurementsFlow and actualFirmwareDumpInfo
 func NewExecutorInput(
 	originalFirmware analysis.Blob,
 	actualFirmware analysis.Blob,
@@ -52,6 +57,7 @@ func NewExecutorInput(
 	eventlog *tpmeventlog.TPMEventLog, // optional
 	actualPCR []byte, // optional
 	enforcedMeasurementsFlow *pcr.Flow, // optional
+	actualFirmwareDumpInfo *analysis.ActualFirmwareDumpInfo, // optional
 ) (analysis.Input, error) {
 	if originalFirmware == nil || actualFirmware == nil {
 		return nil, fmt.Errorf("firmware images should be specified")
@@ -81,6 +87,9 @@ func NewExecutorInput(
 	if enforcedMeasurementsFlow != nil {
 		result.ForceBootFlow(flowscompat.FromOld(*enforcedMeasurementsFlow))
 	}
+	if actualFirmwareDumpInfo != nil {
+		result.AddActualFirmwareDumpInfo(*actualFirmwareDumpInfo)
+	}
 	return result, nil
 }
 
@@ -93,7 +102,11 @@ type Input struct {
 	AlignedOrigFW    analysis.AlignedOriginalFirmware
 	StatusRegisters  analysis.FixedRegisters
 	BootFlow         types.BootFlow
-	HostAssetID      *analysis.AssetID `exec:"optional"`
+	HostAssetID      *analysis.AssetID                `exec:"optional"`
+	DumpInfo         *analysis.ActualFirmwareDumpInfo `exec:"optional"`
+	KnownTampering   *analysis.KnownTampering         `exec:"optional"`
+	ActualPCR0       analysis.ActualPCR0              `exec:"optional"`
+	EventLog         *tpmeventlog.TPMEventLog         `exec:"optional"`
 }
 
 // DiffMeasuredBoot represents the analyzer
@@ -110,6 +123,11 @@ func (analyzer *DiffMeasuredBoot) ID() analysis.AnalyzerID {
 	return "DiffMeasuredBoot"
 }
 
+// Version implements the Version method required for analysis.Analyzer
+func (analyzer *DiffMeasuredBoot) Version() analysis.AnalyzerVersion {
+	return Version
+}
+
 // TODO: create a dedicated package `typeconv` for conversions to Thrift and back
 func convDataChunk(chunk *diff.DataChunk) *diffanalysis.DataChunk {
 	if chunk == nil {
@@ -131,6 +149,44 @@ func convDataChunk(chunk *diff.DataChunk) *diffanalysis.DataChunk {
 	return convChunk
 }
 
+func convAlignmentSegment(segment imgalign.Segment) *diffanalysis.AlignmentSegment {
+	var kind diffanalysis.AlignmentSegmentKind
+	switch segment.Kind {
+	case imgalign.SegmentKindImage:
+		kind = diffanalysis.AlignmentSegmentKind_Image
+	case imgalign.SegmentKindRegion:
+		kind = diffanalysis.AlignmentSegmentKind_Region
+	case imgalign.SegmentKindVolume:
+		kind = diffanalysis.AlignmentSegmentKind_Volume
+	}
+	return &diffanalysis.AlignmentSegment{
+		Kind: kind,
+		Name: segment.Name,
+		Original: &diffanalysis.Range_{
+			Offset: int64(segment.Original.Offset),
+			Length: int64(segment.Original.Length),
+		},
+		Actual: &diffanalysis.Range_{
+			Offset: int64(segment.Actual.Offset),
+			Length: int64(segment.Actual.Length),
+		},
+	}
+}
+
+func intersectRanges(a, b pkgbytes.Range) (pkgbytes.Range, bool) {
+	start, end := a.Offset, a.End()
+	if b.Offset > start {
+		start = b.Offset
+	}
+	if b.End() < end {
+		end = b.End()
+	}
+	if start >= end {
+		return pkgbytes.Range{}, false
+	}
+	return pkgbytes.Range{Offset: start, Length: end - start}, true
+}
+
 // Analyze makes the difference analysis of firmwares
 func (analyzer *DiffMeasuredBoot) Analyze(
 	ctx context.Context,
@@ -173,28 +229,102 @@ func (analyzer *DiffMeasuredBoot) Analyze(
 
 	result := &analysis.Report{}
 
+	// The ranges which were not dumped cannot be compared, so they are excluded
+	// from the diff and reported explicitly.
+	measuredRanges := refs.Ranges()
+	var missingMeasuredRanges pkgbytes.Ranges
+	if issue := analysis.MissingRangesIssue(input.ActualFirmware, measuredRanges); issue != nil {
+		missingMeasuredRanges = issue.Custom.(pkgbytes.Ranges)
+		result.Issues = append(result.Issues, *issue)
+
+		var dumpedRanges pkgbytes.Ranges
+		for _, r := range measuredRanges {
+			dumpedRanges = append(dumpedRanges, r.Exclude(missingMeasuredRanges...)...)
+		}
+		measuredRanges = dumpedRanges
+	}
+
+	// The ranges which have no counterpart in the original image (the images
+	// have different layouts) cannot be compared either, but unlike the missing
+	// ranges they were dumped and measured, so anything might be there. Thus
+	// they are treated as suspicious.
+	var unmappedMeasuredRanges pkgbytes.Ranges
+	if input.AlignedOrigFW.Alignment != nil && len(input.AlignedOrigFW.Alignment.UnmappedRanges) > 0 {
+		unmapped := input.AlignedOrigFW.Alignment.UnmappedRanges
+		var mappedRanges pkgbytes.Ranges
+		for _, r := range measuredRanges {
+			mappedRanges = append(mappedRanges, r.Exclude(unmapped...)...)
+			for _, u := range unmapped {
+				if intersection, ok := intersectRanges(r, u); ok {
+					unmappedMeasuredRanges = append(unmappedMeasuredRanges, intersection)
+				}
+			}
+		}
+		measuredRanges = mappedRanges
+		if len(unmappedMeasuredRanges) > 0 {
+			result.Issues = append(result.Issues, analysis.Issue{
+				Custom:      unmappedMeasuredRanges,
+				Severity:    analysis.SeverityCritical,
+				Description: fmt.Sprintf("%d measured ranges of the actual firmware have no counterpart in the original firmware (different layouts), their content cannot be verified", len(unmappedMeasuredRanges)),
+			})
+		}
+	}
+
 	alignedOrigFW := input.AlignedOrigFW.UEFI()
-	diffEntries := diff.Diff(refs.Ranges(), alignedOrigFW.Buf(), input.ActualFirmware.Bytes(), nil)
+	diffEntries := diff.Diff(measuredRanges, alignedOrigFW.Buf(), input.ActualFirmware.Bytes(), nil)
 	diffEntries.SortAndMerge()
 
 	report := diff.Analyze(diffEntries, measurementsForDiffAnalysis(bootResult.Log), alignedOrigFW, input.ActualFirmware.Bytes())
-	diagnosis := Diagnose(
+	diagnosis, err := Diagnose(
 		logger.FromCtx(ctx),
 		report.Entries.DiffRanges(),
 		alignedOrigFW,
 		input.ActualFirmware,
 		input.ActualBIOSInfo,
 		input.OriginalBIOSInfo,
+		input.DumpInfo,
+		input.KnownTampering,
+		isOriginalMeasured(ctx, bootResult, input.ActualPCR0, input.EventLog),
 	)
+	if err != nil {
+		return nil, fmt.Errorf("unable to diagnose the difference: %w", err)
+	}
 
 	// == compiling the report ==
 
 	customReport := diffanalysis.CustomReport{
-		// TODO: consider deleting this line:
+		// TODO: delete this line, it is superseded by Alignment:
 		ImageOffset: int64(input.AlignedOrigFW.ImageOffset),
 	}
+	for _, r := range missingMeasuredRanges {
+		customReport.MissingMeasuredRanges = append(customReport.MissingMeasuredRanges, &diffanalysis.Range_{
+			Offset: int64(r.Offset),
+			Length: int64(r.Length),
+		})
+	}
+	for _, r := range unmappedMeasuredRanges {
+		customReport.UnmappedMeasuredRanges = append(customReport.UnmappedMeasuredRanges, &diffanalysis.Range_{
+			Offset: int64(r.Offset),
+			Length: int64(r.Length),
+		})
+	}
+	if input.AlignedOrigFW.Alignment != nil {
+		for _, segment := range input.AlignedOrigFW.Alignment.Mapping {
+			customReport.Alignment = append(customReport.Alignment, convAlignmentSegment(segment))
+		}
+	}
+	if input.DumpInfo != nil {
+		customReport.ActualFirmwareDumpMethod = &input.DumpInfo.Method
+		customReport.ActualFirmwareDumpQuality = &input.DumpInfo.Quality
+		if input.DumpInfo.Fallback {
+			result.Issues = append(result.Issues, analysis.Issue{
+				Severity:    analysis.SeverityWarning,
+				Description: fmt.Sprintf("the actual firmware was dumped using a fallback method '%s': %s", input.DumpInfo.Method, input.DumpInfo.FallbackError),
+			})
+		}
+	}
 	// TODO: move this conversion to Thrift to a dedicated package (and name it "typeconv").
-	for _, diffEntry := range report.Entries {
+	for idx, diffEntry := range report.Entries {
 		convEntry := &diffanalysis.DiffEntry{
 			Range: &diffanalysis.Range_{
 				Offset: int64(diffEntry.DiffRange.Offset),
@@ -202,6 +332,8 @@ func (analyzer *DiffMeasuredBoot) Analyze(
 			},
 			HammingDistance:          int64(diffEntry.HammingDistance),
 			HammingDistanceNon00orFF: int64(diffEntry.HammingDistanceNon00orFF),
+			InUnstableRange:          input.DumpInfo.IsUnstable(diffEntry.DiffRange),
+			Assessment:               diagnosis.Assessments[idx],
 		}
 		for _, m := range diffEntry.RelatedMeasurements {
 			convMeasurement := &diffanalysis.RelatedMeasurement{
@@ -227,9 +359,13 @@ func (analyzer *DiffMeasuredBoot) Analyze(
 		customReport.DiffEntries = append(customReport.DiffEntries, convEntry)
 	}
 
-	customReport.Diagnosis = diagnosis
+	customReport.Diagnosis = diagnosis.Diagnosis
+	customReport.DiagnosisConfidence = diagnosis.Confidence
 	result.Custom = customReport
-	switch diagnosis {
+	if top := diagnosis.MostSuspicious(); top != nil {
+		result.Comments = append(result.Comments, fmt.Sprintf("diagnosis confidence %.2f, the most suspicious difference (score %.2f): %s", diagnosis.Confidence, top.Score, top.Reason))
+	}
+	switch diagnosis.Diagnosis {
 	case diffanalysis.DiffDiagnosis_Match:
 	case diffanalysis.DiffDiagnosis_UnsuspiciousDamage:
 		result.Issues = append(result.Issues, analysis.Issue{
@@ -241,12 +377,17 @@ func (analyzer *DiffMeasuredBoot) Analyze(
 			Severity:    analysis.SeverityCritical,
 			Description: "Suspicious damage",
 		})
+	case diffanalysis.DiffDiagnosis_UnstableDump:
+		result.Issues = append(result.Issues, analysis.Issue{
+			Severity:    analysis.SeverityWarning,
+			Description: "Differences are only within the unstable ranges of the dump",
+		})
 	case diffanalysis.DiffDiagnosis_KnownTamperedHost:
-		result.Comments = append(result.Comments, "the firmware was tampered by fwcompromised")
+		result.Comments = append(result.Comments, fmt.Sprintf("the firmware differs, but the host is known to be tampered: %s", input.KnownTampering.TamperedHost))
 	default:
 		result.Issues = append(result.Issues, analysis.Issue{
 			Severity:    analysis.SeverityWarning,
-			Description: fmt.Sprintf("Result diagnosis: '%s'", diagnosis),
+			Description: fmt.Sprintf("Result diagnosis: '%s'", diagnosis.Diagnosis),
 		})
 	}
 	return result, nil
@@ -0,0 +1,397 @@
+// Copyright 2023 Meta Platforms, Inc. and affiliates.
+//
+// Redistribution and use in source and binary forms, with or without modification, are permitted provided that the following conditions are met:
+//
+// 1. Redistributions of source code must retain the above copyright notice, this list of conditions and the following disclaimer.
+//
+// 2. Redistributions in binary form must reproduce the above copyright notice, this list of conditions and the following disclaimer in the documentation and/or other materials provided with the distribution.
+//
+// 3. Neither the name of the copyright holder nor the names of its contributors may be used to endorse or promote products derived from this software without specific prior written permission.
+//
+// THIS SOFTWARE IS PROVIDED BY THE COPYRIGHT HOLDERS AND CONTRIBUTORS "AS IS" AND ANY EXPRESS OR IMPLIED WARRANTIES, INCLUDING, BUT NOT LIMITED TO, THE IMPLIED WARRANTIES OF MERCHANTABILITY AND FITNESS FOR A PARTICULAR PURPOSE ARE DISCLAIMED. IN NO EVENT SHALL THE COPYRIGHT HOLDER OR CONTRIBUTORS BE LIABLE FOR ANY DIRECT, INDIRECT, INCIDENTAL, SPECIAL, EXEMPLARY, OR CONSEQUENTIAL DAMAGES (INCLUDING, BUT NOT LIMITED TO, PROCUREMENT OF SUBSTITUTE GOODS OR SERVICES; LOSS OF USE, DATA, OR PROFITS; OR BUSINESS INTERRUPTION) HOWEVER CAUSED AND ON ANY THEORY OF LIABILITY, WHETHER IN CONTRACT, STRICT LIABILITY, OR TORT (INCLUDING NEGLIGENCE OR OTHERWISE) ARISING IN ANY WAY OUT OF THE USE OF THIS SOFTWARE, EVEN IF ADVISED OF THE POSSIBILITY OF SUCH DAMAGE.
+package diffmeasuredboot
+
+import (
+	"bytes"
+	"fmt"
+	"math"
+
+	"github.com/immune-gmbh/AttestationFailureAnalysisService/pkg/analyzers/diffmeasuredboot/report/generated/diffanalysis"
+	"github.com/immune-gmbh/AttestationFailureAnalysisService/pkg/uefi"
+
+	"github.com/9elements/converged-security-suite/v2/pkg/uefi/ffs"
+	"github.com/facebookincubator/go-belt/tool/logger"
+	pkgbytes "github.com/linuxboot/fiano/pkg/bytes"
+	"github.com/linuxboot/fiano/pkg/guid"
+	"github.com/linuxboot/fiano/pkg/intel/metadata/fit"
+	fianoUEFI "github.com/linuxboot/fiano/pkg/uefi"
+	"github.com/steakknife/hamming"
+)
+
+const (
+	// spiEraseBlockSize is the minimal erasable unit of SPI flash chips.
+	spiEraseBlockSize = 0x1000
+
+	// bitFlipMaxBits is the maximal amount of differing bits of an entry,
+	// which is still considered a bit flip.
+	bitFlipMaxBits = 2
+
+	// highEntropyMinLength is the minimal length of an entry to make
+	// the entropy meaningful.
+	highEntropyMinLength = 64
+
+	// highEntropyThreshold is the entropy (bits per byte) above which
+	// the data is considered compressed or encrypted.
+	highEntropyThreshold = 7.0
+
+	// suspiciousScoreThreshold is the minimal combined score of the entries
+	// (see combinedScore) for the whole difference to be considered a suspicious damage.
+	suspiciousScoreThreshold = 0.5
+
+	// paddingPayloadMinLength is the minimal length of arbitrary data written
+	// to padding, which may be a payload (even if it has a low entropy,
+	// like a plain code).
+	paddingPayloadMinLength = 64
+)
+
+// microcodeFileGUID is the GUID of the FFS file containing Intel microcode updates.
+var microcodeFileGUID = guid.MustParse("197DB236-F856-4924-90F8-CDF12FB875F3")
+
+// regionBaseScores is the suspiciousness of an arbitrary modification of
+// a region. NVRAM is modified at runtime by design, while code and
+// security metadata should never change.
+var regionBaseScores = map[diffanalysis.DiffRegion]float64{
+	diffanalysis.DiffRegion_NVRAM:     0.1,
+	diffanalysis.DiffRegion_Padding:   0.3,
+	diffanalysis.DiffRegion_Other:     0.6,
+	diffanalysis.DiffRegion_DXECode:   0.8,
+	diffanalysis.DiffRegion_PEICode:   0.9,
+	diffanalysis.DiffRegion_Microcode: 0.9,
+	diffanalysis.DiffRegion_ACM:       0.9,
+	diffanalysis.DiffRegion_Signature: 0.9,
+	diffanalysis.DiffRegion_FIT:       0.9,
+}
+
+// criticalRegions are the regions containing code, signatures or
+// the FIT. Any modification of them is suspicious, whatever the pattern is,
+// except the damages we see just happening in the fleet (see assessEntry).
+var criticalRegions = map[diffanalysis.DiffRegion]bool{
+	diffanalysis.DiffRegion_DXECode:   true,
+	diffanalysis.DiffRegion_PEICode:   true,
+	diffanalysis.DiffRegion_Microcode: true,
+	diffanalysis.DiffRegion_ACM:       true,
+	diffanalysis.DiffRegion_Signature: true,
+	diffanalysis.DiffRegion_FIT:       true,
+}
+
+// regionPriorities defines which region is reported if a difference
+// hits multiple regions: the more critical one wins.
+var regionPriorities = map[diffanalysis.DiffRegion]int{
+	diffanalysis.DiffRegion_Other:     1,
+	diffanalysis.DiffRegion_Padding:   2,
+	diffanalysis.DiffRegion_NVRAM:     3,
+	diffanalysis.DiffRegion_DXECode:   4,
+	diffanalysis.DiffRegion_PEICode:   5,
+	diffanalysis.DiffRegion_Microcode: 6,
+	diffanalysis.DiffRegion_ACM:       7,
+	diffanalysis.DiffRegion_Signature: 8,
+	diffanalysis.DiffRegion_FIT:       9,
+}
+
+type classifiedRange struct {
+	pkgbytes.Range
+	Region diffanalysis.DiffRegion
+}
+
+// regionMap maps byte ranges of a firmware image to the kinds of regions.
+type regionMap []classifiedRange
+
+// newRegionMap classifies the UEFI nodes and the FIT entries of the image.
+//
+// Failures to parse FIT are not fatal: such image just has no FIT-related regions.
+func newRegionMap(log logger.Logger, image *uefi.UEFI) regionMap {
+	var result regionMap
+
+	imageSize := uint64(len(image.Buf()))
+	nodes, err := image.GetByRange(pkgbytes.Range{Offset: 0, Length: imageSize})
+	if err != nil {
+		log.Debugf("unable to scan for UEFI nodes: %v", err)
+	}
+	for _, node := range nodes {
+		if node.Range.Length == 0 {
+			continue
+		}
+		region := nodeRegion(node)
+		if region == diffanalysis.DiffRegion_Undefined {
+			continue
+		}
+		result = append(result, classifiedRange{Range: node.Range, Region: region})
+	}
+
+	fitPointerStart, fitPointerEnd := fit.GetPointerCoordinates(imageSize)
+	if fitPointerStart < 0 {
+		return result
+	}
+	result = append(result, classifiedRange{
+		Range:  pkgbytes.Range{Offset: uint64(fitPointerStart), Length: uint64(fitPointerEnd - fitPointerStart)},
+		Region: diffanalysis.DiffRegion_FIT,
+	})
+	tableStart, tableEnd, err := fit.GetHeadersTableRangeFrom(bytes.NewReader(image.Buf()))
+	if err != nil {
+		log.Debugf("unable to locate FIT: %v", err)
+		return result
+	}
+	result = append(result, classifiedRange{
+		Range:  pkgbytes.Range{Offset: tableStart, Length: tableEnd - tableStart},
+		Region: diffanalysis.DiffRegion_FIT,
+	})
+	entries, err := image.GetFIT()
+	if err != nil {
+		log.Debugf("unable to parse FIT entries: %v", err)
+	}
+	for _, entry := range entries {
+		base := entry.GetEntryBase()
+		if len(base.DataSegmentBytes) == 0 {
+			continue
+		}
+		var region diffanalysis.DiffRegion
+		switch base.Headers.Type() {
+		case fit.EntryTypeMicrocodeUpdateEntry:
+			region = diffanalysis.DiffRegion_Microcode
+		case fit.EntryTypeStartupACModuleEntry:
+			region = diffanalysis.DiffRegion_ACM
+		case fit.EntryTypeKeyManifestRecord, fit.EntryTypeBootPolicyManifest:
+			region = diffanalysis.DiffRegion_Signature
+		default:
+			continue
+		}
+		result = append(result, classifiedRange{
+			Range: pkgbytes.Range{
+				Offset: base.Headers.Address.Offset(imageSize),
+				Length: uint64(len(base.DataSegmentBytes)),
+			},
+			Region: region,
+		})
+	}
+	return result
+}
+
+func nodeRegion(node *ffs.Node) diffanalysis.DiffRegion {
+	switch f := node.Firmware.(type) {
+	case *fianoUEFI.FirmwareVolume:
+		switch f.FileSystemGUID {
+		case *fianoUEFI.EVSA, *fianoUEFI.EVSA2, *fianoUEFI.NVAR:
+			return diffanalysis.DiffRegion_NVRAM
+		}
+	case *fianoUEFI.NVarStore:
+		return diffanalysis.DiffRegion_NVRAM
+	case *fianoUEFI.BIOSPadding:
+		return diffanalysis.DiffRegion_Padding
+	case *fianoUEFI.File:
+		switch f.Header.Type {
+		case fianoUEFI.FVFileTypeSECCore, fianoUEFI.FVFileTypePEICore, fianoUEFI.FVFileTypePEIM,
+			fianoUEFI.FVFileTypeCombinedPEIMDriver:
+			return diffanalysis.DiffRegion_PEICode
+		case fianoUEFI.FVFileTypeDXECore, fianoUEFI.FVFileTypeDriver, fianoUEFI.FVFileTypeApplication,
+			fianoUEFI.FVFileTypeSMM, fianoUEFI.FVFileTypeCombinedSMMDXE, fianoUEFI.FVFileTypeSMMCore,
+			fianoUEFI.FVFileTypeSMMStandalone, fianoUEFI.FVFileTypeSMMCoreStandalone:
+			return diffanalysis.DiffRegion_DXECode
+		case fianoUEFI.FVFileTypePad:
+			return diffanalysis.DiffRegion_Padding
+		}
+		switch f.Header.GUID {
+		case *fianoUEFI.NVAR:
+			return diffanalysis.DiffRegion_NVRAM
+		case *microcodeFileGUID:
+			return diffanalysis.DiffRegion_Microcode
+		}
+		return diffanalysis.DiffRegion_Other
+	}
+	return diffanalysis.DiffRegion_Undefined
+}
+
+// Region returns the most critical region hit by the range.
+//
+// Ranges not covered by any known region are considered Padding if
+// the original data is erased, and Other otherwise.
+func (m regionMap) Region(r pkgbytes.Range, origData []byte) diffanalysis.DiffRegion {
+	result := diffanalysis.DiffRegion_Undefined
+	for _, item := range m {
+		if !item.Intersect(r) {
+			continue
+		}
+		if regionPriorities[item.Region] > regionPriorities[result] {
+			result = item.Region
+		}
+	}
+	if result != diffanalysis.DiffRegion_Undefined {
+		return result
+	}
+	if isFilledWith(origData[r.Offset:r.End()], 0xff) {
+		return diffanalysis.DiffRegion_Padding
+	}
+	return diffanalysis.DiffRegion_Other
+}
+
+// assessEntry classifies a single difference and scores its suspiciousness.
+//
+// A few changed bits or a single byte turned to 0xFF are typical for a flash
+// degradation only if it is the only difference of the image (`isolated`).
+// Modifications of criticalRegions are never scored below suspiciousScoreThreshold,
+// except an isolated single flipped bit or a single byte turned to 0xFF:
+// the measured ranges are almost entirely code and signatures, so otherwise
+// every such damage we see just happening in the fleet would be suspicious.
+func assessEntry(
+	regions regionMap,
+	r pkgbytes.Range,
+	origData, actualData []byte,
+	isolated bool,
+) *diffanalysis.DiffEntryAssessment {
+	orig := origData[r.Offset:r.End()]
+	actual := actualData[r.Offset:r.End()]
+
+	result := &diffanalysis.DiffEntryAssessment{
+		Region:            regions.Region(r, origData),
+		EraseBlockAligned: isWithinErasedBlocks(r, actualData),
+		Entropy:           shannonEntropy(actual),
+	}
+
+	var bitsChanged int
+	for idx := range orig {
+		bitsChanged += hamming.Byte(orig[idx], actual[idx])
+	}
+	switch {
+	case bitsChanged <= bitFlipMaxBits:
+		result.Pattern = diffanalysis.DiffPattern_BitFlip
+	case isFilledWith(actual, 0xff):
+		result.Pattern = diffanalysis.DiffPattern_Erased
+	case isFilledWith(actual, 0x00):
+		result.Pattern = diffanalysis.DiffPattern_Zeroed
+	case len(actual) >= highEntropyMinLength && result.Entropy >= highEntropyThreshold:
+		result.Pattern = diffanalysis.DiffPattern_HighEntropy
+	default:
+		result.Pattern = diffanalysis.DiffPattern_Arbitrary
+	}
+
+	score := regionBaseScores[result.Region]
+	reason := fmt.Sprintf("modified %s", regionDescription(result.Region))
+	isFleetDamage := false
+	switch result.Pattern {
+	case diffanalysis.DiffPattern_BitFlip:
+		if !isolated {
+			reason = fmt.Sprintf("%d bit(s) flipped in %s, while the image has other differences", bitsChanged, regionDescription(result.Region))
+			break
+		}
+		score = math.Min(score, 0.15)
+		reason = fmt.Sprintf("%d bit(s) flipped in %s, typical for a flash degradation", bitsChanged, regionDescription(result.Region))
+		isFleetDamage = bitsChanged == 1
+	case diffanalysis.DiffPattern_Erased:
+		switch {
+		case result.EraseBlockAligned:
+			score = math.Min(score, 0.2)
+			reason = fmt.Sprintf("fully erased SPI block(s) in %s, typical for an interrupted flash update", regionDescription(result.Region))
+		case r.Length == 1 && isolated:
+			score = math.Min(score, 0.15)
+			reason = fmt.Sprintf("a single byte turned to 0xFF in %s", regionDescription(result.Region))
+			isFleetDamage = true
+		default:
+			score *= 0.75
+			reason = fmt.Sprintf("partially erased %s", regionDescription(result.Region))
+		}
+	case diffanalysis.DiffPattern_Zeroed:
+		reason = fmt.Sprintf("zeroed %s", regionDescription(result.Region))
+	case diffanalysis.DiffPattern_HighEntropy:
+		switch result.Region {
+		case diffanalysis.DiffRegion_Padding, diffanalysis.DiffRegion_Other:
+			score = math.Max(score, 0.8)
+			reason = fmt.Sprintf("high-entropy data written to %s, which may hide a payload", regionDescription(result.Region))
+		default:
+			reason = fmt.Sprintf("high-entropy data written to %s", regionDescription(result.Region))
+		}
+	case diffanalysis.DiffPattern_Arbitrary:
+		if result.Region == diffanalysis.DiffRegion_Padding && len(actual) >= paddingPayloadMinLength {
+			score = math.Max(score, 0.6)
+			reason = fmt.Sprintf("data written to %s, which may be a payload", regionDescription(result.Region))
+		}
+	}
+	if criticalRegions[result.Region] && !isFleetDamage && score < suspiciousScoreThreshold {
+		score = suspiciousScoreThreshold
+		reason += fmt.Sprintf("; but %s should never change", regionDescription(result.Region))
+	}
+	result.Score = score
+	result.Reason = reason
+	return result
+}
+
+// combinedScore combines the scores of the entries of a difference: the more
+// entries, the more suspicious the whole difference is, even if none of them
+// is suspicious on its own (for example, multiple NVRAM edits or writes to padding).
+func combinedScore(scores []float64) float64 {
+	unsuspiciousness := 1.0
+	for _, score := range scores {
+		unsuspiciousness *= 1 - score
+	}
+	return 1 - unsuspiciousness
+}
+
+func regionDescription(region diffanalysis.DiffRegion) string {
+	switch region {
+	case diffanalysis.DiffRegion_NVRAM:
+		return "the NVRAM variable store"
+	case diffanalysis.DiffRegion_Microcode:
+		return "a microcode update"
+	case diffanalysis.DiffRegion_Padding:
+		return "padding"
+	case diffanalysis.DiffRegion_PEICode:
+		return "PEI code"
+	case diffanalysis.DiffRegion_DXECode:
+		return "DXE code"
+	case diffanalysis.DiffRegion_FIT:
+		return "the FIT"
+	case diffanalysis.DiffRegion_Signature:
+		return "a signed manifest"
+	case diffanalysis.DiffRegion_ACM:
+		return "an ACM"
+	}
+	return "an unclassified region"
+}
+
+// isWithinErasedBlocks returns true if all the SPI erase blocks touched
+// by the range are fully erased in the data.
+func isWithinErasedBlocks(r pkgbytes.Range, data []byte) bool {
+	start := r.Offset / spiEraseBlockSize * spiEraseBlockSize
+	end := (r.End() + spiEraseBlockSize - 1) / spiEraseBlockSize * spiEraseBlockSize
+	if end > uint64(len(data)) {
+		return false
+	}
+	return isFilledWith(data[start:end], 0xff)
+}
+
+func isFilledWith(data []byte, value byte) bool {
+	for _, b := range data {
+		if b != value {
+			return false
+		}
+	}
+	return len(data) > 0
+}
+
+// shannonEntropy returns the entropy of the data in bits per byte.
+func shannonEntropy(data []byte) float64 {
+	if len(data) == 0 {
+		return 0
+	}
+	var counts [256]int
+	for _, b := range data {
+		counts[b]++
+	}
+	var result float64
+	for _, count := range counts {
+		if count == 0 {
+			continue
+		}
+		p := float64(count) / float64(len(data))
+		result -= p * math.Log2(p)
+	}
+	return result
+}
@@ -21,10 +21,59 @@ import (
 	"github.com/facebookincubator/go-belt/tool/logger"
 	"github.com/immune-gmbh/AttestationFailureAnalysisService/pkg/uefi"
 	pkgbytes "github.com/linuxboot/fiano/pkg/bytes"
-	"github.com/steakknife/hamming"
 )
 
+// Diagnosis is the result of Diagnose.
+type Diagnosis struct {
+	Diagnosis diffanalysis.DiffDiagnosis
+
+	// Confidence is how confident the diagnosis is, in range [0, 1].
+	Confidence float64
+
+	// Assessments has the same length as the diff ranges passed to Diagnose,
+	// the items are nil for the ranges not considered by the diagnosis
+	// (for example, unstable ranges of the dump if the original content was measured).
+	Assessments []*diffanalysis.DiffEntryAssessment
+}
+
+// MostSuspicious returns the assessment with the highest score, or nil
+// if there are no assessments.
+func (d *Diagnosis) MostSuspicious() *diffanalysis.DiffEntryAssessment {
+	var result *diffanalysis.DiffEntryAssessment
+	for _, assessment := range d.Assessments {
+		if assessment == nil {
+			continue
+		}
+		if result == nil || assessment.Score > result.Score {
+			result = assessment
+		}
+	}
+	return result
+}
+
+// unstableRangeConfidenceFactor is applied to the confidence of a diagnosis,
+// which is determined by a difference within an unstable range of the dump.
+const unstableRangeConfidenceFactor = 0.5
+
 // Diagnose provides the diagnosis how to interpret an image corruption.
+//
+// Differences which lie fully within the unstable ranges of the dump (see
+// analysis.ActualFirmwareDumpInfo) are dismissed only if the host is proven
+// to have measured the original content (`originalMeasured`, see
+// isOriginalMeasured): if there are no other differences the diagnosis is
+// UnstableDump. Otherwise the unstable ranges are reported by the client and
+// cannot hide a damage, so such differences are diagnosed as any other
+// difference, just the confidence is lower if they are decisive.
+//
+// Differences on a host, which is known to be intentionally tampered (see
+// analysis.KnownTampering), are diagnosed as KnownTamperedHost, while an image
+// known to be malicious is diagnosed as SuspiciousDamage without further heuristics.
+//
+// Otherwise, if the firmware versions differ, the diagnosis is
+// FirmwareVersionMismatch. Else each difference is classified by the region it
+// hits (see DiffRegion) and by its pattern (see DiffPattern) and gets
+// a suspiciousness score. The damage is suspicious if the combined score of
+// all the differences (see combinedScore) is at least suspiciousScoreThreshold.
 func Diagnose(
 	log logger.Logger,
 	diffRanges pkgbytes.Ranges,
@@ -32,42 +81,106 @@ func Diagnose(
 	modifiedImage analysis.ActualFirmwareBlob,
 	actualBIOSInfo *analysis.ActualBIOSInfo,
 	origBIOSInfo *analysis.OriginalBIOSInfo,
-) diffanalysis.DiffDiagnosis {
-	if len(origImage.Buf()) != len(modifiedImage.Bytes()) {
-		panic(fmt.Sprintf("images has different size: %d != %d", len(origImage.Buf()), len(modifiedImage.Bytes())))
+	dumpInfo *analysis.ActualFirmwareDumpInfo, // optional
+	knownTampering *analysis.KnownTampering, // optional
+	originalMeasured bool,
+) (*Diagnosis, error) {
+	origData, modifiedData := origImage.Buf(), modifiedImage.Bytes()
+	if len(origData) != len(modifiedData) {
+		return nil, fmt.Errorf("images have different sizes: %d != %d", len(origData), len(modifiedData))
+	}
+	for _, r := range diffRanges {
+		if r.End() > uint64(len(modifiedData)) {
+			return nil, fmt.Errorf("diff range 0x%X:0x%X is out of the image of size %d", r.Offset, r.End(), len(modifiedData))
+		}
 	}
 
-	modifiedBytes := diffRanges.Compile(modifiedImage.Bytes())
-	if len(modifiedBytes) == 0 {
-		return diffanalysis.DiffDiagnosis_Match
+	result := &Diagnosis{
+		Confidence:  1,
+		Assessments: make([]*diffanalysis.DiffEntryAssessment, len(diffRanges)),
 	}
 
-	origBytes := diffRanges.Compile(origImage.Buf())
+	if len(diffRanges.Compile(modifiedData)) == 0 {
+		result.Diagnosis = diffanalysis.DiffDiagnosis_Match
+		return result, nil
+	}
+
+	var considerIdxs []int
+	unstable := make([]bool, len(diffRanges))
+	for idx, r := range diffRanges {
+		unstable[idx] = dumpInfo.IsUnstable(r)
+		if unstable[idx] && originalMeasured {
+			log.Debugf("ignoring diff range %X:%X as it is within an unstable range of the dump and the original content was measured", r.Offset, r.End())
+			continue
+		}
+		considerIdxs = append(considerIdxs, idx)
+	}
+	if len(considerIdxs) == 0 {
+		result.Diagnosis = diffanalysis.DiffDiagnosis_UnstableDump
+		return result, nil
+	}
 
-	if len(modifiedBytes) == 1 {
-		// damages we see just happening in our fleet
+	if knownTampering.IsTamperedHost() {
+		log.Debugf("the host is known to be tampered: %s", knownTampering.TamperedHost)
+		result.Diagnosis = diffanalysis.DiffDiagnosis_KnownTamperedHost
+		return result, nil
+	}
+	if knownTampering.IsBadImage() {
+		log.Debugf("the image is known to be malicious: %s", knownTampering.BadImage)
+		result.Diagnosis = diffanalysis.DiffDiagnosis_SuspiciousDamage
+		return result, nil
+	}
 
-		if hamming.Byte(origBytes[0], modifiedBytes[0]) == 1 {
-			// a bitflip
-			return diffanalysis.DiffDiagnosis_UnsuspiciousDamage
+	if actualBIOSInfo != nil && origBIOSInfo != nil && origBIOSInfo.BIOSInfo != actualBIOSInfo.BIOSInfo {
+		result.Diagnosis = diffanalysis.DiffDiagnosis_FirmwareVersionMismatch
+		return result, nil
+	}
+
+	var nonEmptyCount int
+	for _, idx := range considerIdxs {
+		if diffRanges[idx].Length != 0 {
+			nonEmptyCount++
 		}
+	}
 
-		if modifiedBytes[0] == 0xff {
-			// a whole byte turned to 0xff
-			return diffanalysis.DiffDiagnosis_UnsuspiciousDamage
+	regions := newRegionMap(log, origImage)
+	var scores, stableScores []float64
+	for _, idx := range considerIdxs {
+		r := diffRanges[idx]
+		if r.Length == 0 {
+			continue
 		}
+		assessment := assessEntry(regions, r, origData, modifiedData, nonEmptyCount == 1)
+		log.Debugf("diff range %X:%X: score %.2f: %s", r.Offset, r.End(), assessment.Score, assessment.Reason)
+		result.Assessments[idx] = assessment
+		scores = append(scores, assessment.Score)
+		if !unstable[idx] {
+			stableScores = append(stableScores, assessment.Score)
+		}
+	}
+	score := combinedScore(scores)
+	// The diagnosis is less reliable if it is determined by differences
+	// which could also be a problem of the dump:
+	confidenceFactor := 1.0
+	if score > combinedScore(stableScores) {
+		confidenceFactor = unstableRangeConfidenceFactor
+	}
+
+	if score < suspiciousScoreThreshold {
+		result.Diagnosis = diffanalysis.DiffDiagnosis_UnsuspiciousDamage
+		result.Confidence = (1 - score) * confidenceFactor
+		return result, nil
 	}
 
 	switch {
 	case actualBIOSInfo == nil:
 		log.Debugf("no actual BIOS info, assuming BIOS version match")
 	case origBIOSInfo == nil:
-		return diffanalysis.DiffDiagnosis_InvalidOriginalFirmware
-	default:
-		if origBIOSInfo.BIOSInfo != actualBIOSInfo.BIOSInfo {
-			return diffanalysis.DiffDiagnosis_FirmwareVersionMismatch
-		}
+		result.Diagnosis = diffanalysis.DiffDiagnosis_InvalidOriginalFirmware
+		return result, nil
 	}
 
-	return diffanalysis.DiffDiagnosis_SuspiciousDamage
+	result.Diagnosis = diffanalysis.DiffDiagnosis_SuspiciousDamage
+	result.Confidence = score * confidenceFactor
+	return result, nil
 }
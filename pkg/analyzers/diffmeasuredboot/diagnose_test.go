@@ -0,0 +1,352 @@
+// Copyright 2023 Meta Platforms, Inc. and affiliates.
+//
+// Redistribution and use in source and binary forms, with or without modification, are permitted provided that the following conditions are met:
+//
+// 1. Redistributions of source code must retain the above copyright notice, this list of conditions and the following disclaimer.
+//
+// 2. Redistributions in binary form must reproduce the above copyright notice, this list of conditions and the following disclaimer in the documentation and/or other materials provided with the distribution.
+//
+// 3. Neither the name of the copyright holder nor the names of its contributors may be used to endorse or promote products derived from this software without specific prior written permission.
+//
+// THIS SOFTWARE IS PROVIDED BY THE COPYRIGHT HOLDERS AND CONTRIBUTORS "AS IS" AND ANY EXPRESS OR IMPLIED WARRANTIES, INCLUDING, BUT NOT LIMITED TO, THE IMPLIED WARRANTIES OF MERCHANTABILITY AND FITNESS FOR A PARTICULAR PURPOSE ARE DISCLAIMED. IN NO EVENT SHALL THE COPYRIGHT HOLDER OR CONTRIBUTORS BE LIABLE FOR ANY DIRECT, INDIRECT, INCIDENTAL, SPECIAL, EXEMPLARY, OR CONSEQUENTIAL DAMAGES (INCLUDING, BUT NOT LIMITED TO, PROCUREMENT OF SUBSTITUTE GOODS OR SERVICES; LOSS OF USE, DATA, OR PROFITS; OR BUSINESS INTERRUPTION) HOWEVER CAUSED AND ON ANY THEORY OF LIABILITY, WHETHER IN CONTRACT, STRICT LIABILITY, OR TORT (INCLUDING NEGLIGENCE OR OTHERWISE) ARISING IN ANY WAY OUT OF THE USE OF THIS SOFTWARE, EVEN IF ADVISED OF THE POSSIBILITY OF SUCH DAMAGE.
+package diffmeasuredboot
+
+import (
+	"bytes"
+	"crypto/sha512"
+	"testing"
+
+	"github.com/immune-gmbh/AttestationFailureAnalysisService/pkg/analysis"
+	"github.com/immune-gmbh/AttestationFailureAnalysisService/pkg/analyzers/diffmeasuredboot/report/generated/diffanalysis"
+	"github.com/immune-gmbh/AttestationFailureAnalysisService/pkg/uefi"
+
+	"github.com/9elements/converged-security-suite/v2/pkg/uefi/ffs"
+	"github.com/facebookincubator/go-belt/tool/logger"
+	pkgbytes "github.com/linuxboot/fiano/pkg/bytes"
+	fianoUEFI "github.com/linuxboot/fiano/pkg/uefi"
+	"github.com/stretchr/testify/require"
+)
+
+const testImageSize = 0x10000
+
+func testImage() []byte {
+	image := make([]byte, testImageSize)
+	for idx := range image {
+		image[idx] = byte(idx*7 + 3)
+	}
+	// unused flash space
+	copy(image[0x8000:0x9000], bytes.Repeat([]byte{0xff}, 0x1000))
+	return image
+}
+
+func testRegions() regionMap {
+	return regionMap{
+		{Range: pkgbytes.Range{Offset: 0x0000, Length: 0x2000}, Region: diffanalysis.DiffRegion_NVRAM},
+		{Range: pkgbytes.Range{Offset: 0x2000, Length: 0x2000}, Region: diffanalysis.DiffRegion_PEICode},
+		{Range: pkgbytes.Range{Offset: 0x4000, Length: 0x2000}, Region: diffanalysis.DiffRegion_DXECode},
+		{Range: pkgbytes.Range{Offset: 0x5000, Length: 0x0100}, Region: diffanalysis.DiffRegion_Signature},
+	}
+}
+
+func TestAssessEntry(t *testing.T) {
+	orig := testImage()
+
+	type testCase struct {
+		name            string
+		r               pkgbytes.Range
+		modify          func(actual []byte)
+		expectedRegion  diffanalysis.DiffRegion
+		expectedPattern diffanalysis.DiffPattern
+		suspicious      bool
+	}
+	for _, tc := range []testCase{
+		{
+			name: "bitflip_in_code",
+			r:    pkgbytes.Range{Offset: 0x2100, Length: 1},
+			modify: func(actual []byte) {
+				actual[0x2100] ^= 0x10
+			},
+			expectedRegion:  diffanalysis.DiffRegion_PEICode,
+			expectedPattern: diffanalysis.DiffPattern_BitFlip,
+		},
+		{
+			name: "two_bitflips_in_code",
+			r:    pkgbytes.Range{Offset: 0x2100, Length: 1},
+			modify: func(actual []byte) {
+				actual[0x2100] ^= 0x11
+			},
+			expectedRegion:  diffanalysis.DiffRegion_PEICode,
+			expectedPattern: diffanalysis.DiffPattern_BitFlip,
+			suspicious:      true,
+		},
+		{
+			name: "bitflip_in_nvram",
+			r:    pkgbytes.Range{Offset: 0x100, Length: 1},
+			modify: func(actual []byte) {
+				actual[0x100] ^= 0x10
+			},
+			expectedRegion:  diffanalysis.DiffRegion_NVRAM,
+			expectedPattern: diffanalysis.DiffPattern_BitFlip,
+		},
+		{
+			name: "single_byte_ff_in_code",
+			r:    pkgbytes.Range{Offset: 0x4100, Length: 1},
+			modify: func(actual []byte) {
+				actual[0x4100] = 0xff
+			},
+			expectedRegion:  diffanalysis.DiffRegion_DXECode,
+			expectedPattern: diffanalysis.DiffPattern_Erased,
+		},
+		{
+			name: "arbitrary_nvram",
+			r:    pkgbytes.Range{Offset: 0x100, Length: 0x10},
+			modify: func(actual []byte) {
+				copy(actual[0x100:], []byte("some variable!!!"))
+			},
+			expectedRegion:  diffanalysis.DiffRegion_NVRAM,
+			expectedPattern: diffanalysis.DiffPattern_Arbitrary,
+		},
+		{
+			name: "erased_block_in_code",
+			r:    pkgbytes.Range{Offset: 0x3000, Length: 0x1000},
+			modify: func(actual []byte) {
+				copy(actual[0x3000:0x4000], bytes.Repeat([]byte{0xff}, 0x1000))
+			},
+			expectedRegion:  diffanalysis.DiffRegion_PEICode,
+			expectedPattern: diffanalysis.DiffPattern_Erased,
+			suspicious:      true,
+		},
+		{
+			name: "code_in_unused_space",
+			r:    pkgbytes.Range{Offset: 0x8000, Length: 0x40},
+			modify: func(actual []byte) {
+				copy(actual[0x8000:0x8040], bytes.Repeat([]byte("\x90\x90\xeb\xfe"), 0x10))
+			},
+			expectedRegion:  diffanalysis.DiffRegion_Padding,
+			expectedPattern: diffanalysis.DiffPattern_Arbitrary,
+			suspicious:      true,
+		},
+		{
+			name: "arbitrary_code",
+			r:    pkgbytes.Range{Offset: 0x4200, Length: 0x10},
+			modify: func(actual []byte) {
+				copy(actual[0x4200:], []byte("\x90\x90\x90\x90\xcc\xcc\xcc\xcc\xeb\xfe\x00\x11\x22\x33\x44\x55"))
+			},
+			expectedRegion:  diffanalysis.DiffRegion_DXECode,
+			expectedPattern: diffanalysis.DiffPattern_Arbitrary,
+			suspicious:      true,
+		},
+		{
+			name: "zeroed_signature",
+			r:    pkgbytes.Range{Offset: 0x5000, Length: 0x20},
+			modify: func(actual []byte) {
+				copy(actual[0x5000:0x5020], make([]byte, 0x20))
+			},
+			expectedRegion:  diffanalysis.DiffRegion_Signature,
+			expectedPattern: diffanalysis.DiffPattern_Zeroed,
+			suspicious:      true,
+		},
+		{
+			name: "payload_in_unused_space",
+			r:    pkgbytes.Range{Offset: 0x8000, Length: 0x200},
+			modify: func(actual []byte) {
+				for offset := 0x8000; offset < 0x8200; offset += sha512.Size {
+					sum := sha512.Sum512([]byte{byte(offset >> 8), byte(offset)})
+					copy(actual[offset:], sum[:])
+				}
+			},
+			expectedRegion:  diffanalysis.DiffRegion_Padding,
+			expectedPattern: diffanalysis.DiffPattern_HighEntropy,
+			suspicious:      true,
+		},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			actual := append([]byte{}, orig...)
+			tc.modify(actual)
+
+			assessment := assessEntry(testRegions(), tc.r, orig, actual, true)
+			require.Equal(t, tc.expectedRegion, assessment.Region)
+			require.Equal(t, tc.expectedPattern, assessment.Pattern)
+			require.Equal(t, tc.suspicious, assessment.Score >= suspiciousScoreThreshold, assessment.Reason)
+			require.NotEmpty(t, assessment.Reason)
+		})
+	}
+}
+
+func TestAssessEntryNotIsolated(t *testing.T) {
+	orig := testImage()
+	actual := append([]byte{}, orig...)
+	actual[0x8100] ^= 0x01
+	r := pkgbytes.Range{Offset: 0x8100, Length: 1}
+
+	isolated := assessEntry(testRegions(), r, orig, actual, true)
+	notIsolated := assessEntry(testRegions(), r, orig, actual, false)
+	require.Equal(t, diffanalysis.DiffPattern_BitFlip, notIsolated.Pattern)
+	require.Less(t, isolated.Score, notIsolated.Score)
+	require.Equal(t, regionBaseScores[diffanalysis.DiffRegion_Padding], notIsolated.Score)
+
+	// a single flipped bit in code is unsuspicious only if it is the only difference
+	actual = append([]byte{}, orig...)
+	actual[0x2100] ^= 0x01
+	r = pkgbytes.Range{Offset: 0x2100, Length: 1}
+	require.Less(t, assessEntry(testRegions(), r, orig, actual, true).Score, suspiciousScoreThreshold)
+	require.GreaterOrEqual(t, assessEntry(testRegions(), r, orig, actual, false).Score, suspiciousScoreThreshold)
+}
+
+func TestCombinedScore(t *testing.T) {
+	require.Zero(t, combinedScore(nil))
+	require.InDelta(t, 0.3, combinedScore([]float64{0.3}), 1e-9)
+
+	// a few NVRAM edits are fine, but many are suspicious
+	nvramScore := regionBaseScores[diffanalysis.DiffRegion_NVRAM]
+	require.Less(t, combinedScore([]float64{nvramScore, nvramScore}), suspiciousScoreThreshold)
+	var nvramScores []float64
+	for i := 0; i < 7; i++ {
+		nvramScores = append(nvramScores, nvramScore)
+	}
+	require.GreaterOrEqual(t, combinedScore(nvramScores), suspiciousScoreThreshold)
+
+	// the same for writes to padding
+	paddingScore := regionBaseScores[diffanalysis.DiffRegion_Padding]
+	require.GreaterOrEqual(t, combinedScore([]float64{paddingScore, paddingScore}), suspiciousScoreThreshold)
+}
+
+func TestIsWithinErasedBlocks(t *testing.T) {
+	data := testImage()
+	require.True(t, isWithinErasedBlocks(pkgbytes.Range{Offset: 0x8100, Length: 0x10}, data))
+	require.False(t, isWithinErasedBlocks(pkgbytes.Range{Offset: 0x8f00, Length: 0x200}, data))
+	require.False(t, isWithinErasedBlocks(pkgbytes.Range{Offset: 0x100, Length: 0x10}, data))
+}
+
+func TestShannonEntropy(t *testing.T) {
+	require.Zero(t, shannonEntropy(nil))
+	require.Zero(t, shannonEntropy(bytes.Repeat([]byte{0xff}, 100)))
+	require.Equal(t, 1.0, shannonEntropy([]byte{0, 1, 0, 1}))
+
+	all := make([]byte, 256)
+	for idx := range all {
+		all[idx] = byte(idx)
+	}
+	require.Equal(t, 8.0, shannonEntropy(all))
+}
+
+func testUEFI(t *testing.T, image []byte) *uefi.UEFI {
+	padding, err := fianoUEFI.NewBIOSPadding(image, 0)
+	require.NoError(t, err)
+	return &uefi.UEFI{Node: ffs.Node{Firmware: padding}}
+}
+
+func TestDiagnoseSizeMismatch(t *testing.T) {
+	orig := testImage()
+	actual := analysis.NewActualFirmwareBlob(analysis.BytesBlob(orig[:len(orig)-1]))
+
+	_, err := Diagnose(logger.Default(), nil, testUEFI(t, orig), actual, nil, nil, nil, nil, false)
+	require.Error(t, err)
+}
+
+func TestDiagnose(t *testing.T) {
+	orig := testImage()
+
+	t.Run("match", func(t *testing.T) {
+		actual := analysis.NewActualFirmwareBlob(analysis.BytesBlob(append([]byte{}, orig...)))
+		diagnosis, err := Diagnose(logger.Default(), nil, testUEFI(t, orig), actual, nil, nil, nil, nil, false)
+		require.NoError(t, err)
+		require.Equal(t, diffanalysis.DiffDiagnosis_Match, diagnosis.Diagnosis)
+	})
+
+	t.Run("bitflip", func(t *testing.T) {
+		modified := append([]byte{}, orig...)
+		modified[0x100] ^= 0x01
+		actual := analysis.NewActualFirmwareBlob(analysis.BytesBlob(modified))
+		diffRanges := pkgbytes.Ranges{{Offset: 0x100, Length: 1}}
+
+		diagnosis, err := Diagnose(logger.Default(), diffRanges, testUEFI(t, orig), actual, nil, nil, nil, nil, false)
+		require.NoError(t, err)
+		require.Equal(t, diffanalysis.DiffDiagnosis_UnsuspiciousDamage, diagnosis.Diagnosis)
+		require.Len(t, diagnosis.Assessments, 1)
+		require.Equal(t, diffanalysis.DiffPattern_BitFlip, diagnosis.Assessments[0].Pattern)
+		require.Greater(t, diagnosis.Confidence, 0.5)
+	})
+
+	t.Run("multiple_bitflips", func(t *testing.T) {
+		modified := append([]byte{}, orig...)
+		var diffRanges pkgbytes.Ranges
+		// in the unused (erased) space, each of them is not suspicious on its own:
+		for _, offset := range []uint64{0x8100, 0x8300, 0x8500} {
+			modified[offset] ^= 0x01
+			diffRanges = append(diffRanges, pkgbytes.Range{Offset: offset, Length: 1})
+		}
+		actual := analysis.NewActualFirmwareBlob(analysis.BytesBlob(modified))
+
+		diagnosis, err := Diagnose(logger.Default(), diffRanges, testUEFI(t, orig), actual, nil, nil, nil, nil, false)
+		require.NoError(t, err)
+		require.Equal(t, diffanalysis.DiffDiagnosis_SuspiciousDamage, diagnosis.Diagnosis)
+		require.Len(t, diagnosis.Assessments, 3)
+		for _, assessment := range diagnosis.Assessments {
+			require.Equal(t, diffanalysis.DiffPattern_BitFlip, assessment.Pattern)
+			require.Less(t, assessment.Score, suspiciousScoreThreshold)
+		}
+	})
+
+	t.Run("multiple_patches", func(t *testing.T) {
+		modified := append([]byte{}, orig...)
+		copy(modified[0x8000:], []byte("patch#1"))
+		copy(modified[0x8800:], []byte("patch#2"))
+		actual := analysis.NewActualFirmwareBlob(analysis.BytesBlob(modified))
+		diffRanges := pkgbytes.Ranges{{Offset: 0x8000, Length: 7}, {Offset: 0x8800, Length: 7}}
+
+		diagnosis, err := Diagnose(logger.Default(), diffRanges, testUEFI(t, orig), actual, nil, nil, nil, nil, false)
+		require.NoError(t, err)
+		require.Equal(t, diffanalysis.DiffDiagnosis_SuspiciousDamage, diagnosis.Diagnosis)
+	})
+
+	t.Run("version_mismatch", func(t *testing.T) {
+		modified := append([]byte{}, orig...)
+		modified[0x100] ^= 0x01
+		actual := analysis.NewActualFirmwareBlob(analysis.BytesBlob(modified))
+		diffRanges := pkgbytes.Ranges{{Offset: 0x100, Length: 1}}
+		actualBIOSInfo := &analysis.ActualBIOSInfo{}
+		actualBIOSInfo.Version = "1.1"
+		origBIOSInfo := &analysis.OriginalBIOSInfo{}
+		origBIOSInfo.Version = "1.0"
+
+		// the check is not skipped even if the difference is not suspicious
+		diagnosis, err := Diagnose(logger.Default(), diffRanges, testUEFI(t, orig), actual, actualBIOSInfo, origBIOSInfo, nil, nil, false)
+		require.NoError(t, err)
+		require.Equal(t, diffanalysis.DiffDiagnosis_FirmwareVersionMismatch, diagnosis.Diagnosis)
+	})
+
+	t.Run("unstable_range", func(t *testing.T) {
+		modified := append([]byte{}, orig...)
+		for offset := 0x8000; offset < 0x8200; offset += sha512.Size {
+			sum := sha512.Sum512([]byte{byte(offset >> 8), byte(offset)})
+			copy(modified[offset:], sum[:])
+		}
+		actual := analysis.NewActualFirmwareBlob(analysis.BytesBlob(modified))
+		diffRanges := pkgbytes.Ranges{{Offset: 0x8000, Length: 0x200}}
+		dumpInfo := &analysis.ActualFirmwareDumpInfo{
+			UnstableRanges: pkgbytes.Ranges{{Offset: 0x7000, Length: 0x2000}},
+		}
+
+		// the unstable ranges are reported by the client, they cannot hide a damage:
+		diagnosis, err := Diagnose(logger.Default(), diffRanges, testUEFI(t, orig), actual, nil, nil, dumpInfo, nil, false)
+		require.NoError(t, err)
+		require.Equal(t, diffanalysis.DiffDiagnosis_SuspiciousDamage, diagnosis.Diagnosis)
+		require.NotNil(t, diagnosis.Assessments[0])
+		require.Equal(t, diagnosis.Assessments[0].Score*unstableRangeConfidenceFactor, diagnosis.Confidence)
+
+		// but they are dismissed if the original content was measured:
+		diagnosis, err = Diagnose(logger.Default(), diffRanges, testUEFI(t, orig), actual, nil, nil, dumpInfo, nil, true)
+		require.NoError(t, err)
+		require.Equal(t, diffanalysis.DiffDiagnosis_UnstableDump, diagnosis.Diagnosis)
+		require.Nil(t, diagnosis.Assessments[0])
+	})
+
+	t.Run("out_of_range", func(t *testing.T) {
+		actual := analysis.NewActualFirmwareBlob(analysis.BytesBlob(append([]byte{}, orig...)))
+		diffRanges := pkgbytes.Ranges{{Offset: testImageSize - 1, Length: 2}}
+
+		_, err := Diagnose(logger.Default(), diffRanges, testUEFI(t, orig), actual, nil, nil, nil, nil, false)
+		require.Error(t, err)
+	})
+}
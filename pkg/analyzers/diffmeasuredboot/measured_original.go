@@ -0,0 +1,73 @@
+// Copyright 2023 Meta Platforms, Inc. and affiliates.
+//
+// Redistribution and use in source and binary forms, with or without modification, are permitted provided that the following conditions are met:
+//
+// 1. Redistributions of source code must retain the above copyright notice, this list of conditions and the following disclaimer.
+//
+// 2. Redistributions in binary form must reproduce the above copyright notice, this list of conditions and the following disclaimer in the documentation and/or other materials provided with the distribution.
+//
+// 3. Neither the name of the copyright holder nor the names of its contributors may be used to endorse or promote products derived from this software without specific prior written permission.
+//
+// THIS SOFTWARE IS PROVIDED BY THE COPYRIGHT HOLDERS AND CONTRIBUTORS "AS IS" AND ANY EXPRESS OR IMPLIED WARRANTIES, INCLUDING, BUT NOT LIMITED TO, THE IMPLIED WARRANTIES OF MERCHANTABILITY AND FITNESS FOR A PARTICULAR PURPOSE ARE DISCLAIMED. IN NO EVENT SHALL THE COPYRIGHT HOLDER OR CONTRIBUTORS BE LIABLE FOR ANY DIRECT, INDIRECT, INCIDENTAL, SPECIAL, EXEMPLARY, OR CONSEQUENTIAL DAMAGES (INCLUDING, BUT NOT LIMITED TO, PROCUREMENT OF SUBSTITUTE GOODS OR SERVICES; LOSS OF USE, DATA, OR PROFITS; OR BUSINESS INTERRUPTION) HOWEVER CAUSED AND ON ANY THEORY OF LIABILITY, WHETHER IN CONTRACT, STRICT LIABILITY, OR TORT (INCLUDING NEGLIGENCE OR OTHERWISE) ARISING IN ANY WAY OUT OF THE USE OF THIS SOFTWARE, EVEN IF ADVISED OF THE POSSIBILITY OF SUCH DAMAGE.
+package diffmeasuredboot
+
+import (
+	"bytes"
+	"context"
+
+	"github.com/9elements/converged-security-suite/v2/pkg/bootflow/bootengine"
+	"github.com/9elements/converged-security-suite/v2/pkg/bootflow/subsystems/trustchains/tpm"
+	"github.com/9elements/converged-security-suite/v2/pkg/tpmeventlog"
+	"github.com/facebookincubator/go-belt/tool/logger"
+)
+
+// isOriginalMeasured returns true if the host is proven to have measured
+// the content of the original firmware: the actual PCR0 value (or, if it
+// is not available, the PCR0 value replayed from the TPM event log) equals
+// to the PCR0 value of the boot process simulated on the original firmware.
+//
+// If so, the differences within the unstable ranges of the dump are
+// the problems of the dump, not of the firmware.
+func isOriginalMeasured(
+	ctx context.Context,
+	bootResult *bootengine.BootProcess,
+	actualPCR0 []byte, // optional
+	eventLog *tpmeventlog.TPMEventLog, // optional
+) bool {
+	log := logger.FromCtx(ctx)
+	if len(actualPCR0) == 0 && eventLog == nil {
+		return false
+	}
+
+	tpmInstance, err := tpm.GetFrom(bootResult.CurrentState)
+	if err != nil {
+		log.Errorf("unable to obtain the simulated TPM: %v", err)
+		return false
+	}
+
+	for _, hashAlgo := range tpmInstance.SupportedAlgos {
+		expectedPCR0, err := tpmInstance.PCRValues.Get(0, hashAlgo)
+		if err != nil {
+			continue
+		}
+
+		// The TPM has the precedence over the event log: the event log might
+		// be amended, while the PCR value cannot be.
+		if len(actualPCR0) > 0 {
+			if bytes.Equal(expectedPCR0, actualPCR0) {
+				return true
+			}
+			continue
+		}
+
+		replayedPCR0, err := tpmeventlog.Replay(eventLog, 0, hashAlgo, nil)
+		if err != nil {
+			log.Debugf("unable to replay PCR0 for hash algo %s: %v", hashAlgo, err)
+			continue
+		}
+		if bytes.Equal(expectedPCR0, replayedPCR0) {
+			return true
+		}
+	}
+	return false
+}
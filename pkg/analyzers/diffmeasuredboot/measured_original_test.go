@@ -0,0 +1,69 @@
+// Copyright 2023 Meta Platforms, Inc. and affiliates.
+//
+// Redistribution and use in source and binary forms, with or without modification, are permitted provided that the following conditions are met:
+//
+// 1. Redistributions of source code must retain the above copyright notice, this list of conditions and the following disclaimer.
+//
+// 2. Redistributions in binary form must reproduce the above copyright notice, this list of conditions and the following disclaimer in the documentation and/or other materials provided with the distribution.
+//
+// 3. Neither the name of the copyright holder nor the names of its contributors may be used to endorse or promote products derived from this software without specific prior written permission.
+//
+// THIS SOFTWARE IS PROVIDED BY THE COPYRIGHT HOLDERS AND CONTRIBUTORS "AS IS" AND ANY EXPRESS OR IMPLIED WARRANTIES, INCLUDING, BUT NOT LIMITED TO, THE IMPLIED WARRANTIES OF MERCHANTABILITY AND FITNESS FOR A PARTICULAR PURPOSE ARE DISCLAIMED. IN NO EVENT SHALL THE COPYRIGHT HOLDER OR CONTRIBUTORS BE LIABLE FOR ANY DIRECT, INDIRECT, INCIDENTAL, SPECIAL, EXEMPLARY, OR CONSEQUENTIAL DAMAGES (INCLUDING, BUT NOT LIMITED TO, PROCUREMENT OF SUBSTITUTE GOODS OR SERVICES; LOSS OF USE, DATA, OR PROFITS; OR BUSINESS INTERRUPTION) HOWEVER CAUSED AND ON ANY THEORY OF LIABILITY, WHETHER IN CONTRACT, STRICT LIABILITY, OR TORT (INCLUDING NEGLIGENCE OR OTHERWISE) ARISING IN ANY WAY OUT OF THE USE OF THIS SOFTWARE, EVEN IF ADVISED OF THE POSSIBILITY OF SUCH DAMAGE.
+package diffmeasuredboot
+
+import (
+	"context"
+	"crypto/sha256"
+	"testing"
+
+	"github.com/9elements/converged-security-suite/v2/pkg/bootflow/actors"
+	"github.com/9elements/converged-security-suite/v2/pkg/bootflow/datasources"
+	"github.com/9elements/converged-security-suite/v2/pkg/bootflow/steps/commonsteps"
+	"github.com/9elements/converged-security-suite/v2/pkg/bootflow/steps/tpmsteps"
+	"github.com/9elements/converged-security-suite/v2/pkg/bootflow/subsystems/trustchains/tpm"
+	"github.com/9elements/converged-security-suite/v2/pkg/bootflow/systemartifacts/biosimage"
+	bootflowtypes "github.com/9elements/converged-security-suite/v2/pkg/bootflow/types"
+	"github.com/9elements/converged-security-suite/v2/pkg/tpmeventlog"
+	"github.com/9elements/converged-security-suite/v2/testdata/firmware"
+	"github.com/google/go-tpm/tpm2"
+	"github.com/stretchr/testify/require"
+
+	"github.com/immune-gmbh/AttestationFailureAnalysisService/pkg/measurements"
+)
+
+func TestIsOriginalMeasured(t *testing.T) {
+	ctx := context.Background()
+	separator := []byte{0, 0, 0, 0}
+	bootResult := measurements.SimulateBootProcess(ctx, biosimage.New(firmware.FakeIntelFirmware), nil, bootflowtypes.NewFlow("unit-test-flow", bootflowtypes.Steps{
+		commonsteps.SetActor(actors.PEI{}),
+		tpmsteps.InitTPM(0, false),
+		tpmsteps.Measure(0, tpmeventlog.EV_SEPARATOR, datasources.Bytes(separator)),
+	}))
+	require.NoError(t, bootResult.Log.Error())
+	tpmInstance, err := tpm.GetFrom(bootResult.CurrentState)
+	require.NoError(t, err)
+	expectedPCR0, err := tpmInstance.PCRValues.Get(0, tpm2.AlgSHA256)
+	require.NoError(t, err)
+
+	separatorDigest := sha256.Sum256(separator)
+	eventLog := &tpmeventlog.TPMEventLog{Events: []*tpmeventlog.Event{{
+		PCRIndex: 0,
+		Type:     tpmeventlog.EV_SEPARATOR,
+		Digest:   &tpmeventlog.Digest{HashAlgo: tpm2.AlgSHA256, Digest: separatorDigest[:]},
+	}}}
+	otherDigest := sha256.Sum256([]byte("other"))
+	otherEventLog := &tpmeventlog.TPMEventLog{Events: []*tpmeventlog.Event{{
+		PCRIndex: 0,
+		Type:     tpmeventlog.EV_SEPARATOR,
+		Digest:   &tpmeventlog.Digest{HashAlgo: tpm2.AlgSHA256, Digest: otherDigest[:]},
+	}}}
+
+	require.False(t, isOriginalMeasured(ctx, bootResult, nil, nil))
+	require.True(t, isOriginalMeasured(ctx, bootResult, expectedPCR0, nil))
+	require.False(t, isOriginalMeasured(ctx, bootResult, make([]byte, len(expectedPCR0)), nil))
+	require.True(t, isOriginalMeasured(ctx, bootResult, nil, eventLog))
+	require.False(t, isOriginalMeasured(ctx, bootResult, nil, otherEventLog))
+
+	// the PCR value has the precedence over the event log
+	require.False(t, isOriginalMeasured(ctx, bootResult, make([]byte, len(expectedPCR0)), eventLog))
+}
@@ -22,6 +22,58 @@ enum DiffDiagnosis {
   FirmwareVersionMismatch = 4,
   InvalidOriginalFirmware = 5,
   KnownTamperedHost = 6,
+  // UnstableDump means all the differences are within the ranges which
+  // differed between two consecutive reads of the actual firmware, thus
+  // they are likely caused by a flaky dump rather than by a modification.
+  UnstableDump = 7,
+}
+
+// DiffRegion is the kind of the firmware region hit by a difference.
+enum DiffRegion {
+  Undefined = 0,
+  // Other is a region which was not classified (e.g. a non-code FFS file).
+  Other = 1,
+  // NVRAM is a variable store, which is modified at runtime by design.
+  NVRAM = 2,
+  Microcode = 3,
+  // Padding is a pad file, BIOS padding or unused (erased) flash space.
+  Padding = 4,
+  PEICode = 5,
+  DXECode = 6,
+  // FIT is the Firmware Interface Table or the pointer to it.
+  FIT = 7,
+  // Signature is a signed manifest (e.g. Key Manifest or Boot Policy Manifest).
+  Signature = 8,
+  ACM = 9,
+}
+
+// DiffPattern is the shape of a difference.
+enum DiffPattern {
+  Undefined = 0,
+  // Arbitrary is a difference without any recognized pattern.
+  Arbitrary = 1,
+  // BitFlip is a difference of very few bits.
+  BitFlip = 2,
+  // Erased means all the changed bytes became 0xFF.
+  Erased = 3,
+  // Zeroed means all the changed bytes became 0x00.
+  Zeroed = 4,
+  // HighEntropy means the new data looks compressed or encrypted.
+  HighEntropy = 5,
+}
+
+// DiffEntryAssessment explains how suspicious a single difference is.
+struct DiffEntryAssessment {
+  1: DiffRegion Region;
+  2: DiffPattern Pattern;
+  // EraseBlockAligned is true if the difference is within SPI erase blocks
+  // which are fully erased in the actual image.
+  3: bool EraseBlockAligned;
+  // Entropy is the Shannon entropy (bits per byte) of the actual data.
+  4: double Entropy;
+  // Score is the suspiciousness of the difference in range [0, 1].
+  5: double Score;
+  6: string Reason;
 }
 
 struct NodeInfo {
@@ -69,6 +121,32 @@ struct DiffEntry {
   5: Range_ Range;
   6: list<RelatedMeasurement> RelatedMeasurements;
   7: list<NodeInfo> Nodes;
+
+  // InUnstableRange is true if the entry lies within the ranges which
+  // differed between two consecutive reads of the actual firmware.
+  8: bool InUnstableRange;
+
+  // Assessment is set for the entries considered by the diagnosis.
+  9: optional DiffEntryAssessment Assessment;
+}
+
+enum AlignmentSegmentKind {
+  Undefined = 0,
+  // Image means the whole actual image is mapped at once.
+  Image = 1,
+  // Region is an IFD region.
+  Region = 2,
+  // Volume is a firmware volume.
+  Volume = 3,
+}
+
+// AlignmentSegment maps a part of the actual image to a part of the original image.
+struct AlignmentSegment {
+  1: AlignmentSegmentKind Kind;
+  // Name is the region type or the firmware volume GUID.
+  2: string Name;
+  3: Range_ Original;
+  4: Range_ Actual;
 }
 
 struct CustomReport {
@@ -77,5 +155,28 @@ struct CustomReport {
 
   // ImageOffset is the offset used to align the actual and the original images:
   // AddressInOriginalImage = AddressInActualImage + ImageOffset
+  //
+  // It is zero if the images were aligned by regions and volumes, see Alignment.
   3: i64 ImageOffset;
+
+  // MissingMeasuredRanges are the measured ranges of the actual image, which
+  // were not dumped (see SparseBlob), and thus were not compared.
+  4: list<Range_> MissingMeasuredRanges;
+
+  // ActualFirmwareDumpMethod is the method used to dump the actual image,
+  // if it was provided.
+  5: optional string ActualFirmwareDumpMethod;
+  // ActualFirmwareDumpQuality is the quality of the dump of the actual image,
+  // if it was provided.
+  6: optional string ActualFirmwareDumpQuality;
+
+  // DiagnosisConfidence is how confident the diagnosis is, in range [0, 1].
+  7: double DiagnosisConfidence;
+
+  // Alignment is the mapping of the actual image to the original image.
+  8: list<AlignmentSegment> Alignment;
+
+  // UnmappedMeasuredRanges are the measured ranges of the actual image, which
+  // have no counterpart in the original image, and thus were not compared.
+  9: list<Range_> UnmappedMeasuredRanges;
 }
@@ -32,6 +32,9 @@ func init() {
 // ID represents the unique id of DiffMeasuredBoot analyzer
 const ID analysis.AnalyzerID = intelacmanalysis.IntelACMAnalyzerID
 
+// Version is the semantic version of the IntelACM analyzer
+const Version analysis.AnalyzerVersion = "1.0.0"
+
 // NewExecutorInput builds an analysis.Executor's input required for IntelACM analyzer
 func NewExecutorInput(
 	originalFirmware analysis.Blob,
@@ -69,6 +72,11 @@ func (analyzer *IntelACM) ID() analysis.AnalyzerID {
 	return ID
 }
 
+// Version implements the Version method required for analysis.Analyzer
+func (analyzer *IntelACM) Version() analysis.AnalyzerVersion {
+	return Version
+}
+
 // Analyze makes the ACM gathering
 func (analyzer *IntelACM) Analyze(ctx context.Context, in Input) (*analysis.Report, error) {
 	var wg sync.WaitGroup
@@ -104,6 +112,12 @@ func (analyzer *IntelACM) Analyze(ctx context.Context, in Input) (*analysis.Repo
 		},
 	}
 
+	if errReceived != nil {
+		if missing := analysis.MissingRanges(in.ActualFirmware); len(missing) != 0 {
+			errReceived = fmt.Errorf("%w (the actual image was dumped partially, ranges %s are missing)", errReceived, missing)
+		}
+	}
+
 	for _, err := range []error{errOriginal, errReceived} {
 		if err != nil {
 			result.Issues = append(result.Issues, analysis.Issue{
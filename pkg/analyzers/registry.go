@@ -12,6 +12,7 @@
 package analyzers
 
 import (
+	"context"
 	"fmt"
 
 	"github.com/immune-gmbh/AttestationFailureAnalysisService/pkg/analysis"
@@ -31,8 +32,17 @@ type Registry struct {
 	// TODO: use the input type as the key in the map (it is safer)
 	// TODO: also generalize the factory, instead of using `any`
 	analyzerFactories map[analysis.AnalyzerID]any
+	versions          map[analysis.AnalyzerID]analysis.AnalyzerVersion
+	executors         map[analysis.AnalyzerID]executor
 }
 
+type executor func(
+	ctx context.Context,
+	dataCalculator analysis.DataCalculatorInterface,
+	in analysis.Input,
+	cache analysis.DataCache,
+) (*analysis.Report, error)
+
 // Add registers provided analyzer
 func Add[inputType any](r *Registry, id analysis.AnalyzerID, analyzerFactory AnalyzerFactory[inputType]) error {
 	if analyzerFactory == nil {
@@ -45,6 +55,15 @@ func Add[inputType any](r *Registry, id analysis.AnalyzerID, analyzerFactory Ana
 		return fmt.Errorf("empty analyzer id")
 	}
 	r.analyzerFactories[id] = analyzerFactory
+	r.versions[id] = analyzerFactory().Version()
+	r.executors[id] = func(
+		ctx context.Context,
+		dataCalculator analysis.DataCalculatorInterface,
+		in analysis.Input,
+		cache analysis.DataCache,
+	) (*analysis.Report, error) {
+		return analysis.ExecuteAnalyzer(ctx, dataCalculator, analyzerFactory(), in, cache)
+	}
 	return nil
 }
 
@@ -68,10 +87,36 @@ func (r *Registry) IDs() []analysis.AnalyzerID {
 	return result
 }
 
+// Version returns the version of the analyzer with the given id, or an empty
+// string if the analyzer is not registered
+func (r *Registry) Version(id analysis.AnalyzerID) analysis.AnalyzerVersion {
+	return r.versions[id]
+}
+
+// Execute executes the analyzer with the given id on the given input (see analysis.ExecuteAnalyzer).
+//
+// It is useful when the input type of the analyzer is not known at compile time,
+// for example to re-execute an analyzer on a stored input.
+func (r *Registry) Execute(
+	ctx context.Context,
+	id analysis.AnalyzerID,
+	dataCalculator analysis.DataCalculatorInterface,
+	in analysis.Input,
+	cache analysis.DataCache,
+) (*analysis.Report, error) {
+	execute := r.executors[id]
+	if execute == nil {
+		return nil, fmt.Errorf("analyzer with id '%s' is not found", id)
+	}
+	return execute(ctx, dataCalculator, in, cache)
+}
+
 // NewRegistry creates a new Registry instance
 func NewRegistry() *Registry {
 	return &Registry{
 		analyzerFactories: make(map[analysis.AnalyzerID]any),
+		versions:          make(map[analysis.AnalyzerID]analysis.AnalyzerVersion),
+		executors:         make(map[analysis.AnalyzerID]executor),
 	}
 }
 
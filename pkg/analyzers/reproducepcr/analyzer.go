@@ -54,6 +54,9 @@ type ExpectedPCR0 []byte
 // ID represents the unique id of DiffMeasuredBoot analyzer
 const ID analysis.AnalyzerID = reproducepcranalysis.ReproducePCRAnalyzerID
 
+// Version is the semantic version of the ReproducePCR analyzer
+const Version analysis.AnalyzerVersion = "1.0.0"
+
 // NewExecutorInput builds an analysis.Executor's input required for ReproducePCR analyzer
 //
 // Optional arguments: tpm, eventlog and enforcedMeasurementsFlow
@@ -124,6 +127,11 @@ func (analyzer *ReproducePCR) ID() analysis.AnalyzerID {
 	return ID
 }
 
+// Version implements the Version method required for analysis.Analyzer
+func (analyzer *ReproducePCR) Version() analysis.AnalyzerVersion {
+	return Version
+}
+
 // Analyze tries to reproduce ExpectedPCR0
 //
 // TODO: redesign this function, this is an intermediate code while migrating from `pcr` to `bootflow`.
@@ -25,6 +25,9 @@ type BlobStorage interface {
 	Get(ctx context.Context, key []byte) ([]byte, error)
 	Replace(ctx context.Context, key []byte, blob []byte) error
 	Delete(ctx context.Context, key []byte) error
+
+	// Ping returns an error if the storage is not reachable.
+	Ping(ctx context.Context) error
 }
 
 func New(urlString string) (BlobStorage, error) {
@@ -35,7 +38,11 @@ func New(urlString string) (BlobStorage, error) {
 	switch parsedURL.Scheme {
 	case "fs":
 		rootDir := parsedURL.Path
-		return newFS(rootDir)
+		fs, err := newFS(rootDir)
+		if err != nil {
+			return nil, err
+		}
+		return WithMetrics(fs), nil
 	default:
 		return nil, fmt.Errorf("unknown scheme '%s'", parsedURL.Scheme)
 	}
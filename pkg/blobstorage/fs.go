@@ -52,6 +52,17 @@ func (fs *FS) Delete(ctx context.Context, key []byte) error {
 	return os.Remove(objPath)
 }
 
+func (fs *FS) Ping(ctx context.Context) error {
+	stat, err := os.Stat(fs.RootDir)
+	if err != nil {
+		return fmt.Errorf("unable to access the rootdir '%s': %w", fs.RootDir, err)
+	}
+	if !stat.IsDir() {
+		return fmt.Errorf("the rootdir '%s' is not a directory", fs.RootDir)
+	}
+	return nil
+}
+
 func (fs *FS) getPath(key []byte) string {
 	return filepath.Join(fs.RootDir, base32.StdEncoding.EncodeToString(key))
 }
@@ -0,0 +1,66 @@
+// Copyright 2023 Meta Platforms, Inc. and affiliates.
+//
+// Redistribution and use in source and binary forms, with or without modification, are permitted provided that the following conditions are met:
+//
+// 1. Redistributions of source code must retain the above copyright notice, this list of conditions and the following disclaimer.
+//
+// 2. Redistributions in binary form must reproduce the above copyright notice, this list of conditions and the following disclaimer in the documentation and/or other materials provided with the distribution.
+//
+// 3. Neither the name of the copyright holder nor the names of its contributors may be used to endorse or promote products derived from this software without specific prior written permission.
+//
+// THIS SOFTWARE IS PROVIDED BY THE COPYRIGHT HOLDERS AND CONTRIBUTORS "AS IS" AND ANY EXPRESS OR IMPLIED WARRANTIES, INCLUDING, BUT NOT LIMITED TO, THE IMPLIED WARRANTIES OF MERCHANTABILITY AND FITNESS FOR A PARTICULAR PURPOSE ARE DISCLAIMED. IN NO EVENT SHALL THE COPYRIGHT HOLDER OR CONTRIBUTORS BE LIABLE FOR ANY DIRECT, INDIRECT, INCIDENTAL, SPECIAL, EXEMPLARY, OR CONSEQUENTIAL DAMAGES (INCLUDING, BUT NOT LIMITED TO, PROCUREMENT OF SUBSTITUTE GOODS OR SERVICES; LOSS OF USE, DATA, OR PROFITS; OR BUSINESS INTERRUPTION) HOWEVER CAUSED AND ON ANY THEORY OF LIABILITY, WHETHER IN CONTRACT, STRICT LIABILITY, OR TORT (INCLUDING NEGLIGENCE OR OTHERWISE) ARISING IN ANY WAY OUT OF THE USE OF THIS SOFTWARE, EVEN IF ADVISED OF THE POSSIBILITY OF SUCH DAMAGE.
+package blobstorage
+
+import (
+	"context"
+	"time"
+
+	"github.com/facebookincubator/go-belt/pkg/field"
+	"github.com/facebookincubator/go-belt/tool/experimental/metrics"
+
+	"github.com/immune-gmbh/AttestationFailureAnalysisService/pkg/observability"
+)
+
+type blobStorageWithMetrics struct {
+	BlobStorage
+}
+
+// WithMetrics returns a BlobStorage which reports the latency and the amount
+// of errors of each operation of the given BlobStorage to the metrics.
+func WithMetrics(backend BlobStorage) BlobStorage {
+	return blobStorageWithMetrics{BlobStorage: backend}
+}
+
+func observe(ctx context.Context, method string, startTime time.Time, err error) {
+	outcome := "ok"
+	if err != nil {
+		outcome = "error"
+	}
+	fields := field.Fields{
+		{Key: "method", Value: method},
+		{Key: "outcome", Value: outcome},
+	}
+	metrics.FromCtx(ctx).CountFields("blobStorageRequests", fields).Add(1)
+	observability.ObserveDuration(ctx, "blobStorageDurationSeconds", fields, time.Since(startTime))
+}
+
+func (s blobStorageWithMetrics) Get(ctx context.Context, key []byte) ([]byte, error) {
+	startTime := time.Now()
+	blob, err := s.BlobStorage.Get(ctx, key)
+	observe(ctx, "Get", startTime, err)
+	return blob, err
+}
+
+func (s blobStorageWithMetrics) Replace(ctx context.Context, key []byte, blob []byte) error {
+	startTime := time.Now()
+	err := s.BlobStorage.Replace(ctx, key, blob)
+	observe(ctx, "Replace", startTime, err)
+	return err
+}
+
+func (s blobStorageWithMetrics) Delete(ctx context.Context, key []byte) error {
+	startTime := time.Now()
+	err := s.BlobStorage.Delete(ctx, key)
+	observe(ctx, "Delete", startTime, err)
+	return err
+}
@@ -0,0 +1,58 @@
+// Copyright 2023 Meta Platforms, Inc. and affiliates.
+//
+// Redistribution and use in source and binary forms, with or without modification, are permitted provided that the following conditions are met:
+//
+// 1. Redistributions of source code must retain the above copyright notice, this list of conditions and the following disclaimer.
+//
+// 2. Redistributions in binary form must reproduce the above copyright notice, this list of conditions and the following disclaimer in the documentation and/or other materials provided with the distribution.
+//
+// 3. Neither the name of the copyright holder nor the names of its contributors may be used to endorse or promote products derived from this software without specific prior written permission.
+//
+// THIS SOFTWARE IS PROVIDED BY THE COPYRIGHT HOLDERS AND CONTRIBUTORS "AS IS" AND ANY EXPRESS OR IMPLIED WARRANTIES, INCLUDING, BUT NOT LIMITED TO, THE IMPLIED WARRANTIES OF MERCHANTABILITY AND FITNESS FOR A PARTICULAR PURPOSE ARE DISCLAIMED. IN NO EVENT SHALL THE COPYRIGHT HOLDER OR CONTRIBUTORS BE LIABLE FOR ANY DIRECT, INDIRECT, INCIDENTAL, SPECIAL, EXEMPLARY, OR CONSEQUENTIAL DAMAGES (INCLUDING, BUT NOT LIMITED TO, PROCUREMENT OF SUBSTITUTE GOODS OR SERVICES; LOSS OF USE, DATA, OR PROFITS; OR BUSINESS INTERRUPTION) HOWEVER CAUSED AND ON ANY THEORY OF LIABILITY, WHETHER IN CONTRACT, STRICT LIABILITY, OR TORT (INCLUDING NEGLIGENCE OR OTHERWISE) ARISING IN ANY WAY OUT OF THE USE OF THIS SOFTWARE, EVEN IF ADVISED OF THE POSSIBILITY OF SUCH DAMAGE.
+// Package buildinfo provides information about the build of the running binary.
+package buildinfo
+
+import (
+	"runtime/debug"
+)
+
+// These variables could be overridden at build time, for example:
+//
+//	go build -ldflags "-X github.com/immune-gmbh/AttestationFailureAnalysisService/pkg/buildinfo.buildMode=opt" ./cmd/afasd
+var (
+	revision  string
+	buildMode = "dev"
+)
+
+// Revision returns the VCS revision the binary was built from.
+//
+// If it was not set at build time, then the revision embedded by the Go
+// toolchain is used (with suffix "-dirty" if the working tree was modified).
+// Returns an empty string if the revision is unknown.
+func Revision() string {
+	if revision != "" {
+		return revision
+	}
+	info, ok := debug.ReadBuildInfo()
+	if !ok {
+		return ""
+	}
+	var result, modified string
+	for _, setting := range info.Settings {
+		switch setting.Key {
+		case "vcs.revision":
+			result = setting.Value
+		case "vcs.modified":
+			modified = setting.Value
+		}
+	}
+	if result != "" && modified == "true" {
+		result += "-dirty"
+	}
+	return result
+}
+
+// BuildMode returns "opt" for optimized (production) builds and "dev" otherwise.
+func BuildMode() string {
+	return buildMode
+}
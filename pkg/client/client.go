@@ -22,7 +22,9 @@ import (
 
 	"github.com/apache/thrift/lib/go/thrift"
 	"github.com/facebookincubator/go-belt/beltctx"
+	"github.com/facebookincubator/go-belt/pkg/field"
 	"github.com/facebookincubator/go-belt/tool/experimental/errmon"
+	"github.com/facebookincubator/go-belt/tool/experimental/metrics"
 	"github.com/facebookincubator/go-belt/tool/logger"
 	"github.com/hashicorp/go-multierror"
 
@@ -54,13 +56,29 @@ var (
 // with the fanciness of this package.
 type Backend = afas.AttestationFailureAnalyzerServiceClient
 
-// Client is just a client for the firmware analyzer service.
+// Client is a client for the firmware analyzer service.
+//
+// It sends requests to the first healthy endpoint, retries failed requests
+// according to the RetryPolicy and temporary ejects unhealthy endpoints according
+// to the EjectionPolicy. See also ClassifyError.
+//
+// Compatibility note: Client used to embed *Backend and to expose field
+// BackendTransport. Since it may be connected to multiple endpoints (and
+// reconnects to them), there is no single backend anymore: use the methods
+// of Client itself (they implement afas.AttestationFailureAnalyzerService),
+// and Close to close the connections.
 type Client struct {
-	*Backend
-	BackendTransport thrift.TTransport
+	config      initConfig
+	httpHeaders http.Header
+	endpoints   []*endpoint
 }
 
+var _ afas.AttestationFailureAnalyzerService = (*Client)(nil)
+
 // NewClient constructs a client for the firmware analyzer service.
+//
+// It connects to the first available endpoint; connections to the rest
+// endpoints are established only if required.
 func NewClient(ctx context.Context, opts ...Option) (*Client, error) {
 	beltctx.WithField(ctx, "pkg", "afas_client")
 
@@ -68,52 +86,168 @@ func NewClient(ctx context.Context, opts ...Option) (*Client, error) {
 		Timeout:        DefaultTimeout,
 		RemoteLogLevel: logger.LevelWarning,
 		Protocol:       DefaultProtocol,
+		RetryPolicy:    DefaultRetryPolicy,
+		EjectionPolicy: DefaultEjectionPolicy,
+		backendFactory: defaultBackendFactory,
 	}
 	for _, opt := range opts {
 		opt.apply(&cfg)
 	}
 
-	backendClient, transport, err := newBackend(ctx, cfg)
-	if err != nil {
-		return nil, fmt.Errorf("unable to initialize a thrift client: %w", err)
+	if len(cfg.Endpoints) == 0 {
+		return nil, fmt.Errorf("unable to initialize a thrift client: %w", ErrNoDestination{})
 	}
 
-	return &Client{
-		Backend:          backendClient,
-		BackendTransport: transport,
-	}, nil
+	c := &Client{
+		config:      cfg,
+		httpHeaders: clienthelpers.HTTPHeaders(beltctx.Belt(ctx), cfg.RemoteLogLevel),
+	}
+	for _, endpointURL := range cfg.Endpoints {
+		c.endpoints = append(c.endpoints, &endpoint{URL: endpointURL})
+	}
+
+	var errors *multierror.Error
+	for _, ep := range c.endpoints {
+		_, err := ep.getBackend(ctx, c)
+		errmon.ObserveErrorCtx(ctx, err)
+		if err == nil {
+			return c, nil
+		}
+		errors = multierror.Append(errors, fmt.Errorf("unable to initialize a thrift client using endpoint '%s': %w", ep.URL, err))
+		ep.reportTransportFailure(time.Now(), cfg.EjectionPolicy)
+	}
+	return nil, fmt.Errorf("unable to initialize a thrift client: %w", errors)
 }
 
+// Close closes connections to all the endpoints.
 func (c *Client) Close() error {
-	return c.BackendTransport.Close()
+	var result *multierror.Error
+	for _, ep := range c.endpoints {
+		if err := ep.Close(); err != nil {
+			result = multierror.Append(result, fmt.Errorf("unable to close the connection to '%s': %w", ep.URL, err))
+		}
+	}
+	return result.ErrorOrNil()
 }
 
-func newBackend(
-	ctx context.Context,
-	cfg initConfig,
-) (
-	*afas.AttestationFailureAnalyzerServiceClient,
-	thrift.TTransport,
-	error,
-) {
-	if len(cfg.Endpoints) == 0 {
-		return nil, nil, fmt.Errorf("no endpoints defined")
+// pickEndpoint returns the endpoint to be used for the next attempt: the first
+// healthy endpoint which was not tried yet. If all healthy endpoints were already
+// tried, then they are rotated. If all the endpoints are ejected then the one
+// to be returned back first is used.
+func (c *Client) pickEndpoint(now time.Time, attempt uint, tried map[*endpoint]struct{}) *endpoint {
+	var healthy []*endpoint
+	for _, ep := range c.endpoints {
+		if !ep.isEjected(now) {
+			healthy = append(healthy, ep)
+		}
+	}
+	for _, ep := range healthy {
+		if _, ok := tried[ep]; !ok {
+			return ep
+		}
+	}
+	if len(healthy) > 0 {
+		return healthy[int(attempt-1)%len(healthy)]
 	}
 
-	httpHeaders := clienthelpers.HTTPHeaders(beltctx.Belt(ctx), cfg.RemoteLogLevel)
+	result := c.endpoints[0]
+	for _, ep := range c.endpoints[1:] {
+		if ep.getEjectedUntil().Before(result.getEjectedUntil()) {
+			result = ep
+		}
+	}
+	return result
+}
 
-	var errors error
-	for _, endpoint := range cfg.Endpoints {
-		backendClient, transport, err := newBackendUsingBackend(ctx, endpoint, cfg.Timeout, cfg.Protocol, httpHeaders)
-		errmon.ObserveErrorCtx(ctx, err)
-		if err != nil {
-			multierror.Append(errors, fmt.Errorf("unable to initialize a thrift client using endpoint '%s': %w", endpoint, err))
+// call executes fn with retries and failover between endpoints.
+func (c *Client) call(
+	ctx context.Context,
+	method string,
+	idempotent bool,
+	fn func(ctx context.Context, backend afas.AttestationFailureAnalyzerService) error,
+) error {
+	var (
+		log    = logger.FromCtx(ctx)
+		m      = metrics.FromCtx(ctx)
+		errs   *multierror.Error
+		tried  = map[*endpoint]struct{}{}
+		policy = c.config.RetryPolicy
+	)
+	for attempt := uint(1); ; attempt++ {
+		ep := c.pickEndpoint(time.Now(), attempt, tried)
+		tried[ep] = struct{}{}
+
+		backend, err := ep.getBackend(ctx, c)
+		sent := err == nil
+		if sent {
+			err = fn(ctx, backend)
+			// the HTTP transport connects lazily, so a failure to connect
+			// is returned by the request itself
+			sent = err == nil || !isDialError(err)
+		}
+		if err == nil {
+			ep.reportSuccess()
+			return nil
+		}
+
+		class := ClassifyError(err)
+		if !sent && class != ErrorClassCanceled {
+			class = ErrorClassTransport
 		}
-		if backendClient != nil {
-			return backendClient, transport, nil
+		m.CountFields("afasClientErrors", field.Fields{
+			{Key: "method", Value: method},
+			{Key: "class", Value: class.String()},
+		}).Add(1)
+
+		switch class {
+		case ErrorClassTransport:
+			if ep.reportTransportFailure(time.Now(), c.config.EjectionPolicy) {
+				log.Warnf("endpoint '%s' is ejected for %v", ep.URL, c.config.EjectionPolicy.Duration)
+				m.Count("afasClientEndpointEjections").Add(1)
+			}
+		case ErrorClassCanceled:
+		default:
+			// the server responded, so the endpoint is healthy
+			ep.reportSuccess()
+		}
+
+		err = ErrCall{Method: method, Endpoint: ep.URL, Attempt: attempt, Err: err}
+		errs = multierror.Append(errs, err)
+		if attempt >= policy.MaxAttempts || !policy.isRetriable(class, idempotent, sent) {
+			if attempt == 1 {
+				return err
+			}
+			return errs
+		}
+
+		delay := policy.Backoff(attempt)
+		if retryAfter := RetryAfter(err); retryAfter > delay {
+			delay = retryAfter
+		}
+		log.Debugf("%v; retrying in %v", err, delay)
+		m.Count("afasClientRetries").Add(1)
+		select {
+		case <-ctx.Done():
+			return multierror.Append(errs, ctx.Err())
+		case <-time.After(delay):
 		}
 	}
-	return nil, nil, errors
+}
+
+func callWithResult[T any](
+	ctx context.Context,
+	c *Client,
+	method string,
+	idempotent bool,
+	fn func(ctx context.Context, backend afas.AttestationFailureAnalyzerService) (T, error),
+) (T, error) {
+	var result T
+	err := c.call(ctx, method, idempotent, func(ctx context.Context, backend afas.AttestationFailureAnalyzerService) error {
+		var err error
+		result, err = fn(ctx, backend)
+		return err
+	})
+	return result, err
 }
 
 func newBackendUsingBackend(
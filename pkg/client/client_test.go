@@ -0,0 +1,241 @@
+// Copyright 2023 Meta Platforms, Inc. and affiliates.
+//
+// Redistribution and use in source and binary forms, with or without modification, are permitted provided that the following conditions are met:
+//
+// 1. Redistributions of source code must retain the above copyright notice, this list of conditions and the following disclaimer.
+//
+// 2. Redistributions in binary form must reproduce the above copyright notice, this list of conditions and the following disclaimer in the documentation and/or other materials provided with the distribution.
+//
+// 3. Neither the name of the copyright holder nor the names of its contributors may be used to endorse or promote products derived from this software without specific prior written permission.
+//
+// THIS SOFTWARE IS PROVIDED BY THE COPYRIGHT HOLDERS AND CONTRIBUTORS "AS IS" AND ANY EXPRESS OR IMPLIED WARRANTIES, INCLUDING, BUT NOT LIMITED TO, THE IMPLIED WARRANTIES OF MERCHANTABILITY AND FITNESS FOR A PARTICULAR PURPOSE ARE DISCLAIMED. IN NO EVENT SHALL THE COPYRIGHT HOLDER OR CONTRIBUTORS BE LIABLE FOR ANY DIRECT, INDIRECT, INCIDENTAL, SPECIAL, EXEMPLARY, OR CONSEQUENTIAL DAMAGES (INCLUDING, BUT NOT LIMITED TO, PROCUREMENT OF SUBSTITUTE GOODS OR SERVICES; LOSS OF USE, DATA, OR PROFITS; OR BUSINESS INTERRUPTION) HOWEVER CAUSED AND ON ANY THEORY OF LIABILITY, WHETHER IN CONTRACT, STRICT LIABILITY, OR TORT (INCLUDING NEGLIGENCE OR OTHERWISE) ARISING IN ANY WAY OUT OF THE USE OF THIS SOFTWARE, EVEN IF ADVISED OF THE POSSIBILITY OF SUCH DAMAGE.
+
+package client
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"net"
+	"net/http"
+	"syscall"
+	"testing"
+	"time"
+
+	"github.com/apache/thrift/lib/go/thrift"
+	"github.com/stretchr/testify/require"
+
+	"github.com/immune-gmbh/AttestationFailureAnalysisService/if/generated/afas"
+)
+
+type fakeBackend struct {
+	afas.AttestationFailureAnalyzerService
+	errs  []error
+	calls int
+}
+
+func (b *fakeBackend) nextErr() error {
+	b.calls++
+	if len(b.errs) == 0 {
+		return nil
+	}
+	err := b.errs[0]
+	b.errs = b.errs[1:]
+	return err
+}
+
+func (b *fakeBackend) Analyze(context.Context, *afas.AnalyzeRequest) (*afas.AnalyzeResult_, error) {
+	if err := b.nextErr(); err != nil {
+		return nil, err
+	}
+	return &afas.AnalyzeResult_{}, nil
+}
+
+func (b *fakeBackend) SearchFirmware(context.Context, *afas.SearchFirmwareRequest) (*afas.SearchFirmwareResult_, error) {
+	if err := b.nextErr(); err != nil {
+		return nil, err
+	}
+	return &afas.SearchFirmwareResult_{}, nil
+}
+
+type nopCloser struct{}
+
+func (nopCloser) Close() error { return nil }
+
+func newTestClient(t *testing.T, backends map[string]*fakeBackend, endpoints ...string) *Client {
+	factory := func(
+		ctx context.Context,
+		endpoint string,
+		timeout time.Duration,
+		protocol string,
+		httpHeaders http.Header,
+	) (afas.AttestationFailureAnalyzerService, io.Closer, error) {
+		backend := backends[endpoint]
+		if backend == nil {
+			return nil, nil, fmt.Errorf("dial %s: %w", endpoint, syscall.ECONNREFUSED)
+		}
+		return backend, nopCloser{}, nil
+	}
+
+	c, err := NewClient(context.Background(),
+		OptionEndpoints(endpoints),
+		OptionRetryPolicy{MaxAttempts: 3, InitialBackoff: time.Millisecond, MaxBackoff: time.Millisecond, Multiplier: 2},
+		OptionEjectionPolicy{ConsecutiveFailures: 2, Duration: time.Hour},
+		optionBackendFactory(factory),
+	)
+	require.NoError(t, err)
+	return c
+}
+
+func TestClientFailover(t *testing.T) {
+	transportErr := thrift.NewTTransportException(thrift.NOT_OPEN, "connection reset")
+	backends := map[string]*fakeBackend{
+		"tcp://a": {errs: []error{transportErr}},
+		"tcp://b": {},
+	}
+	c := newTestClient(t, backends, "tcp://a", "tcp://b")
+
+	_, err := c.SearchFirmware(context.Background(), &afas.SearchFirmwareRequest{})
+	require.NoError(t, err)
+	require.Equal(t, 1, backends["tcp://a"].calls)
+	require.Equal(t, 1, backends["tcp://b"].calls)
+}
+
+func TestClientAnalyzeIsNotRetriedAfterSent(t *testing.T) {
+	transportErr := thrift.NewTTransportException(thrift.TIMED_OUT, "timeout")
+	backends := map[string]*fakeBackend{
+		"tcp://a": {errs: []error{transportErr}},
+		"tcp://b": {},
+	}
+	c := newTestClient(t, backends, "tcp://a", "tcp://b")
+
+	_, err := c.Analyze(context.Background(), &afas.AnalyzeRequest{})
+	require.Error(t, err)
+	require.Equal(t, ErrorClassTransport, ClassifyError(err))
+	require.Equal(t, 1, backends["tcp://a"].calls)
+	require.Zero(t, backends["tcp://b"].calls)
+}
+
+func TestClientAnalyzeIsRetriedIfNotSent(t *testing.T) {
+	backends := map[string]*fakeBackend{
+		"tcp://b": {},
+	}
+	// "tcp://a" is unreachable, so NewClient connects to "tcp://b" and
+	// Analyze first tries to reconnect to "tcp://a".
+	c := newTestClient(t, backends, "tcp://a", "tcp://b")
+
+	_, err := c.Analyze(context.Background(), &afas.AnalyzeRequest{})
+	require.NoError(t, err)
+	require.Equal(t, 1, backends["tcp://b"].calls)
+}
+
+func TestClientAnalyzeIsRetriedIfDialFailed(t *testing.T) {
+	// the HTTP transport connects only when the request is sent,
+	// so the connection error is returned by Analyze itself
+	listener, err := net.Listen("tcp", "127.0.0.1:0")
+	require.NoError(t, err)
+	closedURL := "http://" + listener.Addr().String() + "/"
+	require.NoError(t, listener.Close())
+
+	backendB := &fakeBackend{}
+	factory := func(
+		ctx context.Context,
+		endpoint string,
+		timeout time.Duration,
+		protocol string,
+		httpHeaders http.Header,
+	) (afas.AttestationFailureAnalyzerService, io.Closer, error) {
+		if endpoint == closedURL {
+			return defaultBackendFactory(ctx, endpoint, timeout, protocol, httpHeaders)
+		}
+		return backendB, nopCloser{}, nil
+	}
+	c, err := NewClient(context.Background(),
+		OptionEndpoints{closedURL, "tcp://b"},
+		OptionRetryPolicy{MaxAttempts: 3, InitialBackoff: time.Millisecond, MaxBackoff: time.Millisecond, Multiplier: 2},
+		optionBackendFactory(factory),
+	)
+	require.NoError(t, err)
+
+	_, err = c.Analyze(context.Background(), &afas.AnalyzeRequest{})
+	require.NoError(t, err)
+	require.Equal(t, 1, backendB.calls)
+}
+
+func TestClientAnalyzeIsRetriedIfOverloaded(t *testing.T) {
+	backends := map[string]*fakeBackend{
+		"tcp://a": {errs: []error{&afas.Overloaded{Reason: "queue is full", RetryAfterMilliseconds: 10}}},
+	}
+	c := newTestClient(t, backends, "tcp://a")
+
+	startTime := time.Now()
+	_, err := c.Analyze(context.Background(), &afas.AnalyzeRequest{})
+	require.NoError(t, err)
+	require.Equal(t, 2, backends["tcp://a"].calls)
+	require.GreaterOrEqual(t, time.Since(startTime), 10*time.Millisecond)
+}
+
+func TestClientEjection(t *testing.T) {
+	transportErr := thrift.NewTTransportException(thrift.NOT_OPEN, "connection reset")
+	backends := map[string]*fakeBackend{
+		"tcp://a": {errs: []error{transportErr, transportErr}},
+		"tcp://b": {},
+	}
+	c := newTestClient(t, backends, "tcp://a", "tcp://b")
+
+	for i := 0; i < 2; i++ {
+		_, err := c.SearchFirmware(context.Background(), &afas.SearchFirmwareRequest{})
+		require.NoError(t, err)
+	}
+	require.True(t, c.endpoints[0].isEjected(time.Now()))
+
+	_, err := c.SearchFirmware(context.Background(), &afas.SearchFirmwareRequest{})
+	require.NoError(t, err)
+	require.Equal(t, 2, backends["tcp://a"].calls)
+	require.Equal(t, 3, backends["tcp://b"].calls)
+}
+
+func TestClientNoRetryOnIncorrectHostConfiguration(t *testing.T) {
+	backends := map[string]*fakeBackend{
+		"tcp://a": {errs: []error{afas.NewIncorrectHostConfiguration()}},
+		"tcp://b": {},
+	}
+	c := newTestClient(t, backends, "tcp://a", "tcp://b")
+
+	_, err := c.SearchFirmware(context.Background(), &afas.SearchFirmwareRequest{})
+	require.Error(t, err)
+	require.Equal(t, ErrorClassIncorrectHostConfiguration, ClassifyError(err))
+	require.Zero(t, backends["tcp://b"].calls)
+	require.False(t, c.endpoints[0].isEjected(time.Now()))
+}
+
+func TestClassifyError(t *testing.T) {
+	for _, tc := range []struct {
+		err   error
+		class ErrorClass
+	}{
+		{nil, ErrorClassNone},
+		{fmt.Errorf("some error"), ErrorClassOther},
+		{fmt.Errorf("wrapped: %w", context.Canceled), ErrorClassCanceled},
+		{fmt.Errorf("wrapped: %w", syscall.ECONNREFUSED), ErrorClassTransport},
+		{io.EOF, ErrorClassTransport},
+		{afas.NewUnableToGetOriginalFirmware(), ErrorClassUnableToGetOriginalFirmware},
+		{fmt.Errorf("wrapped: %w", &afas.IncorrectHostConfiguration{}), ErrorClassIncorrectHostConfiguration},
+		// the class is never guessed from the text of an error
+		{thrift.NewTApplicationException(thrift.INTERNAL_ERROR, "Internal error processing Analyze: UnableToGetOriginalFirmware({...})"), ErrorClassOther},
+		{&afas.Overloaded{}, ErrorClassOverloaded},
+	} {
+		t.Run(fmt.Sprint(tc.err), func(t *testing.T) {
+			require.Equal(t, tc.class, ClassifyError(tc.err))
+		})
+	}
+}
+
+func TestRetryPolicyBackoff(t *testing.T) {
+	p := RetryPolicy{InitialBackoff: 100 * time.Millisecond, MaxBackoff: time.Second, Multiplier: 2, Jitter: 0.2}
+	for i := 0; i < 100; i++ {
+		require.InDelta(t, float64(100*time.Millisecond), float64(p.Backoff(1)), float64(20*time.Millisecond))
+		require.InDelta(t, float64(400*time.Millisecond), float64(p.Backoff(3)), float64(80*time.Millisecond))
+		require.InDelta(t, float64(time.Second), float64(p.Backoff(10)), float64(200*time.Millisecond))
+	}
+}
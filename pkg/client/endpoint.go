@@ -0,0 +1,122 @@
+// Copyright 2023 Meta Platforms, Inc. and affiliates.
+//
+// Redistribution and use in source and binary forms, with or without modification, are permitted provided that the following conditions are met:
+//
+// 1. Redistributions of source code must retain the above copyright notice, this list of conditions and the following disclaimer.
+//
+// 2. Redistributions in binary form must reproduce the above copyright notice, this list of conditions and the following disclaimer in the documentation and/or other materials provided with the distribution.
+//
+// 3. Neither the name of the copyright holder nor the names of its contributors may be used to endorse or promote products derived from this software without specific prior written permission.
+//
+// THIS SOFTWARE IS PROVIDED BY THE COPYRIGHT HOLDERS AND CONTRIBUTORS "AS IS" AND ANY EXPRESS OR IMPLIED WARRANTIES, INCLUDING, BUT NOT LIMITED TO, THE IMPLIED WARRANTIES OF MERCHANTABILITY AND FITNESS FOR A PARTICULAR PURPOSE ARE DISCLAIMED. IN NO EVENT SHALL THE COPYRIGHT HOLDER OR CONTRIBUTORS BE LIABLE FOR ANY DIRECT, INDIRECT, INCIDENTAL, SPECIAL, EXEMPLARY, OR CONSEQUENTIAL DAMAGES (INCLUDING, BUT NOT LIMITED TO, PROCUREMENT OF SUBSTITUTE GOODS OR SERVICES; LOSS OF USE, DATA, OR PROFITS; OR BUSINESS INTERRUPTION) HOWEVER CAUSED AND ON ANY THEORY OF LIABILITY, WHETHER IN CONTRACT, STRICT LIABILITY, OR TORT (INCLUDING NEGLIGENCE OR OTHERWISE) ARISING IN ANY WAY OUT OF THE USE OF THIS SOFTWARE, EVEN IF ADVISED OF THE POSSIBILITY OF SUCH DAMAGE.
+
+package client
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"sync"
+	"time"
+
+	"github.com/immune-gmbh/AttestationFailureAnalysisService/if/generated/afas"
+)
+
+// backendFactory connects to an endpoint.
+type backendFactory func(
+	ctx context.Context,
+	endpoint string,
+	timeout time.Duration,
+	protocol string,
+	httpHeaders http.Header,
+) (afas.AttestationFailureAnalyzerService, io.Closer, error)
+
+func defaultBackendFactory(
+	ctx context.Context,
+	endpoint string,
+	timeout time.Duration,
+	protocol string,
+	httpHeaders http.Header,
+) (afas.AttestationFailureAnalyzerService, io.Closer, error) {
+	backend, transport, err := newBackendUsingBackend(ctx, endpoint, timeout, protocol, httpHeaders)
+	if err != nil {
+		return nil, nil, err
+	}
+	return backend, transport, nil
+}
+
+// endpoint is a server endpoint with its health state.
+type endpoint struct {
+	URL string
+
+	locker              sync.Mutex
+	backend             afas.AttestationFailureAnalyzerService
+	closer              io.Closer
+	consecutiveFailures uint
+	ejectedUntil        time.Time
+}
+
+// getBackend returns the connected backend, connecting to the endpoint if required.
+func (ep *endpoint) getBackend(ctx context.Context, c *Client) (afas.AttestationFailureAnalyzerService, error) {
+	ep.locker.Lock()
+	defer ep.locker.Unlock()
+	if ep.backend != nil {
+		return ep.backend, nil
+	}
+
+	backend, closer, err := c.config.backendFactory(ctx, ep.URL, c.config.Timeout, c.config.Protocol, c.httpHeaders)
+	if err != nil {
+		return nil, err
+	}
+	ep.backend, ep.closer = backend, closer
+	return backend, nil
+}
+
+func (ep *endpoint) reportSuccess() {
+	ep.locker.Lock()
+	defer ep.locker.Unlock()
+	ep.consecutiveFailures = 0
+}
+
+// reportTransportFailure drops the connection (it will be re-established on
+// the next request) and ejects the endpoint if it failed too many times in a row.
+//
+// Returns true if the endpoint got ejected.
+func (ep *endpoint) reportTransportFailure(now time.Time, policy EjectionPolicy) bool {
+	ep.locker.Lock()
+	defer ep.locker.Unlock()
+	_ = ep.resetBackend()
+	ep.consecutiveFailures++
+	if policy.ConsecutiveFailures == 0 || ep.consecutiveFailures < policy.ConsecutiveFailures {
+		return false
+	}
+	ep.consecutiveFailures = 0
+	ep.ejectedUntil = now.Add(policy.Duration)
+	return true
+}
+
+func (ep *endpoint) getEjectedUntil() time.Time {
+	ep.locker.Lock()
+	defer ep.locker.Unlock()
+	return ep.ejectedUntil
+}
+
+func (ep *endpoint) isEjected(now time.Time) bool {
+	return now.Before(ep.getEjectedUntil())
+}
+
+// resetBackend should be called only with locked locker.
+func (ep *endpoint) resetBackend() error {
+	var err error
+	if ep.closer != nil {
+		err = ep.closer.Close()
+	}
+	ep.backend, ep.closer = nil, nil
+	return err
+}
+
+func (ep *endpoint) Close() error {
+	ep.locker.Lock()
+	defer ep.locker.Unlock()
+	return ep.resetBackend()
+}
@@ -0,0 +1,133 @@
+// Copyright 2023 Meta Platforms, Inc. and affiliates.
+//
+// Redistribution and use in source and binary forms, with or without modification, are permitted provided that the following conditions are met:
+//
+// 1. Redistributions of source code must retain the above copyright notice, this list of conditions and the following disclaimer.
+//
+// 2. Redistributions in binary form must reproduce the above copyright notice, this list of conditions and the following disclaimer in the documentation and/or other materials provided with the distribution.
+//
+// 3. Neither the name of the copyright holder nor the names of its contributors may be used to endorse or promote products derived from this software without specific prior written permission.
+//
+// THIS SOFTWARE IS PROVIDED BY THE COPYRIGHT HOLDERS AND CONTRIBUTORS "AS IS" AND ANY EXPRESS OR IMPLIED WARRANTIES, INCLUDING, BUT NOT LIMITED TO, THE IMPLIED WARRANTIES OF MERCHANTABILITY AND FITNESS FOR A PARTICULAR PURPOSE ARE DISCLAIMED. IN NO EVENT SHALL THE COPYRIGHT HOLDER OR CONTRIBUTORS BE LIABLE FOR ANY DIRECT, INDIRECT, INCIDENTAL, SPECIAL, EXEMPLARY, OR CONSEQUENTIAL DAMAGES (INCLUDING, BUT NOT LIMITED TO, PROCUREMENT OF SUBSTITUTE GOODS OR SERVICES; LOSS OF USE, DATA, OR PROFITS; OR BUSINESS INTERRUPTION) HOWEVER CAUSED AND ON ANY THEORY OF LIABILITY, WHETHER IN CONTRACT, STRICT LIABILITY, OR TORT (INCLUDING NEGLIGENCE OR OTHERWISE) ARISING IN ANY WAY OUT OF THE USE OF THIS SOFTWARE, EVEN IF ADVISED OF THE POSSIBILITY OF SUCH DAMAGE.
+
+package client
+
+import (
+	"context"
+	"errors"
+	"io"
+	"net"
+	"syscall"
+	"time"
+
+	"github.com/apache/thrift/lib/go/thrift"
+
+	"github.com/immune-gmbh/AttestationFailureAnalysisService/if/generated/afas"
+)
+
+// ErrorClass is a class of an error returned by the server or
+// the transport, see ClassifyError.
+type ErrorClass int
+
+const (
+	// ErrorClassNone means there is no error.
+	ErrorClassNone = ErrorClass(iota)
+
+	// ErrorClassOther is any error not covered by other classes.
+	ErrorClassOther
+
+	// ErrorClassCanceled means the context was canceled or its deadline exceeded.
+	ErrorClassCanceled
+
+	// ErrorClassTransport means the server was not reached or the connection
+	// broke (the request may or may not be processed by the server).
+	ErrorClassTransport
+
+	// ErrorClassUnableToGetOriginalFirmware means the server was unable
+	// to obtain the original firmware image. This could be a temporary problem.
+	ErrorClassUnableToGetOriginalFirmware
+
+	// ErrorClassIncorrectHostConfiguration means the server considers
+	// the host configuration to be incorrect. Retrying will not help.
+	ErrorClassIncorrectHostConfiguration
+
+	// ErrorClassOverloaded means the server rejected the request without
+	// processing it because it is overloaded. The request could be retried
+	// after a back-off (see RetryAfter).
+	ErrorClassOverloaded
+)
+
+// String implements fmt.Stringer.
+func (c ErrorClass) String() string {
+	switch c {
+	case ErrorClassNone:
+		return "none"
+	case ErrorClassOther:
+		return "other"
+	case ErrorClassCanceled:
+		return "canceled"
+	case ErrorClassTransport:
+		return "transport"
+	case ErrorClassUnableToGetOriginalFirmware:
+		return "unable_to_get_original_firmware"
+	case ErrorClassIncorrectHostConfiguration:
+		return "incorrect_host_configuration"
+	case ErrorClassOverloaded:
+		return "overloaded"
+	}
+	return "unknown"
+}
+
+// ClassifyError returns the class of an error returned by the Client.
+func ClassifyError(err error) ErrorClass {
+	if err == nil {
+		return ErrorClassNone
+	}
+
+	var (
+		errUnableToGetOriginalFirmware *afas.UnableToGetOriginalFirmware
+		errIncorrectHostConfiguration  *afas.IncorrectHostConfiguration
+		errOverloaded                  *afas.Overloaded
+		errTransport                   thrift.TTransportException
+		errNet                         net.Error
+	)
+	switch {
+	case errors.As(err, &errUnableToGetOriginalFirmware):
+		return ErrorClassUnableToGetOriginalFirmware
+	case errors.As(err, &errIncorrectHostConfiguration):
+		return ErrorClassIncorrectHostConfiguration
+	case errors.As(err, &errOverloaded):
+		return ErrorClassOverloaded
+	case errors.Is(err, context.Canceled), errors.Is(err, context.DeadlineExceeded):
+		return ErrorClassCanceled
+	case errors.As(err, &errTransport),
+		errors.As(err, &errNet),
+		errors.Is(err, io.EOF),
+		errors.Is(err, io.ErrUnexpectedEOF),
+		errors.Is(err, syscall.ECONNREFUSED),
+		errors.Is(err, syscall.ECONNRESET),
+		errors.Is(err, syscall.EPIPE):
+		return ErrorClassTransport
+	}
+	return ErrorClassOther
+}
+
+// isDialError returns true if the error means the connection to the server
+// was not established, thus the request was not sent.
+func isDialError(err error) bool {
+	var errOp *net.OpError
+	if errors.As(err, &errOp) && errOp.Op == "dial" {
+		return true
+	}
+	return errors.Is(err, syscall.ECONNREFUSED)
+}
+
+// RetryAfter returns the back-off hint of an Overloaded error returned
+// by the server, or zero if there is no such hint.
+func RetryAfter(err error) time.Duration {
+	var errOverloaded *afas.Overloaded
+	if !errors.As(err, &errOverloaded) {
+		return 0
+	}
+	return time.Duration(errOverloaded.GetRetryAfterMilliseconds()) * time.Millisecond
+}
@@ -48,3 +48,20 @@ type ErrEndpointsListEmpty struct{}
 func (err ErrEndpointsListEmpty) Error() string {
 	return "endpoints list is not nil, but empty"
 }
+
+// ErrCall is an error of a single attempt to call a method of the server.
+type ErrCall struct {
+	Method   string
+	Endpoint string
+	Attempt  uint
+	Err      error
+}
+
+// Error implements interface "error"
+func (err ErrCall) Error() string {
+	return fmt.Sprintf("call of %s at '%s' (attempt #%d) failed: %v", err.Method, err.Endpoint, err.Attempt, err.Err)
+}
+
+func (err ErrCall) Unwrap() error {
+	return err.Err
+}
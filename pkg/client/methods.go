@@ -0,0 +1,134 @@
+// Copyright 2023 Meta Platforms, Inc. and affiliates.
+//
+// Redistribution and use in source and binary forms, with or without modification, are permitted provided that the following conditions are met:
+//
+// 1. Redistributions of source code must retain the above copyright notice, this list of conditions and the following disclaimer.
+//
+// 2. Redistributions in binary form must reproduce the above copyright notice, this list of conditions and the following disclaimer in the documentation and/or other materials provided with the distribution.
+//
+// 3. Neither the name of the copyright holder nor the names of its contributors may be used to endorse or promote products derived from this software without specific prior written permission.
+//
+// THIS SOFTWARE IS PROVIDED BY THE COPYRIGHT HOLDERS AND CONTRIBUTORS "AS IS" AND ANY EXPRESS OR IMPLIED WARRANTIES, INCLUDING, BUT NOT LIMITED TO, THE IMPLIED WARRANTIES OF MERCHANTABILITY AND FITNESS FOR A PARTICULAR PURPOSE ARE DISCLAIMED. IN NO EVENT SHALL THE COPYRIGHT HOLDER OR CONTRIBUTORS BE LIABLE FOR ANY DIRECT, INDIRECT, INCIDENTAL, SPECIAL, EXEMPLARY, OR CONSEQUENTIAL DAMAGES (INCLUDING, BUT NOT LIMITED TO, PROCUREMENT OF SUBSTITUTE GOODS OR SERVICES; LOSS OF USE, DATA, OR PROFITS; OR BUSINESS INTERRUPTION) HOWEVER CAUSED AND ON ANY THEORY OF LIABILITY, WHETHER IN CONTRACT, STRICT LIABILITY, OR TORT (INCLUDING NEGLIGENCE OR OTHERWISE) ARISING IN ANY WAY OUT OF THE USE OF THIS SOFTWARE, EVEN IF ADVISED OF THE POSSIBILITY OF SUCH DAMAGE.
+
+package client
+
+import (
+	"context"
+
+	"github.com/immune-gmbh/AttestationFailureAnalysisService/if/generated/afas"
+)
+
+// GetServerInfo implements afas.AttestationFailureAnalyzerService.
+func (c *Client) GetServerInfo(ctx context.Context, request *afas.GetServerInfoRequest) (*afas.ServerInfo, error) {
+	return callWithResult(ctx, c, "GetServerInfo", true, func(ctx context.Context, backend afas.AttestationFailureAnalyzerService) (*afas.ServerInfo, error) {
+		return backend.GetServerInfo(ctx, request)
+	})
+}
+
+// SearchFirmware implements afas.AttestationFailureAnalyzerService.
+func (c *Client) SearchFirmware(ctx context.Context, request *afas.SearchFirmwareRequest) (*afas.SearchFirmwareResult_, error) {
+	return callWithResult(ctx, c, "SearchFirmware", true, func(ctx context.Context, backend afas.AttestationFailureAnalyzerService) (*afas.SearchFirmwareResult_, error) {
+		return backend.SearchFirmware(ctx, request)
+	})
+}
+
+// SearchReport implements afas.AttestationFailureAnalyzerService.
+func (c *Client) SearchReport(ctx context.Context, request *afas.SearchReportRequest) (*afas.SearchReportResult_, error) {
+	return callWithResult(ctx, c, "SearchReport", true, func(ctx context.Context, backend afas.AttestationFailureAnalyzerService) (*afas.SearchReportResult_, error) {
+		return backend.SearchReport(ctx, request)
+	})
+}
+
+// Analyze implements afas.AttestationFailureAnalyzerService.
+//
+// Analyze stores the results on the server side, so it is not retried
+// if the request was already sent.
+func (c *Client) Analyze(ctx context.Context, request *afas.AnalyzeRequest) (*afas.AnalyzeResult_, error) {
+	return callWithResult(ctx, c, "Analyze", false, func(ctx context.Context, backend afas.AttestationFailureAnalyzerService) (*afas.AnalyzeResult_, error) {
+		return backend.Analyze(ctx, request)
+	})
+}
+
+// CheckFirmwareVersion implements afas.AttestationFailureAnalyzerService.
+func (c *Client) CheckFirmwareVersion(ctx context.Context, request *afas.CheckFirmwareVersionRequest) (*afas.CheckFirmwareVersionResult_, error) {
+	return callWithResult(ctx, c, "CheckFirmwareVersion", true, func(ctx context.Context, backend afas.AttestationFailureAnalyzerService) (*afas.CheckFirmwareVersionResult_, error) {
+		return backend.CheckFirmwareVersion(ctx, request)
+	})
+}
+
+// SearchByPCR implements afas.AttestationFailureAnalyzerService.
+func (c *Client) SearchByPCR(ctx context.Context, request *afas.SearchByPCRRequest) (*afas.SearchByPCRResult_, error) {
+	return callWithResult(ctx, c, "SearchByPCR", true, func(ctx context.Context, backend afas.AttestationFailureAnalyzerService) (*afas.SearchByPCRResult_, error) {
+		return backend.SearchByPCR(ctx, request)
+	})
+}
+
+// CompareReports implements afas.AttestationFailureAnalyzerService.
+func (c *Client) CompareReports(ctx context.Context, request *afas.CompareReportsRequest) (*afas.CompareReportsResult_, error) {
+	return callWithResult(ctx, c, "CompareReports", true, func(ctx context.Context, backend afas.AttestationFailureAnalyzerService) (*afas.CompareReportsResult_, error) {
+		return backend.CompareReports(ctx, request)
+	})
+}
+
+// AggregateReports implements afas.AttestationFailureAnalyzerService.
+func (c *Client) AggregateReports(ctx context.Context, request *afas.AggregateReportsRequest) (*afas.AggregateReportsResult_, error) {
+	return callWithResult(ctx, c, "AggregateReports", true, func(ctx context.Context, backend afas.AttestationFailureAnalyzerService) (*afas.AggregateReportsResult_, error) {
+		return backend.AggregateReports(ctx, request)
+	})
+}
+
+// NegotiateImageUpload implements afas.AttestationFailureAnalyzerService.
+func (c *Client) NegotiateImageUpload(ctx context.Context, request *afas.NegotiateImageUploadRequest) (*afas.NegotiateImageUploadResult_, error) {
+	return callWithResult(ctx, c, "NegotiateImageUpload", true, func(ctx context.Context, backend afas.AttestationFailureAnalyzerService) (*afas.NegotiateImageUploadResult_, error) {
+		return backend.NegotiateImageUpload(ctx, request)
+	})
+}
+
+// InsertOriginalFirmware implements afas.AttestationFailureAnalyzerService.
+func (c *Client) InsertOriginalFirmware(ctx context.Context, request *afas.InsertOriginalFirmwareRequest) (*afas.InsertOriginalFirmwareResult_, error) {
+	return callWithResult(ctx, c, "InsertOriginalFirmware", false, func(ctx context.Context, backend afas.AttestationFailureAnalyzerService) (*afas.InsertOriginalFirmwareResult_, error) {
+		return backend.InsertOriginalFirmware(ctx, request)
+	})
+}
+
+// UpdateOriginalFirmware implements afas.AttestationFailureAnalyzerService.
+func (c *Client) UpdateOriginalFirmware(ctx context.Context, request *afas.UpdateOriginalFirmwareRequest) error {
+	return c.call(ctx, "UpdateOriginalFirmware", false, func(ctx context.Context, backend afas.AttestationFailureAnalyzerService) error {
+		return backend.UpdateOriginalFirmware(ctx, request)
+	})
+}
+
+// DeleteOriginalFirmware implements afas.AttestationFailureAnalyzerService.
+func (c *Client) DeleteOriginalFirmware(ctx context.Context, request *afas.DeleteOriginalFirmwareRequest) error {
+	return c.call(ctx, "DeleteOriginalFirmware", false, func(ctx context.Context, backend afas.AttestationFailureAnalyzerService) error {
+		return backend.DeleteOriginalFirmware(ctx, request)
+	})
+}
+
+// ListTamperingRegistryEntries implements afas.AttestationFailureAnalyzerService.
+func (c *Client) ListTamperingRegistryEntries(ctx context.Context, request *afas.ListTamperingRegistryEntriesRequest) (*afas.ListTamperingRegistryEntriesResult_, error) {
+	return callWithResult(ctx, c, "ListTamperingRegistryEntries", true, func(ctx context.Context, backend afas.AttestationFailureAnalyzerService) (*afas.ListTamperingRegistryEntriesResult_, error) {
+		return backend.ListTamperingRegistryEntries(ctx, request)
+	})
+}
+
+// InsertTamperingRegistryEntry implements afas.AttestationFailureAnalyzerService.
+func (c *Client) InsertTamperingRegistryEntry(ctx context.Context, request *afas.InsertTamperingRegistryEntryRequest) (*afas.InsertTamperingRegistryEntryResult_, error) {
+	return callWithResult(ctx, c, "InsertTamperingRegistryEntry", false, func(ctx context.Context, backend afas.AttestationFailureAnalyzerService) (*afas.InsertTamperingRegistryEntryResult_, error) {
+		return backend.InsertTamperingRegistryEntry(ctx, request)
+	})
+}
+
+// UpdateTamperingRegistryEntry implements afas.AttestationFailureAnalyzerService.
+func (c *Client) UpdateTamperingRegistryEntry(ctx context.Context, request *afas.UpdateTamperingRegistryEntryRequest) error {
+	return c.call(ctx, "UpdateTamperingRegistryEntry", false, func(ctx context.Context, backend afas.AttestationFailureAnalyzerService) error {
+		return backend.UpdateTamperingRegistryEntry(ctx, request)
+	})
+}
+
+// DeleteTamperingRegistryEntry implements afas.AttestationFailureAnalyzerService.
+func (c *Client) DeleteTamperingRegistryEntry(ctx context.Context, request *afas.DeleteTamperingRegistryEntryRequest) error {
+	return c.call(ctx, "DeleteTamperingRegistryEntry", false, func(ctx context.Context, backend afas.AttestationFailureAnalyzerService) error {
+		return backend.DeleteTamperingRegistryEntry(ctx, request)
+	})
+}
@@ -24,6 +24,11 @@ type initConfig struct {
 	Protocol         string
 	RemoteLogLevel   logger.Level
 	LogLocalHostname string
+	RetryPolicy      RetryPolicy
+	EjectionPolicy   EjectionPolicy
+
+	// backendFactory is overridable only for unit-tests.
+	backendFactory backendFactory
 }
 
 // Option is an abstract option for NewClient.
@@ -60,3 +65,24 @@ type OptionLogLocalHostname string
 func (opt OptionLogLocalHostname) apply(config *initConfig) {
 	config.LogLocalHostname = string(opt)
 }
+
+// OptionRetryPolicy is an option to set the policy of retrying failed requests.
+type OptionRetryPolicy RetryPolicy
+
+func (opt OptionRetryPolicy) apply(config *initConfig) {
+	config.RetryPolicy = RetryPolicy(opt)
+}
+
+// OptionEjectionPolicy is an option to set the policy of temporary ejection
+// of unhealthy endpoints.
+type OptionEjectionPolicy EjectionPolicy
+
+func (opt OptionEjectionPolicy) apply(config *initConfig) {
+	config.EjectionPolicy = EjectionPolicy(opt)
+}
+
+type optionBackendFactory backendFactory
+
+func (opt optionBackendFactory) apply(config *initConfig) {
+	config.backendFactory = backendFactory(opt)
+}
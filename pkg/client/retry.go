@@ -0,0 +1,107 @@
+// Copyright 2023 Meta Platforms, Inc. and affiliates.
+//
+// Redistribution and use in source and binary forms, with or without modification, are permitted provided that the following conditions are met:
+//
+// 1. Redistributions of source code must retain the above copyright notice, this list of conditions and the following disclaimer.
+//
+// 2. Redistributions in binary form must reproduce the above copyright notice, this list of conditions and the following disclaimer in the documentation and/or other materials provided with the distribution.
+//
+// 3. Neither the name of the copyright holder nor the names of its contributors may be used to endorse or promote products derived from this software without specific prior written permission.
+//
+// THIS SOFTWARE IS PROVIDED BY THE COPYRIGHT HOLDERS AND CONTRIBUTORS "AS IS" AND ANY EXPRESS OR IMPLIED WARRANTIES, INCLUDING, BUT NOT LIMITED TO, THE IMPLIED WARRANTIES OF MERCHANTABILITY AND FITNESS FOR A PARTICULAR PURPOSE ARE DISCLAIMED. IN NO EVENT SHALL THE COPYRIGHT HOLDER OR CONTRIBUTORS BE LIABLE FOR ANY DIRECT, INDIRECT, INCIDENTAL, SPECIAL, EXEMPLARY, OR CONSEQUENTIAL DAMAGES (INCLUDING, BUT NOT LIMITED TO, PROCUREMENT OF SUBSTITUTE GOODS OR SERVICES; LOSS OF USE, DATA, OR PROFITS; OR BUSINESS INTERRUPTION) HOWEVER CAUSED AND ON ANY THEORY OF LIABILITY, WHETHER IN CONTRACT, STRICT LIABILITY, OR TORT (INCLUDING NEGLIGENCE OR OTHERWISE) ARISING IN ANY WAY OUT OF THE USE OF THIS SOFTWARE, EVEN IF ADVISED OF THE POSSIBILITY OF SUCH DAMAGE.
+
+package client
+
+import (
+	"math"
+	"math/rand"
+	"time"
+)
+
+// RetryPolicy defines how failed requests are retried.
+//
+// Only idempotent requests are retried on errors of class ErrorClassTransport
+// and ErrorClassUnableToGetOriginalFirmware. Non-idempotent requests (like Analyze)
+// are retried only if the connection to the server was not established
+// (and thus the request was not sent). Requests rejected by an overloaded
+// server (ErrorClassOverloaded) are always retried, but not earlier than
+// the server asked to.
+type RetryPolicy struct {
+	// MaxAttempts is the maximal amount of attempts (including the first one)
+	// to perform a request. Values 0 and 1 mean no retries.
+	MaxAttempts uint
+
+	// InitialBackoff is the delay before the first retry.
+	InitialBackoff time.Duration
+
+	// MaxBackoff is the maximal delay between retries.
+	MaxBackoff time.Duration
+
+	// Multiplier is the factor the delay is multiplied by after each retry.
+	Multiplier float64
+
+	// Jitter is the fraction of the delay, which is randomized; for example,
+	// 0.2 means the actual delay is within [0.8*delay, 1.2*delay].
+	Jitter float64
+}
+
+// DefaultRetryPolicy is the RetryPolicy used by default.
+var DefaultRetryPolicy = RetryPolicy{
+	MaxAttempts:    3,
+	InitialBackoff: 200 * time.Millisecond,
+	MaxBackoff:     5 * time.Second,
+	Multiplier:     2,
+	Jitter:         0.2,
+}
+
+// Backoff returns the delay before the retry following the given attempt
+// (starting from 1).
+func (p RetryPolicy) Backoff(attempt uint) time.Duration {
+	if attempt == 0 {
+		attempt = 1
+	}
+	multiplier := p.Multiplier
+	if multiplier < 1 {
+		multiplier = 1
+	}
+	delay := float64(p.InitialBackoff) * math.Pow(multiplier, float64(attempt-1))
+	if p.MaxBackoff > 0 && delay > float64(p.MaxBackoff) {
+		delay = float64(p.MaxBackoff)
+	}
+	if p.Jitter > 0 {
+		delay *= 1 - p.Jitter + 2*p.Jitter*rand.Float64()
+	}
+	return time.Duration(delay)
+}
+
+func (p RetryPolicy) isRetriable(class ErrorClass, idempotent, sent bool) bool {
+	switch class {
+	case ErrorClassTransport:
+		return idempotent || !sent
+	case ErrorClassUnableToGetOriginalFirmware:
+		return idempotent
+	case ErrorClassOverloaded:
+		return true
+	}
+	return false
+}
+
+// EjectionPolicy defines when an endpoint is considered unhealthy
+// and is temporary excluded from the requests.
+//
+// If all the endpoints are ejected then the one which is to be
+// returned back first is used.
+type EjectionPolicy struct {
+	// ConsecutiveFailures is the amount of consecutive transport errors
+	// to eject an endpoint. Zero value disables ejection.
+	ConsecutiveFailures uint
+
+	// Duration is for how long an endpoint is ejected.
+	Duration time.Duration
+}
+
+// DefaultEjectionPolicy is the EjectionPolicy used by default.
+var DefaultEjectionPolicy = EjectionPolicy{
+	ConsecutiveFailures: 3,
+	Duration:            30 * time.Second,
+}
@@ -14,9 +14,13 @@ package commands
 
 import (
 	"github.com/immune-gmbh/AttestationFailureAnalysisService/pkg/firmwarewand"
+	"github.com/immune-gmbh/AttestationFailureAnalysisService/pkg/hwaccess"
 )
 
 type Config struct {
 	IsQuiet             bool
 	FirmwareWandOptions []firmwarewand.Option
+
+	// Hardware is the hardware to collect the data from (usually hwaccess.Local).
+	Hardware hwaccess.Interface
 }
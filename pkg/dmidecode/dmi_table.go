@@ -16,8 +16,10 @@ import (
 	"bytes"
 	"fmt"
 	"io"
+	"os"
 
 	"github.com/digitalocean/go-smbios/smbios"
+	"github.com/immune-gmbh/AttestationFailureAnalysisService/pkg/hwaccess"
 	"github.com/immune-gmbh/AttestationFailureAnalysisService/pkg/uefi"
 	"github.com/linuxboot/fiano/pkg/guid"
 	fianoUEFI "github.com/linuxboot/fiano/pkg/uefi"
@@ -39,6 +41,21 @@ func LocalDMITable() (*DMITable, error) {
 	return &DMITable{DMITable: dmit}, nil
 }
 
+// DMITableFromHardware returns the DMI table of the hardware `hw`
+// (see hwaccess.PathSMBIOS).
+func DMITableFromHardware(hw hwaccess.Interface) (*DMITable, error) {
+	if hw.IsHost() {
+		return LocalDMITable()
+	}
+
+	smbiosPath := hw.Path(hwaccess.PathSMBIOS)
+	smbiosData, err := os.ReadFile(smbiosPath)
+	if err != nil {
+		return nil, fmt.Errorf("unable to read '%s': %w", smbiosPath, err)
+	}
+	return DMITableFromSMBIOSData(bytes.NewReader(smbiosData))
+}
+
 // DMITableFromSMBIOSData returns a parsed instance on DMI table based only on
 // SMBIOS data passes as `smbiosReader` argument.
 func DMITableFromSMBIOSData(smbiosReader io.Reader) (*DMITable, error) {
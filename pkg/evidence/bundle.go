@@ -0,0 +1,179 @@
+// Copyright 2023 Meta Platforms, Inc. and affiliates.
+//
+// Redistribution and use in source and binary forms, with or without modification, are permitted provided that the following conditions are met:
+//
+// 1. Redistributions of source code must retain the above copyright notice, this list of conditions and the following disclaimer.
+//
+// 2. Redistributions in binary form must reproduce the above copyright notice, this list of conditions and the following disclaimer in the documentation and/or other materials provided with the distribution.
+//
+// 3. Neither the name of the copyright holder nor the names of its contributors may be used to endorse or promote products derived from this software without specific prior written permission.
+//
+// THIS SOFTWARE IS PROVIDED BY THE COPYRIGHT HOLDERS AND CONTRIBUTORS "AS IS" AND ANY EXPRESS OR IMPLIED WARRANTIES, INCLUDING, BUT NOT LIMITED TO, THE IMPLIED WARRANTIES OF MERCHANTABILITY AND FITNESS FOR A PARTICULAR PURPOSE ARE DISCLAIMED. IN NO EVENT SHALL THE COPYRIGHT HOLDER OR CONTRIBUTORS BE LIABLE FOR ANY DIRECT, INDIRECT, INCIDENTAL, SPECIAL, EXEMPLARY, OR CONSEQUENTIAL DAMAGES (INCLUDING, BUT NOT LIMITED TO, PROCUREMENT OF SUBSTITUTE GOODS OR SERVICES; LOSS OF USE, DATA, OR PROFITS; OR BUSINESS INTERRUPTION) HOWEVER CAUSED AND ON ANY THEORY OF LIABILITY, WHETHER IN CONTRACT, STRICT LIABILITY, OR TORT (INCLUDING NEGLIGENCE OR OTHERWISE) ARISING IN ANY WAY OUT OF THE USE OF THIS SOFTWARE, EVEN IF ADVISED OF THE POSSIBILITY OF SUCH DAMAGE.
+
+// Package evidence implements the evidence bundle: a self-describing
+// versioned archive capturing the attestation state of a host (the firmware
+// image, status registers, TPM event log, PCR values, etc).
+//
+// A bundle is a tar.gz or zip archive with file "manifest.json" in the root,
+// which describes the format version and lists all the other files together
+// with their kinds, sizes and SHA256 hashes.
+package evidence
+
+import (
+	"bytes"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"time"
+
+	"github.com/9elements/converged-security-suite/v2/pkg/registers"
+	"github.com/9elements/converged-security-suite/v2/pkg/tpmdetection"
+	"github.com/9elements/converged-security-suite/v2/pkg/tpmeventlog"
+	"github.com/google/go-tpm/tpm2"
+
+	"github.com/immune-gmbh/AttestationFailureAnalysisService/if/generated/afas"
+	"github.com/immune-gmbh/AttestationFailureAnalysisService/pkg/dmidecode"
+)
+
+// FormatVersion is the version of the bundle format produced by this package.
+// Bundles of newer versions are rejected by Read.
+const FormatVersion = 1
+
+// ManifestPath is the path of the manifest inside a bundle.
+const ManifestPath = "manifest.json"
+
+// EntryKind defines the kind of data stored in a file of a bundle.
+type EntryKind string
+
+const (
+	// EntryKindFirmwareImage is the raw firmware image.
+	EntryKindFirmwareImage = EntryKind("firmware_image")
+	// EntryKindStatusRegisters is the JSON-serialized registers.Registers.
+	EntryKindStatusRegisters = EntryKind("status_registers")
+	// EntryKindTPMEventLog is the binary TPM event log.
+	EntryKindTPMEventLog = EntryKind("tpm_eventlog")
+	// EntryKindTPM is the JSON-serialized TPMState.
+	EntryKindTPM = EntryKind("tpm")
+	// EntryKindSMBIOS is the raw SMBIOS (DMI) table.
+	EntryKindSMBIOS = EntryKind("smbios")
+	// EntryKindHostInfo is the JSON-serialized afas.HostInfo.
+	EntryKindHostInfo = EntryKind("host_info")
+)
+
+var entryPaths = map[EntryKind]string{
+	EntryKindFirmwareImage:   "firmware.bin",
+	EntryKindStatusRegisters: "status_registers.json",
+	EntryKindTPMEventLog:     "tpm_eventlog.bin",
+	EntryKindTPM:             "tpm.json",
+	EntryKindSMBIOS:          "smbios.bin",
+	EntryKindHostInfo:        "host_info.json",
+}
+
+// Manifest describes the content of a bundle.
+type Manifest struct {
+	FormatVersion uint            `json:"format_version"`
+	CreatedAt     time.Time       `json:"created_at"`
+	Generator     string          `json:"generator,omitempty"`
+	Entries       []ManifestEntry `json:"entries"`
+}
+
+// ManifestEntry describes a file of a bundle.
+type ManifestEntry struct {
+	Kind   EntryKind `json:"kind"`
+	Path   string    `json:"path"`
+	Size   int64     `json:"size"`
+	SHA256 HexBytes  `json:"sha256"`
+}
+
+// HexBytes is a byte slice serialized to JSON as a hex string.
+type HexBytes []byte
+
+// MarshalJSON implements json.Marshaler.
+func (b HexBytes) MarshalJSON() ([]byte, error) {
+	return json.Marshal(hex.EncodeToString(b))
+}
+
+// UnmarshalJSON implements json.Unmarshaler.
+func (b *HexBytes) UnmarshalJSON(data []byte) error {
+	var s string
+	if err := json.Unmarshal(data, &s); err != nil {
+		return err
+	}
+	decoded, err := hex.DecodeString(s)
+	if err != nil {
+		return err
+	}
+	*b = decoded
+	return nil
+}
+
+// PCR is a value of a PCR register in a specific bank.
+type PCR struct {
+	Index uint32   `json:"index"`
+	Bank  string   `json:"bank"`
+	Value HexBytes `json:"value"`
+}
+
+// TPMState is the content of the EntryKindTPM file.
+type TPMState struct {
+	Device string `json:"device"`
+	PCRs   []PCR  `json:"pcrs,omitempty"`
+}
+
+// Bundle is the parsed content of an evidence bundle. Empty fields are
+// not stored.
+type Bundle struct {
+	Generator       string
+	CreatedAt       time.Time
+	FirmwareImage   []byte
+	StatusRegisters registers.Registers
+	TPMEventLog     []byte
+	TPMDevice       *tpmdetection.Type
+	PCRs            []PCR
+	SMBIOS          []byte
+	HostInfo        *afas.HostInfo
+}
+
+// PCR returns the value of the PCR with the given index in the given bank
+// or nil if there is no such value.
+func (bundle *Bundle) PCR(index uint32, bank tpm2.Algorithm) []byte {
+	for _, pcr := range bundle.PCRs {
+		if pcr.Index == index && pcr.Bank == bank.String() {
+			return pcr.Value
+		}
+	}
+	return nil
+}
+
+// PCR0 returns the value of PCR0 preferring the SHA256 bank. Returns nil
+// if there is no PCR0 value.
+func (bundle *Bundle) PCR0() []byte {
+	for _, bank := range []tpm2.Algorithm{tpm2.AlgSHA256, tpm2.AlgSHA1} {
+		if value := bundle.PCR(0, bank); value != nil {
+			return value
+		}
+	}
+	return nil
+}
+
+// ParseTPMEventLog returns the parsed TPM event log or nil if there is
+// no event log in the bundle.
+func (bundle *Bundle) ParseTPMEventLog() (*tpmeventlog.TPMEventLog, error) {
+	if len(bundle.TPMEventLog) == 0 {
+		return nil, nil
+	}
+	eventLog, err := tpmeventlog.Parse(bytes.NewReader(bundle.TPMEventLog))
+	if err != nil {
+		return nil, fmt.Errorf("unable to parse the TPM event log: %w", err)
+	}
+	return eventLog, nil
+}
+
+// DMITable returns the parsed SMBIOS table or nil if there is no SMBIOS
+// table in the bundle.
+func (bundle *Bundle) DMITable() (*dmidecode.DMITable, error) {
+	if len(bundle.SMBIOS) == 0 {
+		return nil, nil
+	}
+	return dmidecode.DMITableFromSMBIOSData(bytes.NewReader(bundle.SMBIOS))
+}
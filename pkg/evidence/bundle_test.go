@@ -0,0 +1,94 @@
+// Copyright 2023 Meta Platforms, Inc. and affiliates.
+//
+// Redistribution and use in source and binary forms, with or without modification, are permitted provided that the following conditions are met:
+//
+// 1. Redistributions of source code must retain the above copyright notice, this list of conditions and the following disclaimer.
+//
+// 2. Redistributions in binary form must reproduce the above copyright notice, this list of conditions and the following disclaimer in the documentation and/or other materials provided with the distribution.
+//
+// 3. Neither the name of the copyright holder nor the names of its contributors may be used to endorse or promote products derived from this software without specific prior written permission.
+//
+// THIS SOFTWARE IS PROVIDED BY THE COPYRIGHT HOLDERS AND CONTRIBUTORS "AS IS" AND ANY EXPRESS OR IMPLIED WARRANTIES, INCLUDING, BUT NOT LIMITED TO, THE IMPLIED WARRANTIES OF MERCHANTABILITY AND FITNESS FOR A PARTICULAR PURPOSE ARE DISCLAIMED. IN NO EVENT SHALL THE COPYRIGHT HOLDER OR CONTRIBUTORS BE LIABLE FOR ANY DIRECT, INDIRECT, INCIDENTAL, SPECIAL, EXEMPLARY, OR CONSEQUENTIAL DAMAGES (INCLUDING, BUT NOT LIMITED TO, PROCUREMENT OF SUBSTITUTE GOODS OR SERVICES; LOSS OF USE, DATA, OR PROFITS; OR BUSINESS INTERRUPTION) HOWEVER CAUSED AND ON ANY THEORY OF LIABILITY, WHETHER IN CONTRACT, STRICT LIABILITY, OR TORT (INCLUDING NEGLIGENCE OR OTHERWISE) ARISING IN ANY WAY OUT OF THE USE OF THIS SOFTWARE, EVEN IF ADVISED OF THE POSSIBILITY OF SUCH DAMAGE.
+
+package evidence
+
+import (
+	"bytes"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/9elements/converged-security-suite/v2/pkg/registers"
+	"github.com/9elements/converged-security-suite/v2/pkg/tpmdetection"
+	"github.com/google/go-tpm/tpm2"
+	"github.com/stretchr/testify/require"
+
+	"github.com/immune-gmbh/AttestationFailureAnalysisService/if/generated/afas"
+)
+
+func testBundle() *Bundle {
+	tpmDevice := tpmdetection.TypeTPM20
+	hostname := "host0"
+	return &Bundle{
+		Generator:     "test",
+		CreatedAt:     time.Unix(1700000000, 0).UTC(),
+		FirmwareImage: []byte("firmware image"),
+		StatusRegisters: registers.Registers{
+			registers.ParseBootGuardPBEC(0x1),
+			registers.ParseBTGSACMInfo(0x30),
+		},
+		TPMEventLog: []byte("event log"),
+		TPMDevice:   &tpmDevice,
+		PCRs: []PCR{
+			{Index: 0, Bank: tpm2.AlgSHA1.String(), Value: bytes.Repeat([]byte{1}, 20)},
+			{Index: 0, Bank: tpm2.AlgSHA256.String(), Value: bytes.Repeat([]byte{2}, 32)},
+		},
+		SMBIOS:   []byte("smbios"),
+		HostInfo: &afas.HostInfo{Hostname: &hostname},
+	}
+}
+
+func TestWriteRead(t *testing.T) {
+	for _, format := range []ArchiveFormat{ArchiveFormatTarGZ, ArchiveFormatZip} {
+		var buf bytes.Buffer
+		require.NoError(t, testBundle().Write(&buf, format))
+
+		bundle, err := Read(buf.Bytes())
+		require.NoError(t, err)
+		require.Equal(t, testBundle(), bundle)
+		require.Equal(t, bytes.Repeat([]byte{2}, 32), bundle.PCR0())
+		require.Equal(t, bytes.Repeat([]byte{1}, 20), bundle.PCR(0, tpm2.AlgSHA1))
+		require.Nil(t, bundle.PCR(1, tpm2.AlgSHA1))
+	}
+}
+
+func TestReadHashMismatch(t *testing.T) {
+	bundle := testBundle()
+	files, err := bundle.files()
+	require.NoError(t, err)
+
+	// the manifest claims a different hash of the firmware image
+	var buf bytes.Buffer
+	files[0].Data = []byte("tampered image")
+	manifest := []byte(`{"format_version":1,"entries":[` +
+		`{"kind":"firmware_image","path":"firmware.bin","size":14,` +
+		`"sha256":"0000000000000000000000000000000000000000000000000000000000000000"}]}`)
+	require.NoError(t, writeZip(&buf, []bundleFile{
+		{Entry: ManifestEntry{Path: ManifestPath}, Data: manifest},
+		files[0],
+	}, time.Now()))
+
+	_, err = Read(buf.Bytes())
+	require.True(t, errors.As(err, &ErrHashMismatch{}), err)
+}
+
+func TestReadUnsupportedVersion(t *testing.T) {
+	var buf bytes.Buffer
+	require.NoError(t, writeTarGZ(&buf, []bundleFile{{
+		Entry: ManifestEntry{Path: ManifestPath},
+		Data:  []byte(`{"format_version":2}`),
+	}}, time.Now()))
+
+	_, err := Read(buf.Bytes())
+	require.True(t, errors.As(err, &ErrUnsupportedVersion{}), err)
+}
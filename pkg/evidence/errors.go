@@ -0,0 +1,50 @@
+// Copyright 2023 Meta Platforms, Inc. and affiliates.
+//
+// Redistribution and use in source and binary forms, with or without modification, are permitted provided that the following conditions are met:
+//
+// 1. Redistributions of source code must retain the above copyright notice, this list of conditions and the following disclaimer.
+//
+// 2. Redistributions in binary form must reproduce the above copyright notice, this list of conditions and the following disclaimer in the documentation and/or other materials provided with the distribution.
+//
+// 3. Neither the name of the copyright holder nor the names of its contributors may be used to endorse or promote products derived from this software without specific prior written permission.
+//
+// THIS SOFTWARE IS PROVIDED BY THE COPYRIGHT HOLDERS AND CONTRIBUTORS "AS IS" AND ANY EXPRESS OR IMPLIED WARRANTIES, INCLUDING, BUT NOT LIMITED TO, THE IMPLIED WARRANTIES OF MERCHANTABILITY AND FITNESS FOR A PARTICULAR PURPOSE ARE DISCLAIMED. IN NO EVENT SHALL THE COPYRIGHT HOLDER OR CONTRIBUTORS BE LIABLE FOR ANY DIRECT, INDIRECT, INCIDENTAL, SPECIAL, EXEMPLARY, OR CONSEQUENTIAL DAMAGES (INCLUDING, BUT NOT LIMITED TO, PROCUREMENT OF SUBSTITUTE GOODS OR SERVICES; LOSS OF USE, DATA, OR PROFITS; OR BUSINESS INTERRUPTION) HOWEVER CAUSED AND ON ANY THEORY OF LIABILITY, WHETHER IN CONTRACT, STRICT LIABILITY, OR TORT (INCLUDING NEGLIGENCE OR OTHERWISE) ARISING IN ANY WAY OUT OF THE USE OF THIS SOFTWARE, EVEN IF ADVISED OF THE POSSIBILITY OF SUCH DAMAGE.
+
+package evidence
+
+import (
+	"fmt"
+)
+
+// ErrInvalidBundle implements "error", for the description see Error.
+type ErrInvalidBundle struct {
+	Err error
+}
+
+func (err ErrInvalidBundle) Error() string {
+	return fmt.Sprintf("invalid evidence bundle: %v", err.Err)
+}
+
+func (err ErrInvalidBundle) Unwrap() error {
+	return err.Err
+}
+
+// ErrUnsupportedVersion implements "error", for the description see Error.
+type ErrUnsupportedVersion struct {
+	Version uint
+}
+
+func (err ErrUnsupportedVersion) Error() string {
+	return fmt.Sprintf("unsupported evidence bundle format version %d (the latest supported is %d)", err.Version, FormatVersion)
+}
+
+// ErrHashMismatch implements "error", for the description see Error.
+type ErrHashMismatch struct {
+	Path     string
+	Expected []byte
+	Actual   []byte
+}
+
+func (err ErrHashMismatch) Error() string {
+	return fmt.Sprintf("SHA256 of file '%s' mismatch: expected %X, actual %X", err.Path, err.Expected, err.Actual)
+}
@@ -0,0 +1,198 @@
+// Copyright 2023 Meta Platforms, Inc. and affiliates.
+//
+// Redistribution and use in source and binary forms, with or without modification, are permitted provided that the following conditions are met:
+//
+// 1. Redistributions of source code must retain the above copyright notice, this list of conditions and the following disclaimer.
+//
+// 2. Redistributions in binary form must reproduce the above copyright notice, this list of conditions and the following disclaimer in the documentation and/or other materials provided with the distribution.
+//
+// 3. Neither the name of the copyright holder nor the names of its contributors may be used to endorse or promote products derived from this software without specific prior written permission.
+//
+// THIS SOFTWARE IS PROVIDED BY THE COPYRIGHT HOLDERS AND CONTRIBUTORS "AS IS" AND ANY EXPRESS OR IMPLIED WARRANTIES, INCLUDING, BUT NOT LIMITED TO, THE IMPLIED WARRANTIES OF MERCHANTABILITY AND FITNESS FOR A PARTICULAR PURPOSE ARE DISCLAIMED. IN NO EVENT SHALL THE COPYRIGHT HOLDER OR CONTRIBUTORS BE LIABLE FOR ANY DIRECT, INDIRECT, INCIDENTAL, SPECIAL, EXEMPLARY, OR CONSEQUENTIAL DAMAGES (INCLUDING, BUT NOT LIMITED TO, PROCUREMENT OF SUBSTITUTE GOODS OR SERVICES; LOSS OF USE, DATA, OR PROFITS; OR BUSINESS INTERRUPTION) HOWEVER CAUSED AND ON ANY THEORY OF LIABILITY, WHETHER IN CONTRACT, STRICT LIABILITY, OR TORT (INCLUDING NEGLIGENCE OR OTHERWISE) ARISING IN ANY WAY OUT OF THE USE OF THIS SOFTWARE, EVEN IF ADVISED OF THE POSSIBILITY OF SUCH DAMAGE.
+
+package evidence
+
+import (
+	"archive/tar"
+	"archive/zip"
+	"bytes"
+	"compress/gzip"
+	"crypto/sha256"
+	"encoding/json"
+	"fmt"
+	"io"
+
+	"github.com/9elements/converged-security-suite/v2/pkg/registers"
+	"github.com/9elements/converged-security-suite/v2/pkg/tpmdetection"
+
+	"github.com/immune-gmbh/AttestationFailureAnalysisService/if/generated/afas"
+)
+
+// MaxSize is the maximal total size of uncompressed files of a bundle
+// accepted by Read.
+const MaxSize = 1 << 30
+
+var (
+	gzipMagic = []byte{0x1f, 0x8b}
+	zipMagic  = []byte("PK\x03\x04")
+)
+
+// Read parses a bundle. The format version and the hashes of all
+// the files listed in the manifest are verified. Files of unknown kinds
+// are ignored.
+func Read(data []byte) (*Bundle, error) {
+	var (
+		files map[string][]byte
+		err   error
+	)
+	switch {
+	case bytes.HasPrefix(data, gzipMagic):
+		files, err = readTarGZ(data)
+	case bytes.HasPrefix(data, zipMagic):
+		files, err = readZip(data)
+	default:
+		return nil, ErrInvalidBundle{Err: fmt.Errorf("unknown archive format")}
+	}
+	if err != nil {
+		return nil, ErrInvalidBundle{Err: err}
+	}
+
+	manifestJSON, ok := files[ManifestPath]
+	if !ok {
+		return nil, ErrInvalidBundle{Err: fmt.Errorf("no '%s' found", ManifestPath)}
+	}
+	var manifest Manifest
+	if err := json.Unmarshal(manifestJSON, &manifest); err != nil {
+		return nil, ErrInvalidBundle{Err: fmt.Errorf("unable to parse the manifest: %w", err)}
+	}
+	if manifest.FormatVersion == 0 {
+		return nil, ErrInvalidBundle{Err: fmt.Errorf("format version is not set")}
+	}
+	if manifest.FormatVersion > FormatVersion {
+		return nil, ErrUnsupportedVersion{Version: manifest.FormatVersion}
+	}
+
+	bundle := &Bundle{
+		Generator: manifest.Generator,
+		CreatedAt: manifest.CreatedAt,
+	}
+	for _, entry := range manifest.Entries {
+		fileData, ok := files[entry.Path]
+		if !ok {
+			return nil, ErrInvalidBundle{Err: fmt.Errorf("file '%s' listed in the manifest is not found", entry.Path)}
+		}
+		if int64(len(fileData)) != entry.Size {
+			return nil, ErrInvalidBundle{Err: fmt.Errorf("size of file '%s' mismatch: expected %d, actual %d", entry.Path, entry.Size, len(fileData))}
+		}
+		hash := sha256.Sum256(fileData)
+		if !bytes.Equal(hash[:], entry.SHA256) {
+			return nil, ErrHashMismatch{Path: entry.Path, Expected: entry.SHA256, Actual: hash[:]}
+		}
+		if err := bundle.setEntry(entry.Kind, fileData); err != nil {
+			return nil, ErrInvalidBundle{Err: fmt.Errorf("unable to parse file '%s': %w", entry.Path, err)}
+		}
+	}
+	return bundle, nil
+}
+
+func (bundle *Bundle) setEntry(kind EntryKind, data []byte) error {
+	switch kind {
+	case EntryKindFirmwareImage:
+		bundle.FirmwareImage = data
+	case EntryKindStatusRegisters:
+		regs := registers.Registers{}
+		if err := regs.UnmarshalJSON(data); err != nil {
+			return err
+		}
+		bundle.StatusRegisters = regs
+	case EntryKindTPMEventLog:
+		bundle.TPMEventLog = data
+	case EntryKindTPM:
+		var state TPMState
+		if err := json.Unmarshal(data, &state); err != nil {
+			return err
+		}
+		tpmDevice, err := tpmdetection.FromString(state.Device)
+		if err != nil {
+			return err
+		}
+		bundle.TPMDevice = &tpmDevice
+		bundle.PCRs = state.PCRs
+	case EntryKindSMBIOS:
+		bundle.SMBIOS = data
+	case EntryKindHostInfo:
+		var hostInfo afas.HostInfo
+		if err := json.Unmarshal(data, &hostInfo); err != nil {
+			return err
+		}
+		bundle.HostInfo = &hostInfo
+	}
+	return nil
+}
+
+func readTarGZ(data []byte) (map[string][]byte, error) {
+	gzReader, err := gzip.NewReader(bytes.NewReader(data))
+	if err != nil {
+		return nil, fmt.Errorf("unable to initialize gzip-decompressor: %w", err)
+	}
+	tarReader := tar.NewReader(gzReader)
+
+	files := map[string][]byte{}
+	var totalSize int64
+	for {
+		hdr, err := tarReader.Next()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			return nil, fmt.Errorf("unable to read the tar archive: %w", err)
+		}
+		if hdr.Typeflag != tar.TypeReg {
+			continue
+		}
+		totalSize += hdr.Size
+		if totalSize > MaxSize {
+			return nil, fmt.Errorf("the bundle is too large (more than %d bytes)", MaxSize)
+		}
+		fileData, err := io.ReadAll(tarReader)
+		if err != nil {
+			return nil, fmt.Errorf("unable to read file '%s': %w", hdr.Name, err)
+		}
+		files[hdr.Name] = fileData
+	}
+	return files, nil
+}
+
+func readZip(data []byte) (map[string][]byte, error) {
+	zipReader, err := zip.NewReader(bytes.NewReader(data), int64(len(data)))
+	if err != nil {
+		return nil, fmt.Errorf("unable to open the zip archive: %w", err)
+	}
+
+	files := map[string][]byte{}
+	var totalSize uint64
+	for _, file := range zipReader.File {
+		if file.FileInfo().IsDir() {
+			continue
+		}
+		totalSize += file.UncompressedSize64
+		if totalSize > MaxSize {
+			return nil, fmt.Errorf("the bundle is too large (more than %d bytes)", MaxSize)
+		}
+		fileData, err := readZipFile(file)
+		if err != nil {
+			return nil, fmt.Errorf("unable to read file '%s': %w", file.Name, err)
+		}
+		files[file.Name] = fileData
+	}
+	return files, nil
+}
+
+func readZipFile(file *zip.File) ([]byte, error) {
+	r, err := file.Open()
+	if err != nil {
+		return nil, err
+	}
+	defer r.Close()
+	return io.ReadAll(io.LimitReader(r, int64(file.UncompressedSize64)+1))
+}
@@ -0,0 +1,192 @@
+// Copyright 2023 Meta Platforms, Inc. and affiliates.
+//
+// Redistribution and use in source and binary forms, with or without modification, are permitted provided that the following conditions are met:
+//
+// 1. Redistributions of source code must retain the above copyright notice, this list of conditions and the following disclaimer.
+//
+// 2. Redistributions in binary form must reproduce the above copyright notice, this list of conditions and the following disclaimer in the documentation and/or other materials provided with the distribution.
+//
+// 3. Neither the name of the copyright holder nor the names of its contributors may be used to endorse or promote products derived from this software without specific prior written permission.
+//
+// THIS SOFTWARE IS PROVIDED BY THE COPYRIGHT HOLDERS AND CONTRIBUTORS "AS IS" AND ANY EXPRESS OR IMPLIED WARRANTIES, INCLUDING, BUT NOT LIMITED TO, THE IMPLIED WARRANTIES OF MERCHANTABILITY AND FITNESS FOR A PARTICULAR PURPOSE ARE DISCLAIMED. IN NO EVENT SHALL THE COPYRIGHT HOLDER OR CONTRIBUTORS BE LIABLE FOR ANY DIRECT, INDIRECT, INCIDENTAL, SPECIAL, EXEMPLARY, OR CONSEQUENTIAL DAMAGES (INCLUDING, BUT NOT LIMITED TO, PROCUREMENT OF SUBSTITUTE GOODS OR SERVICES; LOSS OF USE, DATA, OR PROFITS; OR BUSINESS INTERRUPTION) HOWEVER CAUSED AND ON ANY THEORY OF LIABILITY, WHETHER IN CONTRACT, STRICT LIABILITY, OR TORT (INCLUDING NEGLIGENCE OR OTHERWISE) ARISING IN ANY WAY OUT OF THE USE OF THIS SOFTWARE, EVEN IF ADVISED OF THE POSSIBILITY OF SUCH DAMAGE.
+
+package evidence
+
+import (
+	"archive/tar"
+	"archive/zip"
+	"compress/gzip"
+	"crypto/sha256"
+	"encoding/json"
+	"fmt"
+	"io"
+	"path/filepath"
+	"strings"
+	"time"
+
+	"github.com/9elements/converged-security-suite/v2/pkg/tpmdetection"
+)
+
+// ArchiveFormat is the container format of a bundle.
+type ArchiveFormat int
+
+const (
+	// ArchiveFormatTarGZ is a gzip-compressed tar archive.
+	ArchiveFormatTarGZ = ArchiveFormat(iota)
+	// ArchiveFormatZip is a zip archive.
+	ArchiveFormatZip
+)
+
+// ArchiveFormatFromPath returns ArchiveFormatZip for paths with extension
+// ".zip" and ArchiveFormatTarGZ otherwise.
+func ArchiveFormatFromPath(path string) ArchiveFormat {
+	if strings.EqualFold(filepath.Ext(path), ".zip") {
+		return ArchiveFormatZip
+	}
+	return ArchiveFormatTarGZ
+}
+
+type bundleFile struct {
+	Entry ManifestEntry
+	Data  []byte
+}
+
+func (bundle *Bundle) files() ([]bundleFile, error) {
+	var result []bundleFile
+	add := func(kind EntryKind, data []byte) {
+		hash := sha256.Sum256(data)
+		result = append(result, bundleFile{
+			Entry: ManifestEntry{
+				Kind:   kind,
+				Path:   entryPaths[kind],
+				Size:   int64(len(data)),
+				SHA256: hash[:],
+			},
+			Data: data,
+		})
+	}
+
+	if len(bundle.FirmwareImage) != 0 {
+		add(EntryKindFirmwareImage, bundle.FirmwareImage)
+	}
+	if bundle.StatusRegisters != nil {
+		b, err := bundle.StatusRegisters.MarshalJSON()
+		if err != nil {
+			return nil, fmt.Errorf("unable to serialize status registers: %w", err)
+		}
+		add(EntryKindStatusRegisters, b)
+	}
+	if len(bundle.TPMEventLog) != 0 {
+		add(EntryKindTPMEventLog, bundle.TPMEventLog)
+	}
+	if bundle.TPMDevice != nil || len(bundle.PCRs) != 0 {
+		tpmDevice := tpmdetection.TypeNoTPM
+		if bundle.TPMDevice != nil {
+			tpmDevice = *bundle.TPMDevice
+		}
+		b, err := json.MarshalIndent(TPMState{
+			Device: tpmDevice.String(),
+			PCRs:   bundle.PCRs,
+		}, "", " ")
+		if err != nil {
+			return nil, fmt.Errorf("unable to serialize TPM state: %w", err)
+		}
+		add(EntryKindTPM, b)
+	}
+	if len(bundle.SMBIOS) != 0 {
+		add(EntryKindSMBIOS, bundle.SMBIOS)
+	}
+	if bundle.HostInfo != nil {
+		b, err := json.MarshalIndent(bundle.HostInfo, "", " ")
+		if err != nil {
+			return nil, fmt.Errorf("unable to serialize host info: %w", err)
+		}
+		add(EntryKindHostInfo, b)
+	}
+	return result, nil
+}
+
+// Write serializes the bundle into w.
+func (bundle *Bundle) Write(w io.Writer, format ArchiveFormat) error {
+	files, err := bundle.files()
+	if err != nil {
+		return err
+	}
+
+	createdAt := bundle.CreatedAt
+	if createdAt.IsZero() {
+		createdAt = time.Now()
+	}
+	manifest := Manifest{
+		FormatVersion: FormatVersion,
+		CreatedAt:     createdAt.UTC(),
+		Generator:     bundle.Generator,
+	}
+	for _, file := range files {
+		manifest.Entries = append(manifest.Entries, file.Entry)
+	}
+	manifestJSON, err := json.MarshalIndent(manifest, "", " ")
+	if err != nil {
+		return fmt.Errorf("unable to serialize the manifest: %w", err)
+	}
+	files = append([]bundleFile{{
+		Entry: ManifestEntry{Path: ManifestPath},
+		Data:  manifestJSON,
+	}}, files...)
+
+	switch format {
+	case ArchiveFormatTarGZ:
+		return writeTarGZ(w, files, manifest.CreatedAt)
+	case ArchiveFormatZip:
+		return writeZip(w, files, manifest.CreatedAt)
+	}
+	return fmt.Errorf("unknown archive format: %d", format)
+}
+
+func writeTarGZ(w io.Writer, files []bundleFile, modTime time.Time) error {
+	gzWriter := gzip.NewWriter(w)
+	tarWriter := tar.NewWriter(gzWriter)
+	for _, file := range files {
+		err := tarWriter.WriteHeader(&tar.Header{
+			Typeflag: tar.TypeReg,
+			Name:     file.Entry.Path,
+			Size:     int64(len(file.Data)),
+			Mode:     0640,
+			ModTime:  modTime,
+		})
+		if err != nil {
+			return fmt.Errorf("unable to write tar header of '%s': %w", file.Entry.Path, err)
+		}
+		if _, err := tarWriter.Write(file.Data); err != nil {
+			return fmt.Errorf("unable to write '%s' to the tar archive: %w", file.Entry.Path, err)
+		}
+	}
+	if err := tarWriter.Close(); err != nil {
+		return fmt.Errorf("unable to finalize the tar archive: %w", err)
+	}
+	if err := gzWriter.Close(); err != nil {
+		return fmt.Errorf("unable to finalize the gzip stream: %w", err)
+	}
+	return nil
+}
+
+func writeZip(w io.Writer, files []bundleFile, modTime time.Time) error {
+	zipWriter := zip.NewWriter(w)
+	for _, file := range files {
+		fileWriter, err := zipWriter.CreateHeader(&zip.FileHeader{
+			Name:     file.Entry.Path,
+			Method:   zip.Deflate,
+			Modified: modTime,
+		})
+		if err != nil {
+			return fmt.Errorf("unable to create '%s' in the zip archive: %w", file.Entry.Path, err)
+		}
+		if _, err := fileWriter.Write(file.Data); err != nil {
+			return fmt.Errorf("unable to write '%s' to the zip archive: %w", file.Entry.Path, err)
+		}
+	}
+	if err := zipWriter.Close(); err != nil {
+		return fmt.Errorf("unable to finalize the zip archive: %w", err)
+	}
+	return nil
+}
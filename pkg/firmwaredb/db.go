@@ -18,4 +18,30 @@ import (
 
 type DB interface {
 	Get(ctx context.Context, filters ...Filter) ([]*Firmware, error)
+
+	// Insert adds a firmware entry including its targets and measurements.
+	//
+	// The measurement types are referenced by name (FirmwareMeasurementType.Name)
+	// and are created if they do not exist yet.
+	//
+	// On success fw.ID and IDs of the nested entries are set.
+	Insert(ctx context.Context, fw *Firmware) error
+
+	// Update replaces the firmware entry with ID fw.ID including its targets
+	// and measurements.
+	Update(ctx context.Context, fw *Firmware) error
+
+	// Delete removes the firmware entry with the given ID including its targets
+	// and measurements.
+	Delete(ctx context.Context, id int64) error
+
+	// UpsertMeasurements adds the measurements to all firmware entries of the
+	// given version. Existing measurements of the same types are replaced,
+	// the rest are left intact.
+	UpsertMeasurements(ctx context.Context, version string, measurements []*FirmwareMeasurement) error
+
+	// FindMeasurements returns measurements with the given value. The related
+	// firmware entries (including targets) and measurement types (including
+	// metadata) are loaded as well.
+	FindMeasurements(ctx context.Context, value []byte) ([]*FirmwareMeasurement, error)
 }
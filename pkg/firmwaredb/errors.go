@@ -39,3 +39,9 @@ func (e NotFound) String() string {
 }
 
 type ErrNotFound = Err[NotFound]
+
+type InvalidFirmware struct{}
+
+func (InvalidFirmware) String() string { return "invalid firmware entry" }
+
+type ErrInvalidFirmware = Err[InvalidFirmware]
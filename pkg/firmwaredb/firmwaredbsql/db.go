@@ -15,12 +15,9 @@ package firmwaredbsql
 import (
 	"context"
 	"database/sql"
-	"fmt"
-	"strings"
 
 	"github.com/facebookincubator/go-belt/tool/logger"
 	"github.com/immune-gmbh/AttestationFailureAnalysisService/pkg/firmwaredb"
-	"github.com/immune-gmbh/AttestationFailureAnalysisService/pkg/storage/helpers"
 )
 
 type DB struct {
@@ -45,6 +42,24 @@ func New(driverName, dsn string) (*DB, error) {
 	return db, nil
 }
 
+// Ping returns an error if the database is not reachable.
+func (db *DB) Ping(ctx context.Context) error {
+	conn, err := db.newConnection()
+	if err != nil {
+		return ErrConnect{
+			Err: err,
+		}
+	}
+	defer conn.Close()
+
+	if err := conn.PingContext(ctx); err != nil {
+		return ErrPing{
+			Err: err,
+		}
+	}
+	return nil
+}
+
 func (db *DB) ping() error {
 	conn, err := db.newConnection()
 	if err != nil {
@@ -68,26 +83,9 @@ func (db *DB) newConnection() (*sql.DB, error) {
 func (db *DB) Get(ctx context.Context, filters ...Filter) ([]*Firmware, error) {
 	whereCond, args := Filters(filters).WhereCond()
 
-	var columns []string
-	for tableName, obj := range map[string]any{
-		"firmware":                      &Firmware{},
-		"firmware_measurement_type":     &FirmwareMeasurementType{},
-		"firmware_measurement":          &FirmwareMeasurement{},
-		"firmware_measurement_metadata": &FirmwareMeasurementMetadata{},
-		"firmware_target":               &FirmwareTarget{},
-	} {
-		_, _columns, err := helpers.GetValuesAndColumns(obj, nil)
-		if err != nil {
-			return nil, ErrScan{Err: fmt.Errorf("unable to obtain pointers of values to scan to (table: '%s', object `%T`): %w", tableName, obj, err)}
-		}
-		for _, column := range _columns {
-			columns = append(columns, fmt.Sprintf("`%s`.`%s`", tableName, column))
-		}
-	}
-
 	query := "" +
-		fmt.Sprintf("SELECT %s FROM `firmware` ", strings.Join(columns, ",")) +
-		"RIGHT JOIN `firmware_target` ON `firmware_target`.`firmware_id` = `firmware`.`id` " +
+		"SELECT DISTINCT `firmware`.`id` FROM `firmware` " +
+		"LEFT JOIN `firmware_target` ON `firmware_target`.`firmware_id` = `firmware`.`id` " +
 		"LEFT JOIN `firmware_measurement` ON `firmware_measurement`.`firmware_id` = `firmware`.`id` " +
 		"LEFT JOIN `firmware_measurement_type` ON `firmware_measurement_type`.`id` = `firmware_measurement`.`type_id` " +
 		"LEFT JOIN `firmware_measurement_metadata` ON `firmware_measurement_metadata`.`type_id` = `firmware_measurement_type`.`id` " +
@@ -102,90 +100,27 @@ func (db *DB) Get(ctx context.Context, filters ...Filter) ([]*Firmware, error) {
 	}
 	defer conn.Close()
 
-	rows, err := conn.Query(query, args...)
+	rows, err := conn.QueryContext(ctx, query, args...)
 	if err != nil {
 		return nil, ErrQuery{Err: err, Description: UnableToQuery{Query: query, Args: args}}
 	}
-
-	var preFiltered []*Firmware
-
-	type id = int64
-	firmwares := map[id]*Firmware{}
-	firmwareMeasurementTypes := map[id]*FirmwareMeasurementType{}
-	firmwareMeasurements := map[id]*FirmwareMeasurement{}
-	firmwareMeasurementMetadatas := map[id]*FirmwareMeasurementMetadata{}
-	firmwareTargets := map[id]*FirmwareTarget{}
-
-	for {
-		if !rows.Next() {
-			if err := rows.Err(); err != nil {
-				return nil, ErrScan{Err: err}
-			}
-			break
-		}
-
-		var (
-			values []any
-
-			firmware                    Firmware
-			firmwareMeasurementType     FirmwareMeasurementType
-			firmwareMeasurement         FirmwareMeasurement
-			firmwareMeasurementMetadata FirmwareMeasurementMetadata
-			firmwareTarget              FirmwareTarget
-		)
-
-		for tableName, obj := range map[string]any{
-			"firmware":                      &firmware,
-			"firmware_measurement_type":     &firmwareMeasurementType,
-			"firmware_measurement":          &firmwareMeasurement,
-			"firmware_measurement_metadata": &firmwareMeasurementMetadata,
-			"firmware_target":               &firmwareTarget,
-		} {
-			_values, _, err := helpers.GetValuesAndColumns(obj, nil)
-			if err != nil {
-				return nil, ErrScan{Err: fmt.Errorf("unable to obtain pointers of values to scan to (table: '%s', object `%T`): %w", tableName, obj, err)}
-			}
-			values = append(values, _values...)
-		}
-
-		rows.Scan(values)
-
-		firmwarePtr := firmwares[firmware.ID]
-		if firmwarePtr == nil {
-			firmwarePtr = &firmware
-			firmwares[firmware.ID] = firmwarePtr
-			preFiltered = append(preFiltered, firmwarePtr)
-		}
-
-		firmwareMeasurementTypePtr := firmwareMeasurementTypes[firmware.ID]
-		if firmwareMeasurementTypePtr == nil {
-			firmwareMeasurementTypePtr = &firmwareMeasurementType
-			firmwareMeasurementTypes[firmwareMeasurementType.ID] = firmwareMeasurementTypePtr
-		}
-
-		firmwareMeasurementPtr := firmwareMeasurements[firmware.ID]
-		if firmwareMeasurementPtr == nil {
-			firmwareMeasurementPtr = &firmwareMeasurement
-			firmwareMeasurements[firmwareMeasurement.ID] = firmwareMeasurementPtr
-			firmwareMeasurement.FirmwareMeasurementType = firmwareMeasurementTypePtr
-			firmwareMeasurement.Firmware = firmwarePtr
-			firmwarePtr.Measurements = append(firmwarePtr.Measurements, firmwareMeasurementPtr)
-		}
-
-		firmwareTargetPtr := firmwareTargets[firmware.ID]
-		if firmwareTargetPtr == nil {
-			firmwareTargetPtr = &firmwareTarget
-			firmwareTargets[firmwareTarget.ID] = firmwareTargetPtr
-			firmwareTargetPtr.Firmware = firmwarePtr
-			firmwarePtr.Targets = append(firmwarePtr.Targets, firmwareTargetPtr)
+	var ids []int64
+	for rows.Next() {
+		var id int64
+		if err := rows.Scan(&id); err != nil {
+			rows.Close()
+			return nil, ErrScan{Err: err}
 		}
+		ids = append(ids, id)
+	}
+	rows.Close()
+	if err := rows.Err(); err != nil {
+		return nil, ErrScan{Err: err}
+	}
 
-		firmwareMeasurementMetadataPtr := firmwareMeasurementMetadatas[firmwareMeasurementMetadata.ID]
-		if firmwareMeasurementMetadataPtr == nil {
-			firmwareMeasurementMetadataPtr = &firmwareMeasurementMetadata
-			firmwareMeasurementMetadatas[firmwareMeasurementMetadata.ID] = firmwareMeasurementMetadataPtr
-			firmwareMeasurementTypePtr.Metadata = append(firmwareMeasurementTypePtr.Metadata, firmwareMeasurementMetadataPtr)
-		}
+	preFiltered, err := loadFirmwares(ctx, conn, ids)
+	if err != nil {
+		return nil, err
 	}
 
 	// If it was impossible to effectively filter something using SQL WHERE condition,
@@ -202,3 +137,85 @@ func (db *DB) Get(ctx context.Context, filters ...Filter) ([]*Firmware, error) {
 
 	return filtered, nil
 }
+
+// loadFirmwares returns the firmware entries with the given IDs including
+// their targets, measurements, measurement types and metadata.
+func loadFirmwares(ctx context.Context, conn *sql.DB, ids []int64) ([]*Firmware, error) {
+	if len(ids) == 0 {
+		return nil, nil
+	}
+	idList := int64SliceToSQLList(ids)
+
+	firmwares, err := selectRows[Firmware](ctx, conn, "firmware", "`id` IN "+idList)
+	if err != nil {
+		return nil, err
+	}
+	targets, err := selectRows[FirmwareTarget](ctx, conn, "firmware_target", "`firmware_id` IN "+idList)
+	if err != nil {
+		return nil, err
+	}
+	measurements, err := selectRows[FirmwareMeasurement](ctx, conn, "firmware_measurement", "`firmware_id` IN "+idList)
+	if err != nil {
+		return nil, err
+	}
+
+	var typeIDs []int64
+	for _, measurement := range measurements {
+		typeIDs = append(typeIDs, measurement.MeasurementTypeID)
+	}
+	var (
+		measurementTypes []*FirmwareMeasurementType
+		metadatas        []*FirmwareMeasurementMetadata
+	)
+	if len(typeIDs) > 0 {
+		measurementTypes, err = selectRows[FirmwareMeasurementType](ctx, conn, "firmware_measurement_type", "`id` IN "+int64SliceToSQLList(typeIDs))
+		if err != nil {
+			return nil, err
+		}
+		metadatas, err = selectRows[FirmwareMeasurementMetadata](ctx, conn, "firmware_measurement_metadata", "`type_id` IN "+int64SliceToSQLList(typeIDs))
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	linkFirmwareRelations(firmwares, targets, measurements, measurementTypes, metadatas)
+	return firmwares, nil
+}
+
+func linkFirmwareRelations(
+	firmwares []*Firmware,
+	targets []*FirmwareTarget,
+	measurements []*FirmwareMeasurement,
+	measurementTypes []*FirmwareMeasurementType,
+	metadatas []*FirmwareMeasurementMetadata,
+) {
+	firmwareMap := map[int64]*Firmware{}
+	for _, firmware := range firmwares {
+		firmwareMap[firmware.ID] = firmware
+	}
+	for _, target := range targets {
+		if firmware := firmwareMap[target.FirmwareID]; firmware != nil {
+			target.Firmware = firmware
+			firmware.Targets = append(firmware.Targets, target)
+		}
+	}
+
+	measurementTypeMap := map[int64]*FirmwareMeasurementType{}
+	for _, measurementType := range measurementTypes {
+		measurementTypeMap[measurementType.ID] = measurementType
+	}
+	for _, metadata := range metadatas {
+		if measurementType := measurementTypeMap[metadata.MeasurementTypeID]; measurementType != nil {
+			metadata.FirmwareMeasurementType = measurementType
+			measurementType.Metadata = append(measurementType.Metadata, metadata)
+		}
+	}
+
+	for _, measurement := range measurements {
+		measurement.Firmware = firmwareMap[measurement.FirmwareID]
+		measurement.FirmwareMeasurementType = measurementTypeMap[measurement.MeasurementTypeID]
+		if measurement.Firmware != nil {
+			measurement.Firmware.Measurements = append(measurement.Firmware.Measurements, measurement)
+		}
+	}
+}
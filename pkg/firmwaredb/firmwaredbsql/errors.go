@@ -53,3 +53,14 @@ type ErrPing = firmwaredb.Err[UnableToPing]
 type ErrCancelled = firmwaredb.Err[Cancelled]
 type ErrScan = firmwaredb.Err[UnableToScan]
 type ErrQuery = firmwaredb.Err[UnableToQuery]
+
+type UnableToStartTransaction struct{}
+
+func (UnableToStartTransaction) String() string { return "unable to start a transaction" }
+
+type UnableToCommit struct{}
+
+func (UnableToCommit) String() string { return "unable to commit the transaction" }
+
+type ErrStartTransaction = firmwaredb.Err[UnableToStartTransaction]
+type ErrCommit = firmwaredb.Err[UnableToCommit]
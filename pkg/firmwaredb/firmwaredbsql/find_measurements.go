@@ -0,0 +1,116 @@
+// Copyright 2023 Meta Platforms, Inc. and affiliates.
+//
+// Redistribution and use in source and binary forms, with or without modification, are permitted provided that the following conditions are met:
+//
+// 1. Redistributions of source code must retain the above copyright notice, this list of conditions and the following disclaimer.
+//
+// 2. Redistributions in binary form must reproduce the above copyright notice, this list of conditions and the following disclaimer in the documentation and/or other materials provided with the distribution.
+//
+// 3. Neither the name of the copyright holder nor the names of its contributors may be used to endorse or promote products derived from this software without specific prior written permission.
+//
+// THIS SOFTWARE IS PROVIDED BY THE COPYRIGHT HOLDERS AND CONTRIBUTORS "AS IS" AND ANY EXPRESS OR IMPLIED WARRANTIES, INCLUDING, BUT NOT LIMITED TO, THE IMPLIED WARRANTIES OF MERCHANTABILITY AND FITNESS FOR A PARTICULAR PURPOSE ARE DISCLAIMED. IN NO EVENT SHALL THE COPYRIGHT HOLDER OR CONTRIBUTORS BE LIABLE FOR ANY DIRECT, INDIRECT, INCIDENTAL, SPECIAL, EXEMPLARY, OR CONSEQUENTIAL DAMAGES (INCLUDING, BUT NOT LIMITED TO, PROCUREMENT OF SUBSTITUTE GOODS OR SERVICES; LOSS OF USE, DATA, OR PROFITS; OR BUSINESS INTERRUPTION) HOWEVER CAUSED AND ON ANY THEORY OF LIABILITY, WHETHER IN CONTRACT, STRICT LIABILITY, OR TORT (INCLUDING NEGLIGENCE OR OTHERWISE) ARISING IN ANY WAY OUT OF THE USE OF THIS SOFTWARE, EVEN IF ADVISED OF THE POSSIBILITY OF SUCH DAMAGE.
+
+package firmwaredbsql
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/facebookincubator/go-belt/tool/logger"
+	"github.com/immune-gmbh/AttestationFailureAnalysisService/pkg/storage/helpers"
+)
+
+// FindMeasurements implements firmwaredb.DB.
+func (db *DB) FindMeasurements(ctx context.Context, value []byte) ([]*FirmwareMeasurement, error) {
+	conn, err := db.newConnection()
+	if err != nil {
+		return nil, ErrConnect{
+			Err: err,
+		}
+	}
+	defer conn.Close()
+
+	measurements, err := selectRows[FirmwareMeasurement](ctx, conn, "firmware_measurement", "`value` = ?", value)
+	if err != nil || len(measurements) == 0 {
+		return nil, err
+	}
+
+	var firmwareIDs, typeIDs []int64
+	for _, measurement := range measurements {
+		firmwareIDs = append(firmwareIDs, measurement.FirmwareID)
+		typeIDs = append(typeIDs, measurement.MeasurementTypeID)
+	}
+
+	firmwares, err := selectRows[Firmware](ctx, conn, "firmware", "`id` IN "+int64SliceToSQLList(firmwareIDs))
+	if err != nil {
+		return nil, err
+	}
+	targets, err := selectRows[FirmwareTarget](ctx, conn, "firmware_target", "`firmware_id` IN "+int64SliceToSQLList(firmwareIDs))
+	if err != nil {
+		return nil, err
+	}
+	measurementTypes, err := selectRows[FirmwareMeasurementType](ctx, conn, "firmware_measurement_type", "`id` IN "+int64SliceToSQLList(typeIDs))
+	if err != nil {
+		return nil, err
+	}
+	metadatas, err := selectRows[FirmwareMeasurementMetadata](ctx, conn, "firmware_measurement_metadata", "`type_id` IN "+int64SliceToSQLList(typeIDs))
+	if err != nil {
+		return nil, err
+	}
+
+	linkFirmwareRelations(firmwares, targets, measurements, measurementTypes, metadatas)
+
+	return measurements, nil
+}
+
+func selectRows[T any](ctx context.Context, conn *sql.DB, tableName string, whereCond string, args ...any) ([]*T, error) {
+	_, columns, err := helpers.GetValuesAndColumns((*T)(nil), nil)
+	if err != nil {
+		return nil, ErrScan{Err: fmt.Errorf("unable to obtain columns (table: '%s', object `%T`): %w", tableName, (*T)(nil), err)}
+	}
+
+	quotedColumns := make([]string, 0, len(columns))
+	for _, column := range columns {
+		quotedColumns = append(quotedColumns, fmt.Sprintf("`%s`", column))
+	}
+	query := fmt.Sprintf("SELECT %s FROM `%s` WHERE %s", strings.Join(quotedColumns, ","), tableName, whereCond)
+	logger.FromCtx(ctx).Debugf("query:'%s', args:%v", query, args)
+
+	rows, err := conn.QueryContext(ctx, query, args...)
+	if err != nil {
+		return nil, ErrQuery{Err: err, Description: UnableToQuery{Query: query, Args: args}}
+	}
+	defer rows.Close()
+
+	var result []*T
+	for rows.Next() {
+		row := new(T)
+		values, _, err := helpers.GetValuesAndColumns(row, nil)
+		if err != nil {
+			return nil, ErrScan{Err: fmt.Errorf("unable to obtain pointers of values to scan to (table: '%s', object `%T`): %w", tableName, row, err)}
+		}
+		if err := rows.Scan(values...); err != nil {
+			return nil, ErrScan{Err: err}
+		}
+		result = append(result, row)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, ErrScan{Err: err}
+	}
+	return result, nil
+}
+
+func int64SliceToSQLList(in []int64) string {
+	if len(in) == 0 {
+		return "()"
+	}
+
+	s := make([]string, 0, len(in))
+	for _, v := range in {
+		s = append(s, strconv.FormatInt(v, 10))
+	}
+	return fmt.Sprintf("(%s)", strings.Join(s, ","))
+}
@@ -0,0 +1,310 @@
+// Copyright 2023 Meta Platforms, Inc. and affiliates.
+//
+// Redistribution and use in source and binary forms, with or without modification, are permitted provided that the following conditions are met:
+//
+// 1. Redistributions of source code must retain the above copyright notice, this list of conditions and the following disclaimer.
+//
+// 2. Redistributions in binary form must reproduce the above copyright notice, this list of conditions and the following disclaimer in the documentation and/or other materials provided with the distribution.
+//
+// 3. Neither the name of the copyright holder nor the names of its contributors may be used to endorse or promote products derived from this software without specific prior written permission.
+//
+// THIS SOFTWARE IS PROVIDED BY THE COPYRIGHT HOLDERS AND CONTRIBUTORS "AS IS" AND ANY EXPRESS OR IMPLIED WARRANTIES, INCLUDING, BUT NOT LIMITED TO, THE IMPLIED WARRANTIES OF MERCHANTABILITY AND FITNESS FOR A PARTICULAR PURPOSE ARE DISCLAIMED. IN NO EVENT SHALL THE COPYRIGHT HOLDER OR CONTRIBUTORS BE LIABLE FOR ANY DIRECT, INDIRECT, INCIDENTAL, SPECIAL, EXEMPLARY, OR CONSEQUENTIAL DAMAGES (INCLUDING, BUT NOT LIMITED TO, PROCUREMENT OF SUBSTITUTE GOODS OR SERVICES; LOSS OF USE, DATA, OR PROFITS; OR BUSINESS INTERRUPTION) HOWEVER CAUSED AND ON ANY THEORY OF LIABILITY, WHETHER IN CONTRACT, STRICT LIABILITY, OR TORT (INCLUDING NEGLIGENCE OR OTHERWISE) ARISING IN ANY WAY OUT OF THE USE OF THIS SOFTWARE, EVEN IF ADVISED OF THE POSSIBILITY OF SUCH DAMAGE.
+package firmwaredbsql
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/facebookincubator/go-belt/tool/logger"
+	"github.com/go-sql-driver/mysql"
+	"github.com/immune-gmbh/AttestationFailureAnalysisService/pkg/firmwaredb"
+	"github.com/immune-gmbh/AttestationFailureAnalysisService/pkg/storage/helpers"
+)
+
+// Insert implements firmwaredb.DB.
+func (db *DB) Insert(ctx context.Context, fw *Firmware) error {
+	if err := firmwaredb.Validate(fw); err != nil {
+		return err
+	}
+
+	return db.inTransaction(ctx, func(tx *sql.Tx) error {
+		id, err := insertRow(ctx, tx, "firmware", fw)
+		if err != nil {
+			return err
+		}
+		fw.ID = id
+
+		return insertFirmwareRelations(ctx, tx, fw)
+	})
+}
+
+// Update implements firmwaredb.DB.
+func (db *DB) Update(ctx context.Context, fw *Firmware) error {
+	if err := firmwaredb.Validate(fw); err != nil {
+		return err
+	}
+	if fw.ID == 0 {
+		return firmwaredb.ErrInvalidFirmware{Err: fmt.Errorf("ID is not set")}
+	}
+
+	return db.inTransaction(ctx, func(tx *sql.Tx) error {
+		if err := lockFirmware(ctx, tx, fw.ID); err != nil {
+			return err
+		}
+
+		query := "UPDATE `firmware` SET `type` = ?, `version` = ?, `image_url` = ? WHERE `id` = ?"
+		args := []any{fw.Type, fw.Version, fw.ImageURL, fw.ID}
+		if err := exec(ctx, tx, query, args...); err != nil {
+			return err
+		}
+
+		if err := deleteFirmwareRelations(ctx, tx, fw.ID); err != nil {
+			return err
+		}
+		return insertFirmwareRelations(ctx, tx, fw)
+	})
+}
+
+// Delete implements firmwaredb.DB.
+func (db *DB) Delete(ctx context.Context, id int64) error {
+	return db.inTransaction(ctx, func(tx *sql.Tx) error {
+		if err := lockFirmware(ctx, tx, id); err != nil {
+			return err
+		}
+		if err := deleteFirmwareRelations(ctx, tx, id); err != nil {
+			return err
+		}
+		return exec(ctx, tx, "DELETE FROM `firmware` WHERE `id` = ?", id)
+	})
+}
+
+// UpsertMeasurements implements firmwaredb.DB.
+func (db *DB) UpsertMeasurements(ctx context.Context, version string, measurements []*FirmwareMeasurement) error {
+	return db.inTransaction(ctx, func(tx *sql.Tx) error {
+		query := "SELECT `id` FROM `firmware` WHERE `version` = ? FOR UPDATE"
+		logger.FromCtx(ctx).Debugf("query:'%s', args:%v", query, version)
+		rows, err := tx.QueryContext(ctx, query, version)
+		if err != nil {
+			return ErrQuery{Err: err, Description: UnableToQuery{Query: query, Args: []any{version}}}
+		}
+		var firmwareIDs []int64
+		for rows.Next() {
+			var id int64
+			if err := rows.Scan(&id); err != nil {
+				rows.Close()
+				return ErrScan{Err: err}
+			}
+			firmwareIDs = append(firmwareIDs, id)
+		}
+		rows.Close()
+		if err := rows.Err(); err != nil {
+			return ErrScan{Err: err}
+		}
+		if len(firmwareIDs) == 0 {
+			return firmwaredb.ErrNotFound{Err: sql.ErrNoRows, Description: firmwaredb.NotFound{Filters: Filters{firmwaredb.FilterVersion(version)}}}
+		}
+
+		for _, measurement := range measurements {
+			if err := getOrInsertMeasurementType(ctx, tx, measurement.FirmwareMeasurementType); err != nil {
+				return err
+			}
+			measurement.MeasurementTypeID = measurement.FirmwareMeasurementType.ID
+		}
+
+		for _, firmwareID := range firmwareIDs {
+			for _, measurement := range measurements {
+				query := "DELETE FROM `firmware_measurement` WHERE `firmware_id` = ? AND `type_id` = ?"
+				if err := exec(ctx, tx, query, firmwareID, measurement.MeasurementTypeID); err != nil {
+					return err
+				}
+				row := *measurement
+				row.FirmwareID = firmwareID
+				if _, err := insertRow(ctx, tx, "firmware_measurement", &row); err != nil {
+					return err
+				}
+			}
+		}
+		return nil
+	})
+}
+
+func (db *DB) inTransaction(ctx context.Context, fn func(tx *sql.Tx) error) (retErr error) {
+	conn, err := db.newConnection()
+	if err != nil {
+		return ErrConnect{
+			Err: err,
+		}
+	}
+	defer conn.Close()
+
+	tx, err := conn.BeginTx(ctx, &sql.TxOptions{Isolation: sql.LevelReadCommitted})
+	if err != nil {
+		return ErrStartTransaction{Err: err}
+	}
+	defer func() {
+		if retErr != nil {
+			if rollbackErr := tx.Rollback(); rollbackErr != nil {
+				logger.FromCtx(ctx).Errorf("unable to rollback the transaction: %v", rollbackErr)
+			}
+			return
+		}
+		if err := tx.Commit(); err != nil {
+			retErr = ErrCommit{Err: err}
+		}
+	}()
+
+	return fn(tx)
+}
+
+// lockFirmware write-locks the firmware entry, and returns ErrNotFound if there is no such entry.
+func lockFirmware(ctx context.Context, tx *sql.Tx, id int64) error {
+	query := "SELECT `id` FROM `firmware` WHERE `id` = ? FOR UPDATE"
+	logger.FromCtx(ctx).Debugf("query:'%s', args:%v", query, id)
+
+	var foundID int64
+	err := tx.QueryRowContext(ctx, query, id).Scan(&foundID)
+	switch {
+	case errors.Is(err, sql.ErrNoRows):
+		return firmwaredb.ErrNotFound{Err: err, Description: firmwaredb.NotFound{Filters: Filters{firmwaredb.FilterIDs{id}}}}
+	case err != nil:
+		return ErrQuery{Err: err, Description: UnableToQuery{Query: query, Args: []any{id}}}
+	}
+	return nil
+}
+
+func insertFirmwareRelations(ctx context.Context, tx *sql.Tx, fw *Firmware) error {
+	for _, target := range fw.Targets {
+		target.FirmwareID = fw.ID
+		target.Firmware = fw
+		id, err := insertRow(ctx, tx, "firmware_target", target)
+		if err != nil {
+			return err
+		}
+		target.ID = id
+	}
+
+	for _, measurement := range fw.Measurements {
+		if err := getOrInsertMeasurementType(ctx, tx, measurement.FirmwareMeasurementType); err != nil {
+			return err
+		}
+		measurement.FirmwareID = fw.ID
+		measurement.Firmware = fw
+		measurement.MeasurementTypeID = measurement.FirmwareMeasurementType.ID
+		id, err := insertRow(ctx, tx, "firmware_measurement", measurement)
+		if err != nil {
+			return err
+		}
+		measurement.ID = id
+	}
+
+	return nil
+}
+
+func deleteFirmwareRelations(ctx context.Context, tx *sql.Tx, firmwareID int64) error {
+	for _, tableName := range []string{"firmware_target", "firmware_measurement"} {
+		query := fmt.Sprintf("DELETE FROM `%s` WHERE `firmware_id` = ?", tableName)
+		if err := exec(ctx, tx, query, firmwareID); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// getOrInsertMeasurementType sets the ID of the measurement type with the same name,
+// or inserts the measurement type (including its metadata) if there is no such yet.
+//
+// The name is a unique key, so if a concurrent transaction inserts the same
+// type first, then the insertion fails as a duplicate and the type inserted
+// by that transaction is used.
+func getOrInsertMeasurementType(ctx context.Context, tx *sql.Tx, measurementType *FirmwareMeasurementType) error {
+	found, err := findMeasurementTypeID(ctx, tx, measurementType)
+	if err != nil || found {
+		return err
+	}
+
+	measurementType.ID, err = insertRow(ctx, tx, "firmware_measurement_type", measurementType)
+	if err != nil {
+		var mysqlErr *mysql.MySQLError
+		if !errors.As(err, &mysqlErr) || mysqlErr.Number != mysqlErrDuplicateEntry {
+			return err
+		}
+		found, findErr := findMeasurementTypeID(ctx, tx, measurementType)
+		if findErr != nil {
+			return findErr
+		}
+		if !found {
+			return err
+		}
+		return nil
+	}
+	for _, metadata := range measurementType.Metadata {
+		metadata.MeasurementTypeID = measurementType.ID
+		metadata.FirmwareMeasurementType = measurementType
+		metadata.ID, err = insertRow(ctx, tx, "firmware_measurement_metadata", metadata)
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// mysqlErrDuplicateEntry is the MySQL error number of a unique key violation.
+const mysqlErrDuplicateEntry = 1062
+
+// findMeasurementTypeID sets the ID of the measurement type with the same name
+// and returns true, or returns false if there is no such type.
+func findMeasurementTypeID(ctx context.Context, tx *sql.Tx, measurementType *FirmwareMeasurementType) (bool, error) {
+	query := "SELECT `id` FROM `firmware_measurement_type` WHERE `name` = ? LIMIT 1 FOR UPDATE"
+	logger.FromCtx(ctx).Debugf("query:'%s', args:%v", query, measurementType.Name)
+
+	err := tx.QueryRowContext(ctx, query, measurementType.Name).Scan(&measurementType.ID)
+	switch {
+	case err == nil:
+		return true, nil
+	case errors.Is(err, sql.ErrNoRows):
+		return false, nil
+	}
+	return false, ErrQuery{Err: err, Description: UnableToQuery{Query: query, Args: []any{measurementType.Name}}}
+}
+
+func insertRow(ctx context.Context, tx *sql.Tx, tableName string, obj any) (int64, error) {
+	values, columns, err := helpers.GetValuesAndColumns(obj, func(fieldName string, value any) bool {
+		return fieldName == "ID"
+	})
+	if err != nil {
+		return 0, ErrScan{Err: fmt.Errorf("unable to obtain values to insert (table: '%s', object `%T`): %w", tableName, obj, err)}
+	}
+
+	quotedColumns := make([]string, 0, len(columns))
+	for _, column := range columns {
+		quotedColumns = append(quotedColumns, fmt.Sprintf("`%s`", column))
+	}
+	query := fmt.Sprintf(
+		"INSERT INTO `%s` (%s) VALUES (%s)",
+		tableName,
+		strings.Join(quotedColumns, ","),
+		strings.TrimSuffix(strings.Repeat("?,", len(columns)), ","),
+	)
+	logger.FromCtx(ctx).Debugf("query:'%s', args:%v", query, values)
+
+	result, err := tx.ExecContext(ctx, query, values...)
+	if err != nil {
+		return 0, ErrQuery{Err: err, Description: UnableToQuery{Query: query, Args: values}}
+	}
+	id, err := result.LastInsertId()
+	if err != nil {
+		return 0, ErrQuery{Err: fmt.Errorf("unable to get the last inserted ID: %w", err), Description: UnableToQuery{Query: query, Args: values}}
+	}
+	return id, nil
+}
+
+func exec(ctx context.Context, tx *sql.Tx, query string, args ...any) error {
+	logger.FromCtx(ctx).Debugf("query:'%s', args:%v", query, args)
+	if _, err := tx.ExecContext(ctx, query, args...); err != nil {
+		return ErrQuery{Err: err, Description: UnableToQuery{Query: query, Args: args}}
+	}
+	return nil
+}
@@ -0,0 +1,70 @@
+// Copyright 2023 Meta Platforms, Inc. and affiliates.
+//
+// Redistribution and use in source and binary forms, with or without modification, are permitted provided that the following conditions are met:
+//
+// 1. Redistributions of source code must retain the above copyright notice, this list of conditions and the following disclaimer.
+//
+// 2. Redistributions in binary form must reproduce the above copyright notice, this list of conditions and the following disclaimer in the documentation and/or other materials provided with the distribution.
+//
+// 3. Neither the name of the copyright holder nor the names of its contributors may be used to endorse or promote products derived from this software without specific prior written permission.
+//
+// THIS SOFTWARE IS PROVIDED BY THE COPYRIGHT HOLDERS AND CONTRIBUTORS "AS IS" AND ANY EXPRESS OR IMPLIED WARRANTIES, INCLUDING, BUT NOT LIMITED TO, THE IMPLIED WARRANTIES OF MERCHANTABILITY AND FITNESS FOR A PARTICULAR PURPOSE ARE DISCLAIMED. IN NO EVENT SHALL THE COPYRIGHT HOLDER OR CONTRIBUTORS BE LIABLE FOR ANY DIRECT, INDIRECT, INCIDENTAL, SPECIAL, EXEMPLARY, OR CONSEQUENTIAL DAMAGES (INCLUDING, BUT NOT LIMITED TO, PROCUREMENT OF SUBSTITUTE GOODS OR SERVICES; LOSS OF USE, DATA, OR PROFITS; OR BUSINESS INTERRUPTION) HOWEVER CAUSED AND ON ANY THEORY OF LIABILITY, WHETHER IN CONTRACT, STRICT LIABILITY, OR TORT (INCLUDING NEGLIGENCE OR OTHERWISE) ARISING IN ANY WAY OUT OF THE USE OF THIS SOFTWARE, EVEN IF ADVISED OF THE POSSIBILITY OF SUCH DAMAGE.
+
+package firmwaredb
+
+import (
+	"strconv"
+	"strings"
+)
+
+// Keys of FirmwareMeasurementMetadata describing measurement types of
+// expected PCR values.
+const (
+	MeasurementMetadataKeyPCRIndex  = "pcr_index"
+	MeasurementMetadataKeyHashAlgo  = "hash_algo"
+	MeasurementMetadataKeyFlow      = "flow"
+	MeasurementMetadataKeyTPMDevice = "tpm_device"
+	MeasurementMetadataKeyRegisters = "registers"
+)
+
+// MeasurementTypePCR returns the PCR index and the PCR bank (hash algorithm
+// name, e.g. "SHA256") the measurement type is an expected value of.
+//
+// The values are taken from the metadata, and if there is no metadata then
+// derived from the type name. Types registered manually have no metadata, but
+// are named by convention as "PCR<index>_<hash algo>" (e.g. "PCR0_SHA1"), optionally
+// followed by a slash and a suffix (e.g. "PCR0_SHA1/IntelCBnT/0123ABCD").
+//
+// ok is false if the measurement type is not a PCR value.
+func MeasurementTypePCR(measurementType *FirmwareMeasurementType) (pcrIndex int, hashAlgo string, ok bool) {
+	if measurementType == nil {
+		return 0, "", false
+	}
+
+	var pcrIndexString string
+	for _, metadata := range measurementType.Metadata {
+		switch metadata.Key {
+		case MeasurementMetadataKeyPCRIndex:
+			pcrIndexString = metadata.Value
+		case MeasurementMetadataKeyHashAlgo:
+			hashAlgo = metadata.Value
+		}
+	}
+
+	if pcrIndexString == "" || hashAlgo == "" {
+		name, _, _ := strings.Cut(measurementType.Name, "/")
+		if !strings.HasPrefix(name, "PCR") {
+			return 0, "", false
+		}
+		pcrIndexString, hashAlgo, ok = strings.Cut(strings.TrimPrefix(name, "PCR"), "_")
+		if !ok || hashAlgo == "" {
+			return 0, "", false
+		}
+	}
+
+	pcrIndex, err := strconv.Atoi(pcrIndexString)
+	if err != nil || pcrIndex < 0 {
+		return 0, "", false
+	}
+	return pcrIndex, strings.ToUpper(hashAlgo), true
+}
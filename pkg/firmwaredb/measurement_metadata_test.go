@@ -0,0 +1,81 @@
+// Copyright 2023 Meta Platforms, Inc. and affiliates.
+//
+// Redistribution and use in source and binary forms, with or without modification, are permitted provided that the following conditions are met:
+//
+// 1. Redistributions of source code must retain the above copyright notice, this list of conditions and the following disclaimer.
+//
+// 2. Redistributions in binary form must reproduce the above copyright notice, this list of conditions and the following disclaimer in the documentation and/or other materials provided with the distribution.
+//
+// 3. Neither the name of the copyright holder nor the names of its contributors may be used to endorse or promote products derived from this software without specific prior written permission.
+//
+// THIS SOFTWARE IS PROVIDED BY THE COPYRIGHT HOLDERS AND CONTRIBUTORS "AS IS" AND ANY EXPRESS OR IMPLIED WARRANTIES, INCLUDING, BUT NOT LIMITED TO, THE IMPLIED WARRANTIES OF MERCHANTABILITY AND FITNESS FOR A PARTICULAR PURPOSE ARE DISCLAIMED. IN NO EVENT SHALL THE COPYRIGHT HOLDER OR CONTRIBUTORS BE LIABLE FOR ANY DIRECT, INDIRECT, INCIDENTAL, SPECIAL, EXEMPLARY, OR CONSEQUENTIAL DAMAGES (INCLUDING, BUT NOT LIMITED TO, PROCUREMENT OF SUBSTITUTE GOODS OR SERVICES; LOSS OF USE, DATA, OR PROFITS; OR BUSINESS INTERRUPTION) HOWEVER CAUSED AND ON ANY THEORY OF LIABILITY, WHETHER IN CONTRACT, STRICT LIABILITY, OR TORT (INCLUDING NEGLIGENCE OR OTHERWISE) ARISING IN ANY WAY OUT OF THE USE OF THIS SOFTWARE, EVEN IF ADVISED OF THE POSSIBILITY OF SUCH DAMAGE.
+package firmwaredb
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestMeasurementTypePCR(t *testing.T) {
+	type result struct {
+		PCRIndex int
+		HashAlgo string
+		OK       bool
+	}
+	for name, tc := range map[string]struct {
+		MeasurementType *FirmwareMeasurementType
+		Expected        result
+	}{
+		"nil": {
+			MeasurementType: nil,
+		},
+		"metadata": {
+			MeasurementType: &FirmwareMeasurementType{
+				Name: "custom",
+				Metadata: []*FirmwareMeasurementMetadata{
+					{Key: MeasurementMetadataKeyPCRIndex, Value: "7"},
+					{Key: MeasurementMetadataKeyHashAlgo, Value: "SHA256"},
+				},
+			},
+			Expected: result{PCRIndex: 7, HashAlgo: "SHA256", OK: true},
+		},
+		"metadata_over_name": {
+			MeasurementType: &FirmwareMeasurementType{
+				Name: "PCR1_SHA1",
+				Metadata: []*FirmwareMeasurementMetadata{
+					{Key: MeasurementMetadataKeyPCRIndex, Value: "0"},
+					{Key: MeasurementMetadataKeyHashAlgo, Value: "SHA256"},
+				},
+			},
+			Expected: result{PCRIndex: 0, HashAlgo: "SHA256", OK: true},
+		},
+		"registered_name": {
+			MeasurementType: &FirmwareMeasurementType{Name: "PCR0_SHA1"},
+			Expected:        result{PCRIndex: 0, HashAlgo: "SHA1", OK: true},
+		},
+		"registered_name_lowercase": {
+			MeasurementType: &FirmwareMeasurementType{Name: "PCR17_sha256"},
+			Expected:        result{PCRIndex: 17, HashAlgo: "SHA256", OK: true},
+		},
+		"name_with_suffix": {
+			MeasurementType: &FirmwareMeasurementType{Name: "PCR0_SHA256/IntelCBnT/0123456789ABCDEF"},
+			Expected:        result{PCRIndex: 0, HashAlgo: "SHA256", OK: true},
+		},
+		"not_pcr": {
+			MeasurementType: &FirmwareMeasurementType{Name: MeasurementTypeImageSHA256},
+		},
+		"no_algo": {
+			MeasurementType: &FirmwareMeasurementType{Name: "PCR0"},
+		},
+		"invalid_index": {
+			MeasurementType: &FirmwareMeasurementType{Name: "PCRx_SHA1"},
+		},
+	} {
+		t.Run(name, func(t *testing.T) {
+			var r result
+			r.PCRIndex, r.HashAlgo, r.OK = MeasurementTypePCR(tc.MeasurementType)
+			require.Equal(t, tc.Expected, r)
+		})
+	}
+}
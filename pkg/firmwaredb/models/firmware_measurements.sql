@@ -6,7 +6,7 @@ CREATE TABLE IF NOT EXISTS `firmware_measurement_type` (
 	`name` VARCHAR(255) NOT NULL,
 	`description` TEXT DEFAULT NULL,
     PRIMARY KEY (`id`),
-    KEY `name` (`name`)
+    UNIQUE KEY `name` (`name`)
 ) ENGINE=InnoDB DEFAULT CHARSET=UTF8MB4;
 
 CREATE TABLE IF NOT EXISTS `firmware_measurement` (
@@ -16,7 +16,8 @@ CREATE TABLE IF NOT EXISTS `firmware_measurement` (
 	`value` BLOB,
     PRIMARY KEY (`id`),
     KEY `firmware_id` (`firmware_id`, `type_id`),
-    KEY `type_id` (`type_id`, `firmware_id`)
+    KEY `type_id` (`type_id`, `firmware_id`),
+    KEY `value` (`value`(64))
 ) ENGINE=InnoDB DEFAULT CHARSET=UTF8MB4;
 
 CREATE TABLE IF NOT EXISTS `firmware_measurement_metadata` (
@@ -0,0 +1,76 @@
+// Copyright 2023 Meta Platforms, Inc. and affiliates.
+//
+// Redistribution and use in source and binary forms, with or without modification, are permitted provided that the following conditions are met:
+//
+// 1. Redistributions of source code must retain the above copyright notice, this list of conditions and the following disclaimer.
+//
+// 2. Redistributions in binary form must reproduce the above copyright notice, this list of conditions and the following disclaimer in the documentation and/or other materials provided with the distribution.
+//
+// 3. Neither the name of the copyright holder nor the names of its contributors may be used to endorse or promote products derived from this software without specific prior written permission.
+//
+// THIS SOFTWARE IS PROVIDED BY THE COPYRIGHT HOLDERS AND CONTRIBUTORS "AS IS" AND ANY EXPRESS OR IMPLIED WARRANTIES, INCLUDING, BUT NOT LIMITED TO, THE IMPLIED WARRANTIES OF MERCHANTABILITY AND FITNESS FOR A PARTICULAR PURPOSE ARE DISCLAIMED. IN NO EVENT SHALL THE COPYRIGHT HOLDER OR CONTRIBUTORS BE LIABLE FOR ANY DIRECT, INDIRECT, INCIDENTAL, SPECIAL, EXEMPLARY, OR CONSEQUENTIAL DAMAGES (INCLUDING, BUT NOT LIMITED TO, PROCUREMENT OF SUBSTITUTE GOODS OR SERVICES; LOSS OF USE, DATA, OR PROFITS; OR BUSINESS INTERRUPTION) HOWEVER CAUSED AND ON ANY THEORY OF LIABILITY, WHETHER IN CONTRACT, STRICT LIABILITY, OR TORT (INCLUDING NEGLIGENCE OR OTHERWISE) ARISING IN ANY WAY OUT OF THE USE OF THIS SOFTWARE, EVEN IF ADVISED OF THE POSSIBILITY OF SUCH DAMAGE.
+package firmwaredb
+
+import (
+	"fmt"
+	"net/url"
+
+	"github.com/immune-gmbh/AttestationFailureAnalysisService/pkg/firmwaredb/models"
+)
+
+// Validate checks if the firmware entry is consistent enough to be stored.
+func Validate(fw *Firmware) error {
+	if err := validate(fw); err != nil {
+		return ErrInvalidFirmware{Err: err}
+	}
+	return nil
+}
+
+func validate(fw *Firmware) error {
+	if fw == nil {
+		return fmt.Errorf("firmware is nil")
+	}
+	if fw.Type <= models.FirmwareTypeUndefined || fw.Type >= models.EndOfFirmwareType {
+		return fmt.Errorf("unexpected firmware type: %s", fw.Type)
+	}
+	if fw.Version == "" {
+		return fmt.Errorf("version is empty")
+	}
+	if fw.ImageURL != "" {
+		if _, err := url.Parse(fw.ImageURL); err != nil {
+			return fmt.Errorf("invalid image URL '%s': %w", fw.ImageURL, err)
+		}
+	}
+
+	if len(fw.Targets) == 0 {
+		return fmt.Errorf("no targets defined")
+	}
+	for idx, target := range fw.Targets {
+		if target == nil {
+			return fmt.Errorf("target #%d is nil", idx)
+		}
+		if target.ModelID == nil && (target.Hostname == nil || *target.Hostname == "") {
+			return fmt.Errorf("target #%d has neither model ID nor hostname", idx)
+		}
+	}
+
+	measurementTypes := map[string]struct{}{}
+	for idx, measurement := range fw.Measurements {
+		if measurement == nil {
+			return fmt.Errorf("measurement #%d is nil", idx)
+		}
+		if measurement.FirmwareMeasurementType == nil || measurement.FirmwareMeasurementType.Name == "" {
+			return fmt.Errorf("measurement #%d has no type name", idx)
+		}
+		typeName := measurement.FirmwareMeasurementType.Name
+		if _, ok := measurementTypes[typeName]; ok {
+			return fmt.Errorf("measurement type '%s' is defined multiple times", typeName)
+		}
+		measurementTypes[typeName] = struct{}{}
+		if len(measurement.Value) == 0 {
+			return fmt.Errorf("measurement '%s' has an empty value", typeName)
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,59 @@
+// Copyright 2023 Meta Platforms, Inc. and affiliates.
+//
+// Redistribution and use in source and binary forms, with or without modification, are permitted provided that the following conditions are met:
+//
+// 1. Redistributions of source code must retain the above copyright notice, this list of conditions and the following disclaimer.
+//
+// 2. Redistributions in binary form must reproduce the above copyright notice, this list of conditions and the following disclaimer in the documentation and/or other materials provided with the distribution.
+//
+// 3. Neither the name of the copyright holder nor the names of its contributors may be used to endorse or promote products derived from this software without specific prior written permission.
+//
+// THIS SOFTWARE IS PROVIDED BY THE COPYRIGHT HOLDERS AND CONTRIBUTORS "AS IS" AND ANY EXPRESS OR IMPLIED WARRANTIES, INCLUDING, BUT NOT LIMITED TO, THE IMPLIED WARRANTIES OF MERCHANTABILITY AND FITNESS FOR A PARTICULAR PURPOSE ARE DISCLAIMED. IN NO EVENT SHALL THE COPYRIGHT HOLDER OR CONTRIBUTORS BE LIABLE FOR ANY DIRECT, INDIRECT, INCIDENTAL, SPECIAL, EXEMPLARY, OR CONSEQUENTIAL DAMAGES (INCLUDING, BUT NOT LIMITED TO, PROCUREMENT OF SUBSTITUTE GOODS OR SERVICES; LOSS OF USE, DATA, OR PROFITS; OR BUSINESS INTERRUPTION) HOWEVER CAUSED AND ON ANY THEORY OF LIABILITY, WHETHER IN CONTRACT, STRICT LIABILITY, OR TORT (INCLUDING NEGLIGENCE OR OTHERWISE) ARISING IN ANY WAY OUT OF THE USE OF THIS SOFTWARE, EVEN IF ADVISED OF THE POSSIBILITY OF SUCH DAMAGE.
+package firmwaredb
+
+import (
+	"testing"
+
+	"github.com/immune-gmbh/AttestationFailureAnalysisService/pkg/firmwaredb/models"
+	"github.com/stretchr/testify/require"
+)
+
+func TestValidate(t *testing.T) {
+	newFirmware := func() *Firmware {
+		return &Firmware{
+			Type:     models.FirmwareTypeBIOS,
+			Version:  "F20_3A15",
+			ImageURL: "http://orig-fw-repo/F20_3A15.bin",
+			Targets: []*FirmwareTarget{{
+				ModelID: &[]int64{1}[0],
+			}},
+			Measurements: []*FirmwareMeasurement{{
+				FirmwareMeasurementType: &FirmwareMeasurementType{Name: "PCR0_SHA1"},
+				Value:                   []byte{1, 2, 3},
+			}},
+		}
+	}
+
+	require.NoError(t, Validate(newFirmware()))
+
+	for name, modify := range map[string]func(fw *Firmware){
+		"no_version": func(fw *Firmware) { fw.Version = "" },
+		"no_type":    func(fw *Firmware) { fw.Type = models.FirmwareTypeUndefined },
+		"no_targets": func(fw *Firmware) { fw.Targets = nil },
+		"empty_target": func(fw *Firmware) {
+			fw.Targets = append(fw.Targets, &FirmwareTarget{})
+		},
+		"duplicate_measurement": func(fw *Firmware) {
+			fw.Measurements = append(fw.Measurements, fw.Measurements[0])
+		},
+		"empty_measurement": func(fw *Firmware) { fw.Measurements[0].Value = nil },
+	} {
+		t.Run(name, func(t *testing.T) {
+			fw := newFirmware()
+			modify(fw)
+			err := Validate(fw)
+			require.Error(t, err)
+			require.ErrorAs(t, err, &ErrInvalidFirmware{})
+		})
+	}
+}
@@ -0,0 +1,89 @@
+// Copyright 2023 Meta Platforms, Inc. and affiliates.
+//
+// Redistribution and use in source and binary forms, with or without modification, are permitted provided that the following conditions are met:
+//
+// 1. Redistributions of source code must retain the above copyright notice, this list of conditions and the following disclaimer.
+//
+// 2. Redistributions in binary form must reproduce the above copyright notice, this list of conditions and the following disclaimer in the documentation and/or other materials provided with the distribution.
+//
+// 3. Neither the name of the copyright holder nor the names of its contributors may be used to endorse or promote products derived from this software without specific prior written permission.
+//
+// THIS SOFTWARE IS PROVIDED BY THE COPYRIGHT HOLDERS AND CONTRIBUTORS "AS IS" AND ANY EXPRESS OR IMPLIED WARRANTIES, INCLUDING, BUT NOT LIMITED TO, THE IMPLIED WARRANTIES OF MERCHANTABILITY AND FITNESS FOR A PARTICULAR PURPOSE ARE DISCLAIMED. IN NO EVENT SHALL THE COPYRIGHT HOLDER OR CONTRIBUTORS BE LIABLE FOR ANY DIRECT, INDIRECT, INCIDENTAL, SPECIAL, EXEMPLARY, OR CONSEQUENTIAL DAMAGES (INCLUDING, BUT NOT LIMITED TO, PROCUREMENT OF SUBSTITUTE GOODS OR SERVICES; LOSS OF USE, DATA, OR PROFITS; OR BUSINESS INTERRUPTION) HOWEVER CAUSED AND ON ANY THEORY OF LIABILITY, WHETHER IN CONTRACT, STRICT LIABILITY, OR TORT (INCLUDING NEGLIGENCE OR OTHERWISE) ARISING IN ANY WAY OUT OF THE USE OF THIS SOFTWARE, EVEN IF ADVISED OF THE POSSIBILITY OF SUCH DAMAGE.
+
+package firmwarerepo
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strings"
+
+	"github.com/immune-gmbh/AttestationFailureAnalysisService/pkg/blobstorage"
+)
+
+// Backend is a storage of original firmware images (or archives containing them).
+type Backend interface {
+	// Fetch returns the content and the filename of the file referenced by ref.
+	//
+	// ref is either the image URL from the original firmware database or
+	// the firmware version (if the URL is not set). If ref is an absolute URL
+	// the backend cannot serve, then ErrUnsupportedRef is returned.
+	Fetch(ctx context.Context, ref string) ([]byte, string, error)
+
+	// Ping returns an error if the backend is not reachable.
+	Ping(ctx context.Context) error
+
+	// String returns a description of the backend, it is also used
+	// to distinguish cached images of different backends.
+	String() string
+}
+
+// NewBackend returns a Backend depending on the scheme of the URL:
+//
+//   - "http://..." or "https://...": HTTPBackend, the rest of the URLs
+//     (if provided) are used as mirrors;
+//   - "file:///path/to/dir": DirBackend;
+//   - "oci://host/repository" or "oci+http://host/repository": OCIBackend;
+//   - "blobstorage+<blob storage URL>" (e.g. "blobstorage+fs:///srv/images"): BlobStorageBackend.
+//
+// headers are added to each request of network backends (for example to authenticate).
+func NewBackend(urls []string, headers http.Header) (Backend, error) {
+	if len(urls) == 0 {
+		return nil, fmt.Errorf("no URLs provided")
+	}
+
+	parsedURL, err := url.Parse(urls[0])
+	if err != nil {
+		return nil, ErrParseURL{Err: err, URL: urls[0]}
+	}
+	if len(urls) > 1 && parsedURL.Scheme != "http" && parsedURL.Scheme != "https" {
+		return nil, fmt.Errorf("mirrors are supported only for HTTP backends")
+	}
+
+	switch {
+	case parsedURL.Scheme == "http" || parsedURL.Scheme == "https":
+		return NewHTTPBackend(urls, headers), nil
+	case parsedURL.Scheme == "file":
+		return NewDirBackend(parsedURL.Path), nil
+	case parsedURL.Scheme == "oci" || parsedURL.Scheme == "oci+http":
+		return NewOCIBackend(urls[0], headers)
+	case strings.HasPrefix(parsedURL.Scheme, "blobstorage+"):
+		storage, err := blobstorage.New(strings.TrimPrefix(urls[0], "blobstorage+"))
+		if err != nil {
+			return nil, fmt.Errorf("unable to initialize blob storage: %w", err)
+		}
+		return NewBlobStorageBackend(storage), nil
+	}
+	return nil, ErrUnknownBackend{URL: urls[0]}
+}
+
+// parseAbsoluteURL returns the parsed ref if it is an absolute URL
+// (like "https://host/path" or "file:///path"), otherwise nil.
+func parseAbsoluteURL(ref string) *url.URL {
+	parsedRef, err := url.Parse(ref)
+	if err != nil || parsedRef.Scheme == "" || (parsedRef.Host == "" && parsedRef.Scheme != "file") {
+		return nil
+	}
+	return parsedRef
+}
@@ -0,0 +1,62 @@
+// Copyright 2023 Meta Platforms, Inc. and affiliates.
+//
+// Redistribution and use in source and binary forms, with or without modification, are permitted provided that the following conditions are met:
+//
+// 1. Redistributions of source code must retain the above copyright notice, this list of conditions and the following disclaimer.
+//
+// 2. Redistributions in binary form must reproduce the above copyright notice, this list of conditions and the following disclaimer in the documentation and/or other materials provided with the distribution.
+//
+// 3. Neither the name of the copyright holder nor the names of its contributors may be used to endorse or promote products derived from this software without specific prior written permission.
+//
+// THIS SOFTWARE IS PROVIDED BY THE COPYRIGHT HOLDERS AND CONTRIBUTORS "AS IS" AND ANY EXPRESS OR IMPLIED WARRANTIES, INCLUDING, BUT NOT LIMITED TO, THE IMPLIED WARRANTIES OF MERCHANTABILITY AND FITNESS FOR A PARTICULAR PURPOSE ARE DISCLAIMED. IN NO EVENT SHALL THE COPYRIGHT HOLDER OR CONTRIBUTORS BE LIABLE FOR ANY DIRECT, INDIRECT, INCIDENTAL, SPECIAL, EXEMPLARY, OR CONSEQUENTIAL DAMAGES (INCLUDING, BUT NOT LIMITED TO, PROCUREMENT OF SUBSTITUTE GOODS OR SERVICES; LOSS OF USE, DATA, OR PROFITS; OR BUSINESS INTERRUPTION) HOWEVER CAUSED AND ON ANY THEORY OF LIABILITY, WHETHER IN CONTRACT, STRICT LIABILITY, OR TORT (INCLUDING NEGLIGENCE OR OTHERWISE) ARISING IN ANY WAY OUT OF THE USE OF THIS SOFTWARE, EVEN IF ADVISED OF THE POSSIBILITY OF SUCH DAMAGE.
+
+package firmwarerepo
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"path"
+
+	"github.com/immune-gmbh/AttestationFailureAnalysisService/pkg/blobstorage"
+)
+
+// BlobStorageBackend is a Backend, which reads images from a blob storage.
+// The key of a blob is the ref itself.
+type BlobStorageBackend struct {
+	Storage blobstorage.BlobStorage
+}
+
+var _ Backend = (*BlobStorageBackend)(nil)
+
+// NewBlobStorageBackend returns a new instance of BlobStorageBackend.
+func NewBlobStorageBackend(storage blobstorage.BlobStorage) *BlobStorageBackend {
+	return &BlobStorageBackend{
+		Storage: storage,
+	}
+}
+
+// String implements Backend.
+func (b *BlobStorageBackend) String() string {
+	return fmt.Sprintf("blobstorage:%T", b.Storage)
+}
+
+// Ping implements Backend.
+func (b *BlobStorageBackend) Ping(ctx context.Context) error {
+	return b.Storage.Ping(ctx)
+}
+
+// Fetch implements Backend.
+func (b *BlobStorageBackend) Fetch(ctx context.Context, ref string) ([]byte, string, error) {
+	data, err := b.Storage.Get(ctx, []byte(ref))
+	if err != nil {
+		if os.IsNotExist(err) {
+			return nil, "", ErrNotFound{Ref: ref}
+		}
+		return nil, "", fmt.Errorf("unable to get blob '%s': %w", ref, err)
+	}
+	if data == nil {
+		return nil, "", ErrNotFound{Ref: ref}
+	}
+	return data, path.Base(ref), nil
+}
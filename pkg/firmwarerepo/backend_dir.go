@@ -0,0 +1,84 @@
+// Copyright 2023 Meta Platforms, Inc. and affiliates.
+//
+// Redistribution and use in source and binary forms, with or without modification, are permitted provided that the following conditions are met:
+//
+// 1. Redistributions of source code must retain the above copyright notice, this list of conditions and the following disclaimer.
+//
+// 2. Redistributions in binary form must reproduce the above copyright notice, this list of conditions and the following disclaimer in the documentation and/or other materials provided with the distribution.
+//
+// 3. Neither the name of the copyright holder nor the names of its contributors may be used to endorse or promote products derived from this software without specific prior written permission.
+//
+// THIS SOFTWARE IS PROVIDED BY THE COPYRIGHT HOLDERS AND CONTRIBUTORS "AS IS" AND ANY EXPRESS OR IMPLIED WARRANTIES, INCLUDING, BUT NOT LIMITED TO, THE IMPLIED WARRANTIES OF MERCHANTABILITY AND FITNESS FOR A PARTICULAR PURPOSE ARE DISCLAIMED. IN NO EVENT SHALL THE COPYRIGHT HOLDER OR CONTRIBUTORS BE LIABLE FOR ANY DIRECT, INDIRECT, INCIDENTAL, SPECIAL, EXEMPLARY, OR CONSEQUENTIAL DAMAGES (INCLUDING, BUT NOT LIMITED TO, PROCUREMENT OF SUBSTITUTE GOODS OR SERVICES; LOSS OF USE, DATA, OR PROFITS; OR BUSINESS INTERRUPTION) HOWEVER CAUSED AND ON ANY THEORY OF LIABILITY, WHETHER IN CONTRACT, STRICT LIABILITY, OR TORT (INCLUDING NEGLIGENCE OR OTHERWISE) ARISING IN ANY WAY OUT OF THE USE OF THIS SOFTWARE, EVEN IF ADVISED OF THE POSSIBILITY OF SUCH DAMAGE.
+
+package firmwarerepo
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"os"
+	"path"
+	"path/filepath"
+	"strings"
+)
+
+// DirBackend is a Backend, which reads images from a local directory tree.
+type DirBackend struct {
+	Root string
+}
+
+var _ Backend = (*DirBackend)(nil)
+
+// NewDirBackend returns a new instance of DirBackend.
+func NewDirBackend(root string) *DirBackend {
+	return &DirBackend{
+		Root: root,
+	}
+}
+
+// String implements Backend.
+func (b *DirBackend) String() string {
+	return "dir:" + b.Root
+}
+
+// Ping implements Backend.
+func (b *DirBackend) Ping(ctx context.Context) error {
+	stat, err := os.Stat(b.Root)
+	if err != nil {
+		return fmt.Errorf("unable to access the root directory '%s': %w", b.Root, err)
+	}
+	if !stat.IsDir() {
+		return fmt.Errorf("'%s' is not a directory", b.Root)
+	}
+	return nil
+}
+
+// Fetch implements Backend.
+//
+// ref is interpreted as a slash-separated path relative to the root
+// directory, it cannot point outside of the root directory. The only
+// supported absolute URLs are "file://" URLs within the root directory.
+func (b *DirBackend) Fetch(ctx context.Context, ref string) ([]byte, string, error) {
+	relPath := ref
+	if parsedRef := parseAbsoluteURL(ref); parsedRef != nil {
+		if parsedRef.Scheme != "file" {
+			return nil, "", ErrUnsupportedRef{Ref: ref, Backend: b.String()}
+		}
+		var err error
+		relPath, err = filepath.Rel(b.Root, filepath.FromSlash(parsedRef.Path))
+		if err != nil || relPath == ".." || strings.HasPrefix(relPath, ".."+string(filepath.Separator)) {
+			return nil, "", ErrUnsupportedRef{Ref: ref, Backend: b.String()}
+		}
+		relPath = filepath.ToSlash(relPath)
+	}
+
+	filePath := filepath.Join(b.Root, filepath.FromSlash(path.Clean("/"+relPath)))
+	data, err := os.ReadFile(filePath)
+	if err != nil {
+		if errors.Is(err, os.ErrNotExist) {
+			return nil, "", ErrNotFound{Ref: ref}
+		}
+		return nil, "", fmt.Errorf("unable to read file '%s': %w", filePath, err)
+	}
+	return data, filepath.Base(filePath), nil
+}
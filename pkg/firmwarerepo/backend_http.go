@@ -0,0 +1,195 @@
+// Copyright 2023 Meta Platforms, Inc. and affiliates.
+//
+// Redistribution and use in source and binary forms, with or without modification, are permitted provided that the following conditions are met:
+//
+// 1. Redistributions of source code must retain the above copyright notice, this list of conditions and the following disclaimer.
+//
+// 2. Redistributions in binary form must reproduce the above copyright notice, this list of conditions and the following disclaimer in the documentation and/or other materials provided with the distribution.
+//
+// 3. Neither the name of the copyright holder nor the names of its contributors may be used to endorse or promote products derived from this software without specific prior written permission.
+//
+// THIS SOFTWARE IS PROVIDED BY THE COPYRIGHT HOLDERS AND CONTRIBUTORS "AS IS" AND ANY EXPRESS OR IMPLIED WARRANTIES, INCLUDING, BUT NOT LIMITED TO, THE IMPLIED WARRANTIES OF MERCHANTABILITY AND FITNESS FOR A PARTICULAR PURPOSE ARE DISCLAIMED. IN NO EVENT SHALL THE COPYRIGHT HOLDER OR CONTRIBUTORS BE LIABLE FOR ANY DIRECT, INDIRECT, INCIDENTAL, SPECIAL, EXEMPLARY, OR CONSEQUENTIAL DAMAGES (INCLUDING, BUT NOT LIMITED TO, PROCUREMENT OF SUBSTITUTE GOODS OR SERVICES; LOSS OF USE, DATA, OR PROFITS; OR BUSINESS INTERRUPTION) HOWEVER CAUSED AND ON ANY THEORY OF LIABILITY, WHETHER IN CONTRACT, STRICT LIABILITY, OR TORT (INCLUDING NEGLIGENCE OR OTHERWISE) ARISING IN ANY WAY OUT OF THE USE OF THIS SOFTWARE, EVEN IF ADVISED OF THE POSSIBILITY OF SUCH DAMAGE.
+
+package firmwarerepo
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"path"
+	"strings"
+
+	"github.com/facebookincubator/go-belt/tool/logger"
+)
+
+// HTTPBackend is a Backend, which downloads images using HTTP GET requests
+// to baseURL+ref. If there are multiple base URLs (mirrors), they are tried
+// in order until the first success.
+type HTTPBackend struct {
+	BaseURLs []string
+	Headers  http.Header
+	Client   *http.Client
+}
+
+var _ Backend = (*HTTPBackend)(nil)
+
+// NewHTTPBackend returns a new instance of HTTPBackend.
+func NewHTTPBackend(baseURLs []string, headers http.Header) *HTTPBackend {
+	return &HTTPBackend{
+		BaseURLs: baseURLs,
+		Headers:  headers,
+		Client:   http.DefaultClient,
+	}
+}
+
+// String implements Backend.
+func (b *HTTPBackend) String() string {
+	return "http:" + strings.Join(b.BaseURLs, ",")
+}
+
+// Ping implements Backend.
+//
+// The backend is considered reachable if at least one of the base URLs is.
+func (b *HTTPBackend) Ping(ctx context.Context) error {
+	var mErr error
+	for _, baseURL := range b.BaseURLs {
+		err := httpPing(ctx, b.Client, baseURL, b.Headers)
+		if err == nil {
+			return nil
+		}
+		mErr = errors.Join(mErr, err)
+	}
+	if mErr == nil {
+		return fmt.Errorf("no base URLs defined")
+	}
+	return mErr
+}
+
+// Fetch implements Backend.
+//
+// If ref is an absolute HTTP URL, then it is used as is (without mirrors).
+// Headers are sent only if the URL is under one of the base URLs, since
+// image URLs come from the database and may point to arbitrary hosts.
+func (b *HTTPBackend) Fetch(ctx context.Context, ref string) ([]byte, string, error) {
+	if parsedRef := parseAbsoluteURL(ref); parsedRef != nil {
+		if parsedRef.Scheme != "http" && parsedRef.Scheme != "https" {
+			return nil, "", ErrUnsupportedRef{Ref: ref, Backend: b.String()}
+		}
+		var headers http.Header
+		if b.isUnderBaseURL(parsedRef) {
+			headers = b.Headers
+		} else {
+			logger.FromCtx(ctx).Debugf("'%s' is not under the base URLs, fetching it without the configured headers", ref)
+		}
+		data, err := httpGet(ctx, b.Client, ref, headers)
+		if err != nil {
+			return nil, "", err
+		}
+		return data, path.Base(parsedRef.Path), nil
+	}
+
+	var mErr error
+	for _, baseURL := range b.BaseURLs {
+		u := baseURL + ref
+		data, err := httpGet(ctx, b.Client, u, b.Headers)
+		if err == nil {
+			return data, path.Base(u), nil
+		}
+		logger.FromCtx(ctx).Warnf("unable to fetch '%s': %v", u, err)
+		mErr = errors.Join(mErr, err)
+	}
+	if mErr == nil {
+		return nil, "", fmt.Errorf("no base URLs defined")
+	}
+	return nil, "", mErr
+}
+
+// isUnderBaseURL returns true if u has the same scheme and host as one
+// of the base URLs, and the path is within the path of the base URL.
+func (b *HTTPBackend) isUnderBaseURL(u *url.URL) bool {
+	for _, baseURL := range b.BaseURLs {
+		parsedBaseURL, err := url.Parse(baseURL)
+		if err != nil {
+			continue
+		}
+		if !strings.EqualFold(parsedBaseURL.Scheme, u.Scheme) || !strings.EqualFold(parsedBaseURL.Host, u.Host) {
+			continue
+		}
+		basePath := parsedBaseURL.Path
+		if !strings.HasSuffix(basePath, "/") {
+			basePath += "/"
+		}
+		if strings.HasPrefix(path.Clean("/"+u.Path), basePath) {
+			return true
+		}
+	}
+	return false
+}
+
+// httpPing checks if the HTTP server is reachable. Any response except
+// server errors is considered fine, because the URL is not necessary
+// a valid path by itself (for example it is a base URL).
+func httpPing(ctx context.Context, client *http.Client, u string, headers http.Header) error {
+	req, err := http.NewRequestWithContext(ctx, http.MethodHead, u, nil)
+	if err != nil {
+		return ErrHTTPMakeRequest{Err: err, URL: u}
+	}
+	for k, v := range headers {
+		req.Header[k] = v
+	}
+
+	if client == nil {
+		client = http.DefaultClient
+	}
+	resp, err := client.Do(req)
+	if err != nil {
+		return ErrHTTPGet{Err: err, URL: u}
+	}
+	resp.Body.Close()
+
+	if resp.StatusCode >= 500 {
+		return ErrHTTPGet{Err: fmt.Errorf("invalid status code: %d", resp.StatusCode), URL: u}
+	}
+	return nil
+}
+
+func httpGet(ctx context.Context, client *http.Client, u string, headers http.Header) ([]byte, error) {
+	log := logger.FromCtx(ctx)
+	log.Debugf("downloading a file from '%s'", u)
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, u, nil)
+	if err != nil {
+		err = ErrHTTPMakeRequest{Err: err, URL: u}
+		log.Errorf("internal error: %v", err)
+		return nil, err
+	}
+	for k, v := range headers {
+		req.Header[k] = v
+	}
+
+	if client == nil {
+		client = http.DefaultClient
+	}
+	resp, err := client.Do(req)
+	if err != nil {
+		return nil, ErrHTTPGet{Err: err, URL: u}
+	}
+	defer resp.Body.Close()
+	log.Debugf("status code: %d", resp.StatusCode)
+
+	if resp.StatusCode == http.StatusNotFound {
+		return nil, ErrHTTPGet{Err: ErrNotFound{Ref: u}, URL: u}
+	}
+	if resp.StatusCode < 200 || resp.StatusCode > 300 {
+		log.Warnf("invalid response status code: %d", resp.StatusCode)
+		return nil, ErrHTTPGet{Err: fmt.Errorf("invalid status code: %d", resp.StatusCode), URL: u}
+	}
+
+	data, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return nil, ErrHTTPGetBody{Err: err, URL: u}
+	}
+	return data, nil
+}
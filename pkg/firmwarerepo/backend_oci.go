@@ -0,0 +1,181 @@
+// Copyright 2023 Meta Platforms, Inc. and affiliates.
+//
+// Redistribution and use in source and binary forms, with or without modification, are permitted provided that the following conditions are met:
+//
+// 1. Redistributions of source code must retain the above copyright notice, this list of conditions and the following disclaimer.
+//
+// 2. Redistributions in binary form must reproduce the above copyright notice, this list of conditions and the following disclaimer in the documentation and/or other materials provided with the distribution.
+//
+// 3. Neither the name of the copyright holder nor the names of its contributors may be used to endorse or promote products derived from this software without specific prior written permission.
+//
+// THIS SOFTWARE IS PROVIDED BY THE COPYRIGHT HOLDERS AND CONTRIBUTORS "AS IS" AND ANY EXPRESS OR IMPLIED WARRANTIES, INCLUDING, BUT NOT LIMITED TO, THE IMPLIED WARRANTIES OF MERCHANTABILITY AND FITNESS FOR A PARTICULAR PURPOSE ARE DISCLAIMED. IN NO EVENT SHALL THE COPYRIGHT HOLDER OR CONTRIBUTORS BE LIABLE FOR ANY DIRECT, INDIRECT, INCIDENTAL, SPECIAL, EXEMPLARY, OR CONSEQUENTIAL DAMAGES (INCLUDING, BUT NOT LIMITED TO, PROCUREMENT OF SUBSTITUTE GOODS OR SERVICES; LOSS OF USE, DATA, OR PROFITS; OR BUSINESS INTERRUPTION) HOWEVER CAUSED AND ON ANY THEORY OF LIABILITY, WHETHER IN CONTRACT, STRICT LIABILITY, OR TORT (INCLUDING NEGLIGENCE OR OTHERWISE) ARISING IN ANY WAY OUT OF THE USE OF THIS SOFTWARE, EVEN IF ADVISED OF THE POSSIBILITY OF SUCH DAMAGE.
+
+package firmwarerepo
+
+import (
+	"bytes"
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"regexp"
+	"strings"
+)
+
+const (
+	ociMediaTypeImageManifest  = "application/vnd.oci.image.manifest.v1+json"
+	ociMediaTypeDockerManifest = "application/vnd.docker.distribution.manifest.v2+json"
+	ociAnnotationTitle         = "org.opencontainers.image.title"
+)
+
+var ociInvalidTagChars = regexp.MustCompile(`[^a-zA-Z0-9_.-]`)
+
+// OCIBackend is a Backend, which downloads images from an OCI registry
+// (using the distribution API). An image is expected to be stored as the first
+// layer of an artifact manifest tagged by the ref.
+//
+// Only static authentication is supported (through headers, for example
+// "Authorization: Bearer ...").
+type OCIBackend struct {
+	RegistryURL string
+	Repository  string
+	Headers     http.Header
+	Client      *http.Client
+}
+
+var _ Backend = (*OCIBackend)(nil)
+
+// NewOCIBackend returns a new instance of OCIBackend.
+//
+// The URL has format "oci://host[:port]/repository" (the registry is accessed
+// through HTTPS) or "oci+http://host[:port]/repository" (through plain HTTP).
+func NewOCIBackend(ociURL string, headers http.Header) (*OCIBackend, error) {
+	parsedURL, err := url.Parse(ociURL)
+	if err != nil {
+		return nil, ErrParseURL{Err: err, URL: ociURL}
+	}
+
+	scheme := "https"
+	switch parsedURL.Scheme {
+	case "oci":
+	case "oci+http":
+		scheme = "http"
+	default:
+		return nil, fmt.Errorf("unexpected scheme '%s' of an OCI URL", parsedURL.Scheme)
+	}
+
+	repository := strings.Trim(parsedURL.Path, "/")
+	if parsedURL.Host == "" || repository == "" {
+		return nil, fmt.Errorf("expected format 'oci://host/repository', but received '%s'", ociURL)
+	}
+
+	return &OCIBackend{
+		RegistryURL: scheme + "://" + parsedURL.Host,
+		Repository:  repository,
+		Headers:     headers,
+		Client:      http.DefaultClient,
+	}, nil
+}
+
+// String implements Backend.
+func (b *OCIBackend) String() string {
+	return "oci:" + b.RegistryURL + "/" + b.Repository
+}
+
+type ociDescriptor struct {
+	MediaType   string            `json:"mediaType"`
+	Digest      string            `json:"digest"`
+	Size        int64             `json:"size"`
+	Annotations map[string]string `json:"annotations"`
+}
+
+type ociManifest struct {
+	SchemaVersion int             `json:"schemaVersion"`
+	MediaType     string          `json:"mediaType"`
+	Layers        []ociDescriptor `json:"layers"`
+}
+
+// Ping implements Backend.
+func (b *OCIBackend) Ping(ctx context.Context) error {
+	// the base endpoint of the OCI distribution API
+	return httpPing(ctx, b.Client, b.RegistryURL+"/v2/", b.Headers)
+}
+
+// Fetch implements Backend.
+//
+// ref is a tag (characters not allowed in tags are replaced with "_"),
+// or a digest ("sha256:..."). Absolute URLs are not supported.
+func (b *OCIBackend) Fetch(ctx context.Context, ref string) ([]byte, string, error) {
+	if parseAbsoluteURL(ref) != nil {
+		return nil, "", ErrUnsupportedRef{Ref: ref, Backend: b.String()}
+	}
+
+	reference := ref
+	if !strings.HasPrefix(ref, "sha256:") {
+		reference = ociInvalidTagChars.ReplaceAllString(ref, "_")
+	}
+
+	manifest, err := b.getManifest(ctx, reference)
+	if err != nil {
+		return nil, "", err
+	}
+	if len(manifest.Layers) == 0 {
+		return nil, "", fmt.Errorf("OCI manifest '%s' has no layers", reference)
+	}
+	layer := manifest.Layers[0]
+
+	blobURL := fmt.Sprintf("%s/v2/%s/blobs/%s", b.RegistryURL, b.Repository, layer.Digest)
+	data, err := httpGet(ctx, b.Client, blobURL, b.Headers)
+	if err != nil {
+		return nil, "", err
+	}
+	if err := verifyOCIDigest(layer.Digest, data); err != nil {
+		return nil, "", err
+	}
+
+	filename := layer.Annotations[ociAnnotationTitle]
+	if filename == "" {
+		filename = reference
+	}
+	return data, filename, nil
+}
+
+func (b *OCIBackend) getManifest(ctx context.Context, reference string) (*ociManifest, error) {
+	manifestURL := fmt.Sprintf("%s/v2/%s/manifests/%s", b.RegistryURL, b.Repository, reference)
+	headers := http.Header{}
+	for k, v := range b.Headers {
+		headers[k] = v
+	}
+	headers.Set("Accept", ociMediaTypeImageManifest+", "+ociMediaTypeDockerManifest)
+
+	data, err := httpGet(ctx, b.Client, manifestURL, headers)
+	if err != nil {
+		return nil, err
+	}
+
+	var manifest ociManifest
+	if err := json.NewDecoder(io.LimitReader(bytes.NewReader(data), 1<<20)).Decode(&manifest); err != nil {
+		return nil, fmt.Errorf("unable to parse OCI manifest '%s': %w", manifestURL, err)
+	}
+	return &manifest, nil
+}
+
+func verifyOCIDigest(digest string, data []byte) error {
+	algo, expectedHex, ok := strings.Cut(digest, ":")
+	if !ok || algo != "sha256" {
+		return fmt.Errorf("unsupported OCI digest '%s'", digest)
+	}
+	expected, err := hex.DecodeString(expectedHex)
+	if err != nil {
+		return fmt.Errorf("unable to parse OCI digest '%s': %w", digest, err)
+	}
+	actual := sha256.Sum256(data)
+	if !bytes.Equal(expected, actual[:]) {
+		return ErrHashMismatch{HashName: "OCI blob SHA256", Expected: expected, Actual: actual[:]}
+	}
+	return nil
+}
@@ -0,0 +1,89 @@
+// Copyright 2023 Meta Platforms, Inc. and affiliates.
+//
+// Redistribution and use in source and binary forms, with or without modification, are permitted provided that the following conditions are met:
+//
+// 1. Redistributions of source code must retain the above copyright notice, this list of conditions and the following disclaimer.
+//
+// 2. Redistributions in binary form must reproduce the above copyright notice, this list of conditions and the following disclaimer in the documentation and/or other materials provided with the distribution.
+//
+// 3. Neither the name of the copyright holder nor the names of its contributors may be used to endorse or promote products derived from this software without specific prior written permission.
+//
+// THIS SOFTWARE IS PROVIDED BY THE COPYRIGHT HOLDERS AND CONTRIBUTORS "AS IS" AND ANY EXPRESS OR IMPLIED WARRANTIES, INCLUDING, BUT NOT LIMITED TO, THE IMPLIED WARRANTIES OF MERCHANTABILITY AND FITNESS FOR A PARTICULAR PURPOSE ARE DISCLAIMED. IN NO EVENT SHALL THE COPYRIGHT HOLDER OR CONTRIBUTORS BE LIABLE FOR ANY DIRECT, INDIRECT, INCIDENTAL, SPECIAL, EXEMPLARY, OR CONSEQUENTIAL DAMAGES (INCLUDING, BUT NOT LIMITED TO, PROCUREMENT OF SUBSTITUTE GOODS OR SERVICES; LOSS OF USE, DATA, OR PROFITS; OR BUSINESS INTERRUPTION) HOWEVER CAUSED AND ON ANY THEORY OF LIABILITY, WHETHER IN CONTRACT, STRICT LIABILITY, OR TORT (INCLUDING NEGLIGENCE OR OTHERWISE) ARISING IN ANY WAY OUT OF THE USE OF THIS SOFTWARE, EVEN IF ADVISED OF THE POSSIBILITY OF SUCH DAMAGE.
+
+package firmwarerepo
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+)
+
+// diskCache stores fetched firmware images on a local disk. Each image is
+// stored as "<dir>/<key>/<filename>".
+type diskCache struct {
+	dir string
+}
+
+func newDiskCache(dir string) (*diskCache, error) {
+	if err := os.MkdirAll(dir, 0750); err != nil {
+		return nil, fmt.Errorf("unable to create cache directory '%s': %w", dir, err)
+	}
+	return &diskCache{dir: dir}, nil
+}
+
+func (c *diskCache) key(backend Backend, ref string) string {
+	h := sha256.Sum256([]byte(backend.String() + "\x00" + ref))
+	return hex.EncodeToString(h[:])
+}
+
+// Get returns a cached image. If there is no such image then
+// os.ErrNotExist is returned.
+func (c *diskCache) Get(key string) ([]byte, string, error) {
+	entries, err := os.ReadDir(filepath.Join(c.dir, key))
+	if err != nil {
+		return nil, "", err
+	}
+	if len(entries) != 1 || entries[0].IsDir() {
+		return nil, "", fmt.Errorf("invalid cache entry '%s': expected exactly one file", key)
+	}
+	filename := entries[0].Name()
+	data, err := os.ReadFile(filepath.Join(c.dir, key, filename))
+	if err != nil {
+		return nil, "", err
+	}
+	return data, filename, nil
+}
+
+// Put stores an image to the cache. The entry is written to a temporary
+// directory first, so a concurrent Get never sees a partially written image.
+func (c *diskCache) Put(key string, filename string, data []byte) error {
+	tmpDir, err := os.MkdirTemp(c.dir, key+".tmp-")
+	if err != nil {
+		return fmt.Errorf("unable to create a temporary directory: %w", err)
+	}
+	defer os.RemoveAll(tmpDir)
+
+	if filename == "" || filename != filepath.Base(filename) {
+		filename = "image"
+	}
+	if err := os.WriteFile(filepath.Join(tmpDir, filename), data, 0640); err != nil {
+		return fmt.Errorf("unable to write the image: %w", err)
+	}
+
+	entryDir := filepath.Join(c.dir, key)
+	if err := os.RemoveAll(entryDir); err != nil && !errors.Is(err, os.ErrNotExist) {
+		return fmt.Errorf("unable to remove the previous entry: %w", err)
+	}
+	if err := os.Rename(tmpDir, entryDir); err != nil {
+		return fmt.Errorf("unable to move the entry into place: %w", err)
+	}
+	return nil
+}
+
+// Delete removes an image from the cache.
+func (c *diskCache) Delete(key string) error {
+	return os.RemoveAll(filepath.Join(c.dir, key))
+}
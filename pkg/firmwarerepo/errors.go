@@ -62,6 +62,15 @@ func (err ErrNoFirmwareFoundInTarGZ) Error() string {
 	return "nothing in the tar.gz looks like a firmware image"
 }
 
+// ErrNoFirmwareFoundInArchive implements "error", for the description see Error.
+type ErrNoFirmwareFoundInArchive struct {
+	Filename string
+}
+
+func (err ErrNoFirmwareFoundInArchive) Error() string {
+	return fmt.Sprintf("nothing in the archive '%s' looks like a firmware image", err.Filename)
+}
+
 // ErrGetMeta implements "error", for the description see Error.
 type ErrGetMeta struct {
 	Err error
@@ -102,3 +111,43 @@ func (err ErrParseURL) Error() string {
 func (err ErrParseURL) Unwrap() error {
 	return err.Err
 }
+
+// ErrHashMismatch implements "error", for the description see Error.
+type ErrHashMismatch struct {
+	HashName string
+	Expected []byte
+	Actual   []byte
+}
+
+func (err ErrHashMismatch) Error() string {
+	return fmt.Sprintf("%s hash of the firmware image mismatch: expected %X, actual %X",
+		err.HashName, err.Expected, err.Actual)
+}
+
+// ErrNotFound implements "error", for the description see Error.
+type ErrNotFound struct {
+	Ref string
+}
+
+func (err ErrNotFound) Error() string {
+	return fmt.Sprintf("firmware image '%s' not found", err.Ref)
+}
+
+// ErrUnsupportedRef implements "error", for the description see Error.
+type ErrUnsupportedRef struct {
+	Ref     string
+	Backend string
+}
+
+func (err ErrUnsupportedRef) Error() string {
+	return fmt.Sprintf("reference '%s' cannot be fetched from backend '%s'", err.Ref, err.Backend)
+}
+
+// ErrUnknownBackend implements "error", for the description see Error.
+type ErrUnknownBackend struct {
+	URL string
+}
+
+func (err ErrUnknownBackend) Error() string {
+	return fmt.Sprintf("unknown firmware repository backend for URL '%s'", err.URL)
+}
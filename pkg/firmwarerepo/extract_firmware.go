@@ -14,11 +14,13 @@ package firmwarerepo
 
 import (
 	"archive/tar"
+	"archive/zip"
 	"bytes"
 	"compress/gzip"
 	"context"
 	"fmt"
 	"io"
+	"strings"
 
 	amd_manifest "github.com/linuxboot/fiano/pkg/amd/manifest"
 	fiano "github.com/linuxboot/fiano/pkg/uefi"
@@ -108,3 +110,109 @@ func ExtractFirmwareFromTarball(ctx context.Context, tarball []byte) (*uefi.UEFI
 
 	return nil, "", ErrNoFirmwareFoundInTarGZ{}
 }
+
+var (
+	gzipMagic = []byte{0x1f, 0x8b}
+	zipMagic  = []byte("PK\x03\x04")
+	tarMagic  = []byte("ustar")
+)
+
+const tarMagicOffset = 257
+
+// ExtractFirmware returns the firmware image from data. If data is a supported
+// archive (tar, tar.gz, gz or zip), then the first file which looks like
+// a firmware image is returned. Otherwise data is returned as is.
+func ExtractFirmware(ctx context.Context, filename string, data []byte) ([]byte, string, error) {
+	switch {
+	case bytes.HasPrefix(data, gzipMagic):
+		gzReader, err := gzip.NewReader(bytes.NewReader(data))
+		if err != nil {
+			return nil, "", fmt.Errorf("unable to initialize gzip-decompressor: %w", err)
+		}
+		decompressed, err := io.ReadAll(gzReader)
+		if err != nil {
+			return nil, "", fmt.Errorf("unable to decompress '%s': %w", filename, err)
+		}
+		if isTar(decompressed) {
+			return extractFirmwareFromTar(ctx, filename, decompressed)
+		}
+		innerFilename := gzReader.Name
+		if innerFilename == "" {
+			innerFilename = strings.TrimSuffix(filename, ".gz")
+		}
+		return decompressed, innerFilename, nil
+	case bytes.HasPrefix(data, zipMagic):
+		return extractFirmwareFromZip(ctx, filename, data)
+	case isTar(data):
+		return extractFirmwareFromTar(ctx, filename, data)
+	}
+	return data, filename, nil
+}
+
+func isTar(data []byte) bool {
+	return len(data) >= tarMagicOffset+len(tarMagic) &&
+		bytes.Equal(data[tarMagicOffset:tarMagicOffset+len(tarMagic)], tarMagic)
+}
+
+func extractFirmwareFromTar(ctx context.Context, filename string, data []byte) ([]byte, string, error) {
+	log := logger.FromCtx(ctx)
+	tarReader := tar.NewReader(bytes.NewReader(data))
+	for {
+		hdr, err := tarReader.Next()
+		if err != nil {
+			if err != io.EOF {
+				return nil, "", fmt.Errorf("unable to read file headers from the tarball: %w", err)
+			}
+			break
+		}
+		if hdr.Typeflag != tar.TypeReg || hdr.Size < 1024 {
+			log.Debugf("'%s' is not a regular file or is small", hdr.Name)
+			continue
+		}
+
+		fileData, err := io.ReadAll(tarReader)
+		if err != nil {
+			return nil, "", fmt.Errorf("unable to read file '%s': %w", hdr.Name, err)
+		}
+		if ParseFirmwareImage(ctx, fileData) == nil {
+			continue
+		}
+		log.Debugf("%s: a firmware image", hdr.Name)
+		return fileData, hdr.Name, nil
+	}
+	return nil, "", ErrNoFirmwareFoundInArchive{Filename: filename}
+}
+
+func extractFirmwareFromZip(ctx context.Context, filename string, data []byte) ([]byte, string, error) {
+	log := logger.FromCtx(ctx)
+	zipReader, err := zip.NewReader(bytes.NewReader(data), int64(len(data)))
+	if err != nil {
+		return nil, "", fmt.Errorf("unable to open zip archive '%s': %w", filename, err)
+	}
+	for _, file := range zipReader.File {
+		if file.FileInfo().IsDir() || file.UncompressedSize64 < 1024 {
+			log.Debugf("'%s' is a directory or is small", file.Name)
+			continue
+		}
+
+		fileData, err := readZipFile(file)
+		if err != nil {
+			return nil, "", fmt.Errorf("unable to read file '%s': %w", file.Name, err)
+		}
+		if ParseFirmwareImage(ctx, fileData) == nil {
+			continue
+		}
+		log.Debugf("%s: a firmware image", file.Name)
+		return fileData, file.Name, nil
+	}
+	return nil, "", ErrNoFirmwareFoundInArchive{Filename: filename}
+}
+
+func readZipFile(file *zip.File) ([]byte, error) {
+	r, err := file.Open()
+	if err != nil {
+		return nil, err
+	}
+	defer r.Close()
+	return io.ReadAll(r)
+}
@@ -14,20 +14,140 @@ package firmwarerepo
 
 import (
 	"context"
-	"fmt"
-	"io"
-	"net/http"
-	"net/url"
-	"strings"
+	"errors"
+	"os"
+	"time"
 
 	"github.com/facebookincubator/go-belt/beltctx"
+	"github.com/facebookincubator/go-belt/pkg/field"
+	"github.com/facebookincubator/go-belt/tool/experimental/metrics"
 	"github.com/facebookincubator/go-belt/tool/experimental/tracer"
 	"github.com/facebookincubator/go-belt/tool/logger"
+
+	"github.com/immune-gmbh/AttestationFailureAnalysisService/pkg/firmwaredb"
+	"github.com/immune-gmbh/AttestationFailureAnalysisService/pkg/observability"
 )
 
-// DownloadByVersion downloads a raw firmware file by its name
-func (storage *FirmwareRepo) DownloadByVersion(ctx context.Context, filename string) ([]byte, string, error) {
-	return storage.FetchFirmwareByURL(ctx, storage.baseURL+filename)
+// DownloadByVersion returns the original firmware image of the given version.
+func (fwRepo *FirmwareRepo) DownloadByVersion(ctx context.Context, version string) ([]byte, string, error) {
+	ctx = beltctx.WithField(ctx, "firmwareVersion", version)
+
+	startTime := time.Now()
+	imageBytes, filename, cacheHit, err := fwRepo.downloadByVersion(ctx, version)
+	fields := field.Fields{{Key: "outcome", Value: downloadOutcome(cacheHit, err)}}
+	metrics.FromCtx(ctx).CountFields("firmwareRepoDownloads", fields).Add(1)
+	observability.ObserveDuration(ctx, "firmwareRepoDownloadDurationSeconds", fields, time.Since(startTime))
+	return imageBytes, filename, err
+}
+
+// downloadOutcome returns the class of the result of DownloadByVersion for metrics.
+func downloadOutcome(cacheHit bool, err error) string {
+	switch {
+	case err == nil && cacheHit:
+		return "cache_hit"
+	case err == nil:
+		return "ok"
+	case errors.As(err, &ErrNotFound{}):
+		return "not_found"
+	case errors.As(err, &ErrHashMismatch{}):
+		return "hash_mismatch"
+	case errors.As(err, &ErrGetMeta{}):
+		return "meta_error"
+	}
+	return "error"
+}
+
+func (fwRepo *FirmwareRepo) downloadByVersion(ctx context.Context, version string) ([]byte, string, bool, error) {
+	log := logger.FromCtx(ctx)
+
+	ref, expectedHashes, err := fwRepo.resolve(ctx, version)
+	if err != nil {
+		return nil, "", false, err
+	}
+
+	imageBytes, filename, cacheHit, err := fwRepo.downloadByRef(ctx, ref, expectedHashes)
+	if errors.As(err, &ErrUnsupportedRef{}) && ref != version {
+		log.Warnf("%v; fetching by the version instead", err)
+		return fwRepo.downloadByRef(ctx, version, expectedHashes)
+	}
+	return imageBytes, filename, cacheHit, err
+}
+
+func (fwRepo *FirmwareRepo) downloadByRef(
+	ctx context.Context,
+	ref string,
+	expectedHashes ExpectedHashes,
+) ([]byte, string, bool, error) {
+	log := logger.FromCtx(ctx)
+
+	var cacheKey string
+	if fwRepo.cache != nil {
+		cacheKey = fwRepo.cache.key(fwRepo.backend, ref)
+		imageBytes, filename, err := fwRepo.cache.Get(cacheKey)
+		switch {
+		case err == nil:
+			verifyErr := expectedHashes.Verify(imageBytes)
+			if verifyErr == nil {
+				log.Debugf("found '%s' in the cache", ref)
+				return imageBytes, filename, true, nil
+			}
+			log.Warnf("cached image '%s' is invalid, dropping it: %v", ref, verifyErr)
+			if err := fwRepo.cache.Delete(cacheKey); err != nil {
+				log.Errorf("unable to delete cached image '%s': %v", ref, err)
+			}
+		case !errors.Is(err, os.ErrNotExist):
+			log.Errorf("unable to read cached image '%s': %v", ref, err)
+		}
+	}
+
+	imageBytes, filename, err := fwRepo.FetchFirmware(ctx, ref)
+	if err != nil {
+		return nil, "", false, err
+	}
+	if err := expectedHashes.Verify(imageBytes); err != nil {
+		return nil, "", false, err
+	}
+
+	if fwRepo.cache != nil {
+		if err := fwRepo.cache.Put(cacheKey, filename, imageBytes); err != nil {
+			log.Errorf("unable to cache image '%s': %v", ref, err)
+		}
+	}
+	return imageBytes, filename, false, nil
+}
+
+// resolve returns the reference to fetch the image by and the expected
+// hashes of the image.
+func (fwRepo *FirmwareRepo) resolve(ctx context.Context, version string) (string, ExpectedHashes, error) {
+	if fwRepo.firmwareDB == nil {
+		return version, ExpectedHashes{}, nil
+	}
+
+	entries, err := fwRepo.firmwareDB.Get(ctx, firmwaredb.FilterVersion(version))
+	if err != nil {
+		return "", ExpectedHashes{}, ErrGetMeta{Err: err}
+	}
+
+	var entry *firmwaredb.Firmware
+	for _, candidate := range entries {
+		if candidate.ImageURL != "" {
+			entry = candidate
+			break
+		}
+	}
+	if entry == nil {
+		if len(entries) == 0 {
+			logger.FromCtx(ctx).Debugf("firmware version '%s' is not found in the original firmware DB", version)
+			return version, ExpectedHashes{}, nil
+		}
+		entry = entries[0]
+	}
+
+	ref := entry.ImageURL
+	if ref == "" {
+		ref = version
+	}
+	return ref, ExpectedHashesFromFirmwareDB(entry), nil
 }
 
 type image struct {
@@ -40,29 +160,30 @@ type fetchFirmwareJob struct {
 	Error           error
 	ctx             context.Context
 	firmwareStorage *FirmwareRepo
-	url             string
+	ref             string
 	doneChan        chan struct{}
 }
 
-// FetchFirmwareByURL returns a firmware image by URL.
-func (fwRepo *FirmwareRepo) FetchFirmwareByURL(
+// FetchFirmware returns a firmware image by a reference (see Backend.Fetch).
+// If the fetched file is an archive, then the image is extracted from it.
+func (fwRepo *FirmwareRepo) FetchFirmware(
 	ctx context.Context,
-	url string,
+	ref string,
 ) (imageBytes []byte, filename string, err error) {
-	job := fwRepo.fetchFirmware(ctx, url)
+	job := fwRepo.fetchFirmware(ctx, ref)
 	<-job.Done()
 	logger.FromCtx(ctx).Debugf("fetch-firmware received results: len:%d, name:%s, err:%v", len(job.Image.Bytes), job.Image.Filename, job.Error)
 	return job.Image.Bytes, job.Image.Filename, job.Error
 }
 
-func (fwRepo *FirmwareRepo) fetchFirmware(ctx context.Context, url string) *fetchFirmwareJob {
+func (fwRepo *FirmwareRepo) fetchFirmware(ctx context.Context, ref string) *fetchFirmwareJob {
 
 	// The high-level logic of this code:
 	//
 	// * We want to avoid downloading the same image multiple times in parallel
-	//   so if there are multiple FetchFirmware requests with the same url,
-	//   then they all waits for one real HTTP request to complete. So
-	//   for each URL we create a job and re-use it if necessary.
+	//   so if there are multiple FetchFirmware requests with the same ref,
+	//   then they all waits for one real request to complete. So
+	//   for each ref we create a job and re-use it if necessary.
 
 	span, ctx := tracer.StartChildSpanFromCtx(ctx, "FirmwareStorageSubJob.fetchFirmware")
 	defer span.Finish()
@@ -71,27 +192,27 @@ func (fwRepo *FirmwareRepo) fetchFirmware(ctx context.Context, url string) *fetc
 	fwRepo.fetchFirmwareJobsMutex.Lock()
 	defer fwRepo.fetchFirmwareJobsMutex.Unlock()
 
-	job := fwRepo.fetchFirmwareJobs[url]
+	job := fwRepo.fetchFirmwareJobs[ref]
 	if job != nil {
 		return job
 	}
 
-	log.Debugf("creating a new firmware-fetch for '%s'", url)
-	job = fwRepo.newFetchFirmwareJob(ctx, url, func(image image) {
-		log.Debugf("finished downloading the image for '%s'", url)
+	log.Debugf("creating a new firmware-fetch for '%s'", ref)
+	job = fwRepo.newFetchFirmwareJob(ctx, ref, func() {
+		log.Debugf("finished fetching the image for '%s'", ref)
 
 		fwRepo.fetchFirmwareJobsMutex.Lock()
-		fwRepo.fetchFirmwareJobs[url] = nil
+		delete(fwRepo.fetchFirmwareJobs, ref)
 		fwRepo.fetchFirmwareJobsMutex.Unlock()
 	})
-	fwRepo.fetchFirmwareJobs[url] = job
+	fwRepo.fetchFirmwareJobs[ref] = job
 	return job
 }
 
 func (fwRepo *FirmwareRepo) newFetchFirmwareJob(
 	ctx context.Context,
-	url string,
-	onSuccess func(image),
+	ref string,
+	onFinish func(),
 ) (job *fetchFirmwareJob) {
 
 	ctx = beltctx.WithField(
@@ -99,88 +220,37 @@ func (fwRepo *FirmwareRepo) newFetchFirmwareJob(
 	)
 
 	job = &fetchFirmwareJob{
-		ctx:             beltctx.WithField(ctx, "fetchFirmwareJob", url),
-		url:             url,
+		ctx:             beltctx.WithField(ctx, "fetchFirmwareJob", ref),
+		ref:             ref,
 		firmwareStorage: fwRepo,
 		doneChan:        make(chan struct{}),
 	}
 	go func() {
 		defer func() {
-			logger.FromCtx(ctx).Debugf("download '%s' result: len:%d, name:%s, err:%v", url, len(job.Image.Bytes), job.Image.Filename, job.Error)
+			logger.FromCtx(ctx).Debugf("fetch '%s' result: len:%d, name:%s, err:%v", ref, len(job.Image.Bytes), job.Image.Filename, job.Error)
+			onFinish()
 			close(job.doneChan)
 		}()
 
 		job.fetch()
-		if job.Error != nil {
-			return
-		}
-
-		onSuccess(job.Image)
 	}()
 	return
 }
 
 func (job *fetchFirmwareJob) fetch() {
-	data, filename, err := job.fetchFromURL()
-
+	data, filename, err := job.firmwareStorage.backend.Fetch(job.ctx, job.ref)
 	if err != nil {
 		job.Error = err
 		return
 	}
 
-	job.Image = image{Bytes: data, Filename: filename}
-}
-
-func (job *fetchFirmwareJob) fetchFromURL() ([]byte, string, error) {
-	parsedURL, err := url.Parse(job.url)
+	data, filename, err = ExtractFirmware(job.ctx, filename, data)
 	if err != nil {
-		return nil, "", ErrParseURL{Err: err, URL: job.url}
-	}
-
-	var (
-		imageBytes []byte
-		filename   string
-	)
-
-	switch parsedURL.Scheme {
-	case "http", "https":
-		pathParts := strings.Split(parsedURL.Path, "/")
-		filename = pathParts[len(pathParts)-1]
-		imageBytes, err = job.httpFetch()
-	default:
-		return nil, "", fmt.Errorf("unknown scheme: '%s'", parsedURL.Scheme)
-	}
-	return imageBytes, filename, err
-}
-
-func (job *fetchFirmwareJob) httpFetch() ([]byte, error) {
-	log := logger.FromCtx(job.ctx)
-	log.Debugf("downloading a file from '%s'", job.url)
-	req, err := http.NewRequestWithContext(job.ctx, http.MethodGet, job.url, nil)
-	if err != nil {
-		err = ErrHTTPMakeRequest{Err: err, URL: job.url}
-		logger.FromCtx(job.ctx).Errorf("internal error: %v", err)
-		return nil, err
-	}
-
-	resp, err := http.DefaultClient.Do(req)
-	if err != nil {
-		return nil, ErrHTTPGet{Err: err, URL: job.url}
-	}
-	defer resp.Body.Close()
-	log.Debugf("status code: %d", resp.StatusCode)
-
-	if resp.StatusCode < 200 || resp.StatusCode > 300 {
-		log.Warnf("invalid response status code: %d", resp.StatusCode)
-		return nil, ErrHTTPGet{Err: fmt.Errorf("invalid status code: %d", resp.StatusCode), URL: job.url}
-	}
-
-	imageBytes, err := io.ReadAll(resp.Body)
-	if err != nil {
-		return nil, ErrHTTPGetBody{Err: err, URL: job.url}
+		job.Error = err
+		return
 	}
 
-	return imageBytes, nil
+	job.Image = image{Bytes: data, Filename: filename}
 }
 
 func (job *fetchFirmwareJob) Done() <-chan struct{} {
@@ -13,17 +13,25 @@
 package firmwarerepo
 
 import (
+	"context"
 	"sync"
+
+	"github.com/immune-gmbh/AttestationFailureAnalysisService/pkg/firmwaredb"
 )
 
 // FirmwareRepo is responsible for providing an original image of a specified
-// firmware version. Currently it is implemented through ugly logic of
-// downloading a tarball and looking for image from it.
+// firmware version.
 //
-// TODO: reimplement this logic to download images from Everstore/BlobStorage.
+// The image is fetched from a Backend by the image URL from the original
+// firmware database (or by the version itself if the URL is not set),
+// extracted from an archive (if required) and checked against the expected
+// hashes from the original firmware database. Optionally fetched images
+// are cached on the local disk.
 type FirmwareRepo struct {
-	baseURL    string
+	backend    Backend
 	callerName string
+	firmwareDB firmwaredb.DB
+	cache      *diskCache
 
 	fetchFirmwareJobsMutex sync.Mutex
 	fetchFirmwareJobs      map[string]*fetchFirmwareJob
@@ -31,12 +39,28 @@ type FirmwareRepo struct {
 
 // New returns an instance of FirmwareRepo.
 func New(
-	baseURL string,
+	backend Backend,
 	callerName string,
-) *FirmwareRepo {
-	return &FirmwareRepo{
-		baseURL:           baseURL,
+	opts ...Option,
+) (*FirmwareRepo, error) {
+	cfg := getConfig(opts...)
+	repo := &FirmwareRepo{
+		backend:           backend,
 		fetchFirmwareJobs: map[string]*fetchFirmwareJob{},
 		callerName:        callerName,
+		firmwareDB:        cfg.FirmwareDB,
+	}
+	if cfg.CacheDir != "" {
+		cache, err := newDiskCache(cfg.CacheDir)
+		if err != nil {
+			return nil, err
+		}
+		repo.cache = cache
 	}
+	return repo, nil
+}
+
+// Ping returns an error if the backend of the repository is not reachable.
+func (fwRepo *FirmwareRepo) Ping(ctx context.Context) error {
+	return fwRepo.backend.Ping(ctx)
 }
@@ -0,0 +1,253 @@
+// Copyright 2023 Meta Platforms, Inc. and affiliates.
+//
+// Redistribution and use in source and binary forms, with or without modification, are permitted provided that the following conditions are met:
+//
+// 1. Redistributions of source code must retain the above copyright notice, this list of conditions and the following disclaimer.
+//
+// 2. Redistributions in binary form must reproduce the above copyright notice, this list of conditions and the following disclaimer in the documentation and/or other materials provided with the distribution.
+//
+// 3. Neither the name of the copyright holder nor the names of its contributors may be used to endorse or promote products derived from this software without specific prior written permission.
+//
+// THIS SOFTWARE IS PROVIDED BY THE COPYRIGHT HOLDERS AND CONTRIBUTORS "AS IS" AND ANY EXPRESS OR IMPLIED WARRANTIES, INCLUDING, BUT NOT LIMITED TO, THE IMPLIED WARRANTIES OF MERCHANTABILITY AND FITNESS FOR A PARTICULAR PURPOSE ARE DISCLAIMED. IN NO EVENT SHALL THE COPYRIGHT HOLDER OR CONTRIBUTORS BE LIABLE FOR ANY DIRECT, INDIRECT, INCIDENTAL, SPECIAL, EXEMPLARY, OR CONSEQUENTIAL DAMAGES (INCLUDING, BUT NOT LIMITED TO, PROCUREMENT OF SUBSTITUTE GOODS OR SERVICES; LOSS OF USE, DATA, OR PROFITS; OR BUSINESS INTERRUPTION) HOWEVER CAUSED AND ON ANY THEORY OF LIABILITY, WHETHER IN CONTRACT, STRICT LIABILITY, OR TORT (INCLUDING NEGLIGENCE OR OTHERWISE) ARISING IN ANY WAY OUT OF THE USE OF THIS SOFTWARE, EVEN IF ADVISED OF THE POSSIBILITY OF SUCH DAMAGE.
+
+package firmwarerepo
+
+import (
+	"bytes"
+	"compress/gzip"
+	"context"
+	"crypto/sha256"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/immune-gmbh/AttestationFailureAnalysisService/pkg/firmwaredb"
+	"github.com/stretchr/testify/require"
+)
+
+type firmwareDBStub struct {
+	firmwaredb.DB
+	entries []*firmwaredb.Firmware
+}
+
+func (db firmwareDBStub) Get(ctx context.Context, filters ...firmwaredb.Filter) ([]*firmwaredb.Firmware, error) {
+	var result []*firmwaredb.Firmware
+	for _, entry := range db.entries {
+		if firmwaredb.Filters(filters).Match(entry) {
+			result = append(result, entry)
+		}
+	}
+	return result, nil
+}
+
+func TestDirBackend(t *testing.T) {
+	ctx := context.Background()
+	root := t.TempDir()
+	require.NoError(t, os.MkdirAll(filepath.Join(root, "a"), 0750))
+	require.NoError(t, os.WriteFile(filepath.Join(root, "a", "fw.bin"), []byte("image"), 0640))
+
+	backend := NewDirBackend(root)
+	data, filename, err := backend.Fetch(ctx, "a/fw.bin")
+	require.NoError(t, err)
+	require.Equal(t, []byte("image"), data)
+	require.Equal(t, "fw.bin", filename)
+
+	_, _, err = backend.Fetch(ctx, "../a/fw.bin")
+	require.NoError(t, err, "the path should be kept inside the root")
+
+	_, _, err = backend.Fetch(ctx, "missing.bin")
+	require.ErrorAs(t, err, &ErrNotFound{})
+
+	data, _, err = backend.Fetch(ctx, "file://"+filepath.ToSlash(filepath.Join(root, "a", "fw.bin")))
+	require.NoError(t, err)
+	require.Equal(t, []byte("image"), data)
+
+	_, _, err = backend.Fetch(ctx, "file:///etc/passwd")
+	require.ErrorAs(t, err, &ErrUnsupportedRef{})
+
+	_, _, err = backend.Fetch(ctx, "https://example.com/a/fw.bin")
+	require.ErrorAs(t, err, &ErrUnsupportedRef{})
+}
+
+func TestHTTPBackendMirrors(t *testing.T) {
+	var headers []string
+	broken := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		headers = append(headers, r.Header.Get("Authorization"))
+		w.WriteHeader(http.StatusInternalServerError)
+	}))
+	defer broken.Close()
+	mirror := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		headers = append(headers, r.Header.Get("Authorization"))
+		fmt.Fprintf(w, "image of %s", r.URL.Path)
+	}))
+	defer mirror.Close()
+
+	backend, err := NewBackend([]string{broken.URL + "/", mirror.URL + "/"}, http.Header{"Authorization": {"Bearer token"}})
+	require.NoError(t, err)
+	data, filename, err := backend.Fetch(context.Background(), "1.2.3")
+	require.NoError(t, err)
+	require.Equal(t, "image of /1.2.3", string(data))
+	require.Equal(t, "1.2.3", filename)
+	require.Equal(t, []string{"Bearer token", "Bearer token"}, headers)
+}
+
+func TestHTTPBackendAbsoluteURLHeaders(t *testing.T) {
+	var authorization string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		authorization = r.Header.Get("Authorization")
+		fmt.Fprintf(w, "image of %s", r.URL.Path)
+	}))
+	defer server.Close()
+	ctx := context.Background()
+
+	backend := NewHTTPBackend([]string{server.URL + "/images/"}, http.Header{"Authorization": {"Bearer token"}})
+
+	data, filename, err := backend.Fetch(ctx, server.URL+"/images/sub/fw.bin")
+	require.NoError(t, err)
+	require.Equal(t, "image of /images/sub/fw.bin", string(data))
+	require.Equal(t, "fw.bin", filename)
+	require.Equal(t, "Bearer token", authorization)
+
+	for _, ref := range []string{
+		server.URL + "/other/fw.bin",
+		server.URL + "/images/../other/fw.bin",
+		server.URL + "/imagesX/fw.bin",
+	} {
+		authorization = ""
+		_, _, err = backend.Fetch(ctx, ref)
+		require.NoError(t, err)
+		require.Empty(t, authorization, ref)
+	}
+
+	_, _, err = backend.Fetch(ctx, "file:///etc/passwd")
+	require.ErrorAs(t, err, &ErrUnsupportedRef{})
+}
+
+func newOCIRegistryStandIn(t *testing.T, repository, tag string, blob []byte, corrupt bool) *httptest.Server {
+	digest := fmt.Sprintf("sha256:%x", sha256.Sum256(blob))
+	manifest, err := json.Marshal(ociManifest{
+		SchemaVersion: 2,
+		MediaType:     ociMediaTypeImageManifest,
+		Layers: []ociDescriptor{{
+			MediaType:   "application/octet-stream",
+			Digest:      digest,
+			Size:        int64(len(blob)),
+			Annotations: map[string]string{ociAnnotationTitle: "fw.bin"},
+		}},
+	})
+	require.NoError(t, err)
+
+	mux := http.NewServeMux()
+	mux.HandleFunc(fmt.Sprintf("/v2/%s/manifests/%s", repository, tag), func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", ociMediaTypeImageManifest)
+		w.Write(manifest)
+	})
+	mux.HandleFunc(fmt.Sprintf("/v2/%s/blobs/%s", repository, digest), func(w http.ResponseWriter, r *http.Request) {
+		if corrupt {
+			w.Write(append([]byte{0}, blob...))
+			return
+		}
+		w.Write(blob)
+	})
+	return httptest.NewServer(mux)
+}
+
+func TestOCIBackend(t *testing.T) {
+	ctx := context.Background()
+	blob := []byte("firmware image")
+
+	registry := newOCIRegistryStandIn(t, "firmwares/bios", "1.2.3_beta", blob, false)
+	defer registry.Close()
+
+	backend, err := NewBackend([]string{"oci+http://" + registry.Listener.Addr().String() + "/firmwares/bios"}, nil)
+	require.NoError(t, err)
+	data, filename, err := backend.Fetch(ctx, "1.2.3+beta")
+	require.NoError(t, err)
+	require.Equal(t, blob, data)
+	require.Equal(t, "fw.bin", filename)
+
+	_, _, err = backend.Fetch(ctx, "unknown")
+	require.ErrorAs(t, err, &ErrNotFound{})
+
+	_, _, err = backend.Fetch(ctx, "https://example.com/1.2.3+beta")
+	require.ErrorAs(t, err, &ErrUnsupportedRef{})
+
+	corruptRegistry := newOCIRegistryStandIn(t, "firmwares/bios", "1.2.3", blob, true)
+	defer corruptRegistry.Close()
+
+	backend, err = NewBackend([]string{"oci+http://" + corruptRegistry.Listener.Addr().String() + "/firmwares/bios"}, nil)
+	require.NoError(t, err)
+	_, _, err = backend.Fetch(ctx, "1.2.3")
+	require.ErrorAs(t, err, &ErrHashMismatch{})
+}
+
+func TestDownloadByVersion(t *testing.T) {
+	ctx := context.Background()
+	root := t.TempDir()
+	image := []byte("firmware image")
+	imageHash := sha256.Sum256(image)
+
+	var gzipped bytes.Buffer
+	gzWriter := gzip.NewWriter(&gzipped)
+	gzWriter.Name = "fw.bin"
+	_, err := gzWriter.Write(image)
+	require.NoError(t, err)
+	require.NoError(t, gzWriter.Close())
+	require.NoError(t, os.WriteFile(filepath.Join(root, "fw.bin.gz"), gzipped.Bytes(), 0640))
+	require.NoError(t, os.WriteFile(filepath.Join(root, "bad.bin"), []byte("tampered"), 0640))
+
+	db := firmwareDBStub{entries: []*firmwaredb.Firmware{
+		{
+			Version:  "good",
+			ImageURL: "fw.bin.gz",
+			Measurements: []*firmwaredb.FirmwareMeasurement{{
+				FirmwareMeasurementType: &firmwaredb.FirmwareMeasurementType{Name: firmwaredb.MeasurementTypeImageSHA256},
+				Value:                   imageHash[:],
+			}},
+		},
+		{
+			Version:  "absolute_url",
+			ImageURL: "https://example.com/absolute_url",
+		},
+		{
+			Version:  "bad",
+			ImageURL: "bad.bin",
+			Measurements: []*firmwaredb.FirmwareMeasurement{{
+				FirmwareMeasurementType: &firmwaredb.FirmwareMeasurementType{Name: firmwaredb.MeasurementTypeImageSHA256},
+				Value:                   imageHash[:],
+			}},
+		},
+	}}
+
+	repo, err := New(NewDirBackend(root), t.Name(), OptionFirmwareDB{DB: db}, OptionCacheDir(t.TempDir()))
+	require.NoError(t, err)
+
+	data, filename, err := repo.DownloadByVersion(ctx, "good")
+	require.NoError(t, err)
+	require.Equal(t, image, data)
+	require.Equal(t, "fw.bin", filename)
+
+	_, _, err = repo.DownloadByVersion(ctx, "bad")
+	require.ErrorAs(t, err, &ErrHashMismatch{})
+
+	// the image should now be served from the cache
+	require.NoError(t, os.Remove(filepath.Join(root, "fw.bin.gz")))
+	data, filename, err = repo.DownloadByVersion(ctx, "good")
+	require.NoError(t, err)
+	require.Equal(t, image, data)
+	require.Equal(t, "fw.bin", filename)
+
+	// absolute URLs not supported by the backend fall back to the version
+	require.NoError(t, os.WriteFile(filepath.Join(root, "absolute_url"), image, 0640))
+	data, _, err = repo.DownloadByVersion(ctx, "absolute_url")
+	require.NoError(t, err)
+	require.Equal(t, image, data)
+
+	// versions unknown to the DB are fetched by the version itself
+	_, _, err = repo.DownloadByVersion(ctx, "unknown")
+	require.ErrorAs(t, err, &ErrNotFound{})
+}
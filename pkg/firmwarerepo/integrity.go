@@ -0,0 +1,71 @@
+// Copyright 2023 Meta Platforms, Inc. and affiliates.
+//
+// Redistribution and use in source and binary forms, with or without modification, are permitted provided that the following conditions are met:
+//
+// 1. Redistributions of source code must retain the above copyright notice, this list of conditions and the following disclaimer.
+//
+// 2. Redistributions in binary form must reproduce the above copyright notice, this list of conditions and the following disclaimer in the documentation and/or other materials provided with the distribution.
+//
+// 3. Neither the name of the copyright holder nor the names of its contributors may be used to endorse or promote products derived from this software without specific prior written permission.
+//
+// THIS SOFTWARE IS PROVIDED BY THE COPYRIGHT HOLDERS AND CONTRIBUTORS "AS IS" AND ANY EXPRESS OR IMPLIED WARRANTIES, INCLUDING, BUT NOT LIMITED TO, THE IMPLIED WARRANTIES OF MERCHANTABILITY AND FITNESS FOR A PARTICULAR PURPOSE ARE DISCLAIMED. IN NO EVENT SHALL THE COPYRIGHT HOLDER OR CONTRIBUTORS BE LIABLE FOR ANY DIRECT, INDIRECT, INCIDENTAL, SPECIAL, EXEMPLARY, OR CONSEQUENTIAL DAMAGES (INCLUDING, BUT NOT LIMITED TO, PROCUREMENT OF SUBSTITUTE GOODS OR SERVICES; LOSS OF USE, DATA, OR PROFITS; OR BUSINESS INTERRUPTION) HOWEVER CAUSED AND ON ANY THEORY OF LIABILITY, WHETHER IN CONTRACT, STRICT LIABILITY, OR TORT (INCLUDING NEGLIGENCE OR OTHERWISE) ARISING IN ANY WAY OUT OF THE USE OF THIS SOFTWARE, EVEN IF ADVISED OF THE POSSIBILITY OF SUCH DAMAGE.
+
+package firmwarerepo
+
+import (
+	"bytes"
+	"crypto/sha256"
+	"crypto/sha512"
+
+	"github.com/immune-gmbh/AttestationFailureAnalysisService/pkg/firmwaredb"
+)
+
+// ExpectedHashes are the expected hashes of a firmware image. Empty
+// hashes are not checked.
+type ExpectedHashes struct {
+	SHA256 []byte
+	SHA512 []byte
+}
+
+// IsEmpty returns true if there is nothing to check.
+func (h ExpectedHashes) IsEmpty() bool {
+	return len(h.SHA256) == 0 && len(h.SHA512) == 0
+}
+
+// Verify returns ErrHashMismatch if the image does not match the expected hashes.
+func (h ExpectedHashes) Verify(image []byte) error {
+	if len(h.SHA256) != 0 {
+		actual := sha256.Sum256(image)
+		if !bytes.Equal(h.SHA256, actual[:]) {
+			return ErrHashMismatch{HashName: "SHA256", Expected: h.SHA256, Actual: actual[:]}
+		}
+	}
+	if len(h.SHA512) != 0 {
+		actual := sha512.Sum512(image)
+		if !bytes.Equal(h.SHA512, actual[:]) {
+			return ErrHashMismatch{HashName: "SHA512", Expected: h.SHA512, Actual: actual[:]}
+		}
+	}
+	return nil
+}
+
+// ExpectedHashesFromFirmwareDB returns the expected image hashes from
+// the measurements of an original firmware database entry.
+func ExpectedHashesFromFirmwareDB(fw *firmwaredb.Firmware) ExpectedHashes {
+	var result ExpectedHashes
+	if fw == nil {
+		return result
+	}
+	for _, measurement := range fw.Measurements {
+		if measurement.FirmwareMeasurementType == nil {
+			continue
+		}
+		switch measurement.FirmwareMeasurementType.Name {
+		case firmwaredb.MeasurementTypeImageSHA256:
+			result.SHA256 = measurement.Value
+		case firmwaredb.MeasurementTypeImageSHA512:
+			result.SHA512 = measurement.Value
+		}
+	}
+	return result
+}
@@ -0,0 +1,53 @@
+// Copyright 2023 Meta Platforms, Inc. and affiliates.
+//
+// Redistribution and use in source and binary forms, with or without modification, are permitted provided that the following conditions are met:
+//
+// 1. Redistributions of source code must retain the above copyright notice, this list of conditions and the following disclaimer.
+//
+// 2. Redistributions in binary form must reproduce the above copyright notice, this list of conditions and the following disclaimer in the documentation and/or other materials provided with the distribution.
+//
+// 3. Neither the name of the copyright holder nor the names of its contributors may be used to endorse or promote products derived from this software without specific prior written permission.
+//
+// THIS SOFTWARE IS PROVIDED BY THE COPYRIGHT HOLDERS AND CONTRIBUTORS "AS IS" AND ANY EXPRESS OR IMPLIED WARRANTIES, INCLUDING, BUT NOT LIMITED TO, THE IMPLIED WARRANTIES OF MERCHANTABILITY AND FITNESS FOR A PARTICULAR PURPOSE ARE DISCLAIMED. IN NO EVENT SHALL THE COPYRIGHT HOLDER OR CONTRIBUTORS BE LIABLE FOR ANY DIRECT, INDIRECT, INCIDENTAL, SPECIAL, EXEMPLARY, OR CONSEQUENTIAL DAMAGES (INCLUDING, BUT NOT LIMITED TO, PROCUREMENT OF SUBSTITUTE GOODS OR SERVICES; LOSS OF USE, DATA, OR PROFITS; OR BUSINESS INTERRUPTION) HOWEVER CAUSED AND ON ANY THEORY OF LIABILITY, WHETHER IN CONTRACT, STRICT LIABILITY, OR TORT (INCLUDING NEGLIGENCE OR OTHERWISE) ARISING IN ANY WAY OUT OF THE USE OF THIS SOFTWARE, EVEN IF ADVISED OF THE POSSIBILITY OF SUCH DAMAGE.
+
+package firmwarerepo
+
+import (
+	"github.com/immune-gmbh/AttestationFailureAnalysisService/pkg/firmwaredb"
+)
+
+type config struct {
+	FirmwareDB firmwaredb.DB
+	CacheDir   string
+}
+
+// Option is an optional argument for New.
+type Option interface {
+	apply(*config)
+}
+
+// OptionFirmwareDB defines the original firmware database, which is used
+// to resolve firmware versions to image URLs and to obtain expected hashes
+// of the images.
+type OptionFirmwareDB struct {
+	DB firmwaredb.DB
+}
+
+func (opt OptionFirmwareDB) apply(cfg *config) {
+	cfg.FirmwareDB = opt.DB
+}
+
+// OptionCacheDir enables caching of fetched images in the given directory.
+type OptionCacheDir string
+
+func (opt OptionCacheDir) apply(cfg *config) {
+	cfg.CacheDir = string(opt)
+}
+
+func getConfig(opts ...Option) config {
+	var cfg config
+	for _, opt := range opts {
+		opt.apply(&cfg)
+	}
+	return cfg
+}
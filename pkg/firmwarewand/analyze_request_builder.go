@@ -20,6 +20,7 @@ import (
 	"github.com/immune-gmbh/AttestationFailureAnalysisService/if/generated/measurements"
 	"github.com/immune-gmbh/AttestationFailureAnalysisService/if/typeconv"
 	"github.com/immune-gmbh/AttestationFailureAnalysisService/pkg/flowscompat"
+	"github.com/immune-gmbh/AttestationFailureAnalysisService/pkg/hwaccess"
 	"github.com/immune-gmbh/AttestationFailureAnalysisService/pkg/objhash"
 
 	"github.com/9elements/converged-security-suite/v2/pkg/pcr"
@@ -48,7 +49,12 @@ func (req *AnalyzeRequestBuilder) GetThrift() *afas.AnalyzeRequest {
 
 // AddLocalHostInfo adds information about the local host
 func (req *AnalyzeRequestBuilder) AddLocalHostInfo() error {
-	hostInfo, err := localHostInfo()
+	return req.AddHostInfo(hwaccess.Local())
+}
+
+// AddHostInfo adds information about the host of hardware `hw`
+func (req *AnalyzeRequestBuilder) AddHostInfo(hw hwaccess.Interface) error {
+	hostInfo, err := HostInfo(hw)
 	if err != nil {
 		return err
 	}
@@ -56,6 +62,11 @@ func (req *AnalyzeRequestBuilder) AddLocalHostInfo() error {
 	return nil
 }
 
+// SetHostInfo sets information about the host being analyzed
+func (req *AnalyzeRequestBuilder) SetHostInfo(hostInfo *afas.HostInfo) {
+	req.request.HostInfo = hostInfo
+}
+
 // AddDiffMeasuredBootInput populates AnalyzeRequest with input for DiffMeasuredBoot analyzer
 func (req *AnalyzeRequestBuilder) AddDiffMeasuredBootInput(
 	firmwareVersion string,
@@ -65,6 +76,7 @@ func (req *AnalyzeRequestBuilder) AddDiffMeasuredBootInput(
 	tpmDevice tpmdetection.Type,
 	eventLog *tpmeventlog.TPMEventLog,
 	actualPCR0 []byte,
+	actualFirmwareDumpInfo *afas.FirmwareDumpInfo, // optional
 ) error {
 	if originalFirmwareImage != nil {
 		if err := checkFirmwareImageIsCorrectEnum(*originalFirmwareImage, "originalFirmwareImage"); err != nil {
@@ -153,6 +165,14 @@ func (req *AnalyzeRequestBuilder) AddDiffMeasuredBootInput(
 		input.ActualPCR0 = &idx
 	}
 
+	if actualFirmwareDumpInfo != nil {
+		dumpInfoArtifact := &afas.Artifact{
+			FirmwareDumpInfo: actualFirmwareDumpInfo,
+		}
+		idx := req.addArtifact(dumpInfoArtifact)
+		input.ActualFirmwareDumpInfo = &idx
+	}
+
 	req.request.Analyzers = append(req.request.Analyzers, &afas.AnalyzerInput{
 		DiffMeasuredBoot: &input,
 	})
@@ -28,3 +28,19 @@ func (fwwand *FirmwareWand) Dump(
 
 	return imageBytes, nil
 }
+
+// DumpWithInfo dumps the local firmware and returns information about how it was dumped,
+// see flashrom.DumpWithInfo.
+func (fwwand *FirmwareWand) DumpWithInfo(
+	ctx context.Context,
+) ([]byte, *flashrom.DumpInfo, error) {
+	return flashrom.DumpWithInfo(ctx, fwwand.flashromOptions...)
+}
+
+// DumpRegions dumps only the selected regions of the local firmware, see flashrom.DumpRegions.
+func (fwwand *FirmwareWand) DumpRegions(
+	ctx context.Context,
+	regions flashrom.Regions,
+) (*flashrom.SparseImage, error) {
+	return flashrom.DumpRegions(ctx, regions, fwwand.flashromOptions...)
+}
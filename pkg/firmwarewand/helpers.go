@@ -13,23 +13,29 @@
 package firmwarewand
 
 import (
-	"os"
-
 	"github.com/immune-gmbh/AttestationFailureAnalysisService/if/generated/afas"
 	"github.com/immune-gmbh/AttestationFailureAnalysisService/pkg/dmidecode"
+	"github.com/immune-gmbh/AttestationFailureAnalysisService/pkg/flashrom"
+	"github.com/immune-gmbh/AttestationFailureAnalysisService/pkg/hwaccess"
 )
 
-func localHostInfo() (*afas.HostInfo, error) {
+// LocalHostInfo returns information about the local host.
+func LocalHostInfo() (*afas.HostInfo, error) {
+	return HostInfo(hwaccess.Local())
+}
+
+// HostInfo returns information about the host of hardware `hw`.
+func HostInfo(hw hwaccess.Interface) (*afas.HostInfo, error) {
 	var hostInfo afas.HostInfo
 	hostInfo.IsClientHostAnalyzed = true
 
-	hostname, err := os.Hostname()
+	hostname, err := hw.Hostname()
 	if err != nil {
 		return nil, ErrDetectHostname{Err: err}
 	}
 	hostInfo.Hostname = &hostname
 
-	dmiTable, _ := dmidecode.LocalDMITable()
+	dmiTable, _ := dmidecode.DMITableFromHardware(hw)
 	if dmiTable != nil {
 		sn := dmiTable.SystemInfo().SystemSerialNumber
 		hostInfo.SerialNumber = &sn
@@ -37,3 +43,40 @@ func localHostInfo() (*afas.HostInfo, error) {
 
 	return &hostInfo, nil
 }
+
+// DumpInfoToThrift converts flashrom.DumpInfo to the format used in AnalyzeRequest.
+func DumpInfoToThrift(info flashrom.DumpInfo) *afas.FirmwareDumpInfo {
+	result := &afas.FirmwareDumpInfo{
+		Fallback: info.Fallback,
+	}
+	switch info.Method {
+	case flashrom.DumpMethodFlashrom:
+		result.Method = afas.FirmwareDumpMethod_Flashrom
+	case flashrom.DumpMethodAfulnx64:
+		result.Method = afas.FirmwareDumpMethod_Afulnx64
+	case flashrom.DumpMethodDevMem:
+		result.Method = afas.FirmwareDumpMethod_DevMem
+	case flashrom.DumpMethodMTD:
+		result.Method = afas.FirmwareDumpMethod_MTD
+	default:
+		result.Method = afas.FirmwareDumpMethod_Unknown
+	}
+	switch info.Quality {
+	case flashrom.DumpQualityVerified:
+		result.Quality = afas.FirmwareDumpQuality_Verified
+	case flashrom.DumpQualityUnstable:
+		result.Quality = afas.FirmwareDumpQuality_Unstable
+	default:
+		result.Quality = afas.FirmwareDumpQuality_Unverified
+	}
+	for _, r := range info.UnstableRanges {
+		result.UnstableRanges = append(result.UnstableRanges, &afas.ByteRange{
+			Offset: int64(r.Offset),
+			Length: int64(r.Length),
+		})
+	}
+	if info.Fallback {
+		result.FallbackError = &info.FallbackError
+	}
+	return result
+}
@@ -0,0 +1,64 @@
+// Copyright 2023 Meta Platforms, Inc. and affiliates.
+//
+// Redistribution and use in source and binary forms, with or without modification, are permitted provided that the following conditions are met:
+//
+// 1. Redistributions of source code must retain the above copyright notice, this list of conditions and the following disclaimer.
+//
+// 2. Redistributions in binary form must reproduce the above copyright notice, this list of conditions and the following disclaimer in the documentation and/or other materials provided with the distribution.
+//
+// 3. Neither the name of the copyright holder nor the names of its contributors may be used to endorse or promote products derived from this software without specific prior written permission.
+//
+// THIS SOFTWARE IS PROVIDED BY THE COPYRIGHT HOLDERS AND CONTRIBUTORS "AS IS" AND ANY EXPRESS OR IMPLIED WARRANTIES, INCLUDING, BUT NOT LIMITED TO, THE IMPLIED WARRANTIES OF MERCHANTABILITY AND FITNESS FOR A PARTICULAR PURPOSE ARE DISCLAIMED. IN NO EVENT SHALL THE COPYRIGHT HOLDER OR CONTRIBUTORS BE LIABLE FOR ANY DIRECT, INDIRECT, INCIDENTAL, SPECIAL, EXEMPLARY, OR CONSEQUENTIAL DAMAGES (INCLUDING, BUT NOT LIMITED TO, PROCUREMENT OF SUBSTITUTE GOODS OR SERVICES; LOSS OF USE, DATA, OR PROFITS; OR BUSINESS INTERRUPTION) HOWEVER CAUSED AND ON ANY THEORY OF LIABILITY, WHETHER IN CONTRACT, STRICT LIABILITY, OR TORT (INCLUDING NEGLIGENCE OR OTHERWISE) ARISING IN ANY WAY OUT OF THE USE OF THIS SOFTWARE, EVEN IF ADVISED OF THE POSSIBILITY OF SUCH DAMAGE.
+package firmwarewand
+
+import (
+	"context"
+
+	"github.com/facebookincubator/go-belt/tool/logger"
+
+	"github.com/immune-gmbh/AttestationFailureAnalysisService/if/generated/afas"
+)
+
+// InsertOriginalFirmware registers a firmware in the original firmware database
+// of the firmware analysis service. Returns the ID of the new entry.
+//
+// Requires admin permissions.
+func (fwwand *FirmwareWand) InsertOriginalFirmware(
+	ctx context.Context,
+	fw afas.OriginalFirmware,
+) (int64, error) {
+	logger.FromCtx(ctx).Debugf("inserting original firmware %s %s", fw.Type, fw.Version)
+	result, err := fwwand.afasClient.InsertOriginalFirmware(ctx, &afas.InsertOriginalFirmwareRequest{
+		Firmware: &fw,
+	})
+	if err != nil {
+		return 0, err
+	}
+	return result.GetID(), nil
+}
+
+// UpdateOriginalFirmware replaces an entry of the original firmware database
+// of the firmware analysis service.
+//
+// Requires admin permissions.
+func (fwwand *FirmwareWand) UpdateOriginalFirmware(
+	ctx context.Context,
+	fw afas.OriginalFirmware,
+) error {
+	return fwwand.afasClient.UpdateOriginalFirmware(ctx, &afas.UpdateOriginalFirmwareRequest{
+		Firmware: &fw,
+	})
+}
+
+// DeleteOriginalFirmware removes an entry from the original firmware database
+// of the firmware analysis service.
+//
+// Requires admin permissions.
+func (fwwand *FirmwareWand) DeleteOriginalFirmware(
+	ctx context.Context,
+	id int64,
+) error {
+	return fwwand.afasClient.DeleteOriginalFirmware(ctx, &afas.DeleteOriginalFirmwareRequest{
+		ID: id,
+	})
+}
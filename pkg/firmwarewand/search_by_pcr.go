@@ -0,0 +1,47 @@
+// Copyright 2023 Meta Platforms, Inc. and affiliates.
+//
+// Redistribution and use in source and binary forms, with or without modification, are permitted provided that the following conditions are met:
+//
+// 1. Redistributions of source code must retain the above copyright notice, this list of conditions and the following disclaimer.
+//
+// 2. Redistributions in binary form must reproduce the above copyright notice, this list of conditions and the following disclaimer in the documentation and/or other materials provided with the distribution.
+//
+// 3. Neither the name of the copyright holder nor the names of its contributors may be used to endorse or promote products derived from this software without specific prior written permission.
+//
+// THIS SOFTWARE IS PROVIDED BY THE COPYRIGHT HOLDERS AND CONTRIBUTORS "AS IS" AND ANY EXPRESS OR IMPLIED WARRANTIES, INCLUDING, BUT NOT LIMITED TO, THE IMPLIED WARRANTIES OF MERCHANTABILITY AND FITNESS FOR A PARTICULAR PURPOSE ARE DISCLAIMED. IN NO EVENT SHALL THE COPYRIGHT HOLDER OR CONTRIBUTORS BE LIABLE FOR ANY DIRECT, INDIRECT, INCIDENTAL, SPECIAL, EXEMPLARY, OR CONSEQUENTIAL DAMAGES (INCLUDING, BUT NOT LIMITED TO, PROCUREMENT OF SUBSTITUTE GOODS OR SERVICES; LOSS OF USE, DATA, OR PROFITS; OR BUSINESS INTERRUPTION) HOWEVER CAUSED AND ON ANY THEORY OF LIABILITY, WHETHER IN CONTRACT, STRICT LIABILITY, OR TORT (INCLUDING NEGLIGENCE OR OTHERWISE) ARISING IN ANY WAY OUT OF THE USE OF THIS SOFTWARE, EVEN IF ADVISED OF THE POSSIBILITY OF SUCH DAMAGE.
+
+package firmwarewand
+
+import (
+	"context"
+
+	"github.com/facebookincubator/go-belt/tool/logger"
+
+	"github.com/immune-gmbh/AttestationFailureAnalysisService/if/generated/afas"
+	"github.com/immune-gmbh/AttestationFailureAnalysisService/if/generated/tpm"
+)
+
+// SearchByPCR finds firmware configurations (versions, images, boot flows and
+// status registers), which yield the given PCR value.
+//
+// modelID is optional, if it is not nil then only the original firmwares
+// targeted to the model are considered.
+func (fwwand *FirmwareWand) SearchByPCR(
+	ctx context.Context,
+	pcrIndex int32,
+	bank tpm.Algo,
+	digest []byte,
+	modelID *int64,
+) ([]*afas.PCRMatch, error) {
+	logger.FromCtx(ctx).Debugf("searching firmwares by PCR%d %s:%X", pcrIndex, bank, digest)
+	result, err := fwwand.afasClient.SearchByPCR(ctx, &afas.SearchByPCRRequest{
+		PCRIndex: pcrIndex,
+		Bank:     bank,
+		Digest:   digest,
+		ModelID:  modelID,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return result.GetFound(), nil
+}
@@ -0,0 +1,83 @@
+// Copyright 2023 Meta Platforms, Inc. and affiliates.
+//
+// Redistribution and use in source and binary forms, with or without modification, are permitted provided that the following conditions are met:
+//
+// 1. Redistributions of source code must retain the above copyright notice, this list of conditions and the following disclaimer.
+//
+// 2. Redistributions in binary form must reproduce the above copyright notice, this list of conditions and the following disclaimer in the documentation and/or other materials provided with the distribution.
+//
+// 3. Neither the name of the copyright holder nor the names of its contributors may be used to endorse or promote products derived from this software without specific prior written permission.
+//
+// THIS SOFTWARE IS PROVIDED BY THE COPYRIGHT HOLDERS AND CONTRIBUTORS "AS IS" AND ANY EXPRESS OR IMPLIED WARRANTIES, INCLUDING, BUT NOT LIMITED TO, THE IMPLIED WARRANTIES OF MERCHANTABILITY AND FITNESS FOR A PARTICULAR PURPOSE ARE DISCLAIMED. IN NO EVENT SHALL THE COPYRIGHT HOLDER OR CONTRIBUTORS BE LIABLE FOR ANY DIRECT, INDIRECT, INCIDENTAL, SPECIAL, EXEMPLARY, OR CONSEQUENTIAL DAMAGES (INCLUDING, BUT NOT LIMITED TO, PROCUREMENT OF SUBSTITUTE GOODS OR SERVICES; LOSS OF USE, DATA, OR PROFITS; OR BUSINESS INTERRUPTION) HOWEVER CAUSED AND ON ANY THEORY OF LIABILITY, WHETHER IN CONTRACT, STRICT LIABILITY, OR TORT (INCLUDING NEGLIGENCE OR OTHERWISE) ARISING IN ANY WAY OUT OF THE USE OF THIS SOFTWARE, EVEN IF ADVISED OF THE POSSIBILITY OF SUCH DAMAGE.
+package firmwarewand
+
+import (
+	"context"
+
+	"github.com/facebookincubator/go-belt/tool/logger"
+
+	"github.com/immune-gmbh/AttestationFailureAnalysisService/if/generated/afas"
+)
+
+// ListTamperingRegistryEntries returns entries of the registry of known tampered
+// hosts and known malicious firmware images of the firmware analysis service.
+//
+// If `kind` is nil, then entries of all kinds are returned.
+func (fwwand *FirmwareWand) ListTamperingRegistryEntries(
+	ctx context.Context,
+	kind *afas.TamperingRegistryKind,
+	includeExpired bool,
+) ([]*afas.TamperingRegistryEntry, error) {
+	result, err := fwwand.afasClient.ListTamperingRegistryEntries(ctx, &afas.ListTamperingRegistryEntriesRequest{
+		Kind:           kind,
+		IncludeExpired: includeExpired,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return result.GetEntries(), nil
+}
+
+// InsertTamperingRegistryEntry adds an entry to the registry of known tampered
+// hosts and known malicious firmware images. Returns the ID of the new entry.
+//
+// Requires admin permissions.
+func (fwwand *FirmwareWand) InsertTamperingRegistryEntry(
+	ctx context.Context,
+	entry afas.TamperingRegistryEntry,
+) (int64, error) {
+	logger.FromCtx(ctx).Debugf("inserting tampering registry entry %s '%s'", entry.Kind, entry.Value)
+	result, err := fwwand.afasClient.InsertTamperingRegistryEntry(ctx, &afas.InsertTamperingRegistryEntryRequest{
+		Entry: &entry,
+	})
+	if err != nil {
+		return 0, err
+	}
+	return result.GetID(), nil
+}
+
+// UpdateTamperingRegistryEntry replaces an entry of the registry of known tampered
+// hosts and known malicious firmware images.
+//
+// Requires admin permissions.
+func (fwwand *FirmwareWand) UpdateTamperingRegistryEntry(
+	ctx context.Context,
+	entry afas.TamperingRegistryEntry,
+) error {
+	return fwwand.afasClient.UpdateTamperingRegistryEntry(ctx, &afas.UpdateTamperingRegistryEntryRequest{
+		Entry: &entry,
+	})
+}
+
+// DeleteTamperingRegistryEntry removes an entry from the registry of known tampered
+// hosts and known malicious firmware images.
+//
+// Requires admin permissions.
+func (fwwand *FirmwareWand) DeleteTamperingRegistryEntry(
+	ctx context.Context,
+	id int64,
+) error {
+	return fwwand.afasClient.DeleteTamperingRegistryEntry(ctx, &afas.DeleteTamperingRegistryEntryRequest{
+		ID: id,
+	})
+}
@@ -0,0 +1,143 @@
+// Copyright 2023 Meta Platforms, Inc. and affiliates.
+//
+// Redistribution and use in source and binary forms, with or without modification, are permitted provided that the following conditions are met:
+//
+// 1. Redistributions of source code must retain the above copyright notice, this list of conditions and the following disclaimer.
+//
+// 2. Redistributions in binary form must reproduce the above copyright notice, this list of conditions and the following disclaimer in the documentation and/or other materials provided with the distribution.
+//
+// 3. Neither the name of the copyright holder nor the names of its contributors may be used to endorse or promote products derived from this software without specific prior written permission.
+//
+// THIS SOFTWARE IS PROVIDED BY THE COPYRIGHT HOLDERS AND CONTRIBUTORS "AS IS" AND ANY EXPRESS OR IMPLIED WARRANTIES, INCLUDING, BUT NOT LIMITED TO, THE IMPLIED WARRANTIES OF MERCHANTABILITY AND FITNESS FOR A PARTICULAR PURPOSE ARE DISCLAIMED. IN NO EVENT SHALL THE COPYRIGHT HOLDER OR CONTRIBUTORS BE LIABLE FOR ANY DIRECT, INDIRECT, INCIDENTAL, SPECIAL, EXEMPLARY, OR CONSEQUENTIAL DAMAGES (INCLUDING, BUT NOT LIMITED TO, PROCUREMENT OF SUBSTITUTE GOODS OR SERVICES; LOSS OF USE, DATA, OR PROFITS; OR BUSINESS INTERRUPTION) HOWEVER CAUSED AND ON ANY THEORY OF LIABILITY, WHETHER IN CONTRACT, STRICT LIABILITY, OR TORT (INCLUDING NEGLIGENCE OR OTHERWISE) ARISING IN ANY WAY OUT OF THE USE OF THIS SOFTWARE, EVEN IF ADVISED OF THE POSSIBILITY OF SUCH DAMAGE.
+
+package firmwarewand
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+
+	"github.com/facebookincubator/go-belt/tool/logger"
+	"github.com/ulikunitz/xz"
+
+	"github.com/immune-gmbh/AttestationFailureAnalysisService/if/generated/afas"
+	"github.com/immune-gmbh/AttestationFailureAnalysisService/pkg/flashrom"
+	"github.com/immune-gmbh/AttestationFailureAnalysisService/pkg/imagechunks"
+)
+
+// PrepareImageUpload returns a FirmwareImage to be used in an AnalyzeRequest,
+// which requires to transfer as less data as possible:
+//   - if the server already has the image, then the image is referenced by its ID;
+//   - otherwise only the chunks unknown to the server are sent (see afas.DeltaBlob).
+//
+// If the negotiation with the server fails (for example, the server does not
+// support it), then the whole image is sent XZ-compressed.
+func (fwwand *FirmwareWand) PrepareImageUpload(
+	ctx context.Context,
+	image []byte,
+) (*afas.FirmwareImage, error) {
+	log := logger.FromCtx(ctx)
+	manifest := imagechunks.NewManifest(image, imagechunks.DefaultChunkSize)
+	thriftManifest := &afas.ImageChunksManifest{
+		ImageSHA2_512: manifest.ImageSHA2_512,
+		ImageSize:     int64(manifest.ImageSize),
+		ChunkSize:     int32(manifest.ChunkSize),
+		ChunkHashes:   manifest.ChunkHashes,
+	}
+
+	negotiation, err := fwwand.afasClient.NegotiateImageUpload(ctx, &afas.NegotiateImageUploadRequest{
+		Manifest: thriftManifest,
+	})
+	if err != nil {
+		log.Warnf("unable to negotiate the image upload, sending the whole image: %v", err)
+		return wholeImageUpload(image)
+	}
+
+	if negotiation.IsSetImageID() {
+		log.Infof("the image is already stored with ID %X", negotiation.GetImageID())
+		blobStorageKey := string(negotiation.GetImageID())
+		return &afas.FirmwareImage{
+			BlobStorageKey: &blobStorageKey,
+		}, nil
+	}
+
+	deltaBlob := &afas.DeltaBlob{
+		Manifest: thriftManifest,
+		Chunks:   make(map[int32]*afas.CompressedBlob, len(negotiation.GetMissingChunks())),
+	}
+	var missingChunks []byte
+	for _, idx := range negotiation.GetMissingChunks() {
+		if idx < 0 || int(idx) >= manifest.ChunksCount() {
+			return nil, fmt.Errorf("the server requested chunk %d, which is out of range [0:%d)", idx, manifest.ChunksCount())
+		}
+		chunk := manifest.Chunk(image, int(idx))
+		missingChunks = append(missingChunks, chunk...)
+		compressedChunk, err := compressXZ(chunk)
+		if err != nil {
+			return nil, err
+		}
+		deltaBlob.Chunks[idx] = &afas.CompressedBlob{
+			Blob:        compressedChunk,
+			Compression: afas.CompressionType_XZ,
+		}
+	}
+	log.Infof("sending %d of %d chunks (%d of %d bytes) of the image",
+		len(deltaBlob.Chunks), manifest.ChunksCount(), len(missingChunks), len(image))
+	return &afas.FirmwareImage{
+		DeltaBlob: deltaBlob,
+	}, nil
+}
+
+// SparseImageUpload returns a FirmwareImage of a partially dumped image
+// to be used in an AnalyzeRequest.
+func SparseImageUpload(image *flashrom.SparseImage) (*afas.FirmwareImage, error) {
+	compressedImage, err := compressXZ(image.Image)
+	if err != nil {
+		return nil, err
+	}
+	sparseBlob := &afas.SparseBlob{
+		Blob: &afas.CompressedBlob{
+			Blob:        compressedImage,
+			Compression: afas.CompressionType_XZ,
+		},
+	}
+	for _, r := range image.Coverage {
+		sparseBlob.Coverage = append(sparseBlob.Coverage, &afas.ByteRange{
+			Offset: int64(r.Offset),
+			Length: int64(r.Length),
+		})
+	}
+	return &afas.FirmwareImage{
+		SparseBlob: sparseBlob,
+	}, nil
+}
+
+func wholeImageUpload(image []byte) (*afas.FirmwareImage, error) {
+	compressedImage, err := compressXZ(image)
+	if err != nil {
+		return nil, err
+	}
+	return &afas.FirmwareImage{
+		Blob: &afas.CompressedBlob{
+			Blob:        compressedImage,
+			Compression: afas.CompressionType_XZ,
+		},
+	}, nil
+}
+
+func compressXZ(data []byte) ([]byte, error) {
+	var compressed bytes.Buffer
+	xzWriter, err := xz.NewWriter(&compressed)
+	if err != nil {
+		return nil, fmt.Errorf("unable to create XZ writer: %w", err)
+	}
+	_, err = xzWriter.Write(data)
+	if err != nil {
+		return nil, fmt.Errorf("unable to compress data with XZ: %w", err)
+	}
+	err = xzWriter.Close()
+	if err != nil {
+		return nil, fmt.Errorf("unable to finalize the compression of data with XZ: %w", err)
+	}
+	return compressed.Bytes(), nil
+}
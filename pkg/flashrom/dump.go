@@ -23,56 +23,99 @@ func Dump(ctx context.Context, opts ...Option) ([]byte, error) {
 	return newFlashrom(opts...).Dump(ctx)
 }
 
+// DumpWithInfo dumps a firmware image on the local machine and
+// returns the information how it was acquired.
+//
+// See also OptionDoubleRead.
+func DumpWithInfo(ctx context.Context, opts ...Option) ([]byte, *DumpInfo, error) {
+	return newFlashrom(opts...).DumpWithInfo(ctx)
+}
+
 // Dump dumps a firmware image on the local machine
 func (f *flashrom) Dump(ctx context.Context) ([]byte, error) {
-	switch f.Config.DumpMethod {
+	image, _, err := f.DumpWithInfo(ctx)
+	return image, err
+}
+
+// DumpWithInfo dumps a firmware image on the local machine and
+// returns the information how it was acquired.
+func (f *flashrom) DumpWithInfo(ctx context.Context) ([]byte, *DumpInfo, error) {
+	image, info, err := f.dump(ctx, f.Config.DumpMethod)
+	if err != nil {
+		return nil, nil, err
+	}
+	if f.Config.DoubleRead {
+		f.verifyDump(ctx, image, info, func(ctx context.Context) ([]byte, error) {
+			image, _, err := f.dump(ctx, info.Method)
+			return image, err
+		})
+	}
+	return image, info, nil
+}
+
+func (f *flashrom) dump(ctx context.Context, method DumpMethod) ([]byte, *DumpInfo, error) {
+	var (
+		image []byte
+		err   error
+	)
+	switch method {
 	case DumpMethodAuto:
 		return f.dumpAuto(ctx)
 	case DumpMethodFlashrom:
-		return f.dumpFlashrom(ctx)
+		image, err = f.dumpFlashrom(ctx)
 	case DumpMethodAfulnx64:
-		return f.dumpAfulnx64(ctx)
+		image, err = f.dumpAfulnx64(ctx)
 	case DumpMethodDevMem:
-		return f.dumpDevMem(ctx)
+		image, err = f.dumpDevMem(ctx)
 	case DumpMethodMTD:
-		return f.dumpMTD(ctx)
+		image, err = f.dumpMTD(ctx)
+	default:
+		return nil, nil, fmt.Errorf("invalid dump method: %v", method)
+	}
+	if err != nil {
+		return nil, nil, err
 	}
-	return nil, fmt.Errorf("invalid dump method: %v", f.Config.DumpMethod)
+	return image, &DumpInfo{Method: method}, nil
 }
 
-func (f *flashrom) dumpAuto(ctx context.Context) ([]byte, error) {
+func (f *flashrom) dumpAuto(ctx context.Context) ([]byte, *DumpInfo, error) {
 	imageBytes, flashRomErr := f.dumpFlashrom(ctx)
 	logger.FromCtx(ctx).Debugf("flashrom error: %v", flashRomErr)
 	if flashRomErr == nil {
-		return imageBytes, nil
+		return imageBytes, &DumpInfo{Method: DumpMethodFlashrom}, nil
 	}
 
 	if f.Config.Afulnx64Path != `` {
 		imageBytes, err := f.dumpAfulnx64(ctx)
 		logger.FromCtx(ctx).Debugf("afulnx64 error: %v", err)
 		if err == nil {
-			return imageBytes, nil
+			return imageBytes, &DumpInfo{Method: DumpMethodAfulnx64}, nil
 		}
 	}
 
 	imageBytes, devMemDumpErr := f.dumpDevMem(ctx)
 	logger.FromCtx(ctx).Debugf("'/dev/mem' dumper error: %v", devMemDumpErr)
 	if devMemDumpErr == nil {
-		return imageBytes, nil
+		return imageBytes, &DumpInfo{Method: DumpMethodDevMem}, nil
 	}
 	devMemBytes := imageBytes
 
 	imageBytes, mtdErr := f.dumpMTD(ctx)
 	logger.FromCtx(ctx).Debugf("MTD dumper error: %v", mtdErr)
 	if mtdErr == nil {
-		return imageBytes, nil
+		return imageBytes, &DumpInfo{Method: DumpMethodMTD}, nil
 	}
 
 	if devMemBytes != nil {
+		// The image is not a valid BIOS region, but it is still better than nothing.
 		logger.FromCtx(ctx).Errorf("%v", devMemDumpErr)
-		return devMemBytes, nil
+		return devMemBytes, &DumpInfo{
+			Method:        DumpMethodDevMem,
+			Fallback:      true,
+			FallbackError: devMemDumpErr.Error(),
+		}, nil
 	}
 
-	return nil, fmt.Errorf("unable to find a working way to dump the image; flashRomErr: '%v'; devMemDumpErr: '%v', mtdErr: '%v'",
+	return nil, nil, fmt.Errorf("unable to find a working way to dump the image; flashRomErr: '%v'; devMemDumpErr: '%v', mtdErr: '%v'",
 		flashRomErr, devMemDumpErr, mtdErr)
 }
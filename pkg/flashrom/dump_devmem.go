@@ -16,7 +16,6 @@
 package flashrom
 
 import (
-	"bytes"
 	"context"
 	"fmt"
 	"io"
@@ -29,8 +28,42 @@ import (
 
 const (
 	devMemSkipPlaceholderHead = true
+
+	// devMemPlaceholderWordLength is the granularity of the placeholder
+	// in the beginning of the mapped BIOS region, which is skipped by the
+	// '/dev/mem' dumper (see devMemSkipPlaceholderHead).
+	devMemPlaceholderWordLength = 0x10000
 )
 
+func isDevMemPlaceholderWord(b []byte) bool {
+	for _, c := range b {
+		if c != 0xff {
+			return false
+		}
+	}
+	return true
+}
+
+// devMemPlaceholderHeadLength returns the length of the placeholder in
+// the beginning of the mapped BIOS region, which is skipped by dumpDevMem.
+func (f *flashrom) devMemPlaceholderHeadLength(biosRange pkgbytes.Range) (uint64, error) {
+	if !devMemSkipPlaceholderHead {
+		return 0, nil
+	}
+	word := make([]byte, devMemPlaceholderWordLength)
+	var length uint64
+	for length+devMemPlaceholderWordLength <= biosRange.Length {
+		if err := f.readPhysMem(biosRange.Offset+length, word); err != nil {
+			return 0, err
+		}
+		if !isDevMemPlaceholderWord(word) {
+			break
+		}
+		length += devMemPlaceholderWordLength
+	}
+	return length, nil
+}
+
 func (f *flashrom) findBIOSRegionUsingIOMem() (*pkgbytes.Range, error) {
 	// This is a desperate way to find coordinates of the BIOS region
 	// in the physical memory.
@@ -73,42 +106,47 @@ func (f *flashrom) findBIOSRegionUsingIOMem() (*pkgbytes.Range, error) {
 	}, nil
 }
 
-func (f *flashrom) dumpDevMem(ctx context.Context) ([]byte, error) {
-	biosRange, err := f.findBIOSRegionUsingIOMem()
-	if err != nil {
-		return nil, fmt.Errorf("unable to find the BIOS region range: %w", err)
+// readPhysMem reads the physical memory at address `addr` into `buf`.
+//
+// If the hardware is defined (see OptionHardware), then it is used to access
+// the memory, otherwise it is read from DevMemPath.
+func (f *flashrom) readPhysMem(addr uint64, buf []byte) error {
+	if f.Config.Hardware != nil {
+		if err := f.Config.Hardware.ReadPhysBuf(int64(addr), buf); err != nil {
+			return fmt.Errorf("unable to read the physical memory at 0x%X: %w", addr, err)
+		}
+		return nil
 	}
 
 	devMemFile, err := os.OpenFile(f.Config.DevMemPath, os.O_RDONLY, 0000)
 	if err != nil {
-		return nil, fmt.Errorf("unable to open '%s': %w", f.Config.DevMemPath, err)
+		return fmt.Errorf("unable to open '%s': %w", f.Config.DevMemPath, err)
 	}
+	defer func() {
+		_ = devMemFile.Close()
+	}()
 
-	_, err = devMemFile.Seek(int64(biosRange.Offset), io.SeekStart)
-	if err != nil {
-		return nil, fmt.Errorf("unable to seek(%d, %d) on '%s': %w",
-			int64(biosRange.Offset), io.SeekStart, f.Config.DevMemPath, err)
+	if _, err := io.ReadFull(io.NewSectionReader(devMemFile, int64(addr), int64(len(buf))), buf); err != nil {
+		return fmt.Errorf("unable to read '%s' at 0x%X: %w", f.Config.DevMemPath, addr, err)
 	}
+	return nil
+}
 
-	firmwareSize := biosRange.Length
-	b := make([]byte, firmwareSize)
-	r, err := devMemFile.Read(b)
+func (f *flashrom) dumpDevMem(ctx context.Context) ([]byte, error) {
+	biosRange, err := f.findBIOSRegionUsingIOMem()
 	if err != nil {
-		return nil, fmt.Errorf("unable to read '%s' at %d: %w", f.Config.DevMemPath, biosRange.Offset, err)
+		return nil, fmt.Errorf("unable to find the BIOS region range: %w", err)
 	}
-	if uint64(r) != firmwareSize {
-		return nil, fmt.Errorf("received wrong length: %d != %d", r, firmwareSize)
+
+	b := make([]byte, biosRange.Length)
+	if err := f.readPhysMem(biosRange.Offset, b); err != nil {
+		return nil, err
 	}
 
 	if devMemSkipPlaceholderHead {
-		placeholderWordLength := 0x10000
 		origLength := len(b)
-		placeholderWord := make([]byte, placeholderWordLength)
-		for idx := range placeholderWord {
-			placeholderWord[idx] = 0xff
-		}
-		for bytes.HasPrefix(b, placeholderWord) {
-			b = b[placeholderWordLength:]
+		for len(b) >= devMemPlaceholderWordLength && isDevMemPlaceholderWord(b[:devMemPlaceholderWordLength]) {
+			b = b[devMemPlaceholderWordLength:]
 		}
 		if len(b) < origLength {
 			logger.FromCtx(ctx).Warnf("the beginning of dumped image is filled with 0xFF; after removing the placeholder the length reduced from 0x%X (%d) to 0x%X (%d) bytes", origLength, origLength, len(b), len(b))
@@ -21,6 +21,9 @@ import (
 	"time"
 )
 
+// flashromProgrammer is the programmer used by flashrom to access the flash chip.
+const flashromProgrammer = "internal:laptop=this_is_not_a_laptop,ich_spi_mode=hwseq"
+
 func (f *flashrom) dumpFlashrom(ctx context.Context) ([]byte, error) {
 	// We execute an external tool which add a lot of unpredictability,
 	// for example it could hang for some reason. Therefore we use
@@ -32,7 +35,7 @@ func (f *flashrom) dumpFlashrom(ctx context.Context) ([]byte, error) {
 	defer cancelFunc()
 
 	imageBytes, err := f.execReceive(ctx, f.Config.FlashromPath,
-		"-p", "internal:laptop=this_is_not_a_laptop,ich_spi_mode=hwseq",
+		"-p", flashromProgrammer,
 		"--ifd",
 		"-i", "bios",
 		"-r", outputPathArgument,
@@ -45,7 +48,7 @@ func (f *flashrom) dumpFlashrom(ctx context.Context) ([]byte, error) {
 	}
 
 	imageBytes, err = f.execReceive(ctx, f.Config.FlashromPath,
-		"-p", "internal:laptop=this_is_not_a_laptop,ich_spi_mode=hwseq",
+		"-p", flashromProgrammer,
 		"--layout", f.Config.FirmwareFallbackLayoutPath,
 		"-i", "bios",
 		"-r", outputPathArgument,
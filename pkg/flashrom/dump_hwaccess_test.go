@@ -0,0 +1,90 @@
+// Copyright 2023 Meta Platforms, Inc. and affiliates.
+//
+// Redistribution and use in source and binary forms, with or without modification, are permitted provided that the following conditions are met:
+//
+// 1. Redistributions of source code must retain the above copyright notice, this list of conditions and the following disclaimer.
+//
+// 2. Redistributions in binary form must reproduce the above copyright notice, this list of conditions and the following disclaimer in the documentation and/or other materials provided with the distribution.
+//
+// 3. Neither the name of the copyright holder nor the names of its contributors may be used to endorse or promote products derived from this software without specific prior written permission.
+//
+// THIS SOFTWARE IS PROVIDED BY THE COPYRIGHT HOLDERS AND CONTRIBUTORS "AS IS" AND ANY EXPRESS OR IMPLIED WARRANTIES, INCLUDING, BUT NOT LIMITED TO, THE IMPLIED WARRANTIES OF MERCHANTABILITY AND FITNESS FOR A PARTICULAR PURPOSE ARE DISCLAIMED. IN NO EVENT SHALL THE COPYRIGHT HOLDER OR CONTRIBUTORS BE LIABLE FOR ANY DIRECT, INDIRECT, INCIDENTAL, SPECIAL, EXEMPLARY, OR CONSEQUENTIAL DAMAGES (INCLUDING, BUT NOT LIMITED TO, PROCUREMENT OF SUBSTITUTE GOODS OR SERVICES; LOSS OF USE, DATA, OR PROFITS; OR BUSINESS INTERRUPTION) HOWEVER CAUSED AND ON ANY THEORY OF LIABILITY, WHETHER IN CONTRACT, STRICT LIABILITY, OR TORT (INCLUDING NEGLIGENCE OR OTHERWISE) ARISING IN ANY WAY OUT OF THE USE OF THIS SOFTWARE, EVEN IF ADVISED OF THE POSSIBILITY OF SUCH DAMAGE.
+
+package flashrom
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/immune-gmbh/AttestationFailureAnalysisService/pkg/hwaccess"
+	pkgbytes "github.com/linuxboot/fiano/pkg/bytes"
+	"github.com/stretchr/testify/require"
+)
+
+func TestDumpHardwareFixture(t *testing.T) {
+	ctx := context.Background()
+	image := bytes.Repeat([]byte{0x42}, 0x1000)
+	copy(image[0xff0:], []byte("reset vector"))
+
+	t.Run("mtd", func(t *testing.T) {
+		hw, err := hwaccess.WriteFixture(t.TempDir(), map[string][]byte{
+			"sys/class/mtd/mtd0/name":   []byte("BIOS\n"),
+			"sys/class/mtd/mtd0/size":   []byte(fmt.Sprintf("%d\n", len(image))),
+			"sys/class/mtd/mtd0/offset": []byte("0\n"),
+			"dev/mtd0":                  image,
+		})
+		require.NoError(t, err)
+
+		dump, info, err := DumpWithInfo(ctx, OptionHardware{Hardware: hw})
+		require.NoError(t, err)
+		require.Equal(t, DumpMethodMTD, info.Method)
+		require.Equal(t, image, dump)
+	})
+
+	t.Run("devMemRegions", func(t *testing.T) {
+		hw, err := hwaccess.WriteFixture(t.TempDir(), map[string][]byte{
+			hwaccess.PathIOMem:               []byte("fffff000-ffffffff : Reserved\n"),
+			hwaccess.PhysMemPath(0xfffff000): image,
+		})
+		require.NoError(t, err)
+
+		img, err := DumpRegions(ctx, Regions{
+			MappedRanges: pkgbytes.Ranges{{Offset: 0xfffffff0, Length: 0x10}},
+		}, OptionHardware{Hardware: hw}, OptionDumpMethod(DumpMethodDevMem))
+		require.NoError(t, err)
+		require.Equal(t, pkgbytes.Ranges{{Offset: 0xff0, Length: 0x10}}, img.Coverage)
+		require.Equal(t, image[0xff0:], img.Image[0xff0:])
+	})
+
+	t.Run("devMemRegionsPlaceholderHead", func(t *testing.T) {
+		// the mapped BIOS region starts with a placeholder filled with 0xFF,
+		// which is not a part of the image (see dumpDevMem)
+		biosRegion := append(bytes.Repeat([]byte{0xff}, devMemPlaceholderWordLength), bytes.Repeat(image, 0x10)...)
+		hw, err := hwaccess.WriteFixture(t.TempDir(), map[string][]byte{
+			hwaccess.PathIOMem:               []byte("fffe0000-ffffffff : Reserved\n"),
+			hwaccess.PhysMemPath(0xfffe0000): biosRegion,
+		})
+		require.NoError(t, err)
+
+		img, err := DumpRegions(ctx, Regions{
+			MappedRanges: pkgbytes.Ranges{
+				{Offset: 0xfffe0000, Length: 0x10},
+				{Offset: 0xfffffff0, Length: 0x10},
+			},
+		}, OptionHardware{Hardware: hw}, OptionDumpMethod(DumpMethodDevMem))
+		require.NoError(t, err)
+		require.Len(t, img.Image, 0x10000)
+		require.Equal(t, pkgbytes.Ranges{{Offset: 0xfff0, Length: 0x10}}, img.Coverage)
+		require.Equal(t, biosRegion[0x1fff0:], img.Image[0xfff0:])
+	})
+
+	t.Run("noExternalTools", func(t *testing.T) {
+		hw, err := hwaccess.NewFixture(t.TempDir())
+		require.NoError(t, err)
+
+		_, err = Dump(ctx, OptionHardware{Hardware: hw}, OptionDumpMethod(DumpMethodFlashrom))
+		require.Error(t, err)
+	})
+}
@@ -0,0 +1,133 @@
+// Copyright 2023 Meta Platforms, Inc. and affiliates.
+//
+// Redistribution and use in source and binary forms, with or without modification, are permitted provided that the following conditions are met:
+//
+// 1. Redistributions of source code must retain the above copyright notice, this list of conditions and the following disclaimer.
+//
+// 2. Redistributions in binary form must reproduce the above copyright notice, this list of conditions and the following disclaimer in the documentation and/or other materials provided with the distribution.
+//
+// 3. Neither the name of the copyright holder nor the names of its contributors may be used to endorse or promote products derived from this software without specific prior written permission.
+//
+// THIS SOFTWARE IS PROVIDED BY THE COPYRIGHT HOLDERS AND CONTRIBUTORS "AS IS" AND ANY EXPRESS OR IMPLIED WARRANTIES, INCLUDING, BUT NOT LIMITED TO, THE IMPLIED WARRANTIES OF MERCHANTABILITY AND FITNESS FOR A PARTICULAR PURPOSE ARE DISCLAIMED. IN NO EVENT SHALL THE COPYRIGHT HOLDER OR CONTRIBUTORS BE LIABLE FOR ANY DIRECT, INDIRECT, INCIDENTAL, SPECIAL, EXEMPLARY, OR CONSEQUENTIAL DAMAGES (INCLUDING, BUT NOT LIMITED TO, PROCUREMENT OF SUBSTITUTE GOODS OR SERVICES; LOSS OF USE, DATA, OR PROFITS; OR BUSINESS INTERRUPTION) HOWEVER CAUSED AND ON ANY THEORY OF LIABILITY, WHETHER IN CONTRACT, STRICT LIABILITY, OR TORT (INCLUDING NEGLIGENCE OR OTHERWISE) ARISING IN ANY WAY OUT OF THE USE OF THIS SOFTWARE, EVEN IF ADVISED OF THE POSSIBILITY OF SUCH DAMAGE.
+
+package flashrom
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/facebookincubator/go-belt/tool/logger"
+	pkgbytes "github.com/linuxboot/fiano/pkg/bytes"
+)
+
+const (
+	// unstableRangesMergeDistance is the maximal distance between unstable
+	// bytes to be reported as a single range.
+	unstableRangesMergeDistance = 16
+)
+
+// String implements fmt.Stringer.
+func (method DumpMethod) String() string {
+	switch method {
+	case DumpMethodAuto:
+		return "auto"
+	case DumpMethodFlashrom:
+		return "flashrom"
+	case DumpMethodAfulnx64:
+		return "afulnx64"
+	case DumpMethodDevMem:
+		return "devmem"
+	case DumpMethodMTD:
+		return "mtd"
+	}
+	return fmt.Sprintf("unknown_%d", int(method))
+}
+
+// DumpQuality defines how reliable is a dumped image.
+type DumpQuality int
+
+const (
+	// DumpQualityUnverified means the image was read once, so
+	// there is no information about its reliability.
+	DumpQualityUnverified = DumpQuality(iota)
+
+	// DumpQualityVerified means the image was read twice
+	// and the reads are identical.
+	DumpQualityVerified
+
+	// DumpQualityUnstable means the image was read twice and the
+	// reads differ, see DumpInfo.UnstableRanges.
+	DumpQualityUnstable
+)
+
+// String implements fmt.Stringer.
+func (quality DumpQuality) String() string {
+	switch quality {
+	case DumpQualityUnverified:
+		return "unverified"
+	case DumpQualityVerified:
+		return "verified"
+	case DumpQualityUnstable:
+		return "unstable"
+	}
+	return fmt.Sprintf("unknown_%d", int(quality))
+}
+
+// DumpInfo describes how a firmware image was acquired.
+type DumpInfo struct {
+	// Method is the method which was actually used (it is never DumpMethodAuto).
+	Method DumpMethod
+
+	// Quality defines how reliable is the image.
+	Quality DumpQuality
+
+	// UnstableRanges are the ranges which differ between reads.
+	UnstableRanges pkgbytes.Ranges
+
+	// Fallback is true if all the methods failed and the returned image is
+	// the best-effort result of a failed method (see FallbackError).
+	Fallback bool
+
+	// FallbackError is the error of the method used as the fallback.
+	FallbackError string
+}
+
+// verifyDump reads the image again using `readAgain` and updates Quality and UnstableRanges of `info`.
+func (f *flashrom) verifyDump(
+	ctx context.Context,
+	image []byte,
+	info *DumpInfo,
+	readAgain func(ctx context.Context) ([]byte, error),
+) {
+	log := logger.FromCtx(ctx)
+	secondImage, err := readAgain(ctx)
+	if err != nil {
+		log.Warnf("unable to read the image the second time using method '%s': %v", info.Method, err)
+		info.Quality = DumpQualityUnverified
+		return
+	}
+
+	info.UnstableRanges = compareReads(image, secondImage)
+	if len(info.UnstableRanges) == 0 {
+		info.Quality = DumpQualityVerified
+		return
+	}
+	info.Quality = DumpQualityUnstable
+	log.Warnf("the image reads differ at ranges %s", info.UnstableRanges)
+}
+
+// compareReads returns the ranges of `image` which differ in `secondImage`.
+func compareReads(image, secondImage []byte) pkgbytes.Ranges {
+	if len(image) != len(secondImage) {
+		return pkgbytes.Ranges{{Offset: 0, Length: uint64(len(image))}}
+	}
+
+	var result pkgbytes.Ranges
+	for idx := range image {
+		if image[idx] == secondImage[idx] {
+			continue
+		}
+		result = append(result, pkgbytes.Range{Offset: uint64(idx), Length: 1})
+	}
+	return pkgbytes.MergeRanges(result, unstableRangesMergeDistance)
+}
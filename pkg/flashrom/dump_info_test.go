@@ -0,0 +1,73 @@
+// Copyright 2023 Meta Platforms, Inc. and affiliates.
+//
+// Redistribution and use in source and binary forms, with or without modification, are permitted provided that the following conditions are met:
+//
+// 1. Redistributions of source code must retain the above copyright notice, this list of conditions and the following disclaimer.
+//
+// 2. Redistributions in binary form must reproduce the above copyright notice, this list of conditions and the following disclaimer in the documentation and/or other materials provided with the distribution.
+//
+// 3. Neither the name of the copyright holder nor the names of its contributors may be used to endorse or promote products derived from this software without specific prior written permission.
+//
+// THIS SOFTWARE IS PROVIDED BY THE COPYRIGHT HOLDERS AND CONTRIBUTORS "AS IS" AND ANY EXPRESS OR IMPLIED WARRANTIES, INCLUDING, BUT NOT LIMITED TO, THE IMPLIED WARRANTIES OF MERCHANTABILITY AND FITNESS FOR A PARTICULAR PURPOSE ARE DISCLAIMED. IN NO EVENT SHALL THE COPYRIGHT HOLDER OR CONTRIBUTORS BE LIABLE FOR ANY DIRECT, INDIRECT, INCIDENTAL, SPECIAL, EXEMPLARY, OR CONSEQUENTIAL DAMAGES (INCLUDING, BUT NOT LIMITED TO, PROCUREMENT OF SUBSTITUTE GOODS OR SERVICES; LOSS OF USE, DATA, OR PROFITS; OR BUSINESS INTERRUPTION) HOWEVER CAUSED AND ON ANY THEORY OF LIABILITY, WHETHER IN CONTRACT, STRICT LIABILITY, OR TORT (INCLUDING NEGLIGENCE OR OTHERWISE) ARISING IN ANY WAY OUT OF THE USE OF THIS SOFTWARE, EVEN IF ADVISED OF THE POSSIBILITY OF SUCH DAMAGE.
+
+package flashrom
+
+import (
+	"context"
+	"os"
+	"testing"
+
+	pkgbytes "github.com/linuxboot/fiano/pkg/bytes"
+	"github.com/stretchr/testify/require"
+)
+
+func TestDumpWithInfoDoubleRead(t *testing.T) {
+	ctx := context.Background()
+
+	newMock := func(reads ...[]byte) *flashrom {
+		execCount := 0
+		return &flashrom{
+			Config: getConfig(OptionDumpMethod(DumpMethodFlashrom), OptionDoubleRead(true)),
+			overrideExecCommandFunc: func(ctx context.Context, name string, args ...string) process {
+				require.NoError(t, os.WriteFile(args[len(args)-1], reads[execCount], 0000))
+				execCount++
+				return &mockProcess{}
+			},
+		}
+	}
+
+	t.Run("stable", func(t *testing.T) {
+		image := []byte("0123456789abcdef")
+		f := newMock(image, image)
+		result, info, err := f.DumpWithInfo(ctx)
+		require.NoError(t, err)
+		require.Equal(t, image, result)
+		require.Equal(t, DumpMethodFlashrom, info.Method)
+		require.Equal(t, DumpQualityVerified, info.Quality)
+		require.Empty(t, info.UnstableRanges)
+	})
+
+	t.Run("unstable", func(t *testing.T) {
+		image := make([]byte, 0x100)
+		secondImage := make([]byte, 0x100)
+		secondImage[0x10] = 1
+		secondImage[0x18] = 1
+		secondImage[0x80] = 1
+		f := newMock(image, secondImage)
+		_, info, err := f.DumpWithInfo(ctx)
+		require.NoError(t, err)
+		require.Equal(t, DumpQualityUnstable, info.Quality)
+		require.Equal(t, pkgbytes.Ranges{
+			{Offset: 0x10, Length: 0x9},
+			{Offset: 0x80, Length: 0x1},
+		}, info.UnstableRanges)
+	})
+
+	t.Run("differentSize", func(t *testing.T) {
+		f := newMock(make([]byte, 0x10), make([]byte, 0x20))
+		_, info, err := f.DumpWithInfo(ctx)
+		require.NoError(t, err)
+		require.Equal(t, DumpQualityUnstable, info.Quality)
+		require.Equal(t, pkgbytes.Ranges{{Offset: 0, Length: 0x10}}, info.UnstableRanges)
+	})
+}
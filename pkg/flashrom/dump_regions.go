@@ -0,0 +1,251 @@
+// Copyright 2023 Meta Platforms, Inc. and affiliates.
+//
+// Redistribution and use in source and binary forms, with or without modification, are permitted provided that the following conditions are met:
+//
+// 1. Redistributions of source code must retain the above copyright notice, this list of conditions and the following disclaimer.
+//
+// 2. Redistributions in binary form must reproduce the above copyright notice, this list of conditions and the following disclaimer in the documentation and/or other materials provided with the distribution.
+//
+// 3. Neither the name of the copyright holder nor the names of its contributors may be used to endorse or promote products derived from this software without specific prior written permission.
+//
+// THIS SOFTWARE IS PROVIDED BY THE COPYRIGHT HOLDERS AND CONTRIBUTORS "AS IS" AND ANY EXPRESS OR IMPLIED WARRANTIES, INCLUDING, BUT NOT LIMITED TO, THE IMPLIED WARRANTIES OF MERCHANTABILITY AND FITNESS FOR A PARTICULAR PURPOSE ARE DISCLAIMED. IN NO EVENT SHALL THE COPYRIGHT HOLDER OR CONTRIBUTORS BE LIABLE FOR ANY DIRECT, INDIRECT, INCIDENTAL, SPECIAL, EXEMPLARY, OR CONSEQUENTIAL DAMAGES (INCLUDING, BUT NOT LIMITED TO, PROCUREMENT OF SUBSTITUTE GOODS OR SERVICES; LOSS OF USE, DATA, OR PROFITS; OR BUSINESS INTERRUPTION) HOWEVER CAUSED AND ON ANY THEORY OF LIABILITY, WHETHER IN CONTRACT, STRICT LIABILITY, OR TORT (INCLUDING NEGLIGENCE OR OTHERWISE) ARISING IN ANY WAY OUT OF THE USE OF THIS SOFTWARE, EVEN IF ADVISED OF THE POSSIBILITY OF SUCH DAMAGE.
+
+package flashrom
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"time"
+
+	"github.com/facebookincubator/go-belt/tool/logger"
+	pkgbytes "github.com/linuxboot/fiano/pkg/bytes"
+	"github.com/linuxboot/fiano/pkg/uefi"
+)
+
+// DumpRegions dumps only the requested regions of a firmware image on the local machine.
+//
+// Only DumpMethodFlashrom and DumpMethodDevMem are able to dump an image partially.
+// Other methods dump the whole image (which is returned as a complete SparseImage).
+//
+// DumpMethodDevMem has access only to the BIOS region mapped into the memory, thus
+// the returned image is the BIOS region, and only uefi.RegionTypeBIOS and
+// MappedRanges could be requested.
+//
+// The layout of a partial image is always the same as of a complete image
+// dumped by Dump with the same method (for example, the placeholder in the beginning
+// of the BIOS region is skipped by DumpMethodDevMem in both cases), thus
+// the same offsets could be used to analyze both.
+func DumpRegions(ctx context.Context, regions Regions, opts ...Option) (*SparseImage, error) {
+	return newFlashrom(opts...).DumpRegions(ctx, regions)
+}
+
+// DumpRegions dumps only the requested regions of a firmware image on the local machine.
+func (f *flashrom) DumpRegions(ctx context.Context, regions Regions) (*SparseImage, error) {
+	image, err := f.dumpRegions(ctx, regions, f.Config.DumpMethod)
+	if err != nil {
+		return nil, err
+	}
+	if f.Config.DoubleRead {
+		f.verifyDump(ctx, image.Image, &image.Info, func(ctx context.Context) ([]byte, error) {
+			secondImage, err := f.dumpRegions(ctx, regions, image.Info.Method)
+			if err != nil {
+				return nil, err
+			}
+			return secondImage.Image, nil
+		})
+	}
+	return image, nil
+}
+
+func (f *flashrom) dumpRegions(ctx context.Context, regions Regions, method DumpMethod) (*SparseImage, error) {
+	if regions.IsEmpty() || method == DumpMethodAfulnx64 || method == DumpMethodMTD {
+		image, info, err := f.dump(ctx, method)
+		if err != nil {
+			return nil, err
+		}
+		return newCompleteImage(image, *info), nil
+	}
+
+	switch method {
+	case DumpMethodAuto:
+		return f.dumpRegionsAuto(ctx, regions)
+	case DumpMethodFlashrom:
+		return f.dumpRegionsFlashrom(ctx, regions)
+	case DumpMethodDevMem:
+		return f.dumpRegionsDevMem(ctx, regions)
+	}
+	return nil, fmt.Errorf("invalid dump method: %v", method)
+}
+
+func (f *flashrom) dumpRegionsAuto(ctx context.Context, regions Regions) (*SparseImage, error) {
+	image, flashRomErr := f.dumpRegionsFlashrom(ctx, regions)
+	logger.FromCtx(ctx).Debugf("flashrom error: %v", flashRomErr)
+	if flashRomErr == nil {
+		return image, nil
+	}
+
+	image, devMemDumpErr := f.dumpRegionsDevMem(ctx, regions)
+	logger.FromCtx(ctx).Debugf("'/dev/mem' dumper error: %v", devMemDumpErr)
+	if devMemDumpErr == nil {
+		return image, nil
+	}
+
+	if f.Config.Afulnx64Path != `` {
+		imageBytes, err := f.dumpAfulnx64(ctx)
+		logger.FromCtx(ctx).Debugf("afulnx64 error: %v", err)
+		if err == nil {
+			return newCompleteImage(imageBytes, DumpInfo{Method: DumpMethodAfulnx64}), nil
+		}
+	}
+
+	imageBytes, mtdErr := f.dumpMTD(ctx)
+	logger.FromCtx(ctx).Debugf("MTD dumper error: %v", mtdErr)
+	if mtdErr == nil {
+		return newCompleteImage(imageBytes, DumpInfo{Method: DumpMethodMTD}), nil
+	}
+
+	return nil, fmt.Errorf("unable to find a working way to dump the regions; flashRomErr: '%v'; devMemDumpErr: '%v', mtdErr: '%v'",
+		flashRomErr, devMemDumpErr, mtdErr)
+}
+
+func (f *flashrom) dumpRegionsFlashrom(ctx context.Context, regions Regions) (*SparseImage, error) {
+	// See the comment in dumpFlashrom.
+	ctx, cancelFunc := context.WithTimeout(ctx, time.Minute*5)
+	defer cancelFunc()
+
+	var (
+		fd       *uefi.FlashDescriptor
+		coverage pkgbytes.Ranges
+	)
+	if len(regions.IFD) != 0 || len(regions.MappedRanges) != 0 {
+		descriptorRange := pkgbytes.Range{Offset: 0, Length: uefi.FlashDescriptorLength}
+		image, err := f.readRangesFlashrom(ctx, pkgbytes.Ranges{descriptorRange})
+		if err != nil {
+			return nil, fmt.Errorf("unable to read the flash descriptor: %w", err)
+		}
+		fd, err = parseFlashDescriptor(image)
+		if err != nil {
+			return nil, err
+		}
+		coverage = append(coverage, descriptorRange)
+	}
+
+	ranges, err := regions.flashRanges(fd)
+	if err != nil {
+		return nil, err
+	}
+	coverage = append(coverage, ranges...)
+	coverage.SortAndMerge()
+
+	image, err := f.readRangesFlashrom(ctx, coverage)
+	if err != nil {
+		return nil, err
+	}
+	for _, r := range coverage {
+		if r.End() > uint64(len(image)) {
+			return nil, fmt.Errorf("range %s is outside of the image of size 0x%X", r, len(image))
+		}
+	}
+	return newSparseImage(image, coverage, DumpInfo{Method: DumpMethodFlashrom}), nil
+}
+
+// readRangesFlashrom reads the selected ranges of the flash chip using a generated layout file.
+//
+// The returned image has the size of the flash chip.
+func (f *flashrom) readRangesFlashrom(ctx context.Context, ranges pkgbytes.Ranges) ([]byte, error) {
+	layoutFile, err := os.CreateTemp("", "afas-flashrom-layout-*.txt")
+	if err != nil {
+		return nil, fmt.Errorf("unable to create a layout file: %w", err)
+	}
+	defer func() {
+		_ = os.Remove(layoutFile.Name())
+	}()
+
+	args := []string{
+		"-p", flashromProgrammer,
+		"--layout", layoutFile.Name(),
+	}
+	for idx, r := range ranges {
+		if r.Length == 0 {
+			continue
+		}
+		name := fmt.Sprintf("range%d", idx)
+		if _, err := fmt.Fprintf(layoutFile, "%08x:%08x %s\n", r.Offset, r.End()-1, name); err != nil {
+			_ = layoutFile.Close()
+			return nil, fmt.Errorf("unable to write the layout file '%s': %w", layoutFile.Name(), err)
+		}
+		args = append(args, "-i", name)
+	}
+	if err := layoutFile.Close(); err != nil {
+		return nil, fmt.Errorf("unable to close the layout file '%s': %w", layoutFile.Name(), err)
+	}
+	args = append(args, "-r", outputPathArgument)
+
+	image, err := f.execReceive(ctx, f.Config.FlashromPath, args...)
+	if err != nil {
+		return nil, fmt.Errorf("unable to read ranges %s: %w", ranges, err)
+	}
+	return image, nil
+}
+
+func (f *flashrom) dumpRegionsDevMem(ctx context.Context, regions Regions) (*SparseImage, error) {
+	if len(regions.Ranges) != 0 {
+		return nil, fmt.Errorf("flash image ranges are not accessible through '%s', only mapped ranges are", f.Config.DevMemPath)
+	}
+	for _, regionType := range regions.IFD {
+		if regionType != uefi.RegionTypeBIOS {
+			return nil, fmt.Errorf("IFD region '%s' is not accessible through '%s'", regionType, f.Config.DevMemPath)
+		}
+	}
+
+	biosRange, err := f.findBIOSRegionUsingIOMem()
+	if err != nil {
+		return nil, fmt.Errorf("unable to find the BIOS region range: %w", err)
+	}
+
+	for _, r := range regions.MappedRanges {
+		if r.Offset < biosRange.Offset || r.End() > biosRange.End() {
+			return nil, fmt.Errorf("range %s is outside of the mapped BIOS region %s", r, biosRange)
+		}
+	}
+
+	// The same as dumpDevMem does:
+	headLength, err := f.devMemPlaceholderHeadLength(*biosRange)
+	if err != nil {
+		return nil, fmt.Errorf("unable to check the placeholder in the beginning of the BIOS region: %w", err)
+	}
+	imageRange := pkgbytes.Range{
+		Offset: biosRange.Offset + headLength,
+		Length: biosRange.Length - headLength,
+	}
+
+	var coverage pkgbytes.Ranges
+	if len(regions.IFD) != 0 {
+		coverage = append(coverage, pkgbytes.Range{Offset: 0, Length: imageRange.Length})
+	}
+	for _, r := range regions.MappedRanges {
+		if r.End() <= imageRange.Offset {
+			// the range is within the placeholder, which is not a part of the image
+			continue
+		}
+		start := r.Offset
+		if start < imageRange.Offset {
+			start = imageRange.Offset
+		}
+		coverage = append(coverage, pkgbytes.Range{
+			Offset: start - imageRange.Offset,
+			Length: r.End() - start,
+		})
+	}
+	coverage.SortAndMerge()
+
+	image := make([]byte, imageRange.Length)
+	for _, r := range coverage {
+		if err := f.readPhysMem(imageRange.Offset+r.Offset, image[r.Offset:r.End()]); err != nil {
+			return nil, err
+		}
+	}
+
+	return newSparseImage(image, coverage, DumpInfo{Method: DumpMethodDevMem}), nil
+}
@@ -0,0 +1,155 @@
+// Copyright 2023 Meta Platforms, Inc. and affiliates.
+//
+// Redistribution and use in source and binary forms, with or without modification, are permitted provided that the following conditions are met:
+//
+// 1. Redistributions of source code must retain the above copyright notice, this list of conditions and the following disclaimer.
+//
+// 2. Redistributions in binary form must reproduce the above copyright notice, this list of conditions and the following disclaimer in the documentation and/or other materials provided with the distribution.
+//
+// 3. Neither the name of the copyright holder nor the names of its contributors may be used to endorse or promote products derived from this software without specific prior written permission.
+//
+// THIS SOFTWARE IS PROVIDED BY THE COPYRIGHT HOLDERS AND CONTRIBUTORS "AS IS" AND ANY EXPRESS OR IMPLIED WARRANTIES, INCLUDING, BUT NOT LIMITED TO, THE IMPLIED WARRANTIES OF MERCHANTABILITY AND FITNESS FOR A PARTICULAR PURPOSE ARE DISCLAIMED. IN NO EVENT SHALL THE COPYRIGHT HOLDER OR CONTRIBUTORS BE LIABLE FOR ANY DIRECT, INDIRECT, INCIDENTAL, SPECIAL, EXEMPLARY, OR CONSEQUENTIAL DAMAGES (INCLUDING, BUT NOT LIMITED TO, PROCUREMENT OF SUBSTITUTE GOODS OR SERVICES; LOSS OF USE, DATA, OR PROFITS; OR BUSINESS INTERRUPTION) HOWEVER CAUSED AND ON ANY THEORY OF LIABILITY, WHETHER IN CONTRACT, STRICT LIABILITY, OR TORT (INCLUDING NEGLIGENCE OR OTHERWISE) ARISING IN ANY WAY OUT OF THE USE OF THIS SOFTWARE, EVEN IF ADVISED OF THE POSSIBILITY OF SUCH DAMAGE.
+
+package flashrom
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"os"
+	"strings"
+	"testing"
+
+	pkgbytes "github.com/linuxboot/fiano/pkg/bytes"
+	"github.com/linuxboot/fiano/pkg/uefi"
+	"github.com/stretchr/testify/require"
+)
+
+const testChipSize = 0x100000
+
+// newTestChipImage returns a flash image with a flash descriptor, defining the
+// ME region [0x1000:0x80000] and the BIOS region [0x80000:0x100000].
+func newTestChipImage() []byte {
+	image := bytes.Repeat([]byte{0x42}, testChipSize)
+	copy(image[0x10:], uefi.FlashSignature)
+	copy(image[0x14:], []byte{0x03, 0x00, 0x04, 0x02, 0x06, 0x00, 0x00, 0x00})
+	// region section at 0x40; FLREG0 (the descriptor) is skipped by fiano
+	copy(image[0x40:], []byte{
+		0x00, 0x00, 0x00, 0x00,
+		0x80, 0x00, 0xff, 0x00, // BIOS
+		0x01, 0x00, 0x7f, 0x00, // ME
+	})
+	for idx := 0x4c; idx < 0x80; idx++ {
+		image[idx] = 0
+	}
+	return image
+}
+
+// newFlashromMock returns a flashrom mock which reads only the regions included
+// from the layout file, and fills the rest with zeros.
+func newFlashromMock(t *testing.T, chipImage []byte, execCount *int) *flashrom {
+	return &flashrom{
+		Config: getConfig(),
+		overrideExecCommandFunc: func(ctx context.Context, name string, args ...string) process {
+			*execCount++
+			var (
+				layout   map[string]pkgbytes.Range
+				included []string
+				output   string
+			)
+			for idx := 0; idx < len(args)-1; idx++ {
+				switch args[idx] {
+				case "--layout":
+					layoutBytes, err := os.ReadFile(args[idx+1])
+					require.NoError(t, err)
+					layout = map[string]pkgbytes.Range{}
+					for _, line := range strings.Split(strings.TrimSpace(string(layoutBytes)), "\n") {
+						var start, end uint64
+						var name string
+						_, err := fmt.Sscanf(line, "%x:%x %s", &start, &end, &name)
+						require.NoError(t, err)
+						layout[name] = pkgbytes.Range{Offset: start, Length: end - start + 1}
+					}
+				case "-i":
+					included = append(included, args[idx+1])
+				case "-r":
+					output = args[idx+1]
+				}
+			}
+
+			result := make([]byte, len(chipImage))
+			for _, name := range included {
+				r, ok := layout[name]
+				require.True(t, ok, name)
+				copy(result[r.Offset:r.End()], chipImage[r.Offset:r.End()])
+			}
+			require.NoError(t, os.WriteFile(output, result, 0000))
+			return &mockProcess{}
+		},
+	}
+}
+
+func TestDumpRegionsFlashrom(t *testing.T) {
+	ctx := context.Background()
+	chipImage := newTestChipImage()
+
+	t.Run("ifdRegion", func(t *testing.T) {
+		execCount := 0
+		f := newFlashromMock(t, chipImage, &execCount)
+		img, err := f.dumpRegionsFlashrom(ctx, Regions{IFD: []uefi.FlashRegionType{uefi.RegionTypeBIOS}})
+		require.NoError(t, err)
+		require.Equal(t, 2, execCount)
+		require.Len(t, img.Image, testChipSize)
+		require.Equal(t, pkgbytes.Ranges{
+			{Offset: 0, Length: 0x1000},
+			{Offset: 0x80000, Length: 0x80000},
+		}, img.Coverage)
+		require.Equal(t, pkgbytes.Ranges{{Offset: 0x1000, Length: 0x7f000}}, img.MissingRanges())
+		require.False(t, img.IsComplete())
+		require.True(t, img.IsCovered(pkgbytes.Range{Offset: 0x90000, Length: 0x100}))
+		require.False(t, img.IsCovered(pkgbytes.Range{Offset: 0x7ff00, Length: 0x200}))
+		require.Equal(t, chipImage[0x80000:], img.Image[0x80000:])
+		require.Equal(t, bytes.Repeat([]byte{0xff}, 0x7f000), img.Image[0x1000:0x80000])
+	})
+
+	t.Run("mappedRange", func(t *testing.T) {
+		execCount := 0
+		f := newFlashromMock(t, chipImage, &execCount)
+		img, err := f.dumpRegionsFlashrom(ctx, Regions{MappedRanges: pkgbytes.Ranges{{Offset: 0xffffffc0, Length: 0x40}}})
+		require.NoError(t, err)
+		require.Equal(t, pkgbytes.Ranges{
+			{Offset: 0, Length: 0x1000},
+			{Offset: 0xfffc0, Length: 0x40},
+		}, img.Coverage)
+		require.Equal(t, chipImage[0xfffc0:], img.Image[0xfffc0:])
+	})
+
+	t.Run("rangeOnly", func(t *testing.T) {
+		execCount := 0
+		f := newFlashromMock(t, chipImage, &execCount)
+		img, err := f.dumpRegionsFlashrom(ctx, Regions{Ranges: pkgbytes.Ranges{{Offset: 0x2000, Length: 0x1000}}})
+		require.NoError(t, err)
+		require.Equal(t, 1, execCount)
+		require.Equal(t, pkgbytes.Ranges{{Offset: 0x2000, Length: 0x1000}}, img.Coverage)
+	})
+
+	t.Run("undefinedRegion", func(t *testing.T) {
+		execCount := 0
+		f := newFlashromMock(t, chipImage, &execCount)
+		_, err := f.dumpRegionsFlashrom(ctx, Regions{IFD: []uefi.FlashRegionType{uefi.RegionTypeGBE}})
+		require.Error(t, err)
+	})
+}
+
+func TestParseIFDRegionType(t *testing.T) {
+	regionType, err := ParseIFDRegionType("bios")
+	require.NoError(t, err)
+	require.Equal(t, uefi.RegionTypeBIOS, regionType)
+
+	regionType, err = ParseIFDRegionType("GBE")
+	require.NoError(t, err)
+	require.Equal(t, uefi.RegionTypeGBE, regionType)
+
+	_, err = ParseIFDRegionType("unknown")
+	require.Error(t, err)
+}
@@ -28,6 +28,11 @@ import (
 const outputPathArgument = "${OUTPUT_PATH}"
 
 func (f *flashrom) execReceive(ctx context.Context, cmd string, args ...string) ([]byte, error) {
+	if f.overrideExecCommandFunc == nil && f.Config.Hardware != nil && !f.Config.Hardware.IsHost() {
+		// External tools access the host hardware on their own.
+		return nil, fmt.Errorf("unable to execute '%s': external tools are available only on the host hardware", cmd)
+	}
+
 	// Unfortunately some tools like flashrom does not allow to suppress debugging
 	// messages. Moreover it writes different debug messages to both pipes:
 	// stdout and stderr. So there's no way to collect the firmware images
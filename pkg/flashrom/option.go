@@ -15,6 +15,10 @@
 
 package flashrom
 
+import (
+	"github.com/immune-gmbh/AttestationFailureAnalysisService/pkg/hwaccess"
+)
+
 const (
 	// DefaultDevMemPath is the default path where to look for the device
 	// to access memory by physical addresses.
@@ -72,6 +76,8 @@ type config struct {
 	FirmwareFallbackLayoutPath string
 	DevPath                    string
 	SysFSMTDPath               string
+	DoubleRead                 bool
+	Hardware                   hwaccess.Interface
 }
 
 // Option is an abstract option for flashrom commands.
@@ -147,6 +153,33 @@ func (opt OptionSysFSMTDPath) apply(cfg *config) {
 	cfg.SysFSMTDPath = string(opt)
 }
 
+// OptionDoubleRead is an Option which defines if the image should be read
+// twice to find the unstable ranges, see DumpInfo.
+type OptionDoubleRead bool
+
+func (opt OptionDoubleRead) apply(cfg *config) {
+	cfg.DoubleRead = bool(opt)
+}
+
+// OptionHardware is an Option which defines the hardware to dump the image
+// from (see package hwaccess). It overrides the paths to the host files
+// (DevMemPath, IOMemPath, DevPath and SysFSMTDPath), thus other path options
+// should be applied after this one.
+//
+// External tools (like "flashrom") are used only if the hardware is the host
+// one (see hwaccess.Interface.IsHost).
+type OptionHardware struct {
+	Hardware hwaccess.Interface
+}
+
+func (opt OptionHardware) apply(cfg *config) {
+	cfg.Hardware = opt.Hardware
+	cfg.DevMemPath = opt.Hardware.Path(hwaccess.PathDevMem)
+	cfg.IOMemPath = opt.Hardware.Path(hwaccess.PathIOMem)
+	cfg.DevPath = opt.Hardware.Path(hwaccess.PathDev)
+	cfg.SysFSMTDPath = opt.Hardware.Path(hwaccess.PathSysFSMTD)
+}
+
 func getConfig(opts ...Option) config {
 	cfg := config{
 		IOMemPath:    DefaultIOMemPath,
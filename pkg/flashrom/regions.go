@@ -0,0 +1,131 @@
+// Copyright 2023 Meta Platforms, Inc. and affiliates.
+//
+// Redistribution and use in source and binary forms, with or without modification, are permitted provided that the following conditions are met:
+//
+// 1. Redistributions of source code must retain the above copyright notice, this list of conditions and the following disclaimer.
+//
+// 2. Redistributions in binary form must reproduce the above copyright notice, this list of conditions and the following disclaimer in the documentation and/or other materials provided with the distribution.
+//
+// 3. Neither the name of the copyright holder nor the names of its contributors may be used to endorse or promote products derived from this software without specific prior written permission.
+//
+// THIS SOFTWARE IS PROVIDED BY THE COPYRIGHT HOLDERS AND CONTRIBUTORS "AS IS" AND ANY EXPRESS OR IMPLIED WARRANTIES, INCLUDING, BUT NOT LIMITED TO, THE IMPLIED WARRANTIES OF MERCHANTABILITY AND FITNESS FOR A PARTICULAR PURPOSE ARE DISCLAIMED. IN NO EVENT SHALL THE COPYRIGHT HOLDER OR CONTRIBUTORS BE LIABLE FOR ANY DIRECT, INDIRECT, INCIDENTAL, SPECIAL, EXEMPLARY, OR CONSEQUENTIAL DAMAGES (INCLUDING, BUT NOT LIMITED TO, PROCUREMENT OF SUBSTITUTE GOODS OR SERVICES; LOSS OF USE, DATA, OR PROFITS; OR BUSINESS INTERRUPTION) HOWEVER CAUSED AND ON ANY THEORY OF LIABILITY, WHETHER IN CONTRACT, STRICT LIABILITY, OR TORT (INCLUDING NEGLIGENCE OR OTHERWISE) ARISING IN ANY WAY OUT OF THE USE OF THIS SOFTWARE, EVEN IF ADVISED OF THE POSSIBILITY OF SUCH DAMAGE.
+
+package flashrom
+
+import (
+	"fmt"
+	"strings"
+
+	pkgbytes "github.com/linuxboot/fiano/pkg/bytes"
+	"github.com/linuxboot/fiano/pkg/uefi"
+)
+
+const (
+	// maxIFDRegions is the maximal amount of regions in an Intel Flash Descriptor.
+	maxIFDRegions = 15
+
+	// biosRegionMappedEnd is the physical address right after the end of
+	// the BIOS region mapped into the memory.
+	biosRegionMappedEnd = uint64(1) << 32
+)
+
+// Regions defines which parts of a firmware image should be dumped, see DumpRegions.
+type Regions struct {
+	// IFD is the list of regions to be dumped as they are defined
+	// by the Intel Flash Descriptor.
+	IFD []uefi.FlashRegionType
+
+	// Ranges is the list of ranges to be dumped, the offsets are relative to
+	// the beginning of the flash image.
+	Ranges pkgbytes.Ranges
+
+	// MappedRanges is the list of ranges to be dumped, the offsets are
+	// physical addresses of the BIOS region mapped below 4GiB. For example
+	// the FIT pointer is located at 0xFFFFFFC0.
+	MappedRanges pkgbytes.Ranges
+}
+
+// IsEmpty returns true if no region is requested.
+func (regions Regions) IsEmpty() bool {
+	return len(regions.IFD) == 0 && len(regions.Ranges) == 0 && len(regions.MappedRanges) == 0
+}
+
+// ParseIFDRegionType parses a name of an IFD region (for example: "bios", "me", "gbe").
+func ParseIFDRegionType(name string) (uefi.FlashRegionType, error) {
+	for regionType := uefi.FlashRegionType(0); regionType < maxIFDRegions; regionType++ {
+		if strings.EqualFold(regionType.String(), name) {
+			return regionType, nil
+		}
+	}
+	return 0, fmt.Errorf("unknown IFD region '%s'", name)
+}
+
+// parseFlashDescriptor parses the Intel Flash Descriptor at the beginning of the image.
+func parseFlashDescriptor(image []byte) (*uefi.FlashDescriptor, error) {
+	if len(image) < uefi.FlashDescriptorLength {
+		return nil, fmt.Errorf("the image is too small to contain a flash descriptor: %d < %d", len(image), uefi.FlashDescriptorLength)
+	}
+	fd := &uefi.FlashDescriptor{}
+	fd.SetBuf(image[:uefi.FlashDescriptorLength])
+	if err := fd.ParseFlashDescriptor(); err != nil {
+		return nil, fmt.Errorf("unable to parse the flash descriptor: %w", err)
+	}
+	return fd, nil
+}
+
+// ifdRegionRange returns the range of a region defined in the flash descriptor.
+func ifdRegionRange(fd *uefi.FlashDescriptor, regionType uefi.FlashRegionType) (pkgbytes.Range, error) {
+	if regionType < 0 || regionType >= maxIFDRegions {
+		return pkgbytes.Range{}, fmt.Errorf("invalid IFD region type: %d", regionType)
+	}
+	region := fd.Region.FlashRegions[regionType]
+	if !region.Valid() {
+		return pkgbytes.Range{}, fmt.Errorf("IFD region '%s' is not defined in the flash descriptor", regionType)
+	}
+	return pkgbytes.Range{
+		Offset: uint64(region.BaseOffset()),
+		Length: uint64(region.EndOffset() - region.BaseOffset()),
+	}, nil
+}
+
+// flashRanges returns the requested ranges as offsets of the flash image.
+//
+// `fd` is required only if IFD regions or mapped ranges are requested.
+func (regions Regions) flashRanges(fd *uefi.FlashDescriptor) (pkgbytes.Ranges, error) {
+	var result pkgbytes.Ranges
+	result = append(result, regions.Ranges...)
+
+	if fd == nil {
+		if len(regions.IFD) != 0 || len(regions.MappedRanges) != 0 {
+			return nil, fmt.Errorf("the flash descriptor is required to find IFD regions and mapped ranges")
+		}
+		return result, nil
+	}
+
+	for _, regionType := range regions.IFD {
+		r, err := ifdRegionRange(fd, regionType)
+		if err != nil {
+			return nil, err
+		}
+		result = append(result, r)
+	}
+
+	if len(regions.MappedRanges) != 0 {
+		biosRange, err := ifdRegionRange(fd, uefi.RegionTypeBIOS)
+		if err != nil {
+			return nil, fmt.Errorf("unable to map physical addresses to the flash image: %w", err)
+		}
+		biosMappedStart := biosRegionMappedEnd - biosRange.Length
+		for _, r := range regions.MappedRanges {
+			if r.Offset < biosMappedStart || r.End() > biosRegionMappedEnd {
+				return nil, fmt.Errorf("range %s is outside of the mapped BIOS region [0x%X:0x%X]", r, biosMappedStart, biosRegionMappedEnd)
+			}
+			result = append(result, pkgbytes.Range{
+				Offset: biosRange.Offset + (r.Offset - biosMappedStart),
+				Length: r.Length,
+			})
+		}
+	}
+
+	return result, nil
+}
@@ -0,0 +1,73 @@
+// Copyright 2023 Meta Platforms, Inc. and affiliates.
+//
+// Redistribution and use in source and binary forms, with or without modification, are permitted provided that the following conditions are met:
+//
+// 1. Redistributions of source code must retain the above copyright notice, this list of conditions and the following disclaimer.
+//
+// 2. Redistributions in binary form must reproduce the above copyright notice, this list of conditions and the following disclaimer in the documentation and/or other materials provided with the distribution.
+//
+// 3. Neither the name of the copyright holder nor the names of its contributors may be used to endorse or promote products derived from this software without specific prior written permission.
+//
+// THIS SOFTWARE IS PROVIDED BY THE COPYRIGHT HOLDERS AND CONTRIBUTORS "AS IS" AND ANY EXPRESS OR IMPLIED WARRANTIES, INCLUDING, BUT NOT LIMITED TO, THE IMPLIED WARRANTIES OF MERCHANTABILITY AND FITNESS FOR A PARTICULAR PURPOSE ARE DISCLAIMED. IN NO EVENT SHALL THE COPYRIGHT HOLDER OR CONTRIBUTORS BE LIABLE FOR ANY DIRECT, INDIRECT, INCIDENTAL, SPECIAL, EXEMPLARY, OR CONSEQUENTIAL DAMAGES (INCLUDING, BUT NOT LIMITED TO, PROCUREMENT OF SUBSTITUTE GOODS OR SERVICES; LOSS OF USE, DATA, OR PROFITS; OR BUSINESS INTERRUPTION) HOWEVER CAUSED AND ON ANY THEORY OF LIABILITY, WHETHER IN CONTRACT, STRICT LIABILITY, OR TORT (INCLUDING NEGLIGENCE OR OTHERWISE) ARISING IN ANY WAY OUT OF THE USE OF THIS SOFTWARE, EVEN IF ADVISED OF THE POSSIBILITY OF SUCH DAMAGE.
+
+package flashrom
+
+import (
+	pkgbytes "github.com/linuxboot/fiano/pkg/bytes"
+)
+
+// SparseImage is a firmware image which was dumped only partially, see DumpRegions.
+type SparseImage struct {
+	// Image is the dumped image. The bytes outside of Coverage are
+	// filled with 0xFF and must not be interpreted as the image content.
+	Image []byte
+
+	// Coverage is the sorted list of the ranges of Image which were actually dumped.
+	Coverage pkgbytes.Ranges
+
+	// Info describes how the image was acquired.
+	Info DumpInfo
+}
+
+// newSparseImage returns a SparseImage, the bytes outside of `coverage` are overwritten with 0xFF.
+func newSparseImage(image []byte, coverage pkgbytes.Ranges, info DumpInfo) *SparseImage {
+	img := &SparseImage{
+		Image:    image,
+		Coverage: append(pkgbytes.Ranges{}, coverage...),
+		Info:     info,
+	}
+	img.Coverage.SortAndMerge()
+	for _, r := range img.MissingRanges() {
+		for idx := r.Offset; idx < r.End(); idx++ {
+			image[idx] = 0xff
+		}
+	}
+	return img
+}
+
+// newCompleteImage returns a SparseImage which covers the whole image.
+func newCompleteImage(image []byte, info DumpInfo) *SparseImage {
+	return &SparseImage{
+		Image:    image,
+		Coverage: pkgbytes.Ranges{{Offset: 0, Length: uint64(len(image))}},
+		Info:     info,
+	}
+}
+
+// MissingRanges returns the ranges of the image which were not dumped.
+func (img *SparseImage) MissingRanges() pkgbytes.Ranges {
+	return pkgbytes.Range{Offset: 0, Length: uint64(len(img.Image))}.Exclude(img.Coverage...)
+}
+
+// IsComplete returns true if the whole image was dumped.
+func (img *SparseImage) IsComplete() bool {
+	return len(img.MissingRanges()) == 0
+}
+
+// IsCovered returns true if the range `r` was dumped completely.
+func (img *SparseImage) IsCovered(r pkgbytes.Range) bool {
+	if r.End() > uint64(len(img.Image)) {
+		return false
+	}
+	return len(r.Exclude(img.Coverage...)) == 0
+}
@@ -0,0 +1,147 @@
+// Copyright 2023 Meta Platforms, Inc. and affiliates.
+//
+// Redistribution and use in source and binary forms, with or without modification, are permitted provided that the following conditions are met:
+//
+// 1. Redistributions of source code must retain the above copyright notice, this list of conditions and the following disclaimer.
+//
+// 2. Redistributions in binary form must reproduce the above copyright notice, this list of conditions and the following disclaimer in the documentation and/or other materials provided with the distribution.
+//
+// 3. Neither the name of the copyright holder nor the names of its contributors may be used to endorse or promote products derived from this software without specific prior written permission.
+//
+// THIS SOFTWARE IS PROVIDED BY THE COPYRIGHT HOLDERS AND CONTRIBUTORS "AS IS" AND ANY EXPRESS OR IMPLIED WARRANTIES, INCLUDING, BUT NOT LIMITED TO, THE IMPLIED WARRANTIES OF MERCHANTABILITY AND FITNESS FOR A PARTICULAR PURPOSE ARE DISCLAIMED. IN NO EVENT SHALL THE COPYRIGHT HOLDER OR CONTRIBUTORS BE LIABLE FOR ANY DIRECT, INDIRECT, INCIDENTAL, SPECIAL, EXEMPLARY, OR CONSEQUENTIAL DAMAGES (INCLUDING, BUT NOT LIMITED TO, PROCUREMENT OF SUBSTITUTE GOODS OR SERVICES; LOSS OF USE, DATA, OR PROFITS; OR BUSINESS INTERRUPTION) HOWEVER CAUSED AND ON ANY THEORY OF LIABILITY, WHETHER IN CONTRACT, STRICT LIABILITY, OR TORT (INCLUDING NEGLIGENCE OR OTHERWISE) ARISING IN ANY WAY OUT OF THE USE OF THIS SOFTWARE, EVEN IF ADVISED OF THE POSSIBILITY OF SUCH DAMAGE.
+// Package healthcheck implements HTTP endpoints reporting if a service
+// is alive ("/healthz") and if its dependencies are reachable ("/readyz").
+package healthcheck
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"sync"
+	"time"
+
+	"github.com/facebookincubator/go-belt/tool/logger"
+)
+
+const (
+	// DefaultTimeout is the default time limit of a single check.
+	DefaultTimeout = 5 * time.Second
+
+	statusOK   = "ok"
+	statusFail = "fail"
+)
+
+// CheckFunc returns an error if a dependency is not available.
+type CheckFunc func(ctx context.Context) error
+
+type check struct {
+	Name string
+	Func CheckFunc
+}
+
+// Checker checks the dependencies of a service (databases, storages and so on).
+type Checker struct {
+	// Timeout is the time limit of a single check.
+	Timeout time.Duration
+
+	checks []check
+}
+
+// NewChecker returns a new instance of Checker without checks, see Add.
+func NewChecker() *Checker {
+	return &Checker{
+		Timeout: DefaultTimeout,
+	}
+}
+
+// Add adds a check of a dependency with the given name.
+//
+// It is not safe to call Add concurrently with other methods.
+func (c *Checker) Add(name string, fn CheckFunc) {
+	c.checks = append(c.checks, check{Name: name, Func: fn})
+}
+
+// Check runs all the checks concurrently and returns the errors by check names.
+// The map contains an entry (with a nil error if succeeded) for each check.
+func (c *Checker) Check(ctx context.Context) map[string]error {
+	result := make(map[string]error, len(c.checks))
+	var (
+		mutex sync.Mutex
+		wg    sync.WaitGroup
+	)
+	for _, chk := range c.checks {
+		wg.Add(1)
+		go func(chk check) {
+			defer wg.Done()
+			ctx := ctx
+			if c.Timeout > 0 {
+				var cancelFn context.CancelFunc
+				ctx, cancelFn = context.WithTimeout(ctx, c.Timeout)
+				defer cancelFn()
+			}
+			err := chk.Func(ctx)
+			mutex.Lock()
+			defer mutex.Unlock()
+			result[chk.Name] = err
+		}(chk)
+	}
+	wg.Wait()
+	return result
+}
+
+// Response is the body of the responses of the endpoints.
+type Response struct {
+	// Status is "ok" or "fail".
+	Status string `json:"status"`
+
+	// Checks contains "ok" or "fail" for each check. The error descriptions
+	// are only logged, since they could disclose internal details (addresses,
+	// credentials in DSNs and so on) to unauthenticated clients.
+	Checks map[string]string `json:"checks,omitempty"`
+}
+
+// LivenessHandler returns the handler of "/healthz", which always responds "ok"
+// while the process is serving HTTP requests.
+//
+// The dependencies are intentionally not checked, otherwise an outage of
+// a dependency would lead to restarts of all instances of the service.
+func (c *Checker) LivenessHandler() http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		writeResponse(r.Context(), w, http.StatusOK, Response{Status: statusOK})
+	})
+}
+
+// ReadinessHandler returns the handler of "/readyz", which runs all the checks
+// and responds with status 200 if all of them succeeded, or 503 otherwise.
+func (c *Checker) ReadinessHandler() http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		errs := c.Check(r.Context())
+		resp := Response{
+			Status: statusOK,
+			Checks: make(map[string]string, len(errs)),
+		}
+		for name, err := range errs {
+			if err != nil {
+				logger.FromCtx(r.Context()).Warnf("health check '%s' failed: %v", name, err)
+				resp.Status = statusFail
+				resp.Checks[name] = statusFail
+				continue
+			}
+			resp.Checks[name] = statusOK
+		}
+		statusCode := http.StatusOK
+		if resp.Status != statusOK {
+			statusCode = http.StatusServiceUnavailable
+		}
+		writeResponse(r.Context(), w, statusCode, resp)
+	})
+}
+
+func writeResponse(ctx context.Context, w http.ResponseWriter, statusCode int, resp Response) {
+	w.Header().Set("Content-Type", "application/json")
+	w.Header().Set("Cache-Control", "no-store")
+	w.WriteHeader(statusCode)
+	if err := json.NewEncoder(w).Encode(resp); err != nil {
+		logger.FromCtx(ctx).Debugf("unable to write the response: %v", err)
+	}
+}
@@ -19,8 +19,8 @@ import (
 )
 
 // AddDefaultMiddleware returns recommended thrift option for a server, it sets up a logger
-// and an extended context, reads TraceID if was passed and recovers panics
-// (and logs them through the initialized logger).
+// and an extended context, reads TraceID if was passed, extracts client TLS identities
+// and recovers panics (and logs them through the initialized logger).
 //
 // For description of arguments see SetupContext.
 func AddDefaultMiddleware(
@@ -29,5 +29,5 @@ func AddDefaultMiddleware(
 	overridableLogLevel bool,
 	defaultLogLevel logger.Level,
 ) func(http.ResponseWriter, *http.Request) {
-	return SetupContext(RecoverPanic(LogClientHostname(LogRequests(handler))), belt, overridableLogLevel, defaultLogLevel)
+	return SetupContext(RecoverPanic(LogClientHostname(TLSIdentities(LogRequests(handler)))), belt, overridableLogLevel, defaultLogLevel)
 }
@@ -0,0 +1,275 @@
+// Copyright 2023 Meta Platforms, Inc. and affiliates.
+//
+// Redistribution and use in source and binary forms, with or without modification, are permitted provided that the following conditions are met:
+//
+// 1. Redistributions of source code must retain the above copyright notice, this list of conditions and the following disclaimer.
+//
+// 2. Redistributions in binary form must reproduce the above copyright notice, this list of conditions and the following disclaimer in the documentation and/or other materials provided with the distribution.
+//
+// 3. Neither the name of the copyright holder nor the names of its contributors may be used to endorse or promote products derived from this software without specific prior written permission.
+//
+// THIS SOFTWARE IS PROVIDED BY THE COPYRIGHT HOLDERS AND CONTRIBUTORS "AS IS" AND ANY EXPRESS OR IMPLIED WARRANTIES, INCLUDING, BUT NOT LIMITED TO, THE IMPLIED WARRANTIES OF MERCHANTABILITY AND FITNESS FOR A PARTICULAR PURPOSE ARE DISCLAIMED. IN NO EVENT SHALL THE COPYRIGHT HOLDER OR CONTRIBUTORS BE LIABLE FOR ANY DIRECT, INDIRECT, INCIDENTAL, SPECIAL, EXEMPLARY, OR CONSEQUENTIAL DAMAGES (INCLUDING, BUT NOT LIMITED TO, PROCUREMENT OF SUBSTITUTE GOODS OR SERVICES; LOSS OF USE, DATA, OR PROFITS; OR BUSINESS INTERRUPTION) HOWEVER CAUSED AND ON ANY THEORY OF LIABILITY, WHETHER IN CONTRACT, STRICT LIABILITY, OR TORT (INCLUDING NEGLIGENCE OR OTHERWISE) ARISING IN ANY WAY OUT OF THE USE OF THIS SOFTWARE, EVEN IF ADVISED OF THE POSSIBILITY OF SUCH DAMAGE.
+
+package hwaccess
+
+import (
+	"bytes"
+	"fmt"
+	"os"
+	"path/filepath"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/9elements/converged-security-suite/v2/pkg/tpmdetection"
+	"github.com/klauspost/cpuid"
+)
+
+const (
+	// FixturePhysMemDir is the directory of a fixture which contains the chunks
+	// of the physical memory. Each file is named by the hexadecimal
+	// physical address of its beginning, for example "fed30000" for
+	// the TXT public space.
+	FixturePhysMemDir = `physmem`
+
+	// FixtureMSRDir is the directory of a fixture which contains the values
+	// of model-specific registers. Each file is named by the hexadecimal
+	// index of the register and contains the value (decimal or "0x"-prefixed
+	// hexadecimal), for example file "3a" with content "0x5".
+	FixtureMSRDir = `msr`
+
+	// fixtureCPUInfoPath is the host path of the CPU info, which is
+	// used by the fixture to detect the CPU vendor.
+	fixtureCPUInfoPath = `/proc/cpuinfo`
+)
+
+// Fixture is a fake hardware backed by the files of a directory.
+//
+// The host files are looked up by their paths relative to the directory,
+// for example:
+//
+//	proc/iomem                                        (see PathIOMem)
+//	proc/cpuinfo                                      (only the "vendor_id" line is used)
+//	physmem/ff000000                                  (see FixturePhysMemDir)
+//	msr/3a                                            (see FixtureMSRDir)
+//	dev/mtd0, sys/class/mtd/mtd0/...                  (see PathDev and PathSysFSMTD)
+//	dev/tpm0, sys/class/tpm/tpm0/device/caps          (see PathTPMDevice and PathSysFSTPM)
+//	sys/class/tpm/tpm0/pcrs                           (the TPM1.2 format)
+//	sys/class/tpm/tpm0/pcr-sha256/0                   (the TPM2.0 format)
+//	sys/kernel/security/tpm0/binary_bios_measurements (see PathTPMEventLog)
+//	sys/firmware/dmi/tables/DMI                       (see PathSMBIOS)
+//	proc/sys/kernel/hostname                          (see PathHostname)
+//
+// Missing files are handled the same way as missing files on a real host.
+type Fixture struct {
+	Dir string
+}
+
+var _ Interface = (*Fixture)(nil)
+
+// NewFixture returns a fake hardware backed by the files of directory `dir`.
+func NewFixture(dir string) (*Fixture, error) {
+	stat, err := os.Stat(dir)
+	if err != nil {
+		return nil, fmt.Errorf("unable to access the fixture directory '%s': %w", dir, err)
+	}
+	if !stat.IsDir() {
+		return nil, fmt.Errorf("'%s' is not a directory", dir)
+	}
+	return &Fixture{Dir: dir}, nil
+}
+
+// IsHost implements Interface.
+func (fixture *Fixture) IsHost() bool {
+	return false
+}
+
+// Path implements Interface.
+func (fixture *Fixture) Path(hostPath string) string {
+	return filepath.Join(fixture.Dir, hostPath)
+}
+
+type physMemChunk struct {
+	Path   string
+	Offset int64
+	Length int64
+}
+
+func (fixture *Fixture) physMemChunks() ([]physMemChunk, error) {
+	dir := filepath.Join(fixture.Dir, FixturePhysMemDir)
+	entries, err := os.ReadDir(dir)
+	if err != nil {
+		return nil, fmt.Errorf("unable to open dir '%s': %w", dir, err)
+	}
+
+	var result []physMemChunk
+	for _, entry := range entries {
+		if entry.IsDir() {
+			continue
+		}
+		offset, err := strconv.ParseUint(entry.Name(), 16, 64)
+		if err != nil {
+			return nil, fmt.Errorf("unable to parse the address of physical memory chunk '%s': %w", entry.Name(), err)
+		}
+		info, err := entry.Info()
+		if err != nil {
+			return nil, fmt.Errorf("unable to stat physical memory chunk '%s': %w", entry.Name(), err)
+		}
+		result = append(result, physMemChunk{
+			Path:   filepath.Join(dir, entry.Name()),
+			Offset: int64(offset),
+			Length: info.Size(),
+		})
+	}
+	sort.Slice(result, func(i, j int) bool {
+		return result[i].Offset < result[j].Offset
+	})
+	return result, nil
+}
+
+// ReadPhysBuf implements Interface.
+//
+// The requested range should be fully within one of the chunks, see FixturePhysMemDir.
+func (fixture *Fixture) ReadPhysBuf(addr int64, buf []byte) error {
+	chunks, err := fixture.physMemChunks()
+	if err != nil {
+		return err
+	}
+
+	end := addr + int64(len(buf))
+	for _, chunk := range chunks {
+		if addr < chunk.Offset || end > chunk.Offset+chunk.Length {
+			continue
+		}
+		f, err := os.Open(chunk.Path)
+		if err != nil {
+			return fmt.Errorf("unable to open '%s': %w", chunk.Path, err)
+		}
+		defer func() {
+			_ = f.Close()
+		}()
+		if _, err := f.ReadAt(buf, addr-chunk.Offset); err != nil {
+			return fmt.Errorf("unable to read '%s' at 0x%X: %w", chunk.Path, addr-chunk.Offset, err)
+		}
+		return nil
+	}
+	return fmt.Errorf("physical memory range 0x%X-0x%X is not available in the fixture", addr, end)
+}
+
+// ReadMSR implements Interface.
+func (fixture *Fixture) ReadMSR(msr int64) (uint64, error) {
+	path := filepath.Join(fixture.Dir, MSRPath(msr))
+	b, err := os.ReadFile(path)
+	if err != nil {
+		return 0, fmt.Errorf("unable to read '%s': %w", path, err)
+	}
+	value, err := strconv.ParseUint(strings.TrimSpace(string(b)), 0, 64)
+	if err != nil {
+		return 0, fmt.Errorf("unable to parse MSR value from '%s': %w", path, err)
+	}
+	return value, nil
+}
+
+// Hostname implements Interface.
+//
+// The hostname is read from file PathHostname of the fixture.
+func (fixture *Fixture) Hostname() (string, error) {
+	path := fixture.Path(PathHostname)
+	b, err := os.ReadFile(path)
+	if err != nil {
+		return "", fmt.Errorf("unable to read '%s': %w", path, err)
+	}
+	return strings.TrimSpace(string(b)), nil
+}
+
+// CPUVendor implements Interface.
+func (fixture *Fixture) CPUVendor() cpuid.Vendor {
+	b, err := os.ReadFile(fixture.Path(fixtureCPUInfoPath))
+	if err != nil {
+		return cpuid.Other
+	}
+	for _, line := range bytes.Split(b, []byte("\n")) {
+		parts := strings.SplitN(string(line), ":", 2)
+		if len(parts) != 2 || strings.TrimSpace(parts[0]) != "vendor_id" {
+			continue
+		}
+		switch strings.TrimSpace(parts[1]) {
+		case "GenuineIntel":
+			return cpuid.Intel
+		case "AuthenticAMD":
+			return cpuid.AMD
+		}
+		return cpuid.Other
+	}
+	return cpuid.Other
+}
+
+// TPMType implements Interface.
+//
+// It mirrors tpmdetection.Local: no TPM device file means there is no TPM,
+// and a TPM without the capabilities file is a TPM2.0.
+func (fixture *Fixture) TPMType() (tpmdetection.Type, error) {
+	devicePath := fixture.Path(PathTPMDevice)
+	if _, err := os.Stat(devicePath); err != nil {
+		if os.IsNotExist(err) {
+			return tpmdetection.TypeNoTPM, nil
+		}
+		return 0, fmt.Errorf("failed to check existence of %s, err: %w", devicePath, err)
+	}
+
+	capsPath := filepath.Join(fixture.Path(PathSysFSTPM), "device", "caps")
+	caps, err := os.ReadFile(capsPath)
+	if err != nil {
+		if os.IsNotExist(err) {
+			return tpmdetection.TypeTPM20, nil
+		}
+		return 0, fmt.Errorf("failed to read %s, err: %w", capsPath, err)
+	}
+	for _, line := range bytes.Split(caps, []byte("\n")) {
+		parts := strings.SplitN(string(line), ":", 2)
+		if len(parts) != 2 || strings.TrimSpace(parts[0]) != "TCG version" {
+			continue
+		}
+		if strings.TrimSpace(parts[1]) == "2.0" {
+			return tpmdetection.TypeTPM20, nil
+		}
+		break
+	}
+	return tpmdetection.TypeTPM12, nil
+}
+
+// OpenTPM implements Interface.
+//
+// A fixture does not emulate TPM commands, the PCR values are available
+// only through sysfs (see PathSysFSTPM).
+func (fixture *Fixture) OpenTPM() (*TPM, error) {
+	return nil, fmt.Errorf("TPM commands are not supported by the fixture '%s'", fixture.Dir)
+}
+
+// WriteFixture creates the files `files` in directory `dir` and returns
+// the fixture backed by them. The keys are the paths relative to `dir`
+// (for example "proc/iomem" or "physmem/fed30000"), see Fixture.
+func WriteFixture(dir string, files map[string][]byte) (*Fixture, error) {
+	for path, content := range files {
+		fullPath := filepath.Join(dir, path)
+		if err := os.MkdirAll(filepath.Dir(fullPath), 0755); err != nil {
+			return nil, fmt.Errorf("unable to create the directory for '%s': %w", fullPath, err)
+		}
+		if err := os.WriteFile(fullPath, content, 0644); err != nil {
+			return nil, fmt.Errorf("unable to write '%s': %w", fullPath, err)
+		}
+	}
+	return NewFixture(dir)
+}
+
+// PhysMemPath returns the path (relative to the fixture directory) of
+// the physical memory chunk starting at address `addr`, see FixturePhysMemDir.
+func PhysMemPath(addr uint64) string {
+	return filepath.Join(FixturePhysMemDir, strconv.FormatUint(addr, 16))
+}
+
+// MSRPath returns the path (relative to the fixture directory) of
+// the value of model-specific register `msr`, see FixtureMSRDir.
+func MSRPath(msr int64) string {
+	return filepath.Join(FixtureMSRDir, strconv.FormatInt(msr, 16))
+}
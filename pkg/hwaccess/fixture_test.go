@@ -0,0 +1,83 @@
+// Copyright 2023 Meta Platforms, Inc. and affiliates.
+//
+// Redistribution and use in source and binary forms, with or without modification, are permitted provided that the following conditions are met:
+//
+// 1. Redistributions of source code must retain the above copyright notice, this list of conditions and the following disclaimer.
+//
+// 2. Redistributions in binary form must reproduce the above copyright notice, this list of conditions and the following disclaimer in the documentation and/or other materials provided with the distribution.
+//
+// 3. Neither the name of the copyright holder nor the names of its contributors may be used to endorse or promote products derived from this software without specific prior written permission.
+//
+// THIS SOFTWARE IS PROVIDED BY THE COPYRIGHT HOLDERS AND CONTRIBUTORS "AS IS" AND ANY EXPRESS OR IMPLIED WARRANTIES, INCLUDING, BUT NOT LIMITED TO, THE IMPLIED WARRANTIES OF MERCHANTABILITY AND FITNESS FOR A PARTICULAR PURPOSE ARE DISCLAIMED. IN NO EVENT SHALL THE COPYRIGHT HOLDER OR CONTRIBUTORS BE LIABLE FOR ANY DIRECT, INDIRECT, INCIDENTAL, SPECIAL, EXEMPLARY, OR CONSEQUENTIAL DAMAGES (INCLUDING, BUT NOT LIMITED TO, PROCUREMENT OF SUBSTITUTE GOODS OR SERVICES; LOSS OF USE, DATA, OR PROFITS; OR BUSINESS INTERRUPTION) HOWEVER CAUSED AND ON ANY THEORY OF LIABILITY, WHETHER IN CONTRACT, STRICT LIABILITY, OR TORT (INCLUDING NEGLIGENCE OR OTHERWISE) ARISING IN ANY WAY OUT OF THE USE OF THIS SOFTWARE, EVEN IF ADVISED OF THE POSSIBILITY OF SUCH DAMAGE.
+
+package hwaccess
+
+import (
+	"testing"
+
+	"github.com/9elements/converged-security-suite/v2/pkg/tpmdetection"
+	"github.com/klauspost/cpuid"
+	"github.com/stretchr/testify/require"
+)
+
+func TestFixture(t *testing.T) {
+	fixture, err := WriteFixture(t.TempDir(), map[string][]byte{
+		PhysMemPath(0xfed30000): {0x01, 0x02, 0x03, 0x04},
+		MSRPath(0x3a):           []byte("0x5\n"),
+		"proc/cpuinfo":          []byte("processor\t: 0\nvendor_id\t: GenuineIntel\n"),
+		PathTPMDevice:           nil,
+		PathHostname:            []byte("fixture-host\n"),
+	})
+	require.NoError(t, err)
+	require.False(t, fixture.IsHost())
+
+	buf := make([]byte, 2)
+	require.NoError(t, fixture.ReadPhysBuf(0xfed30001, buf))
+	require.Equal(t, []byte{0x02, 0x03}, buf)
+	require.Error(t, fixture.ReadPhysBuf(0xfed30003, buf))
+	require.Error(t, fixture.ReadPhysBuf(0xff000000, buf))
+
+	msr, err := MSRReader(fixture).Read(0x3a)
+	require.NoError(t, err)
+	require.Equal(t, uint64(5), msr)
+	_, err = fixture.ReadMSR(0x3b)
+	require.Error(t, err)
+
+	require.Equal(t, cpuid.Intel, fixture.CPUVendor())
+
+	hostname, err := fixture.Hostname()
+	require.NoError(t, err)
+	require.Equal(t, "fixture-host", hostname)
+
+	tpmType, err := fixture.TPMType()
+	require.NoError(t, err)
+	require.Equal(t, tpmdetection.TypeTPM20, tpmType)
+
+	_, err = fixture.OpenTPM()
+	require.Error(t, err)
+}
+
+func TestFixtureTPMType(t *testing.T) {
+	fixture, err := NewFixture(t.TempDir())
+	require.NoError(t, err)
+	tpmType, err := fixture.TPMType()
+	require.NoError(t, err)
+	require.Equal(t, tpmdetection.TypeNoTPM, tpmType)
+	require.Equal(t, cpuid.Other, fixture.CPUVendor())
+	_, err = fixture.Hostname()
+	require.Error(t, err)
+
+	fixture, err = WriteFixture(fixture.Dir, map[string][]byte{
+		PathTPMDevice:                 nil,
+		PathSysFSTPM + "/device/caps": []byte("Manufacturer: 0x49465800\nTCG version: 1.2\n"),
+	})
+	require.NoError(t, err)
+	tpmType, err = fixture.TPMType()
+	require.NoError(t, err)
+	require.Equal(t, tpmdetection.TypeTPM12, tpmType)
+}
+
+func TestNewFixtureNotDir(t *testing.T) {
+	_, err := NewFixture("/nonexistent/fixture")
+	require.Error(t, err)
+}
@@ -0,0 +1,111 @@
+// Copyright 2023 Meta Platforms, Inc. and affiliates.
+//
+// Redistribution and use in source and binary forms, with or without modification, are permitted provided that the following conditions are met:
+//
+// 1. Redistributions of source code must retain the above copyright notice, this list of conditions and the following disclaimer.
+//
+// 2. Redistributions in binary form must reproduce the above copyright notice, this list of conditions and the following disclaimer in the documentation and/or other materials provided with the distribution.
+//
+// 3. Neither the name of the copyright holder nor the names of its contributors may be used to endorse or promote products derived from this software without specific prior written permission.
+//
+// THIS SOFTWARE IS PROVIDED BY THE COPYRIGHT HOLDERS AND CONTRIBUTORS "AS IS" AND ANY EXPRESS OR IMPLIED WARRANTIES, INCLUDING, BUT NOT LIMITED TO, THE IMPLIED WARRANTIES OF MERCHANTABILITY AND FITNESS FOR A PARTICULAR PURPOSE ARE DISCLAIMED. IN NO EVENT SHALL THE COPYRIGHT HOLDER OR CONTRIBUTORS BE LIABLE FOR ANY DIRECT, INDIRECT, INCIDENTAL, SPECIAL, EXEMPLARY, OR CONSEQUENTIAL DAMAGES (INCLUDING, BUT NOT LIMITED TO, PROCUREMENT OF SUBSTITUTE GOODS OR SERVICES; LOSS OF USE, DATA, OR PROFITS; OR BUSINESS INTERRUPTION) HOWEVER CAUSED AND ON ANY THEORY OF LIABILITY, WHETHER IN CONTRACT, STRICT LIABILITY, OR TORT (INCLUDING NEGLIGENCE OR OTHERWISE) ARISING IN ANY WAY OUT OF THE USE OF THIS SOFTWARE, EVEN IF ADVISED OF THE POSSIBILITY OF SUCH DAMAGE.
+
+// Package hwaccess abstracts the access to the hardware of a host, so that
+// the collectors (flashrom, TPM, registers) could run against a fake
+// file-backed hardware (see NewFixture) as well as against the local host
+// (see Local).
+package hwaccess
+
+import (
+	"io"
+
+	"github.com/9elements/converged-security-suite/v2/pkg/registers"
+	"github.com/9elements/converged-security-suite/v2/pkg/tpmdetection"
+	"github.com/9elements/go-linux-lowlevel-hw/pkg/hwapi"
+	"github.com/klauspost/cpuid"
+)
+
+const (
+	// PathDevMem is the host path of the device to access memory by physical addresses.
+	PathDevMem = `/dev/mem`
+
+	// PathIOMem is the host path of the list of ranges of physical memory addresses.
+	PathIOMem = `/proc/iomem`
+
+	// PathDev is the host path where to look for various devices.
+	PathDev = `/dev`
+
+	// PathSysFSMTD is the host path of the sysfs exports of MTD devices.
+	PathSysFSMTD = `/sys/class/mtd`
+
+	// PathTPMDevice is the host path of the TPM device.
+	PathTPMDevice = `/dev/tpm0`
+
+	// PathSysFSTPM is the host path of the sysfs exports of the TPM device.
+	PathSysFSTPM = `/sys/class/tpm/tpm0`
+
+	// PathTPMEventLog is the host path of the binary TPM event log.
+	PathTPMEventLog = `/sys/kernel/security/tpm0/binary_bios_measurements`
+
+	// PathSMBIOS is the host path of the raw SMBIOS structures table.
+	PathSMBIOS = `/sys/firmware/dmi/tables/DMI`
+
+	// PathHostname is the host path of the hostname.
+	PathHostname = `/proc/sys/kernel/hostname`
+)
+
+// Interface provides access to the hardware of a host.
+type Interface interface {
+	// IsHost returns true if the hardware is the real hardware of the local host.
+	//
+	// External tools (like "flashrom") access the hardware on their own,
+	// thus they are used only if IsHost returns true.
+	IsHost() bool
+
+	// Path returns the path to be used to access the file of the host
+	// with path `hostPath` (for example PathIOMem).
+	Path(hostPath string) string
+
+	// ReadPhysBuf reads the physical memory at address `addr` into `buf`.
+	ReadPhysBuf(addr int64, buf []byte) error
+
+	// ReadMSR returns the value of the model-specific register `msr`.
+	ReadMSR(msr int64) (uint64, error)
+
+	// Hostname returns the hostname of the host.
+	Hostname() (string, error)
+
+	// CPUVendor returns the vendor of the CPU.
+	CPUVendor() cpuid.Vendor
+
+	// TPMType returns the type of the TPM device.
+	TPMType() (tpmdetection.Type, error)
+
+	// OpenTPM opens the TPM device to send commands to it.
+	OpenTPM() (*TPM, error)
+}
+
+// TPM is an opened TPM device.
+type TPM struct {
+	Version hwapi.TPMVersion
+	RWC     io.ReadWriteCloser
+}
+
+// Close closes the TPM device.
+func (tpm *TPM) Close() error {
+	return tpm.RWC.Close()
+}
+
+// MSRReader returns an adapter of `hw` to registers.MSRReader.
+func MSRReader(hw Interface) registers.MSRReader {
+	return msrReader{Hardware: hw}
+}
+
+type msrReader struct {
+	Hardware Interface
+}
+
+// Read implements registers.MSRReader.
+func (r msrReader) Read(msr int64) (uint64, error) {
+	return r.Hardware.ReadMSR(msr)
+}
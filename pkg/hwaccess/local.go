@@ -0,0 +1,91 @@
+// Copyright 2023 Meta Platforms, Inc. and affiliates.
+//
+// Redistribution and use in source and binary forms, with or without modification, are permitted provided that the following conditions are met:
+//
+// 1. Redistributions of source code must retain the above copyright notice, this list of conditions and the following disclaimer.
+//
+// 2. Redistributions in binary form must reproduce the above copyright notice, this list of conditions and the following disclaimer in the documentation and/or other materials provided with the distribution.
+//
+// 3. Neither the name of the copyright holder nor the names of its contributors may be used to endorse or promote products derived from this software without specific prior written permission.
+//
+// THIS SOFTWARE IS PROVIDED BY THE COPYRIGHT HOLDERS AND CONTRIBUTORS "AS IS" AND ANY EXPRESS OR IMPLIED WARRANTIES, INCLUDING, BUT NOT LIMITED TO, THE IMPLIED WARRANTIES OF MERCHANTABILITY AND FITNESS FOR A PARTICULAR PURPOSE ARE DISCLAIMED. IN NO EVENT SHALL THE COPYRIGHT HOLDER OR CONTRIBUTORS BE LIABLE FOR ANY DIRECT, INDIRECT, INCIDENTAL, SPECIAL, EXEMPLARY, OR CONSEQUENTIAL DAMAGES (INCLUDING, BUT NOT LIMITED TO, PROCUREMENT OF SUBSTITUTE GOODS OR SERVICES; LOSS OF USE, DATA, OR PROFITS; OR BUSINESS INTERRUPTION) HOWEVER CAUSED AND ON ANY THEORY OF LIABILITY, WHETHER IN CONTRACT, STRICT LIABILITY, OR TORT (INCLUDING NEGLIGENCE OR OTHERWISE) ARISING IN ANY WAY OUT OF THE USE OF THIS SOFTWARE, EVEN IF ADVISED OF THE POSSIBILITY OF SUCH DAMAGE.
+
+package hwaccess
+
+import (
+	"fmt"
+	"os"
+
+	"github.com/9elements/converged-security-suite/v2/pkg/registers"
+	"github.com/9elements/converged-security-suite/v2/pkg/tpmdetection"
+	"github.com/9elements/go-linux-lowlevel-hw/pkg/hwapi"
+	"github.com/klauspost/cpuid"
+	"github.com/marcoguerri/go-tpm-tcti/abrmd"
+)
+
+// Local returns the Interface to the hardware of the local host.
+func Local() Interface {
+	return local{}
+}
+
+type local struct{}
+
+var _ Interface = local{}
+
+// IsHost implements Interface.
+func (local) IsHost() bool {
+	return true
+}
+
+// Path implements Interface.
+func (local) Path(hostPath string) string {
+	return hostPath
+}
+
+// ReadPhysBuf implements Interface.
+func (local) ReadPhysBuf(addr int64, buf []byte) error {
+	return hwapi.GetAPI().ReadPhysBuf(addr, buf)
+}
+
+// ReadMSR implements Interface.
+func (local) ReadMSR(msr int64) (uint64, error) {
+	return (&registers.DefaultMSRReader{}).Read(msr)
+}
+
+// CPUVendor implements Interface.
+func (local) Hostname() (string, error) {
+	return os.Hostname()
+}
+
+func (local) CPUVendor() cpuid.Vendor {
+	return cpuid.CPU.VendorID
+}
+
+// TPMType implements Interface.
+func (local) TPMType() (tpmdetection.Type, error) {
+	return tpmdetection.Local()
+}
+
+// OpenTPM implements Interface.
+//
+// It tries abrmd first and then /dev/tpm{rm,}.
+func (local) OpenTPM() (*TPM, error) {
+	abrmdClient, abrmdErr := abrmd.NewBroker()
+	if abrmdErr == nil {
+		// abrmd is part of TPM2 tools, therefore we support on TPM2.0 here.
+		// If we have TPM1.2 then abrmdClient won't initialize.
+		return &TPM{
+			Version: hwapi.TPMVersion20,
+			RWC:     abrmdClient,
+		}, nil
+	}
+
+	tpm, err := hwapi.NewTPM()
+	if err != nil {
+		return nil, fmt.Errorf("unable to connect to abrmd: %v; unable to open TPM: %w", abrmdErr, err)
+	}
+	return &TPM{
+		Version: tpm.Version,
+		RWC:     tpm.RWC,
+	}, nil
+}
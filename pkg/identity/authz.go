@@ -0,0 +1,102 @@
+// Copyright 2023 Meta Platforms, Inc. and affiliates.
+//
+// Redistribution and use in source and binary forms, with or without modification, are permitted provided that the following conditions are met:
+//
+// 1. Redistributions of source code must retain the above copyright notice, this list of conditions and the following disclaimer.
+//
+// 2. Redistributions in binary form must reproduce the above copyright notice, this list of conditions and the following disclaimer in the documentation and/or other materials provided with the distribution.
+//
+// 3. Neither the name of the copyright holder nor the names of its contributors may be used to endorse or promote products derived from this software without specific prior written permission.
+//
+// THIS SOFTWARE IS PROVIDED BY THE COPYRIGHT HOLDERS AND CONTRIBUTORS "AS IS" AND ANY EXPRESS OR IMPLIED WARRANTIES, INCLUDING, BUT NOT LIMITED TO, THE IMPLIED WARRANTIES OF MERCHANTABILITY AND FITNESS FOR A PARTICULAR PURPOSE ARE DISCLAIMED. IN NO EVENT SHALL THE COPYRIGHT HOLDER OR CONTRIBUTORS BE LIABLE FOR ANY DIRECT, INDIRECT, INCIDENTAL, SPECIAL, EXEMPLARY, OR CONSEQUENTIAL DAMAGES (INCLUDING, BUT NOT LIMITED TO, PROCUREMENT OF SUBSTITUTE GOODS OR SERVICES; LOSS OF USE, DATA, OR PROFITS; OR BUSINESS INTERRUPTION) HOWEVER CAUSED AND ON ANY THEORY OF LIABILITY, WHETHER IN CONTRACT, STRICT LIABILITY, OR TORT (INCLUDING NEGLIGENCE OR OTHERWISE) ARISING IN ANY WAY OUT OF THE USE OF THIS SOFTWARE, EVEN IF ADVISED OF THE POSSIBILITY OF SUCH DAMAGE.
+package identity
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"os"
+)
+
+// IsPermitted for some code to decide if a client with the given identities is
+// permitted to use the permission.
+//
+// A permission is the name of an RPC (e.g. "SearchFirmware"), or the name of an RPC
+// and a feature of it separated by a colon (e.g. "SearchFirmware:FetchContent").
+//
+// By default everything is permitted. Similar to NewIdentitiesFromContext, a company
+// may replace the function with something specific to them (see also AuthzPolicy).
+var IsPermitted = func(ctx context.Context, identities []Identity, permission string) bool {
+	return true
+}
+
+// AuthzAnyClient is the hostname in AuthzRule matching any client with
+// a verified certificate.
+const AuthzAnyClient = "*"
+
+// AuthzRule permits clients with certificates issued to any of Hostnames
+// to use any of Permissions.
+type AuthzRule struct {
+	Permissions []string `json:"permissions"`
+	Hostnames   []string `json:"hostnames"`
+}
+
+// AuthzPolicy is a set of rules mapping client identities to permissions.
+//
+// A permission mentioned in any rule is restricted to the clients matching
+// the rules which mention it. Other permissions are permitted to everybody,
+// unless DenyUnlisted is true.
+type AuthzPolicy struct {
+	Rules        []AuthzRule `json:"rules"`
+	DenyUnlisted bool        `json:"deny_unlisted"`
+}
+
+// LoadAuthzPolicy reads an AuthzPolicy from a JSON file.
+func LoadAuthzPolicy(path string) (*AuthzPolicy, error) {
+	b, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("unable to read file '%s': %w", path, err)
+	}
+	var policy AuthzPolicy
+	if err := json.Unmarshal(b, &policy); err != nil {
+		return nil, fmt.Errorf("unable to parse authorization policy '%s': %w", path, err)
+	}
+	for idx, rule := range policy.Rules {
+		if len(rule.Permissions) == 0 || len(rule.Hostnames) == 0 {
+			return nil, fmt.Errorf("rule #%d of authorization policy '%s' has no permissions or no hostnames", idx, path)
+		}
+	}
+	return &policy, nil
+}
+
+// IsPermitted implements IsPermitted.
+func (policy *AuthzPolicy) IsPermitted(ctx context.Context, identities []Identity, permission string) bool {
+	isListed := false
+	for _, rule := range policy.Rules {
+		if !contains(rule.Permissions, permission) {
+			continue
+		}
+		isListed = true
+		for _, identity := range identities {
+			hostnames := Hostnames(identity)
+			if len(hostnames) > 0 && contains(rule.Hostnames, AuthzAnyClient) {
+				return true
+			}
+			for _, hostname := range hostnames {
+				if contains(rule.Hostnames, hostname) {
+					return true
+				}
+			}
+		}
+	}
+	return !isListed && !policy.DenyUnlisted
+}
+
+func contains(s []string, item string) bool {
+	for _, v := range s {
+		if v == item {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,72 @@
+// Copyright 2023 Meta Platforms, Inc. and affiliates.
+//
+// Redistribution and use in source and binary forms, with or without modification, are permitted provided that the following conditions are met:
+//
+// 1. Redistributions of source code must retain the above copyright notice, this list of conditions and the following disclaimer.
+//
+// 2. Redistributions in binary form must reproduce the above copyright notice, this list of conditions and the following disclaimer in the documentation and/or other materials provided with the distribution.
+//
+// 3. Neither the name of the copyright holder nor the names of its contributors may be used to endorse or promote products derived from this software without specific prior written permission.
+//
+// THIS SOFTWARE IS PROVIDED BY THE COPYRIGHT HOLDERS AND CONTRIBUTORS "AS IS" AND ANY EXPRESS OR IMPLIED WARRANTIES, INCLUDING, BUT NOT LIMITED TO, THE IMPLIED WARRANTIES OF MERCHANTABILITY AND FITNESS FOR A PARTICULAR PURPOSE ARE DISCLAIMED. IN NO EVENT SHALL THE COPYRIGHT HOLDER OR CONTRIBUTORS BE LIABLE FOR ANY DIRECT, INDIRECT, INCIDENTAL, SPECIAL, EXEMPLARY, OR CONSEQUENTIAL DAMAGES (INCLUDING, BUT NOT LIMITED TO, PROCUREMENT OF SUBSTITUTE GOODS OR SERVICES; LOSS OF USE, DATA, OR PROFITS; OR BUSINESS INTERRUPTION) HOWEVER CAUSED AND ON ANY THEORY OF LIABILITY, WHETHER IN CONTRACT, STRICT LIABILITY, OR TORT (INCLUDING NEGLIGENCE OR OTHERWISE) ARISING IN ANY WAY OUT OF THE USE OF THIS SOFTWARE, EVEN IF ADVISED OF THE POSSIBILITY OF SUCH DAMAGE.
+package identity
+
+import (
+	"context"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func testIdentity(dnsNames ...string) Identity {
+	return TLSIdentity{Chain: []x509.Certificate{{
+		Subject:  pkix.Name{CommonName: "cn.example.com"},
+		DNSNames: dnsNames,
+	}}}
+}
+
+func TestHostnames(t *testing.T) {
+	require.Equal(t, []string{"a.example.com", "b.example.com"}, Hostnames(testIdentity("a.example.com", "b.example.com")))
+	require.Equal(t, []string{"cn.example.com"}, Hostnames(testIdentity()))
+	require.Nil(t, Hostnames(TLSIdentity{}))
+}
+
+func TestIdentitiesFromCtx(t *testing.T) {
+	ctx := context.Background()
+	require.Nil(t, IdentitiesFromCtx(ctx))
+
+	identities := []Identity{testIdentity("host.example.com")}
+	ids, err := NewIdentitiesFromContext(CtxWithIdentities(ctx, identities))
+	require.NoError(t, err)
+	require.Equal(t, identities, ids)
+}
+
+func TestAuthzPolicy(t *testing.T) {
+	ctx := context.Background()
+	provisioning := []Identity{testIdentity("provisioning.example.com")}
+	other := []Identity{testIdentity("other.example.com")}
+
+	policy := &AuthzPolicy{
+		Rules: []AuthzRule{
+			{Permissions: []string{"SearchFirmware:FetchContent"}, Hostnames: []string{"provisioning.example.com"}},
+			{Permissions: []string{"Analyze"}, Hostnames: []string{AuthzAnyClient}},
+		},
+	}
+
+	// unlisted permissions are permitted to everybody
+	require.True(t, policy.IsPermitted(ctx, nil, "SearchFirmware"))
+	require.True(t, policy.IsPermitted(ctx, other, "SearchFirmware"))
+
+	require.True(t, policy.IsPermitted(ctx, provisioning, "SearchFirmware:FetchContent"))
+	require.False(t, policy.IsPermitted(ctx, other, "SearchFirmware:FetchContent"))
+	require.False(t, policy.IsPermitted(ctx, nil, "SearchFirmware:FetchContent"))
+
+	require.True(t, policy.IsPermitted(ctx, other, "Analyze"))
+	require.False(t, policy.IsPermitted(ctx, nil, "Analyze"))
+
+	policy.DenyUnlisted = true
+	require.False(t, policy.IsPermitted(ctx, other, "SearchFirmware"))
+	require.True(t, policy.IsPermitted(ctx, provisioning, "SearchFirmware:FetchContent"))
+}
@@ -0,0 +1,45 @@
+// Copyright 2023 Meta Platforms, Inc. and affiliates.
+//
+// Redistribution and use in source and binary forms, with or without modification, are permitted provided that the following conditions are met:
+//
+// 1. Redistributions of source code must retain the above copyright notice, this list of conditions and the following disclaimer.
+//
+// 2. Redistributions in binary form must reproduce the above copyright notice, this list of conditions and the following disclaimer in the documentation and/or other materials provided with the distribution.
+//
+// 3. Neither the name of the copyright holder nor the names of its contributors may be used to endorse or promote products derived from this software without specific prior written permission.
+//
+// THIS SOFTWARE IS PROVIDED BY THE COPYRIGHT HOLDERS AND CONTRIBUTORS "AS IS" AND ANY EXPRESS OR IMPLIED WARRANTIES, INCLUDING, BUT NOT LIMITED TO, THE IMPLIED WARRANTIES OF MERCHANTABILITY AND FITNESS FOR A PARTICULAR PURPOSE ARE DISCLAIMED. IN NO EVENT SHALL THE COPYRIGHT HOLDER OR CONTRIBUTORS BE LIABLE FOR ANY DIRECT, INDIRECT, INCIDENTAL, SPECIAL, EXEMPLARY, OR CONSEQUENTIAL DAMAGES (INCLUDING, BUT NOT LIMITED TO, PROCUREMENT OF SUBSTITUTE GOODS OR SERVICES; LOSS OF USE, DATA, OR PROFITS; OR BUSINESS INTERRUPTION) HOWEVER CAUSED AND ON ANY THEORY OF LIABILITY, WHETHER IN CONTRACT, STRICT LIABILITY, OR TORT (INCLUDING NEGLIGENCE OR OTHERWISE) ARISING IN ANY WAY OUT OF THE USE OF THIS SOFTWARE, EVEN IF ADVISED OF THE POSSIBILITY OF SUCH DAMAGE.
+package identity
+
+import (
+	"context"
+)
+
+// IsAdmin for some code to decide if a client with the given identities is permitted
+// to use administrative methods (like modifying the original firmware database).
+//
+// By default nobody is permitted. Similar to NewIdentitiesFromContext, a company
+// may replace the function with something specific to them.
+var IsAdmin = func(ctx context.Context, identities []Identity) bool {
+	return false
+}
+
+// AdminHostnames returns an implementation of IsAdmin which permits clients
+// presenting a TLS certificate issued to any of the given hostnames.
+func AdminHostnames(hostnames ...string) func(ctx context.Context, identities []Identity) bool {
+	permitted := make(map[string]struct{}, len(hostnames))
+	for _, hostname := range hostnames {
+		permitted[hostname] = struct{}{}
+	}
+
+	return func(ctx context.Context, identities []Identity) bool {
+		for _, identity := range identities {
+			for _, hostname := range Hostnames(identity) {
+				if _, ok := permitted[hostname]; ok {
+					return true
+				}
+			}
+		}
+		return false
+	}
+}
@@ -13,18 +13,88 @@ package identity
 
 import (
 	"context"
+	"crypto/tls"
 	"crypto/x509"
 )
 
+// Identity is an authenticated identity of a client.
 type Identity interface {
 	TLSChain() []x509.Certificate
 }
 
+// TLSIdentity is an Identity proven by a verified certificate chain of a TLS peer.
+type TLSIdentity struct {
+	Chain []x509.Certificate
+}
+
+var _ Identity = TLSIdentity{}
+
+// TLSChain implements Identity. The first certificate is the leaf one.
+func (id TLSIdentity) TLSChain() []x509.Certificate {
+	return id.Chain
+}
+
+// NewIdentitiesFromTLS returns an identity per verified certificate chain
+// of the peer.
+//
+// Peer certificates which were not verified (for example, if client
+// certificates are requested, but not verified) are ignored.
+func NewIdentitiesFromTLS(state *tls.ConnectionState) []Identity {
+	if state == nil {
+		return nil
+	}
+	var result []Identity
+	for _, chain := range state.VerifiedChains {
+		if len(chain) == 0 {
+			continue
+		}
+		id := TLSIdentity{Chain: make([]x509.Certificate, 0, len(chain))}
+		for _, cert := range chain {
+			id.Chain = append(id.Chain, *cert)
+		}
+		result = append(result, id)
+	}
+	return result
+}
+
+// Hostnames returns the hostnames the leaf certificate of the identity is
+// issued to: its DNS names, or its common name if there are no DNS names.
+func Hostnames(id Identity) []string {
+	chain := id.TLSChain()
+	if len(chain) == 0 {
+		return nil
+	}
+	if len(chain[0].DNSNames) > 0 {
+		return chain[0].DNSNames
+	}
+	if chain[0].Subject.CommonName != "" {
+		return []string{chain[0].Subject.CommonName}
+	}
+	return nil
+}
+
+type ctxKeyIdentities struct{}
+
+// CtxWithIdentities returns a context with the client identities, which
+// could be extracted with IdentitiesFromCtx.
+func CtxWithIdentities(ctx context.Context, identities []Identity) context.Context {
+	return context.WithValue(ctx, ctxKeyIdentities{}, identities)
+}
+
+// IdentitiesFromCtx returns the client identities set by CtxWithIdentities.
+func IdentitiesFromCtx(ctx context.Context) []Identity {
+	identities, _ := ctx.Value(ctxKeyIdentities{}).([]Identity)
+	return identities
+}
+
 // NewIdentitiesFromContext for some code to extract client identities from a context.
 //
 // Initially there was a code hardcoded to the infra of a company, and if any other
 // company wants to do something with this code, they can replace the function with
 // something specific to them.
+//
+// By default it returns the identities proven by the client TLS certificates (see
+// CtxWithIdentities).
 var NewIdentitiesFromContext = func(ctx context.Context) ([]Identity, error) {
-	return nil, nil
+	return IdentitiesFromCtx(ctx), nil
 }
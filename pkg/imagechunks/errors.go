@@ -0,0 +1,68 @@
+// Copyright 2023 Meta Platforms, Inc. and affiliates.
+//
+// Redistribution and use in source and binary forms, with or without modification, are permitted provided that the following conditions are met:
+//
+// 1. Redistributions of source code must retain the above copyright notice, this list of conditions and the following disclaimer.
+//
+// 2. Redistributions in binary form must reproduce the above copyright notice, this list of conditions and the following disclaimer in the documentation and/or other materials provided with the distribution.
+//
+// 3. Neither the name of the copyright holder nor the names of its contributors may be used to endorse or promote products derived from this software without specific prior written permission.
+//
+// THIS SOFTWARE IS PROVIDED BY THE COPYRIGHT HOLDERS AND CONTRIBUTORS "AS IS" AND ANY EXPRESS OR IMPLIED WARRANTIES, INCLUDING, BUT NOT LIMITED TO, THE IMPLIED WARRANTIES OF MERCHANTABILITY AND FITNESS FOR A PARTICULAR PURPOSE ARE DISCLAIMED. IN NO EVENT SHALL THE COPYRIGHT HOLDER OR CONTRIBUTORS BE LIABLE FOR ANY DIRECT, INDIRECT, INCIDENTAL, SPECIAL, EXEMPLARY, OR CONSEQUENTIAL DAMAGES (INCLUDING, BUT NOT LIMITED TO, PROCUREMENT OF SUBSTITUTE GOODS OR SERVICES; LOSS OF USE, DATA, OR PROFITS; OR BUSINESS INTERRUPTION) HOWEVER CAUSED AND ON ANY THEORY OF LIABILITY, WHETHER IN CONTRACT, STRICT LIABILITY, OR TORT (INCLUDING NEGLIGENCE OR OTHERWISE) ARISING IN ANY WAY OUT OF THE USE OF THIS SOFTWARE, EVEN IF ADVISED OF THE POSSIBILITY OF SUCH DAMAGE.
+
+package imagechunks
+
+import (
+	"fmt"
+)
+
+// ErrInvalidManifest means the manifest is inconsistent.
+type ErrInvalidManifest struct {
+	Err error
+}
+
+func (err ErrInvalidManifest) Error() string {
+	return fmt.Sprintf("invalid manifest: %v", err.Err)
+}
+
+func (err ErrInvalidManifest) Unwrap() error {
+	return err.Err
+}
+
+// ErrGetChunk means it was unable to get the content of a chunk.
+type ErrGetChunk struct {
+	Index int
+	Err   error
+}
+
+func (err ErrGetChunk) Error() string {
+	return fmt.Sprintf("unable to get chunk #%d: %v", err.Index, err.Err)
+}
+
+func (err ErrGetChunk) Unwrap() error {
+	return err.Err
+}
+
+// ErrChunkMismatch means the content of a chunk does not correspond to the manifest.
+type ErrChunkMismatch struct {
+	Index int
+	Err   error
+}
+
+func (err ErrChunkMismatch) Error() string {
+	return fmt.Sprintf("chunk #%d does not match the manifest: %v", err.Index, err.Err)
+}
+
+func (err ErrChunkMismatch) Unwrap() error {
+	return err.Err
+}
+
+// ErrImageMismatch means the reconstructed image does not correspond to the manifest.
+type ErrImageMismatch struct {
+	Expected []byte
+	Actual   []byte
+}
+
+func (err ErrImageMismatch) Error() string {
+	return fmt.Sprintf("the reconstructed image does not match the manifest: expected hash %X, actual hash %X", err.Expected, err.Actual)
+}
@@ -0,0 +1,151 @@
+// Copyright 2023 Meta Platforms, Inc. and affiliates.
+//
+// Redistribution and use in source and binary forms, with or without modification, are permitted provided that the following conditions are met:
+//
+// 1. Redistributions of source code must retain the above copyright notice, this list of conditions and the following disclaimer.
+//
+// 2. Redistributions in binary form must reproduce the above copyright notice, this list of conditions and the following disclaimer in the documentation and/or other materials provided with the distribution.
+//
+// 3. Neither the name of the copyright holder nor the names of its contributors may be used to endorse or promote products derived from this software without specific prior written permission.
+//
+// THIS SOFTWARE IS PROVIDED BY THE COPYRIGHT HOLDERS AND CONTRIBUTORS "AS IS" AND ANY EXPRESS OR IMPLIED WARRANTIES, INCLUDING, BUT NOT LIMITED TO, THE IMPLIED WARRANTIES OF MERCHANTABILITY AND FITNESS FOR A PARTICULAR PURPOSE ARE DISCLAIMED. IN NO EVENT SHALL THE COPYRIGHT HOLDER OR CONTRIBUTORS BE LIABLE FOR ANY DIRECT, INDIRECT, INCIDENTAL, SPECIAL, EXEMPLARY, OR CONSEQUENTIAL DAMAGES (INCLUDING, BUT NOT LIMITED TO, PROCUREMENT OF SUBSTITUTE GOODS OR SERVICES; LOSS OF USE, DATA, OR PROFITS; OR BUSINESS INTERRUPTION) HOWEVER CAUSED AND ON ANY THEORY OF LIABILITY, WHETHER IN CONTRACT, STRICT LIABILITY, OR TORT (INCLUDING NEGLIGENCE OR OTHERWISE) ARISING IN ANY WAY OUT OF THE USE OF THIS SOFTWARE, EVEN IF ADVISED OF THE POSSIBILITY OF SUCH DAMAGE.
+
+// Package imagechunks implements splitting of firmware images into
+// fixed-size chunks and reconstruction of images from chunks.
+//
+// It is used to upload only the parts of an image which are not known
+// to the server yet.
+package imagechunks
+
+import (
+	"bytes"
+	"crypto/sha256"
+	"crypto/sha512"
+	"fmt"
+)
+
+const (
+	// DefaultChunkSize is the chunk size used to index the stored images.
+	// A client should use the same size to be able to reuse the chunks of
+	// stored images.
+	DefaultChunkSize = 64 << 10
+
+	// MaxChunkSize is the maximal accepted chunk size.
+	MaxChunkSize = 1 << 20
+
+	// MaxImageSize is the maximal accepted size of an image
+	// (the largest SPI flash chips in use are 64MiB).
+	MaxImageSize = 64 << 20
+)
+
+// Manifest describes an image split into chunks.
+type Manifest struct {
+	// ImageSHA2_512 is the SHA2-512 hash of the whole image.
+	ImageSHA2_512 []byte
+
+	// ImageSize is the size of the whole image.
+	ImageSize uint64
+
+	// ChunkSize is the size of each chunk, except the last one (which could be smaller).
+	ChunkSize uint32
+
+	// ChunkHashes is the list of SHA2-256 hashes of the chunks.
+	ChunkHashes [][]byte
+}
+
+// NewManifest splits the image into chunks of the given size and returns its Manifest.
+func NewManifest(image []byte, chunkSize uint32) Manifest {
+	imageHash := sha512.Sum512(image)
+	m := Manifest{
+		ImageSHA2_512: imageHash[:],
+		ImageSize:     uint64(len(image)),
+		ChunkSize:     chunkSize,
+	}
+	for idx := 0; idx < m.ChunksCount(); idx++ {
+		chunkHash := sha256.Sum256(m.Chunk(image, idx))
+		m.ChunkHashes = append(m.ChunkHashes, chunkHash[:])
+	}
+	return m
+}
+
+// ChunksCount returns the amount of chunks the image consists of.
+func (m Manifest) ChunksCount() int {
+	if m.ChunkSize == 0 {
+		return 0
+	}
+	return int((m.ImageSize + uint64(m.ChunkSize) - 1) / uint64(m.ChunkSize))
+}
+
+// ChunkRange returns the offset and the size of the chunk within the image.
+func (m Manifest) ChunkRange(idx int) (uint64, uint32) {
+	offset := uint64(idx) * uint64(m.ChunkSize)
+	size := m.ChunkSize
+	if offset+uint64(size) > m.ImageSize {
+		size = uint32(m.ImageSize - offset)
+	}
+	return offset, size
+}
+
+// Chunk returns the chunk content from the image.
+func (m Manifest) Chunk(image []byte, idx int) []byte {
+	offset, size := m.ChunkRange(idx)
+	return image[offset : offset+uint64(size)]
+}
+
+// Validate returns an error if the manifest is inconsistent.
+func (m Manifest) Validate() error {
+	if len(m.ImageSHA2_512) != sha512.Size {
+		return fmt.Errorf("invalid length of the image hash: %d != %d", len(m.ImageSHA2_512), sha512.Size)
+	}
+	if m.ImageSize == 0 || m.ImageSize > MaxImageSize {
+		return fmt.Errorf("invalid image size: %d (expected: (0, %d])", m.ImageSize, MaxImageSize)
+	}
+	if m.ChunkSize == 0 || m.ChunkSize > MaxChunkSize {
+		return fmt.Errorf("invalid chunk size: %d (expected: (0, %d])", m.ChunkSize, MaxChunkSize)
+	}
+	if len(m.ChunkHashes) != m.ChunksCount() {
+		return fmt.Errorf("invalid amount of chunks: %d != %d", len(m.ChunkHashes), m.ChunksCount())
+	}
+	for idx, chunkHash := range m.ChunkHashes {
+		if len(chunkHash) != sha256.Size {
+			return fmt.Errorf("invalid length of hash of chunk #%d: %d != %d", idx, len(chunkHash), sha256.Size)
+		}
+	}
+	return nil
+}
+
+// Reconstruct assembles the image described by the manifest.
+//
+// getChunk should return the content of the chunk with the given index.
+// The content of each chunk and the resulting image are verified against
+// the hashes in the manifest. The image is grown only by verified chunks,
+// so a manifest claiming a large image does not cause a large allocation
+// by itself.
+func (m Manifest) Reconstruct(getChunk func(idx int) ([]byte, error)) ([]byte, error) {
+	if err := m.Validate(); err != nil {
+		return nil, ErrInvalidManifest{Err: err}
+	}
+
+	var image []byte
+	for idx, expectedHash := range m.ChunkHashes {
+		chunk, err := getChunk(idx)
+		if err != nil {
+			return nil, ErrGetChunk{Index: idx, Err: err}
+		}
+		_, size := m.ChunkRange(idx)
+		if len(chunk) != int(size) {
+			return nil, ErrChunkMismatch{Index: idx, Err: fmt.Errorf("invalid size: %d != %d", len(chunk), size)}
+		}
+		chunkHash := sha256.Sum256(chunk)
+		if !bytes.Equal(chunkHash[:], expectedHash) {
+			return nil, ErrChunkMismatch{Index: idx, Err: fmt.Errorf("invalid hash: %X != %X", chunkHash[:], expectedHash)}
+		}
+		image = append(image, chunk...)
+	}
+
+	imageHash := sha512.Sum512(image)
+	if !bytes.Equal(imageHash[:], m.ImageSHA2_512) {
+		return nil, ErrImageMismatch{Expected: m.ImageSHA2_512, Actual: imageHash[:]}
+	}
+	return image, nil
+}
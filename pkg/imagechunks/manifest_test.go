@@ -0,0 +1,77 @@
+// Copyright 2023 Meta Platforms, Inc. and affiliates.
+//
+// Redistribution and use in source and binary forms, with or without modification, are permitted provided that the following conditions are met:
+//
+// 1. Redistributions of source code must retain the above copyright notice, this list of conditions and the following disclaimer.
+//
+// 2. Redistributions in binary form must reproduce the above copyright notice, this list of conditions and the following disclaimer in the documentation and/or other materials provided with the distribution.
+//
+// 3. Neither the name of the copyright holder nor the names of its contributors may be used to endorse or promote products derived from this software without specific prior written permission.
+//
+// THIS SOFTWARE IS PROVIDED BY THE COPYRIGHT HOLDERS AND CONTRIBUTORS "AS IS" AND ANY EXPRESS OR IMPLIED WARRANTIES, INCLUDING, BUT NOT LIMITED TO, THE IMPLIED WARRANTIES OF MERCHANTABILITY AND FITNESS FOR A PARTICULAR PURPOSE ARE DISCLAIMED. IN NO EVENT SHALL THE COPYRIGHT HOLDER OR CONTRIBUTORS BE LIABLE FOR ANY DIRECT, INDIRECT, INCIDENTAL, SPECIAL, EXEMPLARY, OR CONSEQUENTIAL DAMAGES (INCLUDING, BUT NOT LIMITED TO, PROCUREMENT OF SUBSTITUTE GOODS OR SERVICES; LOSS OF USE, DATA, OR PROFITS; OR BUSINESS INTERRUPTION) HOWEVER CAUSED AND ON ANY THEORY OF LIABILITY, WHETHER IN CONTRACT, STRICT LIABILITY, OR TORT (INCLUDING NEGLIGENCE OR OTHERWISE) ARISING IN ANY WAY OUT OF THE USE OF THIS SOFTWARE, EVEN IF ADVISED OF THE POSSIBILITY OF SUCH DAMAGE.
+
+package imagechunks
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"runtime"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestManifestReconstruct(t *testing.T) {
+	image := bytes.Repeat([]byte("0123456789"), 10)
+	m := NewManifest(image, 16)
+	require.NoError(t, m.Validate())
+	require.Equal(t, 7, m.ChunksCount())
+	require.Len(t, m.Chunk(image, 6), 4)
+
+	reconstructed, err := m.Reconstruct(func(idx int) ([]byte, error) {
+		return m.Chunk(image, idx), nil
+	})
+	require.NoError(t, err)
+	require.Equal(t, image, reconstructed)
+
+	_, err = m.Reconstruct(func(idx int) ([]byte, error) {
+		chunk := append([]byte{}, m.Chunk(image, idx)...)
+		if idx == 3 {
+			chunk[0]++
+		}
+		return chunk, nil
+	})
+	require.True(t, errors.As(err, &ErrChunkMismatch{}), err)
+
+	m.ChunkHashes = m.ChunkHashes[1:]
+	_, err = m.Reconstruct(func(idx int) ([]byte, error) {
+		return m.Chunk(image, idx), nil
+	})
+	require.True(t, errors.As(err, &ErrInvalidManifest{}), err)
+}
+
+func TestManifestReconstructNoPreallocation(t *testing.T) {
+	m := Manifest{
+		ImageSHA2_512: make([]byte, 64),
+		ImageSize:     MaxImageSize,
+		ChunkSize:     MaxChunkSize,
+		ChunkHashes:   make([][]byte, MaxImageSize/MaxChunkSize),
+	}
+	for idx := range m.ChunkHashes {
+		m.ChunkHashes[idx] = make([]byte, 32)
+	}
+	require.NoError(t, m.Validate())
+
+	var before, after runtime.MemStats
+	runtime.ReadMemStats(&before)
+	_, err := m.Reconstruct(func(idx int) ([]byte, error) {
+		return nil, fmt.Errorf("unknown chunk")
+	})
+	runtime.ReadMemStats(&after)
+	require.True(t, errors.As(err, &ErrGetChunk{}), err)
+	require.Less(t, after.TotalAlloc-before.TotalAlloc, uint64(MaxChunkSize))
+
+	m.ImageSize = MaxImageSize + 1
+	require.Error(t, m.Validate())
+}
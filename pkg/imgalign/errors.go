@@ -23,36 +23,28 @@ func (ErrNoOrigImageToCompareWith) Error() string {
 	return "no original image to compare with"
 }
 
-// ErrImageLengthDoesNotMatch is returned when the size of the UEFI image
-// is not as expected.
-type ErrImageLengthDoesNotMatch struct {
-	ExpectedLength uint
-	ReceivedLength uint
-}
-
-func (err ErrImageLengthDoesNotMatch) Error() string {
-	return fmt.Sprintf("images length does not match: %d != %d", err.ReceivedLength, err.ExpectedLength)
+// ErrUnableToGetLayout is returned if it was unable to find the IFD
+// regions and firmware volumes of an image.
+type ErrUnableToGetLayout struct {
+	Image string
+	Err   error
 }
 
-// ErrUnableToFindBIOSRegion is returned if BIOSRegion is not found
-type ErrUnableToFindBIOSRegion struct {
-	Err error
+func (err ErrUnableToGetLayout) Error() string {
+	return fmt.Sprintf("unable to get the layout of the %s image: %v", err.Image, err.Err)
 }
 
-func (err ErrUnableToFindBIOSRegion) Error() string {
-	return fmt.Sprintf("unable to find BIOS region: %v", err.Err)
-}
-
-func (err ErrUnableToFindBIOSRegion) Unwrap() error {
+func (err ErrUnableToGetLayout) Unwrap() error {
 	return err.Err
 }
 
-// ErrUnexpectedAmountOfBIOSRegions is returned when an UEFI image
-// contains an amount of BIOS regions not equals to one.
-type ErrUnexpectedAmountOfBIOSRegions struct {
-	FoundCount uint
+// ErrNoCommonLayout is returned when images of different sizes have
+// no common regions or volumes to be aligned by.
+type ErrNoCommonLayout struct {
+	ExpectedLength uint
+	ReceivedLength uint
 }
 
-func (err ErrUnexpectedAmountOfBIOSRegions) Error() string {
-	return fmt.Sprintf("expected amount of BIOS regions is one, but found: %d", err.FoundCount)
+func (err ErrNoCommonLayout) Error() string {
+	return fmt.Sprintf("images of different sizes (%d != %d) have no common regions or volumes", err.ReceivedLength, err.ExpectedLength)
 }
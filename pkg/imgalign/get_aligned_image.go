@@ -14,6 +14,7 @@ package imgalign
 import (
 	"context"
 	"github.com/immune-gmbh/AttestationFailureAnalysisService/pkg/uefi"
+	pkgbytes "github.com/linuxboot/fiano/pkg/bytes"
 	fianoUEFI "github.com/linuxboot/fiano/pkg/uefi"
 
 	"github.com/facebookincubator/go-belt/beltctx"
@@ -22,19 +23,23 @@ import (
 	"github.com/facebookincubator/go-belt/tool/logger"
 )
 
-// GetAlignedImage returns a part of fullImage which is aligned
-// (and ready to be compared) with partialImage.
+// GetAlignedImage returns an image of the same size as partialImage, which
+// contains the data of fullImage aligned (and ready to be compared) with partialImage.
 //
 // Some images might contain only the BIOS region instead of the whole image,
 // and to properly compare two BIOS regions we return the same regios of
 // originalFirmware as we have in receivedImage.
+//
+// Images of other sizes or layouts (different flash sizes, dumps of other tools)
+// are aligned region-by-region using the IFD region map and volume-by-volume
+// using the firmware volume GUIDs (see Mapping).
 func GetAlignedImage(
 	ctx context.Context,
 	fullImage *uefi.UEFI,
 	partialImage []byte,
-) (firmware *uefi.UEFI, offset uint64, err error) {
+) (firmware *uefi.UEFI, alignment *Alignment, err error) {
 	if fullImage == nil {
-		return nil, 0, ErrNoOrigImageToCompareWith{}
+		return nil, nil, ErrNoOrigImageToCompareWith{}
 	}
 	ctx = beltctx.WithFields(ctx, field.Map[int]{
 		"fullSize":    len(fullImage.Buf()),
@@ -45,14 +50,20 @@ func GetAlignedImage(
 	defer span.Finish()
 	log := logger.FromCtx(ctx)
 	defer func() {
-		log.WithField("offset", offset).WithField("err", err).Debugf("")
+		log.WithField("alignment", alignment).WithField("err", err).Debugf("")
 	}()
 
-	switch {
-	case len(fullImage.Buf()) == len(partialImage):
-		return fullImage, 0, nil
-	case len(fullImage.Buf()) < len(partialImage):
-		return nil, 0, ErrImageLengthDoesNotMatch{ExpectedLength: uint(len(fullImage.Buf())), ReceivedLength: uint(len(partialImage))}
+	wholeImage := func(offset uint64) *Alignment {
+		return &Alignment{Mapping: Mapping{{
+			Kind:     SegmentKindImage,
+			Name:     "image",
+			Original: pkgbytes.Range{Offset: offset, Length: uint64(len(partialImage))},
+			Actual:   pkgbytes.Range{Offset: 0, Length: uint64(len(partialImage))},
+		}}}
+	}
+
+	if len(fullImage.Buf()) == len(partialImage) {
+		return fullImage, wholeImage(0), nil
 	}
 
 	// There are different ways to dump a firmware image. And sometimes we
@@ -63,24 +74,73 @@ func GetAlignedImage(
 	// Since we have only a part of the image and we want to make it work,
 	// we reduce the original image to BIOS region as well, to make offsets
 	// and sizes the same as in the received image.
-	log.Debugf("original image is larger than received image (%d > %d), assuming the received image is only the BIOS region",
+	if len(fullImage.Buf()) > len(partialImage) {
+		biosRegions, err := fullImage.GetByRegionType(fianoUEFI.RegionTypeBIOS)
+		if err == nil && len(biosRegions) == 1 && len(biosRegions[0].Buf()) == len(partialImage) {
+			log.Debugf("original image is larger than received image (%d > %d), the received image is the BIOS region",
+				len(fullImage.Buf()), len(partialImage))
+			biosRegion := biosRegions[0]
+			// Here we force to compare a BIOS region with a BIOS region (instead
+			// of the whole image):
+			return &uefi.UEFI{Node: *biosRegion}, wholeImage(biosRegion.Offset), nil
+		}
+	}
+
+	log.Debugf("images have different sizes (%d != %d), aligning by regions and volumes",
 		len(fullImage.Buf()), len(partialImage))
-	biosRegions, err := fullImage.GetByRegionType(fianoUEFI.RegionTypeBIOS)
+	return alignByLayout(fullImage, partialImage)
+}
+
+// Alignment describes how the original image is aligned with the actual image.
+type Alignment struct {
+	Mapping Mapping
+
+	// UnmappedRanges are the ranges of the actual image, which have no
+	// counterpart in the original image and thus cannot be compared.
+	UnmappedRanges pkgbytes.Ranges
+}
+
+// ImageOffset returns the offset of the actual image within the original image
+// if the alignment is just a shift of the whole actual image.
+func (a *Alignment) ImageOffset() (uint64, bool) {
+	if a == nil || len(a.UnmappedRanges) != 0 {
+		return 0, false
+	}
+	return a.Mapping.ImageOffset()
+}
+
+func alignByLayout(
+	fullImage *uefi.UEFI,
+	partialImage []byte,
+) (*uefi.UEFI, *Alignment, error) {
+	originalLayout, err := getLayout(fullImage)
 	if err != nil {
-		return nil, 0, ErrUnableToFindBIOSRegion{Err: err}
+		return nil, nil, ErrUnableToGetLayout{Image: "original", Err: err}
 	}
-	if len(biosRegions) != 1 {
-		return nil, 0, ErrUnexpectedAmountOfBIOSRegions{FoundCount: uint(len(biosRegions))}
+	actualImage, err := uefi.Parse(partialImage, false)
+	if err != nil {
+		return nil, nil, ErrUnableToGetLayout{Image: "actual", Err: err}
+	}
+	actualLayout, err := getLayout(actualImage)
+	if err != nil {
+		return nil, nil, ErrUnableToGetLayout{Image: "actual", Err: err}
 	}
-	biosRegion := biosRegions[0]
 
-	if len(biosRegion.Buf()) != len(partialImage) {
-		// If we got here, something is wrong. We expect the sizes be the same
-		// due to line "fullImage = biosRegions[0]" above.
-		return nil, 0, ErrImageLengthDoesNotMatch{ExpectedLength: uint(len(biosRegion.Buf())), ReceivedLength: uint(len(partialImage))}
+	mapping := matchLayouts(originalLayout, actualLayout)
+	if len(mapping) == 0 {
+		return nil, nil, ErrNoCommonLayout{
+			ExpectedLength: uint(len(fullImage.Buf())),
+			ReceivedLength: uint(len(partialImage)),
+		}
 	}
 
-	// Here we force to compare a BIOS region with a BIOS region (instead
-	// of the whole image):
-	return &uefi.UEFI{Node: *biosRegion}, biosRegion.Offset, nil
+	alignedBytes, unmapped := mapping.Apply(fullImage.Buf(), partialImage)
+	aligned, err := uefi.Parse(alignedBytes, false)
+	if err != nil {
+		return nil, nil, ErrUnableToGetLayout{Image: "aligned", Err: err}
+	}
+	return aligned, &Alignment{
+		Mapping:        mapping,
+		UnmappedRanges: unmapped,
+	}, nil
 }
@@ -0,0 +1,69 @@
+// Copyright 2023 Meta Platforms, Inc. and affiliates.
+//
+// Redistribution and use in source and binary forms, with or without modification, are permitted provided that the following conditions are met:
+//
+// 1. Redistributions of source code must retain the above copyright notice, this list of conditions and the following disclaimer.
+//
+// 2. Redistributions in binary form must reproduce the above copyright notice, this list of conditions and the following disclaimer in the documentation and/or other materials provided with the distribution.
+//
+// 3. Neither the name of the copyright holder nor the names of its contributors may be used to endorse or promote products derived from this software without specific prior written permission.
+//
+// THIS SOFTWARE IS PROVIDED BY THE COPYRIGHT HOLDERS AND CONTRIBUTORS "AS IS" AND ANY EXPRESS OR IMPLIED WARRANTIES, INCLUDING, BUT NOT LIMITED TO, THE IMPLIED WARRANTIES OF MERCHANTABILITY AND FITNESS FOR A PARTICULAR PURPOSE ARE DISCLAIMED. IN NO EVENT SHALL THE COPYRIGHT HOLDER OR CONTRIBUTORS BE LIABLE FOR ANY DIRECT, INDIRECT, INCIDENTAL, SPECIAL, EXEMPLARY, OR CONSEQUENTIAL DAMAGES (INCLUDING, BUT NOT LIMITED TO, PROCUREMENT OF SUBSTITUTE GOODS OR SERVICES; LOSS OF USE, DATA, OR PROFITS; OR BUSINESS INTERRUPTION) HOWEVER CAUSED AND ON ANY THEORY OF LIABILITY, WHETHER IN CONTRACT, STRICT LIABILITY, OR TORT (INCLUDING NEGLIGENCE OR OTHERWISE) ARISING IN ANY WAY OUT OF THE USE OF THIS SOFTWARE, EVEN IF ADVISED OF THE POSSIBILITY OF SUCH DAMAGE.
+package imgalign
+
+import (
+	"bytes"
+	"context"
+	"testing"
+
+	"github.com/9elements/converged-security-suite/v2/testdata/firmware"
+	pkgbytes "github.com/linuxboot/fiano/pkg/bytes"
+	"github.com/stretchr/testify/require"
+
+	"github.com/immune-gmbh/AttestationFailureAnalysisService/pkg/uefi"
+)
+
+func TestGetAlignedImage(t *testing.T) {
+	ctx := context.Background()
+	original, err := uefi.Parse(firmware.FakeIntelFirmware, false)
+	require.NoError(t, err)
+
+	t.Run("no_original", func(t *testing.T) {
+		_, _, err := GetAlignedImage(ctx, nil, firmware.FakeIntelFirmware)
+		require.ErrorAs(t, err, &ErrNoOrigImageToCompareWith{})
+	})
+
+	t.Run("same_size", func(t *testing.T) {
+		aligned, alignment, err := GetAlignedImage(ctx, original, firmware.FakeIntelFirmware)
+		require.NoError(t, err)
+		require.Equal(t, original.Buf(), aligned.Buf())
+		offset, ok := alignment.ImageOffset()
+		require.True(t, ok)
+		require.Zero(t, offset)
+	})
+
+	t.Run("different_layout", func(t *testing.T) {
+		// the actual flash is twice as large, the firmware is at its end
+		// and the unused head is filled with zeros (not erased)
+		const headLength = 0x10000
+		actual := append(make([]byte, headLength), firmware.FakeIntelFirmware...)
+		// a modification within the last firmware volume
+		const modifiedOffset = headLength + 0x9000
+		actual[modifiedOffset] ^= 0xff
+
+		aligned, alignment, err := GetAlignedImage(ctx, original, actual)
+		require.NoError(t, err)
+		require.Len(t, aligned.Buf(), len(actual))
+		require.NotEmpty(t, alignment.Mapping)
+		require.Equal(t, pkgbytes.Ranges{{Offset: 0, Length: headLength}}, alignment.UnmappedRanges)
+		_, ok := alignment.ImageOffset()
+		require.False(t, ok)
+
+		// the mapped part contains the original data, so the modification is visible
+		require.Equal(t, firmware.FakeIntelFirmware, aligned.Buf()[headLength:])
+		require.NotEqual(t, actual[modifiedOffset], aligned.Buf()[modifiedOffset])
+
+		// the unmapped part is not copied from the actual image
+		require.Equal(t, bytes.Repeat([]byte{0xff}, headLength), aligned.Buf()[:headLength])
+	})
+}
@@ -0,0 +1,206 @@
+// Copyright 2023 Meta Platforms, Inc. and affiliates.
+//
+// Redistribution and use in source and binary forms, with or without modification, are permitted provided that the following conditions are met:
+//
+// 1. Redistributions of source code must retain the above copyright notice, this list of conditions and the following disclaimer.
+//
+// 2. Redistributions in binary form must reproduce the above copyright notice, this list of conditions and the following disclaimer in the documentation and/or other materials provided with the distribution.
+//
+// 3. Neither the name of the copyright holder nor the names of its contributors may be used to endorse or promote products derived from this software without specific prior written permission.
+//
+// THIS SOFTWARE IS PROVIDED BY THE COPYRIGHT HOLDERS AND CONTRIBUTORS "AS IS" AND ANY EXPRESS OR IMPLIED WARRANTIES, INCLUDING, BUT NOT LIMITED TO, THE IMPLIED WARRANTIES OF MERCHANTABILITY AND FITNESS FOR A PARTICULAR PURPOSE ARE DISCLAIMED. IN NO EVENT SHALL THE COPYRIGHT HOLDER OR CONTRIBUTORS BE LIABLE FOR ANY DIRECT, INDIRECT, INCIDENTAL, SPECIAL, EXEMPLARY, OR CONSEQUENTIAL DAMAGES (INCLUDING, BUT NOT LIMITED TO, PROCUREMENT OF SUBSTITUTE GOODS OR SERVICES; LOSS OF USE, DATA, OR PROFITS; OR BUSINESS INTERRUPTION) HOWEVER CAUSED AND ON ANY THEORY OF LIABILITY, WHETHER IN CONTRACT, STRICT LIABILITY, OR TORT (INCLUDING NEGLIGENCE OR OTHERWISE) ARISING IN ANY WAY OUT OF THE USE OF THIS SOFTWARE, EVEN IF ADVISED OF THE POSSIBILITY OF SUCH DAMAGE.
+package imgalign
+
+import (
+	"bytes"
+	"fmt"
+	"sort"
+
+	"github.com/immune-gmbh/AttestationFailureAnalysisService/pkg/uefi"
+
+	pkgbytes "github.com/linuxboot/fiano/pkg/bytes"
+	fianoUEFI "github.com/linuxboot/fiano/pkg/uefi"
+)
+
+// SegmentKind defines which layout element a Segment was derived from.
+type SegmentKind int
+
+const (
+	// SegmentKindUndefined is an invalid SegmentKind.
+	SegmentKindUndefined = SegmentKind(iota)
+
+	// SegmentKindImage is a segment covering the whole actual image.
+	SegmentKindImage
+
+	// SegmentKindRegion is a segment derived from an IFD region.
+	SegmentKindRegion
+
+	// SegmentKindVolume is a segment derived from a firmware volume.
+	SegmentKindVolume
+)
+
+// String implements fmt.Stringer.
+func (kind SegmentKind) String() string {
+	switch kind {
+	case SegmentKindImage:
+		return "image"
+	case SegmentKindRegion:
+		return "region"
+	case SegmentKindVolume:
+		return "volume"
+	}
+	return fmt.Sprintf("unknown_%d", int(kind))
+}
+
+// Segment is a pair of byte ranges which are expected to contain the same
+// data in the original and in the actual images.
+type Segment struct {
+	Kind SegmentKind
+
+	// Name identifies the layout element, for example the region type or the volume GUID.
+	Name string
+
+	// Original is the byte range in the original image.
+	Original pkgbytes.Range
+
+	// Actual is the byte range in the actual image, it has the same length as Original.
+	Actual pkgbytes.Range
+}
+
+// String implements fmt.Stringer.
+func (s Segment) String() string {
+	return fmt.Sprintf("%s:%s:0x%X:0x%X->0x%X:0x%X",
+		s.Kind, s.Name, s.Original.Offset, s.Original.End(), s.Actual.Offset, s.Actual.End())
+}
+
+// Mapping is the set of segments mapping the actual image to the original image.
+//
+// Segments are ordered by the priority: if segments overlap in the actual
+// image, then the latter one wins.
+type Mapping []Segment
+
+// ImageOffset returns the offset of the actual image within the original image
+// if the mapping is just a shift of the whole actual image.
+func (m Mapping) ImageOffset() (uint64, bool) {
+	if len(m) != 1 || m[0].Actual.Offset != 0 {
+		return 0, false
+	}
+	return m[0].Original.Offset, true
+}
+
+// Apply builds an image of size `actualSize`, which contains the data of
+// `original` at the positions of the actual image according to the mapping.
+//
+// The bytes not covered by the mapping have no original content, they are
+// filled with 0xFF (erased flash) and returned as `unmapped`. They are never
+// copied from `actual`, since that would make them indistinguishable from
+// verified matching data.
+func (m Mapping) Apply(original, actual []byte) (aligned []byte, unmapped pkgbytes.Ranges) {
+	aligned = bytes.Repeat([]byte{0xff}, len(actual))
+
+	var mapped pkgbytes.Ranges
+	for _, s := range m {
+		copy(aligned[s.Actual.Offset:s.Actual.End()], original[s.Original.Offset:s.Original.End()])
+		mapped = append(mapped, s.Actual)
+	}
+	mapped.SortAndMerge()
+
+	whole := pkgbytes.Range{Offset: 0, Length: uint64(len(actual))}
+	for _, r := range whole.Exclude(mapped...) {
+		if r.Length != 0 {
+			unmapped = append(unmapped, r)
+		}
+	}
+	return aligned, unmapped
+}
+
+// layoutItem is an IFD region or a firmware volume of an image.
+type layoutItem struct {
+	Kind  SegmentKind
+	Name  string
+	Range pkgbytes.Range
+}
+
+// getLayout returns the IFD regions and firmware volumes of the image.
+//
+// Multiple volumes with the same GUID are distinguished by their index.
+func getLayout(image *uefi.UEFI) ([]layoutItem, error) {
+	nodes, err := image.GetByRange(pkgbytes.Range{Offset: 0, Length: uint64(len(image.Buf()))})
+	if err != nil {
+		return nil, fmt.Errorf("unable to scan for UEFI nodes: %w", err)
+	}
+
+	var result []layoutItem
+	volumeCount := map[string]int{}
+	for _, node := range nodes {
+		if node.Range.Length == 0 {
+			continue
+		}
+		switch f := node.Firmware.(type) {
+		case fianoUEFI.Region:
+			result = append(result, layoutItem{
+				Kind:  SegmentKindRegion,
+				Name:  f.Type().String(),
+				Range: node.Range,
+			})
+		case *fianoUEFI.FirmwareVolume:
+			name := f.FVName.String()
+			result = append(result, layoutItem{
+				Kind:  SegmentKindVolume,
+				Name:  fmt.Sprintf("%s#%d", name, volumeCount[name]),
+				Range: node.Range,
+			})
+			volumeCount[name]++
+		}
+	}
+	return result, nil
+}
+
+// matchLayouts maps the layout elements of the actual image to the elements
+// of the original image with the same kind and name.
+//
+// Elements of the same size are mapped as is. Regions of different sizes are
+// mapped by their ends (the BIOS region is mapped right below 4GiB, so it grows
+// downwards on larger SKUs), the excessive part is left unmapped. Volumes of
+// different sizes are not mapped.
+func matchLayouts(original, actual []layoutItem) Mapping {
+	originalItems := map[SegmentKind]map[string]pkgbytes.Range{}
+	for _, item := range original {
+		if originalItems[item.Kind] == nil {
+			originalItems[item.Kind] = map[string]pkgbytes.Range{}
+		}
+		originalItems[item.Kind][item.Name] = item.Range
+	}
+
+	var result Mapping
+	for _, item := range actual {
+		origRange, ok := originalItems[item.Kind][item.Name]
+		if !ok {
+			continue
+		}
+		actualRange := item.Range
+		if origRange.Length != actualRange.Length {
+			if item.Kind != SegmentKindRegion {
+				continue
+			}
+			length := origRange.Length
+			if actualRange.Length < length {
+				length = actualRange.Length
+			}
+			origRange = pkgbytes.Range{Offset: origRange.End() - length, Length: length}
+			actualRange = pkgbytes.Range{Offset: actualRange.End() - length, Length: length}
+		}
+		result = append(result, Segment{
+			Kind:     item.Kind,
+			Name:     item.Name,
+			Original: origRange,
+			Actual:   actualRange,
+		})
+	}
+
+	// regions first, so the more precise volumes override them
+	sort.SliceStable(result, func(i, j int) bool {
+		return result[i].Kind < result[j].Kind
+	})
+	return result
+}
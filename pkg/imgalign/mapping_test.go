@@ -0,0 +1,73 @@
+// Copyright 2023 Meta Platforms, Inc. and affiliates.
+//
+// Redistribution and use in source and binary forms, with or without modification, are permitted provided that the following conditions are met:
+//
+// 1. Redistributions of source code must retain the above copyright notice, this list of conditions and the following disclaimer.
+//
+// 2. Redistributions in binary form must reproduce the above copyright notice, this list of conditions and the following disclaimer in the documentation and/or other materials provided with the distribution.
+//
+// 3. Neither the name of the copyright holder nor the names of its contributors may be used to endorse or promote products derived from this software without specific prior written permission.
+//
+// THIS SOFTWARE IS PROVIDED BY THE COPYRIGHT HOLDERS AND CONTRIBUTORS "AS IS" AND ANY EXPRESS OR IMPLIED WARRANTIES, INCLUDING, BUT NOT LIMITED TO, THE IMPLIED WARRANTIES OF MERCHANTABILITY AND FITNESS FOR A PARTICULAR PURPOSE ARE DISCLAIMED. IN NO EVENT SHALL THE COPYRIGHT HOLDER OR CONTRIBUTORS BE LIABLE FOR ANY DIRECT, INDIRECT, INCIDENTAL, SPECIAL, EXEMPLARY, OR CONSEQUENTIAL DAMAGES (INCLUDING, BUT NOT LIMITED TO, PROCUREMENT OF SUBSTITUTE GOODS OR SERVICES; LOSS OF USE, DATA, OR PROFITS; OR BUSINESS INTERRUPTION) HOWEVER CAUSED AND ON ANY THEORY OF LIABILITY, WHETHER IN CONTRACT, STRICT LIABILITY, OR TORT (INCLUDING NEGLIGENCE OR OTHERWISE) ARISING IN ANY WAY OUT OF THE USE OF THIS SOFTWARE, EVEN IF ADVISED OF THE POSSIBILITY OF SUCH DAMAGE.
+package imgalign
+
+import (
+	"bytes"
+	"testing"
+
+	pkgbytes "github.com/linuxboot/fiano/pkg/bytes"
+	"github.com/stretchr/testify/require"
+)
+
+func TestMatchLayouts(t *testing.T) {
+	original := []layoutItem{
+		{Kind: SegmentKindRegion, Name: "ME", Range: pkgbytes.Range{Offset: 0x1000, Length: 0x3000}},
+		{Kind: SegmentKindRegion, Name: "BIOS", Range: pkgbytes.Range{Offset: 0x4000, Length: 0x4000}},
+		{Kind: SegmentKindVolume, Name: "vol#0", Range: pkgbytes.Range{Offset: 0x5000, Length: 0x1000}},
+		{Kind: SegmentKindVolume, Name: "resized#0", Range: pkgbytes.Range{Offset: 0x6000, Length: 0x1000}},
+	}
+	actual := []layoutItem{
+		{Kind: SegmentKindVolume, Name: "vol#0", Range: pkgbytes.Range{Offset: 0x9000, Length: 0x1000}},
+		{Kind: SegmentKindVolume, Name: "resized#0", Range: pkgbytes.Range{Offset: 0xa000, Length: 0x2000}},
+		{Kind: SegmentKindVolume, Name: "unknown#0", Range: pkgbytes.Range{Offset: 0xc000, Length: 0x1000}},
+		{Kind: SegmentKindRegion, Name: "BIOS", Range: pkgbytes.Range{Offset: 0x8000, Length: 0x8000}},
+	}
+
+	require.Equal(t, Mapping{
+		{
+			Kind:     SegmentKindRegion,
+			Name:     "BIOS",
+			Original: pkgbytes.Range{Offset: 0x4000, Length: 0x4000},
+			Actual:   pkgbytes.Range{Offset: 0xc000, Length: 0x4000},
+		},
+		{
+			Kind:     SegmentKindVolume,
+			Name:     "vol#0",
+			Original: pkgbytes.Range{Offset: 0x5000, Length: 0x1000},
+			Actual:   pkgbytes.Range{Offset: 0x9000, Length: 0x1000},
+		},
+	}, matchLayouts(original, actual))
+}
+
+func TestMappingApply(t *testing.T) {
+	original := bytes.Repeat([]byte{0xaa}, 0x10)
+	copy(original[0x8:], []byte{1, 2, 3, 4})
+	actual := bytes.Repeat([]byte{0xbb}, 0x20)
+
+	mapping := Mapping{
+		{Kind: SegmentKindRegion, Name: "BIOS", Original: pkgbytes.Range{Offset: 0x0, Length: 0x10}, Actual: pkgbytes.Range{Offset: 0x10, Length: 0x10}},
+		{Kind: SegmentKindVolume, Name: "vol#0", Original: pkgbytes.Range{Offset: 0x8, Length: 0x4}, Actual: pkgbytes.Range{Offset: 0x1c, Length: 0x4}},
+	}
+	aligned, unmapped := mapping.Apply(original, actual)
+
+	expected := append(bytes.Repeat([]byte{0xff}, 0x10), original...)
+	copy(expected[0x1c:], []byte{1, 2, 3, 4})
+	require.Equal(t, expected, aligned)
+	require.Equal(t, pkgbytes.Ranges{{Offset: 0, Length: 0x10}}, unmapped)
+
+	_, ok := mapping.ImageOffset()
+	require.False(t, ok)
+	offset, ok := Mapping{{Kind: SegmentKindImage, Original: pkgbytes.Range{Offset: 0x100, Length: 0x10}, Actual: pkgbytes.Range{Offset: 0, Length: 0x10}}}.ImageOffset()
+	require.True(t, ok)
+	require.Equal(t, uint64(0x100), offset)
+}
@@ -0,0 +1,166 @@
+// Copyright 2023 Meta Platforms, Inc. and affiliates.
+//
+// Redistribution and use in source and binary forms, with or without modification, are permitted provided that the following conditions are met:
+//
+// 1. Redistributions of source code must retain the above copyright notice, this list of conditions and the following disclaimer.
+//
+// 2. Redistributions in binary form must reproduce the above copyright notice, this list of conditions and the following disclaimer in the documentation and/or other materials provided with the distribution.
+//
+// 3. Neither the name of the copyright holder nor the names of its contributors may be used to endorse or promote products derived from this software without specific prior written permission.
+//
+// THIS SOFTWARE IS PROVIDED BY THE COPYRIGHT HOLDERS AND CONTRIBUTORS "AS IS" AND ANY EXPRESS OR IMPLIED WARRANTIES, INCLUDING, BUT NOT LIMITED TO, THE IMPLIED WARRANTIES OF MERCHANTABILITY AND FITNESS FOR A PARTICULAR PURPOSE ARE DISCLAIMED. IN NO EVENT SHALL THE COPYRIGHT HOLDER OR CONTRIBUTORS BE LIABLE FOR ANY DIRECT, INDIRECT, INCIDENTAL, SPECIAL, EXEMPLARY, OR CONSEQUENTIAL DAMAGES (INCLUDING, BUT NOT LIMITED TO, PROCUREMENT OF SUBSTITUTE GOODS OR SERVICES; LOSS OF USE, DATA, OR PROFITS; OR BUSINESS INTERRUPTION) HOWEVER CAUSED AND ON ANY THEORY OF LIABILITY, WHETHER IN CONTRACT, STRICT LIABILITY, OR TORT (INCLUDING NEGLIGENCE OR OTHERWISE) ARISING IN ANY WAY OUT OF THE USE OF THIS SOFTWARE, EVEN IF ADVISED OF THE POSSIBILITY OF SUCH DAMAGE.
+
+package measurements
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"os"
+
+	"github.com/9elements/converged-security-suite/v2/pkg/bootflow/flows"
+	"github.com/9elements/converged-security-suite/v2/pkg/bootflow/subsystems/trustchains/tpm"
+	"github.com/9elements/converged-security-suite/v2/pkg/bootflow/systemartifacts/biosimage"
+	bootflowtypes "github.com/9elements/converged-security-suite/v2/pkg/bootflow/types"
+	"github.com/9elements/converged-security-suite/v2/pkg/registers"
+	"github.com/9elements/converged-security-suite/v2/pkg/tpmdetection"
+	"github.com/facebookincubator/go-belt/tool/logger"
+	"github.com/google/go-tpm/tpm2"
+)
+
+// ExpectedPCR0 is a PCR0 value expected to be reported by a host with
+// the specific firmware image, boot flow and status registers.
+type ExpectedPCR0 struct {
+	Flow bootflowtypes.Flow
+
+	// TPMDevices are the TPM device types supporting the PCR bank HashAlgo.
+	// The simulated values do not depend on the TPM device type, so
+	// a value is not duplicated for each of them.
+	TPMDevices []tpmdetection.Type
+
+	Registers registers.Registers
+	HashAlgo  tpm2.Algorithm
+	Value     []byte
+}
+
+// supportedTPMDevices are the TPM device types expected PCR values are calculated for.
+var supportedTPMDevices = []tpmdetection.Type{tpmdetection.TypeTPM12, tpmdetection.TypeTPM20}
+
+// HashAlgosForTPM returns the PCR banks supported by the TPM device.
+func HashAlgosForTPM(tpmDevice tpmdetection.Type) []tpm2.Algorithm {
+	switch tpmDevice {
+	case tpmdetection.TypeTPM12:
+		return []tpm2.Algorithm{tpm2.AlgSHA1}
+	case tpmdetection.TypeTPM20:
+		return []tpm2.Algorithm{tpm2.AlgSHA1, tpm2.AlgSHA256}
+	}
+	return nil
+}
+
+// TPMDevicesForHashAlgo returns the TPM device types (of the supported ones),
+// which support the PCR bank.
+func TPMDevicesForHashAlgo(hashAlgo tpm2.Algorithm) []tpmdetection.Type {
+	var result []tpmdetection.Type
+	for _, tpmDevice := range supportedTPMDevices {
+		for _, supportedAlgo := range HashAlgosForTPM(tpmDevice) {
+			if supportedAlgo == hashAlgo {
+				result = append(result, tpmDevice)
+				break
+			}
+		}
+	}
+	return result
+}
+
+// hashAlgos returns the PCR banks supported by any of the supported TPM device types.
+func hashAlgos() []tpm2.Algorithm {
+	var result []tpm2.Algorithm
+	added := map[tpm2.Algorithm]bool{}
+	for _, tpmDevice := range supportedTPMDevices {
+		for _, hashAlgo := range HashAlgosForTPM(tpmDevice) {
+			if !added[hashAlgo] {
+				added[hashAlgo] = true
+				result = append(result, hashAlgo)
+			}
+		}
+	}
+	return result
+}
+
+// LoadRegisterSets reads status register sets to be passed to CalculateExpectedPCR0s
+// from a JSON file. The file contains an array of register sets, each in
+// the format of registers.Registers (see registers.Registers.MarshalJSON), like:
+//
+//	[[{"id":"ACM_POLICY_STATUS","value":"gYYQAAIAAAA="}], ...]
+func LoadRegisterSets(filePath string) ([]registers.Registers, error) {
+	b, err := os.ReadFile(filePath)
+	if err != nil {
+		return nil, fmt.Errorf("unable to read register sets file '%s': %w", filePath, err)
+	}
+	var result []registers.Registers
+	if err := json.Unmarshal(b, &result); err != nil {
+		return nil, fmt.Errorf("unable to parse register sets file '%s': %w", filePath, err)
+	}
+	return result, nil
+}
+
+// CalculateExpectedPCR0s simulates all known boot flows of the image for each
+// of the given register sets and returns the resulting PCR0 values for each
+// PCR bank supported by the TPM devices.
+//
+// Flows which could not be simulated for the image (or which do not
+// initialize PCR0) are skipped.
+func CalculateExpectedPCR0s(
+	ctx context.Context,
+	biosImg *biosimage.BIOSImage,
+	registerSets []registers.Registers,
+) ([]ExpectedPCR0, error) {
+	return calculateExpectedPCR0s(ctx, biosImg, flows.All(), registerSets)
+}
+
+func calculateExpectedPCR0s(
+	ctx context.Context,
+	biosImg *biosimage.BIOSImage,
+	flows []bootflowtypes.Flow,
+	registerSets []registers.Registers,
+) ([]ExpectedPCR0, error) {
+	if len(registerSets) == 0 {
+		registerSets = []registers.Registers{nil}
+	}
+
+	var result []ExpectedPCR0
+	for _, flow := range flows {
+		for _, regs := range registerSets {
+			if ctx.Err() != nil {
+				return nil, ctx.Err()
+			}
+
+			process := SimulateBootProcess(ctx, biosImg, regs, flow)
+			if err := process.Log.Error(); err != nil {
+				logger.FromCtx(ctx).Debugf("unable to simulate flow %s: %v", flow.Name, err)
+				continue
+			}
+			tpmInstance, err := tpm.GetFrom(process.CurrentState)
+			if err != nil {
+				return nil, fmt.Errorf("unable to access the simulated TPM: %w", err)
+			}
+
+			for _, hashAlgo := range hashAlgos() {
+				pcr0, err := tpmInstance.PCRValues.Get(0, tpm.Algorithm(hashAlgo))
+				if err != nil {
+					// For example, the flow does not initialize the TPM at all.
+					logger.FromCtx(ctx).Debugf("unable to obtain the calculated PCR0 value (flow: %s, algo: %s): %v", flow.Name, hashAlgo, err)
+					continue
+				}
+				result = append(result, ExpectedPCR0{
+					Flow:       flow,
+					TPMDevices: TPMDevicesForHashAlgo(hashAlgo),
+					Registers:  regs,
+					HashAlgo:   hashAlgo,
+					Value:      pcr0,
+				})
+			}
+		}
+	}
+	return result, nil
+}
@@ -0,0 +1,106 @@
+// Copyright 2023 Meta Platforms, Inc. and affiliates.
+//
+// Redistribution and use in source and binary forms, with or without modification, are permitted provided that the following conditions are met:
+//
+// 1. Redistributions of source code must retain the above copyright notice, this list of conditions and the following disclaimer.
+//
+// 2. Redistributions in binary form must reproduce the above copyright notice, this list of conditions and the following disclaimer in the documentation and/or other materials provided with the distribution.
+//
+// 3. Neither the name of the copyright holder nor the names of its contributors may be used to endorse or promote products derived from this software without specific prior written permission.
+//
+// THIS SOFTWARE IS PROVIDED BY THE COPYRIGHT HOLDERS AND CONTRIBUTORS "AS IS" AND ANY EXPRESS OR IMPLIED WARRANTIES, INCLUDING, BUT NOT LIMITED TO, THE IMPLIED WARRANTIES OF MERCHANTABILITY AND FITNESS FOR A PARTICULAR PURPOSE ARE DISCLAIMED. IN NO EVENT SHALL THE COPYRIGHT HOLDER OR CONTRIBUTORS BE LIABLE FOR ANY DIRECT, INDIRECT, INCIDENTAL, SPECIAL, EXEMPLARY, OR CONSEQUENTIAL DAMAGES (INCLUDING, BUT NOT LIMITED TO, PROCUREMENT OF SUBSTITUTE GOODS OR SERVICES; LOSS OF USE, DATA, OR PROFITS; OR BUSINESS INTERRUPTION) HOWEVER CAUSED AND ON ANY THEORY OF LIABILITY, WHETHER IN CONTRACT, STRICT LIABILITY, OR TORT (INCLUDING NEGLIGENCE OR OTHERWISE) ARISING IN ANY WAY OUT OF THE USE OF THIS SOFTWARE, EVEN IF ADVISED OF THE POSSIBILITY OF SUCH DAMAGE.
+package measurements
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/9elements/converged-security-suite/v2/pkg/bootflow/actors"
+	"github.com/9elements/converged-security-suite/v2/pkg/bootflow/actors/intelactors"
+	"github.com/9elements/converged-security-suite/v2/pkg/bootflow/datasources"
+	"github.com/9elements/converged-security-suite/v2/pkg/bootflow/flows"
+	"github.com/9elements/converged-security-suite/v2/pkg/bootflow/steps/commonsteps"
+	"github.com/9elements/converged-security-suite/v2/pkg/bootflow/steps/intelsteps"
+	"github.com/9elements/converged-security-suite/v2/pkg/bootflow/steps/tpmsteps"
+	"github.com/9elements/converged-security-suite/v2/pkg/bootflow/systemartifacts/biosimage"
+	bootflowtypes "github.com/9elements/converged-security-suite/v2/pkg/bootflow/types"
+	"github.com/9elements/converged-security-suite/v2/pkg/registers"
+	"github.com/9elements/converged-security-suite/v2/pkg/tpmdetection"
+	"github.com/9elements/converged-security-suite/v2/pkg/tpmeventlog"
+	"github.com/9elements/converged-security-suite/v2/testdata/firmware"
+	"github.com/google/go-tpm/tpm2"
+	"github.com/stretchr/testify/require"
+)
+
+func TestTPMDevicesForHashAlgo(t *testing.T) {
+	require.Equal(t, []tpmdetection.Type{tpmdetection.TypeTPM12, tpmdetection.TypeTPM20}, TPMDevicesForHashAlgo(tpm2.AlgSHA1))
+	require.Equal(t, []tpmdetection.Type{tpmdetection.TypeTPM20}, TPMDevicesForHashAlgo(tpm2.AlgSHA256))
+	require.Empty(t, TPMDevicesForHashAlgo(tpm2.AlgSHA384))
+}
+
+func TestCalculateExpectedPCR0s(t *testing.T) {
+	testFlow := bootflowtypes.NewFlow("unit-test-flow", bootflowtypes.Steps{
+		commonsteps.SetActor(intelactors.PCH{}),
+		commonsteps.SetActor(intelactors.ACM{}),
+		tpmsteps.InitTPM(3, true),
+		intelsteps.MeasurePCR0DATA{},
+		commonsteps.SetActor(actors.PEI{}),
+		tpmsteps.Measure(0, tpmeventlog.EV_SEPARATOR, datasources.Bytes{0, 0, 0, 0}),
+	})
+	regSets := []registers.Registers{
+		{registers.ParseACMPolicyStatusRegister(0x0000000200108681)},
+		{registers.ParseACMPolicyStatusRegister(0x0000000200108682)},
+	}
+
+	// flows.DXE does not initialize PCR0, so it is expected to be skipped.
+	result, err := calculateExpectedPCR0s(
+		context.Background(),
+		biosimage.New(firmware.FakeIntelFirmware),
+		[]bootflowtypes.Flow{testFlow, flows.DXE},
+		regSets,
+	)
+	require.NoError(t, err)
+	// one value per (register set, PCR bank); no duplicates per TPM device.
+	require.Len(t, result, len(regSets)*2)
+
+	type key struct {
+		regs     int
+		hashAlgo tpm2.Algorithm
+	}
+	seen := map[key]bool{}
+	for idx, expected := range result {
+		require.Equal(t, testFlow.Name, expected.Flow.Name)
+		require.NotEmpty(t, expected.Value)
+		k := key{regs: idx / 2, hashAlgo: expected.HashAlgo}
+		require.False(t, seen[k], "duplicate value for %v", k)
+		seen[k] = true
+
+		switch expected.HashAlgo {
+		case tpm2.AlgSHA1:
+			require.Len(t, expected.Value, 20)
+			require.Equal(t, []tpmdetection.Type{tpmdetection.TypeTPM12, tpmdetection.TypeTPM20}, expected.TPMDevices)
+		case tpm2.AlgSHA256:
+			require.Len(t, expected.Value, 32)
+			require.Equal(t, []tpmdetection.Type{tpmdetection.TypeTPM20}, expected.TPMDevices)
+		default:
+			t.Fatalf("unexpected hash algo: %s", expected.HashAlgo)
+		}
+	}
+}
+
+func TestLoadRegisterSets(t *testing.T) {
+	filePath := filepath.Join(t.TempDir(), "registers.json")
+	require.NoError(t, os.WriteFile(filePath, []byte(`[[{"id":"ACM_POLICY_STATUS","value":"gYYQAAIAAAA="}], []]`), 0o644))
+
+	regSets, err := LoadRegisterSets(filePath)
+	require.NoError(t, err)
+	require.Len(t, regSets, 2)
+	require.Equal(t, registers.Registers{registers.ParseACMPolicyStatusRegister(0x0000000200108681)}, regSets[0])
+	require.Empty(t, regSets[1])
+
+	require.NoError(t, os.WriteFile(filePath, []byte(`{}`), 0o644))
+	_, err = LoadRegisterSets(filePath)
+	require.Error(t, err)
+}
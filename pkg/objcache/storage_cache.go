@@ -16,6 +16,7 @@ import (
 	"time"
 
 	"github.com/dgraph-io/ristretto"
+	"github.com/facebookincubator/go-belt/tool/experimental/metrics"
 
 	"github.com/immune-gmbh/AttestationFailureAnalysisService/pkg/objhash"
 	"github.com/immune-gmbh/AttestationFailureAnalysisService/pkg/storage"
@@ -48,7 +49,12 @@ func New(memoryLimit uint64) (*storageCache, error) {
 }
 
 func (c *storageCache) Get(ctx context.Context, objKey objhash.ObjHash) any {
-	obj, _ := c.cache.Get(string(objKey[:]))
+	obj, found := c.cache.Get(string(objKey[:]))
+	if found {
+		metrics.FromCtx(ctx).Count("objcacheHits").Add(1)
+	} else {
+		metrics.FromCtx(ctx).Count("objcacheMisses").Add(1)
+	}
 	return obj
 }
 
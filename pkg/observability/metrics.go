@@ -12,11 +12,60 @@
 package observability
 
 import (
+	"context"
+	"net/http"
+	"sync"
+	"time"
+
+	"github.com/facebookincubator/go-belt/pkg/field"
 	"github.com/facebookincubator/go-belt/tool/experimental/metrics"
+	"github.com/prometheus/client_golang/prometheus"
+
+	prometheusmetrics "github.com/immune-gmbh/AttestationFailureAnalysisService/pkg/observability/tool/metrics/prometheus"
+)
+
+var (
+	prometheusMetricsOnce     sync.Once
+	prometheusMetricsInstance *prometheusmetrics.Metrics
 )
 
+func getPrometheusMetrics() *prometheusmetrics.Metrics {
+	prometheusMetricsOnce.Do(func() {
+		registry, ok := prometheus.DefaultRegisterer.(*prometheus.Registry)
+		if !ok {
+			registry = prometheus.NewRegistry()
+		}
+		prometheusMetricsInstance = prometheusmetrics.New(registry)
+	})
+	return prometheusMetricsInstance
+}
+
 // NewMetrics returns the default Metrics handler for family of applications
 // based on AttestationFailureAnalysisService.
+//
+// The metrics are collected in the default Prometheus registry,
+// see MetricsHandler.
 func NewMetrics() metrics.Metrics {
-	return metrics.Default()
+	return getPrometheusMetrics()
+}
+
+// MetricsHandler returns the HTTP handler exposing the metrics collected
+// by NewMetrics in Prometheus format (usually served as "/metrics").
+func MetricsHandler() http.Handler {
+	return getPrometheusMetrics().Handler()
+}
+
+// DurationObserver is implemented by Metrics handlers supporting duration histograms.
+type DurationObserver interface {
+	ObserveDuration(key string, fields field.AbstractFields, duration time.Duration)
+}
+
+// ObserveDuration adds the duration to the histogram with key "key" and labels
+// from fields, if the Metrics handler of the context supports histograms.
+func ObserveDuration(ctx context.Context, key string, fields field.AbstractFields, duration time.Duration) {
+	observer, ok := metrics.FromCtx(ctx).(DurationObserver)
+	if !ok {
+		return
+	}
+	observer.ObserveDuration(key, fields, duration)
 }
@@ -0,0 +1,103 @@
+// Copyright 2023 Meta Platforms, Inc. and affiliates.
+//
+// Redistribution and use in source and binary forms, with or without modification, are permitted provided that the following conditions are met:
+//
+// 1. Redistributions of source code must retain the above copyright notice, this list of conditions and the following disclaimer.
+//
+// 2. Redistributions in binary form must reproduce the above copyright notice, this list of conditions and the following disclaimer in the documentation and/or other materials provided with the distribution.
+//
+// 3. Neither the name of the copyright holder nor the names of its contributors may be used to endorse or promote products derived from this software without specific prior written permission.
+//
+// THIS SOFTWARE IS PROVIDED BY THE COPYRIGHT HOLDERS AND CONTRIBUTORS "AS IS" AND ANY EXPRESS OR IMPLIED WARRANTIES, INCLUDING, BUT NOT LIMITED TO, THE IMPLIED WARRANTIES OF MERCHANTABILITY AND FITNESS FOR A PARTICULAR PURPOSE ARE DISCLAIMED. IN NO EVENT SHALL THE COPYRIGHT HOLDER OR CONTRIBUTORS BE LIABLE FOR ANY DIRECT, INDIRECT, INCIDENTAL, SPECIAL, EXEMPLARY, OR CONSEQUENTIAL DAMAGES (INCLUDING, BUT NOT LIMITED TO, PROCUREMENT OF SUBSTITUTE GOODS OR SERVICES; LOSS OF USE, DATA, OR PROFITS; OR BUSINESS INTERRUPTION) HOWEVER CAUSED AND ON ANY THEORY OF LIABILITY, WHETHER IN CONTRACT, STRICT LIABILITY, OR TORT (INCLUDING NEGLIGENCE OR OTHERWISE) ARISING IN ANY WAY OUT OF THE USE OF THIS SOFTWARE, EVEN IF ADVISED OF THE POSSIBILITY OF SUCH DAMAGE.
+package prometheus
+
+import (
+	"github.com/facebookincubator/go-belt/pkg/field"
+	"github.com/facebookincubator/go-belt/tool/experimental/metrics"
+	"github.com/prometheus/client_golang/prometheus"
+	dto "github.com/prometheus/client_model/go"
+)
+
+type count struct {
+	metrics *Metrics
+	key     string
+	counter prometheus.Counter
+}
+
+var _ metrics.Count = (*count)(nil)
+
+// Add implements metrics.Count.
+func (c *count) Add(v uint64) metrics.Count {
+	c.counter.Add(float64(v))
+	return c
+}
+
+// Value implements metrics.Count.
+func (c *count) Value() any {
+	var m dto.Metric
+	if err := c.counter.Write(&m); err != nil {
+		return uint64(0)
+	}
+	return uint64(m.GetCounter().GetValue())
+}
+
+// WithResetFields implements metrics.Count.
+func (c *count) WithResetFields(fields field.AbstractFields) metrics.Count {
+	return c.metrics.CountFields(c.key, fields)
+}
+
+type gauge struct {
+	metrics *Metrics
+	key     string
+	gauge   prometheus.Gauge
+}
+
+var _ metrics.Gauge = (*gauge)(nil)
+
+// Add implements metrics.Gauge.
+func (g *gauge) Add(v float64) metrics.Gauge {
+	g.gauge.Add(v)
+	return g
+}
+
+// Value implements metrics.Gauge.
+func (g *gauge) Value() any {
+	var m dto.Metric
+	if err := g.gauge.Write(&m); err != nil {
+		return float64(0)
+	}
+	return m.GetGauge().GetValue()
+}
+
+// WithResetFields implements metrics.Gauge.
+func (g *gauge) WithResetFields(fields field.AbstractFields) metrics.Gauge {
+	return g.metrics.GaugeFields(g.key, fields)
+}
+
+type intGauge struct {
+	metrics *Metrics
+	key     string
+	gauge   prometheus.Gauge
+}
+
+var _ metrics.IntGauge = (*intGauge)(nil)
+
+// Add implements metrics.IntGauge.
+func (g *intGauge) Add(v int64) metrics.IntGauge {
+	g.gauge.Add(float64(v))
+	return g
+}
+
+// Value implements metrics.IntGauge.
+func (g *intGauge) Value() any {
+	var m dto.Metric
+	if err := g.gauge.Write(&m); err != nil {
+		return int64(0)
+	}
+	return int64(m.GetGauge().GetValue())
+}
+
+// WithResetFields implements metrics.IntGauge.
+func (g *intGauge) WithResetFields(fields field.AbstractFields) metrics.IntGauge {
+	return g.metrics.IntGaugeFields(g.key, fields)
+}
@@ -0,0 +1,175 @@
+// Copyright 2023 Meta Platforms, Inc. and affiliates.
+//
+// Redistribution and use in source and binary forms, with or without modification, are permitted provided that the following conditions are met:
+//
+// 1. Redistributions of source code must retain the above copyright notice, this list of conditions and the following disclaimer.
+//
+// 2. Redistributions in binary form must reproduce the above copyright notice, this list of conditions and the following disclaimer in the documentation and/or other materials provided with the distribution.
+//
+// 3. Neither the name of the copyright holder nor the names of its contributors may be used to endorse or promote products derived from this software without specific prior written permission.
+//
+// THIS SOFTWARE IS PROVIDED BY THE COPYRIGHT HOLDERS AND CONTRIBUTORS "AS IS" AND ANY EXPRESS OR IMPLIED WARRANTIES, INCLUDING, BUT NOT LIMITED TO, THE IMPLIED WARRANTIES OF MERCHANTABILITY AND FITNESS FOR A PARTICULAR PURPOSE ARE DISCLAIMED. IN NO EVENT SHALL THE COPYRIGHT HOLDER OR CONTRIBUTORS BE LIABLE FOR ANY DIRECT, INDIRECT, INCIDENTAL, SPECIAL, EXEMPLARY, OR CONSEQUENTIAL DAMAGES (INCLUDING, BUT NOT LIMITED TO, PROCUREMENT OF SUBSTITUTE GOODS OR SERVICES; LOSS OF USE, DATA, OR PROFITS; OR BUSINESS INTERRUPTION) HOWEVER CAUSED AND ON ANY THEORY OF LIABILITY, WHETHER IN CONTRACT, STRICT LIABILITY, OR TORT (INCLUDING NEGLIGENCE OR OTHERWISE) ARISING IN ANY WAY OUT OF THE USE OF THIS SOFTWARE, EVEN IF ADVISED OF THE POSSIBILITY OF SUCH DAMAGE.
+// Package prometheus provides an implementation of metrics.Metrics on top of Prometheus,
+// which is suitable for long-running services.
+package prometheus
+
+import (
+	"fmt"
+	"net/http"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/facebookincubator/go-belt"
+	"github.com/facebookincubator/go-belt/pkg/field"
+	"github.com/facebookincubator/go-belt/tool/experimental/metrics"
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promhttp"
+)
+
+// DefaultDurationBuckets are the buckets (in seconds) of the duration histograms.
+var DefaultDurationBuckets = prometheus.ExponentialBuckets(0.001, 2, 20)
+
+// Metrics is an implementation of metrics.Metrics on top of Prometheus,
+// which also supports duration histograms (see ObserveDuration).
+//
+// Only the fields explicitly passed to CountFields, GaugeFields and so on
+// are used as labels; the context fields are ignored, because they contain
+// high-cardinality values (like jobID or assetID), which would make
+// the amount of time series unbounded.
+//
+// A metric should always be used with the same set of field keys. If it is
+// used with a different set, then the values are still accounted, but
+// not exported.
+type Metrics struct {
+	registry *prometheus.Registry
+
+	locker sync.Mutex
+	vecs   map[string]any
+}
+
+var _ metrics.Metrics = (*Metrics)(nil)
+
+// New returns a new instance of Metrics, registering the metrics in the registry.
+func New(registry *prometheus.Registry) *Metrics {
+	return &Metrics{
+		registry: registry,
+		vecs:     map[string]any{},
+	}
+}
+
+func labelsOf(fields field.AbstractFields) (prometheus.Labels, []string) {
+	labels := prometheus.Labels{}
+	if fields != nil {
+		fields.ForEachField(func(f *field.Field) bool {
+			labels[f.Key] = fmt.Sprint(f.Value)
+			return true
+		})
+	}
+	labelNames := make([]string, 0, len(labels))
+	for name := range labels {
+		labelNames = append(labelNames, name)
+	}
+	sort.Strings(labelNames)
+	return labels, labelNames
+}
+
+// getOrCreateVec returns the metric vector of the kind, key and label names.
+// newVec is used to create the vector if it does not exist yet.
+func getOrCreateVec[T prometheus.Collector](
+	m *Metrics,
+	kind string,
+	key string,
+	labelNames []string,
+	newVec func() T,
+) T {
+	vecKey := kind + ":" + key + "{" + strings.Join(labelNames, ",") + "}"
+
+	m.locker.Lock()
+	defer m.locker.Unlock()
+	if vec, ok := m.vecs[vecKey]; ok {
+		return vec.(T)
+	}
+
+	vec := newVec()
+	// the error means the key is already used by a metric of a different kind
+	// or with a different set of labels; then the vector is just not exported
+	_ = m.registry.Register(vec)
+	m.vecs[vecKey] = vec
+	return vec
+}
+
+// Count implements metrics.Metrics.
+func (m *Metrics) Count(key string) metrics.Count {
+	return m.CountFields(key, nil)
+}
+
+// CountFields implements metrics.Metrics.
+func (m *Metrics) CountFields(key string, fields field.AbstractFields) metrics.Count {
+	labels, labelNames := labelsOf(fields)
+	vec := getOrCreateVec(m, "count", key, labelNames, func() *prometheus.CounterVec {
+		return prometheus.NewCounterVec(prometheus.CounterOpts{Name: key, Help: key}, labelNames)
+	})
+	return &count{metrics: m, key: key, counter: vec.With(labels)}
+}
+
+// Gauge implements metrics.Metrics.
+func (m *Metrics) Gauge(key string) metrics.Gauge {
+	return m.GaugeFields(key, nil)
+}
+
+// GaugeFields implements metrics.Metrics.
+func (m *Metrics) GaugeFields(key string, fields field.AbstractFields) metrics.Gauge {
+	labels, labelNames := labelsOf(fields)
+	vec := getOrCreateVec(m, "gauge", key, labelNames, func() *prometheus.GaugeVec {
+		return prometheus.NewGaugeVec(prometheus.GaugeOpts{Name: key, Help: key}, labelNames)
+	})
+	return &gauge{metrics: m, key: key, gauge: vec.With(labels)}
+}
+
+// IntGauge implements metrics.Metrics.
+func (m *Metrics) IntGauge(key string) metrics.IntGauge {
+	return m.IntGaugeFields(key, nil)
+}
+
+// IntGaugeFields implements metrics.Metrics.
+func (m *Metrics) IntGaugeFields(key string, fields field.AbstractFields) metrics.IntGauge {
+	labels, labelNames := labelsOf(fields)
+	vec := getOrCreateVec(m, "gauge", key, labelNames, func() *prometheus.GaugeVec {
+		return prometheus.NewGaugeVec(prometheus.GaugeOpts{Name: key, Help: key}, labelNames)
+	})
+	return &intGauge{metrics: m, key: key, gauge: vec.With(labels)}
+}
+
+// ObserveDuration adds the duration (in seconds) to the histogram with key "key"
+// and labels from fields.
+func (m *Metrics) ObserveDuration(key string, fields field.AbstractFields, duration time.Duration) {
+	labels, labelNames := labelsOf(fields)
+	vec := getOrCreateVec(m, "histogram", key, labelNames, func() *prometheus.HistogramVec {
+		return prometheus.NewHistogramVec(prometheus.HistogramOpts{
+			Name:    key,
+			Help:    key + " (seconds)",
+			Buckets: DefaultDurationBuckets,
+		}, labelNames)
+	})
+	vec.With(labels).Observe(duration.Seconds())
+}
+
+// Handler returns the HTTP handler exposing the metrics in Prometheus format.
+func (m *Metrics) Handler() http.Handler {
+	return promhttp.HandlerFor(m.registry, promhttp.HandlerOpts{})
+}
+
+// WithContextFields implements metrics.Metrics (or more specifically belt.Tool).
+func (m *Metrics) WithContextFields(allFields *field.FieldsChain, newFieldsCount int) belt.Tool {
+	return m
+}
+
+// WithTraceIDs implements metrics.Metrics (or more specifically belt.Tool).
+func (m *Metrics) WithTraceIDs(traceIDs belt.TraceIDs, newTraceIDsCount int) belt.Tool {
+	return m
+}
+
+// Flush implements metrics.Metrics (or more specifically belt.Tool).
+func (*Metrics) Flush() {}
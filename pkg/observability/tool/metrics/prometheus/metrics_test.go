@@ -0,0 +1,46 @@
+// Copyright 2023 Meta Platforms, Inc. and affiliates.
+//
+// Redistribution and use in source and binary forms, with or without modification, are permitted provided that the following conditions are met:
+//
+// 1. Redistributions of source code must retain the above copyright notice, this list of conditions and the following disclaimer.
+//
+// 2. Redistributions in binary form must reproduce the above copyright notice, this list of conditions and the following disclaimer in the documentation and/or other materials provided with the distribution.
+//
+// 3. Neither the name of the copyright holder nor the names of its contributors may be used to endorse or promote products derived from this software without specific prior written permission.
+//
+// THIS SOFTWARE IS PROVIDED BY THE COPYRIGHT HOLDERS AND CONTRIBUTORS "AS IS" AND ANY EXPRESS OR IMPLIED WARRANTIES, INCLUDING, BUT NOT LIMITED TO, THE IMPLIED WARRANTIES OF MERCHANTABILITY AND FITNESS FOR A PARTICULAR PURPOSE ARE DISCLAIMED. IN NO EVENT SHALL THE COPYRIGHT HOLDER OR CONTRIBUTORS BE LIABLE FOR ANY DIRECT, INDIRECT, INCIDENTAL, SPECIAL, EXEMPLARY, OR CONSEQUENTIAL DAMAGES (INCLUDING, BUT NOT LIMITED TO, PROCUREMENT OF SUBSTITUTE GOODS OR SERVICES; LOSS OF USE, DATA, OR PROFITS; OR BUSINESS INTERRUPTION) HOWEVER CAUSED AND ON ANY THEORY OF LIABILITY, WHETHER IN CONTRACT, STRICT LIABILITY, OR TORT (INCLUDING NEGLIGENCE OR OTHERWISE) ARISING IN ANY WAY OUT OF THE USE OF THIS SOFTWARE, EVEN IF ADVISED OF THE POSSIBILITY OF SUCH DAMAGE.
+package prometheus
+
+import (
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/facebookincubator/go-belt/pkg/field"
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/stretchr/testify/require"
+)
+
+func TestMetrics(t *testing.T) {
+	m := New(prometheus.NewRegistry())
+
+	// context fields should not become labels
+	withFields := m.WithContextFields(field.NewChainFromOne("jobID", "123"), 1).(*Metrics)
+	withFields.CountFields("analyzerOutcomes", field.Fields{{Key: "analyzerID", Value: "DiffMeasuredBoot"}}).Add(2)
+	withFields.ObserveDuration("analyzerDurationSeconds", field.Fields{{Key: "analyzerID", Value: "DiffMeasuredBoot"}}, 3*time.Second)
+
+	// a different set of labels is accounted, but not exported
+	c := m.Count("analyzerOutcomes").Add(1)
+	require.Equal(t, uint64(1), c.Value())
+	m.ObserveDuration("analyzerDurationSeconds", nil, time.Second)
+
+	recorder := httptest.NewRecorder()
+	m.Handler().ServeHTTP(recorder, httptest.NewRequest("GET", "/metrics", nil))
+	body := recorder.Body.String()
+
+	require.Contains(t, body, `analyzerOutcomes{analyzerID="DiffMeasuredBoot"} 2`)
+	require.NotContains(t, body, "analyzerOutcomes 1")
+	require.Contains(t, body, `analyzerDurationSeconds_count{analyzerID="DiffMeasuredBoot"} 1`)
+	require.Contains(t, body, `analyzerDurationSeconds_sum{analyzerID="DiffMeasuredBoot"} 3`)
+	require.NotContains(t, body, "jobID")
+}
@@ -0,0 +1,167 @@
+// Copyright 2023 Meta Platforms, Inc. and affiliates.
+//
+// Redistribution and use in source and binary forms, with or without modification, are permitted provided that the following conditions are met:
+//
+// 1. Redistributions of source code must retain the above copyright notice, this list of conditions and the following disclaimer.
+//
+// 2. Redistributions in binary form must reproduce the above copyright notice, this list of conditions and the following disclaimer in the documentation and/or other materials provided with the distribution.
+//
+// 3. Neither the name of the copyright holder nor the names of its contributors may be used to endorse or promote products derived from this software without specific prior written permission.
+//
+// THIS SOFTWARE IS PROVIDED BY THE COPYRIGHT HOLDERS AND CONTRIBUTORS "AS IS" AND ANY EXPRESS OR IMPLIED WARRANTIES, INCLUDING, BUT NOT LIMITED TO, THE IMPLIED WARRANTIES OF MERCHANTABILITY AND FITNESS FOR A PARTICULAR PURPOSE ARE DISCLAIMED. IN NO EVENT SHALL THE COPYRIGHT HOLDER OR CONTRIBUTORS BE LIABLE FOR ANY DIRECT, INDIRECT, INCIDENTAL, SPECIAL, EXEMPLARY, OR CONSEQUENTIAL DAMAGES (INCLUDING, BUT NOT LIMITED TO, PROCUREMENT OF SUBSTITUTE GOODS OR SERVICES; LOSS OF USE, DATA, OR PROFITS; OR BUSINESS INTERRUPTION) HOWEVER CAUSED AND ON ANY THEORY OF LIABILITY, WHETHER IN CONTRACT, STRICT LIABILITY, OR TORT (INCLUDING NEGLIGENCE OR OTHERWISE) ARISING IN ANY WAY OUT OF THE USE OF THIS SOFTWARE, EVEN IF ADVISED OF THE POSSIBILITY OF SUCH DAMAGE.
+package otel
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"github.com/facebookincubator/go-belt"
+	"github.com/facebookincubator/go-belt/pkg/field"
+	"github.com/facebookincubator/go-belt/tool/experimental/tracer"
+	"go.opentelemetry.io/otel/trace"
+)
+
+// SpanImpl is the implementation of tracer.Span on top of trace.Span of OpenTelemetry.
+type SpanImpl struct {
+	sendOnce sync.Once
+	parent   tracer.Span
+	name     string
+	startTS  time.Time
+	tracer   *TracerImpl
+	otelSpan trace.Span
+	fields   *field.FieldsChain
+}
+
+var _ tracer.Span = (*SpanImpl)(nil)
+
+func (t *TracerImpl) newSpan(
+	ctx context.Context,
+	name string,
+	parent tracer.Span,
+	newRoot bool,
+	options ...tracer.SpanOption,
+) (tracer.Span, context.Context) {
+	if tracer.IsNoopSpan(parent) {
+		// there is no span in the context, so this is a root span
+		parent = nil
+	}
+
+	span := &SpanImpl{
+		parent:  parent,
+		tracer:  t,
+		fields:  t.ContextFields,
+		name:    name,
+		startTS: time.Now(),
+	}
+
+	if !t.PreHooks.ProcessSpan(span) {
+		return tracer.NewNoopSpan(name, parent, time.Now()), ctx
+	}
+
+	otelOptions := []trace.SpanStartOption{trace.WithAttributes(t.attributes()...)}
+	if newRoot {
+		// the context may contain a span of the caller, ignoring it
+		otelOptions = append(otelOptions, trace.WithNewRoot())
+	}
+	for _, opt := range options {
+		switch opt := opt.(type) {
+		case tracer.SpanOptionAddFields:
+			field.Fields(opt).ForEachField(func(f *field.Field) bool {
+				otelOptions = append(otelOptions, trace.WithAttributes(fieldToAttribute(f.Key, f.Value)))
+				return true
+			})
+		case tracer.SpanOptionRole:
+			switch opt {
+			case tracer.RoleClient:
+				otelOptions = append(otelOptions, trace.WithSpanKind(trace.SpanKindClient))
+			case tracer.RoleServer:
+				otelOptions = append(otelOptions, trace.WithSpanKind(trace.SpanKindServer))
+			}
+		case tracer.SpanOptionStart:
+			span.startTS = time.Time(opt)
+			otelOptions = append(otelOptions, trace.WithTimestamp(span.startTS))
+		}
+	}
+
+	ctx, span.otelSpan = t.OTelTracer.Start(ctx, name, otelOptions...)
+	return span, ctx
+}
+
+// ID implements tracer.Span.
+func (span *SpanImpl) ID() any {
+	return span.otelSpan.SpanContext().SpanID()
+}
+
+// Name implements tracer.Span.
+func (span *SpanImpl) Name() string {
+	return span.name
+}
+
+// TraceIDs implements tracer.Span.
+func (span *SpanImpl) TraceIDs() belt.TraceIDs {
+	return span.tracer.TraceIDs
+}
+
+// StartTS implements tracer.Span.
+func (span *SpanImpl) StartTS() time.Time {
+	return span.startTS
+}
+
+// Fields implements tracer.Span.
+func (span *SpanImpl) Fields() field.AbstractFields {
+	return span.fields
+}
+
+// Parent implements tracer.Span.
+func (span *SpanImpl) Parent() tracer.Span {
+	return span.parent
+}
+
+// SetName implements tracer.Span.
+func (span *SpanImpl) SetName(name string) {
+	span.name = name
+	span.otelSpan.SetName(name)
+}
+
+// Annotate implements tracer.Span.
+func (span *SpanImpl) Annotate(ts time.Time, event string) {
+	span.otelSpan.AddEvent(event, trace.WithTimestamp(ts))
+}
+
+// SetField implements tracer.Span.
+func (span *SpanImpl) SetField(k field.Key, v field.Value) {
+	span.fields = span.fields.WithField(k, v)
+	span.otelSpan.SetAttributes(fieldToAttribute(k, v))
+}
+
+// SetFields implements tracer.Span.
+func (span *SpanImpl) SetFields(fields field.AbstractFields) {
+	fields.ForEachField(func(f *field.Field) bool {
+		span.SetField(f.Key, f.Value)
+		return true
+	})
+}
+
+// Finish implements tracer.Span.
+func (span *SpanImpl) Finish() {
+	span.sendOnce.Do(func() {
+		if !span.tracer.Hooks.ProcessSpan(span) {
+			return
+		}
+		span.otelSpan.End()
+	})
+}
+
+// FinishWithDuration implements tracer.Span.
+func (span *SpanImpl) FinishWithDuration(duration time.Duration) {
+	span.sendOnce.Do(func() {
+		if !span.tracer.Hooks.ProcessSpan(span) {
+			return
+		}
+		span.otelSpan.End(trace.WithTimestamp(span.startTS.Add(duration)))
+	})
+}
+
+// Flush implements tracer.Span.
+func (*SpanImpl) Flush() {}
@@ -0,0 +1,172 @@
+// Copyright 2023 Meta Platforms, Inc. and affiliates.
+//
+// Redistribution and use in source and binary forms, with or without modification, are permitted provided that the following conditions are met:
+//
+// 1. Redistributions of source code must retain the above copyright notice, this list of conditions and the following disclaimer.
+//
+// 2. Redistributions in binary form must reproduce the above copyright notice, this list of conditions and the following disclaimer in the documentation and/or other materials provided with the distribution.
+//
+// 3. Neither the name of the copyright holder nor the names of its contributors may be used to endorse or promote products derived from this software without specific prior written permission.
+//
+// THIS SOFTWARE IS PROVIDED BY THE COPYRIGHT HOLDERS AND CONTRIBUTORS "AS IS" AND ANY EXPRESS OR IMPLIED WARRANTIES, INCLUDING, BUT NOT LIMITED TO, THE IMPLIED WARRANTIES OF MERCHANTABILITY AND FITNESS FOR A PARTICULAR PURPOSE ARE DISCLAIMED. IN NO EVENT SHALL THE COPYRIGHT HOLDER OR CONTRIBUTORS BE LIABLE FOR ANY DIRECT, INDIRECT, INCIDENTAL, SPECIAL, EXEMPLARY, OR CONSEQUENTIAL DAMAGES (INCLUDING, BUT NOT LIMITED TO, PROCUREMENT OF SUBSTITUTE GOODS OR SERVICES; LOSS OF USE, DATA, OR PROFITS; OR BUSINESS INTERRUPTION) HOWEVER CAUSED AND ON ANY THEORY OF LIABILITY, WHETHER IN CONTRACT, STRICT LIABILITY, OR TORT (INCLUDING NEGLIGENCE OR OTHERWISE) ARISING IN ANY WAY OUT OF THE USE OF THIS SOFTWARE, EVEN IF ADVISED OF THE POSSIBILITY OF SUCH DAMAGE.
+// Package otel provides an implementation of tracer.Tracer on top of OpenTelemetry.
+package otel
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/facebookincubator/go-belt"
+	"github.com/facebookincubator/go-belt/pkg/field"
+	"github.com/facebookincubator/go-belt/tool/experimental/tracer"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/trace"
+)
+
+// AttributeTraceIDs is the span attribute used to store belt.TraceIDs.
+const AttributeTraceIDs = "trace_ids"
+
+// TracerImpl is the implementation of tracer.Tracer on top of trace.Tracer of OpenTelemetry.
+//
+// Context fields and trace IDs are exported as span attributes.
+type TracerImpl struct {
+	OTelTracer    trace.Tracer
+	ContextFields *field.FieldsChain
+	TraceIDs      belt.TraceIDs
+	Hooks         tracer.Hooks
+	PreHooks      tracer.Hooks
+}
+
+var _ tracer.Tracer = (*TracerImpl)(nil)
+
+// New returns a new instance of TracerImpl.
+func New(otelTracer trace.Tracer) *TracerImpl {
+	return &TracerImpl{
+		OTelTracer: otelTracer,
+	}
+}
+
+func (t TracerImpl) clone() *TracerImpl {
+	return &t
+}
+
+// WithContextFields implements tracer.Tracer.
+func (t *TracerImpl) WithContextFields(allFields *field.FieldsChain, newFieldsCount int) belt.Tool {
+	c := t.clone()
+	c.ContextFields = allFields
+	return c
+}
+
+// WithTraceIDs implements tracer.Tracer.
+func (t *TracerImpl) WithTraceIDs(traceIDs belt.TraceIDs, newTraceIDsCount int) belt.Tool {
+	c := t.clone()
+	c.TraceIDs = traceIDs
+	return c
+}
+
+// Start implements tracer.Tracer.
+func (t *TracerImpl) Start(name string, parent tracer.Span, options ...tracer.SpanOption) tracer.Span {
+	span, _ := t.newSpan(contextWithParent(context.Background(), parent), name, parent, false, options...)
+	return span
+}
+
+// StartWithCtx implements tracer.Tracer.
+func (t *TracerImpl) StartWithCtx(ctx context.Context, name string, options ...tracer.SpanOption) (tracer.Span, context.Context) {
+	span, ctx := t.newSpan(ctx, name, nil, true, options...)
+	return span, tracer.CtxWithSpan(ctx, span)
+}
+
+// StartChildWithCtx implements tracer.Tracer.
+func (t *TracerImpl) StartChildWithCtx(ctx context.Context, name string, options ...tracer.SpanOption) (tracer.Span, context.Context) {
+	parent := tracer.SpanFromCtx(ctx)
+	span, ctx := t.newSpan(contextWithParent(ctx, parent), name, parent, false, options...)
+	return span, tracer.CtxWithSpan(ctx, span)
+}
+
+// StartWithBelt implements tracer.Tracer.
+func (t *TracerImpl) StartWithBelt(belt *belt.Belt, name string, options ...tracer.SpanOption) (tracer.Span, *belt.Belt) {
+	span, _ := t.newSpan(context.Background(), name, nil, true, options...)
+	return span, tracer.BeltWithSpan(belt, span)
+}
+
+// StartChildWithBelt implements tracer.Tracer.
+func (t *TracerImpl) StartChildWithBelt(belt *belt.Belt, name string, options ...tracer.SpanOption) (tracer.Span, *belt.Belt) {
+	parent := tracer.SpanFromBelt(belt)
+	span, _ := t.newSpan(contextWithParent(context.Background(), parent), name, parent, false, options...)
+	return span, tracer.BeltWithSpan(belt, span)
+}
+
+// WithPreHooks implements tracer.Tracer.
+func (t *TracerImpl) WithPreHooks(hooks ...tracer.Hook) tracer.Tracer {
+	c := t.clone()
+	if hooks == nil {
+		c.PreHooks = nil
+	} else {
+		c.PreHooks = tracer.Hooks{c.PreHooks, tracer.Hooks(hooks)}
+	}
+	return c
+}
+
+// WithHooks implements tracer.Tracer.
+func (t *TracerImpl) WithHooks(hooks ...tracer.Hook) tracer.Tracer {
+	c := t.clone()
+	if hooks == nil {
+		c.Hooks = nil
+	} else {
+		c.Hooks = tracer.Hooks{c.Hooks, tracer.Hooks(hooks)}
+	}
+	return c
+}
+
+// Flush implements tracer.Tracer.
+//
+// The spans are exported by the span processor of the trace.TracerProvider,
+// so flushing is the responsibility of the provider.
+func (*TracerImpl) Flush() {}
+
+func (t *TracerImpl) attributes() []attribute.KeyValue {
+	attrs := make([]attribute.KeyValue, 0, t.ContextFields.Len()+1)
+	t.ContextFields.ForEachField(func(f *field.Field) bool {
+		attrs = append(attrs, fieldToAttribute(f.Key, f.Value))
+		return true
+	})
+	if len(t.TraceIDs) > 0 {
+		strs := make([]string, 0, len(t.TraceIDs))
+		for _, traceID := range t.TraceIDs {
+			strs = append(strs, string(traceID))
+		}
+		attrs = append(attrs, attribute.String(AttributeTraceIDs, strings.Join(strs, ",")))
+	}
+	return attrs
+}
+
+// contextWithParent returns a context with the OpenTelemetry span of parent,
+// so that the new span becomes its child.
+func contextWithParent(ctx context.Context, parent tracer.Span) context.Context {
+	if ctx == nil {
+		ctx = context.Background()
+	}
+	if parent, ok := parent.(*SpanImpl); ok {
+		return trace.ContextWithSpan(ctx, parent.otelSpan)
+	}
+	return ctx
+}
+
+func fieldToAttribute(key field.Key, value field.Value) attribute.KeyValue {
+	switch value := value.(type) {
+	case string:
+		return attribute.String(key, value)
+	case bool:
+		return attribute.Bool(key, value)
+	case int:
+		return attribute.Int(key, value)
+	case int64:
+		return attribute.Int64(key, value)
+	case float64:
+		return attribute.Float64(key, value)
+	case fmt.Stringer:
+		return attribute.String(key, value.String())
+	}
+	return attribute.String(key, fmt.Sprint(value))
+}
@@ -0,0 +1,52 @@
+// Copyright 2023 Meta Platforms, Inc. and affiliates.
+//
+// Redistribution and use in source and binary forms, with or without modification, are permitted provided that the following conditions are met:
+//
+// 1. Redistributions of source code must retain the above copyright notice, this list of conditions and the following disclaimer.
+//
+// 2. Redistributions in binary form must reproduce the above copyright notice, this list of conditions and the following disclaimer in the documentation and/or other materials provided with the distribution.
+//
+// 3. Neither the name of the copyright holder nor the names of its contributors may be used to endorse or promote products derived from this software without specific prior written permission.
+//
+// THIS SOFTWARE IS PROVIDED BY THE COPYRIGHT HOLDERS AND CONTRIBUTORS "AS IS" AND ANY EXPRESS OR IMPLIED WARRANTIES, INCLUDING, BUT NOT LIMITED TO, THE IMPLIED WARRANTIES OF MERCHANTABILITY AND FITNESS FOR A PARTICULAR PURPOSE ARE DISCLAIMED. IN NO EVENT SHALL THE COPYRIGHT HOLDER OR CONTRIBUTORS BE LIABLE FOR ANY DIRECT, INDIRECT, INCIDENTAL, SPECIAL, EXEMPLARY, OR CONSEQUENTIAL DAMAGES (INCLUDING, BUT NOT LIMITED TO, PROCUREMENT OF SUBSTITUTE GOODS OR SERVICES; LOSS OF USE, DATA, OR PROFITS; OR BUSINESS INTERRUPTION) HOWEVER CAUSED AND ON ANY THEORY OF LIABILITY, WHETHER IN CONTRACT, STRICT LIABILITY, OR TORT (INCLUDING NEGLIGENCE OR OTHERWISE) ARISING IN ANY WAY OUT OF THE USE OF THIS SOFTWARE, EVEN IF ADVISED OF THE POSSIBILITY OF SUCH DAMAGE.
+package otel
+
+import (
+	"context"
+	"testing"
+
+	"github.com/facebookincubator/go-belt/beltctx"
+	"github.com/facebookincubator/go-belt/tool/experimental/tracer"
+	"github.com/stretchr/testify/require"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	"go.opentelemetry.io/otel/sdk/trace/tracetest"
+)
+
+func TestTracer(t *testing.T) {
+	recorder := tracetest.NewSpanRecorder()
+	provider := sdktrace.NewTracerProvider(sdktrace.WithSpanProcessor(recorder))
+
+	ctx := tracer.CtxWithTracer(context.Background(), New(provider.Tracer("test")))
+	ctx = beltctx.WithField(ctx, "jobID", "123")
+
+	rootSpan, ctx := tracer.StartChildSpanFromCtx(ctx, "root")
+	childSpan, _ := tracer.StartChildSpanFromCtx(ctx, "child")
+	childSpan.Finish()
+	rootSpan.Finish()
+
+	spans := recorder.Ended()
+	require.Len(t, spans, 2)
+	child, root := spans[0], spans[1]
+	require.Equal(t, "child", child.Name())
+	require.Equal(t, "root", root.Name())
+	require.Equal(t, root.SpanContext().TraceID(), child.SpanContext().TraceID())
+	require.Equal(t, root.SpanContext().SpanID(), child.Parent().SpanID())
+
+	var jobID string
+	for _, attr := range child.Attributes() {
+		if attr.Key == "jobID" {
+			jobID = attr.Value.AsString()
+		}
+	}
+	require.Equal(t, "123", jobID)
+}
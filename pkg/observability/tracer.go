@@ -13,12 +13,73 @@ package observability
 
 import (
 	"context"
+	"fmt"
+	"net/url"
 
 	"github.com/facebookincubator/go-belt/tool/experimental/tracer"
+	"go.opentelemetry.io/otel/exporters/otlp/otlptrace/otlptracehttp"
+	"go.opentelemetry.io/otel/sdk/resource"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	semconv "go.opentelemetry.io/otel/semconv/v1.24.0"
+	"go.opentelemetry.io/otel/trace"
+
+	oteltracer "github.com/immune-gmbh/AttestationFailureAnalysisService/pkg/observability/tool/tracer/otel"
 )
 
+// instrumentationName is the name of the OpenTelemetry tracer.
+const instrumentationName = "github.com/immune-gmbh/AttestationFailureAnalysisService"
+
+// TracerProvider is the OpenTelemetry provider of tracers used by NewTracer.
+//
+// If it is nil, then the default (no-op) Tracer is used. It is supposed to be
+// set (for example to the result of NewOTLPTracerProvider) before WithBelt is called.
+var TracerProvider trace.TracerProvider
+
 // NewTracer returns the default Tracer handler for family of applications
 // based on AttestationFailureAnalysisService.
 func NewTracer(ctx context.Context) tracer.Tracer {
-	return tracer.Default()
+	if TracerProvider == nil {
+		return tracer.Default()
+	}
+	return oteltracer.New(TracerProvider.Tracer(instrumentationName))
+}
+
+// NewOTLPTracerProvider returns an OpenTelemetry provider of tracers, which
+// exports spans to the collector (for example, "http://otel-collector:4318")
+// using OTLP over HTTP. If the URL has no path, then the default one
+// ("/v1/traces") is used.
+//
+// The provider should be shut down before the application exits to
+// export the remaining spans.
+func NewOTLPTracerProvider(
+	ctx context.Context,
+	collectorURL string,
+	serviceName string,
+) (*sdktrace.TracerProvider, error) {
+	u, err := url.Parse(collectorURL)
+	if err != nil {
+		return nil, fmt.Errorf("unable to parse the collector URL '%s': %w", collectorURL, err)
+	}
+	if u.Host == "" {
+		return nil, fmt.Errorf("the collector URL '%s' has no host", collectorURL)
+	}
+	options := []otlptracehttp.Option{otlptracehttp.WithEndpointURL(collectorURL)}
+	if u.Path == "" || u.Path == "/" {
+		options = append(options, otlptracehttp.WithURLPath("/v1/traces"))
+	}
+	exporter, err := otlptracehttp.New(ctx, options...)
+	if err != nil {
+		return nil, fmt.Errorf("unable to initialize the OTLP exporter to '%s': %w", collectorURL, err)
+	}
+	res, err := resource.Merge(
+		resource.Default(),
+		resource.NewWithAttributes(semconv.SchemaURL, semconv.ServiceName(serviceName)),
+	)
+	if err != nil {
+		return nil, fmt.Errorf("unable to initialize the OpenTelemetry resource: %w", err)
+	}
+	return sdktrace.NewTracerProvider(
+		sdktrace.WithBatcher(exporter),
+		sdktrace.WithResource(res),
+	), nil
 }
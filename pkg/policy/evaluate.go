@@ -0,0 +1,220 @@
+// Copyright 2023 Meta Platforms, Inc. and affiliates.
+//
+// Redistribution and use in source and binary forms, with or without modification, are permitted provided that the following conditions are met:
+//
+// 1. Redistributions of source code must retain the above copyright notice, this list of conditions and the following disclaimer.
+//
+// 2. Redistributions in binary form must reproduce the above copyright notice, this list of conditions and the following disclaimer in the documentation and/or other materials provided with the distribution.
+//
+// 3. Neither the name of the copyright holder nor the names of its contributors may be used to endorse or promote products derived from this software without specific prior written permission.
+//
+// THIS SOFTWARE IS PROVIDED BY THE COPYRIGHT HOLDERS AND CONTRIBUTORS "AS IS" AND ANY EXPRESS OR IMPLIED WARRANTIES, INCLUDING, BUT NOT LIMITED TO, THE IMPLIED WARRANTIES OF MERCHANTABILITY AND FITNESS FOR A PARTICULAR PURPOSE ARE DISCLAIMED. IN NO EVENT SHALL THE COPYRIGHT HOLDER OR CONTRIBUTORS BE LIABLE FOR ANY DIRECT, INDIRECT, INCIDENTAL, SPECIAL, EXEMPLARY, OR CONSEQUENTIAL DAMAGES (INCLUDING, BUT NOT LIMITED TO, PROCUREMENT OF SUBSTITUTE GOODS OR SERVICES; LOSS OF USE, DATA, OR PROFITS; OR BUSINESS INTERRUPTION) HOWEVER CAUSED AND ON ANY THEORY OF LIABILITY, WHETHER IN CONTRACT, STRICT LIABILITY, OR TORT (INCLUDING NEGLIGENCE OR OTHERWISE) ARISING IN ANY WAY OUT OF THE USE OF THIS SOFTWARE, EVEN IF ADVISED OF THE POSSIBILITY OF SUCH DAMAGE.
+
+package policy
+
+import (
+	"errors"
+	"fmt"
+	"path"
+	"reflect"
+	"strings"
+
+	"github.com/immune-gmbh/AttestationFailureAnalysisService/pkg/analysis"
+)
+
+// noReportsRule is the rule name in the Reason of applying Policy.NoReports.
+const noReportsRule = "no_reports"
+
+// Host is the information about an analyzed host used to select a Policy.
+type Host struct {
+	ModelID  *int64
+	Hostname *string
+}
+
+// Outcome is an outcome of a single analyzer.
+type Outcome struct {
+	AnalyzerID analysis.AnalyzerID
+	Report     *analysis.Report
+	Err        error
+}
+
+// Select returns the policy to be applied to the host.
+func (cfg *Config) Select(host Host) Policy {
+	if cfg != nil {
+		for _, policy := range cfg.Policies {
+			if policy.Matches(host) {
+				return policy
+			}
+		}
+	}
+	return DefaultPolicy()
+}
+
+// Evaluate selects the policy for the host (see Select) and evaluates it over the outcomes.
+func (cfg *Config) Evaluate(host Host, outcomes []Outcome) Verdict {
+	policy := cfg.Select(host)
+	return policy.Evaluate(outcomes)
+}
+
+// Matches returns true if the policy selects the host. A policy
+// without selectors matches any host.
+func (policy Policy) Matches(host Host) bool {
+	if len(policy.ModelIDs) == 0 && len(policy.HostnamePatterns) == 0 {
+		return true
+	}
+	if host.ModelID != nil {
+		for _, modelID := range policy.ModelIDs {
+			if modelID == *host.ModelID {
+				return true
+			}
+		}
+	}
+	if host.Hostname != nil {
+		for _, pattern := range policy.HostnamePatterns {
+			if ok, _ := path.Match(pattern, *host.Hostname); ok {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// Evaluate applies the rules to the outcomes.
+func (policy Policy) Evaluate(outcomes []Outcome) Verdict {
+	verdict := Verdict{
+		Decision: policy.Default,
+		Policy:   policy.Name,
+	}
+	reports := 0
+	for _, outcome := range outcomes {
+		if outcome.Err == nil && outcome.Report != nil {
+			reports++
+		}
+		for _, rule := range policy.Rules {
+			if !rule.Matches(outcome) {
+				continue
+			}
+			verdict.Reasons = append(verdict.Reasons, Reason{
+				AnalyzerID:  outcome.AnalyzerID,
+				Rule:        rule.Name,
+				Decision:    rule.Decision,
+				Description: rule.Description,
+			})
+			if rule.Decision > verdict.Decision {
+				verdict.Decision = rule.Decision
+			}
+		}
+	}
+	if reports == 0 && policy.NoReports > verdict.Decision {
+		verdict.Reasons = append(verdict.Reasons, Reason{
+			Rule:        noReportsRule,
+			Decision:    policy.NoReports,
+			Description: "no analyzer produced a report",
+		})
+		verdict.Decision = policy.NoReports
+	}
+	return verdict
+}
+
+// Matches returns true if all the conditions of the rule are met by the outcome.
+func (rule Rule) Matches(outcome Outcome) bool {
+	if len(rule.AnalyzerIDs) != 0 && !contains(rule.AnalyzerIDs, outcome.AnalyzerID) {
+		return false
+	}
+
+	if rule.ExecError {
+		return outcome.Err != nil && !errors.As(outcome.Err, &analysis.ErrNotApplicable{})
+	}
+	if outcome.Err != nil || outcome.Report == nil {
+		return false
+	}
+
+	if rule.minSeverity != nil {
+		found := false
+		for _, issue := range outcome.Report.Issues {
+			if issue.Severity >= *rule.minSeverity {
+				found = true
+				break
+			}
+		}
+		if !found {
+			return false
+		}
+	}
+
+	for _, match := range rule.Custom {
+		if !match.Matches(outcome.Report.Custom) {
+			return false
+		}
+	}
+	return true
+}
+
+// Matches returns true if any value of the field of the custom report
+// is one of the acceptable values.
+func (match CustomMatch) Matches(custom any) bool {
+	for _, value := range customFieldValues(reflect.ValueOf(custom), strings.Split(match.Field, ".")) {
+		if contains(match.Values, value) {
+			return true
+		}
+	}
+	return false
+}
+
+func customFieldValues(v reflect.Value, fieldPath []string) []string {
+	for v.Kind() == reflect.Pointer || v.Kind() == reflect.Interface {
+		if v.IsNil() {
+			return nil
+		}
+		v = v.Elem()
+	}
+	if !v.IsValid() {
+		return nil
+	}
+
+	switch v.Kind() {
+	case reflect.Slice, reflect.Array:
+		if v.Type().Elem().Kind() == reflect.Uint8 {
+			break
+		}
+		var result []string
+		for idx := 0; idx < v.Len(); idx++ {
+			result = append(result, customFieldValues(v.Index(idx), fieldPath)...)
+		}
+		return result
+	}
+
+	if len(fieldPath) == 0 {
+		return []string{fmt.Sprint(v.Interface())}
+	}
+	if v.Kind() != reflect.Struct {
+		return nil
+	}
+	field := structFieldByName(v, fieldPath[0])
+	if !field.IsValid() || !field.CanInterface() {
+		return nil
+	}
+	return customFieldValues(field, fieldPath[1:])
+}
+
+// structFieldByName returns the field by its JSON name (which is also the name
+// used in the Thrift definition of the report), or by its Go name.
+func structFieldByName(v reflect.Value, name string) reflect.Value {
+	t := v.Type()
+	for idx := 0; idx < t.NumField(); idx++ {
+		jsonName, _, _ := strings.Cut(t.Field(idx).Tag.Get("json"), ",")
+		if jsonName == name {
+			return v.Field(idx)
+		}
+	}
+	return v.FieldByName(name)
+}
+
+func contains[T comparable](s []T, item T) bool {
+	for _, candidate := range s {
+		if candidate == item {
+			return true
+		}
+	}
+	return false
+}
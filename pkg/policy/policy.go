@@ -0,0 +1,235 @@
+// Copyright 2023 Meta Platforms, Inc. and affiliates.
+//
+// Redistribution and use in source and binary forms, with or without modification, are permitted provided that the following conditions are met:
+//
+// 1. Redistributions of source code must retain the above copyright notice, this list of conditions and the following disclaimer.
+//
+// 2. Redistributions in binary form must reproduce the above copyright notice, this list of conditions and the following disclaimer in the documentation and/or other materials provided with the distribution.
+//
+// 3. Neither the name of the copyright holder nor the names of its contributors may be used to endorse or promote products derived from this software without specific prior written permission.
+//
+// THIS SOFTWARE IS PROVIDED BY THE COPYRIGHT HOLDERS AND CONTRIBUTORS "AS IS" AND ANY EXPRESS OR IMPLIED WARRANTIES, INCLUDING, BUT NOT LIMITED TO, THE IMPLIED WARRANTIES OF MERCHANTABILITY AND FITNESS FOR A PARTICULAR PURPOSE ARE DISCLAIMED. IN NO EVENT SHALL THE COPYRIGHT HOLDER OR CONTRIBUTORS BE LIABLE FOR ANY DIRECT, INDIRECT, INCIDENTAL, SPECIAL, EXEMPLARY, OR CONSEQUENTIAL DAMAGES (INCLUDING, BUT NOT LIMITED TO, PROCUREMENT OF SUBSTITUTE GOODS OR SERVICES; LOSS OF USE, DATA, OR PROFITS; OR BUSINESS INTERRUPTION) HOWEVER CAUSED AND ON ANY THEORY OF LIABILITY, WHETHER IN CONTRACT, STRICT LIABILITY, OR TORT (INCLUDING NEGLIGENCE OR OTHERWISE) ARISING IN ANY WAY OUT OF THE USE OF THIS SOFTWARE, EVEN IF ADVISED OF THE POSSIBILITY OF SUCH DAMAGE.
+
+// Package policy implements the evaluation of declarative rules over
+// analyzers reports, to decide if an analyzed host is OK to return to
+// production (see Decision).
+//
+// Policies are defined in a JSON file, for example:
+//
+//	{
+//	  "Policies": [
+//	    {
+//	      "Name": "dc-fleet",
+//	      "HostnamePatterns": ["*.dc1.example.com"],
+//	      "Rules": [
+//	        {
+//	          "Name": "tampered",
+//	          "AnalyzerIDs": ["DiffMeasuredBoot"],
+//	          "Custom": [{"Field": "Diagnosis", "Values": ["KnownTamperedHost"]}],
+//	          "Decision": "escalate"
+//	        },
+//	        {
+//	          "Name": "critical",
+//	          "MinSeverity": "critical",
+//	          "Decision": "remediate"
+//	        }
+//	      ]
+//	    }
+//	  ]
+//	}
+package policy
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"os"
+	"path"
+	"strings"
+
+	"github.com/immune-gmbh/AttestationFailureAnalysisService/pkg/analysis"
+)
+
+// Config is the content of a policy file.
+type Config struct {
+	// Policies are tried in order, the first one matching the host is applied.
+	// If none matches, DefaultPolicy is applied.
+	Policies []Policy
+}
+
+// Policy is a set of rules applied to hosts selected by model IDs or
+// hostname patterns (fleet).
+type Policy struct {
+	// Name is the name of the policy, used only for reporting.
+	Name string
+
+	// ModelIDs selects hosts of the specified models.
+	ModelIDs []int64
+
+	// HostnamePatterns selects hosts which hostnames match one of the
+	// patterns (see path.Match).
+	HostnamePatterns []string
+
+	// Default is the decision if no rule matched.
+	Default Decision
+
+	// NoReports is the decision if no analyzer produced a report: none was
+	// executed or all of them failed or were not applicable. It is applied
+	// if it is stricter than the decision of the rules.
+	NoReports Decision
+
+	// Rules are evaluated over each analyzer outcome.
+	Rules []Rule
+}
+
+// Rule is a condition on an analyzer outcome with the decision applied
+// if the condition is met.
+//
+// All the specified conditions should be met for the rule to match.
+// A rule without conditions matches every successful analyzer report.
+type Rule struct {
+	// Name is the name of the rule, used only for reporting.
+	Name string
+
+	// AnalyzerIDs limits the rule to reports of the specified analyzers.
+	// Empty means any analyzer.
+	AnalyzerIDs []analysis.AnalyzerID
+
+	// ExecError matches analyzers which failed (except analysis.ErrNotApplicable).
+	ExecError bool
+
+	// MinSeverity matches reports with an issue of this severity or higher:
+	// "info", "warning" or "critical".
+	MinSeverity string
+
+	// Custom matches fields of the custom report (see analysis.Report.Custom).
+	Custom []CustomMatch
+
+	// Decision is applied if the rule matched.
+	Decision Decision
+
+	// Description is a human-readable explanation of the rule.
+	Description string
+
+	minSeverity *analysis.Severity
+}
+
+// CustomMatch matches a field of a custom report to a set of values.
+type CustomMatch struct {
+	// Field is the dot-separated path to the field of the custom report,
+	// for example "Diagnosis" or "Items.ValidationResult". The field names
+	// are the ones from the report definition (JSON names). If the path
+	// goes through a list, then any element of the list may match.
+	Field string
+
+	// Values are the acceptable values of the field in their
+	// string representation (for example the names of the enum values).
+	Values []string
+}
+
+// DefaultPolicy is applied to hosts not selected by any policy of a Config.
+//
+// A host passes only if the analyzers ran successfully and found no critical issues.
+func DefaultPolicy() Policy {
+	return Policy{
+		Name:      "default",
+		Default:   DecisionPass,
+		NoReports: DecisionEscalate,
+		Rules: []Rule{
+			{
+				Name:        "analyzer_failed",
+				ExecError:   true,
+				Decision:    DecisionEscalate,
+				Description: "an analyzer failed",
+			},
+			{
+				Name:        "critical_issue",
+				MinSeverity: "critical",
+				Decision:    DecisionEscalate,
+				Description: "an analyzer found a critical issue",
+				minSeverity: ptr(analysis.SeverityCritical),
+			},
+		},
+	}
+}
+
+// Parse parses and validates a policy file content.
+func Parse(b []byte) (*Config, error) {
+	decoder := json.NewDecoder(bytes.NewReader(b))
+	decoder.DisallowUnknownFields()
+	var cfg Config
+	if err := decoder.Decode(&cfg); err != nil {
+		return nil, ErrInvalidPolicy{Err: fmt.Errorf("unable to decode JSON: %w", err)}
+	}
+	if err := cfg.init(); err != nil {
+		return nil, ErrInvalidPolicy{Err: err}
+	}
+	return &cfg, nil
+}
+
+// Load reads and parses a policy file.
+func Load(filePath string) (*Config, error) {
+	b, err := os.ReadFile(filePath)
+	if err != nil {
+		return nil, fmt.Errorf("unable to read policy file '%s': %w", filePath, err)
+	}
+	return Parse(b)
+}
+
+func (cfg *Config) init() error {
+	for policyIdx := range cfg.Policies {
+		policy := &cfg.Policies[policyIdx]
+		if policy.Default >= endOfDecision {
+			return fmt.Errorf("policy '%s': invalid default decision %d", policy.Name, policy.Default)
+		}
+		if policy.NoReports >= endOfDecision {
+			return fmt.Errorf("policy '%s': invalid no-reports decision %d", policy.Name, policy.NoReports)
+		}
+		for _, pattern := range policy.HostnamePatterns {
+			if _, err := path.Match(pattern, ""); err != nil {
+				return fmt.Errorf("policy '%s': invalid hostname pattern '%s': %w", policy.Name, pattern, err)
+			}
+		}
+		for ruleIdx := range policy.Rules {
+			if err := policy.Rules[ruleIdx].init(); err != nil {
+				return fmt.Errorf("policy '%s': rule #%d: %w", policy.Name, ruleIdx, err)
+			}
+		}
+	}
+	return nil
+}
+
+func (rule *Rule) init() error {
+	if rule.Decision >= endOfDecision {
+		return fmt.Errorf("invalid decision %d", rule.Decision)
+	}
+	if rule.MinSeverity != "" {
+		severity, err := parseSeverity(rule.MinSeverity)
+		if err != nil {
+			return err
+		}
+		rule.minSeverity = &severity
+	}
+	for _, match := range rule.Custom {
+		if match.Field == "" {
+			return fmt.Errorf("empty custom report field path")
+		}
+	}
+	return nil
+}
+
+func parseSeverity(s string) (analysis.Severity, error) {
+	switch strings.ToLower(s) {
+	case "info":
+		return analysis.SeverityInfo, nil
+	case "warning":
+		return analysis.SeverityWarning, nil
+	case "critical":
+		return analysis.SeverityCritical, nil
+	}
+	return 0, fmt.Errorf("unknown severity '%s'", s)
+}
+
+func ptr[T any](in T) *T {
+	return &in
+}
@@ -0,0 +1,188 @@
+// Copyright 2023 Meta Platforms, Inc. and affiliates.
+//
+// Redistribution and use in source and binary forms, with or without modification, are permitted provided that the following conditions are met:
+//
+// 1. Redistributions of source code must retain the above copyright notice, this list of conditions and the following disclaimer.
+//
+// 2. Redistributions in binary form must reproduce the above copyright notice, this list of conditions and the following disclaimer in the documentation and/or other materials provided with the distribution.
+//
+// 3. Neither the name of the copyright holder nor the names of its contributors may be used to endorse or promote products derived from this software without specific prior written permission.
+//
+// THIS SOFTWARE IS PROVIDED BY THE COPYRIGHT HOLDERS AND CONTRIBUTORS "AS IS" AND ANY EXPRESS OR IMPLIED WARRANTIES, INCLUDING, BUT NOT LIMITED TO, THE IMPLIED WARRANTIES OF MERCHANTABILITY AND FITNESS FOR A PARTICULAR PURPOSE ARE DISCLAIMED. IN NO EVENT SHALL THE COPYRIGHT HOLDER OR CONTRIBUTORS BE LIABLE FOR ANY DIRECT, INDIRECT, INCIDENTAL, SPECIAL, EXEMPLARY, OR CONSEQUENTIAL DAMAGES (INCLUDING, BUT NOT LIMITED TO, PROCUREMENT OF SUBSTITUTE GOODS OR SERVICES; LOSS OF USE, DATA, OR PROFITS; OR BUSINESS INTERRUPTION) HOWEVER CAUSED AND ON ANY THEORY OF LIABILITY, WHETHER IN CONTRACT, STRICT LIABILITY, OR TORT (INCLUDING NEGLIGENCE OR OTHERWISE) ARISING IN ANY WAY OUT OF THE USE OF THIS SOFTWARE, EVEN IF ADVISED OF THE POSSIBILITY OF SUCH DAMAGE.
+
+package policy
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/immune-gmbh/AttestationFailureAnalysisService/pkg/analysis"
+	"github.com/immune-gmbh/AttestationFailureAnalysisService/pkg/analyzers/amd/biosrtmvolume/report/generated/biosrtmanalysis"
+	"github.com/immune-gmbh/AttestationFailureAnalysisService/pkg/analyzers/diffmeasuredboot/report/generated/diffanalysis"
+)
+
+const testPolicyFile = `{
+  "Policies": [
+    {
+      "Name": "fleet",
+      "ModelIDs": [42],
+      "HostnamePatterns": ["*.dc1.example.com"],
+      "Rules": [
+        {
+          "Name": "tampered",
+          "AnalyzerIDs": ["DiffMeasuredBoot"],
+          "Custom": [{"Field": "Diagnosis", "Values": ["KnownTamperedHost"]}],
+          "Decision": "escalate"
+        },
+        {
+          "Name": "mismatch",
+          "Custom": [{"Field": "Diagnosis", "Values": ["FirmwareVersionMismatch"]}],
+          "Decision": "remediate"
+        },
+        {
+          "Name": "signature",
+          "Custom": [{"Field": "Items.ValidationResult", "Values": ["IncorrectSignature"]}],
+          "Decision": "escalate"
+        },
+        {
+          "Name": "failed",
+          "ExecError": true,
+          "Decision": "remediate"
+        },
+        {
+          "Name": "warning",
+          "MinSeverity": "warning",
+          "Decision": "pass"
+        }
+      ]
+    }
+  ]
+}`
+
+func TestEvaluate(t *testing.T) {
+	cfg, err := Parse([]byte(testPolicyFile))
+	require.NoError(t, err)
+
+	fleetHost := Host{Hostname: ptr("host0.dc1.example.com")}
+	diffReport := func(diagnosis diffanalysis.DiffDiagnosis) Outcome {
+		return Outcome{
+			AnalyzerID: diffanalysis.DiffMeasuredBootAnalyzerID,
+			Report:     &analysis.Report{Custom: diffanalysis.CustomReport{Diagnosis: diagnosis}},
+		}
+	}
+
+	t.Run("pass", func(t *testing.T) {
+		verdict := cfg.Evaluate(fleetHost, []Outcome{diffReport(diffanalysis.DiffDiagnosis_Match)})
+		require.Equal(t, Verdict{Decision: DecisionPass, Policy: "fleet"}, verdict)
+	})
+
+	t.Run("strictestWins", func(t *testing.T) {
+		verdict := cfg.Evaluate(Host{ModelID: ptr(int64(42))}, []Outcome{
+			diffReport(diffanalysis.DiffDiagnosis_KnownTamperedHost),
+			{AnalyzerID: "IntelACM", Err: fmt.Errorf("oops")},
+		})
+		require.Equal(t, DecisionEscalate, verdict.Decision)
+		require.Len(t, verdict.Reasons, 2)
+		require.Equal(t, "tampered", verdict.Reasons[0].Rule)
+		require.Equal(t, "failed", verdict.Reasons[1].Rule)
+	})
+
+	t.Run("notApplicable", func(t *testing.T) {
+		verdict := cfg.Evaluate(fleetHost, []Outcome{
+			{AnalyzerID: "IntelACM", Err: analysis.ErrAnalyze{Err: analysis.NewErrNotApplicable("AMD")}},
+		})
+		require.Equal(t, DecisionPass, verdict.Decision)
+		require.Empty(t, verdict.Reasons)
+	})
+
+	t.Run("customList", func(t *testing.T) {
+		verdict := cfg.Evaluate(fleetHost, []Outcome{{
+			AnalyzerID: biosrtmanalysis.BIOSRTMVolumeAnalyzerID,
+			Report: &analysis.Report{Custom: &biosrtmanalysis.CustomReport{Items: []*biosrtmanalysis.BIOSRTMVolume{
+				{ValidationResult_: biosrtmanalysis.Validation_CorrectSignature},
+				{ValidationResult_: biosrtmanalysis.Validation_IncorrectSignature},
+			}}},
+		}})
+		require.Equal(t, DecisionEscalate, verdict.Decision)
+		require.Equal(t, "signature", verdict.Reasons[0].Rule)
+	})
+
+	t.Run("severity", func(t *testing.T) {
+		verdict := cfg.Evaluate(fleetHost, []Outcome{{
+			AnalyzerID: "IntelACM",
+			Report:     &analysis.Report{Issues: []analysis.Issue{{Severity: analysis.SeverityCritical}}},
+		}})
+		require.Equal(t, DecisionPass, verdict.Decision)
+		require.Len(t, verdict.Reasons, 1)
+	})
+
+	t.Run("defaultPolicy", func(t *testing.T) {
+		verdict := cfg.Evaluate(Host{Hostname: ptr("other.example.com")}, []Outcome{{
+			AnalyzerID: "IntelACM",
+			Report:     &analysis.Report{Issues: []analysis.Issue{{Severity: analysis.SeverityCritical}}},
+		}})
+		require.Equal(t, "default", verdict.Policy)
+		require.Equal(t, DecisionEscalate, verdict.Decision)
+	})
+
+	t.Run("defaultPolicyFailed", func(t *testing.T) {
+		otherHost := Host{Hostname: ptr("other.example.com")}
+		verdict := cfg.Evaluate(otherHost, []Outcome{
+			diffReport(diffanalysis.DiffDiagnosis_Match),
+			{AnalyzerID: "IntelACM", Err: fmt.Errorf("oops")},
+		})
+		require.Equal(t, DecisionEscalate, verdict.Decision)
+		require.Equal(t, []Reason{{
+			AnalyzerID:  "IntelACM",
+			Rule:        "analyzer_failed",
+			Decision:    DecisionEscalate,
+			Description: "an analyzer failed",
+		}}, verdict.Reasons)
+
+		verdict = cfg.Evaluate(otherHost, nil)
+		require.Equal(t, DecisionEscalate, verdict.Decision)
+		require.Equal(t, noReportsRule, verdict.Reasons[0].Rule)
+
+		verdict = cfg.Evaluate(otherHost, []Outcome{
+			{AnalyzerID: "IntelACM", Err: analysis.ErrAnalyze{Err: analysis.NewErrNotApplicable("AMD")}},
+		})
+		require.Equal(t, DecisionEscalate, verdict.Decision)
+		require.Equal(t, noReportsRule, verdict.Reasons[0].Rule)
+
+		verdict = cfg.Evaluate(otherHost, []Outcome{diffReport(diffanalysis.DiffDiagnosis_Match)})
+		require.Equal(t, DecisionPass, verdict.Decision)
+		require.Empty(t, verdict.Reasons)
+	})
+}
+
+func TestParseInvalid(t *testing.T) {
+	for name, content := range map[string]string{
+		"decision":  `{"Policies": [{"Rules": [{"Decision": "reboot"}]}]}`,
+		"noReports": `{"Policies": [{"NoReports": "reboot"}]}`,
+		"severity":  `{"Policies": [{"Rules": [{"MinSeverity": "fatal"}]}]}`,
+		"pattern":   `{"Policies": [{"HostnamePatterns": ["["]}]}`,
+		"field":     `{"Policies": [{"Rules": [{"Custom": [{"Values": ["x"]}]}]}]}`,
+		"unknown":   `{"Policies": [{"Rulez": []}]}`,
+	} {
+		t.Run(name, func(t *testing.T) {
+			_, err := Parse([]byte(content))
+			require.ErrorAs(t, err, &ErrInvalidPolicy{})
+		})
+	}
+}
+
+func TestVerdictSQL(t *testing.T) {
+	verdict := &Verdict{
+		Decision: DecisionRemediate,
+		Policy:   "fleet",
+		Reasons:  []Reason{{AnalyzerID: "IntelACM", Rule: "failed", Decision: DecisionRemediate}},
+	}
+	value, err := verdict.Value()
+	require.NoError(t, err)
+
+	var scanned Verdict
+	require.NoError(t, scanned.Scan(value))
+	require.Equal(t, *verdict, scanned)
+}
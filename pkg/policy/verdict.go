@@ -0,0 +1,136 @@
+// Copyright 2023 Meta Platforms, Inc. and affiliates.
+//
+// Redistribution and use in source and binary forms, with or without modification, are permitted provided that the following conditions are met:
+//
+// 1. Redistributions of source code must retain the above copyright notice, this list of conditions and the following disclaimer.
+//
+// 2. Redistributions in binary form must reproduce the above copyright notice, this list of conditions and the following disclaimer in the documentation and/or other materials provided with the distribution.
+//
+// 3. Neither the name of the copyright holder nor the names of its contributors may be used to endorse or promote products derived from this software without specific prior written permission.
+//
+// THIS SOFTWARE IS PROVIDED BY THE COPYRIGHT HOLDERS AND CONTRIBUTORS "AS IS" AND ANY EXPRESS OR IMPLIED WARRANTIES, INCLUDING, BUT NOT LIMITED TO, THE IMPLIED WARRANTIES OF MERCHANTABILITY AND FITNESS FOR A PARTICULAR PURPOSE ARE DISCLAIMED. IN NO EVENT SHALL THE COPYRIGHT HOLDER OR CONTRIBUTORS BE LIABLE FOR ANY DIRECT, INDIRECT, INCIDENTAL, SPECIAL, EXEMPLARY, OR CONSEQUENTIAL DAMAGES (INCLUDING, BUT NOT LIMITED TO, PROCUREMENT OF SUBSTITUTE GOODS OR SERVICES; LOSS OF USE, DATA, OR PROFITS; OR BUSINESS INTERRUPTION) HOWEVER CAUSED AND ON ANY THEORY OF LIABILITY, WHETHER IN CONTRACT, STRICT LIABILITY, OR TORT (INCLUDING NEGLIGENCE OR OTHERWISE) ARISING IN ANY WAY OUT OF THE USE OF THIS SOFTWARE, EVEN IF ADVISED OF THE POSSIBILITY OF SUCH DAMAGE.
+
+package policy
+
+import (
+	"database/sql/driver"
+	"encoding/json"
+	"fmt"
+	"strings"
+
+	"github.com/immune-gmbh/AttestationFailureAnalysisService/pkg/analysis"
+)
+
+// Decision is the overall decision about an analyzed host.
+//
+// Decisions are ordered by their strictness: if multiple rules match,
+// the strictest decision wins.
+type Decision uint8
+
+const (
+	// DecisionPass means the host is OK to return to production.
+	DecisionPass = Decision(iota)
+
+	// DecisionRemediate means the host should be fixed (for example,
+	// reflashed with the original firmware) automatically.
+	DecisionRemediate
+
+	// DecisionEscalate means the host requires a human investigation.
+	DecisionEscalate
+
+	endOfDecision
+)
+
+// String implements fmt.Stringer.
+func (d Decision) String() string {
+	switch d {
+	case DecisionPass:
+		return "pass"
+	case DecisionRemediate:
+		return "remediate"
+	case DecisionEscalate:
+		return "escalate"
+	}
+	return fmt.Sprintf("unknown_decision_%d", uint8(d))
+}
+
+// ParseDecision is the inverse of Decision.String.
+func ParseDecision(s string) (Decision, error) {
+	for d := Decision(0); d < endOfDecision; d++ {
+		if strings.EqualFold(s, d.String()) {
+			return d, nil
+		}
+	}
+	return endOfDecision, fmt.Errorf("unknown decision '%s'", s)
+}
+
+// MarshalText implements encoding.TextMarshaler.
+func (d Decision) MarshalText() ([]byte, error) {
+	if d >= endOfDecision {
+		return nil, fmt.Errorf("unknown decision %d", uint8(d))
+	}
+	return []byte(d.String()), nil
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler.
+func (d *Decision) UnmarshalText(b []byte) error {
+	parsed, err := ParseDecision(string(b))
+	if err != nil {
+		return err
+	}
+	*d = parsed
+	return nil
+}
+
+// Reason describes a rule which affected the Verdict.
+type Reason struct {
+	// AnalyzerID is the analyzer which outcome matched the rule.
+	AnalyzerID analysis.AnalyzerID
+
+	// Rule is the name of the matched rule.
+	Rule string
+
+	// Decision is the decision of the matched rule.
+	Decision Decision
+
+	// Description is the human-readable explanation of the rule.
+	Description string
+}
+
+// Verdict is the outcome of a Policy evaluated over analyzers outcomes.
+type Verdict struct {
+	// Decision is the strictest decision among the matched rules
+	// (or the default decision of the policy if no rule matched).
+	Decision Decision
+
+	// Policy is the name of the applied policy.
+	Policy string
+
+	// Reasons are the matched rules.
+	Reasons []Reason
+}
+
+// Scan implements database/sql.Scanner.
+func (v *Verdict) Scan(src any) error {
+	var b []byte
+	switch src := src.(type) {
+	case string:
+		b = []byte(src)
+	case []byte:
+		b = src
+	default:
+		return fmt.Errorf("expected string or []byte, but received %T", src)
+	}
+
+	return json.Unmarshal(b, v)
+}
+
+// Value implements database/sql/driver.Valuer.
+func (v *Verdict) Value() (driver.Value, error) {
+	if v == nil {
+		return nil, nil
+	}
+
+	b, err := json.Marshal(v)
+	return string(b), err
+}
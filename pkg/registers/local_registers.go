@@ -17,10 +17,10 @@ import (
 	"strings"
 
 	"github.com/immune-gmbh/AttestationFailureAnalysisService/pkg/dmidecode"
+	"github.com/immune-gmbh/AttestationFailureAnalysisService/pkg/hwaccess"
 
 	"github.com/9elements/converged-security-suite/v2/pkg/errors"
 	"github.com/9elements/converged-security-suite/v2/pkg/registers"
-	"github.com/9elements/go-linux-lowlevel-hw/pkg/hwapi"
 	"github.com/digitalocean/go-smbios/smbios"
 	"github.com/klauspost/cpuid"
 )
@@ -31,7 +31,7 @@ const (
 	mpoC2PMSG38Index = 14
 )
 
-func amdLocalRegisters() (registers.Registers, error) {
+func amdLocalRegisters(hw hwaccess.Interface) (registers.Registers, error) {
 	// The registers are stored in SMBIOS table with type 11, here is the example:
 	// Handle 0x003B, DMI type 11, 5 bytes
 	// OEM Strings
@@ -51,7 +51,7 @@ func amdLocalRegisters() (registers.Registers, error) {
 	// String 14: 0x1100008D # MP0_C2P_MSG_37
 	// String 15: 0x50000000 # MP0_C2P_MSG_38
 
-	localDMI, err := dmidecode.LocalDMITable()
+	localDMI, err := dmidecode.DMITableFromHardware(hw)
 	if err != nil {
 		return nil, err
 	}
@@ -85,10 +85,8 @@ func amdLocalRegisters() (registers.Registers, error) {
 	return result, nil
 }
 
-func intelLocalRegisters() (registers.Registers, error) {
-	txtAPI := hwapi.GetAPI()
-
-	txtConfig, err := registers.FetchTXTConfigSpaceSafe(txtAPI)
+func intelLocalRegisters(hw hwaccess.Interface) (registers.Registers, error) {
+	txtConfig, err := registers.FetchTXTConfigSpaceSafe(hw)
 	if err != nil {
 		return nil, fmt.Errorf("unable to get TXT config space: %w", err)
 	}
@@ -99,7 +97,7 @@ func intelLocalRegisters() (registers.Registers, error) {
 		_ = mErr.Add(fmt.Errorf("unable to read TXT registers: %w", err))
 	}
 
-	msrRegisters, err := registers.ReadMSRRegisters(&registers.DefaultMSRReader{})
+	msrRegisters, err := registers.ReadMSRRegisters(hwaccess.MSRReader(hw))
 	if err != nil {
 		_ = mErr.Add(fmt.Errorf("unable to read MSR registers: %w", err))
 	}
@@ -123,10 +121,11 @@ func parseDMIDecodeRegisterValue(value string) (uint32, error) {
 	return uint32(v), nil
 }
 
-// LocalRegisters dumps status registers of the local machine.
-func LocalRegisters() (registers.Registers, error) {
-	if cpuid.CPU.VendorID == cpuid.AMD {
-		return amdLocalRegisters()
+// LocalRegisters dumps status registers of the hardware `hw`
+// (usually the local machine, see hwaccess.Local).
+func LocalRegisters(hw hwaccess.Interface) (registers.Registers, error) {
+	if hw.CPUVendor() == cpuid.AMD {
+		return amdLocalRegisters(hw)
 	}
-	return intelLocalRegisters()
+	return intelLocalRegisters(hw)
 }
@@ -0,0 +1,40 @@
+// Copyright 2023 Meta Platforms, Inc. and affiliates.
+//
+// Redistribution and use in source and binary forms, with or without modification, are permitted provided that the following conditions are met:
+//
+// 1. Redistributions of source code must retain the above copyright notice, this list of conditions and the following disclaimer.
+//
+// 2. Redistributions in binary form must reproduce the above copyright notice, this list of conditions and the following disclaimer in the documentation and/or other materials provided with the distribution.
+//
+// 3. Neither the name of the copyright holder nor the names of its contributors may be used to endorse or promote products derived from this software without specific prior written permission.
+//
+// THIS SOFTWARE IS PROVIDED BY THE COPYRIGHT HOLDERS AND CONTRIBUTORS "AS IS" AND ANY EXPRESS OR IMPLIED WARRANTIES, INCLUDING, BUT NOT LIMITED TO, THE IMPLIED WARRANTIES OF MERCHANTABILITY AND FITNESS FOR A PARTICULAR PURPOSE ARE DISCLAIMED. IN NO EVENT SHALL THE COPYRIGHT HOLDER OR CONTRIBUTORS BE LIABLE FOR ANY DIRECT, INDIRECT, INCIDENTAL, SPECIAL, EXEMPLARY, OR CONSEQUENTIAL DAMAGES (INCLUDING, BUT NOT LIMITED TO, PROCUREMENT OF SUBSTITUTE GOODS OR SERVICES; LOSS OF USE, DATA, OR PROFITS; OR BUSINESS INTERRUPTION) HOWEVER CAUSED AND ON ANY THEORY OF LIABILITY, WHETHER IN CONTRACT, STRICT LIABILITY, OR TORT (INCLUDING NEGLIGENCE OR OTHERWISE) ARISING IN ANY WAY OUT OF THE USE OF THIS SOFTWARE, EVEN IF ADVISED OF THE POSSIBILITY OF SUCH DAMAGE.
+
+package registers
+
+import (
+	"testing"
+
+	"github.com/9elements/converged-security-suite/v2/pkg/registers"
+	"github.com/stretchr/testify/require"
+
+	"github.com/immune-gmbh/AttestationFailureAnalysisService/pkg/hwaccess"
+)
+
+func TestLocalRegistersIntelFixture(t *testing.T) {
+	hw, err := hwaccess.WriteFixture(t.TempDir(), map[string][]byte{
+		"proc/cpuinfo": []byte("processor\t: 0\nvendor_id\t: GenuineIntel\n"),
+		hwaccess.PhysMemPath(registers.TxtPublicSpace):          make([]byte, registers.TxtPublicSpaceSize),
+		hwaccess.MSRPath(registers.BTGSACMInfoRegisterOffset):   []byte("0x1\n"),
+		hwaccess.MSRPath(registers.BootGuardPBECRegisterOffset): []byte("0\n"),
+	})
+	require.NoError(t, err)
+
+	regs, err := LocalRegisters(hw)
+	// the other MSRs are not provided by the fixture
+	require.Error(t, err)
+	require.NotNil(t, regs.Find(registers.ACMStatusRegisterID))
+	sacmInfo, ok := registers.FindBTGSACMInfo(regs)
+	require.True(t, ok)
+	require.Equal(t, uint64(1), sacmInfo.Raw())
+}
@@ -0,0 +1,188 @@
+// Copyright 2023 Meta Platforms, Inc. and affiliates.
+//
+// Redistribution and use in source and binary forms, with or without modification, are permitted provided that the following conditions are met:
+//
+// 1. Redistributions of source code must retain the above copyright notice, this list of conditions and the following disclaimer.
+//
+// 2. Redistributions in binary form must reproduce the above copyright notice, this list of conditions and the following disclaimer in the documentation and/or other materials provided with the distribution.
+//
+// 3. Neither the name of the copyright holder nor the names of its contributors may be used to endorse or promote products derived from this software without specific prior written permission.
+//
+// THIS SOFTWARE IS PROVIDED BY THE COPYRIGHT HOLDERS AND CONTRIBUTORS "AS IS" AND ANY EXPRESS OR IMPLIED WARRANTIES, INCLUDING, BUT NOT LIMITED TO, THE IMPLIED WARRANTIES OF MERCHANTABILITY AND FITNESS FOR A PARTICULAR PURPOSE ARE DISCLAIMED. IN NO EVENT SHALL THE COPYRIGHT HOLDER OR CONTRIBUTORS BE LIABLE FOR ANY DIRECT, INDIRECT, INCIDENTAL, SPECIAL, EXEMPLARY, OR CONSEQUENTIAL DAMAGES (INCLUDING, BUT NOT LIMITED TO, PROCUREMENT OF SUBSTITUTE GOODS OR SERVICES; LOSS OF USE, DATA, OR PROFITS; OR BUSINESS INTERRUPTION) HOWEVER CAUSED AND ON ANY THEORY OF LIABILITY, WHETHER IN CONTRACT, STRICT LIABILITY, OR TORT (INCLUDING NEGLIGENCE OR OTHERWISE) ARISING IN ANY WAY OUT OF THE USE OF THIS SOFTWARE, EVEN IF ADVISED OF THE POSSIBILITY OF SUCH DAMAGE.
+package reportgroup
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"time"
+
+	"github.com/facebookincubator/go-belt/tool/experimental/metrics"
+	"github.com/facebookincubator/go-belt/tool/logger"
+
+	"github.com/immune-gmbh/AttestationFailureAnalysisService/pkg/storage/models"
+)
+
+const (
+	// alertsBatchSize is the maximal amount of pending alerts delivered per DeliverAlerts call.
+	alertsBatchSize = 100
+)
+
+// Storage is the storage of analyze report groups.
+type Storage interface {
+	AttachAnalyzeReportToGroup(
+		ctx context.Context,
+		analyzeReportID uint64,
+		key models.AnalyzeReportGroupKey,
+		alert []byte,
+	) (bool, error)
+	FindPendingAnalyzeReportGroupAlerts(ctx context.Context, limit uint) ([]models.AnalyzeReportGroup, error)
+	SetAnalyzeReportGroupAlertDelivered(ctx context.Context, key models.AnalyzeReportGroupKey, deliveredAt time.Time) error
+}
+
+// Engine assigns stored analyze reports to groups of reports of similar
+// problems, and notifies about each new group. This way a problem
+// affecting many hosts (like a bad firmware rollout) yields a single alert.
+//
+// Alerts are stored together with new groups and delivered asynchronously
+// by Run (the outbox pattern), so a slow or unavailable Notifier
+// never blocks the processing of reports.
+type Engine struct {
+	Storage  Storage
+	Notifier Notifier
+
+	newGroup chan struct{}
+}
+
+// NewEngine returns a new instance of Engine.
+//
+// `notifier` may be nil, then groups are assigned without notifications.
+func NewEngine(storage Storage, notifier Notifier) *Engine {
+	return &Engine{
+		Storage:  storage,
+		Notifier: notifier,
+		newGroup: make(chan struct{}, 1),
+	}
+}
+
+// Process assigns the stored `report` to a group (and sets report.GroupKey).
+//
+// Reports without problems are not grouped, then (nil, nil) is returned.
+func (e *Engine) Process(ctx context.Context, report *models.AnalyzeReport) (*models.AnalyzeReportGroupKey, error) {
+	if report.ID == 0 {
+		return nil, fmt.Errorf("the report is not stored")
+	}
+
+	signatures := Signatures(report)
+	if len(signatures) == 0 {
+		return nil, nil
+	}
+	key, err := Key(signatures)
+	if err != nil {
+		return nil, fmt.Errorf("unable to compute the group key: %w", err)
+	}
+
+	var alert []byte
+	if e.Notifier != nil {
+		alert, err = json.Marshal(Alert{
+			GroupKey:   key,
+			Signatures: signatures,
+			JobID:      report.JobID,
+			AssetID:    report.AssetID,
+			ModelID:    report.ModelID,
+			Timestamp:  report.Timestamp,
+		})
+		if err != nil {
+			return nil, fmt.Errorf("unable to serialize the alert: %w", err)
+		}
+	}
+
+	isNew, err := e.Storage.AttachAnalyzeReportToGroup(ctx, report.ID, key, alert)
+	if err != nil {
+		return nil, fmt.Errorf("unable to attach report %d to group %s: %w", report.ID, key, err)
+	}
+	if isNew {
+		logger.FromCtx(ctx).Infof("new analyze report group %s: %#+v", key, signatures)
+		metrics.FromCtx(ctx).Count("analyzeReportGroupNew").Add(1)
+		if alert != nil {
+			// wake up Run, without blocking if it is already awake
+			select {
+			case e.newGroup <- struct{}{}:
+			default:
+			}
+		}
+	}
+
+	report.GroupKey = &key
+	return &key, nil
+}
+
+// Run delivers pending alerts until `ctx` is cancelled. It is woken up
+// by Process on each new group, and retries failed deliveries
+// each `retryInterval`.
+func (e *Engine) Run(ctx context.Context, retryInterval time.Duration) {
+	if e.Notifier == nil {
+		return
+	}
+
+	ticker := time.NewTicker(retryInterval)
+	defer ticker.Stop()
+	for {
+		if err := e.DeliverAlerts(ctx); err != nil {
+			logger.FromCtx(ctx).Errorf("unable to deliver alerts about new analyze report groups: %v", err)
+		}
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		case <-e.newGroup:
+		}
+	}
+}
+
+// DeliverAlerts sends pending alerts through the Notifier and marks them as delivered.
+//
+// An alert may be delivered more than once if marking it as delivered fails.
+func (e *Engine) DeliverAlerts(ctx context.Context) error {
+	if e.Notifier == nil {
+		return nil
+	}
+
+	for {
+		groups, err := e.Storage.FindPendingAnalyzeReportGroupAlerts(ctx, alertsBatchSize)
+		if err != nil {
+			return fmt.Errorf("unable to find pending alerts: %w", err)
+		}
+
+		delivered := 0
+		for _, group := range groups {
+			if err := e.deliverAlert(ctx, group); err != nil {
+				logger.FromCtx(ctx).Errorf("unable to deliver the alert about analyze report group %s: %v", group.GroupKey, err)
+				metrics.FromCtx(ctx).Count("analyzeReportGroupAlertFailed").Add(1)
+				continue
+			}
+			metrics.FromCtx(ctx).Count("analyzeReportGroupAlertDelivered").Add(1)
+			delivered++
+		}
+
+		// a full batch with at least one delivered alert means there might be more alerts;
+		// otherwise the rest is left for the next retry
+		if len(groups) < alertsBatchSize || delivered == 0 {
+			return nil
+		}
+	}
+}
+
+func (e *Engine) deliverAlert(ctx context.Context, group models.AnalyzeReportGroup) error {
+	var alert Alert
+	if err := json.Unmarshal([]byte(group.Alert.String), &alert); err != nil {
+		return fmt.Errorf("unable to parse the alert: %w", err)
+	}
+	if err := e.Notifier.Notify(ctx, alert); err != nil {
+		return fmt.Errorf("unable to notify: %w", err)
+	}
+	if err := e.Storage.SetAnalyzeReportGroupAlertDelivered(ctx, group.GroupKey, time.Now()); err != nil {
+		return fmt.Errorf("unable to mark the alert as delivered: %w", err)
+	}
+	return nil
+}
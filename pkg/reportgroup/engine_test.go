@@ -0,0 +1,207 @@
+// Copyright 2023 Meta Platforms, Inc. and affiliates.
+//
+// Redistribution and use in source and binary forms, with or without modification, are permitted provided that the following conditions are met:
+//
+// 1. Redistributions of source code must retain the above copyright notice, this list of conditions and the following disclaimer.
+//
+// 2. Redistributions in binary form must reproduce the above copyright notice, this list of conditions and the following disclaimer in the documentation and/or other materials provided with the distribution.
+//
+// 3. Neither the name of the copyright holder nor the names of its contributors may be used to endorse or promote products derived from this software without specific prior written permission.
+//
+// THIS SOFTWARE IS PROVIDED BY THE COPYRIGHT HOLDERS AND CONTRIBUTORS "AS IS" AND ANY EXPRESS OR IMPLIED WARRANTIES, INCLUDING, BUT NOT LIMITED TO, THE IMPLIED WARRANTIES OF MERCHANTABILITY AND FITNESS FOR A PARTICULAR PURPOSE ARE DISCLAIMED. IN NO EVENT SHALL THE COPYRIGHT HOLDER OR CONTRIBUTORS BE LIABLE FOR ANY DIRECT, INDIRECT, INCIDENTAL, SPECIAL, EXEMPLARY, OR CONSEQUENTIAL DAMAGES (INCLUDING, BUT NOT LIMITED TO, PROCUREMENT OF SUBSTITUTE GOODS OR SERVICES; LOSS OF USE, DATA, OR PROFITS; OR BUSINESS INTERRUPTION) HOWEVER CAUSED AND ON ANY THEORY OF LIABILITY, WHETHER IN CONTRACT, STRICT LIABILITY, OR TORT (INCLUDING NEGLIGENCE OR OTHERWISE) ARISING IN ANY WAY OUT OF THE USE OF THIS SOFTWARE, EVEN IF ADVISED OF THE POSSIBILITY OF SUCH DAMAGE.
+package reportgroup
+
+import (
+	"bufio"
+	"context"
+	"database/sql"
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/immune-gmbh/AttestationFailureAnalysisService/pkg/analysis"
+	"github.com/immune-gmbh/AttestationFailureAnalysisService/pkg/analyzers/diffmeasuredboot/report/generated/diffanalysis"
+	"github.com/immune-gmbh/AttestationFailureAnalysisService/pkg/dmidecode"
+	"github.com/immune-gmbh/AttestationFailureAnalysisService/pkg/storage/models"
+	"github.com/immune-gmbh/AttestationFailureAnalysisService/pkg/types"
+)
+
+type fakeStorage struct {
+	groups  map[models.AnalyzeReportGroupKey]*models.AnalyzeReportGroup
+	reports map[uint64]models.AnalyzeReportGroupKey
+	order   []models.AnalyzeReportGroupKey
+}
+
+func newFakeStorage() *fakeStorage {
+	return &fakeStorage{
+		groups:  map[models.AnalyzeReportGroupKey]*models.AnalyzeReportGroup{},
+		reports: map[uint64]models.AnalyzeReportGroupKey{},
+	}
+}
+
+func (stor *fakeStorage) AttachAnalyzeReportToGroup(
+	ctx context.Context,
+	analyzeReportID uint64,
+	key models.AnalyzeReportGroupKey,
+	alert []byte,
+) (bool, error) {
+	stor.reports[analyzeReportID] = key
+	if _, ok := stor.groups[key]; ok {
+		return false, nil
+	}
+	stor.groups[key] = &models.AnalyzeReportGroup{
+		GroupKey: key,
+		Alert:    sql.NullString{String: string(alert), Valid: len(alert) > 0},
+	}
+	stor.order = append(stor.order, key)
+	return true, nil
+}
+
+func (stor *fakeStorage) FindPendingAnalyzeReportGroupAlerts(ctx context.Context, limit uint) ([]models.AnalyzeReportGroup, error) {
+	var result []models.AnalyzeReportGroup
+	for _, key := range stor.order {
+		group := stor.groups[key]
+		if !group.Alert.Valid || group.AlertDeliveredAt.Valid {
+			continue
+		}
+		result = append(result, *group)
+		if limit != 0 && uint(len(result)) >= limit {
+			break
+		}
+	}
+	return result, nil
+}
+
+func (stor *fakeStorage) SetAnalyzeReportGroupAlertDelivered(ctx context.Context, key models.AnalyzeReportGroupKey, deliveredAt time.Time) error {
+	stor.groups[key].AlertDeliveredAt = sql.NullTime{Time: deliveredAt, Valid: true}
+	return nil
+}
+
+type failingNotifier struct {
+	Notifier
+	failures int
+}
+
+func (n *failingNotifier) Notify(ctx context.Context, alert Alert) error {
+	if n.failures > 0 {
+		n.failures--
+		return fmt.Errorf("unit-test error")
+	}
+	return n.Notifier.Notify(ctx, alert)
+}
+
+func readAlerts(t *testing.T, path string) []Alert {
+	f, err := os.Open(path)
+	if os.IsNotExist(err) {
+		return nil
+	}
+	require.NoError(t, err)
+	defer f.Close()
+	var alerts []Alert
+	scanner := bufio.NewScanner(f)
+	for scanner.Scan() {
+		var alert Alert
+		require.NoError(t, json.Unmarshal(scanner.Bytes(), &alert))
+		alerts = append(alerts, alert)
+	}
+	require.NoError(t, scanner.Err())
+	return alerts
+}
+
+func newReport(id uint64, diagnosis diffanalysis.DiffDiagnosis, biosVersion string, offsets ...int64) *models.AnalyzeReport {
+	custom := diffanalysis.CustomReport{Diagnosis: diagnosis}
+	for _, offset := range offsets {
+		custom.DiffEntries = append(custom.DiffEntries, &diffanalysis.DiffEntry{
+			Range: &diffanalysis.Range_{Offset: offset, Length: 0x10},
+		})
+	}
+	return &models.AnalyzeReport{
+		ID:    id,
+		JobID: types.NewJobID(),
+		AnalyzerReports: []models.AnalyzerReport{{
+			AnalyzerID:    diffanalysis.DiffMeasuredBootAnalyzerID,
+			DiagnosisCode: sql.NullString{String: diagnosis.String(), Valid: true},
+			Input: analysis.Input{
+				"ActualBIOSInfo": analysis.NewActualBIOSInfo(dmidecode.BIOSInfo{Version: biosVersion}),
+			},
+			Report: &analysis.Report{Custom: custom},
+		}},
+	}
+}
+
+func TestEngine(t *testing.T) {
+	ctx := context.Background()
+	alertsPath := filepath.Join(t.TempDir(), "alerts.jsonl")
+	stor := newFakeStorage()
+	notifier := &failingNotifier{Notifier: NewFileNotifier(alertsPath), failures: 1}
+	engine := NewEngine(stor, notifier)
+
+	// the same problem on many hosts
+	for id := uint64(1); id <= 10; id++ {
+		key, err := engine.Process(ctx, newReport(id, diffanalysis.DiffDiagnosis_SuspiciousDamage, "1.0", 0x100, 0x200))
+		require.NoError(t, err)
+		require.NotNil(t, key)
+	}
+	// another firmware version
+	_, err := engine.Process(ctx, newReport(11, diffanalysis.DiffDiagnosis_SuspiciousDamage, "1.1", 0x100, 0x200))
+	require.NoError(t, err)
+	// another affected range
+	_, err = engine.Process(ctx, newReport(12, diffanalysis.DiffDiagnosis_SuspiciousDamage, "1.0", 0x300))
+	require.NoError(t, err)
+	// no problem
+	key, err := engine.Process(ctx, newReport(13, diffanalysis.DiffDiagnosis_Match, "1.0"))
+	require.NoError(t, err)
+	require.Nil(t, key)
+
+	require.Len(t, stor.groups, 3)
+	require.Len(t, stor.reports, 12)
+	require.Equal(t, stor.reports[1], stor.reports[10])
+	require.NotEqual(t, stor.reports[1], stor.reports[11])
+	require.NotEqual(t, stor.reports[1], stor.reports[12])
+
+	// alerts are only stored by Process, and are delivered separately
+	require.Empty(t, readAlerts(t, alertsPath))
+
+	// the first delivery fails, and it is retried on the next call
+	require.NoError(t, engine.DeliverAlerts(ctx))
+	require.Len(t, readAlerts(t, alertsPath), 2)
+	require.NoError(t, engine.DeliverAlerts(ctx))
+	require.NoError(t, engine.DeliverAlerts(ctx))
+
+	alerts := readAlerts(t, alertsPath)
+	require.Len(t, alerts, 3)
+	for _, group := range stor.groups {
+		require.True(t, group.AlertDeliveredAt.Valid)
+	}
+	require.Equal(t, stor.reports[1], alerts[2].GroupKey)
+	require.Equal(t, []string{"0x100:0x10", "0x200:0x10"}, alerts[2].Signatures[0].AffectedEntries)
+	require.Equal(t, "1.0", alerts[2].Signatures[0].FirmwareVersion)
+}
+
+func TestEngineRun(t *testing.T) {
+	ctx, cancelFn := context.WithCancel(context.Background())
+	defer cancelFn()
+	alertsPath := filepath.Join(t.TempDir(), "alerts.jsonl")
+	stor := newFakeStorage()
+	engine := NewEngine(stor, NewFileNotifier(alertsPath))
+
+	_, err := engine.Process(ctx, newReport(1, diffanalysis.DiffDiagnosis_SuspiciousDamage, "1.0", 0x100))
+	require.NoError(t, err)
+
+	done := make(chan struct{})
+	go func() {
+		defer close(done)
+		engine.Run(ctx, time.Hour)
+	}()
+
+	require.Eventually(t, func() bool {
+		return len(readAlerts(t, alertsPath)) == 1
+	}, 10*time.Second, 10*time.Millisecond)
+	cancelFn()
+	<-done
+}
@@ -0,0 +1,136 @@
+// Copyright 2023 Meta Platforms, Inc. and affiliates.
+//
+// Redistribution and use in source and binary forms, with or without modification, are permitted provided that the following conditions are met:
+//
+// 1. Redistributions of source code must retain the above copyright notice, this list of conditions and the following disclaimer.
+//
+// 2. Redistributions in binary form must reproduce the above copyright notice, this list of conditions and the following disclaimer in the documentation and/or other materials provided with the distribution.
+//
+// 3. Neither the name of the copyright holder nor the names of its contributors may be used to endorse or promote products derived from this software without specific prior written permission.
+//
+// THIS SOFTWARE IS PROVIDED BY THE COPYRIGHT HOLDERS AND CONTRIBUTORS "AS IS" AND ANY EXPRESS OR IMPLIED WARRANTIES, INCLUDING, BUT NOT LIMITED TO, THE IMPLIED WARRANTIES OF MERCHANTABILITY AND FITNESS FOR A PARTICULAR PURPOSE ARE DISCLAIMED. IN NO EVENT SHALL THE COPYRIGHT HOLDER OR CONTRIBUTORS BE LIABLE FOR ANY DIRECT, INDIRECT, INCIDENTAL, SPECIAL, EXEMPLARY, OR CONSEQUENTIAL DAMAGES (INCLUDING, BUT NOT LIMITED TO, PROCUREMENT OF SUBSTITUTE GOODS OR SERVICES; LOSS OF USE, DATA, OR PROFITS; OR BUSINESS INTERRUPTION) HOWEVER CAUSED AND ON ANY THEORY OF LIABILITY, WHETHER IN CONTRACT, STRICT LIABILITY, OR TORT (INCLUDING NEGLIGENCE OR OTHERWISE) ARISING IN ANY WAY OUT OF THE USE OF THIS SOFTWARE, EVEN IF ADVISED OF THE POSSIBILITY OF SUCH DAMAGE.
+package reportgroup
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"os"
+	"sync"
+	"time"
+
+	"github.com/immune-gmbh/AttestationFailureAnalysisService/pkg/storage/models"
+	"github.com/immune-gmbh/AttestationFailureAnalysisService/pkg/types"
+)
+
+// Alert is the notification about a new group of analyze reports.
+type Alert struct {
+	GroupKey   models.AnalyzeReportGroupKey `json:"group_key"`
+	Signatures []Signature                  `json:"signatures"`
+
+	// The first analyze report of the group:
+	JobID     types.JobID `json:"job_id"`
+	AssetID   *int64      `json:"asset_id,omitempty"`
+	ModelID   *int64      `json:"model_id,omitempty"`
+	Timestamp time.Time   `json:"timestamp"`
+}
+
+// Notifier is a destination of alerts about new groups of analyze reports.
+type Notifier interface {
+	// Notify is called once per new group, after the group is stored.
+	// If an error is returned, then Notify is retried later with
+	// the same alert.
+	Notify(ctx context.Context, alert Alert) error
+}
+
+const (
+	// webhookTimeoutDefault is the default timeout of a webhook request.
+	webhookTimeoutDefault = 10 * time.Second
+)
+
+// WebhookNotifier is a Notifier, which sends alerts as JSON in HTTP POST requests.
+type WebhookNotifier struct {
+	URL     string
+	Headers http.Header
+	Client  *http.Client
+}
+
+var _ Notifier = (*WebhookNotifier)(nil)
+
+// NewWebhookNotifier returns a new instance of WebhookNotifier.
+func NewWebhookNotifier(url string, headers http.Header) *WebhookNotifier {
+	return &WebhookNotifier{
+		URL:     url,
+		Headers: headers,
+		Client:  &http.Client{Timeout: webhookTimeoutDefault},
+	}
+}
+
+// Notify implements Notifier.
+func (n *WebhookNotifier) Notify(ctx context.Context, alert Alert) error {
+	b, err := json.Marshal(alert)
+	if err != nil {
+		return fmt.Errorf("unable to serialize the alert: %w", err)
+	}
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, n.URL, bytes.NewReader(b))
+	if err != nil {
+		return fmt.Errorf("unable to make a request to '%s': %w", n.URL, err)
+	}
+	for k, v := range n.Headers {
+		req.Header[k] = v
+	}
+	req.Header.Set("Content-Type", "application/json")
+
+	client := n.Client
+	if client == nil {
+		client = &http.Client{Timeout: webhookTimeoutDefault}
+	}
+	resp, err := client.Do(req)
+	if err != nil {
+		return fmt.Errorf("unable to send the alert to '%s': %w", n.URL, err)
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
+		return fmt.Errorf("unable to send the alert to '%s': invalid status code: %d", n.URL, resp.StatusCode)
+	}
+	return nil
+}
+
+// FileNotifier is a Notifier, which appends alerts to a file, one JSON per line.
+// It is mostly useful for tests and local setups.
+type FileNotifier struct {
+	Path string
+
+	locker sync.Mutex
+}
+
+var _ Notifier = (*FileNotifier)(nil)
+
+// NewFileNotifier returns a new instance of FileNotifier.
+func NewFileNotifier(path string) *FileNotifier {
+	return &FileNotifier{
+		Path: path,
+	}
+}
+
+// Notify implements Notifier.
+func (n *FileNotifier) Notify(ctx context.Context, alert Alert) error {
+	b, err := json.Marshal(alert)
+	if err != nil {
+		return fmt.Errorf("unable to serialize the alert: %w", err)
+	}
+
+	n.locker.Lock()
+	defer n.locker.Unlock()
+	f, err := os.OpenFile(n.Path, os.O_APPEND|os.O_CREATE|os.O_WRONLY, 0640)
+	if err != nil {
+		return fmt.Errorf("unable to open file '%s': %w", n.Path, err)
+	}
+	if _, err := f.Write(append(b, '\n')); err != nil {
+		f.Close()
+		return fmt.Errorf("unable to write to file '%s': %w", n.Path, err)
+	}
+	return f.Close()
+}
@@ -0,0 +1,139 @@
+// Copyright 2023 Meta Platforms, Inc. and affiliates.
+//
+// Redistribution and use in source and binary forms, with or without modification, are permitted provided that the following conditions are met:
+//
+// 1. Redistributions of source code must retain the above copyright notice, this list of conditions and the following disclaimer.
+//
+// 2. Redistributions in binary form must reproduce the above copyright notice, this list of conditions and the following disclaimer in the documentation and/or other materials provided with the distribution.
+//
+// 3. Neither the name of the copyright holder nor the names of its contributors may be used to endorse or promote products derived from this software without specific prior written permission.
+//
+// THIS SOFTWARE IS PROVIDED BY THE COPYRIGHT HOLDERS AND CONTRIBUTORS "AS IS" AND ANY EXPRESS OR IMPLIED WARRANTIES, INCLUDING, BUT NOT LIMITED TO, THE IMPLIED WARRANTIES OF MERCHANTABILITY AND FITNESS FOR A PARTICULAR PURPOSE ARE DISCLAIMED. IN NO EVENT SHALL THE COPYRIGHT HOLDER OR CONTRIBUTORS BE LIABLE FOR ANY DIRECT, INDIRECT, INCIDENTAL, SPECIAL, EXEMPLARY, OR CONSEQUENTIAL DAMAGES (INCLUDING, BUT NOT LIMITED TO, PROCUREMENT OF SUBSTITUTE GOODS OR SERVICES; LOSS OF USE, DATA, OR PROFITS; OR BUSINESS INTERRUPTION) HOWEVER CAUSED AND ON ANY THEORY OF LIABILITY, WHETHER IN CONTRACT, STRICT LIABILITY, OR TORT (INCLUDING NEGLIGENCE OR OTHERWISE) ARISING IN ANY WAY OUT OF THE USE OF THIS SOFTWARE, EVEN IF ADVISED OF THE POSSIBILITY OF SUCH DAMAGE.
+package reportgroup
+
+import (
+	"encoding/json"
+	"fmt"
+	"sort"
+
+	"github.com/immune-gmbh/AttestationFailureAnalysisService/pkg/analysis"
+	"github.com/immune-gmbh/AttestationFailureAnalysisService/pkg/analyzers/diffmeasuredboot/report/generated/diffanalysis"
+	"github.com/immune-gmbh/AttestationFailureAnalysisService/pkg/storage/models"
+	"github.com/immune-gmbh/AttestationFailureAnalysisService/pkg/types"
+)
+
+// Signature is the part of an analyzer report, which is expected to be
+// the same for all hosts affected by the same problem.
+type Signature struct {
+	AnalyzerID analysis.AnalyzerID `json:"analyzer_id"`
+	Diagnosis  string              `json:"diagnosis,omitempty"`
+
+	// AffectedEntries are the firmware entries (or ranges, if the entries
+	// are unknown) which differ from the original firmware.
+	AffectedEntries []string `json:"affected_entries,omitempty"`
+
+	// FirmwareVersion is the version of the actual firmware (or of the
+	// original one, if the actual version is unknown).
+	FirmwareVersion string `json:"firmware_version,omitempty"`
+}
+
+// Signatures returns signatures of the analyzer reports which found a problem.
+//
+// The result is sorted, so it is the same for the same set of problems
+// independently of the order of the analyzers.
+func Signatures(report *models.AnalyzeReport) []Signature {
+	var result []Signature
+	for _, analyzerReport := range report.AnalyzerReports {
+		if !isProblem(analyzerReport) {
+			continue
+		}
+		result = append(result, Signature{
+			AnalyzerID:      analyzerReport.AnalyzerID,
+			Diagnosis:       analyzerReport.DiagnosisCode.String,
+			AffectedEntries: affectedEntries(analyzerReport.Report),
+			FirmwareVersion: firmwareVersion(analyzerReport.Input),
+		})
+	}
+	sort.Slice(result, func(i, j int) bool {
+		if result[i].AnalyzerID != result[j].AnalyzerID {
+			return result[i].AnalyzerID < result[j].AnalyzerID
+		}
+		return result[i].Diagnosis < result[j].Diagnosis
+	})
+	return result
+}
+
+// Key returns the group key for the given signatures.
+func Key(signatures []Signature) (models.AnalyzeReportGroupKey, error) {
+	b, err := json.Marshal(signatures)
+	if err != nil {
+		return models.AnalyzeReportGroupKey{}, fmt.Errorf("unable to serialize signatures: %w", err)
+	}
+	return types.NewImageIDFromImage(b), nil
+}
+
+func isProblem(analyzerReport models.AnalyzerReport) bool {
+	if analyzerReport.Report == nil {
+		// Execution errors are problems of the analysis, not of the host.
+		return false
+	}
+	if analyzerReport.DiagnosisCode.Valid {
+		return analyzerReport.DiagnosisCode.String != diffanalysis.DiffDiagnosis_Match.String()
+	}
+	return len(analyzerReport.Report.Issues) > 0
+}
+
+func affectedEntries(report *analysis.Report) []string {
+	var custom *diffanalysis.CustomReport
+	switch c := report.Custom.(type) {
+	case diffanalysis.CustomReport:
+		custom = &c
+	case *diffanalysis.CustomReport:
+		custom = c
+	default:
+		return nil
+	}
+
+	m := map[string]struct{}{}
+	for _, entry := range custom.GetDiffEntries() {
+		if entry.GetInUnstableRange() {
+			continue
+		}
+		if len(entry.GetNodes()) == 0 {
+			r := entry.GetRange()
+			m[fmt.Sprintf("0x%X:0x%X", r.GetOffset(), r.GetLength())] = struct{}{}
+			continue
+		}
+		for _, node := range entry.GetNodes() {
+			m[node.GetUUID()] = struct{}{}
+		}
+	}
+
+	result := make([]string, 0, len(m))
+	for entry := range m {
+		result = append(result, entry)
+	}
+	sort.Strings(result)
+	return result
+}
+
+func firmwareVersion(input analysis.Input) string {
+	var originalVersion string
+	for _, value := range input {
+		switch v := value.(type) {
+		case analysis.ActualBIOSInfo:
+			return v.Version
+		case *analysis.ActualBIOSInfo:
+			if v != nil {
+				return v.Version
+			}
+		case analysis.OriginalBIOSInfo:
+			originalVersion = v.Version
+		case *analysis.OriginalBIOSInfo:
+			if v != nil {
+				originalVersion = v.Version
+			}
+		}
+	}
+	return originalVersion
+}
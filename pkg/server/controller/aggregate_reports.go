@@ -0,0 +1,117 @@
+// Copyright 2023 Meta Platforms, Inc. and affiliates.
+//
+// Redistribution and use in source and binary forms, with or without modification, are permitted provided that the following conditions are met:
+//
+// 1. Redistributions of source code must retain the above copyright notice, this list of conditions and the following disclaimer.
+//
+// 2. Redistributions in binary form must reproduce the above copyright notice, this list of conditions and the following disclaimer in the documentation and/or other materials provided with the distribution.
+//
+// 3. Neither the name of the copyright holder nor the names of its contributors may be used to endorse or promote products derived from this software without specific prior written permission.
+//
+// THIS SOFTWARE IS PROVIDED BY THE COPYRIGHT HOLDERS AND CONTRIBUTORS "AS IS" AND ANY EXPRESS OR IMPLIED WARRANTIES, INCLUDING, BUT NOT LIMITED TO, THE IMPLIED WARRANTIES OF MERCHANTABILITY AND FITNESS FOR A PARTICULAR PURPOSE ARE DISCLAIMED. IN NO EVENT SHALL THE COPYRIGHT HOLDER OR CONTRIBUTORS BE LIABLE FOR ANY DIRECT, INDIRECT, INCIDENTAL, SPECIAL, EXEMPLARY, OR CONSEQUENTIAL DAMAGES (INCLUDING, BUT NOT LIMITED TO, PROCUREMENT OF SUBSTITUTE GOODS OR SERVICES; LOSS OF USE, DATA, OR PROFITS; OR BUSINESS INTERRUPTION) HOWEVER CAUSED AND ON ANY THEORY OF LIABILITY, WHETHER IN CONTRACT, STRICT LIABILITY, OR TORT (INCLUDING NEGLIGENCE OR OTHERWISE) ARISING IN ANY WAY OUT OF THE USE OF THIS SOFTWARE, EVEN IF ADVISED OF THE POSSIBILITY OF SUCH DAMAGE.
+package controller
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/facebookincubator/go-belt/tool/experimental/metrics"
+	"github.com/facebookincubator/go-belt/tool/experimental/tracer"
+
+	"github.com/immune-gmbh/AttestationFailureAnalysisService/if/generated/afas"
+	"github.com/immune-gmbh/AttestationFailureAnalysisService/pkg/storage"
+)
+
+// AggregateReports returns the amount of analyze reports (and distinct hosts)
+// grouped by the requested dimensions and optionally by time intervals.
+func (ctrl *Controller) AggregateReports(
+	ctx context.Context,
+	request *afas.AggregateReportsRequest,
+) (*afas.AggregateReportsResult_, error) {
+	span, ctx := tracer.StartChildSpanFromCtx(ctx, "AggregateReports")
+	defer span.Finish()
+
+	q, err := aggregateReportsQueryFromThrift(request)
+	if err != nil {
+		return nil, ErrInvalidRequest{Err: err}
+	}
+
+	groups, err := ctrl.FirmwareStorage.AggregateReports(ctx, q)
+	if err != nil {
+		if errors.As(err, &storage.ErrInvalidAggregateQuery{}) {
+			return nil, ErrInvalidRequest{Err: err}
+		}
+		return nil, fmt.Errorf("unable to aggregate reports: %w", err)
+	}
+	metrics.FromCtx(ctx).Count("aggregateReportsGroups").Add(uint64(len(groups)))
+
+	result := &afas.AggregateReportsResult_{
+		Groups: make([]*afas.AggregateReportsGroup, 0, len(groups)),
+	}
+	for _, group := range groups {
+		thriftGroup := &afas.AggregateReportsGroup{
+			Values:  make([]string, 0, len(group.Values)),
+			Reports: int64(group.Reports),
+			Hosts:   int64(group.Hosts),
+		}
+		for _, value := range group.Values {
+			thriftGroup.Values = append(thriftGroup.Values, value.String)
+		}
+		if q.Bucket != 0 {
+			bucketStart := group.BucketStart.Unix()
+			thriftGroup.BucketStart = &bucketStart
+		}
+		result.Groups = append(result.Groups, thriftGroup)
+	}
+	return result, nil
+}
+
+func aggregateReportsQueryFromThrift(request *afas.AggregateReportsRequest) (storage.AggregateReportsQuery, error) {
+	q := storage.AggregateReportsQuery{
+		AnalyzerIDs:    request.GetAnalyzerNames(),
+		DiagnosisCodes: request.GetDiagnoses(),
+		ModelIDs:       request.GetModelIDs(),
+	}
+	for _, dim := range request.GetGroupBy() {
+		storageDim, err := aggregateDimensionFromThrift(dim)
+		if err != nil {
+			return storage.AggregateReportsQuery{}, err
+		}
+		q.GroupBy = append(q.GroupBy, storageDim)
+	}
+	if request.IsSetTimeFrom() {
+		q.From = time.Unix(request.GetTimeFrom(), 0)
+	}
+	if request.IsSetTimeTo() {
+		q.To = time.Unix(request.GetTimeTo(), 0)
+	}
+	if request.IsSetBucketSeconds() {
+		if request.GetBucketSeconds() <= 0 {
+			return storage.AggregateReportsQuery{}, fmt.Errorf("bucket size should be positive, but is %d", request.GetBucketSeconds())
+		}
+		q.Bucket = time.Duration(request.GetBucketSeconds()) * time.Second
+	}
+	return q, nil
+}
+
+func aggregateDimensionFromThrift(dim afas.AggregateDimension) (storage.AggregateDimension, error) {
+	switch dim {
+	case afas.AggregateDimension_Analyzer:
+		return storage.AggregateDimensionAnalyzer, nil
+	case afas.AggregateDimension_Diagnosis:
+		return storage.AggregateDimensionDiagnosis, nil
+	case afas.AggregateDimension_IssueSeverity:
+		return storage.AggregateDimensionIssueSeverity, nil
+	case afas.AggregateDimension_IssueDescription:
+		return storage.AggregateDimensionIssueDescription, nil
+	case afas.AggregateDimension_ActualFirmwareVersion:
+		return storage.AggregateDimensionActualFirmwareVersion, nil
+	case afas.AggregateDimension_OriginalFirmwareVersion:
+		return storage.AggregateDimensionOriginalFirmwareVersion, nil
+	case afas.AggregateDimension_ModelID:
+		return storage.AggregateDimensionModelID, nil
+	}
+	return storage.AggregateDimensionUndefined, fmt.Errorf("unknown dimension: %v", dim)
+}
@@ -13,12 +13,15 @@ package controller
 
 import (
 	"context"
+	"database/sql"
 	"fmt"
 	"sync"
 	"time"
 
 	"github.com/facebookincubator/go-belt/beltctx"
+	"github.com/facebookincubator/go-belt/pkg/field"
 	"github.com/facebookincubator/go-belt/tool/experimental/errmon"
+	"github.com/facebookincubator/go-belt/tool/experimental/metrics"
 	"github.com/facebookincubator/go-belt/tool/experimental/tracer"
 	"github.com/facebookincubator/go-belt/tool/logger"
 
@@ -32,6 +35,8 @@ import (
 	"github.com/immune-gmbh/AttestationFailureAnalysisService/pkg/analyzers/diffmeasuredboot"
 	"github.com/immune-gmbh/AttestationFailureAnalysisService/pkg/analyzers/intelacm"
 	"github.com/immune-gmbh/AttestationFailureAnalysisService/pkg/analyzers/reproducepcr"
+	"github.com/immune-gmbh/AttestationFailureAnalysisService/pkg/evidence"
+	"github.com/immune-gmbh/AttestationFailureAnalysisService/pkg/observability"
 	"github.com/immune-gmbh/AttestationFailureAnalysisService/pkg/server/controller/analyzerinput"
 	controllererrors "github.com/immune-gmbh/AttestationFailureAnalysisService/pkg/server/controller/errors"
 	"github.com/immune-gmbh/AttestationFailureAnalysisService/pkg/storage/models"
@@ -49,6 +54,10 @@ func (ctrl *Controller) Analyze(
 	ctx = beltctx.WithField(ctx, "jobID", jobID)
 	log := logger.FromCtx(ctx)
 
+	if err := ctrl.reconstructDeltaImages(ctx, artifacts); err != nil {
+		return nil, err
+	}
+
 	report, err := ctrl.getAnalyzeReport(ctx, jobID, hostInfo, artifacts, analyzers)
 	if err != nil {
 		return nil, fmt.Errorf("unable to get the analyze report: %w", err)
@@ -62,14 +71,39 @@ func (ctrl *Controller) Analyze(
 		defer span.Finish()
 		if err := ctrl.FirmwareStorage.InsertAnalyzeReport(ctx, report); err != nil {
 			log.Errorf("unable to save the report: %v", err)
-		} else {
-			log.Debugf("successfully saved the AnalyzeReport: %#+v", report)
+			return
+		}
+		log.Debugf("successfully saved the AnalyzeReport: %#+v", report)
+		ctrl.insertReportIssuesAsync(ctx, report.AnalyzerReports)
+		if ctrl.ReportGrouper != nil {
+			if _, err := ctrl.ReportGrouper.Process(ctx, report); err != nil {
+				log.Errorf("unable to group the report: %v", err)
+			}
 		}
 	}()
 
 	return typeconv.ToThriftAnalyzeReport(report), nil
 }
 
+// insertReportIssuesAsync saves the issues of the stored analyzer reports
+// in the background, so the issues do not slow down the Analyze request.
+func (ctrl *Controller) insertReportIssuesAsync(
+	ctx context.Context,
+	reports []models.AnalyzerReport,
+) {
+	// The job should outlive the request, but should have the same logger/tracer/etc.
+	ctx = beltctx.WithBelt(ctrl.Context, beltctx.Belt(ctx))
+	reports = append([]models.AnalyzerReport{}, reports...)
+	err := ctrl.launchAsync(ctx, func(ctx context.Context) {
+		if err := ctrl.FirmwareStorage.InsertReportIssues(ctx, reports); err != nil {
+			logger.FromCtx(ctx).Errorf("unable to save the issues of the report: %v", err)
+		}
+	})
+	if err != nil {
+		logger.FromCtx(ctx).Errorf("unable to launch saving the issues of the report: %v", err)
+	}
+}
+
 func (ctrl *Controller) getAnalyzeReport(
 	ctx context.Context,
 	jobID types.JobID,
@@ -80,7 +114,10 @@ func (ctrl *Controller) getAnalyzeReport(
 	span, ctx := tracer.StartChildSpanFromCtx(ctx, "getAnalyzeReport")
 	defer span.Finish()
 
-	hostInfo, _ := ctrl.getHostInfo(ctx, _hostInfo)
+	if _hostInfo == nil {
+		_hostInfo = hostInfoFromEvidenceBundles(ctx, artifacts)
+	}
+	hostInfo, hostDevice, isDeviceVerified := ctrl.getHostInfo(ctx, _hostInfo)
 
 	report := &models.AnalyzeReport{
 		Timestamp:       time.Now(),
@@ -89,6 +126,7 @@ func (ctrl *Controller) getAnalyzeReport(
 	}
 	if hostInfo != nil {
 		report.AssetID = hostInfo.AssetID
+		report.ModelID = hostInfo.ModelID
 	}
 	ctx = beltctx.WithField(ctx, "assetID", hostInfo.GetAssetID())
 	log := logger.FromCtx(ctx)
@@ -102,6 +140,8 @@ func (ctrl *Controller) getAnalyzeReport(
 		return nil, fmt.Errorf("failed to create artifacts accessor: %w", err)
 	}
 
+	tampering := newTamperingMatcher(ctx, ctrl.FirmwareStorage, hostInfo, hostDevice, isDeviceVerified)
+
 	// scopeCache helps to share all calculated results between all analyzers without putting restrictions of consuming identical set of artifacts
 	scopeCache := analysis.NewDataCache()
 	var (
@@ -129,32 +169,32 @@ func (ctrl *Controller) getAnalyzeReport(
 				span, ctx := tracer.StartChildSpanFromCtx(ctx, fmt.Sprintf("AnalyzerWithInput-%s", diffmeasuredboot.ID))
 				defer span.Finish()
 				analyzerInput, inputErr = analyzerinput.NewDiffMeasuredBootInput(ctx, artifactsAccessor, *analyzerThriftInput.GetDiffMeasuredBoot())
-				analyzerID, analyzerReport, analyzerErr = executeAnalyzer[diffmeasuredboot.Input](ctx, ctrl, hostInfo, scopeCache, analyzerInput, diffmeasuredboot.ID)
+				analyzerID, analyzerReport, analyzerErr = executeAnalyzer[diffmeasuredboot.Input](ctx, ctrl, hostInfo, tampering, scopeCache, analyzerInput, diffmeasuredboot.ID)
 			case analyzerThriftInput.IsSetReproducePCR():
 				span, ctx := tracer.StartChildSpanFromCtx(ctx, fmt.Sprintf("AnalyzerWithInput-%s", reproducepcr.ID))
 				defer span.Finish()
 				analyzerInput, inputErr = analyzerinput.NewReproducePCRInput(ctx, artifactsAccessor, *analyzerThriftInput.GetReproducePCR())
-				analyzerID, analyzerReport, analyzerErr = executeAnalyzer[reproducepcr.Input](ctx, ctrl, hostInfo, scopeCache, analyzerInput, reproducepcr.ID)
+				analyzerID, analyzerReport, analyzerErr = executeAnalyzer[reproducepcr.Input](ctx, ctrl, hostInfo, tampering, scopeCache, analyzerInput, reproducepcr.ID)
 			case analyzerThriftInput.IsSetIntelACM():
 				span, ctx := tracer.StartChildSpanFromCtx(ctx, fmt.Sprintf("AnalyzerWithInput-%s", intelacm.ID))
 				defer span.Finish()
 				analyzerInput, inputErr = analyzerinput.NewIntelACMInput(ctx, artifactsAccessor, *analyzerThriftInput.GetIntelACM())
-				analyzerID, analyzerReport, analyzerErr = executeAnalyzer[intelacm.Input](ctx, ctrl, hostInfo, scopeCache, analyzerInput, intelacm.ID)
+				analyzerID, analyzerReport, analyzerErr = executeAnalyzer[intelacm.Input](ctx, ctrl, hostInfo, tampering, scopeCache, analyzerInput, intelacm.ID)
 			case analyzerThriftInput.IsSetPSPSignature():
 				span, ctx := tracer.StartChildSpanFromCtx(ctx, fmt.Sprintf("AnalyzerWithInput-%s", pspsignature.ID))
 				defer span.Finish()
 				analyzerInput, inputErr = analyzerinput.NewPSPSignatureInput(ctx, artifactsAccessor, *analyzerThriftInput.GetPSPSignature())
-				analyzerID, analyzerReport, analyzerErr = executeAnalyzer[pspsignature.Input](ctx, ctrl, hostInfo, scopeCache, analyzerInput, pspsignature.ID)
+				analyzerID, analyzerReport, analyzerErr = executeAnalyzer[pspsignature.Input](ctx, ctrl, hostInfo, tampering, scopeCache, analyzerInput, pspsignature.ID)
 			case analyzerThriftInput.IsSetBIOSRTMVolume():
 				span, ctx := tracer.StartChildSpanFromCtx(ctx, fmt.Sprintf("AnalyzerWithInput-%s", biosrtmvolume.ID))
 				defer span.Finish()
 				analyzerInput, inputErr = analyzerinput.NewBIOSRTMVolumeInput(ctx, artifactsAccessor, *analyzerThriftInput.GetBIOSRTMVolume())
-				analyzerID, analyzerReport, analyzerErr = executeAnalyzer[biosrtmvolume.Input](ctx, ctrl, hostInfo, scopeCache, analyzerInput, biosrtmvolume.ID)
+				analyzerID, analyzerReport, analyzerErr = executeAnalyzer[biosrtmvolume.Input](ctx, ctrl, hostInfo, tampering, scopeCache, analyzerInput, biosrtmvolume.ID)
 			case analyzerThriftInput.IsSetAPCBSecurityTokens():
 				span, ctx := tracer.StartChildSpanFromCtx(ctx, fmt.Sprintf("AnalyzerWithInput-%s", apcbsectokens.ID))
 				defer span.Finish()
 				analyzerInput, inputErr = analyzerinput.NewAPCBSecurityTokensInput(ctx, artifactsAccessor, *analyzerThriftInput.GetAPCBSecurityTokens())
-				analyzerID, analyzerReport, analyzerErr = executeAnalyzer[apcbsectokens.Input](ctx, ctrl, hostInfo, scopeCache, analyzerInput, apcbsectokens.ID)
+				analyzerID, analyzerReport, analyzerErr = executeAnalyzer[apcbsectokens.Input](ctx, ctrl, hostInfo, tampering, scopeCache, analyzerInput, apcbsectokens.ID)
 			default:
 				log.Errorf("Not supported analyzer: %s", &analyzerThriftInput)
 				resultMutex.Lock()
@@ -169,20 +209,26 @@ func (ctrl *Controller) getAnalyzeReport(
 				log.Errorf("Failed to construct input for analyzer: '%s': '%v'", analyzerID, inputErr)
 				analyzerErr = controllererrors.ErrInvalidInput{Err: inputErr}
 			}
+			var diagnosisCode sql.NullString
+			diagnosisCode.String, diagnosisCode.Valid = analyzers.DiagnosisCode(analyzerReport)
+			observeAnalyzerOutcome(ctx, analyzerID, analyzerErr, diagnosisCode.String)
 			resultMutex.Lock()
 			// Lock isn't really needed, because we assign values by aligned words and there could
 			// not be any problem with concurrency, but just for semantic cleanness keeping them.
 			report.AnalyzerReports[idx] = models.AnalyzerReport{
-				AnalyzerID: analyzerID,
-				Input:      analyzerInput,
-				Report:     analyzerReport,
-				ExecError:  models.SQLErrorWrapper{Err: analyzerErr},
+				AnalyzerID:      analyzerID,
+				AnalyzerVersion: ctrl.analyzersRegistry.Version(analyzerID),
+				Input:           analyzerInput,
+				Report:          analyzerReport,
+				ExecError:       models.SQLErrorWrapper{Err: analyzerErr},
+				DiagnosisCode:   diagnosisCode,
 			}
 			resultMutex.Unlock()
 		}(idx, analyzerThriftInput)
 	}
 	wg.Wait()
 
+	report.Verdict = ctrl.evaluatePolicy(ctx, policyHost(ctx, hostInfo, hostDevice, isDeviceVerified), report.AnalyzerReports)
 	return report, nil
 }
 
@@ -190,6 +236,7 @@ func executeAnalyzer[analyzerInputType any](
 	ctx context.Context,
 	ctrl *Controller,
 	hostInfo *afas.HostInfo,
+	tampering *tamperingMatcher,
 	scopeCache analysis.DataCache,
 	analyzerInput analysis.Input,
 	analyzerID analysis.AnalyzerID,
@@ -202,6 +249,13 @@ func executeAnalyzer[analyzerInputType any](
 		//       should know nothing about our infra (and should be opensourcable).
 		analyzerInput.AddAssetID(*hostInfo.AssetID)
 	}
+	knownTampering, err := tampering.KnownTampering(ctx, analyzerInput)
+	if err != nil {
+		logger.FromCtx(ctx).Errorf("unable to consult the tampering registry: %v", err)
+	}
+	if knownTampering != nil {
+		analyzerInput.AddKnownTampering(*knownTampering)
+	}
 
 	analyzer := analyzers.Get[analyzerInputType](ctrl.analyzersRegistry, analyzerID)
 	if analyzer == nil {
@@ -210,6 +264,43 @@ func executeAnalyzer[analyzerInputType any](
 
 	span, ctx := tracer.StartChildSpanFromCtx(ctx, fmt.Sprintf("Analyzer-%s", analyzerID))
 	defer span.Finish()
+	startTime := time.Now()
 	report, err := analysis.ExecuteAnalyzer(ctx, ctrl.analysisDataCalculator, analyzer, analyzerInput, scopeCache)
+	observability.ObserveDuration(ctx, "analyzerDurationSeconds", field.Fields{{Key: "analyzerID", Value: string(analyzerID)}}, time.Since(startTime))
+	knownTampering.ApplyTo(report)
 	return analyzerID, report, err
 }
+
+// observeAnalyzerOutcome counts the outcomes of analyzers by the error class
+// (see typeconv.AnalyzeExecErrorToClass) and the diagnosis.
+func observeAnalyzerOutcome(ctx context.Context, analyzerID analysis.AnalyzerID, err error, diagnosis string) {
+	errorClass := "None"
+	if err != nil {
+		errorClass = typeconv.AnalyzeExecErrorToClass(err).String()
+	}
+	metrics.FromCtx(ctx).CountFields("analyzerOutcomes", field.Fields{
+		{Key: "analyzerID", Value: string(analyzerID)},
+		{Key: "errorClass", Value: errorClass},
+		{Key: "diagnosis", Value: diagnosis},
+	}).Add(1)
+}
+
+// hostInfoFromEvidenceBundles returns the host info stored in the first
+// evidence bundle artifact which has one. It is used if the request
+// has no host info.
+func hostInfoFromEvidenceBundles(ctx context.Context, artifacts []afas.Artifact) *afas.HostInfo {
+	for idx, artifact := range artifacts {
+		if !artifact.IsSetEvidenceBundle() {
+			continue
+		}
+		bundle, err := evidence.Read(artifact.GetEvidenceBundle())
+		if err != nil {
+			logger.FromCtx(ctx).Warnf("unable to read evidence bundle of artifact '%d': %v", idx, err)
+			continue
+		}
+		if bundle.HostInfo != nil {
+			return bundle.HostInfo
+		}
+	}
+	return nil
+}
@@ -0,0 +1,47 @@
+// Copyright 2023 Meta Platforms, Inc. and affiliates.
+//
+// Redistribution and use in source and binary forms, with or without modification, are permitted provided that the following conditions are met:
+//
+// 1. Redistributions of source code must retain the above copyright notice, this list of conditions and the following disclaimer.
+//
+// 2. Redistributions in binary form must reproduce the above copyright notice, this list of conditions and the following disclaimer in the documentation and/or other materials provided with the distribution.
+//
+// 3. Neither the name of the copyright holder nor the names of its contributors may be used to endorse or promote products derived from this software without specific prior written permission.
+//
+// THIS SOFTWARE IS PROVIDED BY THE COPYRIGHT HOLDERS AND CONTRIBUTORS "AS IS" AND ANY EXPRESS OR IMPLIED WARRANTIES, INCLUDING, BUT NOT LIMITED TO, THE IMPLIED WARRANTIES OF MERCHANTABILITY AND FITNESS FOR A PARTICULAR PURPOSE ARE DISCLAIMED. IN NO EVENT SHALL THE COPYRIGHT HOLDER OR CONTRIBUTORS BE LIABLE FOR ANY DIRECT, INDIRECT, INCIDENTAL, SPECIAL, EXEMPLARY, OR CONSEQUENTIAL DAMAGES (INCLUDING, BUT NOT LIMITED TO, PROCUREMENT OF SUBSTITUTE GOODS OR SERVICES; LOSS OF USE, DATA, OR PROFITS; OR BUSINESS INTERRUPTION) HOWEVER CAUSED AND ON ANY THEORY OF LIABILITY, WHETHER IN CONTRACT, STRICT LIABILITY, OR TORT (INCLUDING NEGLIGENCE OR OTHERWISE) ARISING IN ANY WAY OUT OF THE USE OF THIS SOFTWARE, EVEN IF ADVISED OF THE POSSIBILITY OF SUCH DAMAGE.
+package controller
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/immune-gmbh/AttestationFailureAnalysisService/pkg/analysis"
+	"github.com/immune-gmbh/AttestationFailureAnalysisService/pkg/storage/models"
+)
+
+func TestInsertReportIssuesAsync(t *testing.T) {
+	stor := newFakeStorage()
+	ctrl := &Controller{FirmwareStorage: stor}
+	ctrl.Context, ctrl.ContextCancel = context.WithCancel(context.Background())
+	defer ctrl.ContextCancel()
+
+	issues := []analysis.Issue{{Severity: analysis.SeverityCritical, Description: "unit-test"}}
+	report := &models.AnalyzeReport{
+		AnalyzerReports: []models.AnalyzerReport{
+			{AnalyzerID: "a", Report: &analysis.Report{Issues: issues}},
+			{AnalyzerID: "b"},
+		},
+	}
+	stor.addAnalyzeReport(report)
+
+	reqCtx, reqCancel := context.WithCancel(context.Background())
+	ctrl.insertReportIssuesAsync(reqCtx, report.AnalyzerReports)
+	// the issues are saved even if the request is already finished
+	reqCancel()
+	ctrl.activeGoroutinesWG.Wait()
+
+	require.Len(t, stor.issues, 1)
+	require.Equal(t, issues, stor.issues[report.AnalyzerReports[0].ID])
+}
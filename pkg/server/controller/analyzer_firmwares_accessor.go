@@ -13,6 +13,7 @@ package controller
 
 import (
 	"context"
+	"crypto/sha512"
 	"database/sql"
 	"fmt"
 	"sync"
@@ -20,6 +21,7 @@ import (
 
 	"github.com/facebookincubator/go-belt/tool/experimental/tracer"
 	"github.com/facebookincubator/go-belt/tool/logger"
+	pkgbytes "github.com/linuxboot/fiano/pkg/bytes"
 
 	"github.com/immune-gmbh/AttestationFailureAnalysisService/pkg/analysis"
 	"github.com/immune-gmbh/AttestationFailureAnalysisService/pkg/dmidecode"
@@ -295,6 +297,23 @@ func (a *AnalyzerFirmwaresAccessor) getWrapper(
 	return blob, nil
 }
 
+// sparseImageMeta returns the metadata of a partially dumped image.
+//
+// Both the ID and the hashes are calculated over the coverage and the image
+// together (see types.NewImageIDFromSparseImage), and the firmware version
+// and the stable hash are not set, so that the padding is never
+// mistaken for the content of a complete image by hash or version lookups.
+func sparseImageMeta(image []byte, coverage pkgbytes.Ranges) models.FirmwareImageMetadata {
+	imageID := types.NewImageIDFromSparseImage(image, coverage)
+	return models.FirmwareImageMetadata{
+		ImageID:        imageID,
+		Size:           uint64(len(image)),
+		TSAdd:          time.Now(),
+		HashSHA2_512:   types.HashValue(imageID[:sha512.Size]),
+		HashBlake3_512: types.HashValue(imageID[sha512.Size:]),
+	}
+}
+
 func biosInfoFromMeta(meta *models.FirmwareImageMetadata) *dmidecode.BIOSInfo {
 	if meta == nil {
 		return nil
@@ -325,6 +344,32 @@ func (a *AnalyzerFirmwaresAccessor) GetByBlob(ctx context.Context, image []byte)
 	}, "GetByBlob", meta.ImageID)
 }
 
+// GetBySparseBlob implements analyzerinput.FirmwaresAccessor (see the description of AnalyzerFirmwaresAccessor).
+//
+// The image is stored under an ID which never matches a complete image,
+// and the coverage is kept in the returned accessor (thus in a serialized input).
+func (a *AnalyzerFirmwaresAccessor) GetBySparseBlob(
+	ctx context.Context,
+	image []byte,
+	coverage pkgbytes.Ranges,
+) (analysis.Blob, error) {
+	span, ctx := tracer.StartChildSpanFromCtx(ctx, "FW-GetBySparseBlob")
+	defer span.Finish()
+	coverage = append(pkgbytes.Ranges{}, coverage...)
+	coverage.SortAndMerge()
+	meta := sparseImageMeta(image, coverage)
+	blob, err := a.getWrapper(ctx, func(ctx context.Context) ([]byte, *models.FirmwareImageMetadata, *uefi.UEFI, *dmidecode.BIOSInfo, error) {
+		return image, &meta, nil, nil, nil
+	}, "GetBySparseBlob", meta.ImageID)
+	if err != nil {
+		return nil, err
+	}
+	// the cached accessor is shared, so the coverage is set on a copy:
+	fw := *blob.(*AnalyzerFirmwareAccessor)
+	fw.Coverage = coverage
+	return &fw, nil
+}
+
 // GetByID implements analyzerinput.FirmwaresAccessor (see the description of AnalyzerFirmwaresAccessor).
 func (a *AnalyzerFirmwaresAccessor) GetByID(ctx context.Context, imageID types.ImageID) (analysis.Blob, error) {
 	return a.getWrapper(ctx, func(ctx context.Context) ([]byte, *models.FirmwareImageMetadata, *uefi.UEFI, *dmidecode.BIOSInfo, error) {
@@ -0,0 +1,105 @@
+// Copyright 2023 Meta Platforms, Inc. and affiliates.
+//
+// Redistribution and use in source and binary forms, with or without modification, are permitted provided that the following conditions are met:
+//
+// 1. Redistributions of source code must retain the above copyright notice, this list of conditions and the following disclaimer.
+//
+// 2. Redistributions in binary form must reproduce the above copyright notice, this list of conditions and the following disclaimer in the documentation and/or other materials provided with the distribution.
+//
+// 3. Neither the name of the copyright holder nor the names of its contributors may be used to endorse or promote products derived from this software without specific prior written permission.
+//
+// THIS SOFTWARE IS PROVIDED BY THE COPYRIGHT HOLDERS AND CONTRIBUTORS "AS IS" AND ANY EXPRESS OR IMPLIED WARRANTIES, INCLUDING, BUT NOT LIMITED TO, THE IMPLIED WARRANTIES OF MERCHANTABILITY AND FITNESS FOR A PARTICULAR PURPOSE ARE DISCLAIMED. IN NO EVENT SHALL THE COPYRIGHT HOLDER OR CONTRIBUTORS BE LIABLE FOR ANY DIRECT, INDIRECT, INCIDENTAL, SPECIAL, EXEMPLARY, OR CONSEQUENTIAL DAMAGES (INCLUDING, BUT NOT LIMITED TO, PROCUREMENT OF SUBSTITUTE GOODS OR SERVICES; LOSS OF USE, DATA, OR PROFITS; OR BUSINESS INTERRUPTION) HOWEVER CAUSED AND ON ANY THEORY OF LIABILITY, WHETHER IN CONTRACT, STRICT LIABILITY, OR TORT (INCLUDING NEGLIGENCE OR OTHERWISE) ARISING IN ANY WAY OUT OF THE USE OF THIS SOFTWARE, EVEN IF ADVISED OF THE POSSIBILITY OF SUCH DAMAGE.
+package controller
+
+import (
+	"context"
+	"encoding/json"
+	"sync"
+	"testing"
+
+	pkgbytes "github.com/linuxboot/fiano/pkg/bytes"
+	"github.com/stretchr/testify/require"
+
+	"github.com/immune-gmbh/AttestationFailureAnalysisService/pkg/analysis"
+	controllertypes "github.com/immune-gmbh/AttestationFailureAnalysisService/pkg/server/controller/types"
+	"github.com/immune-gmbh/AttestationFailureAnalysisService/pkg/storage/models"
+	"github.com/immune-gmbh/AttestationFailureAnalysisService/pkg/types"
+)
+
+type fakeImageSaver struct {
+	mutex  sync.Mutex
+	images map[types.ImageID][]byte
+	metas  []models.FirmwareImageMetadata
+}
+
+func (s *fakeImageSaver) saveImageAsync(
+	ctx context.Context,
+	meta models.FirmwareImageMetadata,
+	image []byte,
+) {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+	if s.images == nil {
+		s.images = map[types.ImageID][]byte{}
+	}
+	s.images[meta.ImageID] = image
+	s.metas = append(s.metas, meta)
+}
+
+func TestGetBySparseBlob(t *testing.T) {
+	ctx := context.Background()
+	image := make([]byte, 0x1000)
+	for idx := range image {
+		image[idx] = 0xff
+	}
+	copy(image[0xff0:], []byte("reset vector"))
+	coverage := pkgbytes.Ranges{{Offset: 0xff0, Length: 0x10}}
+
+	saver := &fakeImageSaver{}
+	accessor := NewAnalyzerFirmwaresAccessor(&fakeStorage{}, nil, saver, nil)
+
+	blob, err := accessor.GetBySparseBlob(ctx, image, coverage)
+	require.NoError(t, err)
+	fw, ok := blob.(*AnalyzerFirmwareAccessor)
+	require.True(t, ok)
+	require.Equal(t, coverage, fw.Coverage)
+	require.Equal(t, pkgbytes.Ranges{{Offset: 0, Length: 0xff0}}, analysis.MissingRanges(analysis.NewActualFirmwareBlob(fw)))
+
+	t.Run("notStoredAsCompleteImage", func(t *testing.T) {
+		require.Len(t, saver.metas, 1)
+		meta := saver.metas[0]
+		require.Equal(t, fw.ImageID, meta.ImageID)
+		require.NotEqual(t, types.NewImageIDFromImage(image), meta.ImageID)
+		require.NotEqual(t, types.Hash(types.HashAlgSHA2_512, image), meta.HashSHA2_512)
+		require.NotEqual(t, types.Hash(types.HashAlgBlake3_512, image), meta.HashBlake3_512)
+		require.Nil(t, meta.HashStable)
+		require.False(t, meta.FirmwareVersion.Valid)
+
+		otherCoverage, err := accessor.GetBySparseBlob(ctx, image, pkgbytes.Ranges{{Offset: 0, Length: 0x1000}})
+		require.NoError(t, err)
+		require.NotEqual(t, fw.ImageID, otherCoverage.(*AnalyzerFirmwareAccessor).ImageID)
+	})
+
+	t.Run("serializedLayout", func(t *testing.T) {
+		b, err := json.Marshal(analysis.NewInput().AddActualFirmware(blob))
+		require.NoError(t, err)
+
+		// the path of the generated column `input_actual_firmware_image_id` of `analyzer_report`:
+		var serialized map[string]map[string]map[string]map[string]any
+		require.NoError(t, json.Unmarshal(b, &serialized))
+		imageID := serialized["ActualFirmwareBlob"]["Blob"]["./server/controller/types.AnalyzerFirmwareAccessor"]["ImageID"]
+		require.Equal(t, fw.ImageID.String(), imageID)
+
+		input := analysis.NewInput()
+		require.NoError(t, json.Unmarshal(b, &input))
+		require.NoError(t, controllertypes.InitInputBlobs(ctx, input, func(ctx context.Context, imageID types.ImageID) ([]byte, error) {
+			return saver.images[imageID], nil
+		}))
+		require.Len(t, input, 1)
+		for _, v := range input {
+			actualFirmware := v.(*analysis.ActualFirmwareBlob)
+			require.Equal(t, image, actualFirmware.Bytes())
+			require.Equal(t, pkgbytes.Ranges{{Offset: 0, Length: 0xff0}}, analysis.MissingRanges(actualFirmware))
+		}
+	})
+}
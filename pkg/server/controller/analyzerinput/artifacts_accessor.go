@@ -19,6 +19,7 @@ import (
 	"github.com/immune-gmbh/AttestationFailureAnalysisService/if/generated/afas"
 	"github.com/immune-gmbh/AttestationFailureAnalysisService/if/typeconv"
 	"github.com/immune-gmbh/AttestationFailureAnalysisService/pkg/analysis"
+	"github.com/immune-gmbh/AttestationFailureAnalysisService/pkg/evidence"
 	"github.com/immune-gmbh/AttestationFailureAnalysisService/pkg/lockmap"
 	"github.com/immune-gmbh/AttestationFailureAnalysisService/pkg/objhash"
 	"github.com/immune-gmbh/AttestationFailureAnalysisService/pkg/server/controller/helpers"
@@ -29,6 +30,7 @@ import (
 	"github.com/9elements/converged-security-suite/v2/pkg/tpmdetection"
 	"github.com/9elements/converged-security-suite/v2/pkg/tpmeventlog"
 	"github.com/facebookincubator/go-belt/tool/logger"
+	pkgbytes "github.com/linuxboot/fiano/pkg/bytes"
 )
 
 // FirmwareImageFilename refers to the either firmware filename in the orig firmware table or one of the options below
@@ -42,6 +44,7 @@ type ArtifactsAccessor interface {
 	GetTPMEventLog(ctx context.Context, artIdx int) (*tpmeventlog.TPMEventLog, error)
 	GetPCR(ctx context.Context, artIdx int) ([]byte, uint32, error)
 	GetMeasurementsFlow(ctx context.Context, inputIdx int) (types.BootFlow, error)
+	GetFirmwareDumpInfo(ctx context.Context, artIdx int) (*analysis.ActualFirmwareDumpInfo, error)
 }
 
 // FirmwareImage combines firmware image metadata and data together.
@@ -55,6 +58,7 @@ type FirmwareImage struct {
 // It provides an analysis.Blob given different data (in different methods).
 type FirmwaresAccessor interface {
 	GetByBlob(ctx context.Context, content []byte) (analysis.Blob, error)
+	GetBySparseBlob(ctx context.Context, content []byte, coverage pkgbytes.Ranges) (analysis.Blob, error)
 	GetByID(ctx context.Context, imageID types.ImageID) (analysis.Blob, error)
 	GetByVersion(ctx context.Context, firmwareVersion string) (analysis.Blob, error)
 }
@@ -64,12 +68,18 @@ type getFirmwareResult struct {
 	Error            error
 }
 
+type getBundleResult struct {
+	Bundle *evidence.Bundle
+	Error  error
+}
+
 type artifactsAccessor struct {
 	firmwaresAccessor FirmwaresAccessor
 	artifacts         []afas.Artifact
 
 	singleOpLock *lockmap.LockMap
 	cache        map[int]getFirmwareResult
+	bundleCache  map[int]getBundleResult
 	cacheLocker  sync.Mutex
 }
 
@@ -88,6 +98,7 @@ func NewArtifactsAccessor(
 		artifacts:         artifacts,
 		singleOpLock:      lockmap.NewLockMap(),
 		cache:             make(map[int]getFirmwareResult),
+		bundleCache:       make(map[int]getBundleResult),
 	}, nil
 }
 
@@ -98,6 +109,34 @@ func (a *artifactsAccessor) checkIndex(artIdx int) error {
 	return nil
 }
 
+// getBundle returns the parsed evidence bundle of the artifact. The caller
+// is expected to check that the artifact is an evidence bundle.
+func (a *artifactsAccessor) getBundle(artIdx int) (*evidence.Bundle, error) {
+	key := objhash.MustBuild("getBundle", artIdx)
+	l := a.singleOpLock.Lock(key)
+	defer l.Unlock()
+
+	a.cacheLocker.Lock()
+	cachedResult, ok := a.bundleCache[artIdx]
+	a.cacheLocker.Unlock()
+	if ok {
+		return cachedResult.Bundle, cachedResult.Error
+	}
+
+	bundle, err := evidence.Read(a.artifacts[artIdx].GetEvidenceBundle())
+	if err != nil {
+		err = fmt.Errorf("failed to read evidence bundle of artifact '%d': %w", artIdx, err)
+	}
+
+	a.cacheLocker.Lock()
+	defer a.cacheLocker.Unlock()
+	a.bundleCache[artIdx] = getBundleResult{
+		Bundle: bundle,
+		Error:  err,
+	}
+	return bundle, err
+}
+
 func (a *artifactsAccessor) GetFirmware(
 	ctx context.Context,
 	artIdx int,
@@ -148,6 +187,21 @@ func (a *artifactsAccessor) GetFirmware(
 			} else {
 				firmwareAccessor, err = a.firmwaresAccessor.GetByBlob(ctx, image)
 			}
+		case fwImage.IsSetSparseBlob():
+			sparseBlob := fwImage.GetSparseBlob()
+			var image []byte
+			image, err = helpers.Decompress(sparseBlob.GetBlob().GetBlob(), sparseBlob.GetBlob().GetCompression())
+			if err != nil {
+				err = fmt.Errorf("failed to decompress image for artifact '%d': %w", artIdx, err)
+				break
+			}
+			var coverage pkgbytes.Ranges
+			coverage, err = sparseCoverage(sparseBlob.GetCoverage(), len(image))
+			if err != nil {
+				err = fmt.Errorf("invalid coverage of the image for artifact '%d': %w", artIdx, err)
+				break
+			}
+			firmwareAccessor, err = a.firmwaresAccessor.GetBySparseBlob(ctx, image, coverage)
 		case fwImage.IsSetBlobStorageKey():
 			firmwareAccessor, err = a.firmwaresAccessor.GetByID(ctx, types.NewImageIDFromBytes([]byte(fwImage.GetBlobStorageKey())))
 		case fwImage.IsSetFirmwareVersion():
@@ -155,6 +209,16 @@ func (a *artifactsAccessor) GetFirmware(
 		default:
 			err = fmt.Errorf("not supported firmware image type for artifact '%d'", artIdx)
 		}
+	case artifact.IsSetEvidenceBundle():
+		var bundle *evidence.Bundle
+		bundle, err = a.getBundle(artIdx)
+		switch {
+		case err != nil:
+		case len(bundle.FirmwareImage) == 0:
+			err = fmt.Errorf("evidence bundle of artifact '%d' has no firmware image", artIdx)
+		default:
+			firmwareAccessor, err = a.firmwaresAccessor.GetByBlob(ctx, bundle.FirmwareImage)
+		}
 	default:
 		err = fmt.Errorf("unexpected artifact's '%d' type for obtaining firmware image", artIdx)
 	}
@@ -178,6 +242,16 @@ func (a *artifactsAccessor) GetRegisters(ctx context.Context, inputIdx int) (reg
 	}
 
 	artifact := a.artifacts[inputIdx]
+	if artifact.IsSetEvidenceBundle() {
+		bundle, err := a.getBundle(inputIdx)
+		if err != nil {
+			return nil, err
+		}
+		if bundle.StatusRegisters == nil {
+			return nil, fmt.Errorf("evidence bundle of artifact '%d' has no status registers", inputIdx)
+		}
+		return bundle.StatusRegisters, nil
+	}
 	if !artifact.IsSetStatusRegisters() {
 		return nil, fmt.Errorf("unexpected artifact's '%d' type for obtaining status registers", inputIdx)
 	}
@@ -191,6 +265,16 @@ func (a *artifactsAccessor) GetTPMDevice(ctx context.Context, inputIdx int) (tpm
 	}
 
 	artifact := a.artifacts[inputIdx]
+	if artifact.IsSetEvidenceBundle() {
+		bundle, err := a.getBundle(inputIdx)
+		if err != nil {
+			return tpmdetection.TypeNoTPM, err
+		}
+		if bundle.TPMDevice == nil {
+			return tpmdetection.TypeNoTPM, fmt.Errorf("evidence bundle of artifact '%d' has no TPM device", inputIdx)
+		}
+		return *bundle.TPMDevice, nil
+	}
 	if !artifact.IsSetTPMDevice() {
 		return tpmdetection.TypeNoTPM, fmt.Errorf("unexpected artifact's '%d' type for obtaining TPM device", inputIdx)
 	}
@@ -203,6 +287,16 @@ func (a *artifactsAccessor) GetTPMEventLog(ctx context.Context, inputIdx int) (*
 	}
 
 	artifact := a.artifacts[inputIdx]
+	if artifact.IsSetEvidenceBundle() {
+		bundle, err := a.getBundle(inputIdx)
+		if err != nil {
+			return nil, err
+		}
+		if len(bundle.TPMEventLog) == 0 {
+			return nil, fmt.Errorf("evidence bundle of artifact '%d' has no TPM eventlog", inputIdx)
+		}
+		return bundle.ParseTPMEventLog()
+	}
 	if !artifact.IsSetTPMEventLog() {
 		return nil, fmt.Errorf("unexpected artifact's '%d' type for obtaining TPM eventlog", inputIdx)
 	}
@@ -215,6 +309,17 @@ func (a *artifactsAccessor) GetPCR(ctx context.Context, inputIdx int) ([]byte, u
 		return nil, 0, err
 	}
 	artifact := a.artifacts[inputIdx]
+	if artifact.IsSetEvidenceBundle() {
+		bundle, err := a.getBundle(inputIdx)
+		if err != nil {
+			return nil, 0, err
+		}
+		pcr0 := bundle.PCR0()
+		if pcr0 == nil {
+			return nil, 0, fmt.Errorf("evidence bundle of artifact '%d' has no PCR0", inputIdx)
+		}
+		return pcr0, 0, nil
+	}
 	if !artifact.IsSetPcr() {
 		return nil, 0, fmt.Errorf("unexpected artifact's '%d' type for obtaining PCR", inputIdx)
 	}
@@ -235,3 +340,28 @@ func (a *artifactsAccessor) GetMeasurementsFlow(ctx context.Context, inputIdx in
 	flow, err := typeconv.FromThriftFlow(artifact.GetMeasurementsFlow())
 	return types.BootFlow(flow), err
 }
+
+func (a *artifactsAccessor) GetFirmwareDumpInfo(ctx context.Context, inputIdx int) (*analysis.ActualFirmwareDumpInfo, error) {
+	if err := a.checkIndex(inputIdx); err != nil {
+		return nil, err
+	}
+	artifact := a.artifacts[inputIdx]
+	if !artifact.IsSetFirmwareDumpInfo() {
+		return nil, fmt.Errorf("unexpected artifact's '%d' type for obtaining firmware dump info", inputIdx)
+	}
+	return typeconv.FromThriftFirmwareDumpInfo(artifact.GetFirmwareDumpInfo())
+}
+
+func sparseCoverage(ranges []*afas.ByteRange, imageSize int) (pkgbytes.Ranges, error) {
+	var result pkgbytes.Ranges
+	for _, r := range ranges {
+		if r == nil || r.Offset < 0 || r.Length < 0 || r.Offset+r.Length > int64(imageSize) {
+			return nil, fmt.Errorf("range %v is outside of the image of size %d", r, imageSize)
+		}
+		result = append(result, pkgbytes.Range{
+			Offset: uint64(r.Offset),
+			Length: uint64(r.Length),
+		})
+	}
+	return result, nil
+}
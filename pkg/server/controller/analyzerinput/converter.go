@@ -76,6 +76,15 @@ func NewDiffMeasuredBootInput(
 		}
 	}
 
+	var dumpInfo *analysis.ActualFirmwareDumpInfo
+	if input.IsSetActualFirmwareDumpInfo() {
+		dumpInfo, err = artifacts.GetFirmwareDumpInfo(ctx, int(input.GetActualFirmwareDumpInfo()))
+		if err != nil {
+			log.Errorf("Failed to get actual firmware dump info using artifact %d, err: %v", input.GetActualFirmwareDumpInfo(), err)
+			return nil, err
+		}
+	}
+
 	result, err := diffmeasuredboot.NewExecutorInput(
 		originalFirmware,
 		actualFirmware,
@@ -84,6 +93,7 @@ func NewDiffMeasuredBootInput(
 		eventlog,
 		actualPCR0,
 		nil,
+		dumpInfo,
 	)
 	if err != nil {
 		return nil, err
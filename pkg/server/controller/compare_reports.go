@@ -0,0 +1,67 @@
+// Copyright 2023 Meta Platforms, Inc. and affiliates.
+//
+// Redistribution and use in source and binary forms, with or without modification, are permitted provided that the following conditions are met:
+//
+// 1. Redistributions of source code must retain the above copyright notice, this list of conditions and the following disclaimer.
+//
+// 2. Redistributions in binary form must reproduce the above copyright notice, this list of conditions and the following disclaimer in the documentation and/or other materials provided with the distribution.
+//
+// 3. Neither the name of the copyright holder nor the names of its contributors may be used to endorse or promote products derived from this software without specific prior written permission.
+//
+// THIS SOFTWARE IS PROVIDED BY THE COPYRIGHT HOLDERS AND CONTRIBUTORS "AS IS" AND ANY EXPRESS OR IMPLIED WARRANTIES, INCLUDING, BUT NOT LIMITED TO, THE IMPLIED WARRANTIES OF MERCHANTABILITY AND FITNESS FOR A PARTICULAR PURPOSE ARE DISCLAIMED. IN NO EVENT SHALL THE COPYRIGHT HOLDER OR CONTRIBUTORS BE LIABLE FOR ANY DIRECT, INDIRECT, INCIDENTAL, SPECIAL, EXEMPLARY, OR CONSEQUENTIAL DAMAGES (INCLUDING, BUT NOT LIMITED TO, PROCUREMENT OF SUBSTITUTE GOODS OR SERVICES; LOSS OF USE, DATA, OR PROFITS; OR BUSINESS INTERRUPTION) HOWEVER CAUSED AND ON ANY THEORY OF LIABILITY, WHETHER IN CONTRACT, STRICT LIABILITY, OR TORT (INCLUDING NEGLIGENCE OR OTHERWISE) ARISING IN ANY WAY OUT OF THE USE OF THIS SOFTWARE, EVEN IF ADVISED OF THE POSSIBILITY OF SUCH DAMAGE.
+
+package controller
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/facebookincubator/go-belt/tool/experimental/tracer"
+
+	"github.com/immune-gmbh/AttestationFailureAnalysisService/if/generated/afas"
+	"github.com/immune-gmbh/AttestationFailureAnalysisService/pkg/server/controller/reportdiff"
+	"github.com/immune-gmbh/AttestationFailureAnalysisService/pkg/storage"
+	"github.com/immune-gmbh/AttestationFailureAnalysisService/pkg/storage/models"
+	"github.com/immune-gmbh/AttestationFailureAnalysisService/pkg/types"
+)
+
+// CompareReports returns the difference between the analyze reports of jobs
+// `jobIDA` and `jobIDB` (see package reportdiff).
+func (ctrl *Controller) CompareReports(
+	ctx context.Context,
+	jobIDA []byte,
+	jobIDB []byte,
+) (*afas.CompareReportsResult_, error) {
+	span, ctx := tracer.StartChildSpanFromCtx(ctx, "CompareReports")
+	defer span.Finish()
+
+	reportA, err := ctrl.getAnalyzeReportByJobID(ctx, jobIDA)
+	if err != nil {
+		return nil, fmt.Errorf("unable to get report A: %w", err)
+	}
+	reportB, err := ctrl.getAnalyzeReportByJobID(ctx, jobIDB)
+	if err != nil {
+		return nil, fmt.Errorf("unable to get report B: %w", err)
+	}
+
+	return reportdiff.Compare(reportA, reportB)
+}
+
+func (ctrl *Controller) getAnalyzeReportByJobID(
+	ctx context.Context,
+	jobIDBytes []byte,
+) (*models.AnalyzeReport, error) {
+	jobID, err := types.NewJobIDFromBytes(jobIDBytes)
+	if err != nil {
+		return nil, ErrInvalidRequest{Err: fmt.Errorf("invalid job ID: %w", err)}
+	}
+
+	reports, err := ctrl.FirmwareStorage.FindAnalyzeReports(ctx, storage.AnalyzeReportFindFilter{JobID: &jobID}, nil, 1)
+	if err != nil {
+		return nil, fmt.Errorf("unable to find the report of job %s: %w", jobID, err)
+	}
+	if len(reports) == 0 {
+		return nil, ErrInvalidRequest{Err: fmt.Errorf("report of job %s is not found", jobID)}
+	}
+	return reports[0], nil
+}
@@ -18,6 +18,7 @@ import (
 	"time"
 
 	css_errors "github.com/9elements/converged-security-suite/v2/pkg/errors"
+	"github.com/9elements/converged-security-suite/v2/pkg/registers"
 	"github.com/facebookincubator/go-belt/beltctx"
 	"github.com/facebookincubator/go-belt/tool/logger"
 	fianoUEFI "github.com/linuxboot/fiano/pkg/uefi"
@@ -26,6 +27,14 @@ import (
 	"github.com/immune-gmbh/AttestationFailureAnalysisService/if/generated/device"
 	"github.com/immune-gmbh/AttestationFailureAnalysisService/pkg/analyzers"
 	"github.com/immune-gmbh/AttestationFailureAnalysisService/pkg/firmwaredb"
+	"github.com/immune-gmbh/AttestationFailureAnalysisService/pkg/policy"
+	"github.com/immune-gmbh/AttestationFailureAnalysisService/pkg/reportgroup"
+)
+
+const (
+	// alertsRetryInterval is how often failed deliveries of alerts about
+	// new analyze report groups are retried.
+	alertsRetryInterval = time.Minute
 )
 
 func init() {
@@ -55,6 +64,23 @@ type Controller struct {
 	analyzersRegistry         *analyzers.Registry
 	analysisDataCalculator    analysisDataCalculatorInterface
 
+	// ExpectedMeasurementsRegisterSets are the status registers sets used to
+	// simulate boot flows while populating expected measurements of original firmwares.
+	ExpectedMeasurementsRegisterSets []registers.Registers
+
+	// Policies decides the overall verdicts of analyze reports. If nil,
+	// then policy.DefaultPolicy is used.
+	Policies *policy.Config
+
+	// ReportGrouper assigns stored analyze reports to groups and alerts
+	// about new groups. If nil, then reports are not grouped.
+	//
+	// Use SetReportGrouper to also start the delivery of alerts.
+	ReportGrouper *reportgroup.Engine
+
+	measuredVersions         sync.Map
+	measurementsJobSemaphore chan struct{}
+
 	closedSignal       chan struct{}
 	activeGoroutinesWG sync.WaitGroup
 }
@@ -67,6 +93,8 @@ func New(
 	analysisDataCalculator analysisDataCalculatorInterface,
 	deviceGetter DeviceGetter,
 	apiCachePurgeTimeout time.Duration,
+	retentionPolicy RetentionPolicy,
+	reanalysisPolicy ReanalysisPolicy,
 ) (*Controller, error) {
 	ctx = beltctx.WithField(ctx, "module", "controller")
 
@@ -76,23 +104,42 @@ func New(
 	}
 
 	ctrl := &Controller{
-		FirmwareStorage:           firmwareStorage,
-		DeviceGetter:              deviceGetter,
-		OriginalFWDB:              origFirmwareDB,
-		OriginalFWImageRepository: origFirmwareRepo,
-		analyzersRegistry:         analyzersRegistry,
-		analysisDataCalculator:    analysisDataCalculator,
-
-		closedSignal: make(chan struct{}),
+		FirmwareStorage:        firmwareStorage,
+		DeviceGetter:           deviceGetter,
+		OriginalFWDB:           origFirmwareDB,
+		analyzersRegistry:      analyzersRegistry,
+		analysisDataCalculator: analysisDataCalculator,
+
+		measurementsJobSemaphore: make(chan struct{}, 1),
+		closedSignal:             make(chan struct{}),
+	}
+	ctrl.OriginalFWImageRepository = measuringFWImageRepository{
+		originalFWImageRepository: origFirmwareRepo,
+		ctrl:                      ctrl,
 	}
 	ctrl.Context, ctrl.ContextCancel = context.WithCancel(ctx)
 
 	ctrl.launchAsync(ctrl.Context, func(ctx context.Context) {
 		ctrl.updateCacheLoop(ctx, apiCachePurgeTimeout)
 	})
+	ctrl.launchAsync(ctrl.Context, func(ctx context.Context) {
+		ctrl.gcLoop(ctx, retentionPolicy)
+	})
+	ctrl.launchAsync(ctrl.Context, func(ctx context.Context) {
+		ctrl.reanalysisLoop(ctx, reanalysisPolicy)
+	})
 	return ctrl, nil
 }
 
+// SetReportGrouper sets ReportGrouper and starts the delivery of its alerts
+// in the background (until Close is called).
+func (ctrl *Controller) SetReportGrouper(engine *reportgroup.Engine) error {
+	ctrl.ReportGrouper = engine
+	return ctrl.launchAsync(ctrl.Context, func(ctx context.Context) {
+		engine.Run(ctx, alertsRetryInterval)
+	})
+}
+
 func (ctrl *Controller) updateCacheLoop(
 	ctx context.Context,
 	apiCachePurgeTimeout time.Duration,
@@ -120,24 +167,30 @@ func (ctrl *Controller) purgeAPICache() {
 // getHostInfo tries to get full information about the host being analyzed.
 // If request is being made from the host that is being analyzed, a host can provide information about itself in thrift input structure.
 // But that information may not be full
+//
+// isDeviceVerified reports whether the device was found by the verified
+// TLS identity of the client, rather than by the AssetID or Hostname
+// claimed by the client.
 func (ctrl *Controller) getHostInfo(
 	ctx context.Context,
 	requestHostInfo *afas.HostInfo,
-) (*afas.HostInfo, *device.Device) {
+) (_ *afas.HostInfo, _ *device.Device, isDeviceVerified bool) {
 	if requestHostInfo == nil {
-		return nil, nil
+		return nil, nil, false
 	}
 
 	log := logger.FromCtx(ctx)
 
 	resultHostInfo := *requestHostInfo
+	isVerified := false
 	device := func() *device.Device {
 		if resultHostInfo.IsClientHostAnalyzed {
-			hostname, _ := ExtractHostnameFromCtx(ctx)
+			hostname, isHostnameVerified := ExtractHostnameFromCtx(ctx)
 			if len(hostname) > 0 {
 				log.Debugf("detected TLS identity hostname: %s", hostname)
 				device, err := ctrl.DeviceGetter.GetDeviceByHostname(hostname)
 				if err == nil {
+					isVerified = isHostnameVerified
 					return device
 				}
 				log.Warnf("failed to get device info for %s: %v", hostname, err)
@@ -161,9 +214,9 @@ func (ctrl *Controller) getHostInfo(
 		return nil
 	}()
 	if device != nil {
-		enrichHostInfo(ctx, device, false, &resultHostInfo)
+		enrichHostInfo(ctx, device, isVerified, &resultHostInfo)
 	}
-	return &resultHostInfo, device
+	return &resultHostInfo, device, isVerified
 }
 
 // Close stops the Controller and blocks until all goroutines from launchAsync
@@ -0,0 +1,182 @@
+// Copyright 2023 Meta Platforms, Inc. and affiliates.
+//
+// Redistribution and use in source and binary forms, with or without modification, are permitted provided that the following conditions are met:
+//
+// 1. Redistributions of source code must retain the above copyright notice, this list of conditions and the following disclaimer.
+//
+// 2. Redistributions in binary form must reproduce the above copyright notice, this list of conditions and the following disclaimer in the documentation and/or other materials provided with the distribution.
+//
+// 3. Neither the name of the copyright holder nor the names of its contributors may be used to endorse or promote products derived from this software without specific prior written permission.
+//
+// THIS SOFTWARE IS PROVIDED BY THE COPYRIGHT HOLDERS AND CONTRIBUTORS "AS IS" AND ANY EXPRESS OR IMPLIED WARRANTIES, INCLUDING, BUT NOT LIMITED TO, THE IMPLIED WARRANTIES OF MERCHANTABILITY AND FITNESS FOR A PARTICULAR PURPOSE ARE DISCLAIMED. IN NO EVENT SHALL THE COPYRIGHT HOLDER OR CONTRIBUTORS BE LIABLE FOR ANY DIRECT, INDIRECT, INCIDENTAL, SPECIAL, EXEMPLARY, OR CONSEQUENTIAL DAMAGES (INCLUDING, BUT NOT LIMITED TO, PROCUREMENT OF SUBSTITUTE GOODS OR SERVICES; LOSS OF USE, DATA, OR PROFITS; OR BUSINESS INTERRUPTION) HOWEVER CAUSED AND ON ANY THEORY OF LIABILITY, WHETHER IN CONTRACT, STRICT LIABILITY, OR TORT (INCLUDING NEGLIGENCE OR OTHERWISE) ARISING IN ANY WAY OUT OF THE USE OF THIS SOFTWARE, EVEN IF ADVISED OF THE POSSIBILITY OF SUCH DAMAGE.
+
+package controller
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/facebookincubator/go-belt/tool/experimental/metrics"
+	"github.com/facebookincubator/go-belt/tool/experimental/tracer"
+	"github.com/facebookincubator/go-belt/tool/logger"
+
+	"github.com/immune-gmbh/AttestationFailureAnalysisService/if/generated/afas"
+	"github.com/immune-gmbh/AttestationFailureAnalysisService/pkg/imagechunks"
+	"github.com/immune-gmbh/AttestationFailureAnalysisService/pkg/server/controller/helpers"
+	"github.com/immune-gmbh/AttestationFailureAnalysisService/pkg/storage/models"
+	"github.com/immune-gmbh/AttestationFailureAnalysisService/pkg/types"
+)
+
+func manifestFromThrift(thriftManifest *afas.ImageChunksManifest) (imagechunks.Manifest, error) {
+	if thriftManifest == nil {
+		return imagechunks.Manifest{}, fmt.Errorf("manifest is not set")
+	}
+	if thriftManifest.GetImageSize() < 0 || thriftManifest.GetChunkSize() < 0 {
+		return imagechunks.Manifest{}, fmt.Errorf("negative image size (%d) or chunk size (%d)", thriftManifest.GetImageSize(), thriftManifest.GetChunkSize())
+	}
+	manifest := imagechunks.Manifest{
+		ImageSHA2_512: thriftManifest.GetImageSHA2_512(),
+		ImageSize:     uint64(thriftManifest.GetImageSize()),
+		ChunkSize:     uint32(thriftManifest.GetChunkSize()),
+		ChunkHashes:   thriftManifest.GetChunkHashes(),
+	}
+	return manifest, manifest.Validate()
+}
+
+// NegotiateImageUpload returns which chunks of the image described by the manifest
+// are not known to the server (and therefore should be uploaded).
+//
+// The ImageID of an already stored image is never returned: otherwise anybody
+// knowing just the hash of an image would learn whether it is stored. To learn
+// that a chunk is known a client has to present its hash, which requires
+// the content of the chunk.
+func (ctrl *Controller) NegotiateImageUpload(
+	ctx context.Context,
+	thriftManifest *afas.ImageChunksManifest,
+) (*afas.NegotiateImageUploadResult_, error) {
+	span, ctx := tracer.StartChildSpanFromCtx(ctx, "NegotiateImageUpload")
+	defer span.Finish()
+
+	manifest, err := manifestFromThrift(thriftManifest)
+	if err != nil {
+		return nil, ErrInvalidRequest{Err: err}
+	}
+
+	storedChunks, err := ctrl.findStoredChunks(ctx, manifest)
+	if err != nil {
+		return nil, err
+	}
+
+	result := &afas.NegotiateImageUploadResult_{
+		MissingChunks: []int32{},
+	}
+	for idx, chunkHash := range manifest.ChunkHashes {
+		if _, ok := storedChunks[string(chunkHash)]; !ok {
+			result.MissingChunks = append(result.MissingChunks, int32(idx))
+		}
+	}
+	logger.FromCtx(ctx).Debugf("%d of %d chunks are missing", len(result.MissingChunks), len(manifest.ChunkHashes))
+	metrics.FromCtx(ctx).Count("negotiateImageUploadChunksKnown").Add(uint64(len(manifest.ChunkHashes) - len(result.MissingChunks)))
+	metrics.FromCtx(ctx).Count("negotiateImageUploadChunksMissing").Add(uint64(len(result.MissingChunks)))
+	return result, nil
+}
+
+// findStoredChunks returns the chunks of stored images, which are used
+// by the manifest. The key of the map is the SHA256 hash of the chunk.
+func (ctrl *Controller) findStoredChunks(ctx context.Context, manifest imagechunks.Manifest) (map[string]models.FirmwareImageChunk, error) {
+	uniqueHashes := make(map[string]struct{}, len(manifest.ChunkHashes))
+	hashes := make([][]byte, 0, len(manifest.ChunkHashes))
+	for _, chunkHash := range manifest.ChunkHashes {
+		if _, ok := uniqueHashes[string(chunkHash)]; ok {
+			continue
+		}
+		uniqueHashes[string(chunkHash)] = struct{}{}
+		hashes = append(hashes, chunkHash)
+	}
+
+	chunks, err := ctrl.FirmwareStorage.FindFirmwareChunks(ctx, hashes)
+	if err != nil {
+		return nil, fmt.Errorf("unable to find stored chunks: %w", err)
+	}
+
+	result := make(map[string]models.FirmwareImageChunk, len(chunks))
+	for _, chunk := range chunks {
+		if _, ok := result[string(chunk.HashSHA256)]; ok {
+			continue
+		}
+		result[string(chunk.HashSHA256)] = chunk
+	}
+	return result, nil
+}
+
+// reconstructDeltaImages replaces the firmware images sent as DeltaBlob
+// with the reconstructed images.
+func (ctrl *Controller) reconstructDeltaImages(ctx context.Context, artifacts []afas.Artifact) error {
+	for idx := range artifacts {
+		fwImage := artifacts[idx].GetFwImage()
+		if fwImage == nil || !fwImage.IsSetDeltaBlob() {
+			continue
+		}
+		image, err := ctrl.reconstructDeltaImage(ctx, fwImage.GetDeltaBlob())
+		if err != nil {
+			return fmt.Errorf("unable to reconstruct the image of artifact '%d': %w", idx, err)
+		}
+		artifacts[idx].FwImage = &afas.FirmwareImage{
+			Blob: &afas.CompressedBlob{
+				Blob:        image,
+				Compression: afas.CompressionType_None,
+			},
+		}
+	}
+	return nil
+}
+
+func (ctrl *Controller) reconstructDeltaImage(ctx context.Context, deltaBlob *afas.DeltaBlob) ([]byte, error) {
+	span, ctx := tracer.StartChildSpanFromCtx(ctx, "reconstructDeltaImage")
+	defer span.Finish()
+
+	manifest, err := manifestFromThrift(deltaBlob.GetManifest())
+	if err != nil {
+		return nil, ErrInvalidRequest{Err: err}
+	}
+	for idx := range deltaBlob.GetChunks() {
+		if idx < 0 || int(idx) >= len(manifest.ChunkHashes) {
+			return nil, ErrInvalidRequest{Err: fmt.Errorf("chunk index %d is out of range [0:%d)", idx, len(manifest.ChunkHashes))}
+		}
+	}
+
+	storedChunks, err := ctrl.findStoredChunks(ctx, manifest)
+	if err != nil {
+		return nil, err
+	}
+
+	// A stored image usually provides many chunks, so it is fetched only once.
+	storedImages := map[types.ImageID][]byte{}
+	image, err := manifest.Reconstruct(func(idx int) ([]byte, error) {
+		_, size := manifest.ChunkRange(idx)
+		if sentChunk, ok := deltaBlob.GetChunks()[int32(idx)]; ok && sentChunk != nil {
+			return helpers.DecompressWithLimit(sentChunk.GetBlob(), sentChunk.GetCompression(), uint64(size))
+		}
+		storedChunk, ok := storedChunks[string(manifest.ChunkHashes[idx])]
+		if !ok {
+			return nil, fmt.Errorf("the chunk is neither sent nor stored")
+		}
+		storedImage, ok := storedImages[storedChunk.ImageID]
+		if !ok {
+			storedImage, err = ctrl.FirmwareStorage.GetFirmwareBytes(ctx, storedChunk.ImageID)
+			if err != nil {
+				return nil, fmt.Errorf("unable to get image %s: %w", storedChunk.ImageID, err)
+			}
+			storedImages[storedChunk.ImageID] = storedImage
+		}
+		end := storedChunk.Offset + uint64(storedChunk.Size)
+		if end > uint64(len(storedImage)) {
+			return nil, fmt.Errorf("chunk %d of image %s is out of the image range", storedChunk.ChunkIndex, storedChunk.ImageID)
+		}
+		return storedImage[storedChunk.Offset:end], nil
+	})
+	if err != nil {
+		return nil, ErrInvalidRequest{Err: err}
+	}
+	return image, nil
+}
@@ -0,0 +1,132 @@
+// Copyright 2023 Meta Platforms, Inc. and affiliates.
+//
+// Redistribution and use in source and binary forms, with or without modification, are permitted provided that the following conditions are met:
+//
+// 1. Redistributions of source code must retain the above copyright notice, this list of conditions and the following disclaimer.
+//
+// 2. Redistributions in binary form must reproduce the above copyright notice, this list of conditions and the following disclaimer in the documentation and/or other materials provided with the distribution.
+//
+// 3. Neither the name of the copyright holder nor the names of its contributors may be used to endorse or promote products derived from this software without specific prior written permission.
+//
+// THIS SOFTWARE IS PROVIDED BY THE COPYRIGHT HOLDERS AND CONTRIBUTORS "AS IS" AND ANY EXPRESS OR IMPLIED WARRANTIES, INCLUDING, BUT NOT LIMITED TO, THE IMPLIED WARRANTIES OF MERCHANTABILITY AND FITNESS FOR A PARTICULAR PURPOSE ARE DISCLAIMED. IN NO EVENT SHALL THE COPYRIGHT HOLDER OR CONTRIBUTORS BE LIABLE FOR ANY DIRECT, INDIRECT, INCIDENTAL, SPECIAL, EXEMPLARY, OR CONSEQUENTIAL DAMAGES (INCLUDING, BUT NOT LIMITED TO, PROCUREMENT OF SUBSTITUTE GOODS OR SERVICES; LOSS OF USE, DATA, OR PROFITS; OR BUSINESS INTERRUPTION) HOWEVER CAUSED AND ON ANY THEORY OF LIABILITY, WHETHER IN CONTRACT, STRICT LIABILITY, OR TORT (INCLUDING NEGLIGENCE OR OTHERWISE) ARISING IN ANY WAY OUT OF THE USE OF THIS SOFTWARE, EVEN IF ADVISED OF THE POSSIBILITY OF SUCH DAMAGE.
+package controller
+
+import (
+	"bytes"
+	"context"
+	"crypto/sha256"
+	"math/rand"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"github.com/ulikunitz/xz"
+
+	"github.com/immune-gmbh/AttestationFailureAnalysisService/if/generated/afas"
+	"github.com/immune-gmbh/AttestationFailureAnalysisService/pkg/imagechunks"
+	"github.com/immune-gmbh/AttestationFailureAnalysisService/pkg/server/controller/helpers"
+	"github.com/immune-gmbh/AttestationFailureAnalysisService/pkg/storage/models"
+	"github.com/immune-gmbh/AttestationFailureAnalysisService/pkg/types"
+)
+
+func manifestToThrift(manifest imagechunks.Manifest) *afas.ImageChunksManifest {
+	return &afas.ImageChunksManifest{
+		ImageSHA2_512: manifest.ImageSHA2_512,
+		ImageSize:     int64(manifest.ImageSize),
+		ChunkSize:     int32(manifest.ChunkSize),
+		ChunkHashes:   manifest.ChunkHashes,
+	}
+}
+
+func TestDeltaUpload(t *testing.T) {
+	const chunkSize = 1024
+	ctx := context.Background()
+
+	storedImage := make([]byte, 4*chunkSize)
+	rand.New(rand.NewSource(0)).Read(storedImage)
+	storedImageID := types.NewImageIDFromImage(storedImage)
+	storedManifest := imagechunks.NewManifest(storedImage, chunkSize)
+
+	stor := newFakeStorage()
+	stor.images[storedImageID] = storedImage
+	for idx, chunkHash := range storedManifest.ChunkHashes {
+		offset, size := storedManifest.ChunkRange(idx)
+		stor.chunks = append(stor.chunks, models.FirmwareImageChunk{
+			ImageID:    storedImageID,
+			ChunkIndex: uint32(idx),
+			Offset:     offset,
+			Size:       size,
+			HashSHA256: chunkHash,
+		})
+	}
+	ctrl := &Controller{FirmwareStorage: stor}
+
+	image := bytes.Clone(storedImage)
+	image[chunkSize+1] ^= 0xff
+	manifest := imagechunks.NewManifest(image, chunkSize)
+
+	t.Run("negotiate", func(t *testing.T) {
+		result, err := ctrl.NegotiateImageUpload(ctx, manifestToThrift(manifest))
+		require.NoError(t, err)
+		require.Equal(t, []int32{1}, result.GetMissingChunks())
+		require.False(t, result.IsSetImageID())
+
+		// the server does not tell whether the whole image is stored
+		result, err = ctrl.NegotiateImageUpload(ctx, manifestToThrift(storedManifest))
+		require.NoError(t, err)
+		require.Empty(t, result.GetMissingChunks())
+		require.False(t, result.IsSetImageID())
+	})
+
+	t.Run("reconstruct", func(t *testing.T) {
+		var compressed bytes.Buffer
+		w, err := xz.NewWriter(&compressed)
+		require.NoError(t, err)
+		_, err = w.Write(manifest.Chunk(image, 1))
+		require.NoError(t, err)
+		require.NoError(t, w.Close())
+
+		stor.getImageRequests = 0
+		reconstructed, err := ctrl.reconstructDeltaImage(ctx, &afas.DeltaBlob{
+			Manifest: manifestToThrift(manifest),
+			Chunks: map[int32]*afas.CompressedBlob{
+				1: {Blob: compressed.Bytes(), Compression: afas.CompressionType_XZ},
+			},
+		})
+		require.NoError(t, err)
+		require.Equal(t, image, reconstructed)
+		require.Equal(t, 1, stor.getImageRequests, "the stored image should be fetched once for all its chunks")
+	})
+
+	t.Run("decompression_bomb", func(t *testing.T) {
+		var compressed bytes.Buffer
+		w, err := xz.NewWriter(&compressed)
+		require.NoError(t, err)
+		_, err = w.Write(make([]byte, 64*chunkSize))
+		require.NoError(t, err)
+		require.NoError(t, w.Close())
+
+		_, err = ctrl.reconstructDeltaImage(ctx, &afas.DeltaBlob{
+			Manifest: manifestToThrift(manifest),
+			Chunks: map[int32]*afas.CompressedBlob{
+				1: {Blob: compressed.Bytes(), Compression: afas.CompressionType_XZ},
+			},
+		})
+		require.ErrorAs(t, err, &ErrInvalidRequest{})
+		require.ErrorAs(t, err, &helpers.ErrDecompressedSizeLimit{})
+	})
+
+	t.Run("tampered_chunk", func(t *testing.T) {
+		chunk := bytes.Clone(manifest.Chunk(image, 1))
+		chunk[0] ^= 0xff
+		chunkHash := sha256.Sum256(chunk)
+		require.NotEqual(t, manifest.ChunkHashes[1], chunkHash[:])
+
+		_, err := ctrl.reconstructDeltaImage(ctx, &afas.DeltaBlob{
+			Manifest: manifestToThrift(manifest),
+			Chunks: map[int32]*afas.CompressedBlob{
+				1: {Blob: chunk, Compression: afas.CompressionType_None},
+			},
+		})
+		require.ErrorAs(t, err, &imagechunks.ErrChunkMismatch{})
+	})
+}
@@ -309,3 +309,39 @@ type ErrSameImage struct{}
 func (err ErrSameImage) Error() string {
 	return "the image is the same as the original one, no need to save it"
 }
+
+// ErrPermissionDenied means the client is not permitted to perform the action.
+type ErrPermissionDenied struct {
+	Action string
+}
+
+func (err ErrPermissionDenied) Error() string {
+	return fmt.Sprintf("permission denied to %s", err.Action)
+}
+
+// ThriftException converts a Go err type into a Thrift Exception type
+func (err ErrPermissionDenied) ThriftException() error {
+	return &afas.PermissionDenied{
+		Reason: err.Error(),
+	}
+}
+
+// ErrInvalidRequest means the request is malformed or inconsistent.
+type ErrInvalidRequest struct {
+	Err error
+}
+
+func (err ErrInvalidRequest) Error() string {
+	return fmt.Sprintf("invalid request: %v", err.Err)
+}
+
+func (err ErrInvalidRequest) Unwrap() error {
+	return err.Err
+}
+
+// ThriftException converts a Go err type into a Thrift Exception type
+func (err ErrInvalidRequest) ThriftException() error {
+	return &afas.InvalidRequest{
+		Reason: err.Error(),
+	}
+}
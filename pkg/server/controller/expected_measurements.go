@@ -0,0 +1,215 @@
+// Copyright 2023 Meta Platforms, Inc. and affiliates.
+//
+// Redistribution and use in source and binary forms, with or without modification, are permitted provided that the following conditions are met:
+//
+// 1. Redistributions of source code must retain the above copyright notice, this list of conditions and the following disclaimer.
+//
+// 2. Redistributions in binary form must reproduce the above copyright notice, this list of conditions and the following disclaimer in the documentation and/or other materials provided with the distribution.
+//
+// 3. Neither the name of the copyright holder nor the names of its contributors may be used to endorse or promote products derived from this software without specific prior written permission.
+//
+// THIS SOFTWARE IS PROVIDED BY THE COPYRIGHT HOLDERS AND CONTRIBUTORS "AS IS" AND ANY EXPRESS OR IMPLIED WARRANTIES, INCLUDING, BUT NOT LIMITED TO, THE IMPLIED WARRANTIES OF MERCHANTABILITY AND FITNESS FOR A PARTICULAR PURPOSE ARE DISCLAIMED. IN NO EVENT SHALL THE COPYRIGHT HOLDER OR CONTRIBUTORS BE LIABLE FOR ANY DIRECT, INDIRECT, INCIDENTAL, SPECIAL, EXEMPLARY, OR CONSEQUENTIAL DAMAGES (INCLUDING, BUT NOT LIMITED TO, PROCUREMENT OF SUBSTITUTE GOODS OR SERVICES; LOSS OF USE, DATA, OR PROFITS; OR BUSINESS INTERRUPTION) HOWEVER CAUSED AND ON ANY THEORY OF LIABILITY, WHETHER IN CONTRACT, STRICT LIABILITY, OR TORT (INCLUDING NEGLIGENCE OR OTHERWISE) ARISING IN ANY WAY OUT OF THE USE OF THIS SOFTWARE, EVEN IF ADVISED OF THE POSSIBILITY OF SUCH DAMAGE.
+package controller
+
+import (
+	"context"
+	"crypto/sha512"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"sort"
+	"strconv"
+
+	"github.com/9elements/converged-security-suite/v2/pkg/bootflow/systemartifacts/biosimage"
+	"github.com/9elements/converged-security-suite/v2/pkg/registers"
+	"github.com/facebookincubator/go-belt/beltctx"
+	"github.com/facebookincubator/go-belt/tool/experimental/errmon"
+	"github.com/facebookincubator/go-belt/tool/experimental/metrics"
+	"github.com/facebookincubator/go-belt/tool/experimental/tracer"
+	"github.com/facebookincubator/go-belt/tool/logger"
+
+	"github.com/immune-gmbh/AttestationFailureAnalysisService/pkg/firmwaredb"
+	"github.com/immune-gmbh/AttestationFailureAnalysisService/pkg/measurements"
+)
+
+// measuringFWImageRepository wraps an originalFWImageRepository to
+// populate expected measurements of each firmware version fetched
+// for the first time.
+type measuringFWImageRepository struct {
+	originalFWImageRepository
+	ctrl *Controller
+}
+
+// DownloadByVersion implements originalFWImageRepository.
+func (repo measuringFWImageRepository) DownloadByVersion(ctx context.Context, version string) ([]byte, string, error) {
+	image, filename, err := repo.originalFWImageRepository.DownloadByVersion(ctx, version)
+	if err != nil {
+		return image, filename, err
+	}
+	if _, alreadyPopulated := repo.ctrl.measuredVersions.LoadOrStore(version, struct{}{}); !alreadyPopulated {
+		repo.ctrl.populateExpectedMeasurementsAsync(ctx, version, image)
+	}
+	return image, filename, nil
+}
+
+// populateExpectedMeasurementsAsync runs populateExpectedMeasurements in the background.
+//
+// If image is nil, then it is downloaded from the original firmware image repository.
+func (ctrl *Controller) populateExpectedMeasurementsAsync(
+	ctx context.Context,
+	version string,
+	image []byte,
+) {
+	// The job should outlive the request, but should have the same logger/tracer/etc.
+	ctx = beltctx.WithField(beltctx.WithBelt(ctrl.Context, beltctx.Belt(ctx)), "firmwareVersion", version)
+	err := ctrl.launchAsync(ctx, func(ctx context.Context) {
+		select {
+		case ctrl.measurementsJobSemaphore <- struct{}{}:
+		case <-ctx.Done():
+			return
+		}
+		defer func() { <-ctrl.measurementsJobSemaphore }()
+
+		if err := ctrl.populateExpectedMeasurements(ctx, version, image); err != nil {
+			if errors.As(err, &firmwaredb.ErrNotFound{}) {
+				logger.FromCtx(ctx).Debugf("firmware version '%s' is not registered, skipping populating its measurements", version)
+				return
+			}
+			errmon.ObserveErrorCtx(ctx, err)
+			metrics.FromCtx(ctx).Count("expectedMeasurementsErrors").Add(1)
+			logger.FromCtx(ctx).Errorf("unable to populate expected measurements of firmware '%s': %v", version, err)
+			ctrl.measuredVersions.Delete(version)
+		}
+	})
+	if err != nil {
+		logger.FromCtx(ctx).Warnf("unable to launch populating expected measurements of firmware '%s': %v", version, err)
+	}
+}
+
+// populateExpectedMeasurements simulates the known boot flows of the firmware image
+// and stores the expected PCR0 values as measurements of the original firmware entries
+// of the given version.
+func (ctrl *Controller) populateExpectedMeasurements(
+	ctx context.Context,
+	version string,
+	image []byte,
+) error {
+	span, ctx := tracer.StartChildSpanFromCtx(ctx, "populateExpectedMeasurements")
+	defer span.Finish()
+
+	if image == nil {
+		var err error
+		image, _, err = ctrl.OriginalFWImageRepository.DownloadByVersion(ctx, version)
+		if err != nil {
+			return fmt.Errorf("unable to download the image: %w", err)
+		}
+	}
+
+	expectedPCR0s, err := measurements.CalculateExpectedPCR0s(ctx, biosimage.New(image), ctrl.ExpectedMeasurementsRegisterSets)
+	if err != nil {
+		return fmt.Errorf("unable to calculate expected PCR0 values: %w", err)
+	}
+
+	fwMeasurements := make([]*firmwaredb.FirmwareMeasurement, 0, len(expectedPCR0s))
+	for _, expectedPCR0 := range expectedPCR0s {
+		measurementType, err := newExpectedPCR0MeasurementType(expectedPCR0)
+		if err != nil {
+			return err
+		}
+		fwMeasurements = append(fwMeasurements, &firmwaredb.FirmwareMeasurement{
+			FirmwareMeasurementType: measurementType,
+			Value:                   expectedPCR0.Value,
+		})
+	}
+
+	if err := ctrl.OriginalFWDB.UpsertMeasurements(ctx, version, fwMeasurements); err != nil {
+		return fmt.Errorf("unable to store the measurements: %w", err)
+	}
+	logger.FromCtx(ctx).Infof("stored %d expected measurements of firmware '%s'", len(fwMeasurements), version)
+	metrics.FromCtx(ctx).Count("expectedMeasurementsStored").Add(uint64(len(fwMeasurements)))
+	return nil
+}
+
+func newExpectedPCR0MeasurementType(expectedPCR0 measurements.ExpectedPCR0) (*firmwaredb.FirmwareMeasurementType, error) {
+	regs := make(registers.Registers, len(expectedPCR0.Registers))
+	copy(regs, expectedPCR0.Registers)
+	sort.Slice(regs, func(i, j int) bool {
+		return regs[i].ID() < regs[j].ID()
+	})
+	regsJSON, err := json.Marshal(regs)
+	if err != nil {
+		return nil, fmt.Errorf("unable to serialize registers: %w", err)
+	}
+	regsHash := sha512.Sum512(regsJSON)
+
+	measurementType := &firmwaredb.FirmwareMeasurementType{
+		Name: fmt.Sprintf("PCR0_%s/%s/%X",
+			expectedPCR0.HashAlgo, expectedPCR0.Flow.Name, regsHash[:8]),
+		Description: "expected PCR0 value, calculated by boot flow simulation",
+	}
+	metadata := [][2]string{
+		{firmwaredb.MeasurementMetadataKeyPCRIndex, strconv.Itoa(0)},
+		{firmwaredb.MeasurementMetadataKeyHashAlgo, expectedPCR0.HashAlgo.String()},
+		{firmwaredb.MeasurementMetadataKeyFlow, expectedPCR0.Flow.Name},
+		{firmwaredb.MeasurementMetadataKeyRegisters, string(regsJSON)},
+	}
+	if len(expectedPCR0.TPMDevices) == 1 {
+		// The TPM device is recorded only if the bank is specific to it.
+		metadata = append(metadata, [2]string{firmwaredb.MeasurementMetadataKeyTPMDevice, expectedPCR0.TPMDevices[0].String()})
+	}
+	for _, kv := range metadata {
+		measurementType.Metadata = append(measurementType.Metadata, &firmwaredb.FirmwareMeasurementMetadata{
+			Key:   kv[0],
+			Value: kv[1],
+		})
+	}
+	return measurementType, nil
+}
+
+// isExpectedMeasurementType returns true if the measurement type is
+// populated by the server (see newExpectedPCR0MeasurementType).
+func isExpectedMeasurementType(measurementType *firmwaredb.FirmwareMeasurementType) bool {
+	if measurementType == nil {
+		return false
+	}
+	for _, metadata := range measurementType.Metadata {
+		if metadata.Key == firmwaredb.MeasurementMetadataKeyFlow {
+			return true
+		}
+	}
+	return false
+}
+
+// carryOverExpectedMeasurements adds the expected measurements populated by
+// the server from the stored firmware entry to the updated one, unless
+// the version is changed (then they are not valid anymore) or the updated
+// entry already has a measurement of the same type.
+//
+// Returns the amount of the carried over measurements.
+func carryOverExpectedMeasurements(updated, stored *firmwaredb.Firmware) int {
+	if updated.Version != stored.Version {
+		return 0
+	}
+	typeNames := map[string]struct{}{}
+	for _, measurement := range updated.Measurements {
+		if measurement.FirmwareMeasurementType != nil {
+			typeNames[measurement.FirmwareMeasurementType.Name] = struct{}{}
+		}
+	}
+
+	count := 0
+	for _, measurement := range stored.Measurements {
+		if !isExpectedMeasurementType(measurement.FirmwareMeasurementType) {
+			continue
+		}
+		if _, ok := typeNames[measurement.FirmwareMeasurementType.Name]; ok {
+			continue
+		}
+		updated.Measurements = append(updated.Measurements, &firmwaredb.FirmwareMeasurement{
+			FirmwareMeasurementType: measurement.FirmwareMeasurementType,
+			Value:                   measurement.Value,
+		})
+		count++
+	}
+	return count
+}
@@ -0,0 +1,116 @@
+// Copyright 2023 Meta Platforms, Inc. and affiliates.
+//
+// Redistribution and use in source and binary forms, with or without modification, are permitted provided that the following conditions are met:
+//
+// 1. Redistributions of source code must retain the above copyright notice, this list of conditions and the following disclaimer.
+//
+// 2. Redistributions in binary form must reproduce the above copyright notice, this list of conditions and the following disclaimer in the documentation and/or other materials provided with the distribution.
+//
+// 3. Neither the name of the copyright holder nor the names of its contributors may be used to endorse or promote products derived from this software without specific prior written permission.
+//
+// THIS SOFTWARE IS PROVIDED BY THE COPYRIGHT HOLDERS AND CONTRIBUTORS "AS IS" AND ANY EXPRESS OR IMPLIED WARRANTIES, INCLUDING, BUT NOT LIMITED TO, THE IMPLIED WARRANTIES OF MERCHANTABILITY AND FITNESS FOR A PARTICULAR PURPOSE ARE DISCLAIMED. IN NO EVENT SHALL THE COPYRIGHT HOLDER OR CONTRIBUTORS BE LIABLE FOR ANY DIRECT, INDIRECT, INCIDENTAL, SPECIAL, EXEMPLARY, OR CONSEQUENTIAL DAMAGES (INCLUDING, BUT NOT LIMITED TO, PROCUREMENT OF SUBSTITUTE GOODS OR SERVICES; LOSS OF USE, DATA, OR PROFITS; OR BUSINESS INTERRUPTION) HOWEVER CAUSED AND ON ANY THEORY OF LIABILITY, WHETHER IN CONTRACT, STRICT LIABILITY, OR TORT (INCLUDING NEGLIGENCE OR OTHERWISE) ARISING IN ANY WAY OUT OF THE USE OF THIS SOFTWARE, EVEN IF ADVISED OF THE POSSIBILITY OF SUCH DAMAGE.
+package controller
+
+import (
+	"context"
+	"testing"
+
+	"github.com/9elements/converged-security-suite/v2/pkg/bootflow/flows"
+	"github.com/9elements/converged-security-suite/v2/pkg/registers"
+	"github.com/9elements/converged-security-suite/v2/pkg/tpmdetection"
+	"github.com/9elements/converged-security-suite/v2/testdata/firmware"
+	"github.com/google/go-tpm/tpm2"
+	"github.com/stretchr/testify/require"
+
+	"github.com/immune-gmbh/AttestationFailureAnalysisService/pkg/firmwaredb"
+	"github.com/immune-gmbh/AttestationFailureAnalysisService/pkg/measurements"
+)
+
+func metadataMap(measurementType *firmwaredb.FirmwareMeasurementType) map[string]string {
+	result := map[string]string{}
+	for _, metadata := range measurementType.Metadata {
+		result[metadata.Key] = metadata.Value
+	}
+	return result
+}
+
+func TestNewExpectedPCR0MeasurementType(t *testing.T) {
+	regs := registers.Registers{registers.ParseACMPolicyStatusRegister(0x0000000200108681)}
+	newType := func(hashAlgo tpm2.Algorithm, regs registers.Registers) *firmwaredb.FirmwareMeasurementType {
+		measurementType, err := newExpectedPCR0MeasurementType(measurements.ExpectedPCR0{
+			Flow:       flows.IntelCBnT,
+			TPMDevices: measurements.TPMDevicesForHashAlgo(hashAlgo),
+			Registers:  regs,
+			HashAlgo:   hashAlgo,
+			Value:      []byte{1, 2, 3},
+		})
+		require.NoError(t, err)
+		return measurementType
+	}
+
+	sha1Type := newType(tpm2.AlgSHA1, regs)
+	sha256Type := newType(tpm2.AlgSHA256, regs)
+	otherRegsType := newType(tpm2.AlgSHA1, registers.Registers{registers.ParseACMPolicyStatusRegister(0x0000000200108682)})
+
+	require.NotEqual(t, sha1Type.Name, sha256Type.Name)
+	require.NotEqual(t, sha1Type.Name, otherRegsType.Name)
+	require.Equal(t, sha1Type.Name, newType(tpm2.AlgSHA1, regs).Name)
+
+	sha1Metadata := metadataMap(sha1Type)
+	require.Equal(t, "0", sha1Metadata[firmwaredb.MeasurementMetadataKeyPCRIndex])
+	require.Equal(t, tpm2.AlgSHA1.String(), sha1Metadata[firmwaredb.MeasurementMetadataKeyHashAlgo])
+	require.Equal(t, flows.IntelCBnT.Name, sha1Metadata[firmwaredb.MeasurementMetadataKeyFlow])
+	// SHA1 bank is supported by both TPM1.2 and TPM2.0.
+	require.NotContains(t, sha1Metadata, firmwaredb.MeasurementMetadataKeyTPMDevice)
+
+	sha256Metadata := metadataMap(sha256Type)
+	require.Equal(t, tpmdetection.TypeTPM20.String(), sha256Metadata[firmwaredb.MeasurementMetadataKeyTPMDevice])
+}
+
+func TestPopulateExpectedMeasurements(t *testing.T) {
+	fwDB := &fakeFirmwareDB{}
+	ctrl := &Controller{OriginalFWDB: fwDB}
+
+	err := ctrl.populateExpectedMeasurements(context.Background(), "1.2.3", firmware.FakeIntelFirmware)
+	require.NoError(t, err)
+	require.Equal(t, []string{"1.2.3"}, fwDB.upsertedVersions)
+}
+
+func TestCarryOverExpectedMeasurements(t *testing.T) {
+	expectedType, err := newExpectedPCR0MeasurementType(measurements.ExpectedPCR0{
+		Flow:       flows.IntelCBnT,
+		TPMDevices: measurements.TPMDevicesForHashAlgo(tpm2.AlgSHA256),
+		HashAlgo:   tpm2.AlgSHA256,
+		Value:      []byte{1, 2, 3},
+	})
+	require.NoError(t, err)
+	manualType := &firmwaredb.FirmwareMeasurementType{Name: "PCR0_SHA1"}
+	stored := &firmwaredb.Firmware{
+		ID:      1,
+		Version: "1.2.3",
+		Measurements: []*firmwaredb.FirmwareMeasurement{
+			{ID: 10, FirmwareID: 1, FirmwareMeasurementType: expectedType, Value: []byte{1, 2, 3}},
+			{ID: 11, FirmwareID: 1, FirmwareMeasurementType: manualType, Value: []byte{4, 5, 6}},
+		},
+	}
+
+	// the manual measurements are replaced, the populated ones are kept
+	updated := &firmwaredb.Firmware{ID: 1, Version: "1.2.3"}
+	require.Equal(t, 1, carryOverExpectedMeasurements(updated, stored))
+	require.Len(t, updated.Measurements, 1)
+	require.Equal(t, expectedType.Name, updated.Measurements[0].FirmwareMeasurementType.Name)
+	require.Zero(t, updated.Measurements[0].ID)
+	require.Equal(t, []byte{1, 2, 3}, []byte(updated.Measurements[0].Value))
+
+	// a measurement of the same type provided explicitly wins
+	updated = &firmwaredb.Firmware{ID: 1, Version: "1.2.3", Measurements: []*firmwaredb.FirmwareMeasurement{
+		{FirmwareMeasurementType: &firmwaredb.FirmwareMeasurementType{Name: expectedType.Name}, Value: []byte{7}},
+	}}
+	require.Zero(t, carryOverExpectedMeasurements(updated, stored))
+	require.Len(t, updated.Measurements, 1)
+
+	// the measurements of another version are not valid anymore
+	updated = &firmwaredb.Firmware{ID: 1, Version: "1.2.4"}
+	require.Zero(t, carryOverExpectedMeasurements(updated, stored))
+	require.Empty(t, updated.Measurements)
+}
@@ -0,0 +1,236 @@
+// Copyright 2023 Meta Platforms, Inc. and affiliates.
+//
+// Redistribution and use in source and binary forms, with or without modification, are permitted provided that the following conditions are met:
+//
+// 1. Redistributions of source code must retain the above copyright notice, this list of conditions and the following disclaimer.
+//
+// 2. Redistributions in binary form must reproduce the above copyright notice, this list of conditions and the following disclaimer in the documentation and/or other materials provided with the distribution.
+//
+// 3. Neither the name of the copyright holder nor the names of its contributors may be used to endorse or promote products derived from this software without specific prior written permission.
+//
+// THIS SOFTWARE IS PROVIDED BY THE COPYRIGHT HOLDERS AND CONTRIBUTORS "AS IS" AND ANY EXPRESS OR IMPLIED WARRANTIES, INCLUDING, BUT NOT LIMITED TO, THE IMPLIED WARRANTIES OF MERCHANTABILITY AND FITNESS FOR A PARTICULAR PURPOSE ARE DISCLAIMED. IN NO EVENT SHALL THE COPYRIGHT HOLDER OR CONTRIBUTORS BE LIABLE FOR ANY DIRECT, INDIRECT, INCIDENTAL, SPECIAL, EXEMPLARY, OR CONSEQUENTIAL DAMAGES (INCLUDING, BUT NOT LIMITED TO, PROCUREMENT OF SUBSTITUTE GOODS OR SERVICES; LOSS OF USE, DATA, OR PROFITS; OR BUSINESS INTERRUPTION) HOWEVER CAUSED AND ON ANY THEORY OF LIABILITY, WHETHER IN CONTRACT, STRICT LIABILITY, OR TORT (INCLUDING NEGLIGENCE OR OTHERWISE) ARISING IN ANY WAY OUT OF THE USE OF THIS SOFTWARE, EVEN IF ADVISED OF THE POSSIBILITY OF SUCH DAMAGE.
+package controller
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/immune-gmbh/AttestationFailureAnalysisService/pkg/analysis"
+	"github.com/immune-gmbh/AttestationFailureAnalysisService/pkg/firmwaredb"
+	"github.com/immune-gmbh/AttestationFailureAnalysisService/pkg/storage"
+	"github.com/immune-gmbh/AttestationFailureAnalysisService/pkg/storage/models"
+	"github.com/immune-gmbh/AttestationFailureAnalysisService/pkg/types"
+)
+
+// fakeStorage is an in-memory implementation of the parts of Storage used
+// by the tests. Calling a method which is not implemented panics (because
+// of the nil embedded interface).
+type fakeStorage struct {
+	Storage
+
+	mutex            sync.Mutex
+	analyzeReports   map[uint64]*models.AnalyzeReport
+	issues           map[uint64][]analysis.Issue
+	images           map[types.ImageID][]byte
+	chunks           []models.FirmwareImageChunk
+	unreferenced     []types.ImageID
+	lastID           uint64
+	getImageRequests int
+}
+
+func newFakeStorage() *fakeStorage {
+	return &fakeStorage{
+		analyzeReports: map[uint64]*models.AnalyzeReport{},
+		issues:         map[uint64][]analysis.Issue{},
+		images:         map[types.ImageID][]byte{},
+	}
+}
+
+func (stor *fakeStorage) nextID() uint64 {
+	stor.lastID++
+	return stor.lastID
+}
+
+// addAnalyzeReport stores the report (and its analyzer reports) assigning IDs.
+func (stor *fakeStorage) addAnalyzeReport(report *models.AnalyzeReport) {
+	stor.mutex.Lock()
+	defer stor.mutex.Unlock()
+	report.ID = stor.nextID()
+	for idx := range report.AnalyzerReports {
+		report.AnalyzerReports[idx].ID = stor.nextID()
+		report.AnalyzerReports[idx].AnalyzeReportID = report.ID
+	}
+	stor.analyzeReports[report.ID] = report
+}
+
+func (stor *fakeStorage) GetFirmwareBytes(ctx context.Context, imageID types.ImageID) ([]byte, error) {
+	stor.mutex.Lock()
+	defer stor.mutex.Unlock()
+	stor.getImageRequests++
+	image, ok := stor.images[imageID]
+	if !ok {
+		return nil, storage.ErrNotFound{Query: imageID.String()}
+	}
+	return image, nil
+}
+
+func (stor *fakeStorage) FindExpiredAnalyzeReportIDs(
+	ctx context.Context,
+	filter storage.ExpiredAnalyzeReportsFilter,
+	limit uint,
+) ([]uint64, error) {
+	stor.mutex.Lock()
+	defer stor.mutex.Unlock()
+	var ids []uint64
+	for id, report := range stor.analyzeReports {
+		if id > filter.AfterID && report.Timestamp.Before(filter.OlderThan) {
+			ids = append(ids, id)
+		}
+	}
+	sort.Slice(ids, func(i, j int) bool { return ids[i] < ids[j] })
+	if limit != 0 && uint(len(ids)) > limit {
+		ids = ids[:limit]
+	}
+	return ids, nil
+}
+
+func (stor *fakeStorage) GetAnalyzerReports(ctx context.Context, analyzeReportIDs []uint64) ([]models.AnalyzerReport, error) {
+	stor.mutex.Lock()
+	defer stor.mutex.Unlock()
+	var result []models.AnalyzerReport
+	for _, id := range analyzeReportIDs {
+		for _, analyzerReport := range stor.analyzeReports[id].AnalyzerReports {
+			if analyzerReport.SupersededBy == nil {
+				result = append(result, analyzerReport)
+			}
+		}
+	}
+	return result, nil
+}
+
+func (stor *fakeStorage) DeleteAnalyzeReports(ctx context.Context, ids []uint64) (int64, error) {
+	stor.mutex.Lock()
+	defer stor.mutex.Unlock()
+	var deleted int64
+	for _, id := range ids {
+		if _, ok := stor.analyzeReports[id]; ok {
+			delete(stor.analyzeReports, id)
+			deleted++
+		}
+	}
+	return deleted, nil
+}
+
+func (stor *fakeStorage) FindUnreferencedFirmware(ctx context.Context, addedBefore time.Time, limit uint) ([]types.ImageID, error) {
+	stor.mutex.Lock()
+	defer stor.mutex.Unlock()
+	result := stor.unreferenced
+	if limit != 0 && uint(len(result)) > limit {
+		result = result[:limit]
+	}
+	return append([]types.ImageID{}, result...), nil
+}
+
+func (stor *fakeStorage) DeleteFirmware(ctx context.Context, imageID types.ImageID) error {
+	stor.mutex.Lock()
+	defer stor.mutex.Unlock()
+	for idx, unreferenced := range stor.unreferenced {
+		if unreferenced == imageID {
+			stor.unreferenced = append(stor.unreferenced[:idx], stor.unreferenced[idx+1:]...)
+			delete(stor.images, imageID)
+			return nil
+		}
+	}
+	return fmt.Errorf("image %s is not found", imageID)
+}
+
+func (stor *fakeStorage) FindReproducedPCRsWithImageMetadataByPCR0(ctx context.Context, pcr0 []byte) ([]models.ReproducedPCRs, []models.FirmwareImageMetadata, error) {
+	return nil, nil, nil
+}
+
+// fakeFirmwareDB is an in-memory implementation of the parts of firmwaredb.DB
+// used by the tests.
+type fakeFirmwareDB struct {
+	firmwaredb.DB
+
+	measurements     []*firmwaredb.FirmwareMeasurement
+	upsertedVersions []string
+}
+
+func (db *fakeFirmwareDB) UpsertMeasurements(ctx context.Context, version string, measurements []*firmwaredb.FirmwareMeasurement) error {
+	db.upsertedVersions = append(db.upsertedVersions, version)
+	return nil
+}
+
+func (db *fakeFirmwareDB) FindMeasurements(ctx context.Context, value []byte) ([]*firmwaredb.FirmwareMeasurement, error) {
+	var result []*firmwaredb.FirmwareMeasurement
+	for _, measurement := range db.measurements {
+		if bytes.Equal(measurement.Value, value) {
+			result = append(result, measurement)
+		}
+	}
+	return result, nil
+}
+
+// InsertReportIssues follows the contract of storage.Storage.InsertReportIssues.
+func (stor *fakeStorage) InsertReportIssues(ctx context.Context, reports []models.AnalyzerReport) error {
+	stor.mutex.Lock()
+	defer stor.mutex.Unlock()
+	for _, report := range reports {
+		if report.ID == 0 {
+			return fmt.Errorf("analyzer report of analyzer %s is not stored", report.AnalyzerID)
+		}
+		if report.Report == nil {
+			continue
+		}
+		stor.issues[report.ID] = append(stor.issues[report.ID], report.Report.Issues...)
+	}
+	return nil
+}
+
+// InsertReanalysisReport follows the contract of storage.Storage.InsertReanalysisReport.
+func (stor *fakeStorage) InsertReanalysisReport(ctx context.Context, report *models.AnalyzerReport) error {
+	stor.mutex.Lock()
+	defer stor.mutex.Unlock()
+	if report.ReanalysisOf == nil {
+		return fmt.Errorf("the report is not a re-analysis report (ReanalysisOf is not set)")
+	}
+	analyzeReport := stor.analyzeReports[report.AnalyzeReportID]
+	if analyzeReport == nil {
+		return fmt.Errorf("analyze report %d is not found", report.AnalyzeReportID)
+	}
+	for idx := range analyzeReport.AnalyzerReports {
+		previous := &analyzeReport.AnalyzerReports[idx]
+		if previous.ID != *report.ReanalysisOf {
+			continue
+		}
+		if previous.SupersededBy != nil {
+			break
+		}
+		id := stor.nextID()
+		report.ID = id
+		previous.SupersededBy = &id
+		analyzeReport.AnalyzerReports = append(analyzeReport.AnalyzerReports, *report)
+		return nil
+	}
+	return fmt.Errorf("analyzer report %d is not found or is already superseded", *report.ReanalysisOf)
+}
+
+func (stor *fakeStorage) FindFirmwareChunks(ctx context.Context, hashes [][]byte) ([]models.FirmwareImageChunk, error) {
+	stor.mutex.Lock()
+	defer stor.mutex.Unlock()
+	var result []models.FirmwareImageChunk
+	for _, chunk := range stor.chunks {
+		for _, hash := range hashes {
+			if bytes.Equal(chunk.HashSHA256, hash) {
+				result = append(result, chunk)
+				break
+			}
+		}
+	}
+	return result, nil
+}
@@ -0,0 +1,258 @@
+// Copyright 2023 Meta Platforms, Inc. and affiliates.
+//
+// Redistribution and use in source and binary forms, with or without modification, are permitted provided that the following conditions are met:
+//
+// 1. Redistributions of source code must retain the above copyright notice, this list of conditions and the following disclaimer.
+//
+// 2. Redistributions in binary form must reproduce the above copyright notice, this list of conditions and the following disclaimer in the documentation and/or other materials provided with the distribution.
+//
+// 3. Neither the name of the copyright holder nor the names of its contributors may be used to endorse or promote products derived from this software without specific prior written permission.
+//
+// THIS SOFTWARE IS PROVIDED BY THE COPYRIGHT HOLDERS AND CONTRIBUTORS "AS IS" AND ANY EXPRESS OR IMPLIED WARRANTIES, INCLUDING, BUT NOT LIMITED TO, THE IMPLIED WARRANTIES OF MERCHANTABILITY AND FITNESS FOR A PARTICULAR PURPOSE ARE DISCLAIMED. IN NO EVENT SHALL THE COPYRIGHT HOLDER OR CONTRIBUTORS BE LIABLE FOR ANY DIRECT, INDIRECT, INCIDENTAL, SPECIAL, EXEMPLARY, OR CONSEQUENTIAL DAMAGES (INCLUDING, BUT NOT LIMITED TO, PROCUREMENT OF SUBSTITUTE GOODS OR SERVICES; LOSS OF USE, DATA, OR PROFITS; OR BUSINESS INTERRUPTION) HOWEVER CAUSED AND ON ANY THEORY OF LIABILITY, WHETHER IN CONTRACT, STRICT LIABILITY, OR TORT (INCLUDING NEGLIGENCE OR OTHERWISE) ARISING IN ANY WAY OUT OF THE USE OF THIS SOFTWARE, EVEN IF ADVISED OF THE POSSIBILITY OF SUCH DAMAGE.
+package controller
+
+import (
+	"context"
+	"time"
+
+	"github.com/facebookincubator/go-belt/beltctx"
+	"github.com/facebookincubator/go-belt/tool/experimental/errmon"
+	"github.com/facebookincubator/go-belt/tool/experimental/metrics"
+	"github.com/facebookincubator/go-belt/tool/experimental/tracer"
+	"github.com/facebookincubator/go-belt/tool/logger"
+
+	"github.com/immune-gmbh/AttestationFailureAnalysisService/pkg/analysis"
+	"github.com/immune-gmbh/AttestationFailureAnalysisService/pkg/storage"
+	"github.com/immune-gmbh/AttestationFailureAnalysisService/pkg/storage/models"
+)
+
+const (
+	gcBatchSizeDefault = 1000
+)
+
+// RetentionPolicy defines which analyze reports and firmware images
+// are deleted by the background garbage collector of the Controller.
+type RetentionPolicy struct {
+	// GCInterval is the interval between garbage collection runs.
+	// Zero value disables the garbage collector.
+	GCInterval time.Duration
+
+	// MaxReportAge is the age after which an analyze report is expired.
+	// Zero value means no limit.
+	MaxReportAge time.Duration
+
+	// MaxReportsPerAsset is the amount of the newest analyze reports
+	// kept for each asset, the rest are expired. Zero value means no limit.
+	MaxReportsPerAsset uint
+
+	// KeepCritical prevents deletion of expired analyze reports which
+	// contain an issue of severity analysis.SeverityCritical.
+	KeepCritical bool
+
+	// ImageGracePeriod is the minimal age of a firmware image to be deleted
+	// if it is not referenced by any analyzer report. It should be large enough
+	// to cover the time between saving an image and saving the report referencing it.
+	// Zero value disables deletion of images.
+	ImageGracePeriod time.Duration
+
+	// BatchSize is the maximal amount of entities processed at once.
+	// Zero value means the default value.
+	BatchSize uint
+
+	// DryRun makes the garbage collector only log and count what it would
+	// delete, without deleting anything.
+	DryRun bool
+}
+
+func (policy RetentionPolicy) reportsFilter(now time.Time) storage.ExpiredAnalyzeReportsFilter {
+	var filter storage.ExpiredAnalyzeReportsFilter
+	if policy.MaxReportAge != 0 {
+		filter.OlderThan = now.Add(-policy.MaxReportAge)
+	}
+	filter.MaxReportsPerAsset = policy.MaxReportsPerAsset
+	return filter
+}
+
+func (policy RetentionPolicy) batchSize() uint {
+	if policy.BatchSize == 0 {
+		return gcBatchSizeDefault
+	}
+	return policy.BatchSize
+}
+
+func (ctrl *Controller) gcLoop(
+	ctx context.Context,
+	policy RetentionPolicy,
+) {
+	if policy.GCInterval == 0 {
+		return
+	}
+	ctx = beltctx.WithField(ctx, "gcDryRun", policy.DryRun)
+
+	gcTicker := time.NewTicker(policy.GCInterval)
+	defer gcTicker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-gcTicker.C:
+			ctrl.collectGarbage(ctx, policy)
+		}
+	}
+}
+
+func (ctrl *Controller) collectGarbage(
+	ctx context.Context,
+	policy RetentionPolicy,
+) {
+	span, ctx := tracer.StartChildSpanFromCtx(ctx, "collectGarbage")
+	defer span.Finish()
+
+	log := logger.FromCtx(ctx)
+	log.Infof("garbage collection started")
+
+	now := time.Now()
+	reportsDeleted, err := ctrl.collectAnalyzeReports(ctx, policy, now)
+	if err != nil {
+		errmon.ObserveErrorCtx(ctx, err)
+		metrics.FromCtx(ctx).Count("gcErrors").Add(1)
+		log.Errorf("unable to collect expired analyze reports: %v", err)
+	}
+
+	imagesDeleted, err := ctrl.collectFirmwareImages(ctx, policy, now)
+	if err != nil {
+		errmon.ObserveErrorCtx(ctx, err)
+		metrics.FromCtx(ctx).Count("gcErrors").Add(1)
+		log.Errorf("unable to collect unreferenced firmware images: %v", err)
+	}
+
+	log.Infof("garbage collection finished: analyze reports: %d, images: %d", reportsDeleted, imagesDeleted)
+}
+
+// collectAnalyzeReports deletes expired analyze reports (including their analyzer reports).
+func (ctrl *Controller) collectAnalyzeReports(
+	ctx context.Context,
+	policy RetentionPolicy,
+	now time.Time,
+) (uint64, error) {
+	filter := policy.reportsFilter(now)
+	if filter.IsEmpty() {
+		return 0, nil
+	}
+
+	log := logger.FromCtx(ctx)
+	var total uint64
+	for ctx.Err() == nil {
+		ids, err := ctrl.FirmwareStorage.FindExpiredAnalyzeReportIDs(ctx, filter, policy.batchSize())
+		if err != nil {
+			return total, err
+		}
+		if len(ids) == 0 {
+			break
+		}
+		filter.AfterID = ids[len(ids)-1]
+
+		if policy.KeepCritical {
+			analyzerReports, err := ctrl.FirmwareStorage.GetAnalyzerReports(ctx, ids)
+			if err != nil {
+				return total, err
+			}
+			var kept int
+			ids, kept = filterOutCritical(ids, analyzerReports)
+			metrics.FromCtx(ctx).Count("gcAnalyzeReportsKeptCritical").Add(uint64(kept))
+		}
+		if len(ids) == 0 {
+			continue
+		}
+		metrics.FromCtx(ctx).Count("gcAnalyzeReportsExpired").Add(uint64(len(ids)))
+
+		if policy.DryRun {
+			log.Infof("dry run: would delete analyze reports: %v", ids)
+			total += uint64(len(ids))
+			continue
+		}
+
+		deleted, err := ctrl.FirmwareStorage.DeleteAnalyzeReports(ctx, ids)
+		if err != nil {
+			return total, err
+		}
+		log.Debugf("deleted analyze reports: %v", ids)
+		metrics.FromCtx(ctx).Count("gcAnalyzeReportsDeleted").Add(uint64(deleted))
+		total += uint64(deleted)
+	}
+
+	return total, nil
+}
+
+// filterOutCritical returns the IDs of analyze reports which has no critical issues
+// and the amount of the filtered out IDs.
+func filterOutCritical(
+	analyzeReportIDs []uint64,
+	analyzerReports []models.AnalyzerReport,
+) ([]uint64, int) {
+	critical := map[uint64]struct{}{}
+	for _, analyzerReport := range analyzerReports {
+		if analyzerReport.Report == nil {
+			continue
+		}
+		for _, issue := range analyzerReport.Report.Issues {
+			if issue.Severity == analysis.SeverityCritical {
+				critical[analyzerReport.AnalyzeReportID] = struct{}{}
+				break
+			}
+		}
+	}
+
+	result := make([]uint64, 0, len(analyzeReportIDs))
+	for _, id := range analyzeReportIDs {
+		if _, ok := critical[id]; ok {
+			continue
+		}
+		result = append(result, id)
+	}
+	return result, len(analyzeReportIDs) - len(result)
+}
+
+// collectFirmwareImages deletes firmware images which are not referenced
+// by any analyzer report.
+func (ctrl *Controller) collectFirmwareImages(
+	ctx context.Context,
+	policy RetentionPolicy,
+	now time.Time,
+) (uint64, error) {
+	if policy.ImageGracePeriod == 0 {
+		return 0, nil
+	}
+
+	log := logger.FromCtx(ctx)
+	imageIDs, err := ctrl.FirmwareStorage.FindUnreferencedFirmware(ctx, now.Add(-policy.ImageGracePeriod), policy.batchSize())
+	if err != nil {
+		return 0, err
+	}
+	metrics.FromCtx(ctx).Count("gcImagesExpired").Add(uint64(len(imageIDs)))
+
+	var total uint64
+	for _, imageID := range imageIDs {
+		if ctx.Err() != nil {
+			break
+		}
+		if policy.DryRun {
+			log.Infof("dry run: would delete image %s", imageID)
+			total++
+			continue
+		}
+
+		if err := ctrl.FirmwareStorage.DeleteFirmware(ctx, imageID); err != nil {
+			// The image might have got referenced in the meanwhile; it will be
+			// reconsidered during the next run.
+			log.Warnf("unable to delete image %s: %v", imageID, err)
+			metrics.FromCtx(ctx).Count("gcErrors").Add(1)
+			continue
+		}
+		metrics.FromCtx(ctx).Count("gcImagesDeleted").Add(1)
+		total++
+	}
+
+	return total, nil
+}
@@ -0,0 +1,73 @@
+// Copyright 2023 Meta Platforms, Inc. and affiliates.
+//
+// Redistribution and use in source and binary forms, with or without modification, are permitted provided that the following conditions are met:
+//
+// 1. Redistributions of source code must retain the above copyright notice, this list of conditions and the following disclaimer.
+//
+// 2. Redistributions in binary form must reproduce the above copyright notice, this list of conditions and the following disclaimer in the documentation and/or other materials provided with the distribution.
+//
+// 3. Neither the name of the copyright holder nor the names of its contributors may be used to endorse or promote products derived from this software without specific prior written permission.
+//
+// THIS SOFTWARE IS PROVIDED BY THE COPYRIGHT HOLDERS AND CONTRIBUTORS "AS IS" AND ANY EXPRESS OR IMPLIED WARRANTIES, INCLUDING, BUT NOT LIMITED TO, THE IMPLIED WARRANTIES OF MERCHANTABILITY AND FITNESS FOR A PARTICULAR PURPOSE ARE DISCLAIMED. IN NO EVENT SHALL THE COPYRIGHT HOLDER OR CONTRIBUTORS BE LIABLE FOR ANY DIRECT, INDIRECT, INCIDENTAL, SPECIAL, EXEMPLARY, OR CONSEQUENTIAL DAMAGES (INCLUDING, BUT NOT LIMITED TO, PROCUREMENT OF SUBSTITUTE GOODS OR SERVICES; LOSS OF USE, DATA, OR PROFITS; OR BUSINESS INTERRUPTION) HOWEVER CAUSED AND ON ANY THEORY OF LIABILITY, WHETHER IN CONTRACT, STRICT LIABILITY, OR TORT (INCLUDING NEGLIGENCE OR OTHERWISE) ARISING IN ANY WAY OUT OF THE USE OF THIS SOFTWARE, EVEN IF ADVISED OF THE POSSIBILITY OF SUCH DAMAGE.
+package controller
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/immune-gmbh/AttestationFailureAnalysisService/pkg/analysis"
+	"github.com/immune-gmbh/AttestationFailureAnalysisService/pkg/storage/models"
+	"github.com/immune-gmbh/AttestationFailureAnalysisService/pkg/types"
+)
+
+func TestCollectGarbage(t *testing.T) {
+	now := time.Now()
+	newReport := func(age time.Duration, severity analysis.Severity) *models.AnalyzeReport {
+		return &models.AnalyzeReport{
+			Timestamp: now.Add(-age),
+			AnalyzerReports: []models.AnalyzerReport{{
+				AnalyzerID: "DiffMeasuredBoot",
+				Report:     &analysis.Report{Issues: []analysis.Issue{{Severity: severity}}},
+			}},
+		}
+	}
+
+	stor := newFakeStorage()
+	oldInfo := newReport(48*time.Hour, analysis.SeverityInfo)
+	oldCritical := newReport(48*time.Hour, analysis.SeverityCritical)
+	fresh := newReport(time.Hour, analysis.SeverityInfo)
+	for _, report := range []*models.AnalyzeReport{oldInfo, oldCritical, fresh} {
+		stor.addAnalyzeReport(report)
+	}
+	unreferencedImage := types.NewImageIDFromImage([]byte{1})
+	stor.images[unreferencedImage] = []byte{1}
+	stor.unreferenced = []types.ImageID{unreferencedImage}
+
+	ctrl := &Controller{FirmwareStorage: stor}
+	policy := RetentionPolicy{
+		MaxReportAge:     24 * time.Hour,
+		KeepCritical:     true,
+		ImageGracePeriod: time.Hour,
+		BatchSize:        1,
+	}
+
+	t.Run("dry_run", func(t *testing.T) {
+		dryRunPolicy := policy
+		dryRunPolicy.DryRun = true
+		ctrl.collectGarbage(context.Background(), dryRunPolicy)
+		require.Len(t, stor.analyzeReports, 3)
+		require.Len(t, stor.unreferenced, 1)
+	})
+
+	t.Run("collect", func(t *testing.T) {
+		ctrl.collectGarbage(context.Background(), policy)
+		require.NotContains(t, stor.analyzeReports, oldInfo.ID)
+		require.Contains(t, stor.analyzeReports, oldCritical.ID)
+		require.Contains(t, stor.analyzeReports, fresh.ID)
+		require.Empty(t, stor.unreferenced)
+		require.NotContains(t, stor.images, unreferencedImage)
+	})
+}
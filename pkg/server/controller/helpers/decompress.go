@@ -21,10 +21,24 @@ import (
 	"github.com/ulikunitz/xz"
 )
 
-// Decompress decompresses image in accordance with compression algorithm
+// MaxDecompressedSize is the default limit of the size of decompressed data.
+const MaxDecompressedSize = 1 << 30
+
+// Decompress decompresses image in accordance with compression algorithm.
+// The decompressed data is limited by MaxDecompressedSize.
 func Decompress(b []byte, compressionType afas.CompressionType) ([]byte, error) {
+	return DecompressWithLimit(b, compressionType, MaxDecompressedSize)
+}
+
+// DecompressWithLimit decompresses image in accordance with compression algorithm,
+// but returns an error (without decompressing the rest) as soon as the decompressed
+// data exceeds maxSize bytes.
+func DecompressWithLimit(b []byte, compressionType afas.CompressionType, maxSize uint64) ([]byte, error) {
 	switch compressionType {
 	case afas.CompressionType_None:
+		if uint64(len(b)) > maxSize {
+			return nil, ErrDecompressedSizeLimit{Limit: maxSize}
+		}
 		return b, nil
 	case afas.CompressionType_XZ:
 		r, err := xz.ReaderConfig{SingleStream: true}.NewReader(bytes.NewReader(b))
@@ -32,10 +46,13 @@ func Decompress(b []byte, compressionType afas.CompressionType) ([]byte, error)
 			return nil, fmt.Errorf("unable to create XZ reader: %w", err)
 		}
 		var decompressed bytes.Buffer
-		_, err = io.Copy(&decompressed, r)
+		n, err := io.Copy(&decompressed, io.LimitReader(r, int64(maxSize)+1))
 		if err != nil {
 			return nil, fmt.Errorf("unable to decompress XZ data: %w", err)
 		}
+		if uint64(n) > maxSize {
+			return nil, ErrDecompressedSizeLimit{Limit: maxSize}
+		}
 		return decompressed.Bytes(), nil
 	default:
 		return nil, fmt.Errorf("unknown compression type: %s", compressionType)
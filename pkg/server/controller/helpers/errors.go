@@ -56,3 +56,11 @@ type ErrUnexpectedAmountOfBIOSRegions struct {
 func (err ErrUnexpectedAmountOfBIOSRegions) Error() string {
 	return fmt.Sprintf("expected amount of BIOS regions is one, but found: %d", err.FoundCount)
 }
+
+type ErrDecompressedSizeLimit struct {
+	Limit uint64
+}
+
+func (err ErrDecompressedSizeLimit) Error() string {
+	return fmt.Sprintf("decompressed data exceeds the limit of %d bytes", err.Limit)
+}
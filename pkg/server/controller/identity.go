@@ -21,19 +21,23 @@ import (
 	"github.com/immune-gmbh/AttestationFailureAnalysisService/pkg/identity"
 )
 
-// ExtractHostnameFromCtx extracts hostname from provided thrift context
+// ExtractHostnameFromCtx extracts hostname from provided thrift context.
+//
+// isVerified is true if the hostname is proven by a client identity
+// (see identity.NewIdentitiesFromContext).
 func ExtractHostnameFromCtx(ctx context.Context) (hostname string, isVerified bool) {
 	clientIdentities, err := identity.NewIdentitiesFromContext(ctx)
 	if err != nil {
+		logger.FromCtx(ctx).Warnf("unable to get client identities: %v", err)
 		return "", false
 	}
 
 	for _, clientIdentity := range clientIdentities {
-		hostname = clientIdentity.TLSChain()[0].DNSNames[0]
+		if hostnames := identity.Hostnames(clientIdentity); len(hostnames) > 0 {
+			return hostnames[0], true
+		}
 	}
-
-	// set isVerified to true if TLSChain is valid
-	return
+	return "", false
 }
 
 func enrichHostInfo(ctx context.Context, device *device.Device, isVerified bool, hostInfo *afas.HostInfo) {
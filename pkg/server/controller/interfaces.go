@@ -14,6 +14,7 @@ package controller
 import (
 	"context"
 	"io"
+	"time"
 
 	"github.com/jmoiron/sqlx"
 
@@ -33,13 +34,31 @@ type Storage interface {
 	GetFirmwareBytes(ctx context.Context, imageID types.ImageID) (firmwareImage []byte, err error)
 	FindFirmware(ctx context.Context, filters storage.FindFirmwareFilter) (imageMetas []*models.FirmwareImageMetadata, unlockFn context.CancelFunc, err error)
 	FindFirmwareOne(ctx context.Context, filters storage.FindFirmwareFilter) (*models.FirmwareImageMetadata, context.CancelFunc, error)
+	FindUnreferencedFirmware(ctx context.Context, addedBefore time.Time, limit uint) ([]types.ImageID, error)
+	DeleteFirmware(ctx context.Context, imageID types.ImageID) error
+	FindFirmwareChunks(ctx context.Context, hashes [][]byte) ([]models.FirmwareImageChunk, error)
 
 	// ReproducedPCRs
 	UpsertReproducedPCRs(ctx context.Context, reproducedPCRs models.ReproducedPCRs) error
+	FindReproducedPCRsWithImageMetadataByPCR0(ctx context.Context, pcr0 []byte) ([]models.ReproducedPCRs, []models.FirmwareImageMetadata, error)
 
 	// AnalyzeReport
 	InsertAnalyzeReport(ctx context.Context, report *models.AnalyzeReport) error
+	InsertReportIssues(ctx context.Context, reports []models.AnalyzerReport) error
 	FindAnalyzeReports(ctx context.Context, filterInput storage.AnalyzeReportFindFilter, tx *sqlx.Tx, limit uint) ([]*models.AnalyzeReport, error)
+	FindExpiredAnalyzeReportIDs(ctx context.Context, filter storage.ExpiredAnalyzeReportsFilter, limit uint) ([]uint64, error)
+	GetAnalyzerReports(ctx context.Context, analyzeReportIDs []uint64) ([]models.AnalyzerReport, error)
+	AggregateReports(ctx context.Context, q storage.AggregateReportsQuery) ([]storage.AggregateReportsGroup, error)
+	AttachAnalyzeReportToGroup(ctx context.Context, analyzeReportID uint64, key models.AnalyzeReportGroupKey, alert []byte) (bool, error)
+	FindPendingAnalyzeReportGroupAlerts(ctx context.Context, limit uint) ([]models.AnalyzeReportGroup, error)
+	SetAnalyzeReportGroupAlertDelivered(ctx context.Context, key models.AnalyzeReportGroupKey, deliveredAt time.Time) error
+	FindTamperingRegistryEntries(ctx context.Context, filter storage.TamperingRegistryFilter) ([]models.TamperingRegistryEntry, error)
+	InsertTamperingRegistryEntry(ctx context.Context, entry *models.TamperingRegistryEntry) error
+	UpdateTamperingRegistryEntry(ctx context.Context, entry models.TamperingRegistryEntry) error
+	DeleteTamperingRegistryEntry(ctx context.Context, id uint64) error
+	DeleteAnalyzeReports(ctx context.Context, ids []uint64) (int64, error)
+	FindOutdatedAnalyzerReports(ctx context.Context, filter storage.OutdatedAnalyzerReportsFilter, limit uint) ([]models.AnalyzerReport, error)
+	InsertReanalysisReport(ctx context.Context, report *models.AnalyzerReport) error
 }
 
 type DeviceGetter interface {
@@ -0,0 +1,120 @@
+// Copyright 2023 Meta Platforms, Inc. and affiliates.
+//
+// Redistribution and use in source and binary forms, with or without modification, are permitted provided that the following conditions are met:
+//
+// 1. Redistributions of source code must retain the above copyright notice, this list of conditions and the following disclaimer.
+//
+// 2. Redistributions in binary form must reproduce the above copyright notice, this list of conditions and the following disclaimer in the documentation and/or other materials provided with the distribution.
+//
+// 3. Neither the name of the copyright holder nor the names of its contributors may be used to endorse or promote products derived from this software without specific prior written permission.
+//
+// THIS SOFTWARE IS PROVIDED BY THE COPYRIGHT HOLDERS AND CONTRIBUTORS "AS IS" AND ANY EXPRESS OR IMPLIED WARRANTIES, INCLUDING, BUT NOT LIMITED TO, THE IMPLIED WARRANTIES OF MERCHANTABILITY AND FITNESS FOR A PARTICULAR PURPOSE ARE DISCLAIMED. IN NO EVENT SHALL THE COPYRIGHT HOLDER OR CONTRIBUTORS BE LIABLE FOR ANY DIRECT, INDIRECT, INCIDENTAL, SPECIAL, EXEMPLARY, OR CONSEQUENTIAL DAMAGES (INCLUDING, BUT NOT LIMITED TO, PROCUREMENT OF SUBSTITUTE GOODS OR SERVICES; LOSS OF USE, DATA, OR PROFITS; OR BUSINESS INTERRUPTION) HOWEVER CAUSED AND ON ANY THEORY OF LIABILITY, WHETHER IN CONTRACT, STRICT LIABILITY, OR TORT (INCLUDING NEGLIGENCE OR OTHERWISE) ARISING IN ANY WAY OUT OF THE USE OF THIS SOFTWARE, EVEN IF ADVISED OF THE POSSIBILITY OF SUCH DAMAGE.
+package controller
+
+import (
+	"context"
+	"errors"
+
+	"github.com/facebookincubator/go-belt/tool/logger"
+
+	"github.com/immune-gmbh/AttestationFailureAnalysisService/if/generated/afas"
+	"github.com/immune-gmbh/AttestationFailureAnalysisService/if/typeconv"
+	"github.com/immune-gmbh/AttestationFailureAnalysisService/pkg/firmwaredb"
+	"github.com/immune-gmbh/AttestationFailureAnalysisService/pkg/identity"
+)
+
+// checkAdmin returns ErrPermissionDenied if the client is not an admin.
+func checkAdmin(ctx context.Context, action string) error {
+	clientIdentities, err := identity.NewIdentitiesFromContext(ctx)
+	if err != nil {
+		logger.FromCtx(ctx).Warnf("unable to get client identities: %v", err)
+		return ErrPermissionDenied{Action: action}
+	}
+	if !identity.IsAdmin(ctx, clientIdentities) {
+		return ErrPermissionDenied{Action: action}
+	}
+	return nil
+}
+
+// InsertOriginalFirmware adds an entry to the original firmware database.
+func (ctrl *Controller) InsertOriginalFirmware(
+	ctx context.Context,
+	fw *afas.OriginalFirmware,
+) (int64, error) {
+	if err := checkAdmin(ctx, "insert an original firmware"); err != nil {
+		return 0, err
+	}
+
+	entry, err := typeconv.FromThriftOriginalFirmware(fw)
+	if err != nil {
+		return 0, ErrInvalidRequest{Err: err}
+	}
+	entry.ID = 0
+
+	if err := ctrl.OriginalFWDB.Insert(ctx, entry); err != nil {
+		return 0, convertFirmwareDBError(err)
+	}
+	logger.FromCtx(ctx).Infof("inserted original firmware %d: %s %s", entry.ID, entry.Type, entry.Version)
+	ctrl.measuredVersions.Store(entry.Version, struct{}{})
+	ctrl.populateExpectedMeasurementsAsync(ctx, entry.Version, nil)
+	return entry.ID, nil
+}
+
+// UpdateOriginalFirmware replaces an entry of the original firmware database.
+func (ctrl *Controller) UpdateOriginalFirmware(
+	ctx context.Context,
+	fw *afas.OriginalFirmware,
+) error {
+	if err := checkAdmin(ctx, "update an original firmware"); err != nil {
+		return err
+	}
+
+	entry, err := typeconv.FromThriftOriginalFirmware(fw)
+	if err != nil {
+		return ErrInvalidRequest{Err: err}
+	}
+
+	// Update replaces all the measurements, so the expected measurements
+	// populated by the server are carried over to not lose them.
+	stored, err := ctrl.OriginalFWDB.Get(ctx, firmwaredb.FilterIDs{entry.ID})
+	if err != nil {
+		return convertFirmwareDBError(err)
+	}
+	carriedOver := 0
+	if len(stored) == 1 {
+		carriedOver = carryOverExpectedMeasurements(entry, stored[0])
+	}
+
+	if err := ctrl.OriginalFWDB.Update(ctx, entry); err != nil {
+		return convertFirmwareDBError(err)
+	}
+	logger.FromCtx(ctx).Infof("updated original firmware %d: %s %s (kept %d expected measurements)", entry.ID, entry.Type, entry.Version, carriedOver)
+	if carriedOver == 0 {
+		ctrl.measuredVersions.Store(entry.Version, struct{}{})
+		ctrl.populateExpectedMeasurementsAsync(ctx, entry.Version, nil)
+	}
+	return nil
+}
+
+// DeleteOriginalFirmware removes an entry from the original firmware database.
+func (ctrl *Controller) DeleteOriginalFirmware(
+	ctx context.Context,
+	id int64,
+) error {
+	if err := checkAdmin(ctx, "delete an original firmware"); err != nil {
+		return err
+	}
+
+	if err := ctrl.OriginalFWDB.Delete(ctx, id); err != nil {
+		return convertFirmwareDBError(err)
+	}
+	logger.FromCtx(ctx).Infof("deleted original firmware %d", id)
+	return nil
+}
+
+func convertFirmwareDBError(err error) error {
+	if errors.As(err, &firmwaredb.ErrInvalidFirmware{}) || errors.As(err, &firmwaredb.ErrNotFound{}) {
+		return ErrInvalidRequest{Err: err}
+	}
+	return err
+}
@@ -0,0 +1,236 @@
+// Copyright 2023 Meta Platforms, Inc. and affiliates.
+//
+// Redistribution and use in source and binary forms, with or without modification, are permitted provided that the following conditions are met:
+//
+// 1. Redistributions of source code must retain the above copyright notice, this list of conditions and the following disclaimer.
+//
+// 2. Redistributions in binary form must reproduce the above copyright notice, this list of conditions and the following disclaimer in the documentation and/or other materials provided with the distribution.
+//
+// 3. Neither the name of the copyright holder nor the names of its contributors may be used to endorse or promote products derived from this software without specific prior written permission.
+//
+// THIS SOFTWARE IS PROVIDED BY THE COPYRIGHT HOLDERS AND CONTRIBUTORS "AS IS" AND ANY EXPRESS OR IMPLIED WARRANTIES, INCLUDING, BUT NOT LIMITED TO, THE IMPLIED WARRANTIES OF MERCHANTABILITY AND FITNESS FOR A PARTICULAR PURPOSE ARE DISCLAIMED. IN NO EVENT SHALL THE COPYRIGHT HOLDER OR CONTRIBUTORS BE LIABLE FOR ANY DIRECT, INDIRECT, INCIDENTAL, SPECIAL, EXEMPLARY, OR CONSEQUENTIAL DAMAGES (INCLUDING, BUT NOT LIMITED TO, PROCUREMENT OF SUBSTITUTE GOODS OR SERVICES; LOSS OF USE, DATA, OR PROFITS; OR BUSINESS INTERRUPTION) HOWEVER CAUSED AND ON ANY THEORY OF LIABILITY, WHETHER IN CONTRACT, STRICT LIABILITY, OR TORT (INCLUDING NEGLIGENCE OR OTHERWISE) ARISING IN ANY WAY OUT OF THE USE OF THIS SOFTWARE, EVEN IF ADVISED OF THE POSSIBILITY OF SUCH DAMAGE.
+
+package controller
+
+import (
+	"context"
+	"crypto/sha1"
+	"crypto/sha256"
+	"encoding/json"
+	"fmt"
+	"strings"
+
+	"github.com/9elements/converged-security-suite/v2/pkg/bootflow/flows"
+	"github.com/9elements/converged-security-suite/v2/pkg/registers"
+	"github.com/9elements/converged-security-suite/v2/pkg/tpmdetection"
+	"github.com/facebookincubator/go-belt/tool/logger"
+	"github.com/google/go-tpm/tpm2"
+
+	"github.com/immune-gmbh/AttestationFailureAnalysisService/if/generated/afas"
+	"github.com/immune-gmbh/AttestationFailureAnalysisService/if/generated/tpm"
+	"github.com/immune-gmbh/AttestationFailureAnalysisService/if/typeconv"
+	"github.com/immune-gmbh/AttestationFailureAnalysisService/pkg/firmwaredb"
+)
+
+// SearchByPCR finds firmware configurations (versions, images, boot flows and
+// status registers), which yield the given PCR value.
+//
+// Matches are looked up among the expected measurements of the original firmware
+// database and among the PCR values reproduced by previous analyses. The latter
+// contains only PCR0 values and knows nothing about models, thus modelID
+// is applied only to the original firmware database.
+func (ctrl *Controller) SearchByPCR(
+	ctx context.Context,
+	pcrIndex int32,
+	bank tpm.Algo,
+	digest []byte,
+	modelID *int64,
+) ([]*afas.PCRMatch, error) {
+	var expectedSize int
+	switch bank {
+	case tpm.Algo_SHA1:
+		expectedSize = sha1.Size
+	case tpm.Algo_SHA256:
+		expectedSize = sha256.Size
+	default:
+		return nil, ErrInvalidRequest{Err: fmt.Errorf("unsupported PCR bank: %s", bank)}
+	}
+	if len(digest) != expectedSize {
+		return nil, ErrInvalidRequest{Err: fmt.Errorf("invalid digest length for bank %s: expected %d, received %d", bank, expectedSize, len(digest))}
+	}
+	if pcrIndex < 0 {
+		return nil, ErrInvalidRequest{Err: fmt.Errorf("invalid PCR index: %d", pcrIndex)}
+	}
+
+	var result []*afas.PCRMatch
+
+	dbMatches, err := ctrl.searchOriginalFirmwareDBByPCR(ctx, pcrIndex, tpm2.Algorithm(bank), digest, modelID)
+	if err != nil {
+		return nil, err
+	}
+	result = append(result, dbMatches...)
+
+	if pcrIndex == 0 {
+		reproducedMatches, err := ctrl.searchReproducedPCRsByPCR0(ctx, digest)
+		if err != nil {
+			return nil, err
+		}
+		result = append(result, reproducedMatches...)
+	}
+
+	return result, nil
+}
+
+func (ctrl *Controller) searchOriginalFirmwareDBByPCR(
+	ctx context.Context,
+	pcrIndex int32,
+	hashAlgo tpm2.Algorithm,
+	digest []byte,
+	modelID *int64,
+) ([]*afas.PCRMatch, error) {
+	if ctrl.OriginalFWDB == nil {
+		return nil, nil
+	}
+
+	fwMeasurements, err := ctrl.OriginalFWDB.FindMeasurements(ctx, digest)
+	if err != nil {
+		return nil, fmt.Errorf("unable to find measurements in the original firmware DB: %w", err)
+	}
+
+	var result []*afas.PCRMatch
+	for _, fwMeasurement := range fwMeasurements {
+		if fwMeasurement.Firmware == nil || fwMeasurement.FirmwareMeasurementType == nil {
+			continue
+		}
+		measurementPCRIndex, measurementHashAlgo, ok := firmwaredb.MeasurementTypePCR(fwMeasurement.FirmwareMeasurementType)
+		if !ok || measurementPCRIndex != int(pcrIndex) || !strings.EqualFold(measurementHashAlgo, hashAlgo.String()) {
+			continue
+		}
+		metadata := map[string]string{}
+		for _, kv := range fwMeasurement.FirmwareMeasurementType.Metadata {
+			metadata[kv.Key] = kv.Value
+		}
+		if modelID != nil && !firmwareTargetsModel(fwMeasurement.Firmware, *modelID) {
+			continue
+		}
+
+		match, err := newPCRMatchFromMeasurementMetadata(metadata)
+		if err != nil {
+			logger.FromCtx(ctx).Errorf("unable to parse the metadata of measurement type '%s': %v", fwMeasurement.FirmwareMeasurementType.Name, err)
+			continue
+		}
+		match.FirmwareVersion = &fwMeasurement.Firmware.Version
+		result = append(result, match)
+	}
+	return result, nil
+}
+
+func firmwareTargetsModel(fw *firmwaredb.Firmware, modelID int64) bool {
+	for _, target := range fw.Targets {
+		if target.ModelID != nil && *target.ModelID == modelID {
+			return true
+		}
+	}
+	return false
+}
+
+func newPCRMatchFromMeasurementMetadata(metadata map[string]string) (*afas.PCRMatch, error) {
+	match := &afas.PCRMatch{
+		Source: afas.PCRMatchSource_OriginalFirmwareDB,
+	}
+
+	if flowName, ok := metadata[firmwaredb.MeasurementMetadataKeyFlow]; ok {
+		flow, ok := flows.GetFlowByName(flowName)
+		if !ok {
+			return nil, fmt.Errorf("unknown flow '%s'", flowName)
+		}
+		thriftFlow, err := typeconv.ToThriftFlow(flow)
+		if err != nil {
+			return nil, err
+		}
+		match.BootFlow = &thriftFlow
+	}
+
+	if tpmName, ok := metadata[firmwaredb.MeasurementMetadataKeyTPMDevice]; ok {
+		tpmDevice, err := parseTPMType(tpmName)
+		if err != nil {
+			return nil, err
+		}
+		thriftTPM, err := typeconv.ToThriftTPMType(tpmDevice)
+		if err != nil {
+			return nil, err
+		}
+		match.TPMDevice = &thriftTPM
+	}
+
+	if regsJSON, ok := metadata[firmwaredb.MeasurementMetadataKeyRegisters]; ok {
+		var regs registers.Registers
+		if err := json.Unmarshal([]byte(regsJSON), &regs); err != nil {
+			return nil, fmt.Errorf("unable to parse registers: %w", err)
+		}
+		thriftRegs, err := typeconv.ToThriftRegisters(regs)
+		if err != nil {
+			return nil, err
+		}
+		match.StatusRegisters = thriftRegs
+	}
+
+	return match, nil
+}
+
+func parseTPMType(s string) (tpmdetection.Type, error) {
+	for _, tpmType := range tpmdetection.AllTypes() {
+		if tpmType.String() == s {
+			return tpmType, nil
+		}
+	}
+	return tpmdetection.TypeNoTPM, fmt.Errorf("unknown TPM type '%s'", s)
+}
+
+func (ctrl *Controller) searchReproducedPCRsByPCR0(
+	ctx context.Context,
+	pcr0 []byte,
+) ([]*afas.PCRMatch, error) {
+	reproducedPCRs, imageMetas, err := ctrl.FirmwareStorage.FindReproducedPCRsWithImageMetadataByPCR0(ctx, pcr0)
+	if err != nil {
+		return nil, fmt.Errorf("unable to find reproduced PCRs: %w", err)
+	}
+
+	result := make([]*afas.PCRMatch, 0, len(reproducedPCRs))
+	for idx, reproducedPCR := range reproducedPCRs {
+		imageMeta := imageMetas[idx]
+
+		regs, err := reproducedPCR.ParseResgisters()
+		if err != nil {
+			logger.FromCtx(ctx).Errorf("unable to parse registers of reproduced PCRs %d: %v", reproducedPCR.ID, err)
+			continue
+		}
+		thriftRegs, err := typeconv.ToThriftRegisters(regs)
+		if err != nil {
+			logger.FromCtx(ctx).Errorf("unable to convert registers of reproduced PCRs %d: %v", reproducedPCR.ID, err)
+			continue
+		}
+		tpmDevice, err := reproducedPCR.ParseTPMDevice()
+		if err != nil {
+			logger.FromCtx(ctx).Errorf("unable to parse TPM device of reproduced PCRs %d: %v", reproducedPCR.ID, err)
+			continue
+		}
+		thriftTPM, err := typeconv.ToThriftTPMType(tpmDevice)
+		if err != nil {
+			logger.FromCtx(ctx).Errorf("unable to convert TPM device of reproduced PCRs %d: %v", reproducedPCR.ID, err)
+			continue
+		}
+
+		match := &afas.PCRMatch{
+			Source:          afas.PCRMatchSource_ReproducedPCRs,
+			ImageID:         imageMeta.ImageID[:],
+			TPMDevice:       &thriftTPM,
+			StatusRegisters: thriftRegs,
+		}
+		if imageMeta.FirmwareVersion.Valid {
+			match.FirmwareVersion = &imageMeta.FirmwareVersion.String
+		}
+		result = append(result, match)
+	}
+	return result, nil
+}
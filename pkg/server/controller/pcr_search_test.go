@@ -0,0 +1,108 @@
+// Copyright 2023 Meta Platforms, Inc. and affiliates.
+//
+// Redistribution and use in source and binary forms, with or without modification, are permitted provided that the following conditions are met:
+//
+// 1. Redistributions of source code must retain the above copyright notice, this list of conditions and the following disclaimer.
+//
+// 2. Redistributions in binary form must reproduce the above copyright notice, this list of conditions and the following disclaimer in the documentation and/or other materials provided with the distribution.
+//
+// 3. Neither the name of the copyright holder nor the names of its contributors may be used to endorse or promote products derived from this software without specific prior written permission.
+//
+// THIS SOFTWARE IS PROVIDED BY THE COPYRIGHT HOLDERS AND CONTRIBUTORS "AS IS" AND ANY EXPRESS OR IMPLIED WARRANTIES, INCLUDING, BUT NOT LIMITED TO, THE IMPLIED WARRANTIES OF MERCHANTABILITY AND FITNESS FOR A PARTICULAR PURPOSE ARE DISCLAIMED. IN NO EVENT SHALL THE COPYRIGHT HOLDER OR CONTRIBUTORS BE LIABLE FOR ANY DIRECT, INDIRECT, INCIDENTAL, SPECIAL, EXEMPLARY, OR CONSEQUENTIAL DAMAGES (INCLUDING, BUT NOT LIMITED TO, PROCUREMENT OF SUBSTITUTE GOODS OR SERVICES; LOSS OF USE, DATA, OR PROFITS; OR BUSINESS INTERRUPTION) HOWEVER CAUSED AND ON ANY THEORY OF LIABILITY, WHETHER IN CONTRACT, STRICT LIABILITY, OR TORT (INCLUDING NEGLIGENCE OR OTHERWISE) ARISING IN ANY WAY OUT OF THE USE OF THIS SOFTWARE, EVEN IF ADVISED OF THE POSSIBILITY OF SUCH DAMAGE.
+package controller
+
+import (
+	"bytes"
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/immune-gmbh/AttestationFailureAnalysisService/if/generated/afas"
+	"github.com/immune-gmbh/AttestationFailureAnalysisService/if/generated/tpm"
+	"github.com/immune-gmbh/AttestationFailureAnalysisService/pkg/firmwaredb"
+)
+
+func TestSearchByPCR(t *testing.T) {
+	sha1Digest := bytes.Repeat([]byte{0x01}, 20)
+	sha256Digest := bytes.Repeat([]byte{0x02}, 32)
+	modelID := int64(3)
+	otherModelID := int64(4)
+
+	newFirmware := func(version string, modelID int64) *firmwaredb.Firmware {
+		return &firmwaredb.Firmware{
+			Version: version,
+			Targets: []*firmwaredb.FirmwareTarget{{ModelID: &modelID}},
+		}
+	}
+	newMeasurement := func(fw *firmwaredb.Firmware, typeName string, value []byte, metadata ...*firmwaredb.FirmwareMeasurementMetadata) *firmwaredb.FirmwareMeasurement {
+		return &firmwaredb.FirmwareMeasurement{
+			Firmware:                fw,
+			FirmwareMeasurementType: &firmwaredb.FirmwareMeasurementType{Name: typeName, Metadata: metadata},
+			Value:                   value,
+		}
+	}
+
+	fwDB := &fakeFirmwareDB{
+		measurements: []*firmwaredb.FirmwareMeasurement{
+			// registered manually (via "register -measurement"), thus without metadata
+			newMeasurement(newFirmware("registered", modelID), "PCR0_SHA1", sha1Digest),
+			newMeasurement(newFirmware("other_model", otherModelID), "PCR0_SHA1", sha1Digest),
+			// the same digest, but of another PCR
+			newMeasurement(newFirmware("pcr1", modelID), "PCR1_SHA1", sha1Digest),
+			// not a PCR value at all
+			newMeasurement(newFirmware("image_hash", modelID), firmwaredb.MeasurementTypeImageSHA256, sha256Digest),
+			// calculated by populateExpectedMeasurements
+			newMeasurement(newFirmware("simulated", modelID), "PCR0_SHA256/IntelCBnT/0123456789ABCDEF", sha256Digest,
+				&firmwaredb.FirmwareMeasurementMetadata{Key: firmwaredb.MeasurementMetadataKeyPCRIndex, Value: "0"},
+				&firmwaredb.FirmwareMeasurementMetadata{Key: firmwaredb.MeasurementMetadataKeyHashAlgo, Value: "SHA256"},
+				&firmwaredb.FirmwareMeasurementMetadata{Key: firmwaredb.MeasurementMetadataKeyFlow, Value: "IntelCBnT"},
+			),
+		},
+	}
+	ctrl := &Controller{
+		OriginalFWDB:    fwDB,
+		FirmwareStorage: newFakeStorage(),
+	}
+	ctx := context.Background()
+
+	versions := func(matches []*afas.PCRMatch) []string {
+		var result []string
+		for _, match := range matches {
+			require.Equal(t, afas.PCRMatchSource_OriginalFirmwareDB, match.Source)
+			require.NotNil(t, match.FirmwareVersion)
+			result = append(result, *match.FirmwareVersion)
+		}
+		return result
+	}
+
+	t.Run("registered_without_metadata", func(t *testing.T) {
+		matches, err := ctrl.SearchByPCR(ctx, 0, tpm.Algo_SHA1, sha1Digest, nil)
+		require.NoError(t, err)
+		require.ElementsMatch(t, []string{"registered", "other_model"}, versions(matches))
+	})
+
+	t.Run("model_filter", func(t *testing.T) {
+		matches, err := ctrl.SearchByPCR(ctx, 0, tpm.Algo_SHA1, sha1Digest, &modelID)
+		require.NoError(t, err)
+		require.Equal(t, []string{"registered"}, versions(matches))
+	})
+
+	t.Run("pcr_index", func(t *testing.T) {
+		matches, err := ctrl.SearchByPCR(ctx, 1, tpm.Algo_SHA1, sha1Digest, nil)
+		require.NoError(t, err)
+		require.Equal(t, []string{"pcr1"}, versions(matches))
+	})
+
+	t.Run("simulated", func(t *testing.T) {
+		matches, err := ctrl.SearchByPCR(ctx, 0, tpm.Algo_SHA256, sha256Digest, nil)
+		require.NoError(t, err)
+		require.Equal(t, []string{"simulated"}, versions(matches))
+		require.NotNil(t, matches[0].BootFlow)
+	})
+
+	t.Run("invalid_digest_length", func(t *testing.T) {
+		_, err := ctrl.SearchByPCR(ctx, 0, tpm.Algo_SHA256, sha1Digest, nil)
+		require.ErrorAs(t, err, &ErrInvalidRequest{})
+	})
+}
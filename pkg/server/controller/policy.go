@@ -0,0 +1,78 @@
+// Copyright 2023 Meta Platforms, Inc. and affiliates.
+//
+// Redistribution and use in source and binary forms, with or without modification, are permitted provided that the following conditions are met:
+//
+// 1. Redistributions of source code must retain the above copyright notice, this list of conditions and the following disclaimer.
+//
+// 2. Redistributions in binary form must reproduce the above copyright notice, this list of conditions and the following disclaimer in the documentation and/or other materials provided with the distribution.
+//
+// 3. Neither the name of the copyright holder nor the names of its contributors may be used to endorse or promote products derived from this software without specific prior written permission.
+//
+// THIS SOFTWARE IS PROVIDED BY THE COPYRIGHT HOLDERS AND CONTRIBUTORS "AS IS" AND ANY EXPRESS OR IMPLIED WARRANTIES, INCLUDING, BUT NOT LIMITED TO, THE IMPLIED WARRANTIES OF MERCHANTABILITY AND FITNESS FOR A PARTICULAR PURPOSE ARE DISCLAIMED. IN NO EVENT SHALL THE COPYRIGHT HOLDER OR CONTRIBUTORS BE LIABLE FOR ANY DIRECT, INDIRECT, INCIDENTAL, SPECIAL, EXEMPLARY, OR CONSEQUENTIAL DAMAGES (INCLUDING, BUT NOT LIMITED TO, PROCUREMENT OF SUBSTITUTE GOODS OR SERVICES; LOSS OF USE, DATA, OR PROFITS; OR BUSINESS INTERRUPTION) HOWEVER CAUSED AND ON ANY THEORY OF LIABILITY, WHETHER IN CONTRACT, STRICT LIABILITY, OR TORT (INCLUDING NEGLIGENCE OR OTHERWISE) ARISING IN ANY WAY OUT OF THE USE OF THIS SOFTWARE, EVEN IF ADVISED OF THE POSSIBILITY OF SUCH DAMAGE.
+
+package controller
+
+import (
+	"context"
+	"strings"
+
+	"github.com/facebookincubator/go-belt/tool/experimental/metrics"
+	"github.com/facebookincubator/go-belt/tool/experimental/tracer"
+	"github.com/facebookincubator/go-belt/tool/logger"
+
+	"github.com/immune-gmbh/AttestationFailureAnalysisService/if/generated/afas"
+	"github.com/immune-gmbh/AttestationFailureAnalysisService/if/generated/device"
+	"github.com/immune-gmbh/AttestationFailureAnalysisService/pkg/policy"
+	"github.com/immune-gmbh/AttestationFailureAnalysisService/pkg/storage/models"
+)
+
+// policyHost returns the identity of the host used to select the policy.
+//
+// Only verified data is used: the TLS identity of the client (if the client
+// analyzes itself) and the device found in the inventory by that identity.
+// The host info provided by the client (including the device found by
+// the claimed AssetID or Hostname) is not used, otherwise a client could
+// select a more lenient policy.
+func policyHost(
+	ctx context.Context,
+	hostInfo *afas.HostInfo,
+	hostDevice *device.Device,
+	isDeviceVerified bool,
+) policy.Host {
+	var host policy.Host
+	if hostDevice != nil && isDeviceVerified {
+		host.ModelID = &hostDevice.ModelID
+		host.Hostname = hostDevice.Hostname
+	}
+	if hostInfo != nil && hostInfo.IsClientHostAnalyzed {
+		if hostname, isVerified := ExtractHostnameFromCtx(ctx); isVerified && hostname != "" {
+			host.Hostname = &hostname
+		}
+	}
+	return host
+}
+
+// evaluatePolicy decides the overall verdict about the host given the analyzers reports.
+func (ctrl *Controller) evaluatePolicy(
+	ctx context.Context,
+	host policy.Host,
+	analyzerReports []models.AnalyzerReport,
+) *policy.Verdict {
+	span, ctx := tracer.StartChildSpanFromCtx(ctx, "evaluatePolicy")
+	defer span.Finish()
+
+	outcomes := make([]policy.Outcome, 0, len(analyzerReports))
+	for _, analyzerReport := range analyzerReports {
+		outcomes = append(outcomes, policy.Outcome{
+			AnalyzerID: analyzerReport.AnalyzerID,
+			Report:     analyzerReport.Report,
+			Err:        analyzerReport.ExecError.Err,
+		})
+	}
+
+	verdict := ctrl.Policies.Evaluate(host, outcomes)
+	logger.FromCtx(ctx).Infof("policy '%s' verdict: %s (reasons: %d)", verdict.Policy, verdict.Decision, len(verdict.Reasons))
+	decision := verdict.Decision.String()
+	metrics.FromCtx(ctx).Count("analyzeVerdict" + strings.ToUpper(decision[:1]) + decision[1:]).Add(1)
+	return &verdict
+}
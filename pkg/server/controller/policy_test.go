@@ -0,0 +1,48 @@
+// Copyright 2023 Meta Platforms, Inc. and affiliates.
+//
+// Redistribution and use in source and binary forms, with or without modification, are permitted provided that the following conditions are met:
+//
+// 1. Redistributions of source code must retain the above copyright notice, this list of conditions and the following disclaimer.
+//
+// 2. Redistributions in binary form must reproduce the above copyright notice, this list of conditions and the following disclaimer in the documentation and/or other materials provided with the distribution.
+//
+// 3. Neither the name of the copyright holder nor the names of its contributors may be used to endorse or promote products derived from this software without specific prior written permission.
+//
+// THIS SOFTWARE IS PROVIDED BY THE COPYRIGHT HOLDERS AND CONTRIBUTORS "AS IS" AND ANY EXPRESS OR IMPLIED WARRANTIES, INCLUDING, BUT NOT LIMITED TO, THE IMPLIED WARRANTIES OF MERCHANTABILITY AND FITNESS FOR A PARTICULAR PURPOSE ARE DISCLAIMED. IN NO EVENT SHALL THE COPYRIGHT HOLDER OR CONTRIBUTORS BE LIABLE FOR ANY DIRECT, INDIRECT, INCIDENTAL, SPECIAL, EXEMPLARY, OR CONSEQUENTIAL DAMAGES (INCLUDING, BUT NOT LIMITED TO, PROCUREMENT OF SUBSTITUTE GOODS OR SERVICES; LOSS OF USE, DATA, OR PROFITS; OR BUSINESS INTERRUPTION) HOWEVER CAUSED AND ON ANY THEORY OF LIABILITY, WHETHER IN CONTRACT, STRICT LIABILITY, OR TORT (INCLUDING NEGLIGENCE OR OTHERWISE) ARISING IN ANY WAY OUT OF THE USE OF THIS SOFTWARE, EVEN IF ADVISED OF THE POSSIBILITY OF SUCH DAMAGE.
+package controller
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/immune-gmbh/AttestationFailureAnalysisService/if/generated/afas"
+	"github.com/immune-gmbh/AttestationFailureAnalysisService/if/generated/device"
+	"github.com/immune-gmbh/AttestationFailureAnalysisService/pkg/policy"
+)
+
+func TestPolicyHost(t *testing.T) {
+	ctx := context.Background()
+	modelID := int64(42)
+	clientHostname := "lenient.example.com"
+	hostInfo := &afas.HostInfo{
+		ModelID:              &modelID,
+		Hostname:             &clientHostname,
+		IsClientHostAnalyzed: true,
+	}
+
+	// the identity claimed by the client is not verified, thus not used:
+	require.Equal(t, policy.Host{}, policyHost(ctx, hostInfo, nil, false))
+
+	deviceHostname := "host2.example.com"
+	hostDevice := &device.Device{AssetID: 2, ModelID: 7, Hostname: &deviceHostname}
+
+	// the device found by the identity claimed by the client is not used either:
+	require.Equal(t, policy.Host{}, policyHost(ctx, hostInfo, hostDevice, false))
+
+	require.Equal(t, policy.Host{
+		ModelID:  &[]int64{7}[0],
+		Hostname: &deviceHostname,
+	}, policyHost(ctx, hostInfo, hostDevice, true))
+}
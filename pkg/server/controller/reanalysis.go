@@ -0,0 +1,217 @@
+// Copyright 2023 Meta Platforms, Inc. and affiliates.
+//
+// Redistribution and use in source and binary forms, with or without modification, are permitted provided that the following conditions are met:
+//
+// 1. Redistributions of source code must retain the above copyright notice, this list of conditions and the following disclaimer.
+//
+// 2. Redistributions in binary form must reproduce the above copyright notice, this list of conditions and the following disclaimer in the documentation and/or other materials provided with the distribution.
+//
+// 3. Neither the name of the copyright holder nor the names of its contributors may be used to endorse or promote products derived from this software without specific prior written permission.
+//
+// THIS SOFTWARE IS PROVIDED BY THE COPYRIGHT HOLDERS AND CONTRIBUTORS "AS IS" AND ANY EXPRESS OR IMPLIED WARRANTIES, INCLUDING, BUT NOT LIMITED TO, THE IMPLIED WARRANTIES OF MERCHANTABILITY AND FITNESS FOR A PARTICULAR PURPOSE ARE DISCLAIMED. IN NO EVENT SHALL THE COPYRIGHT HOLDER OR CONTRIBUTORS BE LIABLE FOR ANY DIRECT, INDIRECT, INCIDENTAL, SPECIAL, EXEMPLARY, OR CONSEQUENTIAL DAMAGES (INCLUDING, BUT NOT LIMITED TO, PROCUREMENT OF SUBSTITUTE GOODS OR SERVICES; LOSS OF USE, DATA, OR PROFITS; OR BUSINESS INTERRUPTION) HOWEVER CAUSED AND ON ANY THEORY OF LIABILITY, WHETHER IN CONTRACT, STRICT LIABILITY, OR TORT (INCLUDING NEGLIGENCE OR OTHERWISE) ARISING IN ANY WAY OUT OF THE USE OF THIS SOFTWARE, EVEN IF ADVISED OF THE POSSIBILITY OF SUCH DAMAGE.
+package controller
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"strconv"
+	"time"
+
+	"github.com/facebookincubator/go-belt/pkg/field"
+	"github.com/facebookincubator/go-belt/tool/experimental/errmon"
+	"github.com/facebookincubator/go-belt/tool/experimental/metrics"
+	"github.com/facebookincubator/go-belt/tool/experimental/tracer"
+	"github.com/facebookincubator/go-belt/tool/logger"
+
+	"github.com/immune-gmbh/AttestationFailureAnalysisService/pkg/analysis"
+	"github.com/immune-gmbh/AttestationFailureAnalysisService/pkg/analyzers"
+	"github.com/immune-gmbh/AttestationFailureAnalysisService/pkg/server/controller/reportdiff"
+	controllertypes "github.com/immune-gmbh/AttestationFailureAnalysisService/pkg/server/controller/types"
+	"github.com/immune-gmbh/AttestationFailureAnalysisService/pkg/storage"
+	"github.com/immune-gmbh/AttestationFailureAnalysisService/pkg/storage/models"
+)
+
+const (
+	reanalysisBatchSizeDefault = 100
+)
+
+// ReanalysisPolicy defines which stored analyzer reports are re-analyzed
+// in background after an analyzer was improved (its version was changed).
+//
+// A re-analyzed report is stored as a new analyzer report, which supersedes
+// the previous one (the previous one is kept for history). The verdict of the
+// analyze report is not re-evaluated, because the host info is not stored.
+type ReanalysisPolicy struct {
+	// Interval is the interval between re-analysis runs.
+	// Zero value disables the re-analysis.
+	Interval time.Duration
+
+	// Analyzers is the list of analyzers, reports of which are re-analyzed.
+	Analyzers []analysis.AnalyzerID
+
+	// BatchSize is the maximal amount of reports re-analyzed at once
+	// per analyzer and run. Zero value means the default value.
+	BatchSize uint
+}
+
+func (policy ReanalysisPolicy) batchSize() uint {
+	if policy.BatchSize == 0 {
+		return reanalysisBatchSizeDefault
+	}
+	return policy.BatchSize
+}
+
+func (ctrl *Controller) reanalysisLoop(
+	ctx context.Context,
+	policy ReanalysisPolicy,
+) {
+	if policy.Interval == 0 || len(policy.Analyzers) == 0 {
+		return
+	}
+
+	// cursors are IDs of the last processed reports of each analyzer;
+	// reports which failed to be re-analyzed are skipped until the next pass.
+	cursors := map[analysis.AnalyzerID]uint64{}
+
+	reanalysisTicker := time.NewTicker(policy.Interval)
+	defer reanalysisTicker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-reanalysisTicker.C:
+			for _, analyzerID := range policy.Analyzers {
+				cursors[analyzerID] = ctrl.reanalyzeOutdatedReports(ctx, policy, analyzerID, cursors[analyzerID])
+			}
+		}
+	}
+}
+
+// reanalyzeOutdatedReports re-analyzes a batch of reports of the analyzer
+// produced by other versions of the analyzer, starting before ID `beforeID`
+// (if not zero). Returns the cursor for the next batch (zero if the pass is finished).
+func (ctrl *Controller) reanalyzeOutdatedReports(
+	ctx context.Context,
+	policy ReanalysisPolicy,
+	analyzerID analysis.AnalyzerID,
+	beforeID uint64,
+) uint64 {
+	span, ctx := tracer.StartChildSpanFromCtx(ctx, "reanalyzeOutdatedReports")
+	defer span.Finish()
+	log := logger.FromCtx(ctx).WithField("analyzerID", analyzerID)
+
+	currentVersion := ctrl.analyzersRegistry.Version(analyzerID)
+	if currentVersion == "" {
+		log.Errorf("unknown analyzer '%s', skipping re-analysis", analyzerID)
+		return 0
+	}
+
+	reports, err := ctrl.FirmwareStorage.FindOutdatedAnalyzerReports(ctx, storage.OutdatedAnalyzerReportsFilter{
+		AnalyzerID:     analyzerID,
+		CurrentVersion: currentVersion,
+		BeforeID:       beforeID,
+	}, policy.batchSize())
+	if err != nil {
+		errmon.ObserveErrorCtx(ctx, err)
+		metrics.FromCtx(ctx).Count("reanalysisErrors").Add(1)
+		log.Errorf("unable to find outdated analyzer reports: %v", err)
+		return beforeID
+	}
+	if len(reports) == 0 {
+		return 0
+	}
+
+	var reanalyzed uint64
+	for idx := range reports {
+		if ctx.Err() != nil {
+			break
+		}
+		previous := &reports[idx]
+		if err := ctrl.reanalyzeReport(ctx, previous, currentVersion); err != nil {
+			errmon.ObserveErrorCtx(ctx, err)
+			metrics.FromCtx(ctx).Count("reanalysisErrors").Add(1)
+			log.Errorf("unable to re-analyze analyzer report %d: %v", previous.ID, err)
+			continue
+		}
+		reanalyzed++
+	}
+	log.Infof("re-analyzed %d of %d outdated analyzer reports", reanalyzed, len(reports))
+
+	return reports[len(reports)-1].ID
+}
+
+// isInconclusiveReanalysis returns true if the re-analysis failed not because
+// of the analyzer itself, but because of the infrastructure (for example, the
+// context was canceled or a storage was unavailable) or the input (it was
+// unable to resolve or to calculate the input values). Such a result must not
+// supersede the previous report.
+func isInconclusiveReanalysis(execErr error) bool {
+	if execErr == nil {
+		return false
+	}
+	if errors.Is(execErr, context.Canceled) || errors.Is(execErr, context.DeadlineExceeded) {
+		return true
+	}
+	return !errors.As(execErr, &analysis.ErrAnalyze{}) && !errors.As(execErr, &analysis.ErrNotApplicable{})
+}
+
+// reanalyzeReport executes the current version of the analyzer on the input
+// of the previous report and stores the result as a report superseding the previous one
+// (unless the result is inconclusive, see isInconclusiveReanalysis).
+func (ctrl *Controller) reanalyzeReport(
+	ctx context.Context,
+	previous *models.AnalyzerReport,
+	currentVersion analysis.AnalyzerVersion,
+) error {
+	if err := controllertypes.InitInputBlobs(ctx, previous.Input, ctrl.FirmwareStorage.GetFirmwareBytes); err != nil {
+		return fmt.Errorf("unable to initialize the input: %w", err)
+	}
+
+	result, execErr := ctrl.analyzersRegistry.Execute(ctx, previous.AnalyzerID, ctrl.analysisDataCalculator, previous.Input, nil)
+	if isInconclusiveReanalysis(execErr) {
+		// the previous report is kept as is, it will be retried on the next pass
+		return fmt.Errorf("the re-analysis is inconclusive: %w", execErr)
+	}
+	analysis.KnownTamperingOf(previous.Input).ApplyTo(result)
+
+	var diagnosisCode sql.NullString
+	diagnosisCode.String, diagnosisCode.Valid = analyzers.DiagnosisCode(result)
+	report := &models.AnalyzerReport{
+		AnalyzeReportID: previous.AnalyzeReportID,
+		AnalyzerID:      previous.AnalyzerID,
+		AnalyzerVersion: currentVersion,
+		Input:           previous.Input,
+		Report:          result,
+		ExecError:       models.SQLErrorWrapper{Err: execErr},
+		DiagnosisCode:   diagnosisCode,
+		ReanalysisOf:    &previous.ID,
+	}
+
+	diff, err := reportdiff.CompareAnalyzerReports(previous, report)
+	if err != nil {
+		return fmt.Errorf("unable to compare the reports: %w", err)
+	}
+	verdictChanged := reportdiff.VerdictChanged(diff)
+	report.ReanalysisDiff = &models.ReanalysisDiff{
+		PreviousVersion: previous.AnalyzerVersion,
+		VerdictChanged:  verdictChanged,
+		Diff:            diff,
+	}
+
+	if err := ctrl.FirmwareStorage.InsertReanalysisReport(ctx, report); err != nil {
+		return fmt.Errorf("unable to store the report: %w", err)
+	}
+	if err := ctrl.FirmwareStorage.InsertReportIssues(ctx, []models.AnalyzerReport{*report}); err != nil {
+		// the report is stored, and the issues could be restored by the backfill
+		logger.FromCtx(ctx).Errorf("unable to store the issues of analyzer report %d: %v", report.ID, err)
+	}
+
+	metrics.FromCtx(ctx).CountFields("reanalysisReports", field.Fields{
+		{Key: "analyzerID", Value: string(previous.AnalyzerID)},
+		{Key: "verdictChanged", Value: strconv.FormatBool(verdictChanged)},
+	}).Add(1)
+	return nil
+}
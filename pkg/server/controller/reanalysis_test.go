@@ -0,0 +1,149 @@
+// Copyright 2023 Meta Platforms, Inc. and affiliates.
+//
+// Redistribution and use in source and binary forms, with or without modification, are permitted provided that the following conditions are met:
+//
+// 1. Redistributions of source code must retain the above copyright notice, this list of conditions and the following disclaimer.
+//
+// 2. Redistributions in binary form must reproduce the above copyright notice, this list of conditions and the following disclaimer in the documentation and/or other materials provided with the distribution.
+//
+// 3. Neither the name of the copyright holder nor the names of its contributors may be used to endorse or promote products derived from this software without specific prior written permission.
+//
+// THIS SOFTWARE IS PROVIDED BY THE COPYRIGHT HOLDERS AND CONTRIBUTORS "AS IS" AND ANY EXPRESS OR IMPLIED WARRANTIES, INCLUDING, BUT NOT LIMITED TO, THE IMPLIED WARRANTIES OF MERCHANTABILITY AND FITNESS FOR A PARTICULAR PURPOSE ARE DISCLAIMED. IN NO EVENT SHALL THE COPYRIGHT HOLDER OR CONTRIBUTORS BE LIABLE FOR ANY DIRECT, INDIRECT, INCIDENTAL, SPECIAL, EXEMPLARY, OR CONSEQUENTIAL DAMAGES (INCLUDING, BUT NOT LIMITED TO, PROCUREMENT OF SUBSTITUTE GOODS OR SERVICES; LOSS OF USE, DATA, OR PROFITS; OR BUSINESS INTERRUPTION) HOWEVER CAUSED AND ON ANY THEORY OF LIABILITY, WHETHER IN CONTRACT, STRICT LIABILITY, OR TORT (INCLUDING NEGLIGENCE OR OTHERWISE) ARISING IN ANY WAY OUT OF THE USE OF THIS SOFTWARE, EVEN IF ADVISED OF THE POSSIBILITY OF SUCH DAMAGE.
+package controller
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"testing"
+
+	pkgbytes "github.com/linuxboot/fiano/pkg/bytes"
+	"github.com/stretchr/testify/require"
+
+	"github.com/immune-gmbh/AttestationFailureAnalysisService/pkg/analysis"
+	"github.com/immune-gmbh/AttestationFailureAnalysisService/pkg/analyzers"
+	"github.com/immune-gmbh/AttestationFailureAnalysisService/pkg/storage/models"
+	"github.com/immune-gmbh/AttestationFailureAnalysisService/pkg/types"
+)
+
+type reanalysisTestInput struct {
+	ActualFirmware analysis.ActualFirmwareBlob
+}
+
+type reanalysisTestAnalyzer struct{}
+
+func (reanalysisTestAnalyzer) ID() analysis.AnalyzerID {
+	return "ReanalysisTest"
+}
+
+func (reanalysisTestAnalyzer) Version() analysis.AnalyzerVersion {
+	return "2"
+}
+
+func (reanalysisTestAnalyzer) Analyze(ctx context.Context, input reanalysisTestInput) (*analysis.Report, error) {
+	image := input.ActualFirmware.Bytes()
+	if len(image) == 0 {
+		return nil, fmt.Errorf("empty image")
+	}
+	if image[0] != 0 {
+		return &analysis.Report{Issues: []analysis.Issue{{
+			Severity:    analysis.SeverityCritical,
+			Description: "the image is modified",
+		}}}, nil
+	}
+	return &analysis.Report{}, nil
+}
+
+func TestReanalyzeReport(t *testing.T) {
+	ctx := context.Background()
+
+	registry := analyzers.NewRegistry()
+	require.NoError(t, analyzers.Add(registry, "ReanalysisTest", func() analysis.Analyzer[reanalysisTestInput] {
+		return reanalysisTestAnalyzer{}
+	}))
+	dataCalculator, err := analysis.NewDataCalculator(0)
+	require.NoError(t, err)
+
+	stor := newFakeStorage()
+	ctrl := &Controller{
+		FirmwareStorage:        stor,
+		analyzersRegistry:      registry,
+		analysisDataCalculator: dataCalculator,
+	}
+
+	// storedInput returns the input as it is loaded from the storage
+	// (the accessor is wrapped into several layers of blobs).
+	storedInput := func(image []byte) analysis.Input {
+		imageID := types.NewImageIDFromImage(image)
+		stor.images[imageID] = image
+		b, err := json.Marshal(analysis.NewInput().AddActualFirmware(analysis.NewSparseBlob(
+			&AnalyzerFirmwareAccessor{ImageID: imageID},
+			pkgbytes.Ranges{{Offset: 0, Length: uint64(len(image))}},
+		)))
+		require.NoError(t, err)
+		input := analysis.NewInput()
+		require.NoError(t, json.Unmarshal(b, &input))
+		return input
+	}
+	addReport := func(input analysis.Input) *models.AnalyzeReport {
+		report := &models.AnalyzeReport{
+			AnalyzerReports: []models.AnalyzerReport{{
+				AnalyzerID:      "ReanalysisTest",
+				AnalyzerVersion: "1",
+				Input:           input,
+				Report:          &analysis.Report{},
+			}},
+		}
+		stor.addAnalyzeReport(report)
+		return report
+	}
+
+	t.Run("supersede", func(t *testing.T) {
+		report := addReport(storedInput([]byte{1, 2, 3}))
+		previous := report.AnalyzerReports[0]
+		require.NoError(t, ctrl.reanalyzeReport(ctx, &previous, "2"))
+
+		require.Len(t, report.AnalyzerReports, 2)
+		reanalyzed := report.AnalyzerReports[1]
+		require.Equal(t, &reanalyzed.ID, report.AnalyzerReports[0].SupersededBy)
+		require.Equal(t, &previous.ID, reanalyzed.ReanalysisOf)
+		require.Equal(t, analysis.AnalyzerVersion("2"), reanalyzed.AnalyzerVersion)
+		require.NoError(t, reanalyzed.ExecError.Err)
+		require.Len(t, reanalyzed.Report.Issues, 1)
+		require.Equal(t, reanalyzed.Report.Issues, stor.issues[reanalyzed.ID])
+		require.NotNil(t, reanalyzed.ReanalysisDiff)
+		require.Equal(t, analysis.AnalyzerVersion("1"), reanalyzed.ReanalysisDiff.PreviousVersion)
+		require.True(t, reanalyzed.ReanalysisDiff.VerdictChanged)
+
+		// a report is superseded only once
+		require.Error(t, ctrl.reanalyzeReport(ctx, &previous, "2"))
+		require.Len(t, report.AnalyzerReports, 2)
+	})
+
+	t.Run("analyzerError", func(t *testing.T) {
+		report := addReport(storedInput([]byte{}))
+		previous := report.AnalyzerReports[0]
+		require.NoError(t, ctrl.reanalyzeReport(ctx, &previous, "2"))
+
+		require.Len(t, report.AnalyzerReports, 2)
+		require.NotNil(t, report.AnalyzerReports[0].SupersededBy)
+		require.ErrorAs(t, report.AnalyzerReports[1].ExecError.Err, &analysis.ErrAnalyze{})
+	})
+
+	t.Run("inconclusive", func(t *testing.T) {
+		for name, input := range map[string]analysis.Input{
+			"missingInput": analysis.NewInput(),
+			"missingImage": analysis.NewInput().AddActualFirmware(&AnalyzerFirmwareAccessor{
+				ImageID: types.NewImageIDFromImage([]byte("not stored")),
+			}),
+		} {
+			t.Run(name, func(t *testing.T) {
+				report := addReport(input)
+				previous := report.AnalyzerReports[0]
+				require.Error(t, ctrl.reanalyzeReport(ctx, &previous, "2"))
+				require.Len(t, report.AnalyzerReports, 1)
+				require.Nil(t, report.AnalyzerReports[0].SupersededBy)
+			})
+		}
+	})
+}
@@ -0,0 +1,310 @@
+// Copyright 2023 Meta Platforms, Inc. and affiliates.
+//
+// Redistribution and use in source and binary forms, with or without modification, are permitted provided that the following conditions are met:
+//
+// 1. Redistributions of source code must retain the above copyright notice, this list of conditions and the following disclaimer.
+//
+// 2. Redistributions in binary form must reproduce the above copyright notice, this list of conditions and the following disclaimer in the documentation and/or other materials provided with the distribution.
+//
+// 3. Neither the name of the copyright holder nor the names of its contributors may be used to endorse or promote products derived from this software without specific prior written permission.
+//
+// THIS SOFTWARE IS PROVIDED BY THE COPYRIGHT HOLDERS AND CONTRIBUTORS "AS IS" AND ANY EXPRESS OR IMPLIED WARRANTIES, INCLUDING, BUT NOT LIMITED TO, THE IMPLIED WARRANTIES OF MERCHANTABILITY AND FITNESS FOR A PARTICULAR PURPOSE ARE DISCLAIMED. IN NO EVENT SHALL THE COPYRIGHT HOLDER OR CONTRIBUTORS BE LIABLE FOR ANY DIRECT, INDIRECT, INCIDENTAL, SPECIAL, EXEMPLARY, OR CONSEQUENTIAL DAMAGES (INCLUDING, BUT NOT LIMITED TO, PROCUREMENT OF SUBSTITUTE GOODS OR SERVICES; LOSS OF USE, DATA, OR PROFITS; OR BUSINESS INTERRUPTION) HOWEVER CAUSED AND ON ANY THEORY OF LIABILITY, WHETHER IN CONTRACT, STRICT LIABILITY, OR TORT (INCLUDING NEGLIGENCE OR OTHERWISE) ARISING IN ANY WAY OUT OF THE USE OF THIS SOFTWARE, EVEN IF ADVISED OF THE POSSIBILITY OF SUCH DAMAGE.
+
+// Package reportdiff compares two analyze reports (usually of the same host),
+// to show what changed between the analyses.
+package reportdiff
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"sort"
+
+	"github.com/immune-gmbh/AttestationFailureAnalysisService/if/generated/afas"
+	"github.com/immune-gmbh/AttestationFailureAnalysisService/if/generated/analyzerreport"
+	"github.com/immune-gmbh/AttestationFailureAnalysisService/if/typeconv"
+	"github.com/immune-gmbh/AttestationFailureAnalysisService/pkg/analysis"
+	"github.com/immune-gmbh/AttestationFailureAnalysisService/pkg/analyzers"
+	"github.com/immune-gmbh/AttestationFailureAnalysisService/pkg/storage/models"
+	"github.com/immune-gmbh/AttestationFailureAnalysisService/pkg/xjson"
+)
+
+// Compare returns the difference between analyze reports `a` and `b`.
+//
+// Analyzer reports are aligned by analyzer IDs (if the same analyzer
+// was executed multiple times within a report, then by the order of execution).
+func Compare(a, b *models.AnalyzeReport) (*afas.CompareReportsResult_, error) {
+	result := &afas.CompareReportsResult_{
+		ReportA: summary(a),
+		ReportB: summary(b),
+	}
+
+	for _, pair := range alignAnalyzerReports(a.AnalyzerReports, b.AnalyzerReports) {
+		analyzerDiff, err := compareAnalyzerReports(pair.ID, pair.A, pair.B)
+		if err != nil {
+			return nil, fmt.Errorf("unable to compare reports of analyzer '%s': %w", pair.ID, err)
+		}
+		result.Analyzers = append(result.Analyzers, analyzerDiff)
+	}
+	return result, nil
+}
+
+func summary(report *models.AnalyzeReport) *afas.AnalyzeReportSummary {
+	result := &afas.AnalyzeReportSummary{
+		JobID:     report.JobID[:],
+		AssetID:   report.AssetID,
+		Timestamp: report.Timestamp.UnixNano(),
+	}
+	if report.Verdict != nil {
+		result.Verdict = typeconv.ToThriftAnalyzeVerdict(*report.Verdict)
+	}
+	return result
+}
+
+type analyzerReportPair struct {
+	ID analysis.AnalyzerID
+	A  *models.AnalyzerReport
+	B  *models.AnalyzerReport
+}
+
+func alignAnalyzerReports(a, b []models.AnalyzerReport) []analyzerReportPair {
+	type key struct {
+		ID         analysis.AnalyzerID
+		Occurrence int
+	}
+	keys := func(reports []models.AnalyzerReport) []key {
+		result := make([]key, 0, len(reports))
+		occurrences := map[analysis.AnalyzerID]int{}
+		for _, report := range reports {
+			result = append(result, key{ID: report.AnalyzerID, Occurrence: occurrences[report.AnalyzerID]})
+			occurrences[report.AnalyzerID]++
+		}
+		return result
+	}
+
+	var result []analyzerReportPair
+	pairIdx := map[key]int{}
+	for idx, k := range keys(b) {
+		pairIdx[k] = len(result)
+		result = append(result, analyzerReportPair{ID: k.ID, B: &b[idx]})
+	}
+	for idx, k := range keys(a) {
+		if pIdx, ok := pairIdx[k]; ok {
+			result[pIdx].A = &a[idx]
+			continue
+		}
+		result = append(result, analyzerReportPair{ID: k.ID, A: &a[idx]})
+	}
+	return result
+}
+
+func compareAnalyzerReports(analyzerID analysis.AnalyzerID, a, b *models.AnalyzerReport) (*afas.AnalyzerReportDiff, error) {
+	result := &afas.AnalyzerReportDiff{
+		AnalyzerName: string(analyzerID),
+		PresentInA:   a != nil,
+		PresentInB:   b != nil,
+		ErrorA:       execError(a),
+		ErrorB:       execError(b),
+		DiagnosisA:   diagnosis(a),
+		DiagnosisB:   diagnosis(b),
+	}
+
+	var err error
+	result.Issues, err = compareIssues(issues(a), issues(b))
+	if err != nil {
+		return nil, fmt.Errorf("unable to compare issues: %w", err)
+	}
+
+	customA, err := json.Marshal(custom(a))
+	if err != nil {
+		return nil, fmt.Errorf("unable to serialize custom report A: %w", err)
+	}
+	customB, err := json.Marshal(custom(b))
+	if err != nil {
+		return nil, fmt.Errorf("unable to serialize custom report B: %w", err)
+	}
+	result.CustomReportChanged = !bytes.Equal(customA, customB)
+
+	result.ChangedInputs, err = compareInputs(input(a), input(b))
+	if err != nil {
+		return nil, fmt.Errorf("unable to compare inputs: %w", err)
+	}
+	return result, nil
+}
+
+// CompareAnalyzerReports returns the difference between reports `a` and `b`
+// of the same analyzer (for example produced by different versions of the analyzer).
+// Both reports should not be nil.
+func CompareAnalyzerReports(a, b *models.AnalyzerReport) (*afas.AnalyzerReportDiff, error) {
+	return compareAnalyzerReports(b.AnalyzerID, a, b)
+}
+
+// VerdictChanged returns true if the difference affects the outcome of
+// the analysis: the diagnosis, the execution error or the found issues.
+func VerdictChanged(diff *afas.AnalyzerReportDiff) bool {
+	if diff == nil {
+		return false
+	}
+	if !equalStringPtrs(diff.DiagnosisA, diff.DiagnosisB) {
+		return true
+	}
+	if !equalStringPtrs(diff.ErrorA, diff.ErrorB) {
+		return true
+	}
+	for _, issue := range diff.Issues {
+		if issue.Change != afas.IssueChange_Unchanged {
+			return true
+		}
+	}
+	return false
+}
+
+func equalStringPtrs(a, b *string) bool {
+	if a == nil || b == nil {
+		return a == b
+	}
+	return *a == *b
+}
+
+func execError(report *models.AnalyzerReport) *string {
+	if report == nil || report.ExecError.Err == nil {
+		return nil
+	}
+	s := report.ExecError.Err.Error()
+	return &s
+}
+
+func issues(report *models.AnalyzerReport) []analysis.Issue {
+	if report == nil || report.Report == nil {
+		return nil
+	}
+	return report.Report.Issues
+}
+
+func custom(report *models.AnalyzerReport) any {
+	if report == nil || report.Report == nil {
+		return nil
+	}
+	return report.Report.Custom
+}
+
+func input(report *models.AnalyzerReport) analysis.Input {
+	if report == nil {
+		return nil
+	}
+	return report.Input
+}
+
+func diagnosis(report *models.AnalyzerReport) *string {
+	if report == nil {
+		return nil
+	}
+	code, ok := analyzers.DiagnosisCode(report.Report)
+	if !ok {
+		return nil
+	}
+	return &code
+}
+
+type issueKey struct {
+	Severity    analysis.Severity
+	Description string
+}
+
+func compareIssues(a, b []analysis.Issue) ([]*afas.IssueDiff, error) {
+	countA := map[issueKey]int{}
+	for _, issue := range a {
+		countA[issueKey{Severity: issue.Severity, Description: issue.Description}]++
+	}
+
+	var result []*afas.IssueDiff
+	add := func(change afas.IssueChange, issue analysis.Issue) error {
+		thriftIssue, err := toThriftIssue(issue)
+		if err != nil {
+			return err
+		}
+		result = append(result, &afas.IssueDiff{Change: change, Issue: thriftIssue})
+		return nil
+	}
+
+	for _, issue := range b {
+		k := issueKey{Severity: issue.Severity, Description: issue.Description}
+		change := afas.IssueChange_New
+		if countA[k] > 0 {
+			countA[k]--
+			change = afas.IssueChange_Unchanged
+		}
+		if err := add(change, issue); err != nil {
+			return nil, err
+		}
+	}
+	for _, issue := range a {
+		k := issueKey{Severity: issue.Severity, Description: issue.Description}
+		if countA[k] == 0 {
+			continue
+		}
+		countA[k]--
+		if err := add(afas.IssueChange_Resolved, issue); err != nil {
+			return nil, err
+		}
+	}
+	return result, nil
+}
+
+func toThriftIssue(issue analysis.Issue) (*analyzerreport.Issue, error) {
+	// Issue.Custom is not comparable among reports and is not supported
+	// by the conversion, so it is ignored.
+	issue.Custom = nil
+	return typeconv.ToThriftAnalysisIssue(issue)
+}
+
+func compareInputs(a, b analysis.Input) ([]*afas.InputDiff, error) {
+	typeIDs := map[analysis.TypeID]struct{}{}
+	for typeID := range a {
+		typeIDs[typeID] = struct{}{}
+	}
+	for typeID := range b {
+		typeIDs[typeID] = struct{}{}
+	}
+	sortedTypeIDs := make([]analysis.TypeID, 0, len(typeIDs))
+	for typeID := range typeIDs {
+		sortedTypeIDs = append(sortedTypeIDs, typeID)
+	}
+	sort.Slice(sortedTypeIDs, func(i, j int) bool {
+		return sortedTypeIDs[i] < sortedTypeIDs[j]
+	})
+
+	var result []*afas.InputDiff
+	for _, typeID := range sortedTypeIDs {
+		valueA, err := inputValueJSON(a, typeID)
+		if err != nil {
+			return nil, err
+		}
+		valueB, err := inputValueJSON(b, typeID)
+		if err != nil {
+			return nil, err
+		}
+		if valueA != nil && valueB != nil && *valueA == *valueB {
+			continue
+		}
+		result = append(result, &afas.InputDiff{
+			Type:   string(typeID),
+			ValueA: valueA,
+			ValueB: valueB,
+		})
+	}
+	return result, nil
+}
+
+func inputValueJSON(in analysis.Input, typeID analysis.TypeID) (*string, error) {
+	value, ok := in[typeID]
+	if !ok {
+		return nil, nil
+	}
+	b, err := xjson.MarshalWithTypeIDs(value, analysis.TypeRegistry())
+	if err != nil {
+		return nil, fmt.Errorf("unable to serialize input value '%s': %w", typeID, err)
+	}
+	s := string(b)
+	return &s, nil
+}
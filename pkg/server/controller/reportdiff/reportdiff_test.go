@@ -0,0 +1,136 @@
+// Copyright 2023 Meta Platforms, Inc. and affiliates.
+//
+// Redistribution and use in source and binary forms, with or without modification, are permitted provided that the following conditions are met:
+//
+// 1. Redistributions of source code must retain the above copyright notice, this list of conditions and the following disclaimer.
+//
+// 2. Redistributions in binary form must reproduce the above copyright notice, this list of conditions and the following disclaimer in the documentation and/or other materials provided with the distribution.
+//
+// 3. Neither the name of the copyright holder nor the names of its contributors may be used to endorse or promote products derived from this software without specific prior written permission.
+//
+// THIS SOFTWARE IS PROVIDED BY THE COPYRIGHT HOLDERS AND CONTRIBUTORS "AS IS" AND ANY EXPRESS OR IMPLIED WARRANTIES, INCLUDING, BUT NOT LIMITED TO, THE IMPLIED WARRANTIES OF MERCHANTABILITY AND FITNESS FOR A PARTICULAR PURPOSE ARE DISCLAIMED. IN NO EVENT SHALL THE COPYRIGHT HOLDER OR CONTRIBUTORS BE LIABLE FOR ANY DIRECT, INDIRECT, INCIDENTAL, SPECIAL, EXEMPLARY, OR CONSEQUENTIAL DAMAGES (INCLUDING, BUT NOT LIMITED TO, PROCUREMENT OF SUBSTITUTE GOODS OR SERVICES; LOSS OF USE, DATA, OR PROFITS; OR BUSINESS INTERRUPTION) HOWEVER CAUSED AND ON ANY THEORY OF LIABILITY, WHETHER IN CONTRACT, STRICT LIABILITY, OR TORT (INCLUDING NEGLIGENCE OR OTHERWISE) ARISING IN ANY WAY OUT OF THE USE OF THIS SOFTWARE, EVEN IF ADVISED OF THE POSSIBILITY OF SUCH DAMAGE.
+
+package reportdiff
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/immune-gmbh/AttestationFailureAnalysisService/if/generated/afas"
+	"github.com/immune-gmbh/AttestationFailureAnalysisService/pkg/analysis"
+	"github.com/immune-gmbh/AttestationFailureAnalysisService/pkg/analyzers/diffmeasuredboot/report/generated/diffanalysis"
+	"github.com/immune-gmbh/AttestationFailureAnalysisService/pkg/policy"
+	"github.com/immune-gmbh/AttestationFailureAnalysisService/pkg/storage/models"
+	"github.com/immune-gmbh/AttestationFailureAnalysisService/pkg/types"
+)
+
+func TestCompare(t *testing.T) {
+	newInput := func(pcr0 []byte) analysis.Input {
+		return analysis.NewInput().AddActualPCR0(pcr0).AddAssetID(1)
+	}
+	issue := func(severity analysis.Severity, description string) analysis.Issue {
+		return analysis.Issue{Severity: severity, Description: description}
+	}
+
+	reportA := &models.AnalyzeReport{
+		JobID: types.NewJobID(),
+		AnalyzerReports: []models.AnalyzerReport{
+			{
+				AnalyzerID: diffanalysis.DiffMeasuredBootAnalyzerID,
+				Input:      newInput([]byte{1}),
+				Report: &analysis.Report{
+					Custom: diffanalysis.CustomReport{Diagnosis: diffanalysis.DiffDiagnosis_FirmwareVersionMismatch},
+					Issues: []analysis.Issue{
+						issue(analysis.SeverityCritical, "mismatch"),
+						issue(analysis.SeverityWarning, "stays"),
+					},
+				},
+			},
+			{
+				AnalyzerID: "IntelACM",
+				ExecError:  models.SQLErrorWrapper{Err: fmt.Errorf("oops")},
+			},
+		},
+	}
+	reportB := &models.AnalyzeReport{
+		JobID: types.NewJobID(),
+		AnalyzerReports: []models.AnalyzerReport{
+			{
+				AnalyzerID: "ReproducePCR",
+				Report:     &analysis.Report{},
+			},
+			{
+				AnalyzerID: diffanalysis.DiffMeasuredBootAnalyzerID,
+				Input:      newInput([]byte{2}),
+				Report: &analysis.Report{
+					Custom: &diffanalysis.CustomReport{Diagnosis: diffanalysis.DiffDiagnosis_Match},
+					Issues: []analysis.Issue{
+						issue(analysis.SeverityWarning, "stays"),
+						issue(analysis.SeverityInfo, "new"),
+					},
+				},
+			},
+		},
+		Verdict: &policy.Verdict{Decision: policy.DecisionPass},
+	}
+
+	result, err := Compare(reportA, reportB)
+	require.NoError(t, err)
+	require.Equal(t, reportA.JobID[:], result.ReportA.JobID)
+	require.Nil(t, result.ReportA.Verdict)
+	require.Equal(t, afas.Verdict_Pass, result.ReportB.Verdict.Verdict)
+	require.Len(t, result.Analyzers, 3)
+
+	reproducePCR := result.Analyzers[0]
+	require.Equal(t, "ReproducePCR", reproducePCR.AnalyzerName)
+	require.False(t, reproducePCR.PresentInA)
+	require.True(t, reproducePCR.PresentInB)
+
+	diff := result.Analyzers[1]
+	require.Equal(t, string(diffanalysis.DiffMeasuredBootAnalyzerID), diff.AnalyzerName)
+	require.Equal(t, "FirmwareVersionMismatch", diff.GetDiagnosisA())
+	require.Equal(t, "Match", diff.GetDiagnosisB())
+	require.True(t, diff.CustomReportChanged)
+	require.Len(t, diff.Issues, 3)
+	require.Equal(t, afas.IssueChange_Unchanged, diff.Issues[0].Change)
+	require.Equal(t, "stays", diff.Issues[0].Issue.GetDescription())
+	require.Equal(t, afas.IssueChange_New, diff.Issues[1].Change)
+	require.Equal(t, "new", diff.Issues[1].Issue.GetDescription())
+	require.Equal(t, afas.IssueChange_Resolved, diff.Issues[2].Change)
+	require.Equal(t, "mismatch", diff.Issues[2].Issue.GetDescription())
+	require.Len(t, diff.ChangedInputs, 1)
+	require.Equal(t, `"AQ=="`, diff.ChangedInputs[0].GetValueA())
+	require.Equal(t, `"Ag=="`, diff.ChangedInputs[0].GetValueB())
+
+	intelACM := result.Analyzers[2]
+	require.True(t, intelACM.PresentInA)
+	require.False(t, intelACM.PresentInB)
+	require.Equal(t, "oops", intelACM.GetErrorA())
+	require.Nil(t, intelACM.ErrorB)
+
+	require.True(t, VerdictChanged(diff))
+}
+
+func TestVerdictChanged(t *testing.T) {
+	report := &models.AnalyzerReport{
+		AnalyzerID: diffanalysis.DiffMeasuredBootAnalyzerID,
+		Report: &analysis.Report{
+			Custom: diffanalysis.CustomReport{Diagnosis: diffanalysis.DiffDiagnosis_Match},
+			Issues: []analysis.Issue{{Severity: analysis.SeverityInfo, Description: "same"}},
+		},
+	}
+
+	diff, err := CompareAnalyzerReports(report, report)
+	require.NoError(t, err)
+	require.False(t, VerdictChanged(diff))
+
+	failed := &models.AnalyzerReport{
+		AnalyzerID: diffanalysis.DiffMeasuredBootAnalyzerID,
+		ExecError:  models.SQLErrorWrapper{Err: fmt.Errorf("oops")},
+	}
+	diff, err = CompareAnalyzerReports(report, failed)
+	require.NoError(t, err)
+	require.True(t, VerdictChanged(diff))
+}
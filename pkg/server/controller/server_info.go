@@ -0,0 +1,64 @@
+// Copyright 2023 Meta Platforms, Inc. and affiliates.
+//
+// Redistribution and use in source and binary forms, with or without modification, are permitted provided that the following conditions are met:
+//
+// 1. Redistributions of source code must retain the above copyright notice, this list of conditions and the following disclaimer.
+//
+// 2. Redistributions in binary form must reproduce the above copyright notice, this list of conditions and the following disclaimer in the documentation and/or other materials provided with the distribution.
+//
+// 3. Neither the name of the copyright holder nor the names of its contributors may be used to endorse or promote products derived from this software without specific prior written permission.
+//
+// THIS SOFTWARE IS PROVIDED BY THE COPYRIGHT HOLDERS AND CONTRIBUTORS "AS IS" AND ANY EXPRESS OR IMPLIED WARRANTIES, INCLUDING, BUT NOT LIMITED TO, THE IMPLIED WARRANTIES OF MERCHANTABILITY AND FITNESS FOR A PARTICULAR PURPOSE ARE DISCLAIMED. IN NO EVENT SHALL THE COPYRIGHT HOLDER OR CONTRIBUTORS BE LIABLE FOR ANY DIRECT, INDIRECT, INCIDENTAL, SPECIAL, EXEMPLARY, OR CONSEQUENTIAL DAMAGES (INCLUDING, BUT NOT LIMITED TO, PROCUREMENT OF SUBSTITUTE GOODS OR SERVICES; LOSS OF USE, DATA, OR PROFITS; OR BUSINESS INTERRUPTION) HOWEVER CAUSED AND ON ANY THEORY OF LIABILITY, WHETHER IN CONTRACT, STRICT LIABILITY, OR TORT (INCLUDING NEGLIGENCE OR OTHERWISE) ARISING IN ANY WAY OUT OF THE USE OF THIS SOFTWARE, EVEN IF ADVISED OF THE POSSIBILITY OF SUCH DAMAGE.
+package controller
+
+import (
+	"context"
+	"reflect"
+	"sort"
+	"strings"
+
+	"github.com/immune-gmbh/AttestationFailureAnalysisService/if/generated/afas"
+	"github.com/immune-gmbh/AttestationFailureAnalysisService/pkg/buildinfo"
+)
+
+// GetServerInfo returns the build information and the capabilities of the server.
+func (ctrl *Controller) GetServerInfo(ctx context.Context) *afas.ServerInfo {
+	buildMode, err := afas.BuildModeFromString(buildinfo.BuildMode())
+	if err != nil {
+		buildMode = afas.BuildMode_Undefined
+	}
+
+	analyzerIDs := ctrl.analyzersRegistry.IDs()
+	sort.Slice(analyzerIDs, func(i, j int) bool {
+		return analyzerIDs[i] < analyzerIDs[j]
+	})
+	analyzers := make([]*afas.AnalyzerInfo, 0, len(analyzerIDs))
+	for _, id := range analyzerIDs {
+		analyzers = append(analyzers, &afas.AnalyzerInfo{
+			ID:      string(id),
+			Version: string(ctrl.analyzersRegistry.Version(id)),
+		})
+	}
+
+	return &afas.ServerInfo{
+		Revision:      buildinfo.Revision(),
+		BuildMode:     buildMode,
+		Analyzers:     analyzers,
+		ArtifactTypes: artifactTypes(),
+	}
+}
+
+// artifactTypes returns the names of the fields of union afas.Artifact.
+func artifactTypes() []string {
+	t := reflect.TypeOf(afas.Artifact{})
+	result := make([]string, 0, t.NumField())
+	for i := 0; i < t.NumField(); i++ {
+		tag := t.Field(i).Tag.Get("thrift")
+		if tag == "" {
+			continue
+		}
+		name, _, _ := strings.Cut(tag, ",")
+		result = append(result, name)
+	}
+	return result
+}
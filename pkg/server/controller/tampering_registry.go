@@ -0,0 +1,286 @@
+// Copyright 2023 Meta Platforms, Inc. and affiliates.
+//
+// Redistribution and use in source and binary forms, with or without modification, are permitted provided that the following conditions are met:
+//
+// 1. Redistributions of source code must retain the above copyright notice, this list of conditions and the following disclaimer.
+//
+// 2. Redistributions in binary form must reproduce the above copyright notice, this list of conditions and the following disclaimer in the documentation and/or other materials provided with the distribution.
+//
+// 3. Neither the name of the copyright holder nor the names of its contributors may be used to endorse or promote products derived from this software without specific prior written permission.
+//
+// THIS SOFTWARE IS PROVIDED BY THE COPYRIGHT HOLDERS AND CONTRIBUTORS "AS IS" AND ANY EXPRESS OR IMPLIED WARRANTIES, INCLUDING, BUT NOT LIMITED TO, THE IMPLIED WARRANTIES OF MERCHANTABILITY AND FITNESS FOR A PARTICULAR PURPOSE ARE DISCLAIMED. IN NO EVENT SHALL THE COPYRIGHT HOLDER OR CONTRIBUTORS BE LIABLE FOR ANY DIRECT, INDIRECT, INCIDENTAL, SPECIAL, EXEMPLARY, OR CONSEQUENTIAL DAMAGES (INCLUDING, BUT NOT LIMITED TO, PROCUREMENT OF SUBSTITUTE GOODS OR SERVICES; LOSS OF USE, DATA, OR PROFITS; OR BUSINESS INTERRUPTION) HOWEVER CAUSED AND ON ANY THEORY OF LIABILITY, WHETHER IN CONTRACT, STRICT LIABILITY, OR TORT (INCLUDING NEGLIGENCE OR OTHERWISE) ARISING IN ANY WAY OUT OF THE USE OF THIS SOFTWARE, EVEN IF ADVISED OF THE POSSIBILITY OF SUCH DAMAGE.
+package controller
+
+import (
+	"context"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/facebookincubator/go-belt/tool/experimental/metrics"
+	"github.com/facebookincubator/go-belt/tool/logger"
+
+	"github.com/immune-gmbh/AttestationFailureAnalysisService/if/generated/afas"
+	"github.com/immune-gmbh/AttestationFailureAnalysisService/if/generated/device"
+	"github.com/immune-gmbh/AttestationFailureAnalysisService/if/typeconv"
+	"github.com/immune-gmbh/AttestationFailureAnalysisService/pkg/analysis"
+	"github.com/immune-gmbh/AttestationFailureAnalysisService/pkg/storage"
+	"github.com/immune-gmbh/AttestationFailureAnalysisService/pkg/storage/models"
+	"github.com/immune-gmbh/AttestationFailureAnalysisService/pkg/types"
+)
+
+// ListTamperingRegistryEntries returns entries of the registry of known tampered
+// hosts and known malicious firmware images.
+func (ctrl *Controller) ListTamperingRegistryEntries(
+	ctx context.Context,
+	kind *afas.TamperingRegistryKind,
+	includeExpired bool,
+) ([]*afas.TamperingRegistryEntry, error) {
+	var filter storage.TamperingRegistryFilter
+	if kind != nil {
+		var err error
+		filter.Kind, err = typeconv.FromThriftTamperingRegistryKind(*kind)
+		if err != nil {
+			return nil, ErrInvalidRequest{Err: err}
+		}
+	}
+	if !includeExpired {
+		filter.ActiveAt = time.Now()
+	}
+
+	entries, err := ctrl.FirmwareStorage.FindTamperingRegistryEntries(ctx, filter)
+	if err != nil {
+		return nil, fmt.Errorf("unable to find tampering registry entries: %w", err)
+	}
+
+	result := make([]*afas.TamperingRegistryEntry, 0, len(entries))
+	for _, entry := range entries {
+		thriftEntry, err := typeconv.ToThriftTamperingRegistryEntry(entry)
+		if err != nil {
+			return nil, fmt.Errorf("unable to convert tampering registry entry %d: %w", entry.ID, err)
+		}
+		result = append(result, thriftEntry)
+	}
+	return result, nil
+}
+
+// InsertTamperingRegistryEntry adds an entry to the registry of known tampered
+// hosts and known malicious firmware images.
+func (ctrl *Controller) InsertTamperingRegistryEntry(
+	ctx context.Context,
+	thriftEntry *afas.TamperingRegistryEntry,
+) (int64, error) {
+	if err := checkAdmin(ctx, "insert a tampering registry entry"); err != nil {
+		return 0, err
+	}
+
+	entry, err := typeconv.FromThriftTamperingRegistryEntry(thriftEntry)
+	if err != nil {
+		return 0, ErrInvalidRequest{Err: err}
+	}
+	entry.ID = 0
+
+	if err := ctrl.FirmwareStorage.InsertTamperingRegistryEntry(ctx, entry); err != nil {
+		return 0, convertTamperingRegistryError(err)
+	}
+	logger.FromCtx(ctx).Infof("inserted tampering registry entry %d: %s '%s' (%s)", entry.ID, entry.Kind, entry.Value, entry.Reason)
+	return int64(entry.ID), nil
+}
+
+// UpdateTamperingRegistryEntry replaces an entry of the registry of known tampered
+// hosts and known malicious firmware images.
+func (ctrl *Controller) UpdateTamperingRegistryEntry(
+	ctx context.Context,
+	thriftEntry *afas.TamperingRegistryEntry,
+) error {
+	if err := checkAdmin(ctx, "update a tampering registry entry"); err != nil {
+		return err
+	}
+
+	entry, err := typeconv.FromThriftTamperingRegistryEntry(thriftEntry)
+	if err != nil {
+		return ErrInvalidRequest{Err: err}
+	}
+	if entry.ID == 0 {
+		return ErrInvalidRequest{Err: fmt.Errorf("the entry ID is not set")}
+	}
+
+	if err := ctrl.FirmwareStorage.UpdateTamperingRegistryEntry(ctx, *entry); err != nil {
+		return convertTamperingRegistryError(err)
+	}
+	logger.FromCtx(ctx).Infof("updated tampering registry entry %d: %s '%s' (%s)", entry.ID, entry.Kind, entry.Value, entry.Reason)
+	return nil
+}
+
+// DeleteTamperingRegistryEntry removes an entry from the registry of known tampered
+// hosts and known malicious firmware images.
+func (ctrl *Controller) DeleteTamperingRegistryEntry(
+	ctx context.Context,
+	id int64,
+) error {
+	if err := checkAdmin(ctx, "delete a tampering registry entry"); err != nil {
+		return err
+	}
+	if id <= 0 {
+		return ErrInvalidRequest{Err: fmt.Errorf("invalid ID: %d", id)}
+	}
+
+	if err := ctrl.FirmwareStorage.DeleteTamperingRegistryEntry(ctx, uint64(id)); err != nil {
+		return convertTamperingRegistryError(err)
+	}
+	logger.FromCtx(ctx).Infof("deleted tampering registry entry %d", id)
+	return nil
+}
+
+func convertTamperingRegistryError(err error) error {
+	if errors.As(err, &storage.ErrNotFound{}) || errors.As(err, &storage.ErrTamperingRegistryEntryExists{}) {
+		return ErrInvalidRequest{Err: err}
+	}
+	return err
+}
+
+// tamperingMatcher finds the tampering registry entries matching the analyzed
+// host and its actual firmware images.
+//
+// It is created per analysis, so host entries are looked up only once and
+// image hashes are calculated only once per image.
+type tamperingMatcher struct {
+	storage      Storage
+	hostKeys     []storage.TamperingRegistryKey
+	imageKeys    map[types.ImageID][]storage.TamperingRegistryKey
+	imageKeysMtx sync.Mutex
+}
+
+// newTamperingMatcher creates a tamperingMatcher for a host. The host is
+// matched only by the identities verified by the server: the hostname of
+// the TLS peer (if the client host is the analyzed one) and the device found
+// by the DeviceGetter by that hostname. The AssetID and Hostname provided by
+// the client (and the device found by them) are not used, because otherwise
+// a client could avoid or cause a match by claiming another identity.
+func newTamperingMatcher(
+	ctx context.Context,
+	stor Storage,
+	hostInfo *afas.HostInfo,
+	hostDevice *device.Device,
+	isDeviceVerified bool,
+) *tamperingMatcher {
+	m := &tamperingMatcher{
+		storage:   stor,
+		imageKeys: map[types.ImageID][]storage.TamperingRegistryKey{},
+	}
+	addHostname := func(hostname string) {
+		if hostname == "" {
+			return
+		}
+		m.hostKeys = append(m.hostKeys, storage.TamperingRegistryKey{
+			Kind:  models.TamperingRegistryKindHostname,
+			Value: strings.ToLower(hostname),
+		})
+	}
+	if hostInfo != nil && hostInfo.IsClientHostAnalyzed {
+		if hostname, isVerified := ExtractHostnameFromCtx(ctx); isVerified {
+			addHostname(hostname)
+		}
+	}
+	if hostDevice != nil && isDeviceVerified {
+		m.hostKeys = append(m.hostKeys, storage.TamperingRegistryKey{
+			Kind:  models.TamperingRegistryKindAssetID,
+			Value: strconv.FormatInt(hostDevice.AssetID, 10),
+		})
+		if hostDevice.Hostname != nil {
+			addHostname(*hostDevice.Hostname)
+		}
+	}
+	return m
+}
+
+// KnownTampering returns the matching registry entries for the given analyzer input,
+// or nil if there are no matches.
+func (m *tamperingMatcher) KnownTampering(ctx context.Context, input analysis.Input) (*analysis.KnownTampering, error) {
+	keys := append([]storage.TamperingRegistryKey{}, m.hostKeys...)
+	for _, value := range input {
+		if actualFirmware, ok := value.(analysis.ActualFirmwareBlob); ok {
+			keys = append(keys, m.getImageKeys(ctx, actualFirmware)...)
+		}
+	}
+	if len(keys) == 0 {
+		return nil, nil
+	}
+
+	entries, err := m.storage.FindTamperingRegistryEntries(ctx, storage.TamperingRegistryFilter{
+		Keys:     keys,
+		ActiveAt: time.Now(),
+	})
+	if err != nil {
+		return nil, fmt.Errorf("unable to find tampering registry entries: %w", err)
+	}
+	if len(entries) == 0 {
+		return nil, nil
+	}
+
+	var result analysis.KnownTampering
+	for _, entry := range entries {
+		knownEntry := &analysis.KnownTamperingEntry{
+			Kind:   string(entry.Kind),
+			Value:  entry.Value,
+			Reason: entry.Reason,
+		}
+		switch {
+		case entry.Kind.IsHost() && result.TamperedHost == nil:
+			result.TamperedHost = knownEntry
+		case !entry.Kind.IsHost() && result.BadImage == nil:
+			result.BadImage = knownEntry
+		}
+	}
+	metrics.FromCtx(ctx).Count("knownTamperingMatch").Add(1)
+	return &result, nil
+}
+
+func (m *tamperingMatcher) getImageKeys(ctx context.Context, actualFirmware analysis.ActualFirmwareBlob) []storage.TamperingRegistryKey {
+	if len(analysis.MissingRanges(actualFirmware)) != 0 {
+		// the hashes of a partially dumped image are hashes of the padding
+		// as well, they never identify an image
+		return nil
+	}
+
+	accessor, isAccessor := actualFirmware.Blob.(*AnalyzerFirmwareAccessor)
+	if isAccessor {
+		m.imageKeysMtx.Lock()
+		defer m.imageKeysMtx.Unlock()
+		if keys, ok := m.imageKeys[accessor.ImageID]; ok {
+			return keys
+		}
+	}
+
+	image := actualFirmware.Bytes()
+	keys := []storage.TamperingRegistryKey{{
+		Kind:  models.TamperingRegistryKindImageHash,
+		Value: hex.EncodeToString(types.Hash(types.HashAlgSHA2_512, image)),
+	}}
+	var (
+		stableHash types.HashValue
+		err        error
+	)
+	if isAccessor && accessor.ParsedCache() != nil {
+		stableHash, err = types.NewImageStableHash(accessor.ParsedCache())
+	} else {
+		stableHash, err = types.NewImageStableHashFromImage(image)
+	}
+	if err != nil {
+		logger.FromCtx(ctx).Warnf("unable to calculate the stable hash of the actual image: %v", err)
+	} else {
+		keys = append(keys, storage.TamperingRegistryKey{
+			Kind:  models.TamperingRegistryKindImageStableHash,
+			Value: hex.EncodeToString(stableHash),
+		})
+	}
+
+	if isAccessor {
+		m.imageKeys[accessor.ImageID] = keys
+	}
+	return keys
+}
@@ -0,0 +1,63 @@
+// Copyright 2023 Meta Platforms, Inc. and affiliates.
+//
+// Redistribution and use in source and binary forms, with or without modification, are permitted provided that the following conditions are met:
+//
+// 1. Redistributions of source code must retain the above copyright notice, this list of conditions and the following disclaimer.
+//
+// 2. Redistributions in binary form must reproduce the above copyright notice, this list of conditions and the following disclaimer in the documentation and/or other materials provided with the distribution.
+//
+// 3. Neither the name of the copyright holder nor the names of its contributors may be used to endorse or promote products derived from this software without specific prior written permission.
+//
+// THIS SOFTWARE IS PROVIDED BY THE COPYRIGHT HOLDERS AND CONTRIBUTORS "AS IS" AND ANY EXPRESS OR IMPLIED WARRANTIES, INCLUDING, BUT NOT LIMITED TO, THE IMPLIED WARRANTIES OF MERCHANTABILITY AND FITNESS FOR A PARTICULAR PURPOSE ARE DISCLAIMED. IN NO EVENT SHALL THE COPYRIGHT HOLDER OR CONTRIBUTORS BE LIABLE FOR ANY DIRECT, INDIRECT, INCIDENTAL, SPECIAL, EXEMPLARY, OR CONSEQUENTIAL DAMAGES (INCLUDING, BUT NOT LIMITED TO, PROCUREMENT OF SUBSTITUTE GOODS OR SERVICES; LOSS OF USE, DATA, OR PROFITS; OR BUSINESS INTERRUPTION) HOWEVER CAUSED AND ON ANY THEORY OF LIABILITY, WHETHER IN CONTRACT, STRICT LIABILITY, OR TORT (INCLUDING NEGLIGENCE OR OTHERWISE) ARISING IN ANY WAY OUT OF THE USE OF THIS SOFTWARE, EVEN IF ADVISED OF THE POSSIBILITY OF SUCH DAMAGE.
+package controller
+
+import (
+	"context"
+	"testing"
+
+	pkgbytes "github.com/linuxboot/fiano/pkg/bytes"
+	"github.com/stretchr/testify/require"
+
+	"github.com/immune-gmbh/AttestationFailureAnalysisService/if/generated/afas"
+	"github.com/immune-gmbh/AttestationFailureAnalysisService/if/generated/device"
+	"github.com/immune-gmbh/AttestationFailureAnalysisService/pkg/analysis"
+	"github.com/immune-gmbh/AttestationFailureAnalysisService/pkg/storage"
+	"github.com/immune-gmbh/AttestationFailureAnalysisService/pkg/storage/models"
+)
+
+func TestTamperingMatcherHostKeys(t *testing.T) {
+	ctx := context.Background()
+	assetID := int64(1)
+	clientHostname := "claimed.example.com"
+	hostInfo := &afas.HostInfo{
+		AssetID:              &assetID,
+		Hostname:             &clientHostname,
+		IsClientHostAnalyzed: true,
+	}
+
+	// the identity claimed by the client is not verified, thus not used:
+	require.Empty(t, newTamperingMatcher(ctx, nil, hostInfo, nil, false).hostKeys)
+
+	deviceHostname := "Host2.example.com"
+	hostDevice := &device.Device{AssetID: 2, Hostname: &deviceHostname}
+
+	// the device found by the identity claimed by the client is not used either:
+	require.Empty(t, newTamperingMatcher(ctx, nil, hostInfo, hostDevice, false).hostKeys)
+
+	m := newTamperingMatcher(ctx, nil, hostInfo, hostDevice, true)
+	require.Equal(t, []storage.TamperingRegistryKey{
+		{Kind: models.TamperingRegistryKindAssetID, Value: "2"},
+		{Kind: models.TamperingRegistryKindHostname, Value: "host2.example.com"},
+	}, m.hostKeys)
+}
+
+func TestTamperingMatcherPartialImage(t *testing.T) {
+	ctx := context.Background()
+	m := newTamperingMatcher(ctx, nil, nil, nil, false)
+
+	image := make([]byte, 0x100)
+	require.Nil(t, m.getImageKeys(ctx, analysis.NewActualFirmwareBlob(
+		analysis.NewSparseBlob(analysis.BytesBlob(image), pkgbytes.Ranges{{Offset: 0, Length: 0x10}}),
+	)))
+	require.NotEmpty(t, m.getImageKeys(ctx, analysis.NewActualFirmwareBlob(analysis.BytesBlob(image))))
+}
@@ -12,10 +12,14 @@
 package types
 
 import (
+	"context"
+	"fmt"
+
 	"github.com/immune-gmbh/AttestationFailureAnalysisService/pkg/analysis"
 	"github.com/immune-gmbh/AttestationFailureAnalysisService/pkg/dmidecode"
 	"github.com/immune-gmbh/AttestationFailureAnalysisService/pkg/types"
 	"github.com/immune-gmbh/AttestationFailureAnalysisService/pkg/uefi"
+	pkgbytes "github.com/linuxboot/fiano/pkg/bytes"
 )
 
 func init() {
@@ -35,9 +39,17 @@ type AnalyzerFirmwareAccessor struct {
 	// == Serializable part ==
 
 	ImageID types.ImageID
+
+	// Coverage is the list of actually dumped ranges if the image was
+	// dumped partially (see types.NewImageIDFromSparseImage), otherwise it is nil.
+	//
+	// It is stored here (instead of wrapping the accessor into analysis.SparseBlob)
+	// to keep the path to ImageID in a serialized input the same for
+	// partial and complete images.
+	Coverage pkgbytes.Ranges `json:",omitempty"`
 }
 
-var _ analysis.Blob = (*AnalyzerFirmwareAccessor)(nil)
+var _ analysis.PartialBlob = (*AnalyzerFirmwareAccessor)(nil)
 
 // Bytes implements analysis.Blob
 func (fw *AnalyzerFirmwareAccessor) Bytes() []byte {
@@ -67,6 +79,14 @@ func (fw *AnalyzerFirmwareAccessor) Init(
 	fw.biosInfoCache = biosInfoCache
 }
 
+// MissingRanges implements analysis.PartialBlob
+func (fw *AnalyzerFirmwareAccessor) MissingRanges() pkgbytes.Ranges {
+	if fw.Coverage == nil {
+		return nil
+	}
+	return pkgbytes.Range{Offset: 0, Length: uint64(len(fw.Bytes()))}.Exclude(fw.Coverage...)
+}
+
 var _ biosInfoCacheInterface = (*AnalyzerFirmwareAccessor)(nil)
 
 // BIOSInfoCache implements biosInfoCacheInterface
@@ -80,3 +100,33 @@ var _ parsedFirmwareCacheInterface = (*AnalyzerFirmwareAccessor)(nil)
 func (fw *AnalyzerFirmwareAccessor) ParsedCache() *uefi.UEFI {
 	return fw.parsedCache
 }
+
+// InitInputBlobs initializes AnalyzerFirmwareAccessor-s found in a deserialized input
+// (for example, an input of a stored analyzer report) with images provided by `getImage`.
+func InitInputBlobs(
+	ctx context.Context,
+	in analysis.Input,
+	getImage func(ctx context.Context, imageID types.ImageID) ([]byte, error),
+) error {
+	for _, v := range in {
+		// The accessor might be wrapped into multiple layers (for example,
+		// into analysis.ActualFirmwareBlob):
+		blob, ok := v.(analysis.Blob)
+		if !ok {
+			continue
+		}
+		fw, ok := analysis.UnwrapBlob(blob).(*AnalyzerFirmwareAccessor)
+		if !ok {
+			continue
+		}
+
+		image, err := getImage(ctx, fw.ImageID)
+		if err != nil {
+			return fmt.Errorf("unable to get the image (ID %v): %w", fw.ImageID, err)
+		}
+
+		// fw is a pointer thus we can initialize the accessor right here:
+		fw.Init(image, nil, nil)
+	}
+	return nil
+}
@@ -0,0 +1,139 @@
+// Copyright 2023 Meta Platforms, Inc. and affiliates.
+//
+// Redistribution and use in source and binary forms, with or without modification, are permitted provided that the following conditions are met:
+//
+// 1. Redistributions of source code must retain the above copyright notice, this list of conditions and the following disclaimer.
+//
+// 2. Redistributions in binary form must reproduce the above copyright notice, this list of conditions and the following disclaimer in the documentation and/or other materials provided with the distribution.
+//
+// 3. Neither the name of the copyright holder nor the names of its contributors may be used to endorse or promote products derived from this software without specific prior written permission.
+//
+// THIS SOFTWARE IS PROVIDED BY THE COPYRIGHT HOLDERS AND CONTRIBUTORS "AS IS" AND ANY EXPRESS OR IMPLIED WARRANTIES, INCLUDING, BUT NOT LIMITED TO, THE IMPLIED WARRANTIES OF MERCHANTABILITY AND FITNESS FOR A PARTICULAR PURPOSE ARE DISCLAIMED. IN NO EVENT SHALL THE COPYRIGHT HOLDER OR CONTRIBUTORS BE LIABLE FOR ANY DIRECT, INDIRECT, INCIDENTAL, SPECIAL, EXEMPLARY, OR CONSEQUENTIAL DAMAGES (INCLUDING, BUT NOT LIMITED TO, PROCUREMENT OF SUBSTITUTE GOODS OR SERVICES; LOSS OF USE, DATA, OR PROFITS; OR BUSINESS INTERRUPTION) HOWEVER CAUSED AND ON ANY THEORY OF LIABILITY, WHETHER IN CONTRACT, STRICT LIABILITY, OR TORT (INCLUDING NEGLIGENCE OR OTHERWISE) ARISING IN ANY WAY OUT OF THE USE OF THIS SOFTWARE, EVEN IF ADVISED OF THE POSSIBILITY OF SUCH DAMAGE.
+package jsongateway
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/facebookincubator/go-belt/tool/logger"
+
+	"github.com/immune-gmbh/AttestationFailureAnalysisService/if/generated/afas"
+)
+
+// errorResponse is the body of a non-2xx response.
+type errorResponse struct {
+	// Error is the description of the error.
+	Error string `json:"Error"`
+
+	// Exception is the name of the Thrift exception (if the error is one).
+	Exception string `json:"Exception,omitempty"`
+
+	// Details is the JSON-encoded Thrift exception (if the error is one).
+	Details any `json:"Details,omitempty"`
+}
+
+// errUnknownMethod implements "error", for the description see Error.
+type errUnknownMethod struct {
+	Name string
+}
+
+func (err errUnknownMethod) Error() string {
+	return fmt.Sprintf("unknown method '%s'", err.Name)
+}
+
+// errMethodNotAllowed implements "error", for the description see Error.
+type errMethodNotAllowed struct {
+	Allowed string
+}
+
+func (err errMethodNotAllowed) Error() string {
+	return fmt.Sprintf("HTTP method is not allowed, expected %s", err.Allowed)
+}
+
+// errDecodeRequest implements "error", for the description see Error.
+type errDecodeRequest struct {
+	Err error
+}
+
+func (err errDecodeRequest) Error() string {
+	return fmt.Sprintf("unable to decode the request: %v", err.Err)
+}
+
+func (err errDecodeRequest) Unwrap() error {
+	return err.Err
+}
+
+// exceptionStatusCodes maps the Thrift exceptions to HTTP status codes.
+var exceptionStatusCodes = map[string]int{
+	"InvalidRequest":              http.StatusBadRequest,
+	"PermissionDenied":            http.StatusForbidden,
+	"IncorrectHostConfiguration":  http.StatusUnprocessableEntity,
+	"UnableToGetOriginalFirmware": http.StatusBadGateway,
+	"Overloaded":                  http.StatusServiceUnavailable,
+}
+
+func classifyError(err error) (int, errorResponse) {
+	resp := errorResponse{Error: err.Error()}
+
+	var (
+		errUnknownMethod    errUnknownMethod
+		errMethodNotAllowed errMethodNotAllowed
+		errDecodeRequest    errDecodeRequest
+
+		errInvalidRequest              *afas.InvalidRequest
+		errPermissionDenied            *afas.PermissionDenied
+		errIncorrectHostConfiguration  *afas.IncorrectHostConfiguration
+		errUnableToGetOriginalFirmware *afas.UnableToGetOriginalFirmware
+		errOverloaded                  *afas.Overloaded
+	)
+	switch {
+	case errors.As(err, &errUnknownMethod):
+		return http.StatusNotFound, resp
+	case errors.As(err, &errMethodNotAllowed):
+		return http.StatusMethodNotAllowed, resp
+	case errors.As(err, &errDecodeRequest):
+		return http.StatusBadRequest, resp
+	case errors.As(err, &errInvalidRequest):
+		resp.Exception, resp.Details = "InvalidRequest", errInvalidRequest
+	case errors.As(err, &errPermissionDenied):
+		resp.Exception, resp.Details = "PermissionDenied", errPermissionDenied
+	case errors.As(err, &errIncorrectHostConfiguration):
+		resp.Exception, resp.Details = "IncorrectHostConfiguration", errIncorrectHostConfiguration
+	case errors.As(err, &errUnableToGetOriginalFirmware):
+		resp.Exception, resp.Details = "UnableToGetOriginalFirmware", errUnableToGetOriginalFirmware
+	case errors.As(err, &errOverloaded):
+		resp.Exception, resp.Details = "Overloaded", errOverloaded
+	default:
+		return http.StatusInternalServerError, resp
+	}
+	return exceptionStatusCodes[resp.Exception], resp
+}
+
+func writeError(ctx context.Context, w http.ResponseWriter, err error) {
+	statusCode, resp := classifyError(err)
+
+	var errMethodNotAllowed errMethodNotAllowed
+	if errors.As(err, &errMethodNotAllowed) {
+		w.Header().Set("Allow", errMethodNotAllowed.Allowed)
+	}
+	var errOverloaded *afas.Overloaded
+	if errors.As(err, &errOverloaded) {
+		retryAfter := time.Duration(errOverloaded.GetRetryAfterMilliseconds()) * time.Millisecond
+		// Retry-After is in seconds, round up
+		w.Header().Set("Retry-After", strconv.FormatInt(int64((retryAfter+time.Second-1)/time.Second), 10))
+	}
+	if statusCode >= http.StatusInternalServerError {
+		logger.FromCtx(ctx).Errorf("JSON gateway request failed: %v", err)
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(statusCode)
+	if err := json.NewEncoder(w).Encode(resp); err != nil {
+		logger.FromCtx(ctx).Errorf("unable to write the error response: %v", err)
+	}
+}
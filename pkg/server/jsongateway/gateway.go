@@ -0,0 +1,154 @@
+// Copyright 2023 Meta Platforms, Inc. and affiliates.
+//
+// Redistribution and use in source and binary forms, with or without modification, are permitted provided that the following conditions are met:
+//
+// 1. Redistributions of source code must retain the above copyright notice, this list of conditions and the following disclaimer.
+//
+// 2. Redistributions in binary form must reproduce the above copyright notice, this list of conditions and the following disclaimer in the documentation and/or other materials provided with the distribution.
+//
+// 3. Neither the name of the copyright holder nor the names of its contributors may be used to endorse or promote products derived from this software without specific prior written permission.
+//
+// THIS SOFTWARE IS PROVIDED BY THE COPYRIGHT HOLDERS AND CONTRIBUTORS "AS IS" AND ANY EXPRESS OR IMPLIED WARRANTIES, INCLUDING, BUT NOT LIMITED TO, THE IMPLIED WARRANTIES OF MERCHANTABILITY AND FITNESS FOR A PARTICULAR PURPOSE ARE DISCLAIMED. IN NO EVENT SHALL THE COPYRIGHT HOLDER OR CONTRIBUTORS BE LIABLE FOR ANY DIRECT, INDIRECT, INCIDENTAL, SPECIAL, EXEMPLARY, OR CONSEQUENTIAL DAMAGES (INCLUDING, BUT NOT LIMITED TO, PROCUREMENT OF SUBSTITUTE GOODS OR SERVICES; LOSS OF USE, DATA, OR PROFITS; OR BUSINESS INTERRUPTION) HOWEVER CAUSED AND ON ANY THEORY OF LIABILITY, WHETHER IN CONTRACT, STRICT LIABILITY, OR TORT (INCLUDING NEGLIGENCE OR OTHERWISE) ARISING IN ANY WAY OUT OF THE USE OF THIS SOFTWARE, EVEN IF ADVISED OF THE POSSIBILITY OF SUCH DAMAGE.
+package jsongateway
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"reflect"
+	"sort"
+	"strings"
+
+	"github.com/facebookincubator/go-belt/tool/logger"
+
+	"github.com/immune-gmbh/AttestationFailureAnalysisService/if/generated/afas"
+)
+
+const (
+	// PathPrefix is the path prefix the gateway is supposed to be served at.
+	PathPrefix = "/json/v1/"
+
+	// OpenAPIPath is the path (relative to the PathPrefix) of the OpenAPI description.
+	OpenAPIPath = "openapi.json"
+)
+
+// Gateway serves the methods of the Thrift service as JSON over HTTP.
+//
+// Each method is available as "POST <PathPrefix><MethodName>", the body
+// of the request is the JSON-encoded Thrift request structure and the body
+// of the response is the JSON-encoded Thrift result structure (the field
+// names are the same as in the Thrift definitions). Thrift exceptions are
+// returned with non-2xx status codes, see errorResponse.
+type Gateway struct {
+	pathPrefix string
+	methods    map[string]method
+	openAPI    []byte
+}
+
+type method struct {
+	Name        string
+	Description string
+	RequestType reflect.Type
+	ResultType  reflect.Type
+	Call        func(ctx context.Context, request any) (any, error)
+}
+
+func newMethod[REQ, RES any](
+	name, description string,
+	fn func(context.Context, *REQ) (*RES, error),
+) method {
+	return method{
+		Name:        name,
+		Description: description,
+		RequestType: reflect.TypeOf((*REQ)(nil)).Elem(),
+		ResultType:  reflect.TypeOf((*RES)(nil)).Elem(),
+		Call: func(ctx context.Context, request any) (any, error) {
+			return fn(ctx, request.(*REQ))
+		},
+	}
+}
+
+// NewGateway returns a Gateway to the backend served at pathPrefix
+// (if empty then PathPrefix is used).
+//
+// The backend is expected to perform the same authorization and admission
+// control as for the Thrift requests (the same service instance should be used).
+func NewGateway(backend afas.AttestationFailureAnalyzerService, pathPrefix string) (*Gateway, error) {
+	if pathPrefix == "" {
+		pathPrefix = PathPrefix
+	}
+	if !strings.HasSuffix(pathPrefix, "/") {
+		pathPrefix += "/"
+	}
+	gw := &Gateway{
+		pathPrefix: pathPrefix,
+		methods:    map[string]method{},
+	}
+	for _, m := range []method{
+		newMethod("Analyze", "Analyzes the provided artifacts with the requested analyzers.", backend.Analyze),
+		newMethod("SearchFirmware", "Searches for firmware images.", backend.SearchFirmware),
+		newMethod("SearchReport", "Searches for analysis reports.", backend.SearchReport),
+		newMethod("GetServerInfo", "Returns the build information and the capabilities of the server.", backend.GetServerInfo),
+		newMethod("CheckFirmwareVersion", "Checks if the firmware images of the provided versions are available.", backend.CheckFirmwareVersion),
+	} {
+		gw.methods[m.Name] = m
+	}
+
+	methods := make([]method, 0, len(gw.methods))
+	for _, m := range gw.methods {
+		methods = append(methods, m)
+	}
+	sort.Slice(methods, func(i, j int) bool {
+		return methods[i].Name < methods[j].Name
+	})
+	openAPI, err := json.MarshalIndent(newOpenAPI(pathPrefix, methods), "", "  ")
+	if err != nil {
+		return nil, fmt.Errorf("unable to serialize the OpenAPI description: %w", err)
+	}
+	gw.openAPI = openAPI
+	return gw, nil
+}
+
+// ServeHTTP implements http.Handler.
+func (gw *Gateway) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	name := strings.TrimPrefix(r.URL.Path, gw.pathPrefix)
+	if name == OpenAPIPath {
+		if r.Method != http.MethodGet {
+			writeError(r.Context(), w, errMethodNotAllowed{Allowed: http.MethodGet})
+			return
+		}
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write(gw.openAPI)
+		return
+	}
+
+	m, ok := gw.methods[name]
+	if !ok {
+		writeError(r.Context(), w, errUnknownMethod{Name: name})
+		return
+	}
+	if r.Method != http.MethodPost {
+		writeError(r.Context(), w, errMethodNotAllowed{Allowed: http.MethodPost})
+		return
+	}
+
+	request := reflect.New(m.RequestType).Interface()
+	decoder := json.NewDecoder(r.Body)
+	decoder.DisallowUnknownFields()
+	if err := decoder.Decode(request); err != nil {
+		writeError(r.Context(), w, errDecodeRequest{Err: err})
+		return
+	}
+
+	result, err := m.Call(r.Context(), request)
+	if err != nil {
+		writeError(r.Context(), w, err)
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	if err := json.NewEncoder(w).Encode(result); err != nil {
+		logger.FromCtx(r.Context()).Errorf("unable to write the result of %s: %v", name, err)
+	}
+}
@@ -0,0 +1,136 @@
+// Copyright 2023 Meta Platforms, Inc. and affiliates.
+//
+// Redistribution and use in source and binary forms, with or without modification, are permitted provided that the following conditions are met:
+//
+// 1. Redistributions of source code must retain the above copyright notice, this list of conditions and the following disclaimer.
+//
+// 2. Redistributions in binary form must reproduce the above copyright notice, this list of conditions and the following disclaimer in the documentation and/or other materials provided with the distribution.
+//
+// 3. Neither the name of the copyright holder nor the names of its contributors may be used to endorse or promote products derived from this software without specific prior written permission.
+//
+// THIS SOFTWARE IS PROVIDED BY THE COPYRIGHT HOLDERS AND CONTRIBUTORS "AS IS" AND ANY EXPRESS OR IMPLIED WARRANTIES, INCLUDING, BUT NOT LIMITED TO, THE IMPLIED WARRANTIES OF MERCHANTABILITY AND FITNESS FOR A PARTICULAR PURPOSE ARE DISCLAIMED. IN NO EVENT SHALL THE COPYRIGHT HOLDER OR CONTRIBUTORS BE LIABLE FOR ANY DIRECT, INDIRECT, INCIDENTAL, SPECIAL, EXEMPLARY, OR CONSEQUENTIAL DAMAGES (INCLUDING, BUT NOT LIMITED TO, PROCUREMENT OF SUBSTITUTE GOODS OR SERVICES; LOSS OF USE, DATA, OR PROFITS; OR BUSINESS INTERRUPTION) HOWEVER CAUSED AND ON ANY THEORY OF LIABILITY, WHETHER IN CONTRACT, STRICT LIABILITY, OR TORT (INCLUDING NEGLIGENCE OR OTHERWISE) ARISING IN ANY WAY OUT OF THE USE OF THIS SOFTWARE, EVEN IF ADVISED OF THE POSSIBILITY OF SUCH DAMAGE.
+package jsongateway
+
+import (
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/immune-gmbh/AttestationFailureAnalysisService/if/generated/afas"
+)
+
+type fakeBackend struct {
+	afas.AttestationFailureAnalyzerService
+	searchFirmwareErr error
+	lastRequest       *afas.SearchFirmwareRequest
+}
+
+func (b *fakeBackend) SearchFirmware(
+	ctx context.Context,
+	request *afas.SearchFirmwareRequest,
+) (*afas.SearchFirmwareResult_, error) {
+	b.lastRequest = request
+	if b.searchFirmwareErr != nil {
+		return nil, b.searchFirmwareErr
+	}
+	return &afas.SearchFirmwareResult_{
+		Found: []*afas.Firmware{{Metadata: &afas.FirmwareImageMetadata{Size: 1}, Data: []byte{1}}},
+	}, nil
+}
+
+func newTestGateway(t *testing.T, backend *fakeBackend) *httptest.Server {
+	gw, err := NewGateway(backend, "")
+	require.NoError(t, err)
+	srv := httptest.NewServer(gw)
+	t.Cleanup(srv.Close)
+	return srv
+}
+
+func post(t *testing.T, srv *httptest.Server, method, body string) (*http.Response, []byte) {
+	resp, err := http.Post(srv.URL+PathPrefix+method, "application/json", strings.NewReader(body))
+	require.NoError(t, err)
+	defer resp.Body.Close()
+	var buf strings.Builder
+	_, err = io.Copy(&buf, resp.Body)
+	require.NoError(t, err)
+	return resp, []byte(buf.String())
+}
+
+func TestGatewayCall(t *testing.T) {
+	backend := &fakeBackend{}
+	srv := newTestGateway(t, backend)
+
+	resp, body := post(t, srv, "SearchFirmware", `{"OrFilters":[{"Version":"1.0"}],"FetchContent":false}`)
+	require.Equal(t, http.StatusOK, resp.StatusCode, string(body))
+	require.Equal(t, "1.0", backend.lastRequest.OrFilters[0].GetVersion())
+
+	var result afas.SearchFirmwareResult_
+	require.NoError(t, json.Unmarshal(body, &result))
+	require.Equal(t, []byte{1}, result.Found[0].Data)
+}
+
+func TestGatewayErrors(t *testing.T) {
+	backend := &fakeBackend{}
+	srv := newTestGateway(t, backend)
+
+	t.Run("unknown_method", func(t *testing.T) {
+		resp, _ := post(t, srv, "NoSuchMethod", `{}`)
+		require.Equal(t, http.StatusNotFound, resp.StatusCode)
+	})
+
+	t.Run("unknown_field", func(t *testing.T) {
+		resp, _ := post(t, srv, "SearchFirmware", `{"NoSuchField":1}`)
+		require.Equal(t, http.StatusBadRequest, resp.StatusCode)
+	})
+
+	t.Run("PermissionDenied", func(t *testing.T) {
+		backend.searchFirmwareErr = &afas.PermissionDenied{Reason: "dummy"}
+		resp, body := post(t, srv, "SearchFirmware", `{}`)
+		require.Equal(t, http.StatusForbidden, resp.StatusCode)
+
+		var errResp errorResponse
+		require.NoError(t, json.Unmarshal(body, &errResp))
+		require.Equal(t, "PermissionDenied", errResp.Exception)
+	})
+
+	t.Run("Overloaded", func(t *testing.T) {
+		backend.searchFirmwareErr = &afas.Overloaded{Reason: "dummy", RetryAfterMilliseconds: 1500}
+		resp, _ := post(t, srv, "SearchFirmware", `{}`)
+		require.Equal(t, http.StatusServiceUnavailable, resp.StatusCode)
+		require.Equal(t, "2", resp.Header.Get("Retry-After"))
+	})
+}
+
+func TestGatewayOpenAPI(t *testing.T) {
+	srv := newTestGateway(t, &fakeBackend{})
+
+	resp, err := http.Get(srv.URL + PathPrefix + OpenAPIPath)
+	require.NoError(t, err)
+	defer resp.Body.Close()
+	require.Equal(t, http.StatusOK, resp.StatusCode)
+
+	var spec struct {
+		Paths      map[string]any `json:"paths"`
+		Components struct {
+			Schemas map[string]map[string]any `json:"schemas"`
+		} `json:"components"`
+	}
+	require.NoError(t, json.NewDecoder(resp.Body).Decode(&spec))
+	for _, method := range []string{"Analyze", "SearchFirmware", "SearchReport", "CheckFirmwareVersion", "GetServerInfo"} {
+		require.Contains(t, spec.Paths, PathPrefix+method)
+	}
+	require.Contains(t, spec.Components.Schemas, "afas.AnalyzeRequest")
+	require.Contains(t, spec.Components.Schemas, errorResponseSchema)
+
+	// enums are serialized as strings
+	artifactProperties := spec.Components.Schemas["afas.Artifact"]["properties"].(map[string]any)
+	tpmDevice := artifactProperties["TPMDevice"].(map[string]any)
+	require.Equal(t, "string", tpmDevice["type"])
+	require.Contains(t, tpmDevice["enum"], "TPM20")
+}
@@ -0,0 +1,195 @@
+// Copyright 2023 Meta Platforms, Inc. and affiliates.
+//
+// Redistribution and use in source and binary forms, with or without modification, are permitted provided that the following conditions are met:
+//
+// 1. Redistributions of source code must retain the above copyright notice, this list of conditions and the following disclaimer.
+//
+// 2. Redistributions in binary form must reproduce the above copyright notice, this list of conditions and the following disclaimer in the documentation and/or other materials provided with the distribution.
+//
+// 3. Neither the name of the copyright holder nor the names of its contributors may be used to endorse or promote products derived from this software without specific prior written permission.
+//
+// THIS SOFTWARE IS PROVIDED BY THE COPYRIGHT HOLDERS AND CONTRIBUTORS "AS IS" AND ANY EXPRESS OR IMPLIED WARRANTIES, INCLUDING, BUT NOT LIMITED TO, THE IMPLIED WARRANTIES OF MERCHANTABILITY AND FITNESS FOR A PARTICULAR PURPOSE ARE DISCLAIMED. IN NO EVENT SHALL THE COPYRIGHT HOLDER OR CONTRIBUTORS BE LIABLE FOR ANY DIRECT, INDIRECT, INCIDENTAL, SPECIAL, EXEMPLARY, OR CONSEQUENTIAL DAMAGES (INCLUDING, BUT NOT LIMITED TO, PROCUREMENT OF SUBSTITUTE GOODS OR SERVICES; LOSS OF USE, DATA, OR PROFITS; OR BUSINESS INTERRUPTION) HOWEVER CAUSED AND ON ANY THEORY OF LIABILITY, WHETHER IN CONTRACT, STRICT LIABILITY, OR TORT (INCLUDING NEGLIGENCE OR OTHERWISE) ARISING IN ANY WAY OUT OF THE USE OF THIS SOFTWARE, EVEN IF ADVISED OF THE POSSIBILITY OF SUCH DAMAGE.
+package jsongateway
+
+import (
+	"encoding"
+	"fmt"
+	"net/http"
+	"path"
+	"reflect"
+	"strings"
+)
+
+const (
+	schemaRefPrefix      = "#/components/schemas/"
+	errorResponseSchema  = "ErrorResponse"
+	maxProbedEnumValue   = 1024
+	unsetEnumValueString = "<UNSET>"
+)
+
+var textMarshalerType = reflect.TypeOf((*encoding.TextMarshaler)(nil)).Elem()
+
+type jsonObject = map[string]any
+
+// newOpenAPI returns the OpenAPI 3 description of the methods.
+//
+// The schemas are derived from the Go types generated by Thrift, so
+// the description never diverges from the actual API.
+func newOpenAPI(pathPrefix string, methods []method) jsonObject {
+	b := &openAPIBuilder{schemas: jsonObject{}}
+	b.schemas[errorResponseSchema] = b.schemaOfStruct(reflect.TypeOf(errorResponse{}))
+
+	errorResponseRef := jsonObject{
+		"content": jsonObject{
+			"application/json": jsonObject{
+				"schema": jsonObject{"$ref": schemaRefPrefix + errorResponseSchema},
+			},
+		},
+	}
+	paths := jsonObject{}
+	for _, m := range methods {
+		responses := jsonObject{
+			"200": jsonObject{
+				"description": "the result",
+				"content": jsonObject{
+					"application/json": jsonObject{"schema": b.schemaOf(m.ResultType)},
+				},
+			},
+			"default": withDescription(errorResponseRef, "an error"),
+		}
+		for exception, statusCode := range exceptionStatusCodes {
+			key := fmt.Sprint(statusCode)
+			if _, ok := responses[key]; ok {
+				continue
+			}
+			responses[key] = withDescription(errorResponseRef, fmt.Sprintf("%s: the Thrift exception %s", http.StatusText(statusCode), exception))
+		}
+		paths[path.Join(pathPrefix, m.Name)] = jsonObject{
+			"post": jsonObject{
+				"operationId": m.Name,
+				"description": m.Description,
+				"requestBody": jsonObject{
+					"required": true,
+					"content": jsonObject{
+						"application/json": jsonObject{"schema": b.schemaOf(m.RequestType)},
+					},
+				},
+				"responses": responses,
+			},
+		}
+	}
+
+	return jsonObject{
+		"openapi": "3.0.3",
+		"info": jsonObject{
+			"title":       "Attestation Failure Analysis Service",
+			"description": "JSON over HTTP gateway to the Thrift service AttestationFailureAnalyzerService (see if/afas.thrift).",
+			"version":     "1",
+		},
+		"paths": paths,
+		"components": jsonObject{
+			"schemas": b.schemas,
+		},
+	}
+}
+
+func withDescription(obj jsonObject, description string) jsonObject {
+	result := jsonObject{"description": description}
+	for k, v := range obj {
+		result[k] = v
+	}
+	return result
+}
+
+type openAPIBuilder struct {
+	schemas jsonObject
+}
+
+func (b *openAPIBuilder) schemaOf(t reflect.Type) jsonObject {
+	if t.Kind() != reflect.Pointer && t.Implements(textMarshalerType) {
+		return schemaOfEnum(t)
+	}
+
+	switch t.Kind() {
+	case reflect.Pointer:
+		return b.schemaOf(t.Elem())
+	case reflect.Struct:
+		name := schemaName(t)
+		if _, ok := b.schemas[name]; !ok {
+			// registering before the recursion to support recursive types
+			b.schemas[name] = nil
+			b.schemas[name] = b.schemaOfStruct(t)
+		}
+		return jsonObject{"$ref": schemaRefPrefix + name}
+	case reflect.Slice, reflect.Array:
+		if t.Elem().Kind() == reflect.Uint8 {
+			return jsonObject{"type": "string", "format": "byte"}
+		}
+		return jsonObject{"type": "array", "items": b.schemaOf(t.Elem())}
+	case reflect.Map:
+		return jsonObject{"type": "object", "additionalProperties": b.schemaOf(t.Elem())}
+	case reflect.Bool:
+		return jsonObject{"type": "boolean"}
+	case reflect.Int8, reflect.Int16, reflect.Int32, reflect.Uint8, reflect.Uint16:
+		return jsonObject{"type": "integer", "format": "int32"}
+	case reflect.Int, reflect.Int64, reflect.Uint, reflect.Uint32, reflect.Uint64:
+		return jsonObject{"type": "integer", "format": "int64"}
+	case reflect.Float32, reflect.Float64:
+		return jsonObject{"type": "number", "format": "double"}
+	case reflect.String:
+		return jsonObject{"type": "string"}
+	}
+	return jsonObject{}
+}
+
+func (b *openAPIBuilder) schemaOfStruct(t reflect.Type) jsonObject {
+	properties := jsonObject{}
+	for idx := 0; idx < t.NumField(); idx++ {
+		field := t.Field(idx)
+		if !field.IsExported() {
+			continue
+		}
+		name, _, _ := strings.Cut(field.Tag.Get("json"), ",")
+		switch name {
+		case "-":
+			continue
+		case "":
+			name = field.Name
+		}
+		properties[name] = b.schemaOf(field.Type)
+	}
+	return jsonObject{"type": "object", "properties": properties}
+}
+
+// schemaOfEnum returns the schema of a Thrift enum, which is serialized
+// as a string by encoding.TextMarshaler.
+//
+// Go does not provide a way to list the values of an enum, so the values
+// are probed through the String method generated by Thrift.
+func schemaOfEnum(t reflect.Type) jsonObject {
+	schema := jsonObject{"type": "string"}
+	switch t.Kind() {
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+	default:
+		return schema
+	}
+
+	var values []string
+	v := reflect.New(t).Elem()
+	for i := int64(0); i < maxProbedEnumValue; i++ {
+		v.SetInt(i)
+		s := fmt.Sprint(v.Interface())
+		if s == unsetEnumValueString {
+			continue
+		}
+		values = append(values, s)
+	}
+	if len(values) > 0 {
+		schema["enum"] = values
+	}
+	return schema
+}
+
+func schemaName(t reflect.Type) string {
+	return path.Base(t.PkgPath()) + "." + t.Name()
+}
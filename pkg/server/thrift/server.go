@@ -13,19 +13,28 @@ package thrift
 
 import (
 	"context"
+	"crypto/tls"
+	"errors"
 	"fmt"
 	"net/http"
 	"sync/atomic"
+	"time"
 
 	"github.com/apache/thrift/lib/go/thrift"
 	"github.com/facebookincubator/go-belt"
 	"github.com/facebookincubator/go-belt/tool/logger"
 
 	"github.com/immune-gmbh/AttestationFailureAnalysisService/if/generated/afas"
+	"github.com/immune-gmbh/AttestationFailureAnalysisService/pkg/admission"
 	"github.com/immune-gmbh/AttestationFailureAnalysisService/pkg/httputils/servermiddleware"
 	"github.com/immune-gmbh/AttestationFailureAnalysisService/pkg/server/controller"
+	"github.com/immune-gmbh/AttestationFailureAnalysisService/pkg/server/jsongateway"
 )
 
+// shutdownTimeout is the time limit for the requests in progress
+// to be finished after the context of Serve is cancelled.
+const shutdownTimeout = 30 * time.Second
+
 type serviceInterface interface {
 	Reset()
 }
@@ -35,12 +44,18 @@ type Server struct {
 	HardConcurrentRequestsLimit uint
 	MaxCPULoad                  float64
 
+	// TLSConfig enables serving HTTPS if not nil (see NewTLSConfig).
+	TLSConfig *tls.Config
+
 	service    serviceInterface
 	serveCount uint64
 }
 
 // Serve starts listening on bindAddr and serves it.
 //
+// When the context is cancelled, the server stops accepting new connections,
+// waits for the requests in progress to be finished and returns nil.
+//
 // This method could be executed only once.
 func (srv *Server) Serve(
 	ctx context.Context,
@@ -50,10 +65,39 @@ func (srv *Server) Serve(
 		return fmt.Errorf("method Serve could be used only once")
 	}
 	defer srv.service.Reset()
-	return http.ListenAndServe(bindAddr, nil)
+	httpServer := &http.Server{
+		Addr:      bindAddr,
+		TLSConfig: srv.TLSConfig,
+	}
+
+	shutdownErr := make(chan error, 1)
+	go func() {
+		<-ctx.Done()
+		shutdownCtx, cancelFn := context.WithTimeout(context.Background(), shutdownTimeout)
+		defer cancelFn()
+		shutdownErr <- httpServer.Shutdown(shutdownCtx)
+	}()
+
+	var err error
+	if srv.TLSConfig == nil {
+		err = httpServer.ListenAndServe()
+	} else {
+		err = httpServer.ListenAndServeTLS("", "")
+	}
+	if !errors.Is(err, http.ErrServerClosed) {
+		return err
+	}
+	if err := <-shutdownErr; err != nil {
+		return fmt.Errorf("unable to shut down the server gracefully: %w", err)
+	}
+	return nil
 }
 
 // NewServer returns a Thrift server for a firmware analysis service.
+//
+// Analyze requests are executed by at most numWorkers concurrently, at most
+// hardConcurrentRequestsLimit requests are queued, and new requests are
+// rejected while the CPU load exceeds maxCPULoad.
 func NewServer(
 	numWorkers, hardConcurrentRequestsLimit uint,
 	maxCPULoad float64,
@@ -62,15 +106,30 @@ func NewServer(
 	logLevel logger.Level,
 ) (*Server, error) {
 	protocolFactory := thrift.NewTBinaryProtocolFactoryConf(nil)
-	svc := newService(ctrl)
+	analyzeLimiter := admission.NewLimiter(admission.Config{
+		Name:       "Analyze",
+		Workers:    numWorkers,
+		QueueSize:  hardConcurrentRequestsLimit,
+		MaxCPULoad: maxCPULoad,
+		CPULoad:    admission.NewProcStatCPULoad().Load,
+	})
+	svc := newService(ctrl, analyzeLimiter)
 	processor := afas.NewAttestationFailureAnalyzerServiceProcessor(svc)
 	handler := thrift.NewThriftHandlerFunc(processor, protocolFactory, protocolFactory)
 	handler = servermiddleware.AddDefaultMiddleware(handler, observability, true, logLevel)
 	http.HandleFunc("/", handler)
+
+	// the same service (and thus the same authorization and admission control)
+	// is also served as JSON over HTTP for clients without Thrift support
+	gateway, err := jsongateway.NewGateway(svc, jsongateway.PathPrefix)
+	if err != nil {
+		return nil, fmt.Errorf("unable to initialize the JSON gateway: %w", err)
+	}
+	http.HandleFunc(jsongateway.PathPrefix, servermiddleware.AddDefaultMiddleware(gateway.ServeHTTP, observability, true, logLevel))
 	srv := &Server{
 		HardConcurrentRequestsLimit: hardConcurrentRequestsLimit,
 		MaxCPULoad:                  maxCPULoad,
-		service:                     newService(ctrl),
+		service:                     svc,
 	}
 	return srv, nil
 }
@@ -17,7 +17,11 @@ import (
 	"fmt"
 	"time"
 
+	"github.com/facebookincubator/go-belt/tool/logger"
+
 	"github.com/immune-gmbh/AttestationFailureAnalysisService/if/generated/afas"
+	"github.com/immune-gmbh/AttestationFailureAnalysisService/pkg/admission"
+	"github.com/immune-gmbh/AttestationFailureAnalysisService/pkg/identity"
 	"github.com/immune-gmbh/AttestationFailureAnalysisService/pkg/server/controller"
 )
 
@@ -29,13 +33,18 @@ var _ afas.AttestationFailureAnalyzerService = (*service)(nil)
 
 type service struct {
 	Controller *controller.Controller
+
+	// AnalyzeLimiter is the admission control for Analyze; nil means no limits.
+	AnalyzeLimiter *admission.Limiter
 }
 
 func newService(
 	ctrl *controller.Controller,
+	analyzeLimiter *admission.Limiter,
 ) *service {
 	return &service{
-		Controller: ctrl,
+		Controller:     ctrl,
+		AnalyzeLimiter: analyzeLimiter,
 	}
 }
 
@@ -47,6 +56,16 @@ type thriftExceptioner interface {
 	ThriftException() error
 }
 
+func (svc *service) GetServerInfo(
+	ctx context.Context,
+	request *afas.GetServerInfoRequest,
+) (*afas.ServerInfo, error) {
+	if err := authorize(ctx, "GetServerInfo"); err != nil {
+		return nil, err
+	}
+	return svc.Controller.GetServerInfo(ctx), nil
+}
+
 func (svc *service) SearchFirmware(
 	ctx context.Context,
 	request *afas.SearchFirmwareRequest,
@@ -54,6 +73,13 @@ func (svc *service) SearchFirmware(
 	if request == nil {
 		return nil, fmt.Errorf("request == nil")
 	}
+	permissions := []string{"SearchFirmware"}
+	if request.GetFetchContent() {
+		permissions = append(permissions, "SearchFirmware:FetchContent")
+	}
+	if err := authorize(ctx, permissions...); err != nil {
+		return nil, err
+	}
 	report, err = svc.Controller.SearchFirmware(
 		ctx,
 		request.GetOrFilters(),
@@ -69,6 +95,9 @@ func (svc *service) SearchReport(
 	if request == nil {
 		return nil, fmt.Errorf("request == nil")
 	}
+	if err := authorize(ctx, "SearchReport"); err != nil {
+		return nil, err
+	}
 	report, err = svc.Controller.SearchReport(
 		ctx,
 		request.GetOrFilters(),
@@ -84,6 +113,9 @@ func (svc *service) Analyze(
 	if request == nil {
 		return nil, fmt.Errorf("request == nil")
 	}
+	if err := authorize(ctx, "Analyze"); err != nil {
+		return nil, err
+	}
 
 	artifacts := make([]afas.Artifact, 0, len(request.GetArtifacts()))
 	for idx, art := range request.GetArtifacts() {
@@ -108,6 +140,16 @@ func (svc *service) Analyze(
 		analyzers = append(analyzers, *analyzer)
 	}
 
+	if svc.AnalyzeLimiter != nil {
+		// requests are queued fairly per client; all unverified clients share the same queue
+		clientKey, _ := controller.ExtractHostnameFromCtx(ctx)
+		release, err := svc.AnalyzeLimiter.Acquire(ctx, clientKey)
+		if err != nil {
+			return nil, unwrapException(err)
+		}
+		defer release()
+	}
+
 	result, err := svc.Controller.Analyze(
 		ctx,
 		request.GetHostInfo(),
@@ -127,6 +169,9 @@ func (svc *service) CheckFirmwareVersion(
 	if request == nil {
 		return nil, fmt.Errorf("request == nil")
 	}
+	if err := authorize(ctx, "CheckFirmwareVersion"); err != nil {
+		return nil, err
+	}
 
 	var inputVersions []afas.FirmwareVersion
 	for _, firmwareVersion := range request.Firmwares {
@@ -148,6 +193,202 @@ func (svc *service) CheckFirmwareVersion(
 	}, nil
 }
 
+func (svc *service) SearchByPCR(
+	ctx context.Context,
+	request *afas.SearchByPCRRequest,
+) (*afas.SearchByPCRResult_, error) {
+	if request == nil {
+		return nil, fmt.Errorf("request == nil")
+	}
+	if err := authorize(ctx, "SearchByPCR"); err != nil {
+		return nil, err
+	}
+
+	found, err := svc.Controller.SearchByPCR(ctx, request.GetPCRIndex(), request.GetBank(), request.GetDigest(), request.ModelID)
+	if err != nil {
+		return nil, unwrapException(err)
+	}
+	return &afas.SearchByPCRResult_{
+		Found: found,
+	}, nil
+}
+
+func (svc *service) CompareReports(
+	ctx context.Context,
+	request *afas.CompareReportsRequest,
+) (*afas.CompareReportsResult_, error) {
+	if request == nil {
+		return nil, fmt.Errorf("request == nil")
+	}
+	if err := authorize(ctx, "CompareReports"); err != nil {
+		return nil, err
+	}
+
+	result, err := svc.Controller.CompareReports(ctx, request.GetJobIDA(), request.GetJobIDB())
+	return result, unwrapException(err)
+}
+
+func (svc *service) AggregateReports(
+	ctx context.Context,
+	request *afas.AggregateReportsRequest,
+) (*afas.AggregateReportsResult_, error) {
+	if request == nil {
+		return nil, fmt.Errorf("request == nil")
+	}
+	if err := authorize(ctx, "AggregateReports"); err != nil {
+		return nil, err
+	}
+
+	result, err := svc.Controller.AggregateReports(ctx, request)
+	return result, unwrapException(err)
+}
+
+func (svc *service) NegotiateImageUpload(
+	ctx context.Context,
+	request *afas.NegotiateImageUploadRequest,
+) (*afas.NegotiateImageUploadResult_, error) {
+	if request == nil {
+		return nil, fmt.Errorf("request == nil")
+	}
+	if err := authorize(ctx, "NegotiateImageUpload"); err != nil {
+		return nil, err
+	}
+
+	result, err := svc.Controller.NegotiateImageUpload(ctx, request.GetManifest())
+	return result, unwrapException(err)
+}
+
+func (svc *service) InsertOriginalFirmware(
+	ctx context.Context,
+	request *afas.InsertOriginalFirmwareRequest,
+) (*afas.InsertOriginalFirmwareResult_, error) {
+	if request == nil {
+		return nil, fmt.Errorf("request == nil")
+	}
+	if err := authorize(ctx, "InsertOriginalFirmware"); err != nil {
+		return nil, err
+	}
+
+	id, err := svc.Controller.InsertOriginalFirmware(ctx, request.GetFirmware())
+	if err != nil {
+		return nil, unwrapException(err)
+	}
+	return &afas.InsertOriginalFirmwareResult_{
+		ID: id,
+	}, nil
+}
+
+func (svc *service) UpdateOriginalFirmware(
+	ctx context.Context,
+	request *afas.UpdateOriginalFirmwareRequest,
+) error {
+	if request == nil {
+		return fmt.Errorf("request == nil")
+	}
+	if err := authorize(ctx, "UpdateOriginalFirmware"); err != nil {
+		return err
+	}
+
+	return unwrapException(svc.Controller.UpdateOriginalFirmware(ctx, request.GetFirmware()))
+}
+
+func (svc *service) DeleteOriginalFirmware(
+	ctx context.Context,
+	request *afas.DeleteOriginalFirmwareRequest,
+) error {
+	if request == nil {
+		return fmt.Errorf("request == nil")
+	}
+	if err := authorize(ctx, "DeleteOriginalFirmware"); err != nil {
+		return err
+	}
+
+	return unwrapException(svc.Controller.DeleteOriginalFirmware(ctx, request.GetID()))
+}
+
+func (svc *service) ListTamperingRegistryEntries(
+	ctx context.Context,
+	request *afas.ListTamperingRegistryEntriesRequest,
+) (*afas.ListTamperingRegistryEntriesResult_, error) {
+	if request == nil {
+		return nil, fmt.Errorf("request == nil")
+	}
+	if err := authorize(ctx, "ListTamperingRegistryEntries"); err != nil {
+		return nil, err
+	}
+
+	entries, err := svc.Controller.ListTamperingRegistryEntries(ctx, request.Kind, request.GetIncludeExpired())
+	if err != nil {
+		return nil, unwrapException(err)
+	}
+	return &afas.ListTamperingRegistryEntriesResult_{
+		Entries: entries,
+	}, nil
+}
+
+func (svc *service) InsertTamperingRegistryEntry(
+	ctx context.Context,
+	request *afas.InsertTamperingRegistryEntryRequest,
+) (*afas.InsertTamperingRegistryEntryResult_, error) {
+	if request == nil {
+		return nil, fmt.Errorf("request == nil")
+	}
+	if err := authorize(ctx, "InsertTamperingRegistryEntry"); err != nil {
+		return nil, err
+	}
+
+	id, err := svc.Controller.InsertTamperingRegistryEntry(ctx, request.GetEntry())
+	if err != nil {
+		return nil, unwrapException(err)
+	}
+	return &afas.InsertTamperingRegistryEntryResult_{
+		ID: id,
+	}, nil
+}
+
+func (svc *service) UpdateTamperingRegistryEntry(
+	ctx context.Context,
+	request *afas.UpdateTamperingRegistryEntryRequest,
+) error {
+	if request == nil {
+		return fmt.Errorf("request == nil")
+	}
+	if err := authorize(ctx, "UpdateTamperingRegistryEntry"); err != nil {
+		return err
+	}
+
+	return unwrapException(svc.Controller.UpdateTamperingRegistryEntry(ctx, request.GetEntry()))
+}
+
+func (svc *service) DeleteTamperingRegistryEntry(
+	ctx context.Context,
+	request *afas.DeleteTamperingRegistryEntryRequest,
+) error {
+	if request == nil {
+		return fmt.Errorf("request == nil")
+	}
+	if err := authorize(ctx, "DeleteTamperingRegistryEntry"); err != nil {
+		return err
+	}
+
+	return unwrapException(svc.Controller.DeleteTamperingRegistryEntry(ctx, request.GetID()))
+}
+
+// authorize returns PermissionDenied if the client is not permitted to use
+// any of the permissions (see identity.IsPermitted).
+func authorize(ctx context.Context, permissions ...string) error {
+	identities, err := identity.NewIdentitiesFromContext(ctx)
+	if err != nil {
+		logger.FromCtx(ctx).Warnf("unable to get client identities: %v", err)
+	}
+	for _, permission := range permissions {
+		if !identity.IsPermitted(ctx, identities, permission) {
+			return unwrapException(controller.ErrPermissionDenied{Action: fmt.Sprintf("use %s", permission)})
+		}
+	}
+	return nil
+}
+
 func unwrapException(err error) error {
 	if err == nil {
 		return nil
@@ -35,4 +35,9 @@ func TestThriftExceptionsUnwrapping(t *testing.T) {
 		configErr := controller.NewErrInvalidHostConfiguration(fmt.Errorf("dummy"))
 		require.Equal(t, configErr.ThriftException(), unwrapException(configErr))
 	})
+
+	t.Run("ErrPermissionDenied", func(t *testing.T) {
+		permErr := fmt.Errorf("wrapped: %w", controller.ErrPermissionDenied{Action: "dummy"})
+		require.Equal(t, controller.ErrPermissionDenied{Action: "dummy"}.ThriftException(), unwrapException(permErr))
+	})
 }
@@ -0,0 +1,82 @@
+// Copyright 2023 Meta Platforms, Inc. and affiliates.
+//
+// Redistribution and use in source and binary forms, with or without modification, are permitted provided that the following conditions are met:
+//
+// 1. Redistributions of source code must retain the above copyright notice, this list of conditions and the following disclaimer.
+//
+// 2. Redistributions in binary form must reproduce the above copyright notice, this list of conditions and the following disclaimer in the documentation and/or other materials provided with the distribution.
+//
+// 3. Neither the name of the copyright holder nor the names of its contributors may be used to endorse or promote products derived from this software without specific prior written permission.
+//
+// THIS SOFTWARE IS PROVIDED BY THE COPYRIGHT HOLDERS AND CONTRIBUTORS "AS IS" AND ANY EXPRESS OR IMPLIED WARRANTIES, INCLUDING, BUT NOT LIMITED TO, THE IMPLIED WARRANTIES OF MERCHANTABILITY AND FITNESS FOR A PARTICULAR PURPOSE ARE DISCLAIMED. IN NO EVENT SHALL THE COPYRIGHT HOLDER OR CONTRIBUTORS BE LIABLE FOR ANY DIRECT, INDIRECT, INCIDENTAL, SPECIAL, EXEMPLARY, OR CONSEQUENTIAL DAMAGES (INCLUDING, BUT NOT LIMITED TO, PROCUREMENT OF SUBSTITUTE GOODS OR SERVICES; LOSS OF USE, DATA, OR PROFITS; OR BUSINESS INTERRUPTION) HOWEVER CAUSED AND ON ANY THEORY OF LIABILITY, WHETHER IN CONTRACT, STRICT LIABILITY, OR TORT (INCLUDING NEGLIGENCE OR OTHERWISE) ARISING IN ANY WAY OUT OF THE USE OF THIS SOFTWARE, EVEN IF ADVISED OF THE POSSIBILITY OF SUCH DAMAGE.
+package thrift
+
+import (
+	"crypto/tls"
+	"crypto/x509"
+	"fmt"
+	"os"
+)
+
+// ClientAuth defines if and how client TLS certificates are verified.
+type ClientAuth int
+
+const (
+	// ClientAuthNone means client certificates are not requested.
+	ClientAuthNone = ClientAuth(iota)
+
+	// ClientAuthVerifyIfGiven means client certificates are requested and
+	// verified if provided, but clients without certificates are accepted
+	// (as anonymous clients).
+	ClientAuthVerifyIfGiven
+
+	// ClientAuthRequire means every client must provide a valid certificate
+	// (mutual TLS).
+	ClientAuthRequire
+)
+
+// NewTLSConfig returns a TLS configuration for the server.
+//
+// clientCAFiles are paths to PEM bundles of CA certificates the client
+// certificates are verified against; they are required unless clientAuth
+// is ClientAuthNone.
+func NewTLSConfig(
+	certFile, keyFile string,
+	clientCAFiles []string,
+	clientAuth ClientAuth,
+) (*tls.Config, error) {
+	cert, err := tls.LoadX509KeyPair(certFile, keyFile)
+	if err != nil {
+		return nil, fmt.Errorf("unable to load the server certificate '%s' (key: '%s'): %w", certFile, keyFile, err)
+	}
+	cfg := &tls.Config{
+		MinVersion:   tls.VersionTLS12,
+		Certificates: []tls.Certificate{cert},
+	}
+
+	switch clientAuth {
+	case ClientAuthNone:
+		return cfg, nil
+	case ClientAuthVerifyIfGiven:
+		cfg.ClientAuth = tls.VerifyClientCertIfGiven
+	case ClientAuthRequire:
+		cfg.ClientAuth = tls.RequireAndVerifyClientCert
+	default:
+		return nil, fmt.Errorf("unknown client auth mode: %d", clientAuth)
+	}
+
+	if len(clientCAFiles) == 0 {
+		return nil, fmt.Errorf("client CA bundles are required to verify client certificates")
+	}
+	cfg.ClientCAs = x509.NewCertPool()
+	for _, caFile := range clientCAFiles {
+		pem, err := os.ReadFile(caFile)
+		if err != nil {
+			return nil, fmt.Errorf("unable to read the client CA bundle '%s': %w", caFile, err)
+		}
+		if !cfg.ClientCAs.AppendCertsFromPEM(pem) {
+			return nil, fmt.Errorf("no certificates found in the client CA bundle '%s'", caFile)
+		}
+	}
+	return cfg, nil
+}
@@ -0,0 +1,179 @@
+// Copyright 2023 Meta Platforms, Inc. and affiliates.
+//
+// Redistribution and use in source and binary forms, with or without modification, are permitted provided that the following conditions are met:
+//
+// 1. Redistributions of source code must retain the above copyright notice, this list of conditions and the following disclaimer.
+//
+// 2. Redistributions in binary form must reproduce the above copyright notice, this list of conditions and the following disclaimer in the documentation and/or other materials provided with the distribution.
+//
+// 3. Neither the name of the copyright holder nor the names of its contributors may be used to endorse or promote products derived from this software without specific prior written permission.
+//
+// THIS SOFTWARE IS PROVIDED BY THE COPYRIGHT HOLDERS AND CONTRIBUTORS "AS IS" AND ANY EXPRESS OR IMPLIED WARRANTIES, INCLUDING, BUT NOT LIMITED TO, THE IMPLIED WARRANTIES OF MERCHANTABILITY AND FITNESS FOR A PARTICULAR PURPOSE ARE DISCLAIMED. IN NO EVENT SHALL THE COPYRIGHT HOLDER OR CONTRIBUTORS BE LIABLE FOR ANY DIRECT, INDIRECT, INCIDENTAL, SPECIAL, EXEMPLARY, OR CONSEQUENTIAL DAMAGES (INCLUDING, BUT NOT LIMITED TO, PROCUREMENT OF SUBSTITUTE GOODS OR SERVICES; LOSS OF USE, DATA, OR PROFITS; OR BUSINESS INTERRUPTION) HOWEVER CAUSED AND ON ANY THEORY OF LIABILITY, WHETHER IN CONTRACT, STRICT LIABILITY, OR TORT (INCLUDING NEGLIGENCE OR OTHERWISE) ARISING IN ANY WAY OUT OF THE USE OF THIS SOFTWARE, EVEN IF ADVISED OF THE POSSIBILITY OF SUCH DAMAGE.
+package thrift
+
+import (
+	"context"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/tls"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/pem"
+	"fmt"
+	"io"
+	"math/big"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/immune-gmbh/AttestationFailureAnalysisService/if/generated/afas"
+	"github.com/immune-gmbh/AttestationFailureAnalysisService/pkg/httputils/servermiddleware"
+	"github.com/immune-gmbh/AttestationFailureAnalysisService/pkg/identity"
+	"github.com/immune-gmbh/AttestationFailureAnalysisService/pkg/server/controller"
+)
+
+type testCA struct {
+	cert *x509.Certificate
+	key  *ecdsa.PrivateKey
+	pem  []byte
+}
+
+func newTestCA(t *testing.T) *testCA {
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	require.NoError(t, err)
+	template := &x509.Certificate{
+		SerialNumber:          big.NewInt(1),
+		Subject:               pkix.Name{CommonName: "test CA"},
+		NotBefore:             time.Now().Add(-time.Hour),
+		NotAfter:              time.Now().Add(time.Hour),
+		KeyUsage:              x509.KeyUsageCertSign,
+		IsCA:                  true,
+		BasicConstraintsValid: true,
+	}
+	der, err := x509.CreateCertificate(rand.Reader, template, template, &key.PublicKey, key)
+	require.NoError(t, err)
+	cert, err := x509.ParseCertificate(der)
+	require.NoError(t, err)
+	return &testCA{
+		cert: cert,
+		key:  key,
+		pem:  pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: der}),
+	}
+}
+
+// issue returns PEM-encoded certificate and key.
+func (ca *testCA) issue(t *testing.T, serial int64, dnsName string, extKeyUsage x509.ExtKeyUsage) ([]byte, []byte) {
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	require.NoError(t, err)
+	template := &x509.Certificate{
+		SerialNumber: big.NewInt(serial),
+		Subject:      pkix.Name{CommonName: dnsName},
+		DNSNames:     []string{dnsName},
+		IPAddresses:  []net.IP{net.ParseIP("127.0.0.1")},
+		NotBefore:    time.Now().Add(-time.Hour),
+		NotAfter:     time.Now().Add(time.Hour),
+		KeyUsage:     x509.KeyUsageDigitalSignature,
+		ExtKeyUsage:  []x509.ExtKeyUsage{extKeyUsage},
+	}
+	der, err := x509.CreateCertificate(rand.Reader, template, ca.cert, &key.PublicKey, ca.key)
+	require.NoError(t, err)
+	keyDER, err := x509.MarshalECPrivateKey(key)
+	require.NoError(t, err)
+	return pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: der}),
+		pem.EncodeToMemory(&pem.Block{Type: "EC PRIVATE KEY", Bytes: keyDER})
+}
+
+func writeFile(t *testing.T, dir, name string, content []byte) string {
+	path := filepath.Join(dir, name)
+	require.NoError(t, os.WriteFile(path, content, 0600))
+	return path
+}
+
+func TestMutualTLS(t *testing.T) {
+	dir := t.TempDir()
+	ca := newTestCA(t)
+	serverCert, serverKey := ca.issue(t, 2, "localhost", x509.ExtKeyUsageServerAuth)
+	clientCert, clientKey := ca.issue(t, 3, "provisioning.example.com", x509.ExtKeyUsageClientAuth)
+
+	cfg, err := NewTLSConfig(
+		writeFile(t, dir, "server.pem", serverCert),
+		writeFile(t, dir, "server.key", serverKey),
+		[]string{writeFile(t, dir, "ca.pem", ca.pem)},
+		ClientAuthVerifyIfGiven,
+	)
+	require.NoError(t, err)
+
+	srv := httptest.NewUnstartedServer(http.HandlerFunc(servermiddleware.TLSIdentities(func(w http.ResponseWriter, r *http.Request) {
+		hostname, isVerified := controller.ExtractHostnameFromCtx(r.Context())
+		fmt.Fprintf(w, "%s:%v", hostname, isVerified)
+	})))
+	srv.TLS = cfg
+	srv.StartTLS()
+	defer srv.Close()
+
+	rootCAs := x509.NewCertPool()
+	rootCAs.AppendCertsFromPEM(ca.pem)
+	get := func(t *testing.T, certs ...tls.Certificate) (string, error) {
+		client := &http.Client{Transport: &http.Transport{TLSClientConfig: &tls.Config{
+			RootCAs:      rootCAs,
+			Certificates: certs,
+		}}}
+		resp, err := client.Get(srv.URL)
+		if err != nil {
+			return "", err
+		}
+		defer resp.Body.Close()
+		b, err := io.ReadAll(resp.Body)
+		return string(b), err
+	}
+
+	t.Run("client_certificate", func(t *testing.T) {
+		cert, err := tls.X509KeyPair(clientCert, clientKey)
+		require.NoError(t, err)
+		body, err := get(t, cert)
+		require.NoError(t, err)
+		require.Equal(t, "provisioning.example.com:true", body)
+	})
+
+	t.Run("anonymous", func(t *testing.T) {
+		body, err := get(t)
+		require.NoError(t, err)
+		require.Equal(t, ":false", body)
+	})
+
+	t.Run("untrusted_client_certificate", func(t *testing.T) {
+		otherCA := newTestCA(t)
+		certPEM, keyPEM := otherCA.issue(t, 4, "provisioning.example.com", x509.ExtKeyUsageClientAuth)
+		cert, err := tls.X509KeyPair(certPEM, keyPEM)
+		require.NoError(t, err)
+		_, err = get(t, cert)
+		require.Error(t, err)
+	})
+}
+
+func TestAuthorize(t *testing.T) {
+	oldIsPermitted := identity.IsPermitted
+	defer func() { identity.IsPermitted = oldIsPermitted }()
+	identity.IsPermitted = (&identity.AuthzPolicy{Rules: []identity.AuthzRule{{
+		Permissions: []string{"SearchFirmware:FetchContent"},
+		Hostnames:   []string{"provisioning.example.com"},
+	}}}).IsPermitted
+
+	ctx := context.Background()
+	require.NoError(t, authorize(ctx, "SearchFirmware"))
+
+	err := authorize(ctx, "SearchFirmware", "SearchFirmware:FetchContent")
+	require.IsType(t, &afas.PermissionDenied{}, err)
+
+	ctx = identity.CtxWithIdentities(ctx, []identity.Identity{identity.TLSIdentity{Chain: []x509.Certificate{{
+		DNSNames: []string{"provisioning.example.com"},
+	}}}})
+	require.NoError(t, authorize(ctx, "SearchFirmware", "SearchFirmware:FetchContent"))
+}
@@ -0,0 +1,323 @@
+// Copyright 2023 Meta Platforms, Inc. and affiliates.
+//
+// Redistribution and use in source and binary forms, with or without modification, are permitted provided that the following conditions are met:
+//
+// 1. Redistributions of source code must retain the above copyright notice, this list of conditions and the following disclaimer.
+//
+// 2. Redistributions in binary form must reproduce the above copyright notice, this list of conditions and the following disclaimer in the documentation and/or other materials provided with the distribution.
+//
+// 3. Neither the name of the copyright holder nor the names of its contributors may be used to endorse or promote products derived from this software without specific prior written permission.
+//
+// THIS SOFTWARE IS PROVIDED BY THE COPYRIGHT HOLDERS AND CONTRIBUTORS "AS IS" AND ANY EXPRESS OR IMPLIED WARRANTIES, INCLUDING, BUT NOT LIMITED TO, THE IMPLIED WARRANTIES OF MERCHANTABILITY AND FITNESS FOR A PARTICULAR PURPOSE ARE DISCLAIMED. IN NO EVENT SHALL THE COPYRIGHT HOLDER OR CONTRIBUTORS BE LIABLE FOR ANY DIRECT, INDIRECT, INCIDENTAL, SPECIAL, EXEMPLARY, OR CONSEQUENTIAL DAMAGES (INCLUDING, BUT NOT LIMITED TO, PROCUREMENT OF SUBSTITUTE GOODS OR SERVICES; LOSS OF USE, DATA, OR PROFITS; OR BUSINESS INTERRUPTION) HOWEVER CAUSED AND ON ANY THEORY OF LIABILITY, WHETHER IN CONTRACT, STRICT LIABILITY, OR TORT (INCLUDING NEGLIGENCE OR OTHERWISE) ARISING IN ANY WAY OUT OF THE USE OF THIS SOFTWARE, EVEN IF ADVISED OF THE POSSIBILITY OF SUCH DAMAGE.
+package storage
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/facebookincubator/go-belt/tool/logger"
+
+	"github.com/immune-gmbh/AttestationFailureAnalysisService/pkg/analysis"
+)
+
+// AggregateDimension is a dimension to group AnalyzeReport-s by.
+type AggregateDimension int
+
+const (
+	// AggregateDimensionUndefined is an invalid dimension.
+	AggregateDimensionUndefined = AggregateDimension(iota)
+
+	// AggregateDimensionAnalyzer groups by the analyzer ID.
+	AggregateDimensionAnalyzer
+
+	// AggregateDimensionDiagnosis groups by the diagnosis code of an analyzer report.
+	AggregateDimensionDiagnosis
+
+	// AggregateDimensionIssueSeverity groups by the severity of reported issues.
+	AggregateDimensionIssueSeverity
+
+	// AggregateDimensionIssueDescription groups by the description of reported issues.
+	AggregateDimensionIssueDescription
+
+	// AggregateDimensionActualFirmwareVersion groups by the version of the
+	// firmware which was actually found on the host.
+	AggregateDimensionActualFirmwareVersion
+
+	// AggregateDimensionOriginalFirmwareVersion groups by the version of the
+	// firmware which was expected to be on the host.
+	AggregateDimensionOriginalFirmwareVersion
+
+	// AggregateDimensionModelID groups by the model ID of the host.
+	AggregateDimensionModelID
+
+	endOfAggregateDimension
+)
+
+// String implements fmt.Stringer.
+func (d AggregateDimension) String() string {
+	switch d {
+	case AggregateDimensionUndefined:
+		return "undefined"
+	case AggregateDimensionAnalyzer:
+		return "analyzer"
+	case AggregateDimensionDiagnosis:
+		return "diagnosis"
+	case AggregateDimensionIssueSeverity:
+		return "issue_severity"
+	case AggregateDimensionIssueDescription:
+		return "issue_description"
+	case AggregateDimensionActualFirmwareVersion:
+		return "actual_firmware_version"
+	case AggregateDimensionOriginalFirmwareVersion:
+		return "original_firmware_version"
+	case AggregateDimensionModelID:
+		return "model_id"
+	}
+	return fmt.Sprintf("unknown_%d", int(d))
+}
+
+func (d AggregateDimension) column() string {
+	switch d {
+	case AggregateDimensionAnalyzer:
+		return "`analyzer_report`.`analyzer_id`"
+	case AggregateDimensionDiagnosis:
+		return "`analyzer_report`.`diagnosis_code`"
+	case AggregateDimensionIssueSeverity:
+		return "`report_issue`.`severity`"
+	case AggregateDimensionIssueDescription:
+		return "`report_issue`.`description`"
+	case AggregateDimensionActualFirmwareVersion:
+		return "`actual_firmware`.`firmware_version`"
+	case AggregateDimensionOriginalFirmwareVersion:
+		return "`original_firmware`.`firmware_version`"
+	case AggregateDimensionModelID:
+		return "`analyze_report`.`model_id`"
+	}
+	return ""
+}
+
+// formatValue converts the raw value of the dimension to its presentation,
+// for example the numeric severity to its name.
+func (d AggregateDimension) formatValue(value sql.NullString) (sql.NullString, error) {
+	if !value.Valid || d != AggregateDimensionIssueSeverity {
+		return value, nil
+	}
+	severity, err := strconv.ParseUint(value.String, 10, 32)
+	if err != nil {
+		return value, fmt.Errorf("unable to parse severity '%s': %w", value.String, err)
+	}
+	return sql.NullString{String: analysis.Severity(severity).String(), Valid: true}, nil
+}
+
+func (d AggregateDimension) needsAnalyzerReport() bool {
+	return d != AggregateDimensionModelID
+}
+
+func (d AggregateDimension) needsReportIssue() bool {
+	return d == AggregateDimensionIssueSeverity || d == AggregateDimensionIssueDescription
+}
+
+// AggregateReportsQuery defines how to aggregate AnalyzeReport-s.
+//
+// Filters with a zero-value are not applied.
+type AggregateReportsQuery struct {
+	// GroupBy is the list of dimensions to group by.
+	GroupBy []AggregateDimension
+
+	// From selects reports with `timestamp` not before the given time.
+	From time.Time
+
+	// To selects reports with `timestamp` before the given time.
+	To time.Time
+
+	// Bucket additionally groups reports by time intervals of the given
+	// duration. It should be a multiple of a second.
+	Bucket time.Duration
+
+	// AnalyzerIDs selects only reports of the given analyzers.
+	AnalyzerIDs []string
+
+	// DiagnosisCodes selects only reports with the given diagnosis codes.
+	DiagnosisCodes []string
+
+	// ModelIDs selects only reports of hosts with the given model IDs.
+	ModelIDs []int64
+}
+
+// AggregateReportsGroup is a single group of aggregated AnalyzeReport-s.
+type AggregateReportsGroup struct {
+	// Values contains a value for each dimension of AggregateReportsQuery.GroupBy
+	// (in the same order). Severities are represented by their names
+	// (see analysis.Severity.String).
+	Values []sql.NullString
+
+	// BucketStart is the beginning of the time interval of the group.
+	// It is zero if AggregateReportsQuery.Bucket is not set.
+	BucketStart time.Time
+
+	// Reports is the amount of distinct AnalyzeReport-s in the group.
+	Reports uint64
+
+	// Hosts is the amount of distinct asset IDs in the group.
+	Hosts uint64
+}
+
+func compileAggregateReportsQuery(q AggregateReportsQuery) (string, []any, error) {
+	var (
+		needsAnalyzerReport bool
+		needsReportIssue    bool
+		needsActualFW       bool
+		needsOriginalFW     bool
+		selectExprs         []string
+		groupBy             []string
+	)
+
+	if q.Bucket != 0 {
+		if q.Bucket < time.Second || q.Bucket%time.Second != 0 {
+			return "", nil, fmt.Errorf("bucket duration should be a positive multiple of a second, but is %v", q.Bucket)
+		}
+		bucketSecs := int64(q.Bucket / time.Second)
+		selectExprs = append(selectExprs, fmt.Sprintf("FLOOR(UNIX_TIMESTAMP(`analyze_report`.`timestamp`) / %d) * %d AS `bucket`", bucketSecs, bucketSecs))
+		groupBy = append(groupBy, "`bucket`")
+	}
+
+	seen := map[AggregateDimension]struct{}{}
+	for idx, dim := range q.GroupBy {
+		if dim <= AggregateDimensionUndefined || dim >= endOfAggregateDimension {
+			return "", nil, fmt.Errorf("invalid dimension: %v", dim)
+		}
+		if _, ok := seen[dim]; ok {
+			return "", nil, fmt.Errorf("duplicate dimension: %v", dim)
+		}
+		seen[dim] = struct{}{}
+
+		needsAnalyzerReport = needsAnalyzerReport || dim.needsAnalyzerReport()
+		needsReportIssue = needsReportIssue || dim.needsReportIssue()
+		needsActualFW = needsActualFW || dim == AggregateDimensionActualFirmwareVersion
+		needsOriginalFW = needsOriginalFW || dim == AggregateDimensionOriginalFirmwareVersion
+
+		alias := fmt.Sprintf("`dim%d`", idx)
+		selectExprs = append(selectExprs, fmt.Sprintf("CAST(%s AS CHAR) AS %s", dim.column(), alias))
+		groupBy = append(groupBy, alias)
+	}
+	selectExprs = append(selectExprs,
+		"COUNT(DISTINCT `analyze_report`.`id`) AS `reports`",
+		"COUNT(DISTINCT `analyze_report`.`asset_id`) AS `hosts`",
+	)
+
+	var whereConds []string
+	var whereArgs []any
+	if !q.From.IsZero() {
+		whereConds = append(whereConds, "`analyze_report`.`timestamp` >= ?")
+		whereArgs = append(whereArgs, q.From)
+	}
+	if !q.To.IsZero() {
+		whereConds = append(whereConds, "`analyze_report`.`timestamp` < ?")
+		whereArgs = append(whereArgs, q.To)
+	}
+	if len(q.AnalyzerIDs) > 0 {
+		needsAnalyzerReport = true
+		whereConds = append(whereConds, fmt.Sprintf("`analyzer_report`.`analyzer_id` IN (%s)", constructPlaceholders(len(q.AnalyzerIDs))))
+		for _, analyzerID := range q.AnalyzerIDs {
+			whereArgs = append(whereArgs, analyzerID)
+		}
+	}
+	if len(q.DiagnosisCodes) > 0 {
+		needsAnalyzerReport = true
+		whereConds = append(whereConds, fmt.Sprintf("`analyzer_report`.`diagnosis_code` IN (%s)", constructPlaceholders(len(q.DiagnosisCodes))))
+		for _, diagnosisCode := range q.DiagnosisCodes {
+			whereArgs = append(whereArgs, diagnosisCode)
+		}
+	}
+	if len(q.ModelIDs) > 0 {
+		whereConds = append(whereConds, fmt.Sprintf("`analyze_report`.`model_id` IN (%s)", constructPlaceholders(len(q.ModelIDs))))
+		for _, modelID := range q.ModelIDs {
+			whereArgs = append(whereArgs, modelID)
+		}
+	}
+
+	query := "SELECT " + strings.Join(selectExprs, ", ") + " FROM `analyze_report`"
+	if needsAnalyzerReport {
+		query += " JOIN `analyzer_report` ON `analyzer_report`.`analyze_report_id` = `analyze_report`.`id` AND `analyzer_report`.`superseded_by` IS NULL"
+	}
+	if needsReportIssue {
+		query += " JOIN `report_issue` ON `report_issue`.`analyzer_report_id` = `analyzer_report`.`id`"
+	}
+	if needsActualFW {
+		query += " LEFT JOIN `firmware_image_metadata` AS `actual_firmware` ON `actual_firmware`.`image_id` = `analyzer_report`.`input_actual_firmware_image_id`"
+	}
+	if needsOriginalFW {
+		query += " LEFT JOIN `firmware_image_metadata` AS `original_firmware` ON `original_firmware`.`image_id` = `analyzer_report`.`input_original_firmware_image_id`"
+	}
+	if len(whereConds) > 0 {
+		query += " WHERE (" + strings.Join(whereConds, ") AND (") + ")"
+	}
+	if len(groupBy) > 0 {
+		query += " GROUP BY " + strings.Join(groupBy, ", ")
+		query += " ORDER BY " + strings.Join(groupBy, ", ")
+	}
+	return query, whereArgs, nil
+}
+
+// AggregateReports returns the amount of AnalyzeReport-s (and distinct hosts)
+// grouped by the requested dimensions.
+//
+// TODO: Remove these functions from `Storage`. The initial purpose of storage is combine together
+//
+//	management of metadata in MySQL and data in BlobStorage for firmware images. All the rest
+//	entities should not be accessed through Storage. Otherwise locking, transactions and other
+//	usual stuff is pretty cludgy.
+func (stor *Storage) AggregateReports(
+	ctx context.Context,
+	q AggregateReportsQuery,
+) ([]AggregateReportsGroup, error) {
+	query, args, err := compileAggregateReportsQuery(q)
+	if err != nil {
+		return nil, ErrInvalidAggregateQuery{Err: err}
+	}
+	logger.FromCtx(ctx).Debugf("query: <%s>; args: %v", query, args)
+
+	rows, err := stor.DB.QueryContext(ctx, query, args...)
+	if err != nil {
+		return nil, ErrSelect{Err: fmt.Errorf("unable to perform query '%s' with args %v: %w", query, args, err)}
+	}
+	defer rows.Close()
+
+	var result []AggregateReportsGroup
+	for rows.Next() {
+		var (
+			group  AggregateReportsGroup
+			bucket sql.NullInt64
+			dest   []any
+		)
+		if q.Bucket != 0 {
+			dest = append(dest, &bucket)
+		}
+		group.Values = make([]sql.NullString, len(q.GroupBy))
+		for idx := range group.Values {
+			dest = append(dest, &group.Values[idx])
+		}
+		dest = append(dest, &group.Reports, &group.Hosts)
+		if err := rows.Scan(dest...); err != nil {
+			return nil, ErrSelect{Err: fmt.Errorf("unable to scan a row of query '%s': %w", query, err)}
+		}
+		for idx, dim := range q.GroupBy {
+			group.Values[idx], err = dim.formatValue(group.Values[idx])
+			if err != nil {
+				return nil, ErrSelect{Err: fmt.Errorf("unable to format the value of dimension %s: %w", dim, err)}
+			}
+		}
+		if bucket.Valid {
+			group.BucketStart = time.Unix(bucket.Int64, 0)
+		}
+		result = append(result, group)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, ErrSelect{Err: fmt.Errorf("unable to iterate rows of query '%s': %w", query, err)}
+	}
+	return result, nil
+}
@@ -0,0 +1,117 @@
+// Copyright 2023 Meta Platforms, Inc. and affiliates.
+//
+// Redistribution and use in source and binary forms, with or without modification, are permitted provided that the following conditions are met:
+//
+// 1. Redistributions of source code must retain the above copyright notice, this list of conditions and the following disclaimer.
+//
+// 2. Redistributions in binary form must reproduce the above copyright notice, this list of conditions and the following disclaimer in the documentation and/or other materials provided with the distribution.
+//
+// 3. Neither the name of the copyright holder nor the names of its contributors may be used to endorse or promote products derived from this software without specific prior written permission.
+//
+// THIS SOFTWARE IS PROVIDED BY THE COPYRIGHT HOLDERS AND CONTRIBUTORS "AS IS" AND ANY EXPRESS OR IMPLIED WARRANTIES, INCLUDING, BUT NOT LIMITED TO, THE IMPLIED WARRANTIES OF MERCHANTABILITY AND FITNESS FOR A PARTICULAR PURPOSE ARE DISCLAIMED. IN NO EVENT SHALL THE COPYRIGHT HOLDER OR CONTRIBUTORS BE LIABLE FOR ANY DIRECT, INDIRECT, INCIDENTAL, SPECIAL, EXEMPLARY, OR CONSEQUENTIAL DAMAGES (INCLUDING, BUT NOT LIMITED TO, PROCUREMENT OF SUBSTITUTE GOODS OR SERVICES; LOSS OF USE, DATA, OR PROFITS; OR BUSINESS INTERRUPTION) HOWEVER CAUSED AND ON ANY THEORY OF LIABILITY, WHETHER IN CONTRACT, STRICT LIABILITY, OR TORT (INCLUDING NEGLIGENCE OR OTHERWISE) ARISING IN ANY WAY OUT OF THE USE OF THIS SOFTWARE, EVEN IF ADVISED OF THE POSSIBILITY OF SUCH DAMAGE.
+package storage
+
+import (
+	"context"
+	"database/sql"
+	"database/sql/driver"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestCompileAggregateReportsQuery(t *testing.T) {
+	from := time.Date(2023, 1, 2, 3, 4, 5, 0, time.UTC)
+	{
+		query, args, err := compileAggregateReportsQuery(AggregateReportsQuery{
+			GroupBy: []AggregateDimension{AggregateDimensionModelID},
+			From:    from,
+		})
+		require.NoError(t, err)
+		require.Equal(t, "SELECT CAST(`analyze_report`.`model_id` AS CHAR) AS `dim0`, "+
+			"COUNT(DISTINCT `analyze_report`.`id`) AS `reports`, COUNT(DISTINCT `analyze_report`.`asset_id`) AS `hosts` "+
+			"FROM `analyze_report` WHERE (`analyze_report`.`timestamp` >= ?) GROUP BY `dim0` ORDER BY `dim0`", query)
+		require.Equal(t, []any{from}, args)
+	}
+	{
+		query, args, err := compileAggregateReportsQuery(AggregateReportsQuery{
+			GroupBy:     []AggregateDimension{AggregateDimensionIssueSeverity, AggregateDimensionActualFirmwareVersion},
+			Bucket:      time.Hour,
+			AnalyzerIDs: []string{"a", "b"},
+			ModelIDs:    []int64{1},
+		})
+		require.NoError(t, err)
+		require.True(t, strings.HasPrefix(query, "SELECT FLOOR(UNIX_TIMESTAMP(`analyze_report`.`timestamp`) / 3600) * 3600 AS `bucket`, "), query)
+		require.Contains(t, query, " JOIN `analyzer_report` ON ")
+		require.Contains(t, query, " JOIN `report_issue` ON ")
+		require.Contains(t, query, " LEFT JOIN `firmware_image_metadata` AS `actual_firmware` ON ")
+		require.NotContains(t, query, "`original_firmware`")
+		require.True(t, strings.HasSuffix(query, " WHERE (`analyzer_report`.`analyzer_id` IN (?, ?)) AND (`analyze_report`.`model_id` IN (?)) "+
+			"GROUP BY `bucket`, `dim0`, `dim1` ORDER BY `bucket`, `dim0`, `dim1`"), query)
+		require.Equal(t, []any{"a", "b", int64(1)}, args)
+	}
+	{
+		query, args, err := compileAggregateReportsQuery(AggregateReportsQuery{})
+		require.NoError(t, err)
+		require.True(t, strings.HasSuffix(query, " FROM `analyze_report`"), query)
+		require.Empty(t, args)
+	}
+	{
+		_, _, err := compileAggregateReportsQuery(AggregateReportsQuery{
+			GroupBy: []AggregateDimension{AggregateDimensionAnalyzer, AggregateDimensionAnalyzer},
+		})
+		require.Error(t, err)
+	}
+	{
+		_, _, err := compileAggregateReportsQuery(AggregateReportsQuery{
+			GroupBy: []AggregateDimension{endOfAggregateDimension},
+		})
+		require.Error(t, err)
+	}
+	{
+		_, _, err := compileAggregateReportsQuery(AggregateReportsQuery{
+			Bucket: 1500 * time.Millisecond,
+		})
+		require.Error(t, err)
+	}
+}
+
+func TestAggregateReports(t *testing.T) {
+	db := &fakeDB{
+		Columns: []string{"bucket", "dim0", "dim1", "reports", "hosts"},
+		Rows: [][]driver.Value{
+			{int64(3600), []byte("2"), []byte("DiffMeasuredBoot"), int64(10), int64(3)},
+			{int64(7200), []byte("0"), nil, int64(1), int64(1)},
+		},
+	}
+	stor := newFakeStorage(db)
+
+	groups, err := stor.AggregateReports(context.Background(), AggregateReportsQuery{
+		GroupBy: []AggregateDimension{AggregateDimensionIssueSeverity, AggregateDimensionAnalyzer},
+		Bucket:  time.Hour,
+	})
+	require.NoError(t, err)
+	require.Equal(t, []AggregateReportsGroup{
+		{
+			Values:      []sql.NullString{{String: "critical", Valid: true}, {String: "DiffMeasuredBoot", Valid: true}},
+			BucketStart: time.Unix(3600, 0),
+			Reports:     10,
+			Hosts:       3,
+		},
+		{
+			Values:      []sql.NullString{{String: "info", Valid: true}, {}},
+			BucketStart: time.Unix(7200, 0),
+			Reports:     1,
+			Hosts:       1,
+		},
+	}, groups)
+	require.Len(t, db.Statements(), 1)
+
+	db.Rows = [][]driver.Value{{[]byte("not-a-number"), int64(1), int64(1)}}
+	_, err = stor.AggregateReports(context.Background(), AggregateReportsQuery{
+		GroupBy: []AggregateDimension{AggregateDimensionIssueSeverity},
+	})
+	require.Error(t, err)
+}
@@ -17,13 +17,16 @@ import (
 	"fmt"
 	"strconv"
 	"strings"
+	"time"
 
 	"github.com/facebookincubator/go-belt/tool/logger"
 	"github.com/jmoiron/sqlx"
 
+	"github.com/immune-gmbh/AttestationFailureAnalysisService/pkg/analysis"
 	"github.com/immune-gmbh/AttestationFailureAnalysisService/pkg/storage/helpers"
 	"github.com/immune-gmbh/AttestationFailureAnalysisService/pkg/storage/models"
 	"github.com/immune-gmbh/AttestationFailureAnalysisService/pkg/types"
+	"github.com/immune-gmbh/AttestationFailureAnalysisService/pkg/xjson"
 )
 
 // InsertAnalyzeReport adds information about performed analysis.
@@ -34,6 +37,8 @@ import (
 // * `ID` is set.
 // * `report.Reports` are also saved and: `ID` and `AnalyzerReportID` are also set.
 //
+// Issues of `report.Reports` are not saved, see InsertReportIssues.
+//
 // TODO: Remove these functions from `Storage`. The initial purpose of storage is combine together
 //
 //	management of metadata in MySQL and data in BlobStorage for firmware images. All the rest
@@ -127,6 +132,102 @@ func (stor *Storage) insertAnalyzerReport(tx *sql.Tx, report *models.AnalyzerRep
 	return nil
 }
 
+// InsertReportIssues saves the issues of the stored AnalyzerReport-s `reports`
+// (to table `report_issue`) in a single transaction.
+//
+// It is separated from InsertAnalyzeReport to keep the issues out of
+// the transaction of the analysis result. If the issues of a report are
+// lost (for example, due to a crash), then they are restored by BackfillAnalyzerReports.
+//
+// TODO: Remove these functions from `Storage`. The initial purpose of storage is combine together
+//
+//	management of metadata in MySQL and data in BlobStorage for firmware images. All the rest
+//	entities should not be accessed through Storage. Otherwise locking, transactions and other
+//	usual stuff is pretty cludgy.
+func (stor *Storage) InsertReportIssues(ctx context.Context, reports []models.AnalyzerReport) (retErr error) {
+	var issues []*models.ReportIssue
+	for _, report := range reports {
+		if report.ID == 0 {
+			return fmt.Errorf("analyzer report of analyzer %s is not stored", report.AnalyzerID)
+		}
+		if report.Report == nil {
+			continue
+		}
+		for idx, issue := range report.Report.Issues {
+			reportIssue, err := newReportIssue(report.ID, issue)
+			if err != nil {
+				return fmt.Errorf("unable to convert issue #%d of analyzer report %d: %w", idx, report.ID, err)
+			}
+			issues = append(issues, reportIssue)
+		}
+	}
+	if len(issues) == 0 {
+		return nil
+	}
+
+	tx, err := stor.startTransaction(ctx)
+	if err != nil {
+		return fmt.Errorf("unable to start transaction: %w", err)
+	}
+	defer func() {
+		if retErr == nil {
+			if err := tx.Commit(); err != nil {
+				retErr = fmt.Errorf("unable to commit the transaction: %w", err)
+			}
+			return
+		}
+
+		if rollbackErr := tx.Rollback(); rollbackErr != nil {
+			panic(fmt.Errorf("unable to rollback the transaction and do not know to react on that: %w", rollbackErr))
+		}
+	}()
+
+	for _, issue := range issues {
+		if err := stor.insertReportIssue(tx.Tx, issue); err != nil {
+			return fmt.Errorf("unable to insert an issue of analyzer report %d: %w", issue.AnalyzerReportID, err)
+		}
+	}
+	return nil
+}
+
+func newReportIssue(analyzerReportID uint64, issue analysis.Issue) (*models.ReportIssue, error) {
+	reportIssue := &models.ReportIssue{
+		AnalyzerReportID: analyzerReportID,
+		Severity:         issue.Severity,
+		Description:      sql.NullString{String: issue.Description, Valid: issue.Description != ""},
+	}
+	if issue.Custom != nil {
+		b, err := xjson.MarshalWithTypeIDs(issue.Custom, analysis.TypeRegistry())
+		if err != nil {
+			return nil, fmt.Errorf("unable to serialize the custom field: %w", err)
+		}
+		reportIssue.Custom = sql.NullString{String: string(b), Valid: true}
+	}
+	return reportIssue, nil
+}
+
+func (stor *Storage) insertReportIssue(tx *sql.Tx, issue *models.ReportIssue) error {
+	values, columns, err := helpers.GetValuesAndColumns(issue, func(fieldName string, value any) bool {
+		return fieldName == "ID"
+	})
+	if err != nil {
+		return fmt.Errorf("unable to get query parameters: %w", err)
+	}
+
+	query := fmt.Sprintf("INSERT INTO `report_issue` (%s) VALUES (%s)", constructColumns("", columns), constructPlaceholders(len(columns)))
+	sqlResult, err := tx.Exec(query, values...)
+	if err != nil {
+		return fmt.Errorf("unable to perform query '%s' with arguments %#+v: %w", query, values, err)
+	}
+	lastID, err := sqlResult.LastInsertId()
+	if err != nil {
+		return fmt.Errorf("unable to get last inserted ID: %w", err)
+	}
+
+	issue.ID = uint64(lastID)
+	return nil
+}
+
 // AnalyzeReportFindFilter is a set of values to look for (concatenated through "AND"-s).
 //
 // If a field has a nil-value then it is not included to filter conditions.
@@ -136,15 +237,28 @@ type AnalyzeReportFindFilter struct {
 	AssetID     *int32
 	ProcessedAt *sql.NullTime
 
+	// IDBefore selects reports with ID less than the given one. Since the reports
+	// are returned the newest first, it could be used to fetch the reports by pages.
+	IDBefore *uint64
+
+	// ProcessedAfter selects reports processed not before the given time.
+	ProcessedAfter *time.Time
+
+	// ProcessedBefore selects reports processed before the given time.
+	ProcessedBefore *time.Time
+
 	// Firmware image referenced in the report.
 	ActualFirmware FindFirmwareFilter
 }
 
 type analyzeReportFindFilter struct {
-	ID          *uint64
-	JobID       *types.JobID
-	AssetID     *int32
-	ProcessedAt *sql.NullTime
+	ID              *uint64
+	JobID           *types.JobID
+	AssetID         *int32
+	ProcessedAt     *sql.NullTime
+	IDBefore        *uint64
+	ProcessedAfter  *time.Time
+	ProcessedBefore *time.Time
 
 	ActualFirmwareImageIDs []types.ImageID
 }
@@ -169,10 +283,13 @@ func (stor *Storage) FindAnalyzeReports(
 	error,
 ) {
 	filter := &analyzeReportFindFilter{
-		ID:          filterInput.ID,
-		JobID:       filterInput.JobID,
-		AssetID:     filterInput.AssetID,
-		ProcessedAt: filterInput.ProcessedAt,
+		ID:              filterInput.ID,
+		JobID:           filterInput.JobID,
+		AssetID:         filterInput.AssetID,
+		ProcessedAt:     filterInput.ProcessedAt,
+		IDBefore:        filterInput.IDBefore,
+		ProcessedAfter:  filterInput.ProcessedAfter,
+		ProcessedBefore: filterInput.ProcessedBefore,
 	}
 
 	if filterInput.ActualFirmware.ImageID != nil {
@@ -258,7 +375,7 @@ func (stor *Storage) findAnalyzeReports(
 		for _, imageID := range filter.ActualFirmwareImageIDs {
 			imageIDs = append(imageIDs, fmt.Sprintf("0x%X", imageID[:]))
 		}
-		joinStatements = append(joinStatements, "JOIN `analyzer_report` ON `analyze_report`.`id` = `analyzer_report`.`analyze_report_id`")
+		joinStatements = append(joinStatements, "JOIN `analyzer_report` ON `analyze_report`.`id` = `analyzer_report`.`analyze_report_id` AND `analyzer_report`.`superseded_by` IS NULL")
 		whereConds = append(whereConds, fmt.Sprintf("`input_actual_firmware_image_id` IN (%s)", strings.Join(imageIDs, ", ")))
 	}
 
@@ -282,6 +399,18 @@ func (stor *Storage) findAnalyzeReports(
 			whereArgs = append(whereArgs, "0000-00-00 00:00:00")
 		}
 	}
+	if filter.IDBefore != nil {
+		whereConds = append(whereConds, "`analyze_report`.`id` < ?")
+		whereArgs = append(whereArgs, *filter.IDBefore)
+	}
+	if filter.ProcessedAfter != nil {
+		whereConds = append(whereConds, "`analyze_report`.`processed_at` >= ?")
+		whereArgs = append(whereArgs, *filter.ProcessedAfter)
+	}
+	if filter.ProcessedBefore != nil {
+		whereConds = append(whereConds, "`analyze_report`.`processed_at` < ?")
+		whereArgs = append(whereArgs, *filter.ProcessedBefore)
+	}
 	_, columns, err := helpers.GetValuesAndColumns(&models.AnalyzeReport{}, nil)
 	if err != nil {
 		return nil, fmt.Errorf("unable to gather column names: %w", err)
@@ -340,7 +469,7 @@ func (stor *Storage) findAnalyzerReportsByAnalyzeReportID(
 	}
 
 	query := fmt.Sprintf(
-		"SELECT %s FROM `analyzer_report` WHERE `analyze_report_id` = ?",
+		"SELECT %s FROM `analyzer_report` WHERE `analyze_report_id` = ? AND `superseded_by` IS NULL",
 		constructColumns(`analyzer_report`, columns),
 	)
 	if tx != nil {
@@ -16,7 +16,9 @@ import (
 	"database/sql"
 	"errors"
 	"fmt"
+	"time"
 
+	"github.com/facebookincubator/go-belt/tool/logger"
 	"github.com/jmoiron/sqlx"
 
 	"github.com/immune-gmbh/AttestationFailureAnalysisService/pkg/storage/helpers"
@@ -121,3 +123,119 @@ func (stor *Storage) GetOrCreateAnalyzeReportGroup(
 
 	return group, nil
 }
+
+// AttachAnalyzeReportToGroup sets the group of AnalyzeReport with ID `analyzeReportID`,
+// creating the group if it does not exist.
+//
+// If the group is created, then `alert` (if not empty) is stored together with
+// the group in the same transaction, to be delivered after the transaction is
+// committed (see FindPendingAnalyzeReportGroupAlerts).
+//
+// Returns true if the group was created.
+//
+// TODO: Remove these functions from `Storage`. The initial purpose of storage is combine together
+//
+//	management of metadata in MySQL and data in BlobStorage for firmware images. All the rest
+//	entities should not be accessed through Storage. Otherwise locking, transactions and other
+//	usual stuff is pretty cludgy.
+func (stor *Storage) AttachAnalyzeReportToGroup(
+	ctx context.Context,
+	analyzeReportID uint64,
+	key models.AnalyzeReportGroupKey,
+	alert []byte,
+) (isNewGroup bool, retErr error) {
+	if key.IsZero() {
+		return false, fmt.Errorf("the provided key is the zero value")
+	}
+
+	tx, err := stor.startTransaction(ctx)
+	if err != nil {
+		return false, fmt.Errorf("unable to start transaction: %w", err)
+	}
+	defer func() {
+		if retErr == nil {
+			if err := tx.Commit(); err != nil {
+				isNewGroup = false
+				retErr = fmt.Errorf("unable to commit the transaction: %w", err)
+			}
+			return
+		}
+		if err := tx.Rollback(); err != nil {
+			retErr = fmt.Errorf("unable to rollback the transaction: %w (the original error: %v)", err, retErr)
+		}
+	}()
+
+	// "INSERT IGNORE" instead of GetOrCreateAnalyzeReportGroup to be sure only one
+	// of concurrent transactions considers the group new.
+	alertValue := sql.NullString{String: string(alert), Valid: len(alert) > 0}
+	query := "INSERT IGNORE INTO `analyze_report_group` SET `group_key` = ?, `alert` = ?"
+	sqlResult, err := tx.Exec(query, key, alertValue)
+	if err != nil {
+		return false, fmt.Errorf("unable to create an analyzer reports group with key %s using query '%s': %w", key, query, err)
+	}
+	rowsAffected, err := sqlResult.RowsAffected()
+	if err != nil {
+		return false, fmt.Errorf("unable to get the amount of affected rows: %w", err)
+	}
+	isNewGroup = rowsAffected > 0
+
+	query = "UPDATE `analyze_report` SET `group_key` = ? WHERE `id` = ?"
+	if _, err := tx.Exec(query, key, analyzeReportID); err != nil {
+		return false, fmt.Errorf("unable to set the group of analyze report %d using query '%s': %w", analyzeReportID, query, err)
+	}
+
+	return isNewGroup, nil
+}
+
+// FindPendingAnalyzeReportGroupAlerts returns AnalyzeReportGroup-s with alerts,
+// which are not delivered yet.
+//
+// TODO: Remove these functions from `Storage`. The initial purpose of storage is combine together
+//
+//	management of metadata in MySQL and data in BlobStorage for firmware images. All the rest
+//	entities should not be accessed through Storage. Otherwise locking, transactions and other
+//	usual stuff is pretty cludgy.
+func (stor *Storage) FindPendingAnalyzeReportGroupAlerts(
+	ctx context.Context,
+	limit uint, // 0 -- no limit
+) ([]models.AnalyzeReportGroup, error) {
+	_, columns, err := helpers.GetValuesAndColumns(&models.AnalyzeReportGroup{}, nil)
+	if err != nil {
+		return nil, fmt.Errorf("unable to gather column names: %w", err)
+	}
+
+	query := fmt.Sprintf(
+		"SELECT %s FROM `analyze_report_group` WHERE `alert_delivered_at` IS NULL AND `alert` IS NOT NULL",
+		constructColumns(`analyze_report_group`, columns),
+	)
+	if limit != 0 {
+		query += fmt.Sprintf(" LIMIT %d", limit)
+	}
+	logger.FromCtx(ctx).Debugf("query: %s", query)
+
+	var groups []models.AnalyzeReportGroup
+	if err := sqlx.SelectContext(ctx, stor.DB, &groups, query); err != nil {
+		return nil, ErrSelect{Err: fmt.Errorf("unable to perform query '%s': %w", query, err)}
+	}
+	return groups, nil
+}
+
+// SetAnalyzeReportGroupAlertDelivered marks the alert of the AnalyzeReportGroup as delivered.
+//
+// TODO: Remove these functions from `Storage`. The initial purpose of storage is combine together
+//
+//	management of metadata in MySQL and data in BlobStorage for firmware images. All the rest
+//	entities should not be accessed through Storage. Otherwise locking, transactions and other
+//	usual stuff is pretty cludgy.
+func (stor *Storage) SetAnalyzeReportGroupAlertDelivered(
+	ctx context.Context,
+	key models.AnalyzeReportGroupKey,
+	deliveredAt time.Time,
+) error {
+	query := "UPDATE `analyze_report_group` SET `alert_delivered_at` = ? WHERE `group_key` = ?"
+	logger.FromCtx(ctx).Debugf("query: %s; args: %v, %s", query, deliveredAt, key)
+	if _, err := stor.DB.ExecContext(ctx, query, deliveredAt, key); err != nil {
+		return fmt.Errorf("unable to perform query '%s': %w", query, err)
+	}
+	return nil
+}
@@ -0,0 +1,95 @@
+// Copyright 2023 Meta Platforms, Inc. and affiliates.
+//
+// Redistribution and use in source and binary forms, with or without modification, are permitted provided that the following conditions are met:
+//
+// 1. Redistributions of source code must retain the above copyright notice, this list of conditions and the following disclaimer.
+//
+// 2. Redistributions in binary form must reproduce the above copyright notice, this list of conditions and the following disclaimer in the documentation and/or other materials provided with the distribution.
+//
+// 3. Neither the name of the copyright holder nor the names of its contributors may be used to endorse or promote products derived from this software without specific prior written permission.
+//
+// THIS SOFTWARE IS PROVIDED BY THE COPYRIGHT HOLDERS AND CONTRIBUTORS "AS IS" AND ANY EXPRESS OR IMPLIED WARRANTIES, INCLUDING, BUT NOT LIMITED TO, THE IMPLIED WARRANTIES OF MERCHANTABILITY AND FITNESS FOR A PARTICULAR PURPOSE ARE DISCLAIMED. IN NO EVENT SHALL THE COPYRIGHT HOLDER OR CONTRIBUTORS BE LIABLE FOR ANY DIRECT, INDIRECT, INCIDENTAL, SPECIAL, EXEMPLARY, OR CONSEQUENTIAL DAMAGES (INCLUDING, BUT NOT LIMITED TO, PROCUREMENT OF SUBSTITUTE GOODS OR SERVICES; LOSS OF USE, DATA, OR PROFITS; OR BUSINESS INTERRUPTION) HOWEVER CAUSED AND ON ANY THEORY OF LIABILITY, WHETHER IN CONTRACT, STRICT LIABILITY, OR TORT (INCLUDING NEGLIGENCE OR OTHERWISE) ARISING IN ANY WAY OUT OF THE USE OF THIS SOFTWARE, EVEN IF ADVISED OF THE POSSIBILITY OF SUCH DAMAGE.
+package storage
+
+import (
+	"context"
+	"database/sql/driver"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/immune-gmbh/AttestationFailureAnalysisService/pkg/analysis"
+	"github.com/immune-gmbh/AttestationFailureAnalysisService/pkg/storage/models"
+	"github.com/immune-gmbh/AttestationFailureAnalysisService/pkg/types"
+)
+
+func TestInsertReportIssues(t *testing.T) {
+	ctx := context.Background()
+	db := &fakeDB{}
+	stor := newFakeStorage(db)
+
+	report := &models.AnalyzeReport{
+		JobID:     types.NewJobID(),
+		Timestamp: time.Now(),
+		AnalyzerReports: []models.AnalyzerReport{
+			{
+				AnalyzerID: "a",
+				Report: &analysis.Report{Issues: []analysis.Issue{
+					{Severity: analysis.SeverityCritical, Description: "first"},
+					{Severity: analysis.SeverityInfo, Description: "second"},
+				}},
+			},
+			{
+				AnalyzerID: "b",
+			},
+		},
+	}
+	require.Error(t, stor.InsertReportIssues(ctx, report.AnalyzerReports))
+	require.Empty(t, db.Statements())
+
+	// the issues are not a part of the transaction of the report
+	require.NoError(t, stor.InsertAnalyzeReport(ctx, report))
+	statements := db.Statements()
+	require.Len(t, statements, 5)
+	require.Equal(t, "BEGIN", statements[0])
+	require.True(t, strings.HasPrefix(statements[1], "INSERT INTO `analyze_report` "), statements[1])
+	require.True(t, strings.HasPrefix(statements[2], "INSERT INTO `analyzer_report` "), statements[2])
+	require.True(t, strings.HasPrefix(statements[3], "INSERT INTO `analyzer_report` "), statements[3])
+	require.Equal(t, "COMMIT", statements[4])
+	require.NotZero(t, report.AnalyzerReports[0].ID)
+
+	require.NoError(t, stor.InsertReportIssues(ctx, report.AnalyzerReports))
+	statements = db.Statements()[5:]
+	require.Len(t, statements, 4)
+	require.Equal(t, "BEGIN", statements[0])
+	require.True(t, strings.HasPrefix(statements[1], "INSERT INTO `report_issue` "), statements[1])
+	require.True(t, strings.HasPrefix(statements[2], "INSERT INTO `report_issue` "), statements[2])
+	require.Equal(t, "COMMIT", statements[3])
+}
+
+func TestFindAnalyzeReportsPaging(t *testing.T) {
+	ctx := context.Background()
+	db := &fakeDB{}
+	stor := newFakeStorage(db)
+
+	idBefore := uint64(100)
+	processedAfter := time.Date(2023, 1, 1, 0, 0, 0, 0, time.UTC)
+	processedBefore := time.Date(2023, 2, 1, 0, 0, 0, 0, time.UTC)
+	reports, err := stor.FindAnalyzeReports(ctx, AnalyzeReportFindFilter{
+		IDBefore:        &idBefore,
+		ProcessedAfter:  &processedAfter,
+		ProcessedBefore: &processedBefore,
+	}, nil, 10)
+	require.NoError(t, err)
+	require.Empty(t, reports)
+
+	statements := db.Statements()
+	require.NotEmpty(t, statements)
+	require.Contains(t, statements[0], "`analyze_report`.`id` < ?")
+	require.Contains(t, statements[0], "`analyze_report`.`processed_at` >= ?")
+	require.Contains(t, statements[0], "`analyze_report`.`processed_at` < ?")
+	require.Contains(t, statements[0], "ORDER BY `analyze_report`.`id` DESC LIMIT 10")
+	require.Equal(t, []driver.Value{int64(idBefore), processedAfter, processedBefore}, db.Args()[0])
+}
@@ -0,0 +1,122 @@
+// Copyright 2023 Meta Platforms, Inc. and affiliates.
+//
+// Redistribution and use in source and binary forms, with or without modification, are permitted provided that the following conditions are met:
+//
+// 1. Redistributions of source code must retain the above copyright notice, this list of conditions and the following disclaimer.
+//
+// 2. Redistributions in binary form must reproduce the above copyright notice, this list of conditions and the following disclaimer in the documentation and/or other materials provided with the distribution.
+//
+// 3. Neither the name of the copyright holder nor the names of its contributors may be used to endorse or promote products derived from this software without specific prior written permission.
+//
+// THIS SOFTWARE IS PROVIDED BY THE COPYRIGHT HOLDERS AND CONTRIBUTORS "AS IS" AND ANY EXPRESS OR IMPLIED WARRANTIES, INCLUDING, BUT NOT LIMITED TO, THE IMPLIED WARRANTIES OF MERCHANTABILITY AND FITNESS FOR A PARTICULAR PURPOSE ARE DISCLAIMED. IN NO EVENT SHALL THE COPYRIGHT HOLDER OR CONTRIBUTORS BE LIABLE FOR ANY DIRECT, INDIRECT, INCIDENTAL, SPECIAL, EXEMPLARY, OR CONSEQUENTIAL DAMAGES (INCLUDING, BUT NOT LIMITED TO, PROCUREMENT OF SUBSTITUTE GOODS OR SERVICES; LOSS OF USE, DATA, OR PROFITS; OR BUSINESS INTERRUPTION) HOWEVER CAUSED AND ON ANY THEORY OF LIABILITY, WHETHER IN CONTRACT, STRICT LIABILITY, OR TORT (INCLUDING NEGLIGENCE OR OTHERWISE) ARISING IN ANY WAY OUT OF THE USE OF THIS SOFTWARE, EVEN IF ADVISED OF THE POSSIBILITY OF SUCH DAMAGE.
+package storage
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+
+	"github.com/facebookincubator/go-belt/tool/experimental/errmon"
+	"github.com/facebookincubator/go-belt/tool/logger"
+	"github.com/jmoiron/sqlx"
+
+	"github.com/immune-gmbh/AttestationFailureAnalysisService/pkg/analysis"
+)
+
+// backfillAnalyzerReport is the part of an AnalyzerReport required to backfill its derived data.
+type backfillAnalyzerReport struct {
+	ID            uint64           `db:"id"`
+	Report        *analysis.Report `db:"report"`
+	DiagnosisCode sql.NullString   `db:"diagnosis_code"`
+	HasIssues     bool             `db:"has_issues"`
+}
+
+func compileBackfillAnalyzerReportsQuery(afterID uint64, limit uint) (string, []any) {
+	query := "SELECT `id`, `report`, `diagnosis_code`, " +
+		"EXISTS(SELECT 1 FROM `report_issue` WHERE `report_issue`.`analyzer_report_id` = `analyzer_report`.`id`) AS `has_issues` " +
+		"FROM `analyzer_report` WHERE `id` > ? ORDER BY `id`"
+	if limit != 0 {
+		query += fmt.Sprintf(" LIMIT %d", limit)
+	}
+	return query, []any{afterID}
+}
+
+// BackfillAnalyzerReports fills the data derived from the stored AnalyzerReport-s,
+// which were stored before this data was maintained: the diagnosis code (if it
+// is not set) and the issues in table `report_issue` (if there are none).
+//
+// Reports are processed in the order of IDs starting after `afterID`, a batch
+// is processed in a single transaction. Returns the ID of the last processed
+// report or zero if there are no more reports.
+//
+// `diagnosisCode` extracts the diagnosis code of a report (see analyzers.DiagnosisCode).
+//
+// TODO: Remove these functions from `Storage`. The initial purpose of storage is combine together
+//
+//	management of metadata in MySQL and data in BlobStorage for firmware images. All the rest
+//	entities should not be accessed through Storage. Otherwise locking, transactions and other
+//	usual stuff is pretty cludgy.
+func (stor *Storage) BackfillAnalyzerReports(
+	ctx context.Context,
+	afterID uint64,
+	limit uint, // 0 -- no limit
+	diagnosisCode func(report *analysis.Report) (string, bool),
+) (_ uint64, retErr error) {
+	log := logger.FromCtx(ctx)
+
+	query, args := compileBackfillAnalyzerReportsQuery(afterID, limit)
+	log.Debugf("query: <%s>; args: %v", query, args)
+	var reports []backfillAnalyzerReport
+	if err := sqlx.SelectContext(ctx, stor.DB, &reports, query, args...); err != nil {
+		return afterID, ErrSelect{Err: fmt.Errorf("unable to perform query '%s' with args %v: %w", query, args, err)}
+	}
+	if len(reports) == 0 {
+		return 0, nil
+	}
+
+	tx, err := stor.startTransaction(ctx)
+	if err != nil {
+		return afterID, fmt.Errorf("unable to start a transaction: %w", err)
+	}
+	defer func() {
+		if retErr == nil {
+			if err := tx.Commit(); err != nil {
+				retErr = fmt.Errorf("unable to commit the transaction: %w", err)
+			}
+			return
+		}
+
+		errmon.ObserveErrorCtx(ctx, retErr)
+		if rollbackErr := tx.Rollback(); rollbackErr != nil {
+			panic(fmt.Errorf("unable to rollback the transaction and do not know to react on that: %w", rollbackErr))
+		}
+	}()
+
+	for _, report := range reports {
+		if report.Report == nil {
+			continue
+		}
+
+		if code, ok := diagnosisCode(report.Report); ok && !report.DiagnosisCode.Valid {
+			query := "UPDATE `analyzer_report` SET `diagnosis_code` = ? WHERE `id` = ? AND `diagnosis_code` IS NULL"
+			if _, err := tx.ExecContext(ctx, query, code, report.ID); err != nil {
+				return afterID, fmt.Errorf("unable to perform query '%s' for analyzer report %d: %w", query, report.ID, err)
+			}
+		}
+
+		if report.HasIssues {
+			continue
+		}
+		for idx, issue := range report.Report.Issues {
+			reportIssue, err := newReportIssue(report.ID, issue)
+			if err != nil {
+				return afterID, fmt.Errorf("unable to convert issue #%d of analyzer report %d: %w", idx, report.ID, err)
+			}
+			if err := stor.insertReportIssue(tx.Tx, reportIssue); err != nil {
+				return afterID, fmt.Errorf("unable to insert issue #%d of analyzer report %d: %w", idx, report.ID, err)
+			}
+		}
+	}
+
+	return reports[len(reports)-1].ID, nil
+}
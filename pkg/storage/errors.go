@@ -15,6 +15,8 @@ import (
 	"fmt"
 
 	"github.com/go-sql-driver/mysql"
+
+	"github.com/immune-gmbh/AttestationFailureAnalysisService/pkg/storage/models"
 )
 
 // ErrInitMySQL implements "error", for the description see Error.
@@ -193,3 +195,40 @@ type ErrEmptyFilters struct{}
 func (err ErrEmptyFilters) Error() string {
 	return "empty filters"
 }
+
+// ErrUnableToDelete implements "error", for the description see Error.
+type ErrUnableToDelete struct {
+	deletedValue string
+	Err          error
+}
+
+func (err ErrUnableToDelete) Error() string {
+	return fmt.Sprintf("unable to delete '%s': %v", err.deletedValue, err.Err)
+}
+
+func (err ErrUnableToDelete) Unwrap() error {
+	return err.Err
+}
+
+// ErrInvalidAggregateQuery implements "error", for the description see Error.
+type ErrInvalidAggregateQuery struct {
+	Err error
+}
+
+func (err ErrInvalidAggregateQuery) Error() string {
+	return fmt.Sprintf("invalid aggregate query: %v", err.Err)
+}
+
+func (err ErrInvalidAggregateQuery) Unwrap() error {
+	return err.Err
+}
+
+// ErrTamperingRegistryEntryExists implements "error", for the description see Error.
+type ErrTamperingRegistryEntryExists struct {
+	Kind  models.TamperingRegistryKind
+	Value string
+}
+
+func (err ErrTamperingRegistryEntryExists) Error() string {
+	return fmt.Sprintf("tampering registry entry %s '%s' already exists", err.Kind, err.Value)
+}
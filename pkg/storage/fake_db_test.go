@@ -0,0 +1,152 @@
+// Copyright 2023 Meta Platforms, Inc. and affiliates.
+//
+// Redistribution and use in source and binary forms, with or without modification, are permitted provided that the following conditions are met:
+//
+// 1. Redistributions of source code must retain the above copyright notice, this list of conditions and the following disclaimer.
+//
+// 2. Redistributions in binary form must reproduce the above copyright notice, this list of conditions and the following disclaimer in the documentation and/or other materials provided with the distribution.
+//
+// 3. Neither the name of the copyright holder nor the names of its contributors may be used to endorse or promote products derived from this software without specific prior written permission.
+//
+// THIS SOFTWARE IS PROVIDED BY THE COPYRIGHT HOLDERS AND CONTRIBUTORS "AS IS" AND ANY EXPRESS OR IMPLIED WARRANTIES, INCLUDING, BUT NOT LIMITED TO, THE IMPLIED WARRANTIES OF MERCHANTABILITY AND FITNESS FOR A PARTICULAR PURPOSE ARE DISCLAIMED. IN NO EVENT SHALL THE COPYRIGHT HOLDER OR CONTRIBUTORS BE LIABLE FOR ANY DIRECT, INDIRECT, INCIDENTAL, SPECIAL, EXEMPLARY, OR CONSEQUENTIAL DAMAGES (INCLUDING, BUT NOT LIMITED TO, PROCUREMENT OF SUBSTITUTE GOODS OR SERVICES; LOSS OF USE, DATA, OR PROFITS; OR BUSINESS INTERRUPTION) HOWEVER CAUSED AND ON ANY THEORY OF LIABILITY, WHETHER IN CONTRACT, STRICT LIABILITY, OR TORT (INCLUDING NEGLIGENCE OR OTHERWISE) ARISING IN ANY WAY OUT OF THE USE OF THIS SOFTWARE, EVEN IF ADVISED OF THE POSSIBILITY OF SUCH DAMAGE.
+package storage
+
+import (
+	"context"
+	"database/sql"
+	"database/sql/driver"
+	"fmt"
+	"io"
+	"sync"
+
+	"github.com/jmoiron/sqlx"
+)
+
+// fakeDB is a minimal in-memory database/sql driver, which records
+// the executed statements and replies to queries with the preset rows.
+type fakeDB struct {
+	locker     sync.Mutex
+	statements []string
+	args       [][]driver.Value
+	lastID     int64
+
+	// Columns and Rows are the result of any query.
+	Columns []string
+	Rows    [][]driver.Value
+}
+
+func newFakeStorage(db *fakeDB) *Storage {
+	return &Storage{DB: sqlx.NewDb(sql.OpenDB(db), "mysql")}
+}
+
+func (db *fakeDB) Statements() []string {
+	db.locker.Lock()
+	defer db.locker.Unlock()
+	return append([]string{}, db.statements...)
+}
+
+// Args returns the arguments of the statements returned by Statements.
+func (db *fakeDB) Args() [][]driver.Value {
+	db.locker.Lock()
+	defer db.locker.Unlock()
+	return append([][]driver.Value{}, db.args...)
+}
+
+func (db *fakeDB) record(statement string, args ...driver.NamedValue) {
+	db.locker.Lock()
+	defer db.locker.Unlock()
+	db.statements = append(db.statements, statement)
+	values := make([]driver.Value, 0, len(args))
+	for _, arg := range args {
+		values = append(values, arg.Value)
+	}
+	db.args = append(db.args, values)
+}
+
+// Connect implements driver.Connector.
+func (db *fakeDB) Connect(context.Context) (driver.Conn, error) {
+	return fakeConn{db: db}, nil
+}
+
+// Driver implements driver.Connector.
+func (db *fakeDB) Driver() driver.Driver {
+	return nil
+}
+
+type fakeConn struct {
+	db *fakeDB
+}
+
+func (conn fakeConn) Prepare(query string) (driver.Stmt, error) {
+	return nil, fmt.Errorf("prepared statements are not supported")
+}
+
+func (conn fakeConn) Close() error {
+	return nil
+}
+
+func (conn fakeConn) Begin() (driver.Tx, error) {
+	return conn.BeginTx(context.Background(), driver.TxOptions{})
+}
+
+func (conn fakeConn) BeginTx(ctx context.Context, opts driver.TxOptions) (driver.Tx, error) {
+	conn.db.record("BEGIN")
+	return conn, nil
+}
+
+func (conn fakeConn) Commit() error {
+	conn.db.record("COMMIT")
+	return nil
+}
+
+func (conn fakeConn) Rollback() error {
+	conn.db.record("ROLLBACK")
+	return nil
+}
+
+func (conn fakeConn) ExecContext(ctx context.Context, query string, args []driver.NamedValue) (driver.Result, error) {
+	conn.db.record(query, args...)
+	conn.db.locker.Lock()
+	defer conn.db.locker.Unlock()
+	conn.db.lastID++
+	return fakeResult{lastID: conn.db.lastID}, nil
+}
+
+func (conn fakeConn) QueryContext(ctx context.Context, query string, args []driver.NamedValue) (driver.Rows, error) {
+	conn.db.record(query, args...)
+	return &fakeRows{columns: conn.db.Columns, rows: conn.db.Rows}, nil
+}
+
+type fakeResult struct {
+	lastID int64
+}
+
+func (r fakeResult) LastInsertId() (int64, error) {
+	return r.lastID, nil
+}
+
+func (r fakeResult) RowsAffected() (int64, error) {
+	return 1, nil
+}
+
+type fakeRows struct {
+	columns []string
+	rows    [][]driver.Value
+}
+
+func (rows *fakeRows) Columns() []string {
+	return rows.columns
+}
+
+func (rows *fakeRows) Close() error {
+	return nil
+}
+
+func (rows *fakeRows) Next(dest []driver.Value) error {
+	if len(rows.rows) == 0 {
+		return io.EOF
+	}
+	copy(dest, rows.rows[0])
+	rows.rows = rows.rows[1:]
+	return nil
+}
@@ -0,0 +1,113 @@
+// Copyright 2023 Meta Platforms, Inc. and affiliates.
+//
+// Redistribution and use in source and binary forms, with or without modification, are permitted provided that the following conditions are met:
+//
+// 1. Redistributions of source code must retain the above copyright notice, this list of conditions and the following disclaimer.
+//
+// 2. Redistributions in binary form must reproduce the above copyright notice, this list of conditions and the following disclaimer in the documentation and/or other materials provided with the distribution.
+//
+// 3. Neither the name of the copyright holder nor the names of its contributors may be used to endorse or promote products derived from this software without specific prior written permission.
+//
+// THIS SOFTWARE IS PROVIDED BY THE COPYRIGHT HOLDERS AND CONTRIBUTORS "AS IS" AND ANY EXPRESS OR IMPLIED WARRANTIES, INCLUDING, BUT NOT LIMITED TO, THE IMPLIED WARRANTIES OF MERCHANTABILITY AND FITNESS FOR A PARTICULAR PURPOSE ARE DISCLAIMED. IN NO EVENT SHALL THE COPYRIGHT HOLDER OR CONTRIBUTORS BE LIABLE FOR ANY DIRECT, INDIRECT, INCIDENTAL, SPECIAL, EXEMPLARY, OR CONSEQUENTIAL DAMAGES (INCLUDING, BUT NOT LIMITED TO, PROCUREMENT OF SUBSTITUTE GOODS OR SERVICES; LOSS OF USE, DATA, OR PROFITS; OR BUSINESS INTERRUPTION) HOWEVER CAUSED AND ON ANY THEORY OF LIABILITY, WHETHER IN CONTRACT, STRICT LIABILITY, OR TORT (INCLUDING NEGLIGENCE OR OTHERWISE) ARISING IN ANY WAY OUT OF THE USE OF THIS SOFTWARE, EVEN IF ADVISED OF THE POSSIBILITY OF SUCH DAMAGE.
+
+package storage
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/immune-gmbh/AttestationFailureAnalysisService/pkg/imagechunks"
+	"github.com/immune-gmbh/AttestationFailureAnalysisService/pkg/storage/helpers"
+	"github.com/immune-gmbh/AttestationFailureAnalysisService/pkg/storage/models"
+	"github.com/immune-gmbh/AttestationFailureAnalysisService/pkg/types"
+
+	"github.com/jmoiron/sqlx"
+)
+
+// chunksBatchSize limits the amount of rows/arguments in a single query.
+const chunksBatchSize = 1000
+
+// insertFirmwareChunks indexes the chunks of an image (see package "imagechunks"),
+// to allow to find them by hash later.
+func insertFirmwareChunks(ctx context.Context, tx *sqlx.Tx, imageID types.ImageID, image []byte) error {
+	manifest := imagechunks.NewManifest(image, imagechunks.DefaultChunkSize)
+
+	var chunks []models.FirmwareImageChunk
+	for idx, chunkHash := range manifest.ChunkHashes {
+		offset, size := manifest.ChunkRange(idx)
+		chunks = append(chunks, models.FirmwareImageChunk{
+			ImageID:    imageID,
+			ChunkIndex: uint32(idx),
+			Offset:     offset,
+			Size:       size,
+			HashSHA256: chunkHash,
+		})
+	}
+
+	for len(chunks) > 0 {
+		batch := chunks
+		if len(batch) > chunksBatchSize {
+			batch = batch[:chunksBatchSize]
+		}
+		chunks = chunks[len(batch):]
+
+		var (
+			columns      []string
+			values       []any
+			placeholders string
+		)
+		for idx := range batch {
+			var rowValues []any
+			var err error
+			rowValues, columns, err = helpers.GetValuesAndColumns(&batch[idx], nil)
+			if err != nil {
+				return fmt.Errorf("unable to get values of the chunk: %w", err)
+			}
+			values = append(values, rowValues...)
+			if idx > 0 {
+				placeholders += ","
+			}
+			placeholders += "(" + constructPlaceholders(len(columns)) + ")"
+		}
+
+		query := "INSERT INTO `firmware_image_chunk` (" + constructColumns("", columns) + ") VALUES " + placeholders
+		if _, err := tx.ExecContext(ctx, query, values...); err != nil {
+			return fmt.Errorf("unable to insert the chunks of image %s: %w", imageID, err)
+		}
+	}
+	return nil
+}
+
+// FindFirmwareChunks returns the chunks of stored images, which has any of the given SHA256 hashes.
+func (stor *Storage) FindFirmwareChunks(ctx context.Context, hashes [][]byte) ([]models.FirmwareImageChunk, error) {
+	_, columns, err := helpers.GetValuesAndColumns(&models.FirmwareImageChunk{}, nil)
+	if err != nil {
+		return nil, fmt.Errorf("unable to gather column names: %w", err)
+	}
+
+	var result []models.FirmwareImageChunk
+	for len(hashes) > 0 {
+		batch := hashes
+		if len(batch) > chunksBatchSize {
+			batch = batch[:chunksBatchSize]
+		}
+		hashes = hashes[len(batch):]
+
+		args := make([]any, 0, len(batch))
+		for _, hash := range batch {
+			args = append(args, hash)
+		}
+		query := fmt.Sprintf(
+			"SELECT %s FROM `firmware_image_chunk` WHERE `hash_sha256` IN (%s)",
+			constructColumns("firmware_image_chunk", columns),
+			constructPlaceholders(len(batch)),
+		)
+
+		var chunks []models.FirmwareImageChunk
+		if err := sqlx.SelectContext(ctx, stor.DB, &chunks, query, args...); err != nil {
+			return nil, ErrSelect{Err: fmt.Errorf("unable to query firmware image chunks: %w", err)}
+		}
+		result = append(result, chunks...)
+	}
+	return result, nil
+}
@@ -149,6 +149,10 @@ func (stor *Storage) InsertFirmware(ctx context.Context, imageMeta models.Firmwa
 		}
 	}
 
+	if err = insertFirmwareChunks(ctx, tx, imageMeta.ImageID, imageData); err != nil {
+		return ErrUnableToInsert{insertedValue: imageMeta.ImageID.String(), Err: err}
+	}
+
 	// Uploading the image to BlobStorageClient.
 	err = stor.retryLoop(func() error {
 		return stor.BlobStorage.Replace(ctx, imageMeta.BlobStorageKey(), imageData)
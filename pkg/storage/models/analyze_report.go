@@ -4,6 +4,7 @@ import (
 	"database/sql"
 	"time"
 
+	"github.com/immune-gmbh/AttestationFailureAnalysisService/pkg/policy"
 	"github.com/immune-gmbh/AttestationFailureAnalysisService/pkg/types"
 )
 
@@ -22,6 +23,9 @@ type AnalyzeReport struct {
 	// AssetID is an optional field that represents a host which was analyzed
 	AssetID *int64 `db:"asset_id"`
 
+	// ModelID is an optional field that represents the model of the host which was analyzed
+	ModelID *int64 `db:"model_id"`
+
 	// Timestamp defines the time moment when the analysis report was requested
 	Timestamp time.Time `db:"timestamp"`
 
@@ -34,6 +38,10 @@ type AnalyzeReport struct {
 	// currently it is firmware-alerter.
 	GroupKey *AnalyzeReportGroupKey `db:"group_key"`
 
+	// Verdict is the overall decision derived from AnalyzerReports by
+	// the server policy (see package policy).
+	Verdict *policy.Verdict `db:"verdict"`
+
 	// == Connected data (stored in other tables) ==
 
 	// AnalyzerReports is a list of succeeded analysis reports
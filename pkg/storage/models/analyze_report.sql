@@ -3,12 +3,15 @@ CREATE TABLE IF NOT EXISTS `analyze_report` (
     `id` BIGINT UNSIGNED NOT NULL AUTO_INCREMENT,
     `job_id` BINARY(16) NOT NULL,
     `asset_id` BIGINT UNSIGNED DEFAULT NULL,
+    `model_id` BIGINT DEFAULT NULL,
     `timestamp` TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
     `processed_at` TIMESTAMP DEFAULT NULL,
     `group_key` BINARY(128) NULL,
+    `verdict` JSON DEFAULT NULL,
     PRIMARY KEY (`id`),
     KEY `job_id` (`job_id`),
     KEY `asset_id` (`asset_id`),
+    KEY `model_id` (`model_id`),
     KEY `timestamp` (`timestamp`),
     KEY `processed_at` (`processed_at`),
     KEY `group_key` (`group_key`)
@@ -1,6 +1,8 @@
 package models
 
 import (
+	"database/sql"
+
 	"github.com/immune-gmbh/AttestationFailureAnalysisService/pkg/types"
 )
 
@@ -24,6 +26,14 @@ type AnalyzeReportGroup struct {
 	// The ID of the task (currently, for PWM) which represents this group of reports (aggregated by `ReportKey`).
 	TaskID *int64 `db:"task_id"`
 
+	// Alert is the serialized notification about the new group. It is stored
+	// together with the group and delivered after the group is committed (outbox).
+	Alert sql.NullString `db:"alert"`
+
+	// AlertDeliveredAt is the time when Alert was delivered, it is NULL
+	// while the delivery is pending.
+	AlertDeliveredAt sql.NullTime `db:"alert_delivered_at"`
+
 	// == Connected data (stored in other tables) ==
 
 	// AnalyzeReports is the list of AnalyzeReports` associated with this group.
@@ -3,7 +3,10 @@ CREATE TABLE IF NOT EXISTS `analyze_report_group` (
     `group_key` BINARY(128),
     `post_id` BIGINT UNSIGNED DEFAULT NULL,
     `task_id` BIGINT UNSIGNED DEFAULT NULL,
+    `alert` JSON DEFAULT NULL,
+    `alert_delivered_at` TIMESTAMP NULL DEFAULT NULL,
     PRIMARY KEY (`group_key`),
     KEY `post_id` (`post_id`),
-    KEY `task_id` (`task_id`)
+    KEY `task_id` (`task_id`),
+    KEY `alert_delivered_at` (`alert_delivered_at`)
 ) ENGINE=InnoDB DEFAULT CHARSET=UTF8MB4;
@@ -1,15 +1,71 @@
 package models
 
 import (
+	"database/sql"
+	"database/sql/driver"
+	"encoding/json"
+	"fmt"
+
+	"github.com/immune-gmbh/AttestationFailureAnalysisService/if/generated/afas"
 	"github.com/immune-gmbh/AttestationFailureAnalysisService/pkg/analysis"
 )
 
 // AnalyzerReport represents a report that was generated by a specific analyzer
 type AnalyzerReport struct {
-	ID              uint64              `db:"id"`
-	AnalyzeReportID uint64              `db:"analyze_report_id"`
-	AnalyzerID      analysis.AnalyzerID `db:"analyzer_id"` // TODO: remove this field from here, it should be just an index on the DB side
-	Input           analysis.Input      `db:"input"`       // TODO: make typed, so that AnalyzeID could be removed
-	Report          *analysis.Report    `db:"report"`
-	ExecError       SQLErrorWrapper     `db:"exec_error"`
+	ID              uint64                   `db:"id"`
+	AnalyzeReportID uint64                   `db:"analyze_report_id"`
+	AnalyzerID      analysis.AnalyzerID      `db:"analyzer_id"` // TODO: remove this field from here, it should be just an index on the DB side
+	AnalyzerVersion analysis.AnalyzerVersion `db:"analyzer_version"`
+	Input           analysis.Input           `db:"input"` // TODO: make typed, so that AnalyzeID could be removed
+	Report          *analysis.Report         `db:"report"`
+	ExecError       SQLErrorWrapper          `db:"exec_error"`
+	DiagnosisCode   sql.NullString           `db:"diagnosis_code"`
+
+	// ReanalysisOf is the ID of the analyzer report, which was re-analyzed
+	// (with a newer version of the analyzer) to produce this report.
+	ReanalysisOf *uint64 `db:"reanalysis_of"`
+
+	// ReanalysisDiff is the difference of this report from the report ReanalysisOf.
+	ReanalysisDiff *ReanalysisDiff `db:"reanalysis_diff"`
+
+	// SupersededBy is the ID of the analyzer report, which re-analyzed this one.
+	// Superseded reports are not returned as part of analyze reports.
+	SupersededBy *uint64 `db:"superseded_by"`
+}
+
+// ReanalysisDiff is the difference between the reports of the same
+// analyzer on the same input, produced by different versions of the analyzer.
+type ReanalysisDiff struct {
+	PreviousVersion analysis.AnalyzerVersion
+
+	// VerdictChanged is true if the diagnosis, the execution error or the issues differ.
+	VerdictChanged bool
+
+	// Diff is the detailed difference, the previous report is "A" and the new one is "B".
+	Diff *afas.AnalyzerReportDiff
+}
+
+// Scan implements database/sql.Scanner.
+func (d *ReanalysisDiff) Scan(src any) error {
+	var b []byte
+	switch src := src.(type) {
+	case string:
+		b = []byte(src)
+	case []byte:
+		b = src
+	default:
+		return fmt.Errorf("expected string or []byte, but received %T", src)
+	}
+
+	return json.Unmarshal(b, d)
+}
+
+// Value implements database/sql/driver.Valuer.
+func (d *ReanalysisDiff) Value() (driver.Value, error) {
+	if d == nil {
+		return nil, nil
+	}
+
+	b, err := json.Marshal(d)
+	return string(b), err
 }
@@ -3,16 +3,22 @@ CREATE TABLE IF NOT EXISTS `analyzer_report` (
     `id` BIGINT UNSIGNED NOT NULL AUTO_INCREMENT,
     `analyze_report_id` BIGINT UNSIGNED NOT NULL,
     `analyzer_id` VARCHAR(64) NOT NULL,
+    `analyzer_version` VARCHAR(32) NOT NULL DEFAULT '',
     `exec_error` JSON DEFAULT NULL,
     `input` JSON DEFAULT NULL,
     `report` JSON DEFAULT NULL,
     `diagnosis_code` VARCHAR(255) NULL,
+    `reanalysis_of` BIGINT UNSIGNED NULL,
+    `reanalysis_diff` JSON DEFAULT NULL,
+    `superseded_by` BIGINT UNSIGNED NULL,
     `input_actual_firmware_image_id` BINARY(128) GENERATED ALWAYS AS (UNHEX(input ->> '$.ActualFirmwareBlob.Blob."./server/controller/types.AnalyzerFirmwareAccessor".ImageID')),
     `input_original_firmware_image_id` BINARY(128) GENERATED ALWAYS AS (UNHEX(input ->> '$.OriginalFirmwareBlob.Blob."./server/controller/types.AnalyzerFirmwareAccessor".ImageID')),
     `exec_error_code` ENUM('OK', 'ErrNotApplicable', 'ErrOther') GENERATED ALWAYS AS (IF(exec_error IS NULL, 'OK',IF(JSON_CONTAINS_PATH(exec_error, 'one', '$**.ErrNotApplicable'), 'ErrNotApplicable', 'ErrOther'))),
     PRIMARY KEY (`id`),
     KEY `analyze_report_id` (`analyze_report_id`),
     KEY `analyzer_diagnosis` (`analyzer_id`, `diagnosis_code`),
+    KEY `analyzer_version` (`analyzer_id`, `analyzer_version`),
+    KEY `reanalysis_of` (`reanalysis_of`),
     KEY `input_actual_firmware_image_id` (`input_actual_firmware_image_id`),
     KEY `input_original_firmware_image_id` (`input_original_firmware_image_id`),
     KEY `exec_error_code` (`exec_error_code`)
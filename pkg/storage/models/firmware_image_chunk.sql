@@ -0,0 +1,9 @@
+CREATE TABLE IF NOT EXISTS firmware_image_chunk (
+    image_id VARBINARY(192) NOT NULL,
+    chunk_index INT UNSIGNED NOT NULL,
+    chunk_offset BIGINT UNSIGNED NOT NULL,
+    size INT UNSIGNED NOT NULL,
+    hash_sha256 BINARY(32) NOT NULL,
+    PRIMARY KEY (image_id, chunk_index),
+    INDEX (hash_sha256)
+) DEFAULT CHARSET UTF8MB4;
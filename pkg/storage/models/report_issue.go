@@ -0,0 +1,18 @@
+package models
+
+import (
+	"database/sql"
+
+	"github.com/immune-gmbh/AttestationFailureAnalysisService/pkg/analysis"
+)
+
+// ReportIssue is an issue of an AnalyzerReport. Issues are also stored
+// within AnalyzerReport.Report, the copy in a separate table allows
+// to aggregate reports by issues.
+type ReportIssue struct {
+	ID               uint64            `db:"id"`
+	AnalyzerReportID uint64            `db:"analyzer_report_id"`
+	Custom           sql.NullString    `db:"custom"`
+	Severity         analysis.Severity `db:"severity"`
+	Description      sql.NullString    `db:"description"`
+}
@@ -0,0 +1,46 @@
+package models
+
+import (
+	"database/sql"
+	"time"
+)
+
+// TamperingRegistryKind defines what a TamperingRegistryEntry is matched against.
+type TamperingRegistryKind string
+
+const (
+	// TamperingRegistryKindAssetID matches a host by its asset ID (decimal).
+	TamperingRegistryKindAssetID = TamperingRegistryKind("asset_id")
+
+	// TamperingRegistryKindHostname matches a host by its hostname.
+	TamperingRegistryKindHostname = TamperingRegistryKind("hostname")
+
+	// TamperingRegistryKindImageHash matches a firmware image by its SHA2-512 hash (lowercase hex).
+	TamperingRegistryKindImageHash = TamperingRegistryKind("image_hash")
+
+	// TamperingRegistryKindImageStableHash matches a firmware image by its stable hash (lowercase hex).
+	TamperingRegistryKindImageStableHash = TamperingRegistryKind("image_stable_hash")
+)
+
+// IsHost returns true if the kind matches hosts (not firmware images).
+func (kind TamperingRegistryKind) IsHost() bool {
+	return kind == TamperingRegistryKindAssetID || kind == TamperingRegistryKindHostname
+}
+
+// TamperingRegistryEntry represents a single row of `tampering_registry_entry` table.
+//
+// An entry marks a host as intentionally tampered (for example a red-team
+// machine), or a firmware image as known to be malicious.
+type TamperingRegistryEntry struct {
+	ID        uint64                `db:"id"`
+	Kind      TamperingRegistryKind `db:"kind"`
+	Value     string                `db:"value"`
+	Reason    string                `db:"reason"`
+	ExpiresAt sql.NullTime          `db:"expires_at"`
+	CreatedAt time.Time             `db:"created_at"`
+}
+
+// IsActive returns true if the entry is not expired at the given moment.
+func (entry TamperingRegistryEntry) IsActive(at time.Time) bool {
+	return !entry.ExpiresAt.Valid || entry.ExpiresAt.Time.After(at)
+}
@@ -0,0 +1,11 @@
+CREATE TABLE IF NOT EXISTS `tampering_registry_entry` (
+    `id` BIGINT UNSIGNED NOT NULL AUTO_INCREMENT,
+    `kind` ENUM('asset_id', 'hostname', 'image_hash', 'image_stable_hash') NOT NULL,
+    `value` VARCHAR(512) CHARACTER SET ascii NOT NULL,
+    `reason` TEXT NOT NULL,
+    `expires_at` TIMESTAMP NULL DEFAULT NULL,
+    `created_at` TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    PRIMARY KEY (`id`),
+    UNIQUE KEY `kind_value` (`kind`, `value`),
+    KEY `expires_at` (`expires_at`)
+) ENGINE=InnoDB DEFAULT CHARSET=UTF8MB4;
@@ -0,0 +1,152 @@
+// Copyright 2023 Meta Platforms, Inc. and affiliates.
+//
+// Redistribution and use in source and binary forms, with or without modification, are permitted provided that the following conditions are met:
+//
+// 1. Redistributions of source code must retain the above copyright notice, this list of conditions and the following disclaimer.
+//
+// 2. Redistributions in binary form must reproduce the above copyright notice, this list of conditions and the following disclaimer in the documentation and/or other materials provided with the distribution.
+//
+// 3. Neither the name of the copyright holder nor the names of its contributors may be used to endorse or promote products derived from this software without specific prior written permission.
+//
+// THIS SOFTWARE IS PROVIDED BY THE COPYRIGHT HOLDERS AND CONTRIBUTORS "AS IS" AND ANY EXPRESS OR IMPLIED WARRANTIES, INCLUDING, BUT NOT LIMITED TO, THE IMPLIED WARRANTIES OF MERCHANTABILITY AND FITNESS FOR A PARTICULAR PURPOSE ARE DISCLAIMED. IN NO EVENT SHALL THE COPYRIGHT HOLDER OR CONTRIBUTORS BE LIABLE FOR ANY DIRECT, INDIRECT, INCIDENTAL, SPECIAL, EXEMPLARY, OR CONSEQUENTIAL DAMAGES (INCLUDING, BUT NOT LIMITED TO, PROCUREMENT OF SUBSTITUTE GOODS OR SERVICES; LOSS OF USE, DATA, OR PROFITS; OR BUSINESS INTERRUPTION) HOWEVER CAUSED AND ON ANY THEORY OF LIABILITY, WHETHER IN CONTRACT, STRICT LIABILITY, OR TORT (INCLUDING NEGLIGENCE OR OTHERWISE) ARISING IN ANY WAY OUT OF THE USE OF THIS SOFTWARE, EVEN IF ADVISED OF THE POSSIBILITY OF SUCH DAMAGE.
+package storage
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/facebookincubator/go-belt/tool/experimental/errmon"
+	"github.com/facebookincubator/go-belt/tool/logger"
+	"github.com/jmoiron/sqlx"
+
+	"github.com/immune-gmbh/AttestationFailureAnalysisService/pkg/analysis"
+	"github.com/immune-gmbh/AttestationFailureAnalysisService/pkg/storage/helpers"
+	"github.com/immune-gmbh/AttestationFailureAnalysisService/pkg/storage/models"
+)
+
+// OutdatedAnalyzerReportsFilter defines which AnalyzerReport-s are considered
+// outdated: current (not superseded) reports of the analyzer, which were
+// produced by a version different from the current one and have a stored input.
+type OutdatedAnalyzerReportsFilter struct {
+	// AnalyzerID is the analyzer which reports are selected.
+	AnalyzerID analysis.AnalyzerID
+
+	// CurrentVersion is the version of the analyzer, reports of which
+	// are not considered outdated.
+	CurrentVersion analysis.AnalyzerVersion
+
+	// BeforeID restricts the result to reports with IDs less than the given one
+	// (if not zero). It is used to iterate over the outdated reports in batches.
+	BeforeID uint64
+}
+
+func compileOutdatedAnalyzerReportsQuery(
+	filter OutdatedAnalyzerReportsFilter,
+	columns []string,
+	limit uint, // 0 -- no limit
+) (string, []any) {
+	whereConds := "`analyzer_id` = ? AND `analyzer_version` != ? AND `superseded_by` IS NULL AND `input` IS NOT NULL"
+	whereArgs := []any{filter.AnalyzerID, filter.CurrentVersion}
+	if filter.BeforeID != 0 {
+		whereConds += " AND `id` < ?"
+		whereArgs = append(whereArgs, filter.BeforeID)
+	}
+
+	query := fmt.Sprintf(
+		"SELECT %s FROM `analyzer_report` WHERE %s ORDER BY `id` DESC",
+		constructColumns(`analyzer_report`, columns),
+		whereConds,
+	)
+	if limit != 0 {
+		query += fmt.Sprintf(" LIMIT %d", limit)
+	}
+	return query, whereArgs
+}
+
+// FindOutdatedAnalyzerReports returns AnalyzerReport-s matching the filter, the newest first.
+//
+// TODO: Remove these functions from `Storage`. The initial purpose of storage is combine together
+//
+//	management of metadata in MySQL and data in BlobStorage for firmware images. All the rest
+//	entities should not be accessed through Storage. Otherwise locking, transactions and other
+//	usual stuff is pretty cludgy.
+func (stor *Storage) FindOutdatedAnalyzerReports(
+	ctx context.Context,
+	filter OutdatedAnalyzerReportsFilter,
+	limit uint, // 0 -- no limit
+) ([]models.AnalyzerReport, error) {
+	if filter.AnalyzerID == "" {
+		return nil, ErrEmptyFilters{}
+	}
+
+	_, columns, err := helpers.GetValuesAndColumns(&models.AnalyzerReport{}, nil)
+	if err != nil {
+		return nil, fmt.Errorf("unable to gather column names: %w", err)
+	}
+
+	query, args := compileOutdatedAnalyzerReportsQuery(filter, columns, limit)
+	logger.FromCtx(ctx).Debugf("query: <%s>; args: %v", query, args)
+
+	var reports []models.AnalyzerReport
+	if err := sqlx.SelectContext(ctx, stor.DB, &reports, query, args...); err != nil {
+		return nil, ErrSelect{Err: fmt.Errorf("unable to perform query '%s' with args %v: %w", query, args, err)}
+	}
+	return reports, nil
+}
+
+// InsertReanalysisReport inserts an AnalyzerReport produced by re-analysis
+// of the report with ID `report.ReanalysisOf` and marks the latter as superseded
+// by the new one. Everything is done in a single transaction; if the previous
+// report is already superseded (for example by a concurrent re-analysis) then
+// nothing is inserted and an error is returned.
+//
+// Issues of the report are not saved, see InsertReportIssues.
+//
+// TODO: Remove these functions from `Storage`. The initial purpose of storage is combine together
+//
+//	management of metadata in MySQL and data in BlobStorage for firmware images. All the rest
+//	entities should not be accessed through Storage. Otherwise locking, transactions and other
+//	usual stuff is pretty cludgy.
+func (stor *Storage) InsertReanalysisReport(ctx context.Context, report *models.AnalyzerReport) (retErr error) {
+	if report.ReanalysisOf == nil {
+		return fmt.Errorf("the report is not a re-analysis report (ReanalysisOf is not set)")
+	}
+	previousID := *report.ReanalysisOf
+
+	tx, err := stor.startTransaction(ctx)
+	if err != nil {
+		return fmt.Errorf("unable to start a transaction: %w", err)
+	}
+	defer func() {
+		if retErr == nil {
+			if err := tx.Commit(); err != nil {
+				retErr = fmt.Errorf("unable to commit the transaction: %w", err)
+			}
+			return
+		}
+
+		errmon.ObserveErrorCtx(ctx, retErr)
+		if rollbackErr := tx.Rollback(); rollbackErr != nil {
+			panic(fmt.Errorf("unable to rollback the transaction and do not know to react on that: %w", rollbackErr))
+		}
+	}()
+
+	if err := stor.insertAnalyzerReport(tx.Tx, report); err != nil {
+		return fmt.Errorf("unable to insert the analyzer report: %w", err)
+	}
+
+	query := "UPDATE `analyzer_report` SET `superseded_by` = ? WHERE `id` = ? AND `superseded_by` IS NULL"
+	logger.FromCtx(ctx).Debugf("query: %s; args: %d, %d", query, report.ID, previousID)
+	sqlResult, err := tx.ExecContext(ctx, query, report.ID, previousID)
+	if err != nil {
+		return fmt.Errorf("unable to perform query '%s': %w", query, err)
+	}
+	affected, err := sqlResult.RowsAffected()
+	if err != nil {
+		return fmt.Errorf("unable to get the amount of affected rows: %w", err)
+	}
+	if affected == 0 {
+		return fmt.Errorf("analyzer report %d is not found or is already superseded", previousID)
+	}
+	return nil
+}
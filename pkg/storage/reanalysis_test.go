@@ -0,0 +1,42 @@
+// Copyright 2023 Meta Platforms, Inc. and affiliates.
+//
+// Redistribution and use in source and binary forms, with or without modification, are permitted provided that the following conditions are met:
+//
+// 1. Redistributions of source code must retain the above copyright notice, this list of conditions and the following disclaimer.
+//
+// 2. Redistributions in binary form must reproduce the above copyright notice, this list of conditions and the following disclaimer in the documentation and/or other materials provided with the distribution.
+//
+// 3. Neither the name of the copyright holder nor the names of its contributors may be used to endorse or promote products derived from this software without specific prior written permission.
+//
+// THIS SOFTWARE IS PROVIDED BY THE COPYRIGHT HOLDERS AND CONTRIBUTORS "AS IS" AND ANY EXPRESS OR IMPLIED WARRANTIES, INCLUDING, BUT NOT LIMITED TO, THE IMPLIED WARRANTIES OF MERCHANTABILITY AND FITNESS FOR A PARTICULAR PURPOSE ARE DISCLAIMED. IN NO EVENT SHALL THE COPYRIGHT HOLDER OR CONTRIBUTORS BE LIABLE FOR ANY DIRECT, INDIRECT, INCIDENTAL, SPECIAL, EXEMPLARY, OR CONSEQUENTIAL DAMAGES (INCLUDING, BUT NOT LIMITED TO, PROCUREMENT OF SUBSTITUTE GOODS OR SERVICES; LOSS OF USE, DATA, OR PROFITS; OR BUSINESS INTERRUPTION) HOWEVER CAUSED AND ON ANY THEORY OF LIABILITY, WHETHER IN CONTRACT, STRICT LIABILITY, OR TORT (INCLUDING NEGLIGENCE OR OTHERWISE) ARISING IN ANY WAY OUT OF THE USE OF THIS SOFTWARE, EVEN IF ADVISED OF THE POSSIBILITY OF SUCH DAMAGE.
+package storage
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/immune-gmbh/AttestationFailureAnalysisService/pkg/analysis"
+)
+
+func TestCompileOutdatedAnalyzerReportsQuery(t *testing.T) {
+	columns := []string{"id", "analyzer_id"}
+	{
+		query, args := compileOutdatedAnalyzerReportsQuery(OutdatedAnalyzerReportsFilter{
+			AnalyzerID:     "DiffMeasuredBoot",
+			CurrentVersion: "1.1.0",
+		}, columns, 0)
+		require.True(t, strings.HasSuffix(query, " WHERE `analyzer_id` = ? AND `analyzer_version` != ? AND `superseded_by` IS NULL AND `input` IS NOT NULL ORDER BY `id` DESC"), query)
+		require.Equal(t, []any{analysis.AnalyzerID("DiffMeasuredBoot"), analysis.AnalyzerVersion("1.1.0")}, args)
+	}
+	{
+		query, args := compileOutdatedAnalyzerReportsQuery(OutdatedAnalyzerReportsFilter{
+			AnalyzerID:     "DiffMeasuredBoot",
+			CurrentVersion: "1.1.0",
+			BeforeID:       5,
+		}, columns, 100)
+		require.True(t, strings.HasSuffix(query, " AND `input` IS NOT NULL AND `id` < ? ORDER BY `id` DESC LIMIT 100"), query)
+		require.Equal(t, []any{analysis.AnalyzerID("DiffMeasuredBoot"), analysis.AnalyzerVersion("1.1.0"), uint64(5)}, args)
+	}
+}
@@ -13,6 +13,8 @@ package storage
 
 import (
 	"context"
+	"crypto/sha1"
+	"crypto/sha256"
 	"fmt"
 	"strings"
 
@@ -89,6 +91,33 @@ func ptr[T any](v T) *T {
 //	entities should not be accessed through Storage. Otherwise locking, transactions and other
 //	usual stuff is pretty cludgy.
 func (stor *Storage) SelectReproducedPCRsWithImageMetadata(ctx context.Context) ([]models.ReproducedPCRs, []models.FirmwareImageMetadata, error) {
+	return stor.selectReproducedPCRsWithImageMetadata(ctx, "")
+}
+
+// FindReproducedPCRsWithImageMetadataByPCR0 is similar to SelectReproducedPCRsWithImageMetadata,
+// but selects only the entries with the given PCR0 value. The PCR bank is determined by
+// the length of the value (SHA1 or SHA256).
+//
+// TODO: Remove these functions from `Storage`. The initial purpose of storage is combine together
+//
+//	management of metadata in MySQL and data in BlobStorage for firmware images. All the rest
+//	entities should not be accessed through Storage. Otherwise locking, transactions and other
+//	usual stuff is pretty cludgy.
+func (stor *Storage) FindReproducedPCRsWithImageMetadataByPCR0(ctx context.Context, pcr0 []byte) ([]models.ReproducedPCRs, []models.FirmwareImageMetadata, error) {
+	switch len(pcr0) {
+	case sha1.Size:
+		return stor.selectReproducedPCRsWithImageMetadata(ctx, "`pcrs`.`pcr0_sha1` = ?", pcr0)
+	case sha256.Size:
+		return stor.selectReproducedPCRsWithImageMetadata(ctx, "`pcrs`.`pcr0_sha256` = ?", pcr0)
+	}
+	return nil, nil, fmt.Errorf("unexpected length of PCR0 value: %d", len(pcr0))
+}
+
+func (stor *Storage) selectReproducedPCRsWithImageMetadata(
+	ctx context.Context,
+	whereCond string,
+	args ...any,
+) ([]models.ReproducedPCRs, []models.FirmwareImageMetadata, error) {
 	var (
 		leftRow  models.ReproducedPCRs
 		rightRow models.FirmwareImageMetadata
@@ -107,7 +136,10 @@ func (stor *Storage) SelectReproducedPCRsWithImageMetadata(ctx context.Context)
 		constructColumns("pcrs", leftColumns),
 		constructColumns("meta", rightColumns),
 	)
-	rows, err := stor.DB.Query(query)
+	if whereCond != "" {
+		query += " WHERE " + whereCond
+	}
+	rows, err := stor.DB.QueryContext(ctx, query, args...)
 	if err != nil {
 		return nil, nil, fmt.Errorf("unable to query '%s': %w", query, err)
 	}
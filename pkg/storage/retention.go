@@ -0,0 +1,313 @@
+// Copyright 2023 Meta Platforms, Inc. and affiliates.
+//
+// Redistribution and use in source and binary forms, with or without modification, are permitted provided that the following conditions are met:
+//
+// 1. Redistributions of source code must retain the above copyright notice, this list of conditions and the following disclaimer.
+//
+// 2. Redistributions in binary form must reproduce the above copyright notice, this list of conditions and the following disclaimer in the documentation and/or other materials provided with the distribution.
+//
+// 3. Neither the name of the copyright holder nor the names of its contributors may be used to endorse or promote products derived from this software without specific prior written permission.
+//
+// THIS SOFTWARE IS PROVIDED BY THE COPYRIGHT HOLDERS AND CONTRIBUTORS "AS IS" AND ANY EXPRESS OR IMPLIED WARRANTIES, INCLUDING, BUT NOT LIMITED TO, THE IMPLIED WARRANTIES OF MERCHANTABILITY AND FITNESS FOR A PARTICULAR PURPOSE ARE DISCLAIMED. IN NO EVENT SHALL THE COPYRIGHT HOLDER OR CONTRIBUTORS BE LIABLE FOR ANY DIRECT, INDIRECT, INCIDENTAL, SPECIAL, EXEMPLARY, OR CONSEQUENTIAL DAMAGES (INCLUDING, BUT NOT LIMITED TO, PROCUREMENT OF SUBSTITUTE GOODS OR SERVICES; LOSS OF USE, DATA, OR PROFITS; OR BUSINESS INTERRUPTION) HOWEVER CAUSED AND ON ANY THEORY OF LIABILITY, WHETHER IN CONTRACT, STRICT LIABILITY, OR TORT (INCLUDING NEGLIGENCE OR OTHERWISE) ARISING IN ANY WAY OUT OF THE USE OF THIS SOFTWARE, EVEN IF ADVISED OF THE POSSIBILITY OF SUCH DAMAGE.
+package storage
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"os"
+	"strings"
+	"time"
+
+	"github.com/facebookincubator/go-belt/tool/experimental/errmon"
+	"github.com/facebookincubator/go-belt/tool/logger"
+	"github.com/jmoiron/sqlx"
+
+	"github.com/immune-gmbh/AttestationFailureAnalysisService/pkg/storage/helpers"
+	"github.com/immune-gmbh/AttestationFailureAnalysisService/pkg/storage/models"
+	"github.com/immune-gmbh/AttestationFailureAnalysisService/pkg/types"
+)
+
+// ExpiredAnalyzeReportsFilter defines which AnalyzeReport-s are considered expired.
+//
+// A report is expired if it matches any of the conditions (concatenated through "OR"-s).
+// If a field has a zero-value then it is not included to filter conditions.
+type ExpiredAnalyzeReportsFilter struct {
+	// OlderThan selects reports with `timestamp` before the given time.
+	OlderThan time.Time
+
+	// MaxReportsPerAsset selects all reports of an asset except the newest
+	// MaxReportsPerAsset ones. Reports without an asset ID are not affected.
+	MaxReportsPerAsset uint
+
+	// AfterID restricts the result to reports with IDs greater than the given one.
+	// It is used to iterate over the expired reports in batches.
+	AfterID uint64
+}
+
+// IsEmpty returns true if the filter has no conditions.
+func (f ExpiredAnalyzeReportsFilter) IsEmpty() bool {
+	return f.OlderThan.IsZero() && f.MaxReportsPerAsset == 0
+}
+
+func compileExpiredAnalyzeReportsQuery(
+	filter ExpiredAnalyzeReportsFilter,
+	limit uint, // 0 -- no limit
+) (string, []any) {
+	var whereConds []string
+	var whereArgs []any
+
+	if !filter.OlderThan.IsZero() {
+		whereConds = append(whereConds, "`timestamp` < ?")
+		whereArgs = append(whereArgs, filter.OlderThan)
+	}
+	if filter.MaxReportsPerAsset != 0 {
+		whereConds = append(whereConds, "`asset_id` IS NOT NULL AND `asset_rank` > ?")
+		whereArgs = append(whereArgs, filter.MaxReportsPerAsset)
+	}
+
+	query := "SELECT `id` FROM (" +
+		"SELECT `id`, `asset_id`, `timestamp`, ROW_NUMBER() OVER (PARTITION BY `asset_id` ORDER BY `id` DESC) AS `asset_rank` FROM `analyze_report`" +
+		") AS `ranked_analyze_report`"
+	if len(whereConds) > 0 {
+		whereTotal := "(" + strings.Join(whereConds, ") OR (") + ")"
+		if filter.AfterID != 0 {
+			whereTotal = "`id` > ? AND (" + whereTotal + ")"
+			whereArgs = append([]any{filter.AfterID}, whereArgs...)
+		}
+		query += " WHERE " + whereTotal
+	}
+	query += " ORDER BY `id`"
+	if limit != 0 {
+		query += fmt.Sprintf(" LIMIT %d", limit)
+	}
+	return query, whereArgs
+}
+
+// FindExpiredAnalyzeReportIDs returns IDs of AnalyzeReport-s matching the
+// retention filter, the oldest first.
+//
+// TODO: Remove these functions from `Storage`. The initial purpose of storage is combine together
+//
+//	management of metadata in MySQL and data in BlobStorage for firmware images. All the rest
+//	entities should not be accessed through Storage. Otherwise locking, transactions and other
+//	usual stuff is pretty cludgy.
+func (stor *Storage) FindExpiredAnalyzeReportIDs(
+	ctx context.Context,
+	filter ExpiredAnalyzeReportsFilter,
+	limit uint, // 0 -- no limit
+) ([]uint64, error) {
+	if filter.IsEmpty() {
+		return nil, ErrEmptyFilters{}
+	}
+
+	query, args := compileExpiredAnalyzeReportsQuery(filter, limit)
+	logger.FromCtx(ctx).Debugf("query: <%s>; args: %v", query, args)
+
+	var ids []uint64
+	if err := sqlx.SelectContext(ctx, stor.DB, &ids, query, args...); err != nil {
+		return nil, ErrSelect{Err: fmt.Errorf("unable to perform query '%s' with args %v: %w", query, args, err)}
+	}
+	return ids, nil
+}
+
+// GetAnalyzerReports returns AnalyzerReport-s of the given AnalyzeReport-s.
+//
+// TODO: Remove these functions from `Storage`. The initial purpose of storage is combine together
+//
+//	management of metadata in MySQL and data in BlobStorage for firmware images. All the rest
+//	entities should not be accessed through Storage. Otherwise locking, transactions and other
+//	usual stuff is pretty cludgy.
+func (stor *Storage) GetAnalyzerReports(
+	ctx context.Context,
+	analyzeReportIDs []uint64,
+) ([]models.AnalyzerReport, error) {
+	if len(analyzeReportIDs) == 0 {
+		return nil, nil
+	}
+
+	_, columns, err := helpers.GetValuesAndColumns(&models.AnalyzerReport{}, nil)
+	if err != nil {
+		return nil, fmt.Errorf("unable to gather column names: %w", err)
+	}
+
+	query := fmt.Sprintf(
+		"SELECT %s FROM `analyzer_report` WHERE `analyze_report_id` IN %s AND `superseded_by` IS NULL",
+		constructColumns(`analyzer_report`, columns),
+		uint64SliceToSQLList(analyzeReportIDs),
+	)
+	logger.FromCtx(ctx).Debugf("query: %s", query)
+
+	var reports []models.AnalyzerReport
+	if err := sqlx.SelectContext(ctx, stor.DB, &reports, query); err != nil {
+		return nil, ErrSelect{Err: fmt.Errorf("unable to query analyzer reports by analyze report IDs %v: %w", analyzeReportIDs, err)}
+	}
+	return reports, nil
+}
+
+// DeleteAnalyzeReports deletes AnalyzeReport-s with the given IDs including
+// the related AnalyzerReport-s and ReportIssue-s. Everything is deleted
+// in a single transaction: either all the reports are deleted or none.
+//
+// Returns the amount of deleted AnalyzeReport-s.
+//
+// TODO: Remove these functions from `Storage`. The initial purpose of storage is combine together
+//
+//	management of metadata in MySQL and data in BlobStorage for firmware images. All the rest
+//	entities should not be accessed through Storage. Otherwise locking, transactions and other
+//	usual stuff is pretty cludgy.
+func (stor *Storage) DeleteAnalyzeReports(ctx context.Context, ids []uint64) (_ int64, retErr error) {
+	if len(ids) == 0 {
+		return 0, nil
+	}
+	idList := uint64SliceToSQLList(ids)
+
+	tx, err := stor.startTransaction(ctx)
+	if err != nil {
+		return 0, ErrUnableToDelete{deletedValue: idList, Err: fmt.Errorf("unable to start a transaction: %w", err)}
+	}
+	defer func() {
+		if retErr == nil {
+			if err := tx.Commit(); err != nil {
+				retErr = ErrUnableToDelete{deletedValue: idList, Err: fmt.Errorf("unable to commit the transaction: %w", err)}
+			}
+			return
+		}
+
+		errmon.ObserveErrorCtx(ctx, retErr)
+		if rollbackErr := tx.Rollback(); rollbackErr != nil {
+			panic(fmt.Errorf("unable to rollback the transaction and do not know to react on that: %w", rollbackErr))
+		}
+	}()
+
+	// The groups are deleted after the reports, if no other report refers them.
+	var groupKeys []models.AnalyzeReportGroupKey
+	query := "SELECT DISTINCT `group_key` FROM `analyze_report` WHERE `group_key` IS NOT NULL AND `id` IN " + idList
+	logger.FromCtx(ctx).Debugf("query: %s", query)
+	if err := tx.SelectContext(ctx, &groupKeys, query); err != nil {
+		return 0, ErrUnableToDelete{deletedValue: idList, Err: fmt.Errorf("unable to perform query '%s': %w", query, err)}
+	}
+
+	// Children first, so that a failure in the middle never leaves orphans.
+	queries := []string{
+		"DELETE `report_issue` FROM `report_issue` JOIN `analyzer_report` ON `report_issue`.`analyzer_report_id` = `analyzer_report`.`id` WHERE `analyzer_report`.`analyze_report_id` IN " + idList,
+		"DELETE FROM `analyzer_report` WHERE `analyze_report_id` IN " + idList,
+		"DELETE FROM `analyze_report` WHERE `id` IN " + idList,
+	}
+	var deleted int64
+	for _, query := range queries {
+		logger.FromCtx(ctx).Debugf("query: %s", query)
+		sqlResult, err := tx.ExecContext(ctx, query)
+		if err != nil {
+			return 0, ErrUnableToDelete{deletedValue: idList, Err: fmt.Errorf("unable to perform query '%s': %w", query, err)}
+		}
+		deleted, err = sqlResult.RowsAffected()
+		if err != nil {
+			return 0, ErrUnableToDelete{deletedValue: idList, Err: fmt.Errorf("unable to get the amount of affected rows: %w", err)}
+		}
+	}
+
+	if len(groupKeys) > 0 {
+		query := compileDeleteOrphanGroupsQuery(len(groupKeys))
+		args := make([]any, 0, len(groupKeys))
+		for _, key := range groupKeys {
+			args = append(args, key)
+		}
+		logger.FromCtx(ctx).Debugf("query: %s", query)
+		if _, err := tx.ExecContext(ctx, query, args...); err != nil {
+			return 0, ErrUnableToDelete{deletedValue: idList, Err: fmt.Errorf("unable to perform query '%s': %w", query, err)}
+		}
+	}
+
+	return deleted, nil
+}
+
+// compileDeleteOrphanGroupsQuery returns a query deleting the AnalyzeReportGroup-s
+// with the given keys (`amount` placeholders), which are not referenced by any AnalyzeReport.
+func compileDeleteOrphanGroupsQuery(amount int) string {
+	return "DELETE FROM `analyze_report_group` WHERE `group_key` IN (" + constructPlaceholders(amount) + ") AND " +
+		"NOT EXISTS (SELECT 1 FROM `analyze_report` WHERE `analyze_report`.`group_key` = `analyze_report_group`.`group_key`)"
+}
+
+const firmwareReferencedCond = "EXISTS (SELECT 1 FROM `analyzer_report` WHERE `analyzer_report`.`input_actual_firmware_image_id` = `firmware_image_metadata`.`image_id`) OR " +
+	"EXISTS (SELECT 1 FROM `analyzer_report` WHERE `analyzer_report`.`input_original_firmware_image_id` = `firmware_image_metadata`.`image_id`)"
+
+// FindUnreferencedFirmware returns IDs of images which were added before `addedBefore`
+// and are not used as an input in any AnalyzerReport.
+func (stor *Storage) FindUnreferencedFirmware(
+	ctx context.Context,
+	addedBefore time.Time,
+	limit uint, // 0 -- no limit
+) ([]types.ImageID, error) {
+	query := "SELECT `image_id` FROM `firmware_image_metadata` WHERE `ts_add` < ? AND NOT (" + firmwareReferencedCond + ") ORDER BY `ts_add`"
+	if limit != 0 {
+		query += fmt.Sprintf(" LIMIT %d", limit)
+	}
+	logger.FromCtx(ctx).Debugf("query: <%s>; addedBefore: %v", query, addedBefore)
+
+	var imageIDs []types.ImageID
+	if err := sqlx.SelectContext(ctx, stor.DB, &imageIDs, query, addedBefore); err != nil {
+		return nil, ErrSelect{Err: fmt.Errorf("unable to perform query '%s': %w", query, err)}
+	}
+	return imageIDs, nil
+}
+
+// DeleteFirmware deletes an image from the storage (both: the metadata and the image itself).
+//
+// The metadata row is locked and the image is re-checked to be unreferenced before
+// the deletion. If the image got referenced in the meanwhile, then nothing is deleted
+// and ErrUnableToDelete is returned.
+func (stor *Storage) DeleteFirmware(ctx context.Context, imageID types.ImageID) (err error) {
+	// The metadata row is deleted first, but the transaction is committed only
+	// after the blob is deleted. So if deletion from the BlobStorage fails
+	// the metadata stays intact and the deletion could be retried later.
+
+	tx, err := stor.startTransaction(ctx)
+	if err != nil {
+		return ErrUnableToDelete{deletedValue: imageID.String(), Err: fmt.Errorf("unable to start a transaction: %w", err)}
+	}
+	defer func() {
+		if err != nil {
+			errmon.ObserveErrorCtx(ctx, err)
+			if rollbackErr := tx.Rollback(); rollbackErr != nil {
+				// To do not leave a transaction which could hang other workers we panic,
+				// it with disconnect from MySQL and force-release the transaction.
+				panic(fmt.Errorf("unable to rollback the transaction and do not how to remediate: %w", rollbackErr))
+			}
+			return
+		}
+
+		if commitErr := tx.Commit(); commitErr != nil {
+			err = ErrUnableToDelete{deletedValue: imageID.String(), Err: fmt.Errorf("unable to commit the transaction: %w", commitErr)}
+		}
+	}()
+
+	var referenced bool
+	query := "SELECT " + firmwareReferencedCond + " FROM `firmware_image_metadata` WHERE `image_id` = ? FOR UPDATE"
+	if err = tx.GetContext(ctx, &referenced, query, imageID); err != nil {
+		return ErrUnableToDelete{deletedValue: imageID.String(), Err: fmt.Errorf("unable to lock the metadata row: %w", err)}
+	}
+	if referenced {
+		return ErrUnableToDelete{deletedValue: imageID.String(), Err: fmt.Errorf("the image is referenced by an analyzer report")}
+	}
+
+	if _, err = tx.ExecContext(ctx, "DELETE FROM `firmware_image_chunk` WHERE `image_id` = ?", imageID); err != nil {
+		return ErrUnableToDelete{deletedValue: imageID.String(), Err: fmt.Errorf("unable to delete the chunk rows: %w", err)}
+	}
+	if _, err = tx.ExecContext(ctx, "DELETE FROM `firmware_image_metadata` WHERE `image_id` = ?", imageID); err != nil {
+		return ErrUnableToDelete{deletedValue: imageID.String(), Err: fmt.Errorf("unable to delete the metadata row: %w", err)}
+	}
+
+	err = stor.retryLoop(func() error {
+		err := stor.BlobStorage.Delete(ctx, imageID.BlobStorageKey())
+		if errors.Is(err, os.ErrNotExist) {
+			// already deleted, nothing to do
+			return nil
+		}
+		return err
+	})
+	if err != nil {
+		return ErrUnableToDelete{deletedValue: imageID.String(), Err: fmt.Errorf("unable to delete the image from the blob storage: %w", err)}
+	}
+	return nil
+}
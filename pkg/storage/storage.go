@@ -136,6 +136,11 @@ func (stor *Storage) startTransactionWithRollback(ctx context.Context) (*sqlx.Tx
 	}, nil
 }
 
+// Ping returns an error if the database is not reachable.
+func (stor *Storage) Ping(ctx context.Context) error {
+	return stor.DB.PingContext(ctx)
+}
+
 // Close stops the instance of the Storage.
 func (stor *Storage) Close() error {
 	return multierror.Append((error)(nil),
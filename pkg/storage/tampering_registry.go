@@ -0,0 +1,220 @@
+// Copyright 2023 Meta Platforms, Inc. and affiliates.
+//
+// Redistribution and use in source and binary forms, with or without modification, are permitted provided that the following conditions are met:
+//
+// 1. Redistributions of source code must retain the above copyright notice, this list of conditions and the following disclaimer.
+//
+// 2. Redistributions in binary form must reproduce the above copyright notice, this list of conditions and the following disclaimer in the documentation and/or other materials provided with the distribution.
+//
+// 3. Neither the name of the copyright holder nor the names of its contributors may be used to endorse or promote products derived from this software without specific prior written permission.
+//
+// THIS SOFTWARE IS PROVIDED BY THE COPYRIGHT HOLDERS AND CONTRIBUTORS "AS IS" AND ANY EXPRESS OR IMPLIED WARRANTIES, INCLUDING, BUT NOT LIMITED TO, THE IMPLIED WARRANTIES OF MERCHANTABILITY AND FITNESS FOR A PARTICULAR PURPOSE ARE DISCLAIMED. IN NO EVENT SHALL THE COPYRIGHT HOLDER OR CONTRIBUTORS BE LIABLE FOR ANY DIRECT, INDIRECT, INCIDENTAL, SPECIAL, EXEMPLARY, OR CONSEQUENTIAL DAMAGES (INCLUDING, BUT NOT LIMITED TO, PROCUREMENT OF SUBSTITUTE GOODS OR SERVICES; LOSS OF USE, DATA, OR PROFITS; OR BUSINESS INTERRUPTION) HOWEVER CAUSED AND ON ANY THEORY OF LIABILITY, WHETHER IN CONTRACT, STRICT LIABILITY, OR TORT (INCLUDING NEGLIGENCE OR OTHERWISE) ARISING IN ANY WAY OUT OF THE USE OF THIS SOFTWARE, EVEN IF ADVISED OF THE POSSIBILITY OF SUCH DAMAGE.
+package storage
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/facebookincubator/go-belt/tool/logger"
+	"github.com/jmoiron/sqlx"
+
+	"github.com/immune-gmbh/AttestationFailureAnalysisService/pkg/storage/helpers"
+	"github.com/immune-gmbh/AttestationFailureAnalysisService/pkg/storage/models"
+)
+
+// TamperingRegistryKey is a value to be matched against TamperingRegistryEntry-s.
+type TamperingRegistryKey struct {
+	Kind  models.TamperingRegistryKind
+	Value string
+}
+
+// TamperingRegistryFilter defines which TamperingRegistryEntry-s to select.
+//
+// If a field has a zero-value then it is not included to filter conditions.
+type TamperingRegistryFilter struct {
+	// Kind selects only entries of the given kind.
+	Kind models.TamperingRegistryKind
+
+	// Keys selects only entries matching any of the given keys.
+	Keys []TamperingRegistryKey
+
+	// ActiveAt selects only entries which are not expired at the given moment.
+	ActiveAt time.Time
+}
+
+func compileFindTamperingRegistryEntriesQuery(
+	filter TamperingRegistryFilter,
+	columns []string,
+) (string, []any) {
+	var whereConds []string
+	var whereArgs []any
+
+	if filter.Kind != "" {
+		whereConds = append(whereConds, "`kind` = ?")
+		whereArgs = append(whereArgs, filter.Kind)
+	}
+	if len(filter.Keys) > 0 {
+		keyConds := make([]string, 0, len(filter.Keys))
+		for _, key := range filter.Keys {
+			keyConds = append(keyConds, "(`kind` = ? AND `value` = ?)")
+			whereArgs = append(whereArgs, key.Kind, key.Value)
+		}
+		whereConds = append(whereConds, strings.Join(keyConds, " OR "))
+	}
+	if !filter.ActiveAt.IsZero() {
+		whereConds = append(whereConds, "`expires_at` IS NULL OR `expires_at` > ?")
+		whereArgs = append(whereArgs, filter.ActiveAt)
+	}
+
+	query := fmt.Sprintf("SELECT %s FROM `tampering_registry_entry`", constructColumns("tampering_registry_entry", columns))
+	if len(whereConds) > 0 {
+		query += " WHERE (" + strings.Join(whereConds, ") AND (") + ")"
+	}
+	query += " ORDER BY `id`"
+	return query, whereArgs
+}
+
+// FindTamperingRegistryEntries returns TamperingRegistryEntry-s matching the filter.
+//
+// TODO: Remove these functions from `Storage`. The initial purpose of storage is combine together
+//
+//	management of metadata in MySQL and data in BlobStorage for firmware images. All the rest
+//	entities should not be accessed through Storage. Otherwise locking, transactions and other
+//	usual stuff is pretty cludgy.
+func (stor *Storage) FindTamperingRegistryEntries(
+	ctx context.Context,
+	filter TamperingRegistryFilter,
+) ([]models.TamperingRegistryEntry, error) {
+	_, columns, err := helpers.GetValuesAndColumns(&models.TamperingRegistryEntry{}, nil)
+	if err != nil {
+		return nil, fmt.Errorf("unable to gather column names: %w", err)
+	}
+
+	query, args := compileFindTamperingRegistryEntriesQuery(filter, columns)
+	logger.FromCtx(ctx).Debugf("query: <%s>; args: %v", query, args)
+
+	var entries []models.TamperingRegistryEntry
+	if err := sqlx.SelectContext(ctx, stor.DB, &entries, query, args...); err != nil {
+		return nil, ErrSelect{Err: fmt.Errorf("unable to perform query '%s' with args %v: %w", query, args, err)}
+	}
+	return entries, nil
+}
+
+// InsertTamperingRegistryEntry adds an entry to the tampering registry.
+//
+// `entry.ID` should be zero, on success it is set.
+//
+// TODO: Remove these functions from `Storage`. The initial purpose of storage is combine together
+//
+//	management of metadata in MySQL and data in BlobStorage for firmware images. All the rest
+//	entities should not be accessed through Storage. Otherwise locking, transactions and other
+//	usual stuff is pretty cludgy.
+func (stor *Storage) InsertTamperingRegistryEntry(
+	ctx context.Context,
+	entry *models.TamperingRegistryEntry,
+) error {
+	if entry == nil {
+		return fmt.Errorf("entry is nil")
+	}
+	if entry.ID != 0 {
+		return fmt.Errorf("ID is already non-zero: %d", entry.ID)
+	}
+	if entry.CreatedAt.IsZero() {
+		entry.CreatedAt = time.Now()
+	}
+
+	values, columns, err := helpers.GetValuesAndColumns(entry, func(fieldName string, value any) bool {
+		return fieldName == "ID"
+	})
+	if err != nil {
+		return fmt.Errorf("unable to get query parameters: %w", err)
+	}
+
+	query := fmt.Sprintf("INSERT INTO `tampering_registry_entry` (%s) VALUES (%s)", constructColumns("", columns), constructPlaceholders(len(columns)))
+	sqlResult, err := stor.DB.ExecContext(ctx, query, values...)
+	if err != nil {
+		if mysqlErr := asMySQLError(err, 1062); mysqlErr != nil {
+			return ErrTamperingRegistryEntryExists{Kind: entry.Kind, Value: entry.Value}
+		}
+		return ErrUnableToInsert{insertedValue: fmt.Sprintf("%s:%s", entry.Kind, entry.Value), Err: err}
+	}
+	lastID, err := sqlResult.LastInsertId()
+	if err != nil {
+		return fmt.Errorf("unable to get last inserted ID: %w", err)
+	}
+	entry.ID = uint64(lastID)
+	return nil
+}
+
+// UpdateTamperingRegistryEntry replaces the kind, the value, the reason and
+// the expiration time of the entry with ID `entry.ID`.
+//
+// Returns ErrNotFound if there is no such entry.
+//
+// TODO: Remove these functions from `Storage`. The initial purpose of storage is combine together
+//
+//	management of metadata in MySQL and data in BlobStorage for firmware images. All the rest
+//	entities should not be accessed through Storage. Otherwise locking, transactions and other
+//	usual stuff is pretty cludgy.
+func (stor *Storage) UpdateTamperingRegistryEntry(
+	ctx context.Context,
+	entry models.TamperingRegistryEntry,
+) error {
+	query := "UPDATE `tampering_registry_entry` SET `kind` = ?, `value` = ?, `reason` = ?, `expires_at` = ? WHERE `id` = ?"
+	args := []any{entry.Kind, entry.Value, entry.Reason, entry.ExpiresAt, entry.ID}
+	sqlResult, err := stor.DB.ExecContext(ctx, query, args...)
+	if err != nil {
+		if mysqlErr := asMySQLError(err, 1062); mysqlErr != nil {
+			return ErrTamperingRegistryEntryExists{Kind: entry.Kind, Value: entry.Value}
+		}
+		return ErrUnableToUpdate{insertedValue: fmt.Sprintf("%s:%s", entry.Kind, entry.Value), Err: err}
+	}
+	rowsAffected, err := sqlResult.RowsAffected()
+	if err != nil {
+		return fmt.Errorf("unable to get the amount of affected rows: %w", err)
+	}
+	if rowsAffected > 0 {
+		return nil
+	}
+
+	// MySQL does not count rows, which were not changed, as affected.
+	query = "SELECT COUNT(*) FROM `tampering_registry_entry` WHERE `id` = ?"
+	var count uint64
+	if err := sqlx.GetContext(ctx, stor.DB, &count, query, entry.ID); err != nil {
+		return ErrSelect{Err: fmt.Errorf("unable to perform query '%s': %w", query, err)}
+	}
+	if count == 0 {
+		return ErrNotFound{Query: query}
+	}
+	return nil
+}
+
+// DeleteTamperingRegistryEntry removes the entry with the given ID from the tampering registry.
+//
+// Returns ErrNotFound if there is no such entry.
+//
+// TODO: Remove these functions from `Storage`. The initial purpose of storage is combine together
+//
+//	management of metadata in MySQL and data in BlobStorage for firmware images. All the rest
+//	entities should not be accessed through Storage. Otherwise locking, transactions and other
+//	usual stuff is pretty cludgy.
+func (stor *Storage) DeleteTamperingRegistryEntry(
+	ctx context.Context,
+	id uint64,
+) error {
+	query := "DELETE FROM `tampering_registry_entry` WHERE `id` = ?"
+	sqlResult, err := stor.DB.ExecContext(ctx, query, id)
+	if err != nil {
+		return ErrUnableToDelete{deletedValue: fmt.Sprint(id), Err: err}
+	}
+	rowsAffected, err := sqlResult.RowsAffected()
+	if err != nil {
+		return fmt.Errorf("unable to get the amount of affected rows: %w", err)
+	}
+	if rowsAffected == 0 {
+		return ErrNotFound{Query: query}
+	}
+	return nil
+}
@@ -0,0 +1,56 @@
+// Copyright 2023 Meta Platforms, Inc. and affiliates.
+//
+// Redistribution and use in source and binary forms, with or without modification, are permitted provided that the following conditions are met:
+//
+// 1. Redistributions of source code must retain the above copyright notice, this list of conditions and the following disclaimer.
+//
+// 2. Redistributions in binary form must reproduce the above copyright notice, this list of conditions and the following disclaimer in the documentation and/or other materials provided with the distribution.
+//
+// 3. Neither the name of the copyright holder nor the names of its contributors may be used to endorse or promote products derived from this software without specific prior written permission.
+//
+// THIS SOFTWARE IS PROVIDED BY THE COPYRIGHT HOLDERS AND CONTRIBUTORS "AS IS" AND ANY EXPRESS OR IMPLIED WARRANTIES, INCLUDING, BUT NOT LIMITED TO, THE IMPLIED WARRANTIES OF MERCHANTABILITY AND FITNESS FOR A PARTICULAR PURPOSE ARE DISCLAIMED. IN NO EVENT SHALL THE COPYRIGHT HOLDER OR CONTRIBUTORS BE LIABLE FOR ANY DIRECT, INDIRECT, INCIDENTAL, SPECIAL, EXEMPLARY, OR CONSEQUENTIAL DAMAGES (INCLUDING, BUT NOT LIMITED TO, PROCUREMENT OF SUBSTITUTE GOODS OR SERVICES; LOSS OF USE, DATA, OR PROFITS; OR BUSINESS INTERRUPTION) HOWEVER CAUSED AND ON ANY THEORY OF LIABILITY, WHETHER IN CONTRACT, STRICT LIABILITY, OR TORT (INCLUDING NEGLIGENCE OR OTHERWISE) ARISING IN ANY WAY OUT OF THE USE OF THIS SOFTWARE, EVEN IF ADVISED OF THE POSSIBILITY OF SUCH DAMAGE.
+package storage
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/immune-gmbh/AttestationFailureAnalysisService/pkg/storage/models"
+)
+
+func TestCompileFindTamperingRegistryEntriesQuery(t *testing.T) {
+	activeAt := time.Date(2023, 1, 2, 3, 4, 5, 0, time.UTC)
+	columns := []string{"id", "kind"}
+	{
+		query, args := compileFindTamperingRegistryEntriesQuery(TamperingRegistryFilter{}, columns)
+		require.True(t, strings.HasSuffix(query, " FROM `tampering_registry_entry` ORDER BY `id`"), query)
+		require.Empty(t, args)
+	}
+	{
+		query, args := compileFindTamperingRegistryEntriesQuery(TamperingRegistryFilter{
+			Keys: []TamperingRegistryKey{
+				{Kind: models.TamperingRegistryKindAssetID, Value: "1"},
+				{Kind: models.TamperingRegistryKindHostname, Value: "host"},
+			},
+			ActiveAt: activeAt,
+		}, columns)
+		require.True(t, strings.HasSuffix(query, " WHERE "+
+			"((`kind` = ? AND `value` = ?) OR (`kind` = ? AND `value` = ?)) AND "+
+			"(`expires_at` IS NULL OR `expires_at` > ?) ORDER BY `id`"), query)
+		require.Equal(t, []any{
+			models.TamperingRegistryKindAssetID, "1",
+			models.TamperingRegistryKindHostname, "host",
+			activeAt,
+		}, args)
+	}
+	{
+		query, args := compileFindTamperingRegistryEntriesQuery(TamperingRegistryFilter{
+			Kind: models.TamperingRegistryKindImageHash,
+		}, columns)
+		require.True(t, strings.HasSuffix(query, " WHERE (`kind` = ?) ORDER BY `id`"), query)
+		require.Equal(t, []any{models.TamperingRegistryKindImageHash}, args)
+	}
+}
@@ -18,7 +18,8 @@ import (
 	"strings"
 )
 
-const tpm12PCRsPath = `/sys/class/tpm/tpm0/pcrs`
+// tpm12PCRsFile is the file of a TPM1.2 device in sysfs with all the PCR values.
+const tpm12PCRsFile = `pcrs`
 
 const amountOfPCRs = 24
 
@@ -12,19 +12,26 @@
 package tpm
 
 import (
+	"encoding/hex"
 	"fmt"
 	"os"
+	"path/filepath"
+	"strings"
 
 	"github.com/9elements/converged-security-suite/v2/pkg/errors"
 	pcrtypes "github.com/9elements/converged-security-suite/v2/pkg/pcr/types"
 	"github.com/9elements/go-linux-lowlevel-hw/pkg/hwapi"
 	tpm1 "github.com/google/go-tpm/tpm"
 	"github.com/google/go-tpm/tpm2"
-	"github.com/marcoguerri/go-tpm-tcti/abrmd"
+
+	"github.com/immune-gmbh/AttestationFailureAnalysisService/pkg/hwaccess"
 )
 
 // ReadPCRFromTPM reads PCR value from TPM.
-func ReadPCRFromTPM(pcrIndex pcrtypes.ID, alg tpm2.Algorithm) ([]byte, error) {
+//
+// It tries sysfs first (see hwaccess.PathSysFSTPM) and then the TPM
+// commands (see hwaccess.Interface.OpenTPM).
+func ReadPCRFromTPM(hw hwaccess.Interface, pcrIndex pcrtypes.ID, alg tpm2.Algorithm) ([]byte, error) {
 	if pcrIndex >= amountOfPCRs {
 		return nil, fmt.Errorf("invalid PCR index: %d (should be less than %d)", pcrIndex, amountOfPCRs)
 	}
@@ -32,32 +39,30 @@ func ReadPCRFromTPM(pcrIndex pcrtypes.ID, alg tpm2.Algorithm) ([]byte, error) {
 	var mErr errors.MultiError
 
 	// Try to read PCR values from sysfs
-	if pcrsData, err := os.ReadFile(tpm12PCRsPath); err == nil {
-		if pcrs, err := parseSysfsPCRs(pcrsData); err == nil {
-			return pcrs[pcrIndex], nil
+	bankPCRPath := filepath.Join(hw.Path(hwaccess.PathSysFSTPM), "pcr-"+strings.ToLower(alg.String()), fmt.Sprint(int(pcrIndex)))
+	if pcrData, err := os.ReadFile(bankPCRPath); err == nil {
+		pcrValue, err := hex.DecodeString(strings.TrimSpace(string(pcrData)))
+		if err == nil {
+			return pcrValue, nil
 		}
-		mErr.Add(fmt.Errorf("unable to parse %s: %w", tpm12PCRsPath, err))
+		mErr.Add(fmt.Errorf("unable to parse %s: %w", bankPCRPath, err))
 	} else {
-		mErr.Add(fmt.Errorf("unable to read %s: %w", tpm12PCRsPath, err))
+		mErr.Add(fmt.Errorf("unable to read %s: %w", bankPCRPath, err))
 	}
-
-	// Try abrmd first, if failure then try /dev/tpm{rm,}.
-	abrmdClient, err := abrmd.NewBroker()
-	if err == nil {
-		defer abrmdClient.Close()
-		// abrmd is part of TPM2 tools, therefore we support on TPM2.0 here.
-		// If we have TPM1.2 then abrmdClient won't initialize.
-		pcrValue, err := tpm2.ReadPCR(abrmdClient, int(pcrIndex), alg)
-		if err != nil {
-			mErr.Add(fmt.Errorf("unable to get PCR0 value through abrmd: %w", err))
-			return nil, mErr.ReturnValue()
+	if alg == tpm2.AlgSHA1 {
+		tpm12PCRsPath := filepath.Join(hw.Path(hwaccess.PathSysFSTPM), tpm12PCRsFile)
+		if pcrsData, err := os.ReadFile(tpm12PCRsPath); err == nil {
+			pcrs, err := parseSysfsPCRs(pcrsData)
+			if err == nil {
+				return pcrs[pcrIndex], nil
+			}
+			mErr.Add(fmt.Errorf("unable to parse %s: %w", tpm12PCRsPath, err))
+		} else {
+			mErr.Add(fmt.Errorf("unable to read %s: %w", tpm12PCRsPath, err))
 		}
-		return pcrValue, nil
 	}
-	mErr.Add(fmt.Errorf("unable to connect to abrmd: %w", err))
 
-	// No success with abrmd, trying /dev/tpm{rm,}:
-	tpm, err := hwapi.NewTPM()
+	tpm, err := hw.OpenTPM()
 	if err != nil {
 		mErr.Add(fmt.Errorf("unable to open TPM: %w", err))
 		return nil, mErr.ReturnValue()
@@ -78,7 +83,7 @@ func ReadPCRFromTPM(pcrIndex pcrtypes.ID, alg tpm2.Algorithm) ([]byte, error) {
 	}
 
 	if err != nil {
-		mErr.Add(fmt.Errorf("uanble to get PCR0 value directly from TPM: %w", err))
+		mErr.Add(fmt.Errorf("unable to get PCR%d value from TPM: %w", pcrIndex, err))
 		return nil, mErr.ReturnValue()
 	}
 
@@ -0,0 +1,52 @@
+// Copyright 2023 Meta Platforms, Inc. and affiliates.
+//
+// Redistribution and use in source and binary forms, with or without modification, are permitted provided that the following conditions are met:
+//
+// 1. Redistributions of source code must retain the above copyright notice, this list of conditions and the following disclaimer.
+//
+// 2. Redistributions in binary form must reproduce the above copyright notice, this list of conditions and the following disclaimer in the documentation and/or other materials provided with the distribution.
+//
+// 3. Neither the name of the copyright holder nor the names of its contributors may be used to endorse or promote products derived from this software without specific prior written permission.
+//
+// THIS SOFTWARE IS PROVIDED BY THE COPYRIGHT HOLDERS AND CONTRIBUTORS "AS IS" AND ANY EXPRESS OR IMPLIED WARRANTIES, INCLUDING, BUT NOT LIMITED TO, THE IMPLIED WARRANTIES OF MERCHANTABILITY AND FITNESS FOR A PARTICULAR PURPOSE ARE DISCLAIMED. IN NO EVENT SHALL THE COPYRIGHT HOLDER OR CONTRIBUTORS BE LIABLE FOR ANY DIRECT, INDIRECT, INCIDENTAL, SPECIAL, EXEMPLARY, OR CONSEQUENTIAL DAMAGES (INCLUDING, BUT NOT LIMITED TO, PROCUREMENT OF SUBSTITUTE GOODS OR SERVICES; LOSS OF USE, DATA, OR PROFITS; OR BUSINESS INTERRUPTION) HOWEVER CAUSED AND ON ANY THEORY OF LIABILITY, WHETHER IN CONTRACT, STRICT LIABILITY, OR TORT (INCLUDING NEGLIGENCE OR OTHERWISE) ARISING IN ANY WAY OUT OF THE USE OF THIS SOFTWARE, EVEN IF ADVISED OF THE POSSIBILITY OF SUCH DAMAGE.
+
+package tpm
+
+import (
+	"bytes"
+	"fmt"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/google/go-tpm/tpm2"
+	"github.com/stretchr/testify/require"
+
+	"github.com/immune-gmbh/AttestationFailureAnalysisService/pkg/hwaccess"
+)
+
+func TestReadPCRFromTPMFixture(t *testing.T) {
+	var legacyPCRs strings.Builder
+	for idx := 0; idx < amountOfPCRs; idx++ {
+		fmt.Fprintf(&legacyPCRs, "PCR-%02d:%s\n", idx, strings.Repeat(fmt.Sprintf(" %02X", idx), 20))
+	}
+	hw, err := hwaccess.WriteFixture(t.TempDir(), map[string][]byte{
+		filepath.Join(hwaccess.PathSysFSTPM, "pcr-sha256", "0"): []byte(strings.Repeat("ab", 32) + "\n"),
+		filepath.Join(hwaccess.PathSysFSTPM, tpm12PCRsFile):     []byte(legacyPCRs.String()),
+	})
+	require.NoError(t, err)
+
+	pcr0, err := ReadPCRFromTPM(hw, 0, tpm2.AlgSHA256)
+	require.NoError(t, err)
+	require.Equal(t, bytes.Repeat([]byte{0xab}, 32), pcr0)
+
+	pcr3, err := ReadPCRFromTPM(hw, 3, tpm2.AlgSHA1)
+	require.NoError(t, err)
+	require.Equal(t, bytes.Repeat([]byte{0x03}, 20), pcr3)
+
+	_, err = ReadPCRFromTPM(hw, 1, tpm2.AlgSHA256)
+	require.Error(t, err)
+
+	_, err = ReadPCRFromTPM(hw, amountOfPCRs, tpm2.AlgSHA1)
+	require.Error(t, err)
+}
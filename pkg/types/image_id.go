@@ -16,6 +16,7 @@ import (
 	"crypto/sha512"
 	"database/sql"
 	"database/sql/driver"
+	"encoding/binary"
 	"encoding/hex"
 	"encoding/json"
 	"flag"
@@ -23,6 +24,7 @@ import (
 	"strings"
 	"sync"
 
+	pkgbytes "github.com/linuxboot/fiano/pkg/bytes"
 	"lukechampine.com/blake3"
 )
 
@@ -68,6 +70,30 @@ func NewImageIDFromImage(image []byte) ImageID {
 	return result
 }
 
+// sparseImageIDMagic prefixes the content hashed into the ID of a sparse image,
+// see NewImageIDFromSparseImage.
+const sparseImageIDMagic = "AFAS-SPARSE-IMAGE"
+
+// NewImageIDFromSparseImage calculates an ImageID of a partially dumped image
+// (see flashrom.DumpRegions), where only the bytes within `coverage` are
+// the actual content and the rest is padding.
+//
+// The ID is calculated over the coverage and the image together, so
+// it never equals the ID of a complete image (even if the padding happens to
+// match the original content) and the same bytes with a different coverage
+// get different IDs.
+func NewImageIDFromSparseImage(image []byte, coverage pkgbytes.Ranges) ImageID {
+	buf := make([]byte, 0, len(sparseImageIDMagic)+8+len(coverage)*16+len(image))
+	buf = append(buf, sparseImageIDMagic...)
+	buf = binary.BigEndian.AppendUint64(buf, uint64(len(coverage)))
+	for _, r := range coverage {
+		buf = binary.BigEndian.AppendUint64(buf, r.Offset)
+		buf = binary.BigEndian.AppendUint64(buf, r.Length)
+	}
+	buf = append(buf, image...)
+	return NewImageIDFromImage(buf)
+}
+
 // NewImageIDFromBytes just converts type []byte to ImageID.
 func NewImageIDFromBytes(imageID []byte) ImageID {
 	var result ImageID
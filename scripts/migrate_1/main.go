@@ -0,0 +1,70 @@
+// Copyright 2023 Meta Platforms, Inc. and affiliates.
+//
+// Redistribution and use in source and binary forms, with or without modification, are permitted provided that the following conditions are met:
+//
+// 1. Redistributions of source code must retain the above copyright notice, this list of conditions and the following disclaimer.
+//
+// 2. Redistributions in binary form must reproduce the above copyright notice, this list of conditions and the following disclaimer in the documentation and/or other materials provided with the distribution.
+//
+// 3. Neither the name of the copyright holder nor the names of its contributors may be used to endorse or promote products derived from this software without specific prior written permission.
+//
+// THIS SOFTWARE IS PROVIDED BY THE COPYRIGHT HOLDERS AND CONTRIBUTORS "AS IS" AND ANY EXPRESS OR IMPLIED WARRANTIES, INCLUDING, BUT NOT LIMITED TO, THE IMPLIED WARRANTIES OF MERCHANTABILITY AND FITNESS FOR A PARTICULAR PURPOSE ARE DISCLAIMED. IN NO EVENT SHALL THE COPYRIGHT HOLDER OR CONTRIBUTORS BE LIABLE FOR ANY DIRECT, INDIRECT, INCIDENTAL, SPECIAL, EXEMPLARY, OR CONSEQUENTIAL DAMAGES (INCLUDING, BUT NOT LIMITED TO, PROCUREMENT OF SUBSTITUTE GOODS OR SERVICES; LOSS OF USE, DATA, OR PROFITS; OR BUSINESS INTERRUPTION) HOWEVER CAUSED AND ON ANY THEORY OF LIABILITY, WHETHER IN CONTRACT, STRICT LIABILITY, OR TORT (INCLUDING NEGLIGENCE OR OTHERWISE) ARISING IN ANY WAY OUT OF THE USE OF THIS SOFTWARE, EVEN IF ADVISED OF THE POSSIBILITY OF SUCH DAMAGE.
+// migrate_1 creates table `firmware_image_chunk` in an existing database.
+// The table indexes the chunks of the stored firmware images, so that clients
+// could upload only the chunks unknown to the server. The images stored before
+// are not indexed, so their chunks are not reused.
+package main
+
+import (
+	"context"
+	"database/sql"
+	"os"
+
+	"github.com/facebookincubator/go-belt/tool/logger"
+	"github.com/go-sql-driver/mysql"
+	"github.com/spf13/pflag"
+
+	"github.com/immune-gmbh/AttestationFailureAnalysisService/pkg/observability"
+)
+
+// see pkg/storage/models/firmware_image_chunk.sql
+const createFirmwareImageChunkTable = "CREATE TABLE IF NOT EXISTS firmware_image_chunk (" +
+	"image_id VARBINARY(192) NOT NULL, " +
+	"chunk_index INT UNSIGNED NOT NULL, " +
+	"chunk_offset BIGINT UNSIGNED NOT NULL, " +
+	"size INT UNSIGNED NOT NULL, " +
+	"hash_sha256 BINARY(32) NOT NULL, " +
+	"PRIMARY KEY (image_id, chunk_index), " +
+	"INDEX (hash_sha256)" +
+	") DEFAULT CHARSET UTF8MB4"
+
+func main() {
+	logLevel := logger.LevelInfo // the default value
+	defaultDSN := (&mysql.Config{
+		User:      os.Getenv("DBUSER"),
+		Passwd:    os.Getenv("DBPASS"),
+		Net:       "tcp",
+		Addr:      "127.0.0.1:3306",
+		DBName:    "afas",
+		ParseTime: true,
+	}).FormatDSN()
+	rdbmsDriver := pflag.String("rdbms-driver-internal", "mysql", "")
+	rdbmsDSN := pflag.String("rdbms-dsn-internal", defaultDSN, "")
+	pflag.Var(&logLevel, "log-level", "logging level")
+	pflag.Parse()
+
+	ctx := observability.WithBelt(context.Background(), logLevel, "", true)
+	log := logger.FromCtx(ctx)
+
+	db, err := sql.Open(*rdbmsDriver, *rdbmsDSN)
+	if err != nil {
+		log.Panic(err)
+	}
+	defer func() { _ = db.Close() }()
+
+	log.Debugf("query: %s", createFirmwareImageChunkTable)
+	if _, err := db.ExecContext(ctx, createFirmwareImageChunkTable); err != nil {
+		log.Panicf("unable to perform query '%s': %v", createFirmwareImageChunkTable, err)
+	}
+	log.Infof("done")
+}
@@ -0,0 +1,131 @@
+// Copyright 2023 Meta Platforms, Inc. and affiliates.
+//
+// Redistribution and use in source and binary forms, with or without modification, are permitted provided that the following conditions are met:
+//
+// 1. Redistributions of source code must retain the above copyright notice, this list of conditions and the following disclaimer.
+//
+// 2. Redistributions in binary form must reproduce the above copyright notice, this list of conditions and the following disclaimer in the documentation and/or other materials provided with the distribution.
+//
+// 3. Neither the name of the copyright holder nor the names of its contributors may be used to endorse or promote products derived from this software without specific prior written permission.
+//
+// THIS SOFTWARE IS PROVIDED BY THE COPYRIGHT HOLDERS AND CONTRIBUTORS "AS IS" AND ANY EXPRESS OR IMPLIED WARRANTIES, INCLUDING, BUT NOT LIMITED TO, THE IMPLIED WARRANTIES OF MERCHANTABILITY AND FITNESS FOR A PARTICULAR PURPOSE ARE DISCLAIMED. IN NO EVENT SHALL THE COPYRIGHT HOLDER OR CONTRIBUTORS BE LIABLE FOR ANY DIRECT, INDIRECT, INCIDENTAL, SPECIAL, EXEMPLARY, OR CONSEQUENTIAL DAMAGES (INCLUDING, BUT NOT LIMITED TO, PROCUREMENT OF SUBSTITUTE GOODS OR SERVICES; LOSS OF USE, DATA, OR PROFITS; OR BUSINESS INTERRUPTION) HOWEVER CAUSED AND ON ANY THEORY OF LIABILITY, WHETHER IN CONTRACT, STRICT LIABILITY, OR TORT (INCLUDING NEGLIGENCE OR OTHERWISE) ARISING IN ANY WAY OUT OF THE USE OF THIS SOFTWARE, EVEN IF ADVISED OF THE POSSIBILITY OF SUCH DAMAGE.
+// migrate_6 updates an existing database to record analyzer versions
+// in the reports and re-analyze outdated reports:
+//   - stage "schema" adds the analyzer version and the re-analysis columns
+//     to table `analyzer_report`;
+//   - stage "backfill" fills the diagnosis codes and the issues in table
+//     `report_issue` (see migrate_3) of the analyzer reports stored before
+//     they were maintained.
+//
+// Both stages are idempotent, stage "schema" should be performed first.
+package main
+
+import (
+	"context"
+	"database/sql"
+	"os"
+
+	"github.com/facebookincubator/go-belt/tool/logger"
+	"github.com/go-sql-driver/mysql"
+	"github.com/spf13/pflag"
+
+	"github.com/immune-gmbh/AttestationFailureAnalysisService/pkg/analyzers"
+	"github.com/immune-gmbh/AttestationFailureAnalysisService/pkg/observability"
+	"github.com/immune-gmbh/AttestationFailureAnalysisService/pkg/storage"
+)
+
+type addColumn struct {
+	Table  string
+	Column string
+	Query  string
+}
+
+var addColumns = []addColumn{
+	{
+		Table:  "analyzer_report",
+		Column: "analyzer_version",
+		Query:  "ALTER TABLE `analyzer_report` ADD COLUMN `analyzer_version` VARCHAR(32) NOT NULL DEFAULT '' AFTER `analyzer_id`, ADD KEY `analyzer_version` (`analyzer_id`, `analyzer_version`)",
+	},
+	{
+		Table:  "analyzer_report",
+		Column: "reanalysis_of",
+		Query:  "ALTER TABLE `analyzer_report` ADD COLUMN `reanalysis_of` BIGINT UNSIGNED NULL AFTER `diagnosis_code`, ADD KEY `reanalysis_of` (`reanalysis_of`)",
+	},
+	{
+		Table:  "analyzer_report",
+		Column: "reanalysis_diff",
+		Query:  "ALTER TABLE `analyzer_report` ADD COLUMN `reanalysis_diff` JSON DEFAULT NULL AFTER `reanalysis_of`",
+	},
+	{
+		Table:  "analyzer_report",
+		Column: "superseded_by",
+		Query:  "ALTER TABLE `analyzer_report` ADD COLUMN `superseded_by` BIGINT UNSIGNED NULL AFTER `reanalysis_diff`",
+	},
+}
+
+func main() {
+	logLevel := logger.LevelInfo // the default value
+	defaultDSN := (&mysql.Config{
+		User:      os.Getenv("DBUSER"),
+		Passwd:    os.Getenv("DBPASS"),
+		Net:       "tcp",
+		Addr:      "127.0.0.1:3306",
+		DBName:    "afas",
+		ParseTime: true,
+	}).FormatDSN()
+	rdbmsDriver := pflag.String("rdbms-driver-internal", "mysql", "")
+	rdbmsDSN := pflag.String("rdbms-dsn-internal", defaultDSN, "")
+	stage := pflag.String("stage", "schema", "possible stages: schema, backfill")
+	batchSize := pflag.Uint("batch-size", 1000, "the amount of analyzer reports backfilled in a single transaction")
+	pflag.Var(&logLevel, "log-level", "logging level")
+	pflag.Parse()
+
+	ctx := observability.WithBelt(context.Background(), logLevel, "", true)
+	log := logger.FromCtx(ctx)
+
+	switch *stage {
+	case "schema":
+		db, err := sql.Open(*rdbmsDriver, *rdbmsDSN)
+		if err != nil {
+			log.Panic(err)
+		}
+		defer func() { _ = db.Close() }()
+
+		for _, add := range addColumns {
+			var count int
+			query := "SELECT COUNT(*) FROM `information_schema`.`columns` WHERE `table_schema` = DATABASE() AND `table_name` = ? AND `column_name` = ?"
+			if err := db.QueryRowContext(ctx, query, add.Table, add.Column).Scan(&count); err != nil {
+				log.Panic(err)
+			}
+			if count > 0 {
+				log.Infof("column '%s' of table '%s' already exists, skipping", add.Column, add.Table)
+				continue
+			}
+			log.Debugf("query: %s", add.Query)
+			if _, err := db.ExecContext(ctx, add.Query); err != nil {
+				log.Panicf("unable to perform query '%s': %v", add.Query, err)
+			}
+		}
+	case "backfill":
+		stor, err := storage.New(*rdbmsDriver, *rdbmsDSN, nil, nil, log)
+		if err != nil {
+			log.Panic(err)
+		}
+
+		var lastID uint64
+		for {
+			nextID, err := stor.BackfillAnalyzerReports(ctx, lastID, *batchSize, analyzers.DiagnosisCode)
+			if err != nil {
+				log.Panicf("unable to backfill analyzer reports after ID %d: %v", lastID, err)
+			}
+			if nextID == 0 {
+				break
+			}
+			log.Infof("backfilled analyzer reports up to ID %d", nextID)
+			lastID = nextID
+		}
+	default:
+		log.Panicf("unknown stage '%s'", *stage)
+	}
+	log.Infof("done")
+}
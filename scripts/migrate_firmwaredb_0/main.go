@@ -0,0 +1,105 @@
+// Copyright 2023 Meta Platforms, Inc. and affiliates.
+//
+// Redistribution and use in source and binary forms, with or without modification, are permitted provided that the following conditions are met:
+//
+// 1. Redistributions of source code must retain the above copyright notice, this list of conditions and the following disclaimer.
+//
+// 2. Redistributions in binary form must reproduce the above copyright notice, this list of conditions and the following disclaimer in the documentation and/or other materials provided with the distribution.
+//
+// 3. Neither the name of the copyright holder nor the names of its contributors may be used to endorse or promote products derived from this software without specific prior written permission.
+//
+// THIS SOFTWARE IS PROVIDED BY THE COPYRIGHT HOLDERS AND CONTRIBUTORS "AS IS" AND ANY EXPRESS OR IMPLIED WARRANTIES, INCLUDING, BUT NOT LIMITED TO, THE IMPLIED WARRANTIES OF MERCHANTABILITY AND FITNESS FOR A PARTICULAR PURPOSE ARE DISCLAIMED. IN NO EVENT SHALL THE COPYRIGHT HOLDER OR CONTRIBUTORS BE LIABLE FOR ANY DIRECT, INDIRECT, INCIDENTAL, SPECIAL, EXEMPLARY, OR CONSEQUENTIAL DAMAGES (INCLUDING, BUT NOT LIMITED TO, PROCUREMENT OF SUBSTITUTE GOODS OR SERVICES; LOSS OF USE, DATA, OR PROFITS; OR BUSINESS INTERRUPTION) HOWEVER CAUSED AND ON ANY THEORY OF LIABILITY, WHETHER IN CONTRACT, STRICT LIABILITY, OR TORT (INCLUDING NEGLIGENCE OR OTHERWISE) ARISING IN ANY WAY OUT OF THE USE OF THIS SOFTWARE, EVEN IF ADVISED OF THE POSSIBILITY OF SUCH DAMAGE.
+
+// migrate_firmwaredb_0 makes the names of measurement types unique in the
+// original firmware database: it merges duplicate types (which could be
+// created by concurrent registrations) and adds a UNIQUE key on the name.
+package main
+
+import (
+	"context"
+	"database/sql"
+	"os"
+
+	"github.com/facebookincubator/go-belt/tool/logger"
+	"github.com/go-sql-driver/mysql"
+	"github.com/spf13/pflag"
+
+	"github.com/immune-gmbh/AttestationFailureAnalysisService/pkg/observability"
+)
+
+// canonicalTypes maps each measurement type name to the oldest type with this name.
+const canonicalTypes = "(SELECT `name`, MIN(`id`) AS `id` FROM `firmware_measurement_type` GROUP BY `name`) AS `canonical`"
+
+var dedupQueries = []string{
+	"UPDATE `firmware_measurement` " +
+		"JOIN `firmware_measurement_type` ON `firmware_measurement`.`type_id` = `firmware_measurement_type`.`id` " +
+		"JOIN " + canonicalTypes + " ON `canonical`.`name` = `firmware_measurement_type`.`name` " +
+		"SET `firmware_measurement`.`type_id` = `canonical`.`id` WHERE `firmware_measurement_type`.`id` != `canonical`.`id`",
+	"DELETE `firmware_measurement_metadata` FROM `firmware_measurement_metadata` " +
+		"JOIN `firmware_measurement_type` ON `firmware_measurement_metadata`.`type_id` = `firmware_measurement_type`.`id` " +
+		"JOIN " + canonicalTypes + " ON `canonical`.`name` = `firmware_measurement_type`.`name` " +
+		"WHERE `firmware_measurement_type`.`id` != `canonical`.`id`",
+	"DELETE `firmware_measurement_type` FROM `firmware_measurement_type` " +
+		"JOIN " + canonicalTypes + " ON `canonical`.`name` = `firmware_measurement_type`.`name` " +
+		"WHERE `firmware_measurement_type`.`id` != `canonical`.`id`",
+}
+
+func main() {
+	logLevel := logger.LevelInfo // the default value
+	defaultDSN := (&mysql.Config{
+		User:      os.Getenv("DBUSER"),
+		Passwd:    os.Getenv("DBPASS"),
+		Net:       "tcp",
+		Addr:      "127.0.0.1:3306",
+		DBName:    "afas",
+		ParseTime: true,
+	}).FormatDSN()
+	rdbmsDriver := pflag.String("rdbms-driver-fw-orig", "mysql", "")
+	rdbmsDSN := pflag.String("rdbms-dsn-fw-orig", defaultDSN, "")
+	pflag.Var(&logLevel, "log-level", "logging level")
+	pflag.Parse()
+
+	ctx := observability.WithBelt(context.Background(), logLevel, "", true)
+	log := logger.FromCtx(ctx)
+
+	db, err := sql.Open(*rdbmsDriver, *rdbmsDSN)
+	if err != nil {
+		log.Panic(err)
+	}
+	defer func() { _ = db.Close() }()
+
+	var uniqueKeys int
+	query := "SELECT COUNT(*) FROM `information_schema`.`statistics` WHERE `table_schema` = DATABASE() AND `table_name` = 'firmware_measurement_type' AND `index_name` = 'name' AND `non_unique` = 0"
+	if err := db.QueryRowContext(ctx, query).Scan(&uniqueKeys); err != nil {
+		log.Panic(err)
+	}
+	if uniqueKeys > 0 {
+		log.Infof("the names of measurement types are already unique, nothing to do")
+		return
+	}
+
+	tx, err := db.BeginTx(ctx, nil)
+	if err != nil {
+		log.Panic(err)
+	}
+	for _, query := range dedupQueries {
+		log.Debugf("query: %s", query)
+		result, err := tx.ExecContext(ctx, query)
+		if err != nil {
+			_ = tx.Rollback()
+			log.Panicf("unable to perform query '%s': %v", query, err)
+		}
+		rowsAffected, _ := result.RowsAffected()
+		log.Infof("rows affected: %d (query: %s)", rowsAffected, query)
+	}
+	if err := tx.Commit(); err != nil {
+		log.Panic(err)
+	}
+
+	query = "ALTER TABLE `firmware_measurement_type` DROP KEY `name`, ADD UNIQUE KEY `name` (`name`)"
+	log.Debugf("query: %s", query)
+	if _, err := db.ExecContext(ctx, query); err != nil {
+		log.Panicf("unable to perform query '%s': %v", query, err)
+	}
+	log.Infof("done")
+}
@@ -21,6 +21,7 @@ import (
 
 	"github.com/immune-gmbh/AttestationFailureAnalysisService/pkg/dmidecode"
 	"github.com/immune-gmbh/AttestationFailureAnalysisService/pkg/flashrom"
+	"github.com/immune-gmbh/AttestationFailureAnalysisService/pkg/hwaccess"
 	"github.com/immune-gmbh/AttestationFailureAnalysisService/pkg/measurements"
 	xregisters "github.com/immune-gmbh/AttestationFailureAnalysisService/pkg/registers"
 	"github.com/immune-gmbh/AttestationFailureAnalysisService/pkg/server/controller/helpers"
@@ -187,7 +188,7 @@ func GetValidationInfo(
 	if regs := optionsConfig.ForceStatusRegisters; regs != nil {
 		result.StatusRegisters = regs
 	} else if !optionsConfig.HostNotBooted {
-		result.StatusRegisters, err = xregisters.LocalRegisters()
+		result.StatusRegisters, err = xregisters.LocalRegisters(hwaccess.Local())
 		if result.StatusRegisters == nil && err != nil {
 			// The && in the condition above is because
 			//
@@ -14,12 +14,19 @@ package main
 import (
 	"context"
 	"database/sql"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"io"
 	"os"
+	"runtime"
 	"time"
 
 	"github.com/go-sql-driver/mysql"
 	"github.com/immune-gmbh/AttestationFailureAnalysisService/cmd/afascli/commands/analyze/format"
+	"github.com/immune-gmbh/AttestationFailureAnalysisService/if/generated/afas"
 	"github.com/immune-gmbh/AttestationFailureAnalysisService/if/typeconv"
+	"github.com/immune-gmbh/AttestationFailureAnalysisService/pkg/analysis"
 	"github.com/immune-gmbh/AttestationFailureAnalysisService/pkg/observability"
 	"github.com/immune-gmbh/AttestationFailureAnalysisService/pkg/storage/models"
 	"github.com/immune-gmbh/AttestationFailureAnalysisService/pkg/types"
@@ -58,6 +65,20 @@ func main() {
 	rdbmsDSN := pflag.String("rdbms-dsn", defaultDSN, "")
 	blobstorageURL := pflag.String("object-storage-url", `fs:///srv/afasd`, "URL to an object storage where the firmware images are stored")
 	analyzerReportID := pflag.Int64("analyzer-report-id", 0, "")
+	bundlePath := pflag.String("bundle", "", "path to an evidence bundle (produced by 'afascli collect') to be analyzed instead of an analyzer report")
+	originalImagePath := pflag.String("original-image", "", "path to the original firmware image to be used with -bundle")
+	analyzerIDs := pflag.StringSlice("analyzer", nil, "analyzers to be executed with -bundle or -bulk (all known analyzers by default)")
+	bulk := pflag.Bool("bulk", false, "replay analyzer reports of all analyze reports matching the -filter-* flags and print a summary of verdict changes")
+	filterAssetID := pflag.Int32("filter-asset-id", 0, "with -bulk: select only analyze reports of the given asset ID")
+	filterJobID := pflag.String("filter-job-id", "", "with -bulk: select only the analyze report with the given job ID")
+	filterFirmwareVersion := pflag.String("filter-actual-firmware-version", "", "with -bulk: select only analyze reports of the actual firmware with the given version")
+	filterImageID := pflag.String("filter-actual-image-id", "", "with -bulk: select only analyze reports of the actual firmware with the given image ID (in hex)")
+	filterProcessedAfter := pflag.String("filter-processed-after", "", "with -bulk: select only analyze reports processed at or after the given time (RFC3339)")
+	filterProcessedBefore := pflag.String("filter-processed-before", "", "with -bulk: select only analyze reports processed before the given time (RFC3339)")
+	limit := pflag.Uint("limit", 1000, "with -bulk: the maximal amount of analyze reports to be replayed (the newest first); zero means no limit")
+	concurrency := pflag.Uint("concurrency", uint(runtime.NumCPU()), "with -bulk: the amount of analyzer reports replayed simultaneously")
+	maxExamples := pflag.Uint("examples", 3, "with -bulk: the maximal amount of example reports printed for each verdict change")
+	outputJSON := pflag.Bool("json", false, "with -bulk: print the summary (including the differences of the example reports) as JSON")
 	pflag.Parse()
 
 	ctx := observability.WithBelt(
@@ -82,15 +103,92 @@ func main() {
 	if pflag.NArg() != 0 {
 		usageExit()
 	}
-	if *analyzerReportID == 0 {
-		logger.FromCtx(ctx).Fatalf("-analyzer-report-id is required")
+	if *analyzerReportID == 0 && *bundlePath == "" && !*bulk {
+		logger.FromCtx(ctx).Fatalf("either -analyzer-report-id, -bundle or -bulk is required")
 	}
 
 	fianoLog.DefaultLogger = newFianoLogger(logger.FromCtx(ctx).WithField("module", "fiano"))
 
+	if *bundlePath != "" {
+		bundle, err := os.ReadFile(*bundlePath)
+		assertNoError(ctx, err)
+
+		var originalImage []byte
+		if *originalImagePath != "" {
+			originalImage, err = os.ReadFile(*originalImagePath)
+			assertNoError(ctx, err)
+		}
+
+		reports, err := replay.EvidenceBundle(ctx, bundle, originalImage, toAnalyzerIDs(*analyzerIDs)...)
+		assertNoError(ctx, err)
+
+		printReports(reports)
+		return
+	}
+
+	if *bulk {
+		opts := replay.BulkOptions{
+			Limit:       *limit,
+			Analyzers:   toAnalyzerIDs(*analyzerIDs),
+			Concurrency: *concurrency,
+			MaxExamples: *maxExamples,
+		}
+		if *filterAssetID != 0 {
+			opts.Filter.AssetID = filterAssetID
+		}
+		if *filterJobID != "" {
+			jobID, err := types.ParseJobID(*filterJobID)
+			assertNoError(ctx, err)
+			opts.Filter.JobID = &jobID
+		}
+		if *filterFirmwareVersion != "" {
+			opts.Filter.ActualFirmware.FirmwareVersion = filterFirmwareVersion
+		}
+		if *filterImageID != "" {
+			b, err := hex.DecodeString(*filterImageID)
+			assertNoError(ctx, err)
+			imageID := types.NewImageIDFromBytes(b)
+			opts.Filter.ActualFirmware.ImageID = &imageID
+		}
+		if *filterProcessedAfter != "" {
+			processedAfter, err := time.Parse(time.RFC3339, *filterProcessedAfter)
+			assertNoError(ctx, err)
+			opts.Filter.ProcessedAfter = &processedAfter
+		}
+		if *filterProcessedBefore != "" {
+			processedBefore, err := time.Parse(time.RFC3339, *filterProcessedBefore)
+			assertNoError(ctx, err)
+			opts.Filter.ProcessedBefore = &processedBefore
+		}
+
+		summary, err := replay.Bulk(ctx, *blobstorageURL, *rdbmsDriver, *rdbmsDSN, opts)
+		assertNoError(ctx, err)
+
+		if *outputJSON {
+			enc := json.NewEncoder(os.Stdout)
+			enc.SetIndent("", "  ")
+			assertNoError(ctx, enc.Encode(summary))
+			return
+		}
+		printBulkSummary(os.Stdout, summary)
+		return
+	}
+
 	report, err := replay.AnalyzerReport(ctx, *blobstorageURL, *rdbmsDriver, *rdbmsDSN, *analyzerReportID)
 	assertNoError(ctx, err)
 
+	printReports([]models.AnalyzerReport{*report})
+}
+
+func toAnalyzerIDs(ids []string) []analysis.AnalyzerID {
+	result := make([]analysis.AnalyzerID, 0, len(ids))
+	for _, id := range ids {
+		result = append(result, analysis.AnalyzerID(id))
+	}
+	return result
+}
+
+func printReports(reports []models.AnalyzerReport) {
 	format.HumanReadable(os.Stdout, *typeconv.ToThriftAnalyzeReport(&models.AnalyzeReport{
 		ID:              0,
 		JobID:           types.JobID{},
@@ -98,6 +196,30 @@ func main() {
 		Timestamp:       time.Now(),
 		ProcessedAt:     sql.NullTime{},
 		GroupKey:        nil,
-		AnalyzerReports: []models.AnalyzerReport{*report},
+		AnalyzerReports: reports,
 	}), true, false)
 }
+
+func printBulkSummary(w io.Writer, summary *replay.BulkSummary) {
+	fmt.Fprintf(w, "replayed: %d, verdict changed: %d, failed to replay: %d\n", summary.Total, summary.Changed, summary.Failed)
+	for _, analyzer := range summary.Analyzers {
+		fmt.Fprintf(w, "\n%s (version %s): replayed: %d, verdict changed: %d, failed to replay: %d\n",
+			analyzer.AnalyzerID, analyzer.Version, analyzer.Total, analyzer.Changed, analyzer.Failed)
+		for _, change := range analyzer.Changes {
+			fmt.Fprintf(w, "\t%s -> %s: %d\n", change.DiagnosisBefore, change.DiagnosisAfter, change.Count)
+			for _, example := range change.Examples {
+				fmt.Fprintf(w, "\t\tanalyze report %d, analyzer report %d (version %q)", example.AnalyzeReportID, example.AnalyzerReportID, example.PreviousVersion)
+				if errB := example.Diff.GetErrorB(); errB != "" {
+					fmt.Fprintf(w, ": error: %s", errB)
+				}
+				fmt.Fprintln(w)
+				for _, issue := range example.Diff.Issues {
+					if issue.Change == afas.IssueChange_Unchanged {
+						continue
+					}
+					fmt.Fprintf(w, "\t\t\t%s: [%s] %s\n", issue.Change, issue.Issue.GetSeverity(), issue.Issue.GetDescription())
+				}
+			}
+		}
+	}
+}
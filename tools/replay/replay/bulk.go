@@ -0,0 +1,407 @@
+// Copyright 2023 Meta Platforms, Inc. and affiliates.
+//
+// Redistribution and use in source and binary forms, with or without modification, are permitted provided that the following conditions are met:
+//
+// 1. Redistributions of source code must retain the above copyright notice, this list of conditions and the following disclaimer.
+//
+// 2. Redistributions in binary form must reproduce the above copyright notice, this list of conditions and the following disclaimer in the documentation and/or other materials provided with the distribution.
+//
+// 3. Neither the name of the copyright holder nor the names of its contributors may be used to endorse or promote products derived from this software without specific prior written permission.
+//
+// THIS SOFTWARE IS PROVIDED BY THE COPYRIGHT HOLDERS AND CONTRIBUTORS "AS IS" AND ANY EXPRESS OR IMPLIED WARRANTIES, INCLUDING, BUT NOT LIMITED TO, THE IMPLIED WARRANTIES OF MERCHANTABILITY AND FITNESS FOR A PARTICULAR PURPOSE ARE DISCLAIMED. IN NO EVENT SHALL THE COPYRIGHT HOLDER OR CONTRIBUTORS BE LIABLE FOR ANY DIRECT, INDIRECT, INCIDENTAL, SPECIAL, EXEMPLARY, OR CONSEQUENTIAL DAMAGES (INCLUDING, BUT NOT LIMITED TO, PROCUREMENT OF SUBSTITUTE GOODS OR SERVICES; LOSS OF USE, DATA, OR PROFITS; OR BUSINESS INTERRUPTION) HOWEVER CAUSED AND ON ANY THEORY OF LIABILITY, WHETHER IN CONTRACT, STRICT LIABILITY, OR TORT (INCLUDING NEGLIGENCE OR OTHERWISE) ARISING IN ANY WAY OUT OF THE USE OF THIS SOFTWARE, EVEN IF ADVISED OF THE POSSIBILITY OF SUCH DAMAGE.
+package replay
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"sync"
+
+	"github.com/facebookincubator/go-belt/tool/logger"
+	"github.com/jmoiron/sqlx"
+
+	"github.com/immune-gmbh/AttestationFailureAnalysisService/if/generated/afas"
+	"github.com/immune-gmbh/AttestationFailureAnalysisService/pkg/analysis"
+	"github.com/immune-gmbh/AttestationFailureAnalysisService/pkg/analyzers"
+	"github.com/immune-gmbh/AttestationFailureAnalysisService/pkg/server/controller/reportdiff"
+	controllertypes "github.com/immune-gmbh/AttestationFailureAnalysisService/pkg/server/controller/types"
+	"github.com/immune-gmbh/AttestationFailureAnalysisService/pkg/storage"
+	"github.com/immune-gmbh/AttestationFailureAnalysisService/pkg/storage/models"
+)
+
+const (
+	diagnosisNone  = "<none>"
+	diagnosisError = "<error>"
+
+	pageSizeDefault = 100
+)
+
+// BulkOptions defines which historical analyzer reports are replayed by Bulk and how.
+type BulkOptions struct {
+	// Filter selects analyze reports, analyzer reports of which are replayed.
+	Filter storage.AnalyzeReportFindFilter
+
+	// Limit is the maximal amount of analyze reports (the newest first). Zero means no limit.
+	Limit uint
+
+	// PageSize is the amount of analyze reports fetched from the storage at once.
+	// Zero means the default (100).
+	PageSize uint
+
+	// Analyzers restricts the replayed analyzer reports to the given analyzers.
+	// If empty then reports of all known analyzers are replayed.
+	Analyzers []analysis.AnalyzerID
+
+	// Concurrency is the amount of analyzer reports replayed simultaneously.
+	Concurrency uint
+
+	// MaxExamples is the maximal amount of examples kept for each verdict change.
+	MaxExamples uint
+}
+
+// BulkSummary is the result of Bulk.
+type BulkSummary struct {
+	Total     uint
+	Changed   uint
+	Failed    uint
+	Analyzers []*AnalyzerSummary
+}
+
+// AnalyzerSummary summarizes replays of reports of a single analyzer.
+type AnalyzerSummary struct {
+	AnalyzerID analysis.AnalyzerID
+	Version    analysis.AnalyzerVersion
+
+	// Total is the amount of replayed reports.
+	Total uint
+
+	// Changed is the amount of replayed reports with a changed verdict
+	// (see reportdiff.VerdictChanged).
+	Changed uint
+
+	// Failed is the amount of reports which could not be replayed at all
+	// (for example, because a firmware image is missing in the blob storage).
+	Failed uint
+
+	// Changes are the verdict changes grouped by the diagnoses, the most frequent first.
+	Changes []*VerdictChange
+
+	changesMap map[[2]string]*VerdictChange
+}
+
+// VerdictChange describes replayed reports with a changed verdict and the same diagnoses.
+type VerdictChange struct {
+	DiagnosisBefore string
+	DiagnosisAfter  string
+	Count           uint
+	Examples        []VerdictChangeExample
+}
+
+// VerdictChangeExample is an example of a replayed report with a changed verdict.
+type VerdictChangeExample struct {
+	AnalyzeReportID  uint64
+	AnalyzerReportID uint64
+	PreviousVersion  analysis.AnalyzerVersion
+	Diff             *afas.AnalyzerReportDiff
+}
+
+// Bulk replays analyzer reports of analyze reports matching the filter
+// with the current analyzers and summarizes the verdict changes.
+//
+// It only reads from the storage, so it is safe to be executed against
+// a production database; but it is intended to be used with a local snapshot.
+func Bulk(
+	ctx context.Context,
+	blobstoreURL string,
+	rdbmsDriver string,
+	rdbmsURL string,
+	opts BulkOptions,
+) (*BulkSummary, error) {
+	stor, closeFn, err := openStorage(ctx, blobstoreURL, rdbmsDriver, rdbmsURL)
+	if err != nil {
+		return nil, err
+	}
+	defer closeFn()
+
+	analyzersRegistry, err := analyzers.NewRegistryWithKnownAnalyzers()
+	if err != nil {
+		return nil, fmt.Errorf("unable to get analyzers registry: %w", err)
+	}
+	dataCalculator, err := analysis.NewDataCalculator(100)
+	if err != nil {
+		return nil, fmt.Errorf("unable to initialize data calculator: %w", err)
+	}
+
+	selected := map[analysis.AnalyzerID]bool{}
+	for _, analyzerID := range opts.Analyzers {
+		if analyzersRegistry.Version(analyzerID) == "" {
+			return nil, fmt.Errorf("unknown analyzer (ID '%s')", analyzerID)
+		}
+		selected[analyzerID] = true
+	}
+
+	concurrency := opts.Concurrency
+	if concurrency == 0 {
+		concurrency = 1
+	}
+
+	summary := newBulkSummary(opts.MaxExamples)
+	var (
+		wg       sync.WaitGroup
+		mutex    sync.Mutex
+		queue    = make(chan *models.AnalyzerReport)
+		replayer = bulkReplayer{
+			stor:           stor,
+			registry:       analyzersRegistry,
+			dataCalculator: dataCalculator,
+		}
+	)
+	for i := uint(0); i < concurrency; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for previous := range queue {
+				diff, err := replayer.replay(ctx, previous)
+				if err != nil {
+					logger.FromCtx(ctx).Errorf("unable to replay analyzer report %d: %v", previous.ID, err)
+				}
+
+				mutex.Lock()
+				summary.add(previous, analyzersRegistry.Version(previous.AnalyzerID), diff, err)
+				mutex.Unlock()
+			}
+		}()
+	}
+
+	// The reports are fetched by pages to keep the memory consumption
+	// bounded regardless of the amount of the matching reports.
+	var analyzeReportsCount, analyzerReportsCount uint
+	findErr := forEachAnalyzeReportsPage(ctx, stor, opts.Filter, opts.Limit, opts.PageSize, func(analyzeReports []*models.AnalyzeReport) error {
+		analyzeReportsCount += uint(len(analyzeReports))
+		for _, analyzeReport := range analyzeReports {
+			for idx := range analyzeReport.AnalyzerReports {
+				previous := &analyzeReport.AnalyzerReports[idx]
+				if analyzersRegistry.Version(previous.AnalyzerID) == "" {
+					continue
+				}
+				if len(selected) > 0 && !selected[previous.AnalyzerID] {
+					continue
+				}
+				if previous.Input == nil {
+					continue
+				}
+				select {
+				case queue <- previous:
+					analyzerReportsCount++
+				case <-ctx.Done():
+					return ctx.Err()
+				}
+			}
+		}
+		logger.FromCtx(ctx).Debugf("queued %d analyzer reports of %d analyze reports", analyzerReportsCount, analyzeReportsCount)
+		return nil
+	})
+	close(queue)
+	wg.Wait()
+
+	if err := ctx.Err(); err != nil {
+		return nil, err
+	}
+	if findErr != nil {
+		return nil, fmt.Errorf("unable to find analyze reports: %w", findErr)
+	}
+	logger.FromCtx(ctx).Infof("replayed %d analyzer reports of %d analyze reports", analyzerReportsCount, analyzeReportsCount)
+	return summary.finalize(), nil
+}
+
+type analyzeReportsFinder interface {
+	FindAnalyzeReports(
+		ctx context.Context,
+		filter storage.AnalyzeReportFindFilter,
+		tx *sqlx.Tx,
+		limit uint,
+	) ([]*models.AnalyzeReport, error)
+}
+
+// forEachAnalyzeReportsPage calls `callback` for each page of (at most `pageSize`)
+// analyze reports matching the filter, the newest first, until `limit` analyze reports
+// are passed (zero means no limit), the reports are exhausted or `callback` returns an error.
+func forEachAnalyzeReportsPage(
+	ctx context.Context,
+	finder analyzeReportsFinder,
+	filter storage.AnalyzeReportFindFilter,
+	limit uint,
+	pageSize uint,
+	callback func([]*models.AnalyzeReport) error,
+) error {
+	if pageSize == 0 {
+		pageSize = pageSizeDefault
+	}
+
+	var count uint
+	for limit == 0 || count < limit {
+		curPageSize := pageSize
+		if limit != 0 && limit-count < curPageSize {
+			curPageSize = limit - count
+		}
+
+		page, err := finder.FindAnalyzeReports(ctx, filter, nil, curPageSize)
+		if err != nil {
+			return err
+		}
+		if len(page) == 0 {
+			return nil
+		}
+		count += uint(len(page))
+
+		// FindAnalyzeReports returns the reports the newest (with the highest ID) first,
+		// so the next page starts right after the last report of this page.
+		lastID := page[len(page)-1].ID
+		filter.IDBefore = &lastID
+
+		if err := callback(page); err != nil {
+			return err
+		}
+		if uint(len(page)) < curPageSize {
+			return nil
+		}
+	}
+	return nil
+}
+
+type bulkReplayer struct {
+	stor           *storage.Storage
+	registry       *analyzers.Registry
+	dataCalculator analysis.DataCalculatorInterface
+}
+
+// replay executes the current version of the analyzer on the input
+// of the previous report and returns the difference from the previous report.
+func (r bulkReplayer) replay(
+	ctx context.Context,
+	previous *models.AnalyzerReport,
+) (*afas.AnalyzerReportDiff, error) {
+	// The images are not needed after the replay, and keeping
+	// them for thousands of reports would exhaust the memory.
+	defer func() { previous.Input = nil }()
+
+	if err := controllertypes.InitInputBlobs(ctx, previous.Input, r.stor.GetFirmwareBytes); err != nil {
+		return nil, fmt.Errorf("unable to initialize the input: %w", err)
+	}
+
+	result, execErr := r.registry.Execute(ctx, previous.AnalyzerID, r.dataCalculator, previous.Input, nil)
+	analysis.KnownTamperingOf(previous.Input).ApplyTo(result)
+
+	return reportdiff.CompareAnalyzerReports(previous, &models.AnalyzerReport{
+		AnalyzerID:      previous.AnalyzerID,
+		AnalyzerVersion: r.registry.Version(previous.AnalyzerID),
+		Input:           previous.Input,
+		Report:          result,
+		ExecError:       models.SQLErrorWrapper{Err: execErr},
+	})
+}
+
+type bulkSummary struct {
+	BulkSummary
+	maxExamples  uint
+	analyzersMap map[analysis.AnalyzerID]*AnalyzerSummary
+}
+
+func newBulkSummary(maxExamples uint) *bulkSummary {
+	return &bulkSummary{
+		maxExamples:  maxExamples,
+		analyzersMap: map[analysis.AnalyzerID]*AnalyzerSummary{},
+	}
+}
+
+// add accounts a replay of report `previous` which resulted into difference `diff`
+// (or failed with error `err`).
+func (s *bulkSummary) add(
+	previous *models.AnalyzerReport,
+	version analysis.AnalyzerVersion,
+	diff *afas.AnalyzerReportDiff,
+	err error,
+) {
+	analyzerSummary := s.analyzersMap[previous.AnalyzerID]
+	if analyzerSummary == nil {
+		analyzerSummary = &AnalyzerSummary{
+			AnalyzerID: previous.AnalyzerID,
+			Version:    version,
+			changesMap: map[[2]string]*VerdictChange{},
+		}
+		s.analyzersMap[previous.AnalyzerID] = analyzerSummary
+	}
+
+	s.Total++
+	analyzerSummary.Total++
+	if err != nil {
+		s.Failed++
+		analyzerSummary.Failed++
+		return
+	}
+	if !reportdiff.VerdictChanged(diff) {
+		return
+	}
+	s.Changed++
+	analyzerSummary.Changed++
+
+	key := [2]string{
+		diagnosisLabel(diff.DiagnosisA, diff.ErrorA),
+		diagnosisLabel(diff.DiagnosisB, diff.ErrorB),
+	}
+	change := analyzerSummary.changesMap[key]
+	if change == nil {
+		change = &VerdictChange{
+			DiagnosisBefore: key[0],
+			DiagnosisAfter:  key[1],
+		}
+		analyzerSummary.changesMap[key] = change
+	}
+	change.Count++
+	if uint(len(change.Examples)) < s.maxExamples {
+		change.Examples = append(change.Examples, VerdictChangeExample{
+			AnalyzeReportID:  previous.AnalyzeReportID,
+			AnalyzerReportID: previous.ID,
+			PreviousVersion:  previous.AnalyzerVersion,
+			Diff:             diff,
+		})
+	}
+}
+
+// finalize returns the summary with sorted analyzers and verdict changes.
+func (s *bulkSummary) finalize() *BulkSummary {
+	result := s.BulkSummary
+	result.Analyzers = nil
+	for _, analyzerSummary := range s.analyzersMap {
+		analyzerSummary.Changes = analyzerSummary.Changes[:0]
+		for _, change := range analyzerSummary.changesMap {
+			analyzerSummary.Changes = append(analyzerSummary.Changes, change)
+		}
+		sort.Slice(analyzerSummary.Changes, func(i, j int) bool {
+			a, b := analyzerSummary.Changes[i], analyzerSummary.Changes[j]
+			if a.Count != b.Count {
+				return a.Count > b.Count
+			}
+			if a.DiagnosisBefore != b.DiagnosisBefore {
+				return a.DiagnosisBefore < b.DiagnosisBefore
+			}
+			return a.DiagnosisAfter < b.DiagnosisAfter
+		})
+		result.Analyzers = append(result.Analyzers, analyzerSummary)
+	}
+	sort.Slice(result.Analyzers, func(i, j int) bool {
+		return result.Analyzers[i].AnalyzerID < result.Analyzers[j].AnalyzerID
+	})
+	return &result
+}
+
+func diagnosisLabel(diagnosis, execError *string) string {
+	switch {
+	case execError != nil:
+		return diagnosisError
+	case diagnosis != nil:
+		return *diagnosis
+	default:
+		return diagnosisNone
+	}
+}
@@ -0,0 +1,127 @@
+// Copyright 2023 Meta Platforms, Inc. and affiliates.
+//
+// Redistribution and use in source and binary forms, with or without modification, are permitted provided that the following conditions are met:
+//
+// 1. Redistributions of source code must retain the above copyright notice, this list of conditions and the following disclaimer.
+//
+// 2. Redistributions in binary form must reproduce the above copyright notice, this list of conditions and the following disclaimer in the documentation and/or other materials provided with the distribution.
+//
+// 3. Neither the name of the copyright holder nor the names of its contributors may be used to endorse or promote products derived from this software without specific prior written permission.
+//
+// THIS SOFTWARE IS PROVIDED BY THE COPYRIGHT HOLDERS AND CONTRIBUTORS "AS IS" AND ANY EXPRESS OR IMPLIED WARRANTIES, INCLUDING, BUT NOT LIMITED TO, THE IMPLIED WARRANTIES OF MERCHANTABILITY AND FITNESS FOR A PARTICULAR PURPOSE ARE DISCLAIMED. IN NO EVENT SHALL THE COPYRIGHT HOLDER OR CONTRIBUTORS BE LIABLE FOR ANY DIRECT, INDIRECT, INCIDENTAL, SPECIAL, EXEMPLARY, OR CONSEQUENTIAL DAMAGES (INCLUDING, BUT NOT LIMITED TO, PROCUREMENT OF SUBSTITUTE GOODS OR SERVICES; LOSS OF USE, DATA, OR PROFITS; OR BUSINESS INTERRUPTION) HOWEVER CAUSED AND ON ANY THEORY OF LIABILITY, WHETHER IN CONTRACT, STRICT LIABILITY, OR TORT (INCLUDING NEGLIGENCE OR OTHERWISE) ARISING IN ANY WAY OUT OF THE USE OF THIS SOFTWARE, EVEN IF ADVISED OF THE POSSIBILITY OF SUCH DAMAGE.
+package replay
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/jmoiron/sqlx"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/immune-gmbh/AttestationFailureAnalysisService/if/generated/afas"
+	"github.com/immune-gmbh/AttestationFailureAnalysisService/pkg/analysis"
+	"github.com/immune-gmbh/AttestationFailureAnalysisService/pkg/storage"
+	"github.com/immune-gmbh/AttestationFailureAnalysisService/pkg/storage/models"
+)
+
+func TestBulkSummary(t *testing.T) {
+	ptr := func(s string) *string { return &s }
+	previous := func(id uint64, analyzerID analysis.AnalyzerID) *models.AnalyzerReport {
+		return &models.AnalyzerReport{ID: id, AnalyzeReportID: id * 10, AnalyzerID: analyzerID}
+	}
+
+	summary := newBulkSummary(1)
+	summary.add(previous(1, "B"), "1.1.0", &afas.AnalyzerReportDiff{DiagnosisA: ptr("Match"), DiagnosisB: ptr("Match")}, nil)
+	summary.add(previous(2, "B"), "1.1.0", &afas.AnalyzerReportDiff{DiagnosisA: ptr("Match"), DiagnosisB: ptr("Mismatch")}, nil)
+	summary.add(previous(3, "B"), "1.1.0", &afas.AnalyzerReportDiff{DiagnosisA: ptr("Match"), DiagnosisB: ptr("Mismatch")}, nil)
+	summary.add(previous(4, "B"), "1.1.0", &afas.AnalyzerReportDiff{DiagnosisA: ptr("Match"), ErrorB: ptr("oops")}, nil)
+	summary.add(previous(5, "A"), "1.0.0", nil, fmt.Errorf("image not found"))
+	result := summary.finalize()
+
+	require.Equal(t, uint(5), result.Total)
+	require.Equal(t, uint(3), result.Changed)
+	require.Equal(t, uint(1), result.Failed)
+	require.Len(t, result.Analyzers, 2)
+
+	a := result.Analyzers[0]
+	require.Equal(t, "A", string(a.AnalyzerID))
+	require.Equal(t, uint(1), a.Failed)
+	require.Empty(t, a.Changes)
+
+	b := result.Analyzers[1]
+	require.Equal(t, uint(4), b.Total)
+	require.Equal(t, uint(3), b.Changed)
+	require.Len(t, b.Changes, 2)
+	require.Equal(t, "Mismatch", b.Changes[0].DiagnosisAfter)
+	require.Equal(t, uint(2), b.Changes[0].Count)
+	require.Len(t, b.Changes[0].Examples, 1)
+	require.Equal(t, uint64(20), b.Changes[0].Examples[0].AnalyzeReportID)
+	require.Equal(t, diagnosisError, b.Changes[1].DiagnosisAfter)
+}
+
+type fakeFinder struct {
+	reports []*models.AnalyzeReport // the newest first
+	limits  []uint
+}
+
+func (f *fakeFinder) FindAnalyzeReports(
+	ctx context.Context,
+	filter storage.AnalyzeReportFindFilter,
+	tx *sqlx.Tx,
+	limit uint,
+) ([]*models.AnalyzeReport, error) {
+	f.limits = append(f.limits, limit)
+	var result []*models.AnalyzeReport
+	for _, report := range f.reports {
+		if filter.IDBefore != nil && report.ID >= *filter.IDBefore {
+			continue
+		}
+		if uint(len(result)) == limit {
+			break
+		}
+		result = append(result, report)
+	}
+	return result, nil
+}
+
+func TestForEachAnalyzeReportsPage(t *testing.T) {
+	finder := &fakeFinder{}
+	for id := uint64(10); id > 0; id-- {
+		finder.reports = append(finder.reports, &models.AnalyzeReport{ID: id})
+	}
+	collect := func(limit, pageSize uint) ([][]uint64, error) {
+		finder.limits = nil
+		var pages [][]uint64
+		err := forEachAnalyzeReportsPage(context.Background(), finder, storage.AnalyzeReportFindFilter{}, limit, pageSize, func(page []*models.AnalyzeReport) error {
+			var ids []uint64
+			for _, report := range page {
+				ids = append(ids, report.ID)
+			}
+			pages = append(pages, ids)
+			return nil
+		})
+		return pages, err
+	}
+
+	pages, err := collect(0, 4)
+	require.NoError(t, err)
+	require.Equal(t, [][]uint64{{10, 9, 8, 7}, {6, 5, 4, 3}, {2, 1}}, pages)
+	require.Equal(t, []uint{4, 4, 4}, finder.limits)
+
+	pages, err = collect(6, 4)
+	require.NoError(t, err)
+	require.Equal(t, [][]uint64{{10, 9, 8, 7}, {6, 5}}, pages)
+	require.Equal(t, []uint{4, 2}, finder.limits)
+
+	pages, err = collect(0, 5)
+	require.NoError(t, err)
+	require.Equal(t, [][]uint64{{10, 9, 8, 7, 6}, {5, 4, 3, 2, 1}}, pages)
+	require.Equal(t, []uint{5, 5, 5}, finder.limits)
+
+	err = forEachAnalyzeReportsPage(context.Background(), finder, storage.AnalyzeReportFindFilter{}, 0, 4, func(page []*models.AnalyzeReport) error {
+		return fmt.Errorf("stop")
+	})
+	require.Error(t, err)
+}
@@ -0,0 +1,185 @@
+// Copyright 2023 Meta Platforms, Inc. and affiliates.
+//
+// Redistribution and use in source and binary forms, with or without modification, are permitted provided that the following conditions are met:
+//
+// 1. Redistributions of source code must retain the above copyright notice, this list of conditions and the following disclaimer.
+//
+// 2. Redistributions in binary form must reproduce the above copyright notice, this list of conditions and the following disclaimer in the documentation and/or other materials provided with the distribution.
+//
+// 3. Neither the name of the copyright holder nor the names of its contributors may be used to endorse or promote products derived from this software without specific prior written permission.
+//
+// THIS SOFTWARE IS PROVIDED BY THE COPYRIGHT HOLDERS AND CONTRIBUTORS "AS IS" AND ANY EXPRESS OR IMPLIED WARRANTIES, INCLUDING, BUT NOT LIMITED TO, THE IMPLIED WARRANTIES OF MERCHANTABILITY AND FITNESS FOR A PARTICULAR PURPOSE ARE DISCLAIMED. IN NO EVENT SHALL THE COPYRIGHT HOLDER OR CONTRIBUTORS BE LIABLE FOR ANY DIRECT, INDIRECT, INCIDENTAL, SPECIAL, EXEMPLARY, OR CONSEQUENTIAL DAMAGES (INCLUDING, BUT NOT LIMITED TO, PROCUREMENT OF SUBSTITUTE GOODS OR SERVICES; LOSS OF USE, DATA, OR PROFITS; OR BUSINESS INTERRUPTION) HOWEVER CAUSED AND ON ANY THEORY OF LIABILITY, WHETHER IN CONTRACT, STRICT LIABILITY, OR TORT (INCLUDING NEGLIGENCE OR OTHERWISE) ARISING IN ANY WAY OUT OF THE USE OF THIS SOFTWARE, EVEN IF ADVISED OF THE POSSIBILITY OF SUCH DAMAGE.
+
+package replay
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/immune-gmbh/AttestationFailureAnalysisService/if/generated/afas"
+	"github.com/immune-gmbh/AttestationFailureAnalysisService/pkg/analysis"
+	"github.com/immune-gmbh/AttestationFailureAnalysisService/pkg/analyzers/amd/apcbsectokens"
+	"github.com/immune-gmbh/AttestationFailureAnalysisService/pkg/analyzers/amd/biosrtmvolume"
+	"github.com/immune-gmbh/AttestationFailureAnalysisService/pkg/analyzers/amd/pspsignature"
+	"github.com/immune-gmbh/AttestationFailureAnalysisService/pkg/analyzers/diffmeasuredboot"
+	"github.com/immune-gmbh/AttestationFailureAnalysisService/pkg/analyzers/intelacm"
+	"github.com/immune-gmbh/AttestationFailureAnalysisService/pkg/analyzers/reproducepcr"
+	"github.com/immune-gmbh/AttestationFailureAnalysisService/pkg/evidence"
+	"github.com/immune-gmbh/AttestationFailureAnalysisService/pkg/server/controller/analyzerinput"
+	"github.com/immune-gmbh/AttestationFailureAnalysisService/pkg/storage/models"
+	"github.com/immune-gmbh/AttestationFailureAnalysisService/pkg/types"
+	pkgbytes "github.com/linuxboot/fiano/pkg/bytes"
+)
+
+// KnownAnalyzers is the list of analyzers which could be executed by EvidenceBundle.
+var KnownAnalyzers = []analysis.AnalyzerID{
+	diffmeasuredboot.ID,
+	intelacm.ID,
+	reproducepcr.ID,
+	pspsignature.ID,
+	biosrtmvolume.ID,
+	apcbsectokens.ID,
+}
+
+// localFirmwaresAccessor is an analyzerinput.FirmwaresAccessor which
+// works only with the firmware images passed directly.
+type localFirmwaresAccessor struct{}
+
+var _ analyzerinput.FirmwaresAccessor = localFirmwaresAccessor{}
+
+func (localFirmwaresAccessor) GetByBlob(ctx context.Context, content []byte) (analysis.Blob, error) {
+	return analysis.BytesBlob(content), nil
+}
+
+func (localFirmwaresAccessor) GetBySparseBlob(ctx context.Context, content []byte, coverage pkgbytes.Ranges) (analysis.Blob, error) {
+	return analysis.NewSparseBlob(analysis.BytesBlob(content), coverage), nil
+}
+
+func (localFirmwaresAccessor) GetByID(ctx context.Context, imageID types.ImageID) (analysis.Blob, error) {
+	return nil, fmt.Errorf("getting firmware images by ID is not supported for evidence bundles")
+}
+
+func (localFirmwaresAccessor) GetByVersion(ctx context.Context, firmwareVersion string) (analysis.Blob, error) {
+	return nil, fmt.Errorf("getting firmware images by version is not supported for evidence bundles")
+}
+
+// EvidenceBundle executes analyzers using the content of an evidence bundle
+// (see package "evidence") as the input. Since no firmware database is
+// available, the original firmware image has to be passed directly
+// (it may be nil, but then analyzers requiring it will fail).
+//
+// If analyzerIDs is empty, then all KnownAnalyzers are executed.
+func EvidenceBundle(
+	ctx context.Context,
+	bundleBytes []byte,
+	originalImage []byte,
+	analyzerIDs ...analysis.AnalyzerID,
+) ([]models.AnalyzerReport, error) {
+	bundle, err := evidence.Read(bundleBytes)
+	if err != nil {
+		return nil, fmt.Errorf("unable to read the evidence bundle: %w", err)
+	}
+
+	artifacts := []afas.Artifact{{EvidenceBundle: bundleBytes}}
+	bundleIdx := int32(0)
+	var originalIdx *int32
+	if originalImage != nil {
+		artifacts = append(artifacts, afas.Artifact{FwImage: &afas.FirmwareImage{
+			Blob: &afas.CompressedBlob{
+				Blob:        originalImage,
+				Compression: afas.CompressionType_None,
+			},
+		}})
+		originalIdx = ptr(int32(1))
+	}
+	artifactsAccessor, err := analyzerinput.NewArtifactsAccessor(artifacts, localFirmwaresAccessor{})
+	if err != nil {
+		return nil, fmt.Errorf("unable to initialize artifacts accessor: %w", err)
+	}
+
+	var (
+		registersIdx *int32
+		tpmDeviceIdx *int32
+		eventLogIdx  *int32
+		pcr0Idx      *int32
+	)
+	if bundle.StatusRegisters != nil {
+		registersIdx = &bundleIdx
+	}
+	if bundle.TPMDevice != nil {
+		tpmDeviceIdx = &bundleIdx
+	}
+	if len(bundle.TPMEventLog) > 0 {
+		eventLogIdx = &bundleIdx
+	}
+	if bundle.PCR0() != nil {
+		pcr0Idx = &bundleIdx
+	}
+
+	if len(analyzerIDs) == 0 {
+		analyzerIDs = KnownAnalyzers
+	}
+	result := make([]models.AnalyzerReport, 0, len(analyzerIDs))
+	for _, analyzerID := range analyzerIDs {
+		report := models.AnalyzerReport{
+			AnalyzerID: analyzerID,
+		}
+		var inputErr error
+		switch analyzerID {
+		case diffmeasuredboot.ID:
+			report.Input, inputErr = analyzerinput.NewDiffMeasuredBootInput(ctx, artifactsAccessor, afas.DiffMeasuredBootInput{
+				ActualFirmwareImage:   bundleIdx,
+				OriginalFirmwareImage: originalIdx,
+				StatusRegisters:       registersIdx,
+				TPMDevice:             tpmDeviceIdx,
+				TPMEventLog:           eventLogIdx,
+				ActualPCR0:            pcr0Idx,
+			})
+		case intelacm.ID:
+			report.Input, inputErr = analyzerinput.NewIntelACMInput(ctx, artifactsAccessor, afas.IntelACMInput{
+				ActualFirmwareImage:   bundleIdx,
+				OriginalFirmwareImage: originalIdx,
+			})
+		case reproducepcr.ID:
+			if pcr0Idx == nil {
+				inputErr = fmt.Errorf("the evidence bundle contains no PCR0 value")
+				break
+			}
+			report.Input, inputErr = analyzerinput.NewReproducePCRInput(ctx, artifactsAccessor, afas.ReproducePCRInput{
+				ActualFirmwareImage:   bundleIdx,
+				OriginalFirmwareImage: originalIdx,
+				StatusRegisters:       registersIdx,
+				TPMDevice:             tpmDeviceIdx,
+				TPMEventLog:           eventLogIdx,
+				ExpectedPCR:           *pcr0Idx,
+			})
+		case pspsignature.ID:
+			report.Input, inputErr = analyzerinput.NewPSPSignatureInput(ctx, artifactsAccessor, afas.PSPSignatureInput{
+				ActualFirmwareImage: bundleIdx,
+			})
+		case biosrtmvolume.ID:
+			report.Input, inputErr = analyzerinput.NewBIOSRTMVolumeInput(ctx, artifactsAccessor, afas.BIOSRTMVolumeInput{
+				ActualFirmwareImage: bundleIdx,
+			})
+		case apcbsectokens.ID:
+			report.Input, inputErr = analyzerinput.NewAPCBSecurityTokensInput(ctx, artifactsAccessor, afas.APCBSecurityTokensInput{
+				ActualFirmwareImage: bundleIdx,
+			})
+		default:
+			return nil, fmt.Errorf("unknown analyzer (ID '%s')", analyzerID)
+		}
+		if inputErr != nil {
+			report.ExecError.Err = fmt.Errorf("unable to construct the input: %w", inputErr)
+			result = append(result, report)
+			continue
+		}
+
+		report.Report, report.ExecError.Err = executeAnalyzer(ctx, &report)
+		result = append(result, report)
+	}
+	return result, nil
+}
+
+func ptr[T any](in T) *T {
+	return &in
+}